@@ -16,11 +16,12 @@ import (
 )
 
 var (
-	md_EventBondProvider          protoreflect.MessageDescriptor
-	fd_EventBondProvider_provider protoreflect.FieldDescriptor
-	fd_EventBondProvider_service  protoreflect.FieldDescriptor
-	fd_EventBondProvider_bond_rel protoreflect.FieldDescriptor
-	fd_EventBondProvider_bond_abs protoreflect.FieldDescriptor
+	md_EventBondProvider            protoreflect.MessageDescriptor
+	fd_EventBondProvider_provider   protoreflect.FieldDescriptor
+	fd_EventBondProvider_service    protoreflect.FieldDescriptor
+	fd_EventBondProvider_bond_rel   protoreflect.FieldDescriptor
+	fd_EventBondProvider_bond_abs   protoreflect.FieldDescriptor
+	fd_EventBondProvider_bond_denom protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -30,6 +31,7 @@ func init() {
 	fd_EventBondProvider_service = md_EventBondProvider.Fields().ByName("service")
 	fd_EventBondProvider_bond_rel = md_EventBondProvider.Fields().ByName("bond_rel")
 	fd_EventBondProvider_bond_abs = md_EventBondProvider.Fields().ByName("bond_abs")
+	fd_EventBondProvider_bond_denom = md_EventBondProvider.Fields().ByName("bond_denom")
 }
 
 var _ protoreflect.Message = (*fastReflection_EventBondProvider)(nil)
@@ -121,6 +123,12 @@ func (x *fastReflection_EventBondProvider) Range(f func(protoreflect.FieldDescri
 			return
 		}
 	}
+	if x.BondDenom != "" {
+		value := protoreflect.ValueOfString(x.BondDenom)
+		if !f(fd_EventBondProvider_bond_denom, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -144,6 +152,8 @@ func (x *fastReflection_EventBondProvider) Has(fd protoreflect.FieldDescriptor)
 		return x.BondRel != ""
 	case "arkeo.arkeo.EventBondProvider.bond_abs":
 		return x.BondAbs != ""
+	case "arkeo.arkeo.EventBondProvider.bond_denom":
+		return x.BondDenom != ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventBondProvider"))
@@ -168,6 +178,8 @@ func (x *fastReflection_EventBondProvider) Clear(fd protoreflect.FieldDescriptor
 		x.BondRel = ""
 	case "arkeo.arkeo.EventBondProvider.bond_abs":
 		x.BondAbs = ""
+	case "arkeo.arkeo.EventBondProvider.bond_denom":
+		x.BondDenom = ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventBondProvider"))
@@ -196,6 +208,9 @@ func (x *fastReflection_EventBondProvider) Get(descriptor protoreflect.FieldDesc
 	case "arkeo.arkeo.EventBondProvider.bond_abs":
 		value := x.BondAbs
 		return protoreflect.ValueOfString(value)
+	case "arkeo.arkeo.EventBondProvider.bond_denom":
+		value := x.BondDenom
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventBondProvider"))
@@ -224,6 +239,8 @@ func (x *fastReflection_EventBondProvider) Set(fd protoreflect.FieldDescriptor,
 		x.BondRel = value.Interface().(string)
 	case "arkeo.arkeo.EventBondProvider.bond_abs":
 		x.BondAbs = value.Interface().(string)
+	case "arkeo.arkeo.EventBondProvider.bond_denom":
+		x.BondDenom = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventBondProvider"))
@@ -252,6 +269,8 @@ func (x *fastReflection_EventBondProvider) Mutable(fd protoreflect.FieldDescript
 		panic(fmt.Errorf("field bond_rel of message arkeo.arkeo.EventBondProvider is not mutable"))
 	case "arkeo.arkeo.EventBondProvider.bond_abs":
 		panic(fmt.Errorf("field bond_abs of message arkeo.arkeo.EventBondProvider is not mutable"))
+	case "arkeo.arkeo.EventBondProvider.bond_denom":
+		panic(fmt.Errorf("field bond_denom of message arkeo.arkeo.EventBondProvider is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventBondProvider"))
@@ -273,6 +292,8 @@ func (x *fastReflection_EventBondProvider) NewField(fd protoreflect.FieldDescrip
 		return protoreflect.ValueOfString("")
 	case "arkeo.arkeo.EventBondProvider.bond_abs":
 		return protoreflect.ValueOfString("")
+	case "arkeo.arkeo.EventBondProvider.bond_denom":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventBondProvider"))
@@ -358,6 +379,10 @@ func (x *fastReflection_EventBondProvider) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		l = len(x.BondDenom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -387,6 +412,13 @@ func (x *fastReflection_EventBondProvider) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.BondDenom) > 0 {
+			i -= len(x.BondDenom)
+			copy(dAtA[i:], x.BondDenom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BondDenom)))
+			i--
+			dAtA[i] = 0x2a
+		}
 		if len(x.BondAbs) > 0 {
 			i -= len(x.BondAbs)
 			copy(dAtA[i:], x.BondAbs)
@@ -594,6 +626,38 @@ func (x *fastReflection_EventBondProvider) ProtoMethods() *protoiface.Methods {
 				}
 				x.BondAbs = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BondDenom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BondDenom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -731,6 +795,52 @@ func (x *_EventModProvider_10_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_EventModProvider_13_list)(nil)
+
+type _EventModProvider_13_list struct {
+	list *[]string
+}
+
+func (x *_EventModProvider_13_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_EventModProvider_13_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_EventModProvider_13_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_EventModProvider_13_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_EventModProvider_13_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message EventModProvider at list field UpdatedFields as it is not of Message kind"))
+}
+
+func (x *_EventModProvider_13_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_EventModProvider_13_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_EventModProvider_13_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
 	md_EventModProvider                       protoreflect.MessageDescriptor
 	fd_EventModProvider_creator               protoreflect.FieldDescriptor
@@ -745,6 +855,8 @@ var (
 	fd_EventModProvider_pay_as_you_go_rate    protoreflect.FieldDescriptor
 	fd_EventModProvider_bond                  protoreflect.FieldDescriptor
 	fd_EventModProvider_settlement_duration   protoreflect.FieldDescriptor
+	fd_EventModProvider_updated_fields        protoreflect.FieldDescriptor
+	fd_EventModProvider_max_open_contracts    protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -762,6 +874,8 @@ func init() {
 	fd_EventModProvider_pay_as_you_go_rate = md_EventModProvider.Fields().ByName("pay_as_you_go_rate")
 	fd_EventModProvider_bond = md_EventModProvider.Fields().ByName("bond")
 	fd_EventModProvider_settlement_duration = md_EventModProvider.Fields().ByName("settlement_duration")
+	fd_EventModProvider_updated_fields = md_EventModProvider.Fields().ByName("updated_fields")
+	fd_EventModProvider_max_open_contracts = md_EventModProvider.Fields().ByName("max_open_contracts")
 }
 
 var _ protoreflect.Message = (*fastReflection_EventModProvider)(nil)
@@ -901,6 +1015,18 @@ func (x *fastReflection_EventModProvider) Range(f func(protoreflect.FieldDescrip
 			return
 		}
 	}
+	if len(x.UpdatedFields) != 0 {
+		value := protoreflect.ValueOfList(&_EventModProvider_13_list{list: &x.UpdatedFields})
+		if !f(fd_EventModProvider_updated_fields, value) {
+			return
+		}
+	}
+	if x.MaxOpenContracts != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxOpenContracts)
+		if !f(fd_EventModProvider_max_open_contracts, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -940,6 +1066,10 @@ func (x *fastReflection_EventModProvider) Has(fd protoreflect.FieldDescriptor) b
 		return x.Bond != ""
 	case "arkeo.arkeo.EventModProvider.settlement_duration":
 		return x.SettlementDuration != int64(0)
+	case "arkeo.arkeo.EventModProvider.updated_fields":
+		return len(x.UpdatedFields) != 0
+	case "arkeo.arkeo.EventModProvider.max_open_contracts":
+		return x.MaxOpenContracts != uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventModProvider"))
@@ -980,6 +1110,10 @@ func (x *fastReflection_EventModProvider) Clear(fd protoreflect.FieldDescriptor)
 		x.Bond = ""
 	case "arkeo.arkeo.EventModProvider.settlement_duration":
 		x.SettlementDuration = int64(0)
+	case "arkeo.arkeo.EventModProvider.updated_fields":
+		x.UpdatedFields = nil
+	case "arkeo.arkeo.EventModProvider.max_open_contracts":
+		x.MaxOpenContracts = uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventModProvider"))
@@ -1038,6 +1172,15 @@ func (x *fastReflection_EventModProvider) Get(descriptor protoreflect.FieldDescr
 	case "arkeo.arkeo.EventModProvider.settlement_duration":
 		value := x.SettlementDuration
 		return protoreflect.ValueOfInt64(value)
+	case "arkeo.arkeo.EventModProvider.updated_fields":
+		if len(x.UpdatedFields) == 0 {
+			return protoreflect.ValueOfList(&_EventModProvider_13_list{})
+		}
+		listValue := &_EventModProvider_13_list{list: &x.UpdatedFields}
+		return protoreflect.ValueOfList(listValue)
+	case "arkeo.arkeo.EventModProvider.max_open_contracts":
+		value := x.MaxOpenContracts
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventModProvider"))
@@ -1086,6 +1229,12 @@ func (x *fastReflection_EventModProvider) Set(fd protoreflect.FieldDescriptor, v
 		x.Bond = value.Interface().(string)
 	case "arkeo.arkeo.EventModProvider.settlement_duration":
 		x.SettlementDuration = value.Int()
+	case "arkeo.arkeo.EventModProvider.updated_fields":
+		lv := value.List()
+		clv := lv.(*_EventModProvider_13_list)
+		x.UpdatedFields = *clv.list
+	case "arkeo.arkeo.EventModProvider.max_open_contracts":
+		x.MaxOpenContracts = value.Uint()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventModProvider"))
@@ -1118,6 +1267,12 @@ func (x *fastReflection_EventModProvider) Mutable(fd protoreflect.FieldDescripto
 		}
 		value := &_EventModProvider_10_list{list: &x.PayAsYouGoRate}
 		return protoreflect.ValueOfList(value)
+	case "arkeo.arkeo.EventModProvider.updated_fields":
+		if x.UpdatedFields == nil {
+			x.UpdatedFields = []string{}
+		}
+		value := &_EventModProvider_13_list{list: &x.UpdatedFields}
+		return protoreflect.ValueOfList(value)
 	case "arkeo.arkeo.EventModProvider.creator":
 		panic(fmt.Errorf("field creator of message arkeo.arkeo.EventModProvider is not mutable"))
 	case "arkeo.arkeo.EventModProvider.provider":
@@ -1138,6 +1293,8 @@ func (x *fastReflection_EventModProvider) Mutable(fd protoreflect.FieldDescripto
 		panic(fmt.Errorf("field bond of message arkeo.arkeo.EventModProvider is not mutable"))
 	case "arkeo.arkeo.EventModProvider.settlement_duration":
 		panic(fmt.Errorf("field settlement_duration of message arkeo.arkeo.EventModProvider is not mutable"))
+	case "arkeo.arkeo.EventModProvider.max_open_contracts":
+		panic(fmt.Errorf("field max_open_contracts of message arkeo.arkeo.EventModProvider is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventModProvider"))
@@ -1177,6 +1334,11 @@ func (x *fastReflection_EventModProvider) NewField(fd protoreflect.FieldDescript
 		return protoreflect.ValueOfString("")
 	case "arkeo.arkeo.EventModProvider.settlement_duration":
 		return protoreflect.ValueOfInt64(int64(0))
+	case "arkeo.arkeo.EventModProvider.updated_fields":
+		list := []string{}
+		return protoreflect.ValueOfList(&_EventModProvider_13_list{list: &list})
+	case "arkeo.arkeo.EventModProvider.max_open_contracts":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventModProvider"))
@@ -1293,6 +1455,15 @@ func (x *fastReflection_EventModProvider) ProtoMethods() *protoiface.Methods {
 		if x.SettlementDuration != 0 {
 			n += 1 + runtime.Sov(uint64(x.SettlementDuration))
 		}
+		if len(x.UpdatedFields) > 0 {
+			for _, s := range x.UpdatedFields {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.MaxOpenContracts != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxOpenContracts))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1322,6 +1493,20 @@ func (x *fastReflection_EventModProvider) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.MaxOpenContracts != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxOpenContracts))
+			i--
+			dAtA[i] = 0x70
+		}
+		if len(x.UpdatedFields) > 0 {
+			for iNdEx := len(x.UpdatedFields) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.UpdatedFields[iNdEx])
+				copy(dAtA[i:], x.UpdatedFields[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.UpdatedFields[iNdEx])))
+				i--
+				dAtA[i] = 0x6a
+			}
+		}
 		if x.SettlementDuration != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.SettlementDuration))
 			i--
@@ -1790,6 +1975,57 @@ func (x *fastReflection_EventModProvider) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
+			case 13:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UpdatedFields", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.UpdatedFields = append(x.UpdatedFields, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 14:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxOpenContracts", wireType)
+				}
+				x.MaxOpenContracts = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxOpenContracts |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1826,21 +2062,27 @@ func (x *fastReflection_EventModProvider) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_EventOpenContract                     protoreflect.MessageDescriptor
-	fd_EventOpenContract_provider            protoreflect.FieldDescriptor
-	fd_EventOpenContract_contract_id         protoreflect.FieldDescriptor
-	fd_EventOpenContract_service             protoreflect.FieldDescriptor
-	fd_EventOpenContract_client              protoreflect.FieldDescriptor
-	fd_EventOpenContract_delegate            protoreflect.FieldDescriptor
-	fd_EventOpenContract_type                protoreflect.FieldDescriptor
-	fd_EventOpenContract_height              protoreflect.FieldDescriptor
-	fd_EventOpenContract_duration            protoreflect.FieldDescriptor
-	fd_EventOpenContract_rate                protoreflect.FieldDescriptor
-	fd_EventOpenContract_open_cost           protoreflect.FieldDescriptor
-	fd_EventOpenContract_deposit             protoreflect.FieldDescriptor
-	fd_EventOpenContract_settlement_duration protoreflect.FieldDescriptor
-	fd_EventOpenContract_authorization       protoreflect.FieldDescriptor
-	fd_EventOpenContract_queries_per_minute  protoreflect.FieldDescriptor
+	md_EventOpenContract                         protoreflect.MessageDescriptor
+	fd_EventOpenContract_provider                protoreflect.FieldDescriptor
+	fd_EventOpenContract_contract_id             protoreflect.FieldDescriptor
+	fd_EventOpenContract_service                 protoreflect.FieldDescriptor
+	fd_EventOpenContract_client                  protoreflect.FieldDescriptor
+	fd_EventOpenContract_delegate                protoreflect.FieldDescriptor
+	fd_EventOpenContract_type                    protoreflect.FieldDescriptor
+	fd_EventOpenContract_height                  protoreflect.FieldDescriptor
+	fd_EventOpenContract_duration                protoreflect.FieldDescriptor
+	fd_EventOpenContract_rate                    protoreflect.FieldDescriptor
+	fd_EventOpenContract_open_cost               protoreflect.FieldDescriptor
+	fd_EventOpenContract_deposit                 protoreflect.FieldDescriptor
+	fd_EventOpenContract_settlement_duration     protoreflect.FieldDescriptor
+	fd_EventOpenContract_authorization           protoreflect.FieldDescriptor
+	fd_EventOpenContract_queries_per_minute      protoreflect.FieldDescriptor
+	fd_EventOpenContract_settlement_grace_period protoreflect.FieldDescriptor
+	fd_EventOpenContract_auto_renew              protoreflect.FieldDescriptor
+	fd_EventOpenContract_rate_denom              protoreflect.FieldDescriptor
+	fd_EventOpenContract_rate_amount             protoreflect.FieldDescriptor
+	fd_EventOpenContract_payer                   protoreflect.FieldDescriptor
+	fd_EventOpenContract_start_height            protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -1860,6 +2102,12 @@ func init() {
 	fd_EventOpenContract_settlement_duration = md_EventOpenContract.Fields().ByName("settlement_duration")
 	fd_EventOpenContract_authorization = md_EventOpenContract.Fields().ByName("authorization")
 	fd_EventOpenContract_queries_per_minute = md_EventOpenContract.Fields().ByName("queries_per_minute")
+	fd_EventOpenContract_settlement_grace_period = md_EventOpenContract.Fields().ByName("settlement_grace_period")
+	fd_EventOpenContract_auto_renew = md_EventOpenContract.Fields().ByName("auto_renew")
+	fd_EventOpenContract_rate_denom = md_EventOpenContract.Fields().ByName("rate_denom")
+	fd_EventOpenContract_rate_amount = md_EventOpenContract.Fields().ByName("rate_amount")
+	fd_EventOpenContract_payer = md_EventOpenContract.Fields().ByName("payer")
+	fd_EventOpenContract_start_height = md_EventOpenContract.Fields().ByName("start_height")
 }
 
 var _ protoreflect.Message = (*fastReflection_EventOpenContract)(nil)
@@ -2011,6 +2259,42 @@ func (x *fastReflection_EventOpenContract) Range(f func(protoreflect.FieldDescri
 			return
 		}
 	}
+	if x.SettlementGracePeriod != int64(0) {
+		value := protoreflect.ValueOfInt64(x.SettlementGracePeriod)
+		if !f(fd_EventOpenContract_settlement_grace_period, value) {
+			return
+		}
+	}
+	if x.AutoRenew != false {
+		value := protoreflect.ValueOfBool(x.AutoRenew)
+		if !f(fd_EventOpenContract_auto_renew, value) {
+			return
+		}
+	}
+	if x.RateDenom != "" {
+		value := protoreflect.ValueOfString(x.RateDenom)
+		if !f(fd_EventOpenContract_rate_denom, value) {
+			return
+		}
+	}
+	if x.RateAmount != "" {
+		value := protoreflect.ValueOfString(x.RateAmount)
+		if !f(fd_EventOpenContract_rate_amount, value) {
+			return
+		}
+	}
+	if x.Payer != "" {
+		value := protoreflect.ValueOfString(x.Payer)
+		if !f(fd_EventOpenContract_payer, value) {
+			return
+		}
+	}
+	if x.StartHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.StartHeight)
+		if !f(fd_EventOpenContract_start_height, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -2054,6 +2338,18 @@ func (x *fastReflection_EventOpenContract) Has(fd protoreflect.FieldDescriptor)
 		return x.Authorization != 0
 	case "arkeo.arkeo.EventOpenContract.queries_per_minute":
 		return x.QueriesPerMinute != int64(0)
+	case "arkeo.arkeo.EventOpenContract.settlement_grace_period":
+		return x.SettlementGracePeriod != int64(0)
+	case "arkeo.arkeo.EventOpenContract.auto_renew":
+		return x.AutoRenew != false
+	case "arkeo.arkeo.EventOpenContract.rate_denom":
+		return x.RateDenom != ""
+	case "arkeo.arkeo.EventOpenContract.rate_amount":
+		return x.RateAmount != ""
+	case "arkeo.arkeo.EventOpenContract.payer":
+		return x.Payer != ""
+	case "arkeo.arkeo.EventOpenContract.start_height":
+		return x.StartHeight != int64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventOpenContract"))
@@ -2098,6 +2394,18 @@ func (x *fastReflection_EventOpenContract) Clear(fd protoreflect.FieldDescriptor
 		x.Authorization = 0
 	case "arkeo.arkeo.EventOpenContract.queries_per_minute":
 		x.QueriesPerMinute = int64(0)
+	case "arkeo.arkeo.EventOpenContract.settlement_grace_period":
+		x.SettlementGracePeriod = int64(0)
+	case "arkeo.arkeo.EventOpenContract.auto_renew":
+		x.AutoRenew = false
+	case "arkeo.arkeo.EventOpenContract.rate_denom":
+		x.RateDenom = ""
+	case "arkeo.arkeo.EventOpenContract.rate_amount":
+		x.RateAmount = ""
+	case "arkeo.arkeo.EventOpenContract.payer":
+		x.Payer = ""
+	case "arkeo.arkeo.EventOpenContract.start_height":
+		x.StartHeight = int64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventOpenContract"))
@@ -2156,6 +2464,24 @@ func (x *fastReflection_EventOpenContract) Get(descriptor protoreflect.FieldDesc
 	case "arkeo.arkeo.EventOpenContract.queries_per_minute":
 		value := x.QueriesPerMinute
 		return protoreflect.ValueOfInt64(value)
+	case "arkeo.arkeo.EventOpenContract.settlement_grace_period":
+		value := x.SettlementGracePeriod
+		return protoreflect.ValueOfInt64(value)
+	case "arkeo.arkeo.EventOpenContract.auto_renew":
+		value := x.AutoRenew
+		return protoreflect.ValueOfBool(value)
+	case "arkeo.arkeo.EventOpenContract.rate_denom":
+		value := x.RateDenom
+		return protoreflect.ValueOfString(value)
+	case "arkeo.arkeo.EventOpenContract.rate_amount":
+		value := x.RateAmount
+		return protoreflect.ValueOfString(value)
+	case "arkeo.arkeo.EventOpenContract.payer":
+		value := x.Payer
+		return protoreflect.ValueOfString(value)
+	case "arkeo.arkeo.EventOpenContract.start_height":
+		value := x.StartHeight
+		return protoreflect.ValueOfInt64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventOpenContract"))
@@ -2204,6 +2530,18 @@ func (x *fastReflection_EventOpenContract) Set(fd protoreflect.FieldDescriptor,
 		x.Authorization = (ContractAuthorization)(value.Enum())
 	case "arkeo.arkeo.EventOpenContract.queries_per_minute":
 		x.QueriesPerMinute = value.Int()
+	case "arkeo.arkeo.EventOpenContract.settlement_grace_period":
+		x.SettlementGracePeriod = value.Int()
+	case "arkeo.arkeo.EventOpenContract.auto_renew":
+		x.AutoRenew = value.Bool()
+	case "arkeo.arkeo.EventOpenContract.rate_denom":
+		x.RateDenom = value.Interface().(string)
+	case "arkeo.arkeo.EventOpenContract.rate_amount":
+		x.RateAmount = value.Interface().(string)
+	case "arkeo.arkeo.EventOpenContract.payer":
+		x.Payer = value.Interface().(string)
+	case "arkeo.arkeo.EventOpenContract.start_height":
+		x.StartHeight = value.Int()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventOpenContract"))
@@ -2255,6 +2593,18 @@ func (x *fastReflection_EventOpenContract) Mutable(fd protoreflect.FieldDescript
 		panic(fmt.Errorf("field authorization of message arkeo.arkeo.EventOpenContract is not mutable"))
 	case "arkeo.arkeo.EventOpenContract.queries_per_minute":
 		panic(fmt.Errorf("field queries_per_minute of message arkeo.arkeo.EventOpenContract is not mutable"))
+	case "arkeo.arkeo.EventOpenContract.settlement_grace_period":
+		panic(fmt.Errorf("field settlement_grace_period of message arkeo.arkeo.EventOpenContract is not mutable"))
+	case "arkeo.arkeo.EventOpenContract.auto_renew":
+		panic(fmt.Errorf("field auto_renew of message arkeo.arkeo.EventOpenContract is not mutable"))
+	case "arkeo.arkeo.EventOpenContract.rate_denom":
+		panic(fmt.Errorf("field rate_denom of message arkeo.arkeo.EventOpenContract is not mutable"))
+	case "arkeo.arkeo.EventOpenContract.rate_amount":
+		panic(fmt.Errorf("field rate_amount of message arkeo.arkeo.EventOpenContract is not mutable"))
+	case "arkeo.arkeo.EventOpenContract.payer":
+		panic(fmt.Errorf("field payer of message arkeo.arkeo.EventOpenContract is not mutable"))
+	case "arkeo.arkeo.EventOpenContract.start_height":
+		panic(fmt.Errorf("field start_height of message arkeo.arkeo.EventOpenContract is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventOpenContract"))
@@ -2297,6 +2647,18 @@ func (x *fastReflection_EventOpenContract) NewField(fd protoreflect.FieldDescrip
 		return protoreflect.ValueOfEnum(0)
 	case "arkeo.arkeo.EventOpenContract.queries_per_minute":
 		return protoreflect.ValueOfInt64(int64(0))
+	case "arkeo.arkeo.EventOpenContract.settlement_grace_period":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "arkeo.arkeo.EventOpenContract.auto_renew":
+		return protoreflect.ValueOfBool(false)
+	case "arkeo.arkeo.EventOpenContract.rate_denom":
+		return protoreflect.ValueOfString("")
+	case "arkeo.arkeo.EventOpenContract.rate_amount":
+		return protoreflect.ValueOfString("")
+	case "arkeo.arkeo.EventOpenContract.payer":
+		return protoreflect.ValueOfString("")
+	case "arkeo.arkeo.EventOpenContract.start_height":
+		return protoreflect.ValueOfInt64(int64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventOpenContract"))
@@ -2414,6 +2776,27 @@ func (x *fastReflection_EventOpenContract) ProtoMethods() *protoiface.Methods {
 		if x.QueriesPerMinute != 0 {
 			n += 1 + runtime.Sov(uint64(x.QueriesPerMinute))
 		}
+		if x.SettlementGracePeriod != 0 {
+			n += 1 + runtime.Sov(uint64(x.SettlementGracePeriod))
+		}
+		if x.AutoRenew {
+			n += 3
+		}
+		l = len(x.RateDenom)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.RateAmount)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Payer)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.StartHeight != 0 {
+			n += 2 + runtime.Sov(uint64(x.StartHeight))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -2443,6 +2826,57 @@ func (x *fastReflection_EventOpenContract) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.StartHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.StartHeight))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa0
+		}
+		if len(x.Payer) > 0 {
+			i -= len(x.Payer)
+			copy(dAtA[i:], x.Payer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Payer)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+		if len(x.RateAmount) > 0 {
+			i -= len(x.RateAmount)
+			copy(dAtA[i:], x.RateAmount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RateAmount)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+		if len(x.RateDenom) > 0 {
+			i -= len(x.RateDenom)
+			copy(dAtA[i:], x.RateDenom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RateDenom)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+		if x.AutoRenew {
+			i--
+			if x.AutoRenew {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x80
+		}
+		if x.SettlementGracePeriod != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SettlementGracePeriod))
+			i--
+			dAtA[i] = 0x78
+		}
 		if x.QueriesPerMinute != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.QueriesPerMinute))
 			i--
@@ -2935,20 +3369,174 @@ func (x *fastReflection_EventOpenContract) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			case 15:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SettlementGracePeriod", wireType)
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				x.SettlementGracePeriod = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SettlementGracePeriod |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 16:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AutoRenew", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.AutoRenew = bool(v != 0)
+			case 17:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RateDenom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if (iNdEx + skippy) > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RateDenom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 18:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RateAmount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RateAmount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 19:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Payer", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Payer = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 20:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+				}
+				x.StartHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.StartHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
 				}
 				iNdEx += skippy
 			}
@@ -2982,6 +3570,11 @@ var (
 	fd_EventSettleContract_height      protoreflect.FieldDescriptor
 	fd_EventSettleContract_paid        protoreflect.FieldDescriptor
 	fd_EventSettleContract_reserve     protoreflect.FieldDescriptor
+	fd_EventSettleContract_unpaid      protoreflect.FieldDescriptor
+	fd_EventSettleContract_denom       protoreflect.FieldDescriptor
+	fd_EventSettleContract_refunded    protoreflect.FieldDescriptor
+	fd_EventSettleContract_claimed     protoreflect.FieldDescriptor
+	fd_EventSettleContract_dust        protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -2997,6 +3590,11 @@ func init() {
 	fd_EventSettleContract_height = md_EventSettleContract.Fields().ByName("height")
 	fd_EventSettleContract_paid = md_EventSettleContract.Fields().ByName("paid")
 	fd_EventSettleContract_reserve = md_EventSettleContract.Fields().ByName("reserve")
+	fd_EventSettleContract_unpaid = md_EventSettleContract.Fields().ByName("unpaid")
+	fd_EventSettleContract_denom = md_EventSettleContract.Fields().ByName("denom")
+	fd_EventSettleContract_refunded = md_EventSettleContract.Fields().ByName("refunded")
+	fd_EventSettleContract_claimed = md_EventSettleContract.Fields().ByName("claimed")
+	fd_EventSettleContract_dust = md_EventSettleContract.Fields().ByName("dust")
 }
 
 var _ protoreflect.Message = (*fastReflection_EventSettleContract)(nil)
@@ -3124,6 +3722,36 @@ func (x *fastReflection_EventSettleContract) Range(f func(protoreflect.FieldDesc
 			return
 		}
 	}
+	if x.Unpaid != "" {
+		value := protoreflect.ValueOfString(x.Unpaid)
+		if !f(fd_EventSettleContract_unpaid, value) {
+			return
+		}
+	}
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_EventSettleContract_denom, value) {
+			return
+		}
+	}
+	if x.Refunded != "" {
+		value := protoreflect.ValueOfString(x.Refunded)
+		if !f(fd_EventSettleContract_refunded, value) {
+			return
+		}
+	}
+	if x.Claimed != "" {
+		value := protoreflect.ValueOfString(x.Claimed)
+		if !f(fd_EventSettleContract_claimed, value) {
+			return
+		}
+	}
+	if x.Dust != "" {
+		value := protoreflect.ValueOfString(x.Dust)
+		if !f(fd_EventSettleContract_dust, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -3159,6 +3787,16 @@ func (x *fastReflection_EventSettleContract) Has(fd protoreflect.FieldDescriptor
 		return x.Paid != ""
 	case "arkeo.arkeo.EventSettleContract.reserve":
 		return x.Reserve != ""
+	case "arkeo.arkeo.EventSettleContract.unpaid":
+		return x.Unpaid != ""
+	case "arkeo.arkeo.EventSettleContract.denom":
+		return x.Denom != ""
+	case "arkeo.arkeo.EventSettleContract.refunded":
+		return x.Refunded != ""
+	case "arkeo.arkeo.EventSettleContract.claimed":
+		return x.Claimed != ""
+	case "arkeo.arkeo.EventSettleContract.dust":
+		return x.Dust != ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventSettleContract"))
@@ -3195,6 +3833,16 @@ func (x *fastReflection_EventSettleContract) Clear(fd protoreflect.FieldDescript
 		x.Paid = ""
 	case "arkeo.arkeo.EventSettleContract.reserve":
 		x.Reserve = ""
+	case "arkeo.arkeo.EventSettleContract.unpaid":
+		x.Unpaid = ""
+	case "arkeo.arkeo.EventSettleContract.denom":
+		x.Denom = ""
+	case "arkeo.arkeo.EventSettleContract.refunded":
+		x.Refunded = ""
+	case "arkeo.arkeo.EventSettleContract.claimed":
+		x.Claimed = ""
+	case "arkeo.arkeo.EventSettleContract.dust":
+		x.Dust = ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventSettleContract"))
@@ -3241,6 +3889,21 @@ func (x *fastReflection_EventSettleContract) Get(descriptor protoreflect.FieldDe
 	case "arkeo.arkeo.EventSettleContract.reserve":
 		value := x.Reserve
 		return protoreflect.ValueOfString(value)
+	case "arkeo.arkeo.EventSettleContract.unpaid":
+		value := x.Unpaid
+		return protoreflect.ValueOfString(value)
+	case "arkeo.arkeo.EventSettleContract.denom":
+		value := x.Denom
+		return protoreflect.ValueOfString(value)
+	case "arkeo.arkeo.EventSettleContract.refunded":
+		value := x.Refunded
+		return protoreflect.ValueOfString(value)
+	case "arkeo.arkeo.EventSettleContract.claimed":
+		value := x.Claimed
+		return protoreflect.ValueOfString(value)
+	case "arkeo.arkeo.EventSettleContract.dust":
+		value := x.Dust
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventSettleContract"))
@@ -3281,6 +3944,16 @@ func (x *fastReflection_EventSettleContract) Set(fd protoreflect.FieldDescriptor
 		x.Paid = value.Interface().(string)
 	case "arkeo.arkeo.EventSettleContract.reserve":
 		x.Reserve = value.Interface().(string)
+	case "arkeo.arkeo.EventSettleContract.unpaid":
+		x.Unpaid = value.Interface().(string)
+	case "arkeo.arkeo.EventSettleContract.denom":
+		x.Denom = value.Interface().(string)
+	case "arkeo.arkeo.EventSettleContract.refunded":
+		x.Refunded = value.Interface().(string)
+	case "arkeo.arkeo.EventSettleContract.claimed":
+		x.Claimed = value.Interface().(string)
+	case "arkeo.arkeo.EventSettleContract.dust":
+		x.Dust = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventSettleContract"))
@@ -3321,6 +3994,16 @@ func (x *fastReflection_EventSettleContract) Mutable(fd protoreflect.FieldDescri
 		panic(fmt.Errorf("field paid of message arkeo.arkeo.EventSettleContract is not mutable"))
 	case "arkeo.arkeo.EventSettleContract.reserve":
 		panic(fmt.Errorf("field reserve of message arkeo.arkeo.EventSettleContract is not mutable"))
+	case "arkeo.arkeo.EventSettleContract.unpaid":
+		panic(fmt.Errorf("field unpaid of message arkeo.arkeo.EventSettleContract is not mutable"))
+	case "arkeo.arkeo.EventSettleContract.denom":
+		panic(fmt.Errorf("field denom of message arkeo.arkeo.EventSettleContract is not mutable"))
+	case "arkeo.arkeo.EventSettleContract.refunded":
+		panic(fmt.Errorf("field refunded of message arkeo.arkeo.EventSettleContract is not mutable"))
+	case "arkeo.arkeo.EventSettleContract.claimed":
+		panic(fmt.Errorf("field claimed of message arkeo.arkeo.EventSettleContract is not mutable"))
+	case "arkeo.arkeo.EventSettleContract.dust":
+		panic(fmt.Errorf("field dust of message arkeo.arkeo.EventSettleContract is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventSettleContract"))
@@ -3354,6 +4037,16 @@ func (x *fastReflection_EventSettleContract) NewField(fd protoreflect.FieldDescr
 		return protoreflect.ValueOfString("")
 	case "arkeo.arkeo.EventSettleContract.reserve":
 		return protoreflect.ValueOfString("")
+	case "arkeo.arkeo.EventSettleContract.unpaid":
+		return protoreflect.ValueOfString("")
+	case "arkeo.arkeo.EventSettleContract.denom":
+		return protoreflect.ValueOfString("")
+	case "arkeo.arkeo.EventSettleContract.refunded":
+		return protoreflect.ValueOfString("")
+	case "arkeo.arkeo.EventSettleContract.claimed":
+		return protoreflect.ValueOfString("")
+	case "arkeo.arkeo.EventSettleContract.dust":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventSettleContract"))
@@ -3459,6 +4152,26 @@ func (x *fastReflection_EventSettleContract) ProtoMethods() *protoiface.Methods
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		l = len(x.Unpaid)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Denom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Refunded)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Claimed)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Dust)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -3488,6 +4201,41 @@ func (x *fastReflection_EventSettleContract) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.Dust) > 0 {
+			i -= len(x.Dust)
+			copy(dAtA[i:], x.Dust)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Dust)))
+			i--
+			dAtA[i] = 0x7a
+		}
+		if len(x.Claimed) > 0 {
+			i -= len(x.Claimed)
+			copy(dAtA[i:], x.Claimed)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Claimed)))
+			i--
+			dAtA[i] = 0x72
+		}
+		if len(x.Refunded) > 0 {
+			i -= len(x.Refunded)
+			copy(dAtA[i:], x.Refunded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Refunded)))
+			i--
+			dAtA[i] = 0x6a
+		}
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+			i--
+			dAtA[i] = 0x62
+		}
+		if len(x.Unpaid) > 0 {
+			i -= len(x.Unpaid)
+			copy(dAtA[i:], x.Unpaid)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Unpaid)))
+			i--
+			dAtA[i] = 0x5a
+		}
 		if len(x.Reserve) > 0 {
 			i -= len(x.Reserve)
 			copy(dAtA[i:], x.Reserve)
@@ -3873,107 +4621,277 @@ func (x *fastReflection_EventSettleContract) ProtoMethods() *protoiface.Methods
 				}
 				x.Reserve = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Unpaid", wireType)
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if (iNdEx + skippy) > l {
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				x.Unpaid = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 				}
-				iNdEx += skippy
-			}
-		}
-
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
-	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
-	}
-}
-
-var (
-	md_EventCloseContract             protoreflect.MessageDescriptor
-	fd_EventCloseContract_contract_id protoreflect.FieldDescriptor
-	fd_EventCloseContract_provider    protoreflect.FieldDescriptor
-	fd_EventCloseContract_service     protoreflect.FieldDescriptor
-	fd_EventCloseContract_client      protoreflect.FieldDescriptor
-	fd_EventCloseContract_delegate    protoreflect.FieldDescriptor
-)
-
-func init() {
-	file_arkeo_arkeo_events_proto_init()
-	md_EventCloseContract = File_arkeo_arkeo_events_proto.Messages().ByName("EventCloseContract")
-	fd_EventCloseContract_contract_id = md_EventCloseContract.Fields().ByName("contract_id")
-	fd_EventCloseContract_provider = md_EventCloseContract.Fields().ByName("provider")
-	fd_EventCloseContract_service = md_EventCloseContract.Fields().ByName("service")
-	fd_EventCloseContract_client = md_EventCloseContract.Fields().ByName("client")
-	fd_EventCloseContract_delegate = md_EventCloseContract.Fields().ByName("delegate")
-}
-
-var _ protoreflect.Message = (*fastReflection_EventCloseContract)(nil)
-
-type fastReflection_EventCloseContract EventCloseContract
-
-func (x *EventCloseContract) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_EventCloseContract)(x)
-}
-
-func (x *EventCloseContract) slowProtoReflect() protoreflect.Message {
-	mi := &file_arkeo_arkeo_events_proto_msgTypes[4]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-var _fastReflection_EventCloseContract_messageType fastReflection_EventCloseContract_messageType
-var _ protoreflect.MessageType = fastReflection_EventCloseContract_messageType{}
-
-type fastReflection_EventCloseContract_messageType struct{}
-
-func (x fastReflection_EventCloseContract_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_EventCloseContract)(nil)
-}
-func (x fastReflection_EventCloseContract_messageType) New() protoreflect.Message {
-	return new(fastReflection_EventCloseContract)
-}
-func (x fastReflection_EventCloseContract_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_EventCloseContract
-}
-
-// Descriptor returns message descriptor, which contains only the protobuf
-// type information for the message.
-func (x *fastReflection_EventCloseContract) Descriptor() protoreflect.MessageDescriptor {
-	return md_EventCloseContract
-}
-
-// Type returns the message type, which encapsulates both Go and protobuf
-// type information. If the Go type information is not needed,
-// it is recommended that the message descriptor be used instead.
-func (x *fastReflection_EventCloseContract) Type() protoreflect.MessageType {
-	return _fastReflection_EventCloseContract_messageType
-}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Denom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 13:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Refunded", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Refunded = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 14:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Claimed", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Claimed = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 15:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Dust", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Dust = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EventCloseContract              protoreflect.MessageDescriptor
+	fd_EventCloseContract_contract_id  protoreflect.FieldDescriptor
+	fd_EventCloseContract_provider     protoreflect.FieldDescriptor
+	fd_EventCloseContract_service      protoreflect.FieldDescriptor
+	fd_EventCloseContract_client       protoreflect.FieldDescriptor
+	fd_EventCloseContract_delegate     protoreflect.FieldDescriptor
+	fd_EventCloseContract_by_provider  protoreflect.FieldDescriptor
+	fd_EventCloseContract_penalty      protoreflect.FieldDescriptor
+	fd_EventCloseContract_compensation protoreflect.FieldDescriptor
+	fd_EventCloseContract_refund       protoreflect.FieldDescriptor
+	fd_EventCloseContract_dust         protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_arkeo_arkeo_events_proto_init()
+	md_EventCloseContract = File_arkeo_arkeo_events_proto.Messages().ByName("EventCloseContract")
+	fd_EventCloseContract_contract_id = md_EventCloseContract.Fields().ByName("contract_id")
+	fd_EventCloseContract_provider = md_EventCloseContract.Fields().ByName("provider")
+	fd_EventCloseContract_service = md_EventCloseContract.Fields().ByName("service")
+	fd_EventCloseContract_client = md_EventCloseContract.Fields().ByName("client")
+	fd_EventCloseContract_delegate = md_EventCloseContract.Fields().ByName("delegate")
+	fd_EventCloseContract_by_provider = md_EventCloseContract.Fields().ByName("by_provider")
+	fd_EventCloseContract_penalty = md_EventCloseContract.Fields().ByName("penalty")
+	fd_EventCloseContract_compensation = md_EventCloseContract.Fields().ByName("compensation")
+	fd_EventCloseContract_refund = md_EventCloseContract.Fields().ByName("refund")
+	fd_EventCloseContract_dust = md_EventCloseContract.Fields().ByName("dust")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventCloseContract)(nil)
+
+type fastReflection_EventCloseContract EventCloseContract
+
+func (x *EventCloseContract) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventCloseContract)(x)
+}
+
+func (x *EventCloseContract) slowProtoReflect() protoreflect.Message {
+	mi := &file_arkeo_arkeo_events_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventCloseContract_messageType fastReflection_EventCloseContract_messageType
+var _ protoreflect.MessageType = fastReflection_EventCloseContract_messageType{}
+
+type fastReflection_EventCloseContract_messageType struct{}
+
+func (x fastReflection_EventCloseContract_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventCloseContract)(nil)
+}
+func (x fastReflection_EventCloseContract_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventCloseContract)
+}
+func (x fastReflection_EventCloseContract_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventCloseContract
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventCloseContract) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventCloseContract
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventCloseContract) Type() protoreflect.MessageType {
+	return _fastReflection_EventCloseContract_messageType
+}
 
 // New returns a newly allocated and mutable empty message.
 func (x *fastReflection_EventCloseContract) New() protoreflect.Message {
@@ -4022,6 +4940,36 @@ func (x *fastReflection_EventCloseContract) Range(f func(protoreflect.FieldDescr
 			return
 		}
 	}
+	if x.ByProvider != false {
+		value := protoreflect.ValueOfBool(x.ByProvider)
+		if !f(fd_EventCloseContract_by_provider, value) {
+			return
+		}
+	}
+	if x.Penalty != "" {
+		value := protoreflect.ValueOfString(x.Penalty)
+		if !f(fd_EventCloseContract_penalty, value) {
+			return
+		}
+	}
+	if x.Compensation != "" {
+		value := protoreflect.ValueOfString(x.Compensation)
+		if !f(fd_EventCloseContract_compensation, value) {
+			return
+		}
+	}
+	if x.Refund != "" {
+		value := protoreflect.ValueOfString(x.Refund)
+		if !f(fd_EventCloseContract_refund, value) {
+			return
+		}
+	}
+	if x.Dust != "" {
+		value := protoreflect.ValueOfString(x.Dust)
+		if !f(fd_EventCloseContract_dust, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -4047,6 +4995,16 @@ func (x *fastReflection_EventCloseContract) Has(fd protoreflect.FieldDescriptor)
 		return len(x.Client) != 0
 	case "arkeo.arkeo.EventCloseContract.delegate":
 		return len(x.Delegate) != 0
+	case "arkeo.arkeo.EventCloseContract.by_provider":
+		return x.ByProvider != false
+	case "arkeo.arkeo.EventCloseContract.penalty":
+		return x.Penalty != ""
+	case "arkeo.arkeo.EventCloseContract.compensation":
+		return x.Compensation != ""
+	case "arkeo.arkeo.EventCloseContract.refund":
+		return x.Refund != ""
+	case "arkeo.arkeo.EventCloseContract.dust":
+		return x.Dust != ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventCloseContract"))
@@ -4073,6 +5031,16 @@ func (x *fastReflection_EventCloseContract) Clear(fd protoreflect.FieldDescripto
 		x.Client = nil
 	case "arkeo.arkeo.EventCloseContract.delegate":
 		x.Delegate = nil
+	case "arkeo.arkeo.EventCloseContract.by_provider":
+		x.ByProvider = false
+	case "arkeo.arkeo.EventCloseContract.penalty":
+		x.Penalty = ""
+	case "arkeo.arkeo.EventCloseContract.compensation":
+		x.Compensation = ""
+	case "arkeo.arkeo.EventCloseContract.refund":
+		x.Refund = ""
+	case "arkeo.arkeo.EventCloseContract.dust":
+		x.Dust = ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventCloseContract"))
@@ -4104,6 +5072,21 @@ func (x *fastReflection_EventCloseContract) Get(descriptor protoreflect.FieldDes
 	case "arkeo.arkeo.EventCloseContract.delegate":
 		value := x.Delegate
 		return protoreflect.ValueOfBytes(value)
+	case "arkeo.arkeo.EventCloseContract.by_provider":
+		value := x.ByProvider
+		return protoreflect.ValueOfBool(value)
+	case "arkeo.arkeo.EventCloseContract.penalty":
+		value := x.Penalty
+		return protoreflect.ValueOfString(value)
+	case "arkeo.arkeo.EventCloseContract.compensation":
+		value := x.Compensation
+		return protoreflect.ValueOfString(value)
+	case "arkeo.arkeo.EventCloseContract.refund":
+		value := x.Refund
+		return protoreflect.ValueOfString(value)
+	case "arkeo.arkeo.EventCloseContract.dust":
+		value := x.Dust
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventCloseContract"))
@@ -4134,6 +5117,16 @@ func (x *fastReflection_EventCloseContract) Set(fd protoreflect.FieldDescriptor,
 		x.Client = value.Bytes()
 	case "arkeo.arkeo.EventCloseContract.delegate":
 		x.Delegate = value.Bytes()
+	case "arkeo.arkeo.EventCloseContract.by_provider":
+		x.ByProvider = value.Bool()
+	case "arkeo.arkeo.EventCloseContract.penalty":
+		x.Penalty = value.Interface().(string)
+	case "arkeo.arkeo.EventCloseContract.compensation":
+		x.Compensation = value.Interface().(string)
+	case "arkeo.arkeo.EventCloseContract.refund":
+		x.Refund = value.Interface().(string)
+	case "arkeo.arkeo.EventCloseContract.dust":
+		x.Dust = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventCloseContract"))
@@ -4164,6 +5157,16 @@ func (x *fastReflection_EventCloseContract) Mutable(fd protoreflect.FieldDescrip
 		panic(fmt.Errorf("field client of message arkeo.arkeo.EventCloseContract is not mutable"))
 	case "arkeo.arkeo.EventCloseContract.delegate":
 		panic(fmt.Errorf("field delegate of message arkeo.arkeo.EventCloseContract is not mutable"))
+	case "arkeo.arkeo.EventCloseContract.by_provider":
+		panic(fmt.Errorf("field by_provider of message arkeo.arkeo.EventCloseContract is not mutable"))
+	case "arkeo.arkeo.EventCloseContract.penalty":
+		panic(fmt.Errorf("field penalty of message arkeo.arkeo.EventCloseContract is not mutable"))
+	case "arkeo.arkeo.EventCloseContract.compensation":
+		panic(fmt.Errorf("field compensation of message arkeo.arkeo.EventCloseContract is not mutable"))
+	case "arkeo.arkeo.EventCloseContract.refund":
+		panic(fmt.Errorf("field refund of message arkeo.arkeo.EventCloseContract is not mutable"))
+	case "arkeo.arkeo.EventCloseContract.dust":
+		panic(fmt.Errorf("field dust of message arkeo.arkeo.EventCloseContract is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventCloseContract"))
@@ -4187,6 +5190,16 @@ func (x *fastReflection_EventCloseContract) NewField(fd protoreflect.FieldDescri
 		return protoreflect.ValueOfBytes(nil)
 	case "arkeo.arkeo.EventCloseContract.delegate":
 		return protoreflect.ValueOfBytes(nil)
+	case "arkeo.arkeo.EventCloseContract.by_provider":
+		return protoreflect.ValueOfBool(false)
+	case "arkeo.arkeo.EventCloseContract.penalty":
+		return protoreflect.ValueOfString("")
+	case "arkeo.arkeo.EventCloseContract.compensation":
+		return protoreflect.ValueOfString("")
+	case "arkeo.arkeo.EventCloseContract.refund":
+		return protoreflect.ValueOfString("")
+	case "arkeo.arkeo.EventCloseContract.dust":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventCloseContract"))
@@ -4275,6 +5288,25 @@ func (x *fastReflection_EventCloseContract) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.ByProvider {
+			n += 2
+		}
+		l = len(x.Penalty)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Compensation)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Refund)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Dust)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -4304,16 +5336,54 @@ func (x *fastReflection_EventCloseContract) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Delegate) > 0 {
-			i -= len(x.Delegate)
-			copy(dAtA[i:], x.Delegate)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Delegate)))
+		if len(x.Dust) > 0 {
+			i -= len(x.Dust)
+			copy(dAtA[i:], x.Dust)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Dust)))
 			i--
-			dAtA[i] = 0x2a
+			dAtA[i] = 0x52
 		}
-		if len(x.Client) > 0 {
-			i -= len(x.Client)
-			copy(dAtA[i:], x.Client)
+		if len(x.Refund) > 0 {
+			i -= len(x.Refund)
+			copy(dAtA[i:], x.Refund)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Refund)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if len(x.Compensation) > 0 {
+			i -= len(x.Compensation)
+			copy(dAtA[i:], x.Compensation)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Compensation)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if len(x.Penalty) > 0 {
+			i -= len(x.Penalty)
+			copy(dAtA[i:], x.Penalty)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Penalty)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if x.ByProvider {
+			i--
+			if x.ByProvider {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x30
+		}
+		if len(x.Delegate) > 0 {
+			i -= len(x.Delegate)
+			copy(dAtA[i:], x.Delegate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Delegate)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.Client) > 0 {
+			i -= len(x.Client)
+			copy(dAtA[i:], x.Client)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Client)))
 			i--
 			dAtA[i] = 0x22
@@ -4539,6 +5609,154 @@ func (x *fastReflection_EventCloseContract) ProtoMethods() *protoiface.Methods {
 					x.Delegate = []byte{}
 				}
 				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ByProvider", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.ByProvider = bool(v != 0)
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Penalty", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Penalty = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Compensation", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Compensation = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Refund", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Refund = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Dust", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Dust = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -4575,27 +5793,45 @@ func (x *fastReflection_EventCloseContract) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_EventValidatorPayout           protoreflect.MessageDescriptor
-	fd_EventValidatorPayout_validator protoreflect.FieldDescriptor
-	fd_EventValidatorPayout_reward    protoreflect.FieldDescriptor
+	md_EventRenewContract                protoreflect.MessageDescriptor
+	fd_EventRenewContract_provider       protoreflect.FieldDescriptor
+	fd_EventRenewContract_contract_id    protoreflect.FieldDescriptor
+	fd_EventRenewContract_service        protoreflect.FieldDescriptor
+	fd_EventRenewContract_client         protoreflect.FieldDescriptor
+	fd_EventRenewContract_delegate       protoreflect.FieldDescriptor
+	fd_EventRenewContract_type           protoreflect.FieldDescriptor
+	fd_EventRenewContract_old_expiration protoreflect.FieldDescriptor
+	fd_EventRenewContract_new_expiration protoreflect.FieldDescriptor
+	fd_EventRenewContract_rate           protoreflect.FieldDescriptor
+	fd_EventRenewContract_extra_deposit  protoreflect.FieldDescriptor
+	fd_EventRenewContract_deposit        protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_arkeo_arkeo_events_proto_init()
-	md_EventValidatorPayout = File_arkeo_arkeo_events_proto.Messages().ByName("EventValidatorPayout")
-	fd_EventValidatorPayout_validator = md_EventValidatorPayout.Fields().ByName("validator")
-	fd_EventValidatorPayout_reward = md_EventValidatorPayout.Fields().ByName("reward")
+	md_EventRenewContract = File_arkeo_arkeo_events_proto.Messages().ByName("EventRenewContract")
+	fd_EventRenewContract_provider = md_EventRenewContract.Fields().ByName("provider")
+	fd_EventRenewContract_contract_id = md_EventRenewContract.Fields().ByName("contract_id")
+	fd_EventRenewContract_service = md_EventRenewContract.Fields().ByName("service")
+	fd_EventRenewContract_client = md_EventRenewContract.Fields().ByName("client")
+	fd_EventRenewContract_delegate = md_EventRenewContract.Fields().ByName("delegate")
+	fd_EventRenewContract_type = md_EventRenewContract.Fields().ByName("type")
+	fd_EventRenewContract_old_expiration = md_EventRenewContract.Fields().ByName("old_expiration")
+	fd_EventRenewContract_new_expiration = md_EventRenewContract.Fields().ByName("new_expiration")
+	fd_EventRenewContract_rate = md_EventRenewContract.Fields().ByName("rate")
+	fd_EventRenewContract_extra_deposit = md_EventRenewContract.Fields().ByName("extra_deposit")
+	fd_EventRenewContract_deposit = md_EventRenewContract.Fields().ByName("deposit")
 }
 
-var _ protoreflect.Message = (*fastReflection_EventValidatorPayout)(nil)
+var _ protoreflect.Message = (*fastReflection_EventRenewContract)(nil)
 
-type fastReflection_EventValidatorPayout EventValidatorPayout
+type fastReflection_EventRenewContract EventRenewContract
 
-func (x *EventValidatorPayout) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_EventValidatorPayout)(x)
+func (x *EventRenewContract) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventRenewContract)(x)
 }
 
-func (x *EventValidatorPayout) slowProtoReflect() protoreflect.Message {
+func (x *EventRenewContract) slowProtoReflect() protoreflect.Message {
 	mi := &file_arkeo_arkeo_events_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4607,43 +5843,43 @@ func (x *EventValidatorPayout) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_EventValidatorPayout_messageType fastReflection_EventValidatorPayout_messageType
-var _ protoreflect.MessageType = fastReflection_EventValidatorPayout_messageType{}
+var _fastReflection_EventRenewContract_messageType fastReflection_EventRenewContract_messageType
+var _ protoreflect.MessageType = fastReflection_EventRenewContract_messageType{}
 
-type fastReflection_EventValidatorPayout_messageType struct{}
+type fastReflection_EventRenewContract_messageType struct{}
 
-func (x fastReflection_EventValidatorPayout_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_EventValidatorPayout)(nil)
+func (x fastReflection_EventRenewContract_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventRenewContract)(nil)
 }
-func (x fastReflection_EventValidatorPayout_messageType) New() protoreflect.Message {
-	return new(fastReflection_EventValidatorPayout)
+func (x fastReflection_EventRenewContract_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventRenewContract)
 }
-func (x fastReflection_EventValidatorPayout_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_EventValidatorPayout
+func (x fastReflection_EventRenewContract_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventRenewContract
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_EventValidatorPayout) Descriptor() protoreflect.MessageDescriptor {
-	return md_EventValidatorPayout
+func (x *fastReflection_EventRenewContract) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventRenewContract
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_EventValidatorPayout) Type() protoreflect.MessageType {
-	return _fastReflection_EventValidatorPayout_messageType
+func (x *fastReflection_EventRenewContract) Type() protoreflect.MessageType {
+	return _fastReflection_EventRenewContract_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_EventValidatorPayout) New() protoreflect.Message {
-	return new(fastReflection_EventValidatorPayout)
+func (x *fastReflection_EventRenewContract) New() protoreflect.Message {
+	return new(fastReflection_EventRenewContract)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_EventValidatorPayout) Interface() protoreflect.ProtoMessage {
-	return (*EventValidatorPayout)(x)
+func (x *fastReflection_EventRenewContract) Interface() protoreflect.ProtoMessage {
+	return (*EventRenewContract)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4651,16 +5887,70 @@ func (x *fastReflection_EventValidatorPayout) Interface() protoreflect.ProtoMess
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_EventValidatorPayout) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.Validator) != 0 {
-		value := protoreflect.ValueOfBytes(x.Validator)
-		if !f(fd_EventValidatorPayout_validator, value) {
+func (x *fastReflection_EventRenewContract) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Provider) != 0 {
+		value := protoreflect.ValueOfBytes(x.Provider)
+		if !f(fd_EventRenewContract_provider, value) {
 			return
 		}
 	}
-	if x.Reward != "" {
-		value := protoreflect.ValueOfString(x.Reward)
-		if !f(fd_EventValidatorPayout_reward, value) {
+	if x.ContractId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ContractId)
+		if !f(fd_EventRenewContract_contract_id, value) {
+			return
+		}
+	}
+	if x.Service != "" {
+		value := protoreflect.ValueOfString(x.Service)
+		if !f(fd_EventRenewContract_service, value) {
+			return
+		}
+	}
+	if len(x.Client) != 0 {
+		value := protoreflect.ValueOfBytes(x.Client)
+		if !f(fd_EventRenewContract_client, value) {
+			return
+		}
+	}
+	if len(x.Delegate) != 0 {
+		value := protoreflect.ValueOfBytes(x.Delegate)
+		if !f(fd_EventRenewContract_delegate, value) {
+			return
+		}
+	}
+	if x.Type_ != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Type_))
+		if !f(fd_EventRenewContract_type, value) {
+			return
+		}
+	}
+	if x.OldExpiration != int64(0) {
+		value := protoreflect.ValueOfInt64(x.OldExpiration)
+		if !f(fd_EventRenewContract_old_expiration, value) {
+			return
+		}
+	}
+	if x.NewExpiration != int64(0) {
+		value := protoreflect.ValueOfInt64(x.NewExpiration)
+		if !f(fd_EventRenewContract_new_expiration, value) {
+			return
+		}
+	}
+	if x.Rate != nil {
+		value := protoreflect.ValueOfMessage(x.Rate.ProtoReflect())
+		if !f(fd_EventRenewContract_rate, value) {
+			return
+		}
+	}
+	if x.ExtraDeposit != "" {
+		value := protoreflect.ValueOfString(x.ExtraDeposit)
+		if !f(fd_EventRenewContract_extra_deposit, value) {
+			return
+		}
+	}
+	if x.Deposit != "" {
+		value := protoreflect.ValueOfString(x.Deposit)
+		if !f(fd_EventRenewContract_deposit, value) {
 			return
 		}
 	}
@@ -4677,17 +5967,35 @@ func (x *fastReflection_EventValidatorPayout) Range(f func(protoreflect.FieldDes
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_EventValidatorPayout) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_EventRenewContract) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "arkeo.arkeo.EventValidatorPayout.validator":
-		return len(x.Validator) != 0
-	case "arkeo.arkeo.EventValidatorPayout.reward":
-		return x.Reward != ""
+	case "arkeo.arkeo.EventRenewContract.provider":
+		return len(x.Provider) != 0
+	case "arkeo.arkeo.EventRenewContract.contract_id":
+		return x.ContractId != uint64(0)
+	case "arkeo.arkeo.EventRenewContract.service":
+		return x.Service != ""
+	case "arkeo.arkeo.EventRenewContract.client":
+		return len(x.Client) != 0
+	case "arkeo.arkeo.EventRenewContract.delegate":
+		return len(x.Delegate) != 0
+	case "arkeo.arkeo.EventRenewContract.type":
+		return x.Type_ != 0
+	case "arkeo.arkeo.EventRenewContract.old_expiration":
+		return x.OldExpiration != int64(0)
+	case "arkeo.arkeo.EventRenewContract.new_expiration":
+		return x.NewExpiration != int64(0)
+	case "arkeo.arkeo.EventRenewContract.rate":
+		return x.Rate != nil
+	case "arkeo.arkeo.EventRenewContract.extra_deposit":
+		return x.ExtraDeposit != ""
+	case "arkeo.arkeo.EventRenewContract.deposit":
+		return x.Deposit != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventValidatorPayout"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventRenewContract"))
 		}
-		panic(fmt.Errorf("message arkeo.arkeo.EventValidatorPayout does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message arkeo.arkeo.EventRenewContract does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4697,17 +6005,35 @@ func (x *fastReflection_EventValidatorPayout) Has(fd protoreflect.FieldDescripto
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventValidatorPayout) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_EventRenewContract) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "arkeo.arkeo.EventValidatorPayout.validator":
-		x.Validator = nil
-	case "arkeo.arkeo.EventValidatorPayout.reward":
-		x.Reward = ""
+	case "arkeo.arkeo.EventRenewContract.provider":
+		x.Provider = nil
+	case "arkeo.arkeo.EventRenewContract.contract_id":
+		x.ContractId = uint64(0)
+	case "arkeo.arkeo.EventRenewContract.service":
+		x.Service = ""
+	case "arkeo.arkeo.EventRenewContract.client":
+		x.Client = nil
+	case "arkeo.arkeo.EventRenewContract.delegate":
+		x.Delegate = nil
+	case "arkeo.arkeo.EventRenewContract.type":
+		x.Type_ = 0
+	case "arkeo.arkeo.EventRenewContract.old_expiration":
+		x.OldExpiration = int64(0)
+	case "arkeo.arkeo.EventRenewContract.new_expiration":
+		x.NewExpiration = int64(0)
+	case "arkeo.arkeo.EventRenewContract.rate":
+		x.Rate = nil
+	case "arkeo.arkeo.EventRenewContract.extra_deposit":
+		x.ExtraDeposit = ""
+	case "arkeo.arkeo.EventRenewContract.deposit":
+		x.Deposit = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventValidatorPayout"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventRenewContract"))
 		}
-		panic(fmt.Errorf("message arkeo.arkeo.EventValidatorPayout does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message arkeo.arkeo.EventRenewContract does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4717,19 +6043,46 @@ func (x *fastReflection_EventValidatorPayout) Clear(fd protoreflect.FieldDescrip
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_EventValidatorPayout) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventRenewContract) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "arkeo.arkeo.EventValidatorPayout.validator":
-		value := x.Validator
+	case "arkeo.arkeo.EventRenewContract.provider":
+		value := x.Provider
 		return protoreflect.ValueOfBytes(value)
-	case "arkeo.arkeo.EventValidatorPayout.reward":
-		value := x.Reward
+	case "arkeo.arkeo.EventRenewContract.contract_id":
+		value := x.ContractId
+		return protoreflect.ValueOfUint64(value)
+	case "arkeo.arkeo.EventRenewContract.service":
+		value := x.Service
+		return protoreflect.ValueOfString(value)
+	case "arkeo.arkeo.EventRenewContract.client":
+		value := x.Client
+		return protoreflect.ValueOfBytes(value)
+	case "arkeo.arkeo.EventRenewContract.delegate":
+		value := x.Delegate
+		return protoreflect.ValueOfBytes(value)
+	case "arkeo.arkeo.EventRenewContract.type":
+		value := x.Type_
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "arkeo.arkeo.EventRenewContract.old_expiration":
+		value := x.OldExpiration
+		return protoreflect.ValueOfInt64(value)
+	case "arkeo.arkeo.EventRenewContract.new_expiration":
+		value := x.NewExpiration
+		return protoreflect.ValueOfInt64(value)
+	case "arkeo.arkeo.EventRenewContract.rate":
+		value := x.Rate
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "arkeo.arkeo.EventRenewContract.extra_deposit":
+		value := x.ExtraDeposit
+		return protoreflect.ValueOfString(value)
+	case "arkeo.arkeo.EventRenewContract.deposit":
+		value := x.Deposit
 		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventValidatorPayout"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventRenewContract"))
 		}
-		panic(fmt.Errorf("message arkeo.arkeo.EventValidatorPayout does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message arkeo.arkeo.EventRenewContract does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4743,17 +6096,35 @@ func (x *fastReflection_EventValidatorPayout) Get(descriptor protoreflect.FieldD
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventValidatorPayout) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_EventRenewContract) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "arkeo.arkeo.EventValidatorPayout.validator":
-		x.Validator = value.Bytes()
-	case "arkeo.arkeo.EventValidatorPayout.reward":
-		x.Reward = value.Interface().(string)
+	case "arkeo.arkeo.EventRenewContract.provider":
+		x.Provider = value.Bytes()
+	case "arkeo.arkeo.EventRenewContract.contract_id":
+		x.ContractId = value.Uint()
+	case "arkeo.arkeo.EventRenewContract.service":
+		x.Service = value.Interface().(string)
+	case "arkeo.arkeo.EventRenewContract.client":
+		x.Client = value.Bytes()
+	case "arkeo.arkeo.EventRenewContract.delegate":
+		x.Delegate = value.Bytes()
+	case "arkeo.arkeo.EventRenewContract.type":
+		x.Type_ = (ContractType)(value.Enum())
+	case "arkeo.arkeo.EventRenewContract.old_expiration":
+		x.OldExpiration = value.Int()
+	case "arkeo.arkeo.EventRenewContract.new_expiration":
+		x.NewExpiration = value.Int()
+	case "arkeo.arkeo.EventRenewContract.rate":
+		x.Rate = value.Message().Interface().(*v1beta1.Coin)
+	case "arkeo.arkeo.EventRenewContract.extra_deposit":
+		x.ExtraDeposit = value.Interface().(string)
+	case "arkeo.arkeo.EventRenewContract.deposit":
+		x.Deposit = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventValidatorPayout"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventRenewContract"))
 		}
-		panic(fmt.Errorf("message arkeo.arkeo.EventValidatorPayout does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message arkeo.arkeo.EventRenewContract does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4767,44 +6138,84 @@ func (x *fastReflection_EventValidatorPayout) Set(fd protoreflect.FieldDescripto
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventValidatorPayout) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventRenewContract) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "arkeo.arkeo.EventValidatorPayout.validator":
-		panic(fmt.Errorf("field validator of message arkeo.arkeo.EventValidatorPayout is not mutable"))
-	case "arkeo.arkeo.EventValidatorPayout.reward":
-		panic(fmt.Errorf("field reward of message arkeo.arkeo.EventValidatorPayout is not mutable"))
+	case "arkeo.arkeo.EventRenewContract.rate":
+		if x.Rate == nil {
+			x.Rate = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.Rate.ProtoReflect())
+	case "arkeo.arkeo.EventRenewContract.provider":
+		panic(fmt.Errorf("field provider of message arkeo.arkeo.EventRenewContract is not mutable"))
+	case "arkeo.arkeo.EventRenewContract.contract_id":
+		panic(fmt.Errorf("field contract_id of message arkeo.arkeo.EventRenewContract is not mutable"))
+	case "arkeo.arkeo.EventRenewContract.service":
+		panic(fmt.Errorf("field service of message arkeo.arkeo.EventRenewContract is not mutable"))
+	case "arkeo.arkeo.EventRenewContract.client":
+		panic(fmt.Errorf("field client of message arkeo.arkeo.EventRenewContract is not mutable"))
+	case "arkeo.arkeo.EventRenewContract.delegate":
+		panic(fmt.Errorf("field delegate of message arkeo.arkeo.EventRenewContract is not mutable"))
+	case "arkeo.arkeo.EventRenewContract.type":
+		panic(fmt.Errorf("field type of message arkeo.arkeo.EventRenewContract is not mutable"))
+	case "arkeo.arkeo.EventRenewContract.old_expiration":
+		panic(fmt.Errorf("field old_expiration of message arkeo.arkeo.EventRenewContract is not mutable"))
+	case "arkeo.arkeo.EventRenewContract.new_expiration":
+		panic(fmt.Errorf("field new_expiration of message arkeo.arkeo.EventRenewContract is not mutable"))
+	case "arkeo.arkeo.EventRenewContract.extra_deposit":
+		panic(fmt.Errorf("field extra_deposit of message arkeo.arkeo.EventRenewContract is not mutable"))
+	case "arkeo.arkeo.EventRenewContract.deposit":
+		panic(fmt.Errorf("field deposit of message arkeo.arkeo.EventRenewContract is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventValidatorPayout"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventRenewContract"))
 		}
-		panic(fmt.Errorf("message arkeo.arkeo.EventValidatorPayout does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message arkeo.arkeo.EventRenewContract does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_EventValidatorPayout) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventRenewContract) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "arkeo.arkeo.EventValidatorPayout.validator":
+	case "arkeo.arkeo.EventRenewContract.provider":
 		return protoreflect.ValueOfBytes(nil)
-	case "arkeo.arkeo.EventValidatorPayout.reward":
+	case "arkeo.arkeo.EventRenewContract.contract_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "arkeo.arkeo.EventRenewContract.service":
+		return protoreflect.ValueOfString("")
+	case "arkeo.arkeo.EventRenewContract.client":
+		return protoreflect.ValueOfBytes(nil)
+	case "arkeo.arkeo.EventRenewContract.delegate":
+		return protoreflect.ValueOfBytes(nil)
+	case "arkeo.arkeo.EventRenewContract.type":
+		return protoreflect.ValueOfEnum(0)
+	case "arkeo.arkeo.EventRenewContract.old_expiration":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "arkeo.arkeo.EventRenewContract.new_expiration":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "arkeo.arkeo.EventRenewContract.rate":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "arkeo.arkeo.EventRenewContract.extra_deposit":
+		return protoreflect.ValueOfString("")
+	case "arkeo.arkeo.EventRenewContract.deposit":
 		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventValidatorPayout"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventRenewContract"))
 		}
-		panic(fmt.Errorf("message arkeo.arkeo.EventValidatorPayout does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message arkeo.arkeo.EventRenewContract does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_EventValidatorPayout) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_EventRenewContract) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in arkeo.arkeo.EventValidatorPayout", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in arkeo.arkeo.EventRenewContract", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4812,7 +6223,7 @@ func (x *fastReflection_EventValidatorPayout) WhichOneof(d protoreflect.OneofDes
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_EventValidatorPayout) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_EventRenewContract) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4823,7 +6234,7 @@ func (x *fastReflection_EventValidatorPayout) GetUnknown() protoreflect.RawField
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventValidatorPayout) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_EventRenewContract) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4835,7 +6246,7 @@ func (x *fastReflection_EventValidatorPayout) SetUnknown(fields protoreflect.Raw
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_EventValidatorPayout) IsValid() bool {
+func (x *fastReflection_EventRenewContract) IsValid() bool {
 	return x != nil
 }
 
@@ -4845,9 +6256,9 @@ func (x *fastReflection_EventValidatorPayout) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_EventValidatorPayout) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_EventRenewContract) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*EventValidatorPayout)
+		x := input.Message.Interface().(*EventRenewContract)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4859,11 +6270,43 @@ func (x *fastReflection_EventValidatorPayout) ProtoMethods() *protoiface.Methods
 		var n int
 		var l int
 		_ = l
-		l = len(x.Validator)
+		l = len(x.Provider)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.Reward)
+		if x.ContractId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ContractId))
+		}
+		l = len(x.Service)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Client)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Delegate)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Type_ != 0 {
+			n += 1 + runtime.Sov(uint64(x.Type_))
+		}
+		if x.OldExpiration != 0 {
+			n += 1 + runtime.Sov(uint64(x.OldExpiration))
+		}
+		if x.NewExpiration != 0 {
+			n += 1 + runtime.Sov(uint64(x.NewExpiration))
+		}
+		if x.Rate != nil {
+			l = options.Size(x.Rate)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ExtraDeposit)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Deposit)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -4877,7 +6320,7 @@ func (x *fastReflection_EventValidatorPayout) ProtoMethods() *protoiface.Methods
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*EventValidatorPayout)
+		x := input.Message.Interface().(*EventRenewContract)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4896,17 +6339,79 @@ func (x *fastReflection_EventValidatorPayout) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Reward) > 0 {
-			i -= len(x.Reward)
-			copy(dAtA[i:], x.Reward)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Reward)))
+		if len(x.Deposit) > 0 {
+			i -= len(x.Deposit)
+			copy(dAtA[i:], x.Deposit)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Deposit)))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x5a
 		}
-		if len(x.Validator) > 0 {
-			i -= len(x.Validator)
-			copy(dAtA[i:], x.Validator)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Validator)))
+		if len(x.ExtraDeposit) > 0 {
+			i -= len(x.ExtraDeposit)
+			copy(dAtA[i:], x.ExtraDeposit)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ExtraDeposit)))
+			i--
+			dAtA[i] = 0x52
+		}
+		if x.Rate != nil {
+			encoded, err := options.Marshal(x.Rate)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if x.NewExpiration != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NewExpiration))
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.OldExpiration != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OldExpiration))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.Type_ != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Type_))
+			i--
+			dAtA[i] = 0x30
+		}
+		if len(x.Delegate) > 0 {
+			i -= len(x.Delegate)
+			copy(dAtA[i:], x.Delegate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Delegate)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.Client) > 0 {
+			i -= len(x.Client)
+			copy(dAtA[i:], x.Client)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Client)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Service) > 0 {
+			i -= len(x.Service)
+			copy(dAtA[i:], x.Service)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Service)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.ContractId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ContractId))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Provider) > 0 {
+			i -= len(x.Provider)
+			copy(dAtA[i:], x.Provider)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Provider)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -4921,7 +6426,7 @@ func (x *fastReflection_EventValidatorPayout) ProtoMethods() *protoiface.Methods
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*EventValidatorPayout)
+		x := input.Message.Interface().(*EventRenewContract)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4953,15 +6458,15 @@ func (x *fastReflection_EventValidatorPayout) ProtoMethods() *protoiface.Methods
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventValidatorPayout: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventRenewContract: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventValidatorPayout: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventRenewContract: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
 				}
 				var byteLen int
 				for shift := uint(0); ; shift += 7 {
@@ -4988,14 +6493,33 @@ func (x *fastReflection_EventValidatorPayout) ProtoMethods() *protoiface.Methods
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Validator = append(x.Validator[:0], dAtA[iNdEx:postIndex]...)
-				if x.Validator == nil {
-					x.Validator = []byte{}
+				x.Provider = append(x.Provider[:0], dAtA[iNdEx:postIndex]...)
+				if x.Provider == nil {
+					x.Provider = []byte{}
 				}
 				iNdEx = postIndex
 			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+				}
+				x.ContractId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ContractId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reward", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -5023,22 +6547,247 @@ func (x *fastReflection_EventValidatorPayout) ProtoMethods() *protoiface.Methods
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Reward = string(dAtA[iNdEx:postIndex])
+				x.Service = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Client", wireType)
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if (iNdEx + skippy) > l {
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				x.Client = append(x.Client[:0], dAtA[iNdEx:postIndex]...)
+				if x.Client == nil {
+					x.Client = []byte{}
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Delegate", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Delegate = append(x.Delegate[:0], dAtA[iNdEx:postIndex]...)
+				if x.Delegate == nil {
+					x.Delegate = []byte{}
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Type_", wireType)
+				}
+				x.Type_ = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Type_ |= ContractType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OldExpiration", wireType)
+				}
+				x.OldExpiration = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.OldExpiration |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NewExpiration", wireType)
+				}
+				x.NewExpiration = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NewExpiration |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Rate == nil {
+					x.Rate = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Rate); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExtraDeposit", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ExtraDeposit = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Deposit = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
 				}
 				iNdEx += skippy
 			}
@@ -5060,557 +6809,11297 @@ func (x *fastReflection_EventValidatorPayout) ProtoMethods() *protoiface.Methods
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: arkeo/arkeo/events.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+var (
+	md_EventRotateDelegate              protoreflect.MessageDescriptor
+	fd_EventRotateDelegate_provider     protoreflect.FieldDescriptor
+	fd_EventRotateDelegate_contract_id  protoreflect.FieldDescriptor
+	fd_EventRotateDelegate_service      protoreflect.FieldDescriptor
+	fd_EventRotateDelegate_client       protoreflect.FieldDescriptor
+	fd_EventRotateDelegate_old_delegate protoreflect.FieldDescriptor
+	fd_EventRotateDelegate_new_delegate protoreflect.FieldDescriptor
 )
 
-type EventBondProvider struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func init() {
+	file_arkeo_arkeo_events_proto_init()
+	md_EventRotateDelegate = File_arkeo_arkeo_events_proto.Messages().ByName("EventRotateDelegate")
+	fd_EventRotateDelegate_provider = md_EventRotateDelegate.Fields().ByName("provider")
+	fd_EventRotateDelegate_contract_id = md_EventRotateDelegate.Fields().ByName("contract_id")
+	fd_EventRotateDelegate_service = md_EventRotateDelegate.Fields().ByName("service")
+	fd_EventRotateDelegate_client = md_EventRotateDelegate.Fields().ByName("client")
+	fd_EventRotateDelegate_old_delegate = md_EventRotateDelegate.Fields().ByName("old_delegate")
+	fd_EventRotateDelegate_new_delegate = md_EventRotateDelegate.Fields().ByName("new_delegate")
+}
 
-	Provider []byte `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
-	Service  string `protobuf:"bytes,2,opt,name=service,proto3" json:"service,omitempty"`
-	BondRel  string `protobuf:"bytes,3,opt,name=bond_rel,json=bondRel,proto3" json:"bond_rel,omitempty"`
-	BondAbs  string `protobuf:"bytes,4,opt,name=bond_abs,json=bondAbs,proto3" json:"bond_abs,omitempty"`
+var _ protoreflect.Message = (*fastReflection_EventRotateDelegate)(nil)
+
+type fastReflection_EventRotateDelegate EventRotateDelegate
+
+func (x *EventRotateDelegate) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventRotateDelegate)(x)
 }
 
-func (x *EventBondProvider) Reset() {
-	*x = EventBondProvider{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_arkeo_arkeo_events_proto_msgTypes[0]
+func (x *EventRotateDelegate) slowProtoReflect() protoreflect.Message {
+	mi := &file_arkeo_arkeo_events_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
+	return mi.MessageOf(x)
 }
 
-func (x *EventBondProvider) String() string {
-	return protoimpl.X.MessageStringOf(x)
+var _fastReflection_EventRotateDelegate_messageType fastReflection_EventRotateDelegate_messageType
+var _ protoreflect.MessageType = fastReflection_EventRotateDelegate_messageType{}
+
+type fastReflection_EventRotateDelegate_messageType struct{}
+
+func (x fastReflection_EventRotateDelegate_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventRotateDelegate)(nil)
+}
+func (x fastReflection_EventRotateDelegate_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventRotateDelegate)
+}
+func (x fastReflection_EventRotateDelegate_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventRotateDelegate
 }
 
-func (*EventBondProvider) ProtoMessage() {}
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventRotateDelegate) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventRotateDelegate
+}
 
-// Deprecated: Use EventBondProvider.ProtoReflect.Descriptor instead.
-func (*EventBondProvider) Descriptor() ([]byte, []int) {
-	return file_arkeo_arkeo_events_proto_rawDescGZIP(), []int{0}
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventRotateDelegate) Type() protoreflect.MessageType {
+	return _fastReflection_EventRotateDelegate_messageType
 }
 
-func (x *EventBondProvider) GetProvider() []byte {
-	if x != nil {
-		return x.Provider
-	}
-	return nil
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventRotateDelegate) New() protoreflect.Message {
+	return new(fastReflection_EventRotateDelegate)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventRotateDelegate) Interface() protoreflect.ProtoMessage {
+	return (*EventRotateDelegate)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventRotateDelegate) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Provider) != 0 {
+		value := protoreflect.ValueOfBytes(x.Provider)
+		if !f(fd_EventRotateDelegate_provider, value) {
+			return
+		}
+	}
+	if x.ContractId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ContractId)
+		if !f(fd_EventRotateDelegate_contract_id, value) {
+			return
+		}
+	}
+	if x.Service != "" {
+		value := protoreflect.ValueOfString(x.Service)
+		if !f(fd_EventRotateDelegate_service, value) {
+			return
+		}
+	}
+	if len(x.Client) != 0 {
+		value := protoreflect.ValueOfBytes(x.Client)
+		if !f(fd_EventRotateDelegate_client, value) {
+			return
+		}
+	}
+	if len(x.OldDelegate) != 0 {
+		value := protoreflect.ValueOfBytes(x.OldDelegate)
+		if !f(fd_EventRotateDelegate_old_delegate, value) {
+			return
+		}
+	}
+	if len(x.NewDelegate) != 0 {
+		value := protoreflect.ValueOfBytes(x.NewDelegate)
+		if !f(fd_EventRotateDelegate_new_delegate, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventRotateDelegate) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "arkeo.arkeo.EventRotateDelegate.provider":
+		return len(x.Provider) != 0
+	case "arkeo.arkeo.EventRotateDelegate.contract_id":
+		return x.ContractId != uint64(0)
+	case "arkeo.arkeo.EventRotateDelegate.service":
+		return x.Service != ""
+	case "arkeo.arkeo.EventRotateDelegate.client":
+		return len(x.Client) != 0
+	case "arkeo.arkeo.EventRotateDelegate.old_delegate":
+		return len(x.OldDelegate) != 0
+	case "arkeo.arkeo.EventRotateDelegate.new_delegate":
+		return len(x.NewDelegate) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventRotateDelegate"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.EventRotateDelegate does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventRotateDelegate) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "arkeo.arkeo.EventRotateDelegate.provider":
+		x.Provider = nil
+	case "arkeo.arkeo.EventRotateDelegate.contract_id":
+		x.ContractId = uint64(0)
+	case "arkeo.arkeo.EventRotateDelegate.service":
+		x.Service = ""
+	case "arkeo.arkeo.EventRotateDelegate.client":
+		x.Client = nil
+	case "arkeo.arkeo.EventRotateDelegate.old_delegate":
+		x.OldDelegate = nil
+	case "arkeo.arkeo.EventRotateDelegate.new_delegate":
+		x.NewDelegate = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventRotateDelegate"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.EventRotateDelegate does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventRotateDelegate) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "arkeo.arkeo.EventRotateDelegate.provider":
+		value := x.Provider
+		return protoreflect.ValueOfBytes(value)
+	case "arkeo.arkeo.EventRotateDelegate.contract_id":
+		value := x.ContractId
+		return protoreflect.ValueOfUint64(value)
+	case "arkeo.arkeo.EventRotateDelegate.service":
+		value := x.Service
+		return protoreflect.ValueOfString(value)
+	case "arkeo.arkeo.EventRotateDelegate.client":
+		value := x.Client
+		return protoreflect.ValueOfBytes(value)
+	case "arkeo.arkeo.EventRotateDelegate.old_delegate":
+		value := x.OldDelegate
+		return protoreflect.ValueOfBytes(value)
+	case "arkeo.arkeo.EventRotateDelegate.new_delegate":
+		value := x.NewDelegate
+		return protoreflect.ValueOfBytes(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventRotateDelegate"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.EventRotateDelegate does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventRotateDelegate) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "arkeo.arkeo.EventRotateDelegate.provider":
+		x.Provider = value.Bytes()
+	case "arkeo.arkeo.EventRotateDelegate.contract_id":
+		x.ContractId = value.Uint()
+	case "arkeo.arkeo.EventRotateDelegate.service":
+		x.Service = value.Interface().(string)
+	case "arkeo.arkeo.EventRotateDelegate.client":
+		x.Client = value.Bytes()
+	case "arkeo.arkeo.EventRotateDelegate.old_delegate":
+		x.OldDelegate = value.Bytes()
+	case "arkeo.arkeo.EventRotateDelegate.new_delegate":
+		x.NewDelegate = value.Bytes()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventRotateDelegate"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.EventRotateDelegate does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventRotateDelegate) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "arkeo.arkeo.EventRotateDelegate.provider":
+		panic(fmt.Errorf("field provider of message arkeo.arkeo.EventRotateDelegate is not mutable"))
+	case "arkeo.arkeo.EventRotateDelegate.contract_id":
+		panic(fmt.Errorf("field contract_id of message arkeo.arkeo.EventRotateDelegate is not mutable"))
+	case "arkeo.arkeo.EventRotateDelegate.service":
+		panic(fmt.Errorf("field service of message arkeo.arkeo.EventRotateDelegate is not mutable"))
+	case "arkeo.arkeo.EventRotateDelegate.client":
+		panic(fmt.Errorf("field client of message arkeo.arkeo.EventRotateDelegate is not mutable"))
+	case "arkeo.arkeo.EventRotateDelegate.old_delegate":
+		panic(fmt.Errorf("field old_delegate of message arkeo.arkeo.EventRotateDelegate is not mutable"))
+	case "arkeo.arkeo.EventRotateDelegate.new_delegate":
+		panic(fmt.Errorf("field new_delegate of message arkeo.arkeo.EventRotateDelegate is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventRotateDelegate"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.EventRotateDelegate does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventRotateDelegate) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "arkeo.arkeo.EventRotateDelegate.provider":
+		return protoreflect.ValueOfBytes(nil)
+	case "arkeo.arkeo.EventRotateDelegate.contract_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "arkeo.arkeo.EventRotateDelegate.service":
+		return protoreflect.ValueOfString("")
+	case "arkeo.arkeo.EventRotateDelegate.client":
+		return protoreflect.ValueOfBytes(nil)
+	case "arkeo.arkeo.EventRotateDelegate.old_delegate":
+		return protoreflect.ValueOfBytes(nil)
+	case "arkeo.arkeo.EventRotateDelegate.new_delegate":
+		return protoreflect.ValueOfBytes(nil)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventRotateDelegate"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.EventRotateDelegate does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventRotateDelegate) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in arkeo.arkeo.EventRotateDelegate", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventRotateDelegate) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventRotateDelegate) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventRotateDelegate) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventRotateDelegate) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventRotateDelegate)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Provider)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ContractId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ContractId))
+		}
+		l = len(x.Service)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Client)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.OldDelegate)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.NewDelegate)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventRotateDelegate)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.NewDelegate) > 0 {
+			i -= len(x.NewDelegate)
+			copy(dAtA[i:], x.NewDelegate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NewDelegate)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.OldDelegate) > 0 {
+			i -= len(x.OldDelegate)
+			copy(dAtA[i:], x.OldDelegate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.OldDelegate)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.Client) > 0 {
+			i -= len(x.Client)
+			copy(dAtA[i:], x.Client)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Client)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Service) > 0 {
+			i -= len(x.Service)
+			copy(dAtA[i:], x.Service)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Service)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.ContractId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ContractId))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Provider) > 0 {
+			i -= len(x.Provider)
+			copy(dAtA[i:], x.Provider)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Provider)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventRotateDelegate)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventRotateDelegate: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventRotateDelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Provider = append(x.Provider[:0], dAtA[iNdEx:postIndex]...)
+				if x.Provider == nil {
+					x.Provider = []byte{}
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+				}
+				x.ContractId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ContractId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Service = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Client", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Client = append(x.Client[:0], dAtA[iNdEx:postIndex]...)
+				if x.Client == nil {
+					x.Client = []byte{}
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OldDelegate", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.OldDelegate = append(x.OldDelegate[:0], dAtA[iNdEx:postIndex]...)
+				if x.OldDelegate == nil {
+					x.OldDelegate = []byte{}
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NewDelegate", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.NewDelegate = append(x.NewDelegate[:0], dAtA[iNdEx:postIndex]...)
+				if x.NewDelegate == nil {
+					x.NewDelegate = []byte{}
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EventSetAutoRenew             protoreflect.MessageDescriptor
+	fd_EventSetAutoRenew_provider    protoreflect.FieldDescriptor
+	fd_EventSetAutoRenew_contract_id protoreflect.FieldDescriptor
+	fd_EventSetAutoRenew_service     protoreflect.FieldDescriptor
+	fd_EventSetAutoRenew_client      protoreflect.FieldDescriptor
+	fd_EventSetAutoRenew_auto_renew  protoreflect.FieldDescriptor
+	fd_EventSetAutoRenew_refund      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_arkeo_arkeo_events_proto_init()
+	md_EventSetAutoRenew = File_arkeo_arkeo_events_proto.Messages().ByName("EventSetAutoRenew")
+	fd_EventSetAutoRenew_provider = md_EventSetAutoRenew.Fields().ByName("provider")
+	fd_EventSetAutoRenew_contract_id = md_EventSetAutoRenew.Fields().ByName("contract_id")
+	fd_EventSetAutoRenew_service = md_EventSetAutoRenew.Fields().ByName("service")
+	fd_EventSetAutoRenew_client = md_EventSetAutoRenew.Fields().ByName("client")
+	fd_EventSetAutoRenew_auto_renew = md_EventSetAutoRenew.Fields().ByName("auto_renew")
+	fd_EventSetAutoRenew_refund = md_EventSetAutoRenew.Fields().ByName("refund")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventSetAutoRenew)(nil)
+
+type fastReflection_EventSetAutoRenew EventSetAutoRenew
+
+func (x *EventSetAutoRenew) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventSetAutoRenew)(x)
+}
+
+func (x *EventSetAutoRenew) slowProtoReflect() protoreflect.Message {
+	mi := &file_arkeo_arkeo_events_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventSetAutoRenew_messageType fastReflection_EventSetAutoRenew_messageType
+var _ protoreflect.MessageType = fastReflection_EventSetAutoRenew_messageType{}
+
+type fastReflection_EventSetAutoRenew_messageType struct{}
+
+func (x fastReflection_EventSetAutoRenew_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventSetAutoRenew)(nil)
+}
+func (x fastReflection_EventSetAutoRenew_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventSetAutoRenew)
+}
+func (x fastReflection_EventSetAutoRenew_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventSetAutoRenew
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventSetAutoRenew) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventSetAutoRenew
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventSetAutoRenew) Type() protoreflect.MessageType {
+	return _fastReflection_EventSetAutoRenew_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventSetAutoRenew) New() protoreflect.Message {
+	return new(fastReflection_EventSetAutoRenew)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventSetAutoRenew) Interface() protoreflect.ProtoMessage {
+	return (*EventSetAutoRenew)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventSetAutoRenew) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Provider) != 0 {
+		value := protoreflect.ValueOfBytes(x.Provider)
+		if !f(fd_EventSetAutoRenew_provider, value) {
+			return
+		}
+	}
+	if x.ContractId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ContractId)
+		if !f(fd_EventSetAutoRenew_contract_id, value) {
+			return
+		}
+	}
+	if x.Service != "" {
+		value := protoreflect.ValueOfString(x.Service)
+		if !f(fd_EventSetAutoRenew_service, value) {
+			return
+		}
+	}
+	if len(x.Client) != 0 {
+		value := protoreflect.ValueOfBytes(x.Client)
+		if !f(fd_EventSetAutoRenew_client, value) {
+			return
+		}
+	}
+	if x.AutoRenew != false {
+		value := protoreflect.ValueOfBool(x.AutoRenew)
+		if !f(fd_EventSetAutoRenew_auto_renew, value) {
+			return
+		}
+	}
+	if x.Refund != "" {
+		value := protoreflect.ValueOfString(x.Refund)
+		if !f(fd_EventSetAutoRenew_refund, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventSetAutoRenew) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "arkeo.arkeo.EventSetAutoRenew.provider":
+		return len(x.Provider) != 0
+	case "arkeo.arkeo.EventSetAutoRenew.contract_id":
+		return x.ContractId != uint64(0)
+	case "arkeo.arkeo.EventSetAutoRenew.service":
+		return x.Service != ""
+	case "arkeo.arkeo.EventSetAutoRenew.client":
+		return len(x.Client) != 0
+	case "arkeo.arkeo.EventSetAutoRenew.auto_renew":
+		return x.AutoRenew != false
+	case "arkeo.arkeo.EventSetAutoRenew.refund":
+		return x.Refund != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventSetAutoRenew"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.EventSetAutoRenew does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventSetAutoRenew) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "arkeo.arkeo.EventSetAutoRenew.provider":
+		x.Provider = nil
+	case "arkeo.arkeo.EventSetAutoRenew.contract_id":
+		x.ContractId = uint64(0)
+	case "arkeo.arkeo.EventSetAutoRenew.service":
+		x.Service = ""
+	case "arkeo.arkeo.EventSetAutoRenew.client":
+		x.Client = nil
+	case "arkeo.arkeo.EventSetAutoRenew.auto_renew":
+		x.AutoRenew = false
+	case "arkeo.arkeo.EventSetAutoRenew.refund":
+		x.Refund = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventSetAutoRenew"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.EventSetAutoRenew does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventSetAutoRenew) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "arkeo.arkeo.EventSetAutoRenew.provider":
+		value := x.Provider
+		return protoreflect.ValueOfBytes(value)
+	case "arkeo.arkeo.EventSetAutoRenew.contract_id":
+		value := x.ContractId
+		return protoreflect.ValueOfUint64(value)
+	case "arkeo.arkeo.EventSetAutoRenew.service":
+		value := x.Service
+		return protoreflect.ValueOfString(value)
+	case "arkeo.arkeo.EventSetAutoRenew.client":
+		value := x.Client
+		return protoreflect.ValueOfBytes(value)
+	case "arkeo.arkeo.EventSetAutoRenew.auto_renew":
+		value := x.AutoRenew
+		return protoreflect.ValueOfBool(value)
+	case "arkeo.arkeo.EventSetAutoRenew.refund":
+		value := x.Refund
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventSetAutoRenew"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.EventSetAutoRenew does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventSetAutoRenew) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "arkeo.arkeo.EventSetAutoRenew.provider":
+		x.Provider = value.Bytes()
+	case "arkeo.arkeo.EventSetAutoRenew.contract_id":
+		x.ContractId = value.Uint()
+	case "arkeo.arkeo.EventSetAutoRenew.service":
+		x.Service = value.Interface().(string)
+	case "arkeo.arkeo.EventSetAutoRenew.client":
+		x.Client = value.Bytes()
+	case "arkeo.arkeo.EventSetAutoRenew.auto_renew":
+		x.AutoRenew = value.Bool()
+	case "arkeo.arkeo.EventSetAutoRenew.refund":
+		x.Refund = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventSetAutoRenew"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.EventSetAutoRenew does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventSetAutoRenew) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "arkeo.arkeo.EventSetAutoRenew.provider":
+		panic(fmt.Errorf("field provider of message arkeo.arkeo.EventSetAutoRenew is not mutable"))
+	case "arkeo.arkeo.EventSetAutoRenew.contract_id":
+		panic(fmt.Errorf("field contract_id of message arkeo.arkeo.EventSetAutoRenew is not mutable"))
+	case "arkeo.arkeo.EventSetAutoRenew.service":
+		panic(fmt.Errorf("field service of message arkeo.arkeo.EventSetAutoRenew is not mutable"))
+	case "arkeo.arkeo.EventSetAutoRenew.client":
+		panic(fmt.Errorf("field client of message arkeo.arkeo.EventSetAutoRenew is not mutable"))
+	case "arkeo.arkeo.EventSetAutoRenew.auto_renew":
+		panic(fmt.Errorf("field auto_renew of message arkeo.arkeo.EventSetAutoRenew is not mutable"))
+	case "arkeo.arkeo.EventSetAutoRenew.refund":
+		panic(fmt.Errorf("field refund of message arkeo.arkeo.EventSetAutoRenew is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventSetAutoRenew"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.EventSetAutoRenew does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventSetAutoRenew) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "arkeo.arkeo.EventSetAutoRenew.provider":
+		return protoreflect.ValueOfBytes(nil)
+	case "arkeo.arkeo.EventSetAutoRenew.contract_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "arkeo.arkeo.EventSetAutoRenew.service":
+		return protoreflect.ValueOfString("")
+	case "arkeo.arkeo.EventSetAutoRenew.client":
+		return protoreflect.ValueOfBytes(nil)
+	case "arkeo.arkeo.EventSetAutoRenew.auto_renew":
+		return protoreflect.ValueOfBool(false)
+	case "arkeo.arkeo.EventSetAutoRenew.refund":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventSetAutoRenew"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.EventSetAutoRenew does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventSetAutoRenew) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in arkeo.arkeo.EventSetAutoRenew", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventSetAutoRenew) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventSetAutoRenew) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventSetAutoRenew) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventSetAutoRenew) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventSetAutoRenew)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Provider)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ContractId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ContractId))
+		}
+		l = len(x.Service)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Client)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.AutoRenew {
+			n += 2
+		}
+		l = len(x.Refund)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventSetAutoRenew)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Refund) > 0 {
+			i -= len(x.Refund)
+			copy(dAtA[i:], x.Refund)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Refund)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.AutoRenew {
+			i--
+			if x.AutoRenew {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.Client) > 0 {
+			i -= len(x.Client)
+			copy(dAtA[i:], x.Client)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Client)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Service) > 0 {
+			i -= len(x.Service)
+			copy(dAtA[i:], x.Service)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Service)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.ContractId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ContractId))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Provider) > 0 {
+			i -= len(x.Provider)
+			copy(dAtA[i:], x.Provider)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Provider)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventSetAutoRenew)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventSetAutoRenew: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventSetAutoRenew: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Provider = append(x.Provider[:0], dAtA[iNdEx:postIndex]...)
+				if x.Provider == nil {
+					x.Provider = []byte{}
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+				}
+				x.ContractId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ContractId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Service = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Client", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Client = append(x.Client[:0], dAtA[iNdEx:postIndex]...)
+				if x.Client == nil {
+					x.Client = []byte{}
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AutoRenew", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.AutoRenew = bool(v != 0)
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Refund", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Refund = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EventTopUpContract                protoreflect.MessageDescriptor
+	fd_EventTopUpContract_provider       protoreflect.FieldDescriptor
+	fd_EventTopUpContract_contract_id    protoreflect.FieldDescriptor
+	fd_EventTopUpContract_service        protoreflect.FieldDescriptor
+	fd_EventTopUpContract_client         protoreflect.FieldDescriptor
+	fd_EventTopUpContract_old_expiration protoreflect.FieldDescriptor
+	fd_EventTopUpContract_new_expiration protoreflect.FieldDescriptor
+	fd_EventTopUpContract_top_up         protoreflect.FieldDescriptor
+	fd_EventTopUpContract_deposit        protoreflect.FieldDescriptor
+	fd_EventTopUpContract_balance        protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_arkeo_arkeo_events_proto_init()
+	md_EventTopUpContract = File_arkeo_arkeo_events_proto.Messages().ByName("EventTopUpContract")
+	fd_EventTopUpContract_provider = md_EventTopUpContract.Fields().ByName("provider")
+	fd_EventTopUpContract_contract_id = md_EventTopUpContract.Fields().ByName("contract_id")
+	fd_EventTopUpContract_service = md_EventTopUpContract.Fields().ByName("service")
+	fd_EventTopUpContract_client = md_EventTopUpContract.Fields().ByName("client")
+	fd_EventTopUpContract_old_expiration = md_EventTopUpContract.Fields().ByName("old_expiration")
+	fd_EventTopUpContract_new_expiration = md_EventTopUpContract.Fields().ByName("new_expiration")
+	fd_EventTopUpContract_top_up = md_EventTopUpContract.Fields().ByName("top_up")
+	fd_EventTopUpContract_deposit = md_EventTopUpContract.Fields().ByName("deposit")
+	fd_EventTopUpContract_balance = md_EventTopUpContract.Fields().ByName("balance")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventTopUpContract)(nil)
+
+type fastReflection_EventTopUpContract EventTopUpContract
+
+func (x *EventTopUpContract) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventTopUpContract)(x)
+}
+
+func (x *EventTopUpContract) slowProtoReflect() protoreflect.Message {
+	mi := &file_arkeo_arkeo_events_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventTopUpContract_messageType fastReflection_EventTopUpContract_messageType
+var _ protoreflect.MessageType = fastReflection_EventTopUpContract_messageType{}
+
+type fastReflection_EventTopUpContract_messageType struct{}
+
+func (x fastReflection_EventTopUpContract_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventTopUpContract)(nil)
+}
+func (x fastReflection_EventTopUpContract_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventTopUpContract)
+}
+func (x fastReflection_EventTopUpContract_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventTopUpContract
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventTopUpContract) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventTopUpContract
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventTopUpContract) Type() protoreflect.MessageType {
+	return _fastReflection_EventTopUpContract_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventTopUpContract) New() protoreflect.Message {
+	return new(fastReflection_EventTopUpContract)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventTopUpContract) Interface() protoreflect.ProtoMessage {
+	return (*EventTopUpContract)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventTopUpContract) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Provider) != 0 {
+		value := protoreflect.ValueOfBytes(x.Provider)
+		if !f(fd_EventTopUpContract_provider, value) {
+			return
+		}
+	}
+	if x.ContractId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ContractId)
+		if !f(fd_EventTopUpContract_contract_id, value) {
+			return
+		}
+	}
+	if x.Service != "" {
+		value := protoreflect.ValueOfString(x.Service)
+		if !f(fd_EventTopUpContract_service, value) {
+			return
+		}
+	}
+	if len(x.Client) != 0 {
+		value := protoreflect.ValueOfBytes(x.Client)
+		if !f(fd_EventTopUpContract_client, value) {
+			return
+		}
+	}
+	if x.OldExpiration != int64(0) {
+		value := protoreflect.ValueOfInt64(x.OldExpiration)
+		if !f(fd_EventTopUpContract_old_expiration, value) {
+			return
+		}
+	}
+	if x.NewExpiration != int64(0) {
+		value := protoreflect.ValueOfInt64(x.NewExpiration)
+		if !f(fd_EventTopUpContract_new_expiration, value) {
+			return
+		}
+	}
+	if x.TopUp != "" {
+		value := protoreflect.ValueOfString(x.TopUp)
+		if !f(fd_EventTopUpContract_top_up, value) {
+			return
+		}
+	}
+	if x.Deposit != "" {
+		value := protoreflect.ValueOfString(x.Deposit)
+		if !f(fd_EventTopUpContract_deposit, value) {
+			return
+		}
+	}
+	if x.Balance != "" {
+		value := protoreflect.ValueOfString(x.Balance)
+		if !f(fd_EventTopUpContract_balance, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventTopUpContract) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "arkeo.arkeo.EventTopUpContract.provider":
+		return len(x.Provider) != 0
+	case "arkeo.arkeo.EventTopUpContract.contract_id":
+		return x.ContractId != uint64(0)
+	case "arkeo.arkeo.EventTopUpContract.service":
+		return x.Service != ""
+	case "arkeo.arkeo.EventTopUpContract.client":
+		return len(x.Client) != 0
+	case "arkeo.arkeo.EventTopUpContract.old_expiration":
+		return x.OldExpiration != int64(0)
+	case "arkeo.arkeo.EventTopUpContract.new_expiration":
+		return x.NewExpiration != int64(0)
+	case "arkeo.arkeo.EventTopUpContract.top_up":
+		return x.TopUp != ""
+	case "arkeo.arkeo.EventTopUpContract.deposit":
+		return x.Deposit != ""
+	case "arkeo.arkeo.EventTopUpContract.balance":
+		return x.Balance != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventTopUpContract"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.EventTopUpContract does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventTopUpContract) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "arkeo.arkeo.EventTopUpContract.provider":
+		x.Provider = nil
+	case "arkeo.arkeo.EventTopUpContract.contract_id":
+		x.ContractId = uint64(0)
+	case "arkeo.arkeo.EventTopUpContract.service":
+		x.Service = ""
+	case "arkeo.arkeo.EventTopUpContract.client":
+		x.Client = nil
+	case "arkeo.arkeo.EventTopUpContract.old_expiration":
+		x.OldExpiration = int64(0)
+	case "arkeo.arkeo.EventTopUpContract.new_expiration":
+		x.NewExpiration = int64(0)
+	case "arkeo.arkeo.EventTopUpContract.top_up":
+		x.TopUp = ""
+	case "arkeo.arkeo.EventTopUpContract.deposit":
+		x.Deposit = ""
+	case "arkeo.arkeo.EventTopUpContract.balance":
+		x.Balance = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventTopUpContract"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.EventTopUpContract does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventTopUpContract) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "arkeo.arkeo.EventTopUpContract.provider":
+		value := x.Provider
+		return protoreflect.ValueOfBytes(value)
+	case "arkeo.arkeo.EventTopUpContract.contract_id":
+		value := x.ContractId
+		return protoreflect.ValueOfUint64(value)
+	case "arkeo.arkeo.EventTopUpContract.service":
+		value := x.Service
+		return protoreflect.ValueOfString(value)
+	case "arkeo.arkeo.EventTopUpContract.client":
+		value := x.Client
+		return protoreflect.ValueOfBytes(value)
+	case "arkeo.arkeo.EventTopUpContract.old_expiration":
+		value := x.OldExpiration
+		return protoreflect.ValueOfInt64(value)
+	case "arkeo.arkeo.EventTopUpContract.new_expiration":
+		value := x.NewExpiration
+		return protoreflect.ValueOfInt64(value)
+	case "arkeo.arkeo.EventTopUpContract.top_up":
+		value := x.TopUp
+		return protoreflect.ValueOfString(value)
+	case "arkeo.arkeo.EventTopUpContract.deposit":
+		value := x.Deposit
+		return protoreflect.ValueOfString(value)
+	case "arkeo.arkeo.EventTopUpContract.balance":
+		value := x.Balance
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventTopUpContract"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.EventTopUpContract does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventTopUpContract) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "arkeo.arkeo.EventTopUpContract.provider":
+		x.Provider = value.Bytes()
+	case "arkeo.arkeo.EventTopUpContract.contract_id":
+		x.ContractId = value.Uint()
+	case "arkeo.arkeo.EventTopUpContract.service":
+		x.Service = value.Interface().(string)
+	case "arkeo.arkeo.EventTopUpContract.client":
+		x.Client = value.Bytes()
+	case "arkeo.arkeo.EventTopUpContract.old_expiration":
+		x.OldExpiration = value.Int()
+	case "arkeo.arkeo.EventTopUpContract.new_expiration":
+		x.NewExpiration = value.Int()
+	case "arkeo.arkeo.EventTopUpContract.top_up":
+		x.TopUp = value.Interface().(string)
+	case "arkeo.arkeo.EventTopUpContract.deposit":
+		x.Deposit = value.Interface().(string)
+	case "arkeo.arkeo.EventTopUpContract.balance":
+		x.Balance = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventTopUpContract"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.EventTopUpContract does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventTopUpContract) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "arkeo.arkeo.EventTopUpContract.provider":
+		panic(fmt.Errorf("field provider of message arkeo.arkeo.EventTopUpContract is not mutable"))
+	case "arkeo.arkeo.EventTopUpContract.contract_id":
+		panic(fmt.Errorf("field contract_id of message arkeo.arkeo.EventTopUpContract is not mutable"))
+	case "arkeo.arkeo.EventTopUpContract.service":
+		panic(fmt.Errorf("field service of message arkeo.arkeo.EventTopUpContract is not mutable"))
+	case "arkeo.arkeo.EventTopUpContract.client":
+		panic(fmt.Errorf("field client of message arkeo.arkeo.EventTopUpContract is not mutable"))
+	case "arkeo.arkeo.EventTopUpContract.old_expiration":
+		panic(fmt.Errorf("field old_expiration of message arkeo.arkeo.EventTopUpContract is not mutable"))
+	case "arkeo.arkeo.EventTopUpContract.new_expiration":
+		panic(fmt.Errorf("field new_expiration of message arkeo.arkeo.EventTopUpContract is not mutable"))
+	case "arkeo.arkeo.EventTopUpContract.top_up":
+		panic(fmt.Errorf("field top_up of message arkeo.arkeo.EventTopUpContract is not mutable"))
+	case "arkeo.arkeo.EventTopUpContract.deposit":
+		panic(fmt.Errorf("field deposit of message arkeo.arkeo.EventTopUpContract is not mutable"))
+	case "arkeo.arkeo.EventTopUpContract.balance":
+		panic(fmt.Errorf("field balance of message arkeo.arkeo.EventTopUpContract is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventTopUpContract"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.EventTopUpContract does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventTopUpContract) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "arkeo.arkeo.EventTopUpContract.provider":
+		return protoreflect.ValueOfBytes(nil)
+	case "arkeo.arkeo.EventTopUpContract.contract_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "arkeo.arkeo.EventTopUpContract.service":
+		return protoreflect.ValueOfString("")
+	case "arkeo.arkeo.EventTopUpContract.client":
+		return protoreflect.ValueOfBytes(nil)
+	case "arkeo.arkeo.EventTopUpContract.old_expiration":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "arkeo.arkeo.EventTopUpContract.new_expiration":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "arkeo.arkeo.EventTopUpContract.top_up":
+		return protoreflect.ValueOfString("")
+	case "arkeo.arkeo.EventTopUpContract.deposit":
+		return protoreflect.ValueOfString("")
+	case "arkeo.arkeo.EventTopUpContract.balance":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventTopUpContract"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.EventTopUpContract does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventTopUpContract) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in arkeo.arkeo.EventTopUpContract", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventTopUpContract) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventTopUpContract) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventTopUpContract) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventTopUpContract) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventTopUpContract)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Provider)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ContractId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ContractId))
+		}
+		l = len(x.Service)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Client)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.OldExpiration != 0 {
+			n += 1 + runtime.Sov(uint64(x.OldExpiration))
+		}
+		if x.NewExpiration != 0 {
+			n += 1 + runtime.Sov(uint64(x.NewExpiration))
+		}
+		l = len(x.TopUp)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Deposit)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Balance)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventTopUpContract)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Balance) > 0 {
+			i -= len(x.Balance)
+			copy(dAtA[i:], x.Balance)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Balance)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if len(x.Deposit) > 0 {
+			i -= len(x.Deposit)
+			copy(dAtA[i:], x.Deposit)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Deposit)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if len(x.TopUp) > 0 {
+			i -= len(x.TopUp)
+			copy(dAtA[i:], x.TopUp)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TopUp)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if x.NewExpiration != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NewExpiration))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.OldExpiration != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OldExpiration))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.Client) > 0 {
+			i -= len(x.Client)
+			copy(dAtA[i:], x.Client)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Client)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Service) > 0 {
+			i -= len(x.Service)
+			copy(dAtA[i:], x.Service)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Service)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.ContractId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ContractId))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Provider) > 0 {
+			i -= len(x.Provider)
+			copy(dAtA[i:], x.Provider)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Provider)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventTopUpContract)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventTopUpContract: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventTopUpContract: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Provider = append(x.Provider[:0], dAtA[iNdEx:postIndex]...)
+				if x.Provider == nil {
+					x.Provider = []byte{}
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+				}
+				x.ContractId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ContractId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Service = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Client", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Client = append(x.Client[:0], dAtA[iNdEx:postIndex]...)
+				if x.Client == nil {
+					x.Client = []byte{}
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OldExpiration", wireType)
+				}
+				x.OldExpiration = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.OldExpiration |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NewExpiration", wireType)
+				}
+				x.NewExpiration = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NewExpiration |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TopUp", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TopUp = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Deposit = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Balance = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EventSlashProvider             protoreflect.MessageDescriptor
+	fd_EventSlashProvider_provider    protoreflect.FieldDescriptor
+	fd_EventSlashProvider_service     protoreflect.FieldDescriptor
+	fd_EventSlashProvider_contract_id protoreflect.FieldDescriptor
+	fd_EventSlashProvider_reason      protoreflect.FieldDescriptor
+	fd_EventSlashProvider_amount      protoreflect.FieldDescriptor
+	fd_EventSlashProvider_bond        protoreflect.FieldDescriptor
+	fd_EventSlashProvider_faults      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_arkeo_arkeo_events_proto_init()
+	md_EventSlashProvider = File_arkeo_arkeo_events_proto.Messages().ByName("EventSlashProvider")
+	fd_EventSlashProvider_provider = md_EventSlashProvider.Fields().ByName("provider")
+	fd_EventSlashProvider_service = md_EventSlashProvider.Fields().ByName("service")
+	fd_EventSlashProvider_contract_id = md_EventSlashProvider.Fields().ByName("contract_id")
+	fd_EventSlashProvider_reason = md_EventSlashProvider.Fields().ByName("reason")
+	fd_EventSlashProvider_amount = md_EventSlashProvider.Fields().ByName("amount")
+	fd_EventSlashProvider_bond = md_EventSlashProvider.Fields().ByName("bond")
+	fd_EventSlashProvider_faults = md_EventSlashProvider.Fields().ByName("faults")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventSlashProvider)(nil)
+
+type fastReflection_EventSlashProvider EventSlashProvider
+
+func (x *EventSlashProvider) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventSlashProvider)(x)
+}
+
+func (x *EventSlashProvider) slowProtoReflect() protoreflect.Message {
+	mi := &file_arkeo_arkeo_events_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventSlashProvider_messageType fastReflection_EventSlashProvider_messageType
+var _ protoreflect.MessageType = fastReflection_EventSlashProvider_messageType{}
+
+type fastReflection_EventSlashProvider_messageType struct{}
+
+func (x fastReflection_EventSlashProvider_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventSlashProvider)(nil)
+}
+func (x fastReflection_EventSlashProvider_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventSlashProvider)
+}
+func (x fastReflection_EventSlashProvider_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventSlashProvider
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventSlashProvider) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventSlashProvider
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventSlashProvider) Type() protoreflect.MessageType {
+	return _fastReflection_EventSlashProvider_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventSlashProvider) New() protoreflect.Message {
+	return new(fastReflection_EventSlashProvider)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventSlashProvider) Interface() protoreflect.ProtoMessage {
+	return (*EventSlashProvider)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventSlashProvider) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Provider) != 0 {
+		value := protoreflect.ValueOfBytes(x.Provider)
+		if !f(fd_EventSlashProvider_provider, value) {
+			return
+		}
+	}
+	if x.Service != "" {
+		value := protoreflect.ValueOfString(x.Service)
+		if !f(fd_EventSlashProvider_service, value) {
+			return
+		}
+	}
+	if x.ContractId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ContractId)
+		if !f(fd_EventSlashProvider_contract_id, value) {
+			return
+		}
+	}
+	if x.Reason != "" {
+		value := protoreflect.ValueOfString(x.Reason)
+		if !f(fd_EventSlashProvider_reason, value) {
+			return
+		}
+	}
+	if x.Amount != "" {
+		value := protoreflect.ValueOfString(x.Amount)
+		if !f(fd_EventSlashProvider_amount, value) {
+			return
+		}
+	}
+	if x.Bond != "" {
+		value := protoreflect.ValueOfString(x.Bond)
+		if !f(fd_EventSlashProvider_bond, value) {
+			return
+		}
+	}
+	if x.Faults != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Faults)
+		if !f(fd_EventSlashProvider_faults, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventSlashProvider) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "arkeo.arkeo.EventSlashProvider.provider":
+		return len(x.Provider) != 0
+	case "arkeo.arkeo.EventSlashProvider.service":
+		return x.Service != ""
+	case "arkeo.arkeo.EventSlashProvider.contract_id":
+		return x.ContractId != uint64(0)
+	case "arkeo.arkeo.EventSlashProvider.reason":
+		return x.Reason != ""
+	case "arkeo.arkeo.EventSlashProvider.amount":
+		return x.Amount != ""
+	case "arkeo.arkeo.EventSlashProvider.bond":
+		return x.Bond != ""
+	case "arkeo.arkeo.EventSlashProvider.faults":
+		return x.Faults != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventSlashProvider"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.EventSlashProvider does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventSlashProvider) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "arkeo.arkeo.EventSlashProvider.provider":
+		x.Provider = nil
+	case "arkeo.arkeo.EventSlashProvider.service":
+		x.Service = ""
+	case "arkeo.arkeo.EventSlashProvider.contract_id":
+		x.ContractId = uint64(0)
+	case "arkeo.arkeo.EventSlashProvider.reason":
+		x.Reason = ""
+	case "arkeo.arkeo.EventSlashProvider.amount":
+		x.Amount = ""
+	case "arkeo.arkeo.EventSlashProvider.bond":
+		x.Bond = ""
+	case "arkeo.arkeo.EventSlashProvider.faults":
+		x.Faults = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventSlashProvider"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.EventSlashProvider does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventSlashProvider) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "arkeo.arkeo.EventSlashProvider.provider":
+		value := x.Provider
+		return protoreflect.ValueOfBytes(value)
+	case "arkeo.arkeo.EventSlashProvider.service":
+		value := x.Service
+		return protoreflect.ValueOfString(value)
+	case "arkeo.arkeo.EventSlashProvider.contract_id":
+		value := x.ContractId
+		return protoreflect.ValueOfUint64(value)
+	case "arkeo.arkeo.EventSlashProvider.reason":
+		value := x.Reason
+		return protoreflect.ValueOfString(value)
+	case "arkeo.arkeo.EventSlashProvider.amount":
+		value := x.Amount
+		return protoreflect.ValueOfString(value)
+	case "arkeo.arkeo.EventSlashProvider.bond":
+		value := x.Bond
+		return protoreflect.ValueOfString(value)
+	case "arkeo.arkeo.EventSlashProvider.faults":
+		value := x.Faults
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventSlashProvider"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.EventSlashProvider does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventSlashProvider) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "arkeo.arkeo.EventSlashProvider.provider":
+		x.Provider = value.Bytes()
+	case "arkeo.arkeo.EventSlashProvider.service":
+		x.Service = value.Interface().(string)
+	case "arkeo.arkeo.EventSlashProvider.contract_id":
+		x.ContractId = value.Uint()
+	case "arkeo.arkeo.EventSlashProvider.reason":
+		x.Reason = value.Interface().(string)
+	case "arkeo.arkeo.EventSlashProvider.amount":
+		x.Amount = value.Interface().(string)
+	case "arkeo.arkeo.EventSlashProvider.bond":
+		x.Bond = value.Interface().(string)
+	case "arkeo.arkeo.EventSlashProvider.faults":
+		x.Faults = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventSlashProvider"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.EventSlashProvider does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventSlashProvider) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "arkeo.arkeo.EventSlashProvider.provider":
+		panic(fmt.Errorf("field provider of message arkeo.arkeo.EventSlashProvider is not mutable"))
+	case "arkeo.arkeo.EventSlashProvider.service":
+		panic(fmt.Errorf("field service of message arkeo.arkeo.EventSlashProvider is not mutable"))
+	case "arkeo.arkeo.EventSlashProvider.contract_id":
+		panic(fmt.Errorf("field contract_id of message arkeo.arkeo.EventSlashProvider is not mutable"))
+	case "arkeo.arkeo.EventSlashProvider.reason":
+		panic(fmt.Errorf("field reason of message arkeo.arkeo.EventSlashProvider is not mutable"))
+	case "arkeo.arkeo.EventSlashProvider.amount":
+		panic(fmt.Errorf("field amount of message arkeo.arkeo.EventSlashProvider is not mutable"))
+	case "arkeo.arkeo.EventSlashProvider.bond":
+		panic(fmt.Errorf("field bond of message arkeo.arkeo.EventSlashProvider is not mutable"))
+	case "arkeo.arkeo.EventSlashProvider.faults":
+		panic(fmt.Errorf("field faults of message arkeo.arkeo.EventSlashProvider is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventSlashProvider"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.EventSlashProvider does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventSlashProvider) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "arkeo.arkeo.EventSlashProvider.provider":
+		return protoreflect.ValueOfBytes(nil)
+	case "arkeo.arkeo.EventSlashProvider.service":
+		return protoreflect.ValueOfString("")
+	case "arkeo.arkeo.EventSlashProvider.contract_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "arkeo.arkeo.EventSlashProvider.reason":
+		return protoreflect.ValueOfString("")
+	case "arkeo.arkeo.EventSlashProvider.amount":
+		return protoreflect.ValueOfString("")
+	case "arkeo.arkeo.EventSlashProvider.bond":
+		return protoreflect.ValueOfString("")
+	case "arkeo.arkeo.EventSlashProvider.faults":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventSlashProvider"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.EventSlashProvider does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventSlashProvider) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in arkeo.arkeo.EventSlashProvider", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventSlashProvider) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventSlashProvider) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventSlashProvider) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventSlashProvider) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventSlashProvider)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Provider)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Service)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ContractId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ContractId))
+		}
+		l = len(x.Reason)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Amount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Bond)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Faults != 0 {
+			n += 1 + runtime.Sov(uint64(x.Faults))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventSlashProvider)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Faults != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Faults))
+			i--
+			dAtA[i] = 0x38
+		}
+		if len(x.Bond) > 0 {
+			i -= len(x.Bond)
+			copy(dAtA[i:], x.Bond)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Bond)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.Amount) > 0 {
+			i -= len(x.Amount)
+			copy(dAtA[i:], x.Amount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Amount)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.Reason) > 0 {
+			i -= len(x.Reason)
+			copy(dAtA[i:], x.Reason)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Reason)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.ContractId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ContractId))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Service) > 0 {
+			i -= len(x.Service)
+			copy(dAtA[i:], x.Service)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Service)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Provider) > 0 {
+			i -= len(x.Provider)
+			copy(dAtA[i:], x.Provider)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Provider)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventSlashProvider)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventSlashProvider: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventSlashProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Provider = append(x.Provider[:0], dAtA[iNdEx:postIndex]...)
+				if x.Provider == nil {
+					x.Provider = []byte{}
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Service = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+				}
+				x.ContractId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ContractId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Reason = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Amount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Bond", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Bond = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Faults", wireType)
+				}
+				x.Faults = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Faults |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EventValidatorPayout           protoreflect.MessageDescriptor
+	fd_EventValidatorPayout_validator protoreflect.FieldDescriptor
+	fd_EventValidatorPayout_reward    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_arkeo_arkeo_events_proto_init()
+	md_EventValidatorPayout = File_arkeo_arkeo_events_proto.Messages().ByName("EventValidatorPayout")
+	fd_EventValidatorPayout_validator = md_EventValidatorPayout.Fields().ByName("validator")
+	fd_EventValidatorPayout_reward = md_EventValidatorPayout.Fields().ByName("reward")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventValidatorPayout)(nil)
+
+type fastReflection_EventValidatorPayout EventValidatorPayout
+
+func (x *EventValidatorPayout) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventValidatorPayout)(x)
+}
+
+func (x *EventValidatorPayout) slowProtoReflect() protoreflect.Message {
+	mi := &file_arkeo_arkeo_events_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventValidatorPayout_messageType fastReflection_EventValidatorPayout_messageType
+var _ protoreflect.MessageType = fastReflection_EventValidatorPayout_messageType{}
+
+type fastReflection_EventValidatorPayout_messageType struct{}
+
+func (x fastReflection_EventValidatorPayout_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventValidatorPayout)(nil)
+}
+func (x fastReflection_EventValidatorPayout_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventValidatorPayout)
+}
+func (x fastReflection_EventValidatorPayout_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventValidatorPayout
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventValidatorPayout) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventValidatorPayout
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventValidatorPayout) Type() protoreflect.MessageType {
+	return _fastReflection_EventValidatorPayout_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventValidatorPayout) New() protoreflect.Message {
+	return new(fastReflection_EventValidatorPayout)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventValidatorPayout) Interface() protoreflect.ProtoMessage {
+	return (*EventValidatorPayout)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventValidatorPayout) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Validator) != 0 {
+		value := protoreflect.ValueOfBytes(x.Validator)
+		if !f(fd_EventValidatorPayout_validator, value) {
+			return
+		}
+	}
+	if x.Reward != "" {
+		value := protoreflect.ValueOfString(x.Reward)
+		if !f(fd_EventValidatorPayout_reward, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventValidatorPayout) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "arkeo.arkeo.EventValidatorPayout.validator":
+		return len(x.Validator) != 0
+	case "arkeo.arkeo.EventValidatorPayout.reward":
+		return x.Reward != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventValidatorPayout"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.EventValidatorPayout does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventValidatorPayout) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "arkeo.arkeo.EventValidatorPayout.validator":
+		x.Validator = nil
+	case "arkeo.arkeo.EventValidatorPayout.reward":
+		x.Reward = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventValidatorPayout"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.EventValidatorPayout does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventValidatorPayout) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "arkeo.arkeo.EventValidatorPayout.validator":
+		value := x.Validator
+		return protoreflect.ValueOfBytes(value)
+	case "arkeo.arkeo.EventValidatorPayout.reward":
+		value := x.Reward
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventValidatorPayout"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.EventValidatorPayout does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventValidatorPayout) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "arkeo.arkeo.EventValidatorPayout.validator":
+		x.Validator = value.Bytes()
+	case "arkeo.arkeo.EventValidatorPayout.reward":
+		x.Reward = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventValidatorPayout"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.EventValidatorPayout does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventValidatorPayout) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "arkeo.arkeo.EventValidatorPayout.validator":
+		panic(fmt.Errorf("field validator of message arkeo.arkeo.EventValidatorPayout is not mutable"))
+	case "arkeo.arkeo.EventValidatorPayout.reward":
+		panic(fmt.Errorf("field reward of message arkeo.arkeo.EventValidatorPayout is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventValidatorPayout"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.EventValidatorPayout does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventValidatorPayout) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "arkeo.arkeo.EventValidatorPayout.validator":
+		return protoreflect.ValueOfBytes(nil)
+	case "arkeo.arkeo.EventValidatorPayout.reward":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventValidatorPayout"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.EventValidatorPayout does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventValidatorPayout) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in arkeo.arkeo.EventValidatorPayout", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventValidatorPayout) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventValidatorPayout) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventValidatorPayout) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventValidatorPayout) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventValidatorPayout)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Validator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Reward)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventValidatorPayout)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Reward) > 0 {
+			i -= len(x.Reward)
+			copy(dAtA[i:], x.Reward)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Reward)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Validator) > 0 {
+			i -= len(x.Validator)
+			copy(dAtA[i:], x.Validator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Validator)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventValidatorPayout)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventValidatorPayout: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventValidatorPayout: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Validator = append(x.Validator[:0], dAtA[iNdEx:postIndex]...)
+				if x.Validator == nil {
+					x.Validator = []byte{}
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reward", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Reward = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_ParamChange           protoreflect.MessageDescriptor
+	fd_ParamChange_key       protoreflect.FieldDescriptor
+	fd_ParamChange_old_value protoreflect.FieldDescriptor
+	fd_ParamChange_new_value protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_arkeo_arkeo_events_proto_init()
+	md_ParamChange = File_arkeo_arkeo_events_proto.Messages().ByName("ParamChange")
+	fd_ParamChange_key = md_ParamChange.Fields().ByName("key")
+	fd_ParamChange_old_value = md_ParamChange.Fields().ByName("old_value")
+	fd_ParamChange_new_value = md_ParamChange.Fields().ByName("new_value")
+}
+
+var _ protoreflect.Message = (*fastReflection_ParamChange)(nil)
+
+type fastReflection_ParamChange ParamChange
+
+func (x *ParamChange) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ParamChange)(x)
+}
+
+func (x *ParamChange) slowProtoReflect() protoreflect.Message {
+	mi := &file_arkeo_arkeo_events_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ParamChange_messageType fastReflection_ParamChange_messageType
+var _ protoreflect.MessageType = fastReflection_ParamChange_messageType{}
+
+type fastReflection_ParamChange_messageType struct{}
+
+func (x fastReflection_ParamChange_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ParamChange)(nil)
+}
+func (x fastReflection_ParamChange_messageType) New() protoreflect.Message {
+	return new(fastReflection_ParamChange)
+}
+func (x fastReflection_ParamChange_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ParamChange
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ParamChange) Descriptor() protoreflect.MessageDescriptor {
+	return md_ParamChange
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ParamChange) Type() protoreflect.MessageType {
+	return _fastReflection_ParamChange_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ParamChange) New() protoreflect.Message {
+	return new(fastReflection_ParamChange)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ParamChange) Interface() protoreflect.ProtoMessage {
+	return (*ParamChange)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ParamChange) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Key != "" {
+		value := protoreflect.ValueOfString(x.Key)
+		if !f(fd_ParamChange_key, value) {
+			return
+		}
+	}
+	if x.OldValue != "" {
+		value := protoreflect.ValueOfString(x.OldValue)
+		if !f(fd_ParamChange_old_value, value) {
+			return
+		}
+	}
+	if x.NewValue != "" {
+		value := protoreflect.ValueOfString(x.NewValue)
+		if !f(fd_ParamChange_new_value, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ParamChange) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "arkeo.arkeo.ParamChange.key":
+		return x.Key != ""
+	case "arkeo.arkeo.ParamChange.old_value":
+		return x.OldValue != ""
+	case "arkeo.arkeo.ParamChange.new_value":
+		return x.NewValue != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.ParamChange"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.ParamChange does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ParamChange) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "arkeo.arkeo.ParamChange.key":
+		x.Key = ""
+	case "arkeo.arkeo.ParamChange.old_value":
+		x.OldValue = ""
+	case "arkeo.arkeo.ParamChange.new_value":
+		x.NewValue = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.ParamChange"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.ParamChange does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ParamChange) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "arkeo.arkeo.ParamChange.key":
+		value := x.Key
+		return protoreflect.ValueOfString(value)
+	case "arkeo.arkeo.ParamChange.old_value":
+		value := x.OldValue
+		return protoreflect.ValueOfString(value)
+	case "arkeo.arkeo.ParamChange.new_value":
+		value := x.NewValue
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.ParamChange"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.ParamChange does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ParamChange) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "arkeo.arkeo.ParamChange.key":
+		x.Key = value.Interface().(string)
+	case "arkeo.arkeo.ParamChange.old_value":
+		x.OldValue = value.Interface().(string)
+	case "arkeo.arkeo.ParamChange.new_value":
+		x.NewValue = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.ParamChange"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.ParamChange does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ParamChange) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "arkeo.arkeo.ParamChange.key":
+		panic(fmt.Errorf("field key of message arkeo.arkeo.ParamChange is not mutable"))
+	case "arkeo.arkeo.ParamChange.old_value":
+		panic(fmt.Errorf("field old_value of message arkeo.arkeo.ParamChange is not mutable"))
+	case "arkeo.arkeo.ParamChange.new_value":
+		panic(fmt.Errorf("field new_value of message arkeo.arkeo.ParamChange is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.ParamChange"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.ParamChange does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ParamChange) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "arkeo.arkeo.ParamChange.key":
+		return protoreflect.ValueOfString("")
+	case "arkeo.arkeo.ParamChange.old_value":
+		return protoreflect.ValueOfString("")
+	case "arkeo.arkeo.ParamChange.new_value":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.ParamChange"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.ParamChange does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ParamChange) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in arkeo.arkeo.ParamChange", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ParamChange) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ParamChange) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ParamChange) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ParamChange) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ParamChange)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Key)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.OldValue)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.NewValue)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ParamChange)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.NewValue) > 0 {
+			i -= len(x.NewValue)
+			copy(dAtA[i:], x.NewValue)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NewValue)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.OldValue) > 0 {
+			i -= len(x.OldValue)
+			copy(dAtA[i:], x.OldValue)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.OldValue)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Key) > 0 {
+			i -= len(x.Key)
+			copy(dAtA[i:], x.Key)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Key)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ParamChange)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ParamChange: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ParamChange: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Key = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OldValue", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.OldValue = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NewValue", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.NewValue = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_EventParamsUpdated_2_list)(nil)
+
+type _EventParamsUpdated_2_list struct {
+	list *[]*ParamChange
+}
+
+func (x *_EventParamsUpdated_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_EventParamsUpdated_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_EventParamsUpdated_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ParamChange)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_EventParamsUpdated_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ParamChange)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_EventParamsUpdated_2_list) AppendMutable() protoreflect.Value {
+	v := new(ParamChange)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_EventParamsUpdated_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_EventParamsUpdated_2_list) NewElement() protoreflect.Value {
+	v := new(ParamChange)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_EventParamsUpdated_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_EventParamsUpdated         protoreflect.MessageDescriptor
+	fd_EventParamsUpdated_height  protoreflect.FieldDescriptor
+	fd_EventParamsUpdated_changes protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_arkeo_arkeo_events_proto_init()
+	md_EventParamsUpdated = File_arkeo_arkeo_events_proto.Messages().ByName("EventParamsUpdated")
+	fd_EventParamsUpdated_height = md_EventParamsUpdated.Fields().ByName("height")
+	fd_EventParamsUpdated_changes = md_EventParamsUpdated.Fields().ByName("changes")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventParamsUpdated)(nil)
+
+type fastReflection_EventParamsUpdated EventParamsUpdated
+
+func (x *EventParamsUpdated) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventParamsUpdated)(x)
+}
+
+func (x *EventParamsUpdated) slowProtoReflect() protoreflect.Message {
+	mi := &file_arkeo_arkeo_events_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventParamsUpdated_messageType fastReflection_EventParamsUpdated_messageType
+var _ protoreflect.MessageType = fastReflection_EventParamsUpdated_messageType{}
+
+type fastReflection_EventParamsUpdated_messageType struct{}
+
+func (x fastReflection_EventParamsUpdated_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventParamsUpdated)(nil)
+}
+func (x fastReflection_EventParamsUpdated_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventParamsUpdated)
+}
+func (x fastReflection_EventParamsUpdated_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventParamsUpdated
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventParamsUpdated) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventParamsUpdated
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventParamsUpdated) Type() protoreflect.MessageType {
+	return _fastReflection_EventParamsUpdated_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventParamsUpdated) New() protoreflect.Message {
+	return new(fastReflection_EventParamsUpdated)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventParamsUpdated) Interface() protoreflect.ProtoMessage {
+	return (*EventParamsUpdated)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventParamsUpdated) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Height != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Height)
+		if !f(fd_EventParamsUpdated_height, value) {
+			return
+		}
+	}
+	if len(x.Changes) != 0 {
+		value := protoreflect.ValueOfList(&_EventParamsUpdated_2_list{list: &x.Changes})
+		if !f(fd_EventParamsUpdated_changes, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventParamsUpdated) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "arkeo.arkeo.EventParamsUpdated.height":
+		return x.Height != int64(0)
+	case "arkeo.arkeo.EventParamsUpdated.changes":
+		return len(x.Changes) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventParamsUpdated"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.EventParamsUpdated does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventParamsUpdated) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "arkeo.arkeo.EventParamsUpdated.height":
+		x.Height = int64(0)
+	case "arkeo.arkeo.EventParamsUpdated.changes":
+		x.Changes = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventParamsUpdated"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.EventParamsUpdated does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventParamsUpdated) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "arkeo.arkeo.EventParamsUpdated.height":
+		value := x.Height
+		return protoreflect.ValueOfInt64(value)
+	case "arkeo.arkeo.EventParamsUpdated.changes":
+		if len(x.Changes) == 0 {
+			return protoreflect.ValueOfList(&_EventParamsUpdated_2_list{})
+		}
+		listValue := &_EventParamsUpdated_2_list{list: &x.Changes}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventParamsUpdated"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.EventParamsUpdated does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventParamsUpdated) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "arkeo.arkeo.EventParamsUpdated.height":
+		x.Height = value.Int()
+	case "arkeo.arkeo.EventParamsUpdated.changes":
+		lv := value.List()
+		clv := lv.(*_EventParamsUpdated_2_list)
+		x.Changes = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventParamsUpdated"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.EventParamsUpdated does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventParamsUpdated) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "arkeo.arkeo.EventParamsUpdated.changes":
+		if x.Changes == nil {
+			x.Changes = []*ParamChange{}
+		}
+		value := &_EventParamsUpdated_2_list{list: &x.Changes}
+		return protoreflect.ValueOfList(value)
+	case "arkeo.arkeo.EventParamsUpdated.height":
+		panic(fmt.Errorf("field height of message arkeo.arkeo.EventParamsUpdated is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventParamsUpdated"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.EventParamsUpdated does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventParamsUpdated) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "arkeo.arkeo.EventParamsUpdated.height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "arkeo.arkeo.EventParamsUpdated.changes":
+		list := []*ParamChange{}
+		return protoreflect.ValueOfList(&_EventParamsUpdated_2_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventParamsUpdated"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.EventParamsUpdated does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventParamsUpdated) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in arkeo.arkeo.EventParamsUpdated", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventParamsUpdated) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventParamsUpdated) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventParamsUpdated) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventParamsUpdated) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventParamsUpdated)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
+		}
+		if len(x.Changes) > 0 {
+			for _, e := range x.Changes {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventParamsUpdated)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Changes) > 0 {
+			for iNdEx := len(x.Changes) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Changes[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventParamsUpdated)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventParamsUpdated: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventParamsUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				}
+				x.Height = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Height |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Changes = append(x.Changes, &ParamChange{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Changes[len(x.Changes)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EventContractExpired                    protoreflect.MessageDescriptor
+	fd_EventContractExpired_contract_id        protoreflect.FieldDescriptor
+	fd_EventContractExpired_provider           protoreflect.FieldDescriptor
+	fd_EventContractExpired_service            protoreflect.FieldDescriptor
+	fd_EventContractExpired_client             protoreflect.FieldDescriptor
+	fd_EventContractExpired_type               protoreflect.FieldDescriptor
+	fd_EventContractExpired_expiration         protoreflect.FieldDescriptor
+	fd_EventContractExpired_settlement_pending protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_arkeo_arkeo_events_proto_init()
+	md_EventContractExpired = File_arkeo_arkeo_events_proto.Messages().ByName("EventContractExpired")
+	fd_EventContractExpired_contract_id = md_EventContractExpired.Fields().ByName("contract_id")
+	fd_EventContractExpired_provider = md_EventContractExpired.Fields().ByName("provider")
+	fd_EventContractExpired_service = md_EventContractExpired.Fields().ByName("service")
+	fd_EventContractExpired_client = md_EventContractExpired.Fields().ByName("client")
+	fd_EventContractExpired_type = md_EventContractExpired.Fields().ByName("type")
+	fd_EventContractExpired_expiration = md_EventContractExpired.Fields().ByName("expiration")
+	fd_EventContractExpired_settlement_pending = md_EventContractExpired.Fields().ByName("settlement_pending")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventContractExpired)(nil)
+
+type fastReflection_EventContractExpired EventContractExpired
+
+func (x *EventContractExpired) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventContractExpired)(x)
+}
+
+func (x *EventContractExpired) slowProtoReflect() protoreflect.Message {
+	mi := &file_arkeo_arkeo_events_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventContractExpired_messageType fastReflection_EventContractExpired_messageType
+var _ protoreflect.MessageType = fastReflection_EventContractExpired_messageType{}
+
+type fastReflection_EventContractExpired_messageType struct{}
+
+func (x fastReflection_EventContractExpired_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventContractExpired)(nil)
+}
+func (x fastReflection_EventContractExpired_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventContractExpired)
+}
+func (x fastReflection_EventContractExpired_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventContractExpired
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventContractExpired) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventContractExpired
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventContractExpired) Type() protoreflect.MessageType {
+	return _fastReflection_EventContractExpired_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventContractExpired) New() protoreflect.Message {
+	return new(fastReflection_EventContractExpired)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventContractExpired) Interface() protoreflect.ProtoMessage {
+	return (*EventContractExpired)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventContractExpired) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ContractId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ContractId)
+		if !f(fd_EventContractExpired_contract_id, value) {
+			return
+		}
+	}
+	if len(x.Provider) != 0 {
+		value := protoreflect.ValueOfBytes(x.Provider)
+		if !f(fd_EventContractExpired_provider, value) {
+			return
+		}
+	}
+	if x.Service != "" {
+		value := protoreflect.ValueOfString(x.Service)
+		if !f(fd_EventContractExpired_service, value) {
+			return
+		}
+	}
+	if len(x.Client) != 0 {
+		value := protoreflect.ValueOfBytes(x.Client)
+		if !f(fd_EventContractExpired_client, value) {
+			return
+		}
+	}
+	if x.Type_ != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Type_))
+		if !f(fd_EventContractExpired_type, value) {
+			return
+		}
+	}
+	if x.Expiration != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Expiration)
+		if !f(fd_EventContractExpired_expiration, value) {
+			return
+		}
+	}
+	if x.SettlementPending != false {
+		value := protoreflect.ValueOfBool(x.SettlementPending)
+		if !f(fd_EventContractExpired_settlement_pending, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventContractExpired) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "arkeo.arkeo.EventContractExpired.contract_id":
+		return x.ContractId != uint64(0)
+	case "arkeo.arkeo.EventContractExpired.provider":
+		return len(x.Provider) != 0
+	case "arkeo.arkeo.EventContractExpired.service":
+		return x.Service != ""
+	case "arkeo.arkeo.EventContractExpired.client":
+		return len(x.Client) != 0
+	case "arkeo.arkeo.EventContractExpired.type":
+		return x.Type_ != 0
+	case "arkeo.arkeo.EventContractExpired.expiration":
+		return x.Expiration != int64(0)
+	case "arkeo.arkeo.EventContractExpired.settlement_pending":
+		return x.SettlementPending != false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventContractExpired"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.EventContractExpired does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventContractExpired) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "arkeo.arkeo.EventContractExpired.contract_id":
+		x.ContractId = uint64(0)
+	case "arkeo.arkeo.EventContractExpired.provider":
+		x.Provider = nil
+	case "arkeo.arkeo.EventContractExpired.service":
+		x.Service = ""
+	case "arkeo.arkeo.EventContractExpired.client":
+		x.Client = nil
+	case "arkeo.arkeo.EventContractExpired.type":
+		x.Type_ = 0
+	case "arkeo.arkeo.EventContractExpired.expiration":
+		x.Expiration = int64(0)
+	case "arkeo.arkeo.EventContractExpired.settlement_pending":
+		x.SettlementPending = false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventContractExpired"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.EventContractExpired does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventContractExpired) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "arkeo.arkeo.EventContractExpired.contract_id":
+		value := x.ContractId
+		return protoreflect.ValueOfUint64(value)
+	case "arkeo.arkeo.EventContractExpired.provider":
+		value := x.Provider
+		return protoreflect.ValueOfBytes(value)
+	case "arkeo.arkeo.EventContractExpired.service":
+		value := x.Service
+		return protoreflect.ValueOfString(value)
+	case "arkeo.arkeo.EventContractExpired.client":
+		value := x.Client
+		return protoreflect.ValueOfBytes(value)
+	case "arkeo.arkeo.EventContractExpired.type":
+		value := x.Type_
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "arkeo.arkeo.EventContractExpired.expiration":
+		value := x.Expiration
+		return protoreflect.ValueOfInt64(value)
+	case "arkeo.arkeo.EventContractExpired.settlement_pending":
+		value := x.SettlementPending
+		return protoreflect.ValueOfBool(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventContractExpired"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.EventContractExpired does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventContractExpired) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "arkeo.arkeo.EventContractExpired.contract_id":
+		x.ContractId = value.Uint()
+	case "arkeo.arkeo.EventContractExpired.provider":
+		x.Provider = value.Bytes()
+	case "arkeo.arkeo.EventContractExpired.service":
+		x.Service = value.Interface().(string)
+	case "arkeo.arkeo.EventContractExpired.client":
+		x.Client = value.Bytes()
+	case "arkeo.arkeo.EventContractExpired.type":
+		x.Type_ = (ContractType)(value.Enum())
+	case "arkeo.arkeo.EventContractExpired.expiration":
+		x.Expiration = value.Int()
+	case "arkeo.arkeo.EventContractExpired.settlement_pending":
+		x.SettlementPending = value.Bool()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventContractExpired"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.EventContractExpired does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventContractExpired) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "arkeo.arkeo.EventContractExpired.contract_id":
+		panic(fmt.Errorf("field contract_id of message arkeo.arkeo.EventContractExpired is not mutable"))
+	case "arkeo.arkeo.EventContractExpired.provider":
+		panic(fmt.Errorf("field provider of message arkeo.arkeo.EventContractExpired is not mutable"))
+	case "arkeo.arkeo.EventContractExpired.service":
+		panic(fmt.Errorf("field service of message arkeo.arkeo.EventContractExpired is not mutable"))
+	case "arkeo.arkeo.EventContractExpired.client":
+		panic(fmt.Errorf("field client of message arkeo.arkeo.EventContractExpired is not mutable"))
+	case "arkeo.arkeo.EventContractExpired.type":
+		panic(fmt.Errorf("field type of message arkeo.arkeo.EventContractExpired is not mutable"))
+	case "arkeo.arkeo.EventContractExpired.expiration":
+		panic(fmt.Errorf("field expiration of message arkeo.arkeo.EventContractExpired is not mutable"))
+	case "arkeo.arkeo.EventContractExpired.settlement_pending":
+		panic(fmt.Errorf("field settlement_pending of message arkeo.arkeo.EventContractExpired is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventContractExpired"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.EventContractExpired does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventContractExpired) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "arkeo.arkeo.EventContractExpired.contract_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "arkeo.arkeo.EventContractExpired.provider":
+		return protoreflect.ValueOfBytes(nil)
+	case "arkeo.arkeo.EventContractExpired.service":
+		return protoreflect.ValueOfString("")
+	case "arkeo.arkeo.EventContractExpired.client":
+		return protoreflect.ValueOfBytes(nil)
+	case "arkeo.arkeo.EventContractExpired.type":
+		return protoreflect.ValueOfEnum(0)
+	case "arkeo.arkeo.EventContractExpired.expiration":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "arkeo.arkeo.EventContractExpired.settlement_pending":
+		return protoreflect.ValueOfBool(false)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventContractExpired"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.EventContractExpired does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventContractExpired) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in arkeo.arkeo.EventContractExpired", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventContractExpired) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventContractExpired) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventContractExpired) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventContractExpired) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventContractExpired)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.ContractId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ContractId))
+		}
+		l = len(x.Provider)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Service)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Client)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Type_ != 0 {
+			n += 1 + runtime.Sov(uint64(x.Type_))
+		}
+		if x.Expiration != 0 {
+			n += 1 + runtime.Sov(uint64(x.Expiration))
+		}
+		if x.SettlementPending {
+			n += 2
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventContractExpired)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.SettlementPending {
+			i--
+			if x.SettlementPending {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.Expiration != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Expiration))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.Type_ != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Type_))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.Client) > 0 {
+			i -= len(x.Client)
+			copy(dAtA[i:], x.Client)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Client)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Service) > 0 {
+			i -= len(x.Service)
+			copy(dAtA[i:], x.Service)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Service)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Provider) > 0 {
+			i -= len(x.Provider)
+			copy(dAtA[i:], x.Provider)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Provider)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.ContractId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ContractId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventContractExpired)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventContractExpired: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventContractExpired: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+				}
+				x.ContractId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ContractId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Provider = append(x.Provider[:0], dAtA[iNdEx:postIndex]...)
+				if x.Provider == nil {
+					x.Provider = []byte{}
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Service = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Client", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Client = append(x.Client[:0], dAtA[iNdEx:postIndex]...)
+				if x.Client == nil {
+					x.Client = []byte{}
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Type_", wireType)
+				}
+				x.Type_ = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Type_ |= ContractType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+				}
+				x.Expiration = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Expiration |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SettlementPending", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.SettlementPending = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EventContractAutoRenewed                protoreflect.MessageDescriptor
+	fd_EventContractAutoRenewed_contract_id    protoreflect.FieldDescriptor
+	fd_EventContractAutoRenewed_provider       protoreflect.FieldDescriptor
+	fd_EventContractAutoRenewed_service        protoreflect.FieldDescriptor
+	fd_EventContractAutoRenewed_client         protoreflect.FieldDescriptor
+	fd_EventContractAutoRenewed_old_expiration protoreflect.FieldDescriptor
+	fd_EventContractAutoRenewed_new_expiration protoreflect.FieldDescriptor
+	fd_EventContractAutoRenewed_spent          protoreflect.FieldDescriptor
+	fd_EventContractAutoRenewed_deposit        protoreflect.FieldDescriptor
+	fd_EventContractAutoRenewed_balance        protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_arkeo_arkeo_events_proto_init()
+	md_EventContractAutoRenewed = File_arkeo_arkeo_events_proto.Messages().ByName("EventContractAutoRenewed")
+	fd_EventContractAutoRenewed_contract_id = md_EventContractAutoRenewed.Fields().ByName("contract_id")
+	fd_EventContractAutoRenewed_provider = md_EventContractAutoRenewed.Fields().ByName("provider")
+	fd_EventContractAutoRenewed_service = md_EventContractAutoRenewed.Fields().ByName("service")
+	fd_EventContractAutoRenewed_client = md_EventContractAutoRenewed.Fields().ByName("client")
+	fd_EventContractAutoRenewed_old_expiration = md_EventContractAutoRenewed.Fields().ByName("old_expiration")
+	fd_EventContractAutoRenewed_new_expiration = md_EventContractAutoRenewed.Fields().ByName("new_expiration")
+	fd_EventContractAutoRenewed_spent = md_EventContractAutoRenewed.Fields().ByName("spent")
+	fd_EventContractAutoRenewed_deposit = md_EventContractAutoRenewed.Fields().ByName("deposit")
+	fd_EventContractAutoRenewed_balance = md_EventContractAutoRenewed.Fields().ByName("balance")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventContractAutoRenewed)(nil)
+
+type fastReflection_EventContractAutoRenewed EventContractAutoRenewed
+
+func (x *EventContractAutoRenewed) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventContractAutoRenewed)(x)
+}
+
+func (x *EventContractAutoRenewed) slowProtoReflect() protoreflect.Message {
+	mi := &file_arkeo_arkeo_events_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventContractAutoRenewed_messageType fastReflection_EventContractAutoRenewed_messageType
+var _ protoreflect.MessageType = fastReflection_EventContractAutoRenewed_messageType{}
+
+type fastReflection_EventContractAutoRenewed_messageType struct{}
+
+func (x fastReflection_EventContractAutoRenewed_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventContractAutoRenewed)(nil)
+}
+func (x fastReflection_EventContractAutoRenewed_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventContractAutoRenewed)
+}
+func (x fastReflection_EventContractAutoRenewed_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventContractAutoRenewed
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventContractAutoRenewed) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventContractAutoRenewed
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventContractAutoRenewed) Type() protoreflect.MessageType {
+	return _fastReflection_EventContractAutoRenewed_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventContractAutoRenewed) New() protoreflect.Message {
+	return new(fastReflection_EventContractAutoRenewed)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventContractAutoRenewed) Interface() protoreflect.ProtoMessage {
+	return (*EventContractAutoRenewed)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventContractAutoRenewed) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ContractId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ContractId)
+		if !f(fd_EventContractAutoRenewed_contract_id, value) {
+			return
+		}
+	}
+	if len(x.Provider) != 0 {
+		value := protoreflect.ValueOfBytes(x.Provider)
+		if !f(fd_EventContractAutoRenewed_provider, value) {
+			return
+		}
+	}
+	if x.Service != "" {
+		value := protoreflect.ValueOfString(x.Service)
+		if !f(fd_EventContractAutoRenewed_service, value) {
+			return
+		}
+	}
+	if len(x.Client) != 0 {
+		value := protoreflect.ValueOfBytes(x.Client)
+		if !f(fd_EventContractAutoRenewed_client, value) {
+			return
+		}
+	}
+	if x.OldExpiration != int64(0) {
+		value := protoreflect.ValueOfInt64(x.OldExpiration)
+		if !f(fd_EventContractAutoRenewed_old_expiration, value) {
+			return
+		}
+	}
+	if x.NewExpiration != int64(0) {
+		value := protoreflect.ValueOfInt64(x.NewExpiration)
+		if !f(fd_EventContractAutoRenewed_new_expiration, value) {
+			return
+		}
+	}
+	if x.Spent != "" {
+		value := protoreflect.ValueOfString(x.Spent)
+		if !f(fd_EventContractAutoRenewed_spent, value) {
+			return
+		}
+	}
+	if x.Deposit != "" {
+		value := protoreflect.ValueOfString(x.Deposit)
+		if !f(fd_EventContractAutoRenewed_deposit, value) {
+			return
+		}
+	}
+	if x.Balance != "" {
+		value := protoreflect.ValueOfString(x.Balance)
+		if !f(fd_EventContractAutoRenewed_balance, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventContractAutoRenewed) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "arkeo.arkeo.EventContractAutoRenewed.contract_id":
+		return x.ContractId != uint64(0)
+	case "arkeo.arkeo.EventContractAutoRenewed.provider":
+		return len(x.Provider) != 0
+	case "arkeo.arkeo.EventContractAutoRenewed.service":
+		return x.Service != ""
+	case "arkeo.arkeo.EventContractAutoRenewed.client":
+		return len(x.Client) != 0
+	case "arkeo.arkeo.EventContractAutoRenewed.old_expiration":
+		return x.OldExpiration != int64(0)
+	case "arkeo.arkeo.EventContractAutoRenewed.new_expiration":
+		return x.NewExpiration != int64(0)
+	case "arkeo.arkeo.EventContractAutoRenewed.spent":
+		return x.Spent != ""
+	case "arkeo.arkeo.EventContractAutoRenewed.deposit":
+		return x.Deposit != ""
+	case "arkeo.arkeo.EventContractAutoRenewed.balance":
+		return x.Balance != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventContractAutoRenewed"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.EventContractAutoRenewed does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventContractAutoRenewed) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "arkeo.arkeo.EventContractAutoRenewed.contract_id":
+		x.ContractId = uint64(0)
+	case "arkeo.arkeo.EventContractAutoRenewed.provider":
+		x.Provider = nil
+	case "arkeo.arkeo.EventContractAutoRenewed.service":
+		x.Service = ""
+	case "arkeo.arkeo.EventContractAutoRenewed.client":
+		x.Client = nil
+	case "arkeo.arkeo.EventContractAutoRenewed.old_expiration":
+		x.OldExpiration = int64(0)
+	case "arkeo.arkeo.EventContractAutoRenewed.new_expiration":
+		x.NewExpiration = int64(0)
+	case "arkeo.arkeo.EventContractAutoRenewed.spent":
+		x.Spent = ""
+	case "arkeo.arkeo.EventContractAutoRenewed.deposit":
+		x.Deposit = ""
+	case "arkeo.arkeo.EventContractAutoRenewed.balance":
+		x.Balance = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventContractAutoRenewed"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.EventContractAutoRenewed does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventContractAutoRenewed) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "arkeo.arkeo.EventContractAutoRenewed.contract_id":
+		value := x.ContractId
+		return protoreflect.ValueOfUint64(value)
+	case "arkeo.arkeo.EventContractAutoRenewed.provider":
+		value := x.Provider
+		return protoreflect.ValueOfBytes(value)
+	case "arkeo.arkeo.EventContractAutoRenewed.service":
+		value := x.Service
+		return protoreflect.ValueOfString(value)
+	case "arkeo.arkeo.EventContractAutoRenewed.client":
+		value := x.Client
+		return protoreflect.ValueOfBytes(value)
+	case "arkeo.arkeo.EventContractAutoRenewed.old_expiration":
+		value := x.OldExpiration
+		return protoreflect.ValueOfInt64(value)
+	case "arkeo.arkeo.EventContractAutoRenewed.new_expiration":
+		value := x.NewExpiration
+		return protoreflect.ValueOfInt64(value)
+	case "arkeo.arkeo.EventContractAutoRenewed.spent":
+		value := x.Spent
+		return protoreflect.ValueOfString(value)
+	case "arkeo.arkeo.EventContractAutoRenewed.deposit":
+		value := x.Deposit
+		return protoreflect.ValueOfString(value)
+	case "arkeo.arkeo.EventContractAutoRenewed.balance":
+		value := x.Balance
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventContractAutoRenewed"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.EventContractAutoRenewed does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventContractAutoRenewed) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "arkeo.arkeo.EventContractAutoRenewed.contract_id":
+		x.ContractId = value.Uint()
+	case "arkeo.arkeo.EventContractAutoRenewed.provider":
+		x.Provider = value.Bytes()
+	case "arkeo.arkeo.EventContractAutoRenewed.service":
+		x.Service = value.Interface().(string)
+	case "arkeo.arkeo.EventContractAutoRenewed.client":
+		x.Client = value.Bytes()
+	case "arkeo.arkeo.EventContractAutoRenewed.old_expiration":
+		x.OldExpiration = value.Int()
+	case "arkeo.arkeo.EventContractAutoRenewed.new_expiration":
+		x.NewExpiration = value.Int()
+	case "arkeo.arkeo.EventContractAutoRenewed.spent":
+		x.Spent = value.Interface().(string)
+	case "arkeo.arkeo.EventContractAutoRenewed.deposit":
+		x.Deposit = value.Interface().(string)
+	case "arkeo.arkeo.EventContractAutoRenewed.balance":
+		x.Balance = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventContractAutoRenewed"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.EventContractAutoRenewed does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventContractAutoRenewed) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "arkeo.arkeo.EventContractAutoRenewed.contract_id":
+		panic(fmt.Errorf("field contract_id of message arkeo.arkeo.EventContractAutoRenewed is not mutable"))
+	case "arkeo.arkeo.EventContractAutoRenewed.provider":
+		panic(fmt.Errorf("field provider of message arkeo.arkeo.EventContractAutoRenewed is not mutable"))
+	case "arkeo.arkeo.EventContractAutoRenewed.service":
+		panic(fmt.Errorf("field service of message arkeo.arkeo.EventContractAutoRenewed is not mutable"))
+	case "arkeo.arkeo.EventContractAutoRenewed.client":
+		panic(fmt.Errorf("field client of message arkeo.arkeo.EventContractAutoRenewed is not mutable"))
+	case "arkeo.arkeo.EventContractAutoRenewed.old_expiration":
+		panic(fmt.Errorf("field old_expiration of message arkeo.arkeo.EventContractAutoRenewed is not mutable"))
+	case "arkeo.arkeo.EventContractAutoRenewed.new_expiration":
+		panic(fmt.Errorf("field new_expiration of message arkeo.arkeo.EventContractAutoRenewed is not mutable"))
+	case "arkeo.arkeo.EventContractAutoRenewed.spent":
+		panic(fmt.Errorf("field spent of message arkeo.arkeo.EventContractAutoRenewed is not mutable"))
+	case "arkeo.arkeo.EventContractAutoRenewed.deposit":
+		panic(fmt.Errorf("field deposit of message arkeo.arkeo.EventContractAutoRenewed is not mutable"))
+	case "arkeo.arkeo.EventContractAutoRenewed.balance":
+		panic(fmt.Errorf("field balance of message arkeo.arkeo.EventContractAutoRenewed is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventContractAutoRenewed"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.EventContractAutoRenewed does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventContractAutoRenewed) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "arkeo.arkeo.EventContractAutoRenewed.contract_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "arkeo.arkeo.EventContractAutoRenewed.provider":
+		return protoreflect.ValueOfBytes(nil)
+	case "arkeo.arkeo.EventContractAutoRenewed.service":
+		return protoreflect.ValueOfString("")
+	case "arkeo.arkeo.EventContractAutoRenewed.client":
+		return protoreflect.ValueOfBytes(nil)
+	case "arkeo.arkeo.EventContractAutoRenewed.old_expiration":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "arkeo.arkeo.EventContractAutoRenewed.new_expiration":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "arkeo.arkeo.EventContractAutoRenewed.spent":
+		return protoreflect.ValueOfString("")
+	case "arkeo.arkeo.EventContractAutoRenewed.deposit":
+		return protoreflect.ValueOfString("")
+	case "arkeo.arkeo.EventContractAutoRenewed.balance":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventContractAutoRenewed"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.EventContractAutoRenewed does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventContractAutoRenewed) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in arkeo.arkeo.EventContractAutoRenewed", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventContractAutoRenewed) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventContractAutoRenewed) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventContractAutoRenewed) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventContractAutoRenewed) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventContractAutoRenewed)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.ContractId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ContractId))
+		}
+		l = len(x.Provider)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Service)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Client)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.OldExpiration != 0 {
+			n += 1 + runtime.Sov(uint64(x.OldExpiration))
+		}
+		if x.NewExpiration != 0 {
+			n += 1 + runtime.Sov(uint64(x.NewExpiration))
+		}
+		l = len(x.Spent)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Deposit)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Balance)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventContractAutoRenewed)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Balance) > 0 {
+			i -= len(x.Balance)
+			copy(dAtA[i:], x.Balance)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Balance)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if len(x.Deposit) > 0 {
+			i -= len(x.Deposit)
+			copy(dAtA[i:], x.Deposit)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Deposit)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if len(x.Spent) > 0 {
+			i -= len(x.Spent)
+			copy(dAtA[i:], x.Spent)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Spent)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if x.NewExpiration != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NewExpiration))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.OldExpiration != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OldExpiration))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.Client) > 0 {
+			i -= len(x.Client)
+			copy(dAtA[i:], x.Client)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Client)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Service) > 0 {
+			i -= len(x.Service)
+			copy(dAtA[i:], x.Service)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Service)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Provider) > 0 {
+			i -= len(x.Provider)
+			copy(dAtA[i:], x.Provider)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Provider)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.ContractId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ContractId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventContractAutoRenewed)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventContractAutoRenewed: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventContractAutoRenewed: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+				}
+				x.ContractId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ContractId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Provider = append(x.Provider[:0], dAtA[iNdEx:postIndex]...)
+				if x.Provider == nil {
+					x.Provider = []byte{}
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Service = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Client", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Client = append(x.Client[:0], dAtA[iNdEx:postIndex]...)
+				if x.Client == nil {
+					x.Client = []byte{}
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OldExpiration", wireType)
+				}
+				x.OldExpiration = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.OldExpiration |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NewExpiration", wireType)
+				}
+				x.NewExpiration = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NewExpiration |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Spent", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Spent = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Deposit = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Balance = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_EventClaimContractIncomeBatch_2_list)(nil)
+
+type _EventClaimContractIncomeBatch_2_list struct {
+	list *[]*ContractClaimResult
+}
+
+func (x *_EventClaimContractIncomeBatch_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_EventClaimContractIncomeBatch_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_EventClaimContractIncomeBatch_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ContractClaimResult)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_EventClaimContractIncomeBatch_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ContractClaimResult)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_EventClaimContractIncomeBatch_2_list) AppendMutable() protoreflect.Value {
+	v := new(ContractClaimResult)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_EventClaimContractIncomeBatch_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_EventClaimContractIncomeBatch_2_list) NewElement() protoreflect.Value {
+	v := new(ContractClaimResult)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_EventClaimContractIncomeBatch_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_EventClaimContractIncomeBatch         protoreflect.MessageDescriptor
+	fd_EventClaimContractIncomeBatch_creator protoreflect.FieldDescriptor
+	fd_EventClaimContractIncomeBatch_results protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_arkeo_arkeo_events_proto_init()
+	md_EventClaimContractIncomeBatch = File_arkeo_arkeo_events_proto.Messages().ByName("EventClaimContractIncomeBatch")
+	fd_EventClaimContractIncomeBatch_creator = md_EventClaimContractIncomeBatch.Fields().ByName("creator")
+	fd_EventClaimContractIncomeBatch_results = md_EventClaimContractIncomeBatch.Fields().ByName("results")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventClaimContractIncomeBatch)(nil)
+
+type fastReflection_EventClaimContractIncomeBatch EventClaimContractIncomeBatch
+
+func (x *EventClaimContractIncomeBatch) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventClaimContractIncomeBatch)(x)
+}
+
+func (x *EventClaimContractIncomeBatch) slowProtoReflect() protoreflect.Message {
+	mi := &file_arkeo_arkeo_events_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventClaimContractIncomeBatch_messageType fastReflection_EventClaimContractIncomeBatch_messageType
+var _ protoreflect.MessageType = fastReflection_EventClaimContractIncomeBatch_messageType{}
+
+type fastReflection_EventClaimContractIncomeBatch_messageType struct{}
+
+func (x fastReflection_EventClaimContractIncomeBatch_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventClaimContractIncomeBatch)(nil)
+}
+func (x fastReflection_EventClaimContractIncomeBatch_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventClaimContractIncomeBatch)
+}
+func (x fastReflection_EventClaimContractIncomeBatch_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventClaimContractIncomeBatch
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventClaimContractIncomeBatch) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventClaimContractIncomeBatch
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventClaimContractIncomeBatch) Type() protoreflect.MessageType {
+	return _fastReflection_EventClaimContractIncomeBatch_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventClaimContractIncomeBatch) New() protoreflect.Message {
+	return new(fastReflection_EventClaimContractIncomeBatch)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventClaimContractIncomeBatch) Interface() protoreflect.ProtoMessage {
+	return (*EventClaimContractIncomeBatch)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventClaimContractIncomeBatch) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Creator != "" {
+		value := protoreflect.ValueOfString(x.Creator)
+		if !f(fd_EventClaimContractIncomeBatch_creator, value) {
+			return
+		}
+	}
+	if len(x.Results) != 0 {
+		value := protoreflect.ValueOfList(&_EventClaimContractIncomeBatch_2_list{list: &x.Results})
+		if !f(fd_EventClaimContractIncomeBatch_results, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventClaimContractIncomeBatch) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "arkeo.arkeo.EventClaimContractIncomeBatch.creator":
+		return x.Creator != ""
+	case "arkeo.arkeo.EventClaimContractIncomeBatch.results":
+		return len(x.Results) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventClaimContractIncomeBatch"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.EventClaimContractIncomeBatch does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventClaimContractIncomeBatch) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "arkeo.arkeo.EventClaimContractIncomeBatch.creator":
+		x.Creator = ""
+	case "arkeo.arkeo.EventClaimContractIncomeBatch.results":
+		x.Results = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventClaimContractIncomeBatch"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.EventClaimContractIncomeBatch does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventClaimContractIncomeBatch) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "arkeo.arkeo.EventClaimContractIncomeBatch.creator":
+		value := x.Creator
+		return protoreflect.ValueOfString(value)
+	case "arkeo.arkeo.EventClaimContractIncomeBatch.results":
+		if len(x.Results) == 0 {
+			return protoreflect.ValueOfList(&_EventClaimContractIncomeBatch_2_list{})
+		}
+		listValue := &_EventClaimContractIncomeBatch_2_list{list: &x.Results}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventClaimContractIncomeBatch"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.EventClaimContractIncomeBatch does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventClaimContractIncomeBatch) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "arkeo.arkeo.EventClaimContractIncomeBatch.creator":
+		x.Creator = value.Interface().(string)
+	case "arkeo.arkeo.EventClaimContractIncomeBatch.results":
+		lv := value.List()
+		clv := lv.(*_EventClaimContractIncomeBatch_2_list)
+		x.Results = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventClaimContractIncomeBatch"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.EventClaimContractIncomeBatch does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventClaimContractIncomeBatch) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "arkeo.arkeo.EventClaimContractIncomeBatch.results":
+		if x.Results == nil {
+			x.Results = []*ContractClaimResult{}
+		}
+		value := &_EventClaimContractIncomeBatch_2_list{list: &x.Results}
+		return protoreflect.ValueOfList(value)
+	case "arkeo.arkeo.EventClaimContractIncomeBatch.creator":
+		panic(fmt.Errorf("field creator of message arkeo.arkeo.EventClaimContractIncomeBatch is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventClaimContractIncomeBatch"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.EventClaimContractIncomeBatch does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventClaimContractIncomeBatch) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "arkeo.arkeo.EventClaimContractIncomeBatch.creator":
+		return protoreflect.ValueOfString("")
+	case "arkeo.arkeo.EventClaimContractIncomeBatch.results":
+		list := []*ContractClaimResult{}
+		return protoreflect.ValueOfList(&_EventClaimContractIncomeBatch_2_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventClaimContractIncomeBatch"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.EventClaimContractIncomeBatch does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventClaimContractIncomeBatch) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in arkeo.arkeo.EventClaimContractIncomeBatch", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventClaimContractIncomeBatch) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventClaimContractIncomeBatch) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventClaimContractIncomeBatch) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventClaimContractIncomeBatch) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventClaimContractIncomeBatch)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Creator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Results) > 0 {
+			for _, e := range x.Results {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventClaimContractIncomeBatch)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Results) > 0 {
+			for iNdEx := len(x.Results) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Results[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if len(x.Creator) > 0 {
+			i -= len(x.Creator)
+			copy(dAtA[i:], x.Creator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventClaimContractIncomeBatch)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventClaimContractIncomeBatch: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventClaimContractIncomeBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Creator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Results = append(x.Results, &ContractClaimResult{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Results[len(x.Results)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_EventPurgeExpiredContracts_2_list)(nil)
+
+type _EventPurgeExpiredContracts_2_list struct {
+	list *[]*ContractPurgeResult
+}
+
+func (x *_EventPurgeExpiredContracts_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_EventPurgeExpiredContracts_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_EventPurgeExpiredContracts_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ContractPurgeResult)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_EventPurgeExpiredContracts_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ContractPurgeResult)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_EventPurgeExpiredContracts_2_list) AppendMutable() protoreflect.Value {
+	v := new(ContractPurgeResult)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_EventPurgeExpiredContracts_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_EventPurgeExpiredContracts_2_list) NewElement() protoreflect.Value {
+	v := new(ContractPurgeResult)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_EventPurgeExpiredContracts_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_EventPurgeExpiredContracts         protoreflect.MessageDescriptor
+	fd_EventPurgeExpiredContracts_creator protoreflect.FieldDescriptor
+	fd_EventPurgeExpiredContracts_results protoreflect.FieldDescriptor
+	fd_EventPurgeExpiredContracts_reward  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_arkeo_arkeo_events_proto_init()
+	md_EventPurgeExpiredContracts = File_arkeo_arkeo_events_proto.Messages().ByName("EventPurgeExpiredContracts")
+	fd_EventPurgeExpiredContracts_creator = md_EventPurgeExpiredContracts.Fields().ByName("creator")
+	fd_EventPurgeExpiredContracts_results = md_EventPurgeExpiredContracts.Fields().ByName("results")
+	fd_EventPurgeExpiredContracts_reward = md_EventPurgeExpiredContracts.Fields().ByName("reward")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventPurgeExpiredContracts)(nil)
+
+type fastReflection_EventPurgeExpiredContracts EventPurgeExpiredContracts
+
+func (x *EventPurgeExpiredContracts) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventPurgeExpiredContracts)(x)
+}
+
+func (x *EventPurgeExpiredContracts) slowProtoReflect() protoreflect.Message {
+	mi := &file_arkeo_arkeo_events_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventPurgeExpiredContracts_messageType fastReflection_EventPurgeExpiredContracts_messageType
+var _ protoreflect.MessageType = fastReflection_EventPurgeExpiredContracts_messageType{}
+
+type fastReflection_EventPurgeExpiredContracts_messageType struct{}
+
+func (x fastReflection_EventPurgeExpiredContracts_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventPurgeExpiredContracts)(nil)
+}
+func (x fastReflection_EventPurgeExpiredContracts_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventPurgeExpiredContracts)
+}
+func (x fastReflection_EventPurgeExpiredContracts_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventPurgeExpiredContracts
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventPurgeExpiredContracts) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventPurgeExpiredContracts
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventPurgeExpiredContracts) Type() protoreflect.MessageType {
+	return _fastReflection_EventPurgeExpiredContracts_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventPurgeExpiredContracts) New() protoreflect.Message {
+	return new(fastReflection_EventPurgeExpiredContracts)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventPurgeExpiredContracts) Interface() protoreflect.ProtoMessage {
+	return (*EventPurgeExpiredContracts)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventPurgeExpiredContracts) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Creator != "" {
+		value := protoreflect.ValueOfString(x.Creator)
+		if !f(fd_EventPurgeExpiredContracts_creator, value) {
+			return
+		}
+	}
+	if len(x.Results) != 0 {
+		value := protoreflect.ValueOfList(&_EventPurgeExpiredContracts_2_list{list: &x.Results})
+		if !f(fd_EventPurgeExpiredContracts_results, value) {
+			return
+		}
+	}
+	if x.Reward != "" {
+		value := protoreflect.ValueOfString(x.Reward)
+		if !f(fd_EventPurgeExpiredContracts_reward, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventPurgeExpiredContracts) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "arkeo.arkeo.EventPurgeExpiredContracts.creator":
+		return x.Creator != ""
+	case "arkeo.arkeo.EventPurgeExpiredContracts.results":
+		return len(x.Results) != 0
+	case "arkeo.arkeo.EventPurgeExpiredContracts.reward":
+		return x.Reward != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventPurgeExpiredContracts"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.EventPurgeExpiredContracts does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventPurgeExpiredContracts) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "arkeo.arkeo.EventPurgeExpiredContracts.creator":
+		x.Creator = ""
+	case "arkeo.arkeo.EventPurgeExpiredContracts.results":
+		x.Results = nil
+	case "arkeo.arkeo.EventPurgeExpiredContracts.reward":
+		x.Reward = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventPurgeExpiredContracts"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.EventPurgeExpiredContracts does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventPurgeExpiredContracts) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "arkeo.arkeo.EventPurgeExpiredContracts.creator":
+		value := x.Creator
+		return protoreflect.ValueOfString(value)
+	case "arkeo.arkeo.EventPurgeExpiredContracts.results":
+		if len(x.Results) == 0 {
+			return protoreflect.ValueOfList(&_EventPurgeExpiredContracts_2_list{})
+		}
+		listValue := &_EventPurgeExpiredContracts_2_list{list: &x.Results}
+		return protoreflect.ValueOfList(listValue)
+	case "arkeo.arkeo.EventPurgeExpiredContracts.reward":
+		value := x.Reward
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventPurgeExpiredContracts"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.EventPurgeExpiredContracts does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventPurgeExpiredContracts) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "arkeo.arkeo.EventPurgeExpiredContracts.creator":
+		x.Creator = value.Interface().(string)
+	case "arkeo.arkeo.EventPurgeExpiredContracts.results":
+		lv := value.List()
+		clv := lv.(*_EventPurgeExpiredContracts_2_list)
+		x.Results = *clv.list
+	case "arkeo.arkeo.EventPurgeExpiredContracts.reward":
+		x.Reward = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventPurgeExpiredContracts"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.EventPurgeExpiredContracts does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventPurgeExpiredContracts) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "arkeo.arkeo.EventPurgeExpiredContracts.results":
+		if x.Results == nil {
+			x.Results = []*ContractPurgeResult{}
+		}
+		value := &_EventPurgeExpiredContracts_2_list{list: &x.Results}
+		return protoreflect.ValueOfList(value)
+	case "arkeo.arkeo.EventPurgeExpiredContracts.creator":
+		panic(fmt.Errorf("field creator of message arkeo.arkeo.EventPurgeExpiredContracts is not mutable"))
+	case "arkeo.arkeo.EventPurgeExpiredContracts.reward":
+		panic(fmt.Errorf("field reward of message arkeo.arkeo.EventPurgeExpiredContracts is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventPurgeExpiredContracts"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.EventPurgeExpiredContracts does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventPurgeExpiredContracts) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "arkeo.arkeo.EventPurgeExpiredContracts.creator":
+		return protoreflect.ValueOfString("")
+	case "arkeo.arkeo.EventPurgeExpiredContracts.results":
+		list := []*ContractPurgeResult{}
+		return protoreflect.ValueOfList(&_EventPurgeExpiredContracts_2_list{list: &list})
+	case "arkeo.arkeo.EventPurgeExpiredContracts.reward":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventPurgeExpiredContracts"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.EventPurgeExpiredContracts does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventPurgeExpiredContracts) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in arkeo.arkeo.EventPurgeExpiredContracts", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventPurgeExpiredContracts) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventPurgeExpiredContracts) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventPurgeExpiredContracts) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventPurgeExpiredContracts) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventPurgeExpiredContracts)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Creator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Results) > 0 {
+			for _, e := range x.Results {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.Reward)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventPurgeExpiredContracts)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Reward) > 0 {
+			i -= len(x.Reward)
+			copy(dAtA[i:], x.Reward)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Reward)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Results) > 0 {
+			for iNdEx := len(x.Results) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Results[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if len(x.Creator) > 0 {
+			i -= len(x.Creator)
+			copy(dAtA[i:], x.Creator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventPurgeExpiredContracts)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventPurgeExpiredContracts: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventPurgeExpiredContracts: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Creator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Results = append(x.Results, &ContractPurgeResult{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Results[len(x.Results)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reward", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Reward = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EventProviderDemoted          protoreflect.MessageDescriptor
+	fd_EventProviderDemoted_provider protoreflect.FieldDescriptor
+	fd_EventProviderDemoted_service  protoreflect.FieldDescriptor
+	fd_EventProviderDemoted_bond     protoreflect.FieldDescriptor
+	fd_EventProviderDemoted_min_bond protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_arkeo_arkeo_events_proto_init()
+	md_EventProviderDemoted = File_arkeo_arkeo_events_proto.Messages().ByName("EventProviderDemoted")
+	fd_EventProviderDemoted_provider = md_EventProviderDemoted.Fields().ByName("provider")
+	fd_EventProviderDemoted_service = md_EventProviderDemoted.Fields().ByName("service")
+	fd_EventProviderDemoted_bond = md_EventProviderDemoted.Fields().ByName("bond")
+	fd_EventProviderDemoted_min_bond = md_EventProviderDemoted.Fields().ByName("min_bond")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventProviderDemoted)(nil)
+
+type fastReflection_EventProviderDemoted EventProviderDemoted
+
+func (x *EventProviderDemoted) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventProviderDemoted)(x)
+}
+
+func (x *EventProviderDemoted) slowProtoReflect() protoreflect.Message {
+	mi := &file_arkeo_arkeo_events_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventProviderDemoted_messageType fastReflection_EventProviderDemoted_messageType
+var _ protoreflect.MessageType = fastReflection_EventProviderDemoted_messageType{}
+
+type fastReflection_EventProviderDemoted_messageType struct{}
+
+func (x fastReflection_EventProviderDemoted_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventProviderDemoted)(nil)
+}
+func (x fastReflection_EventProviderDemoted_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventProviderDemoted)
+}
+func (x fastReflection_EventProviderDemoted_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventProviderDemoted
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventProviderDemoted) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventProviderDemoted
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventProviderDemoted) Type() protoreflect.MessageType {
+	return _fastReflection_EventProviderDemoted_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventProviderDemoted) New() protoreflect.Message {
+	return new(fastReflection_EventProviderDemoted)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventProviderDemoted) Interface() protoreflect.ProtoMessage {
+	return (*EventProviderDemoted)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventProviderDemoted) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Provider) != 0 {
+		value := protoreflect.ValueOfBytes(x.Provider)
+		if !f(fd_EventProviderDemoted_provider, value) {
+			return
+		}
+	}
+	if x.Service != "" {
+		value := protoreflect.ValueOfString(x.Service)
+		if !f(fd_EventProviderDemoted_service, value) {
+			return
+		}
+	}
+	if x.Bond != "" {
+		value := protoreflect.ValueOfString(x.Bond)
+		if !f(fd_EventProviderDemoted_bond, value) {
+			return
+		}
+	}
+	if x.MinBond != "" {
+		value := protoreflect.ValueOfString(x.MinBond)
+		if !f(fd_EventProviderDemoted_min_bond, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventProviderDemoted) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "arkeo.arkeo.EventProviderDemoted.provider":
+		return len(x.Provider) != 0
+	case "arkeo.arkeo.EventProviderDemoted.service":
+		return x.Service != ""
+	case "arkeo.arkeo.EventProviderDemoted.bond":
+		return x.Bond != ""
+	case "arkeo.arkeo.EventProviderDemoted.min_bond":
+		return x.MinBond != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventProviderDemoted"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.EventProviderDemoted does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventProviderDemoted) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "arkeo.arkeo.EventProviderDemoted.provider":
+		x.Provider = nil
+	case "arkeo.arkeo.EventProviderDemoted.service":
+		x.Service = ""
+	case "arkeo.arkeo.EventProviderDemoted.bond":
+		x.Bond = ""
+	case "arkeo.arkeo.EventProviderDemoted.min_bond":
+		x.MinBond = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventProviderDemoted"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.EventProviderDemoted does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventProviderDemoted) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "arkeo.arkeo.EventProviderDemoted.provider":
+		value := x.Provider
+		return protoreflect.ValueOfBytes(value)
+	case "arkeo.arkeo.EventProviderDemoted.service":
+		value := x.Service
+		return protoreflect.ValueOfString(value)
+	case "arkeo.arkeo.EventProviderDemoted.bond":
+		value := x.Bond
+		return protoreflect.ValueOfString(value)
+	case "arkeo.arkeo.EventProviderDemoted.min_bond":
+		value := x.MinBond
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventProviderDemoted"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.EventProviderDemoted does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventProviderDemoted) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "arkeo.arkeo.EventProviderDemoted.provider":
+		x.Provider = value.Bytes()
+	case "arkeo.arkeo.EventProviderDemoted.service":
+		x.Service = value.Interface().(string)
+	case "arkeo.arkeo.EventProviderDemoted.bond":
+		x.Bond = value.Interface().(string)
+	case "arkeo.arkeo.EventProviderDemoted.min_bond":
+		x.MinBond = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventProviderDemoted"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.EventProviderDemoted does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventProviderDemoted) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "arkeo.arkeo.EventProviderDemoted.provider":
+		panic(fmt.Errorf("field provider of message arkeo.arkeo.EventProviderDemoted is not mutable"))
+	case "arkeo.arkeo.EventProviderDemoted.service":
+		panic(fmt.Errorf("field service of message arkeo.arkeo.EventProviderDemoted is not mutable"))
+	case "arkeo.arkeo.EventProviderDemoted.bond":
+		panic(fmt.Errorf("field bond of message arkeo.arkeo.EventProviderDemoted is not mutable"))
+	case "arkeo.arkeo.EventProviderDemoted.min_bond":
+		panic(fmt.Errorf("field min_bond of message arkeo.arkeo.EventProviderDemoted is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventProviderDemoted"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.EventProviderDemoted does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventProviderDemoted) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "arkeo.arkeo.EventProviderDemoted.provider":
+		return protoreflect.ValueOfBytes(nil)
+	case "arkeo.arkeo.EventProviderDemoted.service":
+		return protoreflect.ValueOfString("")
+	case "arkeo.arkeo.EventProviderDemoted.bond":
+		return protoreflect.ValueOfString("")
+	case "arkeo.arkeo.EventProviderDemoted.min_bond":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventProviderDemoted"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.EventProviderDemoted does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventProviderDemoted) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in arkeo.arkeo.EventProviderDemoted", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventProviderDemoted) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventProviderDemoted) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventProviderDemoted) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventProviderDemoted) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventProviderDemoted)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Provider)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Service)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Bond)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MinBond)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventProviderDemoted)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.MinBond) > 0 {
+			i -= len(x.MinBond)
+			copy(dAtA[i:], x.MinBond)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MinBond)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Bond) > 0 {
+			i -= len(x.Bond)
+			copy(dAtA[i:], x.Bond)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Bond)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Service) > 0 {
+			i -= len(x.Service)
+			copy(dAtA[i:], x.Service)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Service)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Provider) > 0 {
+			i -= len(x.Provider)
+			copy(dAtA[i:], x.Provider)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Provider)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventProviderDemoted)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventProviderDemoted: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventProviderDemoted: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Provider = append(x.Provider[:0], dAtA[iNdEx:postIndex]...)
+				if x.Provider == nil {
+					x.Provider = []byte{}
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Service = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Bond", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Bond = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinBond", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MinBond = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EventContractActivated              protoreflect.MessageDescriptor
+	fd_EventContractActivated_contract_id  protoreflect.FieldDescriptor
+	fd_EventContractActivated_provider     protoreflect.FieldDescriptor
+	fd_EventContractActivated_service      protoreflect.FieldDescriptor
+	fd_EventContractActivated_client       protoreflect.FieldDescriptor
+	fd_EventContractActivated_type         protoreflect.FieldDescriptor
+	fd_EventContractActivated_start_height protoreflect.FieldDescriptor
+	fd_EventContractActivated_expiration   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_arkeo_arkeo_events_proto_init()
+	md_EventContractActivated = File_arkeo_arkeo_events_proto.Messages().ByName("EventContractActivated")
+	fd_EventContractActivated_contract_id = md_EventContractActivated.Fields().ByName("contract_id")
+	fd_EventContractActivated_provider = md_EventContractActivated.Fields().ByName("provider")
+	fd_EventContractActivated_service = md_EventContractActivated.Fields().ByName("service")
+	fd_EventContractActivated_client = md_EventContractActivated.Fields().ByName("client")
+	fd_EventContractActivated_type = md_EventContractActivated.Fields().ByName("type")
+	fd_EventContractActivated_start_height = md_EventContractActivated.Fields().ByName("start_height")
+	fd_EventContractActivated_expiration = md_EventContractActivated.Fields().ByName("expiration")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventContractActivated)(nil)
+
+type fastReflection_EventContractActivated EventContractActivated
+
+func (x *EventContractActivated) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventContractActivated)(x)
+}
+
+func (x *EventContractActivated) slowProtoReflect() protoreflect.Message {
+	mi := &file_arkeo_arkeo_events_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventContractActivated_messageType fastReflection_EventContractActivated_messageType
+var _ protoreflect.MessageType = fastReflection_EventContractActivated_messageType{}
+
+type fastReflection_EventContractActivated_messageType struct{}
+
+func (x fastReflection_EventContractActivated_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventContractActivated)(nil)
+}
+func (x fastReflection_EventContractActivated_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventContractActivated)
+}
+func (x fastReflection_EventContractActivated_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventContractActivated
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventContractActivated) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventContractActivated
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventContractActivated) Type() protoreflect.MessageType {
+	return _fastReflection_EventContractActivated_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventContractActivated) New() protoreflect.Message {
+	return new(fastReflection_EventContractActivated)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventContractActivated) Interface() protoreflect.ProtoMessage {
+	return (*EventContractActivated)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventContractActivated) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ContractId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ContractId)
+		if !f(fd_EventContractActivated_contract_id, value) {
+			return
+		}
+	}
+	if len(x.Provider) != 0 {
+		value := protoreflect.ValueOfBytes(x.Provider)
+		if !f(fd_EventContractActivated_provider, value) {
+			return
+		}
+	}
+	if x.Service != "" {
+		value := protoreflect.ValueOfString(x.Service)
+		if !f(fd_EventContractActivated_service, value) {
+			return
+		}
+	}
+	if len(x.Client) != 0 {
+		value := protoreflect.ValueOfBytes(x.Client)
+		if !f(fd_EventContractActivated_client, value) {
+			return
+		}
+	}
+	if x.Type_ != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Type_))
+		if !f(fd_EventContractActivated_type, value) {
+			return
+		}
+	}
+	if x.StartHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.StartHeight)
+		if !f(fd_EventContractActivated_start_height, value) {
+			return
+		}
+	}
+	if x.Expiration != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Expiration)
+		if !f(fd_EventContractActivated_expiration, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventContractActivated) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "arkeo.arkeo.EventContractActivated.contract_id":
+		return x.ContractId != uint64(0)
+	case "arkeo.arkeo.EventContractActivated.provider":
+		return len(x.Provider) != 0
+	case "arkeo.arkeo.EventContractActivated.service":
+		return x.Service != ""
+	case "arkeo.arkeo.EventContractActivated.client":
+		return len(x.Client) != 0
+	case "arkeo.arkeo.EventContractActivated.type":
+		return x.Type_ != 0
+	case "arkeo.arkeo.EventContractActivated.start_height":
+		return x.StartHeight != int64(0)
+	case "arkeo.arkeo.EventContractActivated.expiration":
+		return x.Expiration != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventContractActivated"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.EventContractActivated does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventContractActivated) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "arkeo.arkeo.EventContractActivated.contract_id":
+		x.ContractId = uint64(0)
+	case "arkeo.arkeo.EventContractActivated.provider":
+		x.Provider = nil
+	case "arkeo.arkeo.EventContractActivated.service":
+		x.Service = ""
+	case "arkeo.arkeo.EventContractActivated.client":
+		x.Client = nil
+	case "arkeo.arkeo.EventContractActivated.type":
+		x.Type_ = 0
+	case "arkeo.arkeo.EventContractActivated.start_height":
+		x.StartHeight = int64(0)
+	case "arkeo.arkeo.EventContractActivated.expiration":
+		x.Expiration = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventContractActivated"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.EventContractActivated does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventContractActivated) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "arkeo.arkeo.EventContractActivated.contract_id":
+		value := x.ContractId
+		return protoreflect.ValueOfUint64(value)
+	case "arkeo.arkeo.EventContractActivated.provider":
+		value := x.Provider
+		return protoreflect.ValueOfBytes(value)
+	case "arkeo.arkeo.EventContractActivated.service":
+		value := x.Service
+		return protoreflect.ValueOfString(value)
+	case "arkeo.arkeo.EventContractActivated.client":
+		value := x.Client
+		return protoreflect.ValueOfBytes(value)
+	case "arkeo.arkeo.EventContractActivated.type":
+		value := x.Type_
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "arkeo.arkeo.EventContractActivated.start_height":
+		value := x.StartHeight
+		return protoreflect.ValueOfInt64(value)
+	case "arkeo.arkeo.EventContractActivated.expiration":
+		value := x.Expiration
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventContractActivated"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.EventContractActivated does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventContractActivated) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "arkeo.arkeo.EventContractActivated.contract_id":
+		x.ContractId = value.Uint()
+	case "arkeo.arkeo.EventContractActivated.provider":
+		x.Provider = value.Bytes()
+	case "arkeo.arkeo.EventContractActivated.service":
+		x.Service = value.Interface().(string)
+	case "arkeo.arkeo.EventContractActivated.client":
+		x.Client = value.Bytes()
+	case "arkeo.arkeo.EventContractActivated.type":
+		x.Type_ = (ContractType)(value.Enum())
+	case "arkeo.arkeo.EventContractActivated.start_height":
+		x.StartHeight = value.Int()
+	case "arkeo.arkeo.EventContractActivated.expiration":
+		x.Expiration = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventContractActivated"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.EventContractActivated does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventContractActivated) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "arkeo.arkeo.EventContractActivated.contract_id":
+		panic(fmt.Errorf("field contract_id of message arkeo.arkeo.EventContractActivated is not mutable"))
+	case "arkeo.arkeo.EventContractActivated.provider":
+		panic(fmt.Errorf("field provider of message arkeo.arkeo.EventContractActivated is not mutable"))
+	case "arkeo.arkeo.EventContractActivated.service":
+		panic(fmt.Errorf("field service of message arkeo.arkeo.EventContractActivated is not mutable"))
+	case "arkeo.arkeo.EventContractActivated.client":
+		panic(fmt.Errorf("field client of message arkeo.arkeo.EventContractActivated is not mutable"))
+	case "arkeo.arkeo.EventContractActivated.type":
+		panic(fmt.Errorf("field type of message arkeo.arkeo.EventContractActivated is not mutable"))
+	case "arkeo.arkeo.EventContractActivated.start_height":
+		panic(fmt.Errorf("field start_height of message arkeo.arkeo.EventContractActivated is not mutable"))
+	case "arkeo.arkeo.EventContractActivated.expiration":
+		panic(fmt.Errorf("field expiration of message arkeo.arkeo.EventContractActivated is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventContractActivated"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.EventContractActivated does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventContractActivated) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "arkeo.arkeo.EventContractActivated.contract_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "arkeo.arkeo.EventContractActivated.provider":
+		return protoreflect.ValueOfBytes(nil)
+	case "arkeo.arkeo.EventContractActivated.service":
+		return protoreflect.ValueOfString("")
+	case "arkeo.arkeo.EventContractActivated.client":
+		return protoreflect.ValueOfBytes(nil)
+	case "arkeo.arkeo.EventContractActivated.type":
+		return protoreflect.ValueOfEnum(0)
+	case "arkeo.arkeo.EventContractActivated.start_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "arkeo.arkeo.EventContractActivated.expiration":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventContractActivated"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.EventContractActivated does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventContractActivated) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in arkeo.arkeo.EventContractActivated", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventContractActivated) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventContractActivated) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventContractActivated) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventContractActivated) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventContractActivated)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.ContractId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ContractId))
+		}
+		l = len(x.Provider)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Service)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Client)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Type_ != 0 {
+			n += 1 + runtime.Sov(uint64(x.Type_))
+		}
+		if x.StartHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.StartHeight))
+		}
+		if x.Expiration != 0 {
+			n += 1 + runtime.Sov(uint64(x.Expiration))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventContractActivated)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Expiration != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Expiration))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.StartHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.StartHeight))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.Type_ != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Type_))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.Client) > 0 {
+			i -= len(x.Client)
+			copy(dAtA[i:], x.Client)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Client)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Service) > 0 {
+			i -= len(x.Service)
+			copy(dAtA[i:], x.Service)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Service)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Provider) > 0 {
+			i -= len(x.Provider)
+			copy(dAtA[i:], x.Provider)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Provider)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.ContractId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ContractId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventContractActivated)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventContractActivated: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventContractActivated: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+				}
+				x.ContractId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ContractId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Provider = append(x.Provider[:0], dAtA[iNdEx:postIndex]...)
+				if x.Provider == nil {
+					x.Provider = []byte{}
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Service = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Client", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Client = append(x.Client[:0], dAtA[iNdEx:postIndex]...)
+				if x.Client == nil {
+					x.Client = []byte{}
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Type_", wireType)
+				}
+				x.Type_ = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Type_ |= ContractType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+				}
+				x.StartHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.StartHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+				}
+				x.Expiration = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Expiration |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_EventSetProviderAllowlist_4_list)(nil)
+
+type _EventSetProviderAllowlist_4_list struct {
+	list *[][]byte
+}
+
+func (x *_EventSetProviderAllowlist_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_EventSetProviderAllowlist_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfBytes((*x.list)[i])
+}
+
+func (x *_EventSetProviderAllowlist_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Bytes()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_EventSetProviderAllowlist_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Bytes()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_EventSetProviderAllowlist_4_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message EventSetProviderAllowlist at list field Clients as it is not of Message kind"))
+}
+
+func (x *_EventSetProviderAllowlist_4_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_EventSetProviderAllowlist_4_list) NewElement() protoreflect.Value {
+	var v []byte
+	return protoreflect.ValueOfBytes(v)
+}
+
+func (x *_EventSetProviderAllowlist_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_EventSetProviderAllowlist          protoreflect.MessageDescriptor
+	fd_EventSetProviderAllowlist_provider protoreflect.FieldDescriptor
+	fd_EventSetProviderAllowlist_service  protoreflect.FieldDescriptor
+	fd_EventSetProviderAllowlist_action   protoreflect.FieldDescriptor
+	fd_EventSetProviderAllowlist_clients  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_arkeo_arkeo_events_proto_init()
+	md_EventSetProviderAllowlist = File_arkeo_arkeo_events_proto.Messages().ByName("EventSetProviderAllowlist")
+	fd_EventSetProviderAllowlist_provider = md_EventSetProviderAllowlist.Fields().ByName("provider")
+	fd_EventSetProviderAllowlist_service = md_EventSetProviderAllowlist.Fields().ByName("service")
+	fd_EventSetProviderAllowlist_action = md_EventSetProviderAllowlist.Fields().ByName("action")
+	fd_EventSetProviderAllowlist_clients = md_EventSetProviderAllowlist.Fields().ByName("clients")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventSetProviderAllowlist)(nil)
+
+type fastReflection_EventSetProviderAllowlist EventSetProviderAllowlist
+
+func (x *EventSetProviderAllowlist) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventSetProviderAllowlist)(x)
+}
+
+func (x *EventSetProviderAllowlist) slowProtoReflect() protoreflect.Message {
+	mi := &file_arkeo_arkeo_events_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventSetProviderAllowlist_messageType fastReflection_EventSetProviderAllowlist_messageType
+var _ protoreflect.MessageType = fastReflection_EventSetProviderAllowlist_messageType{}
+
+type fastReflection_EventSetProviderAllowlist_messageType struct{}
+
+func (x fastReflection_EventSetProviderAllowlist_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventSetProviderAllowlist)(nil)
+}
+func (x fastReflection_EventSetProviderAllowlist_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventSetProviderAllowlist)
+}
+func (x fastReflection_EventSetProviderAllowlist_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventSetProviderAllowlist
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventSetProviderAllowlist) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventSetProviderAllowlist
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventSetProviderAllowlist) Type() protoreflect.MessageType {
+	return _fastReflection_EventSetProviderAllowlist_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventSetProviderAllowlist) New() protoreflect.Message {
+	return new(fastReflection_EventSetProviderAllowlist)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventSetProviderAllowlist) Interface() protoreflect.ProtoMessage {
+	return (*EventSetProviderAllowlist)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventSetProviderAllowlist) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Provider) != 0 {
+		value := protoreflect.ValueOfBytes(x.Provider)
+		if !f(fd_EventSetProviderAllowlist_provider, value) {
+			return
+		}
+	}
+	if x.Service != "" {
+		value := protoreflect.ValueOfString(x.Service)
+		if !f(fd_EventSetProviderAllowlist_service, value) {
+			return
+		}
+	}
+	if x.Action != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Action))
+		if !f(fd_EventSetProviderAllowlist_action, value) {
+			return
+		}
+	}
+	if len(x.Clients) != 0 {
+		value := protoreflect.ValueOfList(&_EventSetProviderAllowlist_4_list{list: &x.Clients})
+		if !f(fd_EventSetProviderAllowlist_clients, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventSetProviderAllowlist) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "arkeo.arkeo.EventSetProviderAllowlist.provider":
+		return len(x.Provider) != 0
+	case "arkeo.arkeo.EventSetProviderAllowlist.service":
+		return x.Service != ""
+	case "arkeo.arkeo.EventSetProviderAllowlist.action":
+		return x.Action != 0
+	case "arkeo.arkeo.EventSetProviderAllowlist.clients":
+		return len(x.Clients) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventSetProviderAllowlist"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.EventSetProviderAllowlist does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventSetProviderAllowlist) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "arkeo.arkeo.EventSetProviderAllowlist.provider":
+		x.Provider = nil
+	case "arkeo.arkeo.EventSetProviderAllowlist.service":
+		x.Service = ""
+	case "arkeo.arkeo.EventSetProviderAllowlist.action":
+		x.Action = 0
+	case "arkeo.arkeo.EventSetProviderAllowlist.clients":
+		x.Clients = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventSetProviderAllowlist"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.EventSetProviderAllowlist does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventSetProviderAllowlist) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "arkeo.arkeo.EventSetProviderAllowlist.provider":
+		value := x.Provider
+		return protoreflect.ValueOfBytes(value)
+	case "arkeo.arkeo.EventSetProviderAllowlist.service":
+		value := x.Service
+		return protoreflect.ValueOfString(value)
+	case "arkeo.arkeo.EventSetProviderAllowlist.action":
+		value := x.Action
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "arkeo.arkeo.EventSetProviderAllowlist.clients":
+		if len(x.Clients) == 0 {
+			return protoreflect.ValueOfList(&_EventSetProviderAllowlist_4_list{})
+		}
+		listValue := &_EventSetProviderAllowlist_4_list{list: &x.Clients}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventSetProviderAllowlist"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.EventSetProviderAllowlist does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventSetProviderAllowlist) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "arkeo.arkeo.EventSetProviderAllowlist.provider":
+		x.Provider = value.Bytes()
+	case "arkeo.arkeo.EventSetProviderAllowlist.service":
+		x.Service = value.Interface().(string)
+	case "arkeo.arkeo.EventSetProviderAllowlist.action":
+		x.Action = (AllowlistAction)(value.Enum())
+	case "arkeo.arkeo.EventSetProviderAllowlist.clients":
+		lv := value.List()
+		clv := lv.(*_EventSetProviderAllowlist_4_list)
+		x.Clients = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventSetProviderAllowlist"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.EventSetProviderAllowlist does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventSetProviderAllowlist) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "arkeo.arkeo.EventSetProviderAllowlist.clients":
+		if x.Clients == nil {
+			x.Clients = [][]byte{}
+		}
+		value := &_EventSetProviderAllowlist_4_list{list: &x.Clients}
+		return protoreflect.ValueOfList(value)
+	case "arkeo.arkeo.EventSetProviderAllowlist.provider":
+		panic(fmt.Errorf("field provider of message arkeo.arkeo.EventSetProviderAllowlist is not mutable"))
+	case "arkeo.arkeo.EventSetProviderAllowlist.service":
+		panic(fmt.Errorf("field service of message arkeo.arkeo.EventSetProviderAllowlist is not mutable"))
+	case "arkeo.arkeo.EventSetProviderAllowlist.action":
+		panic(fmt.Errorf("field action of message arkeo.arkeo.EventSetProviderAllowlist is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventSetProviderAllowlist"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.EventSetProviderAllowlist does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventSetProviderAllowlist) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "arkeo.arkeo.EventSetProviderAllowlist.provider":
+		return protoreflect.ValueOfBytes(nil)
+	case "arkeo.arkeo.EventSetProviderAllowlist.service":
+		return protoreflect.ValueOfString("")
+	case "arkeo.arkeo.EventSetProviderAllowlist.action":
+		return protoreflect.ValueOfEnum(0)
+	case "arkeo.arkeo.EventSetProviderAllowlist.clients":
+		list := [][]byte{}
+		return protoreflect.ValueOfList(&_EventSetProviderAllowlist_4_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.EventSetProviderAllowlist"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.EventSetProviderAllowlist does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventSetProviderAllowlist) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in arkeo.arkeo.EventSetProviderAllowlist", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventSetProviderAllowlist) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventSetProviderAllowlist) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventSetProviderAllowlist) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventSetProviderAllowlist) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventSetProviderAllowlist)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Provider)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Service)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Action != 0 {
+			n += 1 + runtime.Sov(uint64(x.Action))
+		}
+		if len(x.Clients) > 0 {
+			for _, b := range x.Clients {
+				l = len(b)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventSetProviderAllowlist)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Clients) > 0 {
+			for iNdEx := len(x.Clients) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Clients[iNdEx])
+				copy(dAtA[i:], x.Clients[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Clients[iNdEx])))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if x.Action != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Action))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Service) > 0 {
+			i -= len(x.Service)
+			copy(dAtA[i:], x.Service)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Service)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Provider) > 0 {
+			i -= len(x.Provider)
+			copy(dAtA[i:], x.Provider)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Provider)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventSetProviderAllowlist)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventSetProviderAllowlist: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventSetProviderAllowlist: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Provider = append(x.Provider[:0], dAtA[iNdEx:postIndex]...)
+				if x.Provider == nil {
+					x.Provider = []byte{}
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Service = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+				}
+				x.Action = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Action |= AllowlistAction(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Clients", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Clients = append(x.Clients, make([]byte, postIndex-iNdEx))
+				copy(x.Clients[len(x.Clients)-1], dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: arkeo/arkeo/events.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type EventBondProvider struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Provider []byte `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Service  string `protobuf:"bytes,2,opt,name=service,proto3" json:"service,omitempty"`
+	BondRel  string `protobuf:"bytes,3,opt,name=bond_rel,json=bondRel,proto3" json:"bond_rel,omitempty"`
+	BondAbs  string `protobuf:"bytes,4,opt,name=bond_abs,json=bondAbs,proto3" json:"bond_abs,omitempty"`
+	// denom of the bond amounts
+	BondDenom string `protobuf:"bytes,5,opt,name=bond_denom,json=bondDenom,proto3" json:"bond_denom,omitempty"`
+}
+
+func (x *EventBondProvider) Reset() {
+	*x = EventBondProvider{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_arkeo_arkeo_events_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventBondProvider) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventBondProvider) ProtoMessage() {}
+
+// Deprecated: Use EventBondProvider.ProtoReflect.Descriptor instead.
+func (*EventBondProvider) Descriptor() ([]byte, []int) {
+	return file_arkeo_arkeo_events_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *EventBondProvider) GetProvider() []byte {
+	if x != nil {
+		return x.Provider
+	}
+	return nil
+}
+
+func (x *EventBondProvider) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *EventBondProvider) GetBondRel() string {
+	if x != nil {
+		return x.BondRel
+	}
+	return ""
+}
+
+func (x *EventBondProvider) GetBondAbs() string {
+	if x != nil {
+		return x.BondAbs
+	}
+	return ""
+}
+
+func (x *EventBondProvider) GetBondDenom() string {
+	if x != nil {
+		return x.BondDenom
+	}
+	return ""
+}
+
+type EventModProvider struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Creator             []byte          `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Provider            []byte          `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+	Service             string          `protobuf:"bytes,3,opt,name=service,proto3" json:"service,omitempty"`
+	MetadataUri         string          `protobuf:"bytes,4,opt,name=metadata_uri,json=metadataUri,proto3" json:"metadata_uri,omitempty"`
+	MetadataNonce       uint64          `protobuf:"varint,5,opt,name=metadata_nonce,json=metadataNonce,proto3" json:"metadata_nonce,omitempty"`
+	Status              ProviderStatus  `protobuf:"varint,6,opt,name=status,proto3,enum=arkeo.arkeo.ProviderStatus" json:"status,omitempty"`
+	MinContractDuration int64           `protobuf:"varint,7,opt,name=min_contract_duration,json=minContractDuration,proto3" json:"min_contract_duration,omitempty"`
+	MaxContractDuration int64           `protobuf:"varint,8,opt,name=max_contract_duration,json=maxContractDuration,proto3" json:"max_contract_duration,omitempty"`
+	SubscriptionRate    []*v1beta1.Coin `protobuf:"bytes,9,rep,name=subscription_rate,json=subscriptionRate,proto3" json:"subscription_rate,omitempty"`
+	PayAsYouGoRate      []*v1beta1.Coin `protobuf:"bytes,10,rep,name=pay_as_you_go_rate,json=payAsYouGoRate,proto3" json:"pay_as_you_go_rate,omitempty"`
+	Bond                string          `protobuf:"bytes,11,opt,name=bond,proto3" json:"bond,omitempty"`
+	SettlementDuration  int64           `protobuf:"varint,12,opt,name=settlement_duration,json=settlementDuration,proto3" json:"settlement_duration,omitempty"`
+	// updated_fields names the fields of the provider the event carries, all of them when empty
+	UpdatedFields    []string `protobuf:"bytes,13,rep,name=updated_fields,json=updatedFields,proto3" json:"updated_fields,omitempty"`
+	MaxOpenContracts uint64   `protobuf:"varint,14,opt,name=max_open_contracts,json=maxOpenContracts,proto3" json:"max_open_contracts,omitempty"`
+}
+
+func (x *EventModProvider) Reset() {
+	*x = EventModProvider{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_arkeo_arkeo_events_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventModProvider) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventModProvider) ProtoMessage() {}
+
+// Deprecated: Use EventModProvider.ProtoReflect.Descriptor instead.
+func (*EventModProvider) Descriptor() ([]byte, []int) {
+	return file_arkeo_arkeo_events_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *EventModProvider) GetCreator() []byte {
+	if x != nil {
+		return x.Creator
+	}
+	return nil
+}
+
+func (x *EventModProvider) GetProvider() []byte {
+	if x != nil {
+		return x.Provider
+	}
+	return nil
+}
+
+func (x *EventModProvider) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *EventModProvider) GetMetadataUri() string {
+	if x != nil {
+		return x.MetadataUri
+	}
+	return ""
+}
+
+func (x *EventModProvider) GetMetadataNonce() uint64 {
+	if x != nil {
+		return x.MetadataNonce
+	}
+	return 0
+}
+
+func (x *EventModProvider) GetStatus() ProviderStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ProviderStatus_OFFLINE
+}
+
+func (x *EventModProvider) GetMinContractDuration() int64 {
+	if x != nil {
+		return x.MinContractDuration
+	}
+	return 0
+}
+
+func (x *EventModProvider) GetMaxContractDuration() int64 {
+	if x != nil {
+		return x.MaxContractDuration
+	}
+	return 0
+}
+
+func (x *EventModProvider) GetSubscriptionRate() []*v1beta1.Coin {
+	if x != nil {
+		return x.SubscriptionRate
+	}
+	return nil
+}
+
+func (x *EventModProvider) GetPayAsYouGoRate() []*v1beta1.Coin {
+	if x != nil {
+		return x.PayAsYouGoRate
+	}
+	return nil
+}
+
+func (x *EventModProvider) GetBond() string {
+	if x != nil {
+		return x.Bond
+	}
+	return ""
+}
+
+func (x *EventModProvider) GetSettlementDuration() int64 {
+	if x != nil {
+		return x.SettlementDuration
+	}
+	return 0
+}
+
+func (x *EventModProvider) GetUpdatedFields() []string {
+	if x != nil {
+		return x.UpdatedFields
+	}
+	return nil
+}
+
+func (x *EventModProvider) GetMaxOpenContracts() uint64 {
+	if x != nil {
+		return x.MaxOpenContracts
+	}
+	return 0
+}
+
+type EventOpenContract struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Provider   []byte       `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	ContractId uint64       `protobuf:"varint,2,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	Service    string       `protobuf:"bytes,3,opt,name=service,proto3" json:"service,omitempty"`
+	Client     []byte       `protobuf:"bytes,4,opt,name=client,proto3" json:"client,omitempty"`
+	Delegate   []byte       `protobuf:"bytes,5,opt,name=delegate,proto3" json:"delegate,omitempty"`
+	Type_      ContractType `protobuf:"varint,6,opt,name=type,proto3,enum=arkeo.arkeo.ContractType" json:"type,omitempty"`
+	Height     int64        `protobuf:"varint,7,opt,name=height,proto3" json:"height,omitempty"`
+	Duration   int64        `protobuf:"varint,8,opt,name=duration,proto3" json:"duration,omitempty"`
+	// deprecated, kept for one release, use rate_denom and rate_amount
+	Rate                  *v1beta1.Coin         `protobuf:"bytes,9,opt,name=rate,proto3" json:"rate,omitempty"`
+	OpenCost              int64                 `protobuf:"varint,10,opt,name=open_cost,json=openCost,proto3" json:"open_cost,omitempty"`
+	Deposit               string                `protobuf:"bytes,11,opt,name=deposit,proto3" json:"deposit,omitempty"`
+	SettlementDuration    int64                 `protobuf:"varint,12,opt,name=settlement_duration,json=settlementDuration,proto3" json:"settlement_duration,omitempty"`
+	Authorization         ContractAuthorization `protobuf:"varint,13,opt,name=authorization,proto3,enum=arkeo.arkeo.ContractAuthorization" json:"authorization,omitempty"`
+	QueriesPerMinute      int64                 `protobuf:"varint,14,opt,name=queries_per_minute,json=queriesPerMinute,proto3" json:"queries_per_minute,omitempty"`
+	SettlementGracePeriod int64                 `protobuf:"varint,15,opt,name=settlement_grace_period,json=settlementGracePeriod,proto3" json:"settlement_grace_period,omitempty"`
+	AutoRenew             bool                  `protobuf:"varint,16,opt,name=auto_renew,json=autoRenew,proto3" json:"auto_renew,omitempty"`
+	RateDenom             string                `protobuf:"bytes,17,opt,name=rate_denom,json=rateDenom,proto3" json:"rate_denom,omitempty"`
+	RateAmount            string                `protobuf:"bytes,18,opt,name=rate_amount,json=rateAmount,proto3" json:"rate_amount,omitempty"`
+	// payer funded the deposit instead of the client, empty when it did not
+	Payer string `protobuf:"bytes,19,opt,name=payer,proto3" json:"payer,omitempty"`
+	// start_height the contract is scheduled to start at, zero when it started
+	// as it opened
+	StartHeight int64 `protobuf:"varint,20,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+}
+
+func (x *EventOpenContract) Reset() {
+	*x = EventOpenContract{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_arkeo_arkeo_events_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventOpenContract) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventOpenContract) ProtoMessage() {}
+
+// Deprecated: Use EventOpenContract.ProtoReflect.Descriptor instead.
+func (*EventOpenContract) Descriptor() ([]byte, []int) {
+	return file_arkeo_arkeo_events_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *EventOpenContract) GetProvider() []byte {
+	if x != nil {
+		return x.Provider
+	}
+	return nil
+}
+
+func (x *EventOpenContract) GetContractId() uint64 {
+	if x != nil {
+		return x.ContractId
+	}
+	return 0
+}
+
+func (x *EventOpenContract) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *EventOpenContract) GetClient() []byte {
+	if x != nil {
+		return x.Client
+	}
+	return nil
+}
+
+func (x *EventOpenContract) GetDelegate() []byte {
+	if x != nil {
+		return x.Delegate
+	}
+	return nil
+}
+
+func (x *EventOpenContract) GetType_() ContractType {
+	if x != nil {
+		return x.Type_
+	}
+	return ContractType_SUBSCRIPTION
+}
+
+func (x *EventOpenContract) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *EventOpenContract) GetDuration() int64 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *EventOpenContract) GetRate() *v1beta1.Coin {
+	if x != nil {
+		return x.Rate
+	}
+	return nil
+}
+
+func (x *EventOpenContract) GetOpenCost() int64 {
+	if x != nil {
+		return x.OpenCost
+	}
+	return 0
+}
+
+func (x *EventOpenContract) GetDeposit() string {
+	if x != nil {
+		return x.Deposit
+	}
+	return ""
+}
+
+func (x *EventOpenContract) GetSettlementDuration() int64 {
+	if x != nil {
+		return x.SettlementDuration
+	}
+	return 0
+}
+
+func (x *EventOpenContract) GetAuthorization() ContractAuthorization {
+	if x != nil {
+		return x.Authorization
+	}
+	return ContractAuthorization_STRICT
+}
+
+func (x *EventOpenContract) GetQueriesPerMinute() int64 {
+	if x != nil {
+		return x.QueriesPerMinute
+	}
+	return 0
+}
+
+func (x *EventOpenContract) GetSettlementGracePeriod() int64 {
+	if x != nil {
+		return x.SettlementGracePeriod
+	}
+	return 0
+}
+
+func (x *EventOpenContract) GetAutoRenew() bool {
+	if x != nil {
+		return x.AutoRenew
+	}
+	return false
+}
+
+func (x *EventOpenContract) GetRateDenom() string {
+	if x != nil {
+		return x.RateDenom
+	}
+	return ""
+}
+
+func (x *EventOpenContract) GetRateAmount() string {
+	if x != nil {
+		return x.RateAmount
+	}
+	return ""
+}
+
+func (x *EventOpenContract) GetPayer() string {
+	if x != nil {
+		return x.Payer
+	}
+	return ""
+}
+
+func (x *EventOpenContract) GetStartHeight() int64 {
+	if x != nil {
+		return x.StartHeight
+	}
+	return 0
+}
+
+type EventSettleContract struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Provider   []byte       `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	ContractId uint64       `protobuf:"varint,2,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	Service    string       `protobuf:"bytes,3,opt,name=service,proto3" json:"service,omitempty"`
+	Client     []byte       `protobuf:"bytes,4,opt,name=client,proto3" json:"client,omitempty"`
+	Delegate   []byte       `protobuf:"bytes,5,opt,name=delegate,proto3" json:"delegate,omitempty"`
+	Type_      ContractType `protobuf:"varint,6,opt,name=type,proto3,enum=arkeo.arkeo.ContractType" json:"type,omitempty"`
+	Nonce      int64        `protobuf:"varint,7,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	Height     int64        `protobuf:"varint,8,opt,name=height,proto3" json:"height,omitempty"`
+	// paid out of the deposit, the reserve tax included
+	Paid string `protobuf:"bytes,9,opt,name=paid,proto3" json:"paid,omitempty"`
+	// reserve tax taken out of the amount paid
+	Reserve string `protobuf:"bytes,10,opt,name=reserve,proto3" json:"reserve,omitempty"`
+	// claimed beyond the deposit left, paid and unpaid summing up to claimed
+	Unpaid string `protobuf:"bytes,11,opt,name=unpaid,proto3" json:"unpaid,omitempty"`
+	// denom of the amounts of the settlement
+	Denom string `protobuf:"bytes,12,opt,name=denom,proto3" json:"denom,omitempty"`
+	// deposit left refunded as the settlement closes the contract
+	Refunded string `protobuf:"bytes,13,opt,name=refunded,proto3" json:"refunded,omitempty"`
+	// owed for the queries or blocks served since the last settlement
+	Claimed string `protobuf:"bytes,14,opt,name=claimed,proto3" json:"claimed,omitempty"`
+	// rounding remainder accrued to the reserve, part of the amount paid along
+	// the reserve tax
+	Dust string `protobuf:"bytes,15,opt,name=dust,proto3" json:"dust,omitempty"`
+}
+
+func (x *EventSettleContract) Reset() {
+	*x = EventSettleContract{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_arkeo_arkeo_events_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventSettleContract) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventSettleContract) ProtoMessage() {}
+
+// Deprecated: Use EventSettleContract.ProtoReflect.Descriptor instead.
+func (*EventSettleContract) Descriptor() ([]byte, []int) {
+	return file_arkeo_arkeo_events_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *EventSettleContract) GetProvider() []byte {
+	if x != nil {
+		return x.Provider
+	}
+	return nil
+}
+
+func (x *EventSettleContract) GetContractId() uint64 {
+	if x != nil {
+		return x.ContractId
+	}
+	return 0
+}
+
+func (x *EventSettleContract) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *EventSettleContract) GetClient() []byte {
+	if x != nil {
+		return x.Client
+	}
+	return nil
+}
+
+func (x *EventSettleContract) GetDelegate() []byte {
+	if x != nil {
+		return x.Delegate
+	}
+	return nil
+}
+
+func (x *EventSettleContract) GetType_() ContractType {
+	if x != nil {
+		return x.Type_
+	}
+	return ContractType_SUBSCRIPTION
+}
+
+func (x *EventSettleContract) GetNonce() int64 {
+	if x != nil {
+		return x.Nonce
+	}
+	return 0
+}
+
+func (x *EventSettleContract) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *EventSettleContract) GetPaid() string {
+	if x != nil {
+		return x.Paid
+	}
+	return ""
+}
+
+func (x *EventSettleContract) GetReserve() string {
+	if x != nil {
+		return x.Reserve
+	}
+	return ""
+}
+
+func (x *EventSettleContract) GetUnpaid() string {
+	if x != nil {
+		return x.Unpaid
+	}
+	return ""
+}
+
+func (x *EventSettleContract) GetDenom() string {
+	if x != nil {
+		return x.Denom
+	}
+	return ""
+}
+
+func (x *EventSettleContract) GetRefunded() string {
+	if x != nil {
+		return x.Refunded
+	}
+	return ""
+}
+
+func (x *EventSettleContract) GetClaimed() string {
+	if x != nil {
+		return x.Claimed
+	}
+	return ""
+}
+
+func (x *EventSettleContract) GetDust() string {
+	if x != nil {
+		return x.Dust
+	}
+	return ""
+}
+
+type EventCloseContract struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ContractId uint64 `protobuf:"varint,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	Provider   []byte `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+	Service    string `protobuf:"bytes,3,opt,name=service,proto3" json:"service,omitempty"`
+	Client     []byte `protobuf:"bytes,4,opt,name=client,proto3" json:"client,omitempty"`
+	Delegate   []byte `protobuf:"bytes,5,opt,name=delegate,proto3" json:"delegate,omitempty"`
+	// by_provider is true when the provider closed the contract, paying the penalty from its bond to the client
+	ByProvider bool   `protobuf:"varint,6,opt,name=by_provider,json=byProvider,proto3" json:"by_provider,omitempty"`
+	Penalty    string `protobuf:"bytes,7,opt,name=penalty,proto3" json:"penalty,omitempty"`
+	// split of the deposit a subscription closed early by its client left once
+	// the blocks served were paid: the compensation paid to the provider, the
+	// refund and the dust of the integer split, which goes to the reserve
+	Compensation string `protobuf:"bytes,8,opt,name=compensation,proto3" json:"compensation,omitempty"`
+	Refund       string `protobuf:"bytes,9,opt,name=refund,proto3" json:"refund,omitempty"`
+	Dust         string `protobuf:"bytes,10,opt,name=dust,proto3" json:"dust,omitempty"`
+}
+
+func (x *EventCloseContract) Reset() {
+	*x = EventCloseContract{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_arkeo_arkeo_events_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventCloseContract) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventCloseContract) ProtoMessage() {}
+
+// Deprecated: Use EventCloseContract.ProtoReflect.Descriptor instead.
+func (*EventCloseContract) Descriptor() ([]byte, []int) {
+	return file_arkeo_arkeo_events_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *EventCloseContract) GetContractId() uint64 {
+	if x != nil {
+		return x.ContractId
+	}
+	return 0
+}
+
+func (x *EventCloseContract) GetProvider() []byte {
+	if x != nil {
+		return x.Provider
+	}
+	return nil
+}
+
+func (x *EventCloseContract) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *EventCloseContract) GetClient() []byte {
+	if x != nil {
+		return x.Client
+	}
+	return nil
+}
+
+func (x *EventCloseContract) GetDelegate() []byte {
+	if x != nil {
+		return x.Delegate
+	}
+	return nil
+}
+
+func (x *EventCloseContract) GetByProvider() bool {
+	if x != nil {
+		return x.ByProvider
+	}
+	return false
+}
+
+func (x *EventCloseContract) GetPenalty() string {
+	if x != nil {
+		return x.Penalty
+	}
+	return ""
+}
+
+func (x *EventCloseContract) GetCompensation() string {
+	if x != nil {
+		return x.Compensation
+	}
+	return ""
+}
+
+func (x *EventCloseContract) GetRefund() string {
+	if x != nil {
+		return x.Refund
+	}
+	return ""
+}
+
+func (x *EventCloseContract) GetDust() string {
+	if x != nil {
+		return x.Dust
+	}
+	return ""
+}
+
+type EventRenewContract struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Provider      []byte        `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	ContractId    uint64        `protobuf:"varint,2,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	Service       string        `protobuf:"bytes,3,opt,name=service,proto3" json:"service,omitempty"`
+	Client        []byte        `protobuf:"bytes,4,opt,name=client,proto3" json:"client,omitempty"`
+	Delegate      []byte        `protobuf:"bytes,5,opt,name=delegate,proto3" json:"delegate,omitempty"`
+	Type_         ContractType  `protobuf:"varint,6,opt,name=type,proto3,enum=arkeo.arkeo.ContractType" json:"type,omitempty"`
+	OldExpiration int64         `protobuf:"varint,7,opt,name=old_expiration,json=oldExpiration,proto3" json:"old_expiration,omitempty"`
+	NewExpiration int64         `protobuf:"varint,8,opt,name=new_expiration,json=newExpiration,proto3" json:"new_expiration,omitempty"`
+	Rate          *v1beta1.Coin `protobuf:"bytes,9,opt,name=rate,proto3" json:"rate,omitempty"`
+	ExtraDeposit  string        `protobuf:"bytes,10,opt,name=extra_deposit,json=extraDeposit,proto3" json:"extra_deposit,omitempty"`
+	Deposit       string        `protobuf:"bytes,11,opt,name=deposit,proto3" json:"deposit,omitempty"`
+}
+
+func (x *EventRenewContract) Reset() {
+	*x = EventRenewContract{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_arkeo_arkeo_events_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventRenewContract) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventRenewContract) ProtoMessage() {}
+
+// Deprecated: Use EventRenewContract.ProtoReflect.Descriptor instead.
+func (*EventRenewContract) Descriptor() ([]byte, []int) {
+	return file_arkeo_arkeo_events_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *EventRenewContract) GetProvider() []byte {
+	if x != nil {
+		return x.Provider
+	}
+	return nil
+}
+
+func (x *EventRenewContract) GetContractId() uint64 {
+	if x != nil {
+		return x.ContractId
+	}
+	return 0
+}
+
+func (x *EventRenewContract) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *EventRenewContract) GetClient() []byte {
+	if x != nil {
+		return x.Client
+	}
+	return nil
+}
+
+func (x *EventRenewContract) GetDelegate() []byte {
+	if x != nil {
+		return x.Delegate
+	}
+	return nil
+}
+
+func (x *EventRenewContract) GetType_() ContractType {
+	if x != nil {
+		return x.Type_
+	}
+	return ContractType_SUBSCRIPTION
+}
+
+func (x *EventRenewContract) GetOldExpiration() int64 {
+	if x != nil {
+		return x.OldExpiration
+	}
+	return 0
+}
+
+func (x *EventRenewContract) GetNewExpiration() int64 {
+	if x != nil {
+		return x.NewExpiration
+	}
+	return 0
+}
+
+func (x *EventRenewContract) GetRate() *v1beta1.Coin {
+	if x != nil {
+		return x.Rate
+	}
+	return nil
+}
+
+func (x *EventRenewContract) GetExtraDeposit() string {
+	if x != nil {
+		return x.ExtraDeposit
+	}
+	return ""
+}
+
+func (x *EventRenewContract) GetDeposit() string {
+	if x != nil {
+		return x.Deposit
+	}
+	return ""
+}
+
+// EventRotateDelegate is emitted once the client of the contract replaced its delegate, the claims signed by the old
+// one are no longer accepted
+type EventRotateDelegate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Provider    []byte `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	ContractId  uint64 `protobuf:"varint,2,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	Service     string `protobuf:"bytes,3,opt,name=service,proto3" json:"service,omitempty"`
+	Client      []byte `protobuf:"bytes,4,opt,name=client,proto3" json:"client,omitempty"`
+	OldDelegate []byte `protobuf:"bytes,5,opt,name=old_delegate,json=oldDelegate,proto3" json:"old_delegate,omitempty"`
+	NewDelegate []byte `protobuf:"bytes,6,opt,name=new_delegate,json=newDelegate,proto3" json:"new_delegate,omitempty"`
+}
+
+func (x *EventRotateDelegate) Reset() {
+	*x = EventRotateDelegate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_arkeo_arkeo_events_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventRotateDelegate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventRotateDelegate) ProtoMessage() {}
+
+// Deprecated: Use EventRotateDelegate.ProtoReflect.Descriptor instead.
+func (*EventRotateDelegate) Descriptor() ([]byte, []int) {
+	return file_arkeo_arkeo_events_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *EventRotateDelegate) GetProvider() []byte {
+	if x != nil {
+		return x.Provider
+	}
+	return nil
+}
+
+func (x *EventRotateDelegate) GetContractId() uint64 {
+	if x != nil {
+		return x.ContractId
+	}
+	return 0
+}
+
+func (x *EventRotateDelegate) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *EventRotateDelegate) GetClient() []byte {
+	if x != nil {
+		return x.Client
+	}
+	return nil
+}
+
+func (x *EventRotateDelegate) GetOldDelegate() []byte {
+	if x != nil {
+		return x.OldDelegate
+	}
+	return nil
+}
+
+func (x *EventRotateDelegate) GetNewDelegate() []byte {
+	if x != nil {
+		return x.NewDelegate
+	}
+	return nil
+}
+
+// EventSetAutoRenew is emitted once the client of the subscription turned its
+// auto-renewal on or off, the top-up balance being refunded when off
+type EventSetAutoRenew struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Provider   []byte `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	ContractId uint64 `protobuf:"varint,2,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	Service    string `protobuf:"bytes,3,opt,name=service,proto3" json:"service,omitempty"`
+	Client     []byte `protobuf:"bytes,4,opt,name=client,proto3" json:"client,omitempty"`
+	AutoRenew  bool   `protobuf:"varint,5,opt,name=auto_renew,json=autoRenew,proto3" json:"auto_renew,omitempty"`
+	Refund     string `protobuf:"bytes,6,opt,name=refund,proto3" json:"refund,omitempty"`
+}
+
+func (x *EventSetAutoRenew) Reset() {
+	*x = EventSetAutoRenew{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_arkeo_arkeo_events_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventSetAutoRenew) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventSetAutoRenew) ProtoMessage() {}
+
+// Deprecated: Use EventSetAutoRenew.ProtoReflect.Descriptor instead.
+func (*EventSetAutoRenew) Descriptor() ([]byte, []int) {
+	return file_arkeo_arkeo_events_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *EventSetAutoRenew) GetProvider() []byte {
+	if x != nil {
+		return x.Provider
+	}
+	return nil
+}
+
+func (x *EventSetAutoRenew) GetContractId() uint64 {
+	if x != nil {
+		return x.ContractId
+	}
+	return 0
+}
+
+func (x *EventSetAutoRenew) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *EventSetAutoRenew) GetClient() []byte {
+	if x != nil {
+		return x.Client
+	}
+	return nil
+}
+
+func (x *EventSetAutoRenew) GetAutoRenew() bool {
+	if x != nil {
+		return x.AutoRenew
+	}
+	return false
+}
+
+func (x *EventSetAutoRenew) GetRefund() string {
+	if x != nil {
+		return x.Refund
+	}
+	return ""
+}
+
+// EventTopUpContract is emitted once the client topped up the subscription.
+// The expiration is unchanged while the contract auto-renews, the top-up
+// waiting in its balance until then
+type EventTopUpContract struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Provider      []byte `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	ContractId    uint64 `protobuf:"varint,2,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	Service       string `protobuf:"bytes,3,opt,name=service,proto3" json:"service,omitempty"`
+	Client        []byte `protobuf:"bytes,4,opt,name=client,proto3" json:"client,omitempty"`
+	OldExpiration int64  `protobuf:"varint,5,opt,name=old_expiration,json=oldExpiration,proto3" json:"old_expiration,omitempty"`
+	NewExpiration int64  `protobuf:"varint,6,opt,name=new_expiration,json=newExpiration,proto3" json:"new_expiration,omitempty"`
+	TopUp         string `protobuf:"bytes,7,opt,name=top_up,json=topUp,proto3" json:"top_up,omitempty"`
+	Deposit       string `protobuf:"bytes,8,opt,name=deposit,proto3" json:"deposit,omitempty"`
+	// top-up balance left
+	Balance string `protobuf:"bytes,9,opt,name=balance,proto3" json:"balance,omitempty"`
+}
+
+func (x *EventTopUpContract) Reset() {
+	*x = EventTopUpContract{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_arkeo_arkeo_events_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventTopUpContract) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventTopUpContract) ProtoMessage() {}
+
+// Deprecated: Use EventTopUpContract.ProtoReflect.Descriptor instead.
+func (*EventTopUpContract) Descriptor() ([]byte, []int) {
+	return file_arkeo_arkeo_events_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *EventTopUpContract) GetProvider() []byte {
+	if x != nil {
+		return x.Provider
+	}
+	return nil
+}
+
+func (x *EventTopUpContract) GetContractId() uint64 {
+	if x != nil {
+		return x.ContractId
+	}
+	return 0
+}
+
+func (x *EventTopUpContract) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *EventTopUpContract) GetClient() []byte {
+	if x != nil {
+		return x.Client
+	}
+	return nil
+}
+
+func (x *EventTopUpContract) GetOldExpiration() int64 {
+	if x != nil {
+		return x.OldExpiration
+	}
+	return 0
+}
+
+func (x *EventTopUpContract) GetNewExpiration() int64 {
+	if x != nil {
+		return x.NewExpiration
+	}
+	return 0
+}
+
+func (x *EventTopUpContract) GetTopUp() string {
+	if x != nil {
+		return x.TopUp
+	}
+	return ""
+}
+
+func (x *EventTopUpContract) GetDeposit() string {
+	if x != nil {
+		return x.Deposit
+	}
+	return ""
+}
+
+func (x *EventTopUpContract) GetBalance() string {
+	if x != nil {
+		return x.Balance
+	}
+	return ""
+}
+
+type EventSlashProvider struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Provider   []byte `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Service    string `protobuf:"bytes,2,opt,name=service,proto3" json:"service,omitempty"`
+	ContractId uint64 `protobuf:"varint,3,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	Reason     string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	// slashed from the bond to the reserve
+	Amount string `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	// bond left
+	Bond   string `protobuf:"bytes,6,opt,name=bond,proto3" json:"bond,omitempty"`
+	Faults int64  `protobuf:"varint,7,opt,name=faults,proto3" json:"faults,omitempty"`
+}
+
+func (x *EventSlashProvider) Reset() {
+	*x = EventSlashProvider{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_arkeo_arkeo_events_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventSlashProvider) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventSlashProvider) ProtoMessage() {}
+
+// Deprecated: Use EventSlashProvider.ProtoReflect.Descriptor instead.
+func (*EventSlashProvider) Descriptor() ([]byte, []int) {
+	return file_arkeo_arkeo_events_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *EventSlashProvider) GetProvider() []byte {
+	if x != nil {
+		return x.Provider
+	}
+	return nil
+}
+
+func (x *EventSlashProvider) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *EventSlashProvider) GetContractId() uint64 {
+	if x != nil {
+		return x.ContractId
+	}
+	return 0
+}
+
+func (x *EventSlashProvider) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *EventSlashProvider) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *EventSlashProvider) GetBond() string {
+	if x != nil {
+		return x.Bond
+	}
+	return ""
+}
+
+func (x *EventSlashProvider) GetFaults() int64 {
+	if x != nil {
+		return x.Faults
+	}
+	return 0
+}
+
+type EventValidatorPayout struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Validator []byte `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	Reward    string `protobuf:"bytes,2,opt,name=reward,proto3" json:"reward,omitempty"`
+}
+
+func (x *EventValidatorPayout) Reset() {
+	*x = EventValidatorPayout{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_arkeo_arkeo_events_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventValidatorPayout) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventValidatorPayout) ProtoMessage() {}
+
+// Deprecated: Use EventValidatorPayout.ProtoReflect.Descriptor instead.
+func (*EventValidatorPayout) Descriptor() ([]byte, []int) {
+	return file_arkeo_arkeo_events_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *EventValidatorPayout) GetValidator() []byte {
+	if x != nil {
+		return x.Validator
+	}
+	return nil
+}
+
+func (x *EventValidatorPayout) GetReward() string {
+	if x != nil {
+		return x.Reward
+	}
+	return ""
+}
+
+// ParamChange is a module param changed, its values are JSON encoded
+type ParamChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key      string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	OldValue string `protobuf:"bytes,2,opt,name=old_value,json=oldValue,proto3" json:"old_value,omitempty"`
+	NewValue string `protobuf:"bytes,3,opt,name=new_value,json=newValue,proto3" json:"new_value,omitempty"`
+}
+
+func (x *ParamChange) Reset() {
+	*x = ParamChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_arkeo_arkeo_events_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ParamChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParamChange) ProtoMessage() {}
+
+// Deprecated: Use ParamChange.ProtoReflect.Descriptor instead.
+func (*ParamChange) Descriptor() ([]byte, []int) {
+	return file_arkeo_arkeo_events_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *EventBondProvider) GetService() string {
+func (x *ParamChange) GetKey() string {
 	if x != nil {
-		return x.Service
+		return x.Key
 	}
 	return ""
 }
 
-func (x *EventBondProvider) GetBondRel() string {
+func (x *ParamChange) GetOldValue() string {
 	if x != nil {
-		return x.BondRel
+		return x.OldValue
 	}
 	return ""
 }
 
-func (x *EventBondProvider) GetBondAbs() string {
+func (x *ParamChange) GetNewValue() string {
 	if x != nil {
-		return x.BondAbs
+		return x.NewValue
 	}
 	return ""
 }
 
-type EventModProvider struct {
+type EventParamsUpdated struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Creator             []byte          `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	Provider            []byte          `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
-	Service             string          `protobuf:"bytes,3,opt,name=service,proto3" json:"service,omitempty"`
-	MetadataUri         string          `protobuf:"bytes,4,opt,name=metadata_uri,json=metadataUri,proto3" json:"metadata_uri,omitempty"`
-	MetadataNonce       uint64          `protobuf:"varint,5,opt,name=metadata_nonce,json=metadataNonce,proto3" json:"metadata_nonce,omitempty"`
-	Status              ProviderStatus  `protobuf:"varint,6,opt,name=status,proto3,enum=arkeo.arkeo.ProviderStatus" json:"status,omitempty"`
-	MinContractDuration int64           `protobuf:"varint,7,opt,name=min_contract_duration,json=minContractDuration,proto3" json:"min_contract_duration,omitempty"`
-	MaxContractDuration int64           `protobuf:"varint,8,opt,name=max_contract_duration,json=maxContractDuration,proto3" json:"max_contract_duration,omitempty"`
-	SubscriptionRate    []*v1beta1.Coin `protobuf:"bytes,9,rep,name=subscription_rate,json=subscriptionRate,proto3" json:"subscription_rate,omitempty"`
-	PayAsYouGoRate      []*v1beta1.Coin `protobuf:"bytes,10,rep,name=pay_as_you_go_rate,json=payAsYouGoRate,proto3" json:"pay_as_you_go_rate,omitempty"`
-	Bond                string          `protobuf:"bytes,11,opt,name=bond,proto3" json:"bond,omitempty"`
-	SettlementDuration  int64           `protobuf:"varint,12,opt,name=settlement_duration,json=settlementDuration,proto3" json:"settlement_duration,omitempty"`
+	Height  int64          `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Changes []*ParamChange `protobuf:"bytes,2,rep,name=changes,proto3" json:"changes,omitempty"`
 }
 
-func (x *EventModProvider) Reset() {
-	*x = EventModProvider{}
+func (x *EventParamsUpdated) Reset() {
+	*x = EventParamsUpdated{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_arkeo_arkeo_events_proto_msgTypes[1]
+		mi := &file_arkeo_arkeo_events_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EventModProvider) String() string {
+func (x *EventParamsUpdated) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventModProvider) ProtoMessage() {}
+func (*EventParamsUpdated) ProtoMessage() {}
 
-// Deprecated: Use EventModProvider.ProtoReflect.Descriptor instead.
-func (*EventModProvider) Descriptor() ([]byte, []int) {
-	return file_arkeo_arkeo_events_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use EventParamsUpdated.ProtoReflect.Descriptor instead.
+func (*EventParamsUpdated) Descriptor() ([]byte, []int) {
+	return file_arkeo_arkeo_events_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *EventModProvider) GetCreator() []byte {
+func (x *EventParamsUpdated) GetHeight() int64 {
 	if x != nil {
-		return x.Creator
+		return x.Height
 	}
-	return nil
+	return 0
 }
 
-func (x *EventModProvider) GetProvider() []byte {
+func (x *EventParamsUpdated) GetChanges() []*ParamChange {
 	if x != nil {
-		return x.Provider
+		return x.Changes
 	}
 	return nil
 }
 
-func (x *EventModProvider) GetService() string {
-	if x != nil {
-		return x.Service
-	}
-	return ""
+// EventContractExpired is emitted once by the end blocker, at the height the
+// contract expires
+type EventContractExpired struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ContractId uint64       `protobuf:"varint,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	Provider   []byte       `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+	Service    string       `protobuf:"bytes,3,opt,name=service,proto3" json:"service,omitempty"`
+	Client     []byte       `protobuf:"bytes,4,opt,name=client,proto3" json:"client,omitempty"`
+	Type_      ContractType `protobuf:"varint,5,opt,name=type,proto3,enum=arkeo.arkeo.ContractType" json:"type,omitempty"`
+	Expiration int64        `protobuf:"varint,6,opt,name=expiration,proto3" json:"expiration,omitempty"`
+	// settlement_pending is true when the contract is settled at the end of its
+	// settlement period, later on, claims being still accepted until then
+	SettlementPending bool `protobuf:"varint,7,opt,name=settlement_pending,json=settlementPending,proto3" json:"settlement_pending,omitempty"`
 }
 
-func (x *EventModProvider) GetMetadataUri() string {
-	if x != nil {
-		return x.MetadataUri
+func (x *EventContractExpired) Reset() {
+	*x = EventContractExpired{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_arkeo_arkeo_events_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *EventModProvider) GetMetadataNonce() uint64 {
-	if x != nil {
-		return x.MetadataNonce
-	}
-	return 0
+func (x *EventContractExpired) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *EventModProvider) GetStatus() ProviderStatus {
-	if x != nil {
-		return x.Status
-	}
-	return ProviderStatus_OFFLINE
+func (*EventContractExpired) ProtoMessage() {}
+
+// Deprecated: Use EventContractExpired.ProtoReflect.Descriptor instead.
+func (*EventContractExpired) Descriptor() ([]byte, []int) {
+	return file_arkeo_arkeo_events_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *EventModProvider) GetMinContractDuration() int64 {
+func (x *EventContractExpired) GetContractId() uint64 {
 	if x != nil {
-		return x.MinContractDuration
+		return x.ContractId
 	}
 	return 0
 }
 
-func (x *EventModProvider) GetMaxContractDuration() int64 {
+func (x *EventContractExpired) GetProvider() []byte {
 	if x != nil {
-		return x.MaxContractDuration
+		return x.Provider
 	}
-	return 0
+	return nil
 }
 
-func (x *EventModProvider) GetSubscriptionRate() []*v1beta1.Coin {
+func (x *EventContractExpired) GetService() string {
 	if x != nil {
-		return x.SubscriptionRate
+		return x.Service
 	}
-	return nil
+	return ""
 }
 
-func (x *EventModProvider) GetPayAsYouGoRate() []*v1beta1.Coin {
+func (x *EventContractExpired) GetClient() []byte {
 	if x != nil {
-		return x.PayAsYouGoRate
+		return x.Client
 	}
 	return nil
 }
 
-func (x *EventModProvider) GetBond() string {
+func (x *EventContractExpired) GetType_() ContractType {
 	if x != nil {
-		return x.Bond
+		return x.Type_
 	}
-	return ""
+	return ContractType_SUBSCRIPTION
 }
 
-func (x *EventModProvider) GetSettlementDuration() int64 {
+func (x *EventContractExpired) GetExpiration() int64 {
 	if x != nil {
-		return x.SettlementDuration
+		return x.Expiration
 	}
 	return 0
 }
 
-type EventOpenContract struct {
+func (x *EventContractExpired) GetSettlementPending() bool {
+	if x != nil {
+		return x.SettlementPending
+	}
+	return false
+}
+
+// EventContractAutoRenewed is emitted by the end blocker in place of the
+// expiration of an auto-renewed subscription, extended out of its top-up
+// balance
+type EventContractAutoRenewed struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Provider           []byte                `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
-	ContractId         uint64                `protobuf:"varint,2,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
-	Service            string                `protobuf:"bytes,3,opt,name=service,proto3" json:"service,omitempty"`
-	Client             []byte                `protobuf:"bytes,4,opt,name=client,proto3" json:"client,omitempty"`
-	Delegate           []byte                `protobuf:"bytes,5,opt,name=delegate,proto3" json:"delegate,omitempty"`
-	Type_              ContractType          `protobuf:"varint,6,opt,name=type,proto3,enum=arkeo.arkeo.ContractType" json:"type,omitempty"`
-	Height             int64                 `protobuf:"varint,7,opt,name=height,proto3" json:"height,omitempty"`
-	Duration           int64                 `protobuf:"varint,8,opt,name=duration,proto3" json:"duration,omitempty"`
-	Rate               *v1beta1.Coin         `protobuf:"bytes,9,opt,name=rate,proto3" json:"rate,omitempty"`
-	OpenCost           int64                 `protobuf:"varint,10,opt,name=open_cost,json=openCost,proto3" json:"open_cost,omitempty"`
-	Deposit            string                `protobuf:"bytes,11,opt,name=deposit,proto3" json:"deposit,omitempty"`
-	SettlementDuration int64                 `protobuf:"varint,12,opt,name=settlement_duration,json=settlementDuration,proto3" json:"settlement_duration,omitempty"`
-	Authorization      ContractAuthorization `protobuf:"varint,13,opt,name=authorization,proto3,enum=arkeo.arkeo.ContractAuthorization" json:"authorization,omitempty"`
-	QueriesPerMinute   int64                 `protobuf:"varint,14,opt,name=queries_per_minute,json=queriesPerMinute,proto3" json:"queries_per_minute,omitempty"`
+	ContractId    uint64 `protobuf:"varint,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	Provider      []byte `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+	Service       string `protobuf:"bytes,3,opt,name=service,proto3" json:"service,omitempty"`
+	Client        []byte `protobuf:"bytes,4,opt,name=client,proto3" json:"client,omitempty"`
+	OldExpiration int64  `protobuf:"varint,5,opt,name=old_expiration,json=oldExpiration,proto3" json:"old_expiration,omitempty"`
+	NewExpiration int64  `protobuf:"varint,6,opt,name=new_expiration,json=newExpiration,proto3" json:"new_expiration,omitempty"`
+	// spent out of the top-up balance, added to the deposit
+	Spent   string `protobuf:"bytes,7,opt,name=spent,proto3" json:"spent,omitempty"`
+	Deposit string `protobuf:"bytes,8,opt,name=deposit,proto3" json:"deposit,omitempty"`
+	// top-up balance left
+	Balance string `protobuf:"bytes,9,opt,name=balance,proto3" json:"balance,omitempty"`
 }
 
-func (x *EventOpenContract) Reset() {
-	*x = EventOpenContract{}
+func (x *EventContractAutoRenewed) Reset() {
+	*x = EventContractAutoRenewed{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_arkeo_arkeo_events_proto_msgTypes[2]
+		mi := &file_arkeo_arkeo_events_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EventOpenContract) String() string {
+func (x *EventContractAutoRenewed) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventOpenContract) ProtoMessage() {}
+func (*EventContractAutoRenewed) ProtoMessage() {}
 
-// Deprecated: Use EventOpenContract.ProtoReflect.Descriptor instead.
-func (*EventOpenContract) Descriptor() ([]byte, []int) {
-	return file_arkeo_arkeo_events_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use EventContractAutoRenewed.ProtoReflect.Descriptor instead.
+func (*EventContractAutoRenewed) Descriptor() ([]byte, []int) {
+	return file_arkeo_arkeo_events_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *EventOpenContract) GetProvider() []byte {
+func (x *EventContractAutoRenewed) GetContractId() uint64 {
 	if x != nil {
-		return x.Provider
+		return x.ContractId
 	}
-	return nil
+	return 0
 }
 
-func (x *EventOpenContract) GetContractId() uint64 {
+func (x *EventContractAutoRenewed) GetProvider() []byte {
 	if x != nil {
-		return x.ContractId
+		return x.Provider
 	}
-	return 0
+	return nil
 }
 
-func (x *EventOpenContract) GetService() string {
+func (x *EventContractAutoRenewed) GetService() string {
 	if x != nil {
 		return x.Service
 	}
 	return ""
 }
 
-func (x *EventOpenContract) GetClient() []byte {
+func (x *EventContractAutoRenewed) GetClient() []byte {
 	if x != nil {
 		return x.Client
 	}
 	return nil
 }
 
-func (x *EventOpenContract) GetDelegate() []byte {
+func (x *EventContractAutoRenewed) GetOldExpiration() int64 {
 	if x != nil {
-		return x.Delegate
+		return x.OldExpiration
 	}
-	return nil
+	return 0
 }
 
-func (x *EventOpenContract) GetType_() ContractType {
+func (x *EventContractAutoRenewed) GetNewExpiration() int64 {
 	if x != nil {
-		return x.Type_
+		return x.NewExpiration
 	}
-	return ContractType_SUBSCRIPTION
+	return 0
 }
 
-func (x *EventOpenContract) GetHeight() int64 {
+func (x *EventContractAutoRenewed) GetSpent() string {
 	if x != nil {
-		return x.Height
+		return x.Spent
 	}
-	return 0
+	return ""
 }
 
-func (x *EventOpenContract) GetDuration() int64 {
+func (x *EventContractAutoRenewed) GetDeposit() string {
 	if x != nil {
-		return x.Duration
+		return x.Deposit
 	}
-	return 0
+	return ""
 }
 
-func (x *EventOpenContract) GetRate() *v1beta1.Coin {
+func (x *EventContractAutoRenewed) GetBalance() string {
 	if x != nil {
-		return x.Rate
+		return x.Balance
 	}
-	return nil
+	return ""
 }
 
-func (x *EventOpenContract) GetOpenCost() int64 {
-	if x != nil {
-		return x.OpenCost
-	}
-	return 0
+// EventClaimContractIncomeBatch is emitted once the claims of a batch are
+// processed, with the outcome of each of them
+type EventClaimContractIncomeBatch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Creator string                 `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Results []*ContractClaimResult `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
 }
 
-func (x *EventOpenContract) GetDeposit() string {
-	if x != nil {
-		return x.Deposit
+func (x *EventClaimContractIncomeBatch) Reset() {
+	*x = EventClaimContractIncomeBatch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_arkeo_arkeo_events_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *EventOpenContract) GetSettlementDuration() int64 {
-	if x != nil {
-		return x.SettlementDuration
-	}
-	return 0
+func (x *EventClaimContractIncomeBatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *EventOpenContract) GetAuthorization() ContractAuthorization {
+func (*EventClaimContractIncomeBatch) ProtoMessage() {}
+
+// Deprecated: Use EventClaimContractIncomeBatch.ProtoReflect.Descriptor instead.
+func (*EventClaimContractIncomeBatch) Descriptor() ([]byte, []int) {
+	return file_arkeo_arkeo_events_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *EventClaimContractIncomeBatch) GetCreator() string {
 	if x != nil {
-		return x.Authorization
+		return x.Creator
 	}
-	return ContractAuthorization_STRICT
+	return ""
 }
 
-func (x *EventOpenContract) GetQueriesPerMinute() int64 {
+func (x *EventClaimContractIncomeBatch) GetResults() []*ContractClaimResult {
 	if x != nil {
-		return x.QueriesPerMinute
+		return x.Results
 	}
-	return 0
+	return nil
 }
 
-type EventSettleContract struct {
+// EventPurgeExpiredContracts is emitted once the contracts of a purge are
+// processed, with the outcome of each of them and the reward of the creator
+type EventPurgeExpiredContracts struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Provider   []byte       `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
-	ContractId uint64       `protobuf:"varint,2,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
-	Service    string       `protobuf:"bytes,3,opt,name=service,proto3" json:"service,omitempty"`
-	Client     []byte       `protobuf:"bytes,4,opt,name=client,proto3" json:"client,omitempty"`
-	Delegate   []byte       `protobuf:"bytes,5,opt,name=delegate,proto3" json:"delegate,omitempty"`
-	Type_      ContractType `protobuf:"varint,6,opt,name=type,proto3,enum=arkeo.arkeo.ContractType" json:"type,omitempty"`
-	Nonce      int64        `protobuf:"varint,7,opt,name=nonce,proto3" json:"nonce,omitempty"`
-	Height     int64        `protobuf:"varint,8,opt,name=height,proto3" json:"height,omitempty"`
-	Paid       string       `protobuf:"bytes,9,opt,name=paid,proto3" json:"paid,omitempty"`
-	Reserve    string       `protobuf:"bytes,10,opt,name=reserve,proto3" json:"reserve,omitempty"`
+	Creator string                 `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Results []*ContractPurgeResult `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+	Reward  string                 `protobuf:"bytes,3,opt,name=reward,proto3" json:"reward,omitempty"`
 }
 
-func (x *EventSettleContract) Reset() {
-	*x = EventSettleContract{}
+func (x *EventPurgeExpiredContracts) Reset() {
+	*x = EventPurgeExpiredContracts{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_arkeo_arkeo_events_proto_msgTypes[3]
+		mi := &file_arkeo_arkeo_events_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EventSettleContract) String() string {
+func (x *EventPurgeExpiredContracts) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventSettleContract) ProtoMessage() {}
+func (*EventPurgeExpiredContracts) ProtoMessage() {}
 
-// Deprecated: Use EventSettleContract.ProtoReflect.Descriptor instead.
-func (*EventSettleContract) Descriptor() ([]byte, []int) {
-	return file_arkeo_arkeo_events_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use EventPurgeExpiredContracts.ProtoReflect.Descriptor instead.
+func (*EventPurgeExpiredContracts) Descriptor() ([]byte, []int) {
+	return file_arkeo_arkeo_events_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *EventSettleContract) GetProvider() []byte {
+func (x *EventPurgeExpiredContracts) GetCreator() string {
 	if x != nil {
-		return x.Provider
+		return x.Creator
 	}
-	return nil
+	return ""
 }
 
-func (x *EventSettleContract) GetContractId() uint64 {
+func (x *EventPurgeExpiredContracts) GetResults() []*ContractPurgeResult {
 	if x != nil {
-		return x.ContractId
+		return x.Results
 	}
-	return 0
+	return nil
 }
 
-func (x *EventSettleContract) GetService() string {
+func (x *EventPurgeExpiredContracts) GetReward() string {
 	if x != nil {
-		return x.Service
+		return x.Reward
 	}
 	return ""
 }
 
-func (x *EventSettleContract) GetClient() []byte {
-	if x != nil {
-		return x.Client
-	}
-	return nil
+// EventProviderDemoted is emitted as the bond of an online provider is left
+// below the minimum of its service, setting it offline
+type EventProviderDemoted struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Provider []byte `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Service  string `protobuf:"bytes,2,opt,name=service,proto3" json:"service,omitempty"`
+	Bond     string `protobuf:"bytes,3,opt,name=bond,proto3" json:"bond,omitempty"`
+	MinBond  string `protobuf:"bytes,4,opt,name=min_bond,json=minBond,proto3" json:"min_bond,omitempty"`
 }
 
-func (x *EventSettleContract) GetDelegate() []byte {
-	if x != nil {
-		return x.Delegate
+func (x *EventProviderDemoted) Reset() {
+	*x = EventProviderDemoted{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_arkeo_arkeo_events_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *EventSettleContract) GetType_() ContractType {
-	if x != nil {
-		return x.Type_
-	}
-	return ContractType_SUBSCRIPTION
+func (x *EventProviderDemoted) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *EventSettleContract) GetNonce() int64 {
+func (*EventProviderDemoted) ProtoMessage() {}
+
+// Deprecated: Use EventProviderDemoted.ProtoReflect.Descriptor instead.
+func (*EventProviderDemoted) Descriptor() ([]byte, []int) {
+	return file_arkeo_arkeo_events_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *EventProviderDemoted) GetProvider() []byte {
 	if x != nil {
-		return x.Nonce
+		return x.Provider
 	}
-	return 0
+	return nil
 }
 
-func (x *EventSettleContract) GetHeight() int64 {
+func (x *EventProviderDemoted) GetService() string {
 	if x != nil {
-		return x.Height
+		return x.Service
 	}
-	return 0
+	return ""
 }
 
-func (x *EventSettleContract) GetPaid() string {
+func (x *EventProviderDemoted) GetBond() string {
 	if x != nil {
-		return x.Paid
+		return x.Bond
 	}
 	return ""
 }
 
-func (x *EventSettleContract) GetReserve() string {
+func (x *EventProviderDemoted) GetMinBond() string {
 	if x != nil {
-		return x.Reserve
+		return x.MinBond
 	}
 	return ""
 }
 
-type EventCloseContract struct {
+// EventContractActivated is emitted by the end blocker as a scheduled contract
+// reaches its start height, its duration running from then on
+type EventContractActivated struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ContractId uint64 `protobuf:"varint,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
-	Provider   []byte `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
-	Service    string `protobuf:"bytes,3,opt,name=service,proto3" json:"service,omitempty"`
-	Client     []byte `protobuf:"bytes,4,opt,name=client,proto3" json:"client,omitempty"`
-	Delegate   []byte `protobuf:"bytes,5,opt,name=delegate,proto3" json:"delegate,omitempty"`
+	ContractId  uint64       `protobuf:"varint,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	Provider    []byte       `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+	Service     string       `protobuf:"bytes,3,opt,name=service,proto3" json:"service,omitempty"`
+	Client      []byte       `protobuf:"bytes,4,opt,name=client,proto3" json:"client,omitempty"`
+	Type_       ContractType `protobuf:"varint,5,opt,name=type,proto3,enum=arkeo.arkeo.ContractType" json:"type,omitempty"`
+	StartHeight int64        `protobuf:"varint,6,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	Expiration  int64        `protobuf:"varint,7,opt,name=expiration,proto3" json:"expiration,omitempty"`
 }
 
-func (x *EventCloseContract) Reset() {
-	*x = EventCloseContract{}
+func (x *EventContractActivated) Reset() {
+	*x = EventContractActivated{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_arkeo_arkeo_events_proto_msgTypes[4]
+		mi := &file_arkeo_arkeo_events_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EventCloseContract) String() string {
+func (x *EventContractActivated) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventCloseContract) ProtoMessage() {}
+func (*EventContractActivated) ProtoMessage() {}
 
-// Deprecated: Use EventCloseContract.ProtoReflect.Descriptor instead.
-func (*EventCloseContract) Descriptor() ([]byte, []int) {
-	return file_arkeo_arkeo_events_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use EventContractActivated.ProtoReflect.Descriptor instead.
+func (*EventContractActivated) Descriptor() ([]byte, []int) {
+	return file_arkeo_arkeo_events_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *EventCloseContract) GetContractId() uint64 {
+func (x *EventContractActivated) GetContractId() uint64 {
 	if x != nil {
 		return x.ContractId
 	}
 	return 0
 }
 
-func (x *EventCloseContract) GetProvider() []byte {
+func (x *EventContractActivated) GetProvider() []byte {
 	if x != nil {
 		return x.Provider
 	}
 	return nil
 }
 
-func (x *EventCloseContract) GetService() string {
+func (x *EventContractActivated) GetService() string {
 	if x != nil {
 		return x.Service
 	}
 	return ""
 }
 
-func (x *EventCloseContract) GetClient() []byte {
+func (x *EventContractActivated) GetClient() []byte {
 	if x != nil {
 		return x.Client
 	}
 	return nil
 }
 
-func (x *EventCloseContract) GetDelegate() []byte {
+func (x *EventContractActivated) GetType_() ContractType {
 	if x != nil {
-		return x.Delegate
+		return x.Type_
 	}
-	return nil
+	return ContractType_SUBSCRIPTION
 }
 
-type EventValidatorPayout struct {
+func (x *EventContractActivated) GetStartHeight() int64 {
+	if x != nil {
+		return x.StartHeight
+	}
+	return 0
+}
+
+func (x *EventContractActivated) GetExpiration() int64 {
+	if x != nil {
+		return x.Expiration
+	}
+	return 0
+}
+
+// EventSetProviderAllowlist is emitted as a provider updates the clients
+// allowed to open contracts with it, carrying the allowlist it is left with
+type EventSetProviderAllowlist struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Validator []byte `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
-	Reward    string `protobuf:"bytes,2,opt,name=reward,proto3" json:"reward,omitempty"`
+	Provider []byte          `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Service  string          `protobuf:"bytes,2,opt,name=service,proto3" json:"service,omitempty"`
+	Action   AllowlistAction `protobuf:"varint,3,opt,name=action,proto3,enum=arkeo.arkeo.AllowlistAction" json:"action,omitempty"`
+	Clients  [][]byte        `protobuf:"bytes,4,rep,name=clients,proto3" json:"clients,omitempty"`
 }
 
-func (x *EventValidatorPayout) Reset() {
-	*x = EventValidatorPayout{}
+func (x *EventSetProviderAllowlist) Reset() {
+	*x = EventSetProviderAllowlist{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_arkeo_arkeo_events_proto_msgTypes[5]
+		mi := &file_arkeo_arkeo_events_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EventValidatorPayout) String() string {
+func (x *EventSetProviderAllowlist) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventValidatorPayout) ProtoMessage() {}
+func (*EventSetProviderAllowlist) ProtoMessage() {}
 
-// Deprecated: Use EventValidatorPayout.ProtoReflect.Descriptor instead.
-func (*EventValidatorPayout) Descriptor() ([]byte, []int) {
-	return file_arkeo_arkeo_events_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use EventSetProviderAllowlist.ProtoReflect.Descriptor instead.
+func (*EventSetProviderAllowlist) Descriptor() ([]byte, []int) {
+	return file_arkeo_arkeo_events_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *EventValidatorPayout) GetValidator() []byte {
+func (x *EventSetProviderAllowlist) GetProvider() []byte {
 	if x != nil {
-		return x.Validator
+		return x.Provider
 	}
 	return nil
 }
 
-func (x *EventValidatorPayout) GetReward() string {
+func (x *EventSetProviderAllowlist) GetService() string {
 	if x != nil {
-		return x.Reward
+		return x.Service
 	}
 	return ""
 }
 
+func (x *EventSetProviderAllowlist) GetAction() AllowlistAction {
+	if x != nil {
+		return x.Action
+	}
+	return AllowlistAction_ALLOWLIST_REPLACE
+}
+
+func (x *EventSetProviderAllowlist) GetClients() [][]byte {
+	if x != nil {
+		return x.Clients
+	}
+	return nil
+}
+
 var File_arkeo_arkeo_events_proto protoreflect.FileDescriptor
 
 var file_arkeo_arkeo_events_proto_rawDesc = []byte{
@@ -5623,7 +18112,7 @@ var file_arkeo_arkeo_events_proto_rawDesc = []byte{
 	0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f,
 	0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x18, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2f,
 	0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2f, 0x6b, 0x65, 0x65, 0x70, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x22, 0x8a, 0x02, 0x0a, 0x11, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x42, 0x6f, 0x6e, 0x64,
+	0x74, 0x6f, 0x22, 0xa9, 0x02, 0x0a, 0x11, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x42, 0x6f, 0x6e, 0x64,
 	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x4b, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76,
 	0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x2f, 0xfa, 0xde, 0x1f, 0x2b,
 	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f,
@@ -5639,170 +18128,513 @@ var file_arkeo_arkeo_events_proto_rawDesc = []byte{
 	0x61, 0x62, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2b, 0xc8, 0xde, 0x1f, 0x00, 0xda,
 	0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f,
 	0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x07, 0x62, 0x6f, 0x6e, 0x64, 0x41, 0x62, 0x73, 0x22,
-	0xba, 0x05, 0x0a, 0x10, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4d, 0x6f, 0x64, 0x50, 0x72, 0x6f, 0x76,
-	0x69, 0x64, 0x65, 0x72, 0x12, 0x4b, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x31, 0xfa, 0xde, 0x1f, 0x2d, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x41, 0x63,
-	0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f,
-	0x72, 0x12, 0x4b, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0c, 0x42, 0x2f, 0xfa, 0xde, 0x1f, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
-	0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x50, 0x75,
-	0x62, 0x4b, 0x65, 0x79, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x18,
-	0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0x5f, 0x75, 0x72, 0x69, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
-	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x55, 0x72, 0x69, 0x12, 0x25, 0x0a, 0x0e, 0x6d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x0d, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x4e, 0x6f, 0x6e,
-	0x63, 0x65, 0x12, 0x33, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f,
-	0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
-	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x6d, 0x69, 0x6e, 0x5f, 0x63,
-	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72,
-	0x61, 0x63, 0x74, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x15, 0x6d,
-	0x61, 0x78, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x64, 0x75, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x6d, 0x61, 0x78, 0x43,
-	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x4c, 0x0a, 0x11, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x72, 0x61, 0x74, 0x65, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
-	0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x10, 0x73, 0x75, 0x62,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x12, 0x4b, 0x0a,
-	0x12, 0x70, 0x61, 0x79, 0x5f, 0x61, 0x73, 0x5f, 0x79, 0x6f, 0x75, 0x5f, 0x67, 0x6f, 0x5f, 0x72,
-	0x61, 0x74, 0x65, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x07, 0x62, 0x6f, 0x6e, 0x64, 0x41, 0x62, 0x73, 0x12,
+	0x1d, 0x0a, 0x0a, 0x62, 0x6f, 0x6e, 0x64, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6f, 0x6e, 0x64, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x22, 0x8f,
+	0x06, 0x0a, 0x10, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4d, 0x6f, 0x64, 0x50, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x12, 0x4b, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x42, 0x31, 0xfa, 0xde, 0x1f, 0x2d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x63,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72,
+	0x12, 0x4b, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0c, 0x42, 0x2f, 0xfa, 0xde, 0x1f, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f,
+	0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x50, 0x75, 0x62,
+	0x4b, 0x65, 0x79, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x18, 0x0a,
+	0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x5f, 0x75, 0x72, 0x69, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x55, 0x72, 0x69, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0d, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x4e, 0x6f, 0x6e, 0x63,
+	0x65, 0x12, 0x33, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1b, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e,
+	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x6d, 0x69, 0x6e, 0x5f, 0x63, 0x6f,
+	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x15, 0x6d, 0x61,
+	0x78, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x6d, 0x61, 0x78, 0x43, 0x6f,
+	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4c,
+	0x0a, 0x11, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72,
+	0x61, 0x74, 0x65, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
 	0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
-	0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0e, 0x70, 0x61, 0x79, 0x41,
-	0x73, 0x59, 0x6f, 0x75, 0x47, 0x6f, 0x52, 0x61, 0x74, 0x65, 0x12, 0x3f, 0x0a, 0x04, 0x62, 0x6f,
-	0x6e, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2b, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde,
-	0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d,
-	0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x04, 0x62, 0x6f, 0x6e, 0x64, 0x12, 0x2f, 0x0a, 0x13, 0x73,
-	0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65,
-	0x6d, 0x65, 0x6e, 0x74, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xd6, 0x05, 0x0a,
-	0x11, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4f, 0x70, 0x65, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61,
-	0x63, 0x74, 0x12, 0x4b, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0c, 0x42, 0x2f, 0xfa, 0xde, 0x1f, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
-	0x6b, 0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x50,
-	0x75, 0x62, 0x4b, 0x65, 0x79, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12,
-	0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x49, 0x64,
-	0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x47, 0x0a, 0x06, 0x63, 0x6c,
-	0x69, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x2f, 0xfa, 0xde, 0x1f, 0x2b,
-	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f,
-	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2f, 0x63, 0x6f,
-	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x52, 0x06, 0x63, 0x6c, 0x69,
-	0x65, 0x6e, 0x74, 0x12, 0x4b, 0x0a, 0x08, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x2f, 0xfa, 0xde, 0x1f, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x6e, 0x65, 0x74, 0x77, 0x6f,
-	0x72, 0x6b, 0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
-	0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x52, 0x08, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65,
-	0x12, 0x2d, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19,
-	0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x43, 0x6f, 0x6e,
-	0x74, 0x72, 0x61, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
-	0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x33, 0x0a, 0x04, 0x72, 0x61, 0x74, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e,
-	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde,
-	0x1f, 0x00, 0x52, 0x04, 0x72, 0x61, 0x74, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x6e,
-	0x5f, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6f, 0x70, 0x65,
-	0x6e, 0x43, 0x6f, 0x73, 0x74, 0x12, 0x45, 0x0a, 0x07, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
-	0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2b, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74,
-	0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
-	0x49, 0x6e, 0x74, 0x52, 0x07, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x2f, 0x0a, 0x13,
-	0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x73, 0x65, 0x74, 0x74, 0x6c,
-	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x48, 0x0a,
-	0x0d, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0d,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x61, 0x72, 0x6b,
-	0x65, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x75, 0x74, 0x68, 0x6f,
-	0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
-	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x12, 0x71, 0x75, 0x65, 0x72, 0x69,
-	0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x18, 0x0e, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x10, 0x71, 0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x50, 0x65, 0x72, 0x4d,
-	0x69, 0x6e, 0x75, 0x74, 0x65, 0x22, 0x98, 0x04, 0x0a, 0x13, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53,
-	0x65, 0x74, 0x74, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x4b, 0x0a,
-	0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x42,
-	0x2f, 0xfa, 0xde, 0x1f, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x61, 0x72, 0x6b, 0x65, 0x6f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x72, 0x6b,
-	0x65, 0x6f, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79,
-	0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f,
-	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x47, 0x0a, 0x06, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x2f, 0xfa, 0xde, 0x1f, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x6e, 0x65, 0x74, 0x77, 0x6f,
-	0x72, 0x6b, 0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
-	0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x52, 0x06, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x4b,
-	0x0a, 0x08, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c,
+	0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x10, 0x73, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x12, 0x4b, 0x0a, 0x12,
+	0x70, 0x61, 0x79, 0x5f, 0x61, 0x73, 0x5f, 0x79, 0x6f, 0x75, 0x5f, 0x67, 0x6f, 0x5f, 0x72, 0x61,
+	0x74, 0x65, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43,
+	0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0e, 0x70, 0x61, 0x79, 0x41, 0x73,
+	0x59, 0x6f, 0x75, 0x47, 0x6f, 0x52, 0x61, 0x74, 0x65, 0x12, 0x3f, 0x0a, 0x04, 0x62, 0x6f, 0x6e,
+	0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2b, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f,
+	0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61,
+	0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x49, 0x6e, 0x74, 0x52, 0x04, 0x62, 0x6f, 0x6e, 0x64, 0x12, 0x2f, 0x0a, 0x13, 0x73, 0x65,
+	0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x0d, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0d, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x6d, 0x61, 0x78, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x63,
+	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10,
+	0x6d, 0x61, 0x78, 0x4f, 0x70, 0x65, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x73,
+	0x22, 0xd3, 0x07, 0x0a, 0x11, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4f, 0x70, 0x65, 0x6e, 0x43, 0x6f,
+	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x4b, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x2f, 0xfa, 0xde, 0x1f, 0x2b, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x6e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2f, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x47,
+	0x0a, 0x06, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x2f,
+	0xfa, 0xde, 0x1f, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61,
+	0x72, 0x6b, 0x65, 0x6f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x72, 0x6b, 0x65,
+	0x6f, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x52,
+	0x06, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x4b, 0x0a, 0x08, 0x64, 0x65, 0x6c, 0x65, 0x67,
+	0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x2f, 0xfa, 0xde, 0x1f, 0x2b, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x6e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2f, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x52, 0x08, 0x64, 0x65, 0x6c, 0x65,
+	0x67, 0x61, 0x74, 0x65, 0x12, 0x2d, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x19, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f,
+	0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x64,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x33, 0x0a, 0x04, 0x72, 0x61, 0x74, 0x65, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62,
+	0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e,
+	0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x04, 0x72, 0x61, 0x74, 0x65, 0x12, 0x1b, 0x0a, 0x09,
+	0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x6f, 0x70, 0x65, 0x6e, 0x43, 0x6f, 0x73, 0x74, 0x12, 0x45, 0x0a, 0x07, 0x64, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2b, 0xc8, 0xde, 0x1f, 0x00,
+	0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
+	0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x07, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x12, 0x2f, 0x0a, 0x13, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x64,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x73,
+	0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x48, 0x0a, 0x0d, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f,
+	0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x61, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x12, 0x71,
+	0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x6d, 0x69, 0x6e, 0x75, 0x74,
+	0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x71, 0x75, 0x65, 0x72, 0x69, 0x65, 0x73,
+	0x50, 0x65, 0x72, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x12, 0x36, 0x0a, 0x17, 0x73, 0x65, 0x74,
+	0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x67, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x70, 0x65,
+	0x72, 0x69, 0x6f, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x03, 0x52, 0x15, 0x73, 0x65, 0x74, 0x74,
+	0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x61, 0x63, 0x65, 0x50, 0x65, 0x72, 0x69, 0x6f,
+	0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x72, 0x65, 0x6e, 0x65, 0x77, 0x18,
+	0x10, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x6e, 0x65, 0x77,
+	0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x11,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x61, 0x74, 0x65, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12,
+	0x4c, 0x0a, 0x0b, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x12,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x2b, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e,
+	0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e,
+	0x74, 0x52, 0x0a, 0x72, 0x61, 0x74, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x70, 0x61, 0x79, 0x65, 0x72, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x61,
+	0x79, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x68, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x14, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0xc4, 0x06, 0x0a, 0x13, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x4b,
+	0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
 	0x42, 0x2f, 0xfa, 0xde, 0x1f, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
 	0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x72,
 	0x6b, 0x65, 0x6f, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x50, 0x75, 0x62, 0x4b, 0x65,
-	0x79, 0x52, 0x08, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x12, 0x2d, 0x0a, 0x04, 0x74,
-	0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x61, 0x72, 0x6b, 0x65,
-	0x6f, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
-	0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f,
-	0x6e, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65,
-	0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x3f, 0x0a, 0x04, 0x70, 0x61, 0x69, 0x64,
-	0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2b, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15,
+	0x79, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x63,
+	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x47, 0x0a, 0x06, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x2f, 0xfa, 0xde, 0x1f, 0x2b, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x6e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x52, 0x06, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12,
+	0x4b, 0x0a, 0x08, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0c, 0x42, 0x2f, 0xfa, 0xde, 0x1f, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61,
+	0x72, 0x6b, 0x65, 0x6f, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x50, 0x75, 0x62, 0x4b,
+	0x65, 0x79, 0x52, 0x08, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x12, 0x2d, 0x0a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x61, 0x72, 0x6b,
+	0x65, 0x6f, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6e,
+	0x6f, 0x6e, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x3f, 0x0a, 0x04, 0x70, 0x61, 0x69,
+	0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2b, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f,
+	0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61,
+	0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x49, 0x6e, 0x74, 0x52, 0x04, 0x70, 0x61, 0x69, 0x64, 0x12, 0x45, 0x0a, 0x07, 0x72, 0x65,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2b, 0xc8, 0xde, 0x1f,
+	0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69,
+	0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x12, 0x43, 0x0a, 0x06, 0x75, 0x6e, 0x70, 0x61, 0x69, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x2b, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74,
+	0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x06,
+	0x75, 0x6e, 0x70, 0x61, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x47, 0x0a, 0x08,
+	0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x65, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2b,
+	0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64,
+	0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d,
+	0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x08, 0x72, 0x65, 0x66,
+	0x75, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x45, 0x0a, 0x07, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x65, 0x64,
+	0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2b, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15,
 	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74,
 	0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
-	0x49, 0x6e, 0x74, 0x52, 0x04, 0x70, 0x61, 0x69, 0x64, 0x12, 0x45, 0x0a, 0x07, 0x72, 0x65, 0x73,
-	0x65, 0x72, 0x76, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2b, 0xc8, 0xde, 0x1f, 0x00,
+	0x49, 0x6e, 0x74, 0x52, 0x07, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x65, 0x64, 0x12, 0x3f, 0x0a, 0x04,
+	0x64, 0x75, 0x73, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2b, 0xc8, 0xde, 0x1f, 0x00,
 	0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
 	0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x22, 0xb2, 0x02, 0x0a, 0x12, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x43,
-	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x72,
-	0x61, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x63, 0x6f,
-	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x49, 0x64, 0x12, 0x4b, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76,
-	0x69, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x2f, 0xfa, 0xde, 0x1f, 0x2b,
+	0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x04, 0x64, 0x75, 0x73, 0x74, 0x22, 0xf1, 0x04,
+	0x0a, 0x12, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72,
+	0x61, 0x63, 0x74, 0x49, 0x64, 0x12, 0x4b, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x2f, 0xfa, 0xde, 0x1f, 0x2b, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x6e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x47, 0x0a, 0x06,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x2f, 0xfa, 0xde,
+	0x1f, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x72, 0x6b,
+	0x65, 0x6f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2f,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x52, 0x06, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x4b, 0x0a, 0x08, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x2f, 0xfa, 0xde, 0x1f, 0x2b, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x6e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x52, 0x08, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61,
+	0x74, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x79, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x62, 0x79, 0x50, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x12, 0x45, 0x0a, 0x07, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x2b, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e,
+	0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e,
+	0x74, 0x52, 0x07, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x12, 0x4f, 0x0a, 0x0c, 0x63, 0x6f,
+	0x6d, 0x70, 0x65, 0x6e, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x2b, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2,
+	0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x0c, 0x63,
+	0x6f, 0x6d, 0x70, 0x65, 0x6e, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x43, 0x0a, 0x06, 0x72,
+	0x65, 0x66, 0x75, 0x6e, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2b, 0xc8, 0xde, 0x1f,
+	0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69,
+	0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x06, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64,
+	0x12, 0x3f, 0x0a, 0x04, 0x64, 0x75, 0x73, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2b,
+	0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64,
+	0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d,
+	0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x04, 0x64, 0x75, 0x73,
+	0x74, 0x22, 0xfd, 0x04, 0x0a, 0x12, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x6e, 0x65, 0x77,
+	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x4b, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x2f, 0xfa, 0xde, 0x1f, 0x2b,
 	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f,
 	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2f, 0x63, 0x6f,
 	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x52, 0x08, 0x70, 0x72, 0x6f,
-	0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
-	0x47, 0x0a, 0x06, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x42,
-	0x2f, 0xfa, 0xde, 0x1f, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x61, 0x72, 0x6b, 0x65, 0x6f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x72, 0x6b,
-	0x65, 0x6f, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79,
-	0x52, 0x06, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x4b, 0x0a, 0x08, 0x64, 0x65, 0x6c, 0x65,
-	0x67, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x2f, 0xfa, 0xde, 0x1f, 0x2b,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x12, 0x47, 0x0a, 0x06, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c,
+	0x42, 0x2f, 0xfa, 0xde, 0x1f, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x72,
+	0x6b, 0x65, 0x6f, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x50, 0x75, 0x62, 0x4b, 0x65,
+	0x79, 0x52, 0x06, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x4b, 0x0a, 0x08, 0x64, 0x65, 0x6c,
+	0x65, 0x67, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x2f, 0xfa, 0xde, 0x1f,
+	0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x72, 0x6b, 0x65,
+	0x6f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2f, 0x63,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x52, 0x08, 0x64, 0x65,
+	0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x12, 0x2d, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x61, 0x72, 0x6b,
+	0x65, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x6f, 0x6c, 0x64, 0x5f, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6f,
+	0x6c, 0x64, 0x45, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e,
+	0x6e, 0x65, 0x77, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6e, 0x65, 0x77, 0x45, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x33, 0x0a, 0x04, 0x72, 0x61, 0x74, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde,
+	0x1f, 0x00, 0x52, 0x04, 0x72, 0x61, 0x74, 0x65, 0x12, 0x50, 0x0a, 0x0d, 0x65, 0x78, 0x74, 0x72,
+	0x61, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x2b, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
+	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4,
+	0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x0c, 0x65, 0x78,
+	0x74, 0x72, 0x61, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x45, 0x0a, 0x07, 0x64, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2b, 0xc8, 0xde, 0x1f,
+	0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69,
+	0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x07, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x22, 0x8e, 0x03, 0x0a, 0x13, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x74, 0x61, 0x74,
+	0x65, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x12, 0x4b, 0x0a, 0x08, 0x70, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x2f, 0xfa, 0xde, 0x1f,
+	0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x72, 0x6b, 0x65,
+	0x6f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2f, 0x63,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x52, 0x08, 0x70, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x61, 0x63, 0x74, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x12, 0x47, 0x0a, 0x06, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0c, 0x42, 0x2f, 0xfa, 0xde, 0x1f, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61,
+	0x72, 0x6b, 0x65, 0x6f, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x50, 0x75, 0x62, 0x4b,
+	0x65, 0x79, 0x52, 0x06, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x52, 0x0a, 0x0c, 0x6f, 0x6c,
+	0x64, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c,
+	0x42, 0x2f, 0xfa, 0xde, 0x1f, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x72,
+	0x6b, 0x65, 0x6f, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x50, 0x75, 0x62, 0x4b, 0x65,
+	0x79, 0x52, 0x0b, 0x6f, 0x6c, 0x64, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x12, 0x52,
+	0x0a, 0x0c, 0x6e, 0x65, 0x77, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0c, 0x42, 0x2f, 0xfa, 0xde, 0x1f, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x50,
+	0x75, 0x62, 0x4b, 0x65, 0x79, 0x52, 0x0b, 0x6e, 0x65, 0x77, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61,
+	0x74, 0x65, 0x22, 0xc8, 0x02, 0x0a, 0x11, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x74, 0x41,
+	0x75, 0x74, 0x6f, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x12, 0x4b, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x2f, 0xfa, 0xde, 0x1f, 0x2b,
 	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f,
 	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2f, 0x63, 0x6f,
-	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x52, 0x08, 0x64, 0x65, 0x6c,
-	0x65, 0x67, 0x61, 0x74, 0x65, 0x22, 0xac, 0x01, 0x0a, 0x14, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x56,
-	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x12, 0x4f,
-	0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0c, 0x42, 0x31, 0xfa, 0xde, 0x1f, 0x2d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
-	0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d,
-	0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x63, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12,
-	0x43, 0x0a, 0x06, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x52, 0x08, 0x70, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x12, 0x47, 0x0a, 0x06, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c,
+	0x42, 0x2f, 0xfa, 0xde, 0x1f, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x72,
+	0x6b, 0x65, 0x6f, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x50, 0x75, 0x62, 0x4b, 0x65,
+	0x79, 0x52, 0x06, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x75, 0x74,
+	0x6f, 0x5f, 0x72, 0x65, 0x6e, 0x65, 0x77, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61,
+	0x75, 0x74, 0x6f, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x12, 0x43, 0x0a, 0x06, 0x72, 0x65, 0x66, 0x75,
+	0x6e, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2b, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde,
+	0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d,
+	0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x06, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x22, 0x85, 0x04,
+	0x0a, 0x12, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x6f, 0x70, 0x55, 0x70, 0x43, 0x6f, 0x6e, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x12, 0x4b, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x2f, 0xfa, 0xde, 0x1f, 0x2b, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x6e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
+	0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x47, 0x0a, 0x06,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x2f, 0xfa, 0xde,
+	0x1f, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x72, 0x6b,
+	0x65, 0x6f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2f,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x52, 0x06, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x6f, 0x6c, 0x64, 0x5f, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6f,
+	0x6c, 0x64, 0x45, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e,
+	0x6e, 0x65, 0x77, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6e, 0x65, 0x77, 0x45, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x42, 0x0a, 0x06, 0x74, 0x6f, 0x70, 0x5f, 0x75, 0x70, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x2b, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49,
+	0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74,
+	0x52, 0x05, 0x74, 0x6f, 0x70, 0x55, 0x70, 0x12, 0x45, 0x0a, 0x07, 0x64, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2b, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde,
+	0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d,
+	0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x07, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x45,
+	0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x2b, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
+	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4,
+	0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x07, 0x62, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x22, 0xd2, 0x02, 0x0a, 0x12, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53,
+	0x6c, 0x61, 0x73, 0x68, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x4b, 0x0a, 0x08,
+	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x2f,
+	0xfa, 0xde, 0x1f, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61,
+	0x72, 0x6b, 0x65, 0x6f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x72, 0x6b, 0x65,
+	0x6f, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x52,
+	0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x43, 0x0a, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2b, 0xc8, 0xde,
+	0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e,
+	0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x3f, 0x0a, 0x04, 0x62, 0x6f, 0x6e, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x2b, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
+	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4,
+	0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x04, 0x62, 0x6f,
+	0x6e, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x22, 0xac, 0x01, 0x0a, 0x14, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x61, 0x79,
+	0x6f, 0x75, 0x74, 0x12, 0x4f, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x31, 0xfa, 0xde, 0x1f, 0x2d, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x41,
+	0x63, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x12, 0x43, 0x0a, 0x06, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x2b, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e,
+	0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e,
+	0x74, 0x52, 0x06, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x22, 0x59, 0x0a, 0x0b, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x6c,
+	0x64, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f,
+	0x6c, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x65, 0x77, 0x5f, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x65, 0x77, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x22, 0x66, 0x0a, 0x12, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x12, 0x38, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x61, 0x72, 0x6b, 0x65,
+	0x6f, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x42, 0x04, 0xc8,
+	0xde, 0x1f, 0x00, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x22, 0xe5, 0x02, 0x0a,
+	0x14, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x45, 0x78,
+	0x70, 0x69, 0x72, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x49, 0x64, 0x12, 0x4b, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x2f, 0xfa, 0xde, 0x1f, 0x2b, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x6e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2f, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x47, 0x0a,
+	0x06, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x2f, 0xfa,
+	0xde, 0x1f, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x72,
+	0x6b, 0x65, 0x6f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f,
+	0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x52, 0x06,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x2d, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x61, 0x72, 0x6b,
+	0x65, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x12, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x5f, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x11, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x22, 0x8a, 0x04, 0x0a, 0x18, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x43, 0x6f,
+	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x65,
+	0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
+	0x49, 0x64, 0x12, 0x4b, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x42, 0x2f, 0xfa, 0xde, 0x1f, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x50,
+	0x75, 0x62, 0x4b, 0x65, 0x79, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x47, 0x0a, 0x06, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x2f, 0xfa, 0xde, 0x1f, 0x2b, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x6e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2f, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x52, 0x06, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x6f, 0x6c, 0x64, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6f, 0x6c, 0x64, 0x45,
+	0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x6e, 0x65, 0x77,
+	0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0d, 0x6e, 0x65, 0x77, 0x45, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x41, 0x0a, 0x05, 0x73, 0x70, 0x65, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42,
 	0x2b, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
 	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4,
-	0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x06, 0x72, 0x65,
-	0x77, 0x61, 0x72, 0x64, 0x42, 0x89, 0x01, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x72, 0x6b,
-	0x65, 0x6f, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x42, 0x0b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73,
-	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x1c, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
-	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2f,
-	0x61, 0x72, 0x6b, 0x65, 0x6f, 0xa2, 0x02, 0x03, 0x41, 0x41, 0x58, 0xaa, 0x02, 0x0b, 0x41, 0x72,
-	0x6b, 0x65, 0x6f, 0x2e, 0x41, 0x72, 0x6b, 0x65, 0x6f, 0xca, 0x02, 0x0b, 0x41, 0x72, 0x6b, 0x65,
-	0x6f, 0x5c, 0x41, 0x72, 0x6b, 0x65, 0x6f, 0xe2, 0x02, 0x17, 0x41, 0x72, 0x6b, 0x65, 0x6f, 0x5c,
-	0x41, 0x72, 0x6b, 0x65, 0x6f, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0xea, 0x02, 0x0c, 0x41, 0x72, 0x6b, 0x65, 0x6f, 0x3a, 0x3a, 0x41, 0x72, 0x6b, 0x65, 0x6f,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x05, 0x73, 0x70,
+	0x65, 0x6e, 0x74, 0x12, 0x45, 0x0a, 0x07, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x2b, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e,
+	0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e,
+	0x74, 0x52, 0x07, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x45, 0x0a, 0x07, 0x62, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2b, 0xc8, 0xde, 0x1f,
+	0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69,
+	0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x22, 0x7b, 0x0a, 0x1d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x43,
+	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x65, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x40, 0x0a, 0x07,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e,
+	0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x42,
+	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0xbd,
+	0x01, 0x0a, 0x1a, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x50, 0x75, 0x72, 0x67, 0x65, 0x45, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x73, 0x12, 0x18, 0x0a,
+	0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x40, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f,
+	0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x50,
+	0x75, 0x72, 0x67, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00,
+	0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x43, 0x0a, 0x06, 0x72, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2b, 0xc8, 0xde, 0x1f, 0x00, 0xda,
+	0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f,
+	0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x06, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x22, 0x86,
+	0x02, 0x0a, 0x14, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x44, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x64, 0x12, 0x4b, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x2f, 0xfa, 0xde, 0x1f, 0x2b, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x6e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2f, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x3f,
+	0x0a, 0x04, 0x62, 0x6f, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2b, 0xc8, 0xde,
+	0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e,
+	0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x04, 0x62, 0x6f, 0x6e, 0x64, 0x12,
+	0x46, 0x0a, 0x08, 0x6d, 0x69, 0x6e, 0x5f, 0x62, 0x6f, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x2b, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74,
+	0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x07,
+	0x6d, 0x69, 0x6e, 0x42, 0x6f, 0x6e, 0x64, 0x22, 0xdb, 0x02, 0x0a, 0x16, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74,
+	0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
+	0x74, 0x49, 0x64, 0x12, 0x4b, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x2f, 0xfa, 0xde, 0x1f, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x6e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x47, 0x0a, 0x06, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x2f, 0xfa, 0xde, 0x1f, 0x2b,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f,
+	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2f, 0x63, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x52, 0x06, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x12, 0x2d, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x19, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e,
+	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x48,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x83, 0x02, 0x0a, 0x19, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53,
+	0x65, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x6c,
+	0x69, 0x73, 0x74, 0x12, 0x4b, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x2f, 0xfa, 0xde, 0x1f, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x6e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x34, 0x0a, 0x06, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x61, 0x72, 0x6b,
+	0x65, 0x6f, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69,
+	0x73, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x49, 0x0a, 0x07, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0c, 0x42, 0x2f, 0xfa, 0xde, 0x1f, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61,
+	0x72, 0x6b, 0x65, 0x6f, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x50, 0x75, 0x62, 0x4b,
+	0x65, 0x79, 0x52, 0x07, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x42, 0x89, 0x01, 0x0a, 0x0f,
+	0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x42,
+	0x0b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x1c,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0xa2, 0x02, 0x03, 0x41,
+	0x41, 0x58, 0xaa, 0x02, 0x0b, 0x41, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x41, 0x72, 0x6b, 0x65, 0x6f,
+	0xca, 0x02, 0x0b, 0x41, 0x72, 0x6b, 0x65, 0x6f, 0x5c, 0x41, 0x72, 0x6b, 0x65, 0x6f, 0xe2, 0x02,
+	0x17, 0x41, 0x72, 0x6b, 0x65, 0x6f, 0x5c, 0x41, 0x72, 0x6b, 0x65, 0x6f, 0x5c, 0x47, 0x50, 0x42,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0c, 0x41, 0x72, 0x6b, 0x65, 0x6f,
+	0x3a, 0x3a, 0x41, 0x72, 0x6b, 0x65, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -5817,32 +18649,57 @@ func file_arkeo_arkeo_events_proto_rawDescGZIP() []byte {
 	return file_arkeo_arkeo_events_proto_rawDescData
 }
 
-var file_arkeo_arkeo_events_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_arkeo_arkeo_events_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
 var file_arkeo_arkeo_events_proto_goTypes = []interface{}{
-	(*EventBondProvider)(nil),    // 0: arkeo.arkeo.EventBondProvider
-	(*EventModProvider)(nil),     // 1: arkeo.arkeo.EventModProvider
-	(*EventOpenContract)(nil),    // 2: arkeo.arkeo.EventOpenContract
-	(*EventSettleContract)(nil),  // 3: arkeo.arkeo.EventSettleContract
-	(*EventCloseContract)(nil),   // 4: arkeo.arkeo.EventCloseContract
-	(*EventValidatorPayout)(nil), // 5: arkeo.arkeo.EventValidatorPayout
-	(ProviderStatus)(0),          // 6: arkeo.arkeo.ProviderStatus
-	(*v1beta1.Coin)(nil),         // 7: cosmos.base.v1beta1.Coin
-	(ContractType)(0),            // 8: arkeo.arkeo.ContractType
-	(ContractAuthorization)(0),   // 9: arkeo.arkeo.ContractAuthorization
+	(*EventBondProvider)(nil),             // 0: arkeo.arkeo.EventBondProvider
+	(*EventModProvider)(nil),              // 1: arkeo.arkeo.EventModProvider
+	(*EventOpenContract)(nil),             // 2: arkeo.arkeo.EventOpenContract
+	(*EventSettleContract)(nil),           // 3: arkeo.arkeo.EventSettleContract
+	(*EventCloseContract)(nil),            // 4: arkeo.arkeo.EventCloseContract
+	(*EventRenewContract)(nil),            // 5: arkeo.arkeo.EventRenewContract
+	(*EventRotateDelegate)(nil),           // 6: arkeo.arkeo.EventRotateDelegate
+	(*EventSetAutoRenew)(nil),             // 7: arkeo.arkeo.EventSetAutoRenew
+	(*EventTopUpContract)(nil),            // 8: arkeo.arkeo.EventTopUpContract
+	(*EventSlashProvider)(nil),            // 9: arkeo.arkeo.EventSlashProvider
+	(*EventValidatorPayout)(nil),          // 10: arkeo.arkeo.EventValidatorPayout
+	(*ParamChange)(nil),                   // 11: arkeo.arkeo.ParamChange
+	(*EventParamsUpdated)(nil),            // 12: arkeo.arkeo.EventParamsUpdated
+	(*EventContractExpired)(nil),          // 13: arkeo.arkeo.EventContractExpired
+	(*EventContractAutoRenewed)(nil),      // 14: arkeo.arkeo.EventContractAutoRenewed
+	(*EventClaimContractIncomeBatch)(nil), // 15: arkeo.arkeo.EventClaimContractIncomeBatch
+	(*EventPurgeExpiredContracts)(nil),    // 16: arkeo.arkeo.EventPurgeExpiredContracts
+	(*EventProviderDemoted)(nil),          // 17: arkeo.arkeo.EventProviderDemoted
+	(*EventContractActivated)(nil),        // 18: arkeo.arkeo.EventContractActivated
+	(*EventSetProviderAllowlist)(nil),     // 19: arkeo.arkeo.EventSetProviderAllowlist
+	(ProviderStatus)(0),                   // 20: arkeo.arkeo.ProviderStatus
+	(*v1beta1.Coin)(nil),                  // 21: cosmos.base.v1beta1.Coin
+	(ContractType)(0),                     // 22: arkeo.arkeo.ContractType
+	(ContractAuthorization)(0),            // 23: arkeo.arkeo.ContractAuthorization
+	(*ContractClaimResult)(nil),           // 24: arkeo.arkeo.ContractClaimResult
+	(*ContractPurgeResult)(nil),           // 25: arkeo.arkeo.ContractPurgeResult
+	(AllowlistAction)(0),                  // 26: arkeo.arkeo.AllowlistAction
 }
 var file_arkeo_arkeo_events_proto_depIdxs = []int32{
-	6, // 0: arkeo.arkeo.EventModProvider.status:type_name -> arkeo.arkeo.ProviderStatus
-	7, // 1: arkeo.arkeo.EventModProvider.subscription_rate:type_name -> cosmos.base.v1beta1.Coin
-	7, // 2: arkeo.arkeo.EventModProvider.pay_as_you_go_rate:type_name -> cosmos.base.v1beta1.Coin
-	8, // 3: arkeo.arkeo.EventOpenContract.type:type_name -> arkeo.arkeo.ContractType
-	7, // 4: arkeo.arkeo.EventOpenContract.rate:type_name -> cosmos.base.v1beta1.Coin
-	9, // 5: arkeo.arkeo.EventOpenContract.authorization:type_name -> arkeo.arkeo.ContractAuthorization
-	8, // 6: arkeo.arkeo.EventSettleContract.type:type_name -> arkeo.arkeo.ContractType
-	7, // [7:7] is the sub-list for method output_type
-	7, // [7:7] is the sub-list for method input_type
-	7, // [7:7] is the sub-list for extension type_name
-	7, // [7:7] is the sub-list for extension extendee
-	0, // [0:7] is the sub-list for field type_name
+	20, // 0: arkeo.arkeo.EventModProvider.status:type_name -> arkeo.arkeo.ProviderStatus
+	21, // 1: arkeo.arkeo.EventModProvider.subscription_rate:type_name -> cosmos.base.v1beta1.Coin
+	21, // 2: arkeo.arkeo.EventModProvider.pay_as_you_go_rate:type_name -> cosmos.base.v1beta1.Coin
+	22, // 3: arkeo.arkeo.EventOpenContract.type:type_name -> arkeo.arkeo.ContractType
+	21, // 4: arkeo.arkeo.EventOpenContract.rate:type_name -> cosmos.base.v1beta1.Coin
+	23, // 5: arkeo.arkeo.EventOpenContract.authorization:type_name -> arkeo.arkeo.ContractAuthorization
+	22, // 6: arkeo.arkeo.EventSettleContract.type:type_name -> arkeo.arkeo.ContractType
+	22, // 7: arkeo.arkeo.EventRenewContract.type:type_name -> arkeo.arkeo.ContractType
+	21, // 8: arkeo.arkeo.EventRenewContract.rate:type_name -> cosmos.base.v1beta1.Coin
+	11, // 9: arkeo.arkeo.EventParamsUpdated.changes:type_name -> arkeo.arkeo.ParamChange
+	22, // 10: arkeo.arkeo.EventContractExpired.type:type_name -> arkeo.arkeo.ContractType
+	24, // 11: arkeo.arkeo.EventClaimContractIncomeBatch.results:type_name -> arkeo.arkeo.ContractClaimResult
+	25, // 12: arkeo.arkeo.EventPurgeExpiredContracts.results:type_name -> arkeo.arkeo.ContractPurgeResult
+	22, // 13: arkeo.arkeo.EventContractActivated.type:type_name -> arkeo.arkeo.ContractType
+	26, // 14: arkeo.arkeo.EventSetProviderAllowlist.action:type_name -> arkeo.arkeo.AllowlistAction
+	15, // [15:15] is the sub-list for method output_type
+	15, // [15:15] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
 }
 
 func init() { file_arkeo_arkeo_events_proto_init() }
@@ -5913,6 +18770,66 @@ func file_arkeo_arkeo_events_proto_init() {
 			}
 		}
 		file_arkeo_arkeo_events_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventRenewContract); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_arkeo_arkeo_events_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventRotateDelegate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_arkeo_arkeo_events_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventSetAutoRenew); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_arkeo_arkeo_events_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventTopUpContract); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_arkeo_arkeo_events_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventSlashProvider); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_arkeo_arkeo_events_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*EventValidatorPayout); i {
 			case 0:
 				return &v.state
@@ -5924,6 +18841,114 @@ func file_arkeo_arkeo_events_proto_init() {
 				return nil
 			}
 		}
+		file_arkeo_arkeo_events_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ParamChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_arkeo_arkeo_events_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventParamsUpdated); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_arkeo_arkeo_events_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventContractExpired); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_arkeo_arkeo_events_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventContractAutoRenewed); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_arkeo_arkeo_events_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventClaimContractIncomeBatch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_arkeo_arkeo_events_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventPurgeExpiredContracts); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_arkeo_arkeo_events_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventProviderDemoted); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_arkeo_arkeo_events_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventContractActivated); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_arkeo_arkeo_events_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventSetProviderAllowlist); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -5931,7 +18956,7 @@ func file_arkeo_arkeo_events_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_arkeo_arkeo_events_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   20,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -217,15 +217,277 @@ func (x *_GenesisState_6_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_GenesisState_8_list)(nil)
+
+type _GenesisState_8_list struct {
+	list *[]*ProviderEarnings
+}
+
+func (x *_GenesisState_8_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_8_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_8_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ProviderEarnings)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_8_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ProviderEarnings)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_8_list) AppendMutable() protoreflect.Value {
+	v := new(ProviderEarnings)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_8_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_8_list) NewElement() protoreflect.Value {
+	v := new(ProviderEarnings)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_8_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_9_list)(nil)
+
+type _GenesisState_9_list struct {
+	list *[]*ContractExpirationSet
+}
+
+func (x *_GenesisState_9_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_9_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_9_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ContractExpirationSet)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_9_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ContractExpirationSet)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_9_list) AppendMutable() protoreflect.Value {
+	v := new(ContractExpirationSet)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_9_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_9_list) NewElement() protoreflect.Value {
+	v := new(ContractExpirationSet)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_9_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_10_list)(nil)
+
+type _GenesisState_10_list struct {
+	list *[]*ValidatorVersion
+}
+
+func (x *_GenesisState_10_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_10_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ValidatorVersion)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_10_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ValidatorVersion)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_10_list) AppendMutable() protoreflect.Value {
+	v := new(ValidatorVersion)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_10_list) NewElement() protoreflect.Value {
+	v := new(ValidatorVersion)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_12_list)(nil)
+
+type _GenesisState_12_list struct {
+	list *[]*ContractExpirationSet
+}
+
+func (x *_GenesisState_12_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_12_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_12_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ContractExpirationSet)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_12_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ContractExpirationSet)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_12_list) AppendMutable() protoreflect.Value {
+	v := new(ContractExpirationSet)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_12_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_12_list) NewElement() protoreflect.Value {
+	v := new(ContractExpirationSet)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_12_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_13_list)(nil)
+
+type _GenesisState_13_list struct {
+	list *[]*ProviderAllowlist
+}
+
+func (x *_GenesisState_13_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_13_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_13_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ProviderAllowlist)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_13_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ProviderAllowlist)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_13_list) AppendMutable() protoreflect.Value {
+	v := new(ProviderAllowlist)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_13_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_13_list) NewElement() protoreflect.Value {
+	v := new(ProviderAllowlist)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_13_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_GenesisState                          protoreflect.MessageDescriptor
-	fd_GenesisState_params                   protoreflect.FieldDescriptor
-	fd_GenesisState_providers                protoreflect.FieldDescriptor
-	fd_GenesisState_contracts                protoreflect.FieldDescriptor
-	fd_GenesisState_next_contract_id         protoreflect.FieldDescriptor
-	fd_GenesisState_contract_expiration_sets protoreflect.FieldDescriptor
-	fd_GenesisState_user_contract_sets       protoreflect.FieldDescriptor
-	fd_GenesisState_version                  protoreflect.FieldDescriptor
+	md_GenesisState                               protoreflect.MessageDescriptor
+	fd_GenesisState_params                        protoreflect.FieldDescriptor
+	fd_GenesisState_providers                     protoreflect.FieldDescriptor
+	fd_GenesisState_contracts                     protoreflect.FieldDescriptor
+	fd_GenesisState_next_contract_id              protoreflect.FieldDescriptor
+	fd_GenesisState_contract_expiration_sets      protoreflect.FieldDescriptor
+	fd_GenesisState_user_contract_sets            protoreflect.FieldDescriptor
+	fd_GenesisState_version                       protoreflect.FieldDescriptor
+	fd_GenesisState_provider_earnings             protoreflect.FieldDescriptor
+	fd_GenesisState_pay_as_you_go_expiration_sets protoreflect.FieldDescriptor
+	fd_GenesisState_validator_versions            protoreflect.FieldDescriptor
+	fd_GenesisState_params_record                 protoreflect.FieldDescriptor
+	fd_GenesisState_contract_start_sets           protoreflect.FieldDescriptor
+	fd_GenesisState_provider_allowlists           protoreflect.FieldDescriptor
+	fd_GenesisState_network_stats                 protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -238,6 +500,13 @@ func init() {
 	fd_GenesisState_contract_expiration_sets = md_GenesisState.Fields().ByName("contract_expiration_sets")
 	fd_GenesisState_user_contract_sets = md_GenesisState.Fields().ByName("user_contract_sets")
 	fd_GenesisState_version = md_GenesisState.Fields().ByName("version")
+	fd_GenesisState_provider_earnings = md_GenesisState.Fields().ByName("provider_earnings")
+	fd_GenesisState_pay_as_you_go_expiration_sets = md_GenesisState.Fields().ByName("pay_as_you_go_expiration_sets")
+	fd_GenesisState_validator_versions = md_GenesisState.Fields().ByName("validator_versions")
+	fd_GenesisState_params_record = md_GenesisState.Fields().ByName("params_record")
+	fd_GenesisState_contract_start_sets = md_GenesisState.Fields().ByName("contract_start_sets")
+	fd_GenesisState_provider_allowlists = md_GenesisState.Fields().ByName("provider_allowlists")
+	fd_GenesisState_network_stats = md_GenesisState.Fields().ByName("network_stats")
 }
 
 var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
@@ -347,6 +616,48 @@ func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
+	if len(x.ProviderEarnings) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_8_list{list: &x.ProviderEarnings})
+		if !f(fd_GenesisState_provider_earnings, value) {
+			return
+		}
+	}
+	if len(x.PayAsYouGoExpirationSets) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_9_list{list: &x.PayAsYouGoExpirationSets})
+		if !f(fd_GenesisState_pay_as_you_go_expiration_sets, value) {
+			return
+		}
+	}
+	if len(x.ValidatorVersions) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_10_list{list: &x.ValidatorVersions})
+		if !f(fd_GenesisState_validator_versions, value) {
+			return
+		}
+	}
+	if x.ParamsRecord != nil {
+		value := protoreflect.ValueOfMessage(x.ParamsRecord.ProtoReflect())
+		if !f(fd_GenesisState_params_record, value) {
+			return
+		}
+	}
+	if len(x.ContractStartSets) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_12_list{list: &x.ContractStartSets})
+		if !f(fd_GenesisState_contract_start_sets, value) {
+			return
+		}
+	}
+	if len(x.ProviderAllowlists) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_13_list{list: &x.ProviderAllowlists})
+		if !f(fd_GenesisState_provider_allowlists, value) {
+			return
+		}
+	}
+	if x.NetworkStats != nil {
+		value := protoreflect.ValueOfMessage(x.NetworkStats.ProtoReflect())
+		if !f(fd_GenesisState_network_stats, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -376,6 +687,20 @@ func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool
 		return len(x.UserContractSets) != 0
 	case "arkeo.arkeo.GenesisState.version":
 		return x.Version != int64(0)
+	case "arkeo.arkeo.GenesisState.provider_earnings":
+		return len(x.ProviderEarnings) != 0
+	case "arkeo.arkeo.GenesisState.pay_as_you_go_expiration_sets":
+		return len(x.PayAsYouGoExpirationSets) != 0
+	case "arkeo.arkeo.GenesisState.validator_versions":
+		return len(x.ValidatorVersions) != 0
+	case "arkeo.arkeo.GenesisState.params_record":
+		return x.ParamsRecord != nil
+	case "arkeo.arkeo.GenesisState.contract_start_sets":
+		return len(x.ContractStartSets) != 0
+	case "arkeo.arkeo.GenesisState.provider_allowlists":
+		return len(x.ProviderAllowlists) != 0
+	case "arkeo.arkeo.GenesisState.network_stats":
+		return x.NetworkStats != nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.GenesisState"))
@@ -406,6 +731,20 @@ func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
 		x.UserContractSets = nil
 	case "arkeo.arkeo.GenesisState.version":
 		x.Version = int64(0)
+	case "arkeo.arkeo.GenesisState.provider_earnings":
+		x.ProviderEarnings = nil
+	case "arkeo.arkeo.GenesisState.pay_as_you_go_expiration_sets":
+		x.PayAsYouGoExpirationSets = nil
+	case "arkeo.arkeo.GenesisState.validator_versions":
+		x.ValidatorVersions = nil
+	case "arkeo.arkeo.GenesisState.params_record":
+		x.ParamsRecord = nil
+	case "arkeo.arkeo.GenesisState.contract_start_sets":
+		x.ContractStartSets = nil
+	case "arkeo.arkeo.GenesisState.provider_allowlists":
+		x.ProviderAllowlists = nil
+	case "arkeo.arkeo.GenesisState.network_stats":
+		x.NetworkStats = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.GenesisState"))
@@ -455,6 +794,42 @@ func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescripto
 	case "arkeo.arkeo.GenesisState.version":
 		value := x.Version
 		return protoreflect.ValueOfInt64(value)
+	case "arkeo.arkeo.GenesisState.provider_earnings":
+		if len(x.ProviderEarnings) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_8_list{})
+		}
+		listValue := &_GenesisState_8_list{list: &x.ProviderEarnings}
+		return protoreflect.ValueOfList(listValue)
+	case "arkeo.arkeo.GenesisState.pay_as_you_go_expiration_sets":
+		if len(x.PayAsYouGoExpirationSets) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_9_list{})
+		}
+		listValue := &_GenesisState_9_list{list: &x.PayAsYouGoExpirationSets}
+		return protoreflect.ValueOfList(listValue)
+	case "arkeo.arkeo.GenesisState.validator_versions":
+		if len(x.ValidatorVersions) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_10_list{})
+		}
+		listValue := &_GenesisState_10_list{list: &x.ValidatorVersions}
+		return protoreflect.ValueOfList(listValue)
+	case "arkeo.arkeo.GenesisState.params_record":
+		value := x.ParamsRecord
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "arkeo.arkeo.GenesisState.contract_start_sets":
+		if len(x.ContractStartSets) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_12_list{})
+		}
+		listValue := &_GenesisState_12_list{list: &x.ContractStartSets}
+		return protoreflect.ValueOfList(listValue)
+	case "arkeo.arkeo.GenesisState.provider_allowlists":
+		if len(x.ProviderAllowlists) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_13_list{})
+		}
+		listValue := &_GenesisState_13_list{list: &x.ProviderAllowlists}
+		return protoreflect.ValueOfList(listValue)
+	case "arkeo.arkeo.GenesisState.network_stats":
+		value := x.NetworkStats
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.GenesisState"))
@@ -497,6 +872,30 @@ func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value
 		x.UserContractSets = *clv.list
 	case "arkeo.arkeo.GenesisState.version":
 		x.Version = value.Int()
+	case "arkeo.arkeo.GenesisState.provider_earnings":
+		lv := value.List()
+		clv := lv.(*_GenesisState_8_list)
+		x.ProviderEarnings = *clv.list
+	case "arkeo.arkeo.GenesisState.pay_as_you_go_expiration_sets":
+		lv := value.List()
+		clv := lv.(*_GenesisState_9_list)
+		x.PayAsYouGoExpirationSets = *clv.list
+	case "arkeo.arkeo.GenesisState.validator_versions":
+		lv := value.List()
+		clv := lv.(*_GenesisState_10_list)
+		x.ValidatorVersions = *clv.list
+	case "arkeo.arkeo.GenesisState.params_record":
+		x.ParamsRecord = value.Message().Interface().(*ParamsRecord)
+	case "arkeo.arkeo.GenesisState.contract_start_sets":
+		lv := value.List()
+		clv := lv.(*_GenesisState_12_list)
+		x.ContractStartSets = *clv.list
+	case "arkeo.arkeo.GenesisState.provider_allowlists":
+		lv := value.List()
+		clv := lv.(*_GenesisState_13_list)
+		x.ProviderAllowlists = *clv.list
+	case "arkeo.arkeo.GenesisState.network_stats":
+		x.NetworkStats = value.Message().Interface().(*NetworkStats)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.GenesisState"))
@@ -546,6 +945,46 @@ func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) p
 		}
 		value := &_GenesisState_6_list{list: &x.UserContractSets}
 		return protoreflect.ValueOfList(value)
+	case "arkeo.arkeo.GenesisState.provider_earnings":
+		if x.ProviderEarnings == nil {
+			x.ProviderEarnings = []*ProviderEarnings{}
+		}
+		value := &_GenesisState_8_list{list: &x.ProviderEarnings}
+		return protoreflect.ValueOfList(value)
+	case "arkeo.arkeo.GenesisState.pay_as_you_go_expiration_sets":
+		if x.PayAsYouGoExpirationSets == nil {
+			x.PayAsYouGoExpirationSets = []*ContractExpirationSet{}
+		}
+		value := &_GenesisState_9_list{list: &x.PayAsYouGoExpirationSets}
+		return protoreflect.ValueOfList(value)
+	case "arkeo.arkeo.GenesisState.validator_versions":
+		if x.ValidatorVersions == nil {
+			x.ValidatorVersions = []*ValidatorVersion{}
+		}
+		value := &_GenesisState_10_list{list: &x.ValidatorVersions}
+		return protoreflect.ValueOfList(value)
+	case "arkeo.arkeo.GenesisState.params_record":
+		if x.ParamsRecord == nil {
+			x.ParamsRecord = new(ParamsRecord)
+		}
+		return protoreflect.ValueOfMessage(x.ParamsRecord.ProtoReflect())
+	case "arkeo.arkeo.GenesisState.contract_start_sets":
+		if x.ContractStartSets == nil {
+			x.ContractStartSets = []*ContractExpirationSet{}
+		}
+		value := &_GenesisState_12_list{list: &x.ContractStartSets}
+		return protoreflect.ValueOfList(value)
+	case "arkeo.arkeo.GenesisState.provider_allowlists":
+		if x.ProviderAllowlists == nil {
+			x.ProviderAllowlists = []*ProviderAllowlist{}
+		}
+		value := &_GenesisState_13_list{list: &x.ProviderAllowlists}
+		return protoreflect.ValueOfList(value)
+	case "arkeo.arkeo.GenesisState.network_stats":
+		if x.NetworkStats == nil {
+			x.NetworkStats = new(NetworkStats)
+		}
+		return protoreflect.ValueOfMessage(x.NetworkStats.ProtoReflect())
 	case "arkeo.arkeo.GenesisState.next_contract_id":
 		panic(fmt.Errorf("field next_contract_id of message arkeo.arkeo.GenesisState is not mutable"))
 	case "arkeo.arkeo.GenesisState.version":
@@ -582,6 +1021,27 @@ func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor)
 		return protoreflect.ValueOfList(&_GenesisState_6_list{list: &list})
 	case "arkeo.arkeo.GenesisState.version":
 		return protoreflect.ValueOfInt64(int64(0))
+	case "arkeo.arkeo.GenesisState.provider_earnings":
+		list := []*ProviderEarnings{}
+		return protoreflect.ValueOfList(&_GenesisState_8_list{list: &list})
+	case "arkeo.arkeo.GenesisState.pay_as_you_go_expiration_sets":
+		list := []*ContractExpirationSet{}
+		return protoreflect.ValueOfList(&_GenesisState_9_list{list: &list})
+	case "arkeo.arkeo.GenesisState.validator_versions":
+		list := []*ValidatorVersion{}
+		return protoreflect.ValueOfList(&_GenesisState_10_list{list: &list})
+	case "arkeo.arkeo.GenesisState.params_record":
+		m := new(ParamsRecord)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "arkeo.arkeo.GenesisState.contract_start_sets":
+		list := []*ContractExpirationSet{}
+		return protoreflect.ValueOfList(&_GenesisState_12_list{list: &list})
+	case "arkeo.arkeo.GenesisState.provider_allowlists":
+		list := []*ProviderAllowlist{}
+		return protoreflect.ValueOfList(&_GenesisState_13_list{list: &list})
+	case "arkeo.arkeo.GenesisState.network_stats":
+		m := new(NetworkStats)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.GenesisState"))
@@ -685,14 +1145,52 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 		if x.Version != 0 {
 			n += 1 + runtime.Sov(uint64(x.Version))
 		}
-		if x.unknownFields != nil {
-			n += len(x.unknownFields)
+		if len(x.ProviderEarnings) > 0 {
+			for _, e := range x.ProviderEarnings {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
-		return protoiface.SizeOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Size:              n,
+		if len(x.PayAsYouGoExpirationSets) > 0 {
+			for _, e := range x.PayAsYouGoExpirationSets {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
-	}
+		if len(x.ValidatorVersions) > 0 {
+			for _, e := range x.ValidatorVersions {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.ParamsRecord != nil {
+			l = options.Size(x.ParamsRecord)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.ContractStartSets) > 0 {
+			for _, e := range x.ContractStartSets {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.ProviderAllowlists) > 0 {
+			for _, e := range x.ProviderAllowlists {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.NetworkStats != nil {
+			l = options.Size(x.NetworkStats)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
 		x := input.Message.Interface().(*GenesisState)
@@ -714,6 +1212,114 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.NetworkStats != nil {
+			encoded, err := options.Marshal(x.NetworkStats)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x72
+		}
+		if len(x.ProviderAllowlists) > 0 {
+			for iNdEx := len(x.ProviderAllowlists) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ProviderAllowlists[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x6a
+			}
+		}
+		if len(x.ContractStartSets) > 0 {
+			for iNdEx := len(x.ContractStartSets) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ContractStartSets[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x62
+			}
+		}
+		if x.ParamsRecord != nil {
+			encoded, err := options.Marshal(x.ParamsRecord)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x5a
+		}
+		if len(x.ValidatorVersions) > 0 {
+			for iNdEx := len(x.ValidatorVersions) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ValidatorVersions[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x52
+			}
+		}
+		if len(x.PayAsYouGoExpirationSets) > 0 {
+			for iNdEx := len(x.PayAsYouGoExpirationSets) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.PayAsYouGoExpirationSets[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x4a
+			}
+		}
+		if len(x.ProviderEarnings) > 0 {
+			for iNdEx := len(x.ProviderEarnings) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ProviderEarnings[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x42
+			}
+		}
 		if x.Version != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.Version))
 			i--
@@ -1061,118 +1667,839 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProviderEarnings", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ProviderEarnings = append(x.ProviderEarnings, &ProviderEarnings{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ProviderEarnings[len(x.ProviderEarnings)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PayAsYouGoExpirationSets", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if (iNdEx + skippy) > l {
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				x.PayAsYouGoExpirationSets = append(x.PayAsYouGoExpirationSets, &ContractExpirationSet{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PayAsYouGoExpirationSets[len(x.PayAsYouGoExpirationSets)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				iNdEx += skippy
-			}
-		}
-
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
-	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
-	}
-}
-
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: arkeo/arkeo/genesis.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
-
-// GenesisState defines the arkeo module's genesis state.
-type GenesisState struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Params                 *Params                  `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
-	Providers              []*Provider              `protobuf:"bytes,2,rep,name=providers,proto3" json:"providers,omitempty"`
-	Contracts              []*Contract              `protobuf:"bytes,3,rep,name=contracts,proto3" json:"contracts,omitempty"`
-	NextContractId         uint64                   `protobuf:"varint,4,opt,name=next_contract_id,json=nextContractId,proto3" json:"next_contract_id,omitempty"`
-	ContractExpirationSets []*ContractExpirationSet `protobuf:"bytes,5,rep,name=contract_expiration_sets,json=contractExpirationSets,proto3" json:"contract_expiration_sets,omitempty"`
-	UserContractSets       []*UserContractSet       `protobuf:"bytes,6,rep,name=user_contract_sets,json=userContractSets,proto3" json:"user_contract_sets,omitempty"`
-	Version                int64                    `protobuf:"varint,7,opt,name=version,proto3" json:"version,omitempty"` // this line is used by starport scaffolding # genesis/proto/state
-}
-
-func (x *GenesisState) Reset() {
-	*x = GenesisState{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_arkeo_arkeo_genesis_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *GenesisState) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GenesisState) ProtoMessage() {}
-
-// Deprecated: Use GenesisState.ProtoReflect.Descriptor instead.
-func (*GenesisState) Descriptor() ([]byte, []int) {
-	return file_arkeo_arkeo_genesis_proto_rawDescGZIP(), []int{0}
-}
-
-func (x *GenesisState) GetParams() *Params {
-	if x != nil {
-		return x.Params
-	}
-	return nil
-}
-
-func (x *GenesisState) GetProviders() []*Provider {
-	if x != nil {
-		return x.Providers
-	}
-	return nil
-}
-
-func (x *GenesisState) GetContracts() []*Contract {
-	if x != nil {
-		return x.Contracts
-	}
-	return nil
-}
-
-func (x *GenesisState) GetNextContractId() uint64 {
-	if x != nil {
-		return x.NextContractId
-	}
-	return 0
-}
-
-func (x *GenesisState) GetContractExpirationSets() []*ContractExpirationSet {
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorVersions", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ValidatorVersions = append(x.ValidatorVersions, &ValidatorVersion{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ValidatorVersions[len(x.ValidatorVersions)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ParamsRecord", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.ParamsRecord == nil {
+					x.ParamsRecord = &ParamsRecord{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ParamsRecord); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ContractStartSets", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ContractStartSets = append(x.ContractStartSets, &ContractExpirationSet{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ContractStartSets[len(x.ContractStartSets)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 13:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProviderAllowlists", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ProviderAllowlists = append(x.ProviderAllowlists, &ProviderAllowlist{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ProviderAllowlists[len(x.ProviderAllowlists)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 14:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NetworkStats", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.NetworkStats == nil {
+					x.NetworkStats = &NetworkStats{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.NetworkStats); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_ValidatorVersion         protoreflect.MessageDescriptor
+	fd_ValidatorVersion_address protoreflect.FieldDescriptor
+	fd_ValidatorVersion_version protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_arkeo_arkeo_genesis_proto_init()
+	md_ValidatorVersion = File_arkeo_arkeo_genesis_proto.Messages().ByName("ValidatorVersion")
+	fd_ValidatorVersion_address = md_ValidatorVersion.Fields().ByName("address")
+	fd_ValidatorVersion_version = md_ValidatorVersion.Fields().ByName("version")
+}
+
+var _ protoreflect.Message = (*fastReflection_ValidatorVersion)(nil)
+
+type fastReflection_ValidatorVersion ValidatorVersion
+
+func (x *ValidatorVersion) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ValidatorVersion)(x)
+}
+
+func (x *ValidatorVersion) slowProtoReflect() protoreflect.Message {
+	mi := &file_arkeo_arkeo_genesis_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ValidatorVersion_messageType fastReflection_ValidatorVersion_messageType
+var _ protoreflect.MessageType = fastReflection_ValidatorVersion_messageType{}
+
+type fastReflection_ValidatorVersion_messageType struct{}
+
+func (x fastReflection_ValidatorVersion_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ValidatorVersion)(nil)
+}
+func (x fastReflection_ValidatorVersion_messageType) New() protoreflect.Message {
+	return new(fastReflection_ValidatorVersion)
+}
+func (x fastReflection_ValidatorVersion_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValidatorVersion
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ValidatorVersion) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValidatorVersion
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ValidatorVersion) Type() protoreflect.MessageType {
+	return _fastReflection_ValidatorVersion_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ValidatorVersion) New() protoreflect.Message {
+	return new(fastReflection_ValidatorVersion)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ValidatorVersion) Interface() protoreflect.ProtoMessage {
+	return (*ValidatorVersion)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ValidatorVersion) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_ValidatorVersion_address, value) {
+			return
+		}
+	}
+	if x.Version != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Version)
+		if !f(fd_ValidatorVersion_version, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ValidatorVersion) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "arkeo.arkeo.ValidatorVersion.address":
+		return x.Address != ""
+	case "arkeo.arkeo.ValidatorVersion.version":
+		return x.Version != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.ValidatorVersion"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.ValidatorVersion does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorVersion) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "arkeo.arkeo.ValidatorVersion.address":
+		x.Address = ""
+	case "arkeo.arkeo.ValidatorVersion.version":
+		x.Version = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.ValidatorVersion"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.ValidatorVersion does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ValidatorVersion) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "arkeo.arkeo.ValidatorVersion.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
+	case "arkeo.arkeo.ValidatorVersion.version":
+		value := x.Version
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.ValidatorVersion"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.ValidatorVersion does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorVersion) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "arkeo.arkeo.ValidatorVersion.address":
+		x.Address = value.Interface().(string)
+	case "arkeo.arkeo.ValidatorVersion.version":
+		x.Version = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.ValidatorVersion"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.ValidatorVersion does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorVersion) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "arkeo.arkeo.ValidatorVersion.address":
+		panic(fmt.Errorf("field address of message arkeo.arkeo.ValidatorVersion is not mutable"))
+	case "arkeo.arkeo.ValidatorVersion.version":
+		panic(fmt.Errorf("field version of message arkeo.arkeo.ValidatorVersion is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.ValidatorVersion"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.ValidatorVersion does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ValidatorVersion) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "arkeo.arkeo.ValidatorVersion.address":
+		return protoreflect.ValueOfString("")
+	case "arkeo.arkeo.ValidatorVersion.version":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.ValidatorVersion"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.ValidatorVersion does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ValidatorVersion) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in arkeo.arkeo.ValidatorVersion", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ValidatorVersion) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorVersion) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ValidatorVersion) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ValidatorVersion) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ValidatorVersion)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Version != 0 {
+			n += 1 + runtime.Sov(uint64(x.Version))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ValidatorVersion)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Version != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Version))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ValidatorVersion)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorVersion: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorVersion: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Address = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				}
+				x.Version = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Version |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: arkeo/arkeo/genesis.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// GenesisState defines the arkeo module's genesis state.
+type GenesisState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Params                   *Params                  `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	Providers                []*Provider              `protobuf:"bytes,2,rep,name=providers,proto3" json:"providers,omitempty"`
+	Contracts                []*Contract              `protobuf:"bytes,3,rep,name=contracts,proto3" json:"contracts,omitempty"`
+	NextContractId           uint64                   `protobuf:"varint,4,opt,name=next_contract_id,json=nextContractId,proto3" json:"next_contract_id,omitempty"`
+	ContractExpirationSets   []*ContractExpirationSet `protobuf:"bytes,5,rep,name=contract_expiration_sets,json=contractExpirationSets,proto3" json:"contract_expiration_sets,omitempty"`
+	UserContractSets         []*UserContractSet       `protobuf:"bytes,6,rep,name=user_contract_sets,json=userContractSets,proto3" json:"user_contract_sets,omitempty"`
+	Version                  int64                    `protobuf:"varint,7,opt,name=version,proto3" json:"version,omitempty"`
+	ProviderEarnings         []*ProviderEarnings      `protobuf:"bytes,8,rep,name=provider_earnings,json=providerEarnings,proto3" json:"provider_earnings,omitempty"`
+	PayAsYouGoExpirationSets []*ContractExpirationSet `protobuf:"bytes,9,rep,name=pay_as_you_go_expiration_sets,json=payAsYouGoExpirationSets,proto3" json:"pay_as_you_go_expiration_sets,omitempty"`
+	ValidatorVersions        []*ValidatorVersion      `protobuf:"bytes,10,rep,name=validator_versions,json=validatorVersions,proto3" json:"validator_versions,omitempty"`
+	// params_record is the params last seen by the end blocker, unset until it
+	// ran once
+	ParamsRecord       *ParamsRecord            `protobuf:"bytes,11,opt,name=params_record,json=paramsRecord,proto3" json:"params_record,omitempty"`
+	ContractStartSets  []*ContractExpirationSet `protobuf:"bytes,12,rep,name=contract_start_sets,json=contractStartSets,proto3" json:"contract_start_sets,omitempty"`
+	ProviderAllowlists []*ProviderAllowlist     `protobuf:"bytes,13,rep,name=provider_allowlists,json=providerAllowlists,proto3" json:"provider_allowlists,omitempty"`
+	// network_stats is the counters of the providers and contracts, recounted
+	// on import when unset
+	NetworkStats *NetworkStats `protobuf:"bytes,14,opt,name=network_stats,json=networkStats,proto3" json:"network_stats,omitempty"` // this line is used by starport scaffolding # genesis/proto/state
+}
+
+func (x *GenesisState) Reset() {
+	*x = GenesisState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_arkeo_arkeo_genesis_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenesisState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenesisState) ProtoMessage() {}
+
+// Deprecated: Use GenesisState.ProtoReflect.Descriptor instead.
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return file_arkeo_arkeo_genesis_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GenesisState) GetParams() *Params {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+func (x *GenesisState) GetProviders() []*Provider {
+	if x != nil {
+		return x.Providers
+	}
+	return nil
+}
+
+func (x *GenesisState) GetContracts() []*Contract {
+	if x != nil {
+		return x.Contracts
+	}
+	return nil
+}
+
+func (x *GenesisState) GetNextContractId() uint64 {
+	if x != nil {
+		return x.NextContractId
+	}
+	return 0
+}
+
+func (x *GenesisState) GetContractExpirationSets() []*ContractExpirationSet {
 	if x != nil {
 		return x.ContractExpirationSets
 	}
@@ -1193,6 +2520,99 @@ func (x *GenesisState) GetVersion() int64 {
 	return 0
 }
 
+func (x *GenesisState) GetProviderEarnings() []*ProviderEarnings {
+	if x != nil {
+		return x.ProviderEarnings
+	}
+	return nil
+}
+
+func (x *GenesisState) GetPayAsYouGoExpirationSets() []*ContractExpirationSet {
+	if x != nil {
+		return x.PayAsYouGoExpirationSets
+	}
+	return nil
+}
+
+func (x *GenesisState) GetValidatorVersions() []*ValidatorVersion {
+	if x != nil {
+		return x.ValidatorVersions
+	}
+	return nil
+}
+
+func (x *GenesisState) GetParamsRecord() *ParamsRecord {
+	if x != nil {
+		return x.ParamsRecord
+	}
+	return nil
+}
+
+func (x *GenesisState) GetContractStartSets() []*ContractExpirationSet {
+	if x != nil {
+		return x.ContractStartSets
+	}
+	return nil
+}
+
+func (x *GenesisState) GetProviderAllowlists() []*ProviderAllowlist {
+	if x != nil {
+		return x.ProviderAllowlists
+	}
+	return nil
+}
+
+func (x *GenesisState) GetNetworkStats() *NetworkStats {
+	if x != nil {
+		return x.NetworkStats
+	}
+	return nil
+}
+
+// ValidatorVersion is the software version a validator runs
+type ValidatorVersion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Version int64  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *ValidatorVersion) Reset() {
+	*x = ValidatorVersion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_arkeo_arkeo_genesis_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidatorVersion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatorVersion) ProtoMessage() {}
+
+// Deprecated: Use ValidatorVersion.ProtoReflect.Descriptor instead.
+func (*ValidatorVersion) Descriptor() ([]byte, []int) {
+	return file_arkeo_arkeo_genesis_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ValidatorVersion) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *ValidatorVersion) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 var File_arkeo_arkeo_genesis_proto protoreflect.FileDescriptor
 
 var file_arkeo_arkeo_genesis_proto_rawDesc = []byte{
@@ -1203,7 +2623,7 @@ var file_arkeo_arkeo_genesis_proto_rawDesc = []byte{
 	0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2f, 0x70, 0x61, 0x72, 0x61,
 	0x6d, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x18, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2f,
 	0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2f, 0x6b, 0x65, 0x65, 0x70, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x22, 0xb1, 0x03, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74,
+	0x74, 0x6f, 0x22, 0xf3, 0x07, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74,
 	0x61, 0x74, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x61, 0x72, 0x6b, 0x65,
 	0x6f, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06,
@@ -1230,16 +2650,57 @@ var file_arkeo_arkeo_genesis_proto_rawDesc = []byte{
 	0x74, 0x53, 0x65, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x10, 0x75, 0x73, 0x65, 0x72,
 	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x53, 0x65, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07,
 	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x8a, 0x01, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x2e, 0x61,
-	0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65,
-	0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x1c, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x72, 0x6b,
-	0x65, 0x6f, 0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0xa2, 0x02, 0x03, 0x41, 0x41, 0x58, 0xaa, 0x02,
-	0x0b, 0x41, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x41, 0x72, 0x6b, 0x65, 0x6f, 0xca, 0x02, 0x0b, 0x41,
-	0x72, 0x6b, 0x65, 0x6f, 0x5c, 0x41, 0x72, 0x6b, 0x65, 0x6f, 0xe2, 0x02, 0x17, 0x41, 0x72, 0x6b,
-	0x65, 0x6f, 0x5c, 0x41, 0x72, 0x6b, 0x65, 0x6f, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0c, 0x41, 0x72, 0x6b, 0x65, 0x6f, 0x3a, 0x3a, 0x41, 0x72,
-	0x6b, 0x65, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x50, 0x0a, 0x11, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x5f, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e,
+	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x45, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73,
+	0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x10, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x45, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x69, 0x0a, 0x1d, 0x70, 0x61, 0x79, 0x5f,
+	0x61, 0x73, 0x5f, 0x79, 0x6f, 0x75, 0x5f, 0x67, 0x6f, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x74, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x22, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x43, 0x6f,
+	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x45, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x65, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x18, 0x70, 0x61, 0x79, 0x41, 0x73,
+	0x59, 0x6f, 0x75, 0x47, 0x6f, 0x45, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x65, 0x74, 0x73, 0x12, 0x52, 0x0a, 0x12, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1d, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x04,
+	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x11, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x3e, 0x0a, 0x0d, 0x70, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x0c, 0x70, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x58, 0x0a, 0x13, 0x63, 0x6f, 0x6e, 0x74, 0x72,
+	0x61, 0x63, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x73, 0x65, 0x74, 0x73, 0x18, 0x0c,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x61, 0x72, 0x6b,
+	0x65, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x45, 0x78, 0x70, 0x69, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x11,
+	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x53, 0x74, 0x61, 0x72, 0x74, 0x53, 0x65, 0x74,
+	0x73, 0x12, 0x55, 0x0a, 0x13, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x61, 0x6c,
+	0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e,
+	0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x50, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x42, 0x04,
+	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x12, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x41, 0x6c,
+	0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x73, 0x12, 0x3e, 0x0a, 0x0d, 0x6e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x4e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x0c, 0x6e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x73, 0x22, 0x46, 0x0a, 0x10, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x42, 0x8a, 0x01, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x61,
+	0x72, 0x6b, 0x65, 0x6f, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x50, 0x01, 0x5a, 0x1c, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e,
+	0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2f, 0x61, 0x72, 0x6b,
+	0x65, 0x6f, 0xa2, 0x02, 0x03, 0x41, 0x41, 0x58, 0xaa, 0x02, 0x0b, 0x41, 0x72, 0x6b, 0x65, 0x6f,
+	0x2e, 0x41, 0x72, 0x6b, 0x65, 0x6f, 0xca, 0x02, 0x0b, 0x41, 0x72, 0x6b, 0x65, 0x6f, 0x5c, 0x41,
+	0x72, 0x6b, 0x65, 0x6f, 0xe2, 0x02, 0x17, 0x41, 0x72, 0x6b, 0x65, 0x6f, 0x5c, 0x41, 0x72, 0x6b,
+	0x65, 0x6f, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02,
+	0x0c, 0x41, 0x72, 0x6b, 0x65, 0x6f, 0x3a, 0x3a, 0x41, 0x72, 0x6b, 0x65, 0x6f, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1254,26 +2715,38 @@ func file_arkeo_arkeo_genesis_proto_rawDescGZIP() []byte {
 	return file_arkeo_arkeo_genesis_proto_rawDescData
 }
 
-var file_arkeo_arkeo_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_arkeo_arkeo_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
 var file_arkeo_arkeo_genesis_proto_goTypes = []interface{}{
 	(*GenesisState)(nil),          // 0: arkeo.arkeo.GenesisState
-	(*Params)(nil),                // 1: arkeo.arkeo.Params
-	(*Provider)(nil),              // 2: arkeo.arkeo.Provider
-	(*Contract)(nil),              // 3: arkeo.arkeo.Contract
-	(*ContractExpirationSet)(nil), // 4: arkeo.arkeo.ContractExpirationSet
-	(*UserContractSet)(nil),       // 5: arkeo.arkeo.UserContractSet
+	(*ValidatorVersion)(nil),      // 1: arkeo.arkeo.ValidatorVersion
+	(*Params)(nil),                // 2: arkeo.arkeo.Params
+	(*Provider)(nil),              // 3: arkeo.arkeo.Provider
+	(*Contract)(nil),              // 4: arkeo.arkeo.Contract
+	(*ContractExpirationSet)(nil), // 5: arkeo.arkeo.ContractExpirationSet
+	(*UserContractSet)(nil),       // 6: arkeo.arkeo.UserContractSet
+	(*ProviderEarnings)(nil),      // 7: arkeo.arkeo.ProviderEarnings
+	(*ParamsRecord)(nil),          // 8: arkeo.arkeo.ParamsRecord
+	(*ProviderAllowlist)(nil),     // 9: arkeo.arkeo.ProviderAllowlist
+	(*NetworkStats)(nil),          // 10: arkeo.arkeo.NetworkStats
 }
 var file_arkeo_arkeo_genesis_proto_depIdxs = []int32{
-	1, // 0: arkeo.arkeo.GenesisState.params:type_name -> arkeo.arkeo.Params
-	2, // 1: arkeo.arkeo.GenesisState.providers:type_name -> arkeo.arkeo.Provider
-	3, // 2: arkeo.arkeo.GenesisState.contracts:type_name -> arkeo.arkeo.Contract
-	4, // 3: arkeo.arkeo.GenesisState.contract_expiration_sets:type_name -> arkeo.arkeo.ContractExpirationSet
-	5, // 4: arkeo.arkeo.GenesisState.user_contract_sets:type_name -> arkeo.arkeo.UserContractSet
-	5, // [5:5] is the sub-list for method output_type
-	5, // [5:5] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	2,  // 0: arkeo.arkeo.GenesisState.params:type_name -> arkeo.arkeo.Params
+	3,  // 1: arkeo.arkeo.GenesisState.providers:type_name -> arkeo.arkeo.Provider
+	4,  // 2: arkeo.arkeo.GenesisState.contracts:type_name -> arkeo.arkeo.Contract
+	5,  // 3: arkeo.arkeo.GenesisState.contract_expiration_sets:type_name -> arkeo.arkeo.ContractExpirationSet
+	6,  // 4: arkeo.arkeo.GenesisState.user_contract_sets:type_name -> arkeo.arkeo.UserContractSet
+	7,  // 5: arkeo.arkeo.GenesisState.provider_earnings:type_name -> arkeo.arkeo.ProviderEarnings
+	5,  // 6: arkeo.arkeo.GenesisState.pay_as_you_go_expiration_sets:type_name -> arkeo.arkeo.ContractExpirationSet
+	1,  // 7: arkeo.arkeo.GenesisState.validator_versions:type_name -> arkeo.arkeo.ValidatorVersion
+	8,  // 8: arkeo.arkeo.GenesisState.params_record:type_name -> arkeo.arkeo.ParamsRecord
+	5,  // 9: arkeo.arkeo.GenesisState.contract_start_sets:type_name -> arkeo.arkeo.ContractExpirationSet
+	9,  // 10: arkeo.arkeo.GenesisState.provider_allowlists:type_name -> arkeo.arkeo.ProviderAllowlist
+	10, // 11: arkeo.arkeo.GenesisState.network_stats:type_name -> arkeo.arkeo.NetworkStats
+	12, // [12:12] is the sub-list for method output_type
+	12, // [12:12] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_arkeo_arkeo_genesis_proto_init() }
@@ -1296,6 +2769,18 @@ func file_arkeo_arkeo_genesis_proto_init() {
 				return nil
 			}
 		}
+		file_arkeo_arkeo_genesis_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidatorVersion); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1303,7 +2788,7 @@ func file_arkeo_arkeo_genesis_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_arkeo_arkeo_genesis_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   1,
+			NumMessages:   2,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
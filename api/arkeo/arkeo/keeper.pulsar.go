@@ -131,6 +131,9 @@ var (
 	fd_Provider_bond                  protoreflect.FieldDescriptor
 	fd_Provider_last_update           protoreflect.FieldDescriptor
 	fd_Provider_settlement_duration   protoreflect.FieldDescriptor
+	fd_Provider_faults                protoreflect.FieldDescriptor
+	fd_Provider_open_contracts        protoreflect.FieldDescriptor
+	fd_Provider_max_open_contracts    protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -148,6 +151,9 @@ func init() {
 	fd_Provider_bond = md_Provider.Fields().ByName("bond")
 	fd_Provider_last_update = md_Provider.Fields().ByName("last_update")
 	fd_Provider_settlement_duration = md_Provider.Fields().ByName("settlement_duration")
+	fd_Provider_faults = md_Provider.Fields().ByName("faults")
+	fd_Provider_open_contracts = md_Provider.Fields().ByName("open_contracts")
+	fd_Provider_max_open_contracts = md_Provider.Fields().ByName("max_open_contracts")
 }
 
 var _ protoreflect.Message = (*fastReflection_Provider)(nil)
@@ -287,6 +293,24 @@ func (x *fastReflection_Provider) Range(f func(protoreflect.FieldDescriptor, pro
 			return
 		}
 	}
+	if x.Faults != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Faults)
+		if !f(fd_Provider_faults, value) {
+			return
+		}
+	}
+	if x.OpenContracts != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.OpenContracts)
+		if !f(fd_Provider_open_contracts, value) {
+			return
+		}
+	}
+	if x.MaxOpenContracts != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxOpenContracts)
+		if !f(fd_Provider_max_open_contracts, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -326,6 +350,12 @@ func (x *fastReflection_Provider) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.LastUpdate != int64(0)
 	case "arkeo.arkeo.Provider.settlement_duration":
 		return x.SettlementDuration != int64(0)
+	case "arkeo.arkeo.Provider.faults":
+		return x.Faults != int64(0)
+	case "arkeo.arkeo.Provider.open_contracts":
+		return x.OpenContracts != uint64(0)
+	case "arkeo.arkeo.Provider.max_open_contracts":
+		return x.MaxOpenContracts != uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.Provider"))
@@ -366,6 +396,12 @@ func (x *fastReflection_Provider) Clear(fd protoreflect.FieldDescriptor) {
 		x.LastUpdate = int64(0)
 	case "arkeo.arkeo.Provider.settlement_duration":
 		x.SettlementDuration = int64(0)
+	case "arkeo.arkeo.Provider.faults":
+		x.Faults = int64(0)
+	case "arkeo.arkeo.Provider.open_contracts":
+		x.OpenContracts = uint64(0)
+	case "arkeo.arkeo.Provider.max_open_contracts":
+		x.MaxOpenContracts = uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.Provider"))
@@ -424,6 +460,15 @@ func (x *fastReflection_Provider) Get(descriptor protoreflect.FieldDescriptor) p
 	case "arkeo.arkeo.Provider.settlement_duration":
 		value := x.SettlementDuration
 		return protoreflect.ValueOfInt64(value)
+	case "arkeo.arkeo.Provider.faults":
+		value := x.Faults
+		return protoreflect.ValueOfInt64(value)
+	case "arkeo.arkeo.Provider.open_contracts":
+		value := x.OpenContracts
+		return protoreflect.ValueOfUint64(value)
+	case "arkeo.arkeo.Provider.max_open_contracts":
+		value := x.MaxOpenContracts
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.Provider"))
@@ -472,6 +517,12 @@ func (x *fastReflection_Provider) Set(fd protoreflect.FieldDescriptor, value pro
 		x.LastUpdate = value.Int()
 	case "arkeo.arkeo.Provider.settlement_duration":
 		x.SettlementDuration = value.Int()
+	case "arkeo.arkeo.Provider.faults":
+		x.Faults = value.Int()
+	case "arkeo.arkeo.Provider.open_contracts":
+		x.OpenContracts = value.Uint()
+	case "arkeo.arkeo.Provider.max_open_contracts":
+		x.MaxOpenContracts = value.Uint()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.Provider"))
@@ -524,6 +575,12 @@ func (x *fastReflection_Provider) Mutable(fd protoreflect.FieldDescriptor) proto
 		panic(fmt.Errorf("field last_update of message arkeo.arkeo.Provider is not mutable"))
 	case "arkeo.arkeo.Provider.settlement_duration":
 		panic(fmt.Errorf("field settlement_duration of message arkeo.arkeo.Provider is not mutable"))
+	case "arkeo.arkeo.Provider.faults":
+		panic(fmt.Errorf("field faults of message arkeo.arkeo.Provider is not mutable"))
+	case "arkeo.arkeo.Provider.open_contracts":
+		panic(fmt.Errorf("field open_contracts of message arkeo.arkeo.Provider is not mutable"))
+	case "arkeo.arkeo.Provider.max_open_contracts":
+		panic(fmt.Errorf("field max_open_contracts of message arkeo.arkeo.Provider is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.Provider"))
@@ -563,6 +620,12 @@ func (x *fastReflection_Provider) NewField(fd protoreflect.FieldDescriptor) prot
 		return protoreflect.ValueOfInt64(int64(0))
 	case "arkeo.arkeo.Provider.settlement_duration":
 		return protoreflect.ValueOfInt64(int64(0))
+	case "arkeo.arkeo.Provider.faults":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "arkeo.arkeo.Provider.open_contracts":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "arkeo.arkeo.Provider.max_open_contracts":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.Provider"))
@@ -677,6 +740,15 @@ func (x *fastReflection_Provider) ProtoMethods() *protoiface.Methods {
 		if x.SettlementDuration != 0 {
 			n += 1 + runtime.Sov(uint64(x.SettlementDuration))
 		}
+		if x.Faults != 0 {
+			n += 1 + runtime.Sov(uint64(x.Faults))
+		}
+		if x.OpenContracts != 0 {
+			n += 1 + runtime.Sov(uint64(x.OpenContracts))
+		}
+		if x.MaxOpenContracts != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxOpenContracts))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -706,6 +778,21 @@ func (x *fastReflection_Provider) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.MaxOpenContracts != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxOpenContracts))
+			i--
+			dAtA[i] = 0x78
+		}
+		if x.OpenContracts != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OpenContracts))
+			i--
+			dAtA[i] = 0x70
+		}
+		if x.Faults != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Faults))
+			i--
+			dAtA[i] = 0x68
+		}
 		if x.SettlementDuration != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.SettlementDuration))
 			i--
@@ -1142,6 +1229,63 @@ func (x *fastReflection_Provider) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
+			case 13:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Faults", wireType)
+				}
+				x.Faults = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Faults |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 14:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OpenContracts", wireType)
+				}
+				x.OpenContracts = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.OpenContracts |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 15:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxOpenContracts", wireType)
+				}
+				x.MaxOpenContracts = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxOpenContracts |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1178,23 +1322,28 @@ func (x *fastReflection_Provider) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_Contract                     protoreflect.MessageDescriptor
-	fd_Contract_provider            protoreflect.FieldDescriptor
-	fd_Contract_service             protoreflect.FieldDescriptor
-	fd_Contract_client              protoreflect.FieldDescriptor
-	fd_Contract_delegate            protoreflect.FieldDescriptor
-	fd_Contract_type                protoreflect.FieldDescriptor
-	fd_Contract_height              protoreflect.FieldDescriptor
-	fd_Contract_duration            protoreflect.FieldDescriptor
-	fd_Contract_rate                protoreflect.FieldDescriptor
-	fd_Contract_deposit             protoreflect.FieldDescriptor
-	fd_Contract_paid                protoreflect.FieldDescriptor
-	fd_Contract_nonce               protoreflect.FieldDescriptor
-	fd_Contract_settlement_height   protoreflect.FieldDescriptor
-	fd_Contract_id                  protoreflect.FieldDescriptor
-	fd_Contract_settlement_duration protoreflect.FieldDescriptor
-	fd_Contract_authorization       protoreflect.FieldDescriptor
-	fd_Contract_queries_per_minute  protoreflect.FieldDescriptor
+	md_Contract                         protoreflect.MessageDescriptor
+	fd_Contract_provider                protoreflect.FieldDescriptor
+	fd_Contract_service                 protoreflect.FieldDescriptor
+	fd_Contract_client                  protoreflect.FieldDescriptor
+	fd_Contract_delegate                protoreflect.FieldDescriptor
+	fd_Contract_type                    protoreflect.FieldDescriptor
+	fd_Contract_height                  protoreflect.FieldDescriptor
+	fd_Contract_duration                protoreflect.FieldDescriptor
+	fd_Contract_rate                    protoreflect.FieldDescriptor
+	fd_Contract_deposit                 protoreflect.FieldDescriptor
+	fd_Contract_paid                    protoreflect.FieldDescriptor
+	fd_Contract_nonce                   protoreflect.FieldDescriptor
+	fd_Contract_settlement_height       protoreflect.FieldDescriptor
+	fd_Contract_id                      protoreflect.FieldDescriptor
+	fd_Contract_settlement_duration     protoreflect.FieldDescriptor
+	fd_Contract_authorization           protoreflect.FieldDescriptor
+	fd_Contract_queries_per_minute      protoreflect.FieldDescriptor
+	fd_Contract_settlement_grace_period protoreflect.FieldDescriptor
+	fd_Contract_auto_renew              protoreflect.FieldDescriptor
+	fd_Contract_top_up                  protoreflect.FieldDescriptor
+	fd_Contract_payer                   protoreflect.FieldDescriptor
+	fd_Contract_start_height            protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -1216,6 +1365,11 @@ func init() {
 	fd_Contract_settlement_duration = md_Contract.Fields().ByName("settlement_duration")
 	fd_Contract_authorization = md_Contract.Fields().ByName("authorization")
 	fd_Contract_queries_per_minute = md_Contract.Fields().ByName("queries_per_minute")
+	fd_Contract_settlement_grace_period = md_Contract.Fields().ByName("settlement_grace_period")
+	fd_Contract_auto_renew = md_Contract.Fields().ByName("auto_renew")
+	fd_Contract_top_up = md_Contract.Fields().ByName("top_up")
+	fd_Contract_payer = md_Contract.Fields().ByName("payer")
+	fd_Contract_start_height = md_Contract.Fields().ByName("start_height")
 }
 
 var _ protoreflect.Message = (*fastReflection_Contract)(nil)
@@ -1379,6 +1533,36 @@ func (x *fastReflection_Contract) Range(f func(protoreflect.FieldDescriptor, pro
 			return
 		}
 	}
+	if x.SettlementGracePeriod != int64(0) {
+		value := protoreflect.ValueOfInt64(x.SettlementGracePeriod)
+		if !f(fd_Contract_settlement_grace_period, value) {
+			return
+		}
+	}
+	if x.AutoRenew != false {
+		value := protoreflect.ValueOfBool(x.AutoRenew)
+		if !f(fd_Contract_auto_renew, value) {
+			return
+		}
+	}
+	if x.TopUp != "" {
+		value := protoreflect.ValueOfString(x.TopUp)
+		if !f(fd_Contract_top_up, value) {
+			return
+		}
+	}
+	if x.Payer != "" {
+		value := protoreflect.ValueOfString(x.Payer)
+		if !f(fd_Contract_payer, value) {
+			return
+		}
+	}
+	if x.StartHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.StartHeight)
+		if !f(fd_Contract_start_height, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -1426,6 +1610,16 @@ func (x *fastReflection_Contract) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.Authorization != 0
 	case "arkeo.arkeo.Contract.queries_per_minute":
 		return x.QueriesPerMinute != int64(0)
+	case "arkeo.arkeo.Contract.settlement_grace_period":
+		return x.SettlementGracePeriod != int64(0)
+	case "arkeo.arkeo.Contract.auto_renew":
+		return x.AutoRenew != false
+	case "arkeo.arkeo.Contract.top_up":
+		return x.TopUp != ""
+	case "arkeo.arkeo.Contract.payer":
+		return x.Payer != ""
+	case "arkeo.arkeo.Contract.start_height":
+		return x.StartHeight != int64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.Contract"))
@@ -1474,6 +1668,16 @@ func (x *fastReflection_Contract) Clear(fd protoreflect.FieldDescriptor) {
 		x.Authorization = 0
 	case "arkeo.arkeo.Contract.queries_per_minute":
 		x.QueriesPerMinute = int64(0)
+	case "arkeo.arkeo.Contract.settlement_grace_period":
+		x.SettlementGracePeriod = int64(0)
+	case "arkeo.arkeo.Contract.auto_renew":
+		x.AutoRenew = false
+	case "arkeo.arkeo.Contract.top_up":
+		x.TopUp = ""
+	case "arkeo.arkeo.Contract.payer":
+		x.Payer = ""
+	case "arkeo.arkeo.Contract.start_height":
+		x.StartHeight = int64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.Contract"))
@@ -1538,6 +1742,21 @@ func (x *fastReflection_Contract) Get(descriptor protoreflect.FieldDescriptor) p
 	case "arkeo.arkeo.Contract.queries_per_minute":
 		value := x.QueriesPerMinute
 		return protoreflect.ValueOfInt64(value)
+	case "arkeo.arkeo.Contract.settlement_grace_period":
+		value := x.SettlementGracePeriod
+		return protoreflect.ValueOfInt64(value)
+	case "arkeo.arkeo.Contract.auto_renew":
+		value := x.AutoRenew
+		return protoreflect.ValueOfBool(value)
+	case "arkeo.arkeo.Contract.top_up":
+		value := x.TopUp
+		return protoreflect.ValueOfString(value)
+	case "arkeo.arkeo.Contract.payer":
+		value := x.Payer
+		return protoreflect.ValueOfString(value)
+	case "arkeo.arkeo.Contract.start_height":
+		value := x.StartHeight
+		return protoreflect.ValueOfInt64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.Contract"))
@@ -1590,6 +1809,16 @@ func (x *fastReflection_Contract) Set(fd protoreflect.FieldDescriptor, value pro
 		x.Authorization = (ContractAuthorization)(value.Enum())
 	case "arkeo.arkeo.Contract.queries_per_minute":
 		x.QueriesPerMinute = value.Int()
+	case "arkeo.arkeo.Contract.settlement_grace_period":
+		x.SettlementGracePeriod = value.Int()
+	case "arkeo.arkeo.Contract.auto_renew":
+		x.AutoRenew = value.Bool()
+	case "arkeo.arkeo.Contract.top_up":
+		x.TopUp = value.Interface().(string)
+	case "arkeo.arkeo.Contract.payer":
+		x.Payer = value.Interface().(string)
+	case "arkeo.arkeo.Contract.start_height":
+		x.StartHeight = value.Int()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.Contract"))
@@ -1645,6 +1874,16 @@ func (x *fastReflection_Contract) Mutable(fd protoreflect.FieldDescriptor) proto
 		panic(fmt.Errorf("field authorization of message arkeo.arkeo.Contract is not mutable"))
 	case "arkeo.arkeo.Contract.queries_per_minute":
 		panic(fmt.Errorf("field queries_per_minute of message arkeo.arkeo.Contract is not mutable"))
+	case "arkeo.arkeo.Contract.settlement_grace_period":
+		panic(fmt.Errorf("field settlement_grace_period of message arkeo.arkeo.Contract is not mutable"))
+	case "arkeo.arkeo.Contract.auto_renew":
+		panic(fmt.Errorf("field auto_renew of message arkeo.arkeo.Contract is not mutable"))
+	case "arkeo.arkeo.Contract.top_up":
+		panic(fmt.Errorf("field top_up of message arkeo.arkeo.Contract is not mutable"))
+	case "arkeo.arkeo.Contract.payer":
+		panic(fmt.Errorf("field payer of message arkeo.arkeo.Contract is not mutable"))
+	case "arkeo.arkeo.Contract.start_height":
+		panic(fmt.Errorf("field start_height of message arkeo.arkeo.Contract is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.Contract"))
@@ -1691,6 +1930,16 @@ func (x *fastReflection_Contract) NewField(fd protoreflect.FieldDescriptor) prot
 		return protoreflect.ValueOfEnum(0)
 	case "arkeo.arkeo.Contract.queries_per_minute":
 		return protoreflect.ValueOfInt64(int64(0))
+	case "arkeo.arkeo.Contract.settlement_grace_period":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "arkeo.arkeo.Contract.auto_renew":
+		return protoreflect.ValueOfBool(false)
+	case "arkeo.arkeo.Contract.top_up":
+		return protoreflect.ValueOfString("")
+	case "arkeo.arkeo.Contract.payer":
+		return protoreflect.ValueOfString("")
+	case "arkeo.arkeo.Contract.start_height":
+		return protoreflect.ValueOfInt64(int64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.Contract"))
@@ -1814,6 +2063,23 @@ func (x *fastReflection_Contract) ProtoMethods() *protoiface.Methods {
 		if x.QueriesPerMinute != 0 {
 			n += 2 + runtime.Sov(uint64(x.QueriesPerMinute))
 		}
+		if x.SettlementGracePeriod != 0 {
+			n += 2 + runtime.Sov(uint64(x.SettlementGracePeriod))
+		}
+		if x.AutoRenew {
+			n += 3
+		}
+		l = len(x.TopUp)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Payer)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.StartHeight != 0 {
+			n += 2 + runtime.Sov(uint64(x.StartHeight))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1843,6 +2109,50 @@ func (x *fastReflection_Contract) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.StartHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.StartHeight))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa8
+		}
+		if len(x.Payer) > 0 {
+			i -= len(x.Payer)
+			copy(dAtA[i:], x.Payer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Payer)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+		if len(x.TopUp) > 0 {
+			i -= len(x.TopUp)
+			copy(dAtA[i:], x.TopUp)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TopUp)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+		if x.AutoRenew {
+			i--
+			if x.AutoRenew {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x90
+		}
+		if x.SettlementGracePeriod != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SettlementGracePeriod))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x88
+		}
 		if x.QueriesPerMinute != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.QueriesPerMinute))
 			i--
@@ -2385,6 +2695,128 @@ func (x *fastReflection_Contract) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
+			case 17:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SettlementGracePeriod", wireType)
+				}
+				x.SettlementGracePeriod = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SettlementGracePeriod |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 18:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AutoRenew", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.AutoRenew = bool(v != 0)
+			case 19:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TopUp", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TopUp = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 20:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Payer", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Payer = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 21:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+				}
+				x.StartHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.StartHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -3940,552 +4372,5336 @@ func (x *fastReflection_UserContractSet) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: arkeo/arkeo/keeper.proto
+var _ protoreflect.List = (*_ProviderEarnings_3_list)(nil)
 
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
+type _ProviderEarnings_3_list struct {
+	list *[]*v1beta1.Coin
+}
 
-type ProviderStatus int32
+func (x *_ProviderEarnings_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
 
-const (
-	ProviderStatus_OFFLINE ProviderStatus = 0
-	ProviderStatus_ONLINE  ProviderStatus = 1
-)
+func (x *_ProviderEarnings_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
 
-// Enum value maps for ProviderStatus.
-var (
-	ProviderStatus_name = map[int32]string{
-		0: "OFFLINE",
-		1: "ONLINE",
+func (x *_ProviderEarnings_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_ProviderEarnings_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_ProviderEarnings_3_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ProviderEarnings_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
 	}
-	ProviderStatus_value = map[string]int32{
-		"OFFLINE": 0,
-		"ONLINE":  1,
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_ProviderEarnings_3_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ProviderEarnings_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_ProviderEarnings_5_list)(nil)
+
+type _ProviderEarnings_5_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_ProviderEarnings_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_ProviderEarnings_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_ProviderEarnings_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_ProviderEarnings_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_ProviderEarnings_5_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ProviderEarnings_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
 	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_ProviderEarnings_5_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ProviderEarnings_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_ProviderEarnings                   protoreflect.MessageDescriptor
+	fd_ProviderEarnings_provider          protoreflect.FieldDescriptor
+	fd_ProviderEarnings_service           protoreflect.FieldDescriptor
+	fd_ProviderEarnings_income            protoreflect.FieldDescriptor
+	fd_ProviderEarnings_settled_contracts protoreflect.FieldDescriptor
+	fd_ProviderEarnings_escrowed          protoreflect.FieldDescriptor
 )
 
-func (x ProviderStatus) Enum() *ProviderStatus {
-	p := new(ProviderStatus)
-	*p = x
-	return p
+func init() {
+	file_arkeo_arkeo_keeper_proto_init()
+	md_ProviderEarnings = File_arkeo_arkeo_keeper_proto.Messages().ByName("ProviderEarnings")
+	fd_ProviderEarnings_provider = md_ProviderEarnings.Fields().ByName("provider")
+	fd_ProviderEarnings_service = md_ProviderEarnings.Fields().ByName("service")
+	fd_ProviderEarnings_income = md_ProviderEarnings.Fields().ByName("income")
+	fd_ProviderEarnings_settled_contracts = md_ProviderEarnings.Fields().ByName("settled_contracts")
+	fd_ProviderEarnings_escrowed = md_ProviderEarnings.Fields().ByName("escrowed")
+}
+
+var _ protoreflect.Message = (*fastReflection_ProviderEarnings)(nil)
+
+type fastReflection_ProviderEarnings ProviderEarnings
+
+func (x *ProviderEarnings) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ProviderEarnings)(x)
+}
+
+func (x *ProviderEarnings) slowProtoReflect() protoreflect.Message {
+	mi := &file_arkeo_arkeo_keeper_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ProviderEarnings_messageType fastReflection_ProviderEarnings_messageType
+var _ protoreflect.MessageType = fastReflection_ProviderEarnings_messageType{}
+
+type fastReflection_ProviderEarnings_messageType struct{}
+
+func (x fastReflection_ProviderEarnings_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ProviderEarnings)(nil)
+}
+func (x fastReflection_ProviderEarnings_messageType) New() protoreflect.Message {
+	return new(fastReflection_ProviderEarnings)
+}
+func (x fastReflection_ProviderEarnings_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ProviderEarnings
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ProviderEarnings) Descriptor() protoreflect.MessageDescriptor {
+	return md_ProviderEarnings
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ProviderEarnings) Type() protoreflect.MessageType {
+	return _fastReflection_ProviderEarnings_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ProviderEarnings) New() protoreflect.Message {
+	return new(fastReflection_ProviderEarnings)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ProviderEarnings) Interface() protoreflect.ProtoMessage {
+	return (*ProviderEarnings)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ProviderEarnings) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Provider) != 0 {
+		value := protoreflect.ValueOfBytes(x.Provider)
+		if !f(fd_ProviderEarnings_provider, value) {
+			return
+		}
+	}
+	if x.Service != int32(0) {
+		value := protoreflect.ValueOfInt32(x.Service)
+		if !f(fd_ProviderEarnings_service, value) {
+			return
+		}
+	}
+	if len(x.Income) != 0 {
+		value := protoreflect.ValueOfList(&_ProviderEarnings_3_list{list: &x.Income})
+		if !f(fd_ProviderEarnings_income, value) {
+			return
+		}
+	}
+	if x.SettledContracts != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.SettledContracts)
+		if !f(fd_ProviderEarnings_settled_contracts, value) {
+			return
+		}
+	}
+	if len(x.Escrowed) != 0 {
+		value := protoreflect.ValueOfList(&_ProviderEarnings_5_list{list: &x.Escrowed})
+		if !f(fd_ProviderEarnings_escrowed, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ProviderEarnings) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "arkeo.arkeo.ProviderEarnings.provider":
+		return len(x.Provider) != 0
+	case "arkeo.arkeo.ProviderEarnings.service":
+		return x.Service != int32(0)
+	case "arkeo.arkeo.ProviderEarnings.income":
+		return len(x.Income) != 0
+	case "arkeo.arkeo.ProviderEarnings.settled_contracts":
+		return x.SettledContracts != uint64(0)
+	case "arkeo.arkeo.ProviderEarnings.escrowed":
+		return len(x.Escrowed) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.ProviderEarnings"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.ProviderEarnings does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ProviderEarnings) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "arkeo.arkeo.ProviderEarnings.provider":
+		x.Provider = nil
+	case "arkeo.arkeo.ProviderEarnings.service":
+		x.Service = int32(0)
+	case "arkeo.arkeo.ProviderEarnings.income":
+		x.Income = nil
+	case "arkeo.arkeo.ProviderEarnings.settled_contracts":
+		x.SettledContracts = uint64(0)
+	case "arkeo.arkeo.ProviderEarnings.escrowed":
+		x.Escrowed = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.ProviderEarnings"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.ProviderEarnings does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ProviderEarnings) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "arkeo.arkeo.ProviderEarnings.provider":
+		value := x.Provider
+		return protoreflect.ValueOfBytes(value)
+	case "arkeo.arkeo.ProviderEarnings.service":
+		value := x.Service
+		return protoreflect.ValueOfInt32(value)
+	case "arkeo.arkeo.ProviderEarnings.income":
+		if len(x.Income) == 0 {
+			return protoreflect.ValueOfList(&_ProviderEarnings_3_list{})
+		}
+		listValue := &_ProviderEarnings_3_list{list: &x.Income}
+		return protoreflect.ValueOfList(listValue)
+	case "arkeo.arkeo.ProviderEarnings.settled_contracts":
+		value := x.SettledContracts
+		return protoreflect.ValueOfUint64(value)
+	case "arkeo.arkeo.ProviderEarnings.escrowed":
+		if len(x.Escrowed) == 0 {
+			return protoreflect.ValueOfList(&_ProviderEarnings_5_list{})
+		}
+		listValue := &_ProviderEarnings_5_list{list: &x.Escrowed}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.ProviderEarnings"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.ProviderEarnings does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ProviderEarnings) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "arkeo.arkeo.ProviderEarnings.provider":
+		x.Provider = value.Bytes()
+	case "arkeo.arkeo.ProviderEarnings.service":
+		x.Service = int32(value.Int())
+	case "arkeo.arkeo.ProviderEarnings.income":
+		lv := value.List()
+		clv := lv.(*_ProviderEarnings_3_list)
+		x.Income = *clv.list
+	case "arkeo.arkeo.ProviderEarnings.settled_contracts":
+		x.SettledContracts = value.Uint()
+	case "arkeo.arkeo.ProviderEarnings.escrowed":
+		lv := value.List()
+		clv := lv.(*_ProviderEarnings_5_list)
+		x.Escrowed = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.ProviderEarnings"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.ProviderEarnings does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ProviderEarnings) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "arkeo.arkeo.ProviderEarnings.income":
+		if x.Income == nil {
+			x.Income = []*v1beta1.Coin{}
+		}
+		value := &_ProviderEarnings_3_list{list: &x.Income}
+		return protoreflect.ValueOfList(value)
+	case "arkeo.arkeo.ProviderEarnings.escrowed":
+		if x.Escrowed == nil {
+			x.Escrowed = []*v1beta1.Coin{}
+		}
+		value := &_ProviderEarnings_5_list{list: &x.Escrowed}
+		return protoreflect.ValueOfList(value)
+	case "arkeo.arkeo.ProviderEarnings.provider":
+		panic(fmt.Errorf("field provider of message arkeo.arkeo.ProviderEarnings is not mutable"))
+	case "arkeo.arkeo.ProviderEarnings.service":
+		panic(fmt.Errorf("field service of message arkeo.arkeo.ProviderEarnings is not mutable"))
+	case "arkeo.arkeo.ProviderEarnings.settled_contracts":
+		panic(fmt.Errorf("field settled_contracts of message arkeo.arkeo.ProviderEarnings is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.ProviderEarnings"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.ProviderEarnings does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ProviderEarnings) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "arkeo.arkeo.ProviderEarnings.provider":
+		return protoreflect.ValueOfBytes(nil)
+	case "arkeo.arkeo.ProviderEarnings.service":
+		return protoreflect.ValueOfInt32(int32(0))
+	case "arkeo.arkeo.ProviderEarnings.income":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_ProviderEarnings_3_list{list: &list})
+	case "arkeo.arkeo.ProviderEarnings.settled_contracts":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "arkeo.arkeo.ProviderEarnings.escrowed":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_ProviderEarnings_5_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.ProviderEarnings"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.ProviderEarnings does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ProviderEarnings) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in arkeo.arkeo.ProviderEarnings", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ProviderEarnings) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ProviderEarnings) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ProviderEarnings) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ProviderEarnings) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ProviderEarnings)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Provider)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Service != 0 {
+			n += 1 + runtime.Sov(uint64(x.Service))
+		}
+		if len(x.Income) > 0 {
+			for _, e := range x.Income {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.SettledContracts != 0 {
+			n += 1 + runtime.Sov(uint64(x.SettledContracts))
+		}
+		if len(x.Escrowed) > 0 {
+			for _, e := range x.Escrowed {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ProviderEarnings)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Escrowed) > 0 {
+			for iNdEx := len(x.Escrowed) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Escrowed[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if x.SettledContracts != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SettledContracts))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.Income) > 0 {
+			for iNdEx := len(x.Income) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Income[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if x.Service != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Service))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Provider) > 0 {
+			i -= len(x.Provider)
+			copy(dAtA[i:], x.Provider)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Provider)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ProviderEarnings)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ProviderEarnings: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ProviderEarnings: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Provider = append(x.Provider[:0], dAtA[iNdEx:postIndex]...)
+				if x.Provider == nil {
+					x.Provider = []byte{}
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+				}
+				x.Service = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Service |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Income", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Income = append(x.Income, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Income[len(x.Income)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SettledContracts", wireType)
+				}
+				x.SettledContracts = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SettledContracts |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Escrowed", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Escrowed = append(x.Escrowed, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Escrowed[len(x.Escrowed)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_ContractSettlement                 protoreflect.MessageDescriptor
+	fd_ContractSettlement_contract_id     protoreflect.FieldDescriptor
+	fd_ContractSettlement_nonce           protoreflect.FieldDescriptor
+	fd_ContractSettlement_final           protoreflect.FieldDescriptor
+	fd_ContractSettlement_owed            protoreflect.FieldDescriptor
+	fd_ContractSettlement_provider_income protoreflect.FieldDescriptor
+	fd_ContractSettlement_reserve_tax     protoreflect.FieldDescriptor
+	fd_ContractSettlement_refund          protoreflect.FieldDescriptor
+	fd_ContractSettlement_unpaid          protoreflect.FieldDescriptor
+	fd_ContractSettlement_dust            protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_arkeo_arkeo_keeper_proto_init()
+	md_ContractSettlement = File_arkeo_arkeo_keeper_proto.Messages().ByName("ContractSettlement")
+	fd_ContractSettlement_contract_id = md_ContractSettlement.Fields().ByName("contract_id")
+	fd_ContractSettlement_nonce = md_ContractSettlement.Fields().ByName("nonce")
+	fd_ContractSettlement_final = md_ContractSettlement.Fields().ByName("final")
+	fd_ContractSettlement_owed = md_ContractSettlement.Fields().ByName("owed")
+	fd_ContractSettlement_provider_income = md_ContractSettlement.Fields().ByName("provider_income")
+	fd_ContractSettlement_reserve_tax = md_ContractSettlement.Fields().ByName("reserve_tax")
+	fd_ContractSettlement_refund = md_ContractSettlement.Fields().ByName("refund")
+	fd_ContractSettlement_unpaid = md_ContractSettlement.Fields().ByName("unpaid")
+	fd_ContractSettlement_dust = md_ContractSettlement.Fields().ByName("dust")
+}
+
+var _ protoreflect.Message = (*fastReflection_ContractSettlement)(nil)
+
+type fastReflection_ContractSettlement ContractSettlement
+
+func (x *ContractSettlement) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ContractSettlement)(x)
+}
+
+func (x *ContractSettlement) slowProtoReflect() protoreflect.Message {
+	mi := &file_arkeo_arkeo_keeper_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ContractSettlement_messageType fastReflection_ContractSettlement_messageType
+var _ protoreflect.MessageType = fastReflection_ContractSettlement_messageType{}
+
+type fastReflection_ContractSettlement_messageType struct{}
+
+func (x fastReflection_ContractSettlement_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ContractSettlement)(nil)
+}
+func (x fastReflection_ContractSettlement_messageType) New() protoreflect.Message {
+	return new(fastReflection_ContractSettlement)
+}
+func (x fastReflection_ContractSettlement_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ContractSettlement
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ContractSettlement) Descriptor() protoreflect.MessageDescriptor {
+	return md_ContractSettlement
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ContractSettlement) Type() protoreflect.MessageType {
+	return _fastReflection_ContractSettlement_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ContractSettlement) New() protoreflect.Message {
+	return new(fastReflection_ContractSettlement)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ContractSettlement) Interface() protoreflect.ProtoMessage {
+	return (*ContractSettlement)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ContractSettlement) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ContractId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ContractId)
+		if !f(fd_ContractSettlement_contract_id, value) {
+			return
+		}
+	}
+	if x.Nonce != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Nonce)
+		if !f(fd_ContractSettlement_nonce, value) {
+			return
+		}
+	}
+	if x.Final != false {
+		value := protoreflect.ValueOfBool(x.Final)
+		if !f(fd_ContractSettlement_final, value) {
+			return
+		}
+	}
+	if x.Owed != nil {
+		value := protoreflect.ValueOfMessage(x.Owed.ProtoReflect())
+		if !f(fd_ContractSettlement_owed, value) {
+			return
+		}
+	}
+	if x.ProviderIncome != nil {
+		value := protoreflect.ValueOfMessage(x.ProviderIncome.ProtoReflect())
+		if !f(fd_ContractSettlement_provider_income, value) {
+			return
+		}
+	}
+	if x.ReserveTax != nil {
+		value := protoreflect.ValueOfMessage(x.ReserveTax.ProtoReflect())
+		if !f(fd_ContractSettlement_reserve_tax, value) {
+			return
+		}
+	}
+	if x.Refund != nil {
+		value := protoreflect.ValueOfMessage(x.Refund.ProtoReflect())
+		if !f(fd_ContractSettlement_refund, value) {
+			return
+		}
+	}
+	if x.Unpaid != nil {
+		value := protoreflect.ValueOfMessage(x.Unpaid.ProtoReflect())
+		if !f(fd_ContractSettlement_unpaid, value) {
+			return
+		}
+	}
+	if x.Dust != nil {
+		value := protoreflect.ValueOfMessage(x.Dust.ProtoReflect())
+		if !f(fd_ContractSettlement_dust, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ContractSettlement) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "arkeo.arkeo.ContractSettlement.contract_id":
+		return x.ContractId != uint64(0)
+	case "arkeo.arkeo.ContractSettlement.nonce":
+		return x.Nonce != int64(0)
+	case "arkeo.arkeo.ContractSettlement.final":
+		return x.Final != false
+	case "arkeo.arkeo.ContractSettlement.owed":
+		return x.Owed != nil
+	case "arkeo.arkeo.ContractSettlement.provider_income":
+		return x.ProviderIncome != nil
+	case "arkeo.arkeo.ContractSettlement.reserve_tax":
+		return x.ReserveTax != nil
+	case "arkeo.arkeo.ContractSettlement.refund":
+		return x.Refund != nil
+	case "arkeo.arkeo.ContractSettlement.unpaid":
+		return x.Unpaid != nil
+	case "arkeo.arkeo.ContractSettlement.dust":
+		return x.Dust != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.ContractSettlement"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.ContractSettlement does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ContractSettlement) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "arkeo.arkeo.ContractSettlement.contract_id":
+		x.ContractId = uint64(0)
+	case "arkeo.arkeo.ContractSettlement.nonce":
+		x.Nonce = int64(0)
+	case "arkeo.arkeo.ContractSettlement.final":
+		x.Final = false
+	case "arkeo.arkeo.ContractSettlement.owed":
+		x.Owed = nil
+	case "arkeo.arkeo.ContractSettlement.provider_income":
+		x.ProviderIncome = nil
+	case "arkeo.arkeo.ContractSettlement.reserve_tax":
+		x.ReserveTax = nil
+	case "arkeo.arkeo.ContractSettlement.refund":
+		x.Refund = nil
+	case "arkeo.arkeo.ContractSettlement.unpaid":
+		x.Unpaid = nil
+	case "arkeo.arkeo.ContractSettlement.dust":
+		x.Dust = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.ContractSettlement"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.ContractSettlement does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ContractSettlement) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "arkeo.arkeo.ContractSettlement.contract_id":
+		value := x.ContractId
+		return protoreflect.ValueOfUint64(value)
+	case "arkeo.arkeo.ContractSettlement.nonce":
+		value := x.Nonce
+		return protoreflect.ValueOfInt64(value)
+	case "arkeo.arkeo.ContractSettlement.final":
+		value := x.Final
+		return protoreflect.ValueOfBool(value)
+	case "arkeo.arkeo.ContractSettlement.owed":
+		value := x.Owed
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "arkeo.arkeo.ContractSettlement.provider_income":
+		value := x.ProviderIncome
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "arkeo.arkeo.ContractSettlement.reserve_tax":
+		value := x.ReserveTax
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "arkeo.arkeo.ContractSettlement.refund":
+		value := x.Refund
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "arkeo.arkeo.ContractSettlement.unpaid":
+		value := x.Unpaid
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "arkeo.arkeo.ContractSettlement.dust":
+		value := x.Dust
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.ContractSettlement"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.ContractSettlement does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ContractSettlement) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "arkeo.arkeo.ContractSettlement.contract_id":
+		x.ContractId = value.Uint()
+	case "arkeo.arkeo.ContractSettlement.nonce":
+		x.Nonce = value.Int()
+	case "arkeo.arkeo.ContractSettlement.final":
+		x.Final = value.Bool()
+	case "arkeo.arkeo.ContractSettlement.owed":
+		x.Owed = value.Message().Interface().(*v1beta1.Coin)
+	case "arkeo.arkeo.ContractSettlement.provider_income":
+		x.ProviderIncome = value.Message().Interface().(*v1beta1.Coin)
+	case "arkeo.arkeo.ContractSettlement.reserve_tax":
+		x.ReserveTax = value.Message().Interface().(*v1beta1.Coin)
+	case "arkeo.arkeo.ContractSettlement.refund":
+		x.Refund = value.Message().Interface().(*v1beta1.Coin)
+	case "arkeo.arkeo.ContractSettlement.unpaid":
+		x.Unpaid = value.Message().Interface().(*v1beta1.Coin)
+	case "arkeo.arkeo.ContractSettlement.dust":
+		x.Dust = value.Message().Interface().(*v1beta1.Coin)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.ContractSettlement"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.ContractSettlement does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ContractSettlement) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "arkeo.arkeo.ContractSettlement.owed":
+		if x.Owed == nil {
+			x.Owed = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.Owed.ProtoReflect())
+	case "arkeo.arkeo.ContractSettlement.provider_income":
+		if x.ProviderIncome == nil {
+			x.ProviderIncome = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.ProviderIncome.ProtoReflect())
+	case "arkeo.arkeo.ContractSettlement.reserve_tax":
+		if x.ReserveTax == nil {
+			x.ReserveTax = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.ReserveTax.ProtoReflect())
+	case "arkeo.arkeo.ContractSettlement.refund":
+		if x.Refund == nil {
+			x.Refund = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.Refund.ProtoReflect())
+	case "arkeo.arkeo.ContractSettlement.unpaid":
+		if x.Unpaid == nil {
+			x.Unpaid = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.Unpaid.ProtoReflect())
+	case "arkeo.arkeo.ContractSettlement.dust":
+		if x.Dust == nil {
+			x.Dust = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.Dust.ProtoReflect())
+	case "arkeo.arkeo.ContractSettlement.contract_id":
+		panic(fmt.Errorf("field contract_id of message arkeo.arkeo.ContractSettlement is not mutable"))
+	case "arkeo.arkeo.ContractSettlement.nonce":
+		panic(fmt.Errorf("field nonce of message arkeo.arkeo.ContractSettlement is not mutable"))
+	case "arkeo.arkeo.ContractSettlement.final":
+		panic(fmt.Errorf("field final of message arkeo.arkeo.ContractSettlement is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.ContractSettlement"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.ContractSettlement does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ContractSettlement) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "arkeo.arkeo.ContractSettlement.contract_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "arkeo.arkeo.ContractSettlement.nonce":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "arkeo.arkeo.ContractSettlement.final":
+		return protoreflect.ValueOfBool(false)
+	case "arkeo.arkeo.ContractSettlement.owed":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "arkeo.arkeo.ContractSettlement.provider_income":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "arkeo.arkeo.ContractSettlement.reserve_tax":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "arkeo.arkeo.ContractSettlement.refund":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "arkeo.arkeo.ContractSettlement.unpaid":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "arkeo.arkeo.ContractSettlement.dust":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.ContractSettlement"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.ContractSettlement does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ContractSettlement) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in arkeo.arkeo.ContractSettlement", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ContractSettlement) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ContractSettlement) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ContractSettlement) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ContractSettlement) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ContractSettlement)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.ContractId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ContractId))
+		}
+		if x.Nonce != 0 {
+			n += 1 + runtime.Sov(uint64(x.Nonce))
+		}
+		if x.Final {
+			n += 2
+		}
+		if x.Owed != nil {
+			l = options.Size(x.Owed)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ProviderIncome != nil {
+			l = options.Size(x.ProviderIncome)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ReserveTax != nil {
+			l = options.Size(x.ReserveTax)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Refund != nil {
+			l = options.Size(x.Refund)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Unpaid != nil {
+			l = options.Size(x.Unpaid)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Dust != nil {
+			l = options.Size(x.Dust)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ContractSettlement)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Dust != nil {
+			encoded, err := options.Marshal(x.Dust)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if x.Unpaid != nil {
+			encoded, err := options.Marshal(x.Unpaid)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if x.Refund != nil {
+			encoded, err := options.Marshal(x.Refund)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if x.ReserveTax != nil {
+			encoded, err := options.Marshal(x.ReserveTax)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.ProviderIncome != nil {
+			encoded, err := options.Marshal(x.ProviderIncome)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.Owed != nil {
+			encoded, err := options.Marshal(x.Owed)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.Final {
+			i--
+			if x.Final {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.Nonce != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Nonce))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.ContractId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ContractId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ContractSettlement)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ContractSettlement: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ContractSettlement: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+				}
+				x.ContractId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ContractId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+				}
+				x.Nonce = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Nonce |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Final", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Final = bool(v != 0)
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Owed", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Owed == nil {
+					x.Owed = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Owed); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProviderIncome", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.ProviderIncome == nil {
+					x.ProviderIncome = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ProviderIncome); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReserveTax", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.ReserveTax == nil {
+					x.ReserveTax = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ReserveTax); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Refund", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Refund == nil {
+					x.Refund = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Refund); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Unpaid", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Unpaid == nil {
+					x.Unpaid = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Unpaid); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Dust", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Dust == nil {
+					x.Dust = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Dust); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_ContractClaimResult             protoreflect.MessageDescriptor
+	fd_ContractClaimResult_contract_id protoreflect.FieldDescriptor
+	fd_ContractClaimResult_nonce       protoreflect.FieldDescriptor
+	fd_ContractClaimResult_success     protoreflect.FieldDescriptor
+	fd_ContractClaimResult_error       protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_arkeo_arkeo_keeper_proto_init()
+	md_ContractClaimResult = File_arkeo_arkeo_keeper_proto.Messages().ByName("ContractClaimResult")
+	fd_ContractClaimResult_contract_id = md_ContractClaimResult.Fields().ByName("contract_id")
+	fd_ContractClaimResult_nonce = md_ContractClaimResult.Fields().ByName("nonce")
+	fd_ContractClaimResult_success = md_ContractClaimResult.Fields().ByName("success")
+	fd_ContractClaimResult_error = md_ContractClaimResult.Fields().ByName("error")
+}
+
+var _ protoreflect.Message = (*fastReflection_ContractClaimResult)(nil)
+
+type fastReflection_ContractClaimResult ContractClaimResult
+
+func (x *ContractClaimResult) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ContractClaimResult)(x)
+}
+
+func (x *ContractClaimResult) slowProtoReflect() protoreflect.Message {
+	mi := &file_arkeo_arkeo_keeper_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ContractClaimResult_messageType fastReflection_ContractClaimResult_messageType
+var _ protoreflect.MessageType = fastReflection_ContractClaimResult_messageType{}
+
+type fastReflection_ContractClaimResult_messageType struct{}
+
+func (x fastReflection_ContractClaimResult_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ContractClaimResult)(nil)
+}
+func (x fastReflection_ContractClaimResult_messageType) New() protoreflect.Message {
+	return new(fastReflection_ContractClaimResult)
+}
+func (x fastReflection_ContractClaimResult_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ContractClaimResult
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ContractClaimResult) Descriptor() protoreflect.MessageDescriptor {
+	return md_ContractClaimResult
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ContractClaimResult) Type() protoreflect.MessageType {
+	return _fastReflection_ContractClaimResult_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ContractClaimResult) New() protoreflect.Message {
+	return new(fastReflection_ContractClaimResult)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ContractClaimResult) Interface() protoreflect.ProtoMessage {
+	return (*ContractClaimResult)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ContractClaimResult) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ContractId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ContractId)
+		if !f(fd_ContractClaimResult_contract_id, value) {
+			return
+		}
+	}
+	if x.Nonce != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Nonce)
+		if !f(fd_ContractClaimResult_nonce, value) {
+			return
+		}
+	}
+	if x.Success != false {
+		value := protoreflect.ValueOfBool(x.Success)
+		if !f(fd_ContractClaimResult_success, value) {
+			return
+		}
+	}
+	if x.Error != "" {
+		value := protoreflect.ValueOfString(x.Error)
+		if !f(fd_ContractClaimResult_error, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ContractClaimResult) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "arkeo.arkeo.ContractClaimResult.contract_id":
+		return x.ContractId != uint64(0)
+	case "arkeo.arkeo.ContractClaimResult.nonce":
+		return x.Nonce != int64(0)
+	case "arkeo.arkeo.ContractClaimResult.success":
+		return x.Success != false
+	case "arkeo.arkeo.ContractClaimResult.error":
+		return x.Error != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.ContractClaimResult"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.ContractClaimResult does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ContractClaimResult) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "arkeo.arkeo.ContractClaimResult.contract_id":
+		x.ContractId = uint64(0)
+	case "arkeo.arkeo.ContractClaimResult.nonce":
+		x.Nonce = int64(0)
+	case "arkeo.arkeo.ContractClaimResult.success":
+		x.Success = false
+	case "arkeo.arkeo.ContractClaimResult.error":
+		x.Error = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.ContractClaimResult"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.ContractClaimResult does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ContractClaimResult) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "arkeo.arkeo.ContractClaimResult.contract_id":
+		value := x.ContractId
+		return protoreflect.ValueOfUint64(value)
+	case "arkeo.arkeo.ContractClaimResult.nonce":
+		value := x.Nonce
+		return protoreflect.ValueOfInt64(value)
+	case "arkeo.arkeo.ContractClaimResult.success":
+		value := x.Success
+		return protoreflect.ValueOfBool(value)
+	case "arkeo.arkeo.ContractClaimResult.error":
+		value := x.Error
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.ContractClaimResult"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.ContractClaimResult does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ContractClaimResult) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "arkeo.arkeo.ContractClaimResult.contract_id":
+		x.ContractId = value.Uint()
+	case "arkeo.arkeo.ContractClaimResult.nonce":
+		x.Nonce = value.Int()
+	case "arkeo.arkeo.ContractClaimResult.success":
+		x.Success = value.Bool()
+	case "arkeo.arkeo.ContractClaimResult.error":
+		x.Error = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.ContractClaimResult"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.ContractClaimResult does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ContractClaimResult) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "arkeo.arkeo.ContractClaimResult.contract_id":
+		panic(fmt.Errorf("field contract_id of message arkeo.arkeo.ContractClaimResult is not mutable"))
+	case "arkeo.arkeo.ContractClaimResult.nonce":
+		panic(fmt.Errorf("field nonce of message arkeo.arkeo.ContractClaimResult is not mutable"))
+	case "arkeo.arkeo.ContractClaimResult.success":
+		panic(fmt.Errorf("field success of message arkeo.arkeo.ContractClaimResult is not mutable"))
+	case "arkeo.arkeo.ContractClaimResult.error":
+		panic(fmt.Errorf("field error of message arkeo.arkeo.ContractClaimResult is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.ContractClaimResult"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.ContractClaimResult does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ContractClaimResult) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "arkeo.arkeo.ContractClaimResult.contract_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "arkeo.arkeo.ContractClaimResult.nonce":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "arkeo.arkeo.ContractClaimResult.success":
+		return protoreflect.ValueOfBool(false)
+	case "arkeo.arkeo.ContractClaimResult.error":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.ContractClaimResult"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.ContractClaimResult does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ContractClaimResult) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in arkeo.arkeo.ContractClaimResult", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ContractClaimResult) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ContractClaimResult) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ContractClaimResult) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ContractClaimResult) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ContractClaimResult)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.ContractId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ContractId))
+		}
+		if x.Nonce != 0 {
+			n += 1 + runtime.Sov(uint64(x.Nonce))
+		}
+		if x.Success {
+			n += 2
+		}
+		l = len(x.Error)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ContractClaimResult)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Error) > 0 {
+			i -= len(x.Error)
+			copy(dAtA[i:], x.Error)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Error)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.Success {
+			i--
+			if x.Success {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.Nonce != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Nonce))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.ContractId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ContractId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ContractClaimResult)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ContractClaimResult: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ContractClaimResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+				}
+				x.ContractId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ContractId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+				}
+				x.Nonce = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Nonce |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Success = bool(v != 0)
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Error = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_ContractPurgeResult             protoreflect.MessageDescriptor
+	fd_ContractPurgeResult_contract_id protoreflect.FieldDescriptor
+	fd_ContractPurgeResult_success     protoreflect.FieldDescriptor
+	fd_ContractPurgeResult_error       protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_arkeo_arkeo_keeper_proto_init()
+	md_ContractPurgeResult = File_arkeo_arkeo_keeper_proto.Messages().ByName("ContractPurgeResult")
+	fd_ContractPurgeResult_contract_id = md_ContractPurgeResult.Fields().ByName("contract_id")
+	fd_ContractPurgeResult_success = md_ContractPurgeResult.Fields().ByName("success")
+	fd_ContractPurgeResult_error = md_ContractPurgeResult.Fields().ByName("error")
+}
+
+var _ protoreflect.Message = (*fastReflection_ContractPurgeResult)(nil)
+
+type fastReflection_ContractPurgeResult ContractPurgeResult
+
+func (x *ContractPurgeResult) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ContractPurgeResult)(x)
+}
+
+func (x *ContractPurgeResult) slowProtoReflect() protoreflect.Message {
+	mi := &file_arkeo_arkeo_keeper_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ContractPurgeResult_messageType fastReflection_ContractPurgeResult_messageType
+var _ protoreflect.MessageType = fastReflection_ContractPurgeResult_messageType{}
+
+type fastReflection_ContractPurgeResult_messageType struct{}
+
+func (x fastReflection_ContractPurgeResult_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ContractPurgeResult)(nil)
+}
+func (x fastReflection_ContractPurgeResult_messageType) New() protoreflect.Message {
+	return new(fastReflection_ContractPurgeResult)
+}
+func (x fastReflection_ContractPurgeResult_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ContractPurgeResult
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ContractPurgeResult) Descriptor() protoreflect.MessageDescriptor {
+	return md_ContractPurgeResult
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ContractPurgeResult) Type() protoreflect.MessageType {
+	return _fastReflection_ContractPurgeResult_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ContractPurgeResult) New() protoreflect.Message {
+	return new(fastReflection_ContractPurgeResult)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ContractPurgeResult) Interface() protoreflect.ProtoMessage {
+	return (*ContractPurgeResult)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ContractPurgeResult) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ContractId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ContractId)
+		if !f(fd_ContractPurgeResult_contract_id, value) {
+			return
+		}
+	}
+	if x.Success != false {
+		value := protoreflect.ValueOfBool(x.Success)
+		if !f(fd_ContractPurgeResult_success, value) {
+			return
+		}
+	}
+	if x.Error != "" {
+		value := protoreflect.ValueOfString(x.Error)
+		if !f(fd_ContractPurgeResult_error, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ContractPurgeResult) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "arkeo.arkeo.ContractPurgeResult.contract_id":
+		return x.ContractId != uint64(0)
+	case "arkeo.arkeo.ContractPurgeResult.success":
+		return x.Success != false
+	case "arkeo.arkeo.ContractPurgeResult.error":
+		return x.Error != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.ContractPurgeResult"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.ContractPurgeResult does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ContractPurgeResult) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "arkeo.arkeo.ContractPurgeResult.contract_id":
+		x.ContractId = uint64(0)
+	case "arkeo.arkeo.ContractPurgeResult.success":
+		x.Success = false
+	case "arkeo.arkeo.ContractPurgeResult.error":
+		x.Error = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.ContractPurgeResult"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.ContractPurgeResult does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ContractPurgeResult) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "arkeo.arkeo.ContractPurgeResult.contract_id":
+		value := x.ContractId
+		return protoreflect.ValueOfUint64(value)
+	case "arkeo.arkeo.ContractPurgeResult.success":
+		value := x.Success
+		return protoreflect.ValueOfBool(value)
+	case "arkeo.arkeo.ContractPurgeResult.error":
+		value := x.Error
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.ContractPurgeResult"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.ContractPurgeResult does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ContractPurgeResult) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "arkeo.arkeo.ContractPurgeResult.contract_id":
+		x.ContractId = value.Uint()
+	case "arkeo.arkeo.ContractPurgeResult.success":
+		x.Success = value.Bool()
+	case "arkeo.arkeo.ContractPurgeResult.error":
+		x.Error = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.ContractPurgeResult"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.ContractPurgeResult does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ContractPurgeResult) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "arkeo.arkeo.ContractPurgeResult.contract_id":
+		panic(fmt.Errorf("field contract_id of message arkeo.arkeo.ContractPurgeResult is not mutable"))
+	case "arkeo.arkeo.ContractPurgeResult.success":
+		panic(fmt.Errorf("field success of message arkeo.arkeo.ContractPurgeResult is not mutable"))
+	case "arkeo.arkeo.ContractPurgeResult.error":
+		panic(fmt.Errorf("field error of message arkeo.arkeo.ContractPurgeResult is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.ContractPurgeResult"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.ContractPurgeResult does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ContractPurgeResult) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "arkeo.arkeo.ContractPurgeResult.contract_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "arkeo.arkeo.ContractPurgeResult.success":
+		return protoreflect.ValueOfBool(false)
+	case "arkeo.arkeo.ContractPurgeResult.error":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.ContractPurgeResult"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.ContractPurgeResult does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ContractPurgeResult) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in arkeo.arkeo.ContractPurgeResult", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ContractPurgeResult) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ContractPurgeResult) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ContractPurgeResult) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ContractPurgeResult) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ContractPurgeResult)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.ContractId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ContractId))
+		}
+		if x.Success {
+			n += 2
+		}
+		l = len(x.Error)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ContractPurgeResult)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Error) > 0 {
+			i -= len(x.Error)
+			copy(dAtA[i:], x.Error)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Error)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.Success {
+			i--
+			if x.Success {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.ContractId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ContractId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ContractPurgeResult)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ContractPurgeResult: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ContractPurgeResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+				}
+				x.ContractId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ContractId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Success = bool(v != 0)
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Error = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_ProviderAllowlist_3_list)(nil)
+
+type _ProviderAllowlist_3_list struct {
+	list *[][]byte
+}
+
+func (x *_ProviderAllowlist_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_ProviderAllowlist_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfBytes((*x.list)[i])
+}
+
+func (x *_ProviderAllowlist_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Bytes()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_ProviderAllowlist_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Bytes()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_ProviderAllowlist_3_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message ProviderAllowlist at list field Clients as it is not of Message kind"))
+}
+
+func (x *_ProviderAllowlist_3_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_ProviderAllowlist_3_list) NewElement() protoreflect.Value {
+	var v []byte
+	return protoreflect.ValueOfBytes(v)
+}
+
+func (x *_ProviderAllowlist_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_ProviderAllowlist          protoreflect.MessageDescriptor
+	fd_ProviderAllowlist_provider protoreflect.FieldDescriptor
+	fd_ProviderAllowlist_service  protoreflect.FieldDescriptor
+	fd_ProviderAllowlist_clients  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_arkeo_arkeo_keeper_proto_init()
+	md_ProviderAllowlist = File_arkeo_arkeo_keeper_proto.Messages().ByName("ProviderAllowlist")
+	fd_ProviderAllowlist_provider = md_ProviderAllowlist.Fields().ByName("provider")
+	fd_ProviderAllowlist_service = md_ProviderAllowlist.Fields().ByName("service")
+	fd_ProviderAllowlist_clients = md_ProviderAllowlist.Fields().ByName("clients")
+}
+
+var _ protoreflect.Message = (*fastReflection_ProviderAllowlist)(nil)
+
+type fastReflection_ProviderAllowlist ProviderAllowlist
+
+func (x *ProviderAllowlist) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ProviderAllowlist)(x)
+}
+
+func (x *ProviderAllowlist) slowProtoReflect() protoreflect.Message {
+	mi := &file_arkeo_arkeo_keeper_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ProviderAllowlist_messageType fastReflection_ProviderAllowlist_messageType
+var _ protoreflect.MessageType = fastReflection_ProviderAllowlist_messageType{}
+
+type fastReflection_ProviderAllowlist_messageType struct{}
+
+func (x fastReflection_ProviderAllowlist_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ProviderAllowlist)(nil)
+}
+func (x fastReflection_ProviderAllowlist_messageType) New() protoreflect.Message {
+	return new(fastReflection_ProviderAllowlist)
+}
+func (x fastReflection_ProviderAllowlist_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ProviderAllowlist
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ProviderAllowlist) Descriptor() protoreflect.MessageDescriptor {
+	return md_ProviderAllowlist
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ProviderAllowlist) Type() protoreflect.MessageType {
+	return _fastReflection_ProviderAllowlist_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ProviderAllowlist) New() protoreflect.Message {
+	return new(fastReflection_ProviderAllowlist)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ProviderAllowlist) Interface() protoreflect.ProtoMessage {
+	return (*ProviderAllowlist)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ProviderAllowlist) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Provider) != 0 {
+		value := protoreflect.ValueOfBytes(x.Provider)
+		if !f(fd_ProviderAllowlist_provider, value) {
+			return
+		}
+	}
+	if x.Service != int32(0) {
+		value := protoreflect.ValueOfInt32(x.Service)
+		if !f(fd_ProviderAllowlist_service, value) {
+			return
+		}
+	}
+	if len(x.Clients) != 0 {
+		value := protoreflect.ValueOfList(&_ProviderAllowlist_3_list{list: &x.Clients})
+		if !f(fd_ProviderAllowlist_clients, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ProviderAllowlist) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "arkeo.arkeo.ProviderAllowlist.provider":
+		return len(x.Provider) != 0
+	case "arkeo.arkeo.ProviderAllowlist.service":
+		return x.Service != int32(0)
+	case "arkeo.arkeo.ProviderAllowlist.clients":
+		return len(x.Clients) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.ProviderAllowlist"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.ProviderAllowlist does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ProviderAllowlist) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "arkeo.arkeo.ProviderAllowlist.provider":
+		x.Provider = nil
+	case "arkeo.arkeo.ProviderAllowlist.service":
+		x.Service = int32(0)
+	case "arkeo.arkeo.ProviderAllowlist.clients":
+		x.Clients = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.ProviderAllowlist"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.ProviderAllowlist does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ProviderAllowlist) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "arkeo.arkeo.ProviderAllowlist.provider":
+		value := x.Provider
+		return protoreflect.ValueOfBytes(value)
+	case "arkeo.arkeo.ProviderAllowlist.service":
+		value := x.Service
+		return protoreflect.ValueOfInt32(value)
+	case "arkeo.arkeo.ProviderAllowlist.clients":
+		if len(x.Clients) == 0 {
+			return protoreflect.ValueOfList(&_ProviderAllowlist_3_list{})
+		}
+		listValue := &_ProviderAllowlist_3_list{list: &x.Clients}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.ProviderAllowlist"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.ProviderAllowlist does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ProviderAllowlist) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "arkeo.arkeo.ProviderAllowlist.provider":
+		x.Provider = value.Bytes()
+	case "arkeo.arkeo.ProviderAllowlist.service":
+		x.Service = int32(value.Int())
+	case "arkeo.arkeo.ProviderAllowlist.clients":
+		lv := value.List()
+		clv := lv.(*_ProviderAllowlist_3_list)
+		x.Clients = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.ProviderAllowlist"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.ProviderAllowlist does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ProviderAllowlist) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "arkeo.arkeo.ProviderAllowlist.clients":
+		if x.Clients == nil {
+			x.Clients = [][]byte{}
+		}
+		value := &_ProviderAllowlist_3_list{list: &x.Clients}
+		return protoreflect.ValueOfList(value)
+	case "arkeo.arkeo.ProviderAllowlist.provider":
+		panic(fmt.Errorf("field provider of message arkeo.arkeo.ProviderAllowlist is not mutable"))
+	case "arkeo.arkeo.ProviderAllowlist.service":
+		panic(fmt.Errorf("field service of message arkeo.arkeo.ProviderAllowlist is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.ProviderAllowlist"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.ProviderAllowlist does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ProviderAllowlist) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "arkeo.arkeo.ProviderAllowlist.provider":
+		return protoreflect.ValueOfBytes(nil)
+	case "arkeo.arkeo.ProviderAllowlist.service":
+		return protoreflect.ValueOfInt32(int32(0))
+	case "arkeo.arkeo.ProviderAllowlist.clients":
+		list := [][]byte{}
+		return protoreflect.ValueOfList(&_ProviderAllowlist_3_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.ProviderAllowlist"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.ProviderAllowlist does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ProviderAllowlist) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in arkeo.arkeo.ProviderAllowlist", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ProviderAllowlist) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ProviderAllowlist) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ProviderAllowlist) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ProviderAllowlist) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ProviderAllowlist)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Provider)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Service != 0 {
+			n += 1 + runtime.Sov(uint64(x.Service))
+		}
+		if len(x.Clients) > 0 {
+			for _, b := range x.Clients {
+				l = len(b)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ProviderAllowlist)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Clients) > 0 {
+			for iNdEx := len(x.Clients) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Clients[iNdEx])
+				copy(dAtA[i:], x.Clients[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Clients[iNdEx])))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if x.Service != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Service))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Provider) > 0 {
+			i -= len(x.Provider)
+			copy(dAtA[i:], x.Provider)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Provider)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ProviderAllowlist)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ProviderAllowlist: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ProviderAllowlist: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Provider = append(x.Provider[:0], dAtA[iNdEx:postIndex]...)
+				if x.Provider == nil {
+					x.Provider = []byte{}
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+				}
+				x.Service = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Service |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Clients", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Clients = append(x.Clients, make([]byte, postIndex-iNdEx))
+				copy(x.Clients[len(x.Clients)-1], dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_NetworkStats_3_list)(nil)
+
+type _NetworkStats_3_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_NetworkStats_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_NetworkStats_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_NetworkStats_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_NetworkStats_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_NetworkStats_3_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_NetworkStats_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_NetworkStats_3_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_NetworkStats_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_NetworkStats_5_list)(nil)
+
+type _NetworkStats_5_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_NetworkStats_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_NetworkStats_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_NetworkStats_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_NetworkStats_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_NetworkStats_5_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_NetworkStats_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_NetworkStats_5_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_NetworkStats_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_NetworkStats                  protoreflect.MessageDescriptor
+	fd_NetworkStats_providers        protoreflect.FieldDescriptor
+	fd_NetworkStats_providers_online protoreflect.FieldDescriptor
+	fd_NetworkStats_bonded           protoreflect.FieldDescriptor
+	fd_NetworkStats_open_contracts   protoreflect.FieldDescriptor
+	fd_NetworkStats_escrowed         protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_arkeo_arkeo_keeper_proto_init()
+	md_NetworkStats = File_arkeo_arkeo_keeper_proto.Messages().ByName("NetworkStats")
+	fd_NetworkStats_providers = md_NetworkStats.Fields().ByName("providers")
+	fd_NetworkStats_providers_online = md_NetworkStats.Fields().ByName("providers_online")
+	fd_NetworkStats_bonded = md_NetworkStats.Fields().ByName("bonded")
+	fd_NetworkStats_open_contracts = md_NetworkStats.Fields().ByName("open_contracts")
+	fd_NetworkStats_escrowed = md_NetworkStats.Fields().ByName("escrowed")
+}
+
+var _ protoreflect.Message = (*fastReflection_NetworkStats)(nil)
+
+type fastReflection_NetworkStats NetworkStats
+
+func (x *NetworkStats) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_NetworkStats)(x)
+}
+
+func (x *NetworkStats) slowProtoReflect() protoreflect.Message {
+	mi := &file_arkeo_arkeo_keeper_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_NetworkStats_messageType fastReflection_NetworkStats_messageType
+var _ protoreflect.MessageType = fastReflection_NetworkStats_messageType{}
+
+type fastReflection_NetworkStats_messageType struct{}
+
+func (x fastReflection_NetworkStats_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_NetworkStats)(nil)
+}
+func (x fastReflection_NetworkStats_messageType) New() protoreflect.Message {
+	return new(fastReflection_NetworkStats)
+}
+func (x fastReflection_NetworkStats_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_NetworkStats
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_NetworkStats) Descriptor() protoreflect.MessageDescriptor {
+	return md_NetworkStats
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_NetworkStats) Type() protoreflect.MessageType {
+	return _fastReflection_NetworkStats_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_NetworkStats) New() protoreflect.Message {
+	return new(fastReflection_NetworkStats)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_NetworkStats) Interface() protoreflect.ProtoMessage {
+	return (*NetworkStats)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_NetworkStats) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Providers != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Providers)
+		if !f(fd_NetworkStats_providers, value) {
+			return
+		}
+	}
+	if x.ProvidersOnline != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ProvidersOnline)
+		if !f(fd_NetworkStats_providers_online, value) {
+			return
+		}
+	}
+	if len(x.Bonded) != 0 {
+		value := protoreflect.ValueOfList(&_NetworkStats_3_list{list: &x.Bonded})
+		if !f(fd_NetworkStats_bonded, value) {
+			return
+		}
+	}
+	if x.OpenContracts != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.OpenContracts)
+		if !f(fd_NetworkStats_open_contracts, value) {
+			return
+		}
+	}
+	if len(x.Escrowed) != 0 {
+		value := protoreflect.ValueOfList(&_NetworkStats_5_list{list: &x.Escrowed})
+		if !f(fd_NetworkStats_escrowed, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_NetworkStats) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "arkeo.arkeo.NetworkStats.providers":
+		return x.Providers != uint64(0)
+	case "arkeo.arkeo.NetworkStats.providers_online":
+		return x.ProvidersOnline != uint64(0)
+	case "arkeo.arkeo.NetworkStats.bonded":
+		return len(x.Bonded) != 0
+	case "arkeo.arkeo.NetworkStats.open_contracts":
+		return x.OpenContracts != uint64(0)
+	case "arkeo.arkeo.NetworkStats.escrowed":
+		return len(x.Escrowed) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.NetworkStats"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.NetworkStats does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_NetworkStats) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "arkeo.arkeo.NetworkStats.providers":
+		x.Providers = uint64(0)
+	case "arkeo.arkeo.NetworkStats.providers_online":
+		x.ProvidersOnline = uint64(0)
+	case "arkeo.arkeo.NetworkStats.bonded":
+		x.Bonded = nil
+	case "arkeo.arkeo.NetworkStats.open_contracts":
+		x.OpenContracts = uint64(0)
+	case "arkeo.arkeo.NetworkStats.escrowed":
+		x.Escrowed = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.NetworkStats"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.NetworkStats does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_NetworkStats) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "arkeo.arkeo.NetworkStats.providers":
+		value := x.Providers
+		return protoreflect.ValueOfUint64(value)
+	case "arkeo.arkeo.NetworkStats.providers_online":
+		value := x.ProvidersOnline
+		return protoreflect.ValueOfUint64(value)
+	case "arkeo.arkeo.NetworkStats.bonded":
+		if len(x.Bonded) == 0 {
+			return protoreflect.ValueOfList(&_NetworkStats_3_list{})
+		}
+		listValue := &_NetworkStats_3_list{list: &x.Bonded}
+		return protoreflect.ValueOfList(listValue)
+	case "arkeo.arkeo.NetworkStats.open_contracts":
+		value := x.OpenContracts
+		return protoreflect.ValueOfUint64(value)
+	case "arkeo.arkeo.NetworkStats.escrowed":
+		if len(x.Escrowed) == 0 {
+			return protoreflect.ValueOfList(&_NetworkStats_5_list{})
+		}
+		listValue := &_NetworkStats_5_list{list: &x.Escrowed}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.NetworkStats"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.NetworkStats does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_NetworkStats) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "arkeo.arkeo.NetworkStats.providers":
+		x.Providers = value.Uint()
+	case "arkeo.arkeo.NetworkStats.providers_online":
+		x.ProvidersOnline = value.Uint()
+	case "arkeo.arkeo.NetworkStats.bonded":
+		lv := value.List()
+		clv := lv.(*_NetworkStats_3_list)
+		x.Bonded = *clv.list
+	case "arkeo.arkeo.NetworkStats.open_contracts":
+		x.OpenContracts = value.Uint()
+	case "arkeo.arkeo.NetworkStats.escrowed":
+		lv := value.List()
+		clv := lv.(*_NetworkStats_5_list)
+		x.Escrowed = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.NetworkStats"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.NetworkStats does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_NetworkStats) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "arkeo.arkeo.NetworkStats.bonded":
+		if x.Bonded == nil {
+			x.Bonded = []*v1beta1.Coin{}
+		}
+		value := &_NetworkStats_3_list{list: &x.Bonded}
+		return protoreflect.ValueOfList(value)
+	case "arkeo.arkeo.NetworkStats.escrowed":
+		if x.Escrowed == nil {
+			x.Escrowed = []*v1beta1.Coin{}
+		}
+		value := &_NetworkStats_5_list{list: &x.Escrowed}
+		return protoreflect.ValueOfList(value)
+	case "arkeo.arkeo.NetworkStats.providers":
+		panic(fmt.Errorf("field providers of message arkeo.arkeo.NetworkStats is not mutable"))
+	case "arkeo.arkeo.NetworkStats.providers_online":
+		panic(fmt.Errorf("field providers_online of message arkeo.arkeo.NetworkStats is not mutable"))
+	case "arkeo.arkeo.NetworkStats.open_contracts":
+		panic(fmt.Errorf("field open_contracts of message arkeo.arkeo.NetworkStats is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.NetworkStats"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.NetworkStats does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_NetworkStats) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "arkeo.arkeo.NetworkStats.providers":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "arkeo.arkeo.NetworkStats.providers_online":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "arkeo.arkeo.NetworkStats.bonded":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_NetworkStats_3_list{list: &list})
+	case "arkeo.arkeo.NetworkStats.open_contracts":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "arkeo.arkeo.NetworkStats.escrowed":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_NetworkStats_5_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.NetworkStats"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.NetworkStats does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_NetworkStats) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in arkeo.arkeo.NetworkStats", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_NetworkStats) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_NetworkStats) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_NetworkStats) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_NetworkStats) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*NetworkStats)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Providers != 0 {
+			n += 1 + runtime.Sov(uint64(x.Providers))
+		}
+		if x.ProvidersOnline != 0 {
+			n += 1 + runtime.Sov(uint64(x.ProvidersOnline))
+		}
+		if len(x.Bonded) > 0 {
+			for _, e := range x.Bonded {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.OpenContracts != 0 {
+			n += 1 + runtime.Sov(uint64(x.OpenContracts))
+		}
+		if len(x.Escrowed) > 0 {
+			for _, e := range x.Escrowed {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*NetworkStats)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Escrowed) > 0 {
+			for iNdEx := len(x.Escrowed) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Escrowed[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if x.OpenContracts != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OpenContracts))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.Bonded) > 0 {
+			for iNdEx := len(x.Bonded) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Bonded[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if x.ProvidersOnline != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ProvidersOnline))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Providers != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Providers))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*NetworkStats)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: NetworkStats: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: NetworkStats: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Providers", wireType)
+				}
+				x.Providers = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Providers |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProvidersOnline", wireType)
+				}
+				x.ProvidersOnline = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ProvidersOnline |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Bonded", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Bonded = append(x.Bonded, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Bonded[len(x.Bonded)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OpenContracts", wireType)
+				}
+				x.OpenContracts = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.OpenContracts |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Escrowed", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Escrowed = append(x.Escrowed, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Escrowed[len(x.Escrowed)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: arkeo/arkeo/keeper.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ProviderStatus int32
+
+const (
+	ProviderStatus_OFFLINE ProviderStatus = 0
+	ProviderStatus_ONLINE  ProviderStatus = 1
+)
+
+// Enum value maps for ProviderStatus.
+var (
+	ProviderStatus_name = map[int32]string{
+		0: "OFFLINE",
+		1: "ONLINE",
+	}
+	ProviderStatus_value = map[string]int32{
+		"OFFLINE": 0,
+		"ONLINE":  1,
+	}
+)
+
+func (x ProviderStatus) Enum() *ProviderStatus {
+	p := new(ProviderStatus)
+	*p = x
+	return p
+}
+
+func (x ProviderStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ProviderStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_arkeo_arkeo_keeper_proto_enumTypes[0].Descriptor()
+}
+
+func (ProviderStatus) Type() protoreflect.EnumType {
+	return &file_arkeo_arkeo_keeper_proto_enumTypes[0]
+}
+
+func (x ProviderStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ProviderStatus.Descriptor instead.
+func (ProviderStatus) EnumDescriptor() ([]byte, []int) {
+	return file_arkeo_arkeo_keeper_proto_rawDescGZIP(), []int{0}
+}
+
+type ContractType int32
+
+const (
+	ContractType_SUBSCRIPTION  ContractType = 0
+	ContractType_PAY_AS_YOU_GO ContractType = 1
+)
+
+// Enum value maps for ContractType.
+var (
+	ContractType_name = map[int32]string{
+		0: "SUBSCRIPTION",
+		1: "PAY_AS_YOU_GO",
+	}
+	ContractType_value = map[string]int32{
+		"SUBSCRIPTION":  0,
+		"PAY_AS_YOU_GO": 1,
+	}
+)
+
+func (x ContractType) Enum() *ContractType {
+	p := new(ContractType)
+	*p = x
+	return p
+}
+
+func (x ContractType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ContractType) Descriptor() protoreflect.EnumDescriptor {
+	return file_arkeo_arkeo_keeper_proto_enumTypes[1].Descriptor()
+}
+
+func (ContractType) Type() protoreflect.EnumType {
+	return &file_arkeo_arkeo_keeper_proto_enumTypes[1]
+}
+
+func (x ContractType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ContractType.Descriptor instead.
+func (ContractType) EnumDescriptor() ([]byte, []int) {
+	return file_arkeo_arkeo_keeper_proto_rawDescGZIP(), []int{1}
+}
+
+type ContractAuthorization int32
+
+const (
+	ContractAuthorization_STRICT ContractAuthorization = 0
+	ContractAuthorization_OPEN   ContractAuthorization = 1
+)
+
+// Enum value maps for ContractAuthorization.
+var (
+	ContractAuthorization_name = map[int32]string{
+		0: "STRICT",
+		1: "OPEN",
+	}
+	ContractAuthorization_value = map[string]int32{
+		"STRICT": 0,
+		"OPEN":   1,
+	}
+)
+
+func (x ContractAuthorization) Enum() *ContractAuthorization {
+	p := new(ContractAuthorization)
+	*p = x
+	return p
+}
+
+func (x ContractAuthorization) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ContractAuthorization) Descriptor() protoreflect.EnumDescriptor {
+	return file_arkeo_arkeo_keeper_proto_enumTypes[2].Descriptor()
+}
+
+func (ContractAuthorization) Type() protoreflect.EnumType {
+	return &file_arkeo_arkeo_keeper_proto_enumTypes[2]
+}
+
+func (x ContractAuthorization) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ContractAuthorization.Descriptor instead.
+func (ContractAuthorization) EnumDescriptor() ([]byte, []int) {
+	return file_arkeo_arkeo_keeper_proto_rawDescGZIP(), []int{2}
+}
+
+// AllowlistAction how the clients of a MsgSetProviderAllowlist update the
+// allowlist of the provider
+type AllowlistAction int32
+
+const (
+	AllowlistAction_ALLOWLIST_REPLACE AllowlistAction = 0
+	AllowlistAction_ALLOWLIST_ADD     AllowlistAction = 1
+	AllowlistAction_ALLOWLIST_REMOVE  AllowlistAction = 2
+)
+
+// Enum value maps for AllowlistAction.
+var (
+	AllowlistAction_name = map[int32]string{
+		0: "ALLOWLIST_REPLACE",
+		1: "ALLOWLIST_ADD",
+		2: "ALLOWLIST_REMOVE",
+	}
+	AllowlistAction_value = map[string]int32{
+		"ALLOWLIST_REPLACE": 0,
+		"ALLOWLIST_ADD":     1,
+		"ALLOWLIST_REMOVE":  2,
+	}
+)
+
+func (x AllowlistAction) Enum() *AllowlistAction {
+	p := new(AllowlistAction)
+	*p = x
+	return p
+}
+
+func (x AllowlistAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AllowlistAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_arkeo_arkeo_keeper_proto_enumTypes[3].Descriptor()
+}
+
+func (AllowlistAction) Type() protoreflect.EnumType {
+	return &file_arkeo_arkeo_keeper_proto_enumTypes[3]
+}
+
+func (x AllowlistAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AllowlistAction.Descriptor instead.
+func (AllowlistAction) EnumDescriptor() ([]byte, []int) {
+	return file_arkeo_arkeo_keeper_proto_rawDescGZIP(), []int{3}
+}
+
+type Provider struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PubKey              []byte          `protobuf:"bytes,1,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	Service             int32           `protobuf:"varint,2,opt,name=service,proto3" json:"service,omitempty"`
+	MetadataUri         string          `protobuf:"bytes,3,opt,name=metadata_uri,json=metadataUri,proto3" json:"metadata_uri,omitempty"`
+	MetadataNonce       uint64          `protobuf:"varint,4,opt,name=metadata_nonce,json=metadataNonce,proto3" json:"metadata_nonce,omitempty"`
+	Status              ProviderStatus  `protobuf:"varint,5,opt,name=status,proto3,enum=arkeo.arkeo.ProviderStatus" json:"status,omitempty"`
+	MinContractDuration int64           `protobuf:"varint,6,opt,name=min_contract_duration,json=minContractDuration,proto3" json:"min_contract_duration,omitempty"`
+	MaxContractDuration int64           `protobuf:"varint,7,opt,name=max_contract_duration,json=maxContractDuration,proto3" json:"max_contract_duration,omitempty"`
+	SubscriptionRate    []*v1beta1.Coin `protobuf:"bytes,8,rep,name=subscription_rate,json=subscriptionRate,proto3" json:"subscription_rate,omitempty"`
+	PayAsYouGoRate      []*v1beta1.Coin `protobuf:"bytes,9,rep,name=pay_as_you_go_rate,json=payAsYouGoRate,proto3" json:"pay_as_you_go_rate,omitempty"`
+	Bond                string          `protobuf:"bytes,10,opt,name=bond,proto3" json:"bond,omitempty"`
+	LastUpdate          int64           `protobuf:"varint,11,opt,name=last_update,json=lastUpdate,proto3" json:"last_update,omitempty"`
+	SettlementDuration  int64           `protobuf:"varint,12,opt,name=settlement_duration,json=settlementDuration,proto3" json:"settlement_duration,omitempty"`
+	// faults the provider was slashed for
+	Faults int64 `protobuf:"varint,13,opt,name=faults,proto3" json:"faults,omitempty"`
+	// contracts opened with the provider and not settled yet
+	OpenContracts uint64 `protobuf:"varint,14,opt,name=open_contracts,json=openContracts,proto3" json:"open_contracts,omitempty"`
+	// open contracts the provider accepts, zero for the max_open_contracts param
+	MaxOpenContracts uint64 `protobuf:"varint,15,opt,name=max_open_contracts,json=maxOpenContracts,proto3" json:"max_open_contracts,omitempty"`
+}
+
+func (x *Provider) Reset() {
+	*x = Provider{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_arkeo_arkeo_keeper_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Provider) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Provider) ProtoMessage() {}
+
+// Deprecated: Use Provider.ProtoReflect.Descriptor instead.
+func (*Provider) Descriptor() ([]byte, []int) {
+	return file_arkeo_arkeo_keeper_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Provider) GetPubKey() []byte {
+	if x != nil {
+		return x.PubKey
+	}
+	return nil
+}
+
+func (x *Provider) GetService() int32 {
+	if x != nil {
+		return x.Service
+	}
+	return 0
+}
+
+func (x *Provider) GetMetadataUri() string {
+	if x != nil {
+		return x.MetadataUri
+	}
+	return ""
+}
+
+func (x *Provider) GetMetadataNonce() uint64 {
+	if x != nil {
+		return x.MetadataNonce
+	}
+	return 0
+}
+
+func (x *Provider) GetStatus() ProviderStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ProviderStatus_OFFLINE
+}
+
+func (x *Provider) GetMinContractDuration() int64 {
+	if x != nil {
+		return x.MinContractDuration
+	}
+	return 0
+}
+
+func (x *Provider) GetMaxContractDuration() int64 {
+	if x != nil {
+		return x.MaxContractDuration
+	}
+	return 0
+}
+
+func (x *Provider) GetSubscriptionRate() []*v1beta1.Coin {
+	if x != nil {
+		return x.SubscriptionRate
+	}
+	return nil
+}
+
+func (x *Provider) GetPayAsYouGoRate() []*v1beta1.Coin {
+	if x != nil {
+		return x.PayAsYouGoRate
+	}
+	return nil
+}
+
+func (x *Provider) GetBond() string {
+	if x != nil {
+		return x.Bond
+	}
+	return ""
+}
+
+func (x *Provider) GetLastUpdate() int64 {
+	if x != nil {
+		return x.LastUpdate
+	}
+	return 0
+}
+
+func (x *Provider) GetSettlementDuration() int64 {
+	if x != nil {
+		return x.SettlementDuration
+	}
+	return 0
+}
+
+func (x *Provider) GetFaults() int64 {
+	if x != nil {
+		return x.Faults
+	}
+	return 0
+}
+
+func (x *Provider) GetOpenContracts() uint64 {
+	if x != nil {
+		return x.OpenContracts
+	}
+	return 0
+}
+
+func (x *Provider) GetMaxOpenContracts() uint64 {
+	if x != nil {
+		return x.MaxOpenContracts
+	}
+	return 0
+}
+
+type Contract struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Provider           []byte                `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Service            int32                 `protobuf:"varint,2,opt,name=service,proto3" json:"service,omitempty"`
+	Client             []byte                `protobuf:"bytes,3,opt,name=client,proto3" json:"client,omitempty"`
+	Delegate           []byte                `protobuf:"bytes,4,opt,name=delegate,proto3" json:"delegate,omitempty"`
+	Type_              ContractType          `protobuf:"varint,5,opt,name=type,proto3,enum=arkeo.arkeo.ContractType" json:"type,omitempty"`
+	Height             int64                 `protobuf:"varint,6,opt,name=height,proto3" json:"height,omitempty"`
+	Duration           int64                 `protobuf:"varint,7,opt,name=duration,proto3" json:"duration,omitempty"`
+	Rate               *v1beta1.Coin         `protobuf:"bytes,8,opt,name=rate,proto3" json:"rate,omitempty"`
+	Deposit            string                `protobuf:"bytes,9,opt,name=deposit,proto3" json:"deposit,omitempty"`
+	Paid               string                `protobuf:"bytes,10,opt,name=paid,proto3" json:"paid,omitempty"`
+	Nonce              int64                 `protobuf:"varint,11,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	SettlementHeight   int64                 `protobuf:"varint,12,opt,name=settlement_height,json=settlementHeight,proto3" json:"settlement_height,omitempty"`
+	Id                 uint64                `protobuf:"varint,13,opt,name=id,proto3" json:"id,omitempty"`
+	SettlementDuration int64                 `protobuf:"varint,14,opt,name=settlement_duration,json=settlementDuration,proto3" json:"settlement_duration,omitempty"`
+	Authorization      ContractAuthorization `protobuf:"varint,15,opt,name=authorization,proto3,enum=arkeo.arkeo.ContractAuthorization" json:"authorization,omitempty"`
+	QueriesPerMinute   int64                 `protobuf:"varint,16,opt,name=queries_per_minute,json=queriesPerMinute,proto3" json:"queries_per_minute,omitempty"`
+	// settlement grace period in effect when the contract was opened
+	SettlementGracePeriod int64 `protobuf:"varint,17,opt,name=settlement_grace_period,json=settlementGracePeriod,proto3" json:"settlement_grace_period,omitempty"`
+	// auto_renew extends the subscription at its expiration out of its top-up
+	// balance, instead of settling it
+	AutoRenew bool `protobuf:"varint,18,opt,name=auto_renew,json=autoRenew,proto3" json:"auto_renew,omitempty"`
+	// top_up is the balance the client escrowed for the auto-renewals, not part
+	// of the deposit until it renews the contract
+	TopUp string `protobuf:"bytes,19,opt,name=top_up,json=topUp,proto3" json:"top_up,omitempty"`
+	// payer is the account which funded the deposit instead of the client, the
+	// refund of the deposit goes back to it. Empty when the client funded it.
+	Payer string `protobuf:"bytes,20,opt,name=payer,proto3" json:"payer,omitempty"`
+	// start_height is the height a scheduled contract starts at, its duration
+	// running from it. Zero when the contract started as it opened.
+	StartHeight int64 `protobuf:"varint,21,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+}
+
+func (x *Contract) Reset() {
+	*x = Contract{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_arkeo_arkeo_keeper_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Contract) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Contract) ProtoMessage() {}
+
+// Deprecated: Use Contract.ProtoReflect.Descriptor instead.
+func (*Contract) Descriptor() ([]byte, []int) {
+	return file_arkeo_arkeo_keeper_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Contract) GetProvider() []byte {
+	if x != nil {
+		return x.Provider
+	}
+	return nil
+}
+
+func (x *Contract) GetService() int32 {
+	if x != nil {
+		return x.Service
+	}
+	return 0
+}
+
+func (x *Contract) GetClient() []byte {
+	if x != nil {
+		return x.Client
+	}
+	return nil
+}
+
+func (x *Contract) GetDelegate() []byte {
+	if x != nil {
+		return x.Delegate
+	}
+	return nil
+}
+
+func (x *Contract) GetType_() ContractType {
+	if x != nil {
+		return x.Type_
+	}
+	return ContractType_SUBSCRIPTION
+}
+
+func (x *Contract) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *Contract) GetDuration() int64 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *Contract) GetRate() *v1beta1.Coin {
+	if x != nil {
+		return x.Rate
+	}
+	return nil
+}
+
+func (x *Contract) GetDeposit() string {
+	if x != nil {
+		return x.Deposit
+	}
+	return ""
 }
 
-func (x ProviderStatus) String() string {
-	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+func (x *Contract) GetPaid() string {
+	if x != nil {
+		return x.Paid
+	}
+	return ""
 }
 
-func (ProviderStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_arkeo_arkeo_keeper_proto_enumTypes[0].Descriptor()
+func (x *Contract) GetNonce() int64 {
+	if x != nil {
+		return x.Nonce
+	}
+	return 0
 }
 
-func (ProviderStatus) Type() protoreflect.EnumType {
-	return &file_arkeo_arkeo_keeper_proto_enumTypes[0]
+func (x *Contract) GetSettlementHeight() int64 {
+	if x != nil {
+		return x.SettlementHeight
+	}
+	return 0
 }
 
-func (x ProviderStatus) Number() protoreflect.EnumNumber {
-	return protoreflect.EnumNumber(x)
+func (x *Contract) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
 }
 
-// Deprecated: Use ProviderStatus.Descriptor instead.
-func (ProviderStatus) EnumDescriptor() ([]byte, []int) {
-	return file_arkeo_arkeo_keeper_proto_rawDescGZIP(), []int{0}
+func (x *Contract) GetSettlementDuration() int64 {
+	if x != nil {
+		return x.SettlementDuration
+	}
+	return 0
 }
 
-type ContractType int32
+func (x *Contract) GetAuthorization() ContractAuthorization {
+	if x != nil {
+		return x.Authorization
+	}
+	return ContractAuthorization_STRICT
+}
 
-const (
-	ContractType_SUBSCRIPTION  ContractType = 0
-	ContractType_PAY_AS_YOU_GO ContractType = 1
-)
+func (x *Contract) GetQueriesPerMinute() int64 {
+	if x != nil {
+		return x.QueriesPerMinute
+	}
+	return 0
+}
 
-// Enum value maps for ContractType.
-var (
-	ContractType_name = map[int32]string{
-		0: "SUBSCRIPTION",
-		1: "PAY_AS_YOU_GO",
+func (x *Contract) GetSettlementGracePeriod() int64 {
+	if x != nil {
+		return x.SettlementGracePeriod
 	}
-	ContractType_value = map[string]int32{
-		"SUBSCRIPTION":  0,
-		"PAY_AS_YOU_GO": 1,
+	return 0
+}
+
+func (x *Contract) GetAutoRenew() bool {
+	if x != nil {
+		return x.AutoRenew
 	}
-)
+	return false
+}
 
-func (x ContractType) Enum() *ContractType {
-	p := new(ContractType)
-	*p = x
-	return p
+func (x *Contract) GetTopUp() string {
+	if x != nil {
+		return x.TopUp
+	}
+	return ""
 }
 
-func (x ContractType) String() string {
-	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+func (x *Contract) GetPayer() string {
+	if x != nil {
+		return x.Payer
+	}
+	return ""
 }
 
-func (ContractType) Descriptor() protoreflect.EnumDescriptor {
-	return file_arkeo_arkeo_keeper_proto_enumTypes[1].Descriptor()
+func (x *Contract) GetStartHeight() int64 {
+	if x != nil {
+		return x.StartHeight
+	}
+	return 0
 }
 
-func (ContractType) Type() protoreflect.EnumType {
-	return &file_arkeo_arkeo_keeper_proto_enumTypes[1]
+type ContractSet struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ContractIds []uint64 `protobuf:"varint,1,rep,packed,name=contract_ids,json=contractIds,proto3" json:"contract_ids,omitempty"`
 }
 
-func (x ContractType) Number() protoreflect.EnumNumber {
-	return protoreflect.EnumNumber(x)
+func (x *ContractSet) Reset() {
+	*x = ContractSet{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_arkeo_arkeo_keeper_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-// Deprecated: Use ContractType.Descriptor instead.
-func (ContractType) EnumDescriptor() ([]byte, []int) {
-	return file_arkeo_arkeo_keeper_proto_rawDescGZIP(), []int{1}
+func (x *ContractSet) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-type ContractAuthorization int32
+func (*ContractSet) ProtoMessage() {}
 
-const (
-	ContractAuthorization_STRICT ContractAuthorization = 0
-	ContractAuthorization_OPEN   ContractAuthorization = 1
-)
+// Deprecated: Use ContractSet.ProtoReflect.Descriptor instead.
+func (*ContractSet) Descriptor() ([]byte, []int) {
+	return file_arkeo_arkeo_keeper_proto_rawDescGZIP(), []int{2}
+}
 
-// Enum value maps for ContractAuthorization.
-var (
-	ContractAuthorization_name = map[int32]string{
-		0: "STRICT",
-		1: "OPEN",
-	}
-	ContractAuthorization_value = map[string]int32{
-		"STRICT": 0,
-		"OPEN":   1,
+func (x *ContractSet) GetContractIds() []uint64 {
+	if x != nil {
+		return x.ContractIds
 	}
-)
+	return nil
+}
 
-func (x ContractAuthorization) Enum() *ContractAuthorization {
-	p := new(ContractAuthorization)
-	*p = x
-	return p
+type ContractExpirationSet struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Height      int64        `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	ContractSet *ContractSet `protobuf:"bytes,2,opt,name=contract_set,json=contractSet,proto3" json:"contract_set,omitempty"`
 }
 
-func (x ContractAuthorization) String() string {
-	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+func (x *ContractExpirationSet) Reset() {
+	*x = ContractExpirationSet{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_arkeo_arkeo_keeper_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-func (ContractAuthorization) Descriptor() protoreflect.EnumDescriptor {
-	return file_arkeo_arkeo_keeper_proto_enumTypes[2].Descriptor()
+func (x *ContractExpirationSet) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (ContractAuthorization) Type() protoreflect.EnumType {
-	return &file_arkeo_arkeo_keeper_proto_enumTypes[2]
+func (*ContractExpirationSet) ProtoMessage() {}
+
+// Deprecated: Use ContractExpirationSet.ProtoReflect.Descriptor instead.
+func (*ContractExpirationSet) Descriptor() ([]byte, []int) {
+	return file_arkeo_arkeo_keeper_proto_rawDescGZIP(), []int{3}
 }
 
-func (x ContractAuthorization) Number() protoreflect.EnumNumber {
-	return protoreflect.EnumNumber(x)
+func (x *ContractExpirationSet) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
 }
 
-// Deprecated: Use ContractAuthorization.Descriptor instead.
-func (ContractAuthorization) EnumDescriptor() ([]byte, []int) {
-	return file_arkeo_arkeo_keeper_proto_rawDescGZIP(), []int{2}
+func (x *ContractExpirationSet) GetContractSet() *ContractSet {
+	if x != nil {
+		return x.ContractSet
+	}
+	return nil
 }
 
-type Provider struct {
+type UserContractSet struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PubKey              []byte          `protobuf:"bytes,1,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
-	Service             int32           `protobuf:"varint,2,opt,name=service,proto3" json:"service,omitempty"`
-	MetadataUri         string          `protobuf:"bytes,3,opt,name=metadata_uri,json=metadataUri,proto3" json:"metadata_uri,omitempty"`
-	MetadataNonce       uint64          `protobuf:"varint,4,opt,name=metadata_nonce,json=metadataNonce,proto3" json:"metadata_nonce,omitempty"`
-	Status              ProviderStatus  `protobuf:"varint,5,opt,name=status,proto3,enum=arkeo.arkeo.ProviderStatus" json:"status,omitempty"`
-	MinContractDuration int64           `protobuf:"varint,6,opt,name=min_contract_duration,json=minContractDuration,proto3" json:"min_contract_duration,omitempty"`
-	MaxContractDuration int64           `protobuf:"varint,7,opt,name=max_contract_duration,json=maxContractDuration,proto3" json:"max_contract_duration,omitempty"`
-	SubscriptionRate    []*v1beta1.Coin `protobuf:"bytes,8,rep,name=subscription_rate,json=subscriptionRate,proto3" json:"subscription_rate,omitempty"`
-	PayAsYouGoRate      []*v1beta1.Coin `protobuf:"bytes,9,rep,name=pay_as_you_go_rate,json=payAsYouGoRate,proto3" json:"pay_as_you_go_rate,omitempty"`
-	Bond                string          `protobuf:"bytes,10,opt,name=bond,proto3" json:"bond,omitempty"`
-	LastUpdate          int64           `protobuf:"varint,11,opt,name=last_update,json=lastUpdate,proto3" json:"last_update,omitempty"`
-	SettlementDuration  int64           `protobuf:"varint,12,opt,name=settlement_duration,json=settlementDuration,proto3" json:"settlement_duration,omitempty"`
+	User        []byte       `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	ContractSet *ContractSet `protobuf:"bytes,2,opt,name=contract_set,json=contractSet,proto3" json:"contract_set,omitempty"`
 }
 
-func (x *Provider) Reset() {
-	*x = Provider{}
+func (x *UserContractSet) Reset() {
+	*x = UserContractSet{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_arkeo_arkeo_keeper_proto_msgTypes[0]
+		mi := &file_arkeo_arkeo_keeper_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Provider) String() string {
+func (x *UserContractSet) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Provider) ProtoMessage() {}
+func (*UserContractSet) ProtoMessage() {}
 
-// Deprecated: Use Provider.ProtoReflect.Descriptor instead.
-func (*Provider) Descriptor() ([]byte, []int) {
-	return file_arkeo_arkeo_keeper_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use UserContractSet.ProtoReflect.Descriptor instead.
+func (*UserContractSet) Descriptor() ([]byte, []int) {
+	return file_arkeo_arkeo_keeper_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *Provider) GetPubKey() []byte {
+func (x *UserContractSet) GetUser() []byte {
 	if x != nil {
-		return x.PubKey
+		return x.User
 	}
 	return nil
 }
 
-func (x *Provider) GetService() int32 {
+func (x *UserContractSet) GetContractSet() *ContractSet {
 	if x != nil {
-		return x.Service
+		return x.ContractSet
 	}
-	return 0
+	return nil
 }
 
-func (x *Provider) GetMetadataUri() string {
-	if x != nil {
-		return x.MetadataUri
-	}
-	return ""
-}
+// ProviderEarnings counters of a provider for a service, kept up to date as
+// its contracts open and settle
+type ProviderEarnings struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *Provider) GetMetadataNonce() uint64 {
-	if x != nil {
-		return x.MetadataNonce
-	}
-	return 0
+	Provider []byte `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Service  int32  `protobuf:"varint,2,opt,name=service,proto3" json:"service,omitempty"`
+	// income paid to the provider, net of the reserve tax
+	Income           []*v1beta1.Coin `protobuf:"bytes,3,rep,name=income,proto3" json:"income,omitempty"`
+	SettledContracts uint64          `protobuf:"varint,4,opt,name=settled_contracts,json=settledContracts,proto3" json:"settled_contracts,omitempty"`
+	// deposits of the unsettled contracts not paid yet, the most the provider
+	// can still claim
+	Escrowed []*v1beta1.Coin `protobuf:"bytes,5,rep,name=escrowed,proto3" json:"escrowed,omitempty"`
 }
 
-func (x *Provider) GetStatus() ProviderStatus {
-	if x != nil {
-		return x.Status
+func (x *ProviderEarnings) Reset() {
+	*x = ProviderEarnings{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_arkeo_arkeo_keeper_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ProviderStatus_OFFLINE
 }
 
-func (x *Provider) GetMinContractDuration() int64 {
-	if x != nil {
-		return x.MinContractDuration
-	}
-	return 0
+func (x *ProviderEarnings) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Provider) GetMaxContractDuration() int64 {
-	if x != nil {
-		return x.MaxContractDuration
-	}
-	return 0
+func (*ProviderEarnings) ProtoMessage() {}
+
+// Deprecated: Use ProviderEarnings.ProtoReflect.Descriptor instead.
+func (*ProviderEarnings) Descriptor() ([]byte, []int) {
+	return file_arkeo_arkeo_keeper_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *Provider) GetSubscriptionRate() []*v1beta1.Coin {
+func (x *ProviderEarnings) GetProvider() []byte {
 	if x != nil {
-		return x.SubscriptionRate
+		return x.Provider
 	}
 	return nil
 }
 
-func (x *Provider) GetPayAsYouGoRate() []*v1beta1.Coin {
+func (x *ProviderEarnings) GetService() int32 {
 	if x != nil {
-		return x.PayAsYouGoRate
+		return x.Service
 	}
-	return nil
+	return 0
 }
 
-func (x *Provider) GetBond() string {
+func (x *ProviderEarnings) GetIncome() []*v1beta1.Coin {
 	if x != nil {
-		return x.Bond
+		return x.Income
 	}
-	return ""
+	return nil
 }
 
-func (x *Provider) GetLastUpdate() int64 {
+func (x *ProviderEarnings) GetSettledContracts() uint64 {
 	if x != nil {
-		return x.LastUpdate
+		return x.SettledContracts
 	}
 	return 0
 }
 
-func (x *Provider) GetSettlementDuration() int64 {
+func (x *ProviderEarnings) GetEscrowed() []*v1beta1.Coin {
 	if x != nil {
-		return x.SettlementDuration
+		return x.Escrowed
 	}
-	return 0
+	return nil
 }
 
-type Contract struct {
+// ContractSettlement breakdown of the settlement of a contract
+type ContractSettlement struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Provider           []byte                `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
-	Service            int32                 `protobuf:"varint,2,opt,name=service,proto3" json:"service,omitempty"`
-	Client             []byte                `protobuf:"bytes,3,opt,name=client,proto3" json:"client,omitempty"`
-	Delegate           []byte                `protobuf:"bytes,4,opt,name=delegate,proto3" json:"delegate,omitempty"`
-	Type_              ContractType          `protobuf:"varint,5,opt,name=type,proto3,enum=arkeo.arkeo.ContractType" json:"type,omitempty"`
-	Height             int64                 `protobuf:"varint,6,opt,name=height,proto3" json:"height,omitempty"`
-	Duration           int64                 `protobuf:"varint,7,opt,name=duration,proto3" json:"duration,omitempty"`
-	Rate               *v1beta1.Coin         `protobuf:"bytes,8,opt,name=rate,proto3" json:"rate,omitempty"`
-	Deposit            string                `protobuf:"bytes,9,opt,name=deposit,proto3" json:"deposit,omitempty"`
-	Paid               string                `protobuf:"bytes,10,opt,name=paid,proto3" json:"paid,omitempty"`
-	Nonce              int64                 `protobuf:"varint,11,opt,name=nonce,proto3" json:"nonce,omitempty"`
-	SettlementHeight   int64                 `protobuf:"varint,12,opt,name=settlement_height,json=settlementHeight,proto3" json:"settlement_height,omitempty"`
-	Id                 uint64                `protobuf:"varint,13,opt,name=id,proto3" json:"id,omitempty"`
-	SettlementDuration int64                 `protobuf:"varint,14,opt,name=settlement_duration,json=settlementDuration,proto3" json:"settlement_duration,omitempty"`
-	Authorization      ContractAuthorization `protobuf:"varint,15,opt,name=authorization,proto3,enum=arkeo.arkeo.ContractAuthorization" json:"authorization,omitempty"`
-	QueriesPerMinute   int64                 `protobuf:"varint,16,opt,name=queries_per_minute,json=queriesPerMinute,proto3" json:"queries_per_minute,omitempty"`
-}
-
-func (x *Contract) Reset() {
-	*x = Contract{}
+	ContractId uint64 `protobuf:"varint,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	// nonce the contract settles at
+	Nonce int64 `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// the settlement closes the contract, refunding the deposit left
+	Final bool `protobuf:"varint,3,opt,name=final,proto3" json:"final,omitempty"`
+	// owed for the queries or blocks served since the last settlement
+	Owed *v1beta1.Coin `protobuf:"bytes,4,opt,name=owed,proto3" json:"owed,omitempty"`
+	// paid to the provider, the amount owed net of the reserve tax
+	ProviderIncome *v1beta1.Coin `protobuf:"bytes,5,opt,name=provider_income,json=providerIncome,proto3" json:"provider_income,omitempty"`
+	ReserveTax     *v1beta1.Coin `protobuf:"bytes,6,opt,name=reserve_tax,json=reserveTax,proto3" json:"reserve_tax,omitempty"`
+	// deposit left refunded to the client, when final
+	Refund *v1beta1.Coin `protobuf:"bytes,7,opt,name=refund,proto3" json:"refund,omitempty"`
+	// claimed beyond the deposit left, the provider goes without
+	Unpaid *v1beta1.Coin `protobuf:"bytes,8,opt,name=unpaid,proto3" json:"unpaid,omitempty"`
+	// rounding remainder of the settlement, accrued to the reserve along the
+	// reserve tax
+	Dust *v1beta1.Coin `protobuf:"bytes,9,opt,name=dust,proto3" json:"dust,omitempty"`
+}
+
+func (x *ContractSettlement) Reset() {
+	*x = ContractSettlement{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_arkeo_arkeo_keeper_proto_msgTypes[1]
+		mi := &file_arkeo_arkeo_keeper_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Contract) String() string {
+func (x *ContractSettlement) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Contract) ProtoMessage() {}
+func (*ContractSettlement) ProtoMessage() {}
 
-// Deprecated: Use Contract.ProtoReflect.Descriptor instead.
-func (*Contract) Descriptor() ([]byte, []int) {
-	return file_arkeo_arkeo_keeper_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use ContractSettlement.ProtoReflect.Descriptor instead.
+func (*ContractSettlement) Descriptor() ([]byte, []int) {
+	return file_arkeo_arkeo_keeper_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *Contract) GetProvider() []byte {
+func (x *ContractSettlement) GetContractId() uint64 {
 	if x != nil {
-		return x.Provider
+		return x.ContractId
 	}
-	return nil
+	return 0
 }
 
-func (x *Contract) GetService() int32 {
+func (x *ContractSettlement) GetNonce() int64 {
 	if x != nil {
-		return x.Service
+		return x.Nonce
 	}
 	return 0
 }
 
-func (x *Contract) GetClient() []byte {
+func (x *ContractSettlement) GetFinal() bool {
 	if x != nil {
-		return x.Client
+		return x.Final
 	}
-	return nil
+	return false
 }
 
-func (x *Contract) GetDelegate() []byte {
+func (x *ContractSettlement) GetOwed() *v1beta1.Coin {
 	if x != nil {
-		return x.Delegate
+		return x.Owed
 	}
 	return nil
 }
 
-func (x *Contract) GetType_() ContractType {
+func (x *ContractSettlement) GetProviderIncome() *v1beta1.Coin {
 	if x != nil {
-		return x.Type_
+		return x.ProviderIncome
 	}
-	return ContractType_SUBSCRIPTION
+	return nil
 }
 
-func (x *Contract) GetHeight() int64 {
+func (x *ContractSettlement) GetReserveTax() *v1beta1.Coin {
 	if x != nil {
-		return x.Height
+		return x.ReserveTax
 	}
-	return 0
+	return nil
 }
 
-func (x *Contract) GetDuration() int64 {
+func (x *ContractSettlement) GetRefund() *v1beta1.Coin {
 	if x != nil {
-		return x.Duration
+		return x.Refund
 	}
-	return 0
+	return nil
 }
 
-func (x *Contract) GetRate() *v1beta1.Coin {
+func (x *ContractSettlement) GetUnpaid() *v1beta1.Coin {
 	if x != nil {
-		return x.Rate
+		return x.Unpaid
 	}
 	return nil
 }
 
-func (x *Contract) GetDeposit() string {
+func (x *ContractSettlement) GetDust() *v1beta1.Coin {
 	if x != nil {
-		return x.Deposit
+		return x.Dust
 	}
-	return ""
+	return nil
 }
 
-func (x *Contract) GetPaid() string {
-	if x != nil {
-		return x.Paid
-	}
-	return ""
+// ContractClaimResult outcome of one of the claims of a batch
+type ContractClaimResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ContractId uint64 `protobuf:"varint,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	Nonce      int64  `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	Success    bool   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	// why the claim failed, empty when it succeeded
+	Error string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
 }
 
-func (x *Contract) GetNonce() int64 {
-	if x != nil {
-		return x.Nonce
+func (x *ContractClaimResult) Reset() {
+	*x = ContractClaimResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_arkeo_arkeo_keeper_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *Contract) GetSettlementHeight() int64 {
-	if x != nil {
-		return x.SettlementHeight
-	}
-	return 0
+func (x *ContractClaimResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Contract) GetId() uint64 {
+func (*ContractClaimResult) ProtoMessage() {}
+
+// Deprecated: Use ContractClaimResult.ProtoReflect.Descriptor instead.
+func (*ContractClaimResult) Descriptor() ([]byte, []int) {
+	return file_arkeo_arkeo_keeper_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ContractClaimResult) GetContractId() uint64 {
 	if x != nil {
-		return x.Id
+		return x.ContractId
 	}
 	return 0
 }
 
-func (x *Contract) GetSettlementDuration() int64 {
+func (x *ContractClaimResult) GetNonce() int64 {
 	if x != nil {
-		return x.SettlementDuration
+		return x.Nonce
 	}
 	return 0
 }
 
-func (x *Contract) GetAuthorization() ContractAuthorization {
+func (x *ContractClaimResult) GetSuccess() bool {
 	if x != nil {
-		return x.Authorization
+		return x.Success
 	}
-	return ContractAuthorization_STRICT
+	return false
 }
 
-func (x *Contract) GetQueriesPerMinute() int64 {
+func (x *ContractClaimResult) GetError() string {
 	if x != nil {
-		return x.QueriesPerMinute
+		return x.Error
 	}
-	return 0
+	return ""
 }
 
-type ContractSet struct {
+// ContractPurgeResult outcome of the purge of one of the contracts of a
+// MsgPurgeExpiredContracts
+type ContractPurgeResult struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ContractIds []uint64 `protobuf:"varint,1,rep,packed,name=contract_ids,json=contractIds,proto3" json:"contract_ids,omitempty"`
+	ContractId uint64 `protobuf:"varint,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	Success    bool   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	// why the purge failed, empty when it succeeded
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
 }
 
-func (x *ContractSet) Reset() {
-	*x = ContractSet{}
+func (x *ContractPurgeResult) Reset() {
+	*x = ContractPurgeResult{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_arkeo_arkeo_keeper_proto_msgTypes[2]
+		mi := &file_arkeo_arkeo_keeper_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ContractSet) String() string {
+func (x *ContractPurgeResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ContractSet) ProtoMessage() {}
+func (*ContractPurgeResult) ProtoMessage() {}
 
-// Deprecated: Use ContractSet.ProtoReflect.Descriptor instead.
-func (*ContractSet) Descriptor() ([]byte, []int) {
-	return file_arkeo_arkeo_keeper_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use ContractPurgeResult.ProtoReflect.Descriptor instead.
+func (*ContractPurgeResult) Descriptor() ([]byte, []int) {
+	return file_arkeo_arkeo_keeper_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *ContractSet) GetContractIds() []uint64 {
+func (x *ContractPurgeResult) GetContractId() uint64 {
 	if x != nil {
-		return x.ContractIds
+		return x.ContractId
 	}
-	return nil
+	return 0
 }
 
-type ContractExpirationSet struct {
+func (x *ContractPurgeResult) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ContractPurgeResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// ProviderAllowlist clients allowed to open contracts with a provider for a
+// service, any client can when it is empty
+type ProviderAllowlist struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Height      int64        `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
-	ContractSet *ContractSet `protobuf:"bytes,2,opt,name=contract_set,json=contractSet,proto3" json:"contract_set,omitempty"`
+	Provider []byte   `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Service  int32    `protobuf:"varint,2,opt,name=service,proto3" json:"service,omitempty"`
+	Clients  [][]byte `protobuf:"bytes,3,rep,name=clients,proto3" json:"clients,omitempty"`
 }
 
-func (x *ContractExpirationSet) Reset() {
-	*x = ContractExpirationSet{}
+func (x *ProviderAllowlist) Reset() {
+	*x = ProviderAllowlist{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_arkeo_arkeo_keeper_proto_msgTypes[3]
+		mi := &file_arkeo_arkeo_keeper_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ContractExpirationSet) String() string {
+func (x *ProviderAllowlist) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ContractExpirationSet) ProtoMessage() {}
+func (*ProviderAllowlist) ProtoMessage() {}
 
-// Deprecated: Use ContractExpirationSet.ProtoReflect.Descriptor instead.
-func (*ContractExpirationSet) Descriptor() ([]byte, []int) {
-	return file_arkeo_arkeo_keeper_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use ProviderAllowlist.ProtoReflect.Descriptor instead.
+func (*ProviderAllowlist) Descriptor() ([]byte, []int) {
+	return file_arkeo_arkeo_keeper_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *ContractExpirationSet) GetHeight() int64 {
+func (x *ProviderAllowlist) GetProvider() []byte {
 	if x != nil {
-		return x.Height
+		return x.Provider
+	}
+	return nil
+}
+
+func (x *ProviderAllowlist) GetService() int32 {
+	if x != nil {
+		return x.Service
 	}
 	return 0
 }
 
-func (x *ContractExpirationSet) GetContractSet() *ContractSet {
+func (x *ProviderAllowlist) GetClients() [][]byte {
 	if x != nil {
-		return x.ContractSet
+		return x.Clients
 	}
 	return nil
 }
 
-type UserContractSet struct {
+// NetworkStats counters of the providers and contracts of the network, kept up
+// to date as they are stored
+type NetworkStats struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	User        []byte       `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
-	ContractSet *ContractSet `protobuf:"bytes,2,opt,name=contract_set,json=contractSet,proto3" json:"contract_set,omitempty"`
+	// providers bonded to a service, a provider of several services counting
+	// once for each of them
+	Providers       uint64          `protobuf:"varint,1,opt,name=providers,proto3" json:"providers,omitempty"`
+	ProvidersOnline uint64          `protobuf:"varint,2,opt,name=providers_online,json=providersOnline,proto3" json:"providers_online,omitempty"`
+	Bonded          []*v1beta1.Coin `protobuf:"bytes,3,rep,name=bonded,proto3" json:"bonded,omitempty"`
+	// contracts not settled yet
+	OpenContracts uint64 `protobuf:"varint,4,opt,name=open_contracts,json=openContracts,proto3" json:"open_contracts,omitempty"`
+	// deposits not paid yet and top-up balances of the open contracts
+	Escrowed []*v1beta1.Coin `protobuf:"bytes,5,rep,name=escrowed,proto3" json:"escrowed,omitempty"`
 }
 
-func (x *UserContractSet) Reset() {
-	*x = UserContractSet{}
+func (x *NetworkStats) Reset() {
+	*x = NetworkStats{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_arkeo_arkeo_keeper_proto_msgTypes[4]
+		mi := &file_arkeo_arkeo_keeper_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UserContractSet) String() string {
+func (x *NetworkStats) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserContractSet) ProtoMessage() {}
+func (*NetworkStats) ProtoMessage() {}
 
-// Deprecated: Use UserContractSet.ProtoReflect.Descriptor instead.
-func (*UserContractSet) Descriptor() ([]byte, []int) {
-	return file_arkeo_arkeo_keeper_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use NetworkStats.ProtoReflect.Descriptor instead.
+func (*NetworkStats) Descriptor() ([]byte, []int) {
+	return file_arkeo_arkeo_keeper_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *UserContractSet) GetUser() []byte {
+func (x *NetworkStats) GetProviders() uint64 {
 	if x != nil {
-		return x.User
+		return x.Providers
+	}
+	return 0
+}
+
+func (x *NetworkStats) GetProvidersOnline() uint64 {
+	if x != nil {
+		return x.ProvidersOnline
+	}
+	return 0
+}
+
+func (x *NetworkStats) GetBonded() []*v1beta1.Coin {
+	if x != nil {
+		return x.Bonded
 	}
 	return nil
 }
 
-func (x *UserContractSet) GetContractSet() *ContractSet {
+func (x *NetworkStats) GetOpenContracts() uint64 {
 	if x != nil {
-		return x.ContractSet
+		return x.OpenContracts
+	}
+	return 0
+}
+
+func (x *NetworkStats) GetEscrowed() []*v1beta1.Coin {
+	if x != nil {
+		return x.Escrowed
 	}
 	return nil
 }
@@ -4500,7 +9716,7 @@ var file_arkeo_arkeo_keeper_proto_rawDesc = []byte{
 	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
 	0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
 	0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f,
-	0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb5, 0x05, 0x0a, 0x08, 0x50, 0x72, 0x6f,
+	0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa2, 0x06, 0x0a, 0x08, 0x50, 0x72, 0x6f,
 	0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x48, 0x0a, 0x07, 0x70, 0x75, 0x62, 0x5f, 0x6b, 0x65, 0x79,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x2f, 0xfa, 0xde, 0x1f, 0x2b, 0x67, 0x69, 0x74, 0x68,
 	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x6e, 0x65, 0x74, 0x77,
@@ -4544,98 +9760,220 @@ var file_arkeo_arkeo_keeper_proto_rawDesc = []byte{
 	0x2f, 0x0a, 0x13, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x64, 0x75,
 	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x73, 0x65,
 	0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x22, 0xd5, 0x06, 0x0a, 0x08, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x4b, 0x0a,
-	0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x42,
-	0x2f, 0xfa, 0xde, 0x1f, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x61, 0x72, 0x6b, 0x65, 0x6f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x72, 0x6b,
-	0x65, 0x6f, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79,
-	0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x4a, 0x0a, 0x07, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x30, 0xfa, 0xde, 0x1f,
-	0x2c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x72, 0x6b, 0x65,
-	0x6f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2f, 0x63,
-	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x07, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x47, 0x0a, 0x06, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x2f, 0xfa, 0xde, 0x1f, 0x2b, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x6e, 0x65, 0x74, 0x77,
-	0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
-	0x2e, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x52, 0x06, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12,
-	0x4b, 0x0a, 0x08, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0c, 0x42, 0x2f, 0xfa, 0xde, 0x1f, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
-	0x6d, 0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61,
-	0x72, 0x6b, 0x65, 0x6f, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x50, 0x75, 0x62, 0x4b,
-	0x65, 0x79, 0x52, 0x08, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x12, 0x2d, 0x0a, 0x04,
-	0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x61, 0x72, 0x6b,
-	0x65, 0x6f, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
-	0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68,
-	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69,
-	0x67, 0x68, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x33, 0x0a, 0x04, 0x72, 0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65,
-	0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x04,
-	0x72, 0x61, 0x74, 0x65, 0x12, 0x45, 0x0a, 0x07, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18,
-	0x09, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2b, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68,
-	0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49,
-	0x6e, 0x74, 0x52, 0x07, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x3f, 0x0a, 0x04, 0x70,
-	0x61, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2b, 0xc8, 0xde, 0x1f, 0x00, 0xda,
-	0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f,
-	0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x04, 0x70, 0x61, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05,
-	0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6e, 0x6f, 0x6e,
-	0x63, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
-	0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x73,
-	0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12,
-	0x2f, 0x0a, 0x13, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x64, 0x75,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x73, 0x65,
-	0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x48, 0x0a, 0x0d, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e,
-	0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x75,
-	0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x61, 0x75, 0x74,
-	0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x12, 0x71, 0x75,
-	0x65, 0x72, 0x69, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65,
-	0x18, 0x10, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x71, 0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x50,
-	0x65, 0x72, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x22, 0x34, 0x0a, 0x0b, 0x43, 0x6f, 0x6e, 0x74,
-	0x72, 0x61, 0x63, 0x74, 0x53, 0x65, 0x74, 0x12, 0x25, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x72,
-	0x61, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x04, 0x42, 0x02, 0x10,
-	0x01, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x49, 0x64, 0x73, 0x22, 0x6c,
-	0x0a, 0x15, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x45, 0x78, 0x70, 0x69, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68,
-	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
-	0x3b, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x73, 0x65, 0x74, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x61, 0x72,
-	0x6b, 0x65, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x53, 0x65, 0x74, 0x52,
-	0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x53, 0x65, 0x74, 0x22, 0x93, 0x01, 0x0a,
-	0x0f, 0x55, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x53, 0x65, 0x74,
-	0x12, 0x43, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x2f,
+	0x12, 0x16, 0x0a, 0x06, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x6f, 0x70, 0x65, 0x6e,
+	0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0d, 0x6f, 0x70, 0x65, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x73, 0x12,
+	0x2c, 0x0a, 0x12, 0x6d, 0x61, 0x78, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x6d, 0x61, 0x78,
+	0x4f, 0x70, 0x65, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x73, 0x22, 0xc3, 0x08,
+	0x0a, 0x08, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x4b, 0x0a, 0x08, 0x70, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x2f, 0xfa, 0xde,
+	0x1f, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x72, 0x6b,
+	0x65, 0x6f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2f,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x52, 0x08, 0x70,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x4a, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x30, 0xfa, 0xde, 0x1f, 0x2c, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x6e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2f, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x12, 0x47, 0x0a, 0x06, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0c, 0x42, 0x2f, 0xfa, 0xde, 0x1f, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x50, 0x75,
+	0x62, 0x4b, 0x65, 0x79, 0x52, 0x06, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x4b, 0x0a, 0x08,
+	0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x2f,
 	0xfa, 0xde, 0x1f, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61,
 	0x72, 0x6b, 0x65, 0x6f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x72, 0x6b, 0x65,
 	0x6f, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x52,
-	0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x3b, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
-	0x74, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x72,
-	0x6b, 0x65, 0x6f, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61,
-	0x63, 0x74, 0x53, 0x65, 0x74, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x53,
-	0x65, 0x74, 0x2a, 0x29, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x12, 0x0b, 0x0a, 0x07, 0x4f, 0x46, 0x46, 0x4c, 0x49, 0x4e, 0x45, 0x10,
-	0x00, 0x12, 0x0a, 0x0a, 0x06, 0x4f, 0x4e, 0x4c, 0x49, 0x4e, 0x45, 0x10, 0x01, 0x2a, 0x33, 0x0a,
-	0x0c, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a,
-	0x0c, 0x53, 0x55, 0x42, 0x53, 0x43, 0x52, 0x49, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x00, 0x12,
-	0x11, 0x0a, 0x0d, 0x50, 0x41, 0x59, 0x5f, 0x41, 0x53, 0x5f, 0x59, 0x4f, 0x55, 0x5f, 0x47, 0x4f,
-	0x10, 0x01, 0x2a, 0x2d, 0x0a, 0x15, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x75,
-	0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0a, 0x0a, 0x06, 0x53,
-	0x54, 0x52, 0x49, 0x43, 0x54, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x4f, 0x50, 0x45, 0x4e, 0x10,
-	0x01, 0x42, 0x89, 0x01, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e,
-	0x61, 0x72, 0x6b, 0x65, 0x6f, 0x42, 0x0b, 0x4b, 0x65, 0x65, 0x70, 0x65, 0x72, 0x50, 0x72, 0x6f,
-	0x74, 0x6f, 0x50, 0x01, 0x5a, 0x1c, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e,
-	0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2f, 0x61, 0x72, 0x6b,
-	0x65, 0x6f, 0xa2, 0x02, 0x03, 0x41, 0x41, 0x58, 0xaa, 0x02, 0x0b, 0x41, 0x72, 0x6b, 0x65, 0x6f,
-	0x2e, 0x41, 0x72, 0x6b, 0x65, 0x6f, 0xca, 0x02, 0x0b, 0x41, 0x72, 0x6b, 0x65, 0x6f, 0x5c, 0x41,
-	0x72, 0x6b, 0x65, 0x6f, 0xe2, 0x02, 0x17, 0x41, 0x72, 0x6b, 0x65, 0x6f, 0x5c, 0x41, 0x72, 0x6b,
-	0x65, 0x6f, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02,
-	0x0c, 0x41, 0x72, 0x6b, 0x65, 0x6f, 0x3a, 0x3a, 0x41, 0x72, 0x6b, 0x65, 0x6f, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x08, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x12, 0x2d, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e,
+	0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x33, 0x0a, 0x04,
+	0x72, 0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x04, 0x72, 0x61, 0x74,
+	0x65, 0x12, 0x45, 0x0a, 0x07, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x2b, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e,
+	0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52,
+	0x07, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x3f, 0x0a, 0x04, 0x70, 0x61, 0x69, 0x64,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2b, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74,
+	0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x49, 0x6e, 0x74, 0x52, 0x04, 0x70, 0x61, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e,
+	0x63, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x12,
+	0x2b, 0x0a, 0x11, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x73, 0x65, 0x74, 0x74,
+	0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2f, 0x0a, 0x13,
+	0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x73, 0x65, 0x74, 0x74, 0x6c,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x48, 0x0a,
+	0x0d, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0f,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x61, 0x72, 0x6b,
+	0x65, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x12, 0x71, 0x75, 0x65, 0x72, 0x69,
+	0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x18, 0x10, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x10, 0x71, 0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x50, 0x65, 0x72, 0x4d,
+	0x69, 0x6e, 0x75, 0x74, 0x65, 0x12, 0x36, 0x0a, 0x17, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x5f, 0x67, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64,
+	0x18, 0x11, 0x20, 0x01, 0x28, 0x03, 0x52, 0x15, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x47, 0x72, 0x61, 0x63, 0x65, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x1d, 0x0a,
+	0x0a, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x72, 0x65, 0x6e, 0x65, 0x77, 0x18, 0x12, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x09, 0x61, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x12, 0x42, 0x0a, 0x06,
+	0x74, 0x6f, 0x70, 0x5f, 0x75, 0x70, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2b, 0xc8, 0xde,
+	0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e,
+	0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x55, 0x70,
+	0x12, 0x2e, 0x0a, 0x05, 0x70, 0x61, 0x79, 0x65, 0x72, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x05, 0x70, 0x61, 0x79, 0x65, 0x72,
+	0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x15, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x48, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x22, 0x34, 0x0a, 0x0b, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x53,
+	0x65, 0x74, 0x12, 0x25, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x69,
+	0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x04, 0x42, 0x02, 0x10, 0x01, 0x52, 0x0b, 0x63, 0x6f,
+	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x49, 0x64, 0x73, 0x22, 0x6c, 0x0a, 0x15, 0x43, 0x6f, 0x6e,
+	0x74, 0x72, 0x61, 0x63, 0x74, 0x45, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x3b, 0x0a, 0x0c, 0x63, 0x6f,
+	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x43,
+	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x53, 0x65, 0x74, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x53, 0x65, 0x74, 0x22, 0x93, 0x01, 0x0a, 0x0f, 0x55, 0x73, 0x65, 0x72,
+	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x53, 0x65, 0x74, 0x12, 0x43, 0x0a, 0x04, 0x75,
+	0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x2f, 0xfa, 0xde, 0x1f, 0x2b, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x6e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2f, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72,
+	0x12, 0x3b, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x73, 0x65, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x61,
+	0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x53, 0x65, 0x74,
+	0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x53, 0x65, 0x74, 0x22, 0xce, 0x02,
+	0x0a, 0x10, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x45, 0x61, 0x72, 0x6e, 0x69, 0x6e,
+	0x67, 0x73, 0x12, 0x4b, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x42, 0x2f, 0xfa, 0xde, 0x1f, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x50,
+	0x75, 0x62, 0x4b, 0x65, 0x79, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12,
+	0x4a, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x42, 0x30, 0xfa, 0xde, 0x1f, 0x2c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x72,
+	0x6b, 0x65, 0x6f, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x69,
+	0x6e, 0x63, 0x6f, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x69, 0x6e,
+	0x63, 0x6f, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x64, 0x5f,
+	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x10, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
+	0x73, 0x12, 0x3b, 0x0a, 0x08, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x65, 0x64, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73,
+	0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04,
+	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x08, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x65, 0x64, 0x22, 0xc9,
+	0x03, 0x0a, 0x12, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x53, 0x65, 0x74, 0x74, 0x6c,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x66, 0x69, 0x6e, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66, 0x69, 0x6e,
+	0x61, 0x6c, 0x12, 0x33, 0x0a, 0x04, 0x6f, 0x77, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f,
+	0x00, 0x52, 0x04, 0x6f, 0x77, 0x65, 0x64, 0x12, 0x48, 0x0a, 0x0f, 0x70, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f,
+	0x00, 0x52, 0x0e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x49, 0x6e, 0x63, 0x6f, 0x6d,
+	0x65, 0x12, 0x40, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x5f, 0x74, 0x61, 0x78,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69,
+	0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x54, 0x61, 0x78, 0x12, 0x37, 0x0a, 0x06, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73,
+	0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04,
+	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x12, 0x37, 0x0a, 0x06,
+	0x75, 0x6e, 0x70, 0x61, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x75,
+	0x6e, 0x70, 0x61, 0x69, 0x64, 0x12, 0x33, 0x0a, 0x04, 0x64, 0x75, 0x73, 0x74, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73,
+	0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04,
+	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x04, 0x64, 0x75, 0x73, 0x74, 0x22, 0x7c, 0x0a, 0x13, 0x43, 0x6f,
+	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
+	0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x66, 0x0a, 0x13, 0x43, 0x6f, 0x6e, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x50, 0x75, 0x72, 0x67, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12,
+	0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x49, 0x64,
+	0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x22, 0xf7, 0x01, 0x0a, 0x11, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x41, 0x6c, 0x6c,
+	0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x4b, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x2f, 0xfa, 0xde, 0x1f, 0x2b, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x6e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2f, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x12, 0x4a, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x42, 0x30, 0xfa, 0xde, 0x1f, 0x2c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
+	0x49, 0x0a, 0x07, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0c,
+	0x42, 0x2f, 0xfa, 0xde, 0x1f, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x72,
+	0x6b, 0x65, 0x6f, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x50, 0x75, 0x62, 0x4b, 0x65,
+	0x79, 0x52, 0x07, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x22, 0xf4, 0x01, 0x0a, 0x0c, 0x4e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x70,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09,
+	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x5f, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x4f, 0x6e,
+	0x6c, 0x69, 0x6e, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x62, 0x6f, 0x6e, 0x64, 0x65, 0x64, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61,
+	0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42,
+	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x62, 0x6f, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x25, 0x0a,
+	0x0e, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x6f, 0x70, 0x65, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72,
+	0x61, 0x63, 0x74, 0x73, 0x12, 0x3b, 0x0a, 0x08, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x65, 0x64,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69,
+	0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x08, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x65,
+	0x64, 0x2a, 0x29, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x0b, 0x0a, 0x07, 0x4f, 0x46, 0x46, 0x4c, 0x49, 0x4e, 0x45, 0x10, 0x00,
+	0x12, 0x0a, 0x0a, 0x06, 0x4f, 0x4e, 0x4c, 0x49, 0x4e, 0x45, 0x10, 0x01, 0x2a, 0x33, 0x0a, 0x0c,
+	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x0c,
+	0x53, 0x55, 0x42, 0x53, 0x43, 0x52, 0x49, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x00, 0x12, 0x11,
+	0x0a, 0x0d, 0x50, 0x41, 0x59, 0x5f, 0x41, 0x53, 0x5f, 0x59, 0x4f, 0x55, 0x5f, 0x47, 0x4f, 0x10,
+	0x01, 0x2a, 0x2d, 0x0a, 0x15, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x54,
+	0x52, 0x49, 0x43, 0x54, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x4f, 0x50, 0x45, 0x4e, 0x10, 0x01,
+	0x2a, 0x51, 0x0a, 0x0f, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x15, 0x0a, 0x11, 0x41, 0x4c, 0x4c, 0x4f, 0x57, 0x4c, 0x49, 0x53, 0x54,
+	0x5f, 0x52, 0x45, 0x50, 0x4c, 0x41, 0x43, 0x45, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x41, 0x4c,
+	0x4c, 0x4f, 0x57, 0x4c, 0x49, 0x53, 0x54, 0x5f, 0x41, 0x44, 0x44, 0x10, 0x01, 0x12, 0x14, 0x0a,
+	0x10, 0x41, 0x4c, 0x4c, 0x4f, 0x57, 0x4c, 0x49, 0x53, 0x54, 0x5f, 0x52, 0x45, 0x4d, 0x4f, 0x56,
+	0x45, 0x10, 0x02, 0x42, 0x89, 0x01, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x72, 0x6b, 0x65,
+	0x6f, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x42, 0x0b, 0x4b, 0x65, 0x65, 0x70, 0x65, 0x72, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x1c, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64,
+	0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2f, 0x61,
+	0x72, 0x6b, 0x65, 0x6f, 0xa2, 0x02, 0x03, 0x41, 0x41, 0x58, 0xaa, 0x02, 0x0b, 0x41, 0x72, 0x6b,
+	0x65, 0x6f, 0x2e, 0x41, 0x72, 0x6b, 0x65, 0x6f, 0xca, 0x02, 0x0b, 0x41, 0x72, 0x6b, 0x65, 0x6f,
+	0x5c, 0x41, 0x72, 0x6b, 0x65, 0x6f, 0xe2, 0x02, 0x17, 0x41, 0x72, 0x6b, 0x65, 0x6f, 0x5c, 0x41,
+	0x72, 0x6b, 0x65, 0x6f, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0xea, 0x02, 0x0c, 0x41, 0x72, 0x6b, 0x65, 0x6f, 0x3a, 0x3a, 0x41, 0x72, 0x6b, 0x65, 0x6f, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -4650,33 +9988,50 @@ func file_arkeo_arkeo_keeper_proto_rawDescGZIP() []byte {
 	return file_arkeo_arkeo_keeper_proto_rawDescData
 }
 
-var file_arkeo_arkeo_keeper_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_arkeo_arkeo_keeper_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_arkeo_arkeo_keeper_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_arkeo_arkeo_keeper_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
 var file_arkeo_arkeo_keeper_proto_goTypes = []interface{}{
 	(ProviderStatus)(0),           // 0: arkeo.arkeo.ProviderStatus
 	(ContractType)(0),             // 1: arkeo.arkeo.ContractType
 	(ContractAuthorization)(0),    // 2: arkeo.arkeo.ContractAuthorization
-	(*Provider)(nil),              // 3: arkeo.arkeo.Provider
-	(*Contract)(nil),              // 4: arkeo.arkeo.Contract
-	(*ContractSet)(nil),           // 5: arkeo.arkeo.ContractSet
-	(*ContractExpirationSet)(nil), // 6: arkeo.arkeo.ContractExpirationSet
-	(*UserContractSet)(nil),       // 7: arkeo.arkeo.UserContractSet
-	(*v1beta1.Coin)(nil),          // 8: cosmos.base.v1beta1.Coin
+	(AllowlistAction)(0),          // 3: arkeo.arkeo.AllowlistAction
+	(*Provider)(nil),              // 4: arkeo.arkeo.Provider
+	(*Contract)(nil),              // 5: arkeo.arkeo.Contract
+	(*ContractSet)(nil),           // 6: arkeo.arkeo.ContractSet
+	(*ContractExpirationSet)(nil), // 7: arkeo.arkeo.ContractExpirationSet
+	(*UserContractSet)(nil),       // 8: arkeo.arkeo.UserContractSet
+	(*ProviderEarnings)(nil),      // 9: arkeo.arkeo.ProviderEarnings
+	(*ContractSettlement)(nil),    // 10: arkeo.arkeo.ContractSettlement
+	(*ContractClaimResult)(nil),   // 11: arkeo.arkeo.ContractClaimResult
+	(*ContractPurgeResult)(nil),   // 12: arkeo.arkeo.ContractPurgeResult
+	(*ProviderAllowlist)(nil),     // 13: arkeo.arkeo.ProviderAllowlist
+	(*NetworkStats)(nil),          // 14: arkeo.arkeo.NetworkStats
+	(*v1beta1.Coin)(nil),          // 15: cosmos.base.v1beta1.Coin
 }
 var file_arkeo_arkeo_keeper_proto_depIdxs = []int32{
-	0, // 0: arkeo.arkeo.Provider.status:type_name -> arkeo.arkeo.ProviderStatus
-	8, // 1: arkeo.arkeo.Provider.subscription_rate:type_name -> cosmos.base.v1beta1.Coin
-	8, // 2: arkeo.arkeo.Provider.pay_as_you_go_rate:type_name -> cosmos.base.v1beta1.Coin
-	1, // 3: arkeo.arkeo.Contract.type:type_name -> arkeo.arkeo.ContractType
-	8, // 4: arkeo.arkeo.Contract.rate:type_name -> cosmos.base.v1beta1.Coin
-	2, // 5: arkeo.arkeo.Contract.authorization:type_name -> arkeo.arkeo.ContractAuthorization
-	5, // 6: arkeo.arkeo.ContractExpirationSet.contract_set:type_name -> arkeo.arkeo.ContractSet
-	5, // 7: arkeo.arkeo.UserContractSet.contract_set:type_name -> arkeo.arkeo.ContractSet
-	8, // [8:8] is the sub-list for method output_type
-	8, // [8:8] is the sub-list for method input_type
-	8, // [8:8] is the sub-list for extension type_name
-	8, // [8:8] is the sub-list for extension extendee
-	0, // [0:8] is the sub-list for field type_name
+	0,  // 0: arkeo.arkeo.Provider.status:type_name -> arkeo.arkeo.ProviderStatus
+	15, // 1: arkeo.arkeo.Provider.subscription_rate:type_name -> cosmos.base.v1beta1.Coin
+	15, // 2: arkeo.arkeo.Provider.pay_as_you_go_rate:type_name -> cosmos.base.v1beta1.Coin
+	1,  // 3: arkeo.arkeo.Contract.type:type_name -> arkeo.arkeo.ContractType
+	15, // 4: arkeo.arkeo.Contract.rate:type_name -> cosmos.base.v1beta1.Coin
+	2,  // 5: arkeo.arkeo.Contract.authorization:type_name -> arkeo.arkeo.ContractAuthorization
+	6,  // 6: arkeo.arkeo.ContractExpirationSet.contract_set:type_name -> arkeo.arkeo.ContractSet
+	6,  // 7: arkeo.arkeo.UserContractSet.contract_set:type_name -> arkeo.arkeo.ContractSet
+	15, // 8: arkeo.arkeo.ProviderEarnings.income:type_name -> cosmos.base.v1beta1.Coin
+	15, // 9: arkeo.arkeo.ProviderEarnings.escrowed:type_name -> cosmos.base.v1beta1.Coin
+	15, // 10: arkeo.arkeo.ContractSettlement.owed:type_name -> cosmos.base.v1beta1.Coin
+	15, // 11: arkeo.arkeo.ContractSettlement.provider_income:type_name -> cosmos.base.v1beta1.Coin
+	15, // 12: arkeo.arkeo.ContractSettlement.reserve_tax:type_name -> cosmos.base.v1beta1.Coin
+	15, // 13: arkeo.arkeo.ContractSettlement.refund:type_name -> cosmos.base.v1beta1.Coin
+	15, // 14: arkeo.arkeo.ContractSettlement.unpaid:type_name -> cosmos.base.v1beta1.Coin
+	15, // 15: arkeo.arkeo.ContractSettlement.dust:type_name -> cosmos.base.v1beta1.Coin
+	15, // 16: arkeo.arkeo.NetworkStats.bonded:type_name -> cosmos.base.v1beta1.Coin
+	15, // 17: arkeo.arkeo.NetworkStats.escrowed:type_name -> cosmos.base.v1beta1.Coin
+	18, // [18:18] is the sub-list for method output_type
+	18, // [18:18] is the sub-list for method input_type
+	18, // [18:18] is the sub-list for extension type_name
+	18, // [18:18] is the sub-list for extension extendee
+	0,  // [0:18] is the sub-list for field type_name
 }
 
 func init() { file_arkeo_arkeo_keeper_proto_init() }
@@ -4745,14 +10100,86 @@ func file_arkeo_arkeo_keeper_proto_init() {
 				return nil
 			}
 		}
+		file_arkeo_arkeo_keeper_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProviderEarnings); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_arkeo_arkeo_keeper_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContractSettlement); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_arkeo_arkeo_keeper_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContractClaimResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_arkeo_arkeo_keeper_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContractPurgeResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_arkeo_arkeo_keeper_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProviderAllowlist); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_arkeo_arkeo_keeper_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NetworkStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_arkeo_arkeo_keeper_proto_rawDesc,
-			NumEnums:      3,
-			NumMessages:   5,
+			NumEnums:      4,
+			NumMessages:   11,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -15,31 +15,150 @@ import (
 	sync "sync"
 )
 
+var _ protoreflect.List = (*_Params_13_list)(nil)
+
+type _Params_13_list struct {
+	list *[]string
+}
+
+func (x *_Params_13_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_13_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_Params_13_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_13_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_13_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Params at list field AllowedDenoms as it is not of Message kind"))
+}
+
+func (x *_Params_13_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_13_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_Params_13_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Params_20_list)(nil)
+
+type _Params_20_list struct {
+	list *[]*ServiceMinBond
+}
+
+func (x *_Params_20_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_20_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Params_20_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ServiceMinBond)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_20_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ServiceMinBond)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_20_list) AppendMutable() protoreflect.Value {
+	v := new(ServiceMinBond)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_20_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_20_list) NewElement() protoreflect.Value {
+	v := new(ServiceMinBond)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_20_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_Params                             protoreflect.MessageDescriptor
-	fd_Params_community_pool_percentage   protoreflect.FieldDescriptor
-	fd_Params_dev_fund_percentage         protoreflect.FieldDescriptor
-	fd_Params_grant_fund_percentage       protoreflect.FieldDescriptor
-	fd_Params_inflation_change_percentage protoreflect.FieldDescriptor
-	fd_Params_inflation_min               protoreflect.FieldDescriptor
-	fd_Params_inflation_max               protoreflect.FieldDescriptor
-	fd_Params_goal_bonded                 protoreflect.FieldDescriptor
-	fd_Params_block_per_year              protoreflect.FieldDescriptor
-	fd_Params_emission_curve              protoreflect.FieldDescriptor
+	md_Params                                 protoreflect.MessageDescriptor
+	fd_Params_block_per_year                  protoreflect.FieldDescriptor
+	fd_Params_emission_curve                  protoreflect.FieldDescriptor
+	fd_Params_settlement_grace_period         protoreflect.FieldDescriptor
+	fd_Params_slash_fraction                  protoreflect.FieldDescriptor
+	fd_Params_slash_escalation                protoreflect.FieldDescriptor
+	fd_Params_allowed_denoms                  protoreflect.FieldDescriptor
+	fd_Params_max_open_contracts              protoreflect.FieldDescriptor
+	fd_Params_min_pay_as_you_go_deposit       protoreflect.FieldDescriptor
+	fd_Params_deposit_refund_tolerance        protoreflect.FieldDescriptor
+	fd_Params_max_claim_batch_size            protoreflect.FieldDescriptor
+	fd_Params_max_metadata_uri_length         protoreflect.FieldDescriptor
+	fd_Params_min_provider_bond               protoreflect.FieldDescriptor
+	fd_Params_service_min_bonds               protoreflect.FieldDescriptor
+	fd_Params_contract_dormancy_period        protoreflect.FieldDescriptor
+	fd_Params_purge_reward                    protoreflect.FieldDescriptor
+	fd_Params_max_contract_start_delay        protoreflect.FieldDescriptor
+	fd_Params_max_allowlist_size              protoreflect.FieldDescriptor
+	fd_Params_early_close_compensation        protoreflect.FieldDescriptor
+	fd_Params_early_close_min_period          protoreflect.FieldDescriptor
+	fd_Params_provider_close_contract_penalty protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_arkeo_arkeo_params_proto_init()
 	md_Params = File_arkeo_arkeo_params_proto.Messages().ByName("Params")
-	fd_Params_community_pool_percentage = md_Params.Fields().ByName("community_pool_percentage")
-	fd_Params_dev_fund_percentage = md_Params.Fields().ByName("dev_fund_percentage")
-	fd_Params_grant_fund_percentage = md_Params.Fields().ByName("grant_fund_percentage")
-	fd_Params_inflation_change_percentage = md_Params.Fields().ByName("inflation_change_percentage")
-	fd_Params_inflation_min = md_Params.Fields().ByName("inflation_min")
-	fd_Params_inflation_max = md_Params.Fields().ByName("inflation_max")
-	fd_Params_goal_bonded = md_Params.Fields().ByName("goal_bonded")
 	fd_Params_block_per_year = md_Params.Fields().ByName("block_per_year")
 	fd_Params_emission_curve = md_Params.Fields().ByName("emission_curve")
+	fd_Params_settlement_grace_period = md_Params.Fields().ByName("settlement_grace_period")
+	fd_Params_slash_fraction = md_Params.Fields().ByName("slash_fraction")
+	fd_Params_slash_escalation = md_Params.Fields().ByName("slash_escalation")
+	fd_Params_allowed_denoms = md_Params.Fields().ByName("allowed_denoms")
+	fd_Params_max_open_contracts = md_Params.Fields().ByName("max_open_contracts")
+	fd_Params_min_pay_as_you_go_deposit = md_Params.Fields().ByName("min_pay_as_you_go_deposit")
+	fd_Params_deposit_refund_tolerance = md_Params.Fields().ByName("deposit_refund_tolerance")
+	fd_Params_max_claim_batch_size = md_Params.Fields().ByName("max_claim_batch_size")
+	fd_Params_max_metadata_uri_length = md_Params.Fields().ByName("max_metadata_uri_length")
+	fd_Params_min_provider_bond = md_Params.Fields().ByName("min_provider_bond")
+	fd_Params_service_min_bonds = md_Params.Fields().ByName("service_min_bonds")
+	fd_Params_contract_dormancy_period = md_Params.Fields().ByName("contract_dormancy_period")
+	fd_Params_purge_reward = md_Params.Fields().ByName("purge_reward")
+	fd_Params_max_contract_start_delay = md_Params.Fields().ByName("max_contract_start_delay")
+	fd_Params_max_allowlist_size = md_Params.Fields().ByName("max_allowlist_size")
+	fd_Params_early_close_compensation = md_Params.Fields().ByName("early_close_compensation")
+	fd_Params_early_close_min_period = md_Params.Fields().ByName("early_close_min_period")
+	fd_Params_provider_close_contract_penalty = md_Params.Fields().ByName("provider_close_contract_penalty")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -107,57 +226,123 @@ func (x *fastReflection_Params) Interface() protoreflect.ProtoMessage {
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
 func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.CommunityPoolPercentage != "" {
-		value := protoreflect.ValueOfString(x.CommunityPoolPercentage)
-		if !f(fd_Params_community_pool_percentage, value) {
+	if x.BlockPerYear != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BlockPerYear)
+		if !f(fd_Params_block_per_year, value) {
 			return
 		}
 	}
-	if x.DevFundPercentage != "" {
-		value := protoreflect.ValueOfString(x.DevFundPercentage)
-		if !f(fd_Params_dev_fund_percentage, value) {
+	if x.EmissionCurve != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.EmissionCurve)
+		if !f(fd_Params_emission_curve, value) {
 			return
 		}
 	}
-	if x.GrantFundPercentage != "" {
-		value := protoreflect.ValueOfString(x.GrantFundPercentage)
-		if !f(fd_Params_grant_fund_percentage, value) {
+	if x.SettlementGracePeriod != int64(0) {
+		value := protoreflect.ValueOfInt64(x.SettlementGracePeriod)
+		if !f(fd_Params_settlement_grace_period, value) {
 			return
 		}
 	}
-	if x.InflationChangePercentage != "" {
-		value := protoreflect.ValueOfString(x.InflationChangePercentage)
-		if !f(fd_Params_inflation_change_percentage, value) {
+	if x.SlashFraction != int64(0) {
+		value := protoreflect.ValueOfInt64(x.SlashFraction)
+		if !f(fd_Params_slash_fraction, value) {
 			return
 		}
 	}
-	if x.InflationMin != "" {
-		value := protoreflect.ValueOfString(x.InflationMin)
-		if !f(fd_Params_inflation_min, value) {
+	if x.SlashEscalation != int64(0) {
+		value := protoreflect.ValueOfInt64(x.SlashEscalation)
+		if !f(fd_Params_slash_escalation, value) {
 			return
 		}
 	}
-	if x.InflationMax != "" {
-		value := protoreflect.ValueOfString(x.InflationMax)
-		if !f(fd_Params_inflation_max, value) {
+	if len(x.AllowedDenoms) != 0 {
+		value := protoreflect.ValueOfList(&_Params_13_list{list: &x.AllowedDenoms})
+		if !f(fd_Params_allowed_denoms, value) {
 			return
 		}
 	}
-	if x.GoalBonded != "" {
-		value := protoreflect.ValueOfString(x.GoalBonded)
-		if !f(fd_Params_goal_bonded, value) {
+	if x.MaxOpenContracts != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxOpenContracts)
+		if !f(fd_Params_max_open_contracts, value) {
 			return
 		}
 	}
-	if x.BlockPerYear != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.BlockPerYear)
-		if !f(fd_Params_block_per_year, value) {
+	if x.MinPayAsYouGoDeposit != int64(0) {
+		value := protoreflect.ValueOfInt64(x.MinPayAsYouGoDeposit)
+		if !f(fd_Params_min_pay_as_you_go_deposit, value) {
 			return
 		}
 	}
-	if x.EmissionCurve != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.EmissionCurve)
-		if !f(fd_Params_emission_curve, value) {
+	if x.DepositRefundTolerance != int64(0) {
+		value := protoreflect.ValueOfInt64(x.DepositRefundTolerance)
+		if !f(fd_Params_deposit_refund_tolerance, value) {
+			return
+		}
+	}
+	if x.MaxClaimBatchSize != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxClaimBatchSize)
+		if !f(fd_Params_max_claim_batch_size, value) {
+			return
+		}
+	}
+	if x.MaxMetadataUriLength != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxMetadataUriLength)
+		if !f(fd_Params_max_metadata_uri_length, value) {
+			return
+		}
+	}
+	if x.MinProviderBond != int64(0) {
+		value := protoreflect.ValueOfInt64(x.MinProviderBond)
+		if !f(fd_Params_min_provider_bond, value) {
+			return
+		}
+	}
+	if len(x.ServiceMinBonds) != 0 {
+		value := protoreflect.ValueOfList(&_Params_20_list{list: &x.ServiceMinBonds})
+		if !f(fd_Params_service_min_bonds, value) {
+			return
+		}
+	}
+	if x.ContractDormancyPeriod != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ContractDormancyPeriod)
+		if !f(fd_Params_contract_dormancy_period, value) {
+			return
+		}
+	}
+	if x.PurgeReward != int64(0) {
+		value := protoreflect.ValueOfInt64(x.PurgeReward)
+		if !f(fd_Params_purge_reward, value) {
+			return
+		}
+	}
+	if x.MaxContractStartDelay != int64(0) {
+		value := protoreflect.ValueOfInt64(x.MaxContractStartDelay)
+		if !f(fd_Params_max_contract_start_delay, value) {
+			return
+		}
+	}
+	if x.MaxAllowlistSize != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxAllowlistSize)
+		if !f(fd_Params_max_allowlist_size, value) {
+			return
+		}
+	}
+	if x.EarlyCloseCompensation != int64(0) {
+		value := protoreflect.ValueOfInt64(x.EarlyCloseCompensation)
+		if !f(fd_Params_early_close_compensation, value) {
+			return
+		}
+	}
+	if x.EarlyCloseMinPeriod != int64(0) {
+		value := protoreflect.ValueOfInt64(x.EarlyCloseMinPeriod)
+		if !f(fd_Params_early_close_min_period, value) {
+			return
+		}
+	}
+	if x.ProviderCloseContractPenalty != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ProviderCloseContractPenalty)
+		if !f(fd_Params_provider_close_contract_penalty, value) {
 			return
 		}
 	}
@@ -176,24 +361,46 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 // a repeated field is populated if it is non-empty.
 func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "arkeo.arkeo.Params.community_pool_percentage":
-		return x.CommunityPoolPercentage != ""
-	case "arkeo.arkeo.Params.dev_fund_percentage":
-		return x.DevFundPercentage != ""
-	case "arkeo.arkeo.Params.grant_fund_percentage":
-		return x.GrantFundPercentage != ""
-	case "arkeo.arkeo.Params.inflation_change_percentage":
-		return x.InflationChangePercentage != ""
-	case "arkeo.arkeo.Params.inflation_min":
-		return x.InflationMin != ""
-	case "arkeo.arkeo.Params.inflation_max":
-		return x.InflationMax != ""
-	case "arkeo.arkeo.Params.goal_bonded":
-		return x.GoalBonded != ""
 	case "arkeo.arkeo.Params.block_per_year":
 		return x.BlockPerYear != uint64(0)
 	case "arkeo.arkeo.Params.emission_curve":
 		return x.EmissionCurve != uint64(0)
+	case "arkeo.arkeo.Params.settlement_grace_period":
+		return x.SettlementGracePeriod != int64(0)
+	case "arkeo.arkeo.Params.slash_fraction":
+		return x.SlashFraction != int64(0)
+	case "arkeo.arkeo.Params.slash_escalation":
+		return x.SlashEscalation != int64(0)
+	case "arkeo.arkeo.Params.allowed_denoms":
+		return len(x.AllowedDenoms) != 0
+	case "arkeo.arkeo.Params.max_open_contracts":
+		return x.MaxOpenContracts != uint64(0)
+	case "arkeo.arkeo.Params.min_pay_as_you_go_deposit":
+		return x.MinPayAsYouGoDeposit != int64(0)
+	case "arkeo.arkeo.Params.deposit_refund_tolerance":
+		return x.DepositRefundTolerance != int64(0)
+	case "arkeo.arkeo.Params.max_claim_batch_size":
+		return x.MaxClaimBatchSize != uint64(0)
+	case "arkeo.arkeo.Params.max_metadata_uri_length":
+		return x.MaxMetadataUriLength != uint64(0)
+	case "arkeo.arkeo.Params.min_provider_bond":
+		return x.MinProviderBond != int64(0)
+	case "arkeo.arkeo.Params.service_min_bonds":
+		return len(x.ServiceMinBonds) != 0
+	case "arkeo.arkeo.Params.contract_dormancy_period":
+		return x.ContractDormancyPeriod != int64(0)
+	case "arkeo.arkeo.Params.purge_reward":
+		return x.PurgeReward != int64(0)
+	case "arkeo.arkeo.Params.max_contract_start_delay":
+		return x.MaxContractStartDelay != int64(0)
+	case "arkeo.arkeo.Params.max_allowlist_size":
+		return x.MaxAllowlistSize != uint64(0)
+	case "arkeo.arkeo.Params.early_close_compensation":
+		return x.EarlyCloseCompensation != int64(0)
+	case "arkeo.arkeo.Params.early_close_min_period":
+		return x.EarlyCloseMinPeriod != int64(0)
+	case "arkeo.arkeo.Params.provider_close_contract_penalty":
+		return x.ProviderCloseContractPenalty != int64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.Params"))
@@ -210,24 +417,46 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 // Clear is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "arkeo.arkeo.Params.community_pool_percentage":
-		x.CommunityPoolPercentage = ""
-	case "arkeo.arkeo.Params.dev_fund_percentage":
-		x.DevFundPercentage = ""
-	case "arkeo.arkeo.Params.grant_fund_percentage":
-		x.GrantFundPercentage = ""
-	case "arkeo.arkeo.Params.inflation_change_percentage":
-		x.InflationChangePercentage = ""
-	case "arkeo.arkeo.Params.inflation_min":
-		x.InflationMin = ""
-	case "arkeo.arkeo.Params.inflation_max":
-		x.InflationMax = ""
-	case "arkeo.arkeo.Params.goal_bonded":
-		x.GoalBonded = ""
 	case "arkeo.arkeo.Params.block_per_year":
 		x.BlockPerYear = uint64(0)
 	case "arkeo.arkeo.Params.emission_curve":
 		x.EmissionCurve = uint64(0)
+	case "arkeo.arkeo.Params.settlement_grace_period":
+		x.SettlementGracePeriod = int64(0)
+	case "arkeo.arkeo.Params.slash_fraction":
+		x.SlashFraction = int64(0)
+	case "arkeo.arkeo.Params.slash_escalation":
+		x.SlashEscalation = int64(0)
+	case "arkeo.arkeo.Params.allowed_denoms":
+		x.AllowedDenoms = nil
+	case "arkeo.arkeo.Params.max_open_contracts":
+		x.MaxOpenContracts = uint64(0)
+	case "arkeo.arkeo.Params.min_pay_as_you_go_deposit":
+		x.MinPayAsYouGoDeposit = int64(0)
+	case "arkeo.arkeo.Params.deposit_refund_tolerance":
+		x.DepositRefundTolerance = int64(0)
+	case "arkeo.arkeo.Params.max_claim_batch_size":
+		x.MaxClaimBatchSize = uint64(0)
+	case "arkeo.arkeo.Params.max_metadata_uri_length":
+		x.MaxMetadataUriLength = uint64(0)
+	case "arkeo.arkeo.Params.min_provider_bond":
+		x.MinProviderBond = int64(0)
+	case "arkeo.arkeo.Params.service_min_bonds":
+		x.ServiceMinBonds = nil
+	case "arkeo.arkeo.Params.contract_dormancy_period":
+		x.ContractDormancyPeriod = int64(0)
+	case "arkeo.arkeo.Params.purge_reward":
+		x.PurgeReward = int64(0)
+	case "arkeo.arkeo.Params.max_contract_start_delay":
+		x.MaxContractStartDelay = int64(0)
+	case "arkeo.arkeo.Params.max_allowlist_size":
+		x.MaxAllowlistSize = uint64(0)
+	case "arkeo.arkeo.Params.early_close_compensation":
+		x.EarlyCloseCompensation = int64(0)
+	case "arkeo.arkeo.Params.early_close_min_period":
+		x.EarlyCloseMinPeriod = int64(0)
+	case "arkeo.arkeo.Params.provider_close_contract_penalty":
+		x.ProviderCloseContractPenalty = int64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.Params"))
@@ -244,33 +473,72 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 // of the value; to obtain a mutable reference, use Mutable.
 func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "arkeo.arkeo.Params.community_pool_percentage":
-		value := x.CommunityPoolPercentage
-		return protoreflect.ValueOfString(value)
-	case "arkeo.arkeo.Params.dev_fund_percentage":
-		value := x.DevFundPercentage
-		return protoreflect.ValueOfString(value)
-	case "arkeo.arkeo.Params.grant_fund_percentage":
-		value := x.GrantFundPercentage
-		return protoreflect.ValueOfString(value)
-	case "arkeo.arkeo.Params.inflation_change_percentage":
-		value := x.InflationChangePercentage
-		return protoreflect.ValueOfString(value)
-	case "arkeo.arkeo.Params.inflation_min":
-		value := x.InflationMin
-		return protoreflect.ValueOfString(value)
-	case "arkeo.arkeo.Params.inflation_max":
-		value := x.InflationMax
-		return protoreflect.ValueOfString(value)
-	case "arkeo.arkeo.Params.goal_bonded":
-		value := x.GoalBonded
-		return protoreflect.ValueOfString(value)
 	case "arkeo.arkeo.Params.block_per_year":
 		value := x.BlockPerYear
 		return protoreflect.ValueOfUint64(value)
 	case "arkeo.arkeo.Params.emission_curve":
 		value := x.EmissionCurve
 		return protoreflect.ValueOfUint64(value)
+	case "arkeo.arkeo.Params.settlement_grace_period":
+		value := x.SettlementGracePeriod
+		return protoreflect.ValueOfInt64(value)
+	case "arkeo.arkeo.Params.slash_fraction":
+		value := x.SlashFraction
+		return protoreflect.ValueOfInt64(value)
+	case "arkeo.arkeo.Params.slash_escalation":
+		value := x.SlashEscalation
+		return protoreflect.ValueOfInt64(value)
+	case "arkeo.arkeo.Params.allowed_denoms":
+		if len(x.AllowedDenoms) == 0 {
+			return protoreflect.ValueOfList(&_Params_13_list{})
+		}
+		listValue := &_Params_13_list{list: &x.AllowedDenoms}
+		return protoreflect.ValueOfList(listValue)
+	case "arkeo.arkeo.Params.max_open_contracts":
+		value := x.MaxOpenContracts
+		return protoreflect.ValueOfUint64(value)
+	case "arkeo.arkeo.Params.min_pay_as_you_go_deposit":
+		value := x.MinPayAsYouGoDeposit
+		return protoreflect.ValueOfInt64(value)
+	case "arkeo.arkeo.Params.deposit_refund_tolerance":
+		value := x.DepositRefundTolerance
+		return protoreflect.ValueOfInt64(value)
+	case "arkeo.arkeo.Params.max_claim_batch_size":
+		value := x.MaxClaimBatchSize
+		return protoreflect.ValueOfUint64(value)
+	case "arkeo.arkeo.Params.max_metadata_uri_length":
+		value := x.MaxMetadataUriLength
+		return protoreflect.ValueOfUint64(value)
+	case "arkeo.arkeo.Params.min_provider_bond":
+		value := x.MinProviderBond
+		return protoreflect.ValueOfInt64(value)
+	case "arkeo.arkeo.Params.service_min_bonds":
+		if len(x.ServiceMinBonds) == 0 {
+			return protoreflect.ValueOfList(&_Params_20_list{})
+		}
+		listValue := &_Params_20_list{list: &x.ServiceMinBonds}
+		return protoreflect.ValueOfList(listValue)
+	case "arkeo.arkeo.Params.contract_dormancy_period":
+		value := x.ContractDormancyPeriod
+		return protoreflect.ValueOfInt64(value)
+	case "arkeo.arkeo.Params.purge_reward":
+		value := x.PurgeReward
+		return protoreflect.ValueOfInt64(value)
+	case "arkeo.arkeo.Params.max_contract_start_delay":
+		value := x.MaxContractStartDelay
+		return protoreflect.ValueOfInt64(value)
+	case "arkeo.arkeo.Params.max_allowlist_size":
+		value := x.MaxAllowlistSize
+		return protoreflect.ValueOfUint64(value)
+	case "arkeo.arkeo.Params.early_close_compensation":
+		value := x.EarlyCloseCompensation
+		return protoreflect.ValueOfInt64(value)
+	case "arkeo.arkeo.Params.early_close_min_period":
+		value := x.EarlyCloseMinPeriod
+		return protoreflect.ValueOfInt64(value)
+	case "arkeo.arkeo.Params.provider_close_contract_penalty":
+		value := x.ProviderCloseContractPenalty
+		return protoreflect.ValueOfInt64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.Params"))
@@ -291,24 +559,50 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 // Set is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "arkeo.arkeo.Params.community_pool_percentage":
-		x.CommunityPoolPercentage = value.Interface().(string)
-	case "arkeo.arkeo.Params.dev_fund_percentage":
-		x.DevFundPercentage = value.Interface().(string)
-	case "arkeo.arkeo.Params.grant_fund_percentage":
-		x.GrantFundPercentage = value.Interface().(string)
-	case "arkeo.arkeo.Params.inflation_change_percentage":
-		x.InflationChangePercentage = value.Interface().(string)
-	case "arkeo.arkeo.Params.inflation_min":
-		x.InflationMin = value.Interface().(string)
-	case "arkeo.arkeo.Params.inflation_max":
-		x.InflationMax = value.Interface().(string)
-	case "arkeo.arkeo.Params.goal_bonded":
-		x.GoalBonded = value.Interface().(string)
 	case "arkeo.arkeo.Params.block_per_year":
 		x.BlockPerYear = value.Uint()
 	case "arkeo.arkeo.Params.emission_curve":
 		x.EmissionCurve = value.Uint()
+	case "arkeo.arkeo.Params.settlement_grace_period":
+		x.SettlementGracePeriod = value.Int()
+	case "arkeo.arkeo.Params.slash_fraction":
+		x.SlashFraction = value.Int()
+	case "arkeo.arkeo.Params.slash_escalation":
+		x.SlashEscalation = value.Int()
+	case "arkeo.arkeo.Params.allowed_denoms":
+		lv := value.List()
+		clv := lv.(*_Params_13_list)
+		x.AllowedDenoms = *clv.list
+	case "arkeo.arkeo.Params.max_open_contracts":
+		x.MaxOpenContracts = value.Uint()
+	case "arkeo.arkeo.Params.min_pay_as_you_go_deposit":
+		x.MinPayAsYouGoDeposit = value.Int()
+	case "arkeo.arkeo.Params.deposit_refund_tolerance":
+		x.DepositRefundTolerance = value.Int()
+	case "arkeo.arkeo.Params.max_claim_batch_size":
+		x.MaxClaimBatchSize = value.Uint()
+	case "arkeo.arkeo.Params.max_metadata_uri_length":
+		x.MaxMetadataUriLength = value.Uint()
+	case "arkeo.arkeo.Params.min_provider_bond":
+		x.MinProviderBond = value.Int()
+	case "arkeo.arkeo.Params.service_min_bonds":
+		lv := value.List()
+		clv := lv.(*_Params_20_list)
+		x.ServiceMinBonds = *clv.list
+	case "arkeo.arkeo.Params.contract_dormancy_period":
+		x.ContractDormancyPeriod = value.Int()
+	case "arkeo.arkeo.Params.purge_reward":
+		x.PurgeReward = value.Int()
+	case "arkeo.arkeo.Params.max_contract_start_delay":
+		x.MaxContractStartDelay = value.Int()
+	case "arkeo.arkeo.Params.max_allowlist_size":
+		x.MaxAllowlistSize = value.Uint()
+	case "arkeo.arkeo.Params.early_close_compensation":
+		x.EarlyCloseCompensation = value.Int()
+	case "arkeo.arkeo.Params.early_close_min_period":
+		x.EarlyCloseMinPeriod = value.Int()
+	case "arkeo.arkeo.Params.provider_close_contract_penalty":
+		x.ProviderCloseContractPenalty = value.Int()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.Params"))
@@ -329,24 +623,54 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "arkeo.arkeo.Params.community_pool_percentage":
-		panic(fmt.Errorf("field community_pool_percentage of message arkeo.arkeo.Params is not mutable"))
-	case "arkeo.arkeo.Params.dev_fund_percentage":
-		panic(fmt.Errorf("field dev_fund_percentage of message arkeo.arkeo.Params is not mutable"))
-	case "arkeo.arkeo.Params.grant_fund_percentage":
-		panic(fmt.Errorf("field grant_fund_percentage of message arkeo.arkeo.Params is not mutable"))
-	case "arkeo.arkeo.Params.inflation_change_percentage":
-		panic(fmt.Errorf("field inflation_change_percentage of message arkeo.arkeo.Params is not mutable"))
-	case "arkeo.arkeo.Params.inflation_min":
-		panic(fmt.Errorf("field inflation_min of message arkeo.arkeo.Params is not mutable"))
-	case "arkeo.arkeo.Params.inflation_max":
-		panic(fmt.Errorf("field inflation_max of message arkeo.arkeo.Params is not mutable"))
-	case "arkeo.arkeo.Params.goal_bonded":
-		panic(fmt.Errorf("field goal_bonded of message arkeo.arkeo.Params is not mutable"))
+	case "arkeo.arkeo.Params.allowed_denoms":
+		if x.AllowedDenoms == nil {
+			x.AllowedDenoms = []string{}
+		}
+		value := &_Params_13_list{list: &x.AllowedDenoms}
+		return protoreflect.ValueOfList(value)
+	case "arkeo.arkeo.Params.service_min_bonds":
+		if x.ServiceMinBonds == nil {
+			x.ServiceMinBonds = []*ServiceMinBond{}
+		}
+		value := &_Params_20_list{list: &x.ServiceMinBonds}
+		return protoreflect.ValueOfList(value)
 	case "arkeo.arkeo.Params.block_per_year":
 		panic(fmt.Errorf("field block_per_year of message arkeo.arkeo.Params is not mutable"))
 	case "arkeo.arkeo.Params.emission_curve":
 		panic(fmt.Errorf("field emission_curve of message arkeo.arkeo.Params is not mutable"))
+	case "arkeo.arkeo.Params.settlement_grace_period":
+		panic(fmt.Errorf("field settlement_grace_period of message arkeo.arkeo.Params is not mutable"))
+	case "arkeo.arkeo.Params.slash_fraction":
+		panic(fmt.Errorf("field slash_fraction of message arkeo.arkeo.Params is not mutable"))
+	case "arkeo.arkeo.Params.slash_escalation":
+		panic(fmt.Errorf("field slash_escalation of message arkeo.arkeo.Params is not mutable"))
+	case "arkeo.arkeo.Params.max_open_contracts":
+		panic(fmt.Errorf("field max_open_contracts of message arkeo.arkeo.Params is not mutable"))
+	case "arkeo.arkeo.Params.min_pay_as_you_go_deposit":
+		panic(fmt.Errorf("field min_pay_as_you_go_deposit of message arkeo.arkeo.Params is not mutable"))
+	case "arkeo.arkeo.Params.deposit_refund_tolerance":
+		panic(fmt.Errorf("field deposit_refund_tolerance of message arkeo.arkeo.Params is not mutable"))
+	case "arkeo.arkeo.Params.max_claim_batch_size":
+		panic(fmt.Errorf("field max_claim_batch_size of message arkeo.arkeo.Params is not mutable"))
+	case "arkeo.arkeo.Params.max_metadata_uri_length":
+		panic(fmt.Errorf("field max_metadata_uri_length of message arkeo.arkeo.Params is not mutable"))
+	case "arkeo.arkeo.Params.min_provider_bond":
+		panic(fmt.Errorf("field min_provider_bond of message arkeo.arkeo.Params is not mutable"))
+	case "arkeo.arkeo.Params.contract_dormancy_period":
+		panic(fmt.Errorf("field contract_dormancy_period of message arkeo.arkeo.Params is not mutable"))
+	case "arkeo.arkeo.Params.purge_reward":
+		panic(fmt.Errorf("field purge_reward of message arkeo.arkeo.Params is not mutable"))
+	case "arkeo.arkeo.Params.max_contract_start_delay":
+		panic(fmt.Errorf("field max_contract_start_delay of message arkeo.arkeo.Params is not mutable"))
+	case "arkeo.arkeo.Params.max_allowlist_size":
+		panic(fmt.Errorf("field max_allowlist_size of message arkeo.arkeo.Params is not mutable"))
+	case "arkeo.arkeo.Params.early_close_compensation":
+		panic(fmt.Errorf("field early_close_compensation of message arkeo.arkeo.Params is not mutable"))
+	case "arkeo.arkeo.Params.early_close_min_period":
+		panic(fmt.Errorf("field early_close_min_period of message arkeo.arkeo.Params is not mutable"))
+	case "arkeo.arkeo.Params.provider_close_contract_penalty":
+		panic(fmt.Errorf("field provider_close_contract_penalty of message arkeo.arkeo.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.Params"))
@@ -360,24 +684,48 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 // For lists, maps, and messages, this returns a new, empty, mutable value.
 func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "arkeo.arkeo.Params.community_pool_percentage":
-		return protoreflect.ValueOfString("")
-	case "arkeo.arkeo.Params.dev_fund_percentage":
-		return protoreflect.ValueOfString("")
-	case "arkeo.arkeo.Params.grant_fund_percentage":
-		return protoreflect.ValueOfString("")
-	case "arkeo.arkeo.Params.inflation_change_percentage":
-		return protoreflect.ValueOfString("")
-	case "arkeo.arkeo.Params.inflation_min":
-		return protoreflect.ValueOfString("")
-	case "arkeo.arkeo.Params.inflation_max":
-		return protoreflect.ValueOfString("")
-	case "arkeo.arkeo.Params.goal_bonded":
-		return protoreflect.ValueOfString("")
 	case "arkeo.arkeo.Params.block_per_year":
 		return protoreflect.ValueOfUint64(uint64(0))
 	case "arkeo.arkeo.Params.emission_curve":
 		return protoreflect.ValueOfUint64(uint64(0))
+	case "arkeo.arkeo.Params.settlement_grace_period":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "arkeo.arkeo.Params.slash_fraction":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "arkeo.arkeo.Params.slash_escalation":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "arkeo.arkeo.Params.allowed_denoms":
+		list := []string{}
+		return protoreflect.ValueOfList(&_Params_13_list{list: &list})
+	case "arkeo.arkeo.Params.max_open_contracts":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "arkeo.arkeo.Params.min_pay_as_you_go_deposit":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "arkeo.arkeo.Params.deposit_refund_tolerance":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "arkeo.arkeo.Params.max_claim_batch_size":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "arkeo.arkeo.Params.max_metadata_uri_length":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "arkeo.arkeo.Params.min_provider_bond":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "arkeo.arkeo.Params.service_min_bonds":
+		list := []*ServiceMinBond{}
+		return protoreflect.ValueOfList(&_Params_20_list{list: &list})
+	case "arkeo.arkeo.Params.contract_dormancy_period":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "arkeo.arkeo.Params.purge_reward":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "arkeo.arkeo.Params.max_contract_start_delay":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "arkeo.arkeo.Params.max_allowlist_size":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "arkeo.arkeo.Params.early_close_compensation":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "arkeo.arkeo.Params.early_close_min_period":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "arkeo.arkeo.Params.provider_close_contract_penalty":
+		return protoreflect.ValueOfInt64(int64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.Params"))
@@ -447,39 +795,71 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.CommunityPoolPercentage)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.BlockPerYear != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlockPerYear))
 		}
-		l = len(x.DevFundPercentage)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.EmissionCurve != 0 {
+			n += 1 + runtime.Sov(uint64(x.EmissionCurve))
 		}
-		l = len(x.GrantFundPercentage)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.SettlementGracePeriod != 0 {
+			n += 1 + runtime.Sov(uint64(x.SettlementGracePeriod))
 		}
-		l = len(x.InflationChangePercentage)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.SlashFraction != 0 {
+			n += 1 + runtime.Sov(uint64(x.SlashFraction))
 		}
-		l = len(x.InflationMin)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.SlashEscalation != 0 {
+			n += 1 + runtime.Sov(uint64(x.SlashEscalation))
 		}
-		l = len(x.InflationMax)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if len(x.AllowedDenoms) > 0 {
+			for _, s := range x.AllowedDenoms {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
-		l = len(x.GoalBonded)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.MaxOpenContracts != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxOpenContracts))
 		}
-		if x.BlockPerYear != 0 {
-			n += 1 + runtime.Sov(uint64(x.BlockPerYear))
+		if x.MinPayAsYouGoDeposit != 0 {
+			n += 1 + runtime.Sov(uint64(x.MinPayAsYouGoDeposit))
 		}
-		if x.EmissionCurve != 0 {
-			n += 1 + runtime.Sov(uint64(x.EmissionCurve))
+		if x.DepositRefundTolerance != 0 {
+			n += 2 + runtime.Sov(uint64(x.DepositRefundTolerance))
+		}
+		if x.MaxClaimBatchSize != 0 {
+			n += 2 + runtime.Sov(uint64(x.MaxClaimBatchSize))
+		}
+		if x.MaxMetadataUriLength != 0 {
+			n += 2 + runtime.Sov(uint64(x.MaxMetadataUriLength))
+		}
+		if x.MinProviderBond != 0 {
+			n += 2 + runtime.Sov(uint64(x.MinProviderBond))
+		}
+		if len(x.ServiceMinBonds) > 0 {
+			for _, e := range x.ServiceMinBonds {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.ContractDormancyPeriod != 0 {
+			n += 2 + runtime.Sov(uint64(x.ContractDormancyPeriod))
+		}
+		if x.PurgeReward != 0 {
+			n += 2 + runtime.Sov(uint64(x.PurgeReward))
+		}
+		if x.MaxContractStartDelay != 0 {
+			n += 2 + runtime.Sov(uint64(x.MaxContractStartDelay))
+		}
+		if x.MaxAllowlistSize != 0 {
+			n += 2 + runtime.Sov(uint64(x.MaxAllowlistSize))
+		}
+		if x.EarlyCloseCompensation != 0 {
+			n += 2 + runtime.Sov(uint64(x.EarlyCloseCompensation))
+		}
+		if x.EarlyCloseMinPeriod != 0 {
+			n += 2 + runtime.Sov(uint64(x.EarlyCloseMinPeriod))
+		}
+		if x.ProviderCloseContractPenalty != 0 {
+			n += 2 + runtime.Sov(uint64(x.ProviderCloseContractPenalty))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -510,64 +890,144 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.EmissionCurve != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.EmissionCurve))
+		if x.ProviderCloseContractPenalty != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ProviderCloseContractPenalty))
 			i--
-			dAtA[i] = 0x48
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xd8
 		}
-		if x.BlockPerYear != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockPerYear))
+		if x.EarlyCloseMinPeriod != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.EarlyCloseMinPeriod))
 			i--
-			dAtA[i] = 0x40
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xd0
+		}
+		if x.EarlyCloseCompensation != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.EarlyCloseCompensation))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc8
+		}
+		if x.MaxAllowlistSize != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxAllowlistSize))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc0
+		}
+		if x.MaxContractStartDelay != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxContractStartDelay))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb8
 		}
-		if len(x.GoalBonded) > 0 {
-			i -= len(x.GoalBonded)
-			copy(dAtA[i:], x.GoalBonded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.GoalBonded)))
+		if x.PurgeReward != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PurgeReward))
 			i--
-			dAtA[i] = 0x3a
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb0
 		}
-		if len(x.InflationMax) > 0 {
-			i -= len(x.InflationMax)
-			copy(dAtA[i:], x.InflationMax)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.InflationMax)))
+		if x.ContractDormancyPeriod != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ContractDormancyPeriod))
+			i--
+			dAtA[i] = 0x1
 			i--
-			dAtA[i] = 0x32
+			dAtA[i] = 0xa8
+		}
+		if len(x.ServiceMinBonds) > 0 {
+			for iNdEx := len(x.ServiceMinBonds) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ServiceMinBonds[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0xa2
+			}
 		}
-		if len(x.InflationMin) > 0 {
-			i -= len(x.InflationMin)
-			copy(dAtA[i:], x.InflationMin)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.InflationMin)))
+		if x.MinProviderBond != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MinProviderBond))
 			i--
-			dAtA[i] = 0x2a
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x98
 		}
-		if len(x.InflationChangePercentage) > 0 {
-			i -= len(x.InflationChangePercentage)
-			copy(dAtA[i:], x.InflationChangePercentage)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.InflationChangePercentage)))
+		if x.MaxMetadataUriLength != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxMetadataUriLength))
+			i--
+			dAtA[i] = 0x1
 			i--
-			dAtA[i] = 0x22
+			dAtA[i] = 0x90
 		}
-		if len(x.GrantFundPercentage) > 0 {
-			i -= len(x.GrantFundPercentage)
-			copy(dAtA[i:], x.GrantFundPercentage)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.GrantFundPercentage)))
+		if x.MaxClaimBatchSize != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxClaimBatchSize))
 			i--
-			dAtA[i] = 0x1a
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x88
 		}
-		if len(x.DevFundPercentage) > 0 {
-			i -= len(x.DevFundPercentage)
-			copy(dAtA[i:], x.DevFundPercentage)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DevFundPercentage)))
+		if x.DepositRefundTolerance != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DepositRefundTolerance))
+			i--
+			dAtA[i] = 0x1
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x80
 		}
-		if len(x.CommunityPoolPercentage) > 0 {
-			i -= len(x.CommunityPoolPercentage)
-			copy(dAtA[i:], x.CommunityPoolPercentage)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CommunityPoolPercentage)))
+		if x.MinPayAsYouGoDeposit != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MinPayAsYouGoDeposit))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x78
+		}
+		if x.MaxOpenContracts != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxOpenContracts))
+			i--
+			dAtA[i] = 0x70
+		}
+		if len(x.AllowedDenoms) > 0 {
+			for iNdEx := len(x.AllowedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.AllowedDenoms[iNdEx])
+				copy(dAtA[i:], x.AllowedDenoms[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AllowedDenoms[iNdEx])))
+				i--
+				dAtA[i] = 0x6a
+			}
+		}
+		if x.SlashEscalation != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SlashEscalation))
+			i--
+			dAtA[i] = 0x60
+		}
+		if x.SlashFraction != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SlashFraction))
+			i--
+			dAtA[i] = 0x58
+		}
+		if x.SettlementGracePeriod != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SettlementGracePeriod))
+			i--
+			dAtA[i] = 0x50
+		}
+		if x.EmissionCurve != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.EmissionCurve))
+			i--
+			dAtA[i] = 0x48
+		}
+		if x.BlockPerYear != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockPerYear))
+			i--
+			dAtA[i] = 0x40
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -618,11 +1078,11 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
-			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CommunityPoolPercentage", wireType)
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockPerYear", wireType)
 				}
-				var stringLen uint64
+				x.BlockPerYear = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -632,29 +1092,16 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					x.BlockPerYear |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.CommunityPoolPercentage = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DevFundPercentage", wireType)
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EmissionCurve", wireType)
 				}
-				var stringLen uint64
+				x.EmissionCurve = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -664,29 +1111,16 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					x.EmissionCurve |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.DevFundPercentage = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GrantFundPercentage", wireType)
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SettlementGracePeriod", wireType)
 				}
-				var stringLen uint64
+				x.SettlementGracePeriod = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -696,29 +1130,54 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					x.SettlementGracePeriod |= int64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.GrantFundPercentage = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 4:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InflationChangePercentage", wireType)
+			case 11:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SlashFraction", wireType)
 				}
-				var stringLen uint64
+				x.SlashFraction = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SlashFraction |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 12:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SlashEscalation", wireType)
+				}
+				x.SlashEscalation = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SlashEscalation |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 13:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AllowedDenoms", wireType)
+				}
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -741,48 +1200,1193 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if postIndex > l {
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AllowedDenoms = append(x.AllowedDenoms, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 14:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxOpenContracts", wireType)
+				}
+				x.MaxOpenContracts = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxOpenContracts |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 15:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinPayAsYouGoDeposit", wireType)
+				}
+				x.MinPayAsYouGoDeposit = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MinPayAsYouGoDeposit |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 16:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DepositRefundTolerance", wireType)
+				}
+				x.DepositRefundTolerance = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DepositRefundTolerance |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 17:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxClaimBatchSize", wireType)
+				}
+				x.MaxClaimBatchSize = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxClaimBatchSize |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 18:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxMetadataUriLength", wireType)
+				}
+				x.MaxMetadataUriLength = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxMetadataUriLength |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 19:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinProviderBond", wireType)
+				}
+				x.MinProviderBond = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MinProviderBond |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 20:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ServiceMinBonds", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ServiceMinBonds = append(x.ServiceMinBonds, &ServiceMinBond{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ServiceMinBonds[len(x.ServiceMinBonds)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 21:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ContractDormancyPeriod", wireType)
+				}
+				x.ContractDormancyPeriod = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ContractDormancyPeriod |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 22:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PurgeReward", wireType)
+				}
+				x.PurgeReward = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PurgeReward |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 23:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxContractStartDelay", wireType)
+				}
+				x.MaxContractStartDelay = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxContractStartDelay |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 24:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxAllowlistSize", wireType)
+				}
+				x.MaxAllowlistSize = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxAllowlistSize |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 25:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EarlyCloseCompensation", wireType)
+				}
+				x.EarlyCloseCompensation = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.EarlyCloseCompensation |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 26:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EarlyCloseMinPeriod", wireType)
+				}
+				x.EarlyCloseMinPeriod = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.EarlyCloseMinPeriod |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 27:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProviderCloseContractPenalty", wireType)
+				}
+				x.ProviderCloseContractPenalty = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ProviderCloseContractPenalty |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_ServiceMinBond          protoreflect.MessageDescriptor
+	fd_ServiceMinBond_service  protoreflect.FieldDescriptor
+	fd_ServiceMinBond_min_bond protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_arkeo_arkeo_params_proto_init()
+	md_ServiceMinBond = File_arkeo_arkeo_params_proto.Messages().ByName("ServiceMinBond")
+	fd_ServiceMinBond_service = md_ServiceMinBond.Fields().ByName("service")
+	fd_ServiceMinBond_min_bond = md_ServiceMinBond.Fields().ByName("min_bond")
+}
+
+var _ protoreflect.Message = (*fastReflection_ServiceMinBond)(nil)
+
+type fastReflection_ServiceMinBond ServiceMinBond
+
+func (x *ServiceMinBond) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ServiceMinBond)(x)
+}
+
+func (x *ServiceMinBond) slowProtoReflect() protoreflect.Message {
+	mi := &file_arkeo_arkeo_params_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ServiceMinBond_messageType fastReflection_ServiceMinBond_messageType
+var _ protoreflect.MessageType = fastReflection_ServiceMinBond_messageType{}
+
+type fastReflection_ServiceMinBond_messageType struct{}
+
+func (x fastReflection_ServiceMinBond_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ServiceMinBond)(nil)
+}
+func (x fastReflection_ServiceMinBond_messageType) New() protoreflect.Message {
+	return new(fastReflection_ServiceMinBond)
+}
+func (x fastReflection_ServiceMinBond_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ServiceMinBond
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ServiceMinBond) Descriptor() protoreflect.MessageDescriptor {
+	return md_ServiceMinBond
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ServiceMinBond) Type() protoreflect.MessageType {
+	return _fastReflection_ServiceMinBond_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ServiceMinBond) New() protoreflect.Message {
+	return new(fastReflection_ServiceMinBond)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ServiceMinBond) Interface() protoreflect.ProtoMessage {
+	return (*ServiceMinBond)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ServiceMinBond) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Service != "" {
+		value := protoreflect.ValueOfString(x.Service)
+		if !f(fd_ServiceMinBond_service, value) {
+			return
+		}
+	}
+	if x.MinBond != int64(0) {
+		value := protoreflect.ValueOfInt64(x.MinBond)
+		if !f(fd_ServiceMinBond_min_bond, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ServiceMinBond) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "arkeo.arkeo.ServiceMinBond.service":
+		return x.Service != ""
+	case "arkeo.arkeo.ServiceMinBond.min_bond":
+		return x.MinBond != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.ServiceMinBond"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.ServiceMinBond does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ServiceMinBond) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "arkeo.arkeo.ServiceMinBond.service":
+		x.Service = ""
+	case "arkeo.arkeo.ServiceMinBond.min_bond":
+		x.MinBond = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.ServiceMinBond"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.ServiceMinBond does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ServiceMinBond) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "arkeo.arkeo.ServiceMinBond.service":
+		value := x.Service
+		return protoreflect.ValueOfString(value)
+	case "arkeo.arkeo.ServiceMinBond.min_bond":
+		value := x.MinBond
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.ServiceMinBond"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.ServiceMinBond does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ServiceMinBond) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "arkeo.arkeo.ServiceMinBond.service":
+		x.Service = value.Interface().(string)
+	case "arkeo.arkeo.ServiceMinBond.min_bond":
+		x.MinBond = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.ServiceMinBond"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.ServiceMinBond does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ServiceMinBond) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "arkeo.arkeo.ServiceMinBond.service":
+		panic(fmt.Errorf("field service of message arkeo.arkeo.ServiceMinBond is not mutable"))
+	case "arkeo.arkeo.ServiceMinBond.min_bond":
+		panic(fmt.Errorf("field min_bond of message arkeo.arkeo.ServiceMinBond is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.ServiceMinBond"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.ServiceMinBond does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ServiceMinBond) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "arkeo.arkeo.ServiceMinBond.service":
+		return protoreflect.ValueOfString("")
+	case "arkeo.arkeo.ServiceMinBond.min_bond":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.ServiceMinBond"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.ServiceMinBond does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ServiceMinBond) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in arkeo.arkeo.ServiceMinBond", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ServiceMinBond) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ServiceMinBond) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ServiceMinBond) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ServiceMinBond) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ServiceMinBond)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Service)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.MinBond != 0 {
+			n += 1 + runtime.Sov(uint64(x.MinBond))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ServiceMinBond)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.MinBond != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MinBond))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Service) > 0 {
+			i -= len(x.Service)
+			copy(dAtA[i:], x.Service)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Service)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ServiceMinBond)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ServiceMinBond: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ServiceMinBond: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Service = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinBond", wireType)
+				}
+				x.MinBond = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MinBond |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_ParamsRecord                    protoreflect.MessageDescriptor
+	fd_ParamsRecord_params             protoreflect.FieldDescriptor
+	fd_ParamsRecord_last_change_height protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_arkeo_arkeo_params_proto_init()
+	md_ParamsRecord = File_arkeo_arkeo_params_proto.Messages().ByName("ParamsRecord")
+	fd_ParamsRecord_params = md_ParamsRecord.Fields().ByName("params")
+	fd_ParamsRecord_last_change_height = md_ParamsRecord.Fields().ByName("last_change_height")
+}
+
+var _ protoreflect.Message = (*fastReflection_ParamsRecord)(nil)
+
+type fastReflection_ParamsRecord ParamsRecord
+
+func (x *ParamsRecord) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ParamsRecord)(x)
+}
+
+func (x *ParamsRecord) slowProtoReflect() protoreflect.Message {
+	mi := &file_arkeo_arkeo_params_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ParamsRecord_messageType fastReflection_ParamsRecord_messageType
+var _ protoreflect.MessageType = fastReflection_ParamsRecord_messageType{}
+
+type fastReflection_ParamsRecord_messageType struct{}
+
+func (x fastReflection_ParamsRecord_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ParamsRecord)(nil)
+}
+func (x fastReflection_ParamsRecord_messageType) New() protoreflect.Message {
+	return new(fastReflection_ParamsRecord)
+}
+func (x fastReflection_ParamsRecord_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ParamsRecord
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ParamsRecord) Descriptor() protoreflect.MessageDescriptor {
+	return md_ParamsRecord
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ParamsRecord) Type() protoreflect.MessageType {
+	return _fastReflection_ParamsRecord_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ParamsRecord) New() protoreflect.Message {
+	return new(fastReflection_ParamsRecord)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ParamsRecord) Interface() protoreflect.ProtoMessage {
+	return (*ParamsRecord)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ParamsRecord) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Params != nil {
+		value := protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+		if !f(fd_ParamsRecord_params, value) {
+			return
+		}
+	}
+	if x.LastChangeHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.LastChangeHeight)
+		if !f(fd_ParamsRecord_last_change_height, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ParamsRecord) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "arkeo.arkeo.ParamsRecord.params":
+		return x.Params != nil
+	case "arkeo.arkeo.ParamsRecord.last_change_height":
+		return x.LastChangeHeight != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.ParamsRecord"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.ParamsRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ParamsRecord) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "arkeo.arkeo.ParamsRecord.params":
+		x.Params = nil
+	case "arkeo.arkeo.ParamsRecord.last_change_height":
+		x.LastChangeHeight = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.ParamsRecord"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.ParamsRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ParamsRecord) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "arkeo.arkeo.ParamsRecord.params":
+		value := x.Params
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "arkeo.arkeo.ParamsRecord.last_change_height":
+		value := x.LastChangeHeight
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.ParamsRecord"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.ParamsRecord does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ParamsRecord) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "arkeo.arkeo.ParamsRecord.params":
+		x.Params = value.Message().Interface().(*Params)
+	case "arkeo.arkeo.ParamsRecord.last_change_height":
+		x.LastChangeHeight = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.ParamsRecord"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.ParamsRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ParamsRecord) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "arkeo.arkeo.ParamsRecord.params":
+		if x.Params == nil {
+			x.Params = new(Params)
+		}
+		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+	case "arkeo.arkeo.ParamsRecord.last_change_height":
+		panic(fmt.Errorf("field last_change_height of message arkeo.arkeo.ParamsRecord is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.ParamsRecord"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.ParamsRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ParamsRecord) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "arkeo.arkeo.ParamsRecord.params":
+		m := new(Params)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "arkeo.arkeo.ParamsRecord.last_change_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.ParamsRecord"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.ParamsRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ParamsRecord) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in arkeo.arkeo.ParamsRecord", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ParamsRecord) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ParamsRecord) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ParamsRecord) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ParamsRecord) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ParamsRecord)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Params != nil {
+			l = options.Size(x.Params)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.LastChangeHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.LastChangeHeight))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ParamsRecord)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.LastChangeHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LastChangeHeight))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Params != nil {
+			encoded, err := options.Marshal(x.Params)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ParamsRecord)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.InflationChangePercentage = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 5:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InflationMin", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				x.InflationMin = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 6:
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ParamsRecord: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ParamsRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InflationMax", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -792,80 +2396,33 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.InflationMax = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 7:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GoalBonded", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				if x.Params == nil {
+					x.Params = &Params{}
 				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Params); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				x.GoalBonded = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 8:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockPerYear", wireType)
-				}
-				x.BlockPerYear = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.BlockPerYear |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 9:
+			case 2:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EmissionCurve", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastChangeHeight", wireType)
 				}
-				x.EmissionCurve = 0
+				x.LastChangeHeight = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -875,7 +2432,7 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.EmissionCurve |= uint64(b&0x7F) << shift
+					x.LastChangeHeight |= int64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
@@ -934,15 +2491,56 @@ type Params struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CommunityPoolPercentage   string `protobuf:"bytes,1,opt,name=community_pool_percentage,json=communityPoolPercentage,proto3" json:"community_pool_percentage,omitempty"`
-	DevFundPercentage         string `protobuf:"bytes,2,opt,name=dev_fund_percentage,json=devFundPercentage,proto3" json:"dev_fund_percentage,omitempty"`
-	GrantFundPercentage       string `protobuf:"bytes,3,opt,name=grant_fund_percentage,json=grantFundPercentage,proto3" json:"grant_fund_percentage,omitempty"`
-	InflationChangePercentage string `protobuf:"bytes,4,opt,name=inflation_change_percentage,json=inflationChangePercentage,proto3" json:"inflation_change_percentage,omitempty"`
-	InflationMin              string `protobuf:"bytes,5,opt,name=inflation_min,json=inflationMin,proto3" json:"inflation_min,omitempty"`
-	InflationMax              string `protobuf:"bytes,6,opt,name=inflation_max,json=inflationMax,proto3" json:"inflation_max,omitempty"`
-	GoalBonded                string `protobuf:"bytes,7,opt,name=goal_bonded,json=goalBonded,proto3" json:"goal_bonded,omitempty"`
-	BlockPerYear              uint64 `protobuf:"varint,8,opt,name=block_per_year,json=blockPerYear,proto3" json:"block_per_year,omitempty"`
-	EmissionCurve             uint64 `protobuf:"varint,9,opt,name=emission_curve,json=emissionCurve,proto3" json:"emission_curve,omitempty"`
+	BlockPerYear  uint64 `protobuf:"varint,8,opt,name=block_per_year,json=blockPerYear,proto3" json:"block_per_year,omitempty"`
+	EmissionCurve uint64 `protobuf:"varint,9,opt,name=emission_curve,json=emissionCurve,proto3" json:"emission_curve,omitempty"`
+	// blocks added to the settlement period of the contracts opened from now on, for the last claims to come in
+	SettlementGracePeriod int64 `protobuf:"varint,10,opt,name=settlement_grace_period,json=settlementGracePeriod,proto3" json:"settlement_grace_period,omitempty"`
+	// basis points of the provider bond slashed for its first fault
+	SlashFraction int64 `protobuf:"varint,11,opt,name=slash_fraction,json=slashFraction,proto3" json:"slash_fraction,omitempty"`
+	// basis points added to the slashed fraction for each earlier fault of the provider
+	SlashEscalation int64 `protobuf:"varint,12,opt,name=slash_escalation,json=slashEscalation,proto3" json:"slash_escalation,omitempty"`
+	// denoms providers can set rates in and contracts can open with, the
+	// contracts already open keep settling in their denom
+	AllowedDenoms []string `protobuf:"bytes,13,rep,name=allowed_denoms,json=allowedDenoms,proto3" json:"allowed_denoms,omitempty"`
+	// open contracts a provider accepts at most, it can lower its own cap,
+	// zero for no cap
+	MaxOpenContracts uint64 `protobuf:"varint,14,opt,name=max_open_contracts,json=maxOpenContracts,proto3" json:"max_open_contracts,omitempty"`
+	// queries at the rate of the contract the deposit of a pay-as-you-go
+	// contract covers at least
+	MinPayAsYouGoDeposit int64 `protobuf:"varint,15,opt,name=min_pay_as_you_go_deposit,json=minPayAsYouGoDeposit,proto3" json:"min_pay_as_you_go_deposit,omitempty"`
+	// basis points of the deposit a subscription needs it can be overpaid by,
+	// the excess is left with the client rather than escrowed
+	DepositRefundTolerance int64 `protobuf:"varint,16,opt,name=deposit_refund_tolerance,json=depositRefundTolerance,proto3" json:"deposit_refund_tolerance,omitempty"`
+	// claims a batch of contract income claims carries at most, zero
+	// disabling the batches
+	MaxClaimBatchSize uint64 `protobuf:"varint,17,opt,name=max_claim_batch_size,json=maxClaimBatchSize,proto3" json:"max_claim_batch_size,omitempty"`
+	// length of the metadata uri a provider sets at most, zero for no limit
+	MaxMetadataUriLength uint64 `protobuf:"varint,18,opt,name=max_metadata_uri_length,json=maxMetadataUriLength,proto3" json:"max_metadata_uri_length,omitempty"`
+	// bond a provider needs at least to be online, for the services missing
+	// from service_min_bonds, zero falling back to the MinProviderBond config
+	MinProviderBond int64 `protobuf:"varint,19,opt,name=min_provider_bond,json=minProviderBond,proto3" json:"min_provider_bond,omitempty"`
+	// bond a provider needs at least to be online, per service
+	ServiceMinBonds []*ServiceMinBond `protobuf:"bytes,20,rep,name=service_min_bonds,json=serviceMinBonds,proto3" json:"service_min_bonds,omitempty"`
+	// blocks an unsettled contract stays in state past the end of its
+	// settlement period before anyone can purge it, zero disabling the purge
+	ContractDormancyPeriod int64 `protobuf:"varint,21,opt,name=contract_dormancy_period,json=contractDormancyPeriod,proto3" json:"contract_dormancy_period,omitempty"`
+	// paid out of the reserve to the account purging a contract left unsettled, per contract, kept below the cost of
+	// opening a contract
+	PurgeReward int64 `protobuf:"varint,22,opt,name=purge_reward,json=purgeReward,proto3" json:"purge_reward,omitempty"`
+	// blocks ahead of the current height a contract can be scheduled to start
+	// at most, zero disabling the scheduled contracts
+	MaxContractStartDelay int64 `protobuf:"varint,23,opt,name=max_contract_start_delay,json=maxContractStartDelay,proto3" json:"max_contract_start_delay,omitempty"`
+	// clients a provider can allowlist for a service at most
+	MaxAllowlistSize uint64 `protobuf:"varint,24,opt,name=max_allowlist_size,json=maxAllowlistSize,proto3" json:"max_allowlist_size,omitempty"`
+	// basis points of the unused deposit of a subscription closed early by its
+	// client paid to the provider, the rest refunded
+	EarlyCloseCompensation int64 `protobuf:"varint,25,opt,name=early_close_compensation,json=earlyCloseCompensation,proto3" json:"early_close_compensation,omitempty"`
+	// blocks of a subscription its client pays for even when closing it before
+	// they are served, the duration of the contract at most
+	EarlyCloseMinPeriod int64 `protobuf:"varint,26,opt,name=early_close_min_period,json=earlyCloseMinPeriod,proto3" json:"early_close_min_period,omitempty"`
+	// paid from the provider bond to the client of each contract the provider
+	// closes, the bond left at most
+	ProviderCloseContractPenalty int64 `protobuf:"varint,27,opt,name=provider_close_contract_penalty,json=providerCloseContractPenalty,proto3" json:"provider_close_contract_penalty,omitempty"`
 }
 
 func (x *Params) Reset() {
@@ -965,65 +2563,231 @@ func (*Params) Descriptor() ([]byte, []int) {
 	return file_arkeo_arkeo_params_proto_rawDescGZIP(), []int{0}
 }
 
-func (x *Params) GetCommunityPoolPercentage() string {
+func (x *Params) GetBlockPerYear() uint64 {
 	if x != nil {
-		return x.CommunityPoolPercentage
+		return x.BlockPerYear
 	}
-	return ""
+	return 0
 }
 
-func (x *Params) GetDevFundPercentage() string {
+func (x *Params) GetEmissionCurve() uint64 {
 	if x != nil {
-		return x.DevFundPercentage
+		return x.EmissionCurve
 	}
-	return ""
+	return 0
 }
 
-func (x *Params) GetGrantFundPercentage() string {
+func (x *Params) GetSettlementGracePeriod() int64 {
 	if x != nil {
-		return x.GrantFundPercentage
+		return x.SettlementGracePeriod
 	}
-	return ""
+	return 0
 }
 
-func (x *Params) GetInflationChangePercentage() string {
+func (x *Params) GetSlashFraction() int64 {
 	if x != nil {
-		return x.InflationChangePercentage
+		return x.SlashFraction
 	}
-	return ""
+	return 0
 }
 
-func (x *Params) GetInflationMin() string {
+func (x *Params) GetSlashEscalation() int64 {
 	if x != nil {
-		return x.InflationMin
+		return x.SlashEscalation
 	}
-	return ""
+	return 0
 }
 
-func (x *Params) GetInflationMax() string {
+func (x *Params) GetAllowedDenoms() []string {
 	if x != nil {
-		return x.InflationMax
+		return x.AllowedDenoms
 	}
-	return ""
+	return nil
+}
+
+func (x *Params) GetMaxOpenContracts() uint64 {
+	if x != nil {
+		return x.MaxOpenContracts
+	}
+	return 0
 }
 
-func (x *Params) GetGoalBonded() string {
+func (x *Params) GetMinPayAsYouGoDeposit() int64 {
 	if x != nil {
-		return x.GoalBonded
+		return x.MinPayAsYouGoDeposit
+	}
+	return 0
+}
+
+func (x *Params) GetDepositRefundTolerance() int64 {
+	if x != nil {
+		return x.DepositRefundTolerance
+	}
+	return 0
+}
+
+func (x *Params) GetMaxClaimBatchSize() uint64 {
+	if x != nil {
+		return x.MaxClaimBatchSize
+	}
+	return 0
+}
+
+func (x *Params) GetMaxMetadataUriLength() uint64 {
+	if x != nil {
+		return x.MaxMetadataUriLength
+	}
+	return 0
+}
+
+func (x *Params) GetMinProviderBond() int64 {
+	if x != nil {
+		return x.MinProviderBond
+	}
+	return 0
+}
+
+func (x *Params) GetServiceMinBonds() []*ServiceMinBond {
+	if x != nil {
+		return x.ServiceMinBonds
+	}
+	return nil
+}
+
+func (x *Params) GetContractDormancyPeriod() int64 {
+	if x != nil {
+		return x.ContractDormancyPeriod
+	}
+	return 0
+}
+
+func (x *Params) GetPurgeReward() int64 {
+	if x != nil {
+		return x.PurgeReward
+	}
+	return 0
+}
+
+func (x *Params) GetMaxContractStartDelay() int64 {
+	if x != nil {
+		return x.MaxContractStartDelay
+	}
+	return 0
+}
+
+func (x *Params) GetMaxAllowlistSize() uint64 {
+	if x != nil {
+		return x.MaxAllowlistSize
+	}
+	return 0
+}
+
+func (x *Params) GetEarlyCloseCompensation() int64 {
+	if x != nil {
+		return x.EarlyCloseCompensation
+	}
+	return 0
+}
+
+func (x *Params) GetEarlyCloseMinPeriod() int64 {
+	if x != nil {
+		return x.EarlyCloseMinPeriod
+	}
+	return 0
+}
+
+func (x *Params) GetProviderCloseContractPenalty() int64 {
+	if x != nil {
+		return x.ProviderCloseContractPenalty
+	}
+	return 0
+}
+
+// ServiceMinBond minimum bond of the providers of a service
+type ServiceMinBond struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Service string `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
+	MinBond int64  `protobuf:"varint,2,opt,name=min_bond,json=minBond,proto3" json:"min_bond,omitempty"`
+}
+
+func (x *ServiceMinBond) Reset() {
+	*x = ServiceMinBond{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_arkeo_arkeo_params_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServiceMinBond) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceMinBond) ProtoMessage() {}
+
+// Deprecated: Use ServiceMinBond.ProtoReflect.Descriptor instead.
+func (*ServiceMinBond) Descriptor() ([]byte, []int) {
+	return file_arkeo_arkeo_params_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ServiceMinBond) GetService() string {
+	if x != nil {
+		return x.Service
 	}
 	return ""
 }
 
-func (x *Params) GetBlockPerYear() uint64 {
+func (x *ServiceMinBond) GetMinBond() int64 {
 	if x != nil {
-		return x.BlockPerYear
+		return x.MinBond
 	}
 	return 0
 }
 
-func (x *Params) GetEmissionCurve() uint64 {
+// ParamsRecord is the params as the end blocker last saw them, along with the
+// height they last changed at
+type ParamsRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Params           *Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	LastChangeHeight int64   `protobuf:"varint,2,opt,name=last_change_height,json=lastChangeHeight,proto3" json:"last_change_height,omitempty"`
+}
+
+func (x *ParamsRecord) Reset() {
+	*x = ParamsRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_arkeo_arkeo_params_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ParamsRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParamsRecord) ProtoMessage() {}
+
+// Deprecated: Use ParamsRecord.ProtoReflect.Descriptor instead.
+func (*ParamsRecord) Descriptor() ([]byte, []int) {
+	return file_arkeo_arkeo_params_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ParamsRecord) GetParams() *Params {
 	if x != nil {
-		return x.EmissionCurve
+		return x.Params
+	}
+	return nil
+}
+
+func (x *ParamsRecord) GetLastChangeHeight() int64 {
+	if x != nil {
+		return x.LastChangeHeight
 	}
 	return 0
 }
@@ -1038,65 +2802,93 @@ var file_arkeo_arkeo_params_proto_rawDesc = []byte{
 	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74,
 	0x61, 0x31, 0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63,
 	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x8b, 0x06, 0x0a, 0x06, 0x50, 0x61, 0x72,
-	0x61, 0x6d, 0x73, 0x12, 0x6d, 0x0a, 0x19, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79,
-	0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74,
-	0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x17, 0x63, 0x6f, 0x6d, 0x6d, 0x75,
-	0x6e, 0x69, 0x74, 0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61,
-	0x67, 0x65, 0x12, 0x61, 0x0a, 0x13, 0x64, 0x65, 0x76, 0x5f, 0x66, 0x75, 0x6e, 0x64, 0x5f, 0x70,
-	0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x31, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
-	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63,
-	0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44,
-	0x65, 0x63, 0x52, 0x11, 0x64, 0x65, 0x76, 0x46, 0x75, 0x6e, 0x64, 0x50, 0x65, 0x72, 0x63, 0x65,
-	0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x65, 0x0a, 0x15, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x5f, 0x66,
-	0x75, 0x6e, 0x64, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e,
-	0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x13, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x46, 0x75,
-	0x6e, 0x64, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x71, 0x0a, 0x1b,
-	0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
-	0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x31, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67,
-	0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x2e, 0x44, 0x65, 0x63, 0x52, 0x19, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43,
-	0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12,
-	0x56, 0x0a, 0x0d, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x69, 0x6e,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74,
-	0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x0c, 0x69, 0x6e, 0x66, 0x6c, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x69, 0x6e, 0x12, 0x56, 0x0a, 0x0d, 0x69, 0x6e, 0x66, 0x6c, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x61, 0x78, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31,
-	0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64,
-	0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79,
-	0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65,
-	0x63, 0x52, 0x0c, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x61, 0x78, 0x12,
-	0x52, 0x0a, 0x0b, 0x67, 0x6f, 0x61, 0x6c, 0x5f, 0x62, 0x6f, 0x6e, 0x64, 0x65, 0x64, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e,
-	0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x0a, 0x67, 0x6f, 0x61, 0x6c, 0x42, 0x6f, 0x6e,
-	0x64, 0x65, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x70, 0x65, 0x72,
+	0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x8a, 0x08, 0x0a, 0x06, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x70, 0x65, 0x72,
 	0x5f, 0x79, 0x65, 0x61, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x62, 0x6c, 0x6f,
 	0x63, 0x6b, 0x50, 0x65, 0x72, 0x59, 0x65, 0x61, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x6d, 0x69,
 	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x75, 0x72, 0x76, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28,
 	0x04, 0x52, 0x0d, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x75, 0x72, 0x76, 0x65,
-	0x3a, 0x04, 0x98, 0xa0, 0x1f, 0x00, 0x42, 0x89, 0x01, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x2e, 0x61,
-	0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x42, 0x0b, 0x50, 0x61, 0x72, 0x61,
-	0x6d, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x1c, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x72, 0x6b, 0x65,
-	0x6f, 0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0xa2, 0x02, 0x03, 0x41, 0x41, 0x58, 0xaa, 0x02, 0x0b,
-	0x41, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x41, 0x72, 0x6b, 0x65, 0x6f, 0xca, 0x02, 0x0b, 0x41, 0x72,
-	0x6b, 0x65, 0x6f, 0x5c, 0x41, 0x72, 0x6b, 0x65, 0x6f, 0xe2, 0x02, 0x17, 0x41, 0x72, 0x6b, 0x65,
-	0x6f, 0x5c, 0x41, 0x72, 0x6b, 0x65, 0x6f, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0xea, 0x02, 0x0c, 0x41, 0x72, 0x6b, 0x65, 0x6f, 0x3a, 0x3a, 0x41, 0x72, 0x6b,
-	0x65, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x12, 0x36, 0x0a, 0x17, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x67,
+	0x72, 0x61, 0x63, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x15, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x61,
+	0x63, 0x65, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x6c, 0x61, 0x73,
+	0x68, 0x5f, 0x66, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0d, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x46, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x29, 0x0a, 0x10, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x5f, 0x65, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x73, 0x6c, 0x61, 0x73, 0x68,
+	0x45, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x6c,
+	0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x73, 0x18, 0x0d, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0d, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x44, 0x65, 0x6e, 0x6f, 0x6d,
+	0x73, 0x12, 0x2c, 0x0a, 0x12, 0x6d, 0x61, 0x78, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x63, 0x6f,
+	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x6d,
+	0x61, 0x78, 0x4f, 0x70, 0x65, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x73, 0x12,
+	0x37, 0x0a, 0x19, 0x6d, 0x69, 0x6e, 0x5f, 0x70, 0x61, 0x79, 0x5f, 0x61, 0x73, 0x5f, 0x79, 0x6f,
+	0x75, 0x5f, 0x67, 0x6f, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x0f, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x14, 0x6d, 0x69, 0x6e, 0x50, 0x61, 0x79, 0x41, 0x73, 0x59, 0x6f, 0x75, 0x47,
+	0x6f, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x38, 0x0a, 0x18, 0x64, 0x65, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x5f, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x5f, 0x74, 0x6f, 0x6c, 0x65, 0x72,
+	0x61, 0x6e, 0x63, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x03, 0x52, 0x16, 0x64, 0x65, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x54, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e,
+	0x63, 0x65, 0x12, 0x2f, 0x0a, 0x14, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x5f,
+	0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x11, 0x6d, 0x61, 0x78, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53,
+	0x69, 0x7a, 0x65, 0x12, 0x35, 0x0a, 0x17, 0x6d, 0x61, 0x78, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x5f, 0x75, 0x72, 0x69, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x12,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x6d, 0x61, 0x78, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x55, 0x72, 0x69, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x69,
+	0x6e, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x62, 0x6f, 0x6e, 0x64, 0x18,
+	0x13, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x6d, 0x69, 0x6e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x42, 0x6f, 0x6e, 0x64, 0x12, 0x4d, 0x0a, 0x11, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x62, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x14, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4d, 0x69, 0x6e, 0x42, 0x6f, 0x6e, 0x64, 0x42, 0x04,
+	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4d, 0x69, 0x6e,
+	0x42, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x38, 0x0a, 0x18, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
+	0x74, 0x5f, 0x64, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x79, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f,
+	0x64, 0x18, 0x15, 0x20, 0x01, 0x28, 0x03, 0x52, 0x16, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
+	0x74, 0x44, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x79, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12,
+	0x21, 0x0a, 0x0c, 0x70, 0x75, 0x72, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18,
+	0x16, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x70, 0x75, 0x72, 0x67, 0x65, 0x52, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x12, 0x37, 0x0a, 0x18, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x17,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x15, 0x6d, 0x61, 0x78, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
+	0x74, 0x53, 0x74, 0x61, 0x72, 0x74, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x12, 0x2c, 0x0a, 0x12, 0x6d,
+	0x61, 0x78, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x73, 0x69, 0x7a,
+	0x65, 0x18, 0x18, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x6d, 0x61, 0x78, 0x41, 0x6c, 0x6c, 0x6f,
+	0x77, 0x6c, 0x69, 0x73, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x38, 0x0a, 0x18, 0x65, 0x61, 0x72,
+	0x6c, 0x79, 0x5f, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x65, 0x6e, 0x73,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x19, 0x20, 0x01, 0x28, 0x03, 0x52, 0x16, 0x65, 0x61, 0x72,
+	0x6c, 0x79, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x65, 0x6e, 0x73, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x33, 0x0a, 0x16, 0x65, 0x61, 0x72, 0x6c, 0x79, 0x5f, 0x63, 0x6c, 0x6f,
+	0x73, 0x65, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x1a, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x13, 0x65, 0x61, 0x72, 0x6c, 0x79, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x4d,
+	0x69, 0x6e, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x45, 0x0a, 0x1f, 0x70, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x5f, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72,
+	0x61, 0x63, 0x74, 0x5f, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x18, 0x1b, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x1c, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x43, 0x6c, 0x6f, 0x73, 0x65,
+	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x50, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x3a,
+	0x04, 0x98, 0xa0, 0x1f, 0x00, 0x22, 0x45, 0x0a, 0x0e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x4d, 0x69, 0x6e, 0x42, 0x6f, 0x6e, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x69, 0x6e, 0x5f, 0x62, 0x6f, 0x6e, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x07, 0x6d, 0x69, 0x6e, 0x42, 0x6f, 0x6e, 0x64, 0x22, 0x6f, 0x0a, 0x0c,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x31, 0x0a, 0x06,
+	0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61,
+	0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12,
+	0x2c, 0x0a, 0x12, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x6c, 0x61, 0x73,
+	0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x42, 0x89, 0x01,
+	0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x61, 0x72, 0x6b, 0x65,
+	0x6f, 0x42, 0x0b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01,
+	0x5a, 0x1c, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0xa2, 0x02,
+	0x03, 0x41, 0x41, 0x58, 0xaa, 0x02, 0x0b, 0x41, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x41, 0x72, 0x6b,
+	0x65, 0x6f, 0xca, 0x02, 0x0b, 0x41, 0x72, 0x6b, 0x65, 0x6f, 0x5c, 0x41, 0x72, 0x6b, 0x65, 0x6f,
+	0xe2, 0x02, 0x17, 0x41, 0x72, 0x6b, 0x65, 0x6f, 0x5c, 0x41, 0x72, 0x6b, 0x65, 0x6f, 0x5c, 0x47,
+	0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0c, 0x41, 0x72, 0x6b,
+	0x65, 0x6f, 0x3a, 0x3a, 0x41, 0x72, 0x6b, 0x65, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -1111,16 +2903,20 @@ func file_arkeo_arkeo_params_proto_rawDescGZIP() []byte {
 	return file_arkeo_arkeo_params_proto_rawDescData
 }
 
-var file_arkeo_arkeo_params_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_arkeo_arkeo_params_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_arkeo_arkeo_params_proto_goTypes = []interface{}{
-	(*Params)(nil), // 0: arkeo.arkeo.Params
+	(*Params)(nil),         // 0: arkeo.arkeo.Params
+	(*ServiceMinBond)(nil), // 1: arkeo.arkeo.ServiceMinBond
+	(*ParamsRecord)(nil),   // 2: arkeo.arkeo.ParamsRecord
 }
 var file_arkeo_arkeo_params_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	1, // 0: arkeo.arkeo.Params.service_min_bonds:type_name -> arkeo.arkeo.ServiceMinBond
+	0, // 1: arkeo.arkeo.ParamsRecord.params:type_name -> arkeo.arkeo.Params
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_arkeo_arkeo_params_proto_init() }
@@ -1141,6 +2937,30 @@ func file_arkeo_arkeo_params_proto_init() {
 				return nil
 			}
 		}
+		file_arkeo_arkeo_params_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServiceMinBond); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_arkeo_arkeo_params_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ParamsRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1148,7 +2968,7 @@ func file_arkeo_arkeo_params_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_arkeo_arkeo_params_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   1,
+			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -3,6 +3,7 @@ package arkeo
 
 import (
 	v1beta1 "cosmossdk.io/api/cosmos/base/query/v1beta1"
+	v1beta11 "cosmossdk.io/api/cosmos/base/v1beta1"
 	fmt "fmt"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -372,14 +373,20 @@ func (x *fastReflection_QueryParamsRequest) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_QueryParamsResponse        protoreflect.MessageDescriptor
-	fd_QueryParamsResponse_params protoreflect.FieldDescriptor
+	md_QueryParamsResponse                    protoreflect.MessageDescriptor
+	fd_QueryParamsResponse_params             protoreflect.FieldDescriptor
+	fd_QueryParamsResponse_last_change_height protoreflect.FieldDescriptor
+	fd_QueryParamsResponse_consensus_version  protoreflect.FieldDescriptor
+	fd_QueryParamsResponse_version            protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_arkeo_arkeo_query_proto_init()
 	md_QueryParamsResponse = File_arkeo_arkeo_query_proto.Messages().ByName("QueryParamsResponse")
 	fd_QueryParamsResponse_params = md_QueryParamsResponse.Fields().ByName("params")
+	fd_QueryParamsResponse_last_change_height = md_QueryParamsResponse.Fields().ByName("last_change_height")
+	fd_QueryParamsResponse_consensus_version = md_QueryParamsResponse.Fields().ByName("consensus_version")
+	fd_QueryParamsResponse_version = md_QueryParamsResponse.Fields().ByName("version")
 }
 
 var _ protoreflect.Message = (*fastReflection_QueryParamsResponse)(nil)
@@ -453,6 +460,24 @@ func (x *fastReflection_QueryParamsResponse) Range(f func(protoreflect.FieldDesc
 			return
 		}
 	}
+	if x.LastChangeHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.LastChangeHeight)
+		if !f(fd_QueryParamsResponse_last_change_height, value) {
+			return
+		}
+	}
+	if x.ConsensusVersion != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ConsensusVersion)
+		if !f(fd_QueryParamsResponse_consensus_version, value) {
+			return
+		}
+	}
+	if x.Version != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Version)
+		if !f(fd_QueryParamsResponse_version, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -470,6 +495,12 @@ func (x *fastReflection_QueryParamsResponse) Has(fd protoreflect.FieldDescriptor
 	switch fd.FullName() {
 	case "arkeo.arkeo.QueryParamsResponse.params":
 		return x.Params != nil
+	case "arkeo.arkeo.QueryParamsResponse.last_change_height":
+		return x.LastChangeHeight != int64(0)
+	case "arkeo.arkeo.QueryParamsResponse.consensus_version":
+		return x.ConsensusVersion != uint64(0)
+	case "arkeo.arkeo.QueryParamsResponse.version":
+		return x.Version != int64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryParamsResponse"))
@@ -488,6 +519,12 @@ func (x *fastReflection_QueryParamsResponse) Clear(fd protoreflect.FieldDescript
 	switch fd.FullName() {
 	case "arkeo.arkeo.QueryParamsResponse.params":
 		x.Params = nil
+	case "arkeo.arkeo.QueryParamsResponse.last_change_height":
+		x.LastChangeHeight = int64(0)
+	case "arkeo.arkeo.QueryParamsResponse.consensus_version":
+		x.ConsensusVersion = uint64(0)
+	case "arkeo.arkeo.QueryParamsResponse.version":
+		x.Version = int64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryParamsResponse"))
@@ -507,6 +544,15 @@ func (x *fastReflection_QueryParamsResponse) Get(descriptor protoreflect.FieldDe
 	case "arkeo.arkeo.QueryParamsResponse.params":
 		value := x.Params
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "arkeo.arkeo.QueryParamsResponse.last_change_height":
+		value := x.LastChangeHeight
+		return protoreflect.ValueOfInt64(value)
+	case "arkeo.arkeo.QueryParamsResponse.consensus_version":
+		value := x.ConsensusVersion
+		return protoreflect.ValueOfUint64(value)
+	case "arkeo.arkeo.QueryParamsResponse.version":
+		value := x.Version
+		return protoreflect.ValueOfInt64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryParamsResponse"))
@@ -529,6 +575,12 @@ func (x *fastReflection_QueryParamsResponse) Set(fd protoreflect.FieldDescriptor
 	switch fd.FullName() {
 	case "arkeo.arkeo.QueryParamsResponse.params":
 		x.Params = value.Message().Interface().(*Params)
+	case "arkeo.arkeo.QueryParamsResponse.last_change_height":
+		x.LastChangeHeight = value.Int()
+	case "arkeo.arkeo.QueryParamsResponse.consensus_version":
+		x.ConsensusVersion = value.Uint()
+	case "arkeo.arkeo.QueryParamsResponse.version":
+		x.Version = value.Int()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryParamsResponse"))
@@ -554,6 +606,12 @@ func (x *fastReflection_QueryParamsResponse) Mutable(fd protoreflect.FieldDescri
 			x.Params = new(Params)
 		}
 		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+	case "arkeo.arkeo.QueryParamsResponse.last_change_height":
+		panic(fmt.Errorf("field last_change_height of message arkeo.arkeo.QueryParamsResponse is not mutable"))
+	case "arkeo.arkeo.QueryParamsResponse.consensus_version":
+		panic(fmt.Errorf("field consensus_version of message arkeo.arkeo.QueryParamsResponse is not mutable"))
+	case "arkeo.arkeo.QueryParamsResponse.version":
+		panic(fmt.Errorf("field version of message arkeo.arkeo.QueryParamsResponse is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryParamsResponse"))
@@ -570,6 +628,12 @@ func (x *fastReflection_QueryParamsResponse) NewField(fd protoreflect.FieldDescr
 	case "arkeo.arkeo.QueryParamsResponse.params":
 		m := new(Params)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "arkeo.arkeo.QueryParamsResponse.last_change_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "arkeo.arkeo.QueryParamsResponse.consensus_version":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "arkeo.arkeo.QueryParamsResponse.version":
+		return protoreflect.ValueOfInt64(int64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryParamsResponse"))
@@ -643,6 +707,15 @@ func (x *fastReflection_QueryParamsResponse) ProtoMethods() *protoiface.Methods
 			l = options.Size(x.Params)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.LastChangeHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.LastChangeHeight))
+		}
+		if x.ConsensusVersion != 0 {
+			n += 1 + runtime.Sov(uint64(x.ConsensusVersion))
+		}
+		if x.Version != 0 {
+			n += 1 + runtime.Sov(uint64(x.Version))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -672,6 +745,21 @@ func (x *fastReflection_QueryParamsResponse) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.Version != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Version))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.ConsensusVersion != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ConsensusVersion))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.LastChangeHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LastChangeHeight))
+			i--
+			dAtA[i] = 0x10
+		}
 		if x.Params != nil {
 			encoded, err := options.Marshal(x.Params)
 			if err != nil {
@@ -771,6 +859,63 @@ func (x *fastReflection_QueryParamsResponse) ProtoMethods() *protoiface.Methods
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastChangeHeight", wireType)
+				}
+				x.LastChangeHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LastChangeHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ConsensusVersion", wireType)
+				}
+				x.ConsensusVersion = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ConsensusVersion |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				}
+				x.Version = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Version |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1726,25 +1871,27 @@ func (x *fastReflection_QueryFetchProviderResponse) ProtoMethods() *protoiface.M
 }
 
 var (
-	md_QueryAllProviderRequest            protoreflect.MessageDescriptor
-	fd_QueryAllProviderRequest_pagination protoreflect.FieldDescriptor
+	md_QueryProviderEarningsRequest         protoreflect.MessageDescriptor
+	fd_QueryProviderEarningsRequest_pubkey  protoreflect.FieldDescriptor
+	fd_QueryProviderEarningsRequest_service protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_arkeo_arkeo_query_proto_init()
-	md_QueryAllProviderRequest = File_arkeo_arkeo_query_proto.Messages().ByName("QueryAllProviderRequest")
-	fd_QueryAllProviderRequest_pagination = md_QueryAllProviderRequest.Fields().ByName("pagination")
+	md_QueryProviderEarningsRequest = File_arkeo_arkeo_query_proto.Messages().ByName("QueryProviderEarningsRequest")
+	fd_QueryProviderEarningsRequest_pubkey = md_QueryProviderEarningsRequest.Fields().ByName("pubkey")
+	fd_QueryProviderEarningsRequest_service = md_QueryProviderEarningsRequest.Fields().ByName("service")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryAllProviderRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryProviderEarningsRequest)(nil)
 
-type fastReflection_QueryAllProviderRequest QueryAllProviderRequest
+type fastReflection_QueryProviderEarningsRequest QueryProviderEarningsRequest
 
-func (x *QueryAllProviderRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryAllProviderRequest)(x)
+func (x *QueryProviderEarningsRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryProviderEarningsRequest)(x)
 }
 
-func (x *QueryAllProviderRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryProviderEarningsRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_arkeo_arkeo_query_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1756,43 +1903,43 @@ func (x *QueryAllProviderRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryAllProviderRequest_messageType fastReflection_QueryAllProviderRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryAllProviderRequest_messageType{}
+var _fastReflection_QueryProviderEarningsRequest_messageType fastReflection_QueryProviderEarningsRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryProviderEarningsRequest_messageType{}
 
-type fastReflection_QueryAllProviderRequest_messageType struct{}
+type fastReflection_QueryProviderEarningsRequest_messageType struct{}
 
-func (x fastReflection_QueryAllProviderRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryAllProviderRequest)(nil)
+func (x fastReflection_QueryProviderEarningsRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryProviderEarningsRequest)(nil)
 }
-func (x fastReflection_QueryAllProviderRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryAllProviderRequest)
+func (x fastReflection_QueryProviderEarningsRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryProviderEarningsRequest)
 }
-func (x fastReflection_QueryAllProviderRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryAllProviderRequest
+func (x fastReflection_QueryProviderEarningsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryProviderEarningsRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryAllProviderRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryAllProviderRequest
+func (x *fastReflection_QueryProviderEarningsRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryProviderEarningsRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryAllProviderRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryAllProviderRequest_messageType
+func (x *fastReflection_QueryProviderEarningsRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryProviderEarningsRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryAllProviderRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryAllProviderRequest)
+func (x *fastReflection_QueryProviderEarningsRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryProviderEarningsRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryAllProviderRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryAllProviderRequest)(x)
+func (x *fastReflection_QueryProviderEarningsRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryProviderEarningsRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1800,10 +1947,16 @@ func (x *fastReflection_QueryAllProviderRequest) Interface() protoreflect.ProtoM
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryAllProviderRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Pagination != nil {
-		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-		if !f(fd_QueryAllProviderRequest_pagination, value) {
+func (x *fastReflection_QueryProviderEarningsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Pubkey != "" {
+		value := protoreflect.ValueOfString(x.Pubkey)
+		if !f(fd_QueryProviderEarningsRequest_pubkey, value) {
+			return
+		}
+	}
+	if x.Service != "" {
+		value := protoreflect.ValueOfString(x.Service)
+		if !f(fd_QueryProviderEarningsRequest_service, value) {
 			return
 		}
 	}
@@ -1820,15 +1973,17 @@ func (x *fastReflection_QueryAllProviderRequest) Range(f func(protoreflect.Field
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryAllProviderRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryProviderEarningsRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "arkeo.arkeo.QueryAllProviderRequest.pagination":
-		return x.Pagination != nil
+	case "arkeo.arkeo.QueryProviderEarningsRequest.pubkey":
+		return x.Pubkey != ""
+	case "arkeo.arkeo.QueryProviderEarningsRequest.service":
+		return x.Service != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryAllProviderRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryProviderEarningsRequest"))
 		}
-		panic(fmt.Errorf("message arkeo.arkeo.QueryAllProviderRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message arkeo.arkeo.QueryProviderEarningsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1838,15 +1993,17 @@ func (x *fastReflection_QueryAllProviderRequest) Has(fd protoreflect.FieldDescri
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAllProviderRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryProviderEarningsRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "arkeo.arkeo.QueryAllProviderRequest.pagination":
-		x.Pagination = nil
+	case "arkeo.arkeo.QueryProviderEarningsRequest.pubkey":
+		x.Pubkey = ""
+	case "arkeo.arkeo.QueryProviderEarningsRequest.service":
+		x.Service = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryAllProviderRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryProviderEarningsRequest"))
 		}
-		panic(fmt.Errorf("message arkeo.arkeo.QueryAllProviderRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message arkeo.arkeo.QueryProviderEarningsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1856,16 +2013,19 @@ func (x *fastReflection_QueryAllProviderRequest) Clear(fd protoreflect.FieldDesc
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryAllProviderRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryProviderEarningsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "arkeo.arkeo.QueryAllProviderRequest.pagination":
-		value := x.Pagination
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "arkeo.arkeo.QueryProviderEarningsRequest.pubkey":
+		value := x.Pubkey
+		return protoreflect.ValueOfString(value)
+	case "arkeo.arkeo.QueryProviderEarningsRequest.service":
+		value := x.Service
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryAllProviderRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryProviderEarningsRequest"))
 		}
-		panic(fmt.Errorf("message arkeo.arkeo.QueryAllProviderRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message arkeo.arkeo.QueryProviderEarningsRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1879,15 +2039,17 @@ func (x *fastReflection_QueryAllProviderRequest) Get(descriptor protoreflect.Fie
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAllProviderRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryProviderEarningsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "arkeo.arkeo.QueryAllProviderRequest.pagination":
-		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
+	case "arkeo.arkeo.QueryProviderEarningsRequest.pubkey":
+		x.Pubkey = value.Interface().(string)
+	case "arkeo.arkeo.QueryProviderEarningsRequest.service":
+		x.Service = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryAllProviderRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryProviderEarningsRequest"))
 		}
-		panic(fmt.Errorf("message arkeo.arkeo.QueryAllProviderRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message arkeo.arkeo.QueryProviderEarningsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1901,44 +2063,44 @@ func (x *fastReflection_QueryAllProviderRequest) Set(fd protoreflect.FieldDescri
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAllProviderRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryProviderEarningsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "arkeo.arkeo.QueryAllProviderRequest.pagination":
-		if x.Pagination == nil {
-			x.Pagination = new(v1beta1.PageRequest)
-		}
-		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	case "arkeo.arkeo.QueryProviderEarningsRequest.pubkey":
+		panic(fmt.Errorf("field pubkey of message arkeo.arkeo.QueryProviderEarningsRequest is not mutable"))
+	case "arkeo.arkeo.QueryProviderEarningsRequest.service":
+		panic(fmt.Errorf("field service of message arkeo.arkeo.QueryProviderEarningsRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryAllProviderRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryProviderEarningsRequest"))
 		}
-		panic(fmt.Errorf("message arkeo.arkeo.QueryAllProviderRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message arkeo.arkeo.QueryProviderEarningsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryAllProviderRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryProviderEarningsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "arkeo.arkeo.QueryAllProviderRequest.pagination":
-		m := new(v1beta1.PageRequest)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "arkeo.arkeo.QueryProviderEarningsRequest.pubkey":
+		return protoreflect.ValueOfString("")
+	case "arkeo.arkeo.QueryProviderEarningsRequest.service":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryAllProviderRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryProviderEarningsRequest"))
 		}
-		panic(fmt.Errorf("message arkeo.arkeo.QueryAllProviderRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message arkeo.arkeo.QueryProviderEarningsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryAllProviderRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryProviderEarningsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in arkeo.arkeo.QueryAllProviderRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in arkeo.arkeo.QueryProviderEarningsRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1946,7 +2108,7 @@ func (x *fastReflection_QueryAllProviderRequest) WhichOneof(d protoreflect.Oneof
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryAllProviderRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryProviderEarningsRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1957,7 +2119,7 @@ func (x *fastReflection_QueryAllProviderRequest) GetUnknown() protoreflect.RawFi
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAllProviderRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryProviderEarningsRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1969,7 +2131,7 @@ func (x *fastReflection_QueryAllProviderRequest) SetUnknown(fields protoreflect.
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryAllProviderRequest) IsValid() bool {
+func (x *fastReflection_QueryProviderEarningsRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -1979,9 +2141,9 @@ func (x *fastReflection_QueryAllProviderRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryAllProviderRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryProviderEarningsRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryAllProviderRequest)
+		x := input.Message.Interface().(*QueryProviderEarningsRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1993,8 +2155,12 @@ func (x *fastReflection_QueryAllProviderRequest) ProtoMethods() *protoiface.Meth
 		var n int
 		var l int
 		_ = l
-		if x.Pagination != nil {
-			l = options.Size(x.Pagination)
+		l = len(x.Pubkey)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Service)
+		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -2007,7 +2173,7 @@ func (x *fastReflection_QueryAllProviderRequest) ProtoMethods() *protoiface.Meth
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryAllProviderRequest)
+		x := input.Message.Interface().(*QueryProviderEarningsRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2026,17 +2192,17 @@ func (x *fastReflection_QueryAllProviderRequest) ProtoMethods() *protoiface.Meth
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Pagination != nil {
-			encoded, err := options.Marshal(x.Pagination)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		if len(x.Service) > 0 {
+			i -= len(x.Service)
+			copy(dAtA[i:], x.Service)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Service)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Pubkey) > 0 {
+			i -= len(x.Pubkey)
+			copy(dAtA[i:], x.Pubkey)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Pubkey)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -2051,7 +2217,7 @@ func (x *fastReflection_QueryAllProviderRequest) ProtoMethods() *protoiface.Meth
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryAllProviderRequest)
+		x := input.Message.Interface().(*QueryProviderEarningsRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2083,17 +2249,17 @@ func (x *fastReflection_QueryAllProviderRequest) ProtoMethods() *protoiface.Meth
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAllProviderRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryProviderEarningsRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAllProviderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryProviderEarningsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pubkey", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -2103,27 +2269,55 @@ func (x *fastReflection_QueryAllProviderRequest) ProtoMethods() *protoiface.Meth
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Pagination == nil {
-					x.Pagination = &v1beta1.PageRequest{}
+				x.Pubkey = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
+				x.Service = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -2160,79 +2354,26 @@ func (x *fastReflection_QueryAllProviderRequest) ProtoMethods() *protoiface.Meth
 	}
 }
 
-var _ protoreflect.List = (*_QueryAllProviderResponse_1_list)(nil)
+var (
+	md_QueryProviderEarningsResponse          protoreflect.MessageDescriptor
+	fd_QueryProviderEarningsResponse_earnings protoreflect.FieldDescriptor
+)
 
-type _QueryAllProviderResponse_1_list struct {
-	list *[]*Provider
+func init() {
+	file_arkeo_arkeo_query_proto_init()
+	md_QueryProviderEarningsResponse = File_arkeo_arkeo_query_proto.Messages().ByName("QueryProviderEarningsResponse")
+	fd_QueryProviderEarningsResponse_earnings = md_QueryProviderEarningsResponse.Fields().ByName("earnings")
 }
 
-func (x *_QueryAllProviderResponse_1_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
+var _ protoreflect.Message = (*fastReflection_QueryProviderEarningsResponse)(nil)
 
-func (x *_QueryAllProviderResponse_1_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+type fastReflection_QueryProviderEarningsResponse QueryProviderEarningsResponse
+
+func (x *QueryProviderEarningsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryProviderEarningsResponse)(x)
 }
 
-func (x *_QueryAllProviderResponse_1_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*Provider)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_QueryAllProviderResponse_1_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*Provider)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_QueryAllProviderResponse_1_list) AppendMutable() protoreflect.Value {
-	v := new(Provider)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_QueryAllProviderResponse_1_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_QueryAllProviderResponse_1_list) NewElement() protoreflect.Value {
-	v := new(Provider)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_QueryAllProviderResponse_1_list) IsValid() bool {
-	return x.list != nil
-}
-
-var (
-	md_QueryAllProviderResponse            protoreflect.MessageDescriptor
-	fd_QueryAllProviderResponse_provider   protoreflect.FieldDescriptor
-	fd_QueryAllProviderResponse_pagination protoreflect.FieldDescriptor
-)
-
-func init() {
-	file_arkeo_arkeo_query_proto_init()
-	md_QueryAllProviderResponse = File_arkeo_arkeo_query_proto.Messages().ByName("QueryAllProviderResponse")
-	fd_QueryAllProviderResponse_provider = md_QueryAllProviderResponse.Fields().ByName("provider")
-	fd_QueryAllProviderResponse_pagination = md_QueryAllProviderResponse.Fields().ByName("pagination")
-}
-
-var _ protoreflect.Message = (*fastReflection_QueryAllProviderResponse)(nil)
-
-type fastReflection_QueryAllProviderResponse QueryAllProviderResponse
-
-func (x *QueryAllProviderResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryAllProviderResponse)(x)
-}
-
-func (x *QueryAllProviderResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryProviderEarningsResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_arkeo_arkeo_query_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2244,43 +2385,43 @@ func (x *QueryAllProviderResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryAllProviderResponse_messageType fastReflection_QueryAllProviderResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryAllProviderResponse_messageType{}
+var _fastReflection_QueryProviderEarningsResponse_messageType fastReflection_QueryProviderEarningsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryProviderEarningsResponse_messageType{}
 
-type fastReflection_QueryAllProviderResponse_messageType struct{}
+type fastReflection_QueryProviderEarningsResponse_messageType struct{}
 
-func (x fastReflection_QueryAllProviderResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryAllProviderResponse)(nil)
+func (x fastReflection_QueryProviderEarningsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryProviderEarningsResponse)(nil)
 }
-func (x fastReflection_QueryAllProviderResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryAllProviderResponse)
+func (x fastReflection_QueryProviderEarningsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryProviderEarningsResponse)
 }
-func (x fastReflection_QueryAllProviderResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryAllProviderResponse
+func (x fastReflection_QueryProviderEarningsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryProviderEarningsResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryAllProviderResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryAllProviderResponse
+func (x *fastReflection_QueryProviderEarningsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryProviderEarningsResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryAllProviderResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryAllProviderResponse_messageType
+func (x *fastReflection_QueryProviderEarningsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryProviderEarningsResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryAllProviderResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryAllProviderResponse)
+func (x *fastReflection_QueryProviderEarningsResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryProviderEarningsResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryAllProviderResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryAllProviderResponse)(x)
+func (x *fastReflection_QueryProviderEarningsResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryProviderEarningsResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2288,16 +2429,10 @@ func (x *fastReflection_QueryAllProviderResponse) Interface() protoreflect.Proto
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryAllProviderResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.Provider) != 0 {
-		value := protoreflect.ValueOfList(&_QueryAllProviderResponse_1_list{list: &x.Provider})
-		if !f(fd_QueryAllProviderResponse_provider, value) {
-			return
-		}
-	}
-	if x.Pagination != nil {
-		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-		if !f(fd_QueryAllProviderResponse_pagination, value) {
+func (x *fastReflection_QueryProviderEarningsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Earnings != nil {
+		value := protoreflect.ValueOfMessage(x.Earnings.ProtoReflect())
+		if !f(fd_QueryProviderEarningsResponse_earnings, value) {
 			return
 		}
 	}
@@ -2314,17 +2449,15 @@ func (x *fastReflection_QueryAllProviderResponse) Range(f func(protoreflect.Fiel
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryAllProviderResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryProviderEarningsResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "arkeo.arkeo.QueryAllProviderResponse.provider":
-		return len(x.Provider) != 0
-	case "arkeo.arkeo.QueryAllProviderResponse.pagination":
-		return x.Pagination != nil
+	case "arkeo.arkeo.QueryProviderEarningsResponse.earnings":
+		return x.Earnings != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryAllProviderResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryProviderEarningsResponse"))
 		}
-		panic(fmt.Errorf("message arkeo.arkeo.QueryAllProviderResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message arkeo.arkeo.QueryProviderEarningsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2334,17 +2467,15 @@ func (x *fastReflection_QueryAllProviderResponse) Has(fd protoreflect.FieldDescr
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAllProviderResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryProviderEarningsResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "arkeo.arkeo.QueryAllProviderResponse.provider":
-		x.Provider = nil
-	case "arkeo.arkeo.QueryAllProviderResponse.pagination":
-		x.Pagination = nil
+	case "arkeo.arkeo.QueryProviderEarningsResponse.earnings":
+		x.Earnings = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryAllProviderResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryProviderEarningsResponse"))
 		}
-		panic(fmt.Errorf("message arkeo.arkeo.QueryAllProviderResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message arkeo.arkeo.QueryProviderEarningsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2354,22 +2485,16 @@ func (x *fastReflection_QueryAllProviderResponse) Clear(fd protoreflect.FieldDes
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryAllProviderResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryProviderEarningsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "arkeo.arkeo.QueryAllProviderResponse.provider":
-		if len(x.Provider) == 0 {
-			return protoreflect.ValueOfList(&_QueryAllProviderResponse_1_list{})
-		}
-		listValue := &_QueryAllProviderResponse_1_list{list: &x.Provider}
-		return protoreflect.ValueOfList(listValue)
-	case "arkeo.arkeo.QueryAllProviderResponse.pagination":
-		value := x.Pagination
+	case "arkeo.arkeo.QueryProviderEarningsResponse.earnings":
+		value := x.Earnings
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryAllProviderResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryProviderEarningsResponse"))
 		}
-		panic(fmt.Errorf("message arkeo.arkeo.QueryAllProviderResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message arkeo.arkeo.QueryProviderEarningsResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2383,19 +2508,15 @@ func (x *fastReflection_QueryAllProviderResponse) Get(descriptor protoreflect.Fi
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAllProviderResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryProviderEarningsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "arkeo.arkeo.QueryAllProviderResponse.provider":
-		lv := value.List()
-		clv := lv.(*_QueryAllProviderResponse_1_list)
-		x.Provider = *clv.list
-	case "arkeo.arkeo.QueryAllProviderResponse.pagination":
-		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
+	case "arkeo.arkeo.QueryProviderEarningsResponse.earnings":
+		x.Earnings = value.Message().Interface().(*ProviderEarnings)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryAllProviderResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryProviderEarningsResponse"))
 		}
-		panic(fmt.Errorf("message arkeo.arkeo.QueryAllProviderResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message arkeo.arkeo.QueryProviderEarningsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2409,53 +2530,44 @@ func (x *fastReflection_QueryAllProviderResponse) Set(fd protoreflect.FieldDescr
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAllProviderResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryProviderEarningsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "arkeo.arkeo.QueryAllProviderResponse.provider":
-		if x.Provider == nil {
-			x.Provider = []*Provider{}
-		}
-		value := &_QueryAllProviderResponse_1_list{list: &x.Provider}
-		return protoreflect.ValueOfList(value)
-	case "arkeo.arkeo.QueryAllProviderResponse.pagination":
-		if x.Pagination == nil {
-			x.Pagination = new(v1beta1.PageResponse)
+	case "arkeo.arkeo.QueryProviderEarningsResponse.earnings":
+		if x.Earnings == nil {
+			x.Earnings = new(ProviderEarnings)
 		}
-		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		return protoreflect.ValueOfMessage(x.Earnings.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryAllProviderResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryProviderEarningsResponse"))
 		}
-		panic(fmt.Errorf("message arkeo.arkeo.QueryAllProviderResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message arkeo.arkeo.QueryProviderEarningsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryAllProviderResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryProviderEarningsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "arkeo.arkeo.QueryAllProviderResponse.provider":
-		list := []*Provider{}
-		return protoreflect.ValueOfList(&_QueryAllProviderResponse_1_list{list: &list})
-	case "arkeo.arkeo.QueryAllProviderResponse.pagination":
-		m := new(v1beta1.PageResponse)
+	case "arkeo.arkeo.QueryProviderEarningsResponse.earnings":
+		m := new(ProviderEarnings)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryAllProviderResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryProviderEarningsResponse"))
 		}
-		panic(fmt.Errorf("message arkeo.arkeo.QueryAllProviderResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message arkeo.arkeo.QueryProviderEarningsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryAllProviderResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryProviderEarningsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in arkeo.arkeo.QueryAllProviderResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in arkeo.arkeo.QueryProviderEarningsResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2463,7 +2575,7 @@ func (x *fastReflection_QueryAllProviderResponse) WhichOneof(d protoreflect.Oneo
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryAllProviderResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryProviderEarningsResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2474,7 +2586,7 @@ func (x *fastReflection_QueryAllProviderResponse) GetUnknown() protoreflect.RawF
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAllProviderResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryProviderEarningsResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2486,7 +2598,7 @@ func (x *fastReflection_QueryAllProviderResponse) SetUnknown(fields protoreflect
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryAllProviderResponse) IsValid() bool {
+func (x *fastReflection_QueryProviderEarningsResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -2496,9 +2608,9 @@ func (x *fastReflection_QueryAllProviderResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryAllProviderResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryProviderEarningsResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryAllProviderResponse)
+		x := input.Message.Interface().(*QueryProviderEarningsResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2510,14 +2622,8 @@ func (x *fastReflection_QueryAllProviderResponse) ProtoMethods() *protoiface.Met
 		var n int
 		var l int
 		_ = l
-		if len(x.Provider) > 0 {
-			for _, e := range x.Provider {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if x.Pagination != nil {
-			l = options.Size(x.Pagination)
+		if x.Earnings != nil {
+			l = options.Size(x.Earnings)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -2530,7 +2636,7 @@ func (x *fastReflection_QueryAllProviderResponse) ProtoMethods() *protoiface.Met
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryAllProviderResponse)
+		x := input.Message.Interface().(*QueryProviderEarningsResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2549,8 +2655,8 @@ func (x *fastReflection_QueryAllProviderResponse) ProtoMethods() *protoiface.Met
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Pagination != nil {
-			encoded, err := options.Marshal(x.Pagination)
+		if x.Earnings != nil {
+			encoded, err := options.Marshal(x.Earnings)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2561,23 +2667,7 @@ func (x *fastReflection_QueryAllProviderResponse) ProtoMethods() *protoiface.Met
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x12
-		}
-		if len(x.Provider) > 0 {
-			for iNdEx := len(x.Provider) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Provider[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0xa
-			}
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -2590,7 +2680,7 @@ func (x *fastReflection_QueryAllProviderResponse) ProtoMethods() *protoiface.Met
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryAllProviderResponse)
+		x := input.Message.Interface().(*QueryProviderEarningsResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2622,49 +2712,15 @@ func (x *fastReflection_QueryAllProviderResponse) ProtoMethods() *protoiface.Met
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAllProviderResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryProviderEarningsResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAllProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryProviderEarningsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Provider = append(x.Provider, &Provider{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Provider[len(x.Provider)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Earnings", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -2691,10 +2747,10 @@ func (x *fastReflection_QueryAllProviderResponse) ProtoMethods() *protoiface.Met
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Pagination == nil {
-					x.Pagination = &v1beta1.PageResponse{}
+				if x.Earnings == nil {
+					x.Earnings = &ProviderEarnings{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Earnings); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -2734,25 +2790,25 @@ func (x *fastReflection_QueryAllProviderResponse) ProtoMethods() *protoiface.Met
 }
 
 var (
-	md_QueryFetchContractRequest             protoreflect.MessageDescriptor
-	fd_QueryFetchContractRequest_contract_id protoreflect.FieldDescriptor
+	md_QueryAllProviderRequest            protoreflect.MessageDescriptor
+	fd_QueryAllProviderRequest_pagination protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_arkeo_arkeo_query_proto_init()
-	md_QueryFetchContractRequest = File_arkeo_arkeo_query_proto.Messages().ByName("QueryFetchContractRequest")
-	fd_QueryFetchContractRequest_contract_id = md_QueryFetchContractRequest.Fields().ByName("contract_id")
+	md_QueryAllProviderRequest = File_arkeo_arkeo_query_proto.Messages().ByName("QueryAllProviderRequest")
+	fd_QueryAllProviderRequest_pagination = md_QueryAllProviderRequest.Fields().ByName("pagination")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryFetchContractRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryAllProviderRequest)(nil)
 
-type fastReflection_QueryFetchContractRequest QueryFetchContractRequest
+type fastReflection_QueryAllProviderRequest QueryAllProviderRequest
 
-func (x *QueryFetchContractRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryFetchContractRequest)(x)
+func (x *QueryAllProviderRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryAllProviderRequest)(x)
 }
 
-func (x *QueryFetchContractRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryAllProviderRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_arkeo_arkeo_query_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2764,43 +2820,43 @@ func (x *QueryFetchContractRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryFetchContractRequest_messageType fastReflection_QueryFetchContractRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryFetchContractRequest_messageType{}
+var _fastReflection_QueryAllProviderRequest_messageType fastReflection_QueryAllProviderRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryAllProviderRequest_messageType{}
 
-type fastReflection_QueryFetchContractRequest_messageType struct{}
+type fastReflection_QueryAllProviderRequest_messageType struct{}
 
-func (x fastReflection_QueryFetchContractRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryFetchContractRequest)(nil)
+func (x fastReflection_QueryAllProviderRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryAllProviderRequest)(nil)
 }
-func (x fastReflection_QueryFetchContractRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryFetchContractRequest)
+func (x fastReflection_QueryAllProviderRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryAllProviderRequest)
 }
-func (x fastReflection_QueryFetchContractRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryFetchContractRequest
+func (x fastReflection_QueryAllProviderRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryAllProviderRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryFetchContractRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryFetchContractRequest
+func (x *fastReflection_QueryAllProviderRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryAllProviderRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryFetchContractRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryFetchContractRequest_messageType
-}
+func (x *fastReflection_QueryAllProviderRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryAllProviderRequest_messageType
+}
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryFetchContractRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryFetchContractRequest)
+func (x *fastReflection_QueryAllProviderRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryAllProviderRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryFetchContractRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryFetchContractRequest)(x)
+func (x *fastReflection_QueryAllProviderRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryAllProviderRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2808,10 +2864,10 @@ func (x *fastReflection_QueryFetchContractRequest) Interface() protoreflect.Prot
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryFetchContractRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.ContractId != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.ContractId)
-		if !f(fd_QueryFetchContractRequest_contract_id, value) {
+func (x *fastReflection_QueryAllProviderRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryAllProviderRequest_pagination, value) {
 			return
 		}
 	}
@@ -2828,15 +2884,15 @@ func (x *fastReflection_QueryFetchContractRequest) Range(f func(protoreflect.Fie
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryFetchContractRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryAllProviderRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "arkeo.arkeo.QueryFetchContractRequest.contract_id":
-		return x.ContractId != uint64(0)
+	case "arkeo.arkeo.QueryAllProviderRequest.pagination":
+		return x.Pagination != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryFetchContractRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryAllProviderRequest"))
 		}
-		panic(fmt.Errorf("message arkeo.arkeo.QueryFetchContractRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message arkeo.arkeo.QueryAllProviderRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2846,15 +2902,15 @@ func (x *fastReflection_QueryFetchContractRequest) Has(fd protoreflect.FieldDesc
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryFetchContractRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryAllProviderRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "arkeo.arkeo.QueryFetchContractRequest.contract_id":
-		x.ContractId = uint64(0)
+	case "arkeo.arkeo.QueryAllProviderRequest.pagination":
+		x.Pagination = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryFetchContractRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryAllProviderRequest"))
 		}
-		panic(fmt.Errorf("message arkeo.arkeo.QueryFetchContractRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message arkeo.arkeo.QueryAllProviderRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2864,16 +2920,16 @@ func (x *fastReflection_QueryFetchContractRequest) Clear(fd protoreflect.FieldDe
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryFetchContractRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryAllProviderRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "arkeo.arkeo.QueryFetchContractRequest.contract_id":
-		value := x.ContractId
-		return protoreflect.ValueOfUint64(value)
+	case "arkeo.arkeo.QueryAllProviderRequest.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryFetchContractRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryAllProviderRequest"))
 		}
-		panic(fmt.Errorf("message arkeo.arkeo.QueryFetchContractRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message arkeo.arkeo.QueryAllProviderRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2887,15 +2943,15 @@ func (x *fastReflection_QueryFetchContractRequest) Get(descriptor protoreflect.F
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryFetchContractRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryAllProviderRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "arkeo.arkeo.QueryFetchContractRequest.contract_id":
-		x.ContractId = value.Uint()
+	case "arkeo.arkeo.QueryAllProviderRequest.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryFetchContractRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryAllProviderRequest"))
 		}
-		panic(fmt.Errorf("message arkeo.arkeo.QueryFetchContractRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message arkeo.arkeo.QueryAllProviderRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2909,40 +2965,44 @@ func (x *fastReflection_QueryFetchContractRequest) Set(fd protoreflect.FieldDesc
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryFetchContractRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryAllProviderRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "arkeo.arkeo.QueryFetchContractRequest.contract_id":
-		panic(fmt.Errorf("field contract_id of message arkeo.arkeo.QueryFetchContractRequest is not mutable"))
+	case "arkeo.arkeo.QueryAllProviderRequest.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageRequest)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryFetchContractRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryAllProviderRequest"))
 		}
-		panic(fmt.Errorf("message arkeo.arkeo.QueryFetchContractRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message arkeo.arkeo.QueryAllProviderRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryFetchContractRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryAllProviderRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "arkeo.arkeo.QueryFetchContractRequest.contract_id":
-		return protoreflect.ValueOfUint64(uint64(0))
+	case "arkeo.arkeo.QueryAllProviderRequest.pagination":
+		m := new(v1beta1.PageRequest)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryFetchContractRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryAllProviderRequest"))
 		}
-		panic(fmt.Errorf("message arkeo.arkeo.QueryFetchContractRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message arkeo.arkeo.QueryAllProviderRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryFetchContractRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryAllProviderRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in arkeo.arkeo.QueryFetchContractRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in arkeo.arkeo.QueryAllProviderRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2950,7 +3010,7 @@ func (x *fastReflection_QueryFetchContractRequest) WhichOneof(d protoreflect.One
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryFetchContractRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryAllProviderRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2961,7 +3021,7 @@ func (x *fastReflection_QueryFetchContractRequest) GetUnknown() protoreflect.Raw
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryFetchContractRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryAllProviderRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2973,7 +3033,7 @@ func (x *fastReflection_QueryFetchContractRequest) SetUnknown(fields protoreflec
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryFetchContractRequest) IsValid() bool {
+func (x *fastReflection_QueryAllProviderRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -2983,9 +3043,9 @@ func (x *fastReflection_QueryFetchContractRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryFetchContractRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryAllProviderRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryFetchContractRequest)
+		x := input.Message.Interface().(*QueryAllProviderRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2997,8 +3057,9 @@ func (x *fastReflection_QueryFetchContractRequest) ProtoMethods() *protoiface.Me
 		var n int
 		var l int
 		_ = l
-		if x.ContractId != 0 {
-			n += 1 + runtime.Sov(uint64(x.ContractId))
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -3010,7 +3071,7 @@ func (x *fastReflection_QueryFetchContractRequest) ProtoMethods() *protoiface.Me
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryFetchContractRequest)
+		x := input.Message.Interface().(*QueryAllProviderRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3029,10 +3090,19 @@ func (x *fastReflection_QueryFetchContractRequest) ProtoMethods() *protoiface.Me
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.ContractId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.ContractId))
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x8
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -3045,7 +3115,7 @@ func (x *fastReflection_QueryFetchContractRequest) ProtoMethods() *protoiface.Me
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryFetchContractRequest)
+		x := input.Message.Interface().(*QueryAllProviderRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3077,17 +3147,17 @@ func (x *fastReflection_QueryFetchContractRequest) ProtoMethods() *protoiface.Me
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryFetchContractRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAllProviderRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryFetchContractRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAllProviderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 				}
-				x.ContractId = 0
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3097,11 +3167,28 @@ func (x *fastReflection_QueryFetchContractRequest) ProtoMethods() *protoiface.Me
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.ContractId |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageRequest{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -3137,26 +3224,79 @@ func (x *fastReflection_QueryFetchContractRequest) ProtoMethods() *protoiface.Me
 	}
 }
 
+var _ protoreflect.List = (*_QueryAllProviderResponse_1_list)(nil)
+
+type _QueryAllProviderResponse_1_list struct {
+	list *[]*Provider
+}
+
+func (x *_QueryAllProviderResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryAllProviderResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryAllProviderResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Provider)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryAllProviderResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Provider)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryAllProviderResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(Provider)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryAllProviderResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryAllProviderResponse_1_list) NewElement() protoreflect.Value {
+	v := new(Provider)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryAllProviderResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_QueryFetchContractResponse          protoreflect.MessageDescriptor
-	fd_QueryFetchContractResponse_contract protoreflect.FieldDescriptor
+	md_QueryAllProviderResponse            protoreflect.MessageDescriptor
+	fd_QueryAllProviderResponse_provider   protoreflect.FieldDescriptor
+	fd_QueryAllProviderResponse_pagination protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_arkeo_arkeo_query_proto_init()
-	md_QueryFetchContractResponse = File_arkeo_arkeo_query_proto.Messages().ByName("QueryFetchContractResponse")
-	fd_QueryFetchContractResponse_contract = md_QueryFetchContractResponse.Fields().ByName("contract")
+	md_QueryAllProviderResponse = File_arkeo_arkeo_query_proto.Messages().ByName("QueryAllProviderResponse")
+	fd_QueryAllProviderResponse_provider = md_QueryAllProviderResponse.Fields().ByName("provider")
+	fd_QueryAllProviderResponse_pagination = md_QueryAllProviderResponse.Fields().ByName("pagination")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryFetchContractResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryAllProviderResponse)(nil)
 
-type fastReflection_QueryFetchContractResponse QueryFetchContractResponse
+type fastReflection_QueryAllProviderResponse QueryAllProviderResponse
 
-func (x *QueryFetchContractResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryFetchContractResponse)(x)
+func (x *QueryAllProviderResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryAllProviderResponse)(x)
 }
 
-func (x *QueryFetchContractResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryAllProviderResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_arkeo_arkeo_query_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3168,43 +3308,43 @@ func (x *QueryFetchContractResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryFetchContractResponse_messageType fastReflection_QueryFetchContractResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryFetchContractResponse_messageType{}
+var _fastReflection_QueryAllProviderResponse_messageType fastReflection_QueryAllProviderResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryAllProviderResponse_messageType{}
 
-type fastReflection_QueryFetchContractResponse_messageType struct{}
+type fastReflection_QueryAllProviderResponse_messageType struct{}
 
-func (x fastReflection_QueryFetchContractResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryFetchContractResponse)(nil)
+func (x fastReflection_QueryAllProviderResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryAllProviderResponse)(nil)
 }
-func (x fastReflection_QueryFetchContractResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryFetchContractResponse)
+func (x fastReflection_QueryAllProviderResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryAllProviderResponse)
 }
-func (x fastReflection_QueryFetchContractResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryFetchContractResponse
+func (x fastReflection_QueryAllProviderResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryAllProviderResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryFetchContractResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryFetchContractResponse
+func (x *fastReflection_QueryAllProviderResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryAllProviderResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryFetchContractResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryFetchContractResponse_messageType
+func (x *fastReflection_QueryAllProviderResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryAllProviderResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryFetchContractResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryFetchContractResponse)
+func (x *fastReflection_QueryAllProviderResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryAllProviderResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryFetchContractResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryFetchContractResponse)(x)
+func (x *fastReflection_QueryAllProviderResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryAllProviderResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3212,10 +3352,16 @@ func (x *fastReflection_QueryFetchContractResponse) Interface() protoreflect.Pro
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryFetchContractResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Contract != nil {
-		value := protoreflect.ValueOfMessage(x.Contract.ProtoReflect())
-		if !f(fd_QueryFetchContractResponse_contract, value) {
+func (x *fastReflection_QueryAllProviderResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Provider) != 0 {
+		value := protoreflect.ValueOfList(&_QueryAllProviderResponse_1_list{list: &x.Provider})
+		if !f(fd_QueryAllProviderResponse_provider, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryAllProviderResponse_pagination, value) {
 			return
 		}
 	}
@@ -3232,15 +3378,17 @@ func (x *fastReflection_QueryFetchContractResponse) Range(f func(protoreflect.Fi
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryFetchContractResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryAllProviderResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "arkeo.arkeo.QueryFetchContractResponse.contract":
-		return x.Contract != nil
+	case "arkeo.arkeo.QueryAllProviderResponse.provider":
+		return len(x.Provider) != 0
+	case "arkeo.arkeo.QueryAllProviderResponse.pagination":
+		return x.Pagination != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryFetchContractResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryAllProviderResponse"))
 		}
-		panic(fmt.Errorf("message arkeo.arkeo.QueryFetchContractResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message arkeo.arkeo.QueryAllProviderResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3250,15 +3398,17 @@ func (x *fastReflection_QueryFetchContractResponse) Has(fd protoreflect.FieldDes
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryFetchContractResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryAllProviderResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "arkeo.arkeo.QueryFetchContractResponse.contract":
-		x.Contract = nil
+	case "arkeo.arkeo.QueryAllProviderResponse.provider":
+		x.Provider = nil
+	case "arkeo.arkeo.QueryAllProviderResponse.pagination":
+		x.Pagination = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryFetchContractResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryAllProviderResponse"))
 		}
-		panic(fmt.Errorf("message arkeo.arkeo.QueryFetchContractResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message arkeo.arkeo.QueryAllProviderResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3268,16 +3418,22 @@ func (x *fastReflection_QueryFetchContractResponse) Clear(fd protoreflect.FieldD
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryFetchContractResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryAllProviderResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "arkeo.arkeo.QueryFetchContractResponse.contract":
-		value := x.Contract
+	case "arkeo.arkeo.QueryAllProviderResponse.provider":
+		if len(x.Provider) == 0 {
+			return protoreflect.ValueOfList(&_QueryAllProviderResponse_1_list{})
+		}
+		listValue := &_QueryAllProviderResponse_1_list{list: &x.Provider}
+		return protoreflect.ValueOfList(listValue)
+	case "arkeo.arkeo.QueryAllProviderResponse.pagination":
+		value := x.Pagination
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryFetchContractResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryAllProviderResponse"))
 		}
-		panic(fmt.Errorf("message arkeo.arkeo.QueryFetchContractResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message arkeo.arkeo.QueryAllProviderResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3291,15 +3447,19 @@ func (x *fastReflection_QueryFetchContractResponse) Get(descriptor protoreflect.
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryFetchContractResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryAllProviderResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "arkeo.arkeo.QueryFetchContractResponse.contract":
-		x.Contract = value.Message().Interface().(*Contract)
+	case "arkeo.arkeo.QueryAllProviderResponse.provider":
+		lv := value.List()
+		clv := lv.(*_QueryAllProviderResponse_1_list)
+		x.Provider = *clv.list
+	case "arkeo.arkeo.QueryAllProviderResponse.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryFetchContractResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryAllProviderResponse"))
 		}
-		panic(fmt.Errorf("message arkeo.arkeo.QueryFetchContractResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message arkeo.arkeo.QueryAllProviderResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3313,44 +3473,53 @@ func (x *fastReflection_QueryFetchContractResponse) Set(fd protoreflect.FieldDes
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryFetchContractResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryAllProviderResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "arkeo.arkeo.QueryFetchContractResponse.contract":
-		if x.Contract == nil {
-			x.Contract = new(Contract)
+	case "arkeo.arkeo.QueryAllProviderResponse.provider":
+		if x.Provider == nil {
+			x.Provider = []*Provider{}
 		}
-		return protoreflect.ValueOfMessage(x.Contract.ProtoReflect())
+		value := &_QueryAllProviderResponse_1_list{list: &x.Provider}
+		return protoreflect.ValueOfList(value)
+	case "arkeo.arkeo.QueryAllProviderResponse.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageResponse)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryFetchContractResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryAllProviderResponse"))
 		}
-		panic(fmt.Errorf("message arkeo.arkeo.QueryFetchContractResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message arkeo.arkeo.QueryAllProviderResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryFetchContractResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryAllProviderResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "arkeo.arkeo.QueryFetchContractResponse.contract":
-		m := new(Contract)
+	case "arkeo.arkeo.QueryAllProviderResponse.provider":
+		list := []*Provider{}
+		return protoreflect.ValueOfList(&_QueryAllProviderResponse_1_list{list: &list})
+	case "arkeo.arkeo.QueryAllProviderResponse.pagination":
+		m := new(v1beta1.PageResponse)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryFetchContractResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryAllProviderResponse"))
 		}
-		panic(fmt.Errorf("message arkeo.arkeo.QueryFetchContractResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message arkeo.arkeo.QueryAllProviderResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryFetchContractResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryAllProviderResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in arkeo.arkeo.QueryFetchContractResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in arkeo.arkeo.QueryAllProviderResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3358,7 +3527,7 @@ func (x *fastReflection_QueryFetchContractResponse) WhichOneof(d protoreflect.On
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryFetchContractResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryAllProviderResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3369,7 +3538,7 @@ func (x *fastReflection_QueryFetchContractResponse) GetUnknown() protoreflect.Ra
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryFetchContractResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryAllProviderResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3381,7 +3550,7 @@ func (x *fastReflection_QueryFetchContractResponse) SetUnknown(fields protorefle
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryFetchContractResponse) IsValid() bool {
+func (x *fastReflection_QueryAllProviderResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -3391,9 +3560,9 @@ func (x *fastReflection_QueryFetchContractResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryFetchContractResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryAllProviderResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryFetchContractResponse)
+		x := input.Message.Interface().(*QueryAllProviderResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3405,8 +3574,14 @@ func (x *fastReflection_QueryFetchContractResponse) ProtoMethods() *protoiface.M
 		var n int
 		var l int
 		_ = l
-		if x.Contract != nil {
-			l = options.Size(x.Contract)
+		if len(x.Provider) > 0 {
+			for _, e := range x.Provider {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -3419,7 +3594,7 @@ func (x *fastReflection_QueryFetchContractResponse) ProtoMethods() *protoiface.M
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryFetchContractResponse)
+		x := input.Message.Interface().(*QueryAllProviderResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3438,8 +3613,8 @@ func (x *fastReflection_QueryFetchContractResponse) ProtoMethods() *protoiface.M
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Contract != nil {
-			encoded, err := options.Marshal(x.Contract)
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3450,7 +3625,23 @@ func (x *fastReflection_QueryFetchContractResponse) ProtoMethods() *protoiface.M
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x12
+		}
+		if len(x.Provider) > 0 {
+			for iNdEx := len(x.Provider) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Provider[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -3463,7 +3654,7 @@ func (x *fastReflection_QueryFetchContractResponse) ProtoMethods() *protoiface.M
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryFetchContractResponse)
+		x := input.Message.Interface().(*QueryAllProviderResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3495,15 +3686,15 @@ func (x *fastReflection_QueryFetchContractResponse) ProtoMethods() *protoiface.M
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryFetchContractResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAllProviderResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryFetchContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAllProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -3530,10 +3721,44 @@ func (x *fastReflection_QueryFetchContractResponse) ProtoMethods() *protoiface.M
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Contract == nil {
-					x.Contract = &Contract{}
+				x.Provider = append(x.Provider, &Provider{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Provider[len(x.Provider)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Contract); err != nil {
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageResponse{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -3573,25 +3798,25 @@ func (x *fastReflection_QueryFetchContractResponse) ProtoMethods() *protoiface.M
 }
 
 var (
-	md_QueryAllContractRequest            protoreflect.MessageDescriptor
-	fd_QueryAllContractRequest_pagination protoreflect.FieldDescriptor
+	md_QueryFetchContractRequest             protoreflect.MessageDescriptor
+	fd_QueryFetchContractRequest_contract_id protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_arkeo_arkeo_query_proto_init()
-	md_QueryAllContractRequest = File_arkeo_arkeo_query_proto.Messages().ByName("QueryAllContractRequest")
-	fd_QueryAllContractRequest_pagination = md_QueryAllContractRequest.Fields().ByName("pagination")
+	md_QueryFetchContractRequest = File_arkeo_arkeo_query_proto.Messages().ByName("QueryFetchContractRequest")
+	fd_QueryFetchContractRequest_contract_id = md_QueryFetchContractRequest.Fields().ByName("contract_id")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryAllContractRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryFetchContractRequest)(nil)
 
-type fastReflection_QueryAllContractRequest QueryAllContractRequest
+type fastReflection_QueryFetchContractRequest QueryFetchContractRequest
 
-func (x *QueryAllContractRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryAllContractRequest)(x)
+func (x *QueryFetchContractRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryFetchContractRequest)(x)
 }
 
-func (x *QueryAllContractRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryFetchContractRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_arkeo_arkeo_query_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3603,43 +3828,43 @@ func (x *QueryAllContractRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryAllContractRequest_messageType fastReflection_QueryAllContractRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryAllContractRequest_messageType{}
+var _fastReflection_QueryFetchContractRequest_messageType fastReflection_QueryFetchContractRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryFetchContractRequest_messageType{}
 
-type fastReflection_QueryAllContractRequest_messageType struct{}
+type fastReflection_QueryFetchContractRequest_messageType struct{}
 
-func (x fastReflection_QueryAllContractRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryAllContractRequest)(nil)
+func (x fastReflection_QueryFetchContractRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryFetchContractRequest)(nil)
 }
-func (x fastReflection_QueryAllContractRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryAllContractRequest)
+func (x fastReflection_QueryFetchContractRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryFetchContractRequest)
 }
-func (x fastReflection_QueryAllContractRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryAllContractRequest
+func (x fastReflection_QueryFetchContractRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryFetchContractRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryAllContractRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryAllContractRequest
+func (x *fastReflection_QueryFetchContractRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryFetchContractRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryAllContractRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryAllContractRequest_messageType
+func (x *fastReflection_QueryFetchContractRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryFetchContractRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryAllContractRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryAllContractRequest)
+func (x *fastReflection_QueryFetchContractRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryFetchContractRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryAllContractRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryAllContractRequest)(x)
+func (x *fastReflection_QueryFetchContractRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryFetchContractRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3647,10 +3872,10 @@ func (x *fastReflection_QueryAllContractRequest) Interface() protoreflect.ProtoM
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryAllContractRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Pagination != nil {
-		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-		if !f(fd_QueryAllContractRequest_pagination, value) {
+func (x *fastReflection_QueryFetchContractRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ContractId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ContractId)
+		if !f(fd_QueryFetchContractRequest_contract_id, value) {
 			return
 		}
 	}
@@ -3667,15 +3892,15 @@ func (x *fastReflection_QueryAllContractRequest) Range(f func(protoreflect.Field
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryAllContractRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryFetchContractRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "arkeo.arkeo.QueryAllContractRequest.pagination":
-		return x.Pagination != nil
+	case "arkeo.arkeo.QueryFetchContractRequest.contract_id":
+		return x.ContractId != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryAllContractRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryFetchContractRequest"))
 		}
-		panic(fmt.Errorf("message arkeo.arkeo.QueryAllContractRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message arkeo.arkeo.QueryFetchContractRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3685,15 +3910,15 @@ func (x *fastReflection_QueryAllContractRequest) Has(fd protoreflect.FieldDescri
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAllContractRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryFetchContractRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "arkeo.arkeo.QueryAllContractRequest.pagination":
-		x.Pagination = nil
+	case "arkeo.arkeo.QueryFetchContractRequest.contract_id":
+		x.ContractId = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryAllContractRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryFetchContractRequest"))
 		}
-		panic(fmt.Errorf("message arkeo.arkeo.QueryAllContractRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message arkeo.arkeo.QueryFetchContractRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3703,16 +3928,16 @@ func (x *fastReflection_QueryAllContractRequest) Clear(fd protoreflect.FieldDesc
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryAllContractRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryFetchContractRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "arkeo.arkeo.QueryAllContractRequest.pagination":
-		value := x.Pagination
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "arkeo.arkeo.QueryFetchContractRequest.contract_id":
+		value := x.ContractId
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryAllContractRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryFetchContractRequest"))
 		}
-		panic(fmt.Errorf("message arkeo.arkeo.QueryAllContractRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message arkeo.arkeo.QueryFetchContractRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3726,15 +3951,15 @@ func (x *fastReflection_QueryAllContractRequest) Get(descriptor protoreflect.Fie
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAllContractRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryFetchContractRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "arkeo.arkeo.QueryAllContractRequest.pagination":
-		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
+	case "arkeo.arkeo.QueryFetchContractRequest.contract_id":
+		x.ContractId = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryAllContractRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryFetchContractRequest"))
 		}
-		panic(fmt.Errorf("message arkeo.arkeo.QueryAllContractRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message arkeo.arkeo.QueryFetchContractRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3748,44 +3973,40 @@ func (x *fastReflection_QueryAllContractRequest) Set(fd protoreflect.FieldDescri
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAllContractRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryFetchContractRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "arkeo.arkeo.QueryAllContractRequest.pagination":
-		if x.Pagination == nil {
-			x.Pagination = new(v1beta1.PageRequest)
-		}
-		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	case "arkeo.arkeo.QueryFetchContractRequest.contract_id":
+		panic(fmt.Errorf("field contract_id of message arkeo.arkeo.QueryFetchContractRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryAllContractRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryFetchContractRequest"))
 		}
-		panic(fmt.Errorf("message arkeo.arkeo.QueryAllContractRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message arkeo.arkeo.QueryFetchContractRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryAllContractRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryFetchContractRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "arkeo.arkeo.QueryAllContractRequest.pagination":
-		m := new(v1beta1.PageRequest)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "arkeo.arkeo.QueryFetchContractRequest.contract_id":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryAllContractRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryFetchContractRequest"))
 		}
-		panic(fmt.Errorf("message arkeo.arkeo.QueryAllContractRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message arkeo.arkeo.QueryFetchContractRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryAllContractRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryFetchContractRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in arkeo.arkeo.QueryAllContractRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in arkeo.arkeo.QueryFetchContractRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3793,7 +4014,7 @@ func (x *fastReflection_QueryAllContractRequest) WhichOneof(d protoreflect.Oneof
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryAllContractRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryFetchContractRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3804,7 +4025,7 @@ func (x *fastReflection_QueryAllContractRequest) GetUnknown() protoreflect.RawFi
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAllContractRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryFetchContractRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3816,7 +4037,7 @@ func (x *fastReflection_QueryAllContractRequest) SetUnknown(fields protoreflect.
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryAllContractRequest) IsValid() bool {
+func (x *fastReflection_QueryFetchContractRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -3826,9 +4047,9 @@ func (x *fastReflection_QueryAllContractRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryAllContractRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryFetchContractRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryAllContractRequest)
+		x := input.Message.Interface().(*QueryFetchContractRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3840,9 +4061,8 @@ func (x *fastReflection_QueryAllContractRequest) ProtoMethods() *protoiface.Meth
 		var n int
 		var l int
 		_ = l
-		if x.Pagination != nil {
-			l = options.Size(x.Pagination)
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.ContractId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ContractId))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -3854,7 +4074,7 @@ func (x *fastReflection_QueryAllContractRequest) ProtoMethods() *protoiface.Meth
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryAllContractRequest)
+		x := input.Message.Interface().(*QueryFetchContractRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3873,19 +4093,10 @@ func (x *fastReflection_QueryAllContractRequest) ProtoMethods() *protoiface.Meth
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Pagination != nil {
-			encoded, err := options.Marshal(x.Pagination)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		if x.ContractId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ContractId))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -3898,7 +4109,7 @@ func (x *fastReflection_QueryAllContractRequest) ProtoMethods() *protoiface.Meth
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryAllContractRequest)
+		x := input.Message.Interface().(*QueryFetchContractRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3930,17 +4141,17 @@ func (x *fastReflection_QueryAllContractRequest) ProtoMethods() *protoiface.Meth
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAllContractRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryFetchContractRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAllContractRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryFetchContractRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
 				}
-				var msglen int
+				x.ContractId = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3950,28 +4161,11 @@ func (x *fastReflection_QueryAllContractRequest) ProtoMethods() *protoiface.Meth
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					x.ContractId |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.Pagination == nil {
-					x.Pagination = &v1beta1.PageRequest{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -4007,79 +4201,30 @@ func (x *fastReflection_QueryAllContractRequest) ProtoMethods() *protoiface.Meth
 	}
 }
 
-var _ protoreflect.List = (*_QueryAllContractResponse_1_list)(nil)
-
-type _QueryAllContractResponse_1_list struct {
-	list *[]*Contract
-}
-
-func (x *_QueryAllContractResponse_1_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_QueryAllContractResponse_1_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_QueryAllContractResponse_1_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*Contract)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_QueryAllContractResponse_1_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*Contract)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_QueryAllContractResponse_1_list) AppendMutable() protoreflect.Value {
-	v := new(Contract)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_QueryAllContractResponse_1_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_QueryAllContractResponse_1_list) NewElement() protoreflect.Value {
-	v := new(Contract)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_QueryAllContractResponse_1_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
-	md_QueryAllContractResponse            protoreflect.MessageDescriptor
-	fd_QueryAllContractResponse_contract   protoreflect.FieldDescriptor
-	fd_QueryAllContractResponse_pagination protoreflect.FieldDescriptor
+	md_QueryFetchContractResponse                       protoreflect.MessageDescriptor
+	fd_QueryFetchContractResponse_contract              protoreflect.FieldDescriptor
+	fd_QueryFetchContractResponse_settlement_period_end protoreflect.FieldDescriptor
+	fd_QueryFetchContractResponse_pending               protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_arkeo_arkeo_query_proto_init()
-	md_QueryAllContractResponse = File_arkeo_arkeo_query_proto.Messages().ByName("QueryAllContractResponse")
-	fd_QueryAllContractResponse_contract = md_QueryAllContractResponse.Fields().ByName("contract")
-	fd_QueryAllContractResponse_pagination = md_QueryAllContractResponse.Fields().ByName("pagination")
+	md_QueryFetchContractResponse = File_arkeo_arkeo_query_proto.Messages().ByName("QueryFetchContractResponse")
+	fd_QueryFetchContractResponse_contract = md_QueryFetchContractResponse.Fields().ByName("contract")
+	fd_QueryFetchContractResponse_settlement_period_end = md_QueryFetchContractResponse.Fields().ByName("settlement_period_end")
+	fd_QueryFetchContractResponse_pending = md_QueryFetchContractResponse.Fields().ByName("pending")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryAllContractResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryFetchContractResponse)(nil)
 
-type fastReflection_QueryAllContractResponse QueryAllContractResponse
+type fastReflection_QueryFetchContractResponse QueryFetchContractResponse
 
-func (x *QueryAllContractResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryAllContractResponse)(x)
+func (x *QueryFetchContractResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryFetchContractResponse)(x)
 }
 
-func (x *QueryAllContractResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryFetchContractResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_arkeo_arkeo_query_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4091,43 +4236,43 @@ func (x *QueryAllContractResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryAllContractResponse_messageType fastReflection_QueryAllContractResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryAllContractResponse_messageType{}
+var _fastReflection_QueryFetchContractResponse_messageType fastReflection_QueryFetchContractResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryFetchContractResponse_messageType{}
 
-type fastReflection_QueryAllContractResponse_messageType struct{}
+type fastReflection_QueryFetchContractResponse_messageType struct{}
 
-func (x fastReflection_QueryAllContractResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryAllContractResponse)(nil)
+func (x fastReflection_QueryFetchContractResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryFetchContractResponse)(nil)
 }
-func (x fastReflection_QueryAllContractResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryAllContractResponse)
+func (x fastReflection_QueryFetchContractResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryFetchContractResponse)
 }
-func (x fastReflection_QueryAllContractResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryAllContractResponse
+func (x fastReflection_QueryFetchContractResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryFetchContractResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryAllContractResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryAllContractResponse
+func (x *fastReflection_QueryFetchContractResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryFetchContractResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryAllContractResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryAllContractResponse_messageType
+func (x *fastReflection_QueryFetchContractResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryFetchContractResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryAllContractResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryAllContractResponse)
+func (x *fastReflection_QueryFetchContractResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryFetchContractResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryAllContractResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryAllContractResponse)(x)
+func (x *fastReflection_QueryFetchContractResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryFetchContractResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4135,16 +4280,22 @@ func (x *fastReflection_QueryAllContractResponse) Interface() protoreflect.Proto
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryAllContractResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.Contract) != 0 {
-		value := protoreflect.ValueOfList(&_QueryAllContractResponse_1_list{list: &x.Contract})
-		if !f(fd_QueryAllContractResponse_contract, value) {
+func (x *fastReflection_QueryFetchContractResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Contract != nil {
+		value := protoreflect.ValueOfMessage(x.Contract.ProtoReflect())
+		if !f(fd_QueryFetchContractResponse_contract, value) {
 			return
 		}
 	}
-	if x.Pagination != nil {
-		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-		if !f(fd_QueryAllContractResponse_pagination, value) {
+	if x.SettlementPeriodEnd != int64(0) {
+		value := protoreflect.ValueOfInt64(x.SettlementPeriodEnd)
+		if !f(fd_QueryFetchContractResponse_settlement_period_end, value) {
+			return
+		}
+	}
+	if x.Pending != false {
+		value := protoreflect.ValueOfBool(x.Pending)
+		if !f(fd_QueryFetchContractResponse_pending, value) {
 			return
 		}
 	}
@@ -4161,17 +4312,19 @@ func (x *fastReflection_QueryAllContractResponse) Range(f func(protoreflect.Fiel
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryAllContractResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryFetchContractResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "arkeo.arkeo.QueryAllContractResponse.contract":
-		return len(x.Contract) != 0
-	case "arkeo.arkeo.QueryAllContractResponse.pagination":
-		return x.Pagination != nil
+	case "arkeo.arkeo.QueryFetchContractResponse.contract":
+		return x.Contract != nil
+	case "arkeo.arkeo.QueryFetchContractResponse.settlement_period_end":
+		return x.SettlementPeriodEnd != int64(0)
+	case "arkeo.arkeo.QueryFetchContractResponse.pending":
+		return x.Pending != false
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryAllContractResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryFetchContractResponse"))
 		}
-		panic(fmt.Errorf("message arkeo.arkeo.QueryAllContractResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message arkeo.arkeo.QueryFetchContractResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4181,17 +4334,19 @@ func (x *fastReflection_QueryAllContractResponse) Has(fd protoreflect.FieldDescr
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAllContractResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryFetchContractResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "arkeo.arkeo.QueryAllContractResponse.contract":
+	case "arkeo.arkeo.QueryFetchContractResponse.contract":
 		x.Contract = nil
-	case "arkeo.arkeo.QueryAllContractResponse.pagination":
-		x.Pagination = nil
+	case "arkeo.arkeo.QueryFetchContractResponse.settlement_period_end":
+		x.SettlementPeriodEnd = int64(0)
+	case "arkeo.arkeo.QueryFetchContractResponse.pending":
+		x.Pending = false
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryAllContractResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryFetchContractResponse"))
 		}
-		panic(fmt.Errorf("message arkeo.arkeo.QueryAllContractResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message arkeo.arkeo.QueryFetchContractResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4201,22 +4356,22 @@ func (x *fastReflection_QueryAllContractResponse) Clear(fd protoreflect.FieldDes
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryAllContractResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryFetchContractResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "arkeo.arkeo.QueryAllContractResponse.contract":
-		if len(x.Contract) == 0 {
-			return protoreflect.ValueOfList(&_QueryAllContractResponse_1_list{})
-		}
-		listValue := &_QueryAllContractResponse_1_list{list: &x.Contract}
-		return protoreflect.ValueOfList(listValue)
-	case "arkeo.arkeo.QueryAllContractResponse.pagination":
-		value := x.Pagination
+	case "arkeo.arkeo.QueryFetchContractResponse.contract":
+		value := x.Contract
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "arkeo.arkeo.QueryFetchContractResponse.settlement_period_end":
+		value := x.SettlementPeriodEnd
+		return protoreflect.ValueOfInt64(value)
+	case "arkeo.arkeo.QueryFetchContractResponse.pending":
+		value := x.Pending
+		return protoreflect.ValueOfBool(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryAllContractResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryFetchContractResponse"))
 		}
-		panic(fmt.Errorf("message arkeo.arkeo.QueryAllContractResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message arkeo.arkeo.QueryFetchContractResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4230,19 +4385,19 @@ func (x *fastReflection_QueryAllContractResponse) Get(descriptor protoreflect.Fi
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAllContractResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryFetchContractResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "arkeo.arkeo.QueryAllContractResponse.contract":
-		lv := value.List()
-		clv := lv.(*_QueryAllContractResponse_1_list)
-		x.Contract = *clv.list
-	case "arkeo.arkeo.QueryAllContractResponse.pagination":
-		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
+	case "arkeo.arkeo.QueryFetchContractResponse.contract":
+		x.Contract = value.Message().Interface().(*Contract)
+	case "arkeo.arkeo.QueryFetchContractResponse.settlement_period_end":
+		x.SettlementPeriodEnd = value.Int()
+	case "arkeo.arkeo.QueryFetchContractResponse.pending":
+		x.Pending = value.Bool()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryAllContractResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryFetchContractResponse"))
 		}
-		panic(fmt.Errorf("message arkeo.arkeo.QueryAllContractResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message arkeo.arkeo.QueryFetchContractResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4256,53 +4411,52 @@ func (x *fastReflection_QueryAllContractResponse) Set(fd protoreflect.FieldDescr
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAllContractResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryFetchContractResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "arkeo.arkeo.QueryAllContractResponse.contract":
+	case "arkeo.arkeo.QueryFetchContractResponse.contract":
 		if x.Contract == nil {
-			x.Contract = []*Contract{}
-		}
-		value := &_QueryAllContractResponse_1_list{list: &x.Contract}
-		return protoreflect.ValueOfList(value)
-	case "arkeo.arkeo.QueryAllContractResponse.pagination":
-		if x.Pagination == nil {
-			x.Pagination = new(v1beta1.PageResponse)
+			x.Contract = new(Contract)
 		}
-		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		return protoreflect.ValueOfMessage(x.Contract.ProtoReflect())
+	case "arkeo.arkeo.QueryFetchContractResponse.settlement_period_end":
+		panic(fmt.Errorf("field settlement_period_end of message arkeo.arkeo.QueryFetchContractResponse is not mutable"))
+	case "arkeo.arkeo.QueryFetchContractResponse.pending":
+		panic(fmt.Errorf("field pending of message arkeo.arkeo.QueryFetchContractResponse is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryAllContractResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryFetchContractResponse"))
 		}
-		panic(fmt.Errorf("message arkeo.arkeo.QueryAllContractResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message arkeo.arkeo.QueryFetchContractResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryAllContractResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryFetchContractResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "arkeo.arkeo.QueryAllContractResponse.contract":
-		list := []*Contract{}
-		return protoreflect.ValueOfList(&_QueryAllContractResponse_1_list{list: &list})
-	case "arkeo.arkeo.QueryAllContractResponse.pagination":
-		m := new(v1beta1.PageResponse)
+	case "arkeo.arkeo.QueryFetchContractResponse.contract":
+		m := new(Contract)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "arkeo.arkeo.QueryFetchContractResponse.settlement_period_end":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "arkeo.arkeo.QueryFetchContractResponse.pending":
+		return protoreflect.ValueOfBool(false)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryAllContractResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryFetchContractResponse"))
 		}
-		panic(fmt.Errorf("message arkeo.arkeo.QueryAllContractResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message arkeo.arkeo.QueryFetchContractResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryAllContractResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryFetchContractResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in arkeo.arkeo.QueryAllContractResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in arkeo.arkeo.QueryFetchContractResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4310,7 +4464,7 @@ func (x *fastReflection_QueryAllContractResponse) WhichOneof(d protoreflect.Oneo
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryAllContractResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryFetchContractResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4321,7 +4475,7 @@ func (x *fastReflection_QueryAllContractResponse) GetUnknown() protoreflect.RawF
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAllContractResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryFetchContractResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4333,7 +4487,7 @@ func (x *fastReflection_QueryAllContractResponse) SetUnknown(fields protoreflect
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryAllContractResponse) IsValid() bool {
+func (x *fastReflection_QueryFetchContractResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -4343,9 +4497,9 @@ func (x *fastReflection_QueryAllContractResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryAllContractResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryFetchContractResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryAllContractResponse)
+		x := input.Message.Interface().(*QueryFetchContractResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4357,16 +4511,16 @@ func (x *fastReflection_QueryAllContractResponse) ProtoMethods() *protoiface.Met
 		var n int
 		var l int
 		_ = l
-		if len(x.Contract) > 0 {
-			for _, e := range x.Contract {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if x.Pagination != nil {
-			l = options.Size(x.Pagination)
+		if x.Contract != nil {
+			l = options.Size(x.Contract)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.SettlementPeriodEnd != 0 {
+			n += 1 + runtime.Sov(uint64(x.SettlementPeriodEnd))
+		}
+		if x.Pending {
+			n += 2
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -4377,7 +4531,7 @@ func (x *fastReflection_QueryAllContractResponse) ProtoMethods() *protoiface.Met
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryAllContractResponse)
+		x := input.Message.Interface().(*QueryFetchContractResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4396,8 +4550,23 @@ func (x *fastReflection_QueryAllContractResponse) ProtoMethods() *protoiface.Met
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Pagination != nil {
-			encoded, err := options.Marshal(x.Pagination)
+		if x.Pending {
+			i--
+			if x.Pending {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.SettlementPeriodEnd != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SettlementPeriodEnd))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Contract != nil {
+			encoded, err := options.Marshal(x.Contract)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4408,23 +4577,7 @@ func (x *fastReflection_QueryAllContractResponse) ProtoMethods() *protoiface.Met
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x12
-		}
-		if len(x.Contract) > 0 {
-			for iNdEx := len(x.Contract) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Contract[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0xa
-			}
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -4437,7 +4590,7 @@ func (x *fastReflection_QueryAllContractResponse) ProtoMethods() *protoiface.Met
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryAllContractResponse)
+		x := input.Message.Interface().(*QueryFetchContractResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4469,10 +4622,10 @@ func (x *fastReflection_QueryAllContractResponse) ProtoMethods() *protoiface.Met
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAllContractResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryFetchContractResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAllContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryFetchContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -4504,16 +4657,18 @@ func (x *fastReflection_QueryAllContractResponse) ProtoMethods() *protoiface.Met
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Contract = append(x.Contract, &Contract{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Contract[len(x.Contract)-1]); err != nil {
+				if x.Contract == nil {
+					x.Contract = &Contract{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Contract); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
 			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SettlementPeriodEnd", wireType)
 				}
-				var msglen int
+				x.SettlementPeriodEnd = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -4523,28 +4678,31 @@ func (x *fastReflection_QueryAllContractResponse) ProtoMethods() *protoiface.Met
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					x.SettlementPeriodEnd |= int64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.Pagination == nil {
-					x.Pagination = &v1beta1.PageResponse{}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pending", wireType)
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				iNdEx = postIndex
+				x.Pending = bool(v != 0)
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -4581,29 +4739,27 @@ func (x *fastReflection_QueryAllContractResponse) ProtoMethods() *protoiface.Met
 }
 
 var (
-	md_QueryActiveContractRequest          protoreflect.MessageDescriptor
-	fd_QueryActiveContractRequest_provider protoreflect.FieldDescriptor
-	fd_QueryActiveContractRequest_service  protoreflect.FieldDescriptor
-	fd_QueryActiveContractRequest_spender  protoreflect.FieldDescriptor
+	md_QueryContractSettlementPreviewRequest             protoreflect.MessageDescriptor
+	fd_QueryContractSettlementPreviewRequest_contract_id protoreflect.FieldDescriptor
+	fd_QueryContractSettlementPreviewRequest_nonce       protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_arkeo_arkeo_query_proto_init()
-	md_QueryActiveContractRequest = File_arkeo_arkeo_query_proto.Messages().ByName("QueryActiveContractRequest")
-	fd_QueryActiveContractRequest_provider = md_QueryActiveContractRequest.Fields().ByName("provider")
-	fd_QueryActiveContractRequest_service = md_QueryActiveContractRequest.Fields().ByName("service")
-	fd_QueryActiveContractRequest_spender = md_QueryActiveContractRequest.Fields().ByName("spender")
+	md_QueryContractSettlementPreviewRequest = File_arkeo_arkeo_query_proto.Messages().ByName("QueryContractSettlementPreviewRequest")
+	fd_QueryContractSettlementPreviewRequest_contract_id = md_QueryContractSettlementPreviewRequest.Fields().ByName("contract_id")
+	fd_QueryContractSettlementPreviewRequest_nonce = md_QueryContractSettlementPreviewRequest.Fields().ByName("nonce")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryActiveContractRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryContractSettlementPreviewRequest)(nil)
 
-type fastReflection_QueryActiveContractRequest QueryActiveContractRequest
+type fastReflection_QueryContractSettlementPreviewRequest QueryContractSettlementPreviewRequest
 
-func (x *QueryActiveContractRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryActiveContractRequest)(x)
+func (x *QueryContractSettlementPreviewRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryContractSettlementPreviewRequest)(x)
 }
 
-func (x *QueryActiveContractRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryContractSettlementPreviewRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_arkeo_arkeo_query_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4615,43 +4771,43 @@ func (x *QueryActiveContractRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryActiveContractRequest_messageType fastReflection_QueryActiveContractRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryActiveContractRequest_messageType{}
+var _fastReflection_QueryContractSettlementPreviewRequest_messageType fastReflection_QueryContractSettlementPreviewRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryContractSettlementPreviewRequest_messageType{}
 
-type fastReflection_QueryActiveContractRequest_messageType struct{}
+type fastReflection_QueryContractSettlementPreviewRequest_messageType struct{}
 
-func (x fastReflection_QueryActiveContractRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryActiveContractRequest)(nil)
+func (x fastReflection_QueryContractSettlementPreviewRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryContractSettlementPreviewRequest)(nil)
 }
-func (x fastReflection_QueryActiveContractRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryActiveContractRequest)
+func (x fastReflection_QueryContractSettlementPreviewRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryContractSettlementPreviewRequest)
 }
-func (x fastReflection_QueryActiveContractRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryActiveContractRequest
+func (x fastReflection_QueryContractSettlementPreviewRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryContractSettlementPreviewRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryActiveContractRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryActiveContractRequest
+func (x *fastReflection_QueryContractSettlementPreviewRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryContractSettlementPreviewRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryActiveContractRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryActiveContractRequest_messageType
+func (x *fastReflection_QueryContractSettlementPreviewRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryContractSettlementPreviewRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryActiveContractRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryActiveContractRequest)
+func (x *fastReflection_QueryContractSettlementPreviewRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryContractSettlementPreviewRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryActiveContractRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryActiveContractRequest)(x)
+func (x *fastReflection_QueryContractSettlementPreviewRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryContractSettlementPreviewRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4659,22 +4815,16 @@ func (x *fastReflection_QueryActiveContractRequest) Interface() protoreflect.Pro
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryActiveContractRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Provider != "" {
-		value := protoreflect.ValueOfString(x.Provider)
-		if !f(fd_QueryActiveContractRequest_provider, value) {
-			return
-		}
-	}
-	if x.Service != "" {
-		value := protoreflect.ValueOfString(x.Service)
-		if !f(fd_QueryActiveContractRequest_service, value) {
+func (x *fastReflection_QueryContractSettlementPreviewRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ContractId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ContractId)
+		if !f(fd_QueryContractSettlementPreviewRequest_contract_id, value) {
 			return
 		}
 	}
-	if x.Spender != "" {
-		value := protoreflect.ValueOfString(x.Spender)
-		if !f(fd_QueryActiveContractRequest_spender, value) {
+	if x.Nonce != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Nonce)
+		if !f(fd_QueryContractSettlementPreviewRequest_nonce, value) {
 			return
 		}
 	}
@@ -4691,19 +4841,17 @@ func (x *fastReflection_QueryActiveContractRequest) Range(f func(protoreflect.Fi
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryActiveContractRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryContractSettlementPreviewRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "arkeo.arkeo.QueryActiveContractRequest.provider":
-		return x.Provider != ""
-	case "arkeo.arkeo.QueryActiveContractRequest.service":
-		return x.Service != ""
-	case "arkeo.arkeo.QueryActiveContractRequest.spender":
-		return x.Spender != ""
+	case "arkeo.arkeo.QueryContractSettlementPreviewRequest.contract_id":
+		return x.ContractId != uint64(0)
+	case "arkeo.arkeo.QueryContractSettlementPreviewRequest.nonce":
+		return x.Nonce != int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryActiveContractRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryContractSettlementPreviewRequest"))
 		}
-		panic(fmt.Errorf("message arkeo.arkeo.QueryActiveContractRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message arkeo.arkeo.QueryContractSettlementPreviewRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4713,19 +4861,17 @@ func (x *fastReflection_QueryActiveContractRequest) Has(fd protoreflect.FieldDes
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryActiveContractRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryContractSettlementPreviewRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "arkeo.arkeo.QueryActiveContractRequest.provider":
-		x.Provider = ""
-	case "arkeo.arkeo.QueryActiveContractRequest.service":
-		x.Service = ""
-	case "arkeo.arkeo.QueryActiveContractRequest.spender":
-		x.Spender = ""
+	case "arkeo.arkeo.QueryContractSettlementPreviewRequest.contract_id":
+		x.ContractId = uint64(0)
+	case "arkeo.arkeo.QueryContractSettlementPreviewRequest.nonce":
+		x.Nonce = int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryActiveContractRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryContractSettlementPreviewRequest"))
 		}
-		panic(fmt.Errorf("message arkeo.arkeo.QueryActiveContractRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message arkeo.arkeo.QueryContractSettlementPreviewRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4735,22 +4881,19 @@ func (x *fastReflection_QueryActiveContractRequest) Clear(fd protoreflect.FieldD
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryActiveContractRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryContractSettlementPreviewRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "arkeo.arkeo.QueryActiveContractRequest.provider":
-		value := x.Provider
-		return protoreflect.ValueOfString(value)
-	case "arkeo.arkeo.QueryActiveContractRequest.service":
-		value := x.Service
-		return protoreflect.ValueOfString(value)
-	case "arkeo.arkeo.QueryActiveContractRequest.spender":
-		value := x.Spender
-		return protoreflect.ValueOfString(value)
+	case "arkeo.arkeo.QueryContractSettlementPreviewRequest.contract_id":
+		value := x.ContractId
+		return protoreflect.ValueOfUint64(value)
+	case "arkeo.arkeo.QueryContractSettlementPreviewRequest.nonce":
+		value := x.Nonce
+		return protoreflect.ValueOfInt64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryActiveContractRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryContractSettlementPreviewRequest"))
 		}
-		panic(fmt.Errorf("message arkeo.arkeo.QueryActiveContractRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message arkeo.arkeo.QueryContractSettlementPreviewRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4764,19 +4907,17 @@ func (x *fastReflection_QueryActiveContractRequest) Get(descriptor protoreflect.
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryActiveContractRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryContractSettlementPreviewRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "arkeo.arkeo.QueryActiveContractRequest.provider":
-		x.Provider = value.Interface().(string)
-	case "arkeo.arkeo.QueryActiveContractRequest.service":
-		x.Service = value.Interface().(string)
-	case "arkeo.arkeo.QueryActiveContractRequest.spender":
-		x.Spender = value.Interface().(string)
+	case "arkeo.arkeo.QueryContractSettlementPreviewRequest.contract_id":
+		x.ContractId = value.Uint()
+	case "arkeo.arkeo.QueryContractSettlementPreviewRequest.nonce":
+		x.Nonce = value.Int()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryActiveContractRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryContractSettlementPreviewRequest"))
 		}
-		panic(fmt.Errorf("message arkeo.arkeo.QueryActiveContractRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message arkeo.arkeo.QueryContractSettlementPreviewRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4790,48 +4931,44 @@ func (x *fastReflection_QueryActiveContractRequest) Set(fd protoreflect.FieldDes
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryActiveContractRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryContractSettlementPreviewRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "arkeo.arkeo.QueryActiveContractRequest.provider":
-		panic(fmt.Errorf("field provider of message arkeo.arkeo.QueryActiveContractRequest is not mutable"))
-	case "arkeo.arkeo.QueryActiveContractRequest.service":
-		panic(fmt.Errorf("field service of message arkeo.arkeo.QueryActiveContractRequest is not mutable"))
-	case "arkeo.arkeo.QueryActiveContractRequest.spender":
-		panic(fmt.Errorf("field spender of message arkeo.arkeo.QueryActiveContractRequest is not mutable"))
+	case "arkeo.arkeo.QueryContractSettlementPreviewRequest.contract_id":
+		panic(fmt.Errorf("field contract_id of message arkeo.arkeo.QueryContractSettlementPreviewRequest is not mutable"))
+	case "arkeo.arkeo.QueryContractSettlementPreviewRequest.nonce":
+		panic(fmt.Errorf("field nonce of message arkeo.arkeo.QueryContractSettlementPreviewRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryActiveContractRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryContractSettlementPreviewRequest"))
 		}
-		panic(fmt.Errorf("message arkeo.arkeo.QueryActiveContractRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message arkeo.arkeo.QueryContractSettlementPreviewRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryActiveContractRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryContractSettlementPreviewRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "arkeo.arkeo.QueryActiveContractRequest.provider":
-		return protoreflect.ValueOfString("")
-	case "arkeo.arkeo.QueryActiveContractRequest.service":
-		return protoreflect.ValueOfString("")
-	case "arkeo.arkeo.QueryActiveContractRequest.spender":
-		return protoreflect.ValueOfString("")
+	case "arkeo.arkeo.QueryContractSettlementPreviewRequest.contract_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "arkeo.arkeo.QueryContractSettlementPreviewRequest.nonce":
+		return protoreflect.ValueOfInt64(int64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryActiveContractRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryContractSettlementPreviewRequest"))
 		}
-		panic(fmt.Errorf("message arkeo.arkeo.QueryActiveContractRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message arkeo.arkeo.QueryContractSettlementPreviewRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryActiveContractRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryContractSettlementPreviewRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in arkeo.arkeo.QueryActiveContractRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in arkeo.arkeo.QueryContractSettlementPreviewRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4839,7 +4976,7 @@ func (x *fastReflection_QueryActiveContractRequest) WhichOneof(d protoreflect.On
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryActiveContractRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryContractSettlementPreviewRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4850,7 +4987,7 @@ func (x *fastReflection_QueryActiveContractRequest) GetUnknown() protoreflect.Ra
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryActiveContractRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryContractSettlementPreviewRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4862,7 +4999,7 @@ func (x *fastReflection_QueryActiveContractRequest) SetUnknown(fields protorefle
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryActiveContractRequest) IsValid() bool {
+func (x *fastReflection_QueryContractSettlementPreviewRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -4872,9 +5009,9 @@ func (x *fastReflection_QueryActiveContractRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryActiveContractRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryContractSettlementPreviewRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryActiveContractRequest)
+		x := input.Message.Interface().(*QueryContractSettlementPreviewRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4886,17 +5023,11 @@ func (x *fastReflection_QueryActiveContractRequest) ProtoMethods() *protoiface.M
 		var n int
 		var l int
 		_ = l
-		l = len(x.Provider)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Service)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.ContractId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ContractId))
 		}
-		l = len(x.Spender)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.Nonce != 0 {
+			n += 1 + runtime.Sov(uint64(x.Nonce))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -4908,7 +5039,7 @@ func (x *fastReflection_QueryActiveContractRequest) ProtoMethods() *protoiface.M
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryActiveContractRequest)
+		x := input.Message.Interface().(*QueryContractSettlementPreviewRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4927,26 +5058,15 @@ func (x *fastReflection_QueryActiveContractRequest) ProtoMethods() *protoiface.M
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Spender) > 0 {
-			i -= len(x.Spender)
-			copy(dAtA[i:], x.Spender)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Spender)))
-			i--
-			dAtA[i] = 0x1a
-		}
-		if len(x.Service) > 0 {
-			i -= len(x.Service)
-			copy(dAtA[i:], x.Service)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Service)))
+		if x.Nonce != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Nonce))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x10
 		}
-		if len(x.Provider) > 0 {
-			i -= len(x.Provider)
-			copy(dAtA[i:], x.Provider)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Provider)))
+		if x.ContractId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ContractId))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -4959,7 +5079,7 @@ func (x *fastReflection_QueryActiveContractRequest) ProtoMethods() *protoiface.M
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryActiveContractRequest)
+		x := input.Message.Interface().(*QueryContractSettlementPreviewRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4991,17 +5111,17 @@ func (x *fastReflection_QueryActiveContractRequest) ProtoMethods() *protoiface.M
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryActiveContractRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryContractSettlementPreviewRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryActiveContractRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryContractSettlementPreviewRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
 				}
-				var stringLen uint64
+				x.ContractId = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -5011,61 +5131,16 @@ func (x *fastReflection_QueryActiveContractRequest) ProtoMethods() *protoiface.M
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					x.ContractId |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Provider = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
 			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Service = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Spender", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
 				}
-				var stringLen uint64
+				x.Nonce = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -5075,24 +5150,11 @@ func (x *fastReflection_QueryActiveContractRequest) ProtoMethods() *protoiface.M
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					x.Nonce |= int64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Spender = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -5129,25 +5191,25 @@ func (x *fastReflection_QueryActiveContractRequest) ProtoMethods() *protoiface.M
 }
 
 var (
-	md_QueryActiveContractResponse          protoreflect.MessageDescriptor
-	fd_QueryActiveContractResponse_contract protoreflect.FieldDescriptor
+	md_QueryContractSettlementPreviewResponse            protoreflect.MessageDescriptor
+	fd_QueryContractSettlementPreviewResponse_settlement protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_arkeo_arkeo_query_proto_init()
-	md_QueryActiveContractResponse = File_arkeo_arkeo_query_proto.Messages().ByName("QueryActiveContractResponse")
-	fd_QueryActiveContractResponse_contract = md_QueryActiveContractResponse.Fields().ByName("contract")
+	md_QueryContractSettlementPreviewResponse = File_arkeo_arkeo_query_proto.Messages().ByName("QueryContractSettlementPreviewResponse")
+	fd_QueryContractSettlementPreviewResponse_settlement = md_QueryContractSettlementPreviewResponse.Fields().ByName("settlement")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryActiveContractResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryContractSettlementPreviewResponse)(nil)
 
-type fastReflection_QueryActiveContractResponse QueryActiveContractResponse
+type fastReflection_QueryContractSettlementPreviewResponse QueryContractSettlementPreviewResponse
 
-func (x *QueryActiveContractResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryActiveContractResponse)(x)
+func (x *QueryContractSettlementPreviewResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryContractSettlementPreviewResponse)(x)
 }
 
-func (x *QueryActiveContractResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryContractSettlementPreviewResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_arkeo_arkeo_query_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5159,43 +5221,43 @@ func (x *QueryActiveContractResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryActiveContractResponse_messageType fastReflection_QueryActiveContractResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryActiveContractResponse_messageType{}
+var _fastReflection_QueryContractSettlementPreviewResponse_messageType fastReflection_QueryContractSettlementPreviewResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryContractSettlementPreviewResponse_messageType{}
 
-type fastReflection_QueryActiveContractResponse_messageType struct{}
+type fastReflection_QueryContractSettlementPreviewResponse_messageType struct{}
 
-func (x fastReflection_QueryActiveContractResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryActiveContractResponse)(nil)
+func (x fastReflection_QueryContractSettlementPreviewResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryContractSettlementPreviewResponse)(nil)
 }
-func (x fastReflection_QueryActiveContractResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryActiveContractResponse)
+func (x fastReflection_QueryContractSettlementPreviewResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryContractSettlementPreviewResponse)
 }
-func (x fastReflection_QueryActiveContractResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryActiveContractResponse
+func (x fastReflection_QueryContractSettlementPreviewResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryContractSettlementPreviewResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryActiveContractResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryActiveContractResponse
+func (x *fastReflection_QueryContractSettlementPreviewResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryContractSettlementPreviewResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryActiveContractResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryActiveContractResponse_messageType
+func (x *fastReflection_QueryContractSettlementPreviewResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryContractSettlementPreviewResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryActiveContractResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryActiveContractResponse)
+func (x *fastReflection_QueryContractSettlementPreviewResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryContractSettlementPreviewResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryActiveContractResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryActiveContractResponse)(x)
+func (x *fastReflection_QueryContractSettlementPreviewResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryContractSettlementPreviewResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -5203,10 +5265,10 @@ func (x *fastReflection_QueryActiveContractResponse) Interface() protoreflect.Pr
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryActiveContractResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Contract != nil {
-		value := protoreflect.ValueOfMessage(x.Contract.ProtoReflect())
-		if !f(fd_QueryActiveContractResponse_contract, value) {
+func (x *fastReflection_QueryContractSettlementPreviewResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Settlement != nil {
+		value := protoreflect.ValueOfMessage(x.Settlement.ProtoReflect())
+		if !f(fd_QueryContractSettlementPreviewResponse_settlement, value) {
 			return
 		}
 	}
@@ -5223,15 +5285,15 @@ func (x *fastReflection_QueryActiveContractResponse) Range(f func(protoreflect.F
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryActiveContractResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryContractSettlementPreviewResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "arkeo.arkeo.QueryActiveContractResponse.contract":
-		return x.Contract != nil
+	case "arkeo.arkeo.QueryContractSettlementPreviewResponse.settlement":
+		return x.Settlement != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryActiveContractResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryContractSettlementPreviewResponse"))
 		}
-		panic(fmt.Errorf("message arkeo.arkeo.QueryActiveContractResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message arkeo.arkeo.QueryContractSettlementPreviewResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5241,15 +5303,15 @@ func (x *fastReflection_QueryActiveContractResponse) Has(fd protoreflect.FieldDe
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryActiveContractResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryContractSettlementPreviewResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "arkeo.arkeo.QueryActiveContractResponse.contract":
-		x.Contract = nil
+	case "arkeo.arkeo.QueryContractSettlementPreviewResponse.settlement":
+		x.Settlement = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryActiveContractResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryContractSettlementPreviewResponse"))
 		}
-		panic(fmt.Errorf("message arkeo.arkeo.QueryActiveContractResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message arkeo.arkeo.QueryContractSettlementPreviewResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5259,16 +5321,16 @@ func (x *fastReflection_QueryActiveContractResponse) Clear(fd protoreflect.Field
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryActiveContractResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryContractSettlementPreviewResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "arkeo.arkeo.QueryActiveContractResponse.contract":
-		value := x.Contract
+	case "arkeo.arkeo.QueryContractSettlementPreviewResponse.settlement":
+		value := x.Settlement
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryActiveContractResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryContractSettlementPreviewResponse"))
 		}
-		panic(fmt.Errorf("message arkeo.arkeo.QueryActiveContractResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message arkeo.arkeo.QueryContractSettlementPreviewResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -5282,15 +5344,15 @@ func (x *fastReflection_QueryActiveContractResponse) Get(descriptor protoreflect
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryActiveContractResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryContractSettlementPreviewResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "arkeo.arkeo.QueryActiveContractResponse.contract":
-		x.Contract = value.Message().Interface().(*Contract)
+	case "arkeo.arkeo.QueryContractSettlementPreviewResponse.settlement":
+		x.Settlement = value.Message().Interface().(*ContractSettlement)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryActiveContractResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryContractSettlementPreviewResponse"))
 		}
-		panic(fmt.Errorf("message arkeo.arkeo.QueryActiveContractResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message arkeo.arkeo.QueryContractSettlementPreviewResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5304,44 +5366,44 @@ func (x *fastReflection_QueryActiveContractResponse) Set(fd protoreflect.FieldDe
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryActiveContractResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryContractSettlementPreviewResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "arkeo.arkeo.QueryActiveContractResponse.contract":
-		if x.Contract == nil {
-			x.Contract = new(Contract)
+	case "arkeo.arkeo.QueryContractSettlementPreviewResponse.settlement":
+		if x.Settlement == nil {
+			x.Settlement = new(ContractSettlement)
 		}
-		return protoreflect.ValueOfMessage(x.Contract.ProtoReflect())
+		return protoreflect.ValueOfMessage(x.Settlement.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryActiveContractResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryContractSettlementPreviewResponse"))
 		}
-		panic(fmt.Errorf("message arkeo.arkeo.QueryActiveContractResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message arkeo.arkeo.QueryContractSettlementPreviewResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryActiveContractResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryContractSettlementPreviewResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "arkeo.arkeo.QueryActiveContractResponse.contract":
-		m := new(Contract)
+	case "arkeo.arkeo.QueryContractSettlementPreviewResponse.settlement":
+		m := new(ContractSettlement)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryActiveContractResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryContractSettlementPreviewResponse"))
 		}
-		panic(fmt.Errorf("message arkeo.arkeo.QueryActiveContractResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message arkeo.arkeo.QueryContractSettlementPreviewResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryActiveContractResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryContractSettlementPreviewResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in arkeo.arkeo.QueryActiveContractResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in arkeo.arkeo.QueryContractSettlementPreviewResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -5349,7 +5411,7 @@ func (x *fastReflection_QueryActiveContractResponse) WhichOneof(d protoreflect.O
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryActiveContractResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryContractSettlementPreviewResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -5360,7 +5422,7 @@ func (x *fastReflection_QueryActiveContractResponse) GetUnknown() protoreflect.R
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryActiveContractResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryContractSettlementPreviewResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -5372,7 +5434,7 @@ func (x *fastReflection_QueryActiveContractResponse) SetUnknown(fields protorefl
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryActiveContractResponse) IsValid() bool {
+func (x *fastReflection_QueryContractSettlementPreviewResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -5382,9 +5444,9 @@ func (x *fastReflection_QueryActiveContractResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryActiveContractResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryContractSettlementPreviewResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryActiveContractResponse)
+		x := input.Message.Interface().(*QueryContractSettlementPreviewResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5396,8 +5458,8 @@ func (x *fastReflection_QueryActiveContractResponse) ProtoMethods() *protoiface.
 		var n int
 		var l int
 		_ = l
-		if x.Contract != nil {
-			l = options.Size(x.Contract)
+		if x.Settlement != nil {
+			l = options.Size(x.Settlement)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -5410,7 +5472,7 @@ func (x *fastReflection_QueryActiveContractResponse) ProtoMethods() *protoiface.
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryActiveContractResponse)
+		x := input.Message.Interface().(*QueryContractSettlementPreviewResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5429,8 +5491,8 @@ func (x *fastReflection_QueryActiveContractResponse) ProtoMethods() *protoiface.
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Contract != nil {
-			encoded, err := options.Marshal(x.Contract)
+		if x.Settlement != nil {
+			encoded, err := options.Marshal(x.Settlement)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5454,7 +5516,7 @@ func (x *fastReflection_QueryActiveContractResponse) ProtoMethods() *protoiface.
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryActiveContractResponse)
+		x := input.Message.Interface().(*QueryContractSettlementPreviewResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5486,15 +5548,15 @@ func (x *fastReflection_QueryActiveContractResponse) ProtoMethods() *protoiface.
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryActiveContractResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryContractSettlementPreviewResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryActiveContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryContractSettlementPreviewResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Settlement", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -5521,10 +5583,10 @@ func (x *fastReflection_QueryActiveContractResponse) ProtoMethods() *protoiface.
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Contract == nil {
-					x.Contract = &Contract{}
+				if x.Settlement == nil {
+					x.Settlement = &ContractSettlement{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Contract); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Settlement); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -5563,162 +5625,8880 @@ func (x *fastReflection_QueryActiveContractResponse) ProtoMethods() *protoiface.
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: arkeo/arkeo/query.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+var (
+	md_QueryClaimableIncomeRequest             protoreflect.MessageDescriptor
+	fd_QueryClaimableIncomeRequest_contract_id protoreflect.FieldDescriptor
+	fd_QueryClaimableIncomeRequest_nonce       protoreflect.FieldDescriptor
 )
 
-// QueryParamsRequest is request type for the Query/Params RPC method.
-type QueryParamsRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func init() {
+	file_arkeo_arkeo_query_proto_init()
+	md_QueryClaimableIncomeRequest = File_arkeo_arkeo_query_proto.Messages().ByName("QueryClaimableIncomeRequest")
+	fd_QueryClaimableIncomeRequest_contract_id = md_QueryClaimableIncomeRequest.Fields().ByName("contract_id")
+	fd_QueryClaimableIncomeRequest_nonce = md_QueryClaimableIncomeRequest.Fields().ByName("nonce")
 }
 
-func (x *QueryParamsRequest) Reset() {
-	*x = QueryParamsRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_arkeo_arkeo_query_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
+var _ protoreflect.Message = (*fastReflection_QueryClaimableIncomeRequest)(nil)
 
-func (x *QueryParamsRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
+type fastReflection_QueryClaimableIncomeRequest QueryClaimableIncomeRequest
 
-func (*QueryParamsRequest) ProtoMessage() {}
+func (x *QueryClaimableIncomeRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryClaimableIncomeRequest)(x)
+}
+
+func (x *QueryClaimableIncomeRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_arkeo_arkeo_query_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryClaimableIncomeRequest_messageType fastReflection_QueryClaimableIncomeRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryClaimableIncomeRequest_messageType{}
+
+type fastReflection_QueryClaimableIncomeRequest_messageType struct{}
+
+func (x fastReflection_QueryClaimableIncomeRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryClaimableIncomeRequest)(nil)
+}
+func (x fastReflection_QueryClaimableIncomeRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryClaimableIncomeRequest)
+}
+func (x fastReflection_QueryClaimableIncomeRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryClaimableIncomeRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryClaimableIncomeRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryClaimableIncomeRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryClaimableIncomeRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryClaimableIncomeRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryClaimableIncomeRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryClaimableIncomeRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryClaimableIncomeRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryClaimableIncomeRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryClaimableIncomeRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ContractId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ContractId)
+		if !f(fd_QueryClaimableIncomeRequest_contract_id, value) {
+			return
+		}
+	}
+	if x.Nonce != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Nonce)
+		if !f(fd_QueryClaimableIncomeRequest_nonce, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryClaimableIncomeRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "arkeo.arkeo.QueryClaimableIncomeRequest.contract_id":
+		return x.ContractId != uint64(0)
+	case "arkeo.arkeo.QueryClaimableIncomeRequest.nonce":
+		return x.Nonce != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryClaimableIncomeRequest"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.QueryClaimableIncomeRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryClaimableIncomeRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "arkeo.arkeo.QueryClaimableIncomeRequest.contract_id":
+		x.ContractId = uint64(0)
+	case "arkeo.arkeo.QueryClaimableIncomeRequest.nonce":
+		x.Nonce = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryClaimableIncomeRequest"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.QueryClaimableIncomeRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryClaimableIncomeRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "arkeo.arkeo.QueryClaimableIncomeRequest.contract_id":
+		value := x.ContractId
+		return protoreflect.ValueOfUint64(value)
+	case "arkeo.arkeo.QueryClaimableIncomeRequest.nonce":
+		value := x.Nonce
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryClaimableIncomeRequest"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.QueryClaimableIncomeRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryClaimableIncomeRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "arkeo.arkeo.QueryClaimableIncomeRequest.contract_id":
+		x.ContractId = value.Uint()
+	case "arkeo.arkeo.QueryClaimableIncomeRequest.nonce":
+		x.Nonce = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryClaimableIncomeRequest"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.QueryClaimableIncomeRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryClaimableIncomeRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "arkeo.arkeo.QueryClaimableIncomeRequest.contract_id":
+		panic(fmt.Errorf("field contract_id of message arkeo.arkeo.QueryClaimableIncomeRequest is not mutable"))
+	case "arkeo.arkeo.QueryClaimableIncomeRequest.nonce":
+		panic(fmt.Errorf("field nonce of message arkeo.arkeo.QueryClaimableIncomeRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryClaimableIncomeRequest"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.QueryClaimableIncomeRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryClaimableIncomeRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "arkeo.arkeo.QueryClaimableIncomeRequest.contract_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "arkeo.arkeo.QueryClaimableIncomeRequest.nonce":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryClaimableIncomeRequest"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.QueryClaimableIncomeRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryClaimableIncomeRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in arkeo.arkeo.QueryClaimableIncomeRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryClaimableIncomeRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryClaimableIncomeRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryClaimableIncomeRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryClaimableIncomeRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryClaimableIncomeRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.ContractId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ContractId))
+		}
+		if x.Nonce != 0 {
+			n += 1 + runtime.Sov(uint64(x.Nonce))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryClaimableIncomeRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Nonce != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Nonce))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.ContractId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ContractId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryClaimableIncomeRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryClaimableIncomeRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryClaimableIncomeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+				}
+				x.ContractId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ContractId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+				}
+				x.Nonce = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Nonce |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryClaimableIncomeResponse                  protoreflect.MessageDescriptor
+	fd_QueryClaimableIncomeResponse_nonce            protoreflect.FieldDescriptor
+	fd_QueryClaimableIncomeResponse_claimable        protoreflect.FieldDescriptor
+	fd_QueryClaimableIncomeResponse_reserve_tax      protoreflect.FieldDescriptor
+	fd_QueryClaimableIncomeResponse_remaining_escrow protoreflect.FieldDescriptor
+	fd_QueryClaimableIncomeResponse_dust             protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_arkeo_arkeo_query_proto_init()
+	md_QueryClaimableIncomeResponse = File_arkeo_arkeo_query_proto.Messages().ByName("QueryClaimableIncomeResponse")
+	fd_QueryClaimableIncomeResponse_nonce = md_QueryClaimableIncomeResponse.Fields().ByName("nonce")
+	fd_QueryClaimableIncomeResponse_claimable = md_QueryClaimableIncomeResponse.Fields().ByName("claimable")
+	fd_QueryClaimableIncomeResponse_reserve_tax = md_QueryClaimableIncomeResponse.Fields().ByName("reserve_tax")
+	fd_QueryClaimableIncomeResponse_remaining_escrow = md_QueryClaimableIncomeResponse.Fields().ByName("remaining_escrow")
+	fd_QueryClaimableIncomeResponse_dust = md_QueryClaimableIncomeResponse.Fields().ByName("dust")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryClaimableIncomeResponse)(nil)
+
+type fastReflection_QueryClaimableIncomeResponse QueryClaimableIncomeResponse
+
+func (x *QueryClaimableIncomeResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryClaimableIncomeResponse)(x)
+}
+
+func (x *QueryClaimableIncomeResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_arkeo_arkeo_query_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryClaimableIncomeResponse_messageType fastReflection_QueryClaimableIncomeResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryClaimableIncomeResponse_messageType{}
+
+type fastReflection_QueryClaimableIncomeResponse_messageType struct{}
+
+func (x fastReflection_QueryClaimableIncomeResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryClaimableIncomeResponse)(nil)
+}
+func (x fastReflection_QueryClaimableIncomeResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryClaimableIncomeResponse)
+}
+func (x fastReflection_QueryClaimableIncomeResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryClaimableIncomeResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryClaimableIncomeResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryClaimableIncomeResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryClaimableIncomeResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryClaimableIncomeResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryClaimableIncomeResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryClaimableIncomeResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryClaimableIncomeResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryClaimableIncomeResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryClaimableIncomeResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Nonce != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Nonce)
+		if !f(fd_QueryClaimableIncomeResponse_nonce, value) {
+			return
+		}
+	}
+	if x.Claimable != nil {
+		value := protoreflect.ValueOfMessage(x.Claimable.ProtoReflect())
+		if !f(fd_QueryClaimableIncomeResponse_claimable, value) {
+			return
+		}
+	}
+	if x.ReserveTax != nil {
+		value := protoreflect.ValueOfMessage(x.ReserveTax.ProtoReflect())
+		if !f(fd_QueryClaimableIncomeResponse_reserve_tax, value) {
+			return
+		}
+	}
+	if x.RemainingEscrow != nil {
+		value := protoreflect.ValueOfMessage(x.RemainingEscrow.ProtoReflect())
+		if !f(fd_QueryClaimableIncomeResponse_remaining_escrow, value) {
+			return
+		}
+	}
+	if x.Dust != nil {
+		value := protoreflect.ValueOfMessage(x.Dust.ProtoReflect())
+		if !f(fd_QueryClaimableIncomeResponse_dust, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryClaimableIncomeResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "arkeo.arkeo.QueryClaimableIncomeResponse.nonce":
+		return x.Nonce != int64(0)
+	case "arkeo.arkeo.QueryClaimableIncomeResponse.claimable":
+		return x.Claimable != nil
+	case "arkeo.arkeo.QueryClaimableIncomeResponse.reserve_tax":
+		return x.ReserveTax != nil
+	case "arkeo.arkeo.QueryClaimableIncomeResponse.remaining_escrow":
+		return x.RemainingEscrow != nil
+	case "arkeo.arkeo.QueryClaimableIncomeResponse.dust":
+		return x.Dust != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryClaimableIncomeResponse"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.QueryClaimableIncomeResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryClaimableIncomeResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "arkeo.arkeo.QueryClaimableIncomeResponse.nonce":
+		x.Nonce = int64(0)
+	case "arkeo.arkeo.QueryClaimableIncomeResponse.claimable":
+		x.Claimable = nil
+	case "arkeo.arkeo.QueryClaimableIncomeResponse.reserve_tax":
+		x.ReserveTax = nil
+	case "arkeo.arkeo.QueryClaimableIncomeResponse.remaining_escrow":
+		x.RemainingEscrow = nil
+	case "arkeo.arkeo.QueryClaimableIncomeResponse.dust":
+		x.Dust = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryClaimableIncomeResponse"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.QueryClaimableIncomeResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryClaimableIncomeResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "arkeo.arkeo.QueryClaimableIncomeResponse.nonce":
+		value := x.Nonce
+		return protoreflect.ValueOfInt64(value)
+	case "arkeo.arkeo.QueryClaimableIncomeResponse.claimable":
+		value := x.Claimable
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "arkeo.arkeo.QueryClaimableIncomeResponse.reserve_tax":
+		value := x.ReserveTax
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "arkeo.arkeo.QueryClaimableIncomeResponse.remaining_escrow":
+		value := x.RemainingEscrow
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "arkeo.arkeo.QueryClaimableIncomeResponse.dust":
+		value := x.Dust
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryClaimableIncomeResponse"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.QueryClaimableIncomeResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryClaimableIncomeResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "arkeo.arkeo.QueryClaimableIncomeResponse.nonce":
+		x.Nonce = value.Int()
+	case "arkeo.arkeo.QueryClaimableIncomeResponse.claimable":
+		x.Claimable = value.Message().Interface().(*v1beta11.Coin)
+	case "arkeo.arkeo.QueryClaimableIncomeResponse.reserve_tax":
+		x.ReserveTax = value.Message().Interface().(*v1beta11.Coin)
+	case "arkeo.arkeo.QueryClaimableIncomeResponse.remaining_escrow":
+		x.RemainingEscrow = value.Message().Interface().(*v1beta11.Coin)
+	case "arkeo.arkeo.QueryClaimableIncomeResponse.dust":
+		x.Dust = value.Message().Interface().(*v1beta11.Coin)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryClaimableIncomeResponse"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.QueryClaimableIncomeResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryClaimableIncomeResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "arkeo.arkeo.QueryClaimableIncomeResponse.claimable":
+		if x.Claimable == nil {
+			x.Claimable = new(v1beta11.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.Claimable.ProtoReflect())
+	case "arkeo.arkeo.QueryClaimableIncomeResponse.reserve_tax":
+		if x.ReserveTax == nil {
+			x.ReserveTax = new(v1beta11.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.ReserveTax.ProtoReflect())
+	case "arkeo.arkeo.QueryClaimableIncomeResponse.remaining_escrow":
+		if x.RemainingEscrow == nil {
+			x.RemainingEscrow = new(v1beta11.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.RemainingEscrow.ProtoReflect())
+	case "arkeo.arkeo.QueryClaimableIncomeResponse.dust":
+		if x.Dust == nil {
+			x.Dust = new(v1beta11.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.Dust.ProtoReflect())
+	case "arkeo.arkeo.QueryClaimableIncomeResponse.nonce":
+		panic(fmt.Errorf("field nonce of message arkeo.arkeo.QueryClaimableIncomeResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryClaimableIncomeResponse"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.QueryClaimableIncomeResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryClaimableIncomeResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "arkeo.arkeo.QueryClaimableIncomeResponse.nonce":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "arkeo.arkeo.QueryClaimableIncomeResponse.claimable":
+		m := new(v1beta11.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "arkeo.arkeo.QueryClaimableIncomeResponse.reserve_tax":
+		m := new(v1beta11.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "arkeo.arkeo.QueryClaimableIncomeResponse.remaining_escrow":
+		m := new(v1beta11.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "arkeo.arkeo.QueryClaimableIncomeResponse.dust":
+		m := new(v1beta11.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryClaimableIncomeResponse"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.QueryClaimableIncomeResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryClaimableIncomeResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in arkeo.arkeo.QueryClaimableIncomeResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryClaimableIncomeResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryClaimableIncomeResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryClaimableIncomeResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryClaimableIncomeResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryClaimableIncomeResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Nonce != 0 {
+			n += 1 + runtime.Sov(uint64(x.Nonce))
+		}
+		if x.Claimable != nil {
+			l = options.Size(x.Claimable)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ReserveTax != nil {
+			l = options.Size(x.ReserveTax)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.RemainingEscrow != nil {
+			l = options.Size(x.RemainingEscrow)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Dust != nil {
+			l = options.Size(x.Dust)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryClaimableIncomeResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Dust != nil {
+			encoded, err := options.Marshal(x.Dust)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.RemainingEscrow != nil {
+			encoded, err := options.Marshal(x.RemainingEscrow)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.ReserveTax != nil {
+			encoded, err := options.Marshal(x.ReserveTax)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.Claimable != nil {
+			encoded, err := options.Marshal(x.Claimable)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Nonce != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Nonce))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryClaimableIncomeResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryClaimableIncomeResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryClaimableIncomeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+				}
+				x.Nonce = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Nonce |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Claimable", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Claimable == nil {
+					x.Claimable = &v1beta11.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Claimable); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReserveTax", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.ReserveTax == nil {
+					x.ReserveTax = &v1beta11.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ReserveTax); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RemainingEscrow", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.RemainingEscrow == nil {
+					x.RemainingEscrow = &v1beta11.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RemainingEscrow); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Dust", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Dust == nil {
+					x.Dust = &v1beta11.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Dust); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryProvidersRequest               protoreflect.MessageDescriptor
+	fd_QueryProvidersRequest_service       protoreflect.FieldDescriptor
+	fd_QueryProvidersRequest_status_filter protoreflect.FieldDescriptor
+	fd_QueryProvidersRequest_pagination    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_arkeo_arkeo_query_proto_init()
+	md_QueryProvidersRequest = File_arkeo_arkeo_query_proto.Messages().ByName("QueryProvidersRequest")
+	fd_QueryProvidersRequest_service = md_QueryProvidersRequest.Fields().ByName("service")
+	fd_QueryProvidersRequest_status_filter = md_QueryProvidersRequest.Fields().ByName("status_filter")
+	fd_QueryProvidersRequest_pagination = md_QueryProvidersRequest.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryProvidersRequest)(nil)
+
+type fastReflection_QueryProvidersRequest QueryProvidersRequest
+
+func (x *QueryProvidersRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryProvidersRequest)(x)
+}
+
+func (x *QueryProvidersRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_arkeo_arkeo_query_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryProvidersRequest_messageType fastReflection_QueryProvidersRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryProvidersRequest_messageType{}
+
+type fastReflection_QueryProvidersRequest_messageType struct{}
+
+func (x fastReflection_QueryProvidersRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryProvidersRequest)(nil)
+}
+func (x fastReflection_QueryProvidersRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryProvidersRequest)
+}
+func (x fastReflection_QueryProvidersRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryProvidersRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryProvidersRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryProvidersRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryProvidersRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryProvidersRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryProvidersRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryProvidersRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryProvidersRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryProvidersRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryProvidersRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Service != "" {
+		value := protoreflect.ValueOfString(x.Service)
+		if !f(fd_QueryProvidersRequest_service, value) {
+			return
+		}
+	}
+	if x.StatusFilter != "" {
+		value := protoreflect.ValueOfString(x.StatusFilter)
+		if !f(fd_QueryProvidersRequest_status_filter, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryProvidersRequest_pagination, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryProvidersRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "arkeo.arkeo.QueryProvidersRequest.service":
+		return x.Service != ""
+	case "arkeo.arkeo.QueryProvidersRequest.status_filter":
+		return x.StatusFilter != ""
+	case "arkeo.arkeo.QueryProvidersRequest.pagination":
+		return x.Pagination != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryProvidersRequest"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.QueryProvidersRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryProvidersRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "arkeo.arkeo.QueryProvidersRequest.service":
+		x.Service = ""
+	case "arkeo.arkeo.QueryProvidersRequest.status_filter":
+		x.StatusFilter = ""
+	case "arkeo.arkeo.QueryProvidersRequest.pagination":
+		x.Pagination = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryProvidersRequest"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.QueryProvidersRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryProvidersRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "arkeo.arkeo.QueryProvidersRequest.service":
+		value := x.Service
+		return protoreflect.ValueOfString(value)
+	case "arkeo.arkeo.QueryProvidersRequest.status_filter":
+		value := x.StatusFilter
+		return protoreflect.ValueOfString(value)
+	case "arkeo.arkeo.QueryProvidersRequest.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryProvidersRequest"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.QueryProvidersRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryProvidersRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "arkeo.arkeo.QueryProvidersRequest.service":
+		x.Service = value.Interface().(string)
+	case "arkeo.arkeo.QueryProvidersRequest.status_filter":
+		x.StatusFilter = value.Interface().(string)
+	case "arkeo.arkeo.QueryProvidersRequest.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryProvidersRequest"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.QueryProvidersRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryProvidersRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "arkeo.arkeo.QueryProvidersRequest.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageRequest)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	case "arkeo.arkeo.QueryProvidersRequest.service":
+		panic(fmt.Errorf("field service of message arkeo.arkeo.QueryProvidersRequest is not mutable"))
+	case "arkeo.arkeo.QueryProvidersRequest.status_filter":
+		panic(fmt.Errorf("field status_filter of message arkeo.arkeo.QueryProvidersRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryProvidersRequest"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.QueryProvidersRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryProvidersRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "arkeo.arkeo.QueryProvidersRequest.service":
+		return protoreflect.ValueOfString("")
+	case "arkeo.arkeo.QueryProvidersRequest.status_filter":
+		return protoreflect.ValueOfString("")
+	case "arkeo.arkeo.QueryProvidersRequest.pagination":
+		m := new(v1beta1.PageRequest)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryProvidersRequest"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.QueryProvidersRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryProvidersRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in arkeo.arkeo.QueryProvidersRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryProvidersRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryProvidersRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryProvidersRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryProvidersRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryProvidersRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Service)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.StatusFilter)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryProvidersRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.StatusFilter) > 0 {
+			i -= len(x.StatusFilter)
+			copy(dAtA[i:], x.StatusFilter)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.StatusFilter)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Service) > 0 {
+			i -= len(x.Service)
+			copy(dAtA[i:], x.Service)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Service)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryProvidersRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryProvidersRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryProvidersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Service = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StatusFilter", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.StatusFilter = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageRequest{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryProvidersResponse_1_list)(nil)
+
+type _QueryProvidersResponse_1_list struct {
+	list *[]*Provider
+}
+
+func (x *_QueryProvidersResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryProvidersResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryProvidersResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Provider)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryProvidersResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Provider)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryProvidersResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(Provider)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryProvidersResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryProvidersResponse_1_list) NewElement() protoreflect.Value {
+	v := new(Provider)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryProvidersResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryProvidersResponse            protoreflect.MessageDescriptor
+	fd_QueryProvidersResponse_providers  protoreflect.FieldDescriptor
+	fd_QueryProvidersResponse_pagination protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_arkeo_arkeo_query_proto_init()
+	md_QueryProvidersResponse = File_arkeo_arkeo_query_proto.Messages().ByName("QueryProvidersResponse")
+	fd_QueryProvidersResponse_providers = md_QueryProvidersResponse.Fields().ByName("providers")
+	fd_QueryProvidersResponse_pagination = md_QueryProvidersResponse.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryProvidersResponse)(nil)
+
+type fastReflection_QueryProvidersResponse QueryProvidersResponse
+
+func (x *QueryProvidersResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryProvidersResponse)(x)
+}
+
+func (x *QueryProvidersResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_arkeo_arkeo_query_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryProvidersResponse_messageType fastReflection_QueryProvidersResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryProvidersResponse_messageType{}
+
+type fastReflection_QueryProvidersResponse_messageType struct{}
+
+func (x fastReflection_QueryProvidersResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryProvidersResponse)(nil)
+}
+func (x fastReflection_QueryProvidersResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryProvidersResponse)
+}
+func (x fastReflection_QueryProvidersResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryProvidersResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryProvidersResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryProvidersResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryProvidersResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryProvidersResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryProvidersResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryProvidersResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryProvidersResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryProvidersResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryProvidersResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Providers) != 0 {
+		value := protoreflect.ValueOfList(&_QueryProvidersResponse_1_list{list: &x.Providers})
+		if !f(fd_QueryProvidersResponse_providers, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryProvidersResponse_pagination, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryProvidersResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "arkeo.arkeo.QueryProvidersResponse.providers":
+		return len(x.Providers) != 0
+	case "arkeo.arkeo.QueryProvidersResponse.pagination":
+		return x.Pagination != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryProvidersResponse"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.QueryProvidersResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryProvidersResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "arkeo.arkeo.QueryProvidersResponse.providers":
+		x.Providers = nil
+	case "arkeo.arkeo.QueryProvidersResponse.pagination":
+		x.Pagination = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryProvidersResponse"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.QueryProvidersResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryProvidersResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "arkeo.arkeo.QueryProvidersResponse.providers":
+		if len(x.Providers) == 0 {
+			return protoreflect.ValueOfList(&_QueryProvidersResponse_1_list{})
+		}
+		listValue := &_QueryProvidersResponse_1_list{list: &x.Providers}
+		return protoreflect.ValueOfList(listValue)
+	case "arkeo.arkeo.QueryProvidersResponse.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryProvidersResponse"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.QueryProvidersResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryProvidersResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "arkeo.arkeo.QueryProvidersResponse.providers":
+		lv := value.List()
+		clv := lv.(*_QueryProvidersResponse_1_list)
+		x.Providers = *clv.list
+	case "arkeo.arkeo.QueryProvidersResponse.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryProvidersResponse"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.QueryProvidersResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryProvidersResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "arkeo.arkeo.QueryProvidersResponse.providers":
+		if x.Providers == nil {
+			x.Providers = []*Provider{}
+		}
+		value := &_QueryProvidersResponse_1_list{list: &x.Providers}
+		return protoreflect.ValueOfList(value)
+	case "arkeo.arkeo.QueryProvidersResponse.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageResponse)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryProvidersResponse"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.QueryProvidersResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryProvidersResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "arkeo.arkeo.QueryProvidersResponse.providers":
+		list := []*Provider{}
+		return protoreflect.ValueOfList(&_QueryProvidersResponse_1_list{list: &list})
+	case "arkeo.arkeo.QueryProvidersResponse.pagination":
+		m := new(v1beta1.PageResponse)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryProvidersResponse"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.QueryProvidersResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryProvidersResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in arkeo.arkeo.QueryProvidersResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryProvidersResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryProvidersResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryProvidersResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryProvidersResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryProvidersResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Providers) > 0 {
+			for _, e := range x.Providers {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryProvidersResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Providers) > 0 {
+			for iNdEx := len(x.Providers) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Providers[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryProvidersResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryProvidersResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryProvidersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Providers", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Providers = append(x.Providers, &Provider{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Providers[len(x.Providers)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageResponse{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryAllContractRequest            protoreflect.MessageDescriptor
+	fd_QueryAllContractRequest_pagination protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_arkeo_arkeo_query_proto_init()
+	md_QueryAllContractRequest = File_arkeo_arkeo_query_proto.Messages().ByName("QueryAllContractRequest")
+	fd_QueryAllContractRequest_pagination = md_QueryAllContractRequest.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryAllContractRequest)(nil)
+
+type fastReflection_QueryAllContractRequest QueryAllContractRequest
+
+func (x *QueryAllContractRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryAllContractRequest)(x)
+}
+
+func (x *QueryAllContractRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_arkeo_arkeo_query_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryAllContractRequest_messageType fastReflection_QueryAllContractRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryAllContractRequest_messageType{}
+
+type fastReflection_QueryAllContractRequest_messageType struct{}
+
+func (x fastReflection_QueryAllContractRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryAllContractRequest)(nil)
+}
+func (x fastReflection_QueryAllContractRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryAllContractRequest)
+}
+func (x fastReflection_QueryAllContractRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryAllContractRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryAllContractRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryAllContractRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryAllContractRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryAllContractRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryAllContractRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryAllContractRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryAllContractRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryAllContractRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryAllContractRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryAllContractRequest_pagination, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryAllContractRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "arkeo.arkeo.QueryAllContractRequest.pagination":
+		return x.Pagination != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryAllContractRequest"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.QueryAllContractRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAllContractRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "arkeo.arkeo.QueryAllContractRequest.pagination":
+		x.Pagination = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryAllContractRequest"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.QueryAllContractRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryAllContractRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "arkeo.arkeo.QueryAllContractRequest.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryAllContractRequest"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.QueryAllContractRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAllContractRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "arkeo.arkeo.QueryAllContractRequest.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryAllContractRequest"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.QueryAllContractRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAllContractRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "arkeo.arkeo.QueryAllContractRequest.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageRequest)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryAllContractRequest"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.QueryAllContractRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryAllContractRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "arkeo.arkeo.QueryAllContractRequest.pagination":
+		m := new(v1beta1.PageRequest)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryAllContractRequest"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.QueryAllContractRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryAllContractRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in arkeo.arkeo.QueryAllContractRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryAllContractRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAllContractRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryAllContractRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryAllContractRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryAllContractRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryAllContractRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryAllContractRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAllContractRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAllContractRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageRequest{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryAllContractResponse_1_list)(nil)
+
+type _QueryAllContractResponse_1_list struct {
+	list *[]*Contract
+}
+
+func (x *_QueryAllContractResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryAllContractResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryAllContractResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Contract)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryAllContractResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Contract)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryAllContractResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(Contract)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryAllContractResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryAllContractResponse_1_list) NewElement() protoreflect.Value {
+	v := new(Contract)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryAllContractResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryAllContractResponse            protoreflect.MessageDescriptor
+	fd_QueryAllContractResponse_contract   protoreflect.FieldDescriptor
+	fd_QueryAllContractResponse_pagination protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_arkeo_arkeo_query_proto_init()
+	md_QueryAllContractResponse = File_arkeo_arkeo_query_proto.Messages().ByName("QueryAllContractResponse")
+	fd_QueryAllContractResponse_contract = md_QueryAllContractResponse.Fields().ByName("contract")
+	fd_QueryAllContractResponse_pagination = md_QueryAllContractResponse.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryAllContractResponse)(nil)
+
+type fastReflection_QueryAllContractResponse QueryAllContractResponse
+
+func (x *QueryAllContractResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryAllContractResponse)(x)
+}
+
+func (x *QueryAllContractResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_arkeo_arkeo_query_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryAllContractResponse_messageType fastReflection_QueryAllContractResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryAllContractResponse_messageType{}
+
+type fastReflection_QueryAllContractResponse_messageType struct{}
+
+func (x fastReflection_QueryAllContractResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryAllContractResponse)(nil)
+}
+func (x fastReflection_QueryAllContractResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryAllContractResponse)
+}
+func (x fastReflection_QueryAllContractResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryAllContractResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryAllContractResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryAllContractResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryAllContractResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryAllContractResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryAllContractResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryAllContractResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryAllContractResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryAllContractResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryAllContractResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Contract) != 0 {
+		value := protoreflect.ValueOfList(&_QueryAllContractResponse_1_list{list: &x.Contract})
+		if !f(fd_QueryAllContractResponse_contract, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryAllContractResponse_pagination, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryAllContractResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "arkeo.arkeo.QueryAllContractResponse.contract":
+		return len(x.Contract) != 0
+	case "arkeo.arkeo.QueryAllContractResponse.pagination":
+		return x.Pagination != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryAllContractResponse"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.QueryAllContractResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAllContractResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "arkeo.arkeo.QueryAllContractResponse.contract":
+		x.Contract = nil
+	case "arkeo.arkeo.QueryAllContractResponse.pagination":
+		x.Pagination = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryAllContractResponse"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.QueryAllContractResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryAllContractResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "arkeo.arkeo.QueryAllContractResponse.contract":
+		if len(x.Contract) == 0 {
+			return protoreflect.ValueOfList(&_QueryAllContractResponse_1_list{})
+		}
+		listValue := &_QueryAllContractResponse_1_list{list: &x.Contract}
+		return protoreflect.ValueOfList(listValue)
+	case "arkeo.arkeo.QueryAllContractResponse.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryAllContractResponse"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.QueryAllContractResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAllContractResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "arkeo.arkeo.QueryAllContractResponse.contract":
+		lv := value.List()
+		clv := lv.(*_QueryAllContractResponse_1_list)
+		x.Contract = *clv.list
+	case "arkeo.arkeo.QueryAllContractResponse.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryAllContractResponse"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.QueryAllContractResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAllContractResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "arkeo.arkeo.QueryAllContractResponse.contract":
+		if x.Contract == nil {
+			x.Contract = []*Contract{}
+		}
+		value := &_QueryAllContractResponse_1_list{list: &x.Contract}
+		return protoreflect.ValueOfList(value)
+	case "arkeo.arkeo.QueryAllContractResponse.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageResponse)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryAllContractResponse"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.QueryAllContractResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryAllContractResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "arkeo.arkeo.QueryAllContractResponse.contract":
+		list := []*Contract{}
+		return protoreflect.ValueOfList(&_QueryAllContractResponse_1_list{list: &list})
+	case "arkeo.arkeo.QueryAllContractResponse.pagination":
+		m := new(v1beta1.PageResponse)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryAllContractResponse"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.QueryAllContractResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryAllContractResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in arkeo.arkeo.QueryAllContractResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryAllContractResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAllContractResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryAllContractResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryAllContractResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryAllContractResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Contract) > 0 {
+			for _, e := range x.Contract {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryAllContractResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Contract) > 0 {
+			for iNdEx := len(x.Contract) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Contract[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryAllContractResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAllContractResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAllContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Contract = append(x.Contract, &Contract{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Contract[len(x.Contract)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageResponse{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryContractsByProviderRequest            protoreflect.MessageDescriptor
+	fd_QueryContractsByProviderRequest_provider   protoreflect.FieldDescriptor
+	fd_QueryContractsByProviderRequest_service    protoreflect.FieldDescriptor
+	fd_QueryContractsByProviderRequest_state      protoreflect.FieldDescriptor
+	fd_QueryContractsByProviderRequest_pagination protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_arkeo_arkeo_query_proto_init()
+	md_QueryContractsByProviderRequest = File_arkeo_arkeo_query_proto.Messages().ByName("QueryContractsByProviderRequest")
+	fd_QueryContractsByProviderRequest_provider = md_QueryContractsByProviderRequest.Fields().ByName("provider")
+	fd_QueryContractsByProviderRequest_service = md_QueryContractsByProviderRequest.Fields().ByName("service")
+	fd_QueryContractsByProviderRequest_state = md_QueryContractsByProviderRequest.Fields().ByName("state")
+	fd_QueryContractsByProviderRequest_pagination = md_QueryContractsByProviderRequest.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryContractsByProviderRequest)(nil)
+
+type fastReflection_QueryContractsByProviderRequest QueryContractsByProviderRequest
+
+func (x *QueryContractsByProviderRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryContractsByProviderRequest)(x)
+}
+
+func (x *QueryContractsByProviderRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_arkeo_arkeo_query_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryContractsByProviderRequest_messageType fastReflection_QueryContractsByProviderRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryContractsByProviderRequest_messageType{}
+
+type fastReflection_QueryContractsByProviderRequest_messageType struct{}
+
+func (x fastReflection_QueryContractsByProviderRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryContractsByProviderRequest)(nil)
+}
+func (x fastReflection_QueryContractsByProviderRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryContractsByProviderRequest)
+}
+func (x fastReflection_QueryContractsByProviderRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryContractsByProviderRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryContractsByProviderRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryContractsByProviderRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryContractsByProviderRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryContractsByProviderRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryContractsByProviderRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryContractsByProviderRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryContractsByProviderRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryContractsByProviderRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryContractsByProviderRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Provider != "" {
+		value := protoreflect.ValueOfString(x.Provider)
+		if !f(fd_QueryContractsByProviderRequest_provider, value) {
+			return
+		}
+	}
+	if x.Service != "" {
+		value := protoreflect.ValueOfString(x.Service)
+		if !f(fd_QueryContractsByProviderRequest_service, value) {
+			return
+		}
+	}
+	if x.State != "" {
+		value := protoreflect.ValueOfString(x.State)
+		if !f(fd_QueryContractsByProviderRequest_state, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryContractsByProviderRequest_pagination, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryContractsByProviderRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "arkeo.arkeo.QueryContractsByProviderRequest.provider":
+		return x.Provider != ""
+	case "arkeo.arkeo.QueryContractsByProviderRequest.service":
+		return x.Service != ""
+	case "arkeo.arkeo.QueryContractsByProviderRequest.state":
+		return x.State != ""
+	case "arkeo.arkeo.QueryContractsByProviderRequest.pagination":
+		return x.Pagination != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryContractsByProviderRequest"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.QueryContractsByProviderRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryContractsByProviderRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "arkeo.arkeo.QueryContractsByProviderRequest.provider":
+		x.Provider = ""
+	case "arkeo.arkeo.QueryContractsByProviderRequest.service":
+		x.Service = ""
+	case "arkeo.arkeo.QueryContractsByProviderRequest.state":
+		x.State = ""
+	case "arkeo.arkeo.QueryContractsByProviderRequest.pagination":
+		x.Pagination = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryContractsByProviderRequest"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.QueryContractsByProviderRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryContractsByProviderRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "arkeo.arkeo.QueryContractsByProviderRequest.provider":
+		value := x.Provider
+		return protoreflect.ValueOfString(value)
+	case "arkeo.arkeo.QueryContractsByProviderRequest.service":
+		value := x.Service
+		return protoreflect.ValueOfString(value)
+	case "arkeo.arkeo.QueryContractsByProviderRequest.state":
+		value := x.State
+		return protoreflect.ValueOfString(value)
+	case "arkeo.arkeo.QueryContractsByProviderRequest.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryContractsByProviderRequest"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.QueryContractsByProviderRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryContractsByProviderRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "arkeo.arkeo.QueryContractsByProviderRequest.provider":
+		x.Provider = value.Interface().(string)
+	case "arkeo.arkeo.QueryContractsByProviderRequest.service":
+		x.Service = value.Interface().(string)
+	case "arkeo.arkeo.QueryContractsByProviderRequest.state":
+		x.State = value.Interface().(string)
+	case "arkeo.arkeo.QueryContractsByProviderRequest.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryContractsByProviderRequest"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.QueryContractsByProviderRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryContractsByProviderRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "arkeo.arkeo.QueryContractsByProviderRequest.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageRequest)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	case "arkeo.arkeo.QueryContractsByProviderRequest.provider":
+		panic(fmt.Errorf("field provider of message arkeo.arkeo.QueryContractsByProviderRequest is not mutable"))
+	case "arkeo.arkeo.QueryContractsByProviderRequest.service":
+		panic(fmt.Errorf("field service of message arkeo.arkeo.QueryContractsByProviderRequest is not mutable"))
+	case "arkeo.arkeo.QueryContractsByProviderRequest.state":
+		panic(fmt.Errorf("field state of message arkeo.arkeo.QueryContractsByProviderRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryContractsByProviderRequest"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.QueryContractsByProviderRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryContractsByProviderRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "arkeo.arkeo.QueryContractsByProviderRequest.provider":
+		return protoreflect.ValueOfString("")
+	case "arkeo.arkeo.QueryContractsByProviderRequest.service":
+		return protoreflect.ValueOfString("")
+	case "arkeo.arkeo.QueryContractsByProviderRequest.state":
+		return protoreflect.ValueOfString("")
+	case "arkeo.arkeo.QueryContractsByProviderRequest.pagination":
+		m := new(v1beta1.PageRequest)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryContractsByProviderRequest"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.QueryContractsByProviderRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryContractsByProviderRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in arkeo.arkeo.QueryContractsByProviderRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryContractsByProviderRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryContractsByProviderRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryContractsByProviderRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryContractsByProviderRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryContractsByProviderRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Provider)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Service)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.State)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryContractsByProviderRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.State) > 0 {
+			i -= len(x.State)
+			copy(dAtA[i:], x.State)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.State)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Service) > 0 {
+			i -= len(x.Service)
+			copy(dAtA[i:], x.Service)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Service)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Provider) > 0 {
+			i -= len(x.Provider)
+			copy(dAtA[i:], x.Provider)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Provider)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryContractsByProviderRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryContractsByProviderRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryContractsByProviderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Provider = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Service = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.State = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageRequest{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryContractsByProviderResponse_1_list)(nil)
+
+type _QueryContractsByProviderResponse_1_list struct {
+	list *[]*Contract
+}
+
+func (x *_QueryContractsByProviderResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryContractsByProviderResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryContractsByProviderResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Contract)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryContractsByProviderResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Contract)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryContractsByProviderResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(Contract)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryContractsByProviderResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryContractsByProviderResponse_1_list) NewElement() protoreflect.Value {
+	v := new(Contract)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryContractsByProviderResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryContractsByProviderResponse            protoreflect.MessageDescriptor
+	fd_QueryContractsByProviderResponse_contract   protoreflect.FieldDescriptor
+	fd_QueryContractsByProviderResponse_pagination protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_arkeo_arkeo_query_proto_init()
+	md_QueryContractsByProviderResponse = File_arkeo_arkeo_query_proto.Messages().ByName("QueryContractsByProviderResponse")
+	fd_QueryContractsByProviderResponse_contract = md_QueryContractsByProviderResponse.Fields().ByName("contract")
+	fd_QueryContractsByProviderResponse_pagination = md_QueryContractsByProviderResponse.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryContractsByProviderResponse)(nil)
+
+type fastReflection_QueryContractsByProviderResponse QueryContractsByProviderResponse
+
+func (x *QueryContractsByProviderResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryContractsByProviderResponse)(x)
+}
+
+func (x *QueryContractsByProviderResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_arkeo_arkeo_query_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryContractsByProviderResponse_messageType fastReflection_QueryContractsByProviderResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryContractsByProviderResponse_messageType{}
+
+type fastReflection_QueryContractsByProviderResponse_messageType struct{}
+
+func (x fastReflection_QueryContractsByProviderResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryContractsByProviderResponse)(nil)
+}
+func (x fastReflection_QueryContractsByProviderResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryContractsByProviderResponse)
+}
+func (x fastReflection_QueryContractsByProviderResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryContractsByProviderResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryContractsByProviderResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryContractsByProviderResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryContractsByProviderResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryContractsByProviderResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryContractsByProviderResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryContractsByProviderResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryContractsByProviderResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryContractsByProviderResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryContractsByProviderResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Contract) != 0 {
+		value := protoreflect.ValueOfList(&_QueryContractsByProviderResponse_1_list{list: &x.Contract})
+		if !f(fd_QueryContractsByProviderResponse_contract, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryContractsByProviderResponse_pagination, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryContractsByProviderResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "arkeo.arkeo.QueryContractsByProviderResponse.contract":
+		return len(x.Contract) != 0
+	case "arkeo.arkeo.QueryContractsByProviderResponse.pagination":
+		return x.Pagination != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryContractsByProviderResponse"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.QueryContractsByProviderResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryContractsByProviderResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "arkeo.arkeo.QueryContractsByProviderResponse.contract":
+		x.Contract = nil
+	case "arkeo.arkeo.QueryContractsByProviderResponse.pagination":
+		x.Pagination = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryContractsByProviderResponse"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.QueryContractsByProviderResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryContractsByProviderResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "arkeo.arkeo.QueryContractsByProviderResponse.contract":
+		if len(x.Contract) == 0 {
+			return protoreflect.ValueOfList(&_QueryContractsByProviderResponse_1_list{})
+		}
+		listValue := &_QueryContractsByProviderResponse_1_list{list: &x.Contract}
+		return protoreflect.ValueOfList(listValue)
+	case "arkeo.arkeo.QueryContractsByProviderResponse.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryContractsByProviderResponse"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.QueryContractsByProviderResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryContractsByProviderResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "arkeo.arkeo.QueryContractsByProviderResponse.contract":
+		lv := value.List()
+		clv := lv.(*_QueryContractsByProviderResponse_1_list)
+		x.Contract = *clv.list
+	case "arkeo.arkeo.QueryContractsByProviderResponse.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryContractsByProviderResponse"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.QueryContractsByProviderResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryContractsByProviderResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "arkeo.arkeo.QueryContractsByProviderResponse.contract":
+		if x.Contract == nil {
+			x.Contract = []*Contract{}
+		}
+		value := &_QueryContractsByProviderResponse_1_list{list: &x.Contract}
+		return protoreflect.ValueOfList(value)
+	case "arkeo.arkeo.QueryContractsByProviderResponse.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageResponse)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryContractsByProviderResponse"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.QueryContractsByProviderResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryContractsByProviderResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "arkeo.arkeo.QueryContractsByProviderResponse.contract":
+		list := []*Contract{}
+		return protoreflect.ValueOfList(&_QueryContractsByProviderResponse_1_list{list: &list})
+	case "arkeo.arkeo.QueryContractsByProviderResponse.pagination":
+		m := new(v1beta1.PageResponse)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryContractsByProviderResponse"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.QueryContractsByProviderResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryContractsByProviderResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in arkeo.arkeo.QueryContractsByProviderResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryContractsByProviderResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryContractsByProviderResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryContractsByProviderResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryContractsByProviderResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryContractsByProviderResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Contract) > 0 {
+			for _, e := range x.Contract {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryContractsByProviderResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Contract) > 0 {
+			for iNdEx := len(x.Contract) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Contract[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryContractsByProviderResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryContractsByProviderResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryContractsByProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Contract = append(x.Contract, &Contract{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Contract[len(x.Contract)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageResponse{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryContractsByOwnerRequest                  protoreflect.MessageDescriptor
+	fd_QueryContractsByOwnerRequest_pubkey           protoreflect.FieldDescriptor
+	fd_QueryContractsByOwnerRequest_include_delegate protoreflect.FieldDescriptor
+	fd_QueryContractsByOwnerRequest_pagination       protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_arkeo_arkeo_query_proto_init()
+	md_QueryContractsByOwnerRequest = File_arkeo_arkeo_query_proto.Messages().ByName("QueryContractsByOwnerRequest")
+	fd_QueryContractsByOwnerRequest_pubkey = md_QueryContractsByOwnerRequest.Fields().ByName("pubkey")
+	fd_QueryContractsByOwnerRequest_include_delegate = md_QueryContractsByOwnerRequest.Fields().ByName("include_delegate")
+	fd_QueryContractsByOwnerRequest_pagination = md_QueryContractsByOwnerRequest.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryContractsByOwnerRequest)(nil)
+
+type fastReflection_QueryContractsByOwnerRequest QueryContractsByOwnerRequest
+
+func (x *QueryContractsByOwnerRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryContractsByOwnerRequest)(x)
+}
+
+func (x *QueryContractsByOwnerRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_arkeo_arkeo_query_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryContractsByOwnerRequest_messageType fastReflection_QueryContractsByOwnerRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryContractsByOwnerRequest_messageType{}
+
+type fastReflection_QueryContractsByOwnerRequest_messageType struct{}
+
+func (x fastReflection_QueryContractsByOwnerRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryContractsByOwnerRequest)(nil)
+}
+func (x fastReflection_QueryContractsByOwnerRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryContractsByOwnerRequest)
+}
+func (x fastReflection_QueryContractsByOwnerRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryContractsByOwnerRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryContractsByOwnerRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryContractsByOwnerRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryContractsByOwnerRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryContractsByOwnerRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryContractsByOwnerRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryContractsByOwnerRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryContractsByOwnerRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryContractsByOwnerRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryContractsByOwnerRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Pubkey != "" {
+		value := protoreflect.ValueOfString(x.Pubkey)
+		if !f(fd_QueryContractsByOwnerRequest_pubkey, value) {
+			return
+		}
+	}
+	if x.IncludeDelegate != false {
+		value := protoreflect.ValueOfBool(x.IncludeDelegate)
+		if !f(fd_QueryContractsByOwnerRequest_include_delegate, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryContractsByOwnerRequest_pagination, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryContractsByOwnerRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "arkeo.arkeo.QueryContractsByOwnerRequest.pubkey":
+		return x.Pubkey != ""
+	case "arkeo.arkeo.QueryContractsByOwnerRequest.include_delegate":
+		return x.IncludeDelegate != false
+	case "arkeo.arkeo.QueryContractsByOwnerRequest.pagination":
+		return x.Pagination != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryContractsByOwnerRequest"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.QueryContractsByOwnerRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryContractsByOwnerRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "arkeo.arkeo.QueryContractsByOwnerRequest.pubkey":
+		x.Pubkey = ""
+	case "arkeo.arkeo.QueryContractsByOwnerRequest.include_delegate":
+		x.IncludeDelegate = false
+	case "arkeo.arkeo.QueryContractsByOwnerRequest.pagination":
+		x.Pagination = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryContractsByOwnerRequest"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.QueryContractsByOwnerRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryContractsByOwnerRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "arkeo.arkeo.QueryContractsByOwnerRequest.pubkey":
+		value := x.Pubkey
+		return protoreflect.ValueOfString(value)
+	case "arkeo.arkeo.QueryContractsByOwnerRequest.include_delegate":
+		value := x.IncludeDelegate
+		return protoreflect.ValueOfBool(value)
+	case "arkeo.arkeo.QueryContractsByOwnerRequest.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryContractsByOwnerRequest"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.QueryContractsByOwnerRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryContractsByOwnerRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "arkeo.arkeo.QueryContractsByOwnerRequest.pubkey":
+		x.Pubkey = value.Interface().(string)
+	case "arkeo.arkeo.QueryContractsByOwnerRequest.include_delegate":
+		x.IncludeDelegate = value.Bool()
+	case "arkeo.arkeo.QueryContractsByOwnerRequest.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryContractsByOwnerRequest"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.QueryContractsByOwnerRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryContractsByOwnerRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "arkeo.arkeo.QueryContractsByOwnerRequest.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageRequest)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	case "arkeo.arkeo.QueryContractsByOwnerRequest.pubkey":
+		panic(fmt.Errorf("field pubkey of message arkeo.arkeo.QueryContractsByOwnerRequest is not mutable"))
+	case "arkeo.arkeo.QueryContractsByOwnerRequest.include_delegate":
+		panic(fmt.Errorf("field include_delegate of message arkeo.arkeo.QueryContractsByOwnerRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryContractsByOwnerRequest"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.QueryContractsByOwnerRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryContractsByOwnerRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "arkeo.arkeo.QueryContractsByOwnerRequest.pubkey":
+		return protoreflect.ValueOfString("")
+	case "arkeo.arkeo.QueryContractsByOwnerRequest.include_delegate":
+		return protoreflect.ValueOfBool(false)
+	case "arkeo.arkeo.QueryContractsByOwnerRequest.pagination":
+		m := new(v1beta1.PageRequest)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryContractsByOwnerRequest"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.QueryContractsByOwnerRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryContractsByOwnerRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in arkeo.arkeo.QueryContractsByOwnerRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryContractsByOwnerRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryContractsByOwnerRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryContractsByOwnerRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryContractsByOwnerRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryContractsByOwnerRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Pubkey)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.IncludeDelegate {
+			n += 2
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryContractsByOwnerRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.IncludeDelegate {
+			i--
+			if x.IncludeDelegate {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Pubkey) > 0 {
+			i -= len(x.Pubkey)
+			copy(dAtA[i:], x.Pubkey)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Pubkey)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryContractsByOwnerRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryContractsByOwnerRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryContractsByOwnerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pubkey", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Pubkey = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IncludeDelegate", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.IncludeDelegate = bool(v != 0)
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageRequest{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_OwnerContract                       protoreflect.MessageDescriptor
+	fd_OwnerContract_contract              protoreflect.FieldDescriptor
+	fd_OwnerContract_expiration            protoreflect.FieldDescriptor
+	fd_OwnerContract_settlement_period_end protoreflect.FieldDescriptor
+	fd_OwnerContract_expired               protoreflect.FieldDescriptor
+	fd_OwnerContract_pending               protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_arkeo_arkeo_query_proto_init()
+	md_OwnerContract = File_arkeo_arkeo_query_proto.Messages().ByName("OwnerContract")
+	fd_OwnerContract_contract = md_OwnerContract.Fields().ByName("contract")
+	fd_OwnerContract_expiration = md_OwnerContract.Fields().ByName("expiration")
+	fd_OwnerContract_settlement_period_end = md_OwnerContract.Fields().ByName("settlement_period_end")
+	fd_OwnerContract_expired = md_OwnerContract.Fields().ByName("expired")
+	fd_OwnerContract_pending = md_OwnerContract.Fields().ByName("pending")
+}
+
+var _ protoreflect.Message = (*fastReflection_OwnerContract)(nil)
+
+type fastReflection_OwnerContract OwnerContract
+
+func (x *OwnerContract) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_OwnerContract)(x)
+}
+
+func (x *OwnerContract) slowProtoReflect() protoreflect.Message {
+	mi := &file_arkeo_arkeo_query_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_OwnerContract_messageType fastReflection_OwnerContract_messageType
+var _ protoreflect.MessageType = fastReflection_OwnerContract_messageType{}
+
+type fastReflection_OwnerContract_messageType struct{}
+
+func (x fastReflection_OwnerContract_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_OwnerContract)(nil)
+}
+func (x fastReflection_OwnerContract_messageType) New() protoreflect.Message {
+	return new(fastReflection_OwnerContract)
+}
+func (x fastReflection_OwnerContract_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_OwnerContract
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_OwnerContract) Descriptor() protoreflect.MessageDescriptor {
+	return md_OwnerContract
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_OwnerContract) Type() protoreflect.MessageType {
+	return _fastReflection_OwnerContract_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_OwnerContract) New() protoreflect.Message {
+	return new(fastReflection_OwnerContract)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_OwnerContract) Interface() protoreflect.ProtoMessage {
+	return (*OwnerContract)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_OwnerContract) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Contract != nil {
+		value := protoreflect.ValueOfMessage(x.Contract.ProtoReflect())
+		if !f(fd_OwnerContract_contract, value) {
+			return
+		}
+	}
+	if x.Expiration != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Expiration)
+		if !f(fd_OwnerContract_expiration, value) {
+			return
+		}
+	}
+	if x.SettlementPeriodEnd != int64(0) {
+		value := protoreflect.ValueOfInt64(x.SettlementPeriodEnd)
+		if !f(fd_OwnerContract_settlement_period_end, value) {
+			return
+		}
+	}
+	if x.Expired != false {
+		value := protoreflect.ValueOfBool(x.Expired)
+		if !f(fd_OwnerContract_expired, value) {
+			return
+		}
+	}
+	if x.Pending != false {
+		value := protoreflect.ValueOfBool(x.Pending)
+		if !f(fd_OwnerContract_pending, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_OwnerContract) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "arkeo.arkeo.OwnerContract.contract":
+		return x.Contract != nil
+	case "arkeo.arkeo.OwnerContract.expiration":
+		return x.Expiration != int64(0)
+	case "arkeo.arkeo.OwnerContract.settlement_period_end":
+		return x.SettlementPeriodEnd != int64(0)
+	case "arkeo.arkeo.OwnerContract.expired":
+		return x.Expired != false
+	case "arkeo.arkeo.OwnerContract.pending":
+		return x.Pending != false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.OwnerContract"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.OwnerContract does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_OwnerContract) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "arkeo.arkeo.OwnerContract.contract":
+		x.Contract = nil
+	case "arkeo.arkeo.OwnerContract.expiration":
+		x.Expiration = int64(0)
+	case "arkeo.arkeo.OwnerContract.settlement_period_end":
+		x.SettlementPeriodEnd = int64(0)
+	case "arkeo.arkeo.OwnerContract.expired":
+		x.Expired = false
+	case "arkeo.arkeo.OwnerContract.pending":
+		x.Pending = false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.OwnerContract"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.OwnerContract does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_OwnerContract) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "arkeo.arkeo.OwnerContract.contract":
+		value := x.Contract
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "arkeo.arkeo.OwnerContract.expiration":
+		value := x.Expiration
+		return protoreflect.ValueOfInt64(value)
+	case "arkeo.arkeo.OwnerContract.settlement_period_end":
+		value := x.SettlementPeriodEnd
+		return protoreflect.ValueOfInt64(value)
+	case "arkeo.arkeo.OwnerContract.expired":
+		value := x.Expired
+		return protoreflect.ValueOfBool(value)
+	case "arkeo.arkeo.OwnerContract.pending":
+		value := x.Pending
+		return protoreflect.ValueOfBool(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.OwnerContract"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.OwnerContract does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_OwnerContract) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "arkeo.arkeo.OwnerContract.contract":
+		x.Contract = value.Message().Interface().(*Contract)
+	case "arkeo.arkeo.OwnerContract.expiration":
+		x.Expiration = value.Int()
+	case "arkeo.arkeo.OwnerContract.settlement_period_end":
+		x.SettlementPeriodEnd = value.Int()
+	case "arkeo.arkeo.OwnerContract.expired":
+		x.Expired = value.Bool()
+	case "arkeo.arkeo.OwnerContract.pending":
+		x.Pending = value.Bool()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.OwnerContract"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.OwnerContract does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_OwnerContract) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "arkeo.arkeo.OwnerContract.contract":
+		if x.Contract == nil {
+			x.Contract = new(Contract)
+		}
+		return protoreflect.ValueOfMessage(x.Contract.ProtoReflect())
+	case "arkeo.arkeo.OwnerContract.expiration":
+		panic(fmt.Errorf("field expiration of message arkeo.arkeo.OwnerContract is not mutable"))
+	case "arkeo.arkeo.OwnerContract.settlement_period_end":
+		panic(fmt.Errorf("field settlement_period_end of message arkeo.arkeo.OwnerContract is not mutable"))
+	case "arkeo.arkeo.OwnerContract.expired":
+		panic(fmt.Errorf("field expired of message arkeo.arkeo.OwnerContract is not mutable"))
+	case "arkeo.arkeo.OwnerContract.pending":
+		panic(fmt.Errorf("field pending of message arkeo.arkeo.OwnerContract is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.OwnerContract"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.OwnerContract does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_OwnerContract) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "arkeo.arkeo.OwnerContract.contract":
+		m := new(Contract)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "arkeo.arkeo.OwnerContract.expiration":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "arkeo.arkeo.OwnerContract.settlement_period_end":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "arkeo.arkeo.OwnerContract.expired":
+		return protoreflect.ValueOfBool(false)
+	case "arkeo.arkeo.OwnerContract.pending":
+		return protoreflect.ValueOfBool(false)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.OwnerContract"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.OwnerContract does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_OwnerContract) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in arkeo.arkeo.OwnerContract", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_OwnerContract) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_OwnerContract) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_OwnerContract) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_OwnerContract) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*OwnerContract)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Contract != nil {
+			l = options.Size(x.Contract)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Expiration != 0 {
+			n += 1 + runtime.Sov(uint64(x.Expiration))
+		}
+		if x.SettlementPeriodEnd != 0 {
+			n += 1 + runtime.Sov(uint64(x.SettlementPeriodEnd))
+		}
+		if x.Expired {
+			n += 2
+		}
+		if x.Pending {
+			n += 2
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*OwnerContract)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pending {
+			i--
+			if x.Pending {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.Expired {
+			i--
+			if x.Expired {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.SettlementPeriodEnd != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SettlementPeriodEnd))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.Expiration != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Expiration))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Contract != nil {
+			encoded, err := options.Marshal(x.Contract)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*OwnerContract)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OwnerContract: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OwnerContract: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Contract == nil {
+					x.Contract = &Contract{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Contract); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+				}
+				x.Expiration = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Expiration |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SettlementPeriodEnd", wireType)
+				}
+				x.SettlementPeriodEnd = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SettlementPeriodEnd |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Expired", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Expired = bool(v != 0)
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pending", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Pending = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryContractsByOwnerResponse_1_list)(nil)
+
+type _QueryContractsByOwnerResponse_1_list struct {
+	list *[]*OwnerContract
+}
+
+func (x *_QueryContractsByOwnerResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryContractsByOwnerResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryContractsByOwnerResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*OwnerContract)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryContractsByOwnerResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*OwnerContract)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryContractsByOwnerResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(OwnerContract)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryContractsByOwnerResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryContractsByOwnerResponse_1_list) NewElement() protoreflect.Value {
+	v := new(OwnerContract)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryContractsByOwnerResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryContractsByOwnerResponse            protoreflect.MessageDescriptor
+	fd_QueryContractsByOwnerResponse_contracts  protoreflect.FieldDescriptor
+	fd_QueryContractsByOwnerResponse_pagination protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_arkeo_arkeo_query_proto_init()
+	md_QueryContractsByOwnerResponse = File_arkeo_arkeo_query_proto.Messages().ByName("QueryContractsByOwnerResponse")
+	fd_QueryContractsByOwnerResponse_contracts = md_QueryContractsByOwnerResponse.Fields().ByName("contracts")
+	fd_QueryContractsByOwnerResponse_pagination = md_QueryContractsByOwnerResponse.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryContractsByOwnerResponse)(nil)
+
+type fastReflection_QueryContractsByOwnerResponse QueryContractsByOwnerResponse
+
+func (x *QueryContractsByOwnerResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryContractsByOwnerResponse)(x)
+}
+
+func (x *QueryContractsByOwnerResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_arkeo_arkeo_query_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryContractsByOwnerResponse_messageType fastReflection_QueryContractsByOwnerResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryContractsByOwnerResponse_messageType{}
+
+type fastReflection_QueryContractsByOwnerResponse_messageType struct{}
+
+func (x fastReflection_QueryContractsByOwnerResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryContractsByOwnerResponse)(nil)
+}
+func (x fastReflection_QueryContractsByOwnerResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryContractsByOwnerResponse)
+}
+func (x fastReflection_QueryContractsByOwnerResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryContractsByOwnerResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryContractsByOwnerResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryContractsByOwnerResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryContractsByOwnerResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryContractsByOwnerResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryContractsByOwnerResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryContractsByOwnerResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryContractsByOwnerResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryContractsByOwnerResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryContractsByOwnerResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Contracts) != 0 {
+		value := protoreflect.ValueOfList(&_QueryContractsByOwnerResponse_1_list{list: &x.Contracts})
+		if !f(fd_QueryContractsByOwnerResponse_contracts, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryContractsByOwnerResponse_pagination, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryContractsByOwnerResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "arkeo.arkeo.QueryContractsByOwnerResponse.contracts":
+		return len(x.Contracts) != 0
+	case "arkeo.arkeo.QueryContractsByOwnerResponse.pagination":
+		return x.Pagination != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryContractsByOwnerResponse"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.QueryContractsByOwnerResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryContractsByOwnerResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "arkeo.arkeo.QueryContractsByOwnerResponse.contracts":
+		x.Contracts = nil
+	case "arkeo.arkeo.QueryContractsByOwnerResponse.pagination":
+		x.Pagination = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryContractsByOwnerResponse"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.QueryContractsByOwnerResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryContractsByOwnerResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "arkeo.arkeo.QueryContractsByOwnerResponse.contracts":
+		if len(x.Contracts) == 0 {
+			return protoreflect.ValueOfList(&_QueryContractsByOwnerResponse_1_list{})
+		}
+		listValue := &_QueryContractsByOwnerResponse_1_list{list: &x.Contracts}
+		return protoreflect.ValueOfList(listValue)
+	case "arkeo.arkeo.QueryContractsByOwnerResponse.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryContractsByOwnerResponse"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.QueryContractsByOwnerResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryContractsByOwnerResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "arkeo.arkeo.QueryContractsByOwnerResponse.contracts":
+		lv := value.List()
+		clv := lv.(*_QueryContractsByOwnerResponse_1_list)
+		x.Contracts = *clv.list
+	case "arkeo.arkeo.QueryContractsByOwnerResponse.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryContractsByOwnerResponse"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.QueryContractsByOwnerResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryContractsByOwnerResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "arkeo.arkeo.QueryContractsByOwnerResponse.contracts":
+		if x.Contracts == nil {
+			x.Contracts = []*OwnerContract{}
+		}
+		value := &_QueryContractsByOwnerResponse_1_list{list: &x.Contracts}
+		return protoreflect.ValueOfList(value)
+	case "arkeo.arkeo.QueryContractsByOwnerResponse.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageResponse)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryContractsByOwnerResponse"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.QueryContractsByOwnerResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryContractsByOwnerResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "arkeo.arkeo.QueryContractsByOwnerResponse.contracts":
+		list := []*OwnerContract{}
+		return protoreflect.ValueOfList(&_QueryContractsByOwnerResponse_1_list{list: &list})
+	case "arkeo.arkeo.QueryContractsByOwnerResponse.pagination":
+		m := new(v1beta1.PageResponse)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryContractsByOwnerResponse"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.QueryContractsByOwnerResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryContractsByOwnerResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in arkeo.arkeo.QueryContractsByOwnerResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryContractsByOwnerResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryContractsByOwnerResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryContractsByOwnerResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryContractsByOwnerResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryContractsByOwnerResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Contracts) > 0 {
+			for _, e := range x.Contracts {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryContractsByOwnerResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Contracts) > 0 {
+			for iNdEx := len(x.Contracts) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Contracts[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryContractsByOwnerResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryContractsByOwnerResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryContractsByOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Contracts", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Contracts = append(x.Contracts, &OwnerContract{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Contracts[len(x.Contracts)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageResponse{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryActiveContractRequest          protoreflect.MessageDescriptor
+	fd_QueryActiveContractRequest_provider protoreflect.FieldDescriptor
+	fd_QueryActiveContractRequest_service  protoreflect.FieldDescriptor
+	fd_QueryActiveContractRequest_spender  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_arkeo_arkeo_query_proto_init()
+	md_QueryActiveContractRequest = File_arkeo_arkeo_query_proto.Messages().ByName("QueryActiveContractRequest")
+	fd_QueryActiveContractRequest_provider = md_QueryActiveContractRequest.Fields().ByName("provider")
+	fd_QueryActiveContractRequest_service = md_QueryActiveContractRequest.Fields().ByName("service")
+	fd_QueryActiveContractRequest_spender = md_QueryActiveContractRequest.Fields().ByName("spender")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryActiveContractRequest)(nil)
+
+type fastReflection_QueryActiveContractRequest QueryActiveContractRequest
+
+func (x *QueryActiveContractRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryActiveContractRequest)(x)
+}
+
+func (x *QueryActiveContractRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_arkeo_arkeo_query_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryActiveContractRequest_messageType fastReflection_QueryActiveContractRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryActiveContractRequest_messageType{}
+
+type fastReflection_QueryActiveContractRequest_messageType struct{}
+
+func (x fastReflection_QueryActiveContractRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryActiveContractRequest)(nil)
+}
+func (x fastReflection_QueryActiveContractRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryActiveContractRequest)
+}
+func (x fastReflection_QueryActiveContractRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryActiveContractRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryActiveContractRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryActiveContractRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryActiveContractRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryActiveContractRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryActiveContractRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryActiveContractRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryActiveContractRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryActiveContractRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryActiveContractRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Provider != "" {
+		value := protoreflect.ValueOfString(x.Provider)
+		if !f(fd_QueryActiveContractRequest_provider, value) {
+			return
+		}
+	}
+	if x.Service != "" {
+		value := protoreflect.ValueOfString(x.Service)
+		if !f(fd_QueryActiveContractRequest_service, value) {
+			return
+		}
+	}
+	if x.Spender != "" {
+		value := protoreflect.ValueOfString(x.Spender)
+		if !f(fd_QueryActiveContractRequest_spender, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryActiveContractRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "arkeo.arkeo.QueryActiveContractRequest.provider":
+		return x.Provider != ""
+	case "arkeo.arkeo.QueryActiveContractRequest.service":
+		return x.Service != ""
+	case "arkeo.arkeo.QueryActiveContractRequest.spender":
+		return x.Spender != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryActiveContractRequest"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.QueryActiveContractRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryActiveContractRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "arkeo.arkeo.QueryActiveContractRequest.provider":
+		x.Provider = ""
+	case "arkeo.arkeo.QueryActiveContractRequest.service":
+		x.Service = ""
+	case "arkeo.arkeo.QueryActiveContractRequest.spender":
+		x.Spender = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryActiveContractRequest"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.QueryActiveContractRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryActiveContractRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "arkeo.arkeo.QueryActiveContractRequest.provider":
+		value := x.Provider
+		return protoreflect.ValueOfString(value)
+	case "arkeo.arkeo.QueryActiveContractRequest.service":
+		value := x.Service
+		return protoreflect.ValueOfString(value)
+	case "arkeo.arkeo.QueryActiveContractRequest.spender":
+		value := x.Spender
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryActiveContractRequest"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.QueryActiveContractRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryActiveContractRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "arkeo.arkeo.QueryActiveContractRequest.provider":
+		x.Provider = value.Interface().(string)
+	case "arkeo.arkeo.QueryActiveContractRequest.service":
+		x.Service = value.Interface().(string)
+	case "arkeo.arkeo.QueryActiveContractRequest.spender":
+		x.Spender = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryActiveContractRequest"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.QueryActiveContractRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryActiveContractRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "arkeo.arkeo.QueryActiveContractRequest.provider":
+		panic(fmt.Errorf("field provider of message arkeo.arkeo.QueryActiveContractRequest is not mutable"))
+	case "arkeo.arkeo.QueryActiveContractRequest.service":
+		panic(fmt.Errorf("field service of message arkeo.arkeo.QueryActiveContractRequest is not mutable"))
+	case "arkeo.arkeo.QueryActiveContractRequest.spender":
+		panic(fmt.Errorf("field spender of message arkeo.arkeo.QueryActiveContractRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryActiveContractRequest"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.QueryActiveContractRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryActiveContractRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "arkeo.arkeo.QueryActiveContractRequest.provider":
+		return protoreflect.ValueOfString("")
+	case "arkeo.arkeo.QueryActiveContractRequest.service":
+		return protoreflect.ValueOfString("")
+	case "arkeo.arkeo.QueryActiveContractRequest.spender":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryActiveContractRequest"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.QueryActiveContractRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryActiveContractRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in arkeo.arkeo.QueryActiveContractRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryActiveContractRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryActiveContractRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryActiveContractRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryActiveContractRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryActiveContractRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Provider)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Service)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Spender)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryActiveContractRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Spender) > 0 {
+			i -= len(x.Spender)
+			copy(dAtA[i:], x.Spender)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Spender)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Service) > 0 {
+			i -= len(x.Service)
+			copy(dAtA[i:], x.Service)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Service)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Provider) > 0 {
+			i -= len(x.Provider)
+			copy(dAtA[i:], x.Provider)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Provider)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryActiveContractRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryActiveContractRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryActiveContractRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Provider = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Service = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Spender", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Spender = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryActiveContractResponse                       protoreflect.MessageDescriptor
+	fd_QueryActiveContractResponse_contract              protoreflect.FieldDescriptor
+	fd_QueryActiveContractResponse_settlement_period_end protoreflect.FieldDescriptor
+	fd_QueryActiveContractResponse_pending               protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_arkeo_arkeo_query_proto_init()
+	md_QueryActiveContractResponse = File_arkeo_arkeo_query_proto.Messages().ByName("QueryActiveContractResponse")
+	fd_QueryActiveContractResponse_contract = md_QueryActiveContractResponse.Fields().ByName("contract")
+	fd_QueryActiveContractResponse_settlement_period_end = md_QueryActiveContractResponse.Fields().ByName("settlement_period_end")
+	fd_QueryActiveContractResponse_pending = md_QueryActiveContractResponse.Fields().ByName("pending")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryActiveContractResponse)(nil)
+
+type fastReflection_QueryActiveContractResponse QueryActiveContractResponse
+
+func (x *QueryActiveContractResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryActiveContractResponse)(x)
+}
+
+func (x *QueryActiveContractResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_arkeo_arkeo_query_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryActiveContractResponse_messageType fastReflection_QueryActiveContractResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryActiveContractResponse_messageType{}
+
+type fastReflection_QueryActiveContractResponse_messageType struct{}
+
+func (x fastReflection_QueryActiveContractResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryActiveContractResponse)(nil)
+}
+func (x fastReflection_QueryActiveContractResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryActiveContractResponse)
+}
+func (x fastReflection_QueryActiveContractResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryActiveContractResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryActiveContractResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryActiveContractResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryActiveContractResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryActiveContractResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryActiveContractResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryActiveContractResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryActiveContractResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryActiveContractResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryActiveContractResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Contract != nil {
+		value := protoreflect.ValueOfMessage(x.Contract.ProtoReflect())
+		if !f(fd_QueryActiveContractResponse_contract, value) {
+			return
+		}
+	}
+	if x.SettlementPeriodEnd != int64(0) {
+		value := protoreflect.ValueOfInt64(x.SettlementPeriodEnd)
+		if !f(fd_QueryActiveContractResponse_settlement_period_end, value) {
+			return
+		}
+	}
+	if x.Pending != false {
+		value := protoreflect.ValueOfBool(x.Pending)
+		if !f(fd_QueryActiveContractResponse_pending, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryActiveContractResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "arkeo.arkeo.QueryActiveContractResponse.contract":
+		return x.Contract != nil
+	case "arkeo.arkeo.QueryActiveContractResponse.settlement_period_end":
+		return x.SettlementPeriodEnd != int64(0)
+	case "arkeo.arkeo.QueryActiveContractResponse.pending":
+		return x.Pending != false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryActiveContractResponse"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.QueryActiveContractResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryActiveContractResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "arkeo.arkeo.QueryActiveContractResponse.contract":
+		x.Contract = nil
+	case "arkeo.arkeo.QueryActiveContractResponse.settlement_period_end":
+		x.SettlementPeriodEnd = int64(0)
+	case "arkeo.arkeo.QueryActiveContractResponse.pending":
+		x.Pending = false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryActiveContractResponse"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.QueryActiveContractResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryActiveContractResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "arkeo.arkeo.QueryActiveContractResponse.contract":
+		value := x.Contract
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "arkeo.arkeo.QueryActiveContractResponse.settlement_period_end":
+		value := x.SettlementPeriodEnd
+		return protoreflect.ValueOfInt64(value)
+	case "arkeo.arkeo.QueryActiveContractResponse.pending":
+		value := x.Pending
+		return protoreflect.ValueOfBool(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryActiveContractResponse"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.QueryActiveContractResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryActiveContractResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "arkeo.arkeo.QueryActiveContractResponse.contract":
+		x.Contract = value.Message().Interface().(*Contract)
+	case "arkeo.arkeo.QueryActiveContractResponse.settlement_period_end":
+		x.SettlementPeriodEnd = value.Int()
+	case "arkeo.arkeo.QueryActiveContractResponse.pending":
+		x.Pending = value.Bool()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryActiveContractResponse"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.QueryActiveContractResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryActiveContractResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "arkeo.arkeo.QueryActiveContractResponse.contract":
+		if x.Contract == nil {
+			x.Contract = new(Contract)
+		}
+		return protoreflect.ValueOfMessage(x.Contract.ProtoReflect())
+	case "arkeo.arkeo.QueryActiveContractResponse.settlement_period_end":
+		panic(fmt.Errorf("field settlement_period_end of message arkeo.arkeo.QueryActiveContractResponse is not mutable"))
+	case "arkeo.arkeo.QueryActiveContractResponse.pending":
+		panic(fmt.Errorf("field pending of message arkeo.arkeo.QueryActiveContractResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryActiveContractResponse"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.QueryActiveContractResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryActiveContractResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "arkeo.arkeo.QueryActiveContractResponse.contract":
+		m := new(Contract)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "arkeo.arkeo.QueryActiveContractResponse.settlement_period_end":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "arkeo.arkeo.QueryActiveContractResponse.pending":
+		return protoreflect.ValueOfBool(false)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryActiveContractResponse"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.QueryActiveContractResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryActiveContractResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in arkeo.arkeo.QueryActiveContractResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryActiveContractResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryActiveContractResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryActiveContractResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryActiveContractResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryActiveContractResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Contract != nil {
+			l = options.Size(x.Contract)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.SettlementPeriodEnd != 0 {
+			n += 1 + runtime.Sov(uint64(x.SettlementPeriodEnd))
+		}
+		if x.Pending {
+			n += 2
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryActiveContractResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pending {
+			i--
+			if x.Pending {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.SettlementPeriodEnd != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SettlementPeriodEnd))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Contract != nil {
+			encoded, err := options.Marshal(x.Contract)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryActiveContractResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryActiveContractResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryActiveContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Contract == nil {
+					x.Contract = &Contract{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Contract); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SettlementPeriodEnd", wireType)
+				}
+				x.SettlementPeriodEnd = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SettlementPeriodEnd |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pending", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Pending = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryNetworkStatsRequest protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_arkeo_arkeo_query_proto_init()
+	md_QueryNetworkStatsRequest = File_arkeo_arkeo_query_proto.Messages().ByName("QueryNetworkStatsRequest")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryNetworkStatsRequest)(nil)
+
+type fastReflection_QueryNetworkStatsRequest QueryNetworkStatsRequest
+
+func (x *QueryNetworkStatsRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryNetworkStatsRequest)(x)
+}
+
+func (x *QueryNetworkStatsRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_arkeo_arkeo_query_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryNetworkStatsRequest_messageType fastReflection_QueryNetworkStatsRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryNetworkStatsRequest_messageType{}
+
+type fastReflection_QueryNetworkStatsRequest_messageType struct{}
+
+func (x fastReflection_QueryNetworkStatsRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryNetworkStatsRequest)(nil)
+}
+func (x fastReflection_QueryNetworkStatsRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryNetworkStatsRequest)
+}
+func (x fastReflection_QueryNetworkStatsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryNetworkStatsRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryNetworkStatsRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryNetworkStatsRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryNetworkStatsRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryNetworkStatsRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryNetworkStatsRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryNetworkStatsRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryNetworkStatsRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryNetworkStatsRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryNetworkStatsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryNetworkStatsRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryNetworkStatsRequest"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.QueryNetworkStatsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryNetworkStatsRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryNetworkStatsRequest"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.QueryNetworkStatsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryNetworkStatsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryNetworkStatsRequest"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.QueryNetworkStatsRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryNetworkStatsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryNetworkStatsRequest"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.QueryNetworkStatsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryNetworkStatsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryNetworkStatsRequest"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.QueryNetworkStatsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryNetworkStatsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryNetworkStatsRequest"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.QueryNetworkStatsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryNetworkStatsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in arkeo.arkeo.QueryNetworkStatsRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryNetworkStatsRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryNetworkStatsRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryNetworkStatsRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryNetworkStatsRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryNetworkStatsRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryNetworkStatsRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryNetworkStatsRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryNetworkStatsRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryNetworkStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryNetworkStatsResponse       protoreflect.MessageDescriptor
+	fd_QueryNetworkStatsResponse_stats protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_arkeo_arkeo_query_proto_init()
+	md_QueryNetworkStatsResponse = File_arkeo_arkeo_query_proto.Messages().ByName("QueryNetworkStatsResponse")
+	fd_QueryNetworkStatsResponse_stats = md_QueryNetworkStatsResponse.Fields().ByName("stats")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryNetworkStatsResponse)(nil)
+
+type fastReflection_QueryNetworkStatsResponse QueryNetworkStatsResponse
+
+func (x *QueryNetworkStatsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryNetworkStatsResponse)(x)
+}
+
+func (x *QueryNetworkStatsResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_arkeo_arkeo_query_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryNetworkStatsResponse_messageType fastReflection_QueryNetworkStatsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryNetworkStatsResponse_messageType{}
+
+type fastReflection_QueryNetworkStatsResponse_messageType struct{}
+
+func (x fastReflection_QueryNetworkStatsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryNetworkStatsResponse)(nil)
+}
+func (x fastReflection_QueryNetworkStatsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryNetworkStatsResponse)
+}
+func (x fastReflection_QueryNetworkStatsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryNetworkStatsResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryNetworkStatsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryNetworkStatsResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryNetworkStatsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryNetworkStatsResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryNetworkStatsResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryNetworkStatsResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryNetworkStatsResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryNetworkStatsResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryNetworkStatsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Stats != nil {
+		value := protoreflect.ValueOfMessage(x.Stats.ProtoReflect())
+		if !f(fd_QueryNetworkStatsResponse_stats, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryNetworkStatsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "arkeo.arkeo.QueryNetworkStatsResponse.stats":
+		return x.Stats != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryNetworkStatsResponse"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.QueryNetworkStatsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryNetworkStatsResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "arkeo.arkeo.QueryNetworkStatsResponse.stats":
+		x.Stats = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryNetworkStatsResponse"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.QueryNetworkStatsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryNetworkStatsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "arkeo.arkeo.QueryNetworkStatsResponse.stats":
+		value := x.Stats
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryNetworkStatsResponse"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.QueryNetworkStatsResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryNetworkStatsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "arkeo.arkeo.QueryNetworkStatsResponse.stats":
+		x.Stats = value.Message().Interface().(*NetworkStats)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryNetworkStatsResponse"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.QueryNetworkStatsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryNetworkStatsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "arkeo.arkeo.QueryNetworkStatsResponse.stats":
+		if x.Stats == nil {
+			x.Stats = new(NetworkStats)
+		}
+		return protoreflect.ValueOfMessage(x.Stats.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryNetworkStatsResponse"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.QueryNetworkStatsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryNetworkStatsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "arkeo.arkeo.QueryNetworkStatsResponse.stats":
+		m := new(NetworkStats)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.QueryNetworkStatsResponse"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.QueryNetworkStatsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryNetworkStatsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in arkeo.arkeo.QueryNetworkStatsResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryNetworkStatsResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryNetworkStatsResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryNetworkStatsResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryNetworkStatsResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryNetworkStatsResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Stats != nil {
+			l = options.Size(x.Stats)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryNetworkStatsResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Stats != nil {
+			encoded, err := options.Marshal(x.Stats)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryNetworkStatsResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryNetworkStatsResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryNetworkStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Stats == nil {
+					x.Stats = &NetworkStats{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Stats); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: arkeo/arkeo/query.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// QueryParamsRequest is request type for the Query/Params RPC method.
+type QueryParamsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *QueryParamsRequest) Reset() {
+	*x = QueryParamsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_arkeo_arkeo_query_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryParamsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryParamsRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryParamsRequest.ProtoReflect.Descriptor instead.
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
 	return file_arkeo_arkeo_query_proto_rawDescGZIP(), []int{0}
 }
 
-// QueryParamsResponse is response type for the Query/Params RPC method.
-type QueryParamsResponse struct {
+// QueryParamsResponse is response type for the Query/Params RPC method.
+type QueryParamsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// params holds all the parameters of this module.
+	Params *Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	// height the params last changed at, zero until the end blocker first saw
+	// them
+	LastChangeHeight int64 `protobuf:"varint,2,opt,name=last_change_height,json=lastChangeHeight,proto3" json:"last_change_height,omitempty"`
+	// consensus version of the module, bumped on each state breaking change
+	ConsensusVersion uint64 `protobuf:"varint,3,opt,name=consensus_version,json=consensusVersion,proto3" json:"consensus_version,omitempty"`
+	// version the chain runs, selecting the configs and the handlers enabled
+	Version int64 `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *QueryParamsResponse) Reset() {
+	*x = QueryParamsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_arkeo_arkeo_query_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryParamsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryParamsResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryParamsResponse.ProtoReflect.Descriptor instead.
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return file_arkeo_arkeo_query_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *QueryParamsResponse) GetParams() *Params {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+func (x *QueryParamsResponse) GetLastChangeHeight() int64 {
+	if x != nil {
+		return x.LastChangeHeight
+	}
+	return 0
+}
+
+func (x *QueryParamsResponse) GetConsensusVersion() uint64 {
+	if x != nil {
+		return x.ConsensusVersion
+	}
+	return 0
+}
+
+func (x *QueryParamsResponse) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type QueryFetchProviderRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pubkey  string `protobuf:"bytes,1,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	Service string `protobuf:"bytes,2,opt,name=service,proto3" json:"service,omitempty"`
+}
+
+func (x *QueryFetchProviderRequest) Reset() {
+	*x = QueryFetchProviderRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_arkeo_arkeo_query_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryFetchProviderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryFetchProviderRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryFetchProviderRequest.ProtoReflect.Descriptor instead.
+func (*QueryFetchProviderRequest) Descriptor() ([]byte, []int) {
+	return file_arkeo_arkeo_query_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *QueryFetchProviderRequest) GetPubkey() string {
+	if x != nil {
+		return x.Pubkey
+	}
+	return ""
+}
+
+func (x *QueryFetchProviderRequest) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+type QueryFetchProviderResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Provider *Provider `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+}
+
+func (x *QueryFetchProviderResponse) Reset() {
+	*x = QueryFetchProviderResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_arkeo_arkeo_query_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryFetchProviderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryFetchProviderResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryFetchProviderResponse.ProtoReflect.Descriptor instead.
+func (*QueryFetchProviderResponse) Descriptor() ([]byte, []int) {
+	return file_arkeo_arkeo_query_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *QueryFetchProviderResponse) GetProvider() *Provider {
+	if x != nil {
+		return x.Provider
+	}
+	return nil
+}
+
+type QueryProviderEarningsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pubkey  string `protobuf:"bytes,1,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	Service string `protobuf:"bytes,2,opt,name=service,proto3" json:"service,omitempty"`
+}
+
+func (x *QueryProviderEarningsRequest) Reset() {
+	*x = QueryProviderEarningsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_arkeo_arkeo_query_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryProviderEarningsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryProviderEarningsRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryProviderEarningsRequest.ProtoReflect.Descriptor instead.
+func (*QueryProviderEarningsRequest) Descriptor() ([]byte, []int) {
+	return file_arkeo_arkeo_query_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *QueryProviderEarningsRequest) GetPubkey() string {
+	if x != nil {
+		return x.Pubkey
+	}
+	return ""
+}
+
+func (x *QueryProviderEarningsRequest) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+type QueryProviderEarningsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Earnings *ProviderEarnings `protobuf:"bytes,1,opt,name=earnings,proto3" json:"earnings,omitempty"`
+}
+
+func (x *QueryProviderEarningsResponse) Reset() {
+	*x = QueryProviderEarningsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_arkeo_arkeo_query_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryProviderEarningsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryProviderEarningsResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryProviderEarningsResponse.ProtoReflect.Descriptor instead.
+func (*QueryProviderEarningsResponse) Descriptor() ([]byte, []int) {
+	return file_arkeo_arkeo_query_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *QueryProviderEarningsResponse) GetEarnings() *ProviderEarnings {
+	if x != nil {
+		return x.Earnings
+	}
+	return nil
+}
+
+type QueryAllProviderRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pagination *v1beta1.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *QueryAllProviderRequest) Reset() {
+	*x = QueryAllProviderRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_arkeo_arkeo_query_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryAllProviderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryAllProviderRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryAllProviderRequest.ProtoReflect.Descriptor instead.
+func (*QueryAllProviderRequest) Descriptor() ([]byte, []int) {
+	return file_arkeo_arkeo_query_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *QueryAllProviderRequest) GetPagination() *v1beta1.PageRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type QueryAllProviderResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Provider   []*Provider           `protobuf:"bytes,1,rep,name=provider,proto3" json:"provider,omitempty"`
+	Pagination *v1beta1.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *QueryAllProviderResponse) Reset() {
+	*x = QueryAllProviderResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_arkeo_arkeo_query_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryAllProviderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryAllProviderResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryAllProviderResponse.ProtoReflect.Descriptor instead.
+func (*QueryAllProviderResponse) Descriptor() ([]byte, []int) {
+	return file_arkeo_arkeo_query_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *QueryAllProviderResponse) GetProvider() []*Provider {
+	if x != nil {
+		return x.Provider
+	}
+	return nil
+}
+
+func (x *QueryAllProviderResponse) GetPagination() *v1beta1.PageResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type QueryFetchContractRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ContractId uint64 `protobuf:"varint,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+}
+
+func (x *QueryFetchContractRequest) Reset() {
+	*x = QueryFetchContractRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_arkeo_arkeo_query_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryFetchContractRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryFetchContractRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryFetchContractRequest.ProtoReflect.Descriptor instead.
+func (*QueryFetchContractRequest) Descriptor() ([]byte, []int) {
+	return file_arkeo_arkeo_query_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *QueryFetchContractRequest) GetContractId() uint64 {
+	if x != nil {
+		return x.ContractId
+	}
+	return 0
+}
+
+type QueryFetchContractResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Contract *Contract `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	// height the contract is settled at, at the latest, grace period included
+	SettlementPeriodEnd int64 `protobuf:"varint,2,opt,name=settlement_period_end,json=settlementPeriodEnd,proto3" json:"settlement_period_end,omitempty"`
+	// pending is true until the scheduled contract reaches its start height
+	Pending bool `protobuf:"varint,3,opt,name=pending,proto3" json:"pending,omitempty"`
+}
+
+func (x *QueryFetchContractResponse) Reset() {
+	*x = QueryFetchContractResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_arkeo_arkeo_query_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryFetchContractResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryFetchContractResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryFetchContractResponse.ProtoReflect.Descriptor instead.
+func (*QueryFetchContractResponse) Descriptor() ([]byte, []int) {
+	return file_arkeo_arkeo_query_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *QueryFetchContractResponse) GetContract() *Contract {
+	if x != nil {
+		return x.Contract
+	}
+	return nil
+}
+
+func (x *QueryFetchContractResponse) GetSettlementPeriodEnd() int64 {
+	if x != nil {
+		return x.SettlementPeriodEnd
+	}
+	return 0
+}
+
+func (x *QueryFetchContractResponse) GetPending() bool {
+	if x != nil {
+		return x.Pending
+	}
+	return false
+}
+
+type QueryContractSettlementPreviewRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ContractId uint64 `protobuf:"varint,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	// nonce claimed, zero for the settlement closing the contract
+	Nonce int64 `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
+}
+
+func (x *QueryContractSettlementPreviewRequest) Reset() {
+	*x = QueryContractSettlementPreviewRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_arkeo_arkeo_query_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryContractSettlementPreviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryContractSettlementPreviewRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryContractSettlementPreviewRequest.ProtoReflect.Descriptor instead.
+func (*QueryContractSettlementPreviewRequest) Descriptor() ([]byte, []int) {
+	return file_arkeo_arkeo_query_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *QueryContractSettlementPreviewRequest) GetContractId() uint64 {
+	if x != nil {
+		return x.ContractId
+	}
+	return 0
+}
+
+func (x *QueryContractSettlementPreviewRequest) GetNonce() int64 {
+	if x != nil {
+		return x.Nonce
+	}
+	return 0
+}
+
+type QueryContractSettlementPreviewResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Settlement *ContractSettlement `protobuf:"bytes,1,opt,name=settlement,proto3" json:"settlement,omitempty"`
+}
+
+func (x *QueryContractSettlementPreviewResponse) Reset() {
+	*x = QueryContractSettlementPreviewResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_arkeo_arkeo_query_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryContractSettlementPreviewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryContractSettlementPreviewResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryContractSettlementPreviewResponse.ProtoReflect.Descriptor instead.
+func (*QueryContractSettlementPreviewResponse) Descriptor() ([]byte, []int) {
+	return file_arkeo_arkeo_query_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *QueryContractSettlementPreviewResponse) GetSettlement() *ContractSettlement {
+	if x != nil {
+		return x.Settlement
+	}
+	return nil
+}
+
+type QueryClaimableIncomeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// params holds all the parameters of this module.
-	Params *Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	ContractId uint64 `protobuf:"varint,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	// highest nonce the provider holds a signature of
+	Nonce int64 `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
 }
 
-func (x *QueryParamsResponse) Reset() {
-	*x = QueryParamsResponse{}
+func (x *QueryClaimableIncomeRequest) Reset() {
+	*x = QueryClaimableIncomeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_arkeo_arkeo_query_proto_msgTypes[1]
+		mi := &file_arkeo_arkeo_query_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryParamsResponse) String() string {
+func (x *QueryClaimableIncomeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryParamsResponse) ProtoMessage() {}
+func (*QueryClaimableIncomeRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryParamsResponse.ProtoReflect.Descriptor instead.
-func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return file_arkeo_arkeo_query_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use QueryClaimableIncomeRequest.ProtoReflect.Descriptor instead.
+func (*QueryClaimableIncomeRequest) Descriptor() ([]byte, []int) {
+	return file_arkeo_arkeo_query_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *QueryParamsResponse) GetParams() *Params {
+func (x *QueryClaimableIncomeRequest) GetContractId() uint64 {
 	if x != nil {
-		return x.Params
+		return x.ContractId
+	}
+	return 0
+}
+
+func (x *QueryClaimableIncomeRequest) GetNonce() int64 {
+	if x != nil {
+		return x.Nonce
+	}
+	return 0
+}
+
+type QueryClaimableIncomeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// nonce the claim is paid for, capped to the queries the contract allows
+	Nonce int64 `protobuf:"varint,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// paid to the provider, net of the reserve tax
+	Claimable  *v1beta11.Coin `protobuf:"bytes,2,opt,name=claimable,proto3" json:"claimable,omitempty"`
+	ReserveTax *v1beta11.Coin `protobuf:"bytes,3,opt,name=reserve_tax,json=reserveTax,proto3" json:"reserve_tax,omitempty"`
+	// deposit left in the contract once claimed
+	RemainingEscrow *v1beta11.Coin `protobuf:"bytes,4,opt,name=remaining_escrow,json=remainingEscrow,proto3" json:"remaining_escrow,omitempty"`
+	// rounding remainder accrued to the reserve along the reserve tax
+	Dust *v1beta11.Coin `protobuf:"bytes,5,opt,name=dust,proto3" json:"dust,omitempty"`
+}
+
+func (x *QueryClaimableIncomeResponse) Reset() {
+	*x = QueryClaimableIncomeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_arkeo_arkeo_query_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryClaimableIncomeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryClaimableIncomeResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryClaimableIncomeResponse.ProtoReflect.Descriptor instead.
+func (*QueryClaimableIncomeResponse) Descriptor() ([]byte, []int) {
+	return file_arkeo_arkeo_query_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *QueryClaimableIncomeResponse) GetNonce() int64 {
+	if x != nil {
+		return x.Nonce
+	}
+	return 0
+}
+
+func (x *QueryClaimableIncomeResponse) GetClaimable() *v1beta11.Coin {
+	if x != nil {
+		return x.Claimable
 	}
 	return nil
 }
 
-type QueryFetchProviderRequest struct {
+func (x *QueryClaimableIncomeResponse) GetReserveTax() *v1beta11.Coin {
+	if x != nil {
+		return x.ReserveTax
+	}
+	return nil
+}
+
+func (x *QueryClaimableIncomeResponse) GetRemainingEscrow() *v1beta11.Coin {
+	if x != nil {
+		return x.RemainingEscrow
+	}
+	return nil
+}
+
+func (x *QueryClaimableIncomeResponse) GetDust() *v1beta11.Coin {
+	if x != nil {
+		return x.Dust
+	}
+	return nil
+}
+
+type QueryProvidersRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Pubkey  string `protobuf:"bytes,1,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
-	Service string `protobuf:"bytes,2,opt,name=service,proto3" json:"service,omitempty"`
+	Service string `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
+	// online or offline, all the providers of the service when empty
+	StatusFilter string               `protobuf:"bytes,2,opt,name=status_filter,json=statusFilter,proto3" json:"status_filter,omitempty"`
+	Pagination   *v1beta1.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *QueryFetchProviderRequest) Reset() {
-	*x = QueryFetchProviderRequest{}
+func (x *QueryProvidersRequest) Reset() {
+	*x = QueryProvidersRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_arkeo_arkeo_query_proto_msgTypes[2]
+		mi := &file_arkeo_arkeo_query_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryFetchProviderRequest) String() string {
+func (x *QueryProvidersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryFetchProviderRequest) ProtoMessage() {}
+func (*QueryProvidersRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryFetchProviderRequest.ProtoReflect.Descriptor instead.
-func (*QueryFetchProviderRequest) Descriptor() ([]byte, []int) {
-	return file_arkeo_arkeo_query_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use QueryProvidersRequest.ProtoReflect.Descriptor instead.
+func (*QueryProvidersRequest) Descriptor() ([]byte, []int) {
+	return file_arkeo_arkeo_query_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *QueryFetchProviderRequest) GetPubkey() string {
+func (x *QueryProvidersRequest) GetService() string {
 	if x != nil {
-		return x.Pubkey
+		return x.Service
 	}
 	return ""
 }
 
-func (x *QueryFetchProviderRequest) GetService() string {
+func (x *QueryProvidersRequest) GetStatusFilter() string {
 	if x != nil {
-		return x.Service
+		return x.StatusFilter
 	}
 	return ""
 }
 
-type QueryFetchProviderResponse struct {
+func (x *QueryProvidersRequest) GetPagination() *v1beta1.PageRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type QueryProvidersResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Provider *Provider `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Providers  []*Provider           `protobuf:"bytes,1,rep,name=providers,proto3" json:"providers,omitempty"`
+	Pagination *v1beta1.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *QueryFetchProviderResponse) Reset() {
-	*x = QueryFetchProviderResponse{}
+func (x *QueryProvidersResponse) Reset() {
+	*x = QueryProvidersResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_arkeo_arkeo_query_proto_msgTypes[3]
+		mi := &file_arkeo_arkeo_query_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryFetchProviderResponse) String() string {
+func (x *QueryProvidersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryFetchProviderResponse) ProtoMessage() {}
+func (*QueryProvidersResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryFetchProviderResponse.ProtoReflect.Descriptor instead.
-func (*QueryFetchProviderResponse) Descriptor() ([]byte, []int) {
-	return file_arkeo_arkeo_query_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use QueryProvidersResponse.ProtoReflect.Descriptor instead.
+func (*QueryProvidersResponse) Descriptor() ([]byte, []int) {
+	return file_arkeo_arkeo_query_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *QueryFetchProviderResponse) GetProvider() *Provider {
+func (x *QueryProvidersResponse) GetProviders() []*Provider {
 	if x != nil {
-		return x.Provider
+		return x.Providers
 	}
 	return nil
 }
 
-type QueryAllProviderRequest struct {
+func (x *QueryProvidersResponse) GetPagination() *v1beta1.PageResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type QueryAllContractRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -5726,218 +14506,337 @@ type QueryAllProviderRequest struct {
 	Pagination *v1beta1.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *QueryAllProviderRequest) Reset() {
-	*x = QueryAllProviderRequest{}
+func (x *QueryAllContractRequest) Reset() {
+	*x = QueryAllContractRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_arkeo_arkeo_query_proto_msgTypes[4]
+		mi := &file_arkeo_arkeo_query_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryAllProviderRequest) String() string {
+func (x *QueryAllContractRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryAllProviderRequest) ProtoMessage() {}
+func (*QueryAllContractRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryAllProviderRequest.ProtoReflect.Descriptor instead.
-func (*QueryAllProviderRequest) Descriptor() ([]byte, []int) {
-	return file_arkeo_arkeo_query_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use QueryAllContractRequest.ProtoReflect.Descriptor instead.
+func (*QueryAllContractRequest) Descriptor() ([]byte, []int) {
+	return file_arkeo_arkeo_query_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *QueryAllProviderRequest) GetPagination() *v1beta1.PageRequest {
+func (x *QueryAllContractRequest) GetPagination() *v1beta1.PageRequest {
 	if x != nil {
 		return x.Pagination
 	}
 	return nil
 }
 
-type QueryAllProviderResponse struct {
+type QueryAllContractResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Provider   []*Provider           `protobuf:"bytes,1,rep,name=provider,proto3" json:"provider,omitempty"`
+	Contract   []*Contract           `protobuf:"bytes,1,rep,name=contract,proto3" json:"contract,omitempty"`
 	Pagination *v1beta1.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *QueryAllProviderResponse) Reset() {
-	*x = QueryAllProviderResponse{}
+func (x *QueryAllContractResponse) Reset() {
+	*x = QueryAllContractResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_arkeo_arkeo_query_proto_msgTypes[5]
+		mi := &file_arkeo_arkeo_query_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryAllProviderResponse) String() string {
+func (x *QueryAllContractResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryAllProviderResponse) ProtoMessage() {}
+func (*QueryAllContractResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryAllProviderResponse.ProtoReflect.Descriptor instead.
-func (*QueryAllProviderResponse) Descriptor() ([]byte, []int) {
-	return file_arkeo_arkeo_query_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use QueryAllContractResponse.ProtoReflect.Descriptor instead.
+func (*QueryAllContractResponse) Descriptor() ([]byte, []int) {
+	return file_arkeo_arkeo_query_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *QueryAllProviderResponse) GetProvider() []*Provider {
+func (x *QueryAllContractResponse) GetContract() []*Contract {
 	if x != nil {
-		return x.Provider
+		return x.Contract
 	}
 	return nil
 }
 
-func (x *QueryAllProviderResponse) GetPagination() *v1beta1.PageResponse {
+func (x *QueryAllContractResponse) GetPagination() *v1beta1.PageResponse {
 	if x != nil {
 		return x.Pagination
 	}
 	return nil
 }
 
-type QueryFetchContractRequest struct {
+type QueryContractsByProviderRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ContractId uint64 `protobuf:"varint,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	Provider string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Service  string `protobuf:"bytes,2,opt,name=service,proto3" json:"service,omitempty"`
+	// pending, open or closed, all the contracts when empty
+	State      string               `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	Pagination *v1beta1.PageRequest `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *QueryFetchContractRequest) Reset() {
-	*x = QueryFetchContractRequest{}
+func (x *QueryContractsByProviderRequest) Reset() {
+	*x = QueryContractsByProviderRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_arkeo_arkeo_query_proto_msgTypes[6]
+		mi := &file_arkeo_arkeo_query_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryFetchContractRequest) String() string {
+func (x *QueryContractsByProviderRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryFetchContractRequest) ProtoMessage() {}
+func (*QueryContractsByProviderRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryFetchContractRequest.ProtoReflect.Descriptor instead.
-func (*QueryFetchContractRequest) Descriptor() ([]byte, []int) {
-	return file_arkeo_arkeo_query_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use QueryContractsByProviderRequest.ProtoReflect.Descriptor instead.
+func (*QueryContractsByProviderRequest) Descriptor() ([]byte, []int) {
+	return file_arkeo_arkeo_query_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *QueryFetchContractRequest) GetContractId() uint64 {
+func (x *QueryContractsByProviderRequest) GetProvider() string {
 	if x != nil {
-		return x.ContractId
+		return x.Provider
 	}
-	return 0
+	return ""
 }
 
-type QueryFetchContractResponse struct {
+func (x *QueryContractsByProviderRequest) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *QueryContractsByProviderRequest) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *QueryContractsByProviderRequest) GetPagination() *v1beta1.PageRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type QueryContractsByProviderResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Contract *Contract `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	Contract   []*Contract           `protobuf:"bytes,1,rep,name=contract,proto3" json:"contract,omitempty"`
+	Pagination *v1beta1.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *QueryFetchContractResponse) Reset() {
-	*x = QueryFetchContractResponse{}
+func (x *QueryContractsByProviderResponse) Reset() {
+	*x = QueryContractsByProviderResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_arkeo_arkeo_query_proto_msgTypes[7]
+		mi := &file_arkeo_arkeo_query_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryFetchContractResponse) String() string {
+func (x *QueryContractsByProviderResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryFetchContractResponse) ProtoMessage() {}
+func (*QueryContractsByProviderResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryFetchContractResponse.ProtoReflect.Descriptor instead.
-func (*QueryFetchContractResponse) Descriptor() ([]byte, []int) {
-	return file_arkeo_arkeo_query_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use QueryContractsByProviderResponse.ProtoReflect.Descriptor instead.
+func (*QueryContractsByProviderResponse) Descriptor() ([]byte, []int) {
+	return file_arkeo_arkeo_query_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *QueryFetchContractResponse) GetContract() *Contract {
+func (x *QueryContractsByProviderResponse) GetContract() []*Contract {
 	if x != nil {
 		return x.Contract
 	}
 	return nil
 }
 
-type QueryAllContractRequest struct {
+func (x *QueryContractsByProviderResponse) GetPagination() *v1beta1.PageResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type QueryContractsByOwnerRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Pagination *v1beta1.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Pubkey string `protobuf:"bytes,1,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	// also return the contracts the pubkey is the delegate of
+	IncludeDelegate bool                 `protobuf:"varint,2,opt,name=include_delegate,json=includeDelegate,proto3" json:"include_delegate,omitempty"`
+	Pagination      *v1beta1.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *QueryAllContractRequest) Reset() {
-	*x = QueryAllContractRequest{}
+func (x *QueryContractsByOwnerRequest) Reset() {
+	*x = QueryContractsByOwnerRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_arkeo_arkeo_query_proto_msgTypes[8]
+		mi := &file_arkeo_arkeo_query_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryContractsByOwnerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryContractsByOwnerRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryContractsByOwnerRequest.ProtoReflect.Descriptor instead.
+func (*QueryContractsByOwnerRequest) Descriptor() ([]byte, []int) {
+	return file_arkeo_arkeo_query_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *QueryContractsByOwnerRequest) GetPubkey() string {
+	if x != nil {
+		return x.Pubkey
+	}
+	return ""
+}
+
+func (x *QueryContractsByOwnerRequest) GetIncludeDelegate() bool {
+	if x != nil {
+		return x.IncludeDelegate
+	}
+	return false
+}
+
+func (x *QueryContractsByOwnerRequest) GetPagination() *v1beta1.PageRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type OwnerContract struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Contract   *Contract `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	Expiration int64     `protobuf:"varint,2,opt,name=expiration,proto3" json:"expiration,omitempty"`
+	// height the contract is settled at, at the latest, grace period included
+	SettlementPeriodEnd int64 `protobuf:"varint,3,opt,name=settlement_period_end,json=settlementPeriodEnd,proto3" json:"settlement_period_end,omitempty"`
+	Expired             bool  `protobuf:"varint,4,opt,name=expired,proto3" json:"expired,omitempty"`
+	// pending is true until the scheduled contract reaches its start height
+	Pending bool `protobuf:"varint,6,opt,name=pending,proto3" json:"pending,omitempty"`
+}
+
+func (x *OwnerContract) Reset() {
+	*x = OwnerContract{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_arkeo_arkeo_query_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryAllContractRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *OwnerContract) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OwnerContract) ProtoMessage() {}
+
+// Deprecated: Use OwnerContract.ProtoReflect.Descriptor instead.
+func (*OwnerContract) Descriptor() ([]byte, []int) {
+	return file_arkeo_arkeo_query_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *OwnerContract) GetContract() *Contract {
+	if x != nil {
+		return x.Contract
+	}
+	return nil
+}
+
+func (x *OwnerContract) GetExpiration() int64 {
+	if x != nil {
+		return x.Expiration
+	}
+	return 0
+}
+
+func (x *OwnerContract) GetSettlementPeriodEnd() int64 {
+	if x != nil {
+		return x.SettlementPeriodEnd
+	}
+	return 0
 }
 
-func (*QueryAllContractRequest) ProtoMessage() {}
-
-// Deprecated: Use QueryAllContractRequest.ProtoReflect.Descriptor instead.
-func (*QueryAllContractRequest) Descriptor() ([]byte, []int) {
-	return file_arkeo_arkeo_query_proto_rawDescGZIP(), []int{8}
+func (x *OwnerContract) GetExpired() bool {
+	if x != nil {
+		return x.Expired
+	}
+	return false
 }
 
-func (x *QueryAllContractRequest) GetPagination() *v1beta1.PageRequest {
+func (x *OwnerContract) GetPending() bool {
 	if x != nil {
-		return x.Pagination
+		return x.Pending
 	}
-	return nil
+	return false
 }
 
-type QueryAllContractResponse struct {
+type QueryContractsByOwnerResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Contract   []*Contract           `protobuf:"bytes,1,rep,name=contract,proto3" json:"contract,omitempty"`
+	Contracts  []*OwnerContract      `protobuf:"bytes,1,rep,name=contracts,proto3" json:"contracts,omitempty"`
 	Pagination *v1beta1.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *QueryAllContractResponse) Reset() {
-	*x = QueryAllContractResponse{}
+func (x *QueryContractsByOwnerResponse) Reset() {
+	*x = QueryContractsByOwnerResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_arkeo_arkeo_query_proto_msgTypes[9]
+		mi := &file_arkeo_arkeo_query_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryAllContractResponse) String() string {
+func (x *QueryContractsByOwnerResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryAllContractResponse) ProtoMessage() {}
+func (*QueryContractsByOwnerResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryAllContractResponse.ProtoReflect.Descriptor instead.
-func (*QueryAllContractResponse) Descriptor() ([]byte, []int) {
-	return file_arkeo_arkeo_query_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use QueryContractsByOwnerResponse.ProtoReflect.Descriptor instead.
+func (*QueryContractsByOwnerResponse) Descriptor() ([]byte, []int) {
+	return file_arkeo_arkeo_query_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *QueryAllContractResponse) GetContract() []*Contract {
+func (x *QueryContractsByOwnerResponse) GetContracts() []*OwnerContract {
 	if x != nil {
-		return x.Contract
+		return x.Contracts
 	}
 	return nil
 }
 
-func (x *QueryAllContractResponse) GetPagination() *v1beta1.PageResponse {
+func (x *QueryContractsByOwnerResponse) GetPagination() *v1beta1.PageResponse {
 	if x != nil {
 		return x.Pagination
 	}
@@ -5958,7 +14857,7 @@ type QueryActiveContractRequest struct {
 func (x *QueryActiveContractRequest) Reset() {
 	*x = QueryActiveContractRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_arkeo_arkeo_query_proto_msgTypes[10]
+		mi := &file_arkeo_arkeo_query_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5972,7 +14871,7 @@ func (*QueryActiveContractRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryActiveContractRequest.ProtoReflect.Descriptor instead.
 func (*QueryActiveContractRequest) Descriptor() ([]byte, []int) {
-	return file_arkeo_arkeo_query_proto_rawDescGZIP(), []int{10}
+	return file_arkeo_arkeo_query_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *QueryActiveContractRequest) GetProvider() string {
@@ -6002,12 +14901,16 @@ type QueryActiveContractResponse struct {
 	unknownFields protoimpl.UnknownFields
 
 	Contract *Contract `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	// height the contract is settled at, at the latest, grace period included
+	SettlementPeriodEnd int64 `protobuf:"varint,2,opt,name=settlement_period_end,json=settlementPeriodEnd,proto3" json:"settlement_period_end,omitempty"`
+	// pending is true until the scheduled contract reaches its start height
+	Pending bool `protobuf:"varint,3,opt,name=pending,proto3" json:"pending,omitempty"`
 }
 
 func (x *QueryActiveContractResponse) Reset() {
 	*x = QueryActiveContractResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_arkeo_arkeo_query_proto_msgTypes[11]
+		mi := &file_arkeo_arkeo_query_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6021,7 +14924,7 @@ func (*QueryActiveContractResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryActiveContractResponse.ProtoReflect.Descriptor instead.
 func (*QueryActiveContractResponse) Descriptor() ([]byte, []int) {
-	return file_arkeo_arkeo_query_proto_rawDescGZIP(), []int{11}
+	return file_arkeo_arkeo_query_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *QueryActiveContractResponse) GetContract() *Contract {
@@ -6031,6 +14934,81 @@ func (x *QueryActiveContractResponse) GetContract() *Contract {
 	return nil
 }
 
+func (x *QueryActiveContractResponse) GetSettlementPeriodEnd() int64 {
+	if x != nil {
+		return x.SettlementPeriodEnd
+	}
+	return 0
+}
+
+func (x *QueryActiveContractResponse) GetPending() bool {
+	if x != nil {
+		return x.Pending
+	}
+	return false
+}
+
+type QueryNetworkStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *QueryNetworkStatsRequest) Reset() {
+	*x = QueryNetworkStatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_arkeo_arkeo_query_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryNetworkStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryNetworkStatsRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryNetworkStatsRequest.ProtoReflect.Descriptor instead.
+func (*QueryNetworkStatsRequest) Descriptor() ([]byte, []int) {
+	return file_arkeo_arkeo_query_proto_rawDescGZIP(), []int{25}
+}
+
+type QueryNetworkStatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Stats *NetworkStats `protobuf:"bytes,1,opt,name=stats,proto3" json:"stats,omitempty"`
+}
+
+func (x *QueryNetworkStatsResponse) Reset() {
+	*x = QueryNetworkStatsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_arkeo_arkeo_query_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryNetworkStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryNetworkStatsResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryNetworkStatsResponse.ProtoReflect.Descriptor instead.
+func (*QueryNetworkStatsResponse) Descriptor() ([]byte, []int) {
+	return file_arkeo_arkeo_query_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *QueryNetworkStatsResponse) GetStats() *NetworkStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
 var File_arkeo_arkeo_query_proto protoreflect.FileDescriptor
 
 var file_arkeo_arkeo_query_proto_rawDesc = []byte{
@@ -6042,81 +15020,234 @@ var file_arkeo_arkeo_query_proto_rawDesc = []byte{
 	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2a, 0x63, 0x6f, 0x73, 0x6d,
 	0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2f, 0x76, 0x31,
 	0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62,
+	0x61, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f, 0x69, 0x6e,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x18, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2f, 0x61, 0x72,
 	0x6b, 0x65, 0x6f, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x1a, 0x18, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2f, 0x6b, 0x65,
 	0x65, 0x70, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x14, 0x0a, 0x12, 0x51, 0x75,
 	0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x22, 0x48, 0x0a, 0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e,
-	0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x04, 0xc8, 0xde,
-	0x1f, 0x00, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0x4d, 0x0a, 0x19, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x46, 0x65, 0x74, 0x63, 0x68, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x6b, 0x65,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12,
-	0x18, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x22, 0x55, 0x0a, 0x1a, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x46, 0x65, 0x74, 0x63, 0x68, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69,
-	0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x72, 0x6b, 0x65,
-	0x6f, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
-	0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
-	0x22, 0x61, 0x0a, 0x17, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x6c, 0x6c, 0x50, 0x72, 0x6f, 0x76,
-	0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x46, 0x0a, 0x0a, 0x70,
+	0x22, 0xbd, 0x01, 0x0a, 0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f,
+	0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x04, 0xc8,
+	0xde, 0x1f, 0x00, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x6c,
+	0x61, 0x73, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x63, 0x6f, 0x6e,
+	0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x22, 0x4d, 0x0a, 0x19, 0x51, 0x75, 0x65, 0x72, 0x79, 0x46, 0x65, 0x74, 0x63, 0x68, 0x50, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70,
+	0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x22,
+	0x55, 0x0a, 0x1a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x46, 0x65, 0x74, 0x63, 0x68, 0x50, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a,
+	0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x50, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x08, 0x70, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x22, 0x50, 0x0a, 0x1c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x45, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x18,
+	0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x22, 0x60, 0x0a, 0x1d, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x45, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x08, 0x65, 0x61, 0x72,
+	0x6e, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x72,
+	0x6b, 0x65, 0x6f, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x45, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00,
+	0x52, 0x08, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x61, 0x0a, 0x17, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x41, 0x6c, 0x6c, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x9c, 0x01,
+	0x0a, 0x18, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x6c, 0x6c, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x08, 0x70, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61,
+	0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3c, 0x0a, 0x19,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x46, 0x65, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a,
+	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x49, 0x64, 0x22, 0xa3, 0x01, 0x0a, 0x1a, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x46, 0x65, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x08, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x61, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x72,
+	0x6b, 0x65, 0x6f, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x12, 0x32, 0x0a, 0x15, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x13, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x65, 0x72,
+	0x69, 0x6f, 0x64, 0x45, 0x6e, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x22, 0x5e, 0x0a, 0x25, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
+	0x74, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a,
+	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f,
+	0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65,
+	0x22, 0x6f, 0x0a, 0x26, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
+	0x74, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x0a, 0x73, 0x65,
+	0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f,
+	0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x43, 0x6f, 0x6e,
+	0x74, 0x72, 0x61, 0x63, 0x74, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x42,
+	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0a, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x22, 0x54, 0x0a, 0x1b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x61,
+	0x62, 0x6c, 0x65, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x49,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x22, 0xb6, 0x02, 0x0a, 0x1c, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x3d,
+	0x0a, 0x09, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde,
+	0x1f, 0x00, 0x52, 0x09, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x40, 0x0a,
+	0x0b, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x5f, 0x74, 0x61, 0x78, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8,
+	0xde, 0x1f, 0x00, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x54, 0x61, 0x78, 0x12,
+	0x4a, 0x0a, 0x10, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x73, 0x63,
+	0x72, 0x6f, 0x77, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0f, 0x72, 0x65, 0x6d, 0x61,
+	0x69, 0x6e, 0x69, 0x6e, 0x67, 0x45, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x12, 0x33, 0x0a, 0x04, 0x64,
+	0x75, 0x73, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x04, 0x64, 0x75, 0x73, 0x74,
+	0x22, 0x9e, 0x01, 0x0a, 0x15, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x66,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72,
+	0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0x9c, 0x01, 0x0a, 0x16, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x09,
+	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x50, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x09, 0x70, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0x61, 0x0a, 0x17, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x6c, 0x6c, 0x43, 0x6f, 0x6e, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x46, 0x0a, 0x0a, 0x70,
 	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
 	0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75,
 	0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65,
 	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
 	0x69, 0x6f, 0x6e, 0x22, 0x9c, 0x01, 0x0a, 0x18, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x6c, 0x6c,
-	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x37, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x03,
+	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x37, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x18, 0x01, 0x20, 0x03,
 	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f,
-	0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52,
-	0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67,
+	0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52,
+	0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67,
 	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e,
 	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72,
 	0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65,
 	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x22, 0x3c, 0x0a, 0x19, 0x51, 0x75, 0x65, 0x72, 0x79, 0x46, 0x65, 0x74, 0x63, 0x68,
-	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x49, 0x64,
-	0x22, 0x55, 0x0a, 0x1a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x46, 0x65, 0x74, 0x63, 0x68, 0x43, 0x6f,
-	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37,
-	0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x15, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x43,
-	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x08, 0x63,
-	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x22, 0x61, 0x0a, 0x17, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x41, 0x6c, 0x6c, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x6f, 0x6e, 0x22, 0xb5, 0x01, 0x0a, 0x1f, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x73, 0x42, 0x79, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
 	0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
 	0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a,
-	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x9c, 0x01, 0x0a, 0x18, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x41, 0x6c, 0x6c, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72,
-	0x61, 0x63, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x72, 0x6b, 0x65,
-	0x6f, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
-	0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
-	0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61,
-	0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
-	0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70,
-	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x6c, 0x0a, 0x1a, 0x51, 0x75, 0x65,
+	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xa4, 0x01, 0x0a, 0x20, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x73, 0x42, 0x79, 0x50,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x37, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e,
+	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x08,
+	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0xa9, 0x01, 0x0a, 0x1c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x72,
+	0x61, 0x63, 0x74, 0x73, 0x42, 0x79, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x29, 0x0a, 0x10, 0x69, 0x6e,
+	0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x44, 0x65, 0x6c,
+	0x65, 0x67, 0x61, 0x74, 0x65, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xdf, 0x01,
+	0x0a, 0x0d, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12,
+	0x37, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e,
+	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x08,
+	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x15, 0x73, 0x65, 0x74, 0x74,
+	0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x65, 0x6e,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x45, 0x6e, 0x64, 0x12, 0x18, 0x0a, 0x07,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x4a, 0x04, 0x08, 0x05, 0x10, 0x06, 0x52, 0x07, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x64, 0x22,
+	0xa8, 0x01, 0x0a, 0x1d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
+	0x74, 0x73, 0x42, 0x79, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x3e, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x61, 0x72, 0x6b,
+	0x65, 0x6f, 0x2e, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
+	0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
+	0x73, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62,
+	0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a,
+	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x6c, 0x0a, 0x1a, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x22, 0xa4, 0x01, 0x0a, 0x1b, 0x51, 0x75, 0x65,
 	0x72, 0x79, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69,
-	0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69,
-	0x64, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x18, 0x0a,
-	0x07, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x73, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x22, 0x56, 0x0a, 0x1b, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61,
-	0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f,
-	0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x42,
-	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x32,
-	0x95, 0x06, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x62, 0x0a, 0x06, 0x50, 0x61, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x72, 0x6b,
+	0x65, 0x6f, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
+	0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
+	0x74, 0x12, 0x32, 0x0a, 0x15, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f,
+	0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x13, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x65, 0x72, 0x69,
+	0x6f, 0x64, 0x45, 0x6e, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x22,
+	0x1a, 0x0a, 0x18, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x52, 0x0a, 0x19, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e,
+	0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x32,
+	0xca, 0x0e, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x62, 0x0a, 0x06, 0x50, 0x61, 0x72,
 	0x61, 0x6d, 0x73, 0x12, 0x1f, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x61, 0x72, 0x6b, 0x65,
 	0x6f, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71,
 	0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x61, 0x72, 0x6b,
@@ -6131,50 +15262,117 @@ var file_arkeo_arkeo_query_proto_rawDesc = []byte{
 	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
 	0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x12, 0x22, 0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f,
 	0x2f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2f, 0x7b, 0x70, 0x75, 0x62, 0x6b, 0x65,
-	0x79, 0x7d, 0x2f, 0x7b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x7d, 0x12, 0x74, 0x0a, 0x0b,
-	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x41, 0x6c, 0x6c, 0x12, 0x24, 0x2e, 0x61, 0x72,
-	0x6b, 0x65, 0x6f, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41,
-	0x6c, 0x6c, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x25, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x6c, 0x6c, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x18, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12,
-	0x12, 0x10, 0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
-	0x72, 0x73, 0x12, 0x87, 0x01, 0x0a, 0x0d, 0x46, 0x65, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x74,
-	0x72, 0x61, 0x63, 0x74, 0x12, 0x26, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x61, 0x72, 0x6b,
-	0x65, 0x6f, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x46, 0x65, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6e,
-	0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x61,
+	0x79, 0x7d, 0x2f, 0x7b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x7d, 0x12, 0x9e, 0x01, 0x0a,
+	0x10, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x45, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67,
+	0x73, 0x12, 0x29, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x45, 0x61, 0x72,
+	0x6e, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x61,
+	0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x45, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x33, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2d,
+	0x12, 0x2b, 0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x2f, 0x7b, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x7d, 0x2f, 0x7b, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x7d, 0x2f, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x74, 0x0a,
+	0x0b, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x41, 0x6c, 0x6c, 0x12, 0x24, 0x2e, 0x61,
+	0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x41, 0x6c, 0x6c, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x25, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x6c, 0x6c, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x18, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x12, 0x12, 0x10, 0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x73, 0x12, 0x78, 0x0a, 0x09, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73,
+	0x12, 0x22, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x61, 0x72, 0x6b,
+	0x65, 0x6f, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x1c, 0x12, 0x1a, 0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x73, 0x2f, 0x7b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x7d, 0x12, 0x87, 0x01,
+	0x0a, 0x0d, 0x46, 0x65, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12,
+	0x26, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x46, 0x65, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e,
+	0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x46, 0x65, 0x74, 0x63, 0x68,
+	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12, 0x1d, 0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f,
+	0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x2f, 0x7b, 0x63, 0x6f, 0x6e, 0x74, 0x72,
+	0x61, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0xbe, 0x01, 0x0a, 0x19, 0x43, 0x6f, 0x6e, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x72,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x32, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x61, 0x72,
+	0x6b, 0x65, 0x6f, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
+	0x74, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x61, 0x72, 0x6b, 0x65,
+	0x6f, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6e,
+	0x74, 0x72, 0x61, 0x63, 0x74, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x50,
+	0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x38,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x32, 0x12, 0x30, 0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2f, 0x63,
+	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x2f, 0x7b, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
+	0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x2d, 0x70, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x97, 0x01, 0x0a, 0x0f, 0x43, 0x6c, 0x61,
+	0x69, 0x6d, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x65, 0x12, 0x28, 0x2e, 0x61,
 	0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x46, 0x65, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12, 0x1d, 0x2f,
-	0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x2f, 0x7b,
-	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x74, 0x0a, 0x0b,
-	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x6c, 0x6c, 0x12, 0x24, 0x2e, 0x61, 0x72,
-	0x6b, 0x65, 0x6f, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41,
-	0x6c, 0x6c, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x25, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e,
+	0x43, 0x6c, 0x61, 0x69, 0x6d, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x61,
+	0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x61,
+	0x62, 0x6c, 0x65, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x2f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x29, 0x12, 0x27, 0x2f, 0x61, 0x72, 0x6b, 0x65,
+	0x6f, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x2f, 0x7b, 0x63, 0x6f, 0x6e, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x61, 0x62,
+	0x6c, 0x65, 0x12, 0x74, 0x0a, 0x0b, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x6c,
+	0x6c, 0x12, 0x24, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e,
 	0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x6c, 0x6c, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x18, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12,
-	0x12, 0x10, 0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
-	0x74, 0x73, 0x12, 0xa2, 0x01, 0x0a, 0x0e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x43, 0x6f, 0x6e,
-	0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x27, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x61, 0x72,
-	0x6b, 0x65, 0x6f, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x43,
-	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e,
+	0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x6c, 0x6c, 0x43, 0x6f,
+	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x18,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x12, 0x10, 0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2f, 0x63,
+	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x73, 0x12, 0xaa, 0x01, 0x0a, 0x13, 0x43, 0x6f, 0x6e,
+	0x74, 0x72, 0x61, 0x63, 0x74, 0x73, 0x42, 0x79, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x12, 0x2c, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x73, 0x42, 0x79, 0x50,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d,
 	0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x37,
-	0x12, 0x35, 0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x2d,
-	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
-	0x65, 0x72, 0x7d, 0x2f, 0x7b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x7d, 0x2f, 0x7b, 0x73,
-	0x70, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x7d, 0x42, 0x88, 0x01, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x2e,
-	0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x42, 0x0a, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x1c, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x72, 0x6b, 0x65,
-	0x6f, 0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0xa2, 0x02, 0x03, 0x41, 0x41, 0x58, 0xaa, 0x02, 0x0b,
-	0x41, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x41, 0x72, 0x6b, 0x65, 0x6f, 0xca, 0x02, 0x0b, 0x41, 0x72,
-	0x6b, 0x65, 0x6f, 0x5c, 0x41, 0x72, 0x6b, 0x65, 0x6f, 0xe2, 0x02, 0x17, 0x41, 0x72, 0x6b, 0x65,
-	0x6f, 0x5c, 0x41, 0x72, 0x6b, 0x65, 0x6f, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0xea, 0x02, 0x0c, 0x41, 0x72, 0x6b, 0x65, 0x6f, 0x3a, 0x3a, 0x41, 0x72, 0x6b,
-	0x65, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x72, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x73, 0x42, 0x79, 0x50, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x36, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x30, 0x12, 0x2e, 0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2f, 0x63, 0x6f,
+	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x73, 0x2f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x7d, 0x2f, 0x7b, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x7d, 0x12, 0x92, 0x01, 0x0a, 0x10, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x73, 0x42, 0x79, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x29, 0x2e, 0x61, 0x72, 0x6b,
+	0x65, 0x6f, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f,
+	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x73, 0x42, 0x79, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x61, 0x72,
+	0x6b, 0x65, 0x6f, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
+	0x74, 0x73, 0x42, 0x79, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x12, 0x1f, 0x2f, 0x61, 0x72, 0x6b, 0x65,
+	0x6f, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x73, 0x2f, 0x6f, 0x77, 0x6e, 0x65,
+	0x72, 0x2f, 0x7b, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x7d, 0x12, 0xa2, 0x01, 0x0a, 0x0e, 0x41,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x27, 0x2e,
+	0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x61,
+	0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x3d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x37, 0x12, 0x35, 0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f,
+	0x2f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x2d, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
+	0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x7d, 0x2f, 0x7b, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x7d, 0x2f, 0x7b, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x7d, 0x12,
+	0x7b, 0x0a, 0x0c, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12,
+	0x25, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x61,
+	0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1c,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x12, 0x14, 0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2f, 0x6e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2d, 0x73, 0x74, 0x61, 0x74, 0x73, 0x42, 0x88, 0x01, 0x0a,
+	0x0f, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f,
+	0x42, 0x0a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x1c,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0xa2, 0x02, 0x03, 0x41,
+	0x41, 0x58, 0xaa, 0x02, 0x0b, 0x41, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x41, 0x72, 0x6b, 0x65, 0x6f,
+	0xca, 0x02, 0x0b, 0x41, 0x72, 0x6b, 0x65, 0x6f, 0x5c, 0x41, 0x72, 0x6b, 0x65, 0x6f, 0xe2, 0x02,
+	0x17, 0x41, 0x72, 0x6b, 0x65, 0x6f, 0x5c, 0x41, 0x72, 0x6b, 0x65, 0x6f, 0x5c, 0x47, 0x50, 0x42,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0c, 0x41, 0x72, 0x6b, 0x65, 0x6f,
+	0x3a, 0x3a, 0x41, 0x72, 0x6b, 0x65, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -6189,54 +15387,104 @@ func file_arkeo_arkeo_query_proto_rawDescGZIP() []byte {
 	return file_arkeo_arkeo_query_proto_rawDescData
 }
 
-var file_arkeo_arkeo_query_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_arkeo_arkeo_query_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
 var file_arkeo_arkeo_query_proto_goTypes = []interface{}{
-	(*QueryParamsRequest)(nil),          // 0: arkeo.arkeo.QueryParamsRequest
-	(*QueryParamsResponse)(nil),         // 1: arkeo.arkeo.QueryParamsResponse
-	(*QueryFetchProviderRequest)(nil),   // 2: arkeo.arkeo.QueryFetchProviderRequest
-	(*QueryFetchProviderResponse)(nil),  // 3: arkeo.arkeo.QueryFetchProviderResponse
-	(*QueryAllProviderRequest)(nil),     // 4: arkeo.arkeo.QueryAllProviderRequest
-	(*QueryAllProviderResponse)(nil),    // 5: arkeo.arkeo.QueryAllProviderResponse
-	(*QueryFetchContractRequest)(nil),   // 6: arkeo.arkeo.QueryFetchContractRequest
-	(*QueryFetchContractResponse)(nil),  // 7: arkeo.arkeo.QueryFetchContractResponse
-	(*QueryAllContractRequest)(nil),     // 8: arkeo.arkeo.QueryAllContractRequest
-	(*QueryAllContractResponse)(nil),    // 9: arkeo.arkeo.QueryAllContractResponse
-	(*QueryActiveContractRequest)(nil),  // 10: arkeo.arkeo.QueryActiveContractRequest
-	(*QueryActiveContractResponse)(nil), // 11: arkeo.arkeo.QueryActiveContractResponse
-	(*Params)(nil),                      // 12: arkeo.arkeo.Params
-	(*Provider)(nil),                    // 13: arkeo.arkeo.Provider
-	(*v1beta1.PageRequest)(nil),         // 14: cosmos.base.query.v1beta1.PageRequest
-	(*v1beta1.PageResponse)(nil),        // 15: cosmos.base.query.v1beta1.PageResponse
-	(*Contract)(nil),                    // 16: arkeo.arkeo.Contract
+	(*QueryParamsRequest)(nil),                     // 0: arkeo.arkeo.QueryParamsRequest
+	(*QueryParamsResponse)(nil),                    // 1: arkeo.arkeo.QueryParamsResponse
+	(*QueryFetchProviderRequest)(nil),              // 2: arkeo.arkeo.QueryFetchProviderRequest
+	(*QueryFetchProviderResponse)(nil),             // 3: arkeo.arkeo.QueryFetchProviderResponse
+	(*QueryProviderEarningsRequest)(nil),           // 4: arkeo.arkeo.QueryProviderEarningsRequest
+	(*QueryProviderEarningsResponse)(nil),          // 5: arkeo.arkeo.QueryProviderEarningsResponse
+	(*QueryAllProviderRequest)(nil),                // 6: arkeo.arkeo.QueryAllProviderRequest
+	(*QueryAllProviderResponse)(nil),               // 7: arkeo.arkeo.QueryAllProviderResponse
+	(*QueryFetchContractRequest)(nil),              // 8: arkeo.arkeo.QueryFetchContractRequest
+	(*QueryFetchContractResponse)(nil),             // 9: arkeo.arkeo.QueryFetchContractResponse
+	(*QueryContractSettlementPreviewRequest)(nil),  // 10: arkeo.arkeo.QueryContractSettlementPreviewRequest
+	(*QueryContractSettlementPreviewResponse)(nil), // 11: arkeo.arkeo.QueryContractSettlementPreviewResponse
+	(*QueryClaimableIncomeRequest)(nil),            // 12: arkeo.arkeo.QueryClaimableIncomeRequest
+	(*QueryClaimableIncomeResponse)(nil),           // 13: arkeo.arkeo.QueryClaimableIncomeResponse
+	(*QueryProvidersRequest)(nil),                  // 14: arkeo.arkeo.QueryProvidersRequest
+	(*QueryProvidersResponse)(nil),                 // 15: arkeo.arkeo.QueryProvidersResponse
+	(*QueryAllContractRequest)(nil),                // 16: arkeo.arkeo.QueryAllContractRequest
+	(*QueryAllContractResponse)(nil),               // 17: arkeo.arkeo.QueryAllContractResponse
+	(*QueryContractsByProviderRequest)(nil),        // 18: arkeo.arkeo.QueryContractsByProviderRequest
+	(*QueryContractsByProviderResponse)(nil),       // 19: arkeo.arkeo.QueryContractsByProviderResponse
+	(*QueryContractsByOwnerRequest)(nil),           // 20: arkeo.arkeo.QueryContractsByOwnerRequest
+	(*OwnerContract)(nil),                          // 21: arkeo.arkeo.OwnerContract
+	(*QueryContractsByOwnerResponse)(nil),          // 22: arkeo.arkeo.QueryContractsByOwnerResponse
+	(*QueryActiveContractRequest)(nil),             // 23: arkeo.arkeo.QueryActiveContractRequest
+	(*QueryActiveContractResponse)(nil),            // 24: arkeo.arkeo.QueryActiveContractResponse
+	(*QueryNetworkStatsRequest)(nil),               // 25: arkeo.arkeo.QueryNetworkStatsRequest
+	(*QueryNetworkStatsResponse)(nil),              // 26: arkeo.arkeo.QueryNetworkStatsResponse
+	(*Params)(nil),                                 // 27: arkeo.arkeo.Params
+	(*Provider)(nil),                               // 28: arkeo.arkeo.Provider
+	(*ProviderEarnings)(nil),                       // 29: arkeo.arkeo.ProviderEarnings
+	(*v1beta1.PageRequest)(nil),                    // 30: cosmos.base.query.v1beta1.PageRequest
+	(*v1beta1.PageResponse)(nil),                   // 31: cosmos.base.query.v1beta1.PageResponse
+	(*Contract)(nil),                               // 32: arkeo.arkeo.Contract
+	(*ContractSettlement)(nil),                     // 33: arkeo.arkeo.ContractSettlement
+	(*v1beta11.Coin)(nil),                          // 34: cosmos.base.v1beta1.Coin
+	(*NetworkStats)(nil),                           // 35: arkeo.arkeo.NetworkStats
 }
 var file_arkeo_arkeo_query_proto_depIdxs = []int32{
-	12, // 0: arkeo.arkeo.QueryParamsResponse.params:type_name -> arkeo.arkeo.Params
-	13, // 1: arkeo.arkeo.QueryFetchProviderResponse.provider:type_name -> arkeo.arkeo.Provider
-	14, // 2: arkeo.arkeo.QueryAllProviderRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
-	13, // 3: arkeo.arkeo.QueryAllProviderResponse.provider:type_name -> arkeo.arkeo.Provider
-	15, // 4: arkeo.arkeo.QueryAllProviderResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
-	16, // 5: arkeo.arkeo.QueryFetchContractResponse.contract:type_name -> arkeo.arkeo.Contract
-	14, // 6: arkeo.arkeo.QueryAllContractRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
-	16, // 7: arkeo.arkeo.QueryAllContractResponse.contract:type_name -> arkeo.arkeo.Contract
-	15, // 8: arkeo.arkeo.QueryAllContractResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
-	16, // 9: arkeo.arkeo.QueryActiveContractResponse.contract:type_name -> arkeo.arkeo.Contract
-	0,  // 10: arkeo.arkeo.Query.Params:input_type -> arkeo.arkeo.QueryParamsRequest
-	2,  // 11: arkeo.arkeo.Query.FetchProvider:input_type -> arkeo.arkeo.QueryFetchProviderRequest
-	4,  // 12: arkeo.arkeo.Query.ProviderAll:input_type -> arkeo.arkeo.QueryAllProviderRequest
-	6,  // 13: arkeo.arkeo.Query.FetchContract:input_type -> arkeo.arkeo.QueryFetchContractRequest
-	8,  // 14: arkeo.arkeo.Query.ContractAll:input_type -> arkeo.arkeo.QueryAllContractRequest
-	10, // 15: arkeo.arkeo.Query.ActiveContract:input_type -> arkeo.arkeo.QueryActiveContractRequest
-	1,  // 16: arkeo.arkeo.Query.Params:output_type -> arkeo.arkeo.QueryParamsResponse
-	3,  // 17: arkeo.arkeo.Query.FetchProvider:output_type -> arkeo.arkeo.QueryFetchProviderResponse
-	5,  // 18: arkeo.arkeo.Query.ProviderAll:output_type -> arkeo.arkeo.QueryAllProviderResponse
-	7,  // 19: arkeo.arkeo.Query.FetchContract:output_type -> arkeo.arkeo.QueryFetchContractResponse
-	9,  // 20: arkeo.arkeo.Query.ContractAll:output_type -> arkeo.arkeo.QueryAllContractResponse
-	11, // 21: arkeo.arkeo.Query.ActiveContract:output_type -> arkeo.arkeo.QueryActiveContractResponse
-	16, // [16:22] is the sub-list for method output_type
-	10, // [10:16] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	27, // 0: arkeo.arkeo.QueryParamsResponse.params:type_name -> arkeo.arkeo.Params
+	28, // 1: arkeo.arkeo.QueryFetchProviderResponse.provider:type_name -> arkeo.arkeo.Provider
+	29, // 2: arkeo.arkeo.QueryProviderEarningsResponse.earnings:type_name -> arkeo.arkeo.ProviderEarnings
+	30, // 3: arkeo.arkeo.QueryAllProviderRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	28, // 4: arkeo.arkeo.QueryAllProviderResponse.provider:type_name -> arkeo.arkeo.Provider
+	31, // 5: arkeo.arkeo.QueryAllProviderResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	32, // 6: arkeo.arkeo.QueryFetchContractResponse.contract:type_name -> arkeo.arkeo.Contract
+	33, // 7: arkeo.arkeo.QueryContractSettlementPreviewResponse.settlement:type_name -> arkeo.arkeo.ContractSettlement
+	34, // 8: arkeo.arkeo.QueryClaimableIncomeResponse.claimable:type_name -> cosmos.base.v1beta1.Coin
+	34, // 9: arkeo.arkeo.QueryClaimableIncomeResponse.reserve_tax:type_name -> cosmos.base.v1beta1.Coin
+	34, // 10: arkeo.arkeo.QueryClaimableIncomeResponse.remaining_escrow:type_name -> cosmos.base.v1beta1.Coin
+	34, // 11: arkeo.arkeo.QueryClaimableIncomeResponse.dust:type_name -> cosmos.base.v1beta1.Coin
+	30, // 12: arkeo.arkeo.QueryProvidersRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	28, // 13: arkeo.arkeo.QueryProvidersResponse.providers:type_name -> arkeo.arkeo.Provider
+	31, // 14: arkeo.arkeo.QueryProvidersResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	30, // 15: arkeo.arkeo.QueryAllContractRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	32, // 16: arkeo.arkeo.QueryAllContractResponse.contract:type_name -> arkeo.arkeo.Contract
+	31, // 17: arkeo.arkeo.QueryAllContractResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	30, // 18: arkeo.arkeo.QueryContractsByProviderRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	32, // 19: arkeo.arkeo.QueryContractsByProviderResponse.contract:type_name -> arkeo.arkeo.Contract
+	31, // 20: arkeo.arkeo.QueryContractsByProviderResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	30, // 21: arkeo.arkeo.QueryContractsByOwnerRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	32, // 22: arkeo.arkeo.OwnerContract.contract:type_name -> arkeo.arkeo.Contract
+	21, // 23: arkeo.arkeo.QueryContractsByOwnerResponse.contracts:type_name -> arkeo.arkeo.OwnerContract
+	31, // 24: arkeo.arkeo.QueryContractsByOwnerResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	32, // 25: arkeo.arkeo.QueryActiveContractResponse.contract:type_name -> arkeo.arkeo.Contract
+	35, // 26: arkeo.arkeo.QueryNetworkStatsResponse.stats:type_name -> arkeo.arkeo.NetworkStats
+	0,  // 27: arkeo.arkeo.Query.Params:input_type -> arkeo.arkeo.QueryParamsRequest
+	2,  // 28: arkeo.arkeo.Query.FetchProvider:input_type -> arkeo.arkeo.QueryFetchProviderRequest
+	4,  // 29: arkeo.arkeo.Query.ProviderEarnings:input_type -> arkeo.arkeo.QueryProviderEarningsRequest
+	6,  // 30: arkeo.arkeo.Query.ProviderAll:input_type -> arkeo.arkeo.QueryAllProviderRequest
+	14, // 31: arkeo.arkeo.Query.Providers:input_type -> arkeo.arkeo.QueryProvidersRequest
+	8,  // 32: arkeo.arkeo.Query.FetchContract:input_type -> arkeo.arkeo.QueryFetchContractRequest
+	10, // 33: arkeo.arkeo.Query.ContractSettlementPreview:input_type -> arkeo.arkeo.QueryContractSettlementPreviewRequest
+	12, // 34: arkeo.arkeo.Query.ClaimableIncome:input_type -> arkeo.arkeo.QueryClaimableIncomeRequest
+	16, // 35: arkeo.arkeo.Query.ContractAll:input_type -> arkeo.arkeo.QueryAllContractRequest
+	18, // 36: arkeo.arkeo.Query.ContractsByProvider:input_type -> arkeo.arkeo.QueryContractsByProviderRequest
+	20, // 37: arkeo.arkeo.Query.ContractsByOwner:input_type -> arkeo.arkeo.QueryContractsByOwnerRequest
+	23, // 38: arkeo.arkeo.Query.ActiveContract:input_type -> arkeo.arkeo.QueryActiveContractRequest
+	25, // 39: arkeo.arkeo.Query.NetworkStats:input_type -> arkeo.arkeo.QueryNetworkStatsRequest
+	1,  // 40: arkeo.arkeo.Query.Params:output_type -> arkeo.arkeo.QueryParamsResponse
+	3,  // 41: arkeo.arkeo.Query.FetchProvider:output_type -> arkeo.arkeo.QueryFetchProviderResponse
+	5,  // 42: arkeo.arkeo.Query.ProviderEarnings:output_type -> arkeo.arkeo.QueryProviderEarningsResponse
+	7,  // 43: arkeo.arkeo.Query.ProviderAll:output_type -> arkeo.arkeo.QueryAllProviderResponse
+	15, // 44: arkeo.arkeo.Query.Providers:output_type -> arkeo.arkeo.QueryProvidersResponse
+	9,  // 45: arkeo.arkeo.Query.FetchContract:output_type -> arkeo.arkeo.QueryFetchContractResponse
+	11, // 46: arkeo.arkeo.Query.ContractSettlementPreview:output_type -> arkeo.arkeo.QueryContractSettlementPreviewResponse
+	13, // 47: arkeo.arkeo.Query.ClaimableIncome:output_type -> arkeo.arkeo.QueryClaimableIncomeResponse
+	17, // 48: arkeo.arkeo.Query.ContractAll:output_type -> arkeo.arkeo.QueryAllContractResponse
+	19, // 49: arkeo.arkeo.Query.ContractsByProvider:output_type -> arkeo.arkeo.QueryContractsByProviderResponse
+	22, // 50: arkeo.arkeo.Query.ContractsByOwner:output_type -> arkeo.arkeo.QueryContractsByOwnerResponse
+	24, // 51: arkeo.arkeo.Query.ActiveContract:output_type -> arkeo.arkeo.QueryActiveContractResponse
+	26, // 52: arkeo.arkeo.Query.NetworkStats:output_type -> arkeo.arkeo.QueryNetworkStatsResponse
+	40, // [40:53] is the sub-list for method output_type
+	27, // [27:40] is the sub-list for method input_type
+	27, // [27:27] is the sub-list for extension type_name
+	27, // [27:27] is the sub-list for extension extendee
+	0,  // [0:27] is the sub-list for field type_name
 }
 
 func init() { file_arkeo_arkeo_query_proto_init() }
@@ -6296,7 +15544,7 @@ func file_arkeo_arkeo_query_proto_init() {
 			}
 		}
 		file_arkeo_arkeo_query_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryAllProviderRequest); i {
+			switch v := v.(*QueryProviderEarningsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6308,7 +15556,7 @@ func file_arkeo_arkeo_query_proto_init() {
 			}
 		}
 		file_arkeo_arkeo_query_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryAllProviderResponse); i {
+			switch v := v.(*QueryProviderEarningsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6320,7 +15568,7 @@ func file_arkeo_arkeo_query_proto_init() {
 			}
 		}
 		file_arkeo_arkeo_query_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryFetchContractRequest); i {
+			switch v := v.(*QueryAllProviderRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6332,7 +15580,7 @@ func file_arkeo_arkeo_query_proto_init() {
 			}
 		}
 		file_arkeo_arkeo_query_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryFetchContractResponse); i {
+			switch v := v.(*QueryAllProviderResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6344,7 +15592,7 @@ func file_arkeo_arkeo_query_proto_init() {
 			}
 		}
 		file_arkeo_arkeo_query_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryAllContractRequest); i {
+			switch v := v.(*QueryFetchContractRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6356,7 +15604,7 @@ func file_arkeo_arkeo_query_proto_init() {
 			}
 		}
 		file_arkeo_arkeo_query_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryAllContractResponse); i {
+			switch v := v.(*QueryFetchContractResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6368,7 +15616,7 @@ func file_arkeo_arkeo_query_proto_init() {
 			}
 		}
 		file_arkeo_arkeo_query_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryActiveContractRequest); i {
+			switch v := v.(*QueryContractSettlementPreviewRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6380,6 +15628,162 @@ func file_arkeo_arkeo_query_proto_init() {
 			}
 		}
 		file_arkeo_arkeo_query_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryContractSettlementPreviewResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_arkeo_arkeo_query_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryClaimableIncomeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_arkeo_arkeo_query_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryClaimableIncomeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_arkeo_arkeo_query_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryProvidersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_arkeo_arkeo_query_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryProvidersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_arkeo_arkeo_query_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryAllContractRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_arkeo_arkeo_query_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryAllContractResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_arkeo_arkeo_query_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryContractsByProviderRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_arkeo_arkeo_query_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryContractsByProviderResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_arkeo_arkeo_query_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryContractsByOwnerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_arkeo_arkeo_query_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OwnerContract); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_arkeo_arkeo_query_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryContractsByOwnerResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_arkeo_arkeo_query_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryActiveContractRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_arkeo_arkeo_query_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*QueryActiveContractResponse); i {
 			case 0:
 				return &v.state
@@ -6391,6 +15795,30 @@ func file_arkeo_arkeo_query_proto_init() {
 				return nil
 			}
 		}
+		file_arkeo_arkeo_query_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryNetworkStatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_arkeo_arkeo_query_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryNetworkStatsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -6398,7 +15826,7 @@ func file_arkeo_arkeo_query_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_arkeo_arkeo_query_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   12,
+			NumMessages:   27,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -21,11 +21,28 @@ type QueryClient interface {
 	// Parameters queries the parameters of the module.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	FetchProvider(ctx context.Context, in *QueryFetchProviderRequest, opts ...grpc.CallOption) (*QueryFetchProviderResponse, error)
+	// Queries the earnings of a provider for a service.
+	ProviderEarnings(ctx context.Context, in *QueryProviderEarningsRequest, opts ...grpc.CallOption) (*QueryProviderEarningsResponse, error)
 	ProviderAll(ctx context.Context, in *QueryAllProviderRequest, opts ...grpc.CallOption) (*QueryAllProviderResponse, error)
+	// Queries the providers of a service, of a status when asked to.
+	Providers(ctx context.Context, in *QueryProvidersRequest, opts ...grpc.CallOption) (*QueryProvidersResponse, error)
 	FetchContract(ctx context.Context, in *QueryFetchContractRequest, opts ...grpc.CallOption) (*QueryFetchContractResponse, error)
+	// Previews how a contract settles at the current height, the claim at the
+	// nonce or without a nonce the settlement closing the contract.
+	ContractSettlementPreview(ctx context.Context, in *QueryContractSettlementPreviewRequest, opts ...grpc.CallOption) (*QueryContractSettlementPreviewResponse, error)
+	// Queries what the claim of the provider at the nonce pays it at the
+	// current height.
+	ClaimableIncome(ctx context.Context, in *QueryClaimableIncomeRequest, opts ...grpc.CallOption) (*QueryClaimableIncomeResponse, error)
 	ContractAll(ctx context.Context, in *QueryAllContractRequest, opts ...grpc.CallOption) (*QueryAllContractResponse, error)
+	// Queries the contracts of a provider for a service.
+	ContractsByProvider(ctx context.Context, in *QueryContractsByProviderRequest, opts ...grpc.CallOption) (*QueryContractsByProviderResponse, error)
+	// Queries the contracts of a client, and of a delegate when asked to, that
+	// are not settled yet.
+	ContractsByOwner(ctx context.Context, in *QueryContractsByOwnerRequest, opts ...grpc.CallOption) (*QueryContractsByOwnerResponse, error)
 	// Queries an active contract by spender, provider and service.
 	ActiveContract(ctx context.Context, in *QueryActiveContractRequest, opts ...grpc.CallOption) (*QueryActiveContractResponse, error)
+	// Queries the counters of the providers and contracts of the network.
+	NetworkStats(ctx context.Context, in *QueryNetworkStatsRequest, opts ...grpc.CallOption) (*QueryNetworkStatsResponse, error)
 }
 
 type queryClient struct {
@@ -54,6 +71,15 @@ func (c *queryClient) FetchProvider(ctx context.Context, in *QueryFetchProviderR
 	return out, nil
 }
 
+func (c *queryClient) ProviderEarnings(ctx context.Context, in *QueryProviderEarningsRequest, opts ...grpc.CallOption) (*QueryProviderEarningsResponse, error) {
+	out := new(QueryProviderEarningsResponse)
+	err := c.cc.Invoke(ctx, "/arkeo.arkeo.Query/ProviderEarnings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) ProviderAll(ctx context.Context, in *QueryAllProviderRequest, opts ...grpc.CallOption) (*QueryAllProviderResponse, error) {
 	out := new(QueryAllProviderResponse)
 	err := c.cc.Invoke(ctx, "/arkeo.arkeo.Query/ProviderAll", in, out, opts...)
@@ -63,6 +89,15 @@ func (c *queryClient) ProviderAll(ctx context.Context, in *QueryAllProviderReque
 	return out, nil
 }
 
+func (c *queryClient) Providers(ctx context.Context, in *QueryProvidersRequest, opts ...grpc.CallOption) (*QueryProvidersResponse, error) {
+	out := new(QueryProvidersResponse)
+	err := c.cc.Invoke(ctx, "/arkeo.arkeo.Query/Providers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) FetchContract(ctx context.Context, in *QueryFetchContractRequest, opts ...grpc.CallOption) (*QueryFetchContractResponse, error) {
 	out := new(QueryFetchContractResponse)
 	err := c.cc.Invoke(ctx, "/arkeo.arkeo.Query/FetchContract", in, out, opts...)
@@ -72,6 +107,24 @@ func (c *queryClient) FetchContract(ctx context.Context, in *QueryFetchContractR
 	return out, nil
 }
 
+func (c *queryClient) ContractSettlementPreview(ctx context.Context, in *QueryContractSettlementPreviewRequest, opts ...grpc.CallOption) (*QueryContractSettlementPreviewResponse, error) {
+	out := new(QueryContractSettlementPreviewResponse)
+	err := c.cc.Invoke(ctx, "/arkeo.arkeo.Query/ContractSettlementPreview", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ClaimableIncome(ctx context.Context, in *QueryClaimableIncomeRequest, opts ...grpc.CallOption) (*QueryClaimableIncomeResponse, error) {
+	out := new(QueryClaimableIncomeResponse)
+	err := c.cc.Invoke(ctx, "/arkeo.arkeo.Query/ClaimableIncome", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) ContractAll(ctx context.Context, in *QueryAllContractRequest, opts ...grpc.CallOption) (*QueryAllContractResponse, error) {
 	out := new(QueryAllContractResponse)
 	err := c.cc.Invoke(ctx, "/arkeo.arkeo.Query/ContractAll", in, out, opts...)
@@ -81,6 +134,24 @@ func (c *queryClient) ContractAll(ctx context.Context, in *QueryAllContractReque
 	return out, nil
 }
 
+func (c *queryClient) ContractsByProvider(ctx context.Context, in *QueryContractsByProviderRequest, opts ...grpc.CallOption) (*QueryContractsByProviderResponse, error) {
+	out := new(QueryContractsByProviderResponse)
+	err := c.cc.Invoke(ctx, "/arkeo.arkeo.Query/ContractsByProvider", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ContractsByOwner(ctx context.Context, in *QueryContractsByOwnerRequest, opts ...grpc.CallOption) (*QueryContractsByOwnerResponse, error) {
+	out := new(QueryContractsByOwnerResponse)
+	err := c.cc.Invoke(ctx, "/arkeo.arkeo.Query/ContractsByOwner", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) ActiveContract(ctx context.Context, in *QueryActiveContractRequest, opts ...grpc.CallOption) (*QueryActiveContractResponse, error) {
 	out := new(QueryActiveContractResponse)
 	err := c.cc.Invoke(ctx, "/arkeo.arkeo.Query/ActiveContract", in, out, opts...)
@@ -90,6 +161,15 @@ func (c *queryClient) ActiveContract(ctx context.Context, in *QueryActiveContrac
 	return out, nil
 }
 
+func (c *queryClient) NetworkStats(ctx context.Context, in *QueryNetworkStatsRequest, opts ...grpc.CallOption) (*QueryNetworkStatsResponse, error) {
+	out := new(QueryNetworkStatsResponse)
+	err := c.cc.Invoke(ctx, "/arkeo.arkeo.Query/NetworkStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -97,11 +177,28 @@ type QueryServer interface {
 	// Parameters queries the parameters of the module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	FetchProvider(context.Context, *QueryFetchProviderRequest) (*QueryFetchProviderResponse, error)
+	// Queries the earnings of a provider for a service.
+	ProviderEarnings(context.Context, *QueryProviderEarningsRequest) (*QueryProviderEarningsResponse, error)
 	ProviderAll(context.Context, *QueryAllProviderRequest) (*QueryAllProviderResponse, error)
+	// Queries the providers of a service, of a status when asked to.
+	Providers(context.Context, *QueryProvidersRequest) (*QueryProvidersResponse, error)
 	FetchContract(context.Context, *QueryFetchContractRequest) (*QueryFetchContractResponse, error)
+	// Previews how a contract settles at the current height, the claim at the
+	// nonce or without a nonce the settlement closing the contract.
+	ContractSettlementPreview(context.Context, *QueryContractSettlementPreviewRequest) (*QueryContractSettlementPreviewResponse, error)
+	// Queries what the claim of the provider at the nonce pays it at the
+	// current height.
+	ClaimableIncome(context.Context, *QueryClaimableIncomeRequest) (*QueryClaimableIncomeResponse, error)
 	ContractAll(context.Context, *QueryAllContractRequest) (*QueryAllContractResponse, error)
+	// Queries the contracts of a provider for a service.
+	ContractsByProvider(context.Context, *QueryContractsByProviderRequest) (*QueryContractsByProviderResponse, error)
+	// Queries the contracts of a client, and of a delegate when asked to, that
+	// are not settled yet.
+	ContractsByOwner(context.Context, *QueryContractsByOwnerRequest) (*QueryContractsByOwnerResponse, error)
 	// Queries an active contract by spender, provider and service.
 	ActiveContract(context.Context, *QueryActiveContractRequest) (*QueryActiveContractResponse, error)
+	// Queries the counters of the providers and contracts of the network.
+	NetworkStats(context.Context, *QueryNetworkStatsRequest) (*QueryNetworkStatsResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -115,18 +212,39 @@ func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*Q
 func (UnimplementedQueryServer) FetchProvider(context.Context, *QueryFetchProviderRequest) (*QueryFetchProviderResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FetchProvider not implemented")
 }
+func (UnimplementedQueryServer) ProviderEarnings(context.Context, *QueryProviderEarningsRequest) (*QueryProviderEarningsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProviderEarnings not implemented")
+}
 func (UnimplementedQueryServer) ProviderAll(context.Context, *QueryAllProviderRequest) (*QueryAllProviderResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ProviderAll not implemented")
 }
+func (UnimplementedQueryServer) Providers(context.Context, *QueryProvidersRequest) (*QueryProvidersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Providers not implemented")
+}
 func (UnimplementedQueryServer) FetchContract(context.Context, *QueryFetchContractRequest) (*QueryFetchContractResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FetchContract not implemented")
 }
+func (UnimplementedQueryServer) ContractSettlementPreview(context.Context, *QueryContractSettlementPreviewRequest) (*QueryContractSettlementPreviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContractSettlementPreview not implemented")
+}
+func (UnimplementedQueryServer) ClaimableIncome(context.Context, *QueryClaimableIncomeRequest) (*QueryClaimableIncomeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimableIncome not implemented")
+}
 func (UnimplementedQueryServer) ContractAll(context.Context, *QueryAllContractRequest) (*QueryAllContractResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ContractAll not implemented")
 }
+func (UnimplementedQueryServer) ContractsByProvider(context.Context, *QueryContractsByProviderRequest) (*QueryContractsByProviderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContractsByProvider not implemented")
+}
+func (UnimplementedQueryServer) ContractsByOwner(context.Context, *QueryContractsByOwnerRequest) (*QueryContractsByOwnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContractsByOwner not implemented")
+}
 func (UnimplementedQueryServer) ActiveContract(context.Context, *QueryActiveContractRequest) (*QueryActiveContractResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ActiveContract not implemented")
 }
+func (UnimplementedQueryServer) NetworkStats(context.Context, *QueryNetworkStatsRequest) (*QueryNetworkStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NetworkStats not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -176,6 +294,24 @@ func _Query_FetchProvider_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ProviderEarnings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProviderEarningsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProviderEarnings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/arkeo.arkeo.Query/ProviderEarnings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProviderEarnings(ctx, req.(*QueryProviderEarningsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_ProviderAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryAllProviderRequest)
 	if err := dec(in); err != nil {
@@ -194,6 +330,24 @@ func _Query_ProviderAll_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Providers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProvidersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Providers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/arkeo.arkeo.Query/Providers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Providers(ctx, req.(*QueryProvidersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_FetchContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryFetchContractRequest)
 	if err := dec(in); err != nil {
@@ -212,6 +366,42 @@ func _Query_FetchContract_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ContractSettlementPreview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryContractSettlementPreviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ContractSettlementPreview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/arkeo.arkeo.Query/ContractSettlementPreview",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContractSettlementPreview(ctx, req.(*QueryContractSettlementPreviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ClaimableIncome_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClaimableIncomeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ClaimableIncome(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/arkeo.arkeo.Query/ClaimableIncome",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClaimableIncome(ctx, req.(*QueryClaimableIncomeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_ContractAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryAllContractRequest)
 	if err := dec(in); err != nil {
@@ -230,6 +420,42 @@ func _Query_ContractAll_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ContractsByProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryContractsByProviderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ContractsByProvider(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/arkeo.arkeo.Query/ContractsByProvider",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContractsByProvider(ctx, req.(*QueryContractsByProviderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ContractsByOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryContractsByOwnerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ContractsByOwner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/arkeo.arkeo.Query/ContractsByOwner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContractsByOwner(ctx, req.(*QueryContractsByOwnerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_ActiveContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryActiveContractRequest)
 	if err := dec(in); err != nil {
@@ -248,6 +474,24 @@ func _Query_ActiveContract_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_NetworkStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNetworkStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NetworkStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/arkeo.arkeo.Query/NetworkStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NetworkStats(ctx, req.(*QueryNetworkStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -263,22 +507,50 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "FetchProvider",
 			Handler:    _Query_FetchProvider_Handler,
 		},
+		{
+			MethodName: "ProviderEarnings",
+			Handler:    _Query_ProviderEarnings_Handler,
+		},
 		{
 			MethodName: "ProviderAll",
 			Handler:    _Query_ProviderAll_Handler,
 		},
+		{
+			MethodName: "Providers",
+			Handler:    _Query_Providers_Handler,
+		},
 		{
 			MethodName: "FetchContract",
 			Handler:    _Query_FetchContract_Handler,
 		},
+		{
+			MethodName: "ContractSettlementPreview",
+			Handler:    _Query_ContractSettlementPreview_Handler,
+		},
+		{
+			MethodName: "ClaimableIncome",
+			Handler:    _Query_ClaimableIncome_Handler,
+		},
 		{
 			MethodName: "ContractAll",
 			Handler:    _Query_ContractAll_Handler,
 		},
+		{
+			MethodName: "ContractsByProvider",
+			Handler:    _Query_ContractsByProvider_Handler,
+		},
+		{
+			MethodName: "ContractsByOwner",
+			Handler:    _Query_ContractsByOwner_Handler,
+		},
 		{
 			MethodName: "ActiveContract",
 			Handler:    _Query_ActiveContract_Handler,
 		},
+		{
+			MethodName: "NetworkStats",
+			Handler:    _Query_NetworkStats_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "arkeo/arkeo/query.proto",
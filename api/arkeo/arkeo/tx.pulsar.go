@@ -1087,6 +1087,52 @@ func (x *_MsgModProvider_10_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_MsgModProvider_12_list)(nil)
+
+type _MsgModProvider_12_list struct {
+	list *[]string
+}
+
+func (x *_MsgModProvider_12_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgModProvider_12_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_MsgModProvider_12_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgModProvider_12_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgModProvider_12_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message MsgModProvider at list field UpdateMask as it is not of Message kind"))
+}
+
+func (x *_MsgModProvider_12_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgModProvider_12_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_MsgModProvider_12_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
 	md_MsgModProvider                       protoreflect.MessageDescriptor
 	fd_MsgModProvider_creator               protoreflect.FieldDescriptor
@@ -1100,6 +1146,8 @@ var (
 	fd_MsgModProvider_subscription_rate     protoreflect.FieldDescriptor
 	fd_MsgModProvider_pay_as_you_go_rate    protoreflect.FieldDescriptor
 	fd_MsgModProvider_settlement_duration   protoreflect.FieldDescriptor
+	fd_MsgModProvider_update_mask           protoreflect.FieldDescriptor
+	fd_MsgModProvider_max_open_contracts    protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -1116,6 +1164,8 @@ func init() {
 	fd_MsgModProvider_subscription_rate = md_MsgModProvider.Fields().ByName("subscription_rate")
 	fd_MsgModProvider_pay_as_you_go_rate = md_MsgModProvider.Fields().ByName("pay_as_you_go_rate")
 	fd_MsgModProvider_settlement_duration = md_MsgModProvider.Fields().ByName("settlement_duration")
+	fd_MsgModProvider_update_mask = md_MsgModProvider.Fields().ByName("update_mask")
+	fd_MsgModProvider_max_open_contracts = md_MsgModProvider.Fields().ByName("max_open_contracts")
 }
 
 var _ protoreflect.Message = (*fastReflection_MsgModProvider)(nil)
@@ -1249,6 +1299,18 @@ func (x *fastReflection_MsgModProvider) Range(f func(protoreflect.FieldDescripto
 			return
 		}
 	}
+	if len(x.UpdateMask) != 0 {
+		value := protoreflect.ValueOfList(&_MsgModProvider_12_list{list: &x.UpdateMask})
+		if !f(fd_MsgModProvider_update_mask, value) {
+			return
+		}
+	}
+	if x.MaxOpenContracts != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxOpenContracts)
+		if !f(fd_MsgModProvider_max_open_contracts, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -1286,6 +1348,10 @@ func (x *fastReflection_MsgModProvider) Has(fd protoreflect.FieldDescriptor) boo
 		return len(x.PayAsYouGoRate) != 0
 	case "arkeo.arkeo.MsgModProvider.settlement_duration":
 		return x.SettlementDuration != int64(0)
+	case "arkeo.arkeo.MsgModProvider.update_mask":
+		return len(x.UpdateMask) != 0
+	case "arkeo.arkeo.MsgModProvider.max_open_contracts":
+		return x.MaxOpenContracts != uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgModProvider"))
@@ -1324,6 +1390,10 @@ func (x *fastReflection_MsgModProvider) Clear(fd protoreflect.FieldDescriptor) {
 		x.PayAsYouGoRate = nil
 	case "arkeo.arkeo.MsgModProvider.settlement_duration":
 		x.SettlementDuration = int64(0)
+	case "arkeo.arkeo.MsgModProvider.update_mask":
+		x.UpdateMask = nil
+	case "arkeo.arkeo.MsgModProvider.max_open_contracts":
+		x.MaxOpenContracts = uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgModProvider"))
@@ -1379,6 +1449,15 @@ func (x *fastReflection_MsgModProvider) Get(descriptor protoreflect.FieldDescrip
 	case "arkeo.arkeo.MsgModProvider.settlement_duration":
 		value := x.SettlementDuration
 		return protoreflect.ValueOfInt64(value)
+	case "arkeo.arkeo.MsgModProvider.update_mask":
+		if len(x.UpdateMask) == 0 {
+			return protoreflect.ValueOfList(&_MsgModProvider_12_list{})
+		}
+		listValue := &_MsgModProvider_12_list{list: &x.UpdateMask}
+		return protoreflect.ValueOfList(listValue)
+	case "arkeo.arkeo.MsgModProvider.max_open_contracts":
+		value := x.MaxOpenContracts
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgModProvider"))
@@ -1425,6 +1504,12 @@ func (x *fastReflection_MsgModProvider) Set(fd protoreflect.FieldDescriptor, val
 		x.PayAsYouGoRate = *clv.list
 	case "arkeo.arkeo.MsgModProvider.settlement_duration":
 		x.SettlementDuration = value.Int()
+	case "arkeo.arkeo.MsgModProvider.update_mask":
+		lv := value.List()
+		clv := lv.(*_MsgModProvider_12_list)
+		x.UpdateMask = *clv.list
+	case "arkeo.arkeo.MsgModProvider.max_open_contracts":
+		x.MaxOpenContracts = value.Uint()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgModProvider"))
@@ -1457,6 +1542,12 @@ func (x *fastReflection_MsgModProvider) Mutable(fd protoreflect.FieldDescriptor)
 		}
 		value := &_MsgModProvider_10_list{list: &x.PayAsYouGoRate}
 		return protoreflect.ValueOfList(value)
+	case "arkeo.arkeo.MsgModProvider.update_mask":
+		if x.UpdateMask == nil {
+			x.UpdateMask = []string{}
+		}
+		value := &_MsgModProvider_12_list{list: &x.UpdateMask}
+		return protoreflect.ValueOfList(value)
 	case "arkeo.arkeo.MsgModProvider.creator":
 		panic(fmt.Errorf("field creator of message arkeo.arkeo.MsgModProvider is not mutable"))
 	case "arkeo.arkeo.MsgModProvider.provider":
@@ -1475,6 +1566,8 @@ func (x *fastReflection_MsgModProvider) Mutable(fd protoreflect.FieldDescriptor)
 		panic(fmt.Errorf("field max_contract_duration of message arkeo.arkeo.MsgModProvider is not mutable"))
 	case "arkeo.arkeo.MsgModProvider.settlement_duration":
 		panic(fmt.Errorf("field settlement_duration of message arkeo.arkeo.MsgModProvider is not mutable"))
+	case "arkeo.arkeo.MsgModProvider.max_open_contracts":
+		panic(fmt.Errorf("field max_open_contracts of message arkeo.arkeo.MsgModProvider is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgModProvider"))
@@ -1512,6 +1605,11 @@ func (x *fastReflection_MsgModProvider) NewField(fd protoreflect.FieldDescriptor
 		return protoreflect.ValueOfList(&_MsgModProvider_10_list{list: &list})
 	case "arkeo.arkeo.MsgModProvider.settlement_duration":
 		return protoreflect.ValueOfInt64(int64(0))
+	case "arkeo.arkeo.MsgModProvider.update_mask":
+		list := []string{}
+		return protoreflect.ValueOfList(&_MsgModProvider_12_list{list: &list})
+	case "arkeo.arkeo.MsgModProvider.max_open_contracts":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgModProvider"))
@@ -1624,6 +1722,15 @@ func (x *fastReflection_MsgModProvider) ProtoMethods() *protoiface.Methods {
 		if x.SettlementDuration != 0 {
 			n += 1 + runtime.Sov(uint64(x.SettlementDuration))
 		}
+		if len(x.UpdateMask) > 0 {
+			for _, s := range x.UpdateMask {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.MaxOpenContracts != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxOpenContracts))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1653,6 +1760,20 @@ func (x *fastReflection_MsgModProvider) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.MaxOpenContracts != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxOpenContracts))
+			i--
+			dAtA[i] = 0x68
+		}
+		if len(x.UpdateMask) > 0 {
+			for iNdEx := len(x.UpdateMask) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.UpdateMask[iNdEx])
+				copy(dAtA[i:], x.UpdateMask[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.UpdateMask[iNdEx])))
+				i--
+				dAtA[i] = 0x62
+			}
+		}
 		if x.SettlementDuration != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.SettlementDuration))
 			i--
@@ -2080,6 +2201,57 @@ func (x *fastReflection_MsgModProvider) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UpdateMask", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.UpdateMask = append(x.UpdateMask, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 13:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxOpenContracts", wireType)
+				}
+				x.MaxOpenContracts = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxOpenContracts |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2485,6 +2657,9 @@ var (
 	fd_MsgOpenContract_settlement_duration protoreflect.FieldDescriptor
 	fd_MsgOpenContract_authorization       protoreflect.FieldDescriptor
 	fd_MsgOpenContract_queries_per_minute  protoreflect.FieldDescriptor
+	fd_MsgOpenContract_auto_renew          protoreflect.FieldDescriptor
+	fd_MsgOpenContract_payer               protoreflect.FieldDescriptor
+	fd_MsgOpenContract_start_height        protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -2502,6 +2677,9 @@ func init() {
 	fd_MsgOpenContract_settlement_duration = md_MsgOpenContract.Fields().ByName("settlement_duration")
 	fd_MsgOpenContract_authorization = md_MsgOpenContract.Fields().ByName("authorization")
 	fd_MsgOpenContract_queries_per_minute = md_MsgOpenContract.Fields().ByName("queries_per_minute")
+	fd_MsgOpenContract_auto_renew = md_MsgOpenContract.Fields().ByName("auto_renew")
+	fd_MsgOpenContract_payer = md_MsgOpenContract.Fields().ByName("payer")
+	fd_MsgOpenContract_start_height = md_MsgOpenContract.Fields().ByName("start_height")
 }
 
 var _ protoreflect.Message = (*fastReflection_MsgOpenContract)(nil)
@@ -2641,6 +2819,24 @@ func (x *fastReflection_MsgOpenContract) Range(f func(protoreflect.FieldDescript
 			return
 		}
 	}
+	if x.AutoRenew != false {
+		value := protoreflect.ValueOfBool(x.AutoRenew)
+		if !f(fd_MsgOpenContract_auto_renew, value) {
+			return
+		}
+	}
+	if x.Payer != "" {
+		value := protoreflect.ValueOfString(x.Payer)
+		if !f(fd_MsgOpenContract_payer, value) {
+			return
+		}
+	}
+	if x.StartHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.StartHeight)
+		if !f(fd_MsgOpenContract_start_height, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -2680,6 +2876,12 @@ func (x *fastReflection_MsgOpenContract) Has(fd protoreflect.FieldDescriptor) bo
 		return x.Authorization != 0
 	case "arkeo.arkeo.MsgOpenContract.queries_per_minute":
 		return x.QueriesPerMinute != int64(0)
+	case "arkeo.arkeo.MsgOpenContract.auto_renew":
+		return x.AutoRenew != false
+	case "arkeo.arkeo.MsgOpenContract.payer":
+		return x.Payer != ""
+	case "arkeo.arkeo.MsgOpenContract.start_height":
+		return x.StartHeight != int64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgOpenContract"))
@@ -2720,6 +2922,12 @@ func (x *fastReflection_MsgOpenContract) Clear(fd protoreflect.FieldDescriptor)
 		x.Authorization = 0
 	case "arkeo.arkeo.MsgOpenContract.queries_per_minute":
 		x.QueriesPerMinute = int64(0)
+	case "arkeo.arkeo.MsgOpenContract.auto_renew":
+		x.AutoRenew = false
+	case "arkeo.arkeo.MsgOpenContract.payer":
+		x.Payer = ""
+	case "arkeo.arkeo.MsgOpenContract.start_height":
+		x.StartHeight = int64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgOpenContract"))
@@ -2772,6 +2980,15 @@ func (x *fastReflection_MsgOpenContract) Get(descriptor protoreflect.FieldDescri
 	case "arkeo.arkeo.MsgOpenContract.queries_per_minute":
 		value := x.QueriesPerMinute
 		return protoreflect.ValueOfInt64(value)
+	case "arkeo.arkeo.MsgOpenContract.auto_renew":
+		value := x.AutoRenew
+		return protoreflect.ValueOfBool(value)
+	case "arkeo.arkeo.MsgOpenContract.payer":
+		value := x.Payer
+		return protoreflect.ValueOfString(value)
+	case "arkeo.arkeo.MsgOpenContract.start_height":
+		value := x.StartHeight
+		return protoreflect.ValueOfInt64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgOpenContract"))
@@ -2816,6 +3033,12 @@ func (x *fastReflection_MsgOpenContract) Set(fd protoreflect.FieldDescriptor, va
 		x.Authorization = (ContractAuthorization)(value.Enum())
 	case "arkeo.arkeo.MsgOpenContract.queries_per_minute":
 		x.QueriesPerMinute = value.Int()
+	case "arkeo.arkeo.MsgOpenContract.auto_renew":
+		x.AutoRenew = value.Bool()
+	case "arkeo.arkeo.MsgOpenContract.payer":
+		x.Payer = value.Interface().(string)
+	case "arkeo.arkeo.MsgOpenContract.start_height":
+		x.StartHeight = value.Int()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgOpenContract"))
@@ -2863,6 +3086,12 @@ func (x *fastReflection_MsgOpenContract) Mutable(fd protoreflect.FieldDescriptor
 		panic(fmt.Errorf("field authorization of message arkeo.arkeo.MsgOpenContract is not mutable"))
 	case "arkeo.arkeo.MsgOpenContract.queries_per_minute":
 		panic(fmt.Errorf("field queries_per_minute of message arkeo.arkeo.MsgOpenContract is not mutable"))
+	case "arkeo.arkeo.MsgOpenContract.auto_renew":
+		panic(fmt.Errorf("field auto_renew of message arkeo.arkeo.MsgOpenContract is not mutable"))
+	case "arkeo.arkeo.MsgOpenContract.payer":
+		panic(fmt.Errorf("field payer of message arkeo.arkeo.MsgOpenContract is not mutable"))
+	case "arkeo.arkeo.MsgOpenContract.start_height":
+		panic(fmt.Errorf("field start_height of message arkeo.arkeo.MsgOpenContract is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgOpenContract"))
@@ -2901,6 +3130,12 @@ func (x *fastReflection_MsgOpenContract) NewField(fd protoreflect.FieldDescripto
 		return protoreflect.ValueOfEnum(0)
 	case "arkeo.arkeo.MsgOpenContract.queries_per_minute":
 		return protoreflect.ValueOfInt64(int64(0))
+	case "arkeo.arkeo.MsgOpenContract.auto_renew":
+		return protoreflect.ValueOfBool(false)
+	case "arkeo.arkeo.MsgOpenContract.payer":
+		return protoreflect.ValueOfString("")
+	case "arkeo.arkeo.MsgOpenContract.start_height":
+		return protoreflect.ValueOfInt64(int64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgOpenContract"))
@@ -3013,6 +3248,16 @@ func (x *fastReflection_MsgOpenContract) ProtoMethods() *protoiface.Methods {
 		if x.QueriesPerMinute != 0 {
 			n += 1 + runtime.Sov(uint64(x.QueriesPerMinute))
 		}
+		if x.AutoRenew {
+			n += 2
+		}
+		l = len(x.Payer)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.StartHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.StartHeight))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -3042,6 +3287,28 @@ func (x *fastReflection_MsgOpenContract) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.StartHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.StartHeight))
+			i--
+			dAtA[i] = 0x78
+		}
+		if len(x.Payer) > 0 {
+			i -= len(x.Payer)
+			copy(dAtA[i:], x.Payer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Payer)))
+			i--
+			dAtA[i] = 0x72
+		}
+		if x.AutoRenew {
+			i--
+			if x.AutoRenew {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x68
+		}
 		if x.QueriesPerMinute != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.QueriesPerMinute))
 			i--
@@ -3495,6 +3762,77 @@ func (x *fastReflection_MsgOpenContract) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
+			case 13:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AutoRenew", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.AutoRenew = bool(v != 0)
+			case 14:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Payer", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Payer = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 15:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+				}
+				x.StartHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.StartHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -5781,27 +6119,33 @@ func (x *fastReflection_MsgClaimContractIncomeResponse) ProtoMethods() *protoifa
 }
 
 var (
-	md_MsgSetVersion         protoreflect.MessageDescriptor
-	fd_MsgSetVersion_creator protoreflect.FieldDescriptor
-	fd_MsgSetVersion_version protoreflect.FieldDescriptor
+	md_MsgRenewContract                     protoreflect.MessageDescriptor
+	fd_MsgRenewContract_creator             protoreflect.FieldDescriptor
+	fd_MsgRenewContract_contract_id         protoreflect.FieldDescriptor
+	fd_MsgRenewContract_additional_duration protoreflect.FieldDescriptor
+	fd_MsgRenewContract_extra_deposit       protoreflect.FieldDescriptor
+	fd_MsgRenewContract_rate                protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_arkeo_arkeo_tx_proto_init()
-	md_MsgSetVersion = File_arkeo_arkeo_tx_proto.Messages().ByName("MsgSetVersion")
-	fd_MsgSetVersion_creator = md_MsgSetVersion.Fields().ByName("creator")
-	fd_MsgSetVersion_version = md_MsgSetVersion.Fields().ByName("version")
+	md_MsgRenewContract = File_arkeo_arkeo_tx_proto.Messages().ByName("MsgRenewContract")
+	fd_MsgRenewContract_creator = md_MsgRenewContract.Fields().ByName("creator")
+	fd_MsgRenewContract_contract_id = md_MsgRenewContract.Fields().ByName("contract_id")
+	fd_MsgRenewContract_additional_duration = md_MsgRenewContract.Fields().ByName("additional_duration")
+	fd_MsgRenewContract_extra_deposit = md_MsgRenewContract.Fields().ByName("extra_deposit")
+	fd_MsgRenewContract_rate = md_MsgRenewContract.Fields().ByName("rate")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgSetVersion)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgRenewContract)(nil)
 
-type fastReflection_MsgSetVersion MsgSetVersion
+type fastReflection_MsgRenewContract MsgRenewContract
 
-func (x *MsgSetVersion) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgSetVersion)(x)
+func (x *MsgRenewContract) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgRenewContract)(x)
 }
 
-func (x *MsgSetVersion) slowProtoReflect() protoreflect.Message {
+func (x *MsgRenewContract) slowProtoReflect() protoreflect.Message {
 	mi := &file_arkeo_arkeo_tx_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5813,43 +6157,43 @@ func (x *MsgSetVersion) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgSetVersion_messageType fastReflection_MsgSetVersion_messageType
-var _ protoreflect.MessageType = fastReflection_MsgSetVersion_messageType{}
+var _fastReflection_MsgRenewContract_messageType fastReflection_MsgRenewContract_messageType
+var _ protoreflect.MessageType = fastReflection_MsgRenewContract_messageType{}
 
-type fastReflection_MsgSetVersion_messageType struct{}
+type fastReflection_MsgRenewContract_messageType struct{}
 
-func (x fastReflection_MsgSetVersion_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgSetVersion)(nil)
+func (x fastReflection_MsgRenewContract_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgRenewContract)(nil)
 }
-func (x fastReflection_MsgSetVersion_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgSetVersion)
+func (x fastReflection_MsgRenewContract_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgRenewContract)
 }
-func (x fastReflection_MsgSetVersion_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgSetVersion
+func (x fastReflection_MsgRenewContract_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRenewContract
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgSetVersion) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgSetVersion
+func (x *fastReflection_MsgRenewContract) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRenewContract
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgSetVersion) Type() protoreflect.MessageType {
-	return _fastReflection_MsgSetVersion_messageType
+func (x *fastReflection_MsgRenewContract) Type() protoreflect.MessageType {
+	return _fastReflection_MsgRenewContract_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgSetVersion) New() protoreflect.Message {
-	return new(fastReflection_MsgSetVersion)
+func (x *fastReflection_MsgRenewContract) New() protoreflect.Message {
+	return new(fastReflection_MsgRenewContract)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgSetVersion) Interface() protoreflect.ProtoMessage {
-	return (*MsgSetVersion)(x)
+func (x *fastReflection_MsgRenewContract) Interface() protoreflect.ProtoMessage {
+	return (*MsgRenewContract)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -5857,16 +6201,34 @@ func (x *fastReflection_MsgSetVersion) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgSetVersion) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgRenewContract) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.Creator != "" {
 		value := protoreflect.ValueOfString(x.Creator)
-		if !f(fd_MsgSetVersion_creator, value) {
+		if !f(fd_MsgRenewContract_creator, value) {
 			return
 		}
 	}
-	if x.Version != int64(0) {
-		value := protoreflect.ValueOfInt64(x.Version)
-		if !f(fd_MsgSetVersion_version, value) {
+	if x.ContractId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ContractId)
+		if !f(fd_MsgRenewContract_contract_id, value) {
+			return
+		}
+	}
+	if x.AdditionalDuration != int64(0) {
+		value := protoreflect.ValueOfInt64(x.AdditionalDuration)
+		if !f(fd_MsgRenewContract_additional_duration, value) {
+			return
+		}
+	}
+	if x.ExtraDeposit != "" {
+		value := protoreflect.ValueOfString(x.ExtraDeposit)
+		if !f(fd_MsgRenewContract_extra_deposit, value) {
+			return
+		}
+	}
+	if x.Rate != nil {
+		value := protoreflect.ValueOfMessage(x.Rate.ProtoReflect())
+		if !f(fd_MsgRenewContract_rate, value) {
 			return
 		}
 	}
@@ -5883,17 +6245,23 @@ func (x *fastReflection_MsgSetVersion) Range(f func(protoreflect.FieldDescriptor
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgSetVersion) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgRenewContract) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "arkeo.arkeo.MsgSetVersion.creator":
+	case "arkeo.arkeo.MsgRenewContract.creator":
 		return x.Creator != ""
-	case "arkeo.arkeo.MsgSetVersion.version":
-		return x.Version != int64(0)
+	case "arkeo.arkeo.MsgRenewContract.contract_id":
+		return x.ContractId != uint64(0)
+	case "arkeo.arkeo.MsgRenewContract.additional_duration":
+		return x.AdditionalDuration != int64(0)
+	case "arkeo.arkeo.MsgRenewContract.extra_deposit":
+		return x.ExtraDeposit != ""
+	case "arkeo.arkeo.MsgRenewContract.rate":
+		return x.Rate != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgSetVersion"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgRenewContract"))
 		}
-		panic(fmt.Errorf("message arkeo.arkeo.MsgSetVersion does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message arkeo.arkeo.MsgRenewContract does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5903,17 +6271,23 @@ func (x *fastReflection_MsgSetVersion) Has(fd protoreflect.FieldDescriptor) bool
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSetVersion) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgRenewContract) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "arkeo.arkeo.MsgSetVersion.creator":
+	case "arkeo.arkeo.MsgRenewContract.creator":
 		x.Creator = ""
-	case "arkeo.arkeo.MsgSetVersion.version":
-		x.Version = int64(0)
+	case "arkeo.arkeo.MsgRenewContract.contract_id":
+		x.ContractId = uint64(0)
+	case "arkeo.arkeo.MsgRenewContract.additional_duration":
+		x.AdditionalDuration = int64(0)
+	case "arkeo.arkeo.MsgRenewContract.extra_deposit":
+		x.ExtraDeposit = ""
+	case "arkeo.arkeo.MsgRenewContract.rate":
+		x.Rate = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgSetVersion"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgRenewContract"))
 		}
-		panic(fmt.Errorf("message arkeo.arkeo.MsgSetVersion does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message arkeo.arkeo.MsgRenewContract does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5923,19 +6297,28 @@ func (x *fastReflection_MsgSetVersion) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgSetVersion) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgRenewContract) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "arkeo.arkeo.MsgSetVersion.creator":
+	case "arkeo.arkeo.MsgRenewContract.creator":
 		value := x.Creator
 		return protoreflect.ValueOfString(value)
-	case "arkeo.arkeo.MsgSetVersion.version":
-		value := x.Version
+	case "arkeo.arkeo.MsgRenewContract.contract_id":
+		value := x.ContractId
+		return protoreflect.ValueOfUint64(value)
+	case "arkeo.arkeo.MsgRenewContract.additional_duration":
+		value := x.AdditionalDuration
 		return protoreflect.ValueOfInt64(value)
+	case "arkeo.arkeo.MsgRenewContract.extra_deposit":
+		value := x.ExtraDeposit
+		return protoreflect.ValueOfString(value)
+	case "arkeo.arkeo.MsgRenewContract.rate":
+		value := x.Rate
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgSetVersion"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgRenewContract"))
 		}
-		panic(fmt.Errorf("message arkeo.arkeo.MsgSetVersion does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message arkeo.arkeo.MsgRenewContract does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -5949,17 +6332,23 @@ func (x *fastReflection_MsgSetVersion) Get(descriptor protoreflect.FieldDescript
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSetVersion) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgRenewContract) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "arkeo.arkeo.MsgSetVersion.creator":
+	case "arkeo.arkeo.MsgRenewContract.creator":
 		x.Creator = value.Interface().(string)
-	case "arkeo.arkeo.MsgSetVersion.version":
-		x.Version = value.Int()
+	case "arkeo.arkeo.MsgRenewContract.contract_id":
+		x.ContractId = value.Uint()
+	case "arkeo.arkeo.MsgRenewContract.additional_duration":
+		x.AdditionalDuration = value.Int()
+	case "arkeo.arkeo.MsgRenewContract.extra_deposit":
+		x.ExtraDeposit = value.Interface().(string)
+	case "arkeo.arkeo.MsgRenewContract.rate":
+		x.Rate = value.Message().Interface().(*v1beta1.Coin)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgSetVersion"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgRenewContract"))
 		}
-		panic(fmt.Errorf("message arkeo.arkeo.MsgSetVersion does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message arkeo.arkeo.MsgRenewContract does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5973,44 +6362,60 @@ func (x *fastReflection_MsgSetVersion) Set(fd protoreflect.FieldDescriptor, valu
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSetVersion) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgRenewContract) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "arkeo.arkeo.MsgSetVersion.creator":
-		panic(fmt.Errorf("field creator of message arkeo.arkeo.MsgSetVersion is not mutable"))
-	case "arkeo.arkeo.MsgSetVersion.version":
-		panic(fmt.Errorf("field version of message arkeo.arkeo.MsgSetVersion is not mutable"))
+	case "arkeo.arkeo.MsgRenewContract.rate":
+		if x.Rate == nil {
+			x.Rate = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.Rate.ProtoReflect())
+	case "arkeo.arkeo.MsgRenewContract.creator":
+		panic(fmt.Errorf("field creator of message arkeo.arkeo.MsgRenewContract is not mutable"))
+	case "arkeo.arkeo.MsgRenewContract.contract_id":
+		panic(fmt.Errorf("field contract_id of message arkeo.arkeo.MsgRenewContract is not mutable"))
+	case "arkeo.arkeo.MsgRenewContract.additional_duration":
+		panic(fmt.Errorf("field additional_duration of message arkeo.arkeo.MsgRenewContract is not mutable"))
+	case "arkeo.arkeo.MsgRenewContract.extra_deposit":
+		panic(fmt.Errorf("field extra_deposit of message arkeo.arkeo.MsgRenewContract is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgSetVersion"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgRenewContract"))
 		}
-		panic(fmt.Errorf("message arkeo.arkeo.MsgSetVersion does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message arkeo.arkeo.MsgRenewContract does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgSetVersion) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgRenewContract) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "arkeo.arkeo.MsgSetVersion.creator":
+	case "arkeo.arkeo.MsgRenewContract.creator":
 		return protoreflect.ValueOfString("")
-	case "arkeo.arkeo.MsgSetVersion.version":
+	case "arkeo.arkeo.MsgRenewContract.contract_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "arkeo.arkeo.MsgRenewContract.additional_duration":
 		return protoreflect.ValueOfInt64(int64(0))
+	case "arkeo.arkeo.MsgRenewContract.extra_deposit":
+		return protoreflect.ValueOfString("")
+	case "arkeo.arkeo.MsgRenewContract.rate":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgSetVersion"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgRenewContract"))
 		}
-		panic(fmt.Errorf("message arkeo.arkeo.MsgSetVersion does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message arkeo.arkeo.MsgRenewContract does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgSetVersion) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgRenewContract) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in arkeo.arkeo.MsgSetVersion", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in arkeo.arkeo.MsgRenewContract", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -6018,7 +6423,7 @@ func (x *fastReflection_MsgSetVersion) WhichOneof(d protoreflect.OneofDescriptor
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgSetVersion) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgRenewContract) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -6029,7 +6434,7 @@ func (x *fastReflection_MsgSetVersion) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSetVersion) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgRenewContract) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -6041,7 +6446,7 @@ func (x *fastReflection_MsgSetVersion) SetUnknown(fields protoreflect.RawFields)
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgSetVersion) IsValid() bool {
+func (x *fastReflection_MsgRenewContract) IsValid() bool {
 	return x != nil
 }
 
@@ -6051,9 +6456,9 @@ func (x *fastReflection_MsgSetVersion) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgSetVersion) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgRenewContract) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgSetVersion)
+		x := input.Message.Interface().(*MsgRenewContract)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6069,8 +6474,19 @@ func (x *fastReflection_MsgSetVersion) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.Version != 0 {
-			n += 1 + runtime.Sov(uint64(x.Version))
+		if x.ContractId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ContractId))
+		}
+		if x.AdditionalDuration != 0 {
+			n += 1 + runtime.Sov(uint64(x.AdditionalDuration))
+		}
+		l = len(x.ExtraDeposit)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Rate != nil {
+			l = options.Size(x.Rate)
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -6082,7 +6498,7 @@ func (x *fastReflection_MsgSetVersion) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgSetVersion)
+		x := input.Message.Interface().(*MsgRenewContract)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6101,8 +6517,34 @@ func (x *fastReflection_MsgSetVersion) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Version != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Version))
+		if x.Rate != nil {
+			encoded, err := options.Marshal(x.Rate)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.ExtraDeposit) > 0 {
+			i -= len(x.ExtraDeposit)
+			copy(dAtA[i:], x.ExtraDeposit)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ExtraDeposit)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.AdditionalDuration != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AdditionalDuration))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.ContractId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ContractId))
 			i--
 			dAtA[i] = 0x10
 		}
@@ -6124,7 +6566,7 @@ func (x *fastReflection_MsgSetVersion) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgSetVersion)
+		x := input.Message.Interface().(*MsgRenewContract)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6156,10 +6598,10 @@ func (x *fastReflection_MsgSetVersion) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetVersion: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRenewContract: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetVersion: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRenewContract: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -6196,9 +6638,9 @@ func (x *fastReflection_MsgSetVersion) ProtoMethods() *protoiface.Methods {
 				iNdEx = postIndex
 			case 2:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
 				}
-				x.Version = 0
+				x.ContractId = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -6208,11 +6650,98 @@ func (x *fastReflection_MsgSetVersion) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.Version |= int64(b&0x7F) << shift
+					x.ContractId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AdditionalDuration", wireType)
+				}
+				x.AdditionalDuration = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AdditionalDuration |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExtraDeposit", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ExtraDeposit = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Rate == nil {
+					x.Rate = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Rate); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -6249,23 +6778,23 @@ func (x *fastReflection_MsgSetVersion) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_MsgSetVersionResponse protoreflect.MessageDescriptor
+	md_MsgRenewContractResponse protoreflect.MessageDescriptor
 )
 
 func init() {
 	file_arkeo_arkeo_tx_proto_init()
-	md_MsgSetVersionResponse = File_arkeo_arkeo_tx_proto.Messages().ByName("MsgSetVersionResponse")
+	md_MsgRenewContractResponse = File_arkeo_arkeo_tx_proto.Messages().ByName("MsgRenewContractResponse")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgSetVersionResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgRenewContractResponse)(nil)
 
-type fastReflection_MsgSetVersionResponse MsgSetVersionResponse
+type fastReflection_MsgRenewContractResponse MsgRenewContractResponse
 
-func (x *MsgSetVersionResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgSetVersionResponse)(x)
+func (x *MsgRenewContractResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgRenewContractResponse)(x)
 }
 
-func (x *MsgSetVersionResponse) slowProtoReflect() protoreflect.Message {
+func (x *MsgRenewContractResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_arkeo_arkeo_tx_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6277,43 +6806,43 @@ func (x *MsgSetVersionResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgSetVersionResponse_messageType fastReflection_MsgSetVersionResponse_messageType
-var _ protoreflect.MessageType = fastReflection_MsgSetVersionResponse_messageType{}
+var _fastReflection_MsgRenewContractResponse_messageType fastReflection_MsgRenewContractResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgRenewContractResponse_messageType{}
 
-type fastReflection_MsgSetVersionResponse_messageType struct{}
+type fastReflection_MsgRenewContractResponse_messageType struct{}
 
-func (x fastReflection_MsgSetVersionResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgSetVersionResponse)(nil)
+func (x fastReflection_MsgRenewContractResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgRenewContractResponse)(nil)
 }
-func (x fastReflection_MsgSetVersionResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgSetVersionResponse)
+func (x fastReflection_MsgRenewContractResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgRenewContractResponse)
 }
-func (x fastReflection_MsgSetVersionResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgSetVersionResponse
+func (x fastReflection_MsgRenewContractResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRenewContractResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgSetVersionResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgSetVersionResponse
+func (x *fastReflection_MsgRenewContractResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRenewContractResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgSetVersionResponse) Type() protoreflect.MessageType {
-	return _fastReflection_MsgSetVersionResponse_messageType
+func (x *fastReflection_MsgRenewContractResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgRenewContractResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgSetVersionResponse) New() protoreflect.Message {
-	return new(fastReflection_MsgSetVersionResponse)
+func (x *fastReflection_MsgRenewContractResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgRenewContractResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgSetVersionResponse) Interface() protoreflect.ProtoMessage {
-	return (*MsgSetVersionResponse)(x)
+func (x *fastReflection_MsgRenewContractResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgRenewContractResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -6321,7 +6850,7 @@ func (x *fastReflection_MsgSetVersionResponse) Interface() protoreflect.ProtoMes
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgSetVersionResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgRenewContractResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 }
 
 // Has reports whether a field is populated.
@@ -6335,13 +6864,13 @@ func (x *fastReflection_MsgSetVersionResponse) Range(f func(protoreflect.FieldDe
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgSetVersionResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgRenewContractResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgSetVersionResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgRenewContractResponse"))
 		}
-		panic(fmt.Errorf("message arkeo.arkeo.MsgSetVersionResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message arkeo.arkeo.MsgRenewContractResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6351,13 +6880,13 @@ func (x *fastReflection_MsgSetVersionResponse) Has(fd protoreflect.FieldDescript
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSetVersionResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgRenewContractResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgSetVersionResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgRenewContractResponse"))
 		}
-		panic(fmt.Errorf("message arkeo.arkeo.MsgSetVersionResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message arkeo.arkeo.MsgRenewContractResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6367,13 +6896,13 @@ func (x *fastReflection_MsgSetVersionResponse) Clear(fd protoreflect.FieldDescri
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgSetVersionResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgRenewContractResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgSetVersionResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgRenewContractResponse"))
 		}
-		panic(fmt.Errorf("message arkeo.arkeo.MsgSetVersionResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message arkeo.arkeo.MsgRenewContractResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6387,13 +6916,13 @@ func (x *fastReflection_MsgSetVersionResponse) Get(descriptor protoreflect.Field
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSetVersionResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgRenewContractResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgSetVersionResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgRenewContractResponse"))
 		}
-		panic(fmt.Errorf("message arkeo.arkeo.MsgSetVersionResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message arkeo.arkeo.MsgRenewContractResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6407,36 +6936,36 @@ func (x *fastReflection_MsgSetVersionResponse) Set(fd protoreflect.FieldDescript
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSetVersionResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgRenewContractResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgSetVersionResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgRenewContractResponse"))
 		}
-		panic(fmt.Errorf("message arkeo.arkeo.MsgSetVersionResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message arkeo.arkeo.MsgRenewContractResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgSetVersionResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgRenewContractResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgSetVersionResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgRenewContractResponse"))
 		}
-		panic(fmt.Errorf("message arkeo.arkeo.MsgSetVersionResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message arkeo.arkeo.MsgRenewContractResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgSetVersionResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgRenewContractResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in arkeo.arkeo.MsgSetVersionResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in arkeo.arkeo.MsgRenewContractResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -6444,7 +6973,7 @@ func (x *fastReflection_MsgSetVersionResponse) WhichOneof(d protoreflect.OneofDe
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgSetVersionResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgRenewContractResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -6455,7 +6984,7 @@ func (x *fastReflection_MsgSetVersionResponse) GetUnknown() protoreflect.RawFiel
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSetVersionResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgRenewContractResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -6467,7 +6996,7 @@ func (x *fastReflection_MsgSetVersionResponse) SetUnknown(fields protoreflect.Ra
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgSetVersionResponse) IsValid() bool {
+func (x *fastReflection_MsgRenewContractResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -6477,9 +7006,9 @@ func (x *fastReflection_MsgSetVersionResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgSetVersionResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgRenewContractResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgSetVersionResponse)
+		x := input.Message.Interface().(*MsgRenewContractResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6501,7 +7030,7 @@ func (x *fastReflection_MsgSetVersionResponse) ProtoMethods() *protoiface.Method
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgSetVersionResponse)
+		x := input.Message.Interface().(*MsgRenewContractResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6531,7 +7060,7 @@ func (x *fastReflection_MsgSetVersionResponse) ProtoMethods() *protoiface.Method
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgSetVersionResponse)
+		x := input.Message.Interface().(*MsgRenewContractResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6563,10 +7092,10 @@ func (x *fastReflection_MsgSetVersionResponse) ProtoMethods() *protoiface.Method
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetVersionResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRenewContractResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetVersionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRenewContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			default:
@@ -6604,50 +7133,8223 @@ func (x *fastReflection_MsgSetVersionResponse) ProtoMethods() *protoiface.Method
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: arkeo/arkeo/tx.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+var (
+	md_MsgProviderCloseContract             protoreflect.MessageDescriptor
+	fd_MsgProviderCloseContract_creator     protoreflect.FieldDescriptor
+	fd_MsgProviderCloseContract_contract_id protoreflect.FieldDescriptor
 )
 
-type MsgBondProvider struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func init() {
+	file_arkeo_arkeo_tx_proto_init()
+	md_MsgProviderCloseContract = File_arkeo_arkeo_tx_proto.Messages().ByName("MsgProviderCloseContract")
+	fd_MsgProviderCloseContract_creator = md_MsgProviderCloseContract.Fields().ByName("creator")
+	fd_MsgProviderCloseContract_contract_id = md_MsgProviderCloseContract.Fields().ByName("contract_id")
+}
 
-	Creator  string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	Provider string `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
-	Service  string `protobuf:"bytes,3,opt,name=service,proto3" json:"service,omitempty"`
-	Bond     string `protobuf:"bytes,4,opt,name=bond,proto3" json:"bond,omitempty"`
+var _ protoreflect.Message = (*fastReflection_MsgProviderCloseContract)(nil)
+
+type fastReflection_MsgProviderCloseContract MsgProviderCloseContract
+
+func (x *MsgProviderCloseContract) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgProviderCloseContract)(x)
 }
 
-func (x *MsgBondProvider) Reset() {
-	*x = MsgBondProvider{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_arkeo_arkeo_tx_proto_msgTypes[0]
+func (x *MsgProviderCloseContract) slowProtoReflect() protoreflect.Message {
+	mi := &file_arkeo_arkeo_tx_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
+	return mi.MessageOf(x)
 }
 
-func (x *MsgBondProvider) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
+var _fastReflection_MsgProviderCloseContract_messageType fastReflection_MsgProviderCloseContract_messageType
+var _ protoreflect.MessageType = fastReflection_MsgProviderCloseContract_messageType{}
 
-func (*MsgBondProvider) ProtoMessage() {}
+type fastReflection_MsgProviderCloseContract_messageType struct{}
 
-// Deprecated: Use MsgBondProvider.ProtoReflect.Descriptor instead.
-func (*MsgBondProvider) Descriptor() ([]byte, []int) {
-	return file_arkeo_arkeo_tx_proto_rawDescGZIP(), []int{0}
+func (x fastReflection_MsgProviderCloseContract_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgProviderCloseContract)(nil)
 }
-
+func (x fastReflection_MsgProviderCloseContract_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgProviderCloseContract)
+}
+func (x fastReflection_MsgProviderCloseContract_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgProviderCloseContract
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgProviderCloseContract) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgProviderCloseContract
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgProviderCloseContract) Type() protoreflect.MessageType {
+	return _fastReflection_MsgProviderCloseContract_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgProviderCloseContract) New() protoreflect.Message {
+	return new(fastReflection_MsgProviderCloseContract)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgProviderCloseContract) Interface() protoreflect.ProtoMessage {
+	return (*MsgProviderCloseContract)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgProviderCloseContract) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Creator != "" {
+		value := protoreflect.ValueOfString(x.Creator)
+		if !f(fd_MsgProviderCloseContract_creator, value) {
+			return
+		}
+	}
+	if x.ContractId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ContractId)
+		if !f(fd_MsgProviderCloseContract_contract_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgProviderCloseContract) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "arkeo.arkeo.MsgProviderCloseContract.creator":
+		return x.Creator != ""
+	case "arkeo.arkeo.MsgProviderCloseContract.contract_id":
+		return x.ContractId != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgProviderCloseContract"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.MsgProviderCloseContract does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgProviderCloseContract) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "arkeo.arkeo.MsgProviderCloseContract.creator":
+		x.Creator = ""
+	case "arkeo.arkeo.MsgProviderCloseContract.contract_id":
+		x.ContractId = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgProviderCloseContract"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.MsgProviderCloseContract does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgProviderCloseContract) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "arkeo.arkeo.MsgProviderCloseContract.creator":
+		value := x.Creator
+		return protoreflect.ValueOfString(value)
+	case "arkeo.arkeo.MsgProviderCloseContract.contract_id":
+		value := x.ContractId
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgProviderCloseContract"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.MsgProviderCloseContract does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgProviderCloseContract) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "arkeo.arkeo.MsgProviderCloseContract.creator":
+		x.Creator = value.Interface().(string)
+	case "arkeo.arkeo.MsgProviderCloseContract.contract_id":
+		x.ContractId = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgProviderCloseContract"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.MsgProviderCloseContract does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgProviderCloseContract) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "arkeo.arkeo.MsgProviderCloseContract.creator":
+		panic(fmt.Errorf("field creator of message arkeo.arkeo.MsgProviderCloseContract is not mutable"))
+	case "arkeo.arkeo.MsgProviderCloseContract.contract_id":
+		panic(fmt.Errorf("field contract_id of message arkeo.arkeo.MsgProviderCloseContract is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgProviderCloseContract"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.MsgProviderCloseContract does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgProviderCloseContract) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "arkeo.arkeo.MsgProviderCloseContract.creator":
+		return protoreflect.ValueOfString("")
+	case "arkeo.arkeo.MsgProviderCloseContract.contract_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgProviderCloseContract"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.MsgProviderCloseContract does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgProviderCloseContract) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in arkeo.arkeo.MsgProviderCloseContract", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgProviderCloseContract) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgProviderCloseContract) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgProviderCloseContract) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgProviderCloseContract) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgProviderCloseContract)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Creator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ContractId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ContractId))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgProviderCloseContract)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.ContractId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ContractId))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Creator) > 0 {
+			i -= len(x.Creator)
+			copy(dAtA[i:], x.Creator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgProviderCloseContract)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgProviderCloseContract: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgProviderCloseContract: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Creator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+				}
+				x.ContractId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ContractId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgProviderCloseContractResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_arkeo_arkeo_tx_proto_init()
+	md_MsgProviderCloseContractResponse = File_arkeo_arkeo_tx_proto.Messages().ByName("MsgProviderCloseContractResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgProviderCloseContractResponse)(nil)
+
+type fastReflection_MsgProviderCloseContractResponse MsgProviderCloseContractResponse
+
+func (x *MsgProviderCloseContractResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgProviderCloseContractResponse)(x)
+}
+
+func (x *MsgProviderCloseContractResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_arkeo_arkeo_tx_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgProviderCloseContractResponse_messageType fastReflection_MsgProviderCloseContractResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgProviderCloseContractResponse_messageType{}
+
+type fastReflection_MsgProviderCloseContractResponse_messageType struct{}
+
+func (x fastReflection_MsgProviderCloseContractResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgProviderCloseContractResponse)(nil)
+}
+func (x fastReflection_MsgProviderCloseContractResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgProviderCloseContractResponse)
+}
+func (x fastReflection_MsgProviderCloseContractResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgProviderCloseContractResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgProviderCloseContractResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgProviderCloseContractResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgProviderCloseContractResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgProviderCloseContractResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgProviderCloseContractResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgProviderCloseContractResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgProviderCloseContractResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgProviderCloseContractResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgProviderCloseContractResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgProviderCloseContractResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgProviderCloseContractResponse"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.MsgProviderCloseContractResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgProviderCloseContractResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgProviderCloseContractResponse"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.MsgProviderCloseContractResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgProviderCloseContractResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgProviderCloseContractResponse"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.MsgProviderCloseContractResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgProviderCloseContractResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgProviderCloseContractResponse"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.MsgProviderCloseContractResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgProviderCloseContractResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgProviderCloseContractResponse"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.MsgProviderCloseContractResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgProviderCloseContractResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgProviderCloseContractResponse"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.MsgProviderCloseContractResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgProviderCloseContractResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in arkeo.arkeo.MsgProviderCloseContractResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgProviderCloseContractResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgProviderCloseContractResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgProviderCloseContractResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgProviderCloseContractResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgProviderCloseContractResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgProviderCloseContractResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgProviderCloseContractResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgProviderCloseContractResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgProviderCloseContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgRotateDelegate              protoreflect.MessageDescriptor
+	fd_MsgRotateDelegate_creator      protoreflect.FieldDescriptor
+	fd_MsgRotateDelegate_contract_id  protoreflect.FieldDescriptor
+	fd_MsgRotateDelegate_new_delegate protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_arkeo_arkeo_tx_proto_init()
+	md_MsgRotateDelegate = File_arkeo_arkeo_tx_proto.Messages().ByName("MsgRotateDelegate")
+	fd_MsgRotateDelegate_creator = md_MsgRotateDelegate.Fields().ByName("creator")
+	fd_MsgRotateDelegate_contract_id = md_MsgRotateDelegate.Fields().ByName("contract_id")
+	fd_MsgRotateDelegate_new_delegate = md_MsgRotateDelegate.Fields().ByName("new_delegate")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgRotateDelegate)(nil)
+
+type fastReflection_MsgRotateDelegate MsgRotateDelegate
+
+func (x *MsgRotateDelegate) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgRotateDelegate)(x)
+}
+
+func (x *MsgRotateDelegate) slowProtoReflect() protoreflect.Message {
+	mi := &file_arkeo_arkeo_tx_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgRotateDelegate_messageType fastReflection_MsgRotateDelegate_messageType
+var _ protoreflect.MessageType = fastReflection_MsgRotateDelegate_messageType{}
+
+type fastReflection_MsgRotateDelegate_messageType struct{}
+
+func (x fastReflection_MsgRotateDelegate_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgRotateDelegate)(nil)
+}
+func (x fastReflection_MsgRotateDelegate_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgRotateDelegate)
+}
+func (x fastReflection_MsgRotateDelegate_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRotateDelegate
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgRotateDelegate) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRotateDelegate
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgRotateDelegate) Type() protoreflect.MessageType {
+	return _fastReflection_MsgRotateDelegate_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgRotateDelegate) New() protoreflect.Message {
+	return new(fastReflection_MsgRotateDelegate)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgRotateDelegate) Interface() protoreflect.ProtoMessage {
+	return (*MsgRotateDelegate)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgRotateDelegate) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Creator != "" {
+		value := protoreflect.ValueOfString(x.Creator)
+		if !f(fd_MsgRotateDelegate_creator, value) {
+			return
+		}
+	}
+	if x.ContractId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ContractId)
+		if !f(fd_MsgRotateDelegate_contract_id, value) {
+			return
+		}
+	}
+	if len(x.NewDelegate) != 0 {
+		value := protoreflect.ValueOfBytes(x.NewDelegate)
+		if !f(fd_MsgRotateDelegate_new_delegate, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgRotateDelegate) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "arkeo.arkeo.MsgRotateDelegate.creator":
+		return x.Creator != ""
+	case "arkeo.arkeo.MsgRotateDelegate.contract_id":
+		return x.ContractId != uint64(0)
+	case "arkeo.arkeo.MsgRotateDelegate.new_delegate":
+		return len(x.NewDelegate) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgRotateDelegate"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.MsgRotateDelegate does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRotateDelegate) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "arkeo.arkeo.MsgRotateDelegate.creator":
+		x.Creator = ""
+	case "arkeo.arkeo.MsgRotateDelegate.contract_id":
+		x.ContractId = uint64(0)
+	case "arkeo.arkeo.MsgRotateDelegate.new_delegate":
+		x.NewDelegate = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgRotateDelegate"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.MsgRotateDelegate does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgRotateDelegate) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "arkeo.arkeo.MsgRotateDelegate.creator":
+		value := x.Creator
+		return protoreflect.ValueOfString(value)
+	case "arkeo.arkeo.MsgRotateDelegate.contract_id":
+		value := x.ContractId
+		return protoreflect.ValueOfUint64(value)
+	case "arkeo.arkeo.MsgRotateDelegate.new_delegate":
+		value := x.NewDelegate
+		return protoreflect.ValueOfBytes(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgRotateDelegate"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.MsgRotateDelegate does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRotateDelegate) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "arkeo.arkeo.MsgRotateDelegate.creator":
+		x.Creator = value.Interface().(string)
+	case "arkeo.arkeo.MsgRotateDelegate.contract_id":
+		x.ContractId = value.Uint()
+	case "arkeo.arkeo.MsgRotateDelegate.new_delegate":
+		x.NewDelegate = value.Bytes()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgRotateDelegate"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.MsgRotateDelegate does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRotateDelegate) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "arkeo.arkeo.MsgRotateDelegate.creator":
+		panic(fmt.Errorf("field creator of message arkeo.arkeo.MsgRotateDelegate is not mutable"))
+	case "arkeo.arkeo.MsgRotateDelegate.contract_id":
+		panic(fmt.Errorf("field contract_id of message arkeo.arkeo.MsgRotateDelegate is not mutable"))
+	case "arkeo.arkeo.MsgRotateDelegate.new_delegate":
+		panic(fmt.Errorf("field new_delegate of message arkeo.arkeo.MsgRotateDelegate is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgRotateDelegate"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.MsgRotateDelegate does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgRotateDelegate) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "arkeo.arkeo.MsgRotateDelegate.creator":
+		return protoreflect.ValueOfString("")
+	case "arkeo.arkeo.MsgRotateDelegate.contract_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "arkeo.arkeo.MsgRotateDelegate.new_delegate":
+		return protoreflect.ValueOfBytes(nil)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgRotateDelegate"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.MsgRotateDelegate does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgRotateDelegate) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in arkeo.arkeo.MsgRotateDelegate", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgRotateDelegate) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRotateDelegate) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgRotateDelegate) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgRotateDelegate) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgRotateDelegate)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Creator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ContractId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ContractId))
+		}
+		l = len(x.NewDelegate)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRotateDelegate)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.NewDelegate) > 0 {
+			i -= len(x.NewDelegate)
+			copy(dAtA[i:], x.NewDelegate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NewDelegate)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.ContractId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ContractId))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Creator) > 0 {
+			i -= len(x.Creator)
+			copy(dAtA[i:], x.Creator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRotateDelegate)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRotateDelegate: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRotateDelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Creator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+				}
+				x.ContractId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ContractId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NewDelegate", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.NewDelegate = append(x.NewDelegate[:0], dAtA[iNdEx:postIndex]...)
+				if x.NewDelegate == nil {
+					x.NewDelegate = []byte{}
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgRotateDelegateResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_arkeo_arkeo_tx_proto_init()
+	md_MsgRotateDelegateResponse = File_arkeo_arkeo_tx_proto.Messages().ByName("MsgRotateDelegateResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgRotateDelegateResponse)(nil)
+
+type fastReflection_MsgRotateDelegateResponse MsgRotateDelegateResponse
+
+func (x *MsgRotateDelegateResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgRotateDelegateResponse)(x)
+}
+
+func (x *MsgRotateDelegateResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_arkeo_arkeo_tx_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgRotateDelegateResponse_messageType fastReflection_MsgRotateDelegateResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgRotateDelegateResponse_messageType{}
+
+type fastReflection_MsgRotateDelegateResponse_messageType struct{}
+
+func (x fastReflection_MsgRotateDelegateResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgRotateDelegateResponse)(nil)
+}
+func (x fastReflection_MsgRotateDelegateResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgRotateDelegateResponse)
+}
+func (x fastReflection_MsgRotateDelegateResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRotateDelegateResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgRotateDelegateResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRotateDelegateResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgRotateDelegateResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgRotateDelegateResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgRotateDelegateResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgRotateDelegateResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgRotateDelegateResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgRotateDelegateResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgRotateDelegateResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgRotateDelegateResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgRotateDelegateResponse"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.MsgRotateDelegateResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRotateDelegateResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgRotateDelegateResponse"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.MsgRotateDelegateResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgRotateDelegateResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgRotateDelegateResponse"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.MsgRotateDelegateResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRotateDelegateResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgRotateDelegateResponse"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.MsgRotateDelegateResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRotateDelegateResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgRotateDelegateResponse"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.MsgRotateDelegateResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgRotateDelegateResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgRotateDelegateResponse"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.MsgRotateDelegateResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgRotateDelegateResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in arkeo.arkeo.MsgRotateDelegateResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgRotateDelegateResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRotateDelegateResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgRotateDelegateResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgRotateDelegateResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgRotateDelegateResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRotateDelegateResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRotateDelegateResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRotateDelegateResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRotateDelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgSetAutoRenew             protoreflect.MessageDescriptor
+	fd_MsgSetAutoRenew_creator     protoreflect.FieldDescriptor
+	fd_MsgSetAutoRenew_contract_id protoreflect.FieldDescriptor
+	fd_MsgSetAutoRenew_auto_renew  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_arkeo_arkeo_tx_proto_init()
+	md_MsgSetAutoRenew = File_arkeo_arkeo_tx_proto.Messages().ByName("MsgSetAutoRenew")
+	fd_MsgSetAutoRenew_creator = md_MsgSetAutoRenew.Fields().ByName("creator")
+	fd_MsgSetAutoRenew_contract_id = md_MsgSetAutoRenew.Fields().ByName("contract_id")
+	fd_MsgSetAutoRenew_auto_renew = md_MsgSetAutoRenew.Fields().ByName("auto_renew")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgSetAutoRenew)(nil)
+
+type fastReflection_MsgSetAutoRenew MsgSetAutoRenew
+
+func (x *MsgSetAutoRenew) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgSetAutoRenew)(x)
+}
+
+func (x *MsgSetAutoRenew) slowProtoReflect() protoreflect.Message {
+	mi := &file_arkeo_arkeo_tx_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgSetAutoRenew_messageType fastReflection_MsgSetAutoRenew_messageType
+var _ protoreflect.MessageType = fastReflection_MsgSetAutoRenew_messageType{}
+
+type fastReflection_MsgSetAutoRenew_messageType struct{}
+
+func (x fastReflection_MsgSetAutoRenew_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgSetAutoRenew)(nil)
+}
+func (x fastReflection_MsgSetAutoRenew_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgSetAutoRenew)
+}
+func (x fastReflection_MsgSetAutoRenew_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSetAutoRenew
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgSetAutoRenew) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSetAutoRenew
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgSetAutoRenew) Type() protoreflect.MessageType {
+	return _fastReflection_MsgSetAutoRenew_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgSetAutoRenew) New() protoreflect.Message {
+	return new(fastReflection_MsgSetAutoRenew)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgSetAutoRenew) Interface() protoreflect.ProtoMessage {
+	return (*MsgSetAutoRenew)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgSetAutoRenew) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Creator != "" {
+		value := protoreflect.ValueOfString(x.Creator)
+		if !f(fd_MsgSetAutoRenew_creator, value) {
+			return
+		}
+	}
+	if x.ContractId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ContractId)
+		if !f(fd_MsgSetAutoRenew_contract_id, value) {
+			return
+		}
+	}
+	if x.AutoRenew != false {
+		value := protoreflect.ValueOfBool(x.AutoRenew)
+		if !f(fd_MsgSetAutoRenew_auto_renew, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgSetAutoRenew) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "arkeo.arkeo.MsgSetAutoRenew.creator":
+		return x.Creator != ""
+	case "arkeo.arkeo.MsgSetAutoRenew.contract_id":
+		return x.ContractId != uint64(0)
+	case "arkeo.arkeo.MsgSetAutoRenew.auto_renew":
+		return x.AutoRenew != false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgSetAutoRenew"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.MsgSetAutoRenew does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetAutoRenew) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "arkeo.arkeo.MsgSetAutoRenew.creator":
+		x.Creator = ""
+	case "arkeo.arkeo.MsgSetAutoRenew.contract_id":
+		x.ContractId = uint64(0)
+	case "arkeo.arkeo.MsgSetAutoRenew.auto_renew":
+		x.AutoRenew = false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgSetAutoRenew"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.MsgSetAutoRenew does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgSetAutoRenew) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "arkeo.arkeo.MsgSetAutoRenew.creator":
+		value := x.Creator
+		return protoreflect.ValueOfString(value)
+	case "arkeo.arkeo.MsgSetAutoRenew.contract_id":
+		value := x.ContractId
+		return protoreflect.ValueOfUint64(value)
+	case "arkeo.arkeo.MsgSetAutoRenew.auto_renew":
+		value := x.AutoRenew
+		return protoreflect.ValueOfBool(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgSetAutoRenew"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.MsgSetAutoRenew does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetAutoRenew) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "arkeo.arkeo.MsgSetAutoRenew.creator":
+		x.Creator = value.Interface().(string)
+	case "arkeo.arkeo.MsgSetAutoRenew.contract_id":
+		x.ContractId = value.Uint()
+	case "arkeo.arkeo.MsgSetAutoRenew.auto_renew":
+		x.AutoRenew = value.Bool()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgSetAutoRenew"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.MsgSetAutoRenew does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetAutoRenew) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "arkeo.arkeo.MsgSetAutoRenew.creator":
+		panic(fmt.Errorf("field creator of message arkeo.arkeo.MsgSetAutoRenew is not mutable"))
+	case "arkeo.arkeo.MsgSetAutoRenew.contract_id":
+		panic(fmt.Errorf("field contract_id of message arkeo.arkeo.MsgSetAutoRenew is not mutable"))
+	case "arkeo.arkeo.MsgSetAutoRenew.auto_renew":
+		panic(fmt.Errorf("field auto_renew of message arkeo.arkeo.MsgSetAutoRenew is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgSetAutoRenew"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.MsgSetAutoRenew does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgSetAutoRenew) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "arkeo.arkeo.MsgSetAutoRenew.creator":
+		return protoreflect.ValueOfString("")
+	case "arkeo.arkeo.MsgSetAutoRenew.contract_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "arkeo.arkeo.MsgSetAutoRenew.auto_renew":
+		return protoreflect.ValueOfBool(false)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgSetAutoRenew"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.MsgSetAutoRenew does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgSetAutoRenew) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in arkeo.arkeo.MsgSetAutoRenew", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgSetAutoRenew) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetAutoRenew) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgSetAutoRenew) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgSetAutoRenew) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgSetAutoRenew)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Creator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ContractId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ContractId))
+		}
+		if x.AutoRenew {
+			n += 2
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSetAutoRenew)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.AutoRenew {
+			i--
+			if x.AutoRenew {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.ContractId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ContractId))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Creator) > 0 {
+			i -= len(x.Creator)
+			copy(dAtA[i:], x.Creator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSetAutoRenew)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetAutoRenew: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetAutoRenew: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Creator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+				}
+				x.ContractId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ContractId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AutoRenew", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.AutoRenew = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgSetAutoRenewResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_arkeo_arkeo_tx_proto_init()
+	md_MsgSetAutoRenewResponse = File_arkeo_arkeo_tx_proto.Messages().ByName("MsgSetAutoRenewResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgSetAutoRenewResponse)(nil)
+
+type fastReflection_MsgSetAutoRenewResponse MsgSetAutoRenewResponse
+
+func (x *MsgSetAutoRenewResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgSetAutoRenewResponse)(x)
+}
+
+func (x *MsgSetAutoRenewResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_arkeo_arkeo_tx_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgSetAutoRenewResponse_messageType fastReflection_MsgSetAutoRenewResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgSetAutoRenewResponse_messageType{}
+
+type fastReflection_MsgSetAutoRenewResponse_messageType struct{}
+
+func (x fastReflection_MsgSetAutoRenewResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgSetAutoRenewResponse)(nil)
+}
+func (x fastReflection_MsgSetAutoRenewResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgSetAutoRenewResponse)
+}
+func (x fastReflection_MsgSetAutoRenewResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSetAutoRenewResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgSetAutoRenewResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSetAutoRenewResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgSetAutoRenewResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgSetAutoRenewResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgSetAutoRenewResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgSetAutoRenewResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgSetAutoRenewResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgSetAutoRenewResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgSetAutoRenewResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgSetAutoRenewResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgSetAutoRenewResponse"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.MsgSetAutoRenewResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetAutoRenewResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgSetAutoRenewResponse"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.MsgSetAutoRenewResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgSetAutoRenewResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgSetAutoRenewResponse"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.MsgSetAutoRenewResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetAutoRenewResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgSetAutoRenewResponse"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.MsgSetAutoRenewResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetAutoRenewResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgSetAutoRenewResponse"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.MsgSetAutoRenewResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgSetAutoRenewResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgSetAutoRenewResponse"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.MsgSetAutoRenewResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgSetAutoRenewResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in arkeo.arkeo.MsgSetAutoRenewResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgSetAutoRenewResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetAutoRenewResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgSetAutoRenewResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgSetAutoRenewResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgSetAutoRenewResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSetAutoRenewResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSetAutoRenewResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetAutoRenewResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetAutoRenewResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgTopUpContract             protoreflect.MessageDescriptor
+	fd_MsgTopUpContract_creator     protoreflect.FieldDescriptor
+	fd_MsgTopUpContract_contract_id protoreflect.FieldDescriptor
+	fd_MsgTopUpContract_deposit     protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_arkeo_arkeo_tx_proto_init()
+	md_MsgTopUpContract = File_arkeo_arkeo_tx_proto.Messages().ByName("MsgTopUpContract")
+	fd_MsgTopUpContract_creator = md_MsgTopUpContract.Fields().ByName("creator")
+	fd_MsgTopUpContract_contract_id = md_MsgTopUpContract.Fields().ByName("contract_id")
+	fd_MsgTopUpContract_deposit = md_MsgTopUpContract.Fields().ByName("deposit")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgTopUpContract)(nil)
+
+type fastReflection_MsgTopUpContract MsgTopUpContract
+
+func (x *MsgTopUpContract) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgTopUpContract)(x)
+}
+
+func (x *MsgTopUpContract) slowProtoReflect() protoreflect.Message {
+	mi := &file_arkeo_arkeo_tx_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgTopUpContract_messageType fastReflection_MsgTopUpContract_messageType
+var _ protoreflect.MessageType = fastReflection_MsgTopUpContract_messageType{}
+
+type fastReflection_MsgTopUpContract_messageType struct{}
+
+func (x fastReflection_MsgTopUpContract_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgTopUpContract)(nil)
+}
+func (x fastReflection_MsgTopUpContract_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgTopUpContract)
+}
+func (x fastReflection_MsgTopUpContract_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgTopUpContract
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgTopUpContract) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgTopUpContract
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgTopUpContract) Type() protoreflect.MessageType {
+	return _fastReflection_MsgTopUpContract_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgTopUpContract) New() protoreflect.Message {
+	return new(fastReflection_MsgTopUpContract)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgTopUpContract) Interface() protoreflect.ProtoMessage {
+	return (*MsgTopUpContract)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgTopUpContract) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Creator != "" {
+		value := protoreflect.ValueOfString(x.Creator)
+		if !f(fd_MsgTopUpContract_creator, value) {
+			return
+		}
+	}
+	if x.ContractId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ContractId)
+		if !f(fd_MsgTopUpContract_contract_id, value) {
+			return
+		}
+	}
+	if x.Deposit != "" {
+		value := protoreflect.ValueOfString(x.Deposit)
+		if !f(fd_MsgTopUpContract_deposit, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgTopUpContract) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "arkeo.arkeo.MsgTopUpContract.creator":
+		return x.Creator != ""
+	case "arkeo.arkeo.MsgTopUpContract.contract_id":
+		return x.ContractId != uint64(0)
+	case "arkeo.arkeo.MsgTopUpContract.deposit":
+		return x.Deposit != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgTopUpContract"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.MsgTopUpContract does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgTopUpContract) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "arkeo.arkeo.MsgTopUpContract.creator":
+		x.Creator = ""
+	case "arkeo.arkeo.MsgTopUpContract.contract_id":
+		x.ContractId = uint64(0)
+	case "arkeo.arkeo.MsgTopUpContract.deposit":
+		x.Deposit = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgTopUpContract"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.MsgTopUpContract does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgTopUpContract) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "arkeo.arkeo.MsgTopUpContract.creator":
+		value := x.Creator
+		return protoreflect.ValueOfString(value)
+	case "arkeo.arkeo.MsgTopUpContract.contract_id":
+		value := x.ContractId
+		return protoreflect.ValueOfUint64(value)
+	case "arkeo.arkeo.MsgTopUpContract.deposit":
+		value := x.Deposit
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgTopUpContract"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.MsgTopUpContract does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgTopUpContract) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "arkeo.arkeo.MsgTopUpContract.creator":
+		x.Creator = value.Interface().(string)
+	case "arkeo.arkeo.MsgTopUpContract.contract_id":
+		x.ContractId = value.Uint()
+	case "arkeo.arkeo.MsgTopUpContract.deposit":
+		x.Deposit = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgTopUpContract"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.MsgTopUpContract does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgTopUpContract) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "arkeo.arkeo.MsgTopUpContract.creator":
+		panic(fmt.Errorf("field creator of message arkeo.arkeo.MsgTopUpContract is not mutable"))
+	case "arkeo.arkeo.MsgTopUpContract.contract_id":
+		panic(fmt.Errorf("field contract_id of message arkeo.arkeo.MsgTopUpContract is not mutable"))
+	case "arkeo.arkeo.MsgTopUpContract.deposit":
+		panic(fmt.Errorf("field deposit of message arkeo.arkeo.MsgTopUpContract is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgTopUpContract"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.MsgTopUpContract does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgTopUpContract) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "arkeo.arkeo.MsgTopUpContract.creator":
+		return protoreflect.ValueOfString("")
+	case "arkeo.arkeo.MsgTopUpContract.contract_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "arkeo.arkeo.MsgTopUpContract.deposit":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgTopUpContract"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.MsgTopUpContract does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgTopUpContract) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in arkeo.arkeo.MsgTopUpContract", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgTopUpContract) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgTopUpContract) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgTopUpContract) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgTopUpContract) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgTopUpContract)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Creator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ContractId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ContractId))
+		}
+		l = len(x.Deposit)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgTopUpContract)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Deposit) > 0 {
+			i -= len(x.Deposit)
+			copy(dAtA[i:], x.Deposit)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Deposit)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.ContractId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ContractId))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Creator) > 0 {
+			i -= len(x.Creator)
+			copy(dAtA[i:], x.Creator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgTopUpContract)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgTopUpContract: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgTopUpContract: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Creator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+				}
+				x.ContractId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ContractId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Deposit = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgTopUpContractResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_arkeo_arkeo_tx_proto_init()
+	md_MsgTopUpContractResponse = File_arkeo_arkeo_tx_proto.Messages().ByName("MsgTopUpContractResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgTopUpContractResponse)(nil)
+
+type fastReflection_MsgTopUpContractResponse MsgTopUpContractResponse
+
+func (x *MsgTopUpContractResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgTopUpContractResponse)(x)
+}
+
+func (x *MsgTopUpContractResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_arkeo_arkeo_tx_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgTopUpContractResponse_messageType fastReflection_MsgTopUpContractResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgTopUpContractResponse_messageType{}
+
+type fastReflection_MsgTopUpContractResponse_messageType struct{}
+
+func (x fastReflection_MsgTopUpContractResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgTopUpContractResponse)(nil)
+}
+func (x fastReflection_MsgTopUpContractResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgTopUpContractResponse)
+}
+func (x fastReflection_MsgTopUpContractResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgTopUpContractResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgTopUpContractResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgTopUpContractResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgTopUpContractResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgTopUpContractResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgTopUpContractResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgTopUpContractResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgTopUpContractResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgTopUpContractResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgTopUpContractResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgTopUpContractResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgTopUpContractResponse"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.MsgTopUpContractResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgTopUpContractResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgTopUpContractResponse"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.MsgTopUpContractResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgTopUpContractResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgTopUpContractResponse"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.MsgTopUpContractResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgTopUpContractResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgTopUpContractResponse"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.MsgTopUpContractResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgTopUpContractResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgTopUpContractResponse"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.MsgTopUpContractResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgTopUpContractResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgTopUpContractResponse"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.MsgTopUpContractResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgTopUpContractResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in arkeo.arkeo.MsgTopUpContractResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgTopUpContractResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgTopUpContractResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgTopUpContractResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgTopUpContractResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgTopUpContractResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgTopUpContractResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgTopUpContractResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgTopUpContractResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgTopUpContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_ContractClaim             protoreflect.MessageDescriptor
+	fd_ContractClaim_contract_id protoreflect.FieldDescriptor
+	fd_ContractClaim_nonce       protoreflect.FieldDescriptor
+	fd_ContractClaim_signature   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_arkeo_arkeo_tx_proto_init()
+	md_ContractClaim = File_arkeo_arkeo_tx_proto.Messages().ByName("ContractClaim")
+	fd_ContractClaim_contract_id = md_ContractClaim.Fields().ByName("contract_id")
+	fd_ContractClaim_nonce = md_ContractClaim.Fields().ByName("nonce")
+	fd_ContractClaim_signature = md_ContractClaim.Fields().ByName("signature")
+}
+
+var _ protoreflect.Message = (*fastReflection_ContractClaim)(nil)
+
+type fastReflection_ContractClaim ContractClaim
+
+func (x *ContractClaim) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ContractClaim)(x)
+}
+
+func (x *ContractClaim) slowProtoReflect() protoreflect.Message {
+	mi := &file_arkeo_arkeo_tx_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ContractClaim_messageType fastReflection_ContractClaim_messageType
+var _ protoreflect.MessageType = fastReflection_ContractClaim_messageType{}
+
+type fastReflection_ContractClaim_messageType struct{}
+
+func (x fastReflection_ContractClaim_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ContractClaim)(nil)
+}
+func (x fastReflection_ContractClaim_messageType) New() protoreflect.Message {
+	return new(fastReflection_ContractClaim)
+}
+func (x fastReflection_ContractClaim_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ContractClaim
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ContractClaim) Descriptor() protoreflect.MessageDescriptor {
+	return md_ContractClaim
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ContractClaim) Type() protoreflect.MessageType {
+	return _fastReflection_ContractClaim_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ContractClaim) New() protoreflect.Message {
+	return new(fastReflection_ContractClaim)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ContractClaim) Interface() protoreflect.ProtoMessage {
+	return (*ContractClaim)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ContractClaim) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ContractId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ContractId)
+		if !f(fd_ContractClaim_contract_id, value) {
+			return
+		}
+	}
+	if x.Nonce != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Nonce)
+		if !f(fd_ContractClaim_nonce, value) {
+			return
+		}
+	}
+	if len(x.Signature) != 0 {
+		value := protoreflect.ValueOfBytes(x.Signature)
+		if !f(fd_ContractClaim_signature, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ContractClaim) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "arkeo.arkeo.ContractClaim.contract_id":
+		return x.ContractId != uint64(0)
+	case "arkeo.arkeo.ContractClaim.nonce":
+		return x.Nonce != int64(0)
+	case "arkeo.arkeo.ContractClaim.signature":
+		return len(x.Signature) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.ContractClaim"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.ContractClaim does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ContractClaim) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "arkeo.arkeo.ContractClaim.contract_id":
+		x.ContractId = uint64(0)
+	case "arkeo.arkeo.ContractClaim.nonce":
+		x.Nonce = int64(0)
+	case "arkeo.arkeo.ContractClaim.signature":
+		x.Signature = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.ContractClaim"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.ContractClaim does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ContractClaim) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "arkeo.arkeo.ContractClaim.contract_id":
+		value := x.ContractId
+		return protoreflect.ValueOfUint64(value)
+	case "arkeo.arkeo.ContractClaim.nonce":
+		value := x.Nonce
+		return protoreflect.ValueOfInt64(value)
+	case "arkeo.arkeo.ContractClaim.signature":
+		value := x.Signature
+		return protoreflect.ValueOfBytes(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.ContractClaim"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.ContractClaim does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ContractClaim) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "arkeo.arkeo.ContractClaim.contract_id":
+		x.ContractId = value.Uint()
+	case "arkeo.arkeo.ContractClaim.nonce":
+		x.Nonce = value.Int()
+	case "arkeo.arkeo.ContractClaim.signature":
+		x.Signature = value.Bytes()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.ContractClaim"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.ContractClaim does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ContractClaim) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "arkeo.arkeo.ContractClaim.contract_id":
+		panic(fmt.Errorf("field contract_id of message arkeo.arkeo.ContractClaim is not mutable"))
+	case "arkeo.arkeo.ContractClaim.nonce":
+		panic(fmt.Errorf("field nonce of message arkeo.arkeo.ContractClaim is not mutable"))
+	case "arkeo.arkeo.ContractClaim.signature":
+		panic(fmt.Errorf("field signature of message arkeo.arkeo.ContractClaim is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.ContractClaim"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.ContractClaim does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ContractClaim) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "arkeo.arkeo.ContractClaim.contract_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "arkeo.arkeo.ContractClaim.nonce":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "arkeo.arkeo.ContractClaim.signature":
+		return protoreflect.ValueOfBytes(nil)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.ContractClaim"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.ContractClaim does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ContractClaim) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in arkeo.arkeo.ContractClaim", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ContractClaim) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ContractClaim) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ContractClaim) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ContractClaim) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ContractClaim)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.ContractId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ContractId))
+		}
+		if x.Nonce != 0 {
+			n += 1 + runtime.Sov(uint64(x.Nonce))
+		}
+		l = len(x.Signature)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ContractClaim)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Signature) > 0 {
+			i -= len(x.Signature)
+			copy(dAtA[i:], x.Signature)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Signature)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.Nonce != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Nonce))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.ContractId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ContractId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ContractClaim)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ContractClaim: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ContractClaim: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+				}
+				x.ContractId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ContractId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+				}
+				x.Nonce = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Nonce |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Signature = append(x.Signature[:0], dAtA[iNdEx:postIndex]...)
+				if x.Signature == nil {
+					x.Signature = []byte{}
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_MsgClaimContractIncomeBatch_2_list)(nil)
+
+type _MsgClaimContractIncomeBatch_2_list struct {
+	list *[]*ContractClaim
+}
+
+func (x *_MsgClaimContractIncomeBatch_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgClaimContractIncomeBatch_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MsgClaimContractIncomeBatch_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ContractClaim)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgClaimContractIncomeBatch_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ContractClaim)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgClaimContractIncomeBatch_2_list) AppendMutable() protoreflect.Value {
+	v := new(ContractClaim)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgClaimContractIncomeBatch_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgClaimContractIncomeBatch_2_list) NewElement() protoreflect.Value {
+	v := new(ContractClaim)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgClaimContractIncomeBatch_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_MsgClaimContractIncomeBatch         protoreflect.MessageDescriptor
+	fd_MsgClaimContractIncomeBatch_creator protoreflect.FieldDescriptor
+	fd_MsgClaimContractIncomeBatch_claims  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_arkeo_arkeo_tx_proto_init()
+	md_MsgClaimContractIncomeBatch = File_arkeo_arkeo_tx_proto.Messages().ByName("MsgClaimContractIncomeBatch")
+	fd_MsgClaimContractIncomeBatch_creator = md_MsgClaimContractIncomeBatch.Fields().ByName("creator")
+	fd_MsgClaimContractIncomeBatch_claims = md_MsgClaimContractIncomeBatch.Fields().ByName("claims")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgClaimContractIncomeBatch)(nil)
+
+type fastReflection_MsgClaimContractIncomeBatch MsgClaimContractIncomeBatch
+
+func (x *MsgClaimContractIncomeBatch) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgClaimContractIncomeBatch)(x)
+}
+
+func (x *MsgClaimContractIncomeBatch) slowProtoReflect() protoreflect.Message {
+	mi := &file_arkeo_arkeo_tx_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgClaimContractIncomeBatch_messageType fastReflection_MsgClaimContractIncomeBatch_messageType
+var _ protoreflect.MessageType = fastReflection_MsgClaimContractIncomeBatch_messageType{}
+
+type fastReflection_MsgClaimContractIncomeBatch_messageType struct{}
+
+func (x fastReflection_MsgClaimContractIncomeBatch_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgClaimContractIncomeBatch)(nil)
+}
+func (x fastReflection_MsgClaimContractIncomeBatch_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgClaimContractIncomeBatch)
+}
+func (x fastReflection_MsgClaimContractIncomeBatch_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgClaimContractIncomeBatch
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgClaimContractIncomeBatch) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgClaimContractIncomeBatch
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgClaimContractIncomeBatch) Type() protoreflect.MessageType {
+	return _fastReflection_MsgClaimContractIncomeBatch_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgClaimContractIncomeBatch) New() protoreflect.Message {
+	return new(fastReflection_MsgClaimContractIncomeBatch)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgClaimContractIncomeBatch) Interface() protoreflect.ProtoMessage {
+	return (*MsgClaimContractIncomeBatch)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgClaimContractIncomeBatch) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Creator != "" {
+		value := protoreflect.ValueOfString(x.Creator)
+		if !f(fd_MsgClaimContractIncomeBatch_creator, value) {
+			return
+		}
+	}
+	if len(x.Claims) != 0 {
+		value := protoreflect.ValueOfList(&_MsgClaimContractIncomeBatch_2_list{list: &x.Claims})
+		if !f(fd_MsgClaimContractIncomeBatch_claims, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgClaimContractIncomeBatch) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "arkeo.arkeo.MsgClaimContractIncomeBatch.creator":
+		return x.Creator != ""
+	case "arkeo.arkeo.MsgClaimContractIncomeBatch.claims":
+		return len(x.Claims) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgClaimContractIncomeBatch"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.MsgClaimContractIncomeBatch does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgClaimContractIncomeBatch) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "arkeo.arkeo.MsgClaimContractIncomeBatch.creator":
+		x.Creator = ""
+	case "arkeo.arkeo.MsgClaimContractIncomeBatch.claims":
+		x.Claims = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgClaimContractIncomeBatch"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.MsgClaimContractIncomeBatch does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgClaimContractIncomeBatch) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "arkeo.arkeo.MsgClaimContractIncomeBatch.creator":
+		value := x.Creator
+		return protoreflect.ValueOfString(value)
+	case "arkeo.arkeo.MsgClaimContractIncomeBatch.claims":
+		if len(x.Claims) == 0 {
+			return protoreflect.ValueOfList(&_MsgClaimContractIncomeBatch_2_list{})
+		}
+		listValue := &_MsgClaimContractIncomeBatch_2_list{list: &x.Claims}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgClaimContractIncomeBatch"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.MsgClaimContractIncomeBatch does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgClaimContractIncomeBatch) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "arkeo.arkeo.MsgClaimContractIncomeBatch.creator":
+		x.Creator = value.Interface().(string)
+	case "arkeo.arkeo.MsgClaimContractIncomeBatch.claims":
+		lv := value.List()
+		clv := lv.(*_MsgClaimContractIncomeBatch_2_list)
+		x.Claims = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgClaimContractIncomeBatch"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.MsgClaimContractIncomeBatch does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgClaimContractIncomeBatch) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "arkeo.arkeo.MsgClaimContractIncomeBatch.claims":
+		if x.Claims == nil {
+			x.Claims = []*ContractClaim{}
+		}
+		value := &_MsgClaimContractIncomeBatch_2_list{list: &x.Claims}
+		return protoreflect.ValueOfList(value)
+	case "arkeo.arkeo.MsgClaimContractIncomeBatch.creator":
+		panic(fmt.Errorf("field creator of message arkeo.arkeo.MsgClaimContractIncomeBatch is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgClaimContractIncomeBatch"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.MsgClaimContractIncomeBatch does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgClaimContractIncomeBatch) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "arkeo.arkeo.MsgClaimContractIncomeBatch.creator":
+		return protoreflect.ValueOfString("")
+	case "arkeo.arkeo.MsgClaimContractIncomeBatch.claims":
+		list := []*ContractClaim{}
+		return protoreflect.ValueOfList(&_MsgClaimContractIncomeBatch_2_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgClaimContractIncomeBatch"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.MsgClaimContractIncomeBatch does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgClaimContractIncomeBatch) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in arkeo.arkeo.MsgClaimContractIncomeBatch", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgClaimContractIncomeBatch) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgClaimContractIncomeBatch) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgClaimContractIncomeBatch) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgClaimContractIncomeBatch) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgClaimContractIncomeBatch)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Creator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Claims) > 0 {
+			for _, e := range x.Claims {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgClaimContractIncomeBatch)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Claims) > 0 {
+			for iNdEx := len(x.Claims) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Claims[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if len(x.Creator) > 0 {
+			i -= len(x.Creator)
+			copy(dAtA[i:], x.Creator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgClaimContractIncomeBatch)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgClaimContractIncomeBatch: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgClaimContractIncomeBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Creator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Claims", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Claims = append(x.Claims, &ContractClaim{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Claims[len(x.Claims)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_MsgClaimContractIncomeBatchResponse_1_list)(nil)
+
+type _MsgClaimContractIncomeBatchResponse_1_list struct {
+	list *[]*ContractClaimResult
+}
+
+func (x *_MsgClaimContractIncomeBatchResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgClaimContractIncomeBatchResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MsgClaimContractIncomeBatchResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ContractClaimResult)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgClaimContractIncomeBatchResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ContractClaimResult)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgClaimContractIncomeBatchResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(ContractClaimResult)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgClaimContractIncomeBatchResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgClaimContractIncomeBatchResponse_1_list) NewElement() protoreflect.Value {
+	v := new(ContractClaimResult)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgClaimContractIncomeBatchResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_MsgClaimContractIncomeBatchResponse         protoreflect.MessageDescriptor
+	fd_MsgClaimContractIncomeBatchResponse_results protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_arkeo_arkeo_tx_proto_init()
+	md_MsgClaimContractIncomeBatchResponse = File_arkeo_arkeo_tx_proto.Messages().ByName("MsgClaimContractIncomeBatchResponse")
+	fd_MsgClaimContractIncomeBatchResponse_results = md_MsgClaimContractIncomeBatchResponse.Fields().ByName("results")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgClaimContractIncomeBatchResponse)(nil)
+
+type fastReflection_MsgClaimContractIncomeBatchResponse MsgClaimContractIncomeBatchResponse
+
+func (x *MsgClaimContractIncomeBatchResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgClaimContractIncomeBatchResponse)(x)
+}
+
+func (x *MsgClaimContractIncomeBatchResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_arkeo_arkeo_tx_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgClaimContractIncomeBatchResponse_messageType fastReflection_MsgClaimContractIncomeBatchResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgClaimContractIncomeBatchResponse_messageType{}
+
+type fastReflection_MsgClaimContractIncomeBatchResponse_messageType struct{}
+
+func (x fastReflection_MsgClaimContractIncomeBatchResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgClaimContractIncomeBatchResponse)(nil)
+}
+func (x fastReflection_MsgClaimContractIncomeBatchResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgClaimContractIncomeBatchResponse)
+}
+func (x fastReflection_MsgClaimContractIncomeBatchResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgClaimContractIncomeBatchResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgClaimContractIncomeBatchResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgClaimContractIncomeBatchResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgClaimContractIncomeBatchResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgClaimContractIncomeBatchResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgClaimContractIncomeBatchResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgClaimContractIncomeBatchResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgClaimContractIncomeBatchResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgClaimContractIncomeBatchResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgClaimContractIncomeBatchResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Results) != 0 {
+		value := protoreflect.ValueOfList(&_MsgClaimContractIncomeBatchResponse_1_list{list: &x.Results})
+		if !f(fd_MsgClaimContractIncomeBatchResponse_results, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgClaimContractIncomeBatchResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "arkeo.arkeo.MsgClaimContractIncomeBatchResponse.results":
+		return len(x.Results) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgClaimContractIncomeBatchResponse"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.MsgClaimContractIncomeBatchResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgClaimContractIncomeBatchResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "arkeo.arkeo.MsgClaimContractIncomeBatchResponse.results":
+		x.Results = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgClaimContractIncomeBatchResponse"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.MsgClaimContractIncomeBatchResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgClaimContractIncomeBatchResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "arkeo.arkeo.MsgClaimContractIncomeBatchResponse.results":
+		if len(x.Results) == 0 {
+			return protoreflect.ValueOfList(&_MsgClaimContractIncomeBatchResponse_1_list{})
+		}
+		listValue := &_MsgClaimContractIncomeBatchResponse_1_list{list: &x.Results}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgClaimContractIncomeBatchResponse"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.MsgClaimContractIncomeBatchResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgClaimContractIncomeBatchResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "arkeo.arkeo.MsgClaimContractIncomeBatchResponse.results":
+		lv := value.List()
+		clv := lv.(*_MsgClaimContractIncomeBatchResponse_1_list)
+		x.Results = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgClaimContractIncomeBatchResponse"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.MsgClaimContractIncomeBatchResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgClaimContractIncomeBatchResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "arkeo.arkeo.MsgClaimContractIncomeBatchResponse.results":
+		if x.Results == nil {
+			x.Results = []*ContractClaimResult{}
+		}
+		value := &_MsgClaimContractIncomeBatchResponse_1_list{list: &x.Results}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgClaimContractIncomeBatchResponse"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.MsgClaimContractIncomeBatchResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgClaimContractIncomeBatchResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "arkeo.arkeo.MsgClaimContractIncomeBatchResponse.results":
+		list := []*ContractClaimResult{}
+		return protoreflect.ValueOfList(&_MsgClaimContractIncomeBatchResponse_1_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgClaimContractIncomeBatchResponse"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.MsgClaimContractIncomeBatchResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgClaimContractIncomeBatchResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in arkeo.arkeo.MsgClaimContractIncomeBatchResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgClaimContractIncomeBatchResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgClaimContractIncomeBatchResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgClaimContractIncomeBatchResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgClaimContractIncomeBatchResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgClaimContractIncomeBatchResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Results) > 0 {
+			for _, e := range x.Results {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgClaimContractIncomeBatchResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Results) > 0 {
+			for iNdEx := len(x.Results) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Results[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgClaimContractIncomeBatchResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgClaimContractIncomeBatchResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgClaimContractIncomeBatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Results = append(x.Results, &ContractClaimResult{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Results[len(x.Results)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_MsgPurgeExpiredContracts_2_list)(nil)
+
+type _MsgPurgeExpiredContracts_2_list struct {
+	list *[]uint64
+}
+
+func (x *_MsgPurgeExpiredContracts_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgPurgeExpiredContracts_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfUint64((*x.list)[i])
+}
+
+func (x *_MsgPurgeExpiredContracts_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgPurgeExpiredContracts_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgPurgeExpiredContracts_2_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message MsgPurgeExpiredContracts at list field ContractIds as it is not of Message kind"))
+}
+
+func (x *_MsgPurgeExpiredContracts_2_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgPurgeExpiredContracts_2_list) NewElement() protoreflect.Value {
+	v := uint64(0)
+	return protoreflect.ValueOfUint64(v)
+}
+
+func (x *_MsgPurgeExpiredContracts_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_MsgPurgeExpiredContracts              protoreflect.MessageDescriptor
+	fd_MsgPurgeExpiredContracts_creator      protoreflect.FieldDescriptor
+	fd_MsgPurgeExpiredContracts_contract_ids protoreflect.FieldDescriptor
+	fd_MsgPurgeExpiredContracts_limit        protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_arkeo_arkeo_tx_proto_init()
+	md_MsgPurgeExpiredContracts = File_arkeo_arkeo_tx_proto.Messages().ByName("MsgPurgeExpiredContracts")
+	fd_MsgPurgeExpiredContracts_creator = md_MsgPurgeExpiredContracts.Fields().ByName("creator")
+	fd_MsgPurgeExpiredContracts_contract_ids = md_MsgPurgeExpiredContracts.Fields().ByName("contract_ids")
+	fd_MsgPurgeExpiredContracts_limit = md_MsgPurgeExpiredContracts.Fields().ByName("limit")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgPurgeExpiredContracts)(nil)
+
+type fastReflection_MsgPurgeExpiredContracts MsgPurgeExpiredContracts
+
+func (x *MsgPurgeExpiredContracts) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgPurgeExpiredContracts)(x)
+}
+
+func (x *MsgPurgeExpiredContracts) slowProtoReflect() protoreflect.Message {
+	mi := &file_arkeo_arkeo_tx_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgPurgeExpiredContracts_messageType fastReflection_MsgPurgeExpiredContracts_messageType
+var _ protoreflect.MessageType = fastReflection_MsgPurgeExpiredContracts_messageType{}
+
+type fastReflection_MsgPurgeExpiredContracts_messageType struct{}
+
+func (x fastReflection_MsgPurgeExpiredContracts_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgPurgeExpiredContracts)(nil)
+}
+func (x fastReflection_MsgPurgeExpiredContracts_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgPurgeExpiredContracts)
+}
+func (x fastReflection_MsgPurgeExpiredContracts_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgPurgeExpiredContracts
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgPurgeExpiredContracts) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgPurgeExpiredContracts
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgPurgeExpiredContracts) Type() protoreflect.MessageType {
+	return _fastReflection_MsgPurgeExpiredContracts_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgPurgeExpiredContracts) New() protoreflect.Message {
+	return new(fastReflection_MsgPurgeExpiredContracts)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgPurgeExpiredContracts) Interface() protoreflect.ProtoMessage {
+	return (*MsgPurgeExpiredContracts)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgPurgeExpiredContracts) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Creator != "" {
+		value := protoreflect.ValueOfString(x.Creator)
+		if !f(fd_MsgPurgeExpiredContracts_creator, value) {
+			return
+		}
+	}
+	if len(x.ContractIds) != 0 {
+		value := protoreflect.ValueOfList(&_MsgPurgeExpiredContracts_2_list{list: &x.ContractIds})
+		if !f(fd_MsgPurgeExpiredContracts_contract_ids, value) {
+			return
+		}
+	}
+	if x.Limit != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Limit)
+		if !f(fd_MsgPurgeExpiredContracts_limit, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgPurgeExpiredContracts) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "arkeo.arkeo.MsgPurgeExpiredContracts.creator":
+		return x.Creator != ""
+	case "arkeo.arkeo.MsgPurgeExpiredContracts.contract_ids":
+		return len(x.ContractIds) != 0
+	case "arkeo.arkeo.MsgPurgeExpiredContracts.limit":
+		return x.Limit != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgPurgeExpiredContracts"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.MsgPurgeExpiredContracts does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgPurgeExpiredContracts) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "arkeo.arkeo.MsgPurgeExpiredContracts.creator":
+		x.Creator = ""
+	case "arkeo.arkeo.MsgPurgeExpiredContracts.contract_ids":
+		x.ContractIds = nil
+	case "arkeo.arkeo.MsgPurgeExpiredContracts.limit":
+		x.Limit = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgPurgeExpiredContracts"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.MsgPurgeExpiredContracts does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgPurgeExpiredContracts) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "arkeo.arkeo.MsgPurgeExpiredContracts.creator":
+		value := x.Creator
+		return protoreflect.ValueOfString(value)
+	case "arkeo.arkeo.MsgPurgeExpiredContracts.contract_ids":
+		if len(x.ContractIds) == 0 {
+			return protoreflect.ValueOfList(&_MsgPurgeExpiredContracts_2_list{})
+		}
+		listValue := &_MsgPurgeExpiredContracts_2_list{list: &x.ContractIds}
+		return protoreflect.ValueOfList(listValue)
+	case "arkeo.arkeo.MsgPurgeExpiredContracts.limit":
+		value := x.Limit
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgPurgeExpiredContracts"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.MsgPurgeExpiredContracts does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgPurgeExpiredContracts) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "arkeo.arkeo.MsgPurgeExpiredContracts.creator":
+		x.Creator = value.Interface().(string)
+	case "arkeo.arkeo.MsgPurgeExpiredContracts.contract_ids":
+		lv := value.List()
+		clv := lv.(*_MsgPurgeExpiredContracts_2_list)
+		x.ContractIds = *clv.list
+	case "arkeo.arkeo.MsgPurgeExpiredContracts.limit":
+		x.Limit = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgPurgeExpiredContracts"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.MsgPurgeExpiredContracts does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgPurgeExpiredContracts) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "arkeo.arkeo.MsgPurgeExpiredContracts.contract_ids":
+		if x.ContractIds == nil {
+			x.ContractIds = []uint64{}
+		}
+		value := &_MsgPurgeExpiredContracts_2_list{list: &x.ContractIds}
+		return protoreflect.ValueOfList(value)
+	case "arkeo.arkeo.MsgPurgeExpiredContracts.creator":
+		panic(fmt.Errorf("field creator of message arkeo.arkeo.MsgPurgeExpiredContracts is not mutable"))
+	case "arkeo.arkeo.MsgPurgeExpiredContracts.limit":
+		panic(fmt.Errorf("field limit of message arkeo.arkeo.MsgPurgeExpiredContracts is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgPurgeExpiredContracts"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.MsgPurgeExpiredContracts does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgPurgeExpiredContracts) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "arkeo.arkeo.MsgPurgeExpiredContracts.creator":
+		return protoreflect.ValueOfString("")
+	case "arkeo.arkeo.MsgPurgeExpiredContracts.contract_ids":
+		list := []uint64{}
+		return protoreflect.ValueOfList(&_MsgPurgeExpiredContracts_2_list{list: &list})
+	case "arkeo.arkeo.MsgPurgeExpiredContracts.limit":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgPurgeExpiredContracts"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.MsgPurgeExpiredContracts does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgPurgeExpiredContracts) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in arkeo.arkeo.MsgPurgeExpiredContracts", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgPurgeExpiredContracts) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgPurgeExpiredContracts) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgPurgeExpiredContracts) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgPurgeExpiredContracts) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgPurgeExpiredContracts)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Creator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.ContractIds) > 0 {
+			l = 0
+			for _, e := range x.ContractIds {
+				l += runtime.Sov(uint64(e))
+			}
+			n += 1 + runtime.Sov(uint64(l)) + l
+		}
+		if x.Limit != 0 {
+			n += 1 + runtime.Sov(uint64(x.Limit))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgPurgeExpiredContracts)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Limit != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Limit))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.ContractIds) > 0 {
+			var pksize2 int
+			for _, num := range x.ContractIds {
+				pksize2 += runtime.Sov(uint64(num))
+			}
+			i -= pksize2
+			j1 := i
+			for _, num := range x.ContractIds {
+				for num >= 1<<7 {
+					dAtA[j1] = uint8(uint64(num)&0x7f | 0x80)
+					num >>= 7
+					j1++
+				}
+				dAtA[j1] = uint8(num)
+				j1++
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(pksize2))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Creator) > 0 {
+			i -= len(x.Creator)
+			copy(dAtA[i:], x.Creator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgPurgeExpiredContracts)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgPurgeExpiredContracts: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgPurgeExpiredContracts: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Creator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType == 0 {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					x.ContractIds = append(x.ContractIds, v)
+				} else if wireType == 2 {
+					var packedLen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						packedLen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if packedLen < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postIndex := iNdEx + packedLen
+					if postIndex < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postIndex > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					var elementCount int
+					var count int
+					for _, integer := range dAtA[iNdEx:postIndex] {
+						if integer < 128 {
+							count++
+						}
+					}
+					elementCount = count
+					if elementCount != 0 && len(x.ContractIds) == 0 {
+						x.ContractIds = make([]uint64, 0, elementCount)
+					}
+					for iNdEx < postIndex {
+						var v uint64
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							v |= uint64(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						x.ContractIds = append(x.ContractIds, v)
+					}
+				} else {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ContractIds", wireType)
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+				}
+				x.Limit = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Limit |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_MsgPurgeExpiredContractsResponse_1_list)(nil)
+
+type _MsgPurgeExpiredContractsResponse_1_list struct {
+	list *[]*ContractPurgeResult
+}
+
+func (x *_MsgPurgeExpiredContractsResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgPurgeExpiredContractsResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MsgPurgeExpiredContractsResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ContractPurgeResult)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgPurgeExpiredContractsResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ContractPurgeResult)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgPurgeExpiredContractsResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(ContractPurgeResult)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgPurgeExpiredContractsResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgPurgeExpiredContractsResponse_1_list) NewElement() protoreflect.Value {
+	v := new(ContractPurgeResult)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgPurgeExpiredContractsResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_MsgPurgeExpiredContractsResponse         protoreflect.MessageDescriptor
+	fd_MsgPurgeExpiredContractsResponse_results protoreflect.FieldDescriptor
+	fd_MsgPurgeExpiredContractsResponse_reward  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_arkeo_arkeo_tx_proto_init()
+	md_MsgPurgeExpiredContractsResponse = File_arkeo_arkeo_tx_proto.Messages().ByName("MsgPurgeExpiredContractsResponse")
+	fd_MsgPurgeExpiredContractsResponse_results = md_MsgPurgeExpiredContractsResponse.Fields().ByName("results")
+	fd_MsgPurgeExpiredContractsResponse_reward = md_MsgPurgeExpiredContractsResponse.Fields().ByName("reward")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgPurgeExpiredContractsResponse)(nil)
+
+type fastReflection_MsgPurgeExpiredContractsResponse MsgPurgeExpiredContractsResponse
+
+func (x *MsgPurgeExpiredContractsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgPurgeExpiredContractsResponse)(x)
+}
+
+func (x *MsgPurgeExpiredContractsResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_arkeo_arkeo_tx_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgPurgeExpiredContractsResponse_messageType fastReflection_MsgPurgeExpiredContractsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgPurgeExpiredContractsResponse_messageType{}
+
+type fastReflection_MsgPurgeExpiredContractsResponse_messageType struct{}
+
+func (x fastReflection_MsgPurgeExpiredContractsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgPurgeExpiredContractsResponse)(nil)
+}
+func (x fastReflection_MsgPurgeExpiredContractsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgPurgeExpiredContractsResponse)
+}
+func (x fastReflection_MsgPurgeExpiredContractsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgPurgeExpiredContractsResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgPurgeExpiredContractsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgPurgeExpiredContractsResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgPurgeExpiredContractsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgPurgeExpiredContractsResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgPurgeExpiredContractsResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgPurgeExpiredContractsResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgPurgeExpiredContractsResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgPurgeExpiredContractsResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgPurgeExpiredContractsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Results) != 0 {
+		value := protoreflect.ValueOfList(&_MsgPurgeExpiredContractsResponse_1_list{list: &x.Results})
+		if !f(fd_MsgPurgeExpiredContractsResponse_results, value) {
+			return
+		}
+	}
+	if x.Reward != nil {
+		value := protoreflect.ValueOfMessage(x.Reward.ProtoReflect())
+		if !f(fd_MsgPurgeExpiredContractsResponse_reward, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgPurgeExpiredContractsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "arkeo.arkeo.MsgPurgeExpiredContractsResponse.results":
+		return len(x.Results) != 0
+	case "arkeo.arkeo.MsgPurgeExpiredContractsResponse.reward":
+		return x.Reward != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgPurgeExpiredContractsResponse"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.MsgPurgeExpiredContractsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgPurgeExpiredContractsResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "arkeo.arkeo.MsgPurgeExpiredContractsResponse.results":
+		x.Results = nil
+	case "arkeo.arkeo.MsgPurgeExpiredContractsResponse.reward":
+		x.Reward = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgPurgeExpiredContractsResponse"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.MsgPurgeExpiredContractsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgPurgeExpiredContractsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "arkeo.arkeo.MsgPurgeExpiredContractsResponse.results":
+		if len(x.Results) == 0 {
+			return protoreflect.ValueOfList(&_MsgPurgeExpiredContractsResponse_1_list{})
+		}
+		listValue := &_MsgPurgeExpiredContractsResponse_1_list{list: &x.Results}
+		return protoreflect.ValueOfList(listValue)
+	case "arkeo.arkeo.MsgPurgeExpiredContractsResponse.reward":
+		value := x.Reward
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgPurgeExpiredContractsResponse"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.MsgPurgeExpiredContractsResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgPurgeExpiredContractsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "arkeo.arkeo.MsgPurgeExpiredContractsResponse.results":
+		lv := value.List()
+		clv := lv.(*_MsgPurgeExpiredContractsResponse_1_list)
+		x.Results = *clv.list
+	case "arkeo.arkeo.MsgPurgeExpiredContractsResponse.reward":
+		x.Reward = value.Message().Interface().(*v1beta1.Coin)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgPurgeExpiredContractsResponse"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.MsgPurgeExpiredContractsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgPurgeExpiredContractsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "arkeo.arkeo.MsgPurgeExpiredContractsResponse.results":
+		if x.Results == nil {
+			x.Results = []*ContractPurgeResult{}
+		}
+		value := &_MsgPurgeExpiredContractsResponse_1_list{list: &x.Results}
+		return protoreflect.ValueOfList(value)
+	case "arkeo.arkeo.MsgPurgeExpiredContractsResponse.reward":
+		if x.Reward == nil {
+			x.Reward = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.Reward.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgPurgeExpiredContractsResponse"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.MsgPurgeExpiredContractsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgPurgeExpiredContractsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "arkeo.arkeo.MsgPurgeExpiredContractsResponse.results":
+		list := []*ContractPurgeResult{}
+		return protoreflect.ValueOfList(&_MsgPurgeExpiredContractsResponse_1_list{list: &list})
+	case "arkeo.arkeo.MsgPurgeExpiredContractsResponse.reward":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgPurgeExpiredContractsResponse"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.MsgPurgeExpiredContractsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgPurgeExpiredContractsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in arkeo.arkeo.MsgPurgeExpiredContractsResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgPurgeExpiredContractsResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgPurgeExpiredContractsResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgPurgeExpiredContractsResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgPurgeExpiredContractsResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgPurgeExpiredContractsResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Results) > 0 {
+			for _, e := range x.Results {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Reward != nil {
+			l = options.Size(x.Reward)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgPurgeExpiredContractsResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Reward != nil {
+			encoded, err := options.Marshal(x.Reward)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Results) > 0 {
+			for iNdEx := len(x.Results) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Results[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgPurgeExpiredContractsResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgPurgeExpiredContractsResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgPurgeExpiredContractsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Results = append(x.Results, &ContractPurgeResult{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Results[len(x.Results)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reward", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Reward == nil {
+					x.Reward = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Reward); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_MsgSetProviderAllowlist_5_list)(nil)
+
+type _MsgSetProviderAllowlist_5_list struct {
+	list *[][]byte
+}
+
+func (x *_MsgSetProviderAllowlist_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgSetProviderAllowlist_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfBytes((*x.list)[i])
+}
+
+func (x *_MsgSetProviderAllowlist_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Bytes()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgSetProviderAllowlist_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Bytes()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgSetProviderAllowlist_5_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message MsgSetProviderAllowlist at list field Clients as it is not of Message kind"))
+}
+
+func (x *_MsgSetProviderAllowlist_5_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgSetProviderAllowlist_5_list) NewElement() protoreflect.Value {
+	var v []byte
+	return protoreflect.ValueOfBytes(v)
+}
+
+func (x *_MsgSetProviderAllowlist_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_MsgSetProviderAllowlist          protoreflect.MessageDescriptor
+	fd_MsgSetProviderAllowlist_creator  protoreflect.FieldDescriptor
+	fd_MsgSetProviderAllowlist_provider protoreflect.FieldDescriptor
+	fd_MsgSetProviderAllowlist_service  protoreflect.FieldDescriptor
+	fd_MsgSetProviderAllowlist_action   protoreflect.FieldDescriptor
+	fd_MsgSetProviderAllowlist_clients  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_arkeo_arkeo_tx_proto_init()
+	md_MsgSetProviderAllowlist = File_arkeo_arkeo_tx_proto.Messages().ByName("MsgSetProviderAllowlist")
+	fd_MsgSetProviderAllowlist_creator = md_MsgSetProviderAllowlist.Fields().ByName("creator")
+	fd_MsgSetProviderAllowlist_provider = md_MsgSetProviderAllowlist.Fields().ByName("provider")
+	fd_MsgSetProviderAllowlist_service = md_MsgSetProviderAllowlist.Fields().ByName("service")
+	fd_MsgSetProviderAllowlist_action = md_MsgSetProviderAllowlist.Fields().ByName("action")
+	fd_MsgSetProviderAllowlist_clients = md_MsgSetProviderAllowlist.Fields().ByName("clients")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgSetProviderAllowlist)(nil)
+
+type fastReflection_MsgSetProviderAllowlist MsgSetProviderAllowlist
+
+func (x *MsgSetProviderAllowlist) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgSetProviderAllowlist)(x)
+}
+
+func (x *MsgSetProviderAllowlist) slowProtoReflect() protoreflect.Message {
+	mi := &file_arkeo_arkeo_tx_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgSetProviderAllowlist_messageType fastReflection_MsgSetProviderAllowlist_messageType
+var _ protoreflect.MessageType = fastReflection_MsgSetProviderAllowlist_messageType{}
+
+type fastReflection_MsgSetProviderAllowlist_messageType struct{}
+
+func (x fastReflection_MsgSetProviderAllowlist_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgSetProviderAllowlist)(nil)
+}
+func (x fastReflection_MsgSetProviderAllowlist_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgSetProviderAllowlist)
+}
+func (x fastReflection_MsgSetProviderAllowlist_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSetProviderAllowlist
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgSetProviderAllowlist) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSetProviderAllowlist
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgSetProviderAllowlist) Type() protoreflect.MessageType {
+	return _fastReflection_MsgSetProviderAllowlist_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgSetProviderAllowlist) New() protoreflect.Message {
+	return new(fastReflection_MsgSetProviderAllowlist)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgSetProviderAllowlist) Interface() protoreflect.ProtoMessage {
+	return (*MsgSetProviderAllowlist)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgSetProviderAllowlist) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Creator != "" {
+		value := protoreflect.ValueOfString(x.Creator)
+		if !f(fd_MsgSetProviderAllowlist_creator, value) {
+			return
+		}
+	}
+	if len(x.Provider) != 0 {
+		value := protoreflect.ValueOfBytes(x.Provider)
+		if !f(fd_MsgSetProviderAllowlist_provider, value) {
+			return
+		}
+	}
+	if x.Service != "" {
+		value := protoreflect.ValueOfString(x.Service)
+		if !f(fd_MsgSetProviderAllowlist_service, value) {
+			return
+		}
+	}
+	if x.Action != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Action))
+		if !f(fd_MsgSetProviderAllowlist_action, value) {
+			return
+		}
+	}
+	if len(x.Clients) != 0 {
+		value := protoreflect.ValueOfList(&_MsgSetProviderAllowlist_5_list{list: &x.Clients})
+		if !f(fd_MsgSetProviderAllowlist_clients, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgSetProviderAllowlist) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "arkeo.arkeo.MsgSetProviderAllowlist.creator":
+		return x.Creator != ""
+	case "arkeo.arkeo.MsgSetProviderAllowlist.provider":
+		return len(x.Provider) != 0
+	case "arkeo.arkeo.MsgSetProviderAllowlist.service":
+		return x.Service != ""
+	case "arkeo.arkeo.MsgSetProviderAllowlist.action":
+		return x.Action != 0
+	case "arkeo.arkeo.MsgSetProviderAllowlist.clients":
+		return len(x.Clients) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgSetProviderAllowlist"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.MsgSetProviderAllowlist does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetProviderAllowlist) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "arkeo.arkeo.MsgSetProviderAllowlist.creator":
+		x.Creator = ""
+	case "arkeo.arkeo.MsgSetProviderAllowlist.provider":
+		x.Provider = nil
+	case "arkeo.arkeo.MsgSetProviderAllowlist.service":
+		x.Service = ""
+	case "arkeo.arkeo.MsgSetProviderAllowlist.action":
+		x.Action = 0
+	case "arkeo.arkeo.MsgSetProviderAllowlist.clients":
+		x.Clients = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgSetProviderAllowlist"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.MsgSetProviderAllowlist does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgSetProviderAllowlist) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "arkeo.arkeo.MsgSetProviderAllowlist.creator":
+		value := x.Creator
+		return protoreflect.ValueOfString(value)
+	case "arkeo.arkeo.MsgSetProviderAllowlist.provider":
+		value := x.Provider
+		return protoreflect.ValueOfBytes(value)
+	case "arkeo.arkeo.MsgSetProviderAllowlist.service":
+		value := x.Service
+		return protoreflect.ValueOfString(value)
+	case "arkeo.arkeo.MsgSetProviderAllowlist.action":
+		value := x.Action
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "arkeo.arkeo.MsgSetProviderAllowlist.clients":
+		if len(x.Clients) == 0 {
+			return protoreflect.ValueOfList(&_MsgSetProviderAllowlist_5_list{})
+		}
+		listValue := &_MsgSetProviderAllowlist_5_list{list: &x.Clients}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgSetProviderAllowlist"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.MsgSetProviderAllowlist does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetProviderAllowlist) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "arkeo.arkeo.MsgSetProviderAllowlist.creator":
+		x.Creator = value.Interface().(string)
+	case "arkeo.arkeo.MsgSetProviderAllowlist.provider":
+		x.Provider = value.Bytes()
+	case "arkeo.arkeo.MsgSetProviderAllowlist.service":
+		x.Service = value.Interface().(string)
+	case "arkeo.arkeo.MsgSetProviderAllowlist.action":
+		x.Action = (AllowlistAction)(value.Enum())
+	case "arkeo.arkeo.MsgSetProviderAllowlist.clients":
+		lv := value.List()
+		clv := lv.(*_MsgSetProviderAllowlist_5_list)
+		x.Clients = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgSetProviderAllowlist"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.MsgSetProviderAllowlist does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetProviderAllowlist) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "arkeo.arkeo.MsgSetProviderAllowlist.clients":
+		if x.Clients == nil {
+			x.Clients = [][]byte{}
+		}
+		value := &_MsgSetProviderAllowlist_5_list{list: &x.Clients}
+		return protoreflect.ValueOfList(value)
+	case "arkeo.arkeo.MsgSetProviderAllowlist.creator":
+		panic(fmt.Errorf("field creator of message arkeo.arkeo.MsgSetProviderAllowlist is not mutable"))
+	case "arkeo.arkeo.MsgSetProviderAllowlist.provider":
+		panic(fmt.Errorf("field provider of message arkeo.arkeo.MsgSetProviderAllowlist is not mutable"))
+	case "arkeo.arkeo.MsgSetProviderAllowlist.service":
+		panic(fmt.Errorf("field service of message arkeo.arkeo.MsgSetProviderAllowlist is not mutable"))
+	case "arkeo.arkeo.MsgSetProviderAllowlist.action":
+		panic(fmt.Errorf("field action of message arkeo.arkeo.MsgSetProviderAllowlist is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgSetProviderAllowlist"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.MsgSetProviderAllowlist does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgSetProviderAllowlist) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "arkeo.arkeo.MsgSetProviderAllowlist.creator":
+		return protoreflect.ValueOfString("")
+	case "arkeo.arkeo.MsgSetProviderAllowlist.provider":
+		return protoreflect.ValueOfBytes(nil)
+	case "arkeo.arkeo.MsgSetProviderAllowlist.service":
+		return protoreflect.ValueOfString("")
+	case "arkeo.arkeo.MsgSetProviderAllowlist.action":
+		return protoreflect.ValueOfEnum(0)
+	case "arkeo.arkeo.MsgSetProviderAllowlist.clients":
+		list := [][]byte{}
+		return protoreflect.ValueOfList(&_MsgSetProviderAllowlist_5_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgSetProviderAllowlist"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.MsgSetProviderAllowlist does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgSetProviderAllowlist) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in arkeo.arkeo.MsgSetProviderAllowlist", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgSetProviderAllowlist) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetProviderAllowlist) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgSetProviderAllowlist) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgSetProviderAllowlist) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgSetProviderAllowlist)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Creator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Provider)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Service)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Action != 0 {
+			n += 1 + runtime.Sov(uint64(x.Action))
+		}
+		if len(x.Clients) > 0 {
+			for _, b := range x.Clients {
+				l = len(b)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSetProviderAllowlist)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Clients) > 0 {
+			for iNdEx := len(x.Clients) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Clients[iNdEx])
+				copy(dAtA[i:], x.Clients[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Clients[iNdEx])))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if x.Action != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Action))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.Service) > 0 {
+			i -= len(x.Service)
+			copy(dAtA[i:], x.Service)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Service)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Provider) > 0 {
+			i -= len(x.Provider)
+			copy(dAtA[i:], x.Provider)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Provider)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Creator) > 0 {
+			i -= len(x.Creator)
+			copy(dAtA[i:], x.Creator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSetProviderAllowlist)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetProviderAllowlist: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetProviderAllowlist: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Creator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Provider = append(x.Provider[:0], dAtA[iNdEx:postIndex]...)
+				if x.Provider == nil {
+					x.Provider = []byte{}
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Service = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+				}
+				x.Action = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Action |= AllowlistAction(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Clients", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Clients = append(x.Clients, make([]byte, postIndex-iNdEx))
+				copy(x.Clients[len(x.Clients)-1], dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgSetProviderAllowlistResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_arkeo_arkeo_tx_proto_init()
+	md_MsgSetProviderAllowlistResponse = File_arkeo_arkeo_tx_proto.Messages().ByName("MsgSetProviderAllowlistResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgSetProviderAllowlistResponse)(nil)
+
+type fastReflection_MsgSetProviderAllowlistResponse MsgSetProviderAllowlistResponse
+
+func (x *MsgSetProviderAllowlistResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgSetProviderAllowlistResponse)(x)
+}
+
+func (x *MsgSetProviderAllowlistResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_arkeo_arkeo_tx_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgSetProviderAllowlistResponse_messageType fastReflection_MsgSetProviderAllowlistResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgSetProviderAllowlistResponse_messageType{}
+
+type fastReflection_MsgSetProviderAllowlistResponse_messageType struct{}
+
+func (x fastReflection_MsgSetProviderAllowlistResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgSetProviderAllowlistResponse)(nil)
+}
+func (x fastReflection_MsgSetProviderAllowlistResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgSetProviderAllowlistResponse)
+}
+func (x fastReflection_MsgSetProviderAllowlistResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSetProviderAllowlistResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgSetProviderAllowlistResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSetProviderAllowlistResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgSetProviderAllowlistResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgSetProviderAllowlistResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgSetProviderAllowlistResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgSetProviderAllowlistResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgSetProviderAllowlistResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgSetProviderAllowlistResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgSetProviderAllowlistResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgSetProviderAllowlistResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgSetProviderAllowlistResponse"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.MsgSetProviderAllowlistResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetProviderAllowlistResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgSetProviderAllowlistResponse"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.MsgSetProviderAllowlistResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgSetProviderAllowlistResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgSetProviderAllowlistResponse"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.MsgSetProviderAllowlistResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetProviderAllowlistResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgSetProviderAllowlistResponse"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.MsgSetProviderAllowlistResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetProviderAllowlistResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgSetProviderAllowlistResponse"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.MsgSetProviderAllowlistResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgSetProviderAllowlistResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgSetProviderAllowlistResponse"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.MsgSetProviderAllowlistResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgSetProviderAllowlistResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in arkeo.arkeo.MsgSetProviderAllowlistResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgSetProviderAllowlistResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetProviderAllowlistResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgSetProviderAllowlistResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgSetProviderAllowlistResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgSetProviderAllowlistResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSetProviderAllowlistResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSetProviderAllowlistResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetProviderAllowlistResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetProviderAllowlistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgSetVersion         protoreflect.MessageDescriptor
+	fd_MsgSetVersion_creator protoreflect.FieldDescriptor
+	fd_MsgSetVersion_version protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_arkeo_arkeo_tx_proto_init()
+	md_MsgSetVersion = File_arkeo_arkeo_tx_proto.Messages().ByName("MsgSetVersion")
+	fd_MsgSetVersion_creator = md_MsgSetVersion.Fields().ByName("creator")
+	fd_MsgSetVersion_version = md_MsgSetVersion.Fields().ByName("version")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgSetVersion)(nil)
+
+type fastReflection_MsgSetVersion MsgSetVersion
+
+func (x *MsgSetVersion) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgSetVersion)(x)
+}
+
+func (x *MsgSetVersion) slowProtoReflect() protoreflect.Message {
+	mi := &file_arkeo_arkeo_tx_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgSetVersion_messageType fastReflection_MsgSetVersion_messageType
+var _ protoreflect.MessageType = fastReflection_MsgSetVersion_messageType{}
+
+type fastReflection_MsgSetVersion_messageType struct{}
+
+func (x fastReflection_MsgSetVersion_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgSetVersion)(nil)
+}
+func (x fastReflection_MsgSetVersion_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgSetVersion)
+}
+func (x fastReflection_MsgSetVersion_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSetVersion
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgSetVersion) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSetVersion
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgSetVersion) Type() protoreflect.MessageType {
+	return _fastReflection_MsgSetVersion_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgSetVersion) New() protoreflect.Message {
+	return new(fastReflection_MsgSetVersion)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgSetVersion) Interface() protoreflect.ProtoMessage {
+	return (*MsgSetVersion)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgSetVersion) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Creator != "" {
+		value := protoreflect.ValueOfString(x.Creator)
+		if !f(fd_MsgSetVersion_creator, value) {
+			return
+		}
+	}
+	if x.Version != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Version)
+		if !f(fd_MsgSetVersion_version, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgSetVersion) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "arkeo.arkeo.MsgSetVersion.creator":
+		return x.Creator != ""
+	case "arkeo.arkeo.MsgSetVersion.version":
+		return x.Version != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgSetVersion"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.MsgSetVersion does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetVersion) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "arkeo.arkeo.MsgSetVersion.creator":
+		x.Creator = ""
+	case "arkeo.arkeo.MsgSetVersion.version":
+		x.Version = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgSetVersion"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.MsgSetVersion does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgSetVersion) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "arkeo.arkeo.MsgSetVersion.creator":
+		value := x.Creator
+		return protoreflect.ValueOfString(value)
+	case "arkeo.arkeo.MsgSetVersion.version":
+		value := x.Version
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgSetVersion"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.MsgSetVersion does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetVersion) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "arkeo.arkeo.MsgSetVersion.creator":
+		x.Creator = value.Interface().(string)
+	case "arkeo.arkeo.MsgSetVersion.version":
+		x.Version = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgSetVersion"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.MsgSetVersion does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetVersion) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "arkeo.arkeo.MsgSetVersion.creator":
+		panic(fmt.Errorf("field creator of message arkeo.arkeo.MsgSetVersion is not mutable"))
+	case "arkeo.arkeo.MsgSetVersion.version":
+		panic(fmt.Errorf("field version of message arkeo.arkeo.MsgSetVersion is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgSetVersion"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.MsgSetVersion does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgSetVersion) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "arkeo.arkeo.MsgSetVersion.creator":
+		return protoreflect.ValueOfString("")
+	case "arkeo.arkeo.MsgSetVersion.version":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgSetVersion"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.MsgSetVersion does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgSetVersion) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in arkeo.arkeo.MsgSetVersion", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgSetVersion) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetVersion) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgSetVersion) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgSetVersion) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgSetVersion)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Creator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Version != 0 {
+			n += 1 + runtime.Sov(uint64(x.Version))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSetVersion)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Version != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Version))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Creator) > 0 {
+			i -= len(x.Creator)
+			copy(dAtA[i:], x.Creator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSetVersion)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetVersion: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetVersion: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Creator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				}
+				x.Version = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Version |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgSetVersionResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_arkeo_arkeo_tx_proto_init()
+	md_MsgSetVersionResponse = File_arkeo_arkeo_tx_proto.Messages().ByName("MsgSetVersionResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgSetVersionResponse)(nil)
+
+type fastReflection_MsgSetVersionResponse MsgSetVersionResponse
+
+func (x *MsgSetVersionResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgSetVersionResponse)(x)
+}
+
+func (x *MsgSetVersionResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_arkeo_arkeo_tx_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgSetVersionResponse_messageType fastReflection_MsgSetVersionResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgSetVersionResponse_messageType{}
+
+type fastReflection_MsgSetVersionResponse_messageType struct{}
+
+func (x fastReflection_MsgSetVersionResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgSetVersionResponse)(nil)
+}
+func (x fastReflection_MsgSetVersionResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgSetVersionResponse)
+}
+func (x fastReflection_MsgSetVersionResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSetVersionResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgSetVersionResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSetVersionResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgSetVersionResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgSetVersionResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgSetVersionResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgSetVersionResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgSetVersionResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgSetVersionResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgSetVersionResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgSetVersionResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgSetVersionResponse"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.MsgSetVersionResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetVersionResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgSetVersionResponse"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.MsgSetVersionResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgSetVersionResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgSetVersionResponse"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.MsgSetVersionResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetVersionResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgSetVersionResponse"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.MsgSetVersionResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetVersionResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgSetVersionResponse"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.MsgSetVersionResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgSetVersionResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.arkeo.MsgSetVersionResponse"))
+		}
+		panic(fmt.Errorf("message arkeo.arkeo.MsgSetVersionResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgSetVersionResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in arkeo.arkeo.MsgSetVersionResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgSetVersionResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetVersionResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgSetVersionResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgSetVersionResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgSetVersionResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSetVersionResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSetVersionResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetVersionResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetVersionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: arkeo/arkeo/tx.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type MsgBondProvider struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Creator  string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Provider string `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+	Service  string `protobuf:"bytes,3,opt,name=service,proto3" json:"service,omitempty"`
+	Bond     string `protobuf:"bytes,4,opt,name=bond,proto3" json:"bond,omitempty"`
+}
+
+func (x *MsgBondProvider) Reset() {
+	*x = MsgBondProvider{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_arkeo_arkeo_tx_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgBondProvider) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgBondProvider) ProtoMessage() {}
+
+// Deprecated: Use MsgBondProvider.ProtoReflect.Descriptor instead.
+func (*MsgBondProvider) Descriptor() ([]byte, []int) {
+	return file_arkeo_arkeo_tx_proto_rawDescGZIP(), []int{0}
+}
+
 func (x *MsgBondProvider) GetCreator() string {
 	if x != nil {
 		return x.Creator
@@ -6655,511 +15357,1276 @@ func (x *MsgBondProvider) GetCreator() string {
 	return ""
 }
 
-func (x *MsgBondProvider) GetProvider() string {
+func (x *MsgBondProvider) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *MsgBondProvider) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *MsgBondProvider) GetBond() string {
+	if x != nil {
+		return x.Bond
+	}
+	return ""
+}
+
+type MsgBondProviderResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgBondProviderResponse) Reset() {
+	*x = MsgBondProviderResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_arkeo_arkeo_tx_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgBondProviderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgBondProviderResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgBondProviderResponse.ProtoReflect.Descriptor instead.
+func (*MsgBondProviderResponse) Descriptor() ([]byte, []int) {
+	return file_arkeo_arkeo_tx_proto_rawDescGZIP(), []int{1}
+}
+
+type MsgModProvider struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Creator             string          `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Provider            []byte          `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+	Service             string          `protobuf:"bytes,3,opt,name=service,proto3" json:"service,omitempty"`
+	MetadataUri         string          `protobuf:"bytes,4,opt,name=metadata_uri,json=metadataUri,proto3" json:"metadata_uri,omitempty"`
+	MetadataNonce       uint64          `protobuf:"varint,5,opt,name=metadata_nonce,json=metadataNonce,proto3" json:"metadata_nonce,omitempty"`
+	Status              ProviderStatus  `protobuf:"varint,6,opt,name=status,proto3,enum=arkeo.arkeo.ProviderStatus" json:"status,omitempty"`
+	MinContractDuration int64           `protobuf:"varint,7,opt,name=min_contract_duration,json=minContractDuration,proto3" json:"min_contract_duration,omitempty"`
+	MaxContractDuration int64           `protobuf:"varint,8,opt,name=max_contract_duration,json=maxContractDuration,proto3" json:"max_contract_duration,omitempty"`
+	SubscriptionRate    []*v1beta1.Coin `protobuf:"bytes,9,rep,name=subscription_rate,json=subscriptionRate,proto3" json:"subscription_rate,omitempty"`
+	PayAsYouGoRate      []*v1beta1.Coin `protobuf:"bytes,10,rep,name=pay_as_you_go_rate,json=payAsYouGoRate,proto3" json:"pay_as_you_go_rate,omitempty"`
+	SettlementDuration  int64           `protobuf:"varint,11,opt,name=settlement_duration,json=settlementDuration,proto3" json:"settlement_duration,omitempty"`
+	// update_mask names the fields of the provider updated (e.g. metadata_nonce), the others are left untouched
+	UpdateMask       []string `protobuf:"bytes,12,rep,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	MaxOpenContracts uint64   `protobuf:"varint,13,opt,name=max_open_contracts,json=maxOpenContracts,proto3" json:"max_open_contracts,omitempty"`
+}
+
+func (x *MsgModProvider) Reset() {
+	*x = MsgModProvider{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_arkeo_arkeo_tx_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgModProvider) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgModProvider) ProtoMessage() {}
+
+// Deprecated: Use MsgModProvider.ProtoReflect.Descriptor instead.
+func (*MsgModProvider) Descriptor() ([]byte, []int) {
+	return file_arkeo_arkeo_tx_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *MsgModProvider) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *MsgModProvider) GetProvider() []byte {
+	if x != nil {
+		return x.Provider
+	}
+	return nil
+}
+
+func (x *MsgModProvider) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *MsgModProvider) GetMetadataUri() string {
+	if x != nil {
+		return x.MetadataUri
+	}
+	return ""
+}
+
+func (x *MsgModProvider) GetMetadataNonce() uint64 {
+	if x != nil {
+		return x.MetadataNonce
+	}
+	return 0
+}
+
+func (x *MsgModProvider) GetStatus() ProviderStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ProviderStatus_OFFLINE
+}
+
+func (x *MsgModProvider) GetMinContractDuration() int64 {
+	if x != nil {
+		return x.MinContractDuration
+	}
+	return 0
+}
+
+func (x *MsgModProvider) GetMaxContractDuration() int64 {
+	if x != nil {
+		return x.MaxContractDuration
+	}
+	return 0
+}
+
+func (x *MsgModProvider) GetSubscriptionRate() []*v1beta1.Coin {
+	if x != nil {
+		return x.SubscriptionRate
+	}
+	return nil
+}
+
+func (x *MsgModProvider) GetPayAsYouGoRate() []*v1beta1.Coin {
+	if x != nil {
+		return x.PayAsYouGoRate
+	}
+	return nil
+}
+
+func (x *MsgModProvider) GetSettlementDuration() int64 {
+	if x != nil {
+		return x.SettlementDuration
+	}
+	return 0
+}
+
+func (x *MsgModProvider) GetUpdateMask() []string {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *MsgModProvider) GetMaxOpenContracts() uint64 {
+	if x != nil {
+		return x.MaxOpenContracts
+	}
+	return 0
+}
+
+type MsgModProviderResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgModProviderResponse) Reset() {
+	*x = MsgModProviderResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_arkeo_arkeo_tx_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgModProviderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgModProviderResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgModProviderResponse.ProtoReflect.Descriptor instead.
+func (*MsgModProviderResponse) Descriptor() ([]byte, []int) {
+	return file_arkeo_arkeo_tx_proto_rawDescGZIP(), []int{3}
+}
+
+type MsgOpenContract struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Creator            string                `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Provider           string                `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+	Service            string                `protobuf:"bytes,3,opt,name=service,proto3" json:"service,omitempty"`
+	Client             string                `protobuf:"bytes,4,opt,name=client,proto3" json:"client,omitempty"`
+	Delegate           string                `protobuf:"bytes,5,opt,name=delegate,proto3" json:"delegate,omitempty"`
+	ContractType       ContractType          `protobuf:"varint,6,opt,name=contract_type,json=contractType,proto3,enum=arkeo.arkeo.ContractType" json:"contract_type,omitempty"`
+	Duration           int64                 `protobuf:"varint,7,opt,name=duration,proto3" json:"duration,omitempty"`
+	Rate               *v1beta1.Coin         `protobuf:"bytes,8,opt,name=rate,proto3" json:"rate,omitempty"`
+	Deposit            string                `protobuf:"bytes,9,opt,name=deposit,proto3" json:"deposit,omitempty"`
+	SettlementDuration int64                 `protobuf:"varint,10,opt,name=settlement_duration,json=settlementDuration,proto3" json:"settlement_duration,omitempty"`
+	Authorization      ContractAuthorization `protobuf:"varint,11,opt,name=authorization,proto3,enum=arkeo.arkeo.ContractAuthorization" json:"authorization,omitempty"`
+	QueriesPerMinute   int64                 `protobuf:"varint,12,opt,name=queries_per_minute,json=queriesPerMinute,proto3" json:"queries_per_minute,omitempty"`
+	// auto_renew renews the subscription at its expiration, out of its top-up balance
+	AutoRenew bool `protobuf:"varint,13,opt,name=auto_renew,json=autoRenew,proto3" json:"auto_renew,omitempty"`
+	// payer funds the deposit instead of the creator, and gets the refund of the
+	// deposit at settlement. It signs the msg along with the creator.
+	Payer string `protobuf:"bytes,14,opt,name=payer,proto3" json:"payer,omitempty"`
+	// start_height schedules the contract to start at a later height, its
+	// deposit escrowed at once. Zero starts it right away.
+	StartHeight int64 `protobuf:"varint,15,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+}
+
+func (x *MsgOpenContract) Reset() {
+	*x = MsgOpenContract{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_arkeo_arkeo_tx_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgOpenContract) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgOpenContract) ProtoMessage() {}
+
+// Deprecated: Use MsgOpenContract.ProtoReflect.Descriptor instead.
+func (*MsgOpenContract) Descriptor() ([]byte, []int) {
+	return file_arkeo_arkeo_tx_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *MsgOpenContract) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *MsgOpenContract) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *MsgOpenContract) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *MsgOpenContract) GetClient() string {
+	if x != nil {
+		return x.Client
+	}
+	return ""
+}
+
+func (x *MsgOpenContract) GetDelegate() string {
+	if x != nil {
+		return x.Delegate
+	}
+	return ""
+}
+
+func (x *MsgOpenContract) GetContractType() ContractType {
+	if x != nil {
+		return x.ContractType
+	}
+	return ContractType_SUBSCRIPTION
+}
+
+func (x *MsgOpenContract) GetDuration() int64 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *MsgOpenContract) GetRate() *v1beta1.Coin {
+	if x != nil {
+		return x.Rate
+	}
+	return nil
+}
+
+func (x *MsgOpenContract) GetDeposit() string {
+	if x != nil {
+		return x.Deposit
+	}
+	return ""
+}
+
+func (x *MsgOpenContract) GetSettlementDuration() int64 {
+	if x != nil {
+		return x.SettlementDuration
+	}
+	return 0
+}
+
+func (x *MsgOpenContract) GetAuthorization() ContractAuthorization {
+	if x != nil {
+		return x.Authorization
+	}
+	return ContractAuthorization_STRICT
+}
+
+func (x *MsgOpenContract) GetQueriesPerMinute() int64 {
+	if x != nil {
+		return x.QueriesPerMinute
+	}
+	return 0
+}
+
+func (x *MsgOpenContract) GetAutoRenew() bool {
+	if x != nil {
+		return x.AutoRenew
+	}
+	return false
+}
+
+func (x *MsgOpenContract) GetPayer() string {
+	if x != nil {
+		return x.Payer
+	}
+	return ""
+}
+
+func (x *MsgOpenContract) GetStartHeight() int64 {
+	if x != nil {
+		return x.StartHeight
+	}
+	return 0
+}
+
+type MsgOpenContractResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgOpenContractResponse) Reset() {
+	*x = MsgOpenContractResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_arkeo_arkeo_tx_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgOpenContractResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgOpenContractResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgOpenContractResponse.ProtoReflect.Descriptor instead.
+func (*MsgOpenContractResponse) Descriptor() ([]byte, []int) {
+	return file_arkeo_arkeo_tx_proto_rawDescGZIP(), []int{5}
+}
+
+type MsgCloseContract struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Creator    string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ContractId uint64 `protobuf:"varint,2,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	Client     []byte `protobuf:"bytes,3,opt,name=client,proto3" json:"client,omitempty"`
+	Delegate   []byte `protobuf:"bytes,4,opt,name=delegate,proto3" json:"delegate,omitempty"`
+}
+
+func (x *MsgCloseContract) Reset() {
+	*x = MsgCloseContract{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_arkeo_arkeo_tx_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgCloseContract) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgCloseContract) ProtoMessage() {}
+
+// Deprecated: Use MsgCloseContract.ProtoReflect.Descriptor instead.
+func (*MsgCloseContract) Descriptor() ([]byte, []int) {
+	return file_arkeo_arkeo_tx_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *MsgCloseContract) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *MsgCloseContract) GetContractId() uint64 {
+	if x != nil {
+		return x.ContractId
+	}
+	return 0
+}
+
+func (x *MsgCloseContract) GetClient() []byte {
+	if x != nil {
+		return x.Client
+	}
+	return nil
+}
+
+func (x *MsgCloseContract) GetDelegate() []byte {
+	if x != nil {
+		return x.Delegate
+	}
+	return nil
+}
+
+type MsgCloseContractResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgCloseContractResponse) Reset() {
+	*x = MsgCloseContractResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_arkeo_arkeo_tx_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgCloseContractResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgCloseContractResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgCloseContractResponse.ProtoReflect.Descriptor instead.
+func (*MsgCloseContractResponse) Descriptor() ([]byte, []int) {
+	return file_arkeo_arkeo_tx_proto_rawDescGZIP(), []int{7}
+}
+
+type MsgClaimContractIncome struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Creator    string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ContractId uint64 `protobuf:"varint,2,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	Signature  []byte `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
+	Nonce      int64  `protobuf:"varint,5,opt,name=nonce,proto3" json:"nonce,omitempty"`
+}
+
+func (x *MsgClaimContractIncome) Reset() {
+	*x = MsgClaimContractIncome{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_arkeo_arkeo_tx_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgClaimContractIncome) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgClaimContractIncome) ProtoMessage() {}
+
+// Deprecated: Use MsgClaimContractIncome.ProtoReflect.Descriptor instead.
+func (*MsgClaimContractIncome) Descriptor() ([]byte, []int) {
+	return file_arkeo_arkeo_tx_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *MsgClaimContractIncome) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *MsgClaimContractIncome) GetContractId() uint64 {
+	if x != nil {
+		return x.ContractId
+	}
+	return 0
+}
+
+func (x *MsgClaimContractIncome) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+func (x *MsgClaimContractIncome) GetNonce() int64 {
+	if x != nil {
+		return x.Nonce
+	}
+	return 0
+}
+
+type MsgClaimContractIncomeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgClaimContractIncomeResponse) Reset() {
+	*x = MsgClaimContractIncomeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_arkeo_arkeo_tx_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgClaimContractIncomeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgClaimContractIncomeResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgClaimContractIncomeResponse.ProtoReflect.Descriptor instead.
+func (*MsgClaimContractIncomeResponse) Descriptor() ([]byte, []int) {
+	return file_arkeo_arkeo_tx_proto_rawDescGZIP(), []int{9}
+}
+
+type MsgRenewContract struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Creator            string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ContractId         uint64 `protobuf:"varint,2,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	AdditionalDuration int64  `protobuf:"varint,3,opt,name=additional_duration,json=additionalDuration,proto3" json:"additional_duration,omitempty"`
+	ExtraDeposit       string `protobuf:"bytes,4,opt,name=extra_deposit,json=extraDeposit,proto3" json:"extra_deposit,omitempty"`
+	// rate is the current rate of the provider the client accepts, required when it changed since the contract opened
+	Rate *v1beta1.Coin `protobuf:"bytes,5,opt,name=rate,proto3" json:"rate,omitempty"`
+}
+
+func (x *MsgRenewContract) Reset() {
+	*x = MsgRenewContract{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_arkeo_arkeo_tx_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgRenewContract) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgRenewContract) ProtoMessage() {}
+
+// Deprecated: Use MsgRenewContract.ProtoReflect.Descriptor instead.
+func (*MsgRenewContract) Descriptor() ([]byte, []int) {
+	return file_arkeo_arkeo_tx_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *MsgRenewContract) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *MsgRenewContract) GetContractId() uint64 {
+	if x != nil {
+		return x.ContractId
+	}
+	return 0
+}
+
+func (x *MsgRenewContract) GetAdditionalDuration() int64 {
+	if x != nil {
+		return x.AdditionalDuration
+	}
+	return 0
+}
+
+func (x *MsgRenewContract) GetExtraDeposit() string {
+	if x != nil {
+		return x.ExtraDeposit
+	}
+	return ""
+}
+
+func (x *MsgRenewContract) GetRate() *v1beta1.Coin {
+	if x != nil {
+		return x.Rate
+	}
+	return nil
+}
+
+type MsgRenewContractResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgRenewContractResponse) Reset() {
+	*x = MsgRenewContractResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_arkeo_arkeo_tx_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgRenewContractResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgRenewContractResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgRenewContractResponse.ProtoReflect.Descriptor instead.
+func (*MsgRenewContractResponse) Descriptor() ([]byte, []int) {
+	return file_arkeo_arkeo_tx_proto_rawDescGZIP(), []int{11}
+}
+
+type MsgProviderCloseContract struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Creator    string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ContractId uint64 `protobuf:"varint,2,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+}
+
+func (x *MsgProviderCloseContract) Reset() {
+	*x = MsgProviderCloseContract{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_arkeo_arkeo_tx_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgProviderCloseContract) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgProviderCloseContract) ProtoMessage() {}
+
+// Deprecated: Use MsgProviderCloseContract.ProtoReflect.Descriptor instead.
+func (*MsgProviderCloseContract) Descriptor() ([]byte, []int) {
+	return file_arkeo_arkeo_tx_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *MsgProviderCloseContract) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *MsgProviderCloseContract) GetContractId() uint64 {
+	if x != nil {
+		return x.ContractId
+	}
+	return 0
+}
+
+type MsgProviderCloseContractResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgProviderCloseContractResponse) Reset() {
+	*x = MsgProviderCloseContractResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_arkeo_arkeo_tx_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgProviderCloseContractResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgProviderCloseContractResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgProviderCloseContractResponse.ProtoReflect.Descriptor instead.
+func (*MsgProviderCloseContractResponse) Descriptor() ([]byte, []int) {
+	return file_arkeo_arkeo_tx_proto_rawDescGZIP(), []int{13}
+}
+
+type MsgRotateDelegate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Creator    string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ContractId uint64 `protobuf:"varint,2,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	// new_delegate is the pubkey spending the contract from now on, in place of its current delegate
+	NewDelegate []byte `protobuf:"bytes,3,opt,name=new_delegate,json=newDelegate,proto3" json:"new_delegate,omitempty"`
+}
+
+func (x *MsgRotateDelegate) Reset() {
+	*x = MsgRotateDelegate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_arkeo_arkeo_tx_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgRotateDelegate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgRotateDelegate) ProtoMessage() {}
+
+// Deprecated: Use MsgRotateDelegate.ProtoReflect.Descriptor instead.
+func (*MsgRotateDelegate) Descriptor() ([]byte, []int) {
+	return file_arkeo_arkeo_tx_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *MsgRotateDelegate) GetCreator() string {
 	if x != nil {
-		return x.Provider
+		return x.Creator
 	}
 	return ""
 }
 
-func (x *MsgBondProvider) GetService() string {
+func (x *MsgRotateDelegate) GetContractId() uint64 {
 	if x != nil {
-		return x.Service
+		return x.ContractId
 	}
-	return ""
+	return 0
 }
 
-func (x *MsgBondProvider) GetBond() string {
+func (x *MsgRotateDelegate) GetNewDelegate() []byte {
 	if x != nil {
-		return x.Bond
+		return x.NewDelegate
 	}
-	return ""
+	return nil
 }
 
-type MsgBondProviderResponse struct {
+type MsgRotateDelegateResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *MsgBondProviderResponse) Reset() {
-	*x = MsgBondProviderResponse{}
+func (x *MsgRotateDelegateResponse) Reset() {
+	*x = MsgRotateDelegateResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_arkeo_arkeo_tx_proto_msgTypes[1]
+		mi := &file_arkeo_arkeo_tx_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgBondProviderResponse) String() string {
+func (x *MsgRotateDelegateResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgBondProviderResponse) ProtoMessage() {}
+func (*MsgRotateDelegateResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgBondProviderResponse.ProtoReflect.Descriptor instead.
-func (*MsgBondProviderResponse) Descriptor() ([]byte, []int) {
-	return file_arkeo_arkeo_tx_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use MsgRotateDelegateResponse.ProtoReflect.Descriptor instead.
+func (*MsgRotateDelegateResponse) Descriptor() ([]byte, []int) {
+	return file_arkeo_arkeo_tx_proto_rawDescGZIP(), []int{15}
 }
 
-type MsgModProvider struct {
+type MsgSetAutoRenew struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Creator             string          `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	Provider            []byte          `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
-	Service             string          `protobuf:"bytes,3,opt,name=service,proto3" json:"service,omitempty"`
-	MetadataUri         string          `protobuf:"bytes,4,opt,name=metadata_uri,json=metadataUri,proto3" json:"metadata_uri,omitempty"`
-	MetadataNonce       uint64          `protobuf:"varint,5,opt,name=metadata_nonce,json=metadataNonce,proto3" json:"metadata_nonce,omitempty"`
-	Status              ProviderStatus  `protobuf:"varint,6,opt,name=status,proto3,enum=arkeo.arkeo.ProviderStatus" json:"status,omitempty"`
-	MinContractDuration int64           `protobuf:"varint,7,opt,name=min_contract_duration,json=minContractDuration,proto3" json:"min_contract_duration,omitempty"`
-	MaxContractDuration int64           `protobuf:"varint,8,opt,name=max_contract_duration,json=maxContractDuration,proto3" json:"max_contract_duration,omitempty"`
-	SubscriptionRate    []*v1beta1.Coin `protobuf:"bytes,9,rep,name=subscription_rate,json=subscriptionRate,proto3" json:"subscription_rate,omitempty"`
-	PayAsYouGoRate      []*v1beta1.Coin `protobuf:"bytes,10,rep,name=pay_as_you_go_rate,json=payAsYouGoRate,proto3" json:"pay_as_you_go_rate,omitempty"`
-	SettlementDuration  int64           `protobuf:"varint,11,opt,name=settlement_duration,json=settlementDuration,proto3" json:"settlement_duration,omitempty"`
+	Creator    string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ContractId uint64 `protobuf:"varint,2,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	// auto_renew turned off refunds the top-up balance of the contract
+	AutoRenew bool `protobuf:"varint,3,opt,name=auto_renew,json=autoRenew,proto3" json:"auto_renew,omitempty"`
 }
 
-func (x *MsgModProvider) Reset() {
-	*x = MsgModProvider{}
+func (x *MsgSetAutoRenew) Reset() {
+	*x = MsgSetAutoRenew{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_arkeo_arkeo_tx_proto_msgTypes[2]
+		mi := &file_arkeo_arkeo_tx_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgModProvider) String() string {
+func (x *MsgSetAutoRenew) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgModProvider) ProtoMessage() {}
+func (*MsgSetAutoRenew) ProtoMessage() {}
 
-// Deprecated: Use MsgModProvider.ProtoReflect.Descriptor instead.
-func (*MsgModProvider) Descriptor() ([]byte, []int) {
-	return file_arkeo_arkeo_tx_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use MsgSetAutoRenew.ProtoReflect.Descriptor instead.
+func (*MsgSetAutoRenew) Descriptor() ([]byte, []int) {
+	return file_arkeo_arkeo_tx_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *MsgModProvider) GetCreator() string {
+func (x *MsgSetAutoRenew) GetCreator() string {
 	if x != nil {
 		return x.Creator
 	}
 	return ""
 }
 
-func (x *MsgModProvider) GetProvider() []byte {
+func (x *MsgSetAutoRenew) GetContractId() uint64 {
 	if x != nil {
-		return x.Provider
+		return x.ContractId
 	}
-	return nil
+	return 0
 }
 
-func (x *MsgModProvider) GetService() string {
+func (x *MsgSetAutoRenew) GetAutoRenew() bool {
 	if x != nil {
-		return x.Service
+		return x.AutoRenew
 	}
-	return ""
+	return false
 }
 
-func (x *MsgModProvider) GetMetadataUri() string {
-	if x != nil {
-		return x.MetadataUri
-	}
-	return ""
+type MsgSetAutoRenewResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-func (x *MsgModProvider) GetMetadataNonce() uint64 {
-	if x != nil {
-		return x.MetadataNonce
+func (x *MsgSetAutoRenewResponse) Reset() {
+	*x = MsgSetAutoRenewResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_arkeo_arkeo_tx_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *MsgModProvider) GetStatus() ProviderStatus {
-	if x != nil {
-		return x.Status
-	}
-	return ProviderStatus_OFFLINE
+func (x *MsgSetAutoRenewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *MsgModProvider) GetMinContractDuration() int64 {
-	if x != nil {
-		return x.MinContractDuration
-	}
-	return 0
+func (*MsgSetAutoRenewResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgSetAutoRenewResponse.ProtoReflect.Descriptor instead.
+func (*MsgSetAutoRenewResponse) Descriptor() ([]byte, []int) {
+	return file_arkeo_arkeo_tx_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *MsgModProvider) GetMaxContractDuration() int64 {
-	if x != nil {
-		return x.MaxContractDuration
+type MsgTopUpContract struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Creator    string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ContractId uint64 `protobuf:"varint,2,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	// deposit extends the contract by deposit/(rate*qpm) blocks, right away or at its expiration when it auto-renews
+	Deposit string `protobuf:"bytes,3,opt,name=deposit,proto3" json:"deposit,omitempty"`
+}
+
+func (x *MsgTopUpContract) Reset() {
+	*x = MsgTopUpContract{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_arkeo_arkeo_tx_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *MsgModProvider) GetSubscriptionRate() []*v1beta1.Coin {
+func (x *MsgTopUpContract) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgTopUpContract) ProtoMessage() {}
+
+// Deprecated: Use MsgTopUpContract.ProtoReflect.Descriptor instead.
+func (*MsgTopUpContract) Descriptor() ([]byte, []int) {
+	return file_arkeo_arkeo_tx_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *MsgTopUpContract) GetCreator() string {
 	if x != nil {
-		return x.SubscriptionRate
+		return x.Creator
 	}
-	return nil
+	return ""
 }
 
-func (x *MsgModProvider) GetPayAsYouGoRate() []*v1beta1.Coin {
+func (x *MsgTopUpContract) GetContractId() uint64 {
 	if x != nil {
-		return x.PayAsYouGoRate
+		return x.ContractId
 	}
-	return nil
+	return 0
 }
 
-func (x *MsgModProvider) GetSettlementDuration() int64 {
+func (x *MsgTopUpContract) GetDeposit() string {
 	if x != nil {
-		return x.SettlementDuration
+		return x.Deposit
 	}
-	return 0
+	return ""
 }
 
-type MsgModProviderResponse struct {
+type MsgTopUpContractResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *MsgModProviderResponse) Reset() {
-	*x = MsgModProviderResponse{}
+func (x *MsgTopUpContractResponse) Reset() {
+	*x = MsgTopUpContractResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_arkeo_arkeo_tx_proto_msgTypes[3]
+		mi := &file_arkeo_arkeo_tx_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgModProviderResponse) String() string {
+func (x *MsgTopUpContractResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgModProviderResponse) ProtoMessage() {}
+func (*MsgTopUpContractResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgModProviderResponse.ProtoReflect.Descriptor instead.
-func (*MsgModProviderResponse) Descriptor() ([]byte, []int) {
-	return file_arkeo_arkeo_tx_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use MsgTopUpContractResponse.ProtoReflect.Descriptor instead.
+func (*MsgTopUpContractResponse) Descriptor() ([]byte, []int) {
+	return file_arkeo_arkeo_tx_proto_rawDescGZIP(), []int{19}
 }
 
-type MsgOpenContract struct {
+// ContractClaim claim of the income of a contract, as MsgClaimContractIncome
+type ContractClaim struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Creator            string                `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	Provider           string                `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
-	Service            string                `protobuf:"bytes,3,opt,name=service,proto3" json:"service,omitempty"`
-	Client             string                `protobuf:"bytes,4,opt,name=client,proto3" json:"client,omitempty"`
-	Delegate           string                `protobuf:"bytes,5,opt,name=delegate,proto3" json:"delegate,omitempty"`
-	ContractType       ContractType          `protobuf:"varint,6,opt,name=contract_type,json=contractType,proto3,enum=arkeo.arkeo.ContractType" json:"contract_type,omitempty"`
-	Duration           int64                 `protobuf:"varint,7,opt,name=duration,proto3" json:"duration,omitempty"`
-	Rate               *v1beta1.Coin         `protobuf:"bytes,8,opt,name=rate,proto3" json:"rate,omitempty"`
-	Deposit            string                `protobuf:"bytes,9,opt,name=deposit,proto3" json:"deposit,omitempty"`
-	SettlementDuration int64                 `protobuf:"varint,10,opt,name=settlement_duration,json=settlementDuration,proto3" json:"settlement_duration,omitempty"`
-	Authorization      ContractAuthorization `protobuf:"varint,11,opt,name=authorization,proto3,enum=arkeo.arkeo.ContractAuthorization" json:"authorization,omitempty"`
-	QueriesPerMinute   int64                 `protobuf:"varint,12,opt,name=queries_per_minute,json=queriesPerMinute,proto3" json:"queries_per_minute,omitempty"`
+	ContractId uint64 `protobuf:"varint,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	Nonce      int64  `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	Signature  []byte `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
 }
 
-func (x *MsgOpenContract) Reset() {
-	*x = MsgOpenContract{}
+func (x *ContractClaim) Reset() {
+	*x = ContractClaim{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_arkeo_arkeo_tx_proto_msgTypes[4]
+		mi := &file_arkeo_arkeo_tx_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgOpenContract) String() string {
+func (x *ContractClaim) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgOpenContract) ProtoMessage() {}
-
-// Deprecated: Use MsgOpenContract.ProtoReflect.Descriptor instead.
-func (*MsgOpenContract) Descriptor() ([]byte, []int) {
-	return file_arkeo_arkeo_tx_proto_rawDescGZIP(), []int{4}
-}
+func (*ContractClaim) ProtoMessage() {}
 
-func (x *MsgOpenContract) GetCreator() string {
-	if x != nil {
-		return x.Creator
-	}
-	return ""
+// Deprecated: Use ContractClaim.ProtoReflect.Descriptor instead.
+func (*ContractClaim) Descriptor() ([]byte, []int) {
+	return file_arkeo_arkeo_tx_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *MsgOpenContract) GetProvider() string {
+func (x *ContractClaim) GetContractId() uint64 {
 	if x != nil {
-		return x.Provider
+		return x.ContractId
 	}
-	return ""
+	return 0
 }
 
-func (x *MsgOpenContract) GetService() string {
+func (x *ContractClaim) GetNonce() int64 {
 	if x != nil {
-		return x.Service
+		return x.Nonce
 	}
-	return ""
+	return 0
 }
 
-func (x *MsgOpenContract) GetClient() string {
+func (x *ContractClaim) GetSignature() []byte {
 	if x != nil {
-		return x.Client
+		return x.Signature
 	}
-	return ""
+	return nil
 }
 
-func (x *MsgOpenContract) GetDelegate() string {
-	if x != nil {
-		return x.Delegate
-	}
-	return ""
-}
+type MsgClaimContractIncomeBatch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *MsgOpenContract) GetContractType() ContractType {
-	if x != nil {
-		return x.ContractType
-	}
-	return ContractType_SUBSCRIPTION
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// claims are processed in order, each one succeeding or failing on its own
+	Claims []*ContractClaim `protobuf:"bytes,2,rep,name=claims,proto3" json:"claims,omitempty"`
 }
 
-func (x *MsgOpenContract) GetDuration() int64 {
-	if x != nil {
-		return x.Duration
+func (x *MsgClaimContractIncomeBatch) Reset() {
+	*x = MsgClaimContractIncomeBatch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_arkeo_arkeo_tx_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *MsgOpenContract) GetRate() *v1beta1.Coin {
-	if x != nil {
-		return x.Rate
-	}
-	return nil
+func (x *MsgClaimContractIncomeBatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *MsgOpenContract) GetDeposit() string {
-	if x != nil {
-		return x.Deposit
-	}
-	return ""
-}
+func (*MsgClaimContractIncomeBatch) ProtoMessage() {}
 
-func (x *MsgOpenContract) GetSettlementDuration() int64 {
-	if x != nil {
-		return x.SettlementDuration
-	}
-	return 0
+// Deprecated: Use MsgClaimContractIncomeBatch.ProtoReflect.Descriptor instead.
+func (*MsgClaimContractIncomeBatch) Descriptor() ([]byte, []int) {
+	return file_arkeo_arkeo_tx_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *MsgOpenContract) GetAuthorization() ContractAuthorization {
+func (x *MsgClaimContractIncomeBatch) GetCreator() string {
 	if x != nil {
-		return x.Authorization
+		return x.Creator
 	}
-	return ContractAuthorization_STRICT
+	return ""
 }
 
-func (x *MsgOpenContract) GetQueriesPerMinute() int64 {
+func (x *MsgClaimContractIncomeBatch) GetClaims() []*ContractClaim {
 	if x != nil {
-		return x.QueriesPerMinute
+		return x.Claims
 	}
-	return 0
+	return nil
 }
 
-type MsgOpenContractResponse struct {
+type MsgClaimContractIncomeBatchResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Results []*ContractClaimResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
 }
 
-func (x *MsgOpenContractResponse) Reset() {
-	*x = MsgOpenContractResponse{}
+func (x *MsgClaimContractIncomeBatchResponse) Reset() {
+	*x = MsgClaimContractIncomeBatchResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_arkeo_arkeo_tx_proto_msgTypes[5]
+		mi := &file_arkeo_arkeo_tx_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgOpenContractResponse) String() string {
+func (x *MsgClaimContractIncomeBatchResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgOpenContractResponse) ProtoMessage() {}
+func (*MsgClaimContractIncomeBatchResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgOpenContractResponse.ProtoReflect.Descriptor instead.
-func (*MsgOpenContractResponse) Descriptor() ([]byte, []int) {
-	return file_arkeo_arkeo_tx_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use MsgClaimContractIncomeBatchResponse.ProtoReflect.Descriptor instead.
+func (*MsgClaimContractIncomeBatchResponse) Descriptor() ([]byte, []int) {
+	return file_arkeo_arkeo_tx_proto_rawDescGZIP(), []int{22}
 }
 
-type MsgCloseContract struct {
+func (x *MsgClaimContractIncomeBatchResponse) GetResults() []*ContractClaimResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type MsgPurgeExpiredContracts struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Creator    string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	ContractId uint64 `protobuf:"varint,2,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
-	Client     []byte `protobuf:"bytes,3,opt,name=client,proto3" json:"client,omitempty"`
-	Delegate   []byte `protobuf:"bytes,4,opt,name=delegate,proto3" json:"delegate,omitempty"`
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// contracts to purge, each one purged or failing on its own
+	ContractIds []uint64 `protobuf:"varint,2,rep,packed,name=contract_ids,json=contractIds,proto3" json:"contract_ids,omitempty"`
+	// without contract ids, purge the first contracts found purgeable, up to the limit
+	Limit uint64 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
 }
 
-func (x *MsgCloseContract) Reset() {
-	*x = MsgCloseContract{}
+func (x *MsgPurgeExpiredContracts) Reset() {
+	*x = MsgPurgeExpiredContracts{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_arkeo_arkeo_tx_proto_msgTypes[6]
+		mi := &file_arkeo_arkeo_tx_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgCloseContract) String() string {
+func (x *MsgPurgeExpiredContracts) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgCloseContract) ProtoMessage() {}
+func (*MsgPurgeExpiredContracts) ProtoMessage() {}
 
-// Deprecated: Use MsgCloseContract.ProtoReflect.Descriptor instead.
-func (*MsgCloseContract) Descriptor() ([]byte, []int) {
-	return file_arkeo_arkeo_tx_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use MsgPurgeExpiredContracts.ProtoReflect.Descriptor instead.
+func (*MsgPurgeExpiredContracts) Descriptor() ([]byte, []int) {
+	return file_arkeo_arkeo_tx_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *MsgCloseContract) GetCreator() string {
+func (x *MsgPurgeExpiredContracts) GetCreator() string {
 	if x != nil {
 		return x.Creator
 	}
 	return ""
 }
 
-func (x *MsgCloseContract) GetContractId() uint64 {
-	if x != nil {
-		return x.ContractId
-	}
-	return 0
-}
-
-func (x *MsgCloseContract) GetClient() []byte {
+func (x *MsgPurgeExpiredContracts) GetContractIds() []uint64 {
 	if x != nil {
-		return x.Client
+		return x.ContractIds
 	}
 	return nil
 }
 
-func (x *MsgCloseContract) GetDelegate() []byte {
+func (x *MsgPurgeExpiredContracts) GetLimit() uint64 {
 	if x != nil {
-		return x.Delegate
+		return x.Limit
 	}
-	return nil
+	return 0
 }
 
-type MsgCloseContractResponse struct {
+type MsgPurgeExpiredContractsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Results []*ContractPurgeResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	// paid to the creator for the contracts purged
+	Reward *v1beta1.Coin `protobuf:"bytes,2,opt,name=reward,proto3" json:"reward,omitempty"`
 }
 
-func (x *MsgCloseContractResponse) Reset() {
-	*x = MsgCloseContractResponse{}
+func (x *MsgPurgeExpiredContractsResponse) Reset() {
+	*x = MsgPurgeExpiredContractsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_arkeo_arkeo_tx_proto_msgTypes[7]
+		mi := &file_arkeo_arkeo_tx_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgCloseContractResponse) String() string {
+func (x *MsgPurgeExpiredContractsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgCloseContractResponse) ProtoMessage() {}
+func (*MsgPurgeExpiredContractsResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgCloseContractResponse.ProtoReflect.Descriptor instead.
-func (*MsgCloseContractResponse) Descriptor() ([]byte, []int) {
-	return file_arkeo_arkeo_tx_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use MsgPurgeExpiredContractsResponse.ProtoReflect.Descriptor instead.
+func (*MsgPurgeExpiredContractsResponse) Descriptor() ([]byte, []int) {
+	return file_arkeo_arkeo_tx_proto_rawDescGZIP(), []int{24}
 }
 
-type MsgClaimContractIncome struct {
+func (x *MsgPurgeExpiredContractsResponse) GetResults() []*ContractPurgeResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *MsgPurgeExpiredContractsResponse) GetReward() *v1beta1.Coin {
+	if x != nil {
+		return x.Reward
+	}
+	return nil
+}
+
+type MsgSetProviderAllowlist struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Creator    string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	ContractId uint64 `protobuf:"varint,2,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
-	Signature  []byte `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
-	Nonce      int64  `protobuf:"varint,5,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	Creator  string          `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Provider []byte          `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+	Service  string          `protobuf:"bytes,3,opt,name=service,proto3" json:"service,omitempty"`
+	Action   AllowlistAction `protobuf:"varint,4,opt,name=action,proto3,enum=arkeo.arkeo.AllowlistAction" json:"action,omitempty"`
+	// replacing with no clients opens the provider to all of them again
+	Clients [][]byte `protobuf:"bytes,5,rep,name=clients,proto3" json:"clients,omitempty"`
 }
 
-func (x *MsgClaimContractIncome) Reset() {
-	*x = MsgClaimContractIncome{}
+func (x *MsgSetProviderAllowlist) Reset() {
+	*x = MsgSetProviderAllowlist{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_arkeo_arkeo_tx_proto_msgTypes[8]
+		mi := &file_arkeo_arkeo_tx_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgClaimContractIncome) String() string {
+func (x *MsgSetProviderAllowlist) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgClaimContractIncome) ProtoMessage() {}
+func (*MsgSetProviderAllowlist) ProtoMessage() {}
 
-// Deprecated: Use MsgClaimContractIncome.ProtoReflect.Descriptor instead.
-func (*MsgClaimContractIncome) Descriptor() ([]byte, []int) {
-	return file_arkeo_arkeo_tx_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use MsgSetProviderAllowlist.ProtoReflect.Descriptor instead.
+func (*MsgSetProviderAllowlist) Descriptor() ([]byte, []int) {
+	return file_arkeo_arkeo_tx_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *MsgClaimContractIncome) GetCreator() string {
+func (x *MsgSetProviderAllowlist) GetCreator() string {
 	if x != nil {
 		return x.Creator
 	}
 	return ""
 }
 
-func (x *MsgClaimContractIncome) GetContractId() uint64 {
+func (x *MsgSetProviderAllowlist) GetProvider() []byte {
 	if x != nil {
-		return x.ContractId
+		return x.Provider
 	}
-	return 0
+	return nil
 }
 
-func (x *MsgClaimContractIncome) GetSignature() []byte {
+func (x *MsgSetProviderAllowlist) GetService() string {
 	if x != nil {
-		return x.Signature
+		return x.Service
 	}
-	return nil
+	return ""
 }
 
-func (x *MsgClaimContractIncome) GetNonce() int64 {
+func (x *MsgSetProviderAllowlist) GetAction() AllowlistAction {
 	if x != nil {
-		return x.Nonce
+		return x.Action
 	}
-	return 0
+	return AllowlistAction_ALLOWLIST_REPLACE
 }
 
-type MsgClaimContractIncomeResponse struct {
+func (x *MsgSetProviderAllowlist) GetClients() [][]byte {
+	if x != nil {
+		return x.Clients
+	}
+	return nil
+}
+
+type MsgSetProviderAllowlistResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *MsgClaimContractIncomeResponse) Reset() {
-	*x = MsgClaimContractIncomeResponse{}
+func (x *MsgSetProviderAllowlistResponse) Reset() {
+	*x = MsgSetProviderAllowlistResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_arkeo_arkeo_tx_proto_msgTypes[9]
+		mi := &file_arkeo_arkeo_tx_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgClaimContractIncomeResponse) String() string {
+func (x *MsgSetProviderAllowlistResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgClaimContractIncomeResponse) ProtoMessage() {}
+func (*MsgSetProviderAllowlistResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgClaimContractIncomeResponse.ProtoReflect.Descriptor instead.
-func (*MsgClaimContractIncomeResponse) Descriptor() ([]byte, []int) {
-	return file_arkeo_arkeo_tx_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use MsgSetProviderAllowlistResponse.ProtoReflect.Descriptor instead.
+func (*MsgSetProviderAllowlistResponse) Descriptor() ([]byte, []int) {
+	return file_arkeo_arkeo_tx_proto_rawDescGZIP(), []int{26}
 }
 
 // this line is used by starport scaffolding # proto/tx/message
@@ -7175,7 +16642,7 @@ type MsgSetVersion struct {
 func (x *MsgSetVersion) Reset() {
 	*x = MsgSetVersion{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_arkeo_arkeo_tx_proto_msgTypes[10]
+		mi := &file_arkeo_arkeo_tx_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7189,7 +16656,7 @@ func (*MsgSetVersion) ProtoMessage() {}
 
 // Deprecated: Use MsgSetVersion.ProtoReflect.Descriptor instead.
 func (*MsgSetVersion) Descriptor() ([]byte, []int) {
-	return file_arkeo_arkeo_tx_proto_rawDescGZIP(), []int{10}
+	return file_arkeo_arkeo_tx_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *MsgSetVersion) GetCreator() string {
@@ -7215,7 +16682,7 @@ type MsgSetVersionResponse struct {
 func (x *MsgSetVersionResponse) Reset() {
 	*x = MsgSetVersionResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_arkeo_arkeo_tx_proto_msgTypes[11]
+		mi := &file_arkeo_arkeo_tx_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7229,7 +16696,7 @@ func (*MsgSetVersionResponse) ProtoMessage() {}
 
 // Deprecated: Use MsgSetVersionResponse.ProtoReflect.Descriptor instead.
 func (*MsgSetVersionResponse) Descriptor() ([]byte, []int) {
-	return file_arkeo_arkeo_tx_proto_rawDescGZIP(), []int{11}
+	return file_arkeo_arkeo_tx_proto_rawDescGZIP(), []int{28}
 }
 
 var File_arkeo_arkeo_tx_proto protoreflect.FileDescriptor
@@ -7263,7 +16730,7 @@ var file_arkeo_arkeo_tx_proto_rawDesc = []byte{
 	0x6f, 0x2f, 0x78, 0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2f, 0x4d, 0x73, 0x67, 0x42, 0x6f, 0x6e,
 	0x64, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x22, 0x19, 0x0a, 0x17, 0x4d, 0x73, 0x67,
 	0x42, 0x6f, 0x6e, 0x64, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x8d, 0x05, 0x0a, 0x0e, 0x4d, 0x73, 0x67, 0x4d, 0x6f, 0x64, 0x50,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0xdc, 0x05, 0x0a, 0x0e, 0x4d, 0x73, 0x67, 0x4d, 0x6f, 0x64, 0x50,
 	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x32, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74,
 	0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f,
 	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69,
@@ -7301,141 +16768,357 @@ var file_arkeo_arkeo_tx_proto_rawDesc = []byte{
 	0x52, 0x61, 0x74, 0x65, 0x12, 0x2f, 0x0a, 0x13, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65,
 	0x6e, 0x74, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28,
 	0x03, 0x52, 0x12, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x75, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x2d, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x6f, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x1c, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2f, 0x78, 0x2f,
-	0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2f, 0x4d, 0x73, 0x67, 0x4d, 0x6f, 0x64, 0x50, 0x72, 0x6f, 0x76,
-	0x69, 0x64, 0x65, 0x72, 0x22, 0x18, 0x0a, 0x16, 0x4d, 0x73, 0x67, 0x4d, 0x6f, 0x64, 0x50, 0x72,
-	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xe0,
-	0x04, 0x0a, 0x0f, 0x4d, 0x73, 0x67, 0x4f, 0x70, 0x65, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61,
-	0x63, 0x74, 0x12, 0x32, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
-	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
-	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
-	0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06,
-	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x6c,
-	0x69, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65,
-	0x12, 0x3e, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x74, 0x79, 0x70,
-	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e,
-	0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x54, 0x79,
-	0x70, 0x65, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65,
-	0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x33, 0x0a, 0x04,
-	0x72, 0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
-	0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x04, 0x72, 0x61, 0x74,
-	0x65, 0x12, 0x45, 0x0a, 0x07, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x09, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x2b, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e,
-	0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52,
-	0x07, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x2f, 0x0a, 0x13, 0x73, 0x65, 0x74, 0x74,
-	0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e,
-	0x74, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x48, 0x0a, 0x0d, 0x61, 0x75, 0x74,
-	0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x22, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x43,
-	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x12, 0x71, 0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x5f, 0x70,
-	0x65, 0x72, 0x5f, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x10, 0x71, 0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x50, 0x65, 0x72, 0x4d, 0x69, 0x6e, 0x75, 0x74,
-	0x65, 0x3a, 0x2e, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x8a,
-	0xe7, 0xb0, 0x2a, 0x1d, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2f, 0x78, 0x2f, 0x61, 0x72, 0x6b, 0x65,
-	0x6f, 0x2f, 0x4d, 0x73, 0x67, 0x4f, 0x70, 0x65, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
-	0x74, 0x22, 0x19, 0x0a, 0x17, 0x4d, 0x73, 0x67, 0x4f, 0x70, 0x65, 0x6e, 0x43, 0x6f, 0x6e, 0x74,
-	0x72, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xae, 0x02, 0x0a,
-	0x10, 0x4d, 0x73, 0x67, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f,
+	0x6d, 0x61, 0x73, 0x6b, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x12, 0x2c, 0x0a, 0x12, 0x6d, 0x61, 0x78, 0x5f, 0x6f, 0x70,
+	0x65, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x73, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x10, 0x6d, 0x61, 0x78, 0x4f, 0x70, 0x65, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72,
+	0x61, 0x63, 0x74, 0x73, 0x3a, 0x2d, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x6f, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x1c, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2f, 0x78, 0x2f, 0x61,
+	0x72, 0x6b, 0x65, 0x6f, 0x2f, 0x4d, 0x73, 0x67, 0x4d, 0x6f, 0x64, 0x50, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x22, 0x18, 0x0a, 0x16, 0x4d, 0x73, 0x67, 0x4d, 0x6f, 0x64, 0x50, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xd2, 0x05,
+	0x0a, 0x0f, 0x4d, 0x73, 0x67, 0x4f, 0x70, 0x65, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
 	0x74, 0x12, 0x32, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41,
 	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
-	0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x74,
-	0x72, 0x61, 0x63, 0x74, 0x49, 0x64, 0x12, 0x47, 0x0a, 0x06, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x12,
+	0x3e, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x61,
+	0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x33, 0x0a, 0x04, 0x72,
+	0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x04, 0x72, 0x61, 0x74, 0x65,
+	0x12, 0x45, 0x0a, 0x07, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x2b, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74,
+	0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x07,
+	0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x2f, 0x0a, 0x13, 0x73, 0x65, 0x74, 0x74, 0x6c,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x48, 0x0a, 0x0d, 0x61, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x22, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x43, 0x6f,
+	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x12, 0x71, 0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x5f, 0x70, 0x65,
+	0x72, 0x5f, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10,
+	0x71, 0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x50, 0x65, 0x72, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65,
+	0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x72, 0x65, 0x6e, 0x65, 0x77, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x12,
+	0x2e, 0x0a, 0x05, 0x70, 0x61, 0x79, 0x65, 0x72, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18,
+	0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x05, 0x70, 0x61, 0x79, 0x65, 0x72, 0x12,
+	0x21, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
+	0x0f, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x48, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x3a, 0x2e, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72,
+	0x8a, 0xe7, 0xb0, 0x2a, 0x1d, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2f, 0x78, 0x2f, 0x61, 0x72, 0x6b,
+	0x65, 0x6f, 0x2f, 0x4d, 0x73, 0x67, 0x4f, 0x70, 0x65, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x22, 0x19, 0x0a, 0x17, 0x4d, 0x73, 0x67, 0x4f, 0x70, 0x65, 0x6e, 0x43, 0x6f, 0x6e,
+	0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xae, 0x02,
+	0x0a, 0x10, 0x4d, 0x73, 0x67, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x12, 0x32, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x61, 0x63, 0x74, 0x49, 0x64, 0x12, 0x47, 0x0a, 0x06, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x2f, 0xfa, 0xde, 0x1f, 0x2b, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x6e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x52, 0x06, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x12, 0x4b, 0x0a, 0x08, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0c, 0x42, 0x2f, 0xfa, 0xde, 0x1f, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f,
+	0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x50, 0x75, 0x62,
+	0x4b, 0x65, 0x79, 0x52, 0x08, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x3a, 0x2f, 0x82,
+	0xe7, 0xb0, 0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x1e,
+	0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2f, 0x78, 0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2f, 0x4d, 0x73,
+	0x67, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x22, 0x1a,
+	0x0a, 0x18, 0x4d, 0x73, 0x67, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xd8, 0x01, 0x0a, 0x16, 0x4d,
+	0x73, 0x67, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x49,
+	0x6e, 0x63, 0x6f, 0x6d, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a,
+	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x3a, 0x35,
+	0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x8a, 0xe7, 0xb0, 0x2a,
+	0x24, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2f, 0x78, 0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2f, 0x4d,
+	0x73, 0x67, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x49,
+	0x6e, 0x63, 0x6f, 0x6d, 0x65, 0x22, 0x20, 0x0a, 0x1e, 0x4d, 0x73, 0x67, 0x43, 0x6c, 0x61, 0x69,
+	0x6d, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xd0, 0x02, 0x0a, 0x10, 0x4d, 0x73, 0x67, 0x52,
+	0x65, 0x6e, 0x65, 0x77, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x32, 0x0a, 0x07,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2,
+	0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72,
+	0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x49,
+	0x64, 0x12, 0x2f, 0x0a, 0x13, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f,
+	0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12,
+	0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x50, 0x0a, 0x0d, 0x65, 0x78, 0x74, 0x72, 0x61, 0x5f, 0x64, 0x65, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2b, 0xc8, 0xde, 0x1f, 0x00, 0xda,
+	0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f,
+	0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x0c, 0x65, 0x78, 0x74, 0x72, 0x61, 0x44, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x12, 0x33, 0x0a, 0x04, 0x72, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8,
+	0xde, 0x1f, 0x00, 0x52, 0x04, 0x72, 0x61, 0x74, 0x65, 0x3a, 0x2f, 0x82, 0xe7, 0xb0, 0x2a, 0x07,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x1e, 0x61, 0x72, 0x6b, 0x65,
+	0x6f, 0x2f, 0x78, 0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2f, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x6e,
+	0x65, 0x77, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x22, 0x1a, 0x0a, 0x18, 0x4d, 0x73,
+	0x67, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xa8, 0x01, 0x0a, 0x18, 0x4d, 0x73, 0x67, 0x50, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72,
+	0x61, 0x63, 0x74, 0x12, 0x32, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x72,
+	0x61, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x63, 0x6f,
+	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x49, 0x64, 0x3a, 0x37, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x26, 0x61, 0x72, 0x6b, 0x65, 0x6f,
+	0x2f, 0x78, 0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2f, 0x4d, 0x73, 0x67, 0x50, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
+	0x74, 0x22, 0x22, 0x0a, 0x20, 0x4d, 0x73, 0x67, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x43, 0x6c, 0x6f, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xee, 0x01, 0x0a, 0x11, 0x4d, 0x73, 0x67, 0x52, 0x6f, 0x74,
+	0x61, 0x74, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4,
+	0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12,
+	0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x49, 0x64,
+	0x12, 0x52, 0x0a, 0x0c, 0x6e, 0x65, 0x77, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65,
 	0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x2f, 0xfa, 0xde, 0x1f, 0x2b, 0x67, 0x69, 0x74, 0x68,
 	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x6e, 0x65, 0x74, 0x77,
 	0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
-	0x2e, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x52, 0x06, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12,
-	0x4b, 0x0a, 0x08, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0c, 0x42, 0x2f, 0xfa, 0xde, 0x1f, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
-	0x6d, 0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61,
-	0x72, 0x6b, 0x65, 0x6f, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x50, 0x75, 0x62, 0x4b,
-	0x65, 0x79, 0x52, 0x08, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x3a, 0x2f, 0x82, 0xe7,
-	0xb0, 0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x1e, 0x61,
-	0x72, 0x6b, 0x65, 0x6f, 0x2f, 0x78, 0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2f, 0x4d, 0x73, 0x67,
-	0x43, 0x6c, 0x6f, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x22, 0x1a, 0x0a,
-	0x18, 0x4d, 0x73, 0x67, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xd8, 0x01, 0x0a, 0x16, 0x4d, 0x73,
-	0x67, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x49, 0x6e,
-	0x63, 0x6f, 0x6d, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52,
-	0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x74,
-	0x72, 0x61, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x63,
-	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67,
-	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69,
-	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x3a, 0x35, 0x82,
-	0xe7, 0xb0, 0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x24,
-	0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2f, 0x78, 0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2f, 0x4d, 0x73,
-	0x67, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x49, 0x6e,
-	0x63, 0x6f, 0x6d, 0x65, 0x22, 0x20, 0x0a, 0x1e, 0x4d, 0x73, 0x67, 0x43, 0x6c, 0x61, 0x69, 0x6d,
-	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x8b, 0x01, 0x0a, 0x0d, 0x4d, 0x73, 0x67, 0x53, 0x65,
-	0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07,
-	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x3a, 0x2c, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63, 0x72, 0x65,
-	0x61, 0x74, 0x6f, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x1b, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2f, 0x78,
-	0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2f, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74, 0x56, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x22, 0x17, 0x0a, 0x15, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74, 0x56, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x93, 0x04,
-	0x0a, 0x03, 0x4d, 0x73, 0x67, 0x12, 0x52, 0x0a, 0x0c, 0x42, 0x6f, 0x6e, 0x64, 0x50, 0x72, 0x6f,
-	0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x1c, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x61, 0x72,
-	0x6b, 0x65, 0x6f, 0x2e, 0x4d, 0x73, 0x67, 0x42, 0x6f, 0x6e, 0x64, 0x50, 0x72, 0x6f, 0x76, 0x69,
-	0x64, 0x65, 0x72, 0x1a, 0x24, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x61, 0x72, 0x6b, 0x65,
-	0x6f, 0x2e, 0x4d, 0x73, 0x67, 0x42, 0x6f, 0x6e, 0x64, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
-	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x0b, 0x4d, 0x6f, 0x64,
-	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x1b, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f,
-	0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x4d, 0x73, 0x67, 0x4d, 0x6f, 0x64, 0x50, 0x72, 0x6f,
-	0x76, 0x69, 0x64, 0x65, 0x72, 0x1a, 0x23, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x61, 0x72,
+	0x2e, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x52, 0x0b, 0x6e, 0x65, 0x77, 0x44, 0x65, 0x6c, 0x65,
+	0x67, 0x61, 0x74, 0x65, 0x3a, 0x30, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x6f, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x1f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2f, 0x78, 0x2f, 0x61,
+	0x72, 0x6b, 0x65, 0x6f, 0x2f, 0x4d, 0x73, 0x67, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x44, 0x65,
+	0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x22, 0x1b, 0x0a, 0x19, 0x4d, 0x73, 0x67, 0x52, 0x6f, 0x74,
+	0x61, 0x74, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0xb5, 0x01, 0x0a, 0x0f, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74, 0x41, 0x75,
+	0x74, 0x6f, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x12, 0x32, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x63,
+	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a,
+	0x61, 0x75, 0x74, 0x6f, 0x5f, 0x72, 0x65, 0x6e, 0x65, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x09, 0x61, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x3a, 0x2e, 0x82, 0xe7, 0xb0,
+	0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x1d, 0x61, 0x72,
+	0x6b, 0x65, 0x6f, 0x2f, 0x78, 0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2f, 0x4d, 0x73, 0x67, 0x53,
+	0x65, 0x74, 0x41, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x22, 0x19, 0x0a, 0x17, 0x4d,
+	0x73, 0x67, 0x53, 0x65, 0x74, 0x41, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xdf, 0x01, 0x0a, 0x10, 0x4d, 0x73, 0x67, 0x54, 0x6f,
+	0x70, 0x55, 0x70, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x32, 0x0a, 0x07, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4,
+	0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12,
+	0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x49, 0x64,
+	0x12, 0x45, 0x0a, 0x07, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x2b, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74,
+	0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x07,
+	0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x3a, 0x2f, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x6f, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x1e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2f,
+	0x78, 0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2f, 0x4d, 0x73, 0x67, 0x54, 0x6f, 0x70, 0x55, 0x70,
+	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x22, 0x1a, 0x0a, 0x18, 0x4d, 0x73, 0x67, 0x54,
+	0x6f, 0x70, 0x55, 0x70, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x64, 0x0a, 0x0d, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
+	0x43, 0x6c, 0x61, 0x69, 0x6d, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09,
+	0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0xc7, 0x01, 0x0a, 0x1b, 0x4d,
+	0x73, 0x67, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x49,
+	0x6e, 0x63, 0x6f, 0x6d, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x32, 0x0a, 0x07, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d,
+	0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x38,
+	0x0a, 0x06, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x43, 0x6f, 0x6e,
+	0x74, 0x72, 0x61, 0x63, 0x74, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00,
+	0x52, 0x06, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x73, 0x3a, 0x3a, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x29, 0x61, 0x72, 0x6b, 0x65, 0x6f,
+	0x2f, 0x78, 0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2f, 0x4d, 0x73, 0x67, 0x43, 0x6c, 0x61, 0x69,
+	0x6d, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x65, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x22, 0x67, 0x0a, 0x23, 0x4d, 0x73, 0x67, 0x43, 0x6c, 0x61, 0x69, 0x6d,
+	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x65, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x07, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x61,
+	0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72,
+	0x61, 0x63, 0x74, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x42, 0x04,
+	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0xc0, 0x01,
+	0x0a, 0x18, 0x4d, 0x73, 0x67, 0x50, 0x75, 0x72, 0x67, 0x65, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x73, 0x12, 0x32, 0x0a, 0x07, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d,
+	0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x21,
+	0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x04, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x49, 0x64,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x3a, 0x37, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x6f, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x26, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2f,
+	0x78, 0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2f, 0x4d, 0x73, 0x67, 0x50, 0x75, 0x72, 0x67, 0x65,
+	0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x73,
+	0x22, 0x9d, 0x01, 0x0a, 0x20, 0x4d, 0x73, 0x67, 0x50, 0x75, 0x72, 0x67, 0x65, 0x45, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x61,
+	0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x50, 0x75, 0x72,
+	0x67, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x07,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x37, 0x0a, 0x06, 0x72, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f,
+	0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x22, 0xed, 0x02, 0x0a, 0x17, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x07,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2,
+	0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72,
+	0x12, 0x4b, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0c, 0x42, 0x2f, 0xfa, 0xde, 0x1f, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f,
+	0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x50, 0x75, 0x62,
+	0x4b, 0x65, 0x79, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x18, 0x0a,
+	0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x34, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e,
+	0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x41,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x49, 0x0a,
+	0x07, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0c, 0x42, 0x2f,
+	0xfa, 0xde, 0x1f, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61,
+	0x72, 0x6b, 0x65, 0x6f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x72, 0x6b, 0x65,
+	0x6f, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x52,
+	0x07, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x3a, 0x36, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x25, 0x61, 0x72, 0x6b, 0x65, 0x6f,
+	0x2f, 0x78, 0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2f, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74, 0x50,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74,
+	0x22, 0x21, 0x0a, 0x1f, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x8b, 0x01, 0x0a, 0x0d, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x3a, 0x2c, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f,
+	0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x1b, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2f, 0x78, 0x2f, 0x61, 0x72,
+	0x6b, 0x65, 0x6f, 0x2f, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x22, 0x17, 0x0a, 0x15, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xb1, 0x0a, 0x0a, 0x03, 0x4d,
+	0x73, 0x67, 0x12, 0x52, 0x0a, 0x0c, 0x42, 0x6f, 0x6e, 0x64, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x12, 0x1c, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f,
+	0x2e, 0x4d, 0x73, 0x67, 0x42, 0x6f, 0x6e, 0x64, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x1a, 0x24, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x4d,
+	0x73, 0x67, 0x42, 0x6f, 0x6e, 0x64, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x0b, 0x4d, 0x6f, 0x64, 0x50, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x1b, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x61, 0x72,
 	0x6b, 0x65, 0x6f, 0x2e, 0x4d, 0x73, 0x67, 0x4d, 0x6f, 0x64, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64,
-	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x0c, 0x4f, 0x70,
-	0x65, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x1c, 0x2e, 0x61, 0x72, 0x6b,
-	0x65, 0x6f, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x4d, 0x73, 0x67, 0x4f, 0x70, 0x65, 0x6e,
-	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x1a, 0x24, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f,
-	0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x4d, 0x73, 0x67, 0x4f, 0x70, 0x65, 0x6e, 0x43, 0x6f,
-	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55,
-	0x0a, 0x0d, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12,
-	0x1d, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x4d, 0x73,
-	0x67, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x1a, 0x25,
+	0x65, 0x72, 0x1a, 0x23, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f,
+	0x2e, 0x4d, 0x73, 0x67, 0x4d, 0x6f, 0x64, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x0c, 0x4f, 0x70, 0x65, 0x6e, 0x43,
+	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x1c, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e,
+	0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x4d, 0x73, 0x67, 0x4f, 0x70, 0x65, 0x6e, 0x43, 0x6f, 0x6e,
+	0x74, 0x72, 0x61, 0x63, 0x74, 0x1a, 0x24, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x61, 0x72,
+	0x6b, 0x65, 0x6f, 0x2e, 0x4d, 0x73, 0x67, 0x4f, 0x70, 0x65, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72,
+	0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x0d, 0x43,
+	0x6c, 0x6f, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x1d, 0x2e, 0x61,
+	0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x6c,
+	0x6f, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x1a, 0x25, 0x2e, 0x61, 0x72,
+	0x6b, 0x65, 0x6f, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x6c, 0x6f,
+	0x73, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x67, 0x0a, 0x13, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x43, 0x6f, 0x6e, 0x74, 0x72,
+	0x61, 0x63, 0x74, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x65, 0x12, 0x23, 0x2e, 0x61, 0x72, 0x6b, 0x65,
+	0x6f, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x6c, 0x61, 0x69, 0x6d,
+	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x65, 0x1a, 0x2b,
 	0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x4d, 0x73, 0x67,
-	0x43, 0x6c, 0x6f, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x67, 0x0a, 0x13, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x43, 0x6f,
-	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x65, 0x12, 0x23, 0x2e, 0x61,
+	0x43, 0x6c, 0x61, 0x69, 0x6d, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x49, 0x6e, 0x63,
+	0x6f, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x0d, 0x52,
+	0x65, 0x6e, 0x65, 0x77, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x1d, 0x2e, 0x61,
+	0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65,
+	0x6e, 0x65, 0x77, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x1a, 0x25, 0x2e, 0x61, 0x72,
+	0x6b, 0x65, 0x6f, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x6e,
+	0x65, 0x77, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x6d, 0x0a, 0x15, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x43, 0x6c,
+	0x6f, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x25, 0x2e, 0x61, 0x72,
+	0x6b, 0x65, 0x6f, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x4d, 0x73, 0x67, 0x50, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x1a, 0x2d, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f,
+	0x2e, 0x4d, 0x73, 0x67, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x43, 0x6c, 0x6f, 0x73,
+	0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x58, 0x0a, 0x0e, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x67,
+	0x61, 0x74, 0x65, 0x12, 0x1e, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x61, 0x72, 0x6b, 0x65,
+	0x6f, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x67,
+	0x61, 0x74, 0x65, 0x1a, 0x26, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x61, 0x72, 0x6b, 0x65,
+	0x6f, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x67,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x0c, 0x53,
+	0x65, 0x74, 0x41, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x12, 0x1c, 0x2e, 0x61, 0x72,
+	0x6b, 0x65, 0x6f, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74,
+	0x41, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x1a, 0x24, 0x2e, 0x61, 0x72, 0x6b, 0x65,
+	0x6f, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74, 0x41, 0x75,
+	0x74, 0x6f, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x55, 0x0a, 0x0d, 0x54, 0x6f, 0x70, 0x55, 0x70, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
+	0x12, 0x1d, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x4d,
+	0x73, 0x67, 0x54, 0x6f, 0x70, 0x55, 0x70, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x1a,
+	0x25, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x4d, 0x73,
+	0x67, 0x54, 0x6f, 0x70, 0x55, 0x70, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x76, 0x0a, 0x18, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x43,
+	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x65, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x12, 0x28, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f,
+	0x2e, 0x4d, 0x73, 0x67, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
+	0x74, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x1a, 0x30, 0x2e, 0x61,
 	0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x6c,
 	0x61, 0x69, 0x6d, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x49, 0x6e, 0x63, 0x6f, 0x6d,
-	0x65, 0x1a, 0x2b, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e,
-	0x4d, 0x73, 0x67, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
-	0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c,
-	0x0a, 0x0a, 0x53, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x2e, 0x61,
-	0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x65,
-	0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x1a, 0x22, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f,
-	0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74, 0x56, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x1a, 0x05, 0x80, 0xe7,
-	0xb0, 0x2a, 0x01, 0x42, 0x85, 0x01, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x72, 0x6b, 0x65,
-	0x6f, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x42, 0x07, 0x54, 0x78, 0x50, 0x72, 0x6f, 0x74, 0x6f,
-	0x50, 0x01, 0x5a, 0x1c, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
-	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f,
-	0xa2, 0x02, 0x03, 0x41, 0x41, 0x58, 0xaa, 0x02, 0x0b, 0x41, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x41,
-	0x72, 0x6b, 0x65, 0x6f, 0xca, 0x02, 0x0b, 0x41, 0x72, 0x6b, 0x65, 0x6f, 0x5c, 0x41, 0x72, 0x6b,
-	0x65, 0x6f, 0xe2, 0x02, 0x17, 0x41, 0x72, 0x6b, 0x65, 0x6f, 0x5c, 0x41, 0x72, 0x6b, 0x65, 0x6f,
-	0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0c, 0x41,
-	0x72, 0x6b, 0x65, 0x6f, 0x3a, 0x3a, 0x41, 0x72, 0x6b, 0x65, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6d,
+	0x0a, 0x15, 0x50, 0x75, 0x72, 0x67, 0x65, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x43, 0x6f,
+	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x73, 0x12, 0x25, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e,
+	0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x4d, 0x73, 0x67, 0x50, 0x75, 0x72, 0x67, 0x65, 0x45, 0x78,
+	0x70, 0x69, 0x72, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x73, 0x1a, 0x2d,
+	0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x4d, 0x73, 0x67,
+	0x50, 0x75, 0x72, 0x67, 0x65, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6a, 0x0a,
+	0x14, 0x53, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x41, 0x6c, 0x6c, 0x6f,
+	0x77, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x24, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x61, 0x72,
+	0x6b, 0x65, 0x6f, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x61, 0x72,
+	0x6b, 0x65, 0x6f, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74,
+	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x0a, 0x53, 0x65, 0x74,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e,
+	0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x1a, 0x22, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x61, 0x72, 0x6b, 0x65,
+	0x6f, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x1a, 0x05, 0x80, 0xe7, 0xb0, 0x2a, 0x01, 0x42, 0x85,
+	0x01, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x61, 0x72, 0x6b,
+	0x65, 0x6f, 0x42, 0x07, 0x54, 0x78, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x1c, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0xa2, 0x02, 0x03, 0x41, 0x41,
+	0x58, 0xaa, 0x02, 0x0b, 0x41, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x41, 0x72, 0x6b, 0x65, 0x6f, 0xca,
+	0x02, 0x0b, 0x41, 0x72, 0x6b, 0x65, 0x6f, 0x5c, 0x41, 0x72, 0x6b, 0x65, 0x6f, 0xe2, 0x02, 0x17,
+	0x41, 0x72, 0x6b, 0x65, 0x6f, 0x5c, 0x41, 0x72, 0x6b, 0x65, 0x6f, 0x5c, 0x47, 0x50, 0x42, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0c, 0x41, 0x72, 0x6b, 0x65, 0x6f, 0x3a,
+	0x3a, 0x41, 0x72, 0x6b, 0x65, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -7450,49 +17133,91 @@ func file_arkeo_arkeo_tx_proto_rawDescGZIP() []byte {
 	return file_arkeo_arkeo_tx_proto_rawDescData
 }
 
-var file_arkeo_arkeo_tx_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_arkeo_arkeo_tx_proto_msgTypes = make([]protoimpl.MessageInfo, 29)
 var file_arkeo_arkeo_tx_proto_goTypes = []interface{}{
-	(*MsgBondProvider)(nil),                // 0: arkeo.arkeo.MsgBondProvider
-	(*MsgBondProviderResponse)(nil),        // 1: arkeo.arkeo.MsgBondProviderResponse
-	(*MsgModProvider)(nil),                 // 2: arkeo.arkeo.MsgModProvider
-	(*MsgModProviderResponse)(nil),         // 3: arkeo.arkeo.MsgModProviderResponse
-	(*MsgOpenContract)(nil),                // 4: arkeo.arkeo.MsgOpenContract
-	(*MsgOpenContractResponse)(nil),        // 5: arkeo.arkeo.MsgOpenContractResponse
-	(*MsgCloseContract)(nil),               // 6: arkeo.arkeo.MsgCloseContract
-	(*MsgCloseContractResponse)(nil),       // 7: arkeo.arkeo.MsgCloseContractResponse
-	(*MsgClaimContractIncome)(nil),         // 8: arkeo.arkeo.MsgClaimContractIncome
-	(*MsgClaimContractIncomeResponse)(nil), // 9: arkeo.arkeo.MsgClaimContractIncomeResponse
-	(*MsgSetVersion)(nil),                  // 10: arkeo.arkeo.MsgSetVersion
-	(*MsgSetVersionResponse)(nil),          // 11: arkeo.arkeo.MsgSetVersionResponse
-	(ProviderStatus)(0),                    // 12: arkeo.arkeo.ProviderStatus
-	(*v1beta1.Coin)(nil),                   // 13: cosmos.base.v1beta1.Coin
-	(ContractType)(0),                      // 14: arkeo.arkeo.ContractType
-	(ContractAuthorization)(0),             // 15: arkeo.arkeo.ContractAuthorization
+	(*MsgBondProvider)(nil),                     // 0: arkeo.arkeo.MsgBondProvider
+	(*MsgBondProviderResponse)(nil),             // 1: arkeo.arkeo.MsgBondProviderResponse
+	(*MsgModProvider)(nil),                      // 2: arkeo.arkeo.MsgModProvider
+	(*MsgModProviderResponse)(nil),              // 3: arkeo.arkeo.MsgModProviderResponse
+	(*MsgOpenContract)(nil),                     // 4: arkeo.arkeo.MsgOpenContract
+	(*MsgOpenContractResponse)(nil),             // 5: arkeo.arkeo.MsgOpenContractResponse
+	(*MsgCloseContract)(nil),                    // 6: arkeo.arkeo.MsgCloseContract
+	(*MsgCloseContractResponse)(nil),            // 7: arkeo.arkeo.MsgCloseContractResponse
+	(*MsgClaimContractIncome)(nil),              // 8: arkeo.arkeo.MsgClaimContractIncome
+	(*MsgClaimContractIncomeResponse)(nil),      // 9: arkeo.arkeo.MsgClaimContractIncomeResponse
+	(*MsgRenewContract)(nil),                    // 10: arkeo.arkeo.MsgRenewContract
+	(*MsgRenewContractResponse)(nil),            // 11: arkeo.arkeo.MsgRenewContractResponse
+	(*MsgProviderCloseContract)(nil),            // 12: arkeo.arkeo.MsgProviderCloseContract
+	(*MsgProviderCloseContractResponse)(nil),    // 13: arkeo.arkeo.MsgProviderCloseContractResponse
+	(*MsgRotateDelegate)(nil),                   // 14: arkeo.arkeo.MsgRotateDelegate
+	(*MsgRotateDelegateResponse)(nil),           // 15: arkeo.arkeo.MsgRotateDelegateResponse
+	(*MsgSetAutoRenew)(nil),                     // 16: arkeo.arkeo.MsgSetAutoRenew
+	(*MsgSetAutoRenewResponse)(nil),             // 17: arkeo.arkeo.MsgSetAutoRenewResponse
+	(*MsgTopUpContract)(nil),                    // 18: arkeo.arkeo.MsgTopUpContract
+	(*MsgTopUpContractResponse)(nil),            // 19: arkeo.arkeo.MsgTopUpContractResponse
+	(*ContractClaim)(nil),                       // 20: arkeo.arkeo.ContractClaim
+	(*MsgClaimContractIncomeBatch)(nil),         // 21: arkeo.arkeo.MsgClaimContractIncomeBatch
+	(*MsgClaimContractIncomeBatchResponse)(nil), // 22: arkeo.arkeo.MsgClaimContractIncomeBatchResponse
+	(*MsgPurgeExpiredContracts)(nil),            // 23: arkeo.arkeo.MsgPurgeExpiredContracts
+	(*MsgPurgeExpiredContractsResponse)(nil),    // 24: arkeo.arkeo.MsgPurgeExpiredContractsResponse
+	(*MsgSetProviderAllowlist)(nil),             // 25: arkeo.arkeo.MsgSetProviderAllowlist
+	(*MsgSetProviderAllowlistResponse)(nil),     // 26: arkeo.arkeo.MsgSetProviderAllowlistResponse
+	(*MsgSetVersion)(nil),                       // 27: arkeo.arkeo.MsgSetVersion
+	(*MsgSetVersionResponse)(nil),               // 28: arkeo.arkeo.MsgSetVersionResponse
+	(ProviderStatus)(0),                         // 29: arkeo.arkeo.ProviderStatus
+	(*v1beta1.Coin)(nil),                        // 30: cosmos.base.v1beta1.Coin
+	(ContractType)(0),                           // 31: arkeo.arkeo.ContractType
+	(ContractAuthorization)(0),                  // 32: arkeo.arkeo.ContractAuthorization
+	(*ContractClaimResult)(nil),                 // 33: arkeo.arkeo.ContractClaimResult
+	(*ContractPurgeResult)(nil),                 // 34: arkeo.arkeo.ContractPurgeResult
+	(AllowlistAction)(0),                        // 35: arkeo.arkeo.AllowlistAction
 }
 var file_arkeo_arkeo_tx_proto_depIdxs = []int32{
-	12, // 0: arkeo.arkeo.MsgModProvider.status:type_name -> arkeo.arkeo.ProviderStatus
-	13, // 1: arkeo.arkeo.MsgModProvider.subscription_rate:type_name -> cosmos.base.v1beta1.Coin
-	13, // 2: arkeo.arkeo.MsgModProvider.pay_as_you_go_rate:type_name -> cosmos.base.v1beta1.Coin
-	14, // 3: arkeo.arkeo.MsgOpenContract.contract_type:type_name -> arkeo.arkeo.ContractType
-	13, // 4: arkeo.arkeo.MsgOpenContract.rate:type_name -> cosmos.base.v1beta1.Coin
-	15, // 5: arkeo.arkeo.MsgOpenContract.authorization:type_name -> arkeo.arkeo.ContractAuthorization
-	0,  // 6: arkeo.arkeo.Msg.BondProvider:input_type -> arkeo.arkeo.MsgBondProvider
-	2,  // 7: arkeo.arkeo.Msg.ModProvider:input_type -> arkeo.arkeo.MsgModProvider
-	4,  // 8: arkeo.arkeo.Msg.OpenContract:input_type -> arkeo.arkeo.MsgOpenContract
-	6,  // 9: arkeo.arkeo.Msg.CloseContract:input_type -> arkeo.arkeo.MsgCloseContract
-	8,  // 10: arkeo.arkeo.Msg.ClaimContractIncome:input_type -> arkeo.arkeo.MsgClaimContractIncome
-	10, // 11: arkeo.arkeo.Msg.SetVersion:input_type -> arkeo.arkeo.MsgSetVersion
-	1,  // 12: arkeo.arkeo.Msg.BondProvider:output_type -> arkeo.arkeo.MsgBondProviderResponse
-	3,  // 13: arkeo.arkeo.Msg.ModProvider:output_type -> arkeo.arkeo.MsgModProviderResponse
-	5,  // 14: arkeo.arkeo.Msg.OpenContract:output_type -> arkeo.arkeo.MsgOpenContractResponse
-	7,  // 15: arkeo.arkeo.Msg.CloseContract:output_type -> arkeo.arkeo.MsgCloseContractResponse
-	9,  // 16: arkeo.arkeo.Msg.ClaimContractIncome:output_type -> arkeo.arkeo.MsgClaimContractIncomeResponse
-	11, // 17: arkeo.arkeo.Msg.SetVersion:output_type -> arkeo.arkeo.MsgSetVersionResponse
-	12, // [12:18] is the sub-list for method output_type
-	6,  // [6:12] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+	29, // 0: arkeo.arkeo.MsgModProvider.status:type_name -> arkeo.arkeo.ProviderStatus
+	30, // 1: arkeo.arkeo.MsgModProvider.subscription_rate:type_name -> cosmos.base.v1beta1.Coin
+	30, // 2: arkeo.arkeo.MsgModProvider.pay_as_you_go_rate:type_name -> cosmos.base.v1beta1.Coin
+	31, // 3: arkeo.arkeo.MsgOpenContract.contract_type:type_name -> arkeo.arkeo.ContractType
+	30, // 4: arkeo.arkeo.MsgOpenContract.rate:type_name -> cosmos.base.v1beta1.Coin
+	32, // 5: arkeo.arkeo.MsgOpenContract.authorization:type_name -> arkeo.arkeo.ContractAuthorization
+	30, // 6: arkeo.arkeo.MsgRenewContract.rate:type_name -> cosmos.base.v1beta1.Coin
+	20, // 7: arkeo.arkeo.MsgClaimContractIncomeBatch.claims:type_name -> arkeo.arkeo.ContractClaim
+	33, // 8: arkeo.arkeo.MsgClaimContractIncomeBatchResponse.results:type_name -> arkeo.arkeo.ContractClaimResult
+	34, // 9: arkeo.arkeo.MsgPurgeExpiredContractsResponse.results:type_name -> arkeo.arkeo.ContractPurgeResult
+	30, // 10: arkeo.arkeo.MsgPurgeExpiredContractsResponse.reward:type_name -> cosmos.base.v1beta1.Coin
+	35, // 11: arkeo.arkeo.MsgSetProviderAllowlist.action:type_name -> arkeo.arkeo.AllowlistAction
+	0,  // 12: arkeo.arkeo.Msg.BondProvider:input_type -> arkeo.arkeo.MsgBondProvider
+	2,  // 13: arkeo.arkeo.Msg.ModProvider:input_type -> arkeo.arkeo.MsgModProvider
+	4,  // 14: arkeo.arkeo.Msg.OpenContract:input_type -> arkeo.arkeo.MsgOpenContract
+	6,  // 15: arkeo.arkeo.Msg.CloseContract:input_type -> arkeo.arkeo.MsgCloseContract
+	8,  // 16: arkeo.arkeo.Msg.ClaimContractIncome:input_type -> arkeo.arkeo.MsgClaimContractIncome
+	10, // 17: arkeo.arkeo.Msg.RenewContract:input_type -> arkeo.arkeo.MsgRenewContract
+	12, // 18: arkeo.arkeo.Msg.ProviderCloseContract:input_type -> arkeo.arkeo.MsgProviderCloseContract
+	14, // 19: arkeo.arkeo.Msg.RotateDelegate:input_type -> arkeo.arkeo.MsgRotateDelegate
+	16, // 20: arkeo.arkeo.Msg.SetAutoRenew:input_type -> arkeo.arkeo.MsgSetAutoRenew
+	18, // 21: arkeo.arkeo.Msg.TopUpContract:input_type -> arkeo.arkeo.MsgTopUpContract
+	21, // 22: arkeo.arkeo.Msg.ClaimContractIncomeBatch:input_type -> arkeo.arkeo.MsgClaimContractIncomeBatch
+	23, // 23: arkeo.arkeo.Msg.PurgeExpiredContracts:input_type -> arkeo.arkeo.MsgPurgeExpiredContracts
+	25, // 24: arkeo.arkeo.Msg.SetProviderAllowlist:input_type -> arkeo.arkeo.MsgSetProviderAllowlist
+	27, // 25: arkeo.arkeo.Msg.SetVersion:input_type -> arkeo.arkeo.MsgSetVersion
+	1,  // 26: arkeo.arkeo.Msg.BondProvider:output_type -> arkeo.arkeo.MsgBondProviderResponse
+	3,  // 27: arkeo.arkeo.Msg.ModProvider:output_type -> arkeo.arkeo.MsgModProviderResponse
+	5,  // 28: arkeo.arkeo.Msg.OpenContract:output_type -> arkeo.arkeo.MsgOpenContractResponse
+	7,  // 29: arkeo.arkeo.Msg.CloseContract:output_type -> arkeo.arkeo.MsgCloseContractResponse
+	9,  // 30: arkeo.arkeo.Msg.ClaimContractIncome:output_type -> arkeo.arkeo.MsgClaimContractIncomeResponse
+	11, // 31: arkeo.arkeo.Msg.RenewContract:output_type -> arkeo.arkeo.MsgRenewContractResponse
+	13, // 32: arkeo.arkeo.Msg.ProviderCloseContract:output_type -> arkeo.arkeo.MsgProviderCloseContractResponse
+	15, // 33: arkeo.arkeo.Msg.RotateDelegate:output_type -> arkeo.arkeo.MsgRotateDelegateResponse
+	17, // 34: arkeo.arkeo.Msg.SetAutoRenew:output_type -> arkeo.arkeo.MsgSetAutoRenewResponse
+	19, // 35: arkeo.arkeo.Msg.TopUpContract:output_type -> arkeo.arkeo.MsgTopUpContractResponse
+	22, // 36: arkeo.arkeo.Msg.ClaimContractIncomeBatch:output_type -> arkeo.arkeo.MsgClaimContractIncomeBatchResponse
+	24, // 37: arkeo.arkeo.Msg.PurgeExpiredContracts:output_type -> arkeo.arkeo.MsgPurgeExpiredContractsResponse
+	26, // 38: arkeo.arkeo.Msg.SetProviderAllowlist:output_type -> arkeo.arkeo.MsgSetProviderAllowlistResponse
+	28, // 39: arkeo.arkeo.Msg.SetVersion:output_type -> arkeo.arkeo.MsgSetVersionResponse
+	26, // [26:40] is the sub-list for method output_type
+	12, // [12:26] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_arkeo_arkeo_tx_proto_init() }
@@ -7623,7 +17348,7 @@ func file_arkeo_arkeo_tx_proto_init() {
 			}
 		}
 		file_arkeo_arkeo_tx_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgSetVersion); i {
+			switch v := v.(*MsgRenewContract); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7635,6 +17360,210 @@ func file_arkeo_arkeo_tx_proto_init() {
 			}
 		}
 		file_arkeo_arkeo_tx_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgRenewContractResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_arkeo_arkeo_tx_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgProviderCloseContract); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_arkeo_arkeo_tx_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgProviderCloseContractResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_arkeo_arkeo_tx_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgRotateDelegate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_arkeo_arkeo_tx_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgRotateDelegateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_arkeo_arkeo_tx_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgSetAutoRenew); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_arkeo_arkeo_tx_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgSetAutoRenewResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_arkeo_arkeo_tx_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgTopUpContract); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_arkeo_arkeo_tx_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgTopUpContractResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_arkeo_arkeo_tx_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContractClaim); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_arkeo_arkeo_tx_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgClaimContractIncomeBatch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_arkeo_arkeo_tx_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgClaimContractIncomeBatchResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_arkeo_arkeo_tx_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgPurgeExpiredContracts); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_arkeo_arkeo_tx_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgPurgeExpiredContractsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_arkeo_arkeo_tx_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgSetProviderAllowlist); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_arkeo_arkeo_tx_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgSetProviderAllowlistResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_arkeo_arkeo_tx_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgSetVersion); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_arkeo_arkeo_tx_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MsgSetVersionResponse); i {
 			case 0:
 				return &v.state
@@ -7653,7 +17582,7 @@ func file_arkeo_arkeo_tx_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_arkeo_arkeo_tx_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   12,
+			NumMessages:   29,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
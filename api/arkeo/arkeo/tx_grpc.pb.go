@@ -23,6 +23,14 @@ type MsgClient interface {
 	OpenContract(ctx context.Context, in *MsgOpenContract, opts ...grpc.CallOption) (*MsgOpenContractResponse, error)
 	CloseContract(ctx context.Context, in *MsgCloseContract, opts ...grpc.CallOption) (*MsgCloseContractResponse, error)
 	ClaimContractIncome(ctx context.Context, in *MsgClaimContractIncome, opts ...grpc.CallOption) (*MsgClaimContractIncomeResponse, error)
+	RenewContract(ctx context.Context, in *MsgRenewContract, opts ...grpc.CallOption) (*MsgRenewContractResponse, error)
+	ProviderCloseContract(ctx context.Context, in *MsgProviderCloseContract, opts ...grpc.CallOption) (*MsgProviderCloseContractResponse, error)
+	RotateDelegate(ctx context.Context, in *MsgRotateDelegate, opts ...grpc.CallOption) (*MsgRotateDelegateResponse, error)
+	SetAutoRenew(ctx context.Context, in *MsgSetAutoRenew, opts ...grpc.CallOption) (*MsgSetAutoRenewResponse, error)
+	TopUpContract(ctx context.Context, in *MsgTopUpContract, opts ...grpc.CallOption) (*MsgTopUpContractResponse, error)
+	ClaimContractIncomeBatch(ctx context.Context, in *MsgClaimContractIncomeBatch, opts ...grpc.CallOption) (*MsgClaimContractIncomeBatchResponse, error)
+	PurgeExpiredContracts(ctx context.Context, in *MsgPurgeExpiredContracts, opts ...grpc.CallOption) (*MsgPurgeExpiredContractsResponse, error)
+	SetProviderAllowlist(ctx context.Context, in *MsgSetProviderAllowlist, opts ...grpc.CallOption) (*MsgSetProviderAllowlistResponse, error)
 	// this line is used by starport scaffolding # proto/tx/rpc
 	SetVersion(ctx context.Context, in *MsgSetVersion, opts ...grpc.CallOption) (*MsgSetVersionResponse, error)
 }
@@ -80,6 +88,78 @@ func (c *msgClient) ClaimContractIncome(ctx context.Context, in *MsgClaimContrac
 	return out, nil
 }
 
+func (c *msgClient) RenewContract(ctx context.Context, in *MsgRenewContract, opts ...grpc.CallOption) (*MsgRenewContractResponse, error) {
+	out := new(MsgRenewContractResponse)
+	err := c.cc.Invoke(ctx, "/arkeo.arkeo.Msg/RenewContract", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ProviderCloseContract(ctx context.Context, in *MsgProviderCloseContract, opts ...grpc.CallOption) (*MsgProviderCloseContractResponse, error) {
+	out := new(MsgProviderCloseContractResponse)
+	err := c.cc.Invoke(ctx, "/arkeo.arkeo.Msg/ProviderCloseContract", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RotateDelegate(ctx context.Context, in *MsgRotateDelegate, opts ...grpc.CallOption) (*MsgRotateDelegateResponse, error) {
+	out := new(MsgRotateDelegateResponse)
+	err := c.cc.Invoke(ctx, "/arkeo.arkeo.Msg/RotateDelegate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetAutoRenew(ctx context.Context, in *MsgSetAutoRenew, opts ...grpc.CallOption) (*MsgSetAutoRenewResponse, error) {
+	out := new(MsgSetAutoRenewResponse)
+	err := c.cc.Invoke(ctx, "/arkeo.arkeo.Msg/SetAutoRenew", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) TopUpContract(ctx context.Context, in *MsgTopUpContract, opts ...grpc.CallOption) (*MsgTopUpContractResponse, error) {
+	out := new(MsgTopUpContractResponse)
+	err := c.cc.Invoke(ctx, "/arkeo.arkeo.Msg/TopUpContract", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClaimContractIncomeBatch(ctx context.Context, in *MsgClaimContractIncomeBatch, opts ...grpc.CallOption) (*MsgClaimContractIncomeBatchResponse, error) {
+	out := new(MsgClaimContractIncomeBatchResponse)
+	err := c.cc.Invoke(ctx, "/arkeo.arkeo.Msg/ClaimContractIncomeBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) PurgeExpiredContracts(ctx context.Context, in *MsgPurgeExpiredContracts, opts ...grpc.CallOption) (*MsgPurgeExpiredContractsResponse, error) {
+	out := new(MsgPurgeExpiredContractsResponse)
+	err := c.cc.Invoke(ctx, "/arkeo.arkeo.Msg/PurgeExpiredContracts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetProviderAllowlist(ctx context.Context, in *MsgSetProviderAllowlist, opts ...grpc.CallOption) (*MsgSetProviderAllowlistResponse, error) {
+	out := new(MsgSetProviderAllowlistResponse)
+	err := c.cc.Invoke(ctx, "/arkeo.arkeo.Msg/SetProviderAllowlist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) SetVersion(ctx context.Context, in *MsgSetVersion, opts ...grpc.CallOption) (*MsgSetVersionResponse, error) {
 	out := new(MsgSetVersionResponse)
 	err := c.cc.Invoke(ctx, "/arkeo.arkeo.Msg/SetVersion", in, out, opts...)
@@ -98,6 +178,14 @@ type MsgServer interface {
 	OpenContract(context.Context, *MsgOpenContract) (*MsgOpenContractResponse, error)
 	CloseContract(context.Context, *MsgCloseContract) (*MsgCloseContractResponse, error)
 	ClaimContractIncome(context.Context, *MsgClaimContractIncome) (*MsgClaimContractIncomeResponse, error)
+	RenewContract(context.Context, *MsgRenewContract) (*MsgRenewContractResponse, error)
+	ProviderCloseContract(context.Context, *MsgProviderCloseContract) (*MsgProviderCloseContractResponse, error)
+	RotateDelegate(context.Context, *MsgRotateDelegate) (*MsgRotateDelegateResponse, error)
+	SetAutoRenew(context.Context, *MsgSetAutoRenew) (*MsgSetAutoRenewResponse, error)
+	TopUpContract(context.Context, *MsgTopUpContract) (*MsgTopUpContractResponse, error)
+	ClaimContractIncomeBatch(context.Context, *MsgClaimContractIncomeBatch) (*MsgClaimContractIncomeBatchResponse, error)
+	PurgeExpiredContracts(context.Context, *MsgPurgeExpiredContracts) (*MsgPurgeExpiredContractsResponse, error)
+	SetProviderAllowlist(context.Context, *MsgSetProviderAllowlist) (*MsgSetProviderAllowlistResponse, error)
 	// this line is used by starport scaffolding # proto/tx/rpc
 	SetVersion(context.Context, *MsgSetVersion) (*MsgSetVersionResponse, error)
 	mustEmbedUnimplementedMsgServer()
@@ -122,6 +210,30 @@ func (UnimplementedMsgServer) CloseContract(context.Context, *MsgCloseContract)
 func (UnimplementedMsgServer) ClaimContractIncome(context.Context, *MsgClaimContractIncome) (*MsgClaimContractIncomeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ClaimContractIncome not implemented")
 }
+func (UnimplementedMsgServer) RenewContract(context.Context, *MsgRenewContract) (*MsgRenewContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenewContract not implemented")
+}
+func (UnimplementedMsgServer) ProviderCloseContract(context.Context, *MsgProviderCloseContract) (*MsgProviderCloseContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProviderCloseContract not implemented")
+}
+func (UnimplementedMsgServer) RotateDelegate(context.Context, *MsgRotateDelegate) (*MsgRotateDelegateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateDelegate not implemented")
+}
+func (UnimplementedMsgServer) SetAutoRenew(context.Context, *MsgSetAutoRenew) (*MsgSetAutoRenewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAutoRenew not implemented")
+}
+func (UnimplementedMsgServer) TopUpContract(context.Context, *MsgTopUpContract) (*MsgTopUpContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TopUpContract not implemented")
+}
+func (UnimplementedMsgServer) ClaimContractIncomeBatch(context.Context, *MsgClaimContractIncomeBatch) (*MsgClaimContractIncomeBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimContractIncomeBatch not implemented")
+}
+func (UnimplementedMsgServer) PurgeExpiredContracts(context.Context, *MsgPurgeExpiredContracts) (*MsgPurgeExpiredContractsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeExpiredContracts not implemented")
+}
+func (UnimplementedMsgServer) SetProviderAllowlist(context.Context, *MsgSetProviderAllowlist) (*MsgSetProviderAllowlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetProviderAllowlist not implemented")
+}
 func (UnimplementedMsgServer) SetVersion(context.Context, *MsgSetVersion) (*MsgSetVersionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetVersion not implemented")
 }
@@ -228,6 +340,150 @@ func _Msg_ClaimContractIncome_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RenewContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRenewContract)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RenewContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/arkeo.arkeo.Msg/RenewContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RenewContract(ctx, req.(*MsgRenewContract))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ProviderCloseContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgProviderCloseContract)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ProviderCloseContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/arkeo.arkeo.Msg/ProviderCloseContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ProviderCloseContract(ctx, req.(*MsgProviderCloseContract))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RotateDelegate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRotateDelegate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RotateDelegate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/arkeo.arkeo.Msg/RotateDelegate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RotateDelegate(ctx, req.(*MsgRotateDelegate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetAutoRenew_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAutoRenew)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetAutoRenew(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/arkeo.arkeo.Msg/SetAutoRenew",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAutoRenew(ctx, req.(*MsgSetAutoRenew))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TopUpContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTopUpContract)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TopUpContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/arkeo.arkeo.Msg/TopUpContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TopUpContract(ctx, req.(*MsgTopUpContract))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimContractIncomeBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimContractIncomeBatch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimContractIncomeBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/arkeo.arkeo.Msg/ClaimContractIncomeBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimContractIncomeBatch(ctx, req.(*MsgClaimContractIncomeBatch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PurgeExpiredContracts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPurgeExpiredContracts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PurgeExpiredContracts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/arkeo.arkeo.Msg/PurgeExpiredContracts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PurgeExpiredContracts(ctx, req.(*MsgPurgeExpiredContracts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetProviderAllowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetProviderAllowlist)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetProviderAllowlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/arkeo.arkeo.Msg/SetProviderAllowlist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetProviderAllowlist(ctx, req.(*MsgSetProviderAllowlist))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_SetVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgSetVersion)
 	if err := dec(in); err != nil {
@@ -273,6 +529,38 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ClaimContractIncome",
 			Handler:    _Msg_ClaimContractIncome_Handler,
 		},
+		{
+			MethodName: "RenewContract",
+			Handler:    _Msg_RenewContract_Handler,
+		},
+		{
+			MethodName: "ProviderCloseContract",
+			Handler:    _Msg_ProviderCloseContract_Handler,
+		},
+		{
+			MethodName: "RotateDelegate",
+			Handler:    _Msg_RotateDelegate_Handler,
+		},
+		{
+			MethodName: "SetAutoRenew",
+			Handler:    _Msg_SetAutoRenew_Handler,
+		},
+		{
+			MethodName: "TopUpContract",
+			Handler:    _Msg_TopUpContract_Handler,
+		},
+		{
+			MethodName: "ClaimContractIncomeBatch",
+			Handler:    _Msg_ClaimContractIncomeBatch_Handler,
+		},
+		{
+			MethodName: "PurgeExpiredContracts",
+			Handler:    _Msg_PurgeExpiredContracts_Handler,
+		},
+		{
+			MethodName: "SetProviderAllowlist",
+			Handler:    _Msg_SetProviderAllowlist_Handler,
+		},
 		{
 			MethodName: "SetVersion",
 			Handler:    _Msg_SetVersion_Handler,
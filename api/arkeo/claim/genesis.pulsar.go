@@ -65,11 +65,115 @@ func (x *_GenesisState_3_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_GenesisState_4_list)(nil)
+
+type _GenesisState_4_list struct {
+	list *[]*ClaimRound
+}
+
+func (x *_GenesisState_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ClaimRound)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ClaimRound)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_4_list) AppendMutable() protoreflect.Value {
+	v := new(ClaimRound)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_4_list) NewElement() protoreflect.Value {
+	v := new(ClaimRound)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_5_list)(nil)
+
+type _GenesisState_5_list struct {
+	list *[]*ClaimRoundClaim
+}
+
+func (x *_GenesisState_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ClaimRoundClaim)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ClaimRoundClaim)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_5_list) AppendMutable() protoreflect.Value {
+	v := new(ClaimRoundClaim)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_5_list) NewElement() protoreflect.Value {
+	v := new(ClaimRoundClaim)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
 	md_GenesisState                        protoreflect.MessageDescriptor
 	fd_GenesisState_module_account_balance protoreflect.FieldDescriptor
 	fd_GenesisState_params                 protoreflect.FieldDescriptor
 	fd_GenesisState_claim_records          protoreflect.FieldDescriptor
+	fd_GenesisState_claim_rounds           protoreflect.FieldDescriptor
+	fd_GenesisState_claim_round_claims     protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -78,6 +182,8 @@ func init() {
 	fd_GenesisState_module_account_balance = md_GenesisState.Fields().ByName("module_account_balance")
 	fd_GenesisState_params = md_GenesisState.Fields().ByName("params")
 	fd_GenesisState_claim_records = md_GenesisState.Fields().ByName("claim_records")
+	fd_GenesisState_claim_rounds = md_GenesisState.Fields().ByName("claim_rounds")
+	fd_GenesisState_claim_round_claims = md_GenesisState.Fields().ByName("claim_round_claims")
 }
 
 var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
@@ -163,6 +269,18 @@ func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
+	if len(x.ClaimRounds) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_4_list{list: &x.ClaimRounds})
+		if !f(fd_GenesisState_claim_rounds, value) {
+			return
+		}
+	}
+	if len(x.ClaimRoundClaims) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_5_list{list: &x.ClaimRoundClaims})
+		if !f(fd_GenesisState_claim_round_claims, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -184,6 +302,10 @@ func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool
 		return x.Params != nil
 	case "arkeo.claim.GenesisState.claim_records":
 		return len(x.ClaimRecords) != 0
+	case "arkeo.claim.GenesisState.claim_rounds":
+		return len(x.ClaimRounds) != 0
+	case "arkeo.claim.GenesisState.claim_round_claims":
+		return len(x.ClaimRoundClaims) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.claim.GenesisState"))
@@ -206,6 +328,10 @@ func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
 		x.Params = nil
 	case "arkeo.claim.GenesisState.claim_records":
 		x.ClaimRecords = nil
+	case "arkeo.claim.GenesisState.claim_rounds":
+		x.ClaimRounds = nil
+	case "arkeo.claim.GenesisState.claim_round_claims":
+		x.ClaimRoundClaims = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.claim.GenesisState"))
@@ -234,6 +360,18 @@ func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescripto
 		}
 		listValue := &_GenesisState_3_list{list: &x.ClaimRecords}
 		return protoreflect.ValueOfList(listValue)
+	case "arkeo.claim.GenesisState.claim_rounds":
+		if len(x.ClaimRounds) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_4_list{})
+		}
+		listValue := &_GenesisState_4_list{list: &x.ClaimRounds}
+		return protoreflect.ValueOfList(listValue)
+	case "arkeo.claim.GenesisState.claim_round_claims":
+		if len(x.ClaimRoundClaims) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_5_list{})
+		}
+		listValue := &_GenesisState_5_list{list: &x.ClaimRoundClaims}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.claim.GenesisState"))
@@ -262,6 +400,14 @@ func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value
 		lv := value.List()
 		clv := lv.(*_GenesisState_3_list)
 		x.ClaimRecords = *clv.list
+	case "arkeo.claim.GenesisState.claim_rounds":
+		lv := value.List()
+		clv := lv.(*_GenesisState_4_list)
+		x.ClaimRounds = *clv.list
+	case "arkeo.claim.GenesisState.claim_round_claims":
+		lv := value.List()
+		clv := lv.(*_GenesisState_5_list)
+		x.ClaimRoundClaims = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.claim.GenesisState"))
@@ -298,6 +444,18 @@ func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) p
 		}
 		value := &_GenesisState_3_list{list: &x.ClaimRecords}
 		return protoreflect.ValueOfList(value)
+	case "arkeo.claim.GenesisState.claim_rounds":
+		if x.ClaimRounds == nil {
+			x.ClaimRounds = []*ClaimRound{}
+		}
+		value := &_GenesisState_4_list{list: &x.ClaimRounds}
+		return protoreflect.ValueOfList(value)
+	case "arkeo.claim.GenesisState.claim_round_claims":
+		if x.ClaimRoundClaims == nil {
+			x.ClaimRoundClaims = []*ClaimRoundClaim{}
+		}
+		value := &_GenesisState_5_list{list: &x.ClaimRoundClaims}
+		return protoreflect.ValueOfList(value)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.claim.GenesisState"))
@@ -320,6 +478,12 @@ func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor)
 	case "arkeo.claim.GenesisState.claim_records":
 		list := []*ClaimRecord{}
 		return protoreflect.ValueOfList(&_GenesisState_3_list{list: &list})
+	case "arkeo.claim.GenesisState.claim_rounds":
+		list := []*ClaimRound{}
+		return protoreflect.ValueOfList(&_GenesisState_4_list{list: &list})
+	case "arkeo.claim.GenesisState.claim_round_claims":
+		list := []*ClaimRoundClaim{}
+		return protoreflect.ValueOfList(&_GenesisState_5_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.claim.GenesisState"))
@@ -403,6 +567,18 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
+		if len(x.ClaimRounds) > 0 {
+			for _, e := range x.ClaimRounds {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.ClaimRoundClaims) > 0 {
+			for _, e := range x.ClaimRoundClaims {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -432,6 +608,38 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.ClaimRoundClaims) > 0 {
+			for iNdEx := len(x.ClaimRoundClaims) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ClaimRoundClaims[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if len(x.ClaimRounds) > 0 {
+			for iNdEx := len(x.ClaimRounds) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ClaimRounds[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
 		if len(x.ClaimRecords) > 0 {
 			for iNdEx := len(x.ClaimRecords) - 1; iNdEx >= 0; iNdEx-- {
 				encoded, err := options.Marshal(x.ClaimRecords[iNdEx])
@@ -631,6 +839,74 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ClaimRounds", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ClaimRounds = append(x.ClaimRounds, &ClaimRound{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ClaimRounds[len(x.ClaimRounds)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ClaimRoundClaims", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ClaimRoundClaims = append(x.ClaimRoundClaims, &ClaimRoundClaim{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ClaimRoundClaims[len(x.ClaimRoundClaims)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -690,6 +966,10 @@ type GenesisState struct {
 	Params               *Params       `protobuf:"bytes,2,opt,name=params,proto3" json:"params,omitempty"`
 	// list of claim records, one for every airdrop recipient
 	ClaimRecords []*ClaimRecord `protobuf:"bytes,3,rep,name=claim_records,json=claimRecords,proto3" json:"claim_records,omitempty"`
+	// airdrop rounds of the claims of a merkle root
+	ClaimRounds []*ClaimRound `protobuf:"bytes,4,rep,name=claim_rounds,json=claimRounds,proto3" json:"claim_rounds,omitempty"`
+	// the claims the rounds paid already
+	ClaimRoundClaims []*ClaimRoundClaim `protobuf:"bytes,5,rep,name=claim_round_claims,json=claimRoundClaims,proto3" json:"claim_round_claims,omitempty"`
 }
 
 func (x *GenesisState) Reset() {
@@ -733,6 +1013,20 @@ func (x *GenesisState) GetClaimRecords() []*ClaimRecord {
 	return nil
 }
 
+func (x *GenesisState) GetClaimRounds() []*ClaimRound {
+	if x != nil {
+		return x.ClaimRounds
+	}
+	return nil
+}
+
+func (x *GenesisState) GetClaimRoundClaims() []*ClaimRoundClaim {
+	if x != nil {
+		return x.ClaimRoundClaims
+	}
+	return nil
+}
+
 var File_arkeo_claim_genesis_proto protoreflect.FileDescriptor
 
 var file_arkeo_claim_genesis_proto_rawDesc = []byte{
@@ -743,36 +1037,47 @@ var file_arkeo_claim_genesis_proto_rawDesc = []byte{
 	0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2f, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x2f, 0x70, 0x61, 0x72, 0x61,
 	0x6d, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2f,
 	0x63, 0x6c, 0x61, 0x69, 0x6d, 0x2f, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x5f, 0x72, 0x65, 0x63, 0x6f,
-	0x72, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f,
-	0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x96, 0x02, 0x0a, 0x0c, 0x47, 0x65, 0x6e,
-	0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x76, 0x0a, 0x16, 0x6d, 0x6f, 0x64,
-	0x75, 0x6c, 0x65, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x62, 0x61, 0x6c, 0x61,
-	0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
-	0x43, 0x6f, 0x69, 0x6e, 0x42, 0x25, 0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x1d, 0x79, 0x61,
-	0x6d, 0x6c, 0x3a, 0x22, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x52, 0x14, 0x6d, 0x6f, 0x64,
-	0x75, 0x6c, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63,
-	0x65, 0x12, 0x31, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x13, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x2e,
-	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x70, 0x61,
-	0x72, 0x61, 0x6d, 0x73, 0x12, 0x5b, 0x0a, 0x0d, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x5f, 0x72, 0x65,
-	0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x72,
-	0x6b, 0x65, 0x6f, 0x2e, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x2e, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x52,
-	0x65, 0x63, 0x6f, 0x72, 0x64, 0x42, 0x1c, 0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x14, 0x79,
-	0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72,
-	0x64, 0x73, 0x22, 0x52, 0x0c, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
-	0x73, 0x42, 0x8a, 0x01, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e,
-	0x63, 0x6c, 0x61, 0x69, 0x6d, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72,
-	0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x1c, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b,
-	0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2f, 0x63, 0x6c,
-	0x61, 0x69, 0x6d, 0xa2, 0x02, 0x03, 0x41, 0x43, 0x58, 0xaa, 0x02, 0x0b, 0x41, 0x72, 0x6b, 0x65,
-	0x6f, 0x2e, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0xca, 0x02, 0x0b, 0x41, 0x72, 0x6b, 0x65, 0x6f, 0x5c,
-	0x43, 0x6c, 0x61, 0x69, 0x6d, 0xe2, 0x02, 0x17, 0x41, 0x72, 0x6b, 0x65, 0x6f, 0x5c, 0x43, 0x6c,
-	0x61, 0x69, 0x6d, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea,
-	0x02, 0x0c, 0x41, 0x72, 0x6b, 0x65, 0x6f, 0x3a, 0x3a, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x72, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1d, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2f,
+	0x63, 0x6c, 0x61, 0x69, 0x6d, 0x2f, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x5f, 0x72, 0x6f, 0x75, 0x6e,
+	0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f,
+	0x62, 0x61, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f, 0x69,
+	0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xaa, 0x03, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65,
+	0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x76, 0x0a, 0x16, 0x6d, 0x6f, 0x64, 0x75,
+	0x6c, 0x65, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43,
+	0x6f, 0x69, 0x6e, 0x42, 0x25, 0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x1d, 0x79, 0x61, 0x6d,
+	0x6c, 0x3a, 0x22, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x52, 0x14, 0x6d, 0x6f, 0x64, 0x75,
+	0x6c, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x12, 0x31, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x2e, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x70, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x12, 0x5b, 0x0a, 0x0d, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x5f, 0x72, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x72, 0x6b,
+	0x65, 0x6f, 0x2e, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x2e, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x52, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x42, 0x1c, 0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x14, 0x79, 0x61,
+	0x6d, 0x6c, 0x3a, 0x22, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x73, 0x22, 0x52, 0x0c, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73,
+	0x12, 0x40, 0x0a, 0x0c, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x5f, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x73,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x63,
+	0x6c, 0x61, 0x69, 0x6d, 0x2e, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x42,
+	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0b, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x52, 0x6f, 0x75, 0x6e,
+	0x64, 0x73, 0x12, 0x50, 0x0a, 0x12, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x5f, 0x72, 0x6f, 0x75, 0x6e,
+	0x64, 0x5f, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x2e, 0x43, 0x6c, 0x61,
+	0x69, 0x6d, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x42, 0x04, 0xc8, 0xde,
+	0x1f, 0x00, 0x52, 0x10, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x43, 0x6c,
+	0x61, 0x69, 0x6d, 0x73, 0x42, 0x8a, 0x01, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x72, 0x6b,
+	0x65, 0x6f, 0x2e, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69,
+	0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x1c, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f,
+	0x2f, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0xa2, 0x02, 0x03, 0x41, 0x43, 0x58, 0xaa, 0x02, 0x0b, 0x41,
+	0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0xca, 0x02, 0x0b, 0x41, 0x72, 0x6b,
+	0x65, 0x6f, 0x5c, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0xe2, 0x02, 0x17, 0x41, 0x72, 0x6b, 0x65, 0x6f,
+	0x5c, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0xea, 0x02, 0x0c, 0x41, 0x72, 0x6b, 0x65, 0x6f, 0x3a, 0x3a, 0x43, 0x6c, 0x61, 0x69,
+	0x6d, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -789,20 +1094,24 @@ func file_arkeo_claim_genesis_proto_rawDescGZIP() []byte {
 
 var file_arkeo_claim_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
 var file_arkeo_claim_genesis_proto_goTypes = []interface{}{
-	(*GenesisState)(nil), // 0: arkeo.claim.GenesisState
-	(*v1beta1.Coin)(nil), // 1: cosmos.base.v1beta1.Coin
-	(*Params)(nil),       // 2: arkeo.claim.Params
-	(*ClaimRecord)(nil),  // 3: arkeo.claim.ClaimRecord
+	(*GenesisState)(nil),    // 0: arkeo.claim.GenesisState
+	(*v1beta1.Coin)(nil),    // 1: cosmos.base.v1beta1.Coin
+	(*Params)(nil),          // 2: arkeo.claim.Params
+	(*ClaimRecord)(nil),     // 3: arkeo.claim.ClaimRecord
+	(*ClaimRound)(nil),      // 4: arkeo.claim.ClaimRound
+	(*ClaimRoundClaim)(nil), // 5: arkeo.claim.ClaimRoundClaim
 }
 var file_arkeo_claim_genesis_proto_depIdxs = []int32{
 	1, // 0: arkeo.claim.GenesisState.module_account_balance:type_name -> cosmos.base.v1beta1.Coin
 	2, // 1: arkeo.claim.GenesisState.params:type_name -> arkeo.claim.Params
 	3, // 2: arkeo.claim.GenesisState.claim_records:type_name -> arkeo.claim.ClaimRecord
-	3, // [3:3] is the sub-list for method output_type
-	3, // [3:3] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	4, // 3: arkeo.claim.GenesisState.claim_rounds:type_name -> arkeo.claim.ClaimRound
+	5, // 4: arkeo.claim.GenesisState.claim_round_claims:type_name -> arkeo.claim.ClaimRoundClaim
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_arkeo_claim_genesis_proto_init() }
@@ -812,6 +1121,7 @@ func file_arkeo_claim_genesis_proto_init() {
 	}
 	file_arkeo_claim_params_proto_init()
 	file_arkeo_claim_claim_record_proto_init()
+	file_arkeo_claim_claim_round_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_arkeo_claim_genesis_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GenesisState); i {
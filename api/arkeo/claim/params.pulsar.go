@@ -23,6 +23,7 @@ var (
 	fd_Params_duration_of_decay    protoreflect.FieldDescriptor
 	fd_Params_claim_denom          protoreflect.FieldDescriptor
 	fd_Params_initial_gas_amount   protoreflect.FieldDescriptor
+	fd_Params_airdrop_end_time     protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -33,6 +34,7 @@ func init() {
 	fd_Params_duration_of_decay = md_Params.Fields().ByName("duration_of_decay")
 	fd_Params_claim_denom = md_Params.Fields().ByName("claim_denom")
 	fd_Params_initial_gas_amount = md_Params.Fields().ByName("initial_gas_amount")
+	fd_Params_airdrop_end_time = md_Params.Fields().ByName("airdrop_end_time")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -130,6 +132,12 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.AirdropEndTime != nil {
+		value := protoreflect.ValueOfMessage(x.AirdropEndTime.ProtoReflect())
+		if !f(fd_Params_airdrop_end_time, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -155,6 +163,8 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.ClaimDenom != ""
 	case "arkeo.claim.Params.initial_gas_amount":
 		return x.InitialGasAmount != nil
+	case "arkeo.claim.Params.airdrop_end_time":
+		return x.AirdropEndTime != nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.claim.Params"))
@@ -181,6 +191,8 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 		x.ClaimDenom = ""
 	case "arkeo.claim.Params.initial_gas_amount":
 		x.InitialGasAmount = nil
+	case "arkeo.claim.Params.airdrop_end_time":
+		x.AirdropEndTime = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.claim.Params"))
@@ -212,6 +224,9 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "arkeo.claim.Params.initial_gas_amount":
 		value := x.InitialGasAmount
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "arkeo.claim.Params.airdrop_end_time":
+		value := x.AirdropEndTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.claim.Params"))
@@ -242,6 +257,8 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 		x.ClaimDenom = value.Interface().(string)
 	case "arkeo.claim.Params.initial_gas_amount":
 		x.InitialGasAmount = value.Message().Interface().(*v1beta1.Coin)
+	case "arkeo.claim.Params.airdrop_end_time":
+		x.AirdropEndTime = value.Message().Interface().(*timestamppb.Timestamp)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.claim.Params"))
@@ -282,6 +299,11 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 			x.InitialGasAmount = new(v1beta1.Coin)
 		}
 		return protoreflect.ValueOfMessage(x.InitialGasAmount.ProtoReflect())
+	case "arkeo.claim.Params.airdrop_end_time":
+		if x.AirdropEndTime == nil {
+			x.AirdropEndTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.AirdropEndTime.ProtoReflect())
 	case "arkeo.claim.Params.claim_denom":
 		panic(fmt.Errorf("field claim_denom of message arkeo.claim.Params is not mutable"))
 	default:
@@ -311,6 +333,9 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 	case "arkeo.claim.Params.initial_gas_amount":
 		m := new(v1beta1.Coin)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "arkeo.claim.Params.airdrop_end_time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: arkeo.claim.Params"))
@@ -400,6 +425,10 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			l = options.Size(x.InitialGasAmount)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.AirdropEndTime != nil {
+			l = options.Size(x.AirdropEndTime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -429,6 +458,20 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.AirdropEndTime != nil {
+			encoded, err := options.Marshal(x.AirdropEndTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x32
+		}
 		if x.InitialGasAmount != nil {
 			encoded, err := options.Marshal(x.InitialGasAmount)
 			if err != nil {
@@ -717,6 +760,42 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AirdropEndTime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.AirdropEndTime == nil {
+					x.AirdropEndTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.AirdropEndTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -778,6 +857,10 @@ type Params struct {
 	ClaimDenom string `protobuf:"bytes,4,opt,name=claim_denom,json=claimDenom,proto3" json:"claim_denom,omitempty"`
 	// uarkeo to distribute to arkeo account for gas to make claiming easier
 	InitialGasAmount *v1beta1.Coin `protobuf:"bytes,5,opt,name=initial_gas_amount,json=initialGasAmount,proto3" json:"initial_gas_amount,omitempty"`
+	// airdrop_end_time the claims of the claim records are rejected after, the
+	// claim denom left in the module account swept to the community pool, zero
+	// for no end
+	AirdropEndTime *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=airdrop_end_time,json=airdropEndTime,proto3" json:"airdrop_end_time,omitempty"`
 }
 
 func (x *Params) Reset() {
@@ -835,6 +918,13 @@ func (x *Params) GetInitialGasAmount() *v1beta1.Coin {
 	return nil
 }
 
+func (x *Params) GetAirdropEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AirdropEndTime
+	}
+	return nil
+}
+
 var File_arkeo_claim_params_proto protoreflect.FileDescriptor
 
 var file_arkeo_claim_params_proto_rawDesc = []byte{
@@ -847,8 +937,8 @@ var file_arkeo_claim_params_proto_rawDesc = []byte{
 	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74,
 	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e,
 	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x65,
-	0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa8,
-	0x04, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x6f, 0x0a, 0x12, 0x61, 0x69, 0x72,
+	0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x93,
+	0x05, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x6f, 0x0a, 0x12, 0x61, 0x69, 0x72,
 	0x64, 0x72, 0x6f, 0x70, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
@@ -882,16 +972,23 @@ var file_arkeo_claim_params_proto_rawDesc = []byte{
 	0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x1d, 0xf2, 0xde, 0x1f, 0x19, 0x79, 0x61,
 	0x6d, 0x6c, 0x3a, 0x22, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x67, 0x61, 0x73, 0x5f,
 	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x52, 0x10, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c,
-	0x47, 0x61, 0x73, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x89, 0x01, 0x0a, 0x0f, 0x63, 0x6f,
-	0x6d, 0x2e, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x42, 0x0b, 0x50,
-	0x61, 0x72, 0x61, 0x6d, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x1c, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61,
-	0x72, 0x6b, 0x65, 0x6f, 0x2f, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0xa2, 0x02, 0x03, 0x41, 0x43, 0x58,
-	0xaa, 0x02, 0x0b, 0x41, 0x72, 0x6b, 0x65, 0x6f, 0x2e, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0xca, 0x02,
-	0x0b, 0x41, 0x72, 0x6b, 0x65, 0x6f, 0x5c, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0xe2, 0x02, 0x17, 0x41,
-	0x72, 0x6b, 0x65, 0x6f, 0x5c, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0c, 0x41, 0x72, 0x6b, 0x65, 0x6f, 0x3a, 0x3a,
-	0x43, 0x6c, 0x61, 0x69, 0x6d, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x47, 0x61, 0x73, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x69, 0x0a, 0x10, 0x61, 0x69, 0x72,
+	0x64, 0x72, 0x6f, 0x70, 0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42,
+	0x23, 0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x17, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x61,
+	0x69, 0x72, 0x64, 0x72, 0x6f, 0x70, 0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x22,
+	0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0e, 0x61, 0x69, 0x72, 0x64, 0x72, 0x6f, 0x70, 0x45, 0x6e, 0x64,
+	0x54, 0x69, 0x6d, 0x65, 0x42, 0x89, 0x01, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x72, 0x6b,
+	0x65, 0x6f, 0x2e, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x42, 0x0b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x1c, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
+	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x72, 0x6b, 0x65, 0x6f, 0x2f,
+	0x63, 0x6c, 0x61, 0x69, 0x6d, 0xa2, 0x02, 0x03, 0x41, 0x43, 0x58, 0xaa, 0x02, 0x0b, 0x41, 0x72,
+	0x6b, 0x65, 0x6f, 0x2e, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0xca, 0x02, 0x0b, 0x41, 0x72, 0x6b, 0x65,
+	0x6f, 0x5c, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0xe2, 0x02, 0x17, 0x41, 0x72, 0x6b, 0x65, 0x6f, 0x5c,
+	0x43, 0x6c, 0x61, 0x69, 0x6d, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0xea, 0x02, 0x0c, 0x41, 0x72, 0x6b, 0x65, 0x6f, 0x3a, 0x3a, 0x43, 0x6c, 0x61, 0x69, 0x6d,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -918,11 +1015,12 @@ var file_arkeo_claim_params_proto_depIdxs = []int32{
 	2, // 1: arkeo.claim.Params.duration_until_decay:type_name -> google.protobuf.Duration
 	2, // 2: arkeo.claim.Params.duration_of_decay:type_name -> google.protobuf.Duration
 	3, // 3: arkeo.claim.Params.initial_gas_amount:type_name -> cosmos.base.v1beta1.Coin
-	4, // [4:4] is the sub-list for method output_type
-	4, // [4:4] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	1, // 4: arkeo.claim.Params.airdrop_end_time:type_name -> google.protobuf.Timestamp
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_arkeo_claim_params_proto_init() }
@@ -22,6 +22,14 @@ type QueryClient interface {
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	// Queries a list of ClaimRecord items.
 	ClaimRecord(ctx context.Context, in *QueryClaimRecordRequest, opts ...grpc.CallOption) (*QueryClaimRecordResponse, error)
+	// ClaimableForAction queries what the address can still claim for the
+	// action, decayed as the claim would be
+	ClaimableForAction(ctx context.Context, in *QueryClaimableForActionRequest, opts ...grpc.CallOption) (*QueryClaimableForActionResponse, error)
+	// TotalClaimable queries what the address can still claim over all the
+	// actions, and for which of them
+	TotalClaimable(ctx context.Context, in *QueryTotalClaimableRequest, opts ...grpc.CallOption) (*QueryTotalClaimableResponse, error)
+	// ClaimRound queries the airdrop round and the balance of its account
+	ClaimRound(ctx context.Context, in *QueryClaimRoundRequest, opts ...grpc.CallOption) (*QueryClaimRoundResponse, error)
 }
 
 type queryClient struct {
@@ -50,6 +58,33 @@ func (c *queryClient) ClaimRecord(ctx context.Context, in *QueryClaimRecordReque
 	return out, nil
 }
 
+func (c *queryClient) ClaimableForAction(ctx context.Context, in *QueryClaimableForActionRequest, opts ...grpc.CallOption) (*QueryClaimableForActionResponse, error) {
+	out := new(QueryClaimableForActionResponse)
+	err := c.cc.Invoke(ctx, "/arkeo.claim.Query/ClaimableForAction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TotalClaimable(ctx context.Context, in *QueryTotalClaimableRequest, opts ...grpc.CallOption) (*QueryTotalClaimableResponse, error) {
+	out := new(QueryTotalClaimableResponse)
+	err := c.cc.Invoke(ctx, "/arkeo.claim.Query/TotalClaimable", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ClaimRound(ctx context.Context, in *QueryClaimRoundRequest, opts ...grpc.CallOption) (*QueryClaimRoundResponse, error) {
+	out := new(QueryClaimRoundResponse)
+	err := c.cc.Invoke(ctx, "/arkeo.claim.Query/ClaimRound", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -58,6 +93,14 @@ type QueryServer interface {
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	// Queries a list of ClaimRecord items.
 	ClaimRecord(context.Context, *QueryClaimRecordRequest) (*QueryClaimRecordResponse, error)
+	// ClaimableForAction queries what the address can still claim for the
+	// action, decayed as the claim would be
+	ClaimableForAction(context.Context, *QueryClaimableForActionRequest) (*QueryClaimableForActionResponse, error)
+	// TotalClaimable queries what the address can still claim over all the
+	// actions, and for which of them
+	TotalClaimable(context.Context, *QueryTotalClaimableRequest) (*QueryTotalClaimableResponse, error)
+	// ClaimRound queries the airdrop round and the balance of its account
+	ClaimRound(context.Context, *QueryClaimRoundRequest) (*QueryClaimRoundResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -71,6 +114,15 @@ func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*Q
 func (UnimplementedQueryServer) ClaimRecord(context.Context, *QueryClaimRecordRequest) (*QueryClaimRecordResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ClaimRecord not implemented")
 }
+func (UnimplementedQueryServer) ClaimableForAction(context.Context, *QueryClaimableForActionRequest) (*QueryClaimableForActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimableForAction not implemented")
+}
+func (UnimplementedQueryServer) TotalClaimable(context.Context, *QueryTotalClaimableRequest) (*QueryTotalClaimableResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalClaimable not implemented")
+}
+func (UnimplementedQueryServer) ClaimRound(context.Context, *QueryClaimRoundRequest) (*QueryClaimRoundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimRound not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -120,6 +172,60 @@ func _Query_ClaimRecord_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ClaimableForAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClaimableForActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ClaimableForAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/arkeo.claim.Query/ClaimableForAction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClaimableForAction(ctx, req.(*QueryClaimableForActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TotalClaimable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTotalClaimableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TotalClaimable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/arkeo.claim.Query/TotalClaimable",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TotalClaimable(ctx, req.(*QueryTotalClaimableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ClaimRound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClaimRoundRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ClaimRound(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/arkeo.claim.Query/ClaimRound",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClaimRound(ctx, req.(*QueryClaimRoundRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -135,6 +241,18 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ClaimRecord",
 			Handler:    _Query_ClaimRecord_Handler,
 		},
+		{
+			MethodName: "ClaimableForAction",
+			Handler:    _Query_ClaimableForAction_Handler,
+		},
+		{
+			MethodName: "TotalClaimable",
+			Handler:    _Query_TotalClaimable_Handler,
+		},
+		{
+			MethodName: "ClaimRound",
+			Handler:    _Query_ClaimRound_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "arkeo/claim/query.proto",
@@ -23,6 +23,8 @@ type MsgClient interface {
 	TransferClaim(ctx context.Context, in *MsgTransferClaim, opts ...grpc.CallOption) (*MsgTransferClaimResponse, error)
 	AddClaim(ctx context.Context, in *MsgAddClaim, opts ...grpc.CallOption) (*MsgAddClaimResponse, error)
 	ClaimThorchain(ctx context.Context, in *MsgClaimThorchain, opts ...grpc.CallOption) (*MsgClaimThorchainResponse, error)
+	CreateClaimRound(ctx context.Context, in *MsgCreateClaimRound, opts ...grpc.CallOption) (*MsgCreateClaimRoundResponse, error)
+	ClaimMerkle(ctx context.Context, in *MsgClaimMerkle, opts ...grpc.CallOption) (*MsgClaimMerkleResponse, error)
 }
 
 type msgClient struct {
@@ -78,6 +80,24 @@ func (c *msgClient) ClaimThorchain(ctx context.Context, in *MsgClaimThorchain, o
 	return out, nil
 }
 
+func (c *msgClient) CreateClaimRound(ctx context.Context, in *MsgCreateClaimRound, opts ...grpc.CallOption) (*MsgCreateClaimRoundResponse, error) {
+	out := new(MsgCreateClaimRoundResponse)
+	err := c.cc.Invoke(ctx, "/arkeo.claim.Msg/CreateClaimRound", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClaimMerkle(ctx context.Context, in *MsgClaimMerkle, opts ...grpc.CallOption) (*MsgClaimMerkleResponse, error) {
+	out := new(MsgClaimMerkleResponse)
+	err := c.cc.Invoke(ctx, "/arkeo.claim.Msg/ClaimMerkle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -87,6 +107,8 @@ type MsgServer interface {
 	TransferClaim(context.Context, *MsgTransferClaim) (*MsgTransferClaimResponse, error)
 	AddClaim(context.Context, *MsgAddClaim) (*MsgAddClaimResponse, error)
 	ClaimThorchain(context.Context, *MsgClaimThorchain) (*MsgClaimThorchainResponse, error)
+	CreateClaimRound(context.Context, *MsgCreateClaimRound) (*MsgCreateClaimRoundResponse, error)
+	ClaimMerkle(context.Context, *MsgClaimMerkle) (*MsgClaimMerkleResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -109,6 +131,12 @@ func (UnimplementedMsgServer) AddClaim(context.Context, *MsgAddClaim) (*MsgAddCl
 func (UnimplementedMsgServer) ClaimThorchain(context.Context, *MsgClaimThorchain) (*MsgClaimThorchainResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ClaimThorchain not implemented")
 }
+func (UnimplementedMsgServer) CreateClaimRound(context.Context, *MsgCreateClaimRound) (*MsgCreateClaimRoundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateClaimRound not implemented")
+}
+func (UnimplementedMsgServer) ClaimMerkle(context.Context, *MsgClaimMerkle) (*MsgClaimMerkleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimMerkle not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -212,6 +240,42 @@ func _Msg_ClaimThorchain_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CreateClaimRound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateClaimRound)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateClaimRound(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/arkeo.claim.Msg/CreateClaimRound",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateClaimRound(ctx, req.(*MsgCreateClaimRound))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimMerkle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimMerkle)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimMerkle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/arkeo.claim.Msg/ClaimMerkle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimMerkle(ctx, req.(*MsgClaimMerkle))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -239,6 +303,14 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ClaimThorchain",
 			Handler:    _Msg_ClaimThorchain_Handler,
 		},
+		{
+			MethodName: "CreateClaimRound",
+			Handler:    _Msg_CreateClaimRound_Handler,
+		},
+		{
+			MethodName: "ClaimMerkle",
+			Handler:    _Msg_ClaimMerkle_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "arkeo/claim/tx.proto",
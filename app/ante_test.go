@@ -0,0 +1,135 @@
+package app_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/x/feegrant"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/client"
+	clienttx "github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/arkeonetwork/arkeo/app"
+	"github.com/arkeonetwork/arkeo/common"
+	"github.com/arkeonetwork/arkeo/common/cosmos"
+	"github.com/arkeonetwork/arkeo/x/arkeo/configs"
+	arkeotypes "github.com/arkeonetwork/arkeo/x/arkeo/types"
+)
+
+// TestOpenContractAnte run the txs opening a contract through the ante handler, the fee granted by a sponsor and the
+// payer of the deposit signing along with the client
+func TestOpenContractAnte(t *testing.T) {
+	simApp := newSimApp(log.NewNopLogger(), dbm.NewMemDB())
+	ctx := simApp.NewUncachedContext(false, cmtproto.Header{ChainID: simAppChainID, Height: 1})
+	txConfig := app.MakeEncodingConfig().TxConfig
+	ak, bk := simApp.Keepers.AccountKeeper, simApp.Keepers.BankKeeper
+	require.NoError(t, ak.Params.Set(ctx, authtypes.DefaultParams()))
+	require.NoError(t, bk.SetParams(ctx, banktypes.DefaultParams()))
+
+	newAccount := func(balance int64) *secp256k1.PrivKey {
+		priv := secp256k1.GenPrivKey()
+		addr := sdk.AccAddress(priv.PubKey().Address())
+		ak.SetAccount(ctx, ak.NewAccountWithAddress(ctx, addr))
+		if balance > 0 {
+			coins := sdk.NewCoins(sdk.NewInt64Coin(configs.Denom, balance))
+			require.NoError(t, bk.MintCoins(ctx, minttypes.ModuleName, coins))
+			require.NoError(t, bk.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, addr, coins))
+		}
+		return priv
+	}
+	address := func(priv *secp256k1.PrivKey) sdk.AccAddress {
+		return sdk.AccAddress(priv.PubKey().Address())
+	}
+	balance := func(priv *secp256k1.PrivKey) int64 {
+		return bk.GetBalance(ctx, address(priv), configs.Denom).Amount.Int64()
+	}
+
+	client, sponsor := newAccount(0), newAccount(common.Tokens(10))
+	require.NoError(t, simApp.Keepers.FeeGrantKeeper.GrantAllowance(ctx, address(sponsor), address(client), &feegrant.BasicAllowance{}))
+
+	openContract := func(payer sdk.AccAddress) *arkeotypes.MsgOpenContract {
+		clientPubKey, err := common.NewPubKeyFromCrypto(client.PubKey())
+		require.NoError(t, err)
+		msg := arkeotypes.NewMsgOpenContract(address(client), arkeotypes.GetRandomPubKey(), common.BTCService.String(),
+			clientPubKey, common.EmptyPubKey, arkeotypes.ContractType_SUBSCRIPTION, 100, 0, cosmos.NewInt64Coin(configs.Denom, 15),
+			cosmos.NewInt(1500), arkeotypes.ContractAuthorization_STRICT, 1)
+		if payer != nil {
+			msg.Payer = payer.String()
+		}
+		require.NoError(t, msg.ValidateBasic())
+		return msg
+	}
+	// signTx sign the msg by the signers given, the fee granted by the sponsor
+	signTx := func(msg sdk.Msg, signers ...*secp256k1.PrivKey) sdk.Tx {
+		builder := txConfig.NewTxBuilder()
+		require.NoError(t, builder.SetMsgs(msg))
+		builder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin(configs.Denom, 100)))
+		builder.SetGasLimit(200_000)
+		builder.SetFeeGranter(address(sponsor))
+
+		sigs := make([]signing.SignatureV2, len(signers))
+		for i, priv := range signers {
+			acc := ak.GetAccount(ctx, address(priv))
+			sigs[i] = signing.SignatureV2{
+				PubKey:   priv.PubKey(),
+				Data:     &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT},
+				Sequence: acc.GetSequence(),
+			}
+		}
+		require.NoError(t, builder.SetSignatures(sigs...))
+		for i, priv := range signers {
+			acc := ak.GetAccount(ctx, address(priv))
+			sigs[i] = sign(t, ctx, txConfig, builder, priv, acc.GetAccountNumber(), acc.GetSequence())
+		}
+		require.NoError(t, builder.SetSignatures(sigs...))
+		return builder.GetTx()
+	}
+	ante := func(tx sdk.Tx) error {
+		cacheCtx, _ := ctx.CacheContext()
+		_, err := simApp.AnteHandler()(cacheCtx, tx, false)
+		return err
+	}
+
+	// the client holding nothing, the sponsor pays the fee
+	cacheCtx, commit := ctx.CacheContext()
+	_, err := simApp.AnteHandler()(cacheCtx, signTx(openContract(nil), client), false)
+	require.NoError(t, err)
+	commit()
+	require.Equal(t, common.Tokens(10)-100, balance(sponsor))
+	require.Zero(t, balance(client))
+
+	// the payer signs the msg along with the client
+	msg := openContract(address(sponsor))
+	signers, _, err := simApp.AppCodec().GetMsgV1Signers(msg)
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{address(client), address(sponsor)}, signers)
+	require.NoError(t, ante(signTx(msg, client, sponsor)))
+
+	// the payer not signing, or signing for the client, fails the tx
+	require.ErrorIs(t, ante(signTx(msg, client)), sdkerrors.ErrUnauthorized)
+	require.Error(t, ante(signTx(msg, sponsor, client)))
+}
+
+func sign(t *testing.T, ctx sdk.Context, txConfig client.TxConfig, builder client.TxBuilder, priv cryptotypes.PrivKey, accNum, seq uint64) signing.SignatureV2 {
+	signerData := authsigning.SignerData{
+		Address:       sdk.AccAddress(priv.PubKey().Address()).String(),
+		ChainID:       simAppChainID,
+		AccountNumber: accNum,
+		Sequence:      seq,
+		PubKey:        priv.PubKey(),
+	}
+	sig, err := clienttx.SignWithPrivKey(ctx, signing.SignMode_SIGN_MODE_DIRECT, signerData, builder, priv, txConfig, seq)
+	require.NoError(t, err)
+	return sig
+}
@@ -112,6 +112,7 @@ import (
 	ibctransfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	ibc "github.com/cosmos/ibc-go/v8/modules/core"
 	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
 
 	// ibcclientclient "github.com/cosmos/ibc-go/v8/modules/core/02-client/client"
 
@@ -187,6 +188,7 @@ var (
 		feegrantmodule.AppModuleBasic{},
 		groupmodule.AppModuleBasic{},
 		ibc.AppModuleBasic{},
+		ibctm.AppModuleBasic{},
 		upgrade.AppModuleBasic{},
 		evidence.AppModuleBasic{},
 		transfer.AppModuleBasic{},
@@ -480,8 +482,10 @@ func NewArkeoApp(
 		keys[claimmoduletypes.StoreKey],
 		app.Keepers.AccountKeeper,
 		app.Keepers.BankKeeper,
+		app.Keepers.DistrKeeper,
 		keys[claimmoduletypes.MemStoreKey],
 		app.GetSubspace(claimmoduletypes.ModuleName),
+		govModuleAddr,
 		logger,
 	)
 
@@ -583,7 +587,7 @@ func NewArkeoApp(
 		),
 	)
 
-	app.Keepers.ArkeoKeeper = *arkeomodulekeeper.NewKVStore(
+	arkeoKeeper := arkeomodulekeeper.NewKVStore(
 		appCodec,
 		keys[arkeomoduletypes.StoreKey],
 		keys[arkeomoduletypes.MemStoreKey],
@@ -596,6 +600,11 @@ func NewArkeoApp(
 		app.Keepers.MintKeeper,
 		app.Keepers.DistrKeeper,
 	)
+	app.Keepers.ArkeoKeeper = *arkeoKeeper.SetHooks(
+		arkeomoduletypes.NewMultiArkeoHooks(
+		// insert arkeo hooks receivers here
+		),
+	)
 
 	/****  Module Options ****/
 
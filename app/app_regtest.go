@@ -110,6 +110,7 @@ import (
 	ibctransfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	ibc "github.com/cosmos/ibc-go/v8/modules/core"
 	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
 
 	tmjson "encoding/json"
 
@@ -202,6 +203,7 @@ var (
 		feegrantmodule.AppModuleBasic{},
 		groupmodule.AppModuleBasic{},
 		ibc.AppModuleBasic{},
+		ibctm.AppModuleBasic{},
 		upgrade.AppModuleBasic{},
 		evidence.AppModuleBasic{},
 		transfer.AppModuleBasic{},
@@ -490,8 +492,10 @@ func NewArkeoApp(
 		keys[claimmoduletypes.StoreKey],
 		app.Keepers.AccountKeeper,
 		app.Keepers.BankKeeper,
+		app.Keepers.DistrKeeper,
 		keys[claimmoduletypes.MemStoreKey],
 		app.GetSubspace(claimmoduletypes.ModuleName),
+		govModuleAddr,
 		logger,
 	)
 	claimModule := claimmodule.NewAppModule(appCodec, app.Keepers.ClaimKeeper, app.Keepers.AccountKeeper, app.Keepers.BankKeeper)
@@ -588,7 +592,7 @@ func NewArkeoApp(
 		),
 	)
 
-	app.ArkeoKeeper = *arkeomodulekeeper.NewKVStore(
+	arkeoKeeper := arkeomodulekeeper.NewKVStore(
 		appCodec,
 		keys[arkeomoduletypes.StoreKey],
 		keys[arkeomoduletypes.MemStoreKey],
@@ -601,6 +605,11 @@ func NewArkeoApp(
 		app.Keepers.MintKeeper,
 		app.Keepers.DistrKeeper,
 	)
+	app.ArkeoKeeper = *arkeoKeeper.SetHooks(
+		arkeomoduletypes.NewMultiArkeoHooks(
+		// insert arkeo hooks receivers here
+		),
+	)
 	arkeoModule := arkeomodule.NewAppModule(appCodec, app.ArkeoKeeper, app.Keepers.AccountKeeper, app.Keepers.BankKeeper, *app.Keepers.StakingKeeper)
 
 	app.Keepers.IBCKeeper.SetRouter(ibcRouter)
@@ -6,10 +6,13 @@ import (
 	upgradetypes "cosmossdk.io/x/upgrade/types"
 
 	"github.com/arkeonetwork/arkeo/app/upgrades"
+	v1_1_0 "github.com/arkeonetwork/arkeo/app/upgrades/v1_1_0"
 )
 
 // Upgrades
-var Upgrades = []upgrades.Upgrade{}
+var Upgrades = []upgrades.Upgrade{
+	v1_1_0.Upgrade,
+}
 
 func (app *ArkeoApp) RegisterUpgradeHandlers() {
 	app.setUpgradeHandlers()
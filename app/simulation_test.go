@@ -1,113 +1,122 @@
 package app_test
 
-// import (
-// 	"os"
-// 	"testing"
-
-// 	"github.com/arkeonetwork/arkeo/app"
-
-// 	"github.com/cosmos/cosmos-sdk/baseapp"
-// 	"github.com/cosmos/cosmos-sdk/codec"
-// 	"github.com/cosmos/cosmos-sdk/simapp"
-// 	sdk "github.com/cosmos/cosmos-sdk/types"
-// 	"github.com/cosmos/cosmos-sdk/types/module"
-// 	simulationtypes "github.com/cosmos/cosmos-sdk/types/simulation"
-// 	"github.com/cosmos/cosmos-sdk/x/simulation"
-// 	"github.com/ignite/cli/ignite/pkg/cosmoscmd"
-// 	"github.com/stretchr/testify/require"
-// 	abci "github.com/tendermint/tendermint/abci/types"
-// )
-
-// func init() {
-// 	simapp.GetSimulatorFlags()
-// }
-
-// type SimApp interface {
-// 	cosmoscmd.App
-// 	GetBaseApp() *baseapp.BaseApp
-// 	AppCodec() codec.Codec
-// 	SimulationManager() *module.SimulationManager
-// 	ModuleAccountAddrs() map[string]bool
-// 	Name() string
-// 	LegacyAmino() *codec.LegacyAmino
-// 	BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock) abci.ResponseBeginBlock
-// 	EndBlocker(ctx sdk.Context, req abci.RequestEndBlock) abci.ResponseEndBlock
-// 	InitChainer(ctx sdk.Context, req abci.RequestInitChain) abci.ResponseInitChain
-// }
-
-// /*
-// var defaultConsensusParams = &abci.ConsensusParams{
-// 	Block: &abci.BlockParams{
-// 		MaxBytes: 200000,
-// 		MaxGas:   2000000,
-// 	},
-// 	Evidence: &tmproto.EvidenceParams{
-// 		MaxAgeNumBlocks: 302400,
-// 		MaxAgeDuration:  504 * time.Hour, // 3 weeks is the max duration
-// 		MaxBytes:        10000,
-// 	},
-// 	Validator: &tmproto.ValidatorParams{
-// 		PubKeyTypes: []string{
-// 			tmtypes.ABCIPubKeyTypeEd25519,
-// 		},
-// 	},
-// }
-// */
-
-// // BenchmarkSimulation run the chain simulation
-// // Running using starport command:
-// // `starport chain simulate -v --numBlocks 200 --blockSize 50`
-// // Running as go benchmark test:
-// // `go test -benchmem -run=^$ -bench ^BenchmarkSimulation ./app -NumBlocks=200 -BlockSize 50 -Commit=true -Verbose=true -Enabled=true`
-// func BenchmarkSimulation(b *testing.B) {
-// 	simapp.FlagEnabledValue = true
-// 	simapp.FlagCommitValue = true
-
-// 	config, db, dir, logger, _, err := simapp.SetupSimulation("goleveldb-app-sim", "Simulation")
-// 	require.NoError(b, err, "simulation setup failed")
-
-// 	b.Cleanup(func() {
-// 		db.Close()
-// 		err = os.RemoveAll(dir)
-// 		require.NoError(b, err)
-// 	})
-
-// 	encoding := cosmoscmd.MakeEncodingConfig(app.ModuleBasics)
-
-// 	app := app.New(
-// 		logger,
-// 		db,
-// 		nil,
-// 		true,
-// 		map[int64]bool{},
-// 		app.DefaultNodeHome,
-// 		0,
-// 		encoding,
-// 		simapp.EmptyAppOptions{},
-// 	)
-
-// 	simApp, ok := app.(SimApp)
-// 	require.True(b, ok, "can't use simapp")
-
-// 	// Run randomized simulations
-// 	_, simParams, simErr := simulation.SimulateFromSeed(
-// 		b,
-// 		os.Stdout,
-// 		simApp.GetBaseApp(),
-// 		simapp.AppStateFn(simApp.AppCodec(), simApp.SimulationManager()),
-// 		simulationtypes.RandomAccounts,
-// 		simapp.SimulationOperations(simApp, simApp.AppCodec(), config),
-// 		simApp.ModuleAccountAddrs(),
-// 		config,
-// 		simApp.AppCodec(),
-// 	)
-
-// 	// export state and simParams before the simulation error is checked
-// 	err = simapp.CheckExportSimulation(simApp, config, simParams)
-// 	require.NoError(b, err)
-// 	require.NoError(b, simErr)
-
-// 	if config.Commit {
-// 		simapp.PrintStats(db)
-// 	}
-// }
+import (
+	"math/rand"
+	"os"
+	"testing"
+
+	"cosmossdk.io/log"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	simcli "github.com/cosmos/cosmos-sdk/x/simulation/client/cli"
+	"github.com/stretchr/testify/require"
+
+	"github.com/arkeonetwork/arkeo/app"
+	"github.com/arkeonetwork/arkeo/x/arkeo/configs"
+)
+
+const simAppChainID = "arkeo-simapp"
+
+func init() {
+	simcli.GetSimulatorFlags()
+
+	// the simulated accounts hold and bond arkeo, the denom of the contracts
+	sdk.DefaultBondDenom = configs.Denom
+	cfg := sdk.GetConfig()
+	cfg.SetBech32PrefixForAccount(app.Bech32PrefixAccAddr, app.Bech32PrefixAccPub)
+	cfg.SetBech32PrefixForValidator(app.Bech32PrefixValAddr, app.Bech32PrefixValPub)
+	cfg.SetBech32PrefixForConsensusNode(app.Bech32PrefixConsAddr, app.Bech32PrefixConsPub)
+}
+
+func newSimApp(logger log.Logger, db dbm.DB) *app.ArkeoApp {
+	return app.NewArkeoApp(
+		logger,
+		db,
+		nil,
+		true,
+		map[int64]bool{},
+		app.DefaultNodeHome,
+		simcli.FlagPeriodValue,
+		app.MakeEncodingConfig(),
+		simtestutil.EmptyAppOptions{},
+		baseapp.SetChainID(simAppChainID),
+	)
+}
+
+// runSimulation run the simulation of the config, returning the app simulated
+func runSimulation(tb testing.TB, config simtypes.Config) *app.ArkeoApp {
+	logger := log.NewNopLogger()
+	if simcli.FlagVerboseValue {
+		logger = log.NewTestLogger(tb)
+	}
+	simApp := newSimApp(logger, dbm.NewMemDB())
+
+	_, _, err := simulation.SimulateFromSeed(
+		tb,
+		os.Stdout,
+		simApp.BaseApp,
+		simtestutil.AppStateFn(simApp.AppCodec(), simApp.SimulationManager(), app.NewDefaultGenesisState(simApp.AppCodec())),
+		simtypes.RandomAccounts,
+		simtestutil.SimulationOperations(simApp, simApp.AppCodec(), config),
+		simApp.BlockedModuleAccountAddrs(),
+		config,
+		simApp.AppCodec(),
+	)
+	require.NoError(tb, err)
+	return simApp
+}
+
+// BenchmarkSimulation run the chain simulation
+// Running as go benchmark test:
+// `go test -benchmem -run=^$ -bench ^BenchmarkSimulation ./app -NumBlocks=200 -BlockSize 50 -Commit=true -Verbose=true -Enabled=true`
+func BenchmarkSimulation(b *testing.B) {
+	if !simcli.FlagEnabledValue {
+		b.Skip("skipping application simulation")
+	}
+	config := simcli.NewConfigFromFlags()
+	config.ChainID = simAppChainID
+
+	runSimulation(b, config)
+}
+
+// TestAppStateDeterminism run the simulation of a few seeds several times each, every run of a seed must end on the
+// same app hash
+func TestAppStateDeterminism(t *testing.T) {
+	if !simcli.FlagEnabledValue {
+		t.Skip("skipping application simulation")
+	}
+
+	config := simcli.NewConfigFromFlags()
+	config.InitialBlockHeight = 1
+	config.ExportParamsPath = ""
+	config.OnOperation = false
+	config.AllInvariants = false
+	config.ChainID = simAppChainID
+
+	numSeeds := 3
+	numTimesToRunPerSeed := 3
+	appHashList := make([][]byte, numTimesToRunPerSeed)
+
+	for i := 0; i < numSeeds; i++ {
+		// the seed of the flags is the first one, the others are random
+		if i > 0 || config.Seed == simcli.DefaultSeedValue {
+			config.Seed = rand.Int63() // #nosec G404
+		}
+
+		for j := 0; j < numTimesToRunPerSeed; j++ {
+			simApp := runSimulation(t, config)
+			appHashList[j] = simApp.LastCommitID().Hash
+
+			if j != 0 {
+				require.Equal(
+					t, appHashList[0], appHashList[j],
+					"non-determinism in seed %d: %d/%d, attempt: %d/%d\n", config.Seed, i+1, numSeeds, j+1, numTimesToRunPerSeed,
+				)
+			}
+		}
+	}
+}
@@ -0,0 +1,30 @@
+package v1_1_0
+
+import (
+	"context"
+
+	storetypes "cosmossdk.io/store/types"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	"github.com/arkeonetwork/arkeo/app/keepers"
+	"github.com/arkeonetwork/arkeo/app/upgrades"
+)
+
+// UpgradeName is the name of the upgrade migrating the providers to the multi-denom rates and the service index, and
+// counting the network stats
+const UpgradeName = "v1.1.0"
+
+var Upgrade = upgrades.Upgrade{
+	UpgradeName:          UpgradeName,
+	CreateUpgradeHandler: CreateUpgradeHandler,
+	StoreUpgrades:        storetypes.StoreUpgrades{},
+}
+
+// CreateUpgradeHandler run the in-place store migrations of the modules, the arkeo one rewriting the single-rate
+// providers, indexing the providers by service and counting the network stats
+func CreateUpgradeHandler(mm *module.Manager, configurator module.Configurator, _ keepers.ArkeoKeepers) upgradetypes.UpgradeHandler {
+	return func(ctx context.Context, _ upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		return mm.RunMigrations(ctx, configurator, fromVM)
+	}
+}
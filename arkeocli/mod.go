@@ -33,6 +33,7 @@ func newModProviderCmd() *cobra.Command {
 	modProviderCmd.Flags().Uint64("settlement-duration", 0, "settlement duration (in blocks)")
 	modProviderCmd.Flags().Uint64("subscription-rate", 0, "rate for subscription contracts")
 	modProviderCmd.Flags().Uint64("pay-as-you-go-rate", 0, "rate for pay-as-you-go contracts")
+	modProviderCmd.Flags().Uint64("max-open-contracts", 0, "open contracts accepted at most, 0 for the chain cap")
 	return modProviderCmd
 }
 
@@ -159,6 +160,8 @@ func runModProviderCmd(cmd *cobra.Command, args []string) (err error) {
 		return err
 	}
 
+	argMaxOpenContracts, _ := cmd.Flags().GetUint64("max-open-contracts")
+
 	pubkey, err := common.NewPubKey(argPubkey)
 	if err != nil {
 		return err
@@ -178,6 +181,7 @@ func runModProviderCmd(cmd *cobra.Command, args []string) (err error) {
 		sRate,
 		pRate,
 		int64(argSettlementDuration),
+		argMaxOpenContracts,
 	)
 	if err := msg.ValidateBasic(); err != nil {
 		return err
@@ -33,6 +33,7 @@ import (
 
 	"github.com/arkeonetwork/arkeo/app"
 	"github.com/arkeonetwork/arkeo/app/params"
+	claimcli "github.com/arkeonetwork/arkeo/x/claim/client/cli"
 )
 
 // NewRootCmd
@@ -124,6 +125,7 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig params.EncodingConfig) {
 		queryCommand(app.ModuleBasics),
 		txCommand(app.ModuleBasics),
 		keys.Commands(),
+		claimcli.GetClaimCmd(),
 	)
 }
 
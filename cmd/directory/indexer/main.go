@@ -1,10 +1,12 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
 	"os"
 	"os/signal"
+	"sort"
 	"syscall"
 
 	"github.com/arkeonetwork/arkeo/app"
@@ -27,6 +29,20 @@ func main() {
 	if err := utils.LoadFromEnv(&c, *envPath); err != nil {
 		log.Panicf("failed to load config from env: %+v", err)
 	}
+	if flag.Arg(0) == "backfill-provider" {
+		if err := backfillProvider(c, flag.Args()[1:]); err != nil {
+			log.Errorf("backfill failed: %+v", err)
+			os.Exit(1)
+		}
+		return
+	}
+	if flag.Arg(0) == "capture-fixtures" {
+		if err := captureFixtures(c, flag.Args()[1:]); err != nil {
+			log.Errorf("capture failed: %+v", err)
+			os.Exit(1)
+		}
+		return
+	}
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, os.Interrupt, syscall.SIGTERM)
 	indexApp, err := indexer.NewIndexer(c)
@@ -43,3 +59,76 @@ func main() {
 	}
 	log.Info("indexer shutdown successfully")
 }
+
+// backfillProvider rebuilds the history of a single provider, usage:
+// indexer backfill-provider --pubkey X --service Y [--start N] [--end N]
+func backfillProvider(c indexer.ServiceParams, args []string) error {
+	fs := flag.NewFlagSet("backfill-provider", flag.ExitOnError)
+	pubkey := fs.String("pubkey", "", "provider pubkey")
+	service := fs.String("service", "", "provider service")
+	start := fs.Int64("start", 1, "first height to scan")
+	end := fs.Int64("end", 0, "last height to scan (default: latest indexed block)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *pubkey == "" || *service == "" {
+		fs.Usage()
+		return fmt.Errorf("pubkey and service are required")
+	}
+
+	indexApp, err := indexer.NewIndexer(c)
+	if err != nil {
+		return err
+	}
+	summary, err := indexApp.BackfillProvider(context.Background(), *pubkey, *service, *start, *end)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("rebuilt provider %s service %s from height %d to %d (%d heights scanned)\n",
+		summary.Pubkey, summary.Service, summary.StartHeight, summary.EndHeight, summary.HeightsScanned)
+	fmt.Println("rows deleted:")
+	printCounts(summary.RowsDeleted)
+	fmt.Println("events applied:")
+	printCounts(summary.EventsApplied)
+	return nil
+}
+
+// captureFixtures records the blocks in a height range from the node for the regression harness, usage:
+// indexer capture-fixtures --start N --end N --dir D
+func captureFixtures(c indexer.ServiceParams, args []string) error {
+	fs := flag.NewFlagSet("capture-fixtures", flag.ExitOnError)
+	start := fs.Int64("start", 0, "first height to capture")
+	end := fs.Int64("end", 0, "last height to capture")
+	dir := fs.String("dir", "", "directory to write the fixtures to")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *start <= 0 || *end < *start || *dir == "" {
+		fs.Usage()
+		return fmt.Errorf("start, end and dir are required, end not before start")
+	}
+
+	client, err := utils.NewTendermintClient(c.TendermintWs)
+	if err != nil {
+		return fmt.Errorf("fail to create connection to tendermint,err:%w", err)
+	}
+	txCount, err := indexer.CaptureFixtures(context.Background(), client, *dir, *start, *end)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("captured heights %d to %d (%d transactions) into %s\n", *start, *end, txCount, *dir)
+	return nil
+}
+
+func printCounts(counts map[string]int64) {
+	keys := make([]string, 0, len(counts))
+	for k := range counts {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		fmt.Printf("  %-40s %d\n", k, counts[k])
+	}
+}
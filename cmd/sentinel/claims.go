@@ -0,0 +1,103 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/arkeonetwork/arkeo/sentinel"
+)
+
+const claimsUsage = "usage: sentinel claims export [--out file] | sentinel claims import --in file"
+
+// runClaims run the claims commands moving the pending claims between hosts. The claim store is opened by a single
+// process at a time, the sentinel using it must be stopped first
+func runClaims(args []string) error {
+	if len(args) == 0 {
+		return errors.New(claimsUsage)
+	}
+	flags := flag.NewFlagSet("claims "+args[0], flag.ContinueOnError)
+	storeType := flags.String("store-type", getEnv("CLAIM_STORE_TYPE", sentinel.ClaimStoreTypeLevelDB), "claim store type, leveldb or bolt")
+	location := flags.String("store", os.Getenv("CLAIM_STORE_LOCATION"), "claim store location")
+	out := flags.String("out", "", "file the claims are exported to, stdout when empty")
+	in := flags.String("in", "", "file of the claims to import")
+	if err := flags.Parse(args[1:]); err != nil {
+		return err
+	}
+	if len(*location) == 0 {
+		return errors.New("the claim store location is required, set --store or CLAIM_STORE_LOCATION")
+	}
+
+	switch args[0] {
+	case "export":
+		return exportClaims(*storeType, *location, *out)
+	case "import":
+		if len(*in) == 0 {
+			return errors.New(claimsUsage)
+		}
+		return importClaims(*storeType, *location, *in)
+	default:
+		return errors.New(claimsUsage)
+	}
+}
+
+func exportClaims(storeType, location, out string) error {
+	store, err := sentinel.NewClaimStorage(storeType, location)
+	if err != nil {
+		return err
+	}
+	defer store.Close()
+
+	w := os.Stdout
+	if len(out) > 0 {
+		file, err := os.OpenFile(out, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o600)
+		if err != nil {
+			return fmt.Errorf("fail to create %s: %w", out, err)
+		}
+		defer file.Close()
+		w = file
+	}
+	export, err := sentinel.WriteClaimExport(store, w)
+	if err != nil {
+		return fmt.Errorf("fail to export claims: %w", err)
+	}
+	if w != os.Stdout {
+		if err := w.Sync(); err != nil {
+			return fmt.Errorf("fail to sync %s: %w", out, err)
+		}
+	}
+	fmt.Fprintf(os.Stderr, "exported %d claims, checksum %s\n", len(export.Claims), export.Checksum)
+	return nil
+}
+
+func importClaims(storeType, location, in string) error {
+	file, err := os.Open(in)
+	if err != nil {
+		return fmt.Errorf("fail to open %s: %w", in, err)
+	}
+	defer file.Close()
+	export, err := sentinel.ReadClaimExport(file)
+	if err != nil {
+		return err
+	}
+
+	store, err := sentinel.NewClaimStorage(storeType, location)
+	if err != nil {
+		return err
+	}
+	defer store.Close()
+	result, err := sentinel.ImportClaims(store, export)
+	if err != nil {
+		return fmt.Errorf("fail to import claims: %w", err)
+	}
+	fmt.Printf("imported %d claims, kept %d claims already stored at the same or a higher nonce\n", result.Imported, result.Kept)
+	return nil
+}
+
+func getEnv(key, defaultVal string) string {
+	if val, ok := os.LookupEnv(key); ok {
+		return val
+	}
+	return defaultVal
+}
@@ -0,0 +1,35 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/arkeonetwork/arkeo/sentinel"
+)
+
+// setDevDefaults enable the dev mode and set the settings it needs to start but a local developer doesn't have, the
+// env vars already set are kept. The stores are kept in a temporary directory and the provider is the test key
+func setDevDefaults() {
+	dir := filepath.Join(os.TempDir(), "arkeo-sentinel-dev")
+	defaults := map[string]string{
+		"MONIKER":                        "sentinel-dev",
+		"WEBSITE":                        "http://localhost",
+		"DESCRIPTION":                    sentinel.DevWatermark,
+		"LOCATION":                       "localhost",
+		"SOURCE_CHAIN":                   "http://localhost:1317",
+		"EVENT_STREAM_HOST":              "localhost:26657",
+		"PROVIDER_PUBKEY":                sentinel.DevTestPubKey().String(),
+		"FREE_RATE_LIMIT":                "60",
+		"CHAIN_ID":                       "arkeo-dev",
+		"CLAIM_STORE_LOCATION":           filepath.Join(dir, "claims"),
+		"CONTRACT_CONFIG_STORE_LOCATION": filepath.Join(dir, "contract_configs"),
+		"PROVIDER_CONFIG_STORE_LOCATION": filepath.Join(dir, "provider"),
+	}
+	for key, value := range defaults {
+		if _, ok := os.LookupEnv(key); !ok {
+			_ = os.Setenv(key, value)
+		}
+	}
+	// --dev wins over a DEV_MODE=false left in the env
+	_ = os.Setenv("DEV_MODE", "true")
+}
@@ -1,7 +1,9 @@
 package main
 
 import (
+	"flag"
 	"fmt"
+	"os"
 
 	"github.com/arkeonetwork/arkeo/app"
 	"github.com/arkeonetwork/arkeo/common/cosmos"
@@ -13,6 +15,20 @@ func main() {
 	c := cosmos.GetConfig()
 	c.SetBech32PrefixForAccount(app.AccountAddressPrefix, app.AccountAddressPrefix+"pub")
 
+	if len(os.Args) > 1 && os.Args[1] == "claims" {
+		if err := runClaims(os.Args[2:]); err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	dev := flag.Bool("dev", false, "run against an in-process fake chain for local development, see DEV_CONTRACTS")
+	flag.Parse()
+	if *dev {
+		setDevDefaults()
+	}
+
 	config := conf.NewConfiguration()
 	proxy, err := sentinel.NewProxy(config)
 	if err != nil {
@@ -25,6 +25,8 @@ type ServiceParams struct {
 	ListenAddr string      `mapstructure:"listen_addr" json:"listen_addr"`
 	StaticDir  string      `mapstructure:"static_dir" json:"static_dir"`
 	DBConfig   db.DBConfig `mapstructure:"db" json:"db"`
+	// bearer token protecting the /admin endpoints, they are disabled when empty
+	AdminToken string `mapstructure:"admin_token" json:"admin_token"`
 }
 
 const DefaultListenAddress = "localhost:7777"
@@ -84,11 +86,23 @@ func buildRouter(a *ApiService) *mux.Router {
 
 	contractRouter := router.PathPrefix("/contract").Subrouter()
 	contractRouter.HandleFunc("/{id}", a.getContract).Methods(http.MethodGet)
+	contractRouter.HandleFunc("/search/", a.searchContracts).Methods(http.MethodGet)
 
 	providerRouter := router.PathPrefix("/provider").Subrouter()
 	providerRouter.HandleFunc("/{pubkey}", a.getProvider).Methods(http.MethodGet)
+	providerRouter.HandleFunc("/{pubkey}/stats", a.getStatsProvider).Methods(http.MethodGet)
 	providerRouter.HandleFunc("/search/", a.searchProviders).Methods(http.MethodGet)
 
+	if a.params.AdminToken == "" {
+		log.Warnf("ADMIN_TOKEN not set, admin endpoints disabled")
+	} else {
+		adminRouter := router.PathPrefix("/admin").Subrouter()
+		adminRouter.Use(a.requireAdminToken)
+		adminRouter.HandleFunc("/webhooks", a.listWebhooks).Methods(http.MethodGet)
+		adminRouter.HandleFunc("/webhooks", a.createWebhook).Methods(http.MethodPost)
+		adminRouter.HandleFunc("/webhooks/{id}", a.deleteWebhook).Methods(http.MethodDelete)
+	}
+
 	// router.Walk(func(route *mux.Route, router *mux.Router, ancestors []*mux.Route) error {
 	// 	tpl, _ := route.GetPathTemplate()
 	// 	log.Infof("walk: %s", tpl)
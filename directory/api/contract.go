@@ -10,6 +10,7 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/arkeonetwork/arkeo/directory/db"
+	"github.com/arkeonetwork/arkeo/directory/types"
 )
 
 // swagger:model ArkeoContract
@@ -67,3 +68,60 @@ func (a *ApiService) findContract(ctx context.Context, id uint64) (*db.ArkeoCont
 
 	return dbContract, nil
 }
+
+// swagger:route Get /contract/search/ searchContracts
+//
+// queries the service for a list of contracts
+//
+// Parameters:
+//   + name: provider
+//     in: query
+//     description: pubkey of provider
+//     required: false
+//     type: string
+//   + name: service
+//     in: query
+//     description: service identifier
+//     required: false
+//     type: string
+//   + name: client
+//     in: query
+//     description: pubkey of client
+//     required: false
+//     type: string
+//   + name: debt
+//     in: query
+//     description: only return contracts that settled with an unpaid amount
+//     required: false
+//     type: boolean
+//
+// Responses:
+//
+//	200: ArkeoContracts
+//	500: InternalServerError
+
+func (a *ApiService) searchContracts(w http.ResponseWriter, r *http.Request) {
+	searchParams := types.ContractSearchParams{
+		ProviderPubkey: r.FormValue("provider"),
+		Service:        r.FormValue("service"),
+		ClientPubkey:   r.FormValue("client"),
+	}
+
+	if debtInput := r.FormValue("debt"); debtInput != "" {
+		withDebt, err := strconv.ParseBool(debtInput)
+		if err != nil {
+			respondWithError(w, http.StatusBadRequest, "debt can not be parsed")
+			return
+		}
+		searchParams.WithDebt = withDebt
+	}
+
+	contracts, err := a.db.SearchContracts(r.Context(), searchParams)
+	if err != nil {
+		log.Errorf("error searching contracts: %+v", err)
+		respondWithError(w, http.StatusInternalServerError, "error searching contracts")
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, ArkeoContracts(contracts))
+}
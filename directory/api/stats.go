@@ -1,6 +1,7 @@
 package api
 
 import (
+	"fmt"
 	"net/http"
 
 	"github.com/gorilla/mux"
@@ -50,3 +51,44 @@ func getStatsService(w http.ResponseWriter, r *http.Request) {
 	}
 	respondWithJSON(w, http.StatusOK, &types.ServiceStats{})
 }
+
+// swagger:route Get /provider/{pubkey}/stats getStatsProvider
+//
+// get contract stats for a specific provider, including the total still owed by clients
+// Parameters:
+//   + name: pubkey
+//     in: path
+//     description: provider public key
+//     required: true
+//     type: string
+//   + name: service
+//	   in: query
+//     description: service identifier
+//     required: true
+//     type: string
+//
+// Responses:
+//
+//	200: ProviderStats
+//	500: InternalServerError
+
+func (a *ApiService) getStatsProvider(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	pubkey := vars["pubkey"]
+	service := r.FormValue("service")
+	if pubkey == "" {
+		respondWithError(w, http.StatusBadRequest, "pubkey is required")
+		return
+	}
+	if service == "" {
+		respondWithError(w, http.StatusBadRequest, "service is required")
+		return
+	}
+	providerStats, err := a.db.GetProviderStats(r.Context(), pubkey, service)
+	if err != nil {
+		log.Errorf("error finding stats for provider %s service %s: %+v", pubkey, service, err)
+		respondWithError(w, http.StatusInternalServerError, fmt.Sprintf("error finding stats for provider with pubkey %s", pubkey))
+		return
+	}
+	respondWithJSON(w, http.StatusOK, providerStats)
+}
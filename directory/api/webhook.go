@@ -0,0 +1,124 @@
+package api
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/gorilla/mux"
+
+	"github.com/arkeonetwork/arkeo/directory/db"
+	"github.com/arkeonetwork/arkeo/directory/webhook"
+)
+
+// CreateWebhookRequest is the body accepted by POST /admin/webhooks
+type CreateWebhookRequest struct {
+	URL        string   `json:"url"`
+	Secret     string   `json:"secret"`
+	EventTypes []string `json:"event_types"`
+	Service    string   `json:"service"`
+	Pubkey     string   `json:"pubkey"`
+}
+
+// WebhookSubscriptionResponse expose the subscription id which is hidden on db entities
+type WebhookSubscriptionResponse struct {
+	ID int64 `json:"id"`
+	*db.WebhookSubscription
+}
+
+// requireAdminToken only let through requests carrying the configured admin token as a bearer token
+func (a *ApiService) requireAdminToken(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if subtle.ConstantTimeCompare([]byte(token), []byte(a.params.AdminToken)) != 1 {
+			respondWithError(w, http.StatusUnauthorized, "unauthorized")
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (r CreateWebhookRequest) validate() error {
+	u, err := url.ParseRequestURI(r.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+		return fmt.Errorf("invalid url (%s)", r.URL)
+	}
+	if r.Secret == "" {
+		return fmt.Errorf("secret is required")
+	}
+	if len(r.EventTypes) == 0 {
+		return fmt.Errorf("at least one event type is required")
+	}
+	for _, eventType := range r.EventTypes {
+		if !webhook.IsValidEventType(eventType) {
+			return fmt.Errorf("invalid event type (%s), expected one of %s", eventType, strings.Join(webhook.EventTypes, ","))
+		}
+	}
+	return nil
+}
+
+func (a *ApiService) listWebhooks(w http.ResponseWriter, r *http.Request) {
+	subs, err := a.db.ListWebhookSubscriptions(r.Context())
+	if err != nil {
+		log.Errorf("error listing webhook subscriptions: %+v", err)
+		respondWithError(w, http.StatusInternalServerError, "error listing webhook subscriptions")
+		return
+	}
+	result := make([]WebhookSubscriptionResponse, 0, len(subs))
+	for _, sub := range subs {
+		result = append(result, WebhookSubscriptionResponse{ID: sub.ID, WebhookSubscription: sub})
+	}
+	respondWithJSON(w, http.StatusOK, result)
+}
+
+func (a *ApiService) createWebhook(w http.ResponseWriter, r *http.Request) {
+	var req CreateWebhookRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondWithError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	if err := req.validate(); err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	sub := &db.WebhookSubscription{
+		URL:        req.URL,
+		Secret:     req.Secret,
+		EventTypes: req.EventTypes,
+		Service:    req.Service,
+		Pubkey:     req.Pubkey,
+		Active:     true,
+	}
+	entity, err := a.db.InsertWebhookSubscription(r.Context(), sub)
+	if err != nil {
+		log.Errorf("error inserting webhook subscription: %+v", err)
+		respondWithError(w, http.StatusInternalServerError, "error creating webhook subscription")
+		return
+	}
+	sub.Entity = *entity
+	respondWithJSON(w, http.StatusCreated, WebhookSubscriptionResponse{ID: sub.ID, WebhookSubscription: sub})
+}
+
+func (a *ApiService) deleteWebhook(w http.ResponseWriter, r *http.Request) {
+	rawId := mux.Vars(r)["id"]
+	id, err := strconv.ParseInt(rawId, 10, 64)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, fmt.Sprintf("invalid webhook id (%s)", rawId))
+		return
+	}
+	if _, err := a.db.DeleteWebhookSubscription(r.Context(), id); err != nil {
+		if errors.Is(err, db.ErrNotFound) {
+			respondWithError(w, http.StatusNotFound, fmt.Sprintf("webhook %d not found", id))
+			return
+		}
+		log.Errorf("error deleting webhook subscription %d: %+v", id, err)
+		respondWithError(w, http.StatusInternalServerError, fmt.Sprintf("error deleting webhook %d", id))
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -4,9 +4,12 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/huandu/go-sqlbuilder"
 	"github.com/pkg/errors"
 
 	"github.com/arkeonetwork/arkeo/common/cosmos"
+	"github.com/arkeonetwork/arkeo/directory/types"
 	atypes "github.com/arkeonetwork/arkeo/x/arkeo/types"
 )
 
@@ -36,6 +39,7 @@ type ArkeoContract struct {
 	QueriesPerMinute    int64       `json:"queries_per_minute" db:"queries_per_minute"`
 	Nonce               int64       `json:"nonce" db:"nonce"`
 	Paid                int64       `json:"paid" db:"paid"`
+	Unpaid              int64       `json:"unpaid" db:"unpaid"`
 	SettlementDurtion   int64       `json:"settlement_duration" db:"settlement_duration"`
 	ReserveContribAsset int64       `json:"reserve_contrib_asset" db:"reserve_contrib_asset"`
 	ReserveContribUSD   int64       `json:"reserve_contrib_usd" db:"reserve_contrib_usd"`
@@ -103,6 +107,43 @@ func (d *DirectoryDB) CloseContract(ctx context.Context, contractID uint64, heig
 	return update(ctx, conn, sqlCloseContract, height, contractID)
 }
 
+// RenewContract update the contract with its new expiration, rate and deposit once renewed
+func (d *DirectoryDB) RenewContract(ctx context.Context, evt atypes.EventRenewContract) (*Entity, error) {
+	conn, err := d.getConnection(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error obtaining db connection")
+	}
+	defer conn.Release()
+
+	return update(ctx, conn, sqlRenewContract, evt.NewExpiration, evt.Rate.Denom, evt.Rate.Amount.Int64(), evt.Deposit.Int64(), evt.ContractId)
+}
+
+// ExtendContract update the contract with its new expiration and deposit once extended by a top-up
+func (d *DirectoryDB) ExtendContract(ctx context.Context, contractID uint64, expiration int64, deposit cosmos.Int) (*Entity, error) {
+	conn, err := d.getConnection(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error obtaining db connection")
+	}
+	defer conn.Release()
+
+	return update(ctx, conn, sqlExtendContract, expiration, deposit.Int64(), contractID)
+}
+
+// RotateDelegate update the delegate of the contract once rotated, the client when it has none
+func (d *DirectoryDB) RotateDelegate(ctx context.Context, evt atypes.EventRotateDelegate) (*Entity, error) {
+	conn, err := d.getConnection(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error obtaining db connection")
+	}
+	defer conn.Release()
+
+	if evt.NewDelegate.String() == "" {
+		evt.NewDelegate = evt.Client
+	}
+
+	return update(ctx, conn, sqlRotateDelegate, evt.NewDelegate, evt.ContractId)
+}
+
 func (d *DirectoryDB) UpsertContractSettlementEvent(ctx context.Context, evt atypes.EventSettleContract) (*Entity, error) {
 	conn, err := d.getConnection(ctx)
 	if err != nil {
@@ -110,7 +151,86 @@ func (d *DirectoryDB) UpsertContractSettlementEvent(ctx context.Context, evt aty
 	}
 	defer conn.Release()
 
-	return upsert(ctx, conn, sqlUpsertContractSettlementEvent, evt.Nonce, evt.Paid.Int64(), evt.Reserve.Int64(), evt.ContractId)
+	return upsert(ctx, conn, sqlUpsertContractSettlementEvent, evt.Nonce, evt.Paid.Int64(), evt.Reserve.Int64(), settlementUnpaid(evt), evt.ContractId)
+}
+
+// settlementUnpaid returns the amount the client still owes the provider after
+// the given settlement. Events emitted before the unpaid attribute existed
+// leave it unset, in which case there is no debt
+func settlementUnpaid(evt atypes.EventSettleContract) int64 {
+	if evt.Unpaid.IsNil() {
+		return 0
+	}
+	return evt.Unpaid.Int64()
+}
+
+const contractSearchCols = `
+	c.id,
+	c.created,
+	c.updated,
+	p.pubkey as provider,
+	p.service,
+	c.delegate_pubkey,
+	c.client_pubkey,
+	c.height,
+	c.contract_type,
+	c.duration,
+	c.rate_asset,
+	c.rate_amount,
+	c.open_cost,
+	c.deposit,
+	c.auth,
+	c.queries_per_minute,
+	c.settlement_duration,
+	c.paid,
+	c.unpaid,
+	c.reserve_contrib_asset,
+	c.reserve_contrib_usd,
+	c.closed_height,
+	c.provider_id
+`
+
+// SearchContracts query db for contracts matching the given criteria
+func (d *DirectoryDB) SearchContracts(ctx context.Context, criteria types.ContractSearchParams) ([]*ArkeoContract, error) {
+	conn, err := d.getConnection(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error obtaining db connection")
+	}
+	defer conn.Release()
+
+	sb := sqlbuilder.NewSelectBuilder()
+	sb.Select(contractSearchCols).
+		From("contracts c").
+		JoinWithOption(sqlbuilder.LeftOuterJoin, "providers p", "p.id = c.provider_id")
+
+	if criteria.ProviderPubkey != "" {
+		sb = sb.Where(sb.Equal("p.pubkey", criteria.ProviderPubkey))
+	}
+	if criteria.Service != "" {
+		sb = sb.Where(sb.Equal("p.service", criteria.Service))
+	}
+	if criteria.ClientPubkey != "" {
+		sb = sb.Where(sb.Equal("c.client_pubkey", criteria.ClientPubkey))
+	}
+	if criteria.WithDebt {
+		sb = sb.Where(sb.GreaterThan("c.unpaid", 0))
+	}
+	sb = sb.OrderBy("c.id").Asc()
+
+	q, params := sb.BuildWithFlavor(getFlavor())
+	log.Debugf("sql: %s\n%v", q, params)
+
+	contracts := make([]*ArkeoContract, 0, 512)
+	if err := pgxscan.Select(ctx, conn, &contracts, q, params...); err != nil {
+		return nil, errors.Wrapf(err, "error selecting many")
+	}
+	for _, contract := range contracts {
+		if len(contract.RateAsset) > 0 {
+			contract.Rate = cosmos.NewInt64Coin(contract.RateAsset, contract.RateAmount)
+		}
+	}
+
+	return contracts, nil
 }
 
 func (d *DirectoryDB) UpsertOpenContractEvent(ctx context.Context, contractID int64, evt atypes.EventOpenContract) (*Entity, error) {
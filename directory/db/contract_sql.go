@@ -19,6 +19,7 @@ const (
 	c.queries_per_minute,
 	c.settlement_duration,
 	c.paid,
+	c.unpaid,
 	c.reserve_contrib_asset,
 	c.reserve_contrib_usd,
 	c.closed_height,
@@ -43,10 +44,31 @@ const (
 	returning id, created, updated
 	`
 
+	sqlRenewContract = `
+	update contracts
+	set duration = $1 - height, rate_asset = $2, rate_amount = $3, deposit = $4, updated = now()
+	where id = $5
+	returning id, created, updated
+	`
+
+	sqlExtendContract = `
+	update contracts
+	set duration = $1 - height, deposit = $2, updated = now()
+	where id = $3
+	returning id, created, updated
+	`
+
+	sqlRotateDelegate = `
+	update contracts
+	set delegate_pubkey = $1, updated = now()
+	where id = $2
+	returning id, created, updated
+	`
+
 	sqlUpsertContractSettlementEvent = `
 		UPDATE contracts
-		SET nonce = $1, paid = $2, reserve_contrib_asset = $3
-		WHERE id = $4
+		SET nonce = $1, paid = $2, reserve_contrib_asset = $3, unpaid = $4
+		WHERE id = $5
 	returning id, created, updated
 `
 )
@@ -11,6 +11,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/arkeonetwork/arkeo/common/cosmos"
+	"github.com/arkeonetwork/arkeo/directory/types"
 
 	arkeotypes "github.com/arkeonetwork/arkeo/x/arkeo/types"
 )
@@ -141,8 +142,9 @@ func TestUpsertContractSettltementEvent(t *testing.T) {
 		Paid:       math.NewInt(1000),
 		Reserve:    math.NewInt(1000),
 	}
+	// settlement events without an unpaid attribute carry no debt
 	m.ExpectQuery("UPDATE contracts.*").
-		WithArgs(evt.Nonce, evt.Paid.Int64(), evt.Reserve.Int64(), evt.ContractId).
+		WithArgs(evt.Nonce, evt.Paid.Int64(), evt.Reserve.Int64(), int64(0), evt.ContractId).
 		WillReturnRows(
 			pgxmock.NewRows([]string{"id", "created", "updated"}).
 				AddRow(int64(1), testTime, testTime),
@@ -153,5 +155,44 @@ func TestUpsertContractSettltementEvent(t *testing.T) {
 	assert.Equal(t, int64(1), entity.ID)
 	assert.Equal(t, testTime, entity.Created)
 	assert.Equal(t, testTime, entity.Updated)
+
+	evt.Unpaid = math.NewInt(250)
+	m.ExpectQuery("UPDATE contracts.*").
+		WithArgs(evt.Nonce, evt.Paid.Int64(), evt.Reserve.Int64(), int64(250), evt.ContractId).
+		WillReturnRows(
+			pgxmock.NewRows([]string{"id", "created", "updated"}).
+				AddRow(int64(1), testTime, testTime),
+		)
+	entity, err = db.UpsertContractSettlementEvent(context.Background(), evt)
+	assert.Nil(t, err)
+	assert.NotNil(t, entity)
+	assert.Nil(t, m.ExpectationsWereMet())
+}
+
+func TestSearchContractsWithDebt(t *testing.T) {
+	m, db := getMockDirectoryDBForTest(t)
+	defer m.Close()
+	testTime := time.Now()
+	testPubKey := arkeotypes.GetRandomPubKey()
+	m.ExpectQuery(`SELECT .* FROM contracts c .* WHERE p.pubkey = \$1 AND c.unpaid > \$2 ORDER BY c.id ASC`).
+		WithArgs(testPubKey.String(), 0).
+		WillReturnRows(
+			pgxmock.NewRows([]string{
+				"id", "created", "updated", "provider", "service", "delegate_pubkey", "client_pubkey", "height", "contract_type", "duration", "rate_asset",
+				"rate_amount", "open_cost", "deposit", "auth", "queries_per_minute", "settlement_duration", "paid", "unpaid", "reserve_contrib_asset",
+				"reserve_contrib_usd", "closed_height", "provider_id",
+			}).AddRow(int64(1), testTime, testTime, testPubKey.String(), "mock", testPubKey.String(), testPubKey.String(), int64(1024), "PayAsYouGo",
+				int64(10), "uarkeo", int64(10), int64(10), int64(1000), "STRICT", int64(10), int64(10), int64(1000), int64(250), int64(100), int64(100), int64(2048), int64(1)),
+		)
+	contracts, err := db.SearchContracts(context.Background(), types.ContractSearchParams{
+		ProviderPubkey: testPubKey.String(),
+		WithDebt:       true,
+	})
+	assert.Nil(t, err)
+	assert.Len(t, contracts, 1)
+	assert.Equal(t, int64(1), contracts[0].ContractID)
+	assert.Equal(t, int64(1000), contracts[0].Paid)
+	assert.Equal(t, int64(250), contracts[0].Unpaid)
+	assert.Equal(t, cosmos.NewInt64Coin("uarkeo", 10), contracts[0].Rate)
 	assert.Nil(t, m.ExpectationsWereMet())
 }
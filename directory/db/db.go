@@ -10,6 +10,7 @@ import (
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/pkg/errors"
 
+	"github.com/arkeonetwork/arkeo/common/cosmos"
 	"github.com/arkeonetwork/arkeo/common/logging"
 	"github.com/arkeonetwork/arkeo/sentinel"
 	atypes "github.com/arkeonetwork/arkeo/x/arkeo/types"
@@ -31,15 +32,24 @@ type IDataStorage interface {
 	FindLatestBlock(ctx context.Context) (*Block, error)
 	InsertBlock(ctx context.Context, b *Block) (*Entity, error)
 	UpsertValidatorPayoutEvent(ctx context.Context, evt atypes.EventValidatorPayout, height int64) (*Entity, error)
+	UpsertParamChange(ctx context.Context, height int64, change atypes.ParamChange) (*Entity, error)
 	FindProvider(ctx context.Context, pubkey, service string) (*ArkeoProvider, error)
 	UpsertContract(ctx context.Context, providerID int64, evt atypes.EventOpenContract) (*Entity, error)
 	GetContract(ctx context.Context, contractId uint64) (*ArkeoContract, error)
 	CloseContract(ctx context.Context, contractID uint64, height int64) (*Entity, error)
+	RenewContract(ctx context.Context, evt atypes.EventRenewContract) (*Entity, error)
+	ExtendContract(ctx context.Context, contractID uint64, expiration int64, deposit cosmos.Int) (*Entity, error)
+	RotateDelegate(ctx context.Context, evt atypes.EventRotateDelegate) (*Entity, error)
 	UpdateProvider(ctx context.Context, provider *ArkeoProvider) (*Entity, error)
 	UpsertContractSettlementEvent(ctx context.Context, evt atypes.EventSettleContract) (*Entity, error)
 	UpsertProviderMetadata(ctx context.Context, providerID, nonce int64, data sentinel.Metadata) (*Entity, error)
 	InsertBondProviderEvent(ctx context.Context, providerID int64, evt atypes.EventBondProvider, height int64, txID string) (*Entity, error)
 	InsertProvider(ctx context.Context, provider *ArkeoProvider) (*Entity, error)
+	DeleteProviderHistory(ctx context.Context, pubkey, service string) (map[string]int64, error)
+	WithTransaction(ctx context.Context, fn func(store IDataStorage) error) error
+	EnqueueWebhookDeliveries(ctx context.Context, eventType, service string, pubkeys []string, payload []byte) (int64, error)
+	FindDueWebhookDeliveries(ctx context.Context, limit int) ([]*WebhookDelivery, error)
+	UpdateWebhookDelivery(ctx context.Context, delivery *WebhookDelivery) (*Entity, error)
 }
 
 var _ IDataStorage = &DirectoryDB{}
@@ -67,9 +77,18 @@ type (
 	DirectoryDB        struct {
 		pool     Acquireable
 		hijacker connectionHijacker // this is only used for test
+		tx       pgx.Tx             // set when the instance is bound to a transaction
 	}
 )
 
+// txConnection allows a pgx.Tx to be used wherever a pooled connection is expected
+type txConnection struct {
+	pgx.Tx
+}
+
+// Release is a no-op, the transaction owner is responsible for commit / rollback
+func (txConnection) Release() {}
+
 // Entity base entity for db types
 type Entity struct {
 	ID      int64     `db:"id"`
@@ -81,6 +100,9 @@ var log = logging.WithoutFields()
 
 // obtain a db connection, callers must call conn.Release() when finished to return the conn to the pool
 func (d *DirectoryDB) getConnection(ctx context.Context) (IConnection, error) {
+	if d.tx != nil {
+		return txConnection{Tx: d.tx}, nil
+	}
 	if d.hijacker != nil {
 		return d.hijacker()
 	}
@@ -105,3 +127,28 @@ func New(config DBConfig) (*DirectoryDB, error) {
 		pool: pool,
 	}, nil
 }
+
+// WithTransaction runs fn against a DirectoryDB bound to a single transaction. The transaction is committed
+// when fn returns nil and rolled back otherwise
+func (d *DirectoryDB) WithTransaction(ctx context.Context, fn func(store IDataStorage) error) (err error) {
+	conn, err := d.getConnection(ctx)
+	if err != nil {
+		return errors.Wrapf(err, "error obtaining db connection")
+	}
+	defer conn.Release()
+
+	tx, err := conn.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to begin transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback(ctx)
+		}
+	}()
+
+	if err = fn(&DirectoryDB{tx: tx}); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
@@ -2,6 +2,7 @@ package db
 
 import (
 	"context"
+	"errors"
 	"testing"
 	"time"
 
@@ -73,3 +74,32 @@ func getMockDirectoryDBForTest(t *testing.T) (pgxmock.PgxPoolIface, *DirectoryDB
 	}
 	return m, db
 }
+
+func TestWithTransaction(t *testing.T) {
+	m, db := getMockDirectoryDBForTest(t)
+	defer m.Close()
+	testTime := time.Now()
+
+	m.ExpectBegin()
+	m.ExpectQuery("update contracts.*").
+		WithArgs(int64(1024), uint64(1)).
+		WillReturnRows(
+			pgxmock.NewRows([]string{"id", "created", "updated"}).
+				AddRow(int64(1), testTime, testTime),
+		)
+	m.ExpectCommit()
+	err := db.WithTransaction(context.Background(), func(store IDataStorage) error {
+		_, err := store.CloseContract(context.Background(), 1, 1024)
+		return err
+	})
+	assert.Nil(t, err)
+	assert.Nil(t, m.ExpectationsWereMet())
+
+	m.ExpectBegin()
+	m.ExpectRollback()
+	err = db.WithTransaction(context.Background(), func(store IDataStorage) error {
+		return errors.New("abort")
+	})
+	assert.NotNil(t, err)
+	assert.Nil(t, m.ExpectationsWereMet())
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/stretchr/testify/mock"
 
+	"github.com/arkeonetwork/arkeo/common/cosmos"
 	"github.com/arkeonetwork/arkeo/sentinel"
 	atypes "github.com/arkeonetwork/arkeo/x/arkeo/types"
 )
@@ -42,6 +43,15 @@ func (s *MockDataStorage) UpsertValidatorPayoutEvent(ctx context.Context, evt at
 	return args.Get(0).(*Entity), args.Error(1)
 }
 
+func (s *MockDataStorage) UpsertParamChange(ctx context.Context, height int64, change atypes.ParamChange) (*Entity, error) {
+	args := s.Called(ctx, height, change)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	//nolint:forcetypeassert
+	return args.Get(0).(*Entity), args.Error(1)
+}
+
 func (s *MockDataStorage) FindProvider(ctx context.Context, pubkey, service string) (*ArkeoProvider, error) {
 	args := s.Called(ctx, pubkey, service)
 	if args.Get(0) == nil {
@@ -78,6 +88,33 @@ func (s *MockDataStorage) CloseContract(ctx context.Context, contractID uint64,
 	return args.Get(0).(*Entity), args.Error(1)
 }
 
+func (s *MockDataStorage) RenewContract(ctx context.Context, evt atypes.EventRenewContract) (*Entity, error) {
+	args := s.Called(ctx, evt)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	//nolint:forcetypeassert
+	return args.Get(0).(*Entity), args.Error(1)
+}
+
+func (s *MockDataStorage) ExtendContract(ctx context.Context, contractID uint64, expiration int64, deposit cosmos.Int) (*Entity, error) {
+	args := s.Called(ctx, contractID, expiration, deposit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	//nolint:forcetypeassert
+	return args.Get(0).(*Entity), args.Error(1)
+}
+
+func (s *MockDataStorage) RotateDelegate(ctx context.Context, evt atypes.EventRotateDelegate) (*Entity, error) {
+	args := s.Called(ctx, evt)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	//nolint:forcetypeassert
+	return args.Get(0).(*Entity), args.Error(1)
+}
+
 func (s *MockDataStorage) UpdateProvider(ctx context.Context, provider *ArkeoProvider) (*Entity, error) {
 	args := s.Called(ctx, provider)
 	if args.Get(0) == nil {
@@ -122,3 +159,45 @@ func (s *MockDataStorage) InsertProvider(ctx context.Context, provider *ArkeoPro
 	//nolint:forcetypeassert
 	return args.Get(0).(*Entity), args.Error(1)
 }
+
+func (s *MockDataStorage) DeleteProviderHistory(ctx context.Context, pubkey, service string) (map[string]int64, error) {
+	args := s.Called(ctx, pubkey, service)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	//nolint:forcetypeassert
+	return args.Get(0).(map[string]int64), args.Error(1)
+}
+
+// WithTransaction runs fn against the mock itself once the expectation has been met
+func (s *MockDataStorage) WithTransaction(ctx context.Context, fn func(store IDataStorage) error) error {
+	args := s.Called(ctx, fn)
+	if err := args.Error(0); err != nil {
+		return err
+	}
+	return fn(s)
+}
+
+func (s *MockDataStorage) EnqueueWebhookDeliveries(ctx context.Context, eventType, service string, pubkeys []string, payload []byte) (int64, error) {
+	args := s.Called(ctx, eventType, service, pubkeys, payload)
+	//nolint:forcetypeassert
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (s *MockDataStorage) FindDueWebhookDeliveries(ctx context.Context, limit int) ([]*WebhookDelivery, error) {
+	args := s.Called(ctx, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	//nolint:forcetypeassert
+	return args.Get(0).([]*WebhookDelivery), args.Error(1)
+}
+
+func (s *MockDataStorage) UpdateWebhookDelivery(ctx context.Context, delivery *WebhookDelivery) (*Entity, error) {
+	args := s.Called(ctx, delivery)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	//nolint:forcetypeassert
+	return args.Get(0).(*Entity), args.Error(1)
+}
@@ -0,0 +1,20 @@
+package db
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	atypes "github.com/arkeonetwork/arkeo/x/arkeo/types"
+)
+
+// UpsertParamChange save a module param change, replayed heights overwrite the change they recorded
+func (d *DirectoryDB) UpsertParamChange(ctx context.Context, height int64, change atypes.ParamChange) (*Entity, error) {
+	conn, err := d.getConnection(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error obtaining db connection")
+	}
+	defer conn.Release()
+
+	return upsert(ctx, conn, sqlUpsertParamChange, height, change.Key, change.OldValue, change.NewValue)
+}
@@ -0,0 +1,12 @@
+package db
+
+const (
+	sqlUpsertParamChange = `insert into param_changes(height,key,old_value,new_value)
+	values ($1,$2,$3,$4)
+	on conflict on constraint param_changes_height_key_key
+	do update set updated = now(), old_value = $3, new_value = $4
+	where param_changes.height = $1
+	  and param_changes.key = $2
+	returning id, created, updated
+	`
+)
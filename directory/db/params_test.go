@@ -0,0 +1,33 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pashagolub/pgxmock/v2"
+	"github.com/stretchr/testify/assert"
+
+	arkeotypes "github.com/arkeonetwork/arkeo/x/arkeo/types"
+)
+
+func TestUpsertParamChange(t *testing.T) {
+	m, db := getMockDirectoryDBForTest(t)
+	defer m.Close()
+	testTime := time.Now()
+	m.ExpectQuery("insert into param_changes.*").
+		WithArgs(int64(12), "SlashFraction", "500", "1000").
+		WillReturnRows(
+			pgxmock.NewRows([]string{"id", "created", "updated"}).
+				AddRow(int64(1), testTime, testTime))
+
+	entity, err := db.UpsertParamChange(context.Background(), 12, arkeotypes.ParamChange{
+		Key:      "SlashFraction",
+		OldValue: "500",
+		NewValue: "1000",
+	})
+	assert.Nil(t, err)
+	assert.NotNil(t, entity)
+	assert.Equal(t, int64(1), entity.ID)
+	assert.Nil(t, m.ExpectationsWereMet())
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/georgysavva/scany/v2/pgxscan"
 	"github.com/huandu/go-sqlbuilder"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/pkg/errors"
 
 	"github.com/arkeonetwork/arkeo/common/cosmos"
@@ -346,3 +347,36 @@ func (d *DirectoryDB) UpsertProviderMetadata(ctx context.Context, providerID, no
 	// TODO - always insert instead of upsert, fail on dupe (or read and fail on exists). are there any restrictions on version string?
 	return insert(ctx, conn, sqlUpsertProviderMetadata, providerID, nonce, c.Moniker, c.Website, c.Description, location, c.FreeTierRateLimit)
 }
+
+// DeleteProviderHistory removes the provider identified by pubkey+service along with every row derived from its
+// events (bonds, metadata, rates, contracts and their events). It returns the number of rows removed per table
+func (d *DirectoryDB) DeleteProviderHistory(ctx context.Context, pubkey, service string) (map[string]int64, error) {
+	conn, err := d.getConnection(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error obtaining db connection")
+	}
+	defer conn.Release()
+
+	tx, err := conn.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to begin transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback(ctx)
+		}
+	}()
+
+	deleted := make(map[string]int64, len(providerHistoryDeletes))
+	for _, stmt := range providerHistoryDeletes {
+		var tag pgconn.CommandTag
+		tag, err = tx.Exec(ctx, stmt.SQL, pubkey, service)
+		if err != nil {
+			return nil, fmt.Errorf("fail to delete from %s: %w", stmt.Table, err)
+		}
+		deleted[stmt.Table] = tag.RowsAffected()
+	}
+
+	err = tx.Commit(ctx)
+	return deleted, err
+}
@@ -103,4 +103,31 @@ var (
 		SELECT * FROM provider_pay_as_you_go_rates
         WHERE provider_id = $1
 	`
+
+	// providerHistoryDeletes removes every row derived from a provider's events, children first. each
+	// statement takes the provider pubkey and service as $1 and $2
+	providerHistoryDeletes = []struct {
+		Table string
+		SQL   string
+	}{
+		{"contract_settlement_events", `delete from contract_settlement_events where contract_id in (
+			select c.id from contracts c join providers p on p.id = c.provider_id where p.pubkey = $1 and p.service = $2)`},
+		{"open_contract_events", `delete from open_contract_events where contract_id in (
+			select c.id from contracts c join providers p on p.id = c.provider_id where p.pubkey = $1 and p.service = $2)`},
+		{"close_contract_events", `delete from close_contract_events where contract_id in (
+			select c.id from contracts c join providers p on p.id = c.provider_id where p.pubkey = $1 and p.service = $2)`},
+		{"contracts", `delete from contracts where provider_id in (
+			select id from providers where pubkey = $1 and service = $2)`},
+		{"provider_bond_events", `delete from provider_bond_events where provider_id in (
+			select id from providers where pubkey = $1 and service = $2)`},
+		{"provider_mod_events", `delete from provider_mod_events where provider_id in (
+			select id from providers where pubkey = $1 and service = $2)`},
+		{"provider_metadata", `delete from provider_metadata where provider_id in (
+			select id from providers where pubkey = $1 and service = $2)`},
+		{"provider_subscription_rates", `delete from provider_subscription_rates where provider_id in (
+			select id from providers where pubkey = $1 and service = $2)`},
+		{"provider_pay_as_you_go_rates", `delete from provider_pay_as_you_go_rates where provider_id in (
+			select id from providers where pubkey = $1 and service = $2)`},
+		{"providers", `delete from providers where pubkey = $1 and service = $2`},
+	}
 )
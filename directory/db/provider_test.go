@@ -290,3 +290,33 @@ func TestUpsertProviderMetadata(t *testing.T) {
 	assert.Equal(t, testTime, entity.Updated)
 	assert.Nil(t, m.ExpectationsWereMet())
 }
+
+func TestDeleteProviderHistory(t *testing.T) {
+	m, db := getMockDirectoryDBForTest(t)
+	defer m.Close()
+	m.ExpectBegin()
+	for i, stmt := range providerHistoryDeletes {
+		m.ExpectExec("delete from "+stmt.Table).
+			WithArgs("provider-pubkey", "mock").
+			WillReturnResult(pgxmock.NewResult("DELETE", int64(i)))
+	}
+	m.ExpectCommit()
+	deleted, err := db.DeleteProviderHistory(context.Background(), "provider-pubkey", "mock")
+	assert.Nil(t, err)
+	assert.Len(t, deleted, len(providerHistoryDeletes))
+	assert.Equal(t, int64(0), deleted["contract_settlement_events"])
+	assert.Equal(t, int64(3), deleted["contracts"])
+	assert.Equal(t, int64(len(providerHistoryDeletes)-1), deleted["providers"])
+	assert.Nil(t, m.ExpectationsWereMet())
+
+	// a failure part way through must not leave a partially deleted provider behind
+	m.ExpectBegin()
+	m.ExpectExec("delete from contract_settlement_events").
+		WithArgs("provider-pubkey", "mock").
+		WillReturnError(fmt.Errorf("fail to delete"))
+	m.ExpectRollback()
+	deleted, err = db.DeleteProviderHistory(context.Background(), "provider-pubkey", "mock")
+	assert.NotNil(t, err)
+	assert.Nil(t, deleted)
+	assert.Nil(t, m.ExpectationsWereMet())
+}
@@ -21,3 +21,18 @@ func (d *DirectoryDB) GetArkeoNetworkStats(ctx context.Context) (*types.ArkeoSta
 
 	return &stats, nil
 }
+
+// GetProviderStats aggregates contract activity for the provider identified by pubkey+service
+func (d *DirectoryDB) GetProviderStats(ctx context.Context, pubkey, service string) (*types.ProviderStats, error) {
+	conn, err := d.getConnection(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error obtaining db connection")
+	}
+	defer conn.Release()
+	stats := types.ProviderStats{}
+	if err = selectOne(ctx, conn, sqlGetProviderStats, &stats, pubkey, service); err != nil {
+		return nil, errors.Wrapf(err, "error getting provider stats")
+	}
+
+	return &stats, nil
+}
@@ -1,3 +1,18 @@
 package db
 
 var sqlGetNetworkStats = `select * from network_stats_v limit 1`
+
+var sqlGetProviderStats = `
+	select p.pubkey,
+		p.service,
+		count(c.id) filter (where c.closed_height = 0) as open_contracts,
+		count(c.id)                                     as total_contracts,
+		coalesce(sum(c.nonce), 0)                       as total_queries,
+		coalesce(sum(c.paid), 0)                        as total_paid,
+		coalesce(sum(c.unpaid), 0)                      as total_unpaid
+	from providers p
+		left outer join contracts c on c.provider_id = p.id
+	where p.pubkey = $1
+		and p.service = $2
+	group by p.pubkey, p.service
+`
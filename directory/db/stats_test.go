@@ -30,3 +30,26 @@ func TestGetArkeoNetworkStats(t *testing.T) {
 	assert.Equal(t, int64(7), state.TotalIncome)
 	assert.Nil(t, m.ExpectationsWereMet())
 }
+
+func TestGetProviderStats(t *testing.T) {
+	m, db := getMockDirectoryDBForTest(t)
+	defer m.Close()
+	m.ExpectQuery("select.*from providers p.*left outer join contracts c.*").
+		WithArgs("provider-pubkey", "mock").
+		WillReturnRows(
+			pgxmock.NewRows([]string{
+				"pubkey", "service", "open_contracts", "total_contracts", "total_queries", "total_paid", "total_unpaid",
+			}).
+				AddRow("provider-pubkey", "mock", int64(1), int64(3), int64(40), int64(600), int64(150)))
+	stats, err := db.GetProviderStats(context.Background(), "provider-pubkey", "mock")
+	assert.Nil(t, err)
+	assert.NotNil(t, stats)
+	assert.Equal(t, "provider-pubkey", stats.Pubkey)
+	assert.Equal(t, "mock", stats.Service)
+	assert.Equal(t, int64(1), stats.ContractsOpen)
+	assert.Equal(t, int64(3), stats.ContractsTotal)
+	assert.Equal(t, int64(40), stats.QueryCount)
+	assert.Equal(t, int64(600), stats.TotalIncome)
+	assert.Equal(t, int64(150), stats.TotalUnpaid)
+	assert.Nil(t, m.ExpectationsWereMet())
+}
@@ -0,0 +1,126 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/pkg/errors"
+)
+
+const (
+	WebhookStatusPending   = "pending"
+	WebhookStatusDelivered = "delivered"
+	// WebhookStatusDead marks a delivery that exhausted its attempts, it is kept for inspection but never retried
+	WebhookStatusDead = "dead"
+)
+
+// WebhookSubscription is an integrator endpoint that get notified about the listed event types
+type WebhookSubscription struct {
+	Entity     `json:"-"`
+	URL        string   `json:"url" db:"url"`
+	Secret     string   `json:"-" db:"secret"`
+	EventTypes []string `json:"event_types" db:"event_types"`
+	// optional filters, empty means any
+	Service string `json:"service" db:"service"`
+	Pubkey  string `json:"pubkey" db:"pubkey"`
+	Active  bool   `json:"active" db:"active"`
+}
+
+// WebhookDelivery is a single payload queued for a subscription, along with the subscription's endpoint
+type WebhookDelivery struct {
+	Entity
+	SubscriptionID int64     `db:"subscription_id"`
+	EventType      string    `db:"event_type"`
+	Payload        []byte    `db:"payload"`
+	Status         string    `db:"status"`
+	Attempts       int       `db:"attempts"`
+	NextAttempt    time.Time `db:"next_attempt"`
+	LastError      string    `db:"last_error"`
+	URL            string    `db:"url"`
+	Secret         string    `db:"secret"`
+}
+
+func (d *DirectoryDB) InsertWebhookSubscription(ctx context.Context, sub *WebhookSubscription) (*Entity, error) {
+	if sub == nil {
+		return nil, fmt.Errorf("nil webhook subscription")
+	}
+	conn, err := d.getConnection(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error obtaining db connection")
+	}
+	defer conn.Release()
+
+	return insert(ctx, conn, sqlInsertWebhookSubscription, sub.URL, sub.Secret, sub.EventTypes, sub.Service, sub.Pubkey)
+}
+
+func (d *DirectoryDB) ListWebhookSubscriptions(ctx context.Context) ([]*WebhookSubscription, error) {
+	conn, err := d.getConnection(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error obtaining db connection")
+	}
+	defer conn.Release()
+
+	subs := make([]*WebhookSubscription, 0)
+	if err := pgxscan.Select(ctx, conn, &subs, sqlListWebhookSubscriptions); err != nil {
+		return nil, errors.Wrapf(err, "error selecting webhook subscriptions")
+	}
+	return subs, nil
+}
+
+// DeleteWebhookSubscription removes the subscription and its queued deliveries, ErrNotFound is returned when it doesn't exist
+func (d *DirectoryDB) DeleteWebhookSubscription(ctx context.Context, id int64) (*Entity, error) {
+	conn, err := d.getConnection(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error obtaining db connection")
+	}
+	defer conn.Release()
+
+	return update(ctx, conn, sqlDeleteWebhookSubscription, id)
+}
+
+// EnqueueWebhookDeliveries queue the payload for every active subscription matching the event type, service and any
+// of the given pubkeys. It returns the number of deliveries queued
+func (d *DirectoryDB) EnqueueWebhookDeliveries(ctx context.Context, eventType, service string, pubkeys []string, payload []byte) (int64, error) {
+	conn, err := d.getConnection(ctx)
+	if err != nil {
+		return 0, errors.Wrapf(err, "error obtaining db connection")
+	}
+	defer conn.Release()
+
+	var queued int64
+	if err := conn.QueryRow(ctx, sqlEnqueueWebhookDeliveries, eventType, service, pubkeys, payload).Scan(&queued); err != nil {
+		return 0, errors.Wrapf(err, "error enqueuing %s webhook deliveries", eventType)
+	}
+	return queued, nil
+}
+
+// FindDueWebhookDeliveries return up to limit pending deliveries that are ready to be attempted
+func (d *DirectoryDB) FindDueWebhookDeliveries(ctx context.Context, limit int) ([]*WebhookDelivery, error) {
+	conn, err := d.getConnection(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error obtaining db connection")
+	}
+	defer conn.Release()
+
+	deliveries := make([]*WebhookDelivery, 0, limit)
+	if err := pgxscan.Select(ctx, conn, &deliveries, sqlFindDueWebhookDeliveries, limit); err != nil {
+		return nil, errors.Wrapf(err, "error selecting due webhook deliveries")
+	}
+	return deliveries, nil
+}
+
+// UpdateWebhookDelivery persist the outcome of a delivery attempt
+func (d *DirectoryDB) UpdateWebhookDelivery(ctx context.Context, delivery *WebhookDelivery) (*Entity, error) {
+	if delivery == nil {
+		return nil, fmt.Errorf("nil webhook delivery")
+	}
+	conn, err := d.getConnection(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error obtaining db connection")
+	}
+	defer conn.Release()
+
+	return update(ctx, conn, sqlUpdateWebhookDelivery, delivery.Status, delivery.Attempts, delivery.NextAttempt, delivery.LastError, delivery.ID)
+}
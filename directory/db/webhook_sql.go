@@ -0,0 +1,55 @@
+package db
+
+const (
+	sqlInsertWebhookSubscription = `
+		insert into webhook_subscriptions(url, secret, event_types, service, pubkey)
+		values ($1, $2, $3, $4, $5)
+		returning id, created, updated
+	`
+
+	sqlListWebhookSubscriptions = `
+		select id, created, updated, url, secret, event_types, service, pubkey, active
+		from webhook_subscriptions
+		order by id
+	`
+
+	sqlDeleteWebhookSubscription = `
+		delete from webhook_subscriptions
+		where id = $1
+		returning id, created, updated
+	`
+
+	// a subscription matches when it listens to the event type and its (optional) service / pubkey filters match
+	sqlEnqueueWebhookDeliveries = `
+		with queued as (
+			insert into webhook_deliveries(subscription_id, event_type, payload)
+			select s.id, $1, $4
+			from webhook_subscriptions s
+			where s.active
+			  and $1 = any(s.event_types)
+			  and (s.service = '' or s.service = $2)
+			  and (s.pubkey = '' or s.pubkey = any($3))
+			returning id
+		)
+		select count(*) from queued
+	`
+
+	sqlFindDueWebhookDeliveries = `
+		select d.id, d.created, d.updated, d.subscription_id, d.event_type, d.payload, d.status,
+			d.attempts, d.next_attempt, d.last_error, s.url, s.secret
+		from webhook_deliveries d
+		join webhook_subscriptions s on s.id = d.subscription_id
+		where d.status = 'pending'
+		  and d.next_attempt <= now()
+		  and s.active
+		order by d.next_attempt, d.id
+		limit $1
+	`
+
+	sqlUpdateWebhookDelivery = `
+		update webhook_deliveries
+		set status = $1, attempts = $2, next_attempt = $3, last_error = $4, updated = now()
+		where id = $5
+		returning id, created, updated
+	`
+)
@@ -0,0 +1,68 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pashagolub/pgxmock/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEnqueueWebhookDeliveries(t *testing.T) {
+	m, db := getMockDirectoryDBForTest(t)
+	defer m.Close()
+	payload := []byte(`{"event":"contract.settled"}`)
+	pubkeys := []string{"provider-pubkey", "client-pubkey"}
+	m.ExpectQuery("with queued as.*insert into webhook_deliveries.*").
+		WithArgs("contract.settled", "mock", pubkeys, payload).
+		WillReturnRows(pgxmock.NewRows([]string{"count"}).AddRow(int64(2)))
+	queued, err := db.EnqueueWebhookDeliveries(context.Background(), "contract.settled", "mock", pubkeys, payload)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), queued)
+	assert.Nil(t, m.ExpectationsWereMet())
+}
+
+func TestFindDueWebhookDeliveries(t *testing.T) {
+	m, db := getMockDirectoryDBForTest(t)
+	defer m.Close()
+	testTime := time.Now()
+	m.ExpectQuery("select.*from webhook_deliveries d.*join webhook_subscriptions s.*").
+		WithArgs(10).
+		WillReturnRows(pgxmock.NewRows([]string{
+			"id", "created", "updated", "subscription_id", "event_type", "payload", "status",
+			"attempts", "next_attempt", "last_error", "url", "secret",
+		}).AddRow(int64(1), testTime, testTime, int64(3), "provider.created", []byte(`{}`), WebhookStatusPending,
+			2, testTime, "unexpected status code 500", "http://localhost/hook", "s3cr3t"))
+	deliveries, err := db.FindDueWebhookDeliveries(context.Background(), 10)
+	assert.Nil(t, err)
+	assert.Len(t, deliveries, 1)
+	assert.Equal(t, int64(3), deliveries[0].SubscriptionID)
+	assert.Equal(t, 2, deliveries[0].Attempts)
+	assert.Equal(t, "http://localhost/hook", deliveries[0].URL)
+	assert.Equal(t, "s3cr3t", deliveries[0].Secret)
+	assert.Nil(t, m.ExpectationsWereMet())
+}
+
+func TestUpdateWebhookDelivery(t *testing.T) {
+	m, db := getMockDirectoryDBForTest(t)
+	defer m.Close()
+	testTime := time.Now()
+	delivery := &WebhookDelivery{
+		Entity:      Entity{ID: 5},
+		Status:      WebhookStatusDead,
+		Attempts:    8,
+		NextAttempt: testTime,
+		LastError:   "unexpected status code 500",
+	}
+	m.ExpectQuery("update webhook_deliveries.*").
+		WithArgs(WebhookStatusDead, 8, testTime, "unexpected status code 500", int64(5)).
+		WillReturnRows(pgxmock.NewRows([]string{"id", "created", "updated"}).AddRow(int64(5), testTime, testTime))
+	entity, err := db.UpdateWebhookDelivery(context.Background(), delivery)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(5), entity.ID)
+	assert.Nil(t, m.ExpectationsWereMet())
+
+	_, err = db.UpdateWebhookDelivery(context.Background(), nil)
+	assert.NotNil(t, err)
+}
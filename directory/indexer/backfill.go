@@ -0,0 +1,162 @@
+package indexer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	tmtypes "github.com/cometbft/cometbft/types"
+
+	"github.com/arkeonetwork/arkeo/directory/db"
+	atypes "github.com/arkeonetwork/arkeo/x/arkeo/types"
+)
+
+// ErrNoProviderEvents is returned when a backfill range contains no events for the requested provider
+var ErrNoProviderEvents = errors.New("no events found for provider")
+
+// providerEventTypes are the events that produce provider derived rows, all of them carry provider and service attributes
+var providerEventTypes = map[string]bool{
+	atypes.EventTypeBondProvider:        true,
+	atypes.EventTypeModProvider:         true,
+	atypes.EventTypeOpenContract:        true,
+	atypes.EventTypeSettleContract:      true,
+	atypes.EventTypeCloseContract:       true,
+	atypes.EventTypeRenewContract:       true,
+	atypes.EventTypeRotateDelegate:      true,
+	atypes.EventTypeTopUpContract:       true,
+	atypes.EventTypeContractAutoRenewed: true,
+	atypes.EventTypeSlashProvider:       true,
+	atypes.EventTypeProviderDemoted:     true,
+}
+
+// heightEvent is an abci event along with the height and transaction (nil for block events) it was emitted in
+type heightEvent struct {
+	height      int64
+	transaction tmtypes.Tx
+	event       abcitypes.Event
+}
+
+// BackfillSummary describe what a provider backfill rebuilt
+type BackfillSummary struct {
+	Pubkey         string
+	Service        string
+	StartHeight    int64
+	EndHeight      int64
+	HeightsScanned int64
+	RowsDeleted    map[string]int64
+	EventsApplied  map[string]int64
+}
+
+// BackfillProvider rebuild all the rows derived from a single provider's events without touching any other provider.
+// The directory doesn't keep an archive of raw events, so every height in [start,end] is read back from the node.
+// When end is not set it defaults to the latest indexed block
+func (s *Service) BackfillProvider(ctx context.Context, pubkey, service string, start, end int64) (*BackfillSummary, error) {
+	if start <= 0 {
+		start = 1
+	}
+	if end <= 0 {
+		latest, err := s.db.FindLatestBlock(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("fail to find latest indexed block,err: %w", err)
+		}
+		end = latest.Height
+	}
+	if end < start {
+		return nil, fmt.Errorf("end height %d is before start height %d", end, start)
+	}
+
+	var events []heightEvent
+	for height := start; height <= end; height++ {
+		found, err := s.providerEventsAtHeight(height, pubkey, service)
+		if err != nil {
+			return nil, fmt.Errorf("fail to read events at height %d,err: %w", height, err)
+		}
+		events = append(events, found...)
+	}
+
+	summary, err := s.rebuildProvider(ctx, pubkey, service, events)
+	if err != nil {
+		return nil, err
+	}
+	summary.StartHeight = start
+	summary.EndHeight = end
+	summary.HeightsScanned = end - start + 1
+	return summary, nil
+}
+
+// providerEventsAtHeight read the block at the given height and return the events referencing the provider
+func (s *Service) providerEventsAtHeight(height int64, pubkey, service string) ([]heightEvent, error) {
+	block, blockResults, err := s.fetchBlock(height)
+	if err != nil {
+		return nil, err
+	}
+	var all []heightEvent
+	for _, transaction := range block.Block.Txs {
+		txEvents, err := s.transactionEvents(transaction)
+		if err != nil {
+			return nil, err
+		}
+		for _, event := range txEvents {
+			all = append(all, heightEvent{height: height, transaction: transaction, event: event})
+		}
+	}
+	for _, event := range blockResults.FinalizeBlockEvents {
+		all = append(all, heightEvent{height: height, event: event})
+	}
+	return filterProviderEvents(all, pubkey, service)
+}
+
+// filterProviderEvents return the events that reference the given provider, preserving their order
+func filterProviderEvents(events []heightEvent, pubkey, service string) ([]heightEvent, error) {
+	var result []heightEvent
+	for _, item := range events {
+		if !providerEventTypes[item.event.Type] {
+			continue
+		}
+		attributes, err := convertEventToMap(item.event)
+		if err != nil {
+			return nil, err
+		}
+		if attributes["provider"] == pubkey && attributes["service"] == service {
+			result = append(result, item)
+		}
+	}
+	return result, nil
+}
+
+// rebuildProvider wipe the provider's derived rows and re-apply the given events inside a single transaction
+func (s *Service) rebuildProvider(ctx context.Context, pubkey, service string, events []heightEvent) (*BackfillSummary, error) {
+	// refuse to wipe a provider when nothing can be re-applied, most likely the height range is wrong
+	if len(events) == 0 {
+		return nil, fmt.Errorf("%w %s service %s", ErrNoProviderEvents, pubkey, service)
+	}
+	summary := &BackfillSummary{
+		Pubkey:        pubkey,
+		Service:       service,
+		EventsApplied: make(map[string]int64),
+	}
+	err := s.db.WithTransaction(ctx, func(store db.IDataStorage) error {
+		deleted, err := store.DeleteProviderHistory(ctx, pubkey, service)
+		if err != nil {
+			return fmt.Errorf("fail to delete history of provider %s service %s,err: %w", pubkey, service, err)
+		}
+		summary.RowsDeleted = deleted
+
+		replay := *s
+		replay.db = store
+		// subscribers were already notified when these events were first indexed
+		replay.params.Webhook.Enabled = false
+		for _, item := range events {
+			if err := replay.handleAbciEvent(item.event, item.transaction, item.height); err != nil {
+				return fmt.Errorf("fail to apply %s at height %d,err: %w", item.event.Type, item.height, err)
+			}
+			summary.EventsApplied[item.event.Type]++
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return summary, nil
+}
@@ -0,0 +1,124 @@
+package indexer
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	cosmostypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/arkeonetwork/arkeo/common"
+	"github.com/arkeonetwork/arkeo/common/logging"
+	"github.com/arkeonetwork/arkeo/directory/db"
+	arkeotypes "github.com/arkeonetwork/arkeo/x/arkeo/types"
+)
+
+func toAbciEvent(t *testing.T, msg proto.Message) abcitypes.Event {
+	evt, err := cosmostypes.TypedEventToEvent(msg)
+	assert.Nil(t, err)
+	return abcitypes.Event(evt)
+}
+
+// interleavedProviderEvents returns events of two providers for the same service mixed together, as they would
+// appear on chain
+func interleavedProviderEvents(t *testing.T, providerA, providerB common.PubKey) []heightEvent {
+	client := arkeotypes.GetRandomPubKey()
+	return []heightEvent{
+		{height: 10, event: toAbciEvent(t, &arkeotypes.EventBondProvider{Provider: providerA, Service: "mock", BondRel: math.NewInt(100), BondAbs: math.NewInt(100)})},
+		{height: 10, event: abcitypes.Event{Type: "transfer"}},
+		{height: 11, event: toAbciEvent(t, &arkeotypes.EventBondProvider{Provider: providerB, Service: "mock", BondRel: math.NewInt(200), BondAbs: math.NewInt(200)})},
+		{height: 12, event: toAbciEvent(t, &arkeotypes.EventOpenContract{
+			Provider: providerA, ContractId: 1, Service: "mock", Client: client, Type: arkeotypes.ContractType_PAY_AS_YOU_GO,
+			Height: 12, Duration: 100, Rate: cosmostypes.NewCoin("uarkeo", math.NewInt(1)), Deposit: math.NewInt(100),
+		})},
+		{height: 12, event: toAbciEvent(t, &arkeotypes.EventOpenContract{
+			Provider: providerB, ContractId: 2, Service: "mock", Client: client, Type: arkeotypes.ContractType_PAY_AS_YOU_GO,
+			Height: 12, Duration: 100, Rate: cosmostypes.NewCoin("uarkeo", math.NewInt(1)), Deposit: math.NewInt(100),
+		})},
+		{height: 13, event: toAbciEvent(t, &arkeotypes.EventSettleContract{
+			Provider: providerB, ContractId: 2, Service: "mock", Client: client, Nonce: 5, Height: 12,
+			Paid: math.NewInt(5), Reserve: math.ZeroInt(),
+		})},
+		{height: 14, event: toAbciEvent(t, &arkeotypes.EventSettleContract{
+			Provider: providerA, ContractId: 1, Service: "mock", Client: client, Nonce: 10, Height: 12,
+			Paid: math.NewInt(10), Reserve: math.ZeroInt(),
+		})},
+		{height: 15, event: toAbciEvent(t, &arkeotypes.EventCloseContract{ContractId: 2, Provider: providerB, Service: "mock", Client: client})},
+	}
+}
+
+func TestFilterProviderEvents(t *testing.T) {
+	providerA := arkeotypes.GetRandomPubKey()
+	providerB := arkeotypes.GetRandomPubKey()
+	events := interleavedProviderEvents(t, providerA, providerB)
+
+	result, err := filterProviderEvents(events, providerA.String(), "mock")
+	assert.Nil(t, err)
+	assert.Len(t, result, 3)
+	assert.Equal(t, arkeotypes.EventTypeBondProvider, result[0].event.Type)
+	assert.Equal(t, int64(10), result[0].height)
+	assert.Equal(t, arkeotypes.EventTypeOpenContract, result[1].event.Type)
+	assert.Equal(t, arkeotypes.EventTypeSettleContract, result[2].event.Type)
+	assert.Equal(t, int64(14), result[2].height)
+
+	result, err = filterProviderEvents(events, providerB.String(), "mock")
+	assert.Nil(t, err)
+	assert.Len(t, result, 4)
+	assert.Equal(t, arkeotypes.EventTypeCloseContract, result[3].event.Type)
+
+	// same provider on another service has nothing to rebuild
+	result, err = filterProviderEvents(events, providerA.String(), "btc-mainnet-fullnode")
+	assert.Nil(t, err)
+	assert.Empty(t, result)
+}
+
+func TestRebuildProvider(t *testing.T) {
+	mockDb := new(db.MockDataStorage)
+	s := Service{
+		params:         ServiceParams{},
+		db:             mockDb,
+		done:           make(chan struct{}),
+		wg:             &sync.WaitGroup{},
+		logger:         logging.WithoutFields(),
+		tmClient:       nil,
+		blockFillQueue: make(chan db.BlockGap),
+	}
+	providerA := arkeotypes.GetRandomPubKey()
+	providerB := arkeotypes.GetRandomPubKey()
+	events, err := filterProviderEvents(interleavedProviderEvents(t, providerA, providerB), providerA.String(), "mock")
+	assert.Nil(t, err)
+
+	// nothing to re-apply, the provider must be left untouched
+	_, err = s.rebuildProvider(context.Background(), providerA.String(), "mock", nil)
+	assert.ErrorIs(t, err, ErrNoProviderEvents)
+	mockDb.AssertNotCalled(t, "WithTransaction", mock.Anything, mock.Anything)
+
+	entity := &db.Entity{ID: 7, Created: time.Now(), Updated: time.Now()}
+	mockDb.On("WithTransaction", mock.Anything, mock.Anything).Return(nil)
+	mockDb.On("DeleteProviderHistory", mock.Anything, providerA.String(), "mock").
+		Return(map[string]int64{"providers": 1, "contracts": 1, "provider_bond_events": 2}, nil)
+	mockDb.On("FindProvider", mock.Anything, providerA.String(), "mock").Return(nil, db.ErrNotFound).Once()
+	mockDb.On("InsertProvider", mock.Anything, mock.Anything).Return(entity, nil)
+	mockDb.On("InsertBondProviderEvent", mock.Anything, int64(7), mock.Anything, int64(10), "").Return(entity, nil)
+	mockDb.On("FindProvider", mock.Anything, providerA.String(), "mock").Return(&db.ArkeoProvider{Entity: *entity}, nil)
+	mockDb.On("UpsertContract", mock.Anything, int64(7), mock.Anything).Return(entity, nil)
+	mockDb.On("UpsertContractSettlementEvent", mock.Anything, mock.Anything).Return(entity, nil)
+
+	summary, err := s.rebuildProvider(context.Background(), providerA.String(), "mock", events)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), summary.RowsDeleted["providers"])
+	assert.Equal(t, int64(2), summary.RowsDeleted["provider_bond_events"])
+	assert.Equal(t, int64(1), summary.EventsApplied[arkeotypes.EventTypeBondProvider])
+	assert.Equal(t, int64(1), summary.EventsApplied[arkeotypes.EventTypeOpenContract])
+	assert.Equal(t, int64(1), summary.EventsApplied[arkeotypes.EventTypeSettleContract])
+	mockDb.AssertNotCalled(t, "DeleteProviderHistory", mock.Anything, providerB.String(), mock.Anything)
+	mockDb.AssertNotCalled(t, "FindProvider", mock.Anything, providerB.String(), mock.Anything)
+	mockDb.AssertNotCalled(t, "CloseContract", mock.Anything, mock.Anything, mock.Anything)
+	mockDb.AssertExpectations(t)
+}
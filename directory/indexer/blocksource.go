@@ -0,0 +1,41 @@
+package indexer
+
+import (
+	"context"
+	"strings"
+
+	tmclient "github.com/cometbft/cometbft/rpc/client/http"
+	ctypes "github.com/cometbft/cometbft/rpc/core/types"
+)
+
+// BlockSource is the part of the tendermint rpc the indexer reads blocks from
+type BlockSource interface {
+	Block(ctx context.Context, height *int64) (*ctypes.ResultBlock, error)
+	BlockResults(ctx context.Context, height *int64) (*ctypes.ResultBlockResults, error)
+	Tx(ctx context.Context, hash []byte, prove bool) (*ctypes.ResultTx, error)
+	Status(ctx context.Context) (*ctypes.ResultStatus, error)
+	ABCIInfo(ctx context.Context) (*ctypes.ResultABCIInfo, error)
+	ConsensusParams(ctx context.Context, height *int64) (*ctypes.ResultConsensusParams, error)
+}
+
+var _ BlockSource = &tmclient.HTTP{}
+
+// errors returned by the node when asked for a height it hasn't committed yet
+var heightUnavailableErrors = []string{
+	"must be less than or equal to the current blockchain height",
+	"could not find results for height",
+}
+
+// isHeightUnavailable return true when err indicate the node can't serve the height yet, rather than a real failure.
+// This is what the node returns while the chain is halted for an upgrade, or while it is catching up
+func isHeightUnavailable(err error) bool {
+	if err == nil {
+		return false
+	}
+	for _, msg := range heightUnavailableErrors {
+		if strings.Contains(err.Error(), msg) {
+			return true
+		}
+	}
+	return false
+}
@@ -37,6 +37,16 @@ func (s *Service) handleValidatorPayoutEvent(ctx context.Context, evt atypes.Eve
 	return nil
 }
 
+func (s *Service) handleParamsUpdatedEvent(ctx context.Context, evt atypes.EventParamsUpdated) error {
+	for _, change := range evt.Changes {
+		s.logger.Infof("upserting param change %s at height %d", change.Key, evt.Height)
+		if _, err := s.db.UpsertParamChange(ctx, evt.Height, change); err != nil {
+			return errors.Wrapf(err, "error upserting param change %s", change.Key)
+		}
+	}
+	return nil
+}
+
 // consumeEvents make connection to tendermint using websocket and then consume NewBlock event
 func (s *Service) consumeEvents() error {
 	s.logger.WithField("websocket", s.params.TendermintWs).Info("starting realtime indexing using /websocket")
@@ -79,6 +89,35 @@ func (s *Service) consumeEvents() error {
 
 // consumeHistoricalBlock index one block at a time
 func (s *Service) consumeHistoricalBlock(blockHeight int64) (result *db.Block, err error) {
+	block, blockResults, err := s.fetchBlock(blockHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	log := s.logger.WithField("height", block.Block.Height)
+	for _, transaction := range block.Block.Txs {
+		if err := s.handleTransaction(block.Block.Height, transaction); err != nil {
+			log.WithError(err).Error("fail to handler transaction")
+		}
+	}
+
+	for _, event := range blockResults.FinalizeBlockEvents {
+		log.Debugf("received %s endblock event", event.Type)
+		if err := s.handleAbciEvent(event, nil, block.Block.Height); err != nil {
+			log.WithError(err).Errorf("error handling abci event %#v", event)
+		}
+	}
+
+	r := &db.Block{
+		Height:    block.Block.Height,
+		Hash:      block.Block.Hash().String(),
+		BlockTime: block.Block.Time,
+	}
+	return r, nil
+}
+
+// fetchBlock retrieve the block and its results at the given height
+func (s *Service) fetchBlock(blockHeight int64) (*ctypes.ResultBlock, *ctypes.ResultBlockResults, error) {
 	wg := sync.WaitGroup{}
 	wg.Add(2)
 
@@ -111,42 +150,20 @@ func (s *Service) consumeHistoricalBlock(blockHeight int64) (result *db.Block, e
 	wg.Wait()
 
 	if blockErr != nil {
-		return nil, fmt.Errorf("fail to read block,err: %w", blockErr)
+		return nil, nil, fmt.Errorf("fail to read block,err: %w", blockErr)
 	}
 	if resultsErr != nil {
-		return nil, fmt.Errorf("fail to read blockresult,err: %w", resultsErr)
+		return nil, nil, fmt.Errorf("fail to read blockresult,err: %w", resultsErr)
 	}
-
-	log := s.logger.WithField("height", block.Block.Height)
-	for _, transaction := range block.Block.Txs {
-		if err := s.handleTransaction(block.Block.Height, transaction); err != nil {
-			log.WithError(err).Error("fail to handler transaction")
-		}
-	}
-
-	for _, event := range blockResults.FinalizeBlockEvents {
-		log.Debugf("received %s endblock event", event.Type)
-		if err := s.handleAbciEvent(event, nil, block.Block.Height); err != nil {
-			log.WithError(err).Errorf("error handling abci event %#v", event)
-		}
-	}
-
-	r := &db.Block{
-		Height:    block.Block.Height,
-		Hash:      block.Block.Hash().String(),
-		BlockTime: block.Block.Time,
-	}
-	return r, nil
+	return block, blockResults, nil
 }
 
 func (s *Service) handleTransaction(height int64, transaction tmtypes.Tx) error {
-	ctx, cancel := context.WithTimeout(context.Background(), defaultRetrieveTransactionTimeout)
-	defer cancel()
-	txInfo, err := s.tmClient.Tx(ctx, transaction.Hash(), false)
+	events, err := s.transactionEvents(transaction)
 	if err != nil {
-		return fmt.Errorf("failed to get transaction data for %s,err:%w", string(transaction.Hash()), err)
+		return err
 	}
-	for _, event := range txInfo.TxResult.Events {
+	for _, event := range events {
 		s.logger.WithField("height", height).Debugf("received %s txevent", event.Type)
 		if err := s.handleAbciEvent(event, transaction, height); err != nil {
 			// move on
@@ -156,6 +173,17 @@ func (s *Service) handleTransaction(height int64, transaction tmtypes.Tx) error
 	return nil
 }
 
+// transactionEvents retrieve all the events emitted by the given transaction
+func (s *Service) transactionEvents(transaction tmtypes.Tx) ([]abcitypes.Event, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), defaultRetrieveTransactionTimeout)
+	defer cancel()
+	txInfo, err := s.tmClient.Tx(ctx, transaction.Hash(), false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transaction data for %s,err:%w", string(transaction.Hash()), err)
+	}
+	return txInfo.TxResult.Events, nil
+}
+
 func (s *Service) handleAbciEvent(event abcitypes.Event, transaction tmtypes.Tx, height int64) error {
 	s.logger.WithField("height", height).
 		WithField("event", Stringfy(event)).
@@ -216,6 +244,68 @@ func (s *Service) handleAbciEvent(event abcitypes.Event, transaction tmtypes.Tx,
 		if err := s.handleCloseContractEvent(ctx, eventCloseContract, height); err != nil {
 			return err
 		}
+	case atypes.EventTypeRenewContract:
+		eventRenewContract, err := parseEventToConcreteType[atypes.EventRenewContract](event)
+		if err != nil {
+			return err
+		}
+		if err := s.handleRenewContractEvent(ctx, eventRenewContract); err != nil {
+			return err
+		}
+	case atypes.EventTypeRotateDelegate:
+		eventRotateDelegate, err := parseEventToConcreteType[atypes.EventRotateDelegate](event)
+		if err != nil {
+			return err
+		}
+		if err := s.handleRotateDelegateEvent(ctx, eventRotateDelegate); err != nil {
+			return err
+		}
+	case atypes.EventTypeTopUpContract:
+		eventTopUpContract, err := parseEventToConcreteType[atypes.EventTopUpContract](event)
+		if err != nil {
+			return err
+		}
+		if err := s.handleTopUpContractEvent(ctx, eventTopUpContract); err != nil {
+			return err
+		}
+	case atypes.EventTypeContractAutoRenewed:
+		eventContractAutoRenewed, err := parseEventToConcreteType[atypes.EventContractAutoRenewed](event)
+		if err != nil {
+			return err
+		}
+		if err := s.handleContractAutoRenewedEvent(ctx, eventContractAutoRenewed); err != nil {
+			return err
+		}
+	case atypes.EventTypeContractExpired:
+		eventContractExpired, err := parseEventToConcreteType[atypes.EventContractExpired](event)
+		if err != nil {
+			return err
+		}
+		s.handleContractExpiredEvent(ctx, eventContractExpired)
+	case atypes.EventTypeSlashProvider:
+		eventSlashProvider, err := parseEventToConcreteType[atypes.EventSlashProvider](event)
+		if err != nil {
+			return err
+		}
+		if err := s.handleSlashProviderEvent(ctx, eventSlashProvider); err != nil {
+			return err
+		}
+	case atypes.EventTypeProviderDemoted:
+		eventProviderDemoted, err := parseEventToConcreteType[atypes.EventProviderDemoted](event)
+		if err != nil {
+			return err
+		}
+		if err := s.handleProviderDemotedEvent(ctx, eventProviderDemoted); err != nil {
+			return err
+		}
+	case atypes.EventTypeParamsUpdated:
+		eventParamsUpdated, err := parseEventToConcreteType[atypes.EventParamsUpdated](event)
+		if err != nil {
+			return err
+		}
+		if err := s.handleParamsUpdatedEvent(ctx, eventParamsUpdated); err != nil {
+			return err
+		}
 	case "coin_spent", "coin_received", "transfer", "message", "tx", "coinbase", "mint", "commission", "rewards":
 		// do nothing
 	default:
@@ -255,6 +345,11 @@ func convertEventToMap(event abcitypes.Event) (map[string]any, error) {
 			}
 			result[string(attr.Key)] = nest
 		default:
+			// bools are the only values not quoted, a string reading the same is
+			if raw := string(attr.Value); raw == "true" || raw == "false" {
+				result[string(attr.Key)] = raw == "true"
+				continue
+			}
 			result[string(attr.Key)] = attrValue
 		}
 	}
@@ -59,6 +59,47 @@ func TestEventParsing(t *testing.T) {
 				assert.Equal(t, int64(1495), e.Height)
 				assert.Equal(t, int64(0), e.Paid.Int64())
 				assert.Equal(t, int64(0), e.Reserve.Int64())
+				assert.True(t, e.Unpaid.IsNil())
+			},
+		},
+		{
+			Name:    "EventSettleContractUnpaid",
+			Payload: `{ "type": "arkeo.arkeo.EventSettleContract", "attributes": [ { "key": "client", "value": "\"tarkeopub1addwnpepqgpjgp5v8tj6gdh6gczqwww5ksh4g8ync8xpjjssawsn7cxqwmhmjy4d8d8\"", "index": true }, { "key": "contract_id", "value": "\"3\"", "index": true }, { "key": "delegate", "value": "\"\"", "index": true }, { "key": "height", "value": "\"1495\"", "index": true }, { "key": "nonce", "value": "\"80\"", "index": true }, { "key": "paid", "value": "\"900\"", "index": true }, { "key": "provider", "value": "\"tarkeopub1addwnpepqf0vmghuakef4zxnh6hv2gewmqgm5tdg9f6w3qxjpw49xnsjf36f7f40eve\"", "index": true }, { "key": "reserve", "value": "\"90\"", "index": true }, { "key": "service", "value": "\"mock\"", "index": true }, { "key": "type", "value": "\"PAY_AS_YOU_GO\"", "index": true }, { "key": "unpaid", "value": "\"300\"", "index": true } ] }`,
+			Checker: func(t *testing.T, result any) {
+				assert.IsType(t, arkeotypes.EventSettleContract{}, result)
+				e, ok := result.(arkeotypes.EventSettleContract)
+				assert.True(t, ok)
+				assert.Equal(t, uint64(3), e.ContractId)
+				assert.Equal(t, int64(80), e.Nonce)
+				assert.Equal(t, int64(900), e.Paid.Int64())
+				assert.Equal(t, int64(90), e.Reserve.Int64())
+				assert.Equal(t, int64(300), e.Unpaid.Int64())
+			},
+		},
+		{
+			Name:    "EventOpenContractRateAttributes",
+			Payload: `{ "type": "arkeo.arkeo.EventOpenContract", "attributes": [ { "key": "client", "value": "\"tarkeopub1addwnpepqgpjgp5v8tj6gdh6gczqwww5ksh4g8ync8xpjjssawsn7cxqwmhmjy4d8d8\"", "index": true }, { "key": "contract_id", "value": "\"4\"", "index": true }, { "key": "provider", "value": "\"tarkeopub1addwnpepqf0vmghuakef4zxnh6hv2gewmqgm5tdg9f6w3qxjpw49xnsjf36f7f40eve\"", "index": true }, { "key": "rate", "value": "{\"denom\":\"ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2\",\"amount\":\"15\"}", "index": true }, { "key": "rate_amount", "value": "\"15\"", "index": true }, { "key": "rate_denom", "value": "\"ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2\"", "index": true }, { "key": "service", "value": "\"mock\"", "index": true }, { "key": "type", "value": "\"PAY_AS_YOU_GO\"", "index": true } ] }`,
+			Checker: func(t *testing.T, result any) {
+				e, ok := result.(arkeotypes.EventOpenContract)
+				assert.True(t, ok)
+				assert.Equal(t, "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2", e.RateDenom)
+				assert.Equal(t, int64(15), e.RateAmount.Int64())
+				assert.Equal(t, cosmos.NewInt64Coin("ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2", 15), openContractRate(e))
+				// the rate of the events emitted before the rate attributes
+				e.RateDenom, e.RateAmount = "", cosmos.Int{}
+				e.Rate = cosmos.NewInt64Coin("uarkeo", 10)
+				assert.Equal(t, cosmos.NewInt64Coin("uarkeo", 10), openContractRate(e))
+			},
+		},
+		{
+			Name:    "EventSettleContractDenom",
+			Payload: `{ "type": "arkeo.arkeo.EventSettleContract", "attributes": [ { "key": "contract_id", "value": "\"4\"", "index": true }, { "key": "denom", "value": "\"ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2\"", "index": true }, { "key": "nonce", "value": "\"10\"", "index": true }, { "key": "paid", "value": "\"150\"", "index": true }, { "key": "provider", "value": "\"tarkeopub1addwnpepqf0vmghuakef4zxnh6hv2gewmqgm5tdg9f6w3qxjpw49xnsjf36f7f40eve\"", "index": true }, { "key": "reserve", "value": "\"15\"", "index": true }, { "key": "service", "value": "\"mock\"", "index": true } ] }`,
+			Checker: func(t *testing.T, result any) {
+				e, ok := result.(arkeotypes.EventSettleContract)
+				assert.True(t, ok)
+				assert.Equal(t, "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2", e.Denom)
+				assert.Equal(t, int64(150), e.Paid.Int64())
+				assert.Equal(t, int64(15), e.Reserve.Int64())
 			},
 		},
 		{
@@ -100,6 +141,46 @@ func TestEventParsing(t *testing.T) {
 				assert.Equal(t, int64(1000000000), e.BondAbs.Int64())
 			},
 		},
+		{
+			Name:    "EventBondProviderDenom",
+			Payload: `{"type": "arkeo.arkeo.EventBondProvider", "attributes": [ { "key": "bond_abs", "value": "\"300\"", "index": true }, { "key": "bond_denom", "value": "\"uarkeo\"", "index": true }, { "key": "bond_rel", "value": "\"100\"", "index": true }, { "key": "provider", "value": "\"tarkeopub1addwnpepqgpjgp5v8tj6gdh6gczqwww5ksh4g8ync8xpjjssawsn7cxqwmhmjy4d8d8\"", "index": true }, { "key": "service", "value": "\"mock\"", "index": true } ] }`,
+			Checker: func(t *testing.T, result any) {
+				e, ok := result.(arkeotypes.EventBondProvider)
+				assert.True(t, ok)
+				assert.Equal(t, "uarkeo", e.BondDenom)
+				assert.Equal(t, int64(100), e.BondRel.Int64())
+				assert.Equal(t, int64(300), e.BondAbs.Int64())
+			},
+		},
+		{
+			Name:    "EventParamsUpdated",
+			Payload: `{"type": "arkeo.arkeo.EventParamsUpdated", "attributes": [ { "key": "changes", "value": "[{\"key\":\"SlashFraction\",\"old_value\":\"500\",\"new_value\":\"1000\"},{\"key\":\"AllowedDenoms\",\"old_value\":\"[\\\"uarkeo\\\"]\",\"new_value\":\"[\\\"uarkeo\\\",\\\"uatom\\\"]\"}]", "index": true }, { "key": "height", "value": "\"12\"", "index": true } ] }`,
+			Checker: func(t *testing.T, result any) {
+				assert.IsType(t, arkeotypes.EventParamsUpdated{}, result)
+				e, ok := result.(arkeotypes.EventParamsUpdated)
+				assert.True(t, ok)
+				assert.Equal(t, int64(12), e.Height)
+				assert.Equal(t, []arkeotypes.ParamChange{
+					{Key: "SlashFraction", OldValue: "500", NewValue: "1000"},
+					{Key: "AllowedDenoms", OldValue: `["uarkeo"]`, NewValue: `["uarkeo","uatom"]`},
+				}, e.Changes)
+			},
+		},
+		{
+			Name:    "EventContractExpired",
+			Payload: `{ "type": "arkeo.arkeo.EventContractExpired", "attributes": [ { "key": "client", "value": "\"tarkeopub1addwnpepqgpjgp5v8tj6gdh6gczqwww5ksh4g8ync8xpjjssawsn7cxqwmhmjy4d8d8\"", "index": true }, { "key": "contract_id", "value": "\"2\"", "index": true }, { "key": "expiration", "value": "\"1555\"", "index": true }, { "key": "provider", "value": "\"tarkeopub1addwnpepqf0vmghuakef4zxnh6hv2gewmqgm5tdg9f6w3qxjpw49xnsjf36f7f40eve\"", "index": true }, { "key": "service", "value": "\"mock\"", "index": true }, { "key": "settlement_pending", "value": "true", "index": true }, { "key": "type", "value": "\"PAY_AS_YOU_GO\"", "index": true } ] }`,
+			Checker: func(t *testing.T, result any) {
+				assert.IsType(t, arkeotypes.EventContractExpired{}, result)
+				e, ok := result.(arkeotypes.EventContractExpired)
+				assert.True(t, ok)
+				assert.Equal(t, uint64(2), e.ContractId)
+				assert.Equal(t, "mock", e.Service)
+				assert.Equal(t, "tarkeopub1addwnpepqgpjgp5v8tj6gdh6gczqwww5ksh4g8ync8xpjjssawsn7cxqwmhmjy4d8d8", e.Client.String())
+				assert.Equal(t, arkeotypes.ContractType_PAY_AS_YOU_GO, e.Type)
+				assert.Equal(t, int64(1555), e.Expiration)
+				assert.True(t, e.SettlementPending)
+			},
+		},
 	}
 	for _, c := range inputs {
 		var event abcitypes.Event
@@ -117,6 +198,10 @@ func TestEventParsing(t *testing.T) {
 			result, err = parseEventToConcreteType[arkeotypes.EventModProvider](event)
 		case arkeotypes.EventTypeBondProvider:
 			result, err = parseEventToConcreteType[arkeotypes.EventBondProvider](event)
+		case arkeotypes.EventTypeParamsUpdated:
+			result, err = parseEventToConcreteType[arkeotypes.EventParamsUpdated](event)
+		case arkeotypes.EventTypeContractExpired:
+			result, err = parseEventToConcreteType[arkeotypes.EventContractExpired](event)
 		}
 		assert.Nil(t, err)
 		c.Checker(t, result)
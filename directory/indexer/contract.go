@@ -5,6 +5,8 @@ import (
 
 	"github.com/pkg/errors"
 
+	"github.com/arkeonetwork/arkeo/common/cosmos"
+	"github.com/arkeonetwork/arkeo/directory/webhook"
 	atypes "github.com/arkeonetwork/arkeo/x/arkeo/types"
 )
 
@@ -14,24 +16,77 @@ func (s *Service) handleOpenContractEvent(ctx context.Context, evt atypes.EventO
 		return errors.Wrapf(err, "error finding provider %s for service %s", evt.Provider.String(), evt.Service)
 	}
 
+	evt.Rate = openContractRate(evt)
 	_, err = s.db.UpsertContract(ctx, provider.ID, evt)
 	if err != nil {
 		return errors.Wrapf(err, "error upserting contract")
 	}
-
+	s.notifyWebhooks(ctx, webhook.EventContractOpened, evt.Service, []string{evt.Provider.String(), evt.Client.String()}, evt)
 	return nil
 }
 
+// openContractRate return the rate of the contract opened, out of the rate_denom and rate_amount attributes, the
+// rate attribute of the events emitted before them otherwise
+func openContractRate(evt atypes.EventOpenContract) cosmos.Coin {
+	if evt.RateDenom == "" || evt.RateAmount.IsNil() {
+		return evt.Rate
+	}
+	return cosmos.NewCoin(evt.RateDenom, evt.RateAmount)
+}
+
 func (s *Service) handleCloseContractEvent(ctx context.Context, evt atypes.EventCloseContract, height int64) error {
 	if _, err := s.db.CloseContract(ctx, evt.ContractId, height); err != nil {
 		return errors.Wrapf(err, "error closing contract %d", evt.ContractId)
 	}
+	s.notifyWebhooks(ctx, webhook.EventContractClosed, evt.Service, []string{evt.Provider.String(), evt.Client.String()}, evt)
+	return nil
+}
+
+func (s *Service) handleRenewContractEvent(ctx context.Context, evt atypes.EventRenewContract) error {
+	if _, err := s.db.RenewContract(ctx, evt); err != nil {
+		return errors.Wrapf(err, "error renewing contract %d", evt.ContractId)
+	}
+	s.notifyWebhooks(ctx, webhook.EventContractRenewed, evt.Service, []string{evt.Provider.String(), evt.Client.String()}, evt)
 	return nil
 }
 
+// handleTopUpContractEvent extend the contract topped up, the top-up of an auto-renewed contract waits for its expiration
+func (s *Service) handleTopUpContractEvent(ctx context.Context, evt atypes.EventTopUpContract) error {
+	if evt.NewExpiration == evt.OldExpiration {
+		return nil
+	}
+	if _, err := s.db.ExtendContract(ctx, evt.ContractId, evt.NewExpiration, evt.Deposit); err != nil {
+		return errors.Wrapf(err, "error extending contract %d", evt.ContractId)
+	}
+	s.notifyWebhooks(ctx, webhook.EventContractRenewed, evt.Service, []string{evt.Provider.String(), evt.Client.String()}, evt)
+	return nil
+}
+
+func (s *Service) handleContractAutoRenewedEvent(ctx context.Context, evt atypes.EventContractAutoRenewed) error {
+	if _, err := s.db.ExtendContract(ctx, evt.ContractId, evt.NewExpiration, evt.Deposit); err != nil {
+		return errors.Wrapf(err, "error extending contract %d", evt.ContractId)
+	}
+	s.notifyWebhooks(ctx, webhook.EventContractAutoRenewed, evt.Service, []string{evt.Provider.String(), evt.Client.String()}, evt)
+	return nil
+}
+
+func (s *Service) handleRotateDelegateEvent(ctx context.Context, evt atypes.EventRotateDelegate) error {
+	if _, err := s.db.RotateDelegate(ctx, evt); err != nil {
+		return errors.Wrapf(err, "error rotating delegate of contract %d", evt.ContractId)
+	}
+	s.notifyWebhooks(ctx, webhook.EventContractDelegateRotated, evt.Service, []string{evt.Provider.String(), evt.Client.String()}, evt)
+	return nil
+}
+
+// handleContractExpiredEvent only notify the expiration, the contract rows change when it settles
+func (s *Service) handleContractExpiredEvent(ctx context.Context, evt atypes.EventContractExpired) {
+	s.notifyWebhooks(ctx, webhook.EventContractExpired, evt.Service, []string{evt.Provider.String(), evt.Client.String()}, evt)
+}
+
 func (s *Service) handleContractSettlementEvent(ctx context.Context, evt atypes.EventSettleContract) error {
 	if _, err := s.db.UpsertContractSettlementEvent(ctx, evt); err != nil {
 		return errors.Wrapf(err, "error upserting contract settlement event")
 	}
+	s.notifyWebhooks(ctx, webhook.EventContractSettled, evt.Service, []string{evt.Provider.String(), evt.Client.String()}, evt)
 	return nil
 }
@@ -14,6 +14,7 @@ import (
 
 	"github.com/arkeonetwork/arkeo/common/logging"
 	"github.com/arkeonetwork/arkeo/directory/db"
+	"github.com/arkeonetwork/arkeo/directory/webhook"
 	arkeotypes "github.com/arkeonetwork/arkeo/x/arkeo/types"
 )
 
@@ -140,3 +141,35 @@ func TestHandleContractSettlementEvent(t *testing.T) {
 	err = s.handleContractSettlementEvent(context.Background(), eventSettleContract)
 	assert.Nil(t, err)
 }
+
+func TestHandleContractSettlementEventWebhook(t *testing.T) {
+	mockDb := new(db.MockDataStorage)
+	s := Service{
+		params:         ServiceParams{Webhook: webhook.Config{Enabled: true}},
+		db:             mockDb,
+		done:           make(chan struct{}),
+		wg:             &sync.WaitGroup{},
+		logger:         logging.WithoutFields(),
+		tmClient:       nil,
+		blockFillQueue: make(chan db.BlockGap),
+	}
+	eventSettleContract := arkeotypes.EventSettleContract{
+		Provider:   arkeotypes.GetRandomPubKey(),
+		ContractId: 1,
+		Service:    "mock",
+		Client:     arkeotypes.GetRandomPubKey(),
+		Type:       arkeotypes.ContractType_PAY_AS_YOU_GO,
+		Nonce:      10,
+		Height:     1024,
+		Paid:       math.NewInt(1024),
+		Reserve:    math.NewInt(100),
+	}
+	mockDb.On("UpsertContractSettlementEvent", mock.Anything, mock.Anything).Return(&db.Entity{ID: 1}, nil)
+	pubkeys := []string{eventSettleContract.Provider.String(), eventSettleContract.Client.String()}
+	mockDb.On("EnqueueWebhookDeliveries", mock.Anything, webhook.EventContractSettled, "mock", pubkeys, mock.Anything).
+		Return(int64(0), fmt.Errorf("fail to enqueue"))
+	// failing to queue webhooks must not fail indexing
+	err := s.handleContractSettlementEvent(context.Background(), eventSettleContract)
+	assert.Nil(t, err)
+	mockDb.AssertExpectations(t)
+}
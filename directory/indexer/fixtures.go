@@ -0,0 +1,174 @@
+package indexer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+	ctypes "github.com/cometbft/cometbft/rpc/core/types"
+	tmtypes "github.com/cometbft/cometbft/types"
+)
+
+// fixtures are laid out as one json file per rpc response, serialized the same way the node does
+const (
+	fixtureBlocksDir       = "blocks"
+	fixtureBlockResultsDir = "block_results"
+	fixtureTxsDir          = "txs"
+)
+
+// ErrFixtureNotFound is returned when a fixture doesn't contain the requested height or transaction
+var ErrFixtureNotFound = errors.New("fixture not found")
+
+// CaptureFixtures record the blocks, block results and transactions in [start,end] from the given source into dir,
+// so they can later be replayed with FixtureSource. It returns the number of transactions captured
+func CaptureFixtures(ctx context.Context, source BlockSource, dir string, start, end int64) (int, error) {
+	if start <= 0 || end < start {
+		return 0, fmt.Errorf("invalid height range %d-%d", start, end)
+	}
+	txCount := 0
+	for height := start; height <= end; height++ {
+		h := height
+		block, err := source.Block(ctx, &h)
+		if err != nil {
+			return txCount, fmt.Errorf("fail to read block %d,err: %w", height, err)
+		}
+		blockResults, err := source.BlockResults(ctx, &h)
+		if err != nil {
+			return txCount, fmt.Errorf("fail to read block results %d,err: %w", height, err)
+		}
+		name := strconv.FormatInt(height, 10) + ".json"
+		if err := writeFixture(filepath.Join(dir, fixtureBlocksDir, name), block); err != nil {
+			return txCount, err
+		}
+		if err := writeFixture(filepath.Join(dir, fixtureBlockResultsDir, name), blockResults); err != nil {
+			return txCount, err
+		}
+		for _, transaction := range block.Block.Txs {
+			txInfo, err := source.Tx(ctx, transaction.Hash(), false)
+			if err != nil {
+				return txCount, fmt.Errorf("fail to read tx %X,err: %w", transaction.Hash(), err)
+			}
+			if err := writeFixture(filepath.Join(dir, fixtureTxsDir, txFixtureName(transaction.Hash())), txInfo); err != nil {
+				return txCount, err
+			}
+			txCount++
+		}
+	}
+	return txCount, nil
+}
+
+func writeFixture(path string, v any) error {
+	buf, err := cmtjson.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return fmt.Errorf("fail to marshal fixture %s,err: %w", path, err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("fail to create fixture dir,err: %w", err)
+	}
+	return os.WriteFile(path, append(buf, '\n'), 0o600)
+}
+
+func txFixtureName(hash []byte) string {
+	return fmt.Sprintf("%X.json", hash)
+}
+
+// FixtureSource is a BlockSource serving the fixtures recorded by CaptureFixtures
+type FixtureSource struct {
+	dir   string
+	first int64
+	last  int64
+}
+
+var _ BlockSource = &FixtureSource{}
+
+// NewFixtureSource open the fixtures recorded in dir
+func NewFixtureSource(dir string) (*FixtureSource, error) {
+	entries, err := os.ReadDir(filepath.Join(dir, fixtureBlocksDir))
+	if err != nil {
+		return nil, fmt.Errorf("fail to read fixture blocks,err: %w", err)
+	}
+	var heights []int64
+	for _, entry := range entries {
+		height, err := strconv.ParseInt(strings.TrimSuffix(entry.Name(), ".json"), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("unexpected fixture file %s", entry.Name())
+		}
+		heights = append(heights, height)
+	}
+	if len(heights) == 0 {
+		return nil, fmt.Errorf("no block in %s", dir)
+	}
+	sort.Slice(heights, func(i, j int) bool { return heights[i] < heights[j] })
+	return &FixtureSource{dir: dir, first: heights[0], last: heights[len(heights)-1]}, nil
+}
+
+// Range return the first and last recorded heights
+func (f *FixtureSource) Range() (int64, int64) {
+	return f.first, f.last
+}
+
+func (f *FixtureSource) readFixture(path string, v any) error {
+	buf, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("%w: %s", ErrFixtureNotFound, path)
+		}
+		return err
+	}
+	return cmtjson.Unmarshal(buf, v)
+}
+
+func (f *FixtureSource) height(height *int64) int64 {
+	if height == nil {
+		return f.last
+	}
+	return *height
+}
+
+func (f *FixtureSource) Block(_ context.Context, height *int64) (*ctypes.ResultBlock, error) {
+	result := &ctypes.ResultBlock{}
+	name := strconv.FormatInt(f.height(height), 10) + ".json"
+	if err := f.readFixture(filepath.Join(f.dir, fixtureBlocksDir, name), result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (f *FixtureSource) BlockResults(_ context.Context, height *int64) (*ctypes.ResultBlockResults, error) {
+	result := &ctypes.ResultBlockResults{}
+	name := strconv.FormatInt(f.height(height), 10) + ".json"
+	if err := f.readFixture(filepath.Join(f.dir, fixtureBlockResultsDir, name), result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (f *FixtureSource) Tx(_ context.Context, hash []byte, _ bool) (*ctypes.ResultTx, error) {
+	result := &ctypes.ResultTx{}
+	if err := f.readFixture(filepath.Join(f.dir, fixtureTxsDir, txFixtureName(hash)), result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (f *FixtureSource) Status(_ context.Context) (*ctypes.ResultStatus, error) {
+	return &ctypes.ResultStatus{SyncInfo: ctypes.SyncInfo{
+		EarliestBlockHeight: f.first,
+		LatestBlockHeight:   f.last,
+	}}, nil
+}
+
+func (f *FixtureSource) ABCIInfo(_ context.Context) (*ctypes.ResultABCIInfo, error) {
+	return &ctypes.ResultABCIInfo{Response: abcitypes.ResponseInfo{LastBlockHeight: f.last}}, nil
+}
+
+func (f *FixtureSource) ConsensusParams(_ context.Context, _ *int64) (*ctypes.ResultConsensusParams, error) {
+	return &ctypes.ResultConsensusParams{BlockHeight: f.last, ConsensusParams: *tmtypes.DefaultConsensusParams()}, nil
+}
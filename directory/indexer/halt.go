@@ -0,0 +1,144 @@
+package indexer
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	defaultHaltPollInterval = time.Second * 10
+	defaultStatusTimeout    = time.Second * 5
+)
+
+var (
+	chainHaltedGauge = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: "arkeo",
+		Subsystem: "directory_indexer",
+		Name:      "chain_halted",
+		Help:      "1 while the indexer is waiting for a halted or catching up node to serve the next height",
+	})
+	haltHeightGauge = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: "arkeo",
+		Subsystem: "directory_indexer",
+		Name:      "halt_height",
+		Help:      "height the indexer is waiting for while the chain is halted",
+	})
+)
+
+func init() {
+	prometheus.MustRegister(chainHaltedGauge, haltHeightGauge)
+}
+
+// chainVersion is what the node reports about the running application, recorded to detect upgrades
+type chainVersion struct {
+	AppVersion    uint64
+	Version       string
+	BlockMaxBytes int64
+	BlockMaxGas   int64
+}
+
+// haltState track the wait of the block gap processor, it is only accessed from that go routine
+type haltState struct {
+	pollInterval time.Duration
+	since        time.Time
+	before       *chainVersion
+}
+
+// waitForHeight is called when the node can't serve a height, which is what happens when the chain halts for a
+// coordinated upgrade or the node is catching up. Rather than logging an error per block and skipping the height,
+// the indexer waits quietly until the node is caught up and the height is available. It returns false when the
+// service is shutting down
+func (s *Service) waitForHeight(height int64) bool {
+	interval := s.halt.pollInterval
+	if interval <= 0 {
+		interval = defaultHaltPollInterval
+	}
+	s.halt.since = time.Now()
+	s.halt.before = s.readChainVersion()
+	chainHaltedGauge.Set(1)
+	haltHeightGauge.Set(float64(height))
+	s.logger.WithField("height", height).Warn("node can't serve the next height, chain halted or catching up, waiting for it to resume")
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.done:
+			return false
+		case <-ticker.C:
+		}
+		if s.heightAvailable(height) {
+			break
+		}
+	}
+
+	chainHaltedGauge.Set(0)
+	haltHeightGauge.Set(0)
+	s.logResume(height)
+	return true
+}
+
+// heightAvailable ask the node whether it is caught up and has committed the given height
+func (s *Service) heightAvailable(height int64) bool {
+	ctx, cancel := context.WithTimeout(context.Background(), defaultStatusTimeout)
+	defer cancel()
+	status, err := s.tmClient.Status(ctx)
+	if err != nil {
+		// expected while the node restart for the upgrade
+		s.logger.WithError(err).Debug("fail to read node status")
+		return false
+	}
+	if status.SyncInfo.CatchingUp {
+		return false
+	}
+	return status.SyncInfo.LatestBlockHeight >= height
+}
+
+// readChainVersion return the application version and consensus params reported by the node, nil when the node
+// can't be reached
+func (s *Service) readChainVersion() *chainVersion {
+	ctx, cancel := context.WithTimeout(context.Background(), defaultStatusTimeout)
+	defer cancel()
+	info, err := s.tmClient.ABCIInfo(ctx)
+	if err != nil {
+		s.logger.WithError(err).Debug("fail to read abci info")
+		return nil
+	}
+	params, err := s.tmClient.ConsensusParams(ctx, nil)
+	if err != nil {
+		s.logger.WithError(err).Debug("fail to read consensus params")
+		return nil
+	}
+	return &chainVersion{
+		AppVersion:    info.Response.AppVersion,
+		Version:       info.Response.Version,
+		BlockMaxBytes: params.ConsensusParams.Block.MaxBytes,
+		BlockMaxGas:   params.ConsensusParams.Block.MaxGas,
+	}
+}
+
+// logResume verify the application version and consensus params once the chain resumed, so an upgrade that changed
+// them is visible in the logs
+func (s *Service) logResume(height int64) {
+	log := s.logger.WithField("height", height).
+		WithField("waited", time.Since(s.halt.since).Round(time.Second).String())
+	after := s.readChainVersion()
+	before := s.halt.before
+	s.halt.before = nil
+	switch {
+	case after == nil:
+		log.Warn("chain resumed, unable to verify application version")
+	case before == nil:
+		log.WithField("app_version", after.AppVersion).
+			WithField("version", after.Version).
+			Warn("chain resumed")
+	case *before != *after:
+		log.WithField("before", Stringfy(before)).
+			WithField("after", Stringfy(after)).
+			Warn("================ chain resumed with a new application version / consensus params ================")
+	default:
+		log.Info("chain resumed, application version unchanged")
+	}
+}
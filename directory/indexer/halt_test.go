@@ -0,0 +1,169 @@
+package indexer
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	ctypes "github.com/cometbft/cometbft/rpc/core/types"
+	tmtypes "github.com/cometbft/cometbft/types"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/arkeonetwork/arkeo/common/logging"
+	"github.com/arkeonetwork/arkeo/directory/db"
+)
+
+// upgradeNode simulate a node going through an upgrade window: it stops producing blocks at the halt height,
+// goes down, comes back catching up and eventually produces the next height with a new application version
+type upgradeNode struct {
+	mu         sync.Mutex
+	latest     int64
+	catchingUp bool
+	down       bool
+	appVersion uint64
+}
+
+var _ BlockSource = &upgradeNode{}
+
+func (n *upgradeNode) set(latest int64, catchingUp, down bool, appVersion uint64) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.latest = latest
+	n.catchingUp = catchingUp
+	n.down = down
+	n.appVersion = appVersion
+}
+
+func (n *upgradeNode) Block(_ context.Context, height *int64) (*ctypes.ResultBlock, error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if *height > n.latest {
+		return nil, fmt.Errorf("height %d must be less than or equal to the current blockchain height %d", *height, n.latest)
+	}
+	return &ctypes.ResultBlock{Block: &tmtypes.Block{Header: tmtypes.Header{Height: *height, Time: time.Now()}}}, nil
+}
+
+func (n *upgradeNode) BlockResults(_ context.Context, height *int64) (*ctypes.ResultBlockResults, error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if *height > n.latest {
+		return nil, fmt.Errorf("could not find results for height #%d", *height)
+	}
+	return &ctypes.ResultBlockResults{Height: *height}, nil
+}
+
+func (n *upgradeNode) Tx(_ context.Context, _ []byte, _ bool) (*ctypes.ResultTx, error) {
+	return nil, fmt.Errorf("tx not found")
+}
+
+func (n *upgradeNode) Status(_ context.Context) (*ctypes.ResultStatus, error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if n.down {
+		return nil, fmt.Errorf("connection refused")
+	}
+	return &ctypes.ResultStatus{SyncInfo: ctypes.SyncInfo{LatestBlockHeight: n.latest, CatchingUp: n.catchingUp}}, nil
+}
+
+func (n *upgradeNode) ABCIInfo(_ context.Context) (*ctypes.ResultABCIInfo, error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if n.down {
+		return nil, fmt.Errorf("connection refused")
+	}
+	return &ctypes.ResultABCIInfo{Response: abcitypes.ResponseInfo{AppVersion: n.appVersion, Version: fmt.Sprintf("v%d", n.appVersion)}}, nil
+}
+
+func (n *upgradeNode) ConsensusParams(_ context.Context, _ *int64) (*ctypes.ResultConsensusParams, error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if n.down {
+		return nil, fmt.Errorf("connection refused")
+	}
+	return &ctypes.ResultConsensusParams{BlockHeight: n.latest, ConsensusParams: *tmtypes.DefaultConsensusParams()}, nil
+}
+
+func newHaltTestService(mockDb *db.MockDataStorage, node BlockSource) *Service {
+	return &Service{
+		params:         ServiceParams{},
+		db:             mockDb,
+		done:           make(chan struct{}),
+		wg:             &sync.WaitGroup{},
+		logger:         logging.WithoutFields(),
+		tmClient:       node,
+		blockFillQueue: make(chan db.BlockGap),
+		halt:           haltState{pollInterval: 10 * time.Millisecond},
+	}
+}
+
+func TestIsHeightUnavailable(t *testing.T) {
+	assert.False(t, isHeightUnavailable(nil))
+	assert.True(t, isHeightUnavailable(fmt.Errorf("fail to read block,err: height 101 must be less than or equal to the current blockchain height 100")))
+	assert.True(t, isHeightUnavailable(fmt.Errorf("fail to read blockresult,err: could not find results for height #101")))
+	// pruned heights will never become available
+	assert.False(t, isHeightUnavailable(fmt.Errorf("height 1 is not available, lowest height is 50")))
+	assert.False(t, isHeightUnavailable(fmt.Errorf("connection refused")))
+}
+
+func TestFillGapWaitsThroughUpgrade(t *testing.T) {
+	node := &upgradeNode{latest: 99, appVersion: 1}
+	mockDb := new(db.MockDataStorage)
+	mockDb.On("InsertBlock", mock.Anything, mock.MatchedBy(func(b *db.Block) bool { return b.Height == 100 })).
+		Return(&db.Entity{ID: 1}, nil).Once()
+	s := newHaltTestService(mockDb, node)
+
+	finished := make(chan struct{})
+	go func() {
+		defer close(finished)
+		assert.Nil(t, s.fillGap(db.BlockGap{Start: 100, End: 100}))
+	}()
+	assert.Eventually(t, func() bool {
+		return testutil.ToFloat64(chainHaltedGauge) == 1
+	}, time.Second, 5*time.Millisecond)
+	assert.Equal(t, float64(100), testutil.ToFloat64(haltHeightGauge))
+	assert.NotNil(t, s.halt.before)
+
+	// node is restarted with the new binary
+	node.set(99, false, true, 1)
+	time.Sleep(50 * time.Millisecond)
+	// back online but still catching up, height 100 is known but the node is not ready yet
+	node.set(100, true, false, 2)
+	time.Sleep(50 * time.Millisecond)
+	select {
+	case <-finished:
+		t.Fatal("indexer resumed while the node was still catching up")
+	default:
+	}
+	mockDb.AssertNotCalled(t, "InsertBlock", mock.Anything, mock.Anything)
+
+	node.set(100, false, false, 2)
+	select {
+	case <-finished:
+	case <-time.After(time.Second):
+		t.Fatal("indexer did not resume after the upgrade")
+	}
+	assert.Equal(t, float64(0), testutil.ToFloat64(chainHaltedGauge))
+	assert.Equal(t, float64(0), testutil.ToFloat64(haltHeightGauge))
+	mockDb.AssertExpectations(t)
+}
+
+func TestWaitForHeightShutdown(t *testing.T) {
+	node := &upgradeNode{latest: 99, catchingUp: true, appVersion: 1}
+	s := newHaltTestService(new(db.MockDataStorage), node)
+	result := make(chan bool)
+	go func() {
+		result <- s.waitForHeight(100)
+	}()
+	close(s.done)
+	select {
+	case resumed := <-result:
+		assert.False(t, resumed)
+	case <-time.After(time.Second):
+		t.Fatal("wait did not stop on shutdown")
+	}
+}
@@ -5,14 +5,16 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net/http"
 	"sync"
 	"time"
 
-	tmclient "github.com/cometbft/cometbft/rpc/client/http"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 
 	"github.com/arkeonetwork/arkeo/common/logging"
 	"github.com/arkeonetwork/arkeo/common/utils"
 	"github.com/arkeonetwork/arkeo/directory/db"
+	"github.com/arkeonetwork/arkeo/directory/webhook"
 )
 
 const (
@@ -29,8 +31,9 @@ type Service struct {
 	done           chan struct{}
 	wg             *sync.WaitGroup
 	logger         logging.Logger
-	tmClient       *tmclient.HTTP
+	tmClient       BlockSource
 	blockFillQueue chan db.BlockGap
+	halt           haltState
 }
 
 // NewIndexer create a new instance of Indexer
@@ -69,6 +72,17 @@ func (s *Service) Run() error {
 	}()
 	s.wg.Add(1)
 	go s.blockGapProcessor()
+	if s.params.MetricsListenAddr != "" {
+		go s.serveMetrics()
+	}
+	if s.params.Webhook.Enabled {
+		worker := webhook.NewWorker(s.params.Webhook, s.db)
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			worker.Run(s.done)
+		}()
+	}
 	return nil
 }
 
@@ -141,10 +155,20 @@ func (s *Service) blockGapProcessor() {
 func (s *Service) fillGap(gap db.BlockGap) error {
 	s.logger.Infof("gap filling %s", gap)
 
+	waitedFor := int64(0)
 	for i := gap.Start; i <= gap.End; i++ {
 		s.logger.Infof("processing block: %d", i)
 		block, err := s.consumeHistoricalBlock(i)
 		if err != nil {
+			// skipping the height would leave a hole behind, wait for the node to serve it and retry once
+			if isHeightUnavailable(err) && waitedFor != i {
+				if !s.waitForHeight(i) {
+					return nil
+				}
+				waitedFor = i
+				i--
+				continue
+			}
 			s.logger.WithError(err).Errorf("err consuming block %d:", i)
 			continue
 		}
@@ -155,6 +179,21 @@ func (s *Service) fillGap(gap db.BlockGap) error {
 	return nil
 }
 
+// serveMetrics expose the indexer prometheus metrics
+func (s *Service) serveMetrics() {
+	s.logger.Infof("serving metrics on %s", s.params.MetricsListenAddr)
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+	server := &http.Server{
+		Addr:              s.params.MetricsListenAddr,
+		Handler:           mux,
+		ReadHeaderTimeout: time.Second,
+	}
+	if err := server.ListenAndServe(); err != nil {
+		s.logger.WithError(err).Error("fail to serve metrics")
+	}
+}
+
 // Close will be called when it is time to shut down the service
 // this allows the service to shut down itself gracefully
 func (s *Service) Close() error {
@@ -9,6 +9,7 @@ import (
 
 	"github.com/arkeonetwork/arkeo/directory/db"
 	"github.com/arkeonetwork/arkeo/directory/utils"
+	"github.com/arkeonetwork/arkeo/directory/webhook"
 	atypes "github.com/arkeonetwork/arkeo/x/arkeo/types"
 )
 
@@ -20,15 +21,32 @@ func (s *Service) handleModProviderEvent(ctx context.Context, evt atypes.EventMo
 
 	log := s.logger.WithField("provider", provider.ID)
 
-	isMetaDataUpdated := provider.MetadataNonce == 0 || provider.MetadataNonce < evt.MetadataNonce
-	provider.MetadataURI = evt.MetadataUri
-	provider.MetadataNonce = evt.MetadataNonce
-	provider.Status = evt.Status.String()
-	provider.MinContractDuration = evt.MinContractDuration
-	provider.MaxContractDuration = evt.MaxContractDuration
-	provider.SubscriptionRate = evt.SubscriptionRate
-	provider.PayAsYouGoRate = evt.PayAsYouGoRate
-	provider.SettlementDuration = evt.SettlementDuration
+	// the event carries the fields updated only
+	isMetaDataUpdated := evt.Updates(atypes.ModProviderFieldMetadataNonce) && (provider.MetadataNonce == 0 || provider.MetadataNonce < evt.MetadataNonce)
+	if evt.Updates(atypes.ModProviderFieldMetadataUri) {
+		provider.MetadataURI = evt.MetadataUri
+	}
+	if evt.Updates(atypes.ModProviderFieldMetadataNonce) {
+		provider.MetadataNonce = evt.MetadataNonce
+	}
+	if evt.Updates(atypes.ModProviderFieldStatus) {
+		provider.Status = evt.Status.String()
+	}
+	if evt.Updates(atypes.ModProviderFieldMinContractDuration) {
+		provider.MinContractDuration = evt.MinContractDuration
+	}
+	if evt.Updates(atypes.ModProviderFieldMaxContractDuration) {
+		provider.MaxContractDuration = evt.MaxContractDuration
+	}
+	if evt.Updates(atypes.ModProviderFieldSubscriptionRate) {
+		provider.SubscriptionRate = evt.SubscriptionRate
+	}
+	if evt.Updates(atypes.ModProviderFieldPayAsYouGoRate) {
+		provider.PayAsYouGoRate = evt.PayAsYouGoRate
+	}
+	if evt.Updates(atypes.ModProviderFieldSettlementDuration) {
+		provider.SettlementDuration = evt.SettlementDuration
+	}
 
 	if _, err = s.db.UpdateProvider(ctx, provider); err != nil {
 		return fmt.Errorf("error updating provider for mod event %s service %s,err: %w", provider.Pubkey, provider.Service, err)
@@ -90,6 +108,7 @@ func (s *Service) handleBondProviderEvent(ctx context.Context, evt atypes.EventB
 			return errors.Wrapf(err, "error creating provider %s service %s", evt.Provider, evt.Service)
 		}
 		isNewProvider = true
+		s.notifyWebhooks(ctx, webhook.EventProviderCreated, evt.Service, []string{evt.Provider.String()}, evt)
 	}
 	if !isNewProvider {
 		if evt.BondAbs.IsNil() {
@@ -108,6 +127,35 @@ func (s *Service) handleBondProviderEvent(ctx context.Context, evt atypes.EventB
 	return nil
 }
 
+func (s *Service) handleSlashProviderEvent(ctx context.Context, evt atypes.EventSlashProvider) error {
+	provider, err := s.db.FindProvider(ctx, evt.Provider.String(), evt.Service)
+	if err != nil {
+		return fmt.Errorf("fail to find provider %s for service %s,err: %w", evt.Provider, evt.Service, err)
+	}
+	provider.Bond = evt.Bond.String()
+	if _, err = s.db.UpdateProvider(ctx, provider); err != nil {
+		return errors.Wrapf(err, "error updating provider for slash event %s service %s", evt.Provider, evt.Service)
+	}
+	s.logger.Infof("provider %s service %s slashed %s for %s", evt.Provider, evt.Service, evt.Amount, evt.Reason)
+	s.notifyWebhooks(ctx, webhook.EventProviderSlashed, evt.Service, []string{evt.Provider.String()}, evt)
+	return nil
+}
+
+func (s *Service) handleProviderDemotedEvent(ctx context.Context, evt atypes.EventProviderDemoted) error {
+	provider, err := s.db.FindProvider(ctx, evt.Provider.String(), evt.Service)
+	if err != nil {
+		return fmt.Errorf("fail to find provider %s for service %s,err: %w", evt.Provider, evt.Service, err)
+	}
+	provider.Status = atypes.ProviderStatus_OFFLINE.String()
+	provider.Bond = evt.Bond.String()
+	if _, err = s.db.UpdateProvider(ctx, provider); err != nil {
+		return errors.Wrapf(err, "error updating provider for demoted event %s service %s", evt.Provider, evt.Service)
+	}
+	s.logger.Infof("provider %s service %s set offline, bond %s below the minimum %s", evt.Provider, evt.Service, evt.Bond, evt.MinBond)
+	s.notifyWebhooks(ctx, webhook.EventProviderDemoted, evt.Service, []string{evt.Provider.String()}, evt)
+	return nil
+}
+
 func (s *Service) createProvider(ctx context.Context, evt atypes.EventBondProvider) (*db.ArkeoProvider, error) {
 	// new provider for service, insert
 	provider := &db.ArkeoProvider{
@@ -0,0 +1,30 @@
+package indexer
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/arkeonetwork/arkeo/directory/webhook"
+)
+
+// notifyWebhooks queue a delivery of the event for every matching subscription. Delivery itself happens
+// asynchronously in the webhook worker, failing to queue is logged and never fails block processing
+func (s *Service) notifyWebhooks(ctx context.Context, eventType, service string, pubkeys []string, data any) {
+	if !s.params.Webhook.Enabled {
+		return
+	}
+	log := s.logger.WithField("event", eventType)
+	payload, err := json.Marshal(webhook.Payload{Event: eventType, Data: data})
+	if err != nil {
+		log.WithError(err).Error("fail to marshal webhook payload")
+		return
+	}
+	queued, err := s.db.EnqueueWebhookDeliveries(ctx, eventType, service, pubkeys, payload)
+	if err != nil {
+		log.WithError(err).Error("fail to enqueue webhook deliveries")
+		return
+	}
+	if queued > 0 {
+		log.Debugf("queued %d webhook deliveries", queued)
+	}
+}
@@ -0,0 +1,3 @@
+alter table contracts add column unpaid bigint not null default 0;
+---- create above / drop below ----
+alter table contracts drop column unpaid;
@@ -0,0 +1,36 @@
+create table webhook_subscriptions
+(
+    id          bigserial                 not null
+        constraint webhook_subscriptions_pk
+            primary key,
+    created     timestamptz default now() not null,
+    updated     timestamptz default now() not null,
+    url         text                      not null check ( url != '' ),
+    secret      text                      not null check ( secret != '' ),
+    event_types text[]                    not null check ( cardinality(event_types) > 0 ),
+    service     text        default ''    not null,
+    pubkey      text        default ''    not null,
+    active      boolean     default true  not null
+);
+
+create table webhook_deliveries
+(
+    id              bigserial                 not null
+        constraint webhook_deliveries_pk
+            primary key,
+    created         timestamptz default now() not null,
+    updated         timestamptz default now() not null,
+    subscription_id bigint                    not null references webhook_subscriptions (id) on delete cascade,
+    event_type      text                      not null,
+    payload         jsonb                     not null,
+    status          text        default 'pending' not null check ( status in ('pending', 'delivered', 'dead') ),
+    attempts        integer     default 0     not null,
+    next_attempt    timestamptz default now() not null,
+    last_error      text        default ''    not null
+);
+
+create index webhook_deliveries_due_idx on webhook_deliveries (next_attempt) where status = 'pending';
+
+---- create above / drop below ----
+drop table webhook_deliveries;
+drop table webhook_subscriptions;
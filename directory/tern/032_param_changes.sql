@@ -0,0 +1,16 @@
+create table param_changes
+(
+    id        bigserial                 not null
+        constraint param_changes_pk
+            primary key,
+    created   timestamptz default now() not null,
+    updated   timestamptz default now() not null,
+    height    bigint                    not null,
+    key       text                      not null,
+    old_value text                      not null,
+    new_value text                      not null
+);
+
+alter table param_changes add constraint param_changes_height_key_key unique (height, key);
+---- create above / drop below ----
+drop table param_changes;
@@ -101,6 +101,14 @@ type ProviderSearchParams struct {
 	IsMinOpenContractsSet      bool
 }
 
+type ContractSearchParams struct {
+	ProviderPubkey string
+	Service        string
+	ClientPubkey   string
+	// only return contracts that settled with the client still owing the provider
+	WithDebt bool
+}
+
 // swagger:model ArkeoStats
 type ArkeoStats struct {
 	ContractsOpen           int64 `db:"open_contracts"`
@@ -125,3 +133,14 @@ type ServiceStats struct {
 	TotalIncome        int64
 	TotalIncomeLastDay int64
 }
+
+// swagger:model ProviderStats
+type ProviderStats struct {
+	Pubkey         string `db:"pubkey"`
+	Service        string `db:"service"`
+	ContractsOpen  int64  `db:"open_contracts"`
+	ContractsTotal int64  `db:"total_contracts"`
+	QueryCount     int64  `db:"total_queries"`
+	TotalIncome    int64  `db:"total_paid"`
+	TotalUnpaid    int64  `db:"total_unpaid"`
+}
@@ -0,0 +1,78 @@
+// Package webhook deliver directory events to integrator endpoints. Deliveries are queued in the database while
+// blocks are indexed and POSTed asynchronously by a Worker, signed with the subscription's secret
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+)
+
+// event types a subscription can listen to
+const (
+	EventProviderCreated         = "provider.created"
+	EventContractOpened          = "contract.opened"
+	EventContractSettled         = "contract.settled"
+	EventContractClosed          = "contract.closed"
+	EventContractRenewed         = "contract.renewed"
+	EventContractExpired         = "contract.expired"
+	EventProviderSlashed         = "provider.slashed"
+	EventContractDelegateRotated = "contract.delegate_rotated"
+	EventContractAutoRenewed     = "contract.auto_renewed"
+	EventProviderDemoted         = "provider.demoted"
+)
+
+// headers set on every delivery
+const (
+	HeaderSignature = "X-Arkeo-Signature"
+	HeaderEvent     = "X-Arkeo-Event"
+	HeaderDelivery  = "X-Arkeo-Delivery"
+)
+
+const signaturePrefix = "sha256="
+
+// EventTypes list all the supported event types
+var EventTypes = []string{
+	EventProviderCreated,
+	EventContractOpened,
+	EventContractSettled,
+	EventContractClosed,
+	EventContractRenewed,
+	EventContractExpired,
+	EventProviderSlashed,
+	EventContractDelegateRotated,
+	EventContractAutoRenewed,
+	EventProviderDemoted,
+}
+
+// IsValidEventType return true when the given event type is supported
+func IsValidEventType(eventType string) bool {
+	for _, item := range EventTypes {
+		if item == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// Payload is the json body POSTed to subscribers
+type Payload struct {
+	Event string `json:"event"`
+	Data  any    `json:"data"`
+}
+
+// Sign return the signature of body using the given secret, formatted as sha256=<hex hmac>
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify check the signature received along with the body, receivers can use it to authenticate deliveries
+func Verify(secret string, body []byte, signature string) bool {
+	if !strings.HasPrefix(signature, signaturePrefix) {
+		return false
+	}
+	return hmac.Equal([]byte(Sign(secret, body)), []byte(signature))
+}
@@ -0,0 +1,159 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/arkeonetwork/arkeo/common/logging"
+	"github.com/arkeonetwork/arkeo/directory/db"
+)
+
+const (
+	defaultMaxAttempts        = 8
+	defaultPollIntervalSecond = 5
+	defaultTimeoutSecond      = 10
+	defaultBatchSize          = 50
+	baseBackoff               = 10 * time.Second
+	maxBackoff                = time.Hour
+	// only the beginning of a failed response is kept as last error
+	maxErrorBody = 256
+)
+
+// Config hold the parameters of the delivery worker
+type Config struct {
+	Enabled            bool `mapstructure:"enabled" json:"enabled"`
+	MaxAttempts        int  `mapstructure:"max_attempts" json:"max_attempts"`
+	PollIntervalSecond int  `mapstructure:"poll_interval" json:"poll_interval"`
+	TimeoutSecond      int  `mapstructure:"timeout" json:"timeout"`
+	BatchSize          int  `mapstructure:"batch_size" json:"batch_size"`
+}
+
+// Store is the persistence the worker needs to pick up and record deliveries
+type Store interface {
+	FindDueWebhookDeliveries(ctx context.Context, limit int) ([]*db.WebhookDelivery, error)
+	UpdateWebhookDelivery(ctx context.Context, delivery *db.WebhookDelivery) (*db.Entity, error)
+}
+
+// Worker POST queued deliveries to their subscription, retrying with an exponential backoff until MaxAttempts is
+// reached, at which point the delivery is dead lettered
+type Worker struct {
+	config Config
+	store  Store
+	client *http.Client
+	logger logging.Logger
+}
+
+// NewWorker create a new delivery worker, unset config values fall back to defaults
+func NewWorker(config Config, store Store) *Worker {
+	if config.MaxAttempts <= 0 {
+		config.MaxAttempts = defaultMaxAttempts
+	}
+	if config.PollIntervalSecond <= 0 {
+		config.PollIntervalSecond = defaultPollIntervalSecond
+	}
+	if config.TimeoutSecond <= 0 {
+		config.TimeoutSecond = defaultTimeoutSecond
+	}
+	if config.BatchSize <= 0 {
+		config.BatchSize = defaultBatchSize
+	}
+	return &Worker{
+		config: config,
+		store:  store,
+		client: &http.Client{Timeout: time.Duration(config.TimeoutSecond) * time.Second},
+		logger: logging.WithFields(logging.Fields{"service": "webhook"}),
+	}
+}
+
+// Run poll for due deliveries until done is closed
+func (w *Worker) Run(done <-chan struct{}) {
+	ticker := time.NewTicker(time.Duration(w.config.PollIntervalSecond) * time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+			if _, err := w.ProcessDue(context.Background()); err != nil {
+				w.logger.WithError(err).Error("fail to process webhook deliveries")
+			}
+		}
+	}
+}
+
+// ProcessDue attempt a batch of due deliveries and return how many were attempted
+func (w *Worker) ProcessDue(ctx context.Context) (int, error) {
+	deliveries, err := w.store.FindDueWebhookDeliveries(ctx, w.config.BatchSize)
+	if err != nil {
+		return 0, fmt.Errorf("fail to find due webhook deliveries,err: %w", err)
+	}
+	for _, delivery := range deliveries {
+		w.attempt(ctx, delivery)
+		if _, err := w.store.UpdateWebhookDelivery(ctx, delivery); err != nil {
+			return 0, fmt.Errorf("fail to update webhook delivery %d,err: %w", delivery.ID, err)
+		}
+	}
+	return len(deliveries), nil
+}
+
+// attempt POST the delivery and update its status, attempts and schedule accordingly
+func (w *Worker) attempt(ctx context.Context, delivery *db.WebhookDelivery) {
+	delivery.Attempts++
+	err := w.post(ctx, delivery)
+	if err == nil {
+		delivery.Status = db.WebhookStatusDelivered
+		delivery.LastError = ""
+		return
+	}
+	delivery.LastError = err.Error()
+	log := w.logger.WithError(err).
+		WithField("delivery", delivery.ID).
+		WithField("attempts", delivery.Attempts)
+	if delivery.Attempts >= w.config.MaxAttempts {
+		delivery.Status = db.WebhookStatusDead
+		log.Error("webhook delivery dead lettered")
+		return
+	}
+	delivery.Status = db.WebhookStatusPending
+	delivery.NextAttempt = time.Now().Add(backoff(delivery.Attempts))
+	log.Warn("webhook delivery failed, will retry")
+}
+
+func (w *Worker) post(ctx context.Context, delivery *db.WebhookDelivery) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, delivery.URL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return fmt.Errorf("fail to create request,err: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderEvent, delivery.EventType)
+	req.Header.Set(HeaderDelivery, strconv.FormatInt(delivery.ID, 10))
+	req.Header.Set(HeaderSignature, Sign(delivery.Secret, delivery.Payload))
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("fail to post,err: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBody))
+		return fmt.Errorf("unexpected status code %d: %s", resp.StatusCode, body)
+	}
+	return nil
+}
+
+// backoff return the delay before the next attempt, doubling on every failed attempt
+func backoff(attempts int) time.Duration {
+	delay := baseBackoff
+	for i := 1; i < attempts; i++ {
+		delay *= 2
+		if delay >= maxBackoff {
+			return maxBackoff
+		}
+	}
+	return delay
+}
@@ -0,0 +1,154 @@
+package webhook
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/arkeonetwork/arkeo/directory/db"
+)
+
+// memoryStore keep deliveries in memory, unlike the db it doesn't honour next_attempt so retries can be driven
+// from the test without waiting for the backoff
+type memoryStore struct {
+	mu         sync.Mutex
+	deliveries []*db.WebhookDelivery
+}
+
+func (m *memoryStore) FindDueWebhookDeliveries(_ context.Context, limit int) ([]*db.WebhookDelivery, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var result []*db.WebhookDelivery
+	for _, delivery := range m.deliveries {
+		if delivery.Status == db.WebhookStatusPending && len(result) < limit {
+			result = append(result, delivery)
+		}
+	}
+	return result, nil
+}
+
+func (m *memoryStore) UpdateWebhookDelivery(_ context.Context, delivery *db.WebhookDelivery) (*db.Entity, error) {
+	return &delivery.Entity, nil
+}
+
+func newDelivery(url string) *db.WebhookDelivery {
+	return &db.WebhookDelivery{
+		Entity:    db.Entity{ID: 1},
+		EventType: EventContractSettled,
+		Payload:   []byte(`{"event":"contract.settled","data":{"contract_id":"1"}}`),
+		Status:    db.WebhookStatusPending,
+		URL:       url,
+		Secret:    "s3cr3t",
+	}
+}
+
+func TestSignAndVerify(t *testing.T) {
+	body := []byte(`{"event":"provider.created"}`)
+	signature := Sign("s3cr3t", body)
+	assert.True(t, Verify("s3cr3t", body, signature))
+	assert.False(t, Verify("other", body, signature))
+	assert.False(t, Verify("s3cr3t", []byte(`{"event":"contract.closed"}`), signature))
+	assert.False(t, Verify("s3cr3t", body, signature[len(signaturePrefix):]))
+}
+
+func TestWorkerDeliverSigned(t *testing.T) {
+	var received int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		assert.Nil(t, err)
+		assert.True(t, Verify("s3cr3t", body, r.Header.Get(HeaderSignature)))
+		assert.Equal(t, EventContractSettled, r.Header.Get(HeaderEvent))
+		assert.Equal(t, "1", r.Header.Get(HeaderDelivery))
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		received++
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	delivery := newDelivery(server.URL)
+	store := &memoryStore{deliveries: []*db.WebhookDelivery{delivery}}
+	w := NewWorker(Config{}, store)
+	attempted, err := w.ProcessDue(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, 1, attempted)
+	assert.Equal(t, 1, received)
+	assert.Equal(t, db.WebhookStatusDelivered, delivery.Status)
+	assert.Equal(t, 1, delivery.Attempts)
+
+	// delivered payloads are not sent again
+	attempted, err = w.ProcessDue(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, 0, attempted)
+	assert.Equal(t, 1, received)
+}
+
+func TestWorkerRetry(t *testing.T) {
+	var calls int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	delivery := newDelivery(server.URL)
+	store := &memoryStore{deliveries: []*db.WebhookDelivery{delivery}}
+	w := NewWorker(Config{MaxAttempts: 5}, store)
+
+	_, err := w.ProcessDue(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, db.WebhookStatusPending, delivery.Status)
+	assert.Equal(t, 1, delivery.Attempts)
+	assert.Contains(t, delivery.LastError, "503")
+	firstRetry := delivery.NextAttempt
+	assert.True(t, firstRetry.After(time.Now()))
+
+	_, err = w.ProcessDue(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, db.WebhookStatusPending, delivery.Status)
+	assert.Equal(t, 2, delivery.Attempts)
+	assert.True(t, delivery.NextAttempt.After(firstRetry))
+
+	_, err = w.ProcessDue(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, db.WebhookStatusDelivered, delivery.Status)
+	assert.Equal(t, 3, delivery.Attempts)
+	assert.Empty(t, delivery.LastError)
+	assert.Equal(t, 3, calls)
+}
+
+func TestWorkerDeadLetter(t *testing.T) {
+	var calls int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	delivery := newDelivery(server.URL)
+	store := &memoryStore{deliveries: []*db.WebhookDelivery{delivery}}
+	w := NewWorker(Config{MaxAttempts: 3}, store)
+	for i := 0; i < 5; i++ {
+		_, err := w.ProcessDue(context.Background())
+		assert.Nil(t, err)
+	}
+	assert.Equal(t, db.WebhookStatusDead, delivery.Status)
+	assert.Equal(t, 3, delivery.Attempts)
+	assert.Equal(t, 3, calls)
+}
+
+func TestBackoff(t *testing.T) {
+	assert.Equal(t, baseBackoff, backoff(1))
+	assert.Equal(t, 2*baseBackoff, backoff(2))
+	assert.Equal(t, 4*baseBackoff, backoff(3))
+	assert.Equal(t, maxBackoff, backoff(100))
+}
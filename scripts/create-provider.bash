@@ -27,4 +27,4 @@ PUBKEY=$($BIN debug pubkey-raw "$PUBKEY_RAW" | grep "Bech32 Acc" | awk '{ print
 
 ./"$PWD"/bond-provider.bash "$USER" "$SERVICE" "$BOND"
 
-$BIN tx $BIN_TX mod-provider -y -b block --from "$USER" --keyring-backend test -- "$PUBKEY" "$SERVICE" "http://localhost:3636/metadata.json" 1 1 10 5256000 10uarkeo 10uarkeo 10
+$BIN tx $BIN_TX mod-provider -y -b block --from "$USER" --keyring-backend test "$PUBKEY" "$SERVICE" --metadata-uri "http://localhost:3636/metadata.json" --metadata-nonce 1 --status online --min-contract-duration 10 --max-contract-duration 5256000 --subscription-rates 10uarkeo --pay-as-you-go-rates 10uarkeo --settlement-duration 10
@@ -0,0 +1,326 @@
+package sentinel
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+)
+
+const (
+	accessTierPaid = "paid"
+	accessTierFree = "free"
+	// a request for a free path of the service, served without a contract nor billing
+	accessTierFreePath = "free_path"
+	// a request answered from the response cache of the service, its hits being free
+	accessTierCache = "cache"
+	// redacted replace the secrets of the logged queries
+	redacted = "REDACTED"
+)
+
+// AccessLogger write a line per request to the services with what it was billed, so the charges of a client can be
+// reconciled with the requests it made
+type AccessLogger struct {
+	logger *logrus.Logger
+	file   io.Closer // the rotated file written to, nil when the output isn't owned by the logger
+}
+
+// NewAccessLogger return an access logger writing json lines to out
+func NewAccessLogger(out io.Writer) *AccessLogger {
+	logger := logrus.New()
+	logger.SetOutput(out)
+	logger.SetFormatter(&logrus.JSONFormatter{TimestampFormat: time.RFC3339Nano})
+	logger.SetLevel(logrus.InfoLevel)
+	return &AccessLogger{logger: logger}
+}
+
+// Close close the file of the access log, if any
+func (l *AccessLogger) Close() error {
+	if l == nil || l.file == nil {
+		return nil
+	}
+	return l.file.Close()
+}
+
+// newAccessLogger return the access logger of the configuration, writing to stdout or a rotated file
+func newAccessLogger(file string, maxMB int64, maxFiles int) (*AccessLogger, error) {
+	if len(file) == 0 {
+		return NewAccessLogger(os.Stdout), nil
+	}
+	out, err := openRotatingFile(file, maxMB<<20, maxFiles)
+	if err != nil {
+		return nil, err
+	}
+	logger := NewAccessLogger(out)
+	logger.file = out
+	return logger, nil
+}
+
+// accessRecord collect how a request was billed while it is served
+type accessRecord struct {
+	lock          sync.Mutex
+	tier          string
+	contractId    uint64
+	client        string
+	nonce         int64
+	queries       int64
+	upstreamStart time.Time
+	upstream      time.Duration
+	cached        bool // answered from the response cache
+}
+
+func accessRecordFrom(r *http.Request) *accessRecord {
+	record, _ := r.Context().Value(accessRecordContextKey).(*accessRecord)
+	return record
+}
+
+// paid record a request served under a contract, charged queries
+func (a *accessRecord) paid(contract types.Contract, nonce, queries int64) {
+	if a == nil {
+		return
+	}
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	a.tier = accessTierPaid
+	a.contractId = contract.Id
+	a.client = contract.Client.String()
+	a.nonce = nonce
+	a.queries = queries
+}
+
+// free record a request served by the free tier, counted as queries against its allowance
+func (a *accessRecord) free(pubkey string, queries int64) {
+	if a == nil {
+		return
+	}
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	a.tier = accessTierFree
+	a.client = pubkey
+	a.queries = queries
+}
+
+// freePath record a request for a free path, it isn't charged
+func (a *accessRecord) freePath() {
+	if a == nil {
+		return
+	}
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	a.tier = accessTierFreePath
+}
+
+// cacheHit record a request answered from the response cache, free when the hits of the service aren't charged
+func (a *accessRecord) cacheHit(free bool) {
+	if a == nil {
+		return
+	}
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	a.cached = true
+	if free {
+		a.tier = accessTierCache
+	}
+}
+
+// charge record queries charged to the contract while the request is streamed
+func (a *accessRecord) charge(queries int64) {
+	if a == nil {
+		return
+	}
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	a.queries += queries
+}
+
+// startUpstream record the request being sent to the upstream
+func (a *accessRecord) startUpstream() {
+	if a == nil {
+		return
+	}
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	a.upstreamStart = time.Now()
+}
+
+// upstreamResponded record the upstream answering with the response headers
+func (a *accessRecord) upstreamResponded() {
+	if a == nil {
+		return
+	}
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	if !a.upstreamStart.IsZero() {
+		a.upstream = time.Since(a.upstreamStart)
+	}
+}
+
+// accessLog log every request to the services once it is served, and publish its summary to the request feed
+func (p Proxy) accessLog(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if p.AccessLog == nil && !p.RequestFeed.HasSubscribers() {
+			next.ServeHTTP(w, r)
+			return
+		}
+		// the request is rewritten for the upstream, what the client asked is read first
+		service, requestPath, query := requestService(r), path.Clean("/"+r.URL.Path), redactQuery(r.URL.Query())
+		record := &accessRecord{}
+		start := time.Now()
+		sw := &statusWriter{ResponseWriter: w}
+		next.ServeHTTP(sw, r.WithContext(context.WithValue(r.Context(), accessRecordContextKey, record)))
+
+		record.lock.Lock()
+		defer record.lock.Unlock()
+		duration := time.Since(start)
+		summary := RequestSummary{
+			Time:      start,
+			Service:   service,
+			Method:    r.Method,
+			Path:      requestPath,
+			Tier:      record.tier,
+			Status:    sw.Status(),
+			LatencyMs: duration.Milliseconds(),
+			Queries:   record.queries,
+		}
+		if record.tier == accessTierPaid {
+			summary.ContractId = record.contractId
+		}
+		p.RequestFeed.Publish(summary)
+		if p.AccessLog == nil {
+			return
+		}
+		fields := logrus.Fields{
+			"service":     service,
+			"method":      r.Method,
+			"path":        requestPath,
+			"remote":      p.getRemoteAddr(r),
+			"status":      sw.Status(),
+			"duration_ms": duration.Milliseconds(),
+			"upstream_ms": record.upstream.Milliseconds(),
+			"bytes_in":    r.ContentLength,
+			"bytes_out":   sw.written,
+			"tier":        record.tier,
+			"free_tier":   record.tier == accessTierFree,
+			"client":      record.client,
+			"queries":     record.queries,
+		}
+		if len(query) > 0 {
+			fields["query"] = query
+		}
+		if record.cached {
+			fields["cache_hit"] = true
+		}
+		if record.tier == accessTierPaid {
+			fields["contract_id"] = record.contractId
+			fields["nonce"] = record.nonce
+		}
+		p.AccessLog.logger.WithFields(fields).Info("access")
+	})
+}
+
+// redactQuery return the encoded query without the signatures it carries
+func redactQuery(values url.Values) string {
+	if raw, ok := values[QueryArkAuth]; ok {
+		redactedValues := make([]string, len(raw))
+		for i, arkauth := range raw {
+			redactedValues[i] = redactArkAuth(arkauth)
+		}
+		values[QueryArkAuth] = redactedValues
+	}
+	return values.Encode()
+}
+
+// redactArkAuth return the arkauth without its signature, the contract and the nonce are kept
+func redactArkAuth(raw string) string {
+	version := ""
+	if strings.HasPrefix(raw, arkAuthV2Prefix) {
+		version, raw = arkAuthV2Prefix, strings.TrimPrefix(raw, arkAuthV2Prefix)
+	}
+	parts := strings.SplitN(raw, ":", 3)
+	if len(parts) < 3 {
+		return version + raw
+	}
+	return fmt.Sprintf("%s%s:%s:%s", version, parts[0], parts[1], redacted)
+}
+
+// rotatingFile append to a file, it is moved to file.1 (file.1 to file.2 and so on) once it reaches maxBytes, the
+// maxFiles most recent files are kept
+type rotatingFile struct {
+	lock     sync.Mutex
+	path     string
+	maxBytes int64
+	maxFiles int
+	file     *os.File
+	size     int64
+}
+
+func openRotatingFile(name string, maxBytes int64, maxFiles int) (*rotatingFile, error) {
+	f := &rotatingFile{path: name, maxBytes: maxBytes, maxFiles: maxFiles}
+	if err := f.open(); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+func (f *rotatingFile) open() error {
+	file, err := os.OpenFile(f.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o640)
+	if err != nil {
+		return fmt.Errorf("fail to open %s: %w", f.path, err)
+	}
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+		return fmt.Errorf("fail to stat %s: %w", f.path, err)
+	}
+	f.file, f.size = file, info.Size()
+	return nil
+}
+
+func (f *rotatingFile) Write(b []byte) (int, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	if f.maxBytes > 0 && f.size > 0 && f.size+int64(len(b)) > f.maxBytes {
+		if err := f.rotate(); err != nil {
+			return 0, err
+		}
+	}
+	n, err := f.file.Write(b)
+	f.size += int64(n)
+	return n, err
+}
+
+// rotate shift the rotated files and start a new file
+func (f *rotatingFile) rotate() error {
+	if err := f.file.Close(); err != nil {
+		return err
+	}
+	if f.maxFiles > 0 {
+		for i := f.maxFiles - 1; i > 0; i-- {
+			if err := os.Rename(fmt.Sprintf("%s.%d", f.path, i), fmt.Sprintf("%s.%d", f.path, i+1)); err != nil && !os.IsNotExist(err) {
+				return err
+			}
+		}
+		if err := os.Rename(f.path, f.path+".1"); err != nil {
+			return err
+		}
+	} else if err := os.Remove(f.path); err != nil {
+		return err
+	}
+	return f.open()
+}
+
+func (f *rotatingFile) Close() error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	return f.file.Close()
+}
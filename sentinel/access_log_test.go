@@ -0,0 +1,106 @@
+package sentinel
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/arkeonetwork/arkeo/common"
+	"github.com/arkeonetwork/arkeo/sentinel/conf"
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+)
+
+func TestAccessLog(t *testing.T) {
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("{}"))
+	}))
+	defer upstream.Close()
+
+	config := newTestConfig()
+	config.Services = map[string]conf.ServiceConfiguration{
+		"btc-mainnet-fullnode": {Upstream: upstream.URL},
+	}
+	proxy, err := NewProxy(config)
+	require.NoError(t, err)
+	var out bytes.Buffer
+	proxy.AccessLog = NewAccessLogger(&out)
+	contract := newWebsocketContract(1, 1000, 1000)
+	proxy.MemStore.SetHeight(10)
+	proxy.MemStore.Put(contract)
+	router := proxy.getRouter()
+
+	serve := func(path string) map[string]any {
+		out.Reset()
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+		require.Equal(t, http.StatusOK, w.Code)
+		var line map[string]any
+		require.NoError(t, json.Unmarshal(out.Bytes(), &line))
+		return line
+	}
+
+	// a billed request, its signature is not logged
+	line := serve(fmt.Sprintf("/%s/blocks/?%s=%d:3:aabbcc", common.BTCService, QueryArkAuth, contract.Id))
+	require.NotContains(t, out.String(), "aabbcc")
+	require.NotEmpty(t, line["time"])
+	require.Equal(t, common.BTCService.String(), line["service"])
+	require.Equal(t, "/"+common.BTCService.String()+"/blocks", line["path"])
+	require.Equal(t, fmt.Sprintf("%s=%d%%3A3%%3A%s", QueryArkAuth, contract.Id, redacted), line["query"])
+	require.Equal(t, "paid", line["tier"])
+	require.Equal(t, false, line["free_tier"])
+	require.EqualValues(t, contract.Id, line["contract_id"])
+	require.Equal(t, contract.Client.String(), line["client"])
+	require.EqualValues(t, 3, line["nonce"])
+	require.EqualValues(t, 1, line["queries"])
+	require.EqualValues(t, http.StatusOK, line["status"])
+	require.EqualValues(t, 2, line["bytes_out"])
+	require.Contains(t, line, "upstream_ms")
+
+	// a free request
+	client := types.GetRandomPubKey()
+	line = serve(fmt.Sprintf("/%s?%s=%s", common.BTCService, QueryClientPubKey, client))
+	require.Equal(t, "free", line["tier"])
+	require.Equal(t, true, line["free_tier"])
+	require.Equal(t, client.String(), line["client"])
+	require.EqualValues(t, 1, line["queries"])
+	require.EqualValues(t, http.StatusOK, line["status"])
+	require.EqualValues(t, 2, line["bytes_out"])
+	require.NotContains(t, line, "contract_id")
+	require.NotContains(t, line, "nonce")
+}
+
+func TestRedactArkAuth(t *testing.T) {
+	require.Equal(t, "1:2:"+redacted, redactArkAuth("1:2:deadbeef"))
+	require.Equal(t, "1:2", redactArkAuth("1:2"))
+	require.Equal(t, "1:2:"+redacted, redactArkAuth("1:2:3:4"))
+	require.Equal(t, "v2:1:2:"+redacted, redactArkAuth("v2:1:2:aa:bb:cc"))
+}
+
+func TestRotatingFile(t *testing.T) {
+	name := filepath.Join(t.TempDir(), "access.log")
+	f, err := openRotatingFile(name, 10, 2)
+	require.NoError(t, err)
+	for _, line := range []string{"aaaaaaaa\n", "bbbbbbbb\n", "cccccccc\n", "dddddddd\n"} {
+		_, err = f.Write([]byte(line))
+		require.NoError(t, err)
+	}
+	require.NoError(t, f.Close())
+
+	read := func(name string) string {
+		b, err := os.ReadFile(name)
+		require.NoError(t, err)
+		return string(b)
+	}
+	require.Equal(t, "dddddddd\n", read(name))
+	require.Equal(t, "cccccccc\n", read(name+".1"))
+	require.Equal(t, "bbbbbbbb\n", read(name+".2"))
+	_, err = os.Stat(name + ".3")
+	require.True(t, os.IsNotExist(err))
+}
@@ -0,0 +1,238 @@
+package sentinel
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"net/http"
+	"sort"
+	"strconv"
+
+	"github.com/arkeonetwork/arkeo/common/cosmos"
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+)
+
+// AdminContract is an active contract as listed to the operator
+type AdminContract struct {
+	Id               uint64 `json:"id"`
+	Service          string `json:"service"`
+	Client           string `json:"client"`
+	Delegate         string `json:"delegate,omitempty"`
+	Type             string `json:"type"`
+	Height           int64  `json:"height"`
+	Expiration       int64  `json:"expiration"`
+	Rate             string `json:"rate"`
+	Deposit          string `json:"deposit"`
+	Remaining        string `json:"remaining"`      // deposit left once the queries served are paid
+	Nonce            int64  `json:"nonce"`          // highest nonce served
+	StreamQueries    int64  `json:"stream_queries"` // queries of the websocket and grpc streams on top of the nonce
+	QueriesPerMinute int64  `json:"queries_per_minute"`
+}
+
+// AdminClaim is a claim not settled yet, with the income it can claim
+type AdminClaim struct {
+	ContractId uint64 `json:"contract_id"`
+	Spender    string `json:"spender"`
+	Nonce      int64  `json:"nonce"`
+	Amount     string `json:"amount,omitempty"` // empty when the contract isn't cached
+}
+
+// AdminClaims is the claims not settled yet and the income they claim per denom
+type AdminClaims struct {
+	Claims []AdminClaim      `json:"claims"`
+	Totals map[string]string `json:"totals"`
+}
+
+// AdminLimits is the limits in effect across the sentinel and for each active contract
+type AdminLimits struct {
+	MaxInFlight         int                   `json:"max_in_flight"`
+	InFlight            int                   `json:"in_flight"`
+	ContractMaxInFlight int                   `json:"contract_max_in_flight"`
+	FreeTierRateLimit   int                   `json:"free_tier_rate_limit"`
+	FreeTierDailyLimit  int                   `json:"free_tier_daily_limit"`
+	Contracts           []AdminContractLimits `json:"contracts"`
+}
+
+// AdminContractLimits is the limits in effect for a contract
+type AdminContractLimits struct {
+	ContractId       uint64  `json:"contract_id"`
+	QueriesPerMinute int64   `json:"queries_per_minute"`
+	Tokens           float64 `json:"tokens"` // queries the contract can make right away
+	InFlight         int     `json:"in_flight"`
+}
+
+// AdminClaimSubmission is the outcome of a claim submitted by the operator
+type AdminClaimSubmission struct {
+	ContractId uint64 `json:"contract_id"`
+	TxHash     string `json:"tx_hash,omitempty"`
+	DryRun     bool   `json:"dry_run"`
+}
+
+// adminCaller return the name of the token the request carries, admin for ADMIN_TOKEN, false without a valid one
+func (p Proxy) adminCaller(r *http.Request) (string, bool) {
+	config := p.current().Config
+	authorization := []byte(r.Header.Get("Authorization"))
+	caller, found := "", false
+	if len(config.AdminToken) > 0 && subtle.ConstantTimeCompare(authorization, []byte("Bearer "+config.AdminToken)) == 1 {
+		caller, found = "admin", true
+	}
+	for name, token := range config.AdminTokens {
+		if len(token) > 0 && subtle.ConstantTimeCompare(authorization, []byte("Bearer "+token)) == 1 {
+			caller, found = name, true
+		}
+	}
+	return caller, found
+}
+
+// authorizeAdmin check the method and the token of an admin request and log it with its caller, the request is
+// answered when it is refused
+func (p Proxy) authorizeAdmin(w http.ResponseWriter, r *http.Request, method string) (string, bool) {
+	if r.Method != method {
+		respondWithError(w, "method not allowed", http.StatusMethodNotAllowed)
+		return "", false
+	}
+	caller, ok := p.adminCaller(r)
+	if !ok {
+		p.logger.Info("refusing admin request", "method", r.Method, "path", r.URL.RequestURI(), "remote-addr", r.RemoteAddr)
+		respondWithError(w, "unauthorized", http.StatusUnauthorized)
+		return "", false
+	}
+	p.logger.Info("admin request", "caller", caller, "method", r.Method, "path", r.URL.RequestURI(), "remote-addr", r.RemoteAddr)
+	return caller, true
+}
+
+// activeContracts return the cached contracts that can still be used, by id
+func (p Proxy) activeContracts() []types.Contract {
+	height := p.MemStore.GetHeight()
+	var contracts []types.Contract
+	for _, contract := range p.MemStore.List() {
+		if !contract.IsEmpty() && !contract.IsExpired(height) {
+			contracts = append(contracts, contract)
+		}
+	}
+	sort.Slice(contracts, func(i, j int) bool { return contracts[i].Id < contracts[j].Id })
+	return contracts
+}
+
+// handleAdminContracts list the active contracts with their deposit left and nonce
+func (p Proxy) handleAdminContracts(w http.ResponseWriter, r *http.Request) {
+	if _, ok := p.authorizeAdmin(w, r, http.MethodGet); !ok {
+		return
+	}
+	height := p.MemStore.GetHeight()
+	contracts := []AdminContract{}
+	for _, contract := range p.activeContracts() {
+		nonce := contract.Nonce
+		if claim, err := p.ClaimStore.Get(contract.Key()); err == nil && claim.Nonce > nonce {
+			nonce = claim.Nonce
+		}
+		streamQueries := p.StreamUsage.Get(contract.Id)
+		contracts = append(contracts, AdminContract{
+			Id:               contract.Id,
+			Service:          contract.Service.String(),
+			Client:           contract.Client.String(),
+			Delegate:         contract.Delegate.String(),
+			Type:             contract.Type.String(),
+			Height:           contract.Height,
+			Expiration:       contract.Expiration(),
+			Rate:             contract.Rate.String(),
+			Deposit:          cosmos.Coin{Denom: contract.Rate.Denom, Amount: contract.Deposit}.String(),
+			Remaining:        remainingDeposit(contract, nonce+streamQueries, height).String(),
+			Nonce:            nonce,
+			StreamQueries:    streamQueries,
+			QueriesPerMinute: contract.QueriesPerMinute,
+		})
+	}
+	respondWithJSON(w, http.StatusOK, contracts)
+}
+
+// handleAdminClaims list the claims not settled yet with the income they claim
+func (p Proxy) handleAdminClaims(w http.ResponseWriter, r *http.Request) {
+	if _, ok := p.authorizeAdmin(w, r, http.MethodGet); !ok {
+		return
+	}
+	height := p.MemStore.GetHeight()
+	contracts := make(map[uint64]types.Contract)
+	for _, contract := range p.MemStore.List() {
+		contracts[contract.Id] = contract
+	}
+	result := AdminClaims{Claims: []AdminClaim{}, Totals: map[string]string{}}
+	totals := make(map[string]cosmos.Int)
+	for _, claim := range p.ClaimStore.List() {
+		if claim.Claimed || claim.Signature == "" {
+			continue
+		}
+		item := AdminClaim{ContractId: claim.ContractId, Spender: claim.Spender.String(), Nonce: claim.Nonce}
+		if contract, ok := contracts[claim.ContractId]; ok {
+			income := pendingIncome(contract, claim, height)
+			item.Amount = income.String()
+			if total, ok := totals[income.Denom]; ok {
+				totals[income.Denom] = total.Add(income.Amount)
+			} else {
+				totals[income.Denom] = income.Amount
+			}
+		}
+		result.Claims = append(result.Claims, item)
+	}
+	sort.Slice(result.Claims, func(i, j int) bool { return result.Claims[i].ContractId < result.Claims[j].ContractId })
+	for denom, total := range totals {
+		result.Totals[denom] = total.String()
+	}
+	respondWithJSON(w, http.StatusOK, result)
+}
+
+// handleAdminLimits return the limits in effect across the sentinel and for each active contract
+func (p Proxy) handleAdminLimits(w http.ResponseWriter, r *http.Request) {
+	if _, ok := p.authorizeAdmin(w, r, http.MethodGet); !ok {
+		return
+	}
+	config := p.current().Config
+	inFlight := p.InFlight.ContractsInFlight()
+	result := AdminLimits{
+		MaxInFlight:         config.MaxInFlight,
+		InFlight:            p.InFlight.InFlight(),
+		ContractMaxInFlight: config.ContractMaxInFlight,
+		FreeTierRateLimit:   config.FreeTierRateLimit,
+		FreeTierDailyLimit:  config.FreeTierDailyLimit,
+		Contracts:           []AdminContractLimits{},
+	}
+	for _, contract := range p.activeContracts() {
+		result.Contracts = append(result.Contracts, AdminContractLimits{
+			ContractId:       contract.Id,
+			QueriesPerMinute: contract.QueriesPerMinute,
+			Tokens:           p.ContractLimiter.Tokens(contract),
+			InFlight:         inFlight[contract.Id],
+		})
+	}
+	respondWithJSON(w, http.StatusOK, result)
+}
+
+// handleAdminSubmitClaim submit the claim of the contract_id right away, whatever its pending income
+func (p Proxy) handleAdminSubmitClaim(w http.ResponseWriter, r *http.Request) {
+	caller, ok := p.authorizeAdmin(w, r, http.MethodPost)
+	if !ok {
+		return
+	}
+	if p.AutoClaimer == nil {
+		respondWithError(w, "auto claim isn't enabled", http.StatusServiceUnavailable)
+		return
+	}
+	contractId, err := strconv.ParseUint(r.URL.Query().Get("contract_id"), 10, 64)
+	if err != nil {
+		respondWithError(w, "invalid contract_id", http.StatusBadRequest)
+		return
+	}
+	ctx, cancel := context.WithTimeout(r.Context(), defaultAutoClaimInterval)
+	defer cancel()
+	txHash, err := p.AutoClaimer.ClaimContract(ctx, contractId)
+	switch {
+	case errors.Is(err, ErrNothingToClaim), errors.Is(err, ErrClaimOverMaxQueries):
+		respondWithError(w, err.Error(), http.StatusConflict)
+		return
+	case err != nil:
+		respondWithError(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+	p.logger.Info("claim submitted by admin", "caller", caller, "contract_id", contractId, "tx", txHash)
+	respondWithJSON(w, http.StatusOK, AdminClaimSubmission{ContractId: contractId, TxHash: txHash, DryRun: p.Config.AutoClaim.DryRun})
+}
@@ -0,0 +1,178 @@
+package sentinel
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+)
+
+func newAdminTest(t *testing.T) (*Proxy, func(method, path, token string) *httptest.ResponseRecorder) {
+	config := newTestConfig()
+	config.AdminToken = "secret"
+	config.AdminTokens = map[string]string{"ops": "ops-secret"}
+	config.AutoClaim.Threshold = 1000
+	proxy, err := NewProxy(config)
+	require.NoError(t, err)
+	proxy.MemStore.SetHeight(10)
+
+	// the handlers are bound to the proxy as the test left it
+	serve := func(method, path, token string) *httptest.ResponseRecorder {
+		mux := http.NewServeMux()
+		mux.HandleFunc(RoutesAdminContracts, proxy.handleAdminContracts)
+		mux.HandleFunc(RoutesAdminClaims, proxy.handleAdminClaims)
+		mux.HandleFunc(RoutesAdminLimits, proxy.handleAdminLimits)
+		mux.HandleFunc(RoutesAdminSubmit, proxy.handleAdminSubmitClaim)
+		mux.HandleFunc(RoutesAdminExport, proxy.handleAdminExportClaims)
+		req := httptest.NewRequest(method, path, nil)
+		if len(token) > 0 {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, req)
+		return w
+	}
+	return &proxy, serve
+}
+
+func TestAdminAuth(t *testing.T) {
+	proxy, serve := newAdminTest(t)
+	for _, route := range []struct{ method, path string }{
+		{http.MethodGet, RoutesAdminContracts},
+		{http.MethodGet, RoutesAdminClaims},
+		{http.MethodGet, RoutesAdminLimits},
+		{http.MethodPost, RoutesAdminSubmit + "?contract_id=1"},
+		{http.MethodGet, RoutesAdminExport},
+	} {
+		require.Equal(t, http.StatusUnauthorized, serve(route.method, route.path, "").Code, route.path)
+		require.Equal(t, http.StatusUnauthorized, serve(route.method, route.path, "wrong").Code, route.path)
+		other := http.MethodPost
+		if route.method == http.MethodPost {
+			other = http.MethodGet
+		}
+		require.Equal(t, http.StatusMethodNotAllowed, serve(other, route.path, "secret").Code, route.path)
+	}
+	for _, token := range []string{"secret", "ops-secret"} {
+		require.Equal(t, http.StatusOK, serve(http.MethodGet, RoutesAdminContracts, token).Code)
+	}
+
+	// the caller is named after its token
+	req := httptest.NewRequest(http.MethodGet, RoutesAdminContracts, nil)
+	req.Header.Set("Authorization", "Bearer ops-secret")
+	caller, ok := proxy.adminCaller(req)
+	require.True(t, ok)
+	require.Equal(t, "ops", caller)
+}
+
+func TestAdminContracts(t *testing.T) {
+	proxy, serve := newAdminTest(t)
+	active := newWebsocketContract(1, 60, 100)
+	expired := newWebsocketContract(2, 60, 100)
+	expired.Duration = 1
+	proxy.MemStore.Put(active)
+	proxy.MemStore.Put(expired)
+	require.NoError(t, proxy.ClaimStore.Set(NewClaim(active.Id, active.Client, 3, "aabb")))
+	proxy.StreamUsage.Add(active.Id, 2)
+
+	w := serve(http.MethodGet, RoutesAdminContracts, "secret")
+	require.Equal(t, http.StatusOK, w.Code)
+	var contracts []AdminContract
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &contracts))
+	require.Equal(t, []AdminContract{{
+		Id:               active.Id,
+		Service:          active.Service.String(),
+		Client:           active.Client.String(),
+		Type:             "PAY_AS_YOU_GO",
+		Height:           5,
+		Expiration:       105,
+		Rate:             "1uarkeo",
+		Deposit:          "100uarkeo",
+		Remaining:        "95uarkeo",
+		Nonce:            3,
+		StreamQueries:    2,
+		QueriesPerMinute: 60,
+	}}, contracts)
+}
+
+func TestAdminClaims(t *testing.T) {
+	proxy, serve := newAdminTest(t)
+	first := newWebsocketContract(1, 60, 100)
+	second := newWebsocketContract(2, 60, 100)
+	second.Rate.Amount = second.Rate.Amount.MulRaw(2)
+	proxy.MemStore.Put(first)
+	proxy.MemStore.Put(second)
+	require.NoError(t, proxy.ClaimStore.Set(NewClaim(first.Id, first.Client, 3, "aabb")))
+	require.NoError(t, proxy.ClaimStore.Set(NewClaim(second.Id, second.Client, 4, "aabb")))
+	settled := NewClaim(3, first.Client, 10, "aabb")
+	settled.Claimed = true
+	require.NoError(t, proxy.ClaimStore.Set(settled))
+	// the contract isn't cached
+	require.NoError(t, proxy.ClaimStore.Set(NewClaim(9, first.Client, 1, "aabb")))
+
+	w := serve(http.MethodGet, RoutesAdminClaims, "secret")
+	require.Equal(t, http.StatusOK, w.Code)
+	var claims AdminClaims
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &claims))
+	require.Equal(t, AdminClaims{
+		Claims: []AdminClaim{
+			{ContractId: 1, Spender: first.Client.String(), Nonce: 3, Amount: "3uarkeo"},
+			{ContractId: 2, Spender: second.Client.String(), Nonce: 4, Amount: "8uarkeo"},
+			{ContractId: 9, Spender: first.Client.String(), Nonce: 1},
+		},
+		Totals: map[string]string{"uarkeo": "11"},
+	}, claims)
+}
+
+func TestAdminLimits(t *testing.T) {
+	proxy, serve := newAdminTest(t)
+	contract := newWebsocketContract(1, 60, 100)
+	proxy.MemStore.Put(contract)
+	ok, _ := proxy.ContractLimiter.AllowN(contract, 10)
+	require.True(t, ok)
+	require.True(t, proxy.InFlight.AcquireContract(contract.Id, 0))
+	defer proxy.InFlight.ReleaseContract(contract.Id)
+
+	w := serve(http.MethodGet, RoutesAdminLimits, "secret")
+	require.Equal(t, http.StatusOK, w.Code)
+	var limits AdminLimits
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &limits))
+	require.Equal(t, proxy.Config.MaxInFlight, limits.MaxInFlight)
+	require.Equal(t, proxy.Config.FreeTierRateLimit, limits.FreeTierRateLimit)
+	require.Len(t, limits.Contracts, 1)
+	require.Equal(t, contract.Id, limits.Contracts[0].ContractId)
+	require.EqualValues(t, 60, limits.Contracts[0].QueriesPerMinute)
+	require.InDelta(t, 50, limits.Contracts[0].Tokens, 1)
+	require.Equal(t, 1, limits.Contracts[0].InFlight)
+}
+
+func TestAdminSubmitClaim(t *testing.T) {
+	proxy, serve := newAdminTest(t)
+	submit := func(contractId string) *httptest.ResponseRecorder {
+		return serve(http.MethodPost, fmt.Sprintf("%s?contract_id=%s", RoutesAdminSubmit, contractId), "ops-secret")
+	}
+	require.Equal(t, http.StatusServiceUnavailable, submit("1").Code)
+
+	broadcaster := &mockBroadcaster{address: types.GetRandomBech32Addr()}
+	proxy.AutoClaimer = NewAutoClaimer(proxy.Config.AutoClaim, proxy.ClaimStore, proxy.MemStore, broadcaster, log.NewTMLogger(log.NewSyncWriter(os.Stdout)))
+	contract := newWebsocketContract(1, 60, 100)
+	proxy.MemStore.Put(contract)
+	require.Equal(t, http.StatusBadRequest, submit("one").Code)
+	require.Equal(t, http.StatusConflict, submit("1").Code)
+
+	// the pending income is below the auto claim threshold
+	require.NoError(t, proxy.ClaimStore.Set(NewClaim(contract.Id, contract.Client, 3, "aabb")))
+	w := submit("1")
+	require.Equal(t, http.StatusOK, w.Code)
+	var submission AdminClaimSubmission
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &submission))
+	require.Equal(t, AdminClaimSubmission{ContractId: contract.Id, TxHash: "TX1"}, submission)
+	require.Len(t, broadcaster.msgs, 1)
+	require.EqualValues(t, 3, broadcaster.msgs[0].Nonce)
+}
@@ -1,8 +1,13 @@
 package sentinel
 
 import (
+	"bytes"
+	"crypto/sha256"
 	"encoding/hex"
+	"errors"
 	"fmt"
+	"hash"
+	"io"
 	"net/http"
 	"strconv"
 	"strings"
@@ -20,6 +25,7 @@ const (
 	QueryArkAuth  = "arkauth"
 	QueryContract = "arkcontract"
 	ServiceHeader = "arkservice"
+	CostHeader    = "arkcost" // queries a paid request was charged
 )
 
 // Create a map to hold the rate limiters for each visitor and a mutex.
@@ -28,6 +34,79 @@ var (
 	mu       sync.Mutex
 )
 
+// contractRateLimitError is returned when a contract exceeds its queries per minute
+type contractRateLimitError struct {
+	contract   types.Contract
+	retryAfter time.Duration
+}
+
+func (e *contractRateLimitError) Error() string {
+	return fmt.Sprintf("contract %d exceeded its rate limit of %d queries per minute", e.contract.Id, e.contract.QueriesPerMinute)
+}
+
+const (
+	AuthErrorInvalid  = "invalid_arkauth"             // the arkauth can't be parsed
+	AuthErrorReplayed = "replayed_nonce"              // the arkauth nonce was already used
+	AuthErrorCost     = "nonce_below_cost"            // the arkauth nonce doesn't advance by the queries the request costs
+	AuthErrorOverMax  = "nonce_over_max_queries"      // the arkauth nonce is over the queries the contract allows
+	AuthErrorVersion  = "unsupported_arkauth_version" // the arkauth version is older than the sentinel accepts
+	AuthErrorBodyHash = "body_hash_mismatch"          // the request body isn't the one the v2 arkauth signed
+)
+
+const (
+	// ArkAuthVersion1 sign the contract and nonce only, the signature is the one claimed on chain
+	ArkAuthVersion1 = 1
+	// ArkAuthVersion2 add a signature of the request method and body hash, so the request can't be altered on its way
+	ArkAuthVersion2 = 2
+
+	arkAuthV2Prefix = "v2:"
+)
+
+// emptyBodyHash is the sha256 of an empty body, signed by the v2 arkauth of the requests without a body
+var emptyBodyHash = sha256.Sum256(nil)
+
+var errBodyHashMismatch = errors.New("request body doesn't match the body hash of the arkauth")
+
+// AuthError is the body of the response to a request refused for its arkauth
+type AuthError struct {
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+// nonceReplayError is returned when the arkauth nonce isn't above the highest one the contract already used, either
+// claimed on chain or kept in the claim store
+type nonceReplayError struct {
+	nonce     int64
+	highWater int64
+}
+
+func (e *nonceReplayError) Error() string {
+	return fmt.Sprintf("bad nonce (%d/%d)", e.nonce, e.highWater)
+}
+
+// nonceCostError is returned when the arkauth nonce doesn't pay for a request costing several queries, the nonce has to
+// advance by the cost of the request since the claim is for the nonce
+type nonceCostError struct {
+	nonce     int64
+	highWater int64
+	cost      int64
+}
+
+func (e *nonceCostError) Error() string {
+	return fmt.Sprintf("nonce %d must be at least %d, the request costs %d queries", e.nonce, e.highWater+e.cost, e.cost)
+}
+
+// nonceMaxQueriesError is returned when the arkauth nonce is over the queries the contract allows, a claim for it would
+// get the provider slashed on chain
+type nonceMaxQueriesError struct {
+	nonce      int64
+	maxQueries int64
+}
+
+func (e *nonceMaxQueriesError) Error() string {
+	return fmt.Sprintf("nonce %d is over the contract max queries (%d)", e.nonce, e.maxQueries)
+}
+
 type ContractAuth struct {
 	ContractId uint64
 	Timestamp  int64
@@ -35,14 +114,21 @@ type ContractAuth struct {
 }
 
 type ArkAuth struct {
+	Version    int
 	ContractId uint64
 	Spender    common.PubKey
 	Nonce      int64
-	Signature  []byte
+	Signature  []byte // signature of the contract and nonce, submitted with the claim
+	// v2 only, the sha256 of the request body and the signature of the request method and body hash
+	BodyHash         []byte
+	RequestSignature []byte
 }
 
 // String implement fmt.Stringer
 func (aa ArkAuth) String() string {
+	if aa.Version >= ArkAuthVersion2 {
+		return GenerateArkAuthV2String(aa.ContractId, aa.Nonce, aa.BodyHash, aa.Signature, aa.RequestSignature)
+	}
 	return GenerateArkAuthString(aa.ContractId, aa.Nonce, aa.Signature)
 }
 
@@ -50,10 +136,28 @@ func GenerateArkAuthString(contractId uint64, nonce int64, signature []byte) str
 	return fmt.Sprintf("%s:%s", GenerateMessageToSign(contractId, nonce), hex.EncodeToString(signature))
 }
 
+// GenerateArkAuthV2String return a v2 arkauth, v2:contract:nonce:body hash:signature:request signature
+func GenerateArkAuthV2String(contractId uint64, nonce int64, bodyHash, signature, requestSignature []byte) string {
+	return fmt.Sprintf("%s%s:%s:%s:%s", arkAuthV2Prefix, GenerateMessageToSign(contractId, nonce),
+		hex.EncodeToString(bodyHash), hex.EncodeToString(signature), hex.EncodeToString(requestSignature))
+}
+
 func GenerateMessageToSign(contractId uint64, nonce int64) string {
 	return fmt.Sprintf("%d:%d", contractId, nonce)
 }
 
+// GenerateRequestMessageToSign return the message the request signature of a v2 arkauth signs, the chain only knows
+// of the contract and nonce so it comes on top of the signature claimed
+func GenerateRequestMessageToSign(contractId uint64, nonce int64, method string, bodyHash []byte) string {
+	return fmt.Sprintf("%s:%s:%s", GenerateMessageToSign(contractId, nonce), strings.ToUpper(method), hex.EncodeToString(bodyHash))
+}
+
+// HashRequestBody return the body hash a v2 arkauth signs, the hash of an empty body for the requests without one
+func HashRequestBody(body []byte) []byte {
+	hash := sha256.Sum256(body)
+	return hash[:]
+}
+
 func parseContractAuth(raw string) (ContractAuth, error) {
 	var auth ContractAuth
 	var err error
@@ -67,7 +171,7 @@ func parseContractAuth(raw string) (ContractAuth, error) {
 		}
 	}
 
-	if len(parts) > 0 {
+	if len(parts) > 1 {
 		auth.Timestamp, err = strconv.ParseInt(parts[1], 10, 64)
 		if err != nil {
 			return auth, err
@@ -84,7 +188,10 @@ func parseContractAuth(raw string) (ContractAuth, error) {
 }
 
 func parseArkAuth(raw string) (ArkAuth, error) {
-	var aa ArkAuth
+	if strings.HasPrefix(raw, arkAuthV2Prefix) {
+		return parseArkAuthV2(strings.TrimPrefix(raw, arkAuthV2Prefix))
+	}
+	aa := ArkAuth{Version: ArkAuthVersion1}
 	var err error
 
 	parts := strings.SplitN(raw, ":", 3)
@@ -112,6 +219,34 @@ func parseArkAuth(raw string) (ArkAuth, error) {
 	return aa, nil
 }
 
+func parseArkAuthV2(raw string) (ArkAuth, error) {
+	aa := ArkAuth{Version: ArkAuthVersion2}
+	parts := strings.Split(raw, ":")
+	if len(parts) != 5 {
+		return aa, fmt.Errorf("v2 arkauth must be v2:contract:nonce:body hash:signature:request signature")
+	}
+	var err error
+	if aa.ContractId, err = strconv.ParseUint(parts[0], 10, 64); err != nil {
+		return aa, err
+	}
+	if aa.Nonce, err = strconv.ParseInt(parts[1], 10, 64); err != nil {
+		return aa, err
+	}
+	if aa.BodyHash, err = hex.DecodeString(parts[2]); err != nil {
+		return aa, err
+	}
+	if len(aa.BodyHash) != sha256.Size {
+		return aa, fmt.Errorf("body hash must be a hex sha256")
+	}
+	if aa.Signature, err = hex.DecodeString(parts[3]); err != nil {
+		return aa, err
+	}
+	if aa.RequestSignature, err = hex.DecodeString(parts[4]); err != nil {
+		return aa, err
+	}
+	return aa, nil
+}
+
 func (aa ArkAuth) Validate(provider common.PubKey) error {
 	creator, err := provider.GetMyAddress()
 	if err != nil {
@@ -169,26 +304,24 @@ func (p Proxy) fetchArkAuth(r *http.Request) (aa ArkAuth, err error) {
 
 func (p Proxy) auth(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		// since OPTIONS requests do not include query args, we cannot know
-		// which contract this query is intended for and therefore cannot know
-		// what the appropriate CORS is for this query. So, allow open CORS for
-		// an OPTIONS query to support CORS and the GET/POST request to apply
-		// actual contract specific CORs
-		if r.Method == http.MethodOptions {
-			// Set the necessary headers as per your server's requirement
-			w.Header().Set("Access-Control-Allow-Origin", "*")
-			w.Header().Set("Access-Control-Allow-Methods", "POST, GET, OPTIONS, PUT, DELETE")
-			w.Header().Set("Access-Control-Allow-Headers", "Accept, Content-Type, Content-Length, Accept-Encoding, X-CSRF-Token, Authorization")
-			w.Header().Set("Access-Control-Max-Age", "3600")
-			// Finally, send a 200 status code for the OPTIONS request
-			w.WriteHeader(http.StatusOK)
+		if service := requestService(r); p.isFreePath(r, service) {
+			p.serveFreePath(w, r, next, service)
+			return
+		}
+		if p.serveFreeCacheHit(w, r) {
 			return
 		}
-
 		aa, err := p.fetchArkAuth(r)
 		if err != nil {
 			p.logger.Error("failed to parse ark auth", "error", err)
-			http.Error(w, err.Error(), http.StatusBadRequest)
+			respondWithJSON(w, http.StatusBadRequest, AuthError{Error: err.Error(), Code: AuthErrorInvalid})
+			return
+		}
+		if aa.ContractId > 0 && aa.Version < p.Config.ArkAuthMinVersion {
+			respondWithJSON(w, http.StatusBadRequest, AuthError{
+				Error: fmt.Sprintf("arkauth v%d is no longer accepted, the oldest version accepted is v%d", aa.Version, p.Config.ArkAuthMinVersion),
+				Code:  AuthErrorVersion,
+			})
 			return
 		}
 		remoteAddr := p.getRemoteAddr(r)
@@ -205,7 +338,7 @@ func (p Proxy) auth(next http.Handler) http.Handler {
 			if err != nil {
 				p.logger.Error("failed to fetch contract configuration", "error", err)
 			}
-			w = p.enableCORS(w, conf.CORs)
+			w = p.enableCORS(w, r, conf.CORs)
 
 			// enfore IP Whitelist
 			if len(conf.WhitelistIPAddresses) > 0 {
@@ -230,26 +363,121 @@ func (p Proxy) auth(next http.Handler) http.Handler {
 			}
 		}
 
-		if err == nil && (contract.IsOpenAuthorization() || aa.Validate(p.Config.ProviderPubKey) == nil) {
+		// a contract is only paid for by the provider identity it was opened with, for a service served for it
+		_, served := p.providerOf(contract)
+		if err == nil && served && (contract.IsOpenAuthorization() || (p.verifyArkAuth(aa, contract) == nil && p.verifyRequestSignature(aa, contract, r.Method) == nil)) {
 			p.logger.Info("serving paid requests", "remote-addr", remoteAddr)
 			w.Header().Set("tier", "paid")
 
-			// ensure service of the contract matches first item in the path
-			serviceName := r.Header.Get(ServiceHeader)
-			if len(serviceName) == 0 {
-				parts := strings.Split(r.URL.Path, "/")
-				serviceName = parts[1]
-			}
-			ser, err := common.NewService(serviceName)
+			// ensure service of the contract matches first item in the path, a contract only grant access to the
+			// service it was opened for
+			ser, err := common.NewService(requestService(r))
 			if err != nil || ser != contract.Service {
 				http.Error(w, fmt.Sprintf("contract service doesn't match the service name in the path: (%d/%d)", ser, contract.Service), http.StatusUnauthorized)
 				return
 			}
 
-			httpCode, err := p.paidTier(aa, remoteAddr)
+			if rejected := p.ClientAccess.Check(contract.Client, contract.Delegate); rejected != nil {
+				p.logger.Info("refusing client", "reason", rejected.Reason, "pubkey", rejected.PubKey, "contract_id", contract.Id)
+				respondWithClientRejected(w, rejected)
+				return
+			}
+
+			// the body signed by a v2 arkauth is hashed as it is read, a body read to cost the request is checked
+			// before it is charged
+			if aa.Version >= ArkAuthVersion2 && !contract.IsOpenAuthorization() {
+				if err := withBodyHash(r, aa.BodyHash); err != nil {
+					respondWithJSON(w, http.StatusBadRequest, AuthError{Error: err.Error(), Code: AuthErrorBodyHash})
+					return
+				}
+			}
+			cost, err := p.requestCost(r, requestService(r))
+			if errors.Is(err, errBodyHashMismatch) {
+				respondWithJSON(w, http.StatusBadRequest, AuthError{Error: errBodyHashMismatch.Error(), Code: AuthErrorBodyHash})
+				return
+			}
+			var batchErr *batchTooLargeError
+			if errors.As(err, &batchErr) {
+				respondWithBatchTooLarge(w, batchErr)
+				return
+			}
+			if err != nil {
+				respondWithError(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			// a contract over its in flight requests is refused before it is charged
+			if !p.InFlight.AcquireContract(contract.Id, p.Config.ContractMaxInFlight) {
+				p.Metrics.inFlightRejected(InFlightLimitContract)
+				respondWithInFlightExceeded(w, InFlightLimitContract, p.Config.ContractMaxInFlight, contract.Id)
+				return
+			}
+			defer p.InFlight.ReleaseContract(contract.Id)
+			httpCode, limit, err := p.paidTier(aa, remoteAddr, cost)
+			if limit.Limit > 0 {
+				setRateLimitHeaders(w, limit.Limit, limit.Remaining, limit.Reset)
+			}
 			// paidTier can serve the request
 			if err == nil {
-				next.ServeHTTP(w, r)
+				// within the grace blocks the response is flagged, and free once the settlement wouldn't pay for it
+				if grace, charged, _ := p.contractGrace(contract, p.MemStore.GetHeight()); grace {
+					setContractGrace(w, contract)
+					if !charged {
+						cost = 0
+					}
+				}
+				p.Metrics.contractRequest(contract.Id)
+				p.Metrics.arkAuthRequest(aa.Version)
+				p.QueryUsage.Charge(contract.Id, cost)
+				accessRecordFrom(r).paid(contract, aa.Nonce, cost)
+				w.Header().Set(CostHeader, strconv.FormatInt(cost, 10))
+				next.ServeHTTP(w, withContract(r, contract))
+				return
+			}
+			// a paying client over its contract's limit doesn't fall back to the free tier, nor a replayed request
+			var limitErr *contractRateLimitError
+			if errors.As(err, &limitErr) {
+				respondWithRateLimitExceeded(w, limitErr.contract, limitErr.retryAfter)
+				return
+			}
+			var replayErr *nonceReplayError
+			if errors.As(err, &replayErr) {
+				p.logger.Info("refusing replayed nonce", "contract_id", contract.Id, "nonce", replayErr.nonce, "high_water", replayErr.highWater)
+				respondWithJSON(w, httpCode, AuthError{Error: err.Error(), Code: AuthErrorReplayed})
+				return
+			}
+			var costErr *nonceCostError
+			if errors.As(err, &costErr) {
+				respondWithJSON(w, httpCode, AuthError{Error: err.Error(), Code: AuthErrorCost})
+				return
+			}
+			var maxErr *nonceMaxQueriesError
+			if errors.As(err, &maxErr) {
+				respondWithJSON(w, httpCode, AuthError{Error: err.Error(), Code: AuthErrorOverMax})
+				return
+			}
+			var expiredErr *contractExpiredError
+			if errors.As(err, &expiredErr) {
+				respondWithContractExpired(w, expiredErr)
+				return
+			}
+			var pendingErr *contractPendingError
+			if errors.As(err, &pendingErr) {
+				respondWithContractPending(w, pendingErr)
+				return
+			}
+			var denomErr *denomRejectedError
+			if errors.As(err, &denomErr) {
+				respondWithError(w, err.Error(), httpCode)
+				return
+			}
+			var depositErr *minDepositError
+			if errors.As(err, &depositErr) {
+				respondWithMinDepositRequired(w, depositErr)
+				return
+			}
+			var spentErr *contractSpentError
+			if errors.As(err, &spentErr) {
+				respondWithContractSpent(w, spentErr)
 				return
 			}
 			p.logger.Error("failed to serve paid tier request", "error", err, "http_code", httpCode)
@@ -257,35 +485,147 @@ func (p Proxy) auth(next http.Handler) http.Handler {
 
 		p.logger.Info("serving free tier requests", "remote-addr", remoteAddr)
 		w.Header().Set("tier", "free")
-		httpCode, err := p.freeTier(remoteAddr)
+		pubkey := p.fetchClientPubKey(r)
+		if rejected := p.ClientAccess.CheckFreeTier(pubkey); rejected != nil {
+			p.logger.Info("refusing free tier client", "reason", rejected.Reason, "pubkey", rejected.PubKey, "remote-addr", remoteAddr)
+			respondWithClientRejected(w, rejected)
+			return
+		}
+		// the calls of a JSON-RPC batch are counted against the allowance one by one
+		queries := int64(1)
+		if p.Config.Services[requestService(r)].JSONRPC {
+			cost, err := p.requestCost(r, requestService(r))
+			var batchErr *batchTooLargeError
+			if errors.As(err, &batchErr) {
+				respondWithBatchTooLarge(w, batchErr)
+				return
+			}
+			if err != nil {
+				respondWithError(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			queries = cost
+		}
+		httpCode, err := p.freeTier(w, requestService(r), remoteAddr, pubkey, queries)
 		if err != nil {
 			p.logger.Error("failed to serve free tier request", "error", err)
+			p.Metrics.freeTierRejected()
 			http.Error(w, err.Error(), httpCode)
 			return
 		}
+		accessRecordFrom(r).free(pubkey, queries)
 		next.ServeHTTP(w, r)
 	})
 }
 
+// withBodyHash check the request body against the hash a v2 arkauth signed. A request without a body is checked at
+// once, otherwise the body is hashed as it is streamed to the upstream and its last read fails on a mismatch, so the
+// upstream never gets the whole of an altered body
+func withBodyHash(r *http.Request, expected []byte) error {
+	if r.Body == nil || r.Body == http.NoBody || r.ContentLength == 0 {
+		if !bytes.Equal(expected, emptyBodyHash[:]) {
+			return errBodyHashMismatch
+		}
+		return nil
+	}
+	r.Body = &bodyHashReader{ReadCloser: r.Body, hash: sha256.New(), expected: expected, length: r.ContentLength}
+	return nil
+}
+
+// bodyHashReader hash a request body as it is read. Its end, at EOF or once its length is read, is only reported when
+// it matches the hash expected, the last bytes read are held back otherwise
+type bodyHashReader struct {
+	io.ReadCloser
+	hash     hash.Hash
+	expected []byte
+	length   int64 // -1 when unknown
+	read     int64
+}
+
+func (b *bodyHashReader) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	b.hash.Write(p[:n])
+	b.read += int64(n)
+	end := errors.Is(err, io.EOF) || (b.length >= 0 && b.read >= b.length)
+	if end && !bytes.Equal(b.hash.Sum(nil), b.expected) {
+		return 0, errBodyHashMismatch
+	}
+	return n, err
+}
+
+// verifyRequestSignature check the request signature of a v2 arkauth was made by the spender of the contract over the
+// request method and body hash, the body itself is checked against the hash as it is read
+func (p Proxy) verifyRequestSignature(aa ArkAuth, contract types.Contract, method string) error {
+	if aa.Version < ArkAuthVersion2 {
+		return nil
+	}
+	msg := []byte(GenerateRequestMessageToSign(aa.ContractId, aa.Nonce, method, aa.BodyHash))
+	pk, err := cosmos.GetPubKeyFromBech32(cosmos.Bech32PubKeyTypeAccPub, contract.GetSpender().String())
+	if err == nil && pk.VerifySignature(msg, aa.RequestSignature) {
+		return nil
+	}
+	if p.acceptsDevTestKey(contract) && DevTestPrivKey().PubKey().VerifySignature(msg, aa.RequestSignature) {
+		return nil
+	}
+	return fmt.Errorf("invalid request signature")
+}
+
 const (
 	forwardHeaderName = `X-Forwarded-For`
 	xRealIPName       = `X-Real-Ip`
 )
 
+// getRemoteAddr return the address of the client. The forwarding headers are only trusted when the request comes from
+// a trusted proxy: X-Real-Ip, otherwise the closest X-Forwarded-For hop that isn't a trusted proxy
 func (p Proxy) getRemoteAddr(r *http.Request) string {
-	realIP := r.Header.Get(xRealIPName)
-	if realIP != "" {
+	if !p.isTrustedProxy(clientIP(r.RemoteAddr)) {
+		return r.RemoteAddr
+	}
+	if realIP := r.Header.Get(xRealIPName); realIP != "" {
 		return realIP
 	}
-	forwardIP := r.Header.Get(forwardHeaderName)
-	if forwardIP != "" {
-		return forwardIP
+	hops := forwardedFor(r.Header)
+	for i := len(hops) - 1; i >= 0; i-- {
+		if i == 0 || !p.isTrustedProxy(hops[i]) {
+			return hops[i]
+		}
 	}
 	return r.RemoteAddr
 }
 
-func (p Proxy) freeTier(remoteAddr string) (int, error) {
-	if ok := p.isRateLimited(0, remoteAddr, p.Config.FreeTierRateLimit); ok {
+// fetchClientPubKey return the client pubkey optionally sent along a free tier request, empty when missing or invalid
+func (p Proxy) fetchClientPubKey(r *http.Request) string {
+	raw := r.Header.Get(QueryClientPubKey)
+	if len(raw) == 0 {
+		raw = r.URL.Query().Get(QueryClientPubKey)
+	}
+	if len(raw) == 0 {
+		return ""
+	}
+	pk, err := common.NewPubKey(raw)
+	if err != nil {
+		p.logger.Debug("ignoring invalid client pubkey", "pubkey", raw)
+		return ""
+	}
+	return pk.String()
+}
+
+// freeTier charge the queries of the request to the free tier allowance of the client ip and pubkey for the service,
+// the remaining allowance is returned in the response headers
+func (p Proxy) freeTier(w http.ResponseWriter, service, remoteAddr, pubkey string, queries int64) (int, error) {
+	ip := clientIP(remoteAddr)
+	limiter, limit := p.freeTierFor(service)
+	if limiter.IsAllowListed(ip) {
+		return http.StatusOK, nil
+	}
+	// a free tier without a per minute allowance is closed
+	if limit <= 0 {
+		return http.StatusTooManyRequests, fmt.Errorf("client is rate limited %s", http.StatusText(429))
+	}
+	ok, allowances := limiter.AllowN(ip, pubkey, int(queries))
+	setFreeTierHeaders(w, allowances)
+	if !ok {
+		setRetryAfter(w, freeTierRetryAfter(allowances, limiter.now()))
 		return http.StatusTooManyRequests, fmt.Errorf("client is rate limited %s", http.StatusText(429))
 	}
 
@@ -306,61 +646,93 @@ func (p Proxy) isRateLimited(contractId uint64, key string, limitTokens int) boo
 	return !limiter.Allow()
 }
 
-func (p Proxy) paidTier(aa ArkAuth, remoteAddr string) (code int, err error) {
+// paidTier charge a request costing cost queries to the contract of the arkauth, its nonce must advance by the cost.
+// The allowance left in the contract's rate limit is returned once the request reached it
+func (p Proxy) paidTier(aa ArkAuth, remoteAddr string, cost int64) (code int, limit RateLimitState, err error) {
 	key := strconv.FormatUint(aa.ContractId, 10)
 	contract, err := p.MemStore.Get(key)
 	if err != nil {
-		return http.StatusInternalServerError, fmt.Errorf("internal server error: %w", err)
+		return http.StatusInternalServerError, limit, fmt.Errorf("internal server error: %w", err)
 	}
 
-	if contract.IsExpired(p.MemStore.GetHeight()) {
-		return http.StatusPaymentRequired, fmt.Errorf("open a contract")
+	if contract.IsEmpty() {
+		return http.StatusPaymentRequired, limit, fmt.Errorf("open a contract")
+	}
+	grace, charged, err := p.contractGrace(contract, p.MemStore.GetHeight())
+	if err != nil {
+		return http.StatusPaymentRequired, limit, err
+	}
+	if !p.acceptsDenom(contract.Rate.Denom) {
+		return http.StatusPaymentRequired, limit, &denomRejectedError{denom: contract.Rate.Denom}
 	}
 
+	// the nonce must be above the highest one used, as kept in the claim store across restarts, or claimed on chain
+	// when the store lost it
 	sig := hex.EncodeToString(aa.Signature)
 	claim := NewClaim(aa.ContractId, aa.Spender, aa.Nonce, sig)
+	highWater := contract.Nonce
 	if p.ClaimStore.Has(key) {
 		var err error
 		claim, err = p.ClaimStore.Get(key)
 		if err != nil {
-			return http.StatusInternalServerError, fmt.Errorf("internal server error: %w", err)
+			return http.StatusInternalServerError, limit, fmt.Errorf("internal server error: %w", err)
 		}
-		if claim.Nonce >= aa.Nonce {
-			return http.StatusBadRequest, fmt.Errorf("bad nonce (%d/%d)", aa.Nonce, claim.Nonce)
+		if claim.Nonce > highWater {
+			highWater = claim.Nonce
 		}
 	}
+	if aa.Nonce <= highWater {
+		return http.StatusBadRequest, limit, &nonceReplayError{nonce: aa.Nonce, highWater: highWater}
+	}
+	if aa.Nonce-highWater < cost {
+		return http.StatusBadRequest, limit, &nonceCostError{nonce: aa.Nonce, highWater: highWater, cost: cost}
+	}
+	// the chain slashes the provider claiming a nonce over what the contract allows, whoever signed it, so such a
+	// nonce is never stored to be claimed
+	if maxQueries := contract.MaxQueries(); maxQueries > 0 && aa.Nonce > maxQueries {
+		return http.StatusBadRequest, limit, &nonceMaxQueriesError{nonce: aa.Nonce, maxQueries: maxQueries}
+	}
+	// the deposit left shrinks as the queries served are claimed
+	consumed := highWater + p.StreamUsage.Get(contract.Id)
+	if err := p.checkMinDeposit(contract, consumed); charged && err != nil {
+		return http.StatusPaymentRequired, limit, err
+	}
 
-	// check if we've exceed the total number of pay-as-you-go queries
-	if contract.IsPayAsYouGo() {
-		if contract.Deposit.IsNil() || contract.Deposit.LT(cosmos.NewInt(aa.Nonce*contract.Rate.Amount.Int64())) {
-			return http.StatusPaymentRequired, fmt.Errorf("contract spent")
+	// the queries up to the nonce, and those charged on top of it, must be covered by the deposit
+	if charged {
+		if err := p.checkDeposit(contract, consumed, aa.Nonce-highWater); err != nil {
+			return http.StatusPaymentRequired, limit, err
 		}
 	}
 
-	if ok := p.isRateLimited(contract.Id, key, int(contract.QueriesPerMinute)); ok {
-		return http.StatusTooManyRequests, fmt.Errorf("client is rate limited %s", http.StatusText(429))
+	if limit = p.ContractLimiter.Take(contract, cost); !limit.Allowed {
+		return http.StatusTooManyRequests, limit, &contractRateLimitError{contract: contract, retryAfter: limit.RetryAfter}
+	}
+	// past its expiration the queries the settlement won't pay for aren't claimed
+	if !charged {
+		return http.StatusOK, limit, nil
 	}
 
 	claim.Nonce = aa.Nonce
 	claim.Signature = sig
 	claim.Claimed = false
 	if err := p.ClaimStore.Set(claim); err != nil {
-		return http.StatusInternalServerError, fmt.Errorf("internal server error: %w", err)
+		return http.StatusInternalServerError, limit, fmt.Errorf("internal server error: %w", err)
 	}
-	contract.Nonce = aa.Nonce
-	p.MemStore.Put(contract)
-	return http.StatusOK, nil
+	// an expired contract would be dropped from the cache, it is still served for the grace blocks
+	if !grace {
+		contract.Nonce = aa.Nonce
+		p.MemStore.Put(contract)
+	}
+	return http.StatusOK, limit, nil
 }
 
-func (p Proxy) enableCORS(w http.ResponseWriter, cors CORs) http.ResponseWriter {
-	if len(cors.AllowOrigins) > 0 {
-		w.Header().Set("Access-Control-Allow-Origin", strings.Join(cors.AllowOrigins, ", "))
-	}
-	if len(cors.AllowMethods) > 0 {
-		w.Header().Set("Access-Control-Allow-Methods", strings.Join(cors.AllowMethods, ", "))
-	}
-	if len(cors.AllowHeaders) > 0 {
-		w.Header().Set("Access-Control-Allow-Headers", strings.Join(cors.AllowHeaders, ", "))
+// enableCORS narrow the CORS headers of a paid response to the origins the contract's client allows, the preflights
+// can't tell the contract so they are answered with the sentinel's origins
+func (p Proxy) enableCORS(w http.ResponseWriter, r *http.Request, cors CORs) http.ResponseWriter {
+	if len(cors.AllowOrigins) > 0 && len(allowedOrigin(cors.AllowOrigins, r.Header.Get("Origin"))) == 0 {
+		w.Header().Del("Access-Control-Allow-Origin")
+		w.Header().Del("Access-Control-Expose-Headers")
 	}
 	return w
 }
@@ -1,13 +1,21 @@
 package sentinel
 
 import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
+	"net/http/httptest"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
 	"github.com/arkeonetwork/arkeo/common"
+	"github.com/arkeonetwork/arkeo/common/cosmos"
 	"github.com/arkeonetwork/arkeo/sentinel/conf"
 	"github.com/arkeonetwork/arkeo/x/arkeo/types"
 
@@ -18,6 +26,7 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
 	cKeys "github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/cosmos/cosmos-sdk/std"
 	ctypes "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
@@ -69,11 +78,11 @@ func TestFreeTier(t *testing.T) {
 
 	remoteAddr := "127.0.0.1:8000"
 
-	code, err := proxy.freeTier(remoteAddr)
+	code, err := proxy.freeTier(httptest.NewRecorder(), common.BTCService.String(), remoteAddr, "", 1)
 	require.NoError(t, err)
 	require.Equal(t, code, http.StatusOK)
 
-	code, err = proxy.freeTier(remoteAddr)
+	code, err = proxy.freeTier(httptest.NewRecorder(), common.BTCService.String(), remoteAddr, "", 1)
 	require.Error(t, err)
 	require.Equal(t, code, http.StatusTooManyRequests)
 }
@@ -126,7 +135,7 @@ func TestPaidTier(t *testing.T) {
 		Spender:    pk,
 		Signature:  signature,
 	}
-	code, err := proxy.paidTier(aa, "127.0.0.1:8080")
+	code, _, err := proxy.paidTier(aa, "127.0.0.1:8080", 1)
 	require.NoError(t, err)
 	require.Equal(t, code, http.StatusOK)
 	contract, err = proxy.MemStore.Get(contract.Key())
@@ -137,13 +146,225 @@ func TestPaidTier(t *testing.T) {
 	require.Equal(t, claim.Nonce, int64(3))
 
 	// insure that same noonce is rejected.
-	code, err = proxy.paidTier(aa, "127.0.0.1:8080")
+	code, _, err = proxy.paidTier(aa, "127.0.0.1:8080", 1)
 	require.Error(t, err)
 	require.Equal(t, code, http.StatusBadRequest)
 
 	// rate limited after increasing nonce
 	aa.Nonce++
-	code, err = proxy.paidTier(aa, "127.0.0.1:8080")
+	code, _, err = proxy.paidTier(aa, "127.0.0.1:8080", 1)
 	require.Error(t, err)
 	require.Equal(t, code, http.StatusTooManyRequests)
 }
+
+func TestPaidTierNonceOverMaxQueries(t *testing.T) {
+	pubkey := types.GetRandomPubKey()
+	proxy, err := NewProxy(conf.Configuration{ProviderPubKey: pubkey})
+	require.NoError(t, err)
+
+	contract := types.NewContract(pubkey, common.BTCService, types.GetRandomPubKey())
+	contract.Type = types.ContractType_SUBSCRIPTION
+	contract.Height = 5
+	contract.Duration = 100
+	contract.Id = 546
+	contract.QueriesPerMinute = 10
+	proxy.MemStore.SetHeight(10)
+	proxy.MemStore.Put(contract)
+
+	// a client signing a nonce over the max queries would get the provider slashed for claiming it
+	aa := ArkAuth{ContractId: contract.Id, Nonce: contract.MaxQueries() + 1, Spender: contract.Client, Signature: []byte{0xaa}}
+	code, _, err := proxy.paidTier(aa, "127.0.0.1:8080", 1)
+	var maxErr *nonceMaxQueriesError
+	require.ErrorAs(t, err, &maxErr)
+	require.Equal(t, http.StatusBadRequest, code)
+	require.False(t, proxy.ClaimStore.Has(contract.Key()))
+
+	// the max queries itself is served and claimed
+	aa.Nonce = contract.MaxQueries()
+	code, _, err = proxy.paidTier(aa, "127.0.0.1:8080", 1)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, code)
+	claim, err := proxy.ClaimStore.Get(contract.Key())
+	require.NoError(t, err)
+	require.Equal(t, contract.MaxQueries(), claim.Nonce)
+}
+
+func TestNonceReplayAfterRestart(t *testing.T) {
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("{}"))
+	}))
+	defer upstream.Close()
+
+	for _, storeType := range []string{ClaimStoreTypeLevelDB, ClaimStoreTypeBolt} {
+		t.Run(storeType, func(t *testing.T) {
+			config := newTestConfig()
+			config.ClaimStoreType = storeType
+			config.ClaimStoreLocation = filepath.Join(t.TempDir(), "claims")
+			contract := newWebsocketContract(1, 100, 100)
+
+			serve := func(proxy Proxy, auth string) (int, AuthError) {
+				proxy.proxies[common.BTCService.String()] = common.MustParseURL(upstream.URL)
+				w := httptest.NewRecorder()
+				path := fmt.Sprintf("/%s?%s=%s", common.BTCService, QueryArkAuth, auth)
+				proxy.getRouter().ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+				var authErr AuthError
+				if w.Code == http.StatusBadRequest {
+					require.NoError(t, json.Unmarshal(w.Body.Bytes(), &authErr))
+				}
+				return w.Code, authErr
+			}
+			start := func(contract types.Contract) Proxy {
+				proxy, err := NewProxy(config)
+				require.NoError(t, err)
+				proxy.MemStore.SetHeight(10)
+				proxy.MemStore.Put(contract)
+				return proxy
+			}
+
+			proxy := start(contract)
+			code, _ := serve(proxy, fmt.Sprintf("%d:2", contract.Id))
+			require.Equal(t, http.StatusOK, code)
+			require.NoError(t, proxy.ClaimStore.Close())
+
+			// the contract is fetched from the chain again after the restart, without the nonce served since
+			proxy = start(contract)
+			defer proxy.ClaimStore.Close()
+			for _, nonce := range []int{1, 2} {
+				code, authErr := serve(proxy, fmt.Sprintf("%d:%d", contract.Id, nonce))
+				require.Equal(t, http.StatusBadRequest, code)
+				require.Equal(t, AuthErrorReplayed, authErr.Code)
+			}
+			code, _ = serve(proxy, fmt.Sprintf("%d:3", contract.Id))
+			require.Equal(t, http.StatusOK, code)
+
+			code, authErr := serve(proxy, "not-an-arkauth")
+			require.Equal(t, http.StatusBadRequest, code)
+			require.Equal(t, AuthErrorInvalid, authErr.Code)
+		})
+	}
+
+	// without the claim store, the nonce claimed on chain is the high-water mark
+	config := newTestConfig()
+	proxy, err := NewProxy(config)
+	require.NoError(t, err)
+	proxy.proxies[common.BTCService.String()] = common.MustParseURL(upstream.URL)
+	proxy.MemStore.SetHeight(10)
+	contract := newWebsocketContract(2, 100, 100)
+	contract.Nonce = 5
+	proxy.MemStore.Put(contract)
+	code, _, err := proxy.paidTier(ArkAuth{ContractId: contract.Id, Nonce: 5, Spender: contract.Client}, "127.0.0.1:8080", 1)
+	require.Equal(t, http.StatusBadRequest, code)
+	var replayErr *nonceReplayError
+	require.ErrorAs(t, err, &replayErr)
+	code, _, err = proxy.paidTier(ArkAuth{ContractId: contract.Id, Nonce: 6, Spender: contract.Client}, "127.0.0.1:8080", 1)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, code)
+}
+
+func signArkAuthV2(t *testing.T, key *secp256k1.PrivKey, contractId uint64, nonce int64, method string, body []byte) string {
+	signature, err := key.Sign(types.GetBytesToSign(contractId, nonce))
+	require.NoError(t, err)
+	bodyHash := HashRequestBody(body)
+	requestSignature, err := key.Sign([]byte(GenerateRequestMessageToSign(contractId, nonce, method, bodyHash)))
+	require.NoError(t, err)
+	return GenerateArkAuthV2String(contractId, nonce, bodyHash, signature, requestSignature)
+}
+
+func TestParseArkAuthV2(t *testing.T) {
+	aa := ArkAuth{Version: ArkAuthVersion2, ContractId: 3, Nonce: 9, BodyHash: HashRequestBody([]byte("{}")), Signature: []byte{0xaa}, RequestSignature: []byte{0xbb}}
+	parsed, err := parseArkAuth(aa.String())
+	require.NoError(t, err)
+	require.Equal(t, aa, parsed)
+
+	parsed, err = parseArkAuth("3:9:aa")
+	require.NoError(t, err)
+	require.Equal(t, ArkAuthVersion1, parsed.Version)
+
+	for _, raw := range []string{"v2:3:9:aa:bb", "v2:3:9:aa:bb:cc", "v2:x:9:" + hex.EncodeToString(emptyBodyHash[:]) + ":aa:bb"} {
+		_, err = parseArkAuth(raw)
+		require.Error(t, err, raw)
+	}
+}
+
+func TestArkAuthV2(t *testing.T) {
+	received := make(chan []byte, 10)
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err == nil {
+			received <- body
+		}
+	}))
+	defer upstream.Close()
+	config := newTestConfig()
+	config.FreeTierRateLimit = 0
+	config.Services = map[string]conf.ServiceConfiguration{common.BTCService.String(): {Upstream: upstream.URL, MaxRequestBytes: 16 << 20}}
+	proxy, err := NewProxy(config)
+	require.NoError(t, err)
+	proxy.MemStore.SetHeight(10)
+	contract, key := newSignedContract(t, 7)
+	contract.Deposit = cosmos.NewInt(1000)
+	proxy.MemStore.Put(contract)
+	router := proxy.getRouter()
+
+	send := func(method string, body io.Reader, length int64, auth string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, fmt.Sprintf("/%s?%s=%s", common.BTCService, QueryArkAuth, auth), body)
+		req.ContentLength = length
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+	requireAuthError := func(w *httptest.ResponseRecorder, code string) {
+		require.Equal(t, http.StatusBadRequest, w.Code, w.Body.String())
+		var authErr AuthError
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &authErr))
+		require.Equal(t, code, authErr.Code)
+	}
+
+	// the body signed reaches the upstream
+	body := []byte(`{"jsonrpc":"2.0","method":"getblockcount","id":1}`)
+	w := send(http.MethodPost, bytes.NewReader(body), int64(len(body)), signArkAuthV2(t, key, contract.Id, 1, http.MethodPost, body))
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	require.Equal(t, "paid", w.Header().Get("tier"))
+	require.Equal(t, body, <-received)
+
+	// an altered body is refused, the upstream doesn't get it
+	tampered := []byte(`{"jsonrpc":"2.0","method":"sendrawtransaction","id":1}`)
+	w = send(http.MethodPost, bytes.NewReader(tampered), int64(len(tampered)), signArkAuthV2(t, key, contract.Id, 2, http.MethodPost, body))
+	requireAuthError(w, AuthErrorBodyHash)
+	require.Empty(t, received)
+
+	// so is a request sent with another method than the one signed, it isn't paid
+	w = send(http.MethodPut, bytes.NewReader(body), int64(len(body)), signArkAuthV2(t, key, contract.Id, 3, http.MethodPost, body))
+	require.Equal(t, "free", w.Header().Get("tier"))
+	require.Empty(t, received)
+
+	// the requests without a body sign the hash of an empty body
+	w = send(http.MethodGet, nil, 0, signArkAuthV2(t, key, contract.Id, 4, http.MethodGet, nil))
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	require.Empty(t, <-received)
+	w = send(http.MethodGet, nil, 0, signArkAuthV2(t, key, contract.Id, 5, http.MethodGet, body))
+	requireAuthError(w, AuthErrorBodyHash)
+
+	// a large body is streamed to the upstream as it is hashed
+	large := bytes.Repeat([]byte("0123456789abcdef"), 1<<19)
+	w = send(http.MethodPost, io.NopCloser(bytes.NewReader(large)), int64(len(large)), signArkAuthV2(t, key, contract.Id, 6, http.MethodPost, large))
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	require.Equal(t, large, <-received)
+	altered := bytes.Clone(large)
+	altered[len(altered)-1] = 'x'
+	w = send(http.MethodPost, io.NopCloser(bytes.NewReader(altered)), int64(len(altered)), signArkAuthV2(t, key, contract.Id, 7, http.MethodPost, large))
+	requireAuthError(w, AuthErrorBodyHash)
+	select {
+	case <-received:
+		t.Fatal("the upstream got the whole altered body")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	// the v1 arkauth is accepted until the sentinel requires v2
+	require.Equal(t, "paid", send(http.MethodGet, nil, 0, signArkAuth(t, key, contract.Id, 8)).Header().Get("tier"))
+	<-received
+	proxy.Config.ArkAuthMinVersion = ArkAuthVersion2
+	router = proxy.getRouter()
+	requireAuthError(send(http.MethodGet, nil, 0, signArkAuth(t, key, contract.Id, 9)), AuthErrorVersion)
+	require.Equal(t, "paid", send(http.MethodGet, nil, 0, signArkAuthV2(t, key, contract.Id, 10, http.MethodGet, nil)).Header().Get("tier"))
+}
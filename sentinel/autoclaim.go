@@ -0,0 +1,305 @@
+package sentinel
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cometbft/cometbft/libs/log"
+
+	"github.com/arkeonetwork/arkeo/common"
+	"github.com/arkeonetwork/arkeo/common/cosmos"
+	"github.com/arkeonetwork/arkeo/sentinel/conf"
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+)
+
+const defaultAutoClaimInterval = time.Minute
+
+// ErrNothingToClaim is returned when a contract has no income left to claim
+var ErrNothingToClaim = errors.New("nothing to claim")
+
+// ErrClaimOverMaxQueries is returned when the nonce of a claim is over the queries its contract allows, the chain would
+// slash the provider submitting it
+var ErrClaimOverMaxQueries = errors.New("claim nonce over the contract max queries")
+
+// ClaimBroadcaster sign and broadcast claim transactions with the provider key
+type ClaimBroadcaster interface {
+	// Address return the account paying for the claim transactions
+	Address() cosmos.AccAddress
+	// BroadcastClaim submit the claim, it returns the hash of the transaction
+	BroadcastClaim(ctx context.Context, msg *types.MsgClaimContractIncome) (string, error)
+	// ResetSequence drop the cached account sequence, so it is read from the chain on the next broadcast
+	ResetSequence()
+}
+
+// AutoClaimer watch the claim store and submit the provider's claims once the pending income of a contract
+// crosses the configured threshold, or the contract is about to expire
+type AutoClaimer struct {
+	config      conf.AutoClaimConfiguration
+	claims      ClaimStorage
+	contracts   *MemStore
+	broadcaster ClaimBroadcaster
+	logger      log.Logger
+	// submissions are serialized, all of them are signed by the same key and would otherwise race on the account
+	// sequence
+	submitLock sync.Mutex
+	// last nonce submitted per contract, so the same claim isn't broadcast again while waiting for the settlement
+	submitted map[uint64]int64
+	// thresholds of the denoms configured with their own
+	thresholds map[string]cosmos.Int
+	metrics    *Metrics
+	// broadcasters of the provider identities with their own claim key, by provider pubkey
+	broadcasters map[string]ClaimBroadcaster
+}
+
+func NewAutoClaimer(config conf.AutoClaimConfiguration, claims ClaimStorage, contracts *MemStore, broadcaster ClaimBroadcaster, logger log.Logger) *AutoClaimer {
+	// the thresholds were validated with the configuration
+	thresholds := make(map[string]cosmos.Int, len(config.DenomThresholds))
+	for denom, raw := range config.DenomThresholds {
+		if threshold, ok := cosmos.NewIntFromString(raw); ok {
+			thresholds[denom] = threshold
+		}
+	}
+	return &AutoClaimer{
+		config:      config,
+		claims:      claims,
+		contracts:   contracts,
+		broadcaster: broadcaster,
+		logger:      logger.With("module", "auto-claim"),
+		submitted:   make(map[uint64]int64),
+		thresholds:  thresholds,
+	}
+}
+
+// UseBroadcaster submit the claims of the contracts of provider with broadcaster, they are never submitted with the key
+// of another provider identity
+func (a *AutoClaimer) UseBroadcaster(provider common.PubKey, broadcaster ClaimBroadcaster) {
+	if a.broadcasters == nil {
+		a.broadcasters = make(map[string]ClaimBroadcaster)
+	}
+	a.broadcasters[provider.String()] = broadcaster
+}
+
+// broadcasterFor return the broadcaster of the claims of the contracts of provider
+func (a *AutoClaimer) broadcasterFor(provider common.PubKey) ClaimBroadcaster {
+	if broadcaster, ok := a.broadcasters[provider.String()]; ok {
+		return broadcaster
+	}
+	return a.broadcaster
+}
+
+// Run check the claim store periodically until done is closed
+func (a *AutoClaimer) Run(done <-chan struct{}) {
+	interval := time.Duration(a.config.IntervalSeconds) * time.Second
+	if interval <= 0 {
+		interval = defaultAutoClaimInterval
+	}
+	a.logger.Info("starting auto claim", "interval", interval.String(), "dry_run", a.config.DryRun)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+			a.ClaimDue(context.Background())
+		}
+	}
+}
+
+// ClaimDue submit every claim that is due, it returns the number of claims submitted (or logged in dry run mode)
+func (a *AutoClaimer) ClaimDue(ctx context.Context) int {
+	a.submitLock.Lock()
+	defer a.submitLock.Unlock()
+
+	height := a.contracts.GetHeight()
+	count := 0
+	for _, claim := range a.claims.List() {
+		if claim.Claimed || claim.Signature == "" || a.submitted[claim.ContractId] >= claim.Nonce {
+			continue
+		}
+		contract, err := a.contracts.Get(claim.Key())
+		if err != nil {
+			a.logger.Error("fail to get contract", "error", err, "contract_id", claim.ContractId)
+			continue
+		}
+		if overMaxQueries(contract, claim) {
+			a.logger.Error("skipping claim over the contract max queries", "contract_id", claim.ContractId, "nonce", claim.Nonce, "max_queries", contract.MaxQueries())
+			continue
+		}
+		pending := pendingIncome(contract, claim, height)
+		if !a.isDue(contract, pending, height) {
+			continue
+		}
+		log := a.logger.With("contract_id", claim.ContractId, "nonce", claim.Nonce, "pending", pending.String(), "expiration", contract.Expiration())
+		if a.config.DryRun {
+			log.Info("dry run, would claim contract income")
+			a.metrics.autoClaim(AutoClaimResultDryRun)
+			a.submitted[claim.ContractId] = claim.Nonce
+			count++
+			continue
+		}
+		txHash, err := a.submit(ctx, contract, claim)
+		if err != nil {
+			log.Error("fail to claim contract income", "error", err)
+			a.metrics.autoClaim(AutoClaimResultFailed)
+			continue
+		}
+		log.Info("claimed contract income", "tx", txHash)
+		a.metrics.autoClaim(AutoClaimResultSubmitted)
+		a.submitted[claim.ContractId] = claim.Nonce
+		count++
+	}
+	return count
+}
+
+// ClaimContract submit the claim of the contract right away, whatever its pending income. It returns the hash of the
+// transaction, empty in dry run mode
+func (a *AutoClaimer) ClaimContract(ctx context.Context, contractId uint64) (string, error) {
+	a.submitLock.Lock()
+	defer a.submitLock.Unlock()
+
+	key := strconv.FormatUint(contractId, 10)
+	claim, err := a.claims.Get(key)
+	if err != nil {
+		return "", fmt.Errorf("fail to get claim: %w", err)
+	}
+	if claim.Claimed || claim.Signature == "" {
+		return "", ErrNothingToClaim
+	}
+	contract, err := a.contracts.Get(key)
+	if err != nil {
+		return "", fmt.Errorf("fail to get contract: %w", err)
+	}
+	if overMaxQueries(contract, claim) {
+		return "", ErrClaimOverMaxQueries
+	}
+	height := a.contracts.GetHeight()
+	pending := pendingIncome(contract, claim, height)
+	if contract.IsEmpty() || contract.IsSettled(height) || !pending.Amount.IsPositive() {
+		return "", ErrNothingToClaim
+	}
+	log := a.logger.With("contract_id", claim.ContractId, "nonce", claim.Nonce, "pending", pending.String())
+	if a.config.DryRun {
+		log.Info("dry run, would claim contract income")
+		a.metrics.autoClaim(AutoClaimResultDryRun)
+		a.submitted[claim.ContractId] = claim.Nonce
+		return "", nil
+	}
+	txHash, err := a.submit(ctx, contract, claim)
+	if err != nil {
+		log.Error("fail to claim contract income", "error", err)
+		a.metrics.autoClaim(AutoClaimResultFailed)
+		return "", err
+	}
+	log.Info("claimed contract income", "tx", txHash)
+	a.metrics.autoClaim(AutoClaimResultSubmitted)
+	a.submitted[claim.ContractId] = claim.Nonce
+	return txHash, nil
+}
+
+// isDue return true when the pending income should be claimed now
+func (a *AutoClaimer) isDue(contract types.Contract, pending cosmos.Coin, height int64) bool {
+	if contract.IsEmpty() || contract.IsSettled(height) || !pending.Amount.IsPositive() {
+		return false
+	}
+	if pending.Amount.GTE(a.threshold(pending.Denom)) {
+		return true
+	}
+	return contract.Expiration()-height <= a.config.ExpiryBlocks
+}
+
+// overMaxQueries return true when the claim nonce is over the queries the contract allows. The signature is over the
+// nonce, it can't be capped, the claim is never submitted.
+func overMaxQueries(contract types.Contract, claim Claim) bool {
+	maxQueries := contract.MaxQueries()
+	return maxQueries > 0 && claim.Nonce > maxQueries
+}
+
+// threshold return the pending income above which a contract in denom is claimed
+func (a *AutoClaimer) threshold(denom string) cosmos.Int {
+	if threshold, ok := a.thresholds[denom]; ok {
+		return threshold
+	}
+	return cosmos.NewInt(a.config.Threshold)
+}
+
+// submit broadcast the claim with the key of the contract's provider, retrying when the account sequence used to sign
+// it is stale
+func (a *AutoClaimer) submit(ctx context.Context, contract types.Contract, claim Claim) (string, error) {
+	sig, err := hex.DecodeString(claim.Signature)
+	if err != nil {
+		return "", fmt.Errorf("fail to decode claim signature: %w", err)
+	}
+	broadcaster := a.broadcasterFor(contract.Provider)
+	msg := types.NewMsgClaimContractIncome(broadcaster.Address(), claim.ContractId, claim.Nonce, sig)
+	if err := msg.ValidateBasic(); err != nil {
+		return "", err
+	}
+	for attempt := 0; ; attempt++ {
+		txHash, err := broadcaster.BroadcastClaim(ctx, msg)
+		if err == nil {
+			return txHash, nil
+		}
+		if !isSequenceMismatch(err) || attempt >= a.config.MaxRetries {
+			return "", err
+		}
+		a.logger.Info("account sequence mismatch, retrying claim", "contract_id", claim.ContractId, "attempt", attempt+1)
+		broadcaster.ResetSequence()
+	}
+}
+
+// pendingIncome is the income of the contract that can be claimed with the given claim, in the contract rate denom,
+// mirroring how the chain computes the debt on settlement
+func pendingIncome(contract types.Contract, claim Claim, height int64) cosmos.Coin {
+	none := cosmos.Coin{Denom: contract.Rate.Denom, Amount: cosmos.ZeroInt()}
+	if contract.Rate.Amount.IsNil() || contract.Deposit.IsNil() {
+		return none
+	}
+	paid := contract.Paid
+	if paid.IsNil() {
+		paid = cosmos.ZeroInt()
+	}
+	owed := contractDebt(contract, claim.Nonce, height)
+	if owed.GT(contract.Deposit) {
+		owed = contract.Deposit
+	}
+	pending := owed.Sub(paid)
+	if pending.IsNegative() {
+		return none
+	}
+	return cosmos.Coin{Denom: contract.Rate.Denom, Amount: pending}
+}
+
+// contractDebt return what the contract owes in its rate denom, for the queries up to nonce of a pay-as-you-go
+// contract or the blocks up to height of a subscription, regardless of its deposit
+func contractDebt(contract types.Contract, nonce, height int64) cosmos.Int {
+	if contract.Rate.Amount.IsNil() {
+		return cosmos.ZeroInt()
+	}
+	switch contract.Type {
+	case types.ContractType_PAY_AS_YOU_GO:
+		return queriesCost(contract, nonce).Amount
+	case types.ContractType_SUBSCRIPTION:
+		end := height
+		if end > contract.Expiration() {
+			end = contract.Expiration()
+		}
+		if end < contract.Start() {
+			return cosmos.ZeroInt()
+		}
+		return contract.Rate.Amount.MulRaw(end - contract.Start())
+	default:
+		return cosmos.ZeroInt()
+	}
+}
+
+// isSequenceMismatch return true when the transaction was rejected because it was signed with a stale sequence
+func isSequenceMismatch(err error) bool {
+	return err != nil && strings.Contains(err.Error(), "account sequence mismatch")
+}
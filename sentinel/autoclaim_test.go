@@ -0,0 +1,220 @@
+package sentinel
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"testing"
+
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/arkeonetwork/arkeo/common"
+	"github.com/arkeonetwork/arkeo/common/cosmos"
+	"github.com/arkeonetwork/arkeo/sentinel/conf"
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+)
+
+// mockBroadcaster record the claims it receives, failing the first calls with the given errors
+type mockBroadcaster struct {
+	mu       sync.Mutex
+	address  cosmos.AccAddress
+	errs     []error
+	msgs     []*types.MsgClaimContractIncome
+	resets   int
+	inFlight int
+	overlap  bool
+}
+
+func (b *mockBroadcaster) Address() cosmos.AccAddress {
+	return b.address
+}
+
+func (b *mockBroadcaster) BroadcastClaim(_ context.Context, msg *types.MsgClaimContractIncome) (string, error) {
+	b.mu.Lock()
+	b.inFlight++
+	if b.inFlight > 1 {
+		b.overlap = true
+	}
+	b.mu.Unlock()
+	defer func() {
+		b.mu.Lock()
+		b.inFlight--
+		b.mu.Unlock()
+	}()
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if len(b.errs) > 0 {
+		err := b.errs[0]
+		b.errs = b.errs[1:]
+		return "", err
+	}
+	b.msgs = append(b.msgs, msg)
+	return fmt.Sprintf("TX%d", len(b.msgs)), nil
+}
+
+func (b *mockBroadcaster) ResetSequence() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.resets++
+}
+
+func newAutoClaimTest(t *testing.T, config conf.AutoClaimConfiguration, height int64, contracts ...types.Contract) (*AutoClaimer, *ClaimStore, *mockBroadcaster) {
+	logger := log.NewTMLogger(log.NewSyncWriter(os.Stdout))
+	claims, err := NewClaimStore("")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = claims.Close() })
+	memStore := NewMemStore("", logger)
+	memStore.SetHeight(height)
+	for _, contract := range contracts {
+		memStore.Put(contract)
+	}
+	broadcaster := &mockBroadcaster{address: types.GetRandomBech32Addr()}
+	return NewAutoClaimer(config, claims, memStore, broadcaster, logger), claims, broadcaster
+}
+
+func newAutoClaimContract(id uint64, contractType types.ContractType, rate, deposit, paid int64) types.Contract {
+	return types.Contract{
+		Provider: types.GetRandomPubKey(),
+		Service:  common.BTCService,
+		Client:   types.GetRandomPubKey(),
+		Type:     contractType,
+		Height:   10,
+		Duration: 100,
+		Rate:     cosmos.NewInt64Coin("uarkeo", rate),
+		Deposit:  cosmos.NewInt(deposit),
+		Paid:     cosmos.NewInt(paid),
+		Id:       id,
+	}
+}
+
+func TestPendingIncome(t *testing.T) {
+	paygo := newAutoClaimContract(1, types.ContractType_PAY_AS_YOU_GO, 10, 1000, 200)
+	require.Equal(t, int64(300), pendingIncome(paygo, Claim{Nonce: 50}, 20).Amount.Int64())
+	// capped by the deposit
+	require.Equal(t, int64(800), pendingIncome(paygo, Claim{Nonce: 500}, 20).Amount.Int64())
+	// already paid
+	require.Equal(t, int64(0), pendingIncome(paygo, Claim{Nonce: 10}, 20).Amount.Int64())
+
+	sub := newAutoClaimContract(2, types.ContractType_SUBSCRIPTION, 5, 500, 0)
+	require.Equal(t, int64(100), pendingIncome(sub, Claim{Nonce: 1}, 30).Amount.Int64())
+	// no income accrues past the expiration
+	require.Equal(t, int64(500), pendingIncome(sub, Claim{Nonce: 1}, 200).Amount.Int64())
+}
+
+func TestAutoClaimThresholdAndExpiry(t *testing.T) {
+	config := conf.AutoClaimConfiguration{Threshold: 500, ExpiryBlocks: 10, MaxRetries: 3}
+	// pending 600, above threshold
+	above := newAutoClaimContract(1, types.ContractType_PAY_AS_YOU_GO, 10, 10000, 0)
+	// pending 100, below threshold
+	below := newAutoClaimContract(2, types.ContractType_PAY_AS_YOU_GO, 10, 10000, 0)
+	// pending 100, below threshold but about to expire
+	expiring := newAutoClaimContract(3, types.ContractType_PAY_AS_YOU_GO, 10, 10000, 0)
+	expiring.Duration = 25
+	a, claims, broadcaster := newAutoClaimTest(t, config, 30, above, below, expiring)
+
+	require.NoError(t, claims.Set(NewClaim(1, above.Client, 60, "aabb")))
+	require.NoError(t, claims.Set(NewClaim(2, below.Client, 10, "aabb")))
+	require.NoError(t, claims.Set(NewClaim(3, expiring.Client, 10, "aabb")))
+	// already settled on chain
+	claimed := NewClaim(4, above.Client, 99, "aabb")
+	claimed.Claimed = true
+	require.NoError(t, claims.Set(claimed))
+
+	require.Equal(t, 2, a.ClaimDue(context.Background()))
+	require.Len(t, broadcaster.msgs, 2)
+	ids := []uint64{broadcaster.msgs[0].ContractId, broadcaster.msgs[1].ContractId}
+	require.ElementsMatch(t, []uint64{1, 3}, ids)
+	for _, msg := range broadcaster.msgs {
+		require.Equal(t, broadcaster.address.String(), msg.Creator)
+		require.Equal(t, []byte{0xaa, 0xbb}, msg.Signature)
+	}
+
+	// the same claims are not submitted again until the nonce moves
+	require.Equal(t, 0, a.ClaimDue(context.Background()))
+	require.NoError(t, claims.Set(NewClaim(1, above.Client, 120, "ccdd")))
+	require.Equal(t, 1, a.ClaimDue(context.Background()))
+	require.Equal(t, int64(120), broadcaster.msgs[2].Nonce)
+}
+
+func TestAutoClaimOverMaxQueries(t *testing.T) {
+	config := conf.AutoClaimConfiguration{Threshold: 1}
+	contract := newAutoClaimContract(1, types.ContractType_SUBSCRIPTION, 5, 500, 0)
+	contract.QueriesPerMinute = 2
+	a, claims, broadcaster := newAutoClaimTest(t, config, 30, contract)
+
+	// a claim over the max queries, stored before the sentinel checked it, is never submitted
+	require.NoError(t, claims.Set(NewClaim(1, contract.Client, contract.MaxQueries()+1, "aabb")))
+	require.Equal(t, 0, a.ClaimDue(context.Background()))
+	_, err := a.ClaimContract(context.Background(), contract.Id)
+	require.ErrorIs(t, err, ErrClaimOverMaxQueries)
+	require.Empty(t, broadcaster.msgs)
+
+	require.NoError(t, claims.Set(NewClaim(1, contract.Client, contract.MaxQueries(), "aabb")))
+	require.Equal(t, 1, a.ClaimDue(context.Background()))
+	require.Len(t, broadcaster.msgs, 1)
+}
+
+func TestAutoClaimRetryOnSequenceMismatch(t *testing.T) {
+	config := conf.AutoClaimConfiguration{Threshold: 1, MaxRetries: 2}
+	contract := newAutoClaimContract(1, types.ContractType_PAY_AS_YOU_GO, 10, 10000, 0)
+	a, claims, broadcaster := newAutoClaimTest(t, config, 30, contract)
+	require.NoError(t, claims.Set(NewClaim(1, contract.Client, 5, "aabb")))
+
+	mismatch := fmt.Errorf("account sequence mismatch: expected 7, got 6")
+	broadcaster.errs = []error{mismatch, mismatch}
+	require.Equal(t, 1, a.ClaimDue(context.Background()))
+	require.Len(t, broadcaster.msgs, 1)
+	require.Equal(t, 2, broadcaster.resets)
+
+	// out of retries
+	require.NoError(t, claims.Set(NewClaim(1, contract.Client, 6, "aabb")))
+	broadcaster.errs = []error{mismatch, mismatch, mismatch}
+	require.Equal(t, 0, a.ClaimDue(context.Background()))
+	require.Len(t, broadcaster.msgs, 1)
+
+	// other errors are not retried
+	broadcaster.errs = []error{fmt.Errorf("insufficient fees")}
+	require.Equal(t, 0, a.ClaimDue(context.Background()))
+	require.Equal(t, 4, broadcaster.resets)
+	// and the claim is attempted again on the next round
+	require.Equal(t, 1, a.ClaimDue(context.Background()))
+	require.Len(t, broadcaster.msgs, 2)
+}
+
+func TestAutoClaimDryRun(t *testing.T) {
+	config := conf.AutoClaimConfiguration{Threshold: 1, DryRun: true}
+	contract := newAutoClaimContract(1, types.ContractType_PAY_AS_YOU_GO, 10, 10000, 0)
+	a, claims, broadcaster := newAutoClaimTest(t, config, 30, contract)
+	require.NoError(t, claims.Set(NewClaim(1, contract.Client, 5, "aabb")))
+
+	require.Equal(t, 1, a.ClaimDue(context.Background()))
+	require.Empty(t, broadcaster.msgs)
+	require.Equal(t, 0, a.ClaimDue(context.Background()))
+}
+
+func TestAutoClaimSerialized(t *testing.T) {
+	config := conf.AutoClaimConfiguration{Threshold: 1}
+	var contracts []types.Contract
+	for id := uint64(1); id <= 5; id++ {
+		contracts = append(contracts, newAutoClaimContract(id, types.ContractType_PAY_AS_YOU_GO, 10, 10000, 0))
+	}
+	a, claims, broadcaster := newAutoClaimTest(t, config, 30, contracts...)
+	for _, contract := range contracts {
+		require.NoError(t, claims.Set(NewClaim(contract.Id, contract.Client, 5, "aabb")))
+	}
+
+	wg := sync.WaitGroup{}
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			a.ClaimDue(context.Background())
+		}()
+	}
+	wg.Wait()
+	require.False(t, broadcaster.overlap)
+	require.Len(t, broadcaster.msgs, 5)
+}
@@ -0,0 +1,190 @@
+package sentinel
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/cometbft/cometbft/libs/log"
+
+	"github.com/arkeonetwork/arkeo/common"
+	"github.com/arkeonetwork/arkeo/common/cosmos"
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+)
+
+// ChainProvider is the provider's on chain registration for one service, the terms clients are actually charged
+type ChainProvider struct {
+	Service             string        `json:"service"`
+	Status              string        `json:"status"`
+	MinContractDuration int64         `json:"min_contract_duration"`
+	MaxContractDuration int64         `json:"max_contract_duration"`
+	SettlementDuration  int64         `json:"settlement_duration"`
+	SubscriptionRate    []cosmos.Coin `json:"subscription_rate"`
+	PayAsYouGoRate      []cosmos.Coin `json:"pay_as_you_go_rate"`
+	Bond                string        `json:"bond"`
+	MetadataURI         string        `json:"metadata_uri"`
+	LastUpdate          int64         `json:"last_update"`
+}
+
+// ChainSnapshot is the last known on chain state of the provider. Stale is set when it couldn't be refreshed
+type ChainSnapshot struct {
+	Providers []ChainProvider `json:"providers"`
+	FetchedAt time.Time       `json:"fetched_at"`
+	Stale     bool            `json:"stale"`
+}
+
+// ProviderQuerier fetch the registrations of a provider from the chain
+type ProviderQuerier interface {
+	FetchProviders(ctx context.Context, pubkey common.PubKey) ([]ChainProvider, error)
+}
+
+// ChainMetadataCache cache the provider's on chain registrations for a while, serving the last good snapshot when
+// the chain can't be queried
+type ChainMetadataCache struct {
+	lock     sync.Mutex
+	querier  ProviderQuerier
+	pubkey   common.PubKey
+	ttl      time.Duration
+	now      func() time.Time
+	snapshot *ChainSnapshot
+	logger   log.Logger
+}
+
+func NewChainMetadataCache(querier ProviderQuerier, pubkey common.PubKey, ttl time.Duration, logger log.Logger) *ChainMetadataCache {
+	return &ChainMetadataCache{
+		querier: querier,
+		pubkey:  pubkey,
+		ttl:     ttl,
+		now:     time.Now,
+		logger:  logger,
+	}
+}
+
+// Get return the provider's on chain registrations, refreshed once the ttl expired
+func (c *ChainMetadataCache) Get(ctx context.Context) ChainSnapshot {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	now := c.now()
+	if c.snapshot != nil && !c.snapshot.Stale && now.Sub(c.snapshot.FetchedAt) < c.ttl {
+		return *c.snapshot
+	}
+
+	providers, err := c.querier.FetchProviders(ctx, c.pubkey)
+	if err != nil {
+		c.logger.Error("failed to fetch provider from chain, serving last snapshot", "error", err)
+		if c.snapshot == nil {
+			return ChainSnapshot{Providers: []ChainProvider{}, Stale: true}
+		}
+		c.snapshot.Stale = true
+		return *c.snapshot
+	}
+	c.snapshot = &ChainSnapshot{Providers: providers, FetchedAt: now}
+	return *c.snapshot
+}
+
+// restProviderQuerier fetch providers from the chain's rest api
+type restProviderQuerier struct {
+	client  http.Client
+	baseURL string
+}
+
+func NewRESTProviderQuerier(baseURL string) ProviderQuerier {
+	return &restProviderQuerier{
+		client:  http.Client{Timeout: 10 * time.Second},
+		baseURL: baseURL,
+	}
+}
+
+func (q *restProviderQuerier) FetchProviders(ctx context.Context, pubkey common.PubKey) ([]ChainProvider, error) {
+	type fetchProvider struct {
+		PubKey              common.PubKey  `json:"pub_key"`
+		Service             common.Service `json:"service"`
+		MetadataURI         string         `json:"metadata_uri"`
+		Status              interface{}    `json:"status"`
+		MinContractDuration string         `json:"min_contract_duration"`
+		MaxContractDuration string         `json:"max_contract_duration"`
+		SubscriptionRate    []cosmos.Coin  `json:"subscription_rate"`
+		PayAsYouGoRate      []cosmos.Coin  `json:"pay_as_you_go_rate"`
+		Bond                string         `json:"bond"`
+		LastUpdate          string         `json:"last_update"`
+		SettlementDuration  string         `json:"settlement_duration"`
+	}
+	type fetch struct {
+		Provider   []fetchProvider `json:"provider"`
+		Pagination struct {
+			NextKey string `json:"next_key"`
+		} `json:"pagination"`
+	}
+
+	providers := make([]ChainProvider, 0)
+	nextKey := ""
+	for {
+		values := url.Values{}
+		values.Set("pagination.limit", "200")
+		if nextKey != "" {
+			values.Set("pagination.key", nextKey)
+		}
+		requestURL := fmt.Sprintf("%s/arkeo/providers?%s", q.baseURL, values.Encode())
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL, nil)
+		if err != nil {
+			return nil, fmt.Errorf("fail to create http request: %w", err)
+		}
+		res, err := q.client.Do(req)
+		if err != nil {
+			return nil, fmt.Errorf("fail to send http request: %w", err)
+		}
+		body, err := io.ReadAll(res.Body)
+		_ = res.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("fail to read from response body: %w", err)
+		}
+		if res.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("unexpected status %d from %s", res.StatusCode, requestURL)
+		}
+		var data fetch
+		if err := json.Unmarshal(body, &data); err != nil {
+			return nil, fmt.Errorf("fail to unmarshal response: %w", err)
+		}
+
+		for _, p := range data.Provider {
+			if !p.PubKey.Equals(pubkey) {
+				continue
+			}
+			provider := ChainProvider{
+				Service:          p.Service.String(),
+				Status:           providerStatus(p.Status),
+				SubscriptionRate: p.SubscriptionRate,
+				PayAsYouGoRate:   p.PayAsYouGoRate,
+				Bond:             p.Bond,
+				MetadataURI:      p.MetadataURI,
+			}
+			provider.MinContractDuration, _ = strconv.ParseInt(p.MinContractDuration, 10, 64)
+			provider.MaxContractDuration, _ = strconv.ParseInt(p.MaxContractDuration, 10, 64)
+			provider.SettlementDuration, _ = strconv.ParseInt(p.SettlementDuration, 10, 64)
+			provider.LastUpdate, _ = strconv.ParseInt(p.LastUpdate, 10, 64)
+			providers = append(providers, provider)
+		}
+		if data.Pagination.NextKey == "" {
+			return providers, nil
+		}
+		nextKey = data.Pagination.NextKey
+	}
+}
+
+// providerStatus return the name of a provider status, sent by the rest api either as its name or its value
+func providerStatus(raw interface{}) string {
+	switch v := raw.(type) {
+	case string:
+		return v
+	case float64:
+		return types.ProviderStatus(int32(v)).String()
+	default:
+		return types.ProviderStatus_OFFLINE.String()
+	}
+}
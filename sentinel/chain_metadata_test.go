@@ -0,0 +1,126 @@
+package sentinel
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/arkeonetwork/arkeo/common"
+	"github.com/arkeonetwork/arkeo/common/cosmos"
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+)
+
+type mockProviderQuerier struct {
+	providers []ChainProvider
+	err       error
+	calls     int
+}
+
+func (m *mockProviderQuerier) FetchProviders(_ context.Context, _ common.PubKey) ([]ChainProvider, error) {
+	m.calls++
+	return m.providers, m.err
+}
+
+func TestChainMetadataCache(t *testing.T) {
+	querier := &mockProviderQuerier{
+		providers: []ChainProvider{{
+			Service:        common.BTCService.String(),
+			Status:         types.ProviderStatus_ONLINE.String(),
+			PayAsYouGoRate: []cosmos.Coin{cosmos.NewInt64Coin("uarkeo", 10)},
+		}},
+	}
+	now := time.Unix(1000, 0)
+	cache := NewChainMetadataCache(querier, types.GetRandomPubKey(), time.Minute, log.NewNopLogger())
+	cache.now = func() time.Time { return now }
+
+	// fresh
+	snapshot := cache.Get(context.Background())
+	require.False(t, snapshot.Stale)
+	require.Equal(t, now, snapshot.FetchedAt)
+	require.Len(t, snapshot.Providers, 1)
+	require.Equal(t, 1, querier.calls)
+
+	// cached
+	now = now.Add(30 * time.Second)
+	snapshot = cache.Get(context.Background())
+	require.False(t, snapshot.Stale)
+	require.Equal(t, 1, querier.calls)
+
+	// stale, the last good snapshot is served
+	now = now.Add(time.Minute)
+	querier.err = errors.New("chain unavailable")
+	querier.providers = nil
+	snapshot = cache.Get(context.Background())
+	require.True(t, snapshot.Stale)
+	require.Len(t, snapshot.Providers, 1)
+	require.Equal(t, time.Unix(1000, 0), snapshot.FetchedAt)
+	require.Equal(t, 2, querier.calls)
+
+	// a stale snapshot is refreshed as soon as the chain is back
+	querier.err = nil
+	querier.providers = []ChainProvider{}
+	snapshot = cache.Get(context.Background())
+	require.False(t, snapshot.Stale)
+	require.Empty(t, snapshot.Providers)
+	require.Equal(t, 3, querier.calls)
+
+	// never fetched
+	cache = NewChainMetadataCache(&mockProviderQuerier{err: errors.New("chain unavailable")}, types.GetRandomPubKey(), time.Minute, log.NewNopLogger())
+	snapshot = cache.Get(context.Background())
+	require.True(t, snapshot.Stale)
+	require.Empty(t, snapshot.Providers)
+}
+
+func TestRESTProviderQuerier(t *testing.T) {
+	config := newTestConfig()
+	pubkey := types.GetRandomPubKey()
+	other := types.GetRandomPubKey()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/arkeo/providers", r.URL.Path)
+		var body string
+		if r.URL.Query().Get("pagination.key") == "" {
+			body = fmt.Sprintf(`{"provider":[
+				{"pub_key":"%s","service":10,"status":"ONLINE","min_contract_duration":"10","max_contract_duration":"1000","subscription_rate":[{"denom":"uarkeo","amount":"20"}],"pay_as_you_go_rate":[{"denom":"uarkeo","amount":"2"}],"bond":"500","metadata_uri":"http://sentinel/metadata.json","last_update":"7","settlement_duration":"5"},
+				{"pub_key":"%s","service":1,"status":"ONLINE"}
+			],"pagination":{"next_key":"bmV4dA=="}}`, pubkey, other)
+		} else {
+			body = fmt.Sprintf(`{"provider":[{"pub_key":"%s","service":2,"status":0}],"pagination":{"next_key":null}}`, pubkey)
+		}
+		_, _ = w.Write([]byte(body))
+	}))
+	defer server.Close()
+
+	providers, err := NewRESTProviderQuerier(server.URL).FetchProviders(context.Background(), pubkey)
+	require.NoError(t, err)
+	require.Len(t, providers, 2)
+	require.Equal(t, common.BTCService.String(), providers[0].Service)
+	require.Equal(t, "ONLINE", providers[0].Status)
+	require.Equal(t, int64(10), providers[0].MinContractDuration)
+	require.Equal(t, int64(1000), providers[0].MaxContractDuration)
+	require.Equal(t, int64(5), providers[0].SettlementDuration)
+	require.Equal(t, int64(7), providers[0].LastUpdate)
+	require.Equal(t, "2uarkeo", cosmos.NewCoins(providers[0].PayAsYouGoRate...).String())
+	require.Equal(t, types.ProviderStatus_OFFLINE.String(), providers[1].Status)
+
+	// the metadata endpoint embed the snapshot
+	config.SourceChain = server.URL
+	config.ProviderPubKey = pubkey
+	config.MetadataChainTTL = 60
+	proxy, err := NewProxy(config)
+	require.NoError(t, err)
+	w := httptest.NewRecorder()
+	proxy.handleMetadata(w, httptest.NewRequest(http.MethodGet, RoutesMetaData, nil))
+	var metadata Metadata
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &metadata))
+	require.NotNil(t, metadata.Chain)
+	require.False(t, metadata.Chain.Stale)
+	require.Len(t, metadata.Chain.Providers, 2)
+}
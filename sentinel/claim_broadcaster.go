@@ -0,0 +1,252 @@
+package sentinel
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/std"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	"github.com/arkeonetwork/arkeo/app/params"
+	"github.com/arkeonetwork/arkeo/common"
+	"github.com/arkeonetwork/arkeo/common/cosmos"
+	"github.com/arkeonetwork/arkeo/sentinel/conf"
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+)
+
+// claimKeyCheckTimeout bound the time the chain has to confirm the claim key can submit the claims at startup
+const claimKeyCheckTimeout = 10 * time.Second
+
+// claimMsgTypeURL is the message the provider account grants the claim signer, when the claims are submitted for it
+var claimMsgTypeURL = sdk.MsgTypeURL(&types.MsgClaimContractIncome{})
+
+// KeyringBroadcaster sign claims with a key from the local keyring and broadcast them to the node. The key is either
+// any funded account, anyone can submit a claim and the income is paid to the provider whatever the signer, or an authz
+// grantee of the provider account submitting the claims for it, so the bonded provider key stays off the sentinel
+type KeyringBroadcaster struct {
+	clientCtx client.Context
+	factory   tx.Factory
+	keyName   string
+	address   cosmos.AccAddress
+	// granter is the provider account the claims are submitted for through its authz grant, in the name of the signer
+	// when empty
+	granter  cosmos.AccAddress
+	sequence *signerSequence // shared by the broadcasters signing with the same key
+	// authz and broadcastTx reach the node
+	authz       authz.QueryClient
+	broadcastTx func(txBytes []byte) (*sdk.TxResponse, error)
+}
+
+// signerSequence track the account sequence of the signing key locally, so consecutive claims don't have to wait for
+// the previous one to be committed
+type signerSequence struct {
+	lock          sync.Mutex
+	accountNumber uint64
+	sequence      uint64
+	loaded        bool
+}
+
+var _ ClaimBroadcaster = &KeyringBroadcaster{}
+
+// NewKeyringBroadcaster return the broadcaster of the claims of provider, submitted for the provider account through
+// its authz grant with AutoClaimConfiguration.Authz
+func NewKeyringBroadcaster(config conf.AutoClaimConfiguration, provider common.PubKey) (*KeyringBroadcaster, error) {
+	if config.KeyName == "" {
+		return nil, fmt.Errorf("claim key name is required to claim contract income")
+	}
+	if config.NodeRPC == "" {
+		return nil, fmt.Errorf("node rpc is required to claim contract income")
+	}
+	encoding := params.MakeEncodingConfig()
+	std.RegisterInterfaces(encoding.InterfaceRegistry)
+	authtypes.RegisterInterfaces(encoding.InterfaceRegistry)
+	authz.RegisterInterfaces(encoding.InterfaceRegistry)
+	types.RegisterInterfaces(encoding.InterfaceRegistry)
+
+	kr, err := keyring.New(sdk.KeyringServiceName(), config.KeyringBackend, expandHome(config.KeyringDir), os.Stdin, encoding.Marshaler)
+	if err != nil {
+		return nil, fmt.Errorf("fail to open keyring: %w", err)
+	}
+	record, err := kr.Key(config.KeyName)
+	if err != nil {
+		return nil, fmt.Errorf("fail to find key %s: %w", config.KeyName, err)
+	}
+	address, err := record.GetAddress()
+	if err != nil {
+		return nil, fmt.Errorf("fail to get address of key %s: %w", config.KeyName, err)
+	}
+	rpcClient, err := client.NewClientFromNode(config.NodeRPC)
+	if err != nil {
+		return nil, fmt.Errorf("fail to create rpc client for %s: %w", config.NodeRPC, err)
+	}
+
+	clientCtx := client.Context{}.
+		WithChainID(config.ChainID).
+		WithClient(rpcClient).
+		WithCodec(encoding.Marshaler).
+		WithInterfaceRegistry(encoding.InterfaceRegistry).
+		WithTxConfig(encoding.TxConfig).
+		WithAccountRetriever(authtypes.AccountRetriever{}).
+		WithKeyring(kr).
+		WithFromName(config.KeyName).
+		WithFromAddress(address).
+		WithBroadcastMode(flags.BroadcastSync)
+	factory := tx.Factory{}.
+		WithChainID(config.ChainID).
+		WithKeybase(kr).
+		WithTxConfig(encoding.TxConfig).
+		WithAccountRetriever(authtypes.AccountRetriever{}).
+		WithGas(config.GasLimit).
+		WithGasPrices(config.GasPrices).
+		WithFees(config.Fees).
+		WithSignMode(signing.SignMode_SIGN_MODE_DIRECT)
+
+	broadcaster := &KeyringBroadcaster{
+		clientCtx:   clientCtx,
+		factory:     factory,
+		keyName:     config.KeyName,
+		address:     address,
+		sequence:    &signerSequence{},
+		authz:       authz.NewQueryClient(clientCtx),
+		broadcastTx: clientCtx.BroadcastTxSync,
+	}
+	if config.Authz {
+		return broadcaster.forProvider(provider)
+	}
+	return broadcaster, nil
+}
+
+// forProvider return a broadcaster submitting the claims for the account of provider through its authz grant, it
+// shares the key and the account sequence of b. The claims of a provider signing with its own key need no grant
+func (b *KeyringBroadcaster) forProvider(provider common.PubKey) (*KeyringBroadcaster, error) {
+	granter, err := provider.GetMyAddress()
+	if err != nil {
+		return nil, fmt.Errorf("fail to get account of provider %s: %w", provider, err)
+	}
+	broadcaster := *b
+	broadcaster.granter = nil
+	if !granter.Equals(b.address) {
+		broadcaster.granter = granter
+	}
+	return &broadcaster, nil
+}
+
+func (b *KeyringBroadcaster) Address() cosmos.AccAddress {
+	return b.address
+}
+
+// CheckKey return an error when the signing key is no longer in the keyring
+func (b *KeyringBroadcaster) CheckKey() error {
+	if _, err := b.clientCtx.Keyring.Key(b.keyName); err != nil {
+		return fmt.Errorf("fail to find key %s: %w", b.keyName, err)
+	}
+	return nil
+}
+
+// Verify check the signing key can submit the claims: its account exists on chain to pay the fees and, when the
+// claims are submitted for the provider account, the provider granted it the claims and the grant hasn't expired
+func (b *KeyringBroadcaster) Verify(ctx context.Context) error {
+	if err := b.clientCtx.AccountRetriever.EnsureExists(b.clientCtx, b.address); err != nil {
+		return fmt.Errorf("claim signer %s has no account on chain to pay the fees: %w", b.address, err)
+	}
+	if b.granter.Empty() {
+		return nil
+	}
+	res, err := b.authz.Grants(ctx, &authz.QueryGrantsRequest{Granter: b.granter.String(), Grantee: b.address.String(), MsgTypeUrl: claimMsgTypeURL})
+	if err != nil {
+		return fmt.Errorf("fail to get the grants of provider account %s to claim signer %s: %w", b.granter, b.address, err)
+	}
+	now := time.Now()
+	for _, grant := range res.Grants {
+		if grant.Expiration != nil && !grant.Expiration.After(now) {
+			continue
+		}
+		if authorization, err := grant.GetAuthorization(); err == nil && authorization.MsgTypeURL() == claimMsgTypeURL {
+			return nil
+		}
+	}
+	return fmt.Errorf("provider account %s didn't grant %s to claim signer %s", b.granter, claimMsgTypeURL, b.address)
+}
+
+// verifyClaimKey check on chain that the key of a broadcaster can submit its claims, within claimKeyCheckTimeout
+func verifyClaimKey(b *KeyringBroadcaster) error {
+	ctx, cancel := context.WithTimeout(context.Background(), claimKeyCheckTimeout)
+	defer cancel()
+	return b.Verify(ctx)
+}
+
+func (b *KeyringBroadcaster) ResetSequence() {
+	b.sequence.lock.Lock()
+	defer b.sequence.lock.Unlock()
+	b.sequence.loaded = false
+}
+
+// BroadcastClaim sign and broadcast the claim, wrapped in an authz exec of the claim for the provider account when the
+// signer is its grantee
+func (b *KeyringBroadcaster) BroadcastClaim(ctx context.Context, msg *types.MsgClaimContractIncome) (string, error) {
+	seq := b.sequence
+	seq.lock.Lock()
+	defer seq.lock.Unlock()
+
+	if !seq.loaded {
+		accountNumber, sequence, err := b.clientCtx.AccountRetriever.GetAccountNumberSequence(b.clientCtx, b.address)
+		if err != nil {
+			return "", fmt.Errorf("fail to get account sequence: %w", err)
+		}
+		seq.accountNumber, seq.sequence, seq.loaded = accountNumber, sequence, true
+	}
+	var claim sdk.Msg = msg
+	if !b.granter.Empty() {
+		exec := authz.NewMsgExec(b.address, []sdk.Msg{types.NewMsgClaimContractIncome(b.granter, msg.ContractId, msg.Nonce, msg.Signature)})
+		claim = &exec
+	}
+	factory := b.factory.WithAccountNumber(seq.accountNumber).WithSequence(seq.sequence)
+	builder, err := factory.BuildUnsignedTx(claim)
+	if err != nil {
+		return "", fmt.Errorf("fail to build claim tx: %w", err)
+	}
+	if err := tx.Sign(ctx, factory, b.keyName, builder, true); err != nil {
+		return "", fmt.Errorf("fail to sign claim tx: %w", err)
+	}
+	txBytes, err := b.clientCtx.TxConfig.TxEncoder()(builder.GetTx())
+	if err != nil {
+		return "", fmt.Errorf("fail to encode claim tx: %w", err)
+	}
+	res, err := b.broadcastTx(txBytes)
+	if err != nil {
+		return "", fmt.Errorf("fail to broadcast claim tx: %w", err)
+	}
+	if res.Code != 0 {
+		if res.Codespace == sdkerrors.ErrWrongSequence.Codespace() && res.Code == sdkerrors.ErrWrongSequence.ABCICode() {
+			seq.loaded = false
+			return "", fmt.Errorf("account sequence mismatch: %s", res.RawLog)
+		}
+		return "", fmt.Errorf("claim tx %s rejected (code %d): %s", res.TxHash, res.Code, res.RawLog)
+	}
+	seq.sequence++
+	return res.TxHash, nil
+}
+
+func expandHome(dir string) string {
+	if !strings.HasPrefix(dir, "~/") {
+		return dir
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return dir
+	}
+	return filepath.Join(home, dir[2:])
+}
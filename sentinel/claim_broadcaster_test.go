@@ -0,0 +1,199 @@
+package sentinel
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/arkeonetwork/arkeo/app/params"
+	"github.com/arkeonetwork/arkeo/common"
+	"github.com/arkeonetwork/arkeo/common/cosmos"
+	"github.com/arkeonetwork/arkeo/sentinel/conf"
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+)
+
+// mockGrants answer the grants query with grants
+type mockGrants struct {
+	authz.QueryClient
+	grants []*authz.Grant
+}
+
+func (m *mockGrants) Grants(_ context.Context, _ *authz.QueryGrantsRequest, _ ...grpc.CallOption) (*authz.QueryGrantsResponse, error) {
+	return &authz.QueryGrantsResponse{Grants: m.grants}, nil
+}
+
+// missingAccount is the account retriever of a chain without the signing account
+type missingAccount struct {
+	client.MockAccountRetriever
+}
+
+func (missingAccount) EnsureExists(_ client.Context, addr sdk.AccAddress) error {
+	return fmt.Errorf("account %s not found", addr)
+}
+
+// newBroadcasterTest return a broadcaster signing with a new key of a test keyring, the transactions it broadcasts are
+// decoded and returned by the func rather than sent to a node
+func newBroadcasterTest(t *testing.T, authzGrantee bool, provider common.PubKey) (*KeyringBroadcaster, cosmos.AccAddress, func() sdk.Tx) {
+	dir := t.TempDir()
+	kr, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendTest, dir, nil, params.MakeEncodingConfig().Marshaler)
+	require.NoError(t, err)
+	record, _, err := kr.NewMnemonic("claimer", keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	signer, err := record.GetAddress()
+	require.NoError(t, err)
+
+	broadcaster, err := NewKeyringBroadcaster(conf.AutoClaimConfiguration{
+		ChainID:        "arkeo",
+		NodeRPC:        "tcp://localhost:26657",
+		KeyName:        "claimer",
+		KeyringBackend: keyring.BackendTest,
+		KeyringDir:     dir,
+		GasLimit:       200000,
+		Fees:           "200uarkeo",
+		Authz:          authzGrantee,
+	}, provider)
+	require.NoError(t, err)
+	broadcaster.clientCtx = broadcaster.clientCtx.WithAccountRetriever(client.MockAccountRetriever{ReturnAccNum: 7, ReturnAccSeq: 3})
+	var txs [][]byte
+	broadcaster.broadcastTx = func(txBytes []byte) (*sdk.TxResponse, error) {
+		txs = append(txs, txBytes)
+		return &sdk.TxResponse{TxHash: fmt.Sprintf("TX%d", len(txs))}, nil
+	}
+	last := func() sdk.Tx {
+		require.NotEmpty(t, txs)
+		tx, err := broadcaster.clientCtx.TxConfig.TxDecoder()(txs[len(txs)-1])
+		require.NoError(t, err)
+		return tx
+	}
+	return broadcaster, signer, last
+}
+
+// requireSignedBy check the transaction is signed by the signer alone, at sequence
+func requireSignedBy(t *testing.T, tx sdk.Tx, signer cosmos.AccAddress, sequence uint64) {
+	sigTx, ok := tx.(authsigning.SigVerifiableTx)
+	require.True(t, ok)
+	pubkeys, err := sigTx.GetPubKeys()
+	require.NoError(t, err)
+	require.Len(t, pubkeys, 1)
+	require.Equal(t, signer, cosmos.AccAddress(pubkeys[0].Address()))
+	signatures, err := sigTx.GetSignaturesV2()
+	require.NoError(t, err)
+	require.Equal(t, sequence, signatures[0].Sequence)
+}
+
+func TestKeyringBroadcaster(t *testing.T) {
+	newTestConfig()
+	provider := types.GetRandomPubKey()
+	broadcaster, signer, last := newBroadcasterTest(t, false, provider)
+	require.Equal(t, signer, broadcaster.Address())
+
+	// a funded account claims in its own name, the income is paid to the provider whatever the signer
+	require.NoError(t, broadcaster.Verify(context.Background()))
+	for i, sequence := range []uint64{3, 4} {
+		txHash, err := broadcaster.BroadcastClaim(context.Background(), types.NewMsgClaimContractIncome(signer, 1, int64(i+1), []byte("sig")))
+		require.NoError(t, err)
+		require.Equal(t, fmt.Sprintf("TX%d", i+1), txHash)
+		tx := last()
+		requireSignedBy(t, tx, signer, sequence)
+		require.Len(t, tx.GetMsgs(), 1)
+		claim, ok := tx.GetMsgs()[0].(*types.MsgClaimContractIncome)
+		require.True(t, ok)
+		require.Equal(t, signer.String(), claim.Creator)
+		require.Equal(t, int64(i+1), claim.Nonce)
+	}
+
+	// a signer without an account on chain can't pay the fees
+	broadcaster.clientCtx = broadcaster.clientCtx.WithAccountRetriever(missingAccount{})
+	require.ErrorContains(t, broadcaster.Verify(context.Background()), "has no account on chain")
+}
+
+func TestKeyringBroadcasterAuthz(t *testing.T) {
+	newTestConfig()
+	provider := types.GetRandomPubKey()
+	granter, err := provider.GetMyAddress()
+	require.NoError(t, err)
+	broadcaster, signer, last := newBroadcasterTest(t, true, provider)
+	require.Equal(t, granter, broadcaster.granter)
+
+	// the grantee signs an exec of the claim for the provider account
+	_, err = broadcaster.BroadcastClaim(context.Background(), types.NewMsgClaimContractIncome(signer, 1, 5, []byte("sig")))
+	require.NoError(t, err)
+	tx := last()
+	requireSignedBy(t, tx, signer, 3)
+	require.Len(t, tx.GetMsgs(), 1)
+	exec, ok := tx.GetMsgs()[0].(*authz.MsgExec)
+	require.True(t, ok)
+	require.Equal(t, signer.String(), exec.Grantee)
+	msgs, err := exec.GetMessages()
+	require.NoError(t, err)
+	require.Len(t, msgs, 1)
+	claim, ok := msgs[0].(*types.MsgClaimContractIncome)
+	require.True(t, ok)
+	require.Equal(t, granter.String(), claim.Creator)
+	require.Equal(t, uint64(1), claim.ContractId)
+	require.Equal(t, int64(5), claim.Nonce)
+	require.Equal(t, []byte("sig"), claim.Signature)
+
+	// the broadcaster of another identity shares the key and its account sequence
+	other := types.GetRandomPubKey()
+	otherGranter, err := other.GetMyAddress()
+	require.NoError(t, err)
+	otherBroadcaster, err := broadcaster.forProvider(other)
+	require.NoError(t, err)
+	_, err = otherBroadcaster.BroadcastClaim(context.Background(), types.NewMsgClaimContractIncome(signer, 2, 1, []byte("sig")))
+	require.NoError(t, err)
+	tx = last()
+	requireSignedBy(t, tx, signer, 4)
+	msgs, err = tx.GetMsgs()[0].(*authz.MsgExec).GetMessages()
+	require.NoError(t, err)
+	require.Equal(t, otherGranter.String(), msgs[0].(*types.MsgClaimContractIncome).Creator)
+
+	// the startup check needs a live grant of the claims
+	grant := func(msgTypeURL string, expiration *time.Time) *authz.Grant {
+		g, err := authz.NewGrant(time.Now().Add(-time.Hour), authz.NewGenericAuthorization(msgTypeURL), expiration)
+		require.NoError(t, err)
+		return &g
+	}
+	expired := time.Now().Add(-time.Minute)
+	live := time.Now().Add(time.Hour)
+	for _, tc := range []struct {
+		grants []*authz.Grant
+		valid  bool
+	}{
+		{grants: nil},
+		{grants: []*authz.Grant{grant(sdk.MsgTypeURL(&types.MsgOpenContract{}), nil)}},
+		{grants: []*authz.Grant{grant(claimMsgTypeURL, &expired)}},
+		{grants: []*authz.Grant{grant(claimMsgTypeURL, &live)}, valid: true},
+		{grants: []*authz.Grant{grant(claimMsgTypeURL, nil)}, valid: true},
+	} {
+		broadcaster.authz = &mockGrants{grants: tc.grants}
+		err := broadcaster.Verify(context.Background())
+		if tc.valid {
+			require.NoError(t, err)
+			continue
+		}
+		require.ErrorContains(t, err, "didn't grant")
+	}
+
+	// a provider signing with its own key needs no grant
+	record, err := broadcaster.clientCtx.Keyring.Key("claimer")
+	require.NoError(t, err)
+	cryptoKey, err := record.GetPubKey()
+	require.NoError(t, err)
+	signerKey, err := common.NewPubKeyFromCrypto(cryptoKey)
+	require.NoError(t, err)
+	self, err := broadcaster.forProvider(signerKey)
+	require.NoError(t, err)
+	require.True(t, self.granter.Empty())
+	require.NoError(t, self.Verify(context.Background()))
+}
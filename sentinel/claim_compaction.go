@@ -0,0 +1,143 @@
+package sentinel
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/cometbft/cometbft/libs/log"
+)
+
+// ClaimCompaction is the outcome of a compaction of the claim store
+type ClaimCompaction struct {
+	Kept     int `json:"kept"`     // claims of the contracts still open, or the chain didn't return
+	Archived int `json:"archived"` // claims of settled contracts appended to the archive and removed
+	Removed  int `json:"removed"`  // claims of settled contracts deleted
+}
+
+// ClaimCompactor remove the claims of the contracts settled on chain from the claim store. The store keeps the latest
+// claim of each contract, overwritten as its nonce advances, so it only grows with the contracts that are done with
+type ClaimCompactor struct {
+	claims    ClaimStorage
+	contracts *MemStore
+	archive   string // file the claims are appended to before they are removed, they are deleted when empty
+	interval  time.Duration
+	logger    log.Logger
+	lock      sync.Mutex // one compaction at a time
+}
+
+func NewClaimCompactor(claims ClaimStorage, contracts *MemStore, archive string, interval time.Duration, logger log.Logger) *ClaimCompactor {
+	return &ClaimCompactor{
+		claims:    claims,
+		contracts: contracts,
+		archive:   archive,
+		interval:  interval,
+		logger:    logger.With("module", "claim-compaction"),
+	}
+}
+
+// Run compact the claim store periodically until done is closed, never when no interval is configured
+func (c *ClaimCompactor) Run(done <-chan struct{}) {
+	if c.interval <= 0 {
+		return
+	}
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+			if _, err := c.Compact(); err != nil {
+				c.logger.Error("fail to compact claim store", "error", err)
+			}
+		}
+	}
+}
+
+// Compact remove the claims of the settled contracts, archiving them first when an archive is configured. A settled
+// contract is refused by the paid tier and skipped by the auto claimer, its claim is no longer written nor submitted,
+// so the compaction runs alongside both. The claims of the other contracts are kept as they are
+func (c *ClaimCompactor) Compact() (ClaimCompaction, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	var result ClaimCompaction
+	claims := c.claims.List()
+	height := c.contracts.GetHeight()
+	// nothing is known to be settled before the first block is seen
+	if height <= 0 {
+		result.Kept = len(claims)
+		return result, nil
+	}
+	var settled []Claim
+	for _, claim := range claims {
+		contract, err := c.contracts.Get(claim.Key())
+		if err != nil || contract.Id != claim.ContractId || !contract.IsSettled(height) {
+			result.Kept++
+			continue
+		}
+		settled = append(settled, claim)
+	}
+
+	if len(settled) > 0 && len(c.archive) > 0 {
+		if err := appendClaims(c.archive, settled); err != nil {
+			return result, fmt.Errorf("fail to archive claims: %w", err)
+		}
+	}
+	for _, claim := range settled {
+		if err := c.claims.Remove(claim.Key()); err != nil {
+			return result, fmt.Errorf("fail to remove claim of contract %d: %w", claim.ContractId, err)
+		}
+		if !claim.Claimed {
+			c.logger.Info("removed unclaimed claim of settled contract", "contract_id", claim.ContractId, "nonce", claim.Nonce)
+		}
+		if len(c.archive) > 0 {
+			result.Archived++
+		} else {
+			result.Removed++
+		}
+	}
+	if err := c.claims.Compact(); err != nil {
+		return result, fmt.Errorf("fail to compact claim store: %w", err)
+	}
+	c.logger.Info("compacted claim store", "kept", result.Kept, "archived", result.Archived, "removed", result.Removed)
+	return result, nil
+}
+
+// appendClaims append the claims to the archive file, one json object per line, synced before it returns
+func appendClaims(location string, claims []Claim) error {
+	if err := os.MkdirAll(filepath.Dir(location), 0o755); err != nil {
+		return err
+	}
+	file, err := os.OpenFile(location, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	encoder := json.NewEncoder(file)
+	for _, claim := range claims {
+		if err := encoder.Encode(claim); err != nil {
+			return err
+		}
+	}
+	return file.Sync()
+}
+
+// handleCompactClaims compact the claim store, for the holder of the admin token only
+func (p Proxy) handleCompactClaims(w http.ResponseWriter, r *http.Request) {
+	if _, ok := p.authorizeAdmin(w, r, http.MethodPost); !ok {
+		return
+	}
+	result, err := p.ClaimCompactor.Compact()
+	if err != nil {
+		p.logger.Error("fail to compact claim store", "error", err)
+		respondWithError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	respondWithJSON(w, http.StatusOK, result)
+}
@@ -0,0 +1,143 @@
+package sentinel
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/arkeonetwork/arkeo/sentinel/conf"
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+)
+
+func TestClaimCompaction(t *testing.T) {
+	config := newTestConfig()
+	open := newAutoClaimContract(1, types.ContractType_PAY_AS_YOU_GO, 2, 1000, 0)
+	open.Height = 250
+	settled := newAutoClaimContract(2, types.ContractType_PAY_AS_YOU_GO, 2, 1000, 0)
+
+	// the chain answers for the settled contract only, the cached open contract isn't fetched
+	chain := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasSuffix(r.URL.Path, "/arkeo/contract/2") {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"code":5,"message":"not found"}`))
+			return
+		}
+		_, _ = w.Write([]byte(fmt.Sprintf(`{"contract":{"id":"2","provider_pub_key":"%s","client":"%s","type":"PAY_AS_YOU_GO",
+			"height":"10","duration":"100","rate":{"denom":"uarkeo","amount":"2"},"deposit":"1000",
+			"paid":"0","nonce":"3"}}`, settled.Provider, settled.Client)))
+	}))
+	defer chain.Close()
+
+	config.SourceChain = chain.URL
+	config.ClaimArchiveLocation = filepath.Join(t.TempDir(), "archive", "claims.jsonl")
+	proxy, err := NewProxy(config)
+	require.NoError(t, err)
+	proxy.MemStore.SetHeight(300)
+	proxy.MemStore.Put(open)
+
+	require.NoError(t, proxy.ClaimStore.Set(NewClaim(open.Id, open.Client, 7, "aabb")))
+	require.NoError(t, proxy.ClaimStore.Set(Claim{ContractId: settled.Id, Spender: settled.Client, Nonce: 3, Signature: "aabb", Claimed: true}))
+	// a contract the chain doesn't know about is kept
+	require.NoError(t, proxy.ClaimStore.Set(NewClaim(3, open.Client, 4, "aabb")))
+
+	// the open contract keeps being served while the store is compacted
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for nonce := int64(8); nonce <= 40; nonce++ {
+			if _, _, err := proxy.paidTier(ArkAuth{ContractId: open.Id, Spender: open.Client, Nonce: nonce, Signature: []byte{0xaa, 0xbb}}, "", 1); err != nil {
+				t.Error(err)
+			}
+		}
+	}()
+	results := make(chan ClaimCompaction, 5)
+	for i := 0; i < cap(results); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			result, err := proxy.ClaimCompactor.Compact()
+			if err != nil {
+				t.Error(err)
+			}
+			results <- result
+		}()
+	}
+	wg.Wait()
+	close(results)
+	archived := 0
+	for result := range results {
+		require.Equal(t, 2, result.Kept)
+		require.Zero(t, result.Removed)
+		archived += result.Archived
+	}
+	require.Equal(t, 1, archived)
+	require.False(t, proxy.ClaimStore.Has(settled.Key()))
+	require.True(t, proxy.ClaimStore.Has("3"))
+
+	file, err := os.Open(config.ClaimArchiveLocation)
+	require.NoError(t, err)
+	defer file.Close()
+	scanner := bufio.NewScanner(file)
+	require.True(t, scanner.Scan())
+	var claim Claim
+	require.NoError(t, json.Unmarshal(scanner.Bytes(), &claim))
+	require.Equal(t, settled.Id, claim.ContractId)
+	require.EqualValues(t, 3, claim.Nonce)
+	require.False(t, scanner.Scan())
+
+	// the latest claim of the open contract is submitted with all its income
+	broadcaster := &mockBroadcaster{address: types.GetRandomBech32Addr()}
+	claimer := NewAutoClaimer(conf.AutoClaimConfiguration{Threshold: 1}, proxy.ClaimStore, proxy.MemStore, broadcaster, proxy.logger)
+	require.Equal(t, 1, claimer.ClaimDue(context.Background()))
+	require.Len(t, broadcaster.msgs, 1)
+	require.Equal(t, open.Id, broadcaster.msgs[0].ContractId)
+	require.EqualValues(t, 40, broadcaster.msgs[0].Nonce)
+	latest, err := proxy.ClaimStore.Get(open.Key())
+	require.NoError(t, err)
+	require.EqualValues(t, 80, pendingIncome(open, latest, 300).Amount.Int64())
+
+	// without an archive the claims are deleted
+	proxy.ClaimCompactor.archive = ""
+	require.NoError(t, proxy.ClaimStore.Set(Claim{ContractId: settled.Id, Spender: settled.Client, Nonce: 3, Signature: "aabb"}))
+	result, err := proxy.ClaimCompactor.Compact()
+	require.NoError(t, err)
+	require.Equal(t, ClaimCompaction{Kept: 2, Removed: 1}, result)
+}
+
+func TestHandleCompactClaims(t *testing.T) {
+	config := newTestConfig()
+	config.AdminToken = "secret"
+	proxy, err := NewProxy(config)
+	require.NoError(t, err)
+
+	compact := func(method, token string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, RoutesAdminCompact, nil)
+		if len(token) > 0 {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		w := httptest.NewRecorder()
+		proxy.handleCompactClaims(w, req)
+		return w
+	}
+	require.Equal(t, http.StatusUnauthorized, compact(http.MethodPost, "wrong").Code)
+	require.Equal(t, http.StatusMethodNotAllowed, compact(http.MethodGet, "secret").Code)
+
+	require.NoError(t, proxy.ClaimStore.Set(NewClaim(1, types.GetRandomPubKey(), 1, "aabb")))
+	w := compact(http.MethodPost, "secret")
+	require.Equal(t, http.StatusOK, w.Code)
+	var result ClaimCompaction
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &result))
+	// the height isn't known yet, every claim is kept
+	require.Equal(t, ClaimCompaction{Kept: 1}, result)
+}
@@ -0,0 +1,133 @@
+package sentinel
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"time"
+)
+
+// ClaimExportVersion is the version of the claim export format
+const ClaimExportVersion = 1
+
+// ClaimExport is the pending claims of a claim store, moved to the claim store of another host
+type ClaimExport struct {
+	Version    int       `json:"version"`
+	ExportedAt time.Time `json:"exported_at"`
+	Claims     []Claim   `json:"claims"`
+	Checksum   string    `json:"checksum"` // hex sha256 of the json encoded claims
+}
+
+// ClaimImport is the outcome of an import in the claim store
+type ClaimImport struct {
+	Imported int `json:"imported"` // claims written, new contracts or a higher nonce than the one stored
+	Kept     int `json:"kept"`     // claims the store already had at the same or a higher nonce
+}
+
+// ExportClaims return the claims of the store not claimed yet, by contract id
+func ExportClaims(store ClaimStorage) (ClaimExport, error) {
+	claims := []Claim{}
+	for _, claim := range store.List() {
+		if !claim.Claimed {
+			claims = append(claims, claim)
+		}
+	}
+	sort.Slice(claims, func(i, j int) bool { return claims[i].ContractId < claims[j].ContractId })
+	checksum, err := claimsChecksum(claims)
+	if err != nil {
+		return ClaimExport{}, err
+	}
+	return ClaimExport{
+		Version:    ClaimExportVersion,
+		ExportedAt: time.Now().UTC(),
+		Claims:     claims,
+		Checksum:   checksum,
+	}, nil
+}
+
+// WriteClaimExport write the pending claims of the store to w
+func WriteClaimExport(store ClaimStorage, w io.Writer) (ClaimExport, error) {
+	export, err := ExportClaims(store)
+	if err != nil {
+		return export, err
+	}
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return export, encoder.Encode(export)
+}
+
+// ReadClaimExport read an export from r, it is refused when its checksum doesn't match its claims
+func ReadClaimExport(r io.Reader) (ClaimExport, error) {
+	var export ClaimExport
+	if err := json.NewDecoder(r).Decode(&export); err != nil {
+		return export, fmt.Errorf("fail to decode claim export: %w", err)
+	}
+	if export.Version != ClaimExportVersion {
+		return export, fmt.Errorf("unsupported claim export version %d", export.Version)
+	}
+	checksum, err := claimsChecksum(export.Claims)
+	if err != nil {
+		return export, err
+	}
+	if checksum != export.Checksum {
+		return export, fmt.Errorf("claim export checksum mismatch, %s expected, %s computed", export.Checksum, checksum)
+	}
+	return export, nil
+}
+
+// ImportClaims merge the claims of an export in the store, keeping the highest nonce of each contract. A claim never
+// replaces one of the store at the same or a higher nonce, so the import can't take a contract back to an older claim
+func ImportClaims(store ClaimStorage, export ClaimExport) (ClaimImport, error) {
+	var result ClaimImport
+	var claims []Claim
+	for _, claim := range export.Claims {
+		current, err := store.Get(claim.Key())
+		if err != nil {
+			return result, fmt.Errorf("fail to get claim of contract %d: %w", claim.ContractId, err)
+		}
+		if current.ContractId == claim.ContractId && current.Nonce >= claim.Nonce {
+			result.Kept++
+			continue
+		}
+		claims = append(claims, claim)
+	}
+	if len(claims) > 0 {
+		if err := store.Batch(claims); err != nil {
+			return result, fmt.Errorf("fail to write claims: %w", err)
+		}
+		if err := store.Flush(); err != nil {
+			return result, fmt.Errorf("fail to sync claims: %w", err)
+		}
+	}
+	result.Imported = len(claims)
+	return result, nil
+}
+
+func claimsChecksum(claims []Claim) (string, error) {
+	buf, err := json.Marshal(claims)
+	if err != nil {
+		return "", fmt.Errorf("fail to marshal claims: %w", err)
+	}
+	sum := sha256.Sum256(buf)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// handleAdminExportClaims answer the export of the pending claims, to import them in the claim store of another host
+func (p Proxy) handleAdminExportClaims(w http.ResponseWriter, r *http.Request) {
+	caller, ok := p.authorizeAdmin(w, r, http.MethodGet)
+	if !ok {
+		return
+	}
+	export, err := ExportClaims(p.ClaimStore)
+	if err != nil {
+		p.logger.Error("fail to export claims", "error", err)
+		respondWithError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	p.logger.Info("claims exported by admin", "caller", caller, "claims", len(export.Claims))
+	respondWithJSON(w, http.StatusOK, export)
+}
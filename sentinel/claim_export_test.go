@@ -0,0 +1,93 @@
+package sentinel
+
+import (
+	"bytes"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+)
+
+func TestClaimExportImport(t *testing.T) {
+	spender := types.GetRandomPubKey()
+	source, err := NewClaimStore("")
+	require.NoError(t, err)
+	defer source.Close()
+	claimed := NewClaim(4, spender, 50, "sig4")
+	claimed.Claimed = true
+	require.NoError(t, source.Batch([]Claim{
+		NewClaim(1, spender, 10, "sig1"),
+		NewClaim(2, spender, 20, "sig2"),
+		NewClaim(3, spender, 30, "sig3"),
+		claimed,
+	}))
+
+	// the target already serves some of the contracts, 2 at a higher nonce, 3 at a lower one
+	target, err := NewBoltClaimStore(filepath.Join(t.TempDir(), "claims.db"))
+	require.NoError(t, err)
+	defer target.Close()
+	require.NoError(t, target.Batch([]Claim{
+		NewClaim(2, spender, 25, "sig2-target"),
+		NewClaim(3, spender, 5, "sig3-target"),
+		NewClaim(5, spender, 7, "sig5-target"),
+	}))
+
+	var buf bytes.Buffer
+	export, err := WriteClaimExport(source, &buf)
+	require.NoError(t, err)
+	require.Len(t, export.Claims, 3)
+	read, err := ReadClaimExport(&buf)
+	require.NoError(t, err)
+	require.Equal(t, export.Claims, read.Claims)
+
+	result, err := ImportClaims(target, read)
+	require.NoError(t, err)
+	require.Equal(t, ClaimImport{Imported: 2, Kept: 1}, result)
+	nonces := map[uint64]int64{}
+	signatures := map[uint64]string{}
+	for _, claim := range target.List() {
+		nonces[claim.ContractId] = claim.Nonce
+		signatures[claim.ContractId] = claim.Signature
+	}
+	require.Equal(t, map[uint64]int64{1: 10, 2: 25, 3: 30, 5: 7}, nonces)
+	require.Equal(t, map[uint64]string{1: "sig1", 2: "sig2-target", 3: "sig3", 5: "sig5-target"}, signatures)
+
+	// importing again changes nothing
+	result, err = ImportClaims(target, read)
+	require.NoError(t, err)
+	require.Equal(t, ClaimImport{Kept: 3}, result)
+}
+
+func TestClaimExportChecksum(t *testing.T) {
+	store, err := NewClaimStore("")
+	require.NoError(t, err)
+	defer store.Close()
+	require.NoError(t, store.Set(NewClaim(1, types.GetRandomPubKey(), 10, "sig1")))
+
+	var buf bytes.Buffer
+	_, err = WriteClaimExport(store, &buf)
+	require.NoError(t, err)
+	tampered := strings.Replace(buf.String(), `"nonce": 10`, `"nonce": 1000`, 1)
+	require.NotEqual(t, buf.String(), tampered)
+	_, err = ReadClaimExport(strings.NewReader(tampered))
+	require.ErrorContains(t, err, "checksum mismatch")
+
+	_, err = ReadClaimExport(strings.NewReader(`{"version":2,"claims":[]}`))
+	require.ErrorContains(t, err, "unsupported claim export version")
+}
+
+func TestAdminExportClaims(t *testing.T) {
+	proxy, serve := newAdminTest(t)
+	require.NoError(t, proxy.ClaimStore.Set(NewClaim(1, types.GetRandomPubKey(), 10, "sig1")))
+
+	w := serve(http.MethodGet, RoutesAdminExport, "secret")
+	require.Equal(t, http.StatusOK, w.Code)
+	export, err := ReadClaimExport(w.Body)
+	require.NoError(t, err)
+	require.Len(t, export.Claims, 1)
+	require.EqualValues(t, 10, export.Claims[0].Nonce)
+}
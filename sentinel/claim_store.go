@@ -4,16 +4,57 @@ import (
 	"encoding/json"
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/arkeonetwork/arkeo/common"
 
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
 	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/opt"
 	"github.com/syndtr/goleveldb/leveldb/storage"
 	"github.com/syndtr/goleveldb/leveldb/util"
 )
 
+const (
+	// ClaimStoreTypeLevelDB keep claims in leveldb, in memory when no location is configured
+	ClaimStoreTypeLevelDB = "leveldb"
+	// ClaimStoreTypeBolt keep claims in a bbolt file, every write is synced to disk before it returns
+	ClaimStoreTypeBolt = "bolt"
+)
+
+// ClaimStorage is where the sentinel keeps the latest signed claim of each contract until the provider claims it
+type ClaimStorage interface {
+	Set(item Claim) error
+	Batch(items []Claim) error
+	Get(key string) (Claim, error)
+	Has(key string) bool
+	Remove(key string) error
+	List() []Claim
+	// Compact reclaim the space held by the claims overwritten or removed
+	Compact() error
+	// Flush sync the claims written to disk
+	Flush() error
+	Close() error
+}
+
+var (
+	_ ClaimStorage = &ClaimStore{}
+	_ ClaimStorage = &BoltClaimStore{}
+)
+
+// NewClaimStorage create the claim store of the given type at location
+func NewClaimStorage(storeType, location string) (ClaimStorage, error) {
+	switch strings.ToLower(storeType) {
+	case "", ClaimStoreTypeLevelDB:
+		return NewClaimStore(location)
+	case ClaimStoreTypeBolt:
+		return NewBoltClaimStore(location)
+	default:
+		return nil, fmt.Errorf("unsupported claim store type: %s", storeType)
+	}
+}
+
 type ClaimStore struct {
 	logger zerolog.Logger
 	db     *leveldb.DB
@@ -137,6 +178,21 @@ func (s *ClaimStore) List() []Claim {
 	return results
 }
 
+// Compact drop the previous versions of the claims and the removed ones from the whole key range
+func (s *ClaimStore) Compact() error {
+	return s.db.CompactRange(util.Range{})
+}
+
+// claimFlushKey is deleted with a synced write to sync the journal of the claims written before, it never holds a claim
+var claimFlushKey = []byte("flush")
+
+// Flush sync the journal to disk, the claims are otherwise written without waiting for the disk
+func (s *ClaimStore) Flush() error {
+	batch := new(leveldb.Batch)
+	batch.Delete(claimFlushKey)
+	return s.db.Write(batch, &opt.WriteOptions{Sync: true})
+}
+
 // Close underlying db
 func (s *ClaimStore) Close() error {
 	return s.db.Close()
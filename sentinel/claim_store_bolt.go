@@ -0,0 +1,139 @@
+package sentinel
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+	bolt "go.etcd.io/bbolt"
+)
+
+var claimsBucket = []byte("claims")
+
+// BoltClaimStore keep claims in a single bbolt file. Every write is committed and synced before it returns, so a
+// claim accepted by the sentinel survives a crash or restart of the process
+type BoltClaimStore struct {
+	logger zerolog.Logger
+	db     *bolt.DB
+}
+
+// NewBoltClaimStore open (or create) the claim store file at location, outstanding claims are available as soon
+// as it returns
+func NewBoltClaimStore(location string) (*BoltClaimStore, error) {
+	if len(location) == 0 {
+		return nil, fmt.Errorf("bolt claim store requires a location")
+	}
+	if err := os.MkdirAll(filepath.Dir(location), 0o755); err != nil {
+		return nil, fmt.Errorf("fail to create claim store folder: %w", err)
+	}
+	db, err := bolt.Open(location, 0o600, &bolt.Options{Timeout: 5 * time.Second})
+	if err != nil {
+		return nil, fmt.Errorf("fail to open bolt db %s: %w", location, err)
+	}
+	if err := db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(claimsBucket)
+		return err
+	}); err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("fail to create claims bucket: %w", err)
+	}
+	store := &BoltClaimStore{
+		logger: log.With().Str("module", "claim-storage").Logger(),
+		db:     db,
+	}
+	store.logger.Info().Int("claims", len(store.List())).Str("location", location).Msg("loaded claim store")
+	return store, nil
+}
+
+func (s *BoltClaimStore) Set(item Claim) error {
+	return s.Batch([]Claim{item})
+}
+
+func (s *BoltClaimStore) Batch(items []Claim) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(claimsBucket)
+		for _, item := range items {
+			buf, err := json.Marshal(item)
+			if err != nil {
+				s.logger.Error().Err(err).Msg("fail to marshal to claim store item")
+				return err
+			}
+			if err := bucket.Put([]byte(item.Key()), buf); err != nil {
+				s.logger.Error().Err(err).Msg("fail to set claim item")
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (s *BoltClaimStore) Get(key string) (item Claim, err error) {
+	err = s.db.View(func(tx *bolt.Tx) error {
+		buf := tx.Bucket(claimsBucket).Get([]byte(key))
+		if buf == nil {
+			return nil
+		}
+		if err := json.Unmarshal(buf, &item); err != nil {
+			s.logger.Error().Err(err).Msg("fail to unmarshal to claim store item")
+			return err
+		}
+		return nil
+	})
+	return
+}
+
+// Has check whether the given key exist in key value store
+func (s *BoltClaimStore) Has(key string) (ok bool) {
+	_ = s.db.View(func(tx *bolt.Tx) error {
+		ok = tx.Bucket(claimsBucket).Get([]byte(key)) != nil
+		return nil
+	})
+	return
+}
+
+// Remove remove the given item from key values store
+func (s *BoltClaimStore) Remove(key string) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(claimsBucket).Delete([]byte(key))
+	})
+}
+
+// List return all the claims in the store
+func (s *BoltClaimStore) List() []Claim {
+	var results []Claim
+	_ = s.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(claimsBucket).ForEach(func(_, buf []byte) error {
+			if len(buf) == 0 {
+				return nil
+			}
+			var item Claim
+			if err := json.Unmarshal(buf, &item); err != nil {
+				s.logger.Error().Err(err).Msg("fail to unmarshal to claim store item")
+				return nil
+			}
+			results = append(results, item)
+			return nil
+		})
+	})
+	return results
+}
+
+// Compact is a no-op, bbolt overwrites the claims in place and reuse the pages freed by the removed ones for the next
+// writes. The file doesn't shrink while it is open
+func (s *BoltClaimStore) Compact() error {
+	return nil
+}
+
+// Flush is a no-op, every write is synced before it returns
+func (s *BoltClaimStore) Flush() error {
+	return nil
+}
+
+// Close underlying db
+func (s *BoltClaimStore) Close() error {
+	return s.db.Close()
+}
@@ -1,7 +1,13 @@
 package sentinel
 
 import (
+	"bufio"
+	"fmt"
 	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -50,3 +56,70 @@ func (s *ClaimStoreSuite) TearDownSuite(t *testing.T) {
 func TestClaimStoreSuite(t *testing.T) {
 	suite.Run(t, new(ClaimStoreSuite))
 }
+
+const claimWriterEnv = "SENTINEL_CLAIM_WRITER"
+
+// TestClaimWriterProcess is not a test, it is run in a child process by TestClaimStoreCrashRecovery to write claims
+// and get killed before it can close the store
+func TestClaimWriterProcess(t *testing.T) {
+	args := strings.Split(os.Getenv(claimWriterEnv), ",")
+	if len(args) != 2 {
+		t.Skip("only run as a child process")
+	}
+	_ = newTestConfig() // the claims are written with the arkeo pubkey prefix the parent reads them with
+	store, err := NewClaimStorage(args[0], args[1])
+	require.NoError(t, err)
+	spender := types.GetRandomPubKey()
+	for contractId := uint64(1); contractId <= 3; contractId++ {
+		for nonce := int64(1); nonce <= 50; nonce++ {
+			require.NoError(t, store.Set(NewClaim(contractId, spender, nonce, fmt.Sprintf("sig-%d-%d", contractId, nonce))))
+		}
+	}
+	fmt.Println("ready")
+	// wait to be killed
+	select {}
+}
+
+func TestClaimStoreCrashRecovery(t *testing.T) {
+	_ = newTestConfig()
+	for _, storeType := range []string{ClaimStoreTypeBolt, ClaimStoreTypeLevelDB} {
+		t.Run(storeType, func(t *testing.T) {
+			location := filepath.Join(t.TempDir(), "claims")
+			cmd := exec.Command(os.Args[0], "-test.run=^TestClaimWriterProcess$")
+			cmd.Env = append(os.Environ(), claimWriterEnv+"="+storeType+","+location)
+			stdout, err := cmd.StdoutPipe()
+			require.NoError(t, err)
+			require.NoError(t, cmd.Start())
+			line, err := bufio.NewReader(stdout).ReadString('\n')
+			require.NoError(t, err)
+			require.Equal(t, "ready\n", line)
+			require.NoError(t, cmd.Process.Kill())
+			_ = cmd.Wait()
+
+			// restart the store, every contract keep its highest nonce claim
+			store, err := NewClaimStorage(storeType, location)
+			require.NoError(t, err)
+			defer store.Close()
+			require.Len(t, store.List(), 3)
+			for contractId := uint64(1); contractId <= 3; contractId++ {
+				claim, err := store.Get(strconv.FormatUint(contractId, 10))
+				require.NoError(t, err)
+				require.Equal(t, int64(50), claim.Nonce)
+				require.Equal(t, fmt.Sprintf("sig-%d-50", contractId), claim.Signature)
+				require.False(t, claim.Claimed)
+			}
+		})
+	}
+}
+
+func TestNewClaimStorage(t *testing.T) {
+	store, err := NewClaimStorage("", "")
+	require.NoError(t, err)
+	require.IsType(t, &ClaimStore{}, store)
+	require.NoError(t, store.Close())
+
+	_, err = NewClaimStorage(ClaimStoreTypeBolt, "")
+	require.Error(t, err)
+	_, err = NewClaimStorage("sqlite", "claims")
+	require.Error(t, err)
+}
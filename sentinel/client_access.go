@@ -0,0 +1,134 @@
+package sentinel
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/cometbft/cometbft/libs/log"
+
+	"github.com/arkeonetwork/arkeo/common"
+	"github.com/arkeonetwork/arkeo/sentinel/conf"
+)
+
+const (
+	ClientRejectedDenied     = "denied"      // the client pubkey is on the denylist
+	ClientRejectedNotAllowed = "not_allowed" // an allowlist is configured and the client pubkey isn't on it
+)
+
+// ClientRejected is the body of the response to a client refused by the pubkey lists
+type ClientRejected struct {
+	Error  string `json:"error"`
+	Reason string `json:"reason"`
+	PubKey string `json:"pubkey,omitempty"`
+}
+
+// ClientAccess is the client pubkeys the sentinel refuses to serve, and the only ones it serves when an allowlist is
+// configured. The denylist takes precedence, a key on both lists is refused
+type ClientAccess struct {
+	allow    map[string]struct{} // bech32 pubkeys
+	deny     map[string]struct{}
+	freeTier bool // the allowlist applies to the free tier too, free tier clients aren't authenticated so it is closed
+}
+
+func NewClientAccess(config conf.Configuration) (*ClientAccess, error) {
+	allow, err := parsePubKeySet(config.ClientAllowList)
+	if err != nil {
+		return nil, fmt.Errorf("invalid client allowlist: %w", err)
+	}
+	deny, err := parsePubKeySet(config.ClientDenyList)
+	if err != nil {
+		return nil, fmt.Errorf("invalid client denylist: %w", err)
+	}
+	return &ClientAccess{allow: allow, deny: deny, freeTier: config.ClientAllowListFreeTier}, nil
+}
+
+func parsePubKeySet(raw []string) (map[string]struct{}, error) {
+	set := make(map[string]struct{}, len(raw))
+	for _, item := range raw {
+		pk, err := common.NewPubKey(item)
+		if err != nil {
+			return nil, fmt.Errorf("%s is not a pubkey: %w", item, err)
+		}
+		set[pk.String()] = struct{}{}
+	}
+	return set, nil
+}
+
+// Check return why the contract keys are refused, nil when they are served. A contract is refused as soon as one of
+// its keys is denied, and served when one of them is allowed
+func (a *ClientAccess) Check(pubkeys ...common.PubKey) *ClientRejected {
+	if a == nil {
+		return nil
+	}
+	for _, pk := range pubkeys {
+		if _, ok := a.deny[pk.String()]; ok && !pk.IsEmpty() {
+			return &ClientRejected{Error: "client is denied", Reason: ClientRejectedDenied, PubKey: pk.String()}
+		}
+	}
+	if len(a.allow) == 0 {
+		return nil
+	}
+	for _, pk := range pubkeys {
+		if _, ok := a.allow[pk.String()]; ok && !pk.IsEmpty() {
+			return nil
+		}
+	}
+	rejected := &ClientRejected{Error: "client is not allowed", Reason: ClientRejectedNotAllowed}
+	if len(pubkeys) > 0 {
+		rejected.PubKey = pubkeys[0].String()
+	}
+	return rejected
+}
+
+// CheckFreeTier return why a free tier request is refused, nil when it is served. The pubkey a free tier client sends
+// is only checked against the denylist, it isn't proven to be the client's
+func (a *ClientAccess) CheckFreeTier(pubkey string) *ClientRejected {
+	if a == nil {
+		return nil
+	}
+	if _, ok := a.deny[pubkey]; ok && len(pubkey) > 0 {
+		return &ClientRejected{Error: "client is denied", Reason: ClientRejectedDenied, PubKey: pubkey}
+	}
+	if a.freeTier && len(a.allow) > 0 {
+		return &ClientRejected{Error: "free tier is not allowed", Reason: ClientRejectedNotAllowed, PubKey: pubkey}
+	}
+	return nil
+}
+
+func respondWithClientRejected(w http.ResponseWriter, rejected *ClientRejected) {
+	respondWithJSON(w, http.StatusForbidden, rejected)
+}
+
+// pubKeySetChanges return the keys added and removed from one set to the next
+func pubKeySetChanges(previous, next map[string]struct{}) (added, removed []string) {
+	for pk := range next {
+		if _, ok := previous[pk]; !ok {
+			added = append(added, pk)
+		}
+	}
+	for pk := range previous {
+		if _, ok := next[pk]; !ok {
+			removed = append(removed, pk)
+		}
+	}
+	sort.Strings(added)
+	sort.Strings(removed)
+	return added, removed
+}
+
+// logChanges log the keys added to and removed from the lists
+func (a *ClientAccess) logChanges(previous *ClientAccess, logger log.Logger) {
+	if previous == nil {
+		previous = &ClientAccess{}
+	}
+	allowAdded, allowRemoved := pubKeySetChanges(previous.allow, a.allow)
+	denyAdded, denyRemoved := pubKeySetChanges(previous.deny, a.deny)
+	if len(allowAdded)+len(allowRemoved)+len(denyAdded)+len(denyRemoved) == 0 && previous.freeTier == a.freeTier {
+		return
+	}
+	logger.Info("client access lists changed",
+		"allow_added", allowAdded, "allow_removed", allowRemoved,
+		"deny_added", denyAdded, "deny_removed", denyRemoved,
+		"allowlist_free_tier", a.freeTier)
+}
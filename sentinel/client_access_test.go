@@ -0,0 +1,117 @@
+package sentinel
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/arkeonetwork/arkeo/common"
+	"github.com/arkeonetwork/arkeo/sentinel/conf"
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+)
+
+func TestClientAccess(t *testing.T) {
+	both, allowed, denied, other := types.GetRandomPubKey(), types.GetRandomPubKey(), types.GetRandomPubKey(), types.GetRandomPubKey()
+	config := newTestConfig()
+	config.ClientAllowList = []string{both.String(), allowed.String()}
+	config.ClientDenyList = []string{both.String(), denied.String()}
+	access, err := NewClientAccess(config)
+	require.NoError(t, err)
+
+	// the denylist takes precedence
+	rejected := access.Check(both)
+	require.NotNil(t, rejected)
+	require.Equal(t, ClientRejectedDenied, rejected.Reason)
+	require.Equal(t, both.String(), rejected.PubKey)
+	require.Nil(t, access.Check(allowed))
+	require.Nil(t, access.Check(allowed, common.EmptyPubKey))
+	require.Equal(t, ClientRejectedNotAllowed, access.Check(other).Reason)
+	// a denied delegate refuse the contract
+	require.Equal(t, ClientRejectedDenied, access.Check(allowed, denied).Reason)
+
+	// free tier pubkeys are only checked against the denylist, unless the allowlist close the free tier
+	require.Nil(t, access.CheckFreeTier(""))
+	require.Nil(t, access.CheckFreeTier(allowed.String()))
+	require.Equal(t, ClientRejectedDenied, access.CheckFreeTier(both.String()).Reason)
+	config.ClientAllowListFreeTier = true
+	access, err = NewClientAccess(config)
+	require.NoError(t, err)
+	require.Equal(t, ClientRejectedNotAllowed, access.CheckFreeTier(allowed.String()).Reason)
+
+	// without an allowlist everyone not denied is served
+	config.ClientAllowList = nil
+	access, err = NewClientAccess(config)
+	require.NoError(t, err)
+	require.Nil(t, access.Check(other))
+	require.Nil(t, access.CheckFreeTier(""))
+
+	config.ClientDenyList = []string{"not-a-pubkey"}
+	_, err = NewClientAccess(config)
+	require.Error(t, err)
+}
+
+func TestClientAccessAuth(t *testing.T) {
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("{}"))
+	}))
+	defer upstream.Close()
+
+	allowed := newWebsocketContract(1, 100, 100)
+	other := newWebsocketContract(2, 100, 100)
+	config := newTestConfig()
+	config.ClientAllowList = []string{allowed.Client.String()}
+	// the upstream is configured, so it is kept by the reload
+	config.Services = map[string]conf.ServiceConfiguration{common.BTCService.String(): {Upstream: upstream.URL}}
+	proxy, err := NewProxy(config)
+	require.NoError(t, err)
+	proxy.MemStore.SetHeight(10)
+	proxy.MemStore.Put(allowed)
+	proxy.MemStore.Put(other)
+	router := proxy.getRouter()
+
+	get := func(contractId uint64, nonce int) (int, ClientRejected) {
+		path := fmt.Sprintf("/%s", common.BTCService)
+		if contractId > 0 {
+			path = fmt.Sprintf("%s?%s=%d:%d", path, QueryArkAuth, contractId, nonce)
+		}
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+		var rejected ClientRejected
+		if w.Code == http.StatusForbidden {
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &rejected))
+		}
+		return w.Code, rejected
+	}
+
+	code, _ := get(allowed.Id, 1)
+	require.Equal(t, http.StatusOK, code)
+	code, rejected := get(other.Id, 1)
+	require.Equal(t, http.StatusForbidden, code)
+	require.Equal(t, ClientRejectedNotAllowed, rejected.Reason)
+	require.Equal(t, other.Client.String(), rejected.PubKey)
+	// the free tier stays open unless configured otherwise
+	code, _ = get(0, 0)
+	require.Equal(t, http.StatusOK, code)
+
+	// the lists are reloaded without a restart
+	next := proxy.Config
+	next.ClientAllowList = []string{allowed.Client.String(), other.Client.String()}
+	next.ClientDenyList = []string{allowed.Client.String()}
+	next.ClientAllowListFreeTier = true
+	result, err := proxy.Reload(next)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"client_allow_list", "client_deny_list", "client_allow_list_free_tier"}, result.Applied)
+
+	code, rejected = get(allowed.Id, 2)
+	require.Equal(t, http.StatusForbidden, code)
+	require.Equal(t, ClientRejectedDenied, rejected.Reason)
+	code, _ = get(other.Id, 2)
+	require.Equal(t, http.StatusOK, code)
+	code, rejected = get(0, 0)
+	require.Equal(t, http.StatusForbidden, code)
+	require.Equal(t, ClientRejectedNotAllowed, rejected.Reason)
+}
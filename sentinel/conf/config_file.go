@@ -0,0 +1,77 @@
+package conf
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+)
+
+var (
+	configFileLock sync.Mutex
+	// configFileKeys is the env vars set from the config file, along with their value before it was loaded, nil when
+	// they were unset
+	configFileKeys = make(map[string]*string)
+)
+
+// LoadConfigFile set the KEY=VALUE lines of the file as env vars, over the process env. The vars set by a previous
+// load are restored first, so a line removed from the file no longer applies
+func LoadConfigFile(path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("fail to open config file: %w", err)
+	}
+	defer file.Close()
+
+	values := make(map[string]string)
+	scanner := bufio.NewScanner(file)
+	for line := 1; scanner.Scan(); line++ {
+		raw := strings.TrimSpace(scanner.Text())
+		if raw == "" || strings.HasPrefix(raw, "#") {
+			continue
+		}
+		key, value, ok := strings.Cut(strings.TrimPrefix(raw, "export "), "=")
+		key = strings.TrimSpace(key)
+		if !ok || key == "" {
+			return fmt.Errorf("config file %s line %d: expected KEY=VALUE", path, line)
+		}
+		values[key] = strings.Trim(strings.TrimSpace(value), `"'`)
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("fail to read config file: %w", err)
+	}
+
+	configFileLock.Lock()
+	defer configFileLock.Unlock()
+	for key, previous := range configFileKeys {
+		if previous == nil {
+			_ = os.Unsetenv(key)
+		} else {
+			_ = os.Setenv(key, *previous)
+		}
+	}
+	configFileKeys = make(map[string]*string)
+	for key, value := range values {
+		if previous, ok := os.LookupEnv(key); ok {
+			configFileKeys[key] = &previous
+		} else {
+			configFileKeys[key] = nil
+		}
+		if err := os.Setenv(key, value); err != nil {
+			return fmt.Errorf("fail to set %s: %w", key, err)
+		}
+	}
+	return nil
+}
+
+// ReloadConfiguration read the configuration again, from the config file and the env, an invalid value is returned
+// as an error rather than a panic
+func ReloadConfiguration() (config Configuration, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("invalid configuration: %v", r)
+		}
+	}()
+	return NewConfiguration(), nil
+}
@@ -0,0 +1,31 @@
+package conf
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadConfigFile(t *testing.T) {
+	t.Setenv("MONIKER", "env")
+	t.Setenv("WEBSITE", "")
+	os.Unsetenv("WEBSITE")
+	file := filepath.Join(t.TempDir(), "sentinel.env")
+
+	require.NoError(t, os.WriteFile(file, []byte("# sentinel\nMONIKER=\"file\"\nexport WEBSITE=webby\n\n"), 0o600))
+	require.NoError(t, LoadConfigFile(file))
+	require.Equal(t, "file", os.Getenv("MONIKER"))
+	require.Equal(t, "webby", os.Getenv("WEBSITE"))
+
+	// the lines removed from the file no longer apply
+	require.NoError(t, os.WriteFile(file, []byte("WEBSITE=other\n"), 0o600))
+	require.NoError(t, LoadConfigFile(file))
+	require.Equal(t, "env", os.Getenv("MONIKER"))
+	require.Equal(t, "other", os.Getenv("WEBSITE"))
+
+	require.NoError(t, os.WriteFile(file, []byte("MONIKER\n"), 0o600))
+	require.Error(t, LoadConfigFile(file))
+	require.Error(t, LoadConfigFile(filepath.Join(t.TempDir(), "missing.env")))
+}
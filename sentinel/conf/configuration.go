@@ -1,8 +1,11 @@
 package conf
 
 import (
+	"encoding/base64"
 	"fmt"
 	"os"
+	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 	"text/tabwriter"
@@ -10,25 +13,414 @@ import (
 	"github.com/arkeonetwork/arkeo/common"
 )
 
+// defaultTrustedProxyCIDRs are the loopback and private ranges a reverse proxy in front of the sentinel usually is in
+const defaultTrustedProxyCIDRs = "127.0.0.0/8,::1/128,10.0.0.0/8,172.16.0.0/12,192.168.0.0/16,fc00::/7"
+
 type TLSConfiguration struct {
 	Cert string `json:"tls_certificate"`
 	Key  string `json:"tls_key"`
+	// ACME obtain certificates automatically for Hosts instead of using Cert and Key
+	ACME         bool     `json:"acme"`
+	ACMEEmail    string   `json:"acme_email"`
+	ACMECacheDir string   `json:"acme_cache_dir"`
+	Hosts        []string `json:"hosts"` // public host names of the sentinel, also the only hosts certificates are requested for
+	Port         string   `json:"port"`  // https port, the sentinel port then only redirects to https
+}
+
+// ServiceConfiguration is the settings of one of the services served by the sentinel, zero values fall back to the
+// sentinel wide settings
+type ServiceConfiguration struct {
+	// Upstream replace the upstream url of the service, it may carry credentials so it isn't advertised
+	Upstream string `json:"-"`
+	// PathRewrite replace the service segment of the request path, instead of the upstream url path
+	PathRewrite    string `json:"-"`
+	TimeoutSeconds int64  `json:"timeout_seconds,omitempty"` // time the upstream has to answer a plain http request
+	RateLimit      int    `json:"rate_limit,omitempty"`      // requests per minute to the service across all clients
+	// MaxRequestBytes and MaxResponseBytes bound the size of the bodies of plain http requests, the former defaults to
+	// 1MB, the latter is unbounded by default
+	MaxRequestBytes  int64 `json:"max_request_bytes,omitempty"`
+	MaxResponseBytes int64 `json:"max_response_bytes,omitempty"`
+	// DialTimeoutMs and ResponseHeaderTimeoutMs bound the time to connect to the upstream and for it to send the
+	// response headers, within TimeoutSeconds
+	DialTimeoutMs           int64 `json:"dial_timeout_ms,omitempty"`
+	ResponseHeaderTimeoutMs int64 `json:"response_header_timeout_ms,omitempty"`
+	// MaxIdleConns is the idle connections kept open to the upstream (default 64), for IdleConnTimeoutSeconds (default
+	// 90). MaxConns caps the connections to the upstream, uncapped by default. DisableHTTP2 keeps the https upstreams
+	// on http/1.1
+	MaxIdleConns           int   `json:"max_idle_conns,omitempty"`
+	IdleConnTimeoutSeconds int64 `json:"idle_conn_timeout_seconds,omitempty"`
+	MaxConns               int   `json:"max_conns,omitempty"`
+	DisableHTTP2           bool  `json:"disable_http2,omitempty"`
+	// FreeTierRateLimit and FreeTierDailyLimit override the free tier allowance per client, a negative per minute
+	// limit close the free tier of the service
+	FreeTierRateLimit  int `json:"free_tier_rate_limit,omitempty"`
+	FreeTierDailyLimit int `json:"free_tier_daily_limit,omitempty"`
+	// Costs is the number of queries the heavy requests of the service are charged, the first matching entry applies
+	// and the other requests cost one query
+	Costs []ServiceCost `json:"costs,omitempty"`
+	// StreamMaxSeconds and StreamMaxBytes bound the responses streamed back as they come (server sent events, chunked
+	// bodies) in place of TimeoutSeconds and MaxResponseBytes, both unbounded by default
+	StreamMaxSeconds int64 `json:"stream_max_seconds,omitempty"`
+	StreamMaxBytes   int64 `json:"stream_max_bytes,omitempty"`
+	// StreamAccounting is how the streamed responses are charged, request (default), minute or event
+	StreamAccounting string `json:"stream_accounting,omitempty"`
+	// MinDeposits is the deposit a contract of the service must have left to be served, one amount per denom (e.g.
+	// 1000000uarkeo), the contracts in other denoms have no minimum
+	MinDeposits []string `json:"min_deposits,omitempty"`
+	// Retries is the number of times an idempotent request, GET, HEAD or JSON-RPC calls all listed in RetryRPCMethods,
+	// is sent again after a connection error or a 502, 503 or 504 of the upstream. The attempts are RetryBackoffMs
+	// (default 100) apart, doubled on each attempt and jittered
+	Retries         int      `json:"retries,omitempty"`
+	RetryBackoffMs  int64    `json:"retry_backoff_ms,omitempty"`
+	RetryRPCMethods []string `json:"retry_rpc_methods,omitempty"`
+	// BreakerErrorRate is the percentage of the requests failing upstream, over BreakerWindowSeconds (default 60) and
+	// at least BreakerMinRequests (default 10), that opens the circuit breaker of the service: its requests are refused
+	// for BreakerOpenSeconds (default 30), then a single probe request closes it or opens it again. 0 disables it
+	BreakerErrorRate     int   `json:"breaker_error_rate,omitempty"`
+	BreakerMinRequests   int   `json:"breaker_min_requests,omitempty"`
+	BreakerWindowSeconds int64 `json:"breaker_window_seconds,omitempty"`
+	BreakerOpenSeconds   int64 `json:"breaker_open_seconds,omitempty"`
+	// StripHeaders are removed from the requests sent upstream, on top of the arkeo auth headers which always are, and
+	// InjectHeaders set on them, e.g. the credentials of the upstream. StripResponseHeaders are removed from the
+	// responses, e.g. Server
+	StripHeaders         []string          `json:"strip_headers,omitempty"`
+	InjectHeaders        map[string]string `json:"-"`
+	StripResponseHeaders []string          `json:"strip_response_headers,omitempty"`
+	// HideClientIP stop sending the address of the client upstream in X-Forwarded-For and X-Real-Ip
+	HideClientIP bool `json:"hide_client_ip,omitempty"`
+	// FreePaths are path.Match patterns of the request path past the service segment served without a contract nor
+	// billing, e.g. /health, under an anonymous limit of FreePathRateLimit requests per minute per client ip (default 10)
+	FreePaths         []string `json:"free_paths,omitempty"`
+	FreePathRateLimit int      `json:"free_path_rate_limit,omitempty"`
+	// MaxStreamedRequestBytes stream the request bodies of unknown length (chunked uploads), and those over
+	// MaxRequestBytes, to the upstream as they are read, up to this size counted as they go. 0 buffers the bodies of
+	// unknown length up to MaxRequestBytes instead
+	MaxStreamedRequestBytes int64 `json:"max_streamed_request_bytes,omitempty"`
+	// CacheTTLSeconds keep the responses to the GET requests of the service, up to CacheMaxEntryBytes each (default
+	// 64KB), for the identical requests within the ttl. The hits are charged as the requests they answer unless
+	// CacheFreeHits is set. 0 disables the cache
+	CacheTTLSeconds    int64 `json:"cache_ttl_seconds,omitempty"`
+	CacheMaxEntryBytes int64 `json:"cache_max_entry_bytes,omitempty"`
+	CacheFreeHits      bool  `json:"cache_free_hits,omitempty"`
+	// UpstreamAuth is the credentials the requests are sent upstream with, it is never advertised
+	UpstreamAuth UpstreamAuth `json:"-"`
+	// ResponseAccounting is how the response bodies are charged: request (default) by the cost of their request only,
+	// bytes by the units of ResponseByteUnit bytes (default 1MiB) they start, the cost of the request paying for the
+	// first ones, or request_bytes by both
+	ResponseAccounting string `json:"response_accounting,omitempty"`
+	ResponseByteUnit   int64  `json:"response_byte_unit,omitempty"`
+	// JSONRPC charge and rate limit the POST bodies holding a JSON-RPC batch by their calls, a batch of more than
+	// MaxBatchSize calls (default 100) is refused
+	JSONRPC      bool `json:"json_rpc,omitempty"`
+	MaxBatchSize int  `json:"max_batch_size,omitempty"`
+}
+
+// UpstreamAuth set Header to Value on the requests sent upstream, in place of the header of that name the client sent
+type UpstreamAuth struct {
+	Type   string // bearer, basic or header
+	Header string // Authorization, but for the header type
+	Value  string // holds the secret, it is never printed
+}
+
+// ServiceCost charge Cost queries for the requests matching all of its non empty fields
+type ServiceCost struct {
+	Method    string `json:"method,omitempty"`     // http method
+	Path      string `json:"path,omitempty"`       // path.Match pattern of the request path past the service segment
+	RPCMethod string `json:"rpc_method,omitempty"` // JSON-RPC method, each call of a batch is charged on its own
+	Cost      int64  `json:"cost"`
+}
+
+// parseServiceCost parse a cost entry, either rpc:METHOD:COST or [HTTP_METHOD ]PATH:COST
+func parseServiceCost(raw string) (ServiceCost, error) {
+	i := strings.LastIndex(raw, ":")
+	if i < 0 {
+		return ServiceCost{}, fmt.Errorf("cost %s is missing the number of queries", raw)
+	}
+	cost, err := strconv.ParseInt(raw[i+1:], 10, 64)
+	if err != nil {
+		return ServiceCost{}, fmt.Errorf("cost %s is not an integer: %w", raw, err)
+	}
+	matcher := strings.TrimSpace(raw[:i])
+	if method, ok := strings.CutPrefix(matcher, "rpc:"); ok {
+		return ServiceCost{RPCMethod: method, Cost: cost}, nil
+	}
+	if method, path, ok := strings.Cut(matcher, " "); ok {
+		return ServiceCost{Method: strings.ToUpper(method), Path: strings.TrimSpace(path), Cost: cost}, nil
+	}
+	return ServiceCost{Path: matcher, Cost: cost}, nil
+}
+
+// getEnvServiceCosts return the comma separated service=cost entries of an env var, a service may be listed several
+// times
+func getEnvServiceCosts(key string) map[string][]ServiceCost {
+	result := make(map[string][]ServiceCost)
+	for _, item := range getEnvList(key) {
+		service, raw, ok := strings.Cut(item, "=")
+		if !ok {
+			panic(fmt.Errorf("env var %s entry %s is not a service=cost pair", key, item))
+		}
+		cost, err := parseServiceCost(strings.TrimSpace(raw))
+		if err != nil {
+			panic(fmt.Errorf("env var %s entry %s: %s", key, item, err))
+		}
+		service = strings.TrimSpace(service)
+		result[service] = append(result[service], cost)
+	}
+	return result
+}
+
+// getEnvMapList return the comma separated key=value entries of an env var, a key may be listed several times
+func getEnvMapList(key string) map[string][]string {
+	result := make(map[string][]string)
+	for _, item := range getEnvList(key) {
+		k, v, ok := strings.Cut(item, "=")
+		if !ok {
+			panic(fmt.Errorf("env var %s entry %s is not a key=value pair", key, item))
+		}
+		k = strings.TrimSpace(k)
+		result[k] = append(result[k], strings.TrimSpace(v))
+	}
+	return result
+}
+
+// HasFreeTierOverride return true when the service doesn't use the sentinel wide free tier allowance
+func (c ServiceConfiguration) HasFreeTierOverride() bool {
+	return c.FreeTierRateLimit != 0 || c.FreeTierDailyLimit != 0
+}
+
+// CORSConfiguration control the CORS headers browsers need to call the sentinel from another origin
+type CORSConfiguration struct {
+	AllowOrigins []string `json:"allow_origins"` // origins allowed, * for any, no CORS headers are sent when empty
+	AllowMethods []string `json:"allow_methods"`
+	AllowHeaders []string `json:"allow_headers"` // the arkeo auth headers are always allowed on top of them
+	MaxAge       int64    `json:"max_age"`       // seconds a browser caches the answer to a preflight
+}
+
+// RateLimitStoreConfiguration is where the counters of the free tier and of the contracts' queries per minute are
+// kept: in memory (default), each sentinel counting on its own, or in a redis shared by the replicas of a provider
+type RateLimitStoreConfiguration struct {
+	Type string `json:"type"` // memory (default) or redis
+	URL  string `json:"-"`    // redis url of the shared store, it may carry a password
+	// FailClosed refuse the limited requests while the shared store is unreachable, rather than counting them in memory
+	FailClosed bool  `json:"fail_closed"`
+	TimeoutMs  int64 `json:"timeout_ms"` // time a call to the shared store has before it is deemed unreachable
+}
+
+// HealthConfiguration control the checks behind the health endpoint
+type HealthConfiguration struct {
+	// Probes is the path requested on the upstream of each service checked, any answer but a 5xx means it is up
+	Probes map[string]string `json:"probes"`
+	// CacheSeconds is how long the result of the checks is served before they run again
+	CacheSeconds int64 `json:"cache_seconds"`
+	// TimeoutMs is the time budget of the whole health request, ProbeTimeoutMs can't exceed it
+	TimeoutMs      int64 `json:"timeout_ms"`
+	ProbeTimeoutMs int64 `json:"probe_timeout_ms"`
+}
+
+// AutoClaimConfiguration control the worker submitting claims on behalf of the provider
+type AutoClaimConfiguration struct {
+	Enabled bool `json:"enabled"`
+	// DryRun log the claims that would be submitted without broadcasting them
+	DryRun bool `json:"dry_run"`
+	// Threshold is the pending income, in the contract rate denom, above which a contract is claimed
+	Threshold int64 `json:"threshold"`
+	// DenomThresholds replace Threshold for the contracts in a denom, the amounts are integers of any size
+	DenomThresholds map[string]string `json:"denom_thresholds"`
+	// ExpiryBlocks claim any pending income once the contract is within this many blocks of its expiration
+	ExpiryBlocks    int64  `json:"expiry_blocks"`
+	IntervalSeconds int    `json:"interval_seconds"`
+	ChainID         string `json:"chain_id"`
+	NodeRPC         string `json:"node_rpc"`
+	KeyName         string `json:"key_name"`
+	KeyringBackend  string `json:"keyring_backend"`
+	KeyringDir      string `json:"keyring_dir"`
+	GasLimit        uint64 `json:"gas_limit"`
+	GasPrices       string `json:"gas_prices"`
+	Fees            string `json:"fees"`
+	// MaxRetries is the number of times a claim is retried on account sequence mismatch
+	MaxRetries int `json:"max_retries"`
+	// OnShutdown submit the claims due one last time when the sentinel shuts down
+	OnShutdown bool `json:"on_shutdown"`
+	// Authz submit the claims for the provider account, KeyName being the key of an authz grantee of it rather than
+	// any funded account claiming in its own name
+	Authz bool `json:"authz"`
+	// Settle submit the final claim of the pay as you go contracts once expired, before the chain settles them
+	Settle bool `json:"settle"`
+	// SettleBackoff is the seconds before a failed final claim is submitted again, doubled on each failure
+	SettleBackoff int64 `json:"settle_backoff"`
+}
+
+// DevConfiguration run the sentinel against an in-process fake chain, for local development without a funded chain,
+// keys nor open contracts. It can't be enabled along with a mainnet chain id
+type DevConfiguration struct {
+	Enabled bool `json:"enabled"`
+	// Contracts are the contracts the fake chain has open, one contract of the test key on each service when empty
+	Contracts []DevContract `json:"contracts"`
+	// AcceptTestKey accept the arkauth signed with the documented test key for any of the contracts, whoever their
+	// client is. The signatures of the contracts' clients are verified either way
+	AcceptTestKey bool `json:"accept_test_key"`
+}
+
+// DevContract is a contract open on the fake chain of the dev mode
+type DevContract struct {
+	Id               uint64 `json:"id"`
+	Service          string `json:"service"`
+	Client           string `json:"client"` // client pubkey, the test key when empty
+	Rate             string `json:"rate"`   // rate of a query or of the subscription, e.g. 1uarkeo
+	QueriesPerMinute int64  `json:"queries_per_minute"`
+	Subscription     bool   `json:"subscription"` // pay as you go when false
+}
+
+// parseDevContract parse a contract of the dev mode, ID=SERVICE[:CLIENT[:RATE[:QPM[:TYPE]]]], the type being
+// subscription or payg
+func parseDevContract(raw string) (DevContract, error) {
+	id, fields, ok := strings.Cut(raw, "=")
+	if !ok {
+		return DevContract{}, fmt.Errorf("dev contract %s is not an ID=SERVICE entry", raw)
+	}
+	var contract DevContract
+	var err error
+	if contract.Id, err = strconv.ParseUint(strings.TrimSpace(id), 10, 64); err != nil || contract.Id == 0 {
+		return DevContract{}, fmt.Errorf("dev contract %s id is not a positive integer", raw)
+	}
+	parts := strings.Split(fields, ":")
+	contract.Service = strings.TrimSpace(parts[0])
+	if len(parts) > 1 {
+		contract.Client = strings.TrimSpace(parts[1])
+	}
+	if len(parts) > 2 {
+		contract.Rate = strings.TrimSpace(parts[2])
+	}
+	if len(parts) > 3 && len(strings.TrimSpace(parts[3])) > 0 {
+		if contract.QueriesPerMinute, err = strconv.ParseInt(strings.TrimSpace(parts[3]), 10, 64); err != nil {
+			return DevContract{}, fmt.Errorf("dev contract %s queries per minute is not an integer: %w", raw, err)
+		}
+	}
+	if len(parts) > 4 {
+		switch strings.TrimSpace(parts[4]) {
+		case "subscription":
+			contract.Subscription = true
+		case "payg", "":
+		default:
+			return DevContract{}, fmt.Errorf("dev contract %s type is neither subscription nor payg", raw)
+		}
+	}
+	if len(parts) > 5 {
+		return DevContract{}, fmt.Errorf("dev contract %s has too many fields", raw)
+	}
+	return contract, nil
+}
+
+func NewDevConfiguration() DevConfiguration {
+	var contracts []DevContract
+	for _, raw := range getEnvList("DEV_CONTRACTS") {
+		contract, err := parseDevContract(raw)
+		if err != nil {
+			panic(fmt.Errorf("env var DEV_CONTRACTS: %s", err))
+		}
+		contracts = append(contracts, contract)
+	}
+	return DevConfiguration{
+		Enabled:       getEnvBool("DEV_MODE", false),
+		Contracts:     contracts,
+		AcceptTestKey: getEnvBool("DEV_ACCEPT_TEST_KEY", true),
+	}
+}
+
+// DefaultProviderName is the name of the provider identity of PROVIDER_PUBKEY
+const DefaultProviderName = "default"
+
+// ProviderIdentity is one of the provider pubkeys the sentinel serves, with the services it serves for it and the key
+// its claims are submitted with
+type ProviderIdentity struct {
+	Name         string        `json:"name"`
+	PubKey       common.PubKey `json:"pubkey"`
+	Services     []string      `json:"services"` // services served for the provider, all the sentinel serves when empty
+	ClaimKeyName string        `json:"-"`        // keyring key of its auto claims, AUTO_CLAIM_KEY_NAME when empty
+}
+
+// NewProviderIdentities return the provider identities served on top of PROVIDER_PUBKEY, by name
+func NewProviderIdentities() []ProviderIdentity {
+	pubkeys := getEnvMap("PROVIDER_IDENTITIES")
+	services := getEnvMapList("PROVIDER_IDENTITY_SERVICES")
+	claimKeys := getEnvMap("PROVIDER_IDENTITY_CLAIM_KEYS")
+	identities := make([]ProviderIdentity, 0, len(pubkeys))
+	for name, raw := range pubkeys {
+		pk, err := common.NewPubKey(raw)
+		if err != nil {
+			panic(fmt.Errorf("env var PROVIDER_IDENTITIES entry %s is not a pubkey: %s", name, err))
+		}
+		identities = append(identities, ProviderIdentity{Name: name, PubKey: pk, Services: services[name], ClaimKeyName: claimKeys[name]})
+	}
+	sort.Slice(identities, func(i, j int) bool { return identities[i].Name < identities[j].Name })
+	return identities
+}
+
+// Identities return the provider identities served, the one of PROVIDER_PUBKEY first
+func (c Configuration) Identities() []ProviderIdentity {
+	identities := []ProviderIdentity{{Name: DefaultProviderName, PubKey: c.ProviderPubKey, ClaimKeyName: c.AutoClaim.KeyName}}
+	for _, identity := range c.Providers {
+		if len(identity.ClaimKeyName) == 0 {
+			identity.ClaimKeyName = c.AutoClaim.KeyName
+		}
+		identities = append(identities, identity)
+	}
+	return identities
 }
 
 type Configuration struct {
-	Moniker                     string           `json:"moniker"`
-	Website                     string           `json:"website"`
-	Description                 string           `json:"description"`
-	Location                    string           `json:"location"`
-	Port                        string           `json:"port"`
-	SourceChain                 string           `json:"source_chain"` // base url for arkeo block chain
-	EventStreamHost             string           `json:"event_stream_host"`
-	ClaimStoreLocation          string           `json:"claim_store_location"`           // file location where claims are stored
-	ContractConfigStoreLocation string           `json:"contract_config_store_location"` // file location where contract configurations are stored
-	ProviderConfigStoreLocation string           `json:"provider_config_store_location"` // file location where provider configurations are stored
-	ProviderPubKey              common.PubKey    `json:"provider_pubkey"`
-	FreeTierRateLimit           int              `json:"free_tier_rate_limit"`
-	TLS                         TLSConfiguration `json:"tls"`
+	Moniker                     string                          `json:"moniker"`
+	Website                     string                          `json:"website"`
+	Description                 string                          `json:"description"`
+	Location                    string                          `json:"location"`
+	Port                        string                          `json:"port"`
+	SourceChain                 string                          `json:"source_chain"` // base url for arkeo block chain
+	EventStreamHost             string                          `json:"event_stream_host"`
+	ClaimStoreType              string                          `json:"claim_store_type"`               // claim store backend, leveldb (default) or bolt
+	ClaimStoreLocation          string                          `json:"claim_store_location"`           // file location where claims are stored
+	ClaimCompactionInterval     int64                           `json:"claim_compaction_interval"`      // seconds between the removals of the claims of settled contracts, 0 to only compact from the admin endpoint
+	ClaimArchiveLocation        string                          `json:"claim_archive_location"`         // file the removed claims are appended to, they are deleted when empty
+	ContractConfigStoreLocation string                          `json:"contract_config_store_location"` // file location where contract configurations are stored
+	ContractReconcileInterval   int64                           `json:"contract_reconcile_interval"`    // seconds between the refreshes of the cached contracts from the chain, 0 to only refresh after missed blocks
+	ProviderConfigStoreLocation string                          `json:"provider_config_store_location"` // file location where provider configurations are stored
+	ProviderPubKey              common.PubKey                   `json:"provider_pubkey"`
+	Providers                   []ProviderIdentity              `json:"providers"`              // provider identities served on top of ProviderPubKey
+	AcceptedDenoms              []string                        `json:"accepted_denoms"`        // rate denoms of the contracts served, all when empty
+	FreeTierRateLimit           int                             `json:"free_tier_rate_limit"`   // free tier requests per minute
+	FreeTierDailyLimit          int                             `json:"free_tier_daily_limit"`  // free tier requests per day, 0 for no daily limit
+	FreeTierMaxKeys             int                             `json:"free_tier_max_keys"`     // max number of ips / pubkeys tracked by the free tier
+	FreeTierAllowCIDRs          []string                        `json:"free_tier_allow_cidrs"`  // ip ranges bypassing the free tier limits
+	TrustedProxyCIDRs           []string                        `json:"trusted_proxy_cidrs"`    // ip ranges of the proxies whose forwarding headers give the client address
+	MaxInFlight                 int                             `json:"max_in_flight"`          // requests served at once across the sentinel, 0 for no limit
+	ContractMaxInFlight         int                             `json:"contract_max_in_flight"` // requests served at once per contract, 0 for no limit
+	WebsocketAccounting         map[string]string               `json:"websocket_accounting"`   // per service websocket accounting, message (default) or minute
+	Services                    map[string]ServiceConfiguration `json:"services"`               // services served, all known services when empty
+	ClientAllowList             []string                        `json:"-"`                      // client pubkeys served, all when empty
+	ClientDenyList              []string                        `json:"-"`                      // client pubkeys refused, even when on the allowlist
+	ClientAllowListFreeTier     bool                            `json:"-"`                      // close the free tier when an allowlist is configured
+	GRPCUpstream                map[string]string               `json:"grpc_upstream"`          // per service grpc upstream transport, h2c (default), tls or tls-insecure
+	GRPCAccounting              map[string]string               `json:"grpc_accounting"`        // per service grpc accounting, call (default) or message
+	MetadataChainTTL            int64                           `json:"metadata_chain_ttl"`     // seconds the on chain provider terms are cached for the metadata endpoint
+	TLS                         TLSConfiguration                `json:"tls"`
+	Health                      HealthConfiguration             `json:"health"`
+	CORS                        CORSConfiguration               `json:"cors"`
+	RateLimitStore              RateLimitStoreConfiguration     `json:"rate_limit_store"`
+	ConfigFile                  string                          `json:"-"`                      // optional file of KEY=VALUE env vars, read again on reload
+	AdminToken                  string                          `json:"-"`                      // bearer token of the admin endpoints, they are disabled when empty
+	AdminTokens                 map[string]string               `json:"-"`                      // named bearer tokens of the admin endpoints, the name identifies the caller in the logs
+	ShutdownGracePeriod         int64                           `json:"shutdown_grace_period"`  // seconds the requests in flight have to finish on shutdown
+	AccessLogFile               string                          `json:"-"`                      // file the access log is written to, stdout when empty
+	AccessLogMaxMB              int64                           `json:"-"`                      // size at which the access log file is rotated, 0 to never rotate
+	AccessLogMaxFiles           int                             `json:"-"`                      // rotated access log files kept
+	MetricsListenAddr           string                          `json:"metrics_listen_addr"`    // optional admin address to expose prometheus metrics and the admin endpoints on
+	MetricsMaxContracts         int                             `json:"metrics_max_contracts"`  // max number of contracts labelled in the metrics
+	SignatureCacheSize          int                             `json:"signature_cache_size"`   // max number of arkauth signature verifications cached
+	SignatureNegativeTTL        int64                           `json:"signature_negative_ttl"` // seconds a rejected signature is remembered
+	ArkAuthMinVersion           int                             `json:"arkauth_min_version"`    // oldest arkauth version accepted, 2 refuses the v1 ones not signing the request body
+	ContractGraceBlocks         int64                           `json:"contract_grace_blocks"`  // blocks past its expiration a contract is still served, to absorb a height skew
+	ContractOverdraft           int64                           `json:"contract_overdraft"`     // queries a pay as you go contract is served past its deposit, to absorb rounding
+	AutoClaim                   AutoClaimConfiguration          `json:"auto_claim"`
+	Dev                         DevConfiguration                `json:"dev"`
 }
 
 // Simple helper function to read an environment or return a default value
@@ -47,6 +439,180 @@ func loadVarString(key string) string {
 	return strings.TrimSpace(val)
 }
 
+func getEnvInt(key string, defaultVal int64) int64 {
+	val, exists := os.LookupEnv(key)
+	if !exists {
+		return defaultVal
+	}
+	i, err := strconv.ParseInt(strings.TrimSpace(val), 10, 64)
+	if err != nil {
+		panic(fmt.Errorf("env var %s is not an integer: %s", key, err))
+	}
+	return i
+}
+
+// getEnvList return the comma separated values of an env var
+func getEnvList(key string) []string {
+	return getEnvListDefault(key, "")
+}
+
+// getEnvListDefault return the comma separated values of an env var, or of defaultVal when it isn't set
+func getEnvListDefault(key, defaultVal string) []string {
+	var result []string
+	for _, item := range strings.Split(getEnv(key, defaultVal), ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			result = append(result, item)
+		}
+	}
+	return result
+}
+
+// getEnvMap return the comma separated key=value pairs of an env var
+func getEnvMap(key string) map[string]string {
+	result := make(map[string]string)
+	for _, item := range getEnvList(key) {
+		k, v, ok := strings.Cut(item, "=")
+		if !ok {
+			panic(fmt.Errorf("env var %s entry %s is not a key=value pair", key, item))
+		}
+		result[strings.TrimSpace(k)] = strings.TrimSpace(v)
+	}
+	return result
+}
+
+// getEnvMapBool return the comma separated key=boolean pairs of an env var
+func getEnvMapBool(key string) map[string]bool {
+	result := make(map[string]bool)
+	for k, v := range getEnvMap(key) {
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			panic(fmt.Errorf("env var %s entry %s is not a boolean: %s", key, k, err))
+		}
+		result[k] = b
+	}
+	return result
+}
+
+// getEnvServiceHeaders return the service=Name:value entries of an env var by service, a value env:NAME is read from
+// the NAME env var so secrets can be kept out of the setting
+func getEnvServiceHeaders(key string) map[string]map[string]string {
+	result := make(map[string]map[string]string)
+	for service, entries := range getEnvMapList(key) {
+		headers := make(map[string]string)
+		for _, entry := range entries {
+			name, value, ok := strings.Cut(entry, ":")
+			if !ok || len(strings.TrimSpace(name)) == 0 {
+				panic(fmt.Errorf("env var %s entry %s is not a Name:value header", key, entry))
+			}
+			if env, ok := strings.CutPrefix(value, "env:"); ok {
+				if value, ok = os.LookupEnv(env); !ok {
+					panic(fmt.Errorf("env var %s entry %s reads the unset env var %s", key, entry, env))
+				}
+			}
+			headers[strings.TrimSpace(name)] = value
+		}
+		result[service] = headers
+	}
+	return result
+}
+
+// upstreamAuthSecret match the secrets of a header template, {env:NAME} or {file:PATH}
+var upstreamAuthSecret = regexp.MustCompile(`\{(env|file):([^}]+)\}`)
+
+// getEnvUpstreamAuths return the service=TYPE:ARGS entries of an env var by service: bearer:SECRET, basic:SECRET with
+// the secret a user:password pair, or header:Name:TEMPLATE with the secrets of the template given in braces. A secret
+// is env:NAME, read from the NAME env var, or file:PATH, read from the file, so it is never part of the setting
+func getEnvUpstreamAuths(key string) map[string]UpstreamAuth {
+	result := make(map[string]UpstreamAuth)
+	for service, entry := range getEnvMap(key) {
+		kind, args, _ := strings.Cut(entry, ":")
+		auth := UpstreamAuth{Type: strings.ToLower(kind), Header: "Authorization"}
+		var err error
+		switch auth.Type {
+		case "bearer":
+			auth.Value, err = readUpstreamSecret(args)
+			auth.Value = "Bearer " + auth.Value
+		case "basic":
+			var credentials string
+			if credentials, err = readUpstreamSecret(args); err == nil && !strings.Contains(credentials, ":") {
+				err = fmt.Errorf("the secret %s is not a user:password pair", args)
+			}
+			auth.Value = "Basic " + base64.StdEncoding.EncodeToString([]byte(credentials))
+		case "header":
+			var template string
+			auth.Header, template, _ = strings.Cut(args, ":")
+			if auth.Header = strings.TrimSpace(auth.Header); len(auth.Header) == 0 {
+				err = fmt.Errorf("the header name is missing")
+			}
+			auth.Value = upstreamAuthSecret.ReplaceAllStringFunc(template, func(match string) string {
+				secret, readErr := readUpstreamSecret(match[1 : len(match)-1])
+				if readErr != nil && err == nil {
+					err = readErr
+				}
+				return secret
+			})
+		default:
+			err = fmt.Errorf("the type %s is not bearer, basic nor header", kind)
+		}
+		if err != nil {
+			panic(fmt.Errorf("env var %s entry %s: %w", key, service, err))
+		}
+		result[service] = auth
+	}
+	return result
+}
+
+// readUpstreamSecret read the secret of env:NAME or file:PATH, the surrounding spaces and line breaks trimmed
+func readUpstreamSecret(source string) (string, error) {
+	kind, name, _ := strings.Cut(strings.TrimSpace(source), ":")
+	var secret string
+	switch kind {
+	case "env":
+		value, ok := os.LookupEnv(name)
+		if !ok {
+			return "", fmt.Errorf("the env var %s is unset", name)
+		}
+		secret = value
+	case "file":
+		content, err := os.ReadFile(name)
+		if err != nil {
+			return "", fmt.Errorf("fail to read the secret file %s: %w", name, err)
+		}
+		secret = string(content)
+	default:
+		return "", fmt.Errorf("the secret %s is not env:NAME nor file:PATH", source)
+	}
+	if secret = strings.TrimSpace(secret); len(secret) == 0 {
+		return "", fmt.Errorf("the secret %s is empty", source)
+	}
+	return secret, nil
+}
+
+// getEnvMapInt return the comma separated key=integer pairs of an env var
+func getEnvMapInt(key string) map[string]int64 {
+	result := make(map[string]int64)
+	for k, v := range getEnvMap(key) {
+		i, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			panic(fmt.Errorf("env var %s entry %s is not an integer: %s", key, k, err))
+		}
+		result[k] = i
+	}
+	return result
+}
+
+func getEnvBool(key string, defaultVal bool) bool {
+	val, exists := os.LookupEnv(key)
+	if !exists {
+		return defaultVal
+	}
+	b, err := strconv.ParseBool(strings.TrimSpace(val))
+	if err != nil {
+		panic(fmt.Errorf("env var %s is not a boolean: %s", key, err))
+	}
+	return b
+}
+
 func loadVarPubKey(key string) common.PubKey {
 	val, ok := os.LookupEnv(key)
 	if !ok {
@@ -73,16 +639,184 @@ func loadVarInt(key string) int {
 
 func NewTLSConfiguration() TLSConfiguration {
 	return TLSConfiguration{
-		Cert: getEnv("TLS_CERT", ""),
-		Key:  getEnv("TLS_KEY", ""),
+		Cert:         getEnv("TLS_CERT", ""),
+		Key:          getEnv("TLS_KEY", ""),
+		ACME:         getEnvBool("TLS_ACME", false),
+		ACMEEmail:    getEnv("TLS_ACME_EMAIL", ""),
+		ACMECacheDir: getEnv("TLS_ACME_CACHE_DIR", "~/.arkeo/autocert"),
+		Hosts:        getEnvList("TLS_HOSTS"),
+		Port:         getEnv("TLS_PORT", "443"),
 	}
 }
 
 func (c TLSConfiguration) HasTLS() bool {
+	return c.HasStaticCert() || c.HasACME()
+}
+
+func (c TLSConfiguration) HasStaticCert() bool {
 	return len(c.Cert) > 0 && len(c.Key) > 0
 }
 
+func (c TLSConfiguration) HasACME() bool {
+	return c.ACME && len(c.Hosts) > 0
+}
+
+// NewServiceConfigurations load the services listed in SERVICES, or given an upstream in SERVICE_UPSTREAMS, along with
+// their per service settings
+func NewServiceConfigurations() map[string]ServiceConfiguration {
+	upstreams := getEnvMap("SERVICE_UPSTREAMS")
+	rewrites := getEnvMap("SERVICE_PATH_REWRITES")
+	timeouts := getEnvMapInt("SERVICE_TIMEOUTS")
+	rateLimits := getEnvMapInt("SERVICE_RATE_LIMITS")
+	maxRequestBytes := getEnvMapInt("SERVICE_MAX_REQUEST_BYTES")
+	maxResponseBytes := getEnvMapInt("SERVICE_MAX_RESPONSE_BYTES")
+	dialTimeouts := getEnvMapInt("SERVICE_DIAL_TIMEOUTS_MS")
+	headerTimeouts := getEnvMapInt("SERVICE_RESPONSE_HEADER_TIMEOUTS_MS")
+	maxIdleConns := getEnvMapInt("SERVICE_MAX_IDLE_CONNS")
+	idleConnTimeouts := getEnvMapInt("SERVICE_IDLE_CONN_TIMEOUT_SECONDS")
+	maxConns := getEnvMapInt("SERVICE_MAX_CONNS")
+	disableHTTP2 := getEnvMapBool("SERVICE_DISABLE_HTTP2")
+	freeRateLimits := getEnvMapInt("SERVICE_FREE_RATE_LIMITS")
+	freeDailyLimits := getEnvMapInt("SERVICE_FREE_RATE_LIMITS_DAY")
+	costs := getEnvServiceCosts("SERVICE_COSTS")
+	streamSeconds := getEnvMapInt("SERVICE_STREAM_MAX_SECONDS")
+	streamBytes := getEnvMapInt("SERVICE_STREAM_MAX_BYTES")
+	streamAccounting := getEnvMap("SERVICE_STREAM_ACCOUNTING")
+	minDeposits := getEnvMapList("SERVICE_MIN_DEPOSITS")
+	retries := getEnvMapInt("SERVICE_RETRIES")
+	retryBackoffs := getEnvMapInt("SERVICE_RETRY_BACKOFF_MS")
+	retryRPCMethods := getEnvMapList("SERVICE_RETRY_RPC_METHODS")
+	breakerErrorRates := getEnvMapInt("SERVICE_BREAKER_ERROR_RATE")
+	breakerMinRequests := getEnvMapInt("SERVICE_BREAKER_MIN_REQUESTS")
+	breakerWindows := getEnvMapInt("SERVICE_BREAKER_WINDOW_SECONDS")
+	breakerOpenSeconds := getEnvMapInt("SERVICE_BREAKER_OPEN_SECONDS")
+	stripHeaders := getEnvMapList("SERVICE_STRIP_HEADERS")
+	injectHeaders := getEnvServiceHeaders("SERVICE_INJECT_HEADERS")
+	stripResponseHeaders := getEnvMapList("SERVICE_STRIP_RESPONSE_HEADERS")
+	hideClientIPs := getEnvMapBool("SERVICE_HIDE_CLIENT_IP")
+	freePaths := getEnvMapList("SERVICE_FREE_PATHS")
+	freePathRateLimits := getEnvMapInt("SERVICE_FREE_PATH_RATE_LIMITS")
+	maxStreamedRequestBytes := getEnvMapInt("SERVICE_MAX_STREAMED_REQUEST_BYTES")
+	cacheTTLs := getEnvMapInt("SERVICE_CACHE_TTL_SECONDS")
+	cacheMaxEntryBytes := getEnvMapInt("SERVICE_CACHE_MAX_ENTRY_BYTES")
+	cacheFreeHits := getEnvMapBool("SERVICE_CACHE_FREE_HITS")
+	upstreamAuths := getEnvUpstreamAuths("SERVICE_UPSTREAM_AUTH")
+	responseAccounting := getEnvMap("SERVICE_RESPONSE_ACCOUNTING")
+	responseByteUnits := getEnvMapInt("SERVICE_RESPONSE_BYTE_UNITS")
+	jsonRPC := getEnvMapBool("SERVICE_JSON_RPC")
+	maxBatchSizes := getEnvMapInt("SERVICE_MAX_BATCH_SIZE")
+
+	names := getEnvList("SERVICES")
+	for name := range upstreams {
+		names = append(names, name)
+	}
+	services := make(map[string]ServiceConfiguration)
+	for _, name := range names {
+		services[name] = ServiceConfiguration{
+			Upstream:                upstreams[name],
+			PathRewrite:             rewrites[name],
+			TimeoutSeconds:          timeouts[name],
+			RateLimit:               int(rateLimits[name]),
+			MaxRequestBytes:         maxRequestBytes[name],
+			MaxResponseBytes:        maxResponseBytes[name],
+			DialTimeoutMs:           dialTimeouts[name],
+			ResponseHeaderTimeoutMs: headerTimeouts[name],
+			MaxIdleConns:            int(maxIdleConns[name]),
+			IdleConnTimeoutSeconds:  idleConnTimeouts[name],
+			MaxConns:                int(maxConns[name]),
+			DisableHTTP2:            disableHTTP2[name],
+			FreeTierRateLimit:       int(freeRateLimits[name]),
+			FreeTierDailyLimit:      int(freeDailyLimits[name]),
+			Costs:                   costs[name],
+			StreamMaxSeconds:        streamSeconds[name],
+			StreamMaxBytes:          streamBytes[name],
+			StreamAccounting:        streamAccounting[name],
+			MinDeposits:             minDeposits[name],
+			Retries:                 int(retries[name]),
+			RetryBackoffMs:          retryBackoffs[name],
+			RetryRPCMethods:         retryRPCMethods[name],
+			BreakerErrorRate:        int(breakerErrorRates[name]),
+			BreakerMinRequests:      int(breakerMinRequests[name]),
+			BreakerWindowSeconds:    breakerWindows[name],
+			BreakerOpenSeconds:      breakerOpenSeconds[name],
+			StripHeaders:            stripHeaders[name],
+			InjectHeaders:           injectHeaders[name],
+			StripResponseHeaders:    stripResponseHeaders[name],
+			HideClientIP:            hideClientIPs[name],
+			FreePaths:               freePaths[name],
+			FreePathRateLimit:       int(freePathRateLimits[name]),
+			MaxStreamedRequestBytes: maxStreamedRequestBytes[name],
+			CacheTTLSeconds:         cacheTTLs[name],
+			CacheMaxEntryBytes:      cacheMaxEntryBytes[name],
+			CacheFreeHits:           cacheFreeHits[name],
+			UpstreamAuth:            upstreamAuths[name],
+			ResponseAccounting:      responseAccounting[name],
+			ResponseByteUnit:        responseByteUnits[name],
+			JSONRPC:                 jsonRPC[name],
+			MaxBatchSize:            int(maxBatchSizes[name]),
+		}
+	}
+	return services
+}
+
+func NewCORSConfiguration() CORSConfiguration {
+	return CORSConfiguration{
+		AllowOrigins: getEnvListDefault("CORS_ALLOW_ORIGINS", "*"),
+		AllowMethods: getEnvListDefault("CORS_ALLOW_METHODS", "GET,POST,PUT,DELETE,OPTIONS"),
+		AllowHeaders: getEnvListDefault("CORS_ALLOW_HEADERS", "Accept,Content-Type,Content-Length,Accept-Encoding,X-CSRF-Token,Authorization"),
+		MaxAge:       getEnvInt("CORS_MAX_AGE", 3600),
+	}
+}
+
+func NewRateLimitStoreConfiguration() RateLimitStoreConfiguration {
+	return RateLimitStoreConfiguration{
+		Type:       getEnv("RATE_LIMIT_STORE", "memory"),
+		URL:        getEnv("RATE_LIMIT_STORE_URL", ""),
+		FailClosed: getEnvBool("RATE_LIMIT_STORE_FAIL_CLOSED", false),
+		TimeoutMs:  getEnvInt("RATE_LIMIT_STORE_TIMEOUT_MS", 100),
+	}
+}
+
+func NewHealthConfiguration() HealthConfiguration {
+	return HealthConfiguration{
+		Probes:         getEnvMap("HEALTH_PROBES"),
+		CacheSeconds:   getEnvInt("HEALTH_CACHE_SECONDS", 5),
+		TimeoutMs:      getEnvInt("HEALTH_TIMEOUT_MS", 3000),
+		ProbeTimeoutMs: getEnvInt("HEALTH_PROBE_TIMEOUT_MS", 2000),
+	}
+}
+
+func NewAutoClaimConfiguration() AutoClaimConfiguration {
+	return AutoClaimConfiguration{
+		Enabled:         getEnvBool("AUTO_CLAIM_ENABLED", false),
+		DryRun:          getEnvBool("AUTO_CLAIM_DRY_RUN", false),
+		Threshold:       getEnvInt("AUTO_CLAIM_THRESHOLD", 1000000),
+		DenomThresholds: getEnvMap("AUTO_CLAIM_DENOM_THRESHOLDS"),
+		ExpiryBlocks:    getEnvInt("AUTO_CLAIM_EXPIRY_BLOCKS", 100),
+		IntervalSeconds: int(getEnvInt("AUTO_CLAIM_INTERVAL", 60)),
+		ChainID:         getEnv("CHAIN_ID", "arkeo"),
+		NodeRPC:         getEnv("AUTO_CLAIM_NODE_RPC", ""),
+		KeyName:         getEnv("AUTO_CLAIM_KEY_NAME", getEnv("PROVIDER_KEY_NAME", "")),
+		KeyringBackend:  getEnv("KEYRING_BACKEND", "test"),
+		KeyringDir:      getEnv("KEYRING_DIR", "~/.arkeo"),
+		GasLimit:        uint64(getEnvInt("AUTO_CLAIM_GAS_LIMIT", 200000)),
+		GasPrices:       getEnv("AUTO_CLAIM_GAS_PRICES", ""),
+		Fees:            getEnv("AUTO_CLAIM_FEES", ""),
+		MaxRetries:      int(getEnvInt("AUTO_CLAIM_MAX_RETRIES", 3)),
+		OnShutdown:      getEnvBool("AUTO_CLAIM_ON_SHUTDOWN", false),
+		Authz:           getEnvBool("AUTO_CLAIM_AUTHZ", false),
+		Settle:          getEnvBool("AUTO_CLAIM_SETTLE", false),
+		SettleBackoff:   getEnvInt("AUTO_CLAIM_SETTLE_BACKOFF", 30),
+	}
+}
+
 func NewConfiguration() Configuration {
+	configFile := getEnv("CONFIG_FILE", "")
+	if len(configFile) > 0 {
+		if err := LoadConfigFile(configFile); err != nil {
+			panic(err)
+		}
+	}
 	return Configuration{
 		Moniker:                     loadVarString("MONIKER"),
 		Website:                     loadVarString("WEBSITE"),
@@ -92,16 +826,63 @@ func NewConfiguration() Configuration {
 		SourceChain:                 loadVarString("SOURCE_CHAIN"),
 		EventStreamHost:             loadVarString("EVENT_STREAM_HOST"),
 		ProviderPubKey:              loadVarPubKey("PROVIDER_PUBKEY"),
+		Providers:                   NewProviderIdentities(),
+		AcceptedDenoms:              getEnvList("ACCEPTED_DENOMS"),
 		FreeTierRateLimit:           loadVarInt("FREE_RATE_LIMIT"),
+		FreeTierDailyLimit:          int(getEnvInt("FREE_RATE_LIMIT_DAY", 0)),
+		FreeTierMaxKeys:             int(getEnvInt("FREE_TIER_MAX_KEYS", 100000)),
+		FreeTierAllowCIDRs:          getEnvList("FREE_TIER_ALLOW_CIDRS"),
+		TrustedProxyCIDRs:           getEnvListDefault("TRUSTED_PROXY_CIDRS", defaultTrustedProxyCIDRs),
+		ClaimStoreType:              getEnv("CLAIM_STORE_TYPE", "leveldb"),
 		ClaimStoreLocation:          loadVarString("CLAIM_STORE_LOCATION"),
+		ClaimCompactionInterval:     getEnvInt("CLAIM_COMPACTION_INTERVAL", 3600),
+		ClaimArchiveLocation:        getEnv("CLAIM_ARCHIVE_LOCATION", ""),
 		ContractConfigStoreLocation: loadVarString("CONTRACT_CONFIG_STORE_LOCATION"),
+		ContractReconcileInterval:   getEnvInt("CONTRACT_RECONCILE_INTERVAL", 600),
+		MaxInFlight:                 int(getEnvInt("MAX_IN_FLIGHT", 1024)),
+		ContractMaxInFlight:         int(getEnvInt("CONTRACT_MAX_IN_FLIGHT", 64)),
+		WebsocketAccounting:         getEnvMap("WEBSOCKET_ACCOUNTING"),
+		Services:                    NewServiceConfigurations(),
+		ClientAllowList:             getEnvList("CLIENT_ALLOWLIST"),
+		ClientDenyList:              getEnvList("CLIENT_DENYLIST"),
+		ClientAllowListFreeTier:     getEnvBool("CLIENT_ALLOWLIST_FREE_TIER", false),
+		GRPCUpstream:                getEnvMap("GRPC_UPSTREAM"),
+		GRPCAccounting:              getEnvMap("GRPC_ACCOUNTING"),
+		MetadataChainTTL:            getEnvInt("METADATA_CHAIN_TTL", 60),
 		TLS:                         NewTLSConfiguration(),
+		Health:                      NewHealthConfiguration(),
+		CORS:                        NewCORSConfiguration(),
+		RateLimitStore:              NewRateLimitStoreConfiguration(),
+		ConfigFile:                  configFile,
+		AdminToken:                  getEnv("ADMIN_TOKEN", ""),
+		AdminTokens:                 getEnvMap("ADMIN_TOKENS"),
+		ShutdownGracePeriod:         getEnvInt("SHUTDOWN_GRACE_PERIOD", 30),
+		AccessLogFile:               getEnv("ACCESS_LOG_FILE", ""),
+		AccessLogMaxMB:              getEnvInt("ACCESS_LOG_MAX_MB", 100),
+		AccessLogMaxFiles:           int(getEnvInt("ACCESS_LOG_MAX_FILES", 5)),
+		MetricsListenAddr:           getEnv("METRICS_LISTEN_ADDR", ""),
+		MetricsMaxContracts:         int(getEnvInt("METRICS_MAX_CONTRACTS", 100)),
+		SignatureCacheSize:          int(getEnvInt("SIGNATURE_CACHE_SIZE", 10000)),
+		SignatureNegativeTTL:        getEnvInt("SIGNATURE_NEGATIVE_TTL", 10),
+		ArkAuthMinVersion:           int(getEnvInt("ARKAUTH_MIN_VERSION", 1)),
+		ContractGraceBlocks:         getEnvInt("CONTRACT_GRACE_BLOCKS", 0),
+		ContractOverdraft:           getEnvInt("CONTRACT_OVERDRAFT", 0),
+		AutoClaim:                   NewAutoClaimConfiguration(),
+		Dev:                         NewDevConfiguration(),
 		ProviderConfigStoreLocation: loadVarString("PROVIDER_CONFIG_STORE_LOCATION"),
 	}
 }
 
 func (c Configuration) Print() {
 	writer := tabwriter.NewWriter(os.Stdout, 0, 8, 1, '\t', tabwriter.AlignRight)
+	if c.Dev.Enabled {
+		fmt.Fprintln(writer, "DEV MODE\t", "fake chain, nothing is settled on chain, not for production")
+		for _, contract := range c.Dev.Contracts {
+			fmt.Fprintln(writer, "Dev Contract\t", fmt.Sprintf("%d: %s, client %s, rate %s, %d queries per 1m, subscription %t", contract.Id,
+				contract.Service, contract.Client, contract.Rate, contract.QueriesPerMinute, contract.Subscription))
+		}
+		fmt.Fprintln(writer, "Dev Accept Test Key\t", c.Dev.AcceptTestKey)
+	}
 	fmt.Fprintln(writer, "Moniker\t", c.Moniker)
 	fmt.Fprintln(writer, "Website\t", c.Website)
 	fmt.Fprintln(writer, "Description\t", c.Description)
@@ -109,12 +890,126 @@ func (c Configuration) Print() {
 	fmt.Fprintln(writer, "Port\t", c.Port)
 	fmt.Fprintln(writer, "TLS Certificate\t", c.TLS.Cert)
 	fmt.Fprintln(writer, "TLS Key\t", c.TLS.Key)
+	fmt.Fprintln(writer, "TLS ACME\t", c.TLS.ACME)
+	fmt.Fprintln(writer, "TLS Hosts\t", strings.Join(c.TLS.Hosts, ","))
+	fmt.Fprintln(writer, "TLS Port\t", c.TLS.Port)
 	fmt.Fprintln(writer, "Source Chain\t", c.SourceChain)
 	fmt.Fprintln(writer, "Event Stream Host\t", c.EventStreamHost)
 	fmt.Fprintln(writer, "Provider PubKey\t", c.ProviderPubKey)
+	for _, identity := range c.Providers {
+		fmt.Fprintln(writer, "Provider Identity\t", fmt.Sprintf("%s: %s, services %s, claim key %s", identity.Name, identity.PubKey,
+			strings.Join(identity.Services, ","), identity.ClaimKeyName))
+	}
+	fmt.Fprintln(writer, "Accepted Denoms\t", strings.Join(c.AcceptedDenoms, ","))
+	fmt.Fprintln(writer, "Claim Store Type\t", c.ClaimStoreType)
 	fmt.Fprintln(writer, "Claim Store Location\t", c.ClaimStoreLocation)
+	fmt.Fprintln(writer, "Claim Compaction Interval\t", fmt.Sprintf("%ds", c.ClaimCompactionInterval))
+	fmt.Fprintln(writer, "Claim Archive Location\t", c.ClaimArchiveLocation)
 	fmt.Fprintln(writer, "Contract Config Store Location\t", c.ContractConfigStoreLocation)
+	fmt.Fprintln(writer, "Contract Reconcile Interval\t", fmt.Sprintf("%ds", c.ContractReconcileInterval))
 	fmt.Fprintln(writer, "Free Tier Rate Limit\t", fmt.Sprintf("%d requests per 1m", c.FreeTierRateLimit))
+	fmt.Fprintln(writer, "Free Tier Daily Limit\t", fmt.Sprintf("%d requests per day", c.FreeTierDailyLimit))
+	fmt.Fprintln(writer, "Free Tier Allowlist\t", strings.Join(c.FreeTierAllowCIDRs, ","))
+	fmt.Fprintln(writer, "Rate Limit Store\t", fmt.Sprintf("%s, fail closed %t, timeout %dms", c.RateLimitStore.Type,
+		c.RateLimitStore.FailClosed, c.RateLimitStore.TimeoutMs))
+	fmt.Fprintln(writer, "Trusted Proxies\t", strings.Join(c.TrustedProxyCIDRs, ","))
 	fmt.Fprintln(writer, "Provider Config Store Location\t", c.ProviderConfigStoreLocation)
+	fmt.Fprintln(writer, "Metadata Chain TTL\t", fmt.Sprintf("%ds", c.MetadataChainTTL))
+	fmt.Fprintln(writer, "Max In Flight\t", fmt.Sprintf("%d requests, %d per contract", c.MaxInFlight, c.ContractMaxInFlight))
+	for name, service := range c.Services {
+		fmt.Fprintln(writer, "Service\t", fmt.Sprintf("%s: timeout %ds (dial %dms, headers %dms), max bytes %d/%d, rate limit %d, free tier %d/%d", name,
+			service.TimeoutSeconds, service.DialTimeoutMs, service.ResponseHeaderTimeoutMs, service.MaxRequestBytes, service.MaxResponseBytes,
+			service.RateLimit, service.FreeTierRateLimit, service.FreeTierDailyLimit))
+		fmt.Fprintln(writer, "Service Connections\t", fmt.Sprintf("%s: max idle %d for %ds, max %d, http/2 %t", name,
+			service.MaxIdleConns, service.IdleConnTimeoutSeconds, service.MaxConns, !service.DisableHTTP2))
+		for _, cost := range service.Costs {
+			fmt.Fprintln(writer, "Service Cost\t", fmt.Sprintf("%s: %s %s %s costs %d", name, cost.Method, cost.Path, cost.RPCMethod, cost.Cost))
+		}
+		if service.MaxStreamedRequestBytes > 0 {
+			fmt.Fprintln(writer, "Service Streamed Requests\t", fmt.Sprintf("%s: max bytes %d", name, service.MaxStreamedRequestBytes))
+		}
+		if service.CacheTTLSeconds > 0 {
+			fmt.Fprintln(writer, "Service Cache\t", fmt.Sprintf("%s: ttl %ds, max entry bytes %d, free hits %t", name,
+				service.CacheTTLSeconds, service.CacheMaxEntryBytes, service.CacheFreeHits))
+		}
+		if service.StreamMaxSeconds > 0 || service.StreamMaxBytes > 0 || len(service.StreamAccounting) > 0 {
+			fmt.Fprintln(writer, "Service Stream\t", fmt.Sprintf("%s: max %ds, max bytes %d, accounting %s", name,
+				service.StreamMaxSeconds, service.StreamMaxBytes, service.StreamAccounting))
+		}
+		if len(service.ResponseAccounting) > 0 {
+			fmt.Fprintln(writer, "Service Response Accounting\t", fmt.Sprintf("%s: %s, byte unit %d", name, service.ResponseAccounting, service.ResponseByteUnit))
+		}
+		if service.JSONRPC {
+			fmt.Fprintln(writer, "Service JSON-RPC\t", fmt.Sprintf("%s: max batch size %d", name, service.MaxBatchSize))
+		}
+		if len(service.MinDeposits) > 0 {
+			fmt.Fprintln(writer, "Service Min Deposit\t", fmt.Sprintf("%s: %s", name, strings.Join(service.MinDeposits, ",")))
+		}
+		if service.Retries > 0 {
+			fmt.Fprintln(writer, "Service Retries\t", fmt.Sprintf("%s: %d, backoff %dms, rpc methods %s", name,
+				service.Retries, service.RetryBackoffMs, strings.Join(service.RetryRPCMethods, ",")))
+		}
+		if len(service.StripHeaders) > 0 || len(service.InjectHeaders) > 0 || len(service.StripResponseHeaders) > 0 || service.HideClientIP {
+			injected := make([]string, 0, len(service.InjectHeaders))
+			for header := range service.InjectHeaders {
+				injected = append(injected, header)
+			}
+			fmt.Fprintln(writer, "Service Headers\t", fmt.Sprintf("%s: strip %s, inject %s, strip from responses %s, hide client ip %t", name,
+				strings.Join(service.StripHeaders, ","), strings.Join(injected, ","), strings.Join(service.StripResponseHeaders, ","), service.HideClientIP))
+		}
+		if len(service.UpstreamAuth.Header) > 0 {
+			fmt.Fprintln(writer, "Service Upstream Auth\t", fmt.Sprintf("%s: %s in %s", name, service.UpstreamAuth.Type, service.UpstreamAuth.Header))
+		}
+		if len(service.FreePaths) > 0 {
+			fmt.Fprintln(writer, "Service Free Paths\t", fmt.Sprintf("%s: %s, %d requests per 1m", name,
+				strings.Join(service.FreePaths, ","), service.FreePathRateLimit))
+		}
+		if service.BreakerErrorRate > 0 {
+			fmt.Fprintln(writer, "Service Breaker\t", fmt.Sprintf("%s: %d%% errors of %d requests over %ds, open %ds", name,
+				service.BreakerErrorRate, service.BreakerMinRequests, service.BreakerWindowSeconds, service.BreakerOpenSeconds))
+		}
+	}
+	for service, accounting := range c.WebsocketAccounting {
+		fmt.Fprintln(writer, "Websocket Accounting\t", fmt.Sprintf("%s: %s", service, accounting))
+	}
+	for service, upstream := range c.GRPCUpstream {
+		fmt.Fprintln(writer, "gRPC Upstream\t", fmt.Sprintf("%s: %s", service, upstream))
+	}
+	for service, accounting := range c.GRPCAccounting {
+		fmt.Fprintln(writer, "gRPC Accounting\t", fmt.Sprintf("%s: %s", service, accounting))
+	}
+	for service, probe := range c.Health.Probes {
+		fmt.Fprintln(writer, "Health Probe\t", fmt.Sprintf("%s: %s", service, probe))
+	}
+	fmt.Fprintln(writer, "CORS Allowed Origins\t", strings.Join(c.CORS.AllowOrigins, ","))
+	fmt.Fprintln(writer, "Health Cache\t", fmt.Sprintf("%ds", c.Health.CacheSeconds))
+	fmt.Fprintln(writer, "Health Timeout\t", fmt.Sprintf("%dms", c.Health.TimeoutMs))
+	fmt.Fprintln(writer, "Client Allowlist\t", fmt.Sprintf("%d pubkeys, free tier %t", len(c.ClientAllowList), c.ClientAllowListFreeTier))
+	fmt.Fprintln(writer, "Client Denylist\t", fmt.Sprintf("%d pubkeys", len(c.ClientDenyList)))
+	fmt.Fprintln(writer, "Config File\t", c.ConfigFile)
+	fmt.Fprintln(writer, "Admin Endpoints\t", len(c.AdminToken) > 0 || len(c.AdminTokens) > 0)
+	fmt.Fprintln(writer, "Shutdown Grace Period\t", fmt.Sprintf("%ds", c.ShutdownGracePeriod))
+	fmt.Fprintln(writer, "Access Log\t", fmt.Sprintf("%s, rotated at %dMB, %d files kept", c.AccessLogFile, c.AccessLogMaxMB, c.AccessLogMaxFiles))
+	fmt.Fprintln(writer, "Metrics Listen Address\t", c.MetricsListenAddr)
+	fmt.Fprintln(writer, "Signature Cache Size\t", c.SignatureCacheSize)
+	fmt.Fprintln(writer, "ArkAuth Min Version\t", c.ArkAuthMinVersion)
+	fmt.Fprintln(writer, "Contract Grace\t", fmt.Sprintf("%d blocks", c.ContractGraceBlocks))
+	fmt.Fprintln(writer, "Contract Overdraft\t", fmt.Sprintf("%d queries", c.ContractOverdraft))
+	fmt.Fprintln(writer, "Auto Claim\t", c.AutoClaim.Enabled)
+	if c.AutoClaim.Enabled {
+		fmt.Fprintln(writer, "Auto Claim Dry Run\t", c.AutoClaim.DryRun)
+		fmt.Fprintln(writer, "Auto Claim Threshold\t", c.AutoClaim.Threshold)
+		for denom, threshold := range c.AutoClaim.DenomThresholds {
+			fmt.Fprintln(writer, "Auto Claim Threshold\t", fmt.Sprintf("%s: %s", denom, threshold))
+		}
+		fmt.Fprintln(writer, "Auto Claim Expiry Blocks\t", c.AutoClaim.ExpiryBlocks)
+		fmt.Fprintln(writer, "Auto Claim Key\t", c.AutoClaim.KeyName)
+		fmt.Fprintln(writer, "Auto Claim Authz\t", c.AutoClaim.Authz)
+		fmt.Fprintln(writer, "Auto Claim On Shutdown\t", c.AutoClaim.OnShutdown)
+		fmt.Fprintln(writer, "Auto Claim Settle\t", c.AutoClaim.Settle)
+		if c.AutoClaim.Settle {
+			fmt.Fprintln(writer, "Auto Claim Settle Backoff\t", c.AutoClaim.SettleBackoff)
+		}
+	}
 	writer.Flush()
 }
@@ -1,7 +1,10 @@
 package conf
 
 import (
+	"encoding/json"
+	"io"
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -35,3 +38,110 @@ func TestConfiguration(t *testing.T) {
 	require.Equal(t, config.ContractConfigStoreLocation, "configy")
 	require.Equal(t, config.ProviderConfigStoreLocation, "providy")
 }
+
+func TestServiceCosts(t *testing.T) {
+	t.Setenv("SERVICES", "eth-mainnet-fullnode")
+	t.Setenv("SERVICE_COSTS", "eth-mainnet-fullnode=rpc:eth_getLogs:5, eth-mainnet-fullnode=post /debug/*:10,eth-mainnet-fullnode=/trace:3")
+
+	services := NewServiceConfigurations()
+	require.Equal(t, []ServiceCost{
+		{RPCMethod: "eth_getLogs", Cost: 5},
+		{Method: "POST", Path: "/debug/*", Cost: 10},
+		{Path: "/trace", Cost: 3},
+	}, services["eth-mainnet-fullnode"].Costs)
+
+	t.Setenv("SERVICE_COSTS", "eth-mainnet-fullnode=/trace")
+	require.Panics(t, func() { NewServiceConfigurations() })
+}
+
+func TestDevConfiguration(t *testing.T) {
+	t.Setenv("DEV_MODE", "true")
+	t.Setenv("DEV_CONTRACTS", "1=btc-mainnet-fullnode, 2=eth-mainnet-fullnode:tarkeopub1client:10uarkeo:60:subscription")
+
+	dev := NewDevConfiguration()
+	require.True(t, dev.Enabled)
+	require.True(t, dev.AcceptTestKey)
+	require.Equal(t, []DevContract{
+		{Id: 1, Service: "btc-mainnet-fullnode"},
+		{Id: 2, Service: "eth-mainnet-fullnode", Client: "tarkeopub1client", Rate: "10uarkeo", QueriesPerMinute: 60, Subscription: true},
+	}, dev.Contracts)
+
+	for _, raw := range []string{"btc-mainnet-fullnode", "0=btc-mainnet-fullnode", "1=btc-mainnet-fullnode::1uarkeo:x", "1=btc-mainnet-fullnode:::60:lease", "1=a:b:c:1:payg:x"} {
+		_, err := parseDevContract(raw)
+		require.Error(t, err, raw)
+	}
+}
+
+func TestProviderIdentities(t *testing.T) {
+	pubkey := "cosmospub1addwnpepqg3523h7e7ggeh6na2lsde6s394tqxnvufsz0urld6zwl8687ue9c3dasgu"
+	t.Setenv("PROVIDER_IDENTITIES", "us="+pubkey+",eu="+pubkey)
+	t.Setenv("PROVIDER_IDENTITY_SERVICES", "eu=btc-mainnet-fullnode,eu=eth-mainnet-fullnode")
+	t.Setenv("PROVIDER_IDENTITY_CLAIM_KEYS", "eu=provider-eu")
+
+	identities := NewProviderIdentities()
+	require.Len(t, identities, 2)
+	require.Equal(t, "eu", identities[0].Name)
+	require.Equal(t, pubkey, identities[0].PubKey.String())
+	require.Equal(t, []string{"btc-mainnet-fullnode", "eth-mainnet-fullnode"}, identities[0].Services)
+	require.Equal(t, "provider-eu", identities[0].ClaimKeyName)
+	require.Equal(t, "us", identities[1].Name)
+	require.Empty(t, identities[1].Services)
+
+	// the identity of PROVIDER_PUBKEY comes first, the claim key defaults to the auto claim one
+	config := Configuration{Providers: identities, AutoClaim: AutoClaimConfiguration{KeyName: "provider"}}
+	all := config.Identities()
+	require.Len(t, all, 3)
+	require.Equal(t, DefaultProviderName, all[0].Name)
+	require.Equal(t, "provider", all[0].ClaimKeyName)
+	require.Equal(t, "provider-eu", all[1].ClaimKeyName)
+	require.Equal(t, "provider", all[2].ClaimKeyName)
+
+	t.Setenv("PROVIDER_IDENTITIES", "eu=not-a-pubkey")
+	require.Panics(t, func() { NewProviderIdentities() })
+}
+
+func TestUpstreamAuth(t *testing.T) {
+	secretFile := filepath.Join(t.TempDir(), "btc")
+	require.NoError(t, os.WriteFile(secretFile, []byte("alice:pa55word\n"), 0o600))
+	t.Setenv("ETH_TOKEN", "eth-secret")
+	t.Setenv("SOL_KEY", "sol-secret")
+	t.Setenv("SERVICES", "eth-mainnet-fullnode,btc-mainnet-fullnode,sol-mainnet-fullnode")
+	t.Setenv("SERVICE_UPSTREAM_AUTH", "eth-mainnet-fullnode=bearer:env:ETH_TOKEN,btc-mainnet-fullnode=basic:file:"+secretFile+
+		",sol-mainnet-fullnode=header:X-Api-Key:Token {env:SOL_KEY}")
+
+	services := NewServiceConfigurations()
+	require.Equal(t, UpstreamAuth{Type: "bearer", Header: "Authorization", Value: "Bearer eth-secret"}, services["eth-mainnet-fullnode"].UpstreamAuth)
+	require.Equal(t, UpstreamAuth{Type: "basic", Header: "Authorization", Value: "Basic YWxpY2U6cGE1NXdvcmQ="}, services["btc-mainnet-fullnode"].UpstreamAuth)
+	require.Equal(t, UpstreamAuth{Type: "header", Header: "X-Api-Key", Value: "Token sol-secret"}, services["sol-mainnet-fullnode"].UpstreamAuth)
+
+	// the secrets are neither advertised nor printed
+	raw, err := json.Marshal(services)
+	require.NoError(t, err)
+	require.NotContains(t, string(raw), "secret")
+	stdout := os.Stdout
+	r, w, err := os.Pipe()
+	require.NoError(t, err)
+	os.Stdout = w
+	Configuration{Services: services}.Print()
+	os.Stdout = stdout
+	require.NoError(t, w.Close())
+	printed, err := io.ReadAll(r)
+	require.NoError(t, err)
+	require.Contains(t, string(printed), "eth-mainnet-fullnode: bearer in Authorization")
+	require.NotContains(t, string(printed), "secret")
+	require.NotContains(t, string(printed), "YWxpY2U6cGE1NXdvcmQ=")
+
+	// the secrets are read from env vars or files only, a missing one fails the configuration
+	for _, entry := range []string{
+		"eth-mainnet-fullnode=bearer:eth-secret",
+		"eth-mainnet-fullnode=bearer:env:UNSET_TOKEN",
+		"eth-mainnet-fullnode=bearer:file:/missing",
+		"eth-mainnet-fullnode=basic:env:ETH_TOKEN",
+		"eth-mainnet-fullnode=header::{env:ETH_TOKEN}",
+		"eth-mainnet-fullnode=header:X-Api-Key:{env:UNSET_TOKEN}",
+		"eth-mainnet-fullnode=digest:env:ETH_TOKEN",
+	} {
+		t.Setenv("SERVICE_UPSTREAM_AUTH", entry)
+		require.Panics(t, func() { NewServiceConfigurations() }, entry)
+	}
+}
@@ -0,0 +1,222 @@
+package sentinel
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+)
+
+// ContractRateLimiter enforce the queries per minute each contract paid for, with a token bucket per contract id.
+// Buckets are created on the first request of a contract, follow the contract's queries per minute as the
+// contract cache is refreshed, and are dropped when the contract closes or expires. With shared counters the queries
+// are counted in fixed minute windows in the store shared by the sentinel replicas instead, the buckets being used
+// while the store is down unless it fails closed
+type ContractRateLimiter struct {
+	lock     sync.Mutex
+	limiters map[uint64]*contractLimiter
+	shared   *SharedCounters
+}
+
+type contractLimiter struct {
+	limiter          *rate.Limiter
+	queriesPerMinute int64
+	expiration       int64
+}
+
+const (
+	RateLimitLimitHeader     = "X-RateLimit-Limit"     // queries per minute of the contract, or requests of the free tier window
+	RateLimitRemainingHeader = "X-RateLimit-Remaining" // queries the client can make right away
+	RateLimitResetHeader     = "X-RateLimit-Reset"     // unix time the allowance is whole again
+)
+
+// RateLimitState is the allowance of a contract right after a request was charged to it, or refused
+type RateLimitState struct {
+	Allowed    bool
+	Limit      int64         // queries per minute, zero when the contract isn't limited
+	Remaining  int64         // queries the contract can make right away
+	Reset      time.Time     // when the bucket is full again
+	RetryAfter time.Duration // until a refused request can be made
+}
+
+// RateLimitExceeded is the body returned to a client exceeding its contract's queries per minute
+type RateLimitExceeded struct {
+	Error             string `json:"error"`
+	ContractId        uint64 `json:"contract_id"`
+	QueriesPerMinute  int64  `json:"queries_per_minute"`
+	RetryAfterSeconds int64  `json:"retry_after_seconds"`
+}
+
+func NewContractRateLimiter() *ContractRateLimiter {
+	return &ContractRateLimiter{
+		limiters: make(map[uint64]*contractLimiter),
+	}
+}
+
+// Share count the queries of the contracts in the shared counters
+func (l *ContractRateLimiter) Share(counters *SharedCounters) {
+	l.shared = counters
+}
+
+// Allow take a token from the contract's bucket. When the bucket is empty it returns false along with how long the
+// client should wait before the next query. A contract without queries per minute is not limited
+func (l *ContractRateLimiter) Allow(contract types.Contract) (bool, time.Duration) {
+	return l.AllowN(contract, 1)
+}
+
+// AllowN take the tokens of a request costing n queries from the contract's bucket, a request costing more than the
+// contract's queries per minute is never allowed
+func (l *ContractRateLimiter) AllowN(contract types.Contract, n int64) (bool, time.Duration) {
+	state := l.Take(contract, n)
+	return state.Allowed, state.RetryAfter
+}
+
+// Take charge a request costing n queries as AllowN does, and return the allowance left once it is charged. The
+// allowance is read along with the charge, so concurrent requests each get their own
+func (l *ContractRateLimiter) Take(contract types.Contract, n int64) RateLimitState {
+	if contract.QueriesPerMinute <= 0 {
+		return RateLimitState{Allowed: true}
+	}
+	if l.shared != nil {
+		state, err := l.takeShared(contract, n)
+		if err == nil {
+			return state
+		}
+		if l.shared.failClosed {
+			return RateLimitState{Limit: contract.QueriesPerMinute, Reset: time.Now().Add(counterStoreRetry), RetryAfter: counterStoreRetry}
+		}
+	}
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	cl, ok := l.limiters[contract.Id]
+	// start a new bucket for a new contract, or when the contract was refreshed with a different limit
+	if !ok || cl.queriesPerMinute != contract.QueriesPerMinute {
+		cl = &contractLimiter{
+			limiter:          rate.NewLimiter(perMinute(contract.QueriesPerMinute), int(contract.QueriesPerMinute)),
+			queriesPerMinute: contract.QueriesPerMinute,
+		}
+		l.limiters[contract.Id] = cl
+	}
+	cl.expiration = contract.SettlementPeriodEnd()
+
+	now := time.Now()
+	state := RateLimitState{Allowed: true, Limit: contract.QueriesPerMinute}
+	reservation := cl.limiter.ReserveN(now, int(n))
+	if !reservation.OK() {
+		state.Allowed, state.RetryAfter = false, time.Minute
+	} else if delay := reservation.DelayFrom(now); delay > 0 {
+		reservation.CancelAt(now)
+		state.Allowed, state.RetryAfter = false, delay
+	}
+	tokens := cl.limiter.TokensAt(now)
+	if tokens > 0 {
+		state.Remaining = int64(tokens)
+	}
+	missing := float64(contract.QueriesPerMinute) - tokens
+	state.Reset = now.Add(time.Duration(missing / float64(cl.limiter.Limit()) * float64(time.Second)))
+	return state
+}
+
+// takeShared charge a request to the count of the contract's current minute in the shared store, the count is given
+// back when the request is refused
+func (l *ContractRateLimiter) takeShared(contract types.Contract, n int64) (RateLimitState, error) {
+	now := l.shared.now()
+	count, err := l.shared.incr(contractCounterKey(contract), now, time.Minute, n)
+	if err != nil {
+		return RateLimitState{}, err
+	}
+	reset := now.Truncate(time.Minute).Add(time.Minute)
+	state := RateLimitState{Allowed: true, Limit: contract.QueriesPerMinute, Reset: reset}
+	if count > contract.QueriesPerMinute {
+		// a count that isn't given back only makes the minute tighter
+		_, _ = l.shared.incr(contractCounterKey(contract), now, time.Minute, -n)
+		count -= n
+		state.Allowed, state.RetryAfter = false, reset.Sub(now)
+	}
+	if remaining := contract.QueriesPerMinute - count; remaining > 0 {
+		state.Remaining = remaining
+	}
+	return state, nil
+}
+
+func contractCounterKey(contract types.Contract) string {
+	return "contract:" + strconv.FormatUint(contract.Id, 10)
+}
+
+// Tokens return the queries the contract can make right away
+func (l *ContractRateLimiter) Tokens(contract types.Contract) float64 {
+	if l.shared != nil && contract.QueriesPerMinute > 0 {
+		if count, err := l.shared.get(contractCounterKey(contract), l.shared.now(), time.Minute); err == nil {
+			return float64(contract.QueriesPerMinute - count)
+		}
+	}
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	cl, ok := l.limiters[contract.Id]
+	if !ok || cl.queriesPerMinute != contract.QueriesPerMinute {
+		return float64(contract.QueriesPerMinute)
+	}
+	return cl.limiter.Tokens()
+}
+
+// Remove drop the bucket of a closed contract
+func (l *ContractRateLimiter) Remove(contractId uint64) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	delete(l.limiters, contractId)
+}
+
+// Prune drop the buckets of the contracts that can no longer be used at the given height
+func (l *ContractRateLimiter) Prune(height int64) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	for id, cl := range l.limiters {
+		if cl.expiration < height {
+			delete(l.limiters, id)
+		}
+	}
+}
+
+// Len return the number of contracts with a bucket
+func (l *ContractRateLimiter) Len() int {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	return len(l.limiters)
+}
+
+func perMinute(queries int64) rate.Limit {
+	return rate.Limit(float64(queries) / time.Minute.Seconds())
+}
+
+// setRateLimitHeaders expose the allowance left to the client, on the responses served as on the ones refused
+func setRateLimitHeaders(w http.ResponseWriter, limit, remaining int64, reset time.Time) {
+	w.Header().Set(RateLimitLimitHeader, strconv.FormatInt(limit, 10))
+	w.Header().Set(RateLimitRemainingHeader, strconv.FormatInt(remaining, 10))
+	w.Header().Set(RateLimitResetHeader, strconv.FormatInt(int64(math.Ceil(float64(reset.UnixMilli())/1000)), 10))
+}
+
+// setRetryAfter tell a refused client how many seconds to wait, one at least
+func setRetryAfter(w http.ResponseWriter, retryAfter time.Duration) int64 {
+	seconds := int64(retryAfter.Round(time.Second).Seconds())
+	if seconds < 1 {
+		seconds = 1
+	}
+	w.Header().Set("Retry-After", strconv.FormatInt(seconds, 10))
+	return seconds
+}
+
+func respondWithRateLimitExceeded(w http.ResponseWriter, contract types.Contract, retryAfter time.Duration) {
+	seconds := setRetryAfter(w, retryAfter)
+	respondWithJSON(w, http.StatusTooManyRequests, RateLimitExceeded{
+		Error:             "contract rate limit exceeded",
+		ContractId:        contract.Id,
+		QueriesPerMinute:  contract.QueriesPerMinute,
+		RetryAfterSeconds: seconds,
+	})
+}
@@ -0,0 +1,217 @@
+package sentinel
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/arkeonetwork/arkeo/common"
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+)
+
+func newLimitedContract(id uint64, qpm int64) types.Contract {
+	contract := types.NewContract(types.GetRandomPubKey(), common.BTCService, types.GetRandomPubKey())
+	contract.Id = id
+	contract.Height = 5
+	contract.Duration = 100
+	contract.QueriesPerMinute = qpm
+	return contract
+}
+
+func TestContractRateLimiterConcurrentContracts(t *testing.T) {
+	limiter := NewContractRateLimiter()
+	small := newLimitedContract(1, 5)
+	large := newLimitedContract(2, 50)
+
+	var smallAllowed, largeAllowed int64
+	wg := sync.WaitGroup{}
+	for i := 0; i < 100; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			if ok, _ := limiter.Allow(small); ok {
+				atomic.AddInt64(&smallAllowed, 1)
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			if ok, _ := limiter.Allow(large); ok {
+				atomic.AddInt64(&largeAllowed, 1)
+			}
+		}()
+	}
+	wg.Wait()
+
+	// each contract get its own burst, one doesn't starve the other
+	require.Equal(t, int64(5), smallAllowed)
+	require.Equal(t, int64(50), largeAllowed)
+	require.Equal(t, 2, limiter.Len())
+
+	ok, retryAfter := limiter.Allow(small)
+	require.False(t, ok)
+	// one query every 12 seconds
+	require.InDelta(t, 12*time.Second, retryAfter, float64(time.Second))
+}
+
+func TestContractRateLimiterRefresh(t *testing.T) {
+	limiter := NewContractRateLimiter()
+	contract := newLimitedContract(1, 1)
+	ok, _ := limiter.Allow(contract)
+	require.True(t, ok)
+	ok, _ = limiter.Allow(contract)
+	require.False(t, ok)
+
+	// the contract cache was refreshed with a higher limit
+	contract.QueriesPerMinute = 6000
+	ok, _ = limiter.Allow(contract)
+	require.True(t, ok)
+
+	// no limit on the contract
+	unlimited := newLimitedContract(2, 0)
+	for i := 0; i < 10; i++ {
+		ok, _ = limiter.Allow(unlimited)
+		require.True(t, ok)
+	}
+	require.Equal(t, 1, limiter.Len())
+}
+
+func TestContractRateLimiterExpiry(t *testing.T) {
+	limiter := NewContractRateLimiter()
+	first := newLimitedContract(1, 1)
+	second := newLimitedContract(2, 1)
+	second.Duration = 500
+	limiter.Allow(first)
+	limiter.Allow(second)
+	require.Equal(t, 2, limiter.Len())
+
+	limiter.Prune(first.SettlementPeriodEnd())
+	require.Equal(t, 2, limiter.Len())
+	limiter.Prune(first.SettlementPeriodEnd() + 1)
+	require.Equal(t, 1, limiter.Len())
+
+	limiter.Remove(second.Id)
+	require.Equal(t, 0, limiter.Len())
+	// a new bucket is created if the contract is used again
+	ok, _ := limiter.Allow(second)
+	require.True(t, ok)
+}
+
+func TestRespondWithRateLimitExceeded(t *testing.T) {
+	contract := newLimitedContract(7, 30)
+	w := httptest.NewRecorder()
+	respondWithRateLimitExceeded(w, contract, 1500*time.Millisecond)
+
+	require.Equal(t, http.StatusTooManyRequests, w.Code)
+	require.Equal(t, "2", w.Header().Get("Retry-After"))
+	var body RateLimitExceeded
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	require.Equal(t, RateLimitExceeded{
+		Error:             "contract rate limit exceeded",
+		ContractId:        7,
+		QueriesPerMinute:  30,
+		RetryAfterSeconds: 2,
+	}, body)
+}
+
+func TestContractRateLimiterTake(t *testing.T) {
+	limiter := NewContractRateLimiter()
+	contract := newLimitedContract(1, 10)
+
+	// a concurrent burst crossing the limit, each request charged sees the allowance left right after it
+	states := make(chan RateLimitState, 15)
+	wg := sync.WaitGroup{}
+	for i := 0; i < 15; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			states <- limiter.Take(contract, 1)
+		}()
+	}
+	wg.Wait()
+	close(states)
+	remaining := make(map[int64]bool)
+	refused := 0
+	for state := range states {
+		require.Equal(t, int64(10), state.Limit)
+		if !state.Allowed {
+			refused++
+			require.Zero(t, state.Remaining)
+			require.InDelta(t, 6*time.Second, state.RetryAfter, float64(time.Second))
+			continue
+		}
+		require.False(t, remaining[state.Remaining], "remaining %d reported twice", state.Remaining)
+		remaining[state.Remaining] = true
+	}
+	require.Equal(t, 5, refused)
+	require.Len(t, remaining, 10)
+	for i := int64(0); i < 10; i++ {
+		require.True(t, remaining[i], i)
+	}
+
+	// an unlimited contract has no allowance to report
+	require.Equal(t, RateLimitState{Allowed: true}, limiter.Take(newLimitedContract(2, 0), 1))
+}
+
+func TestRateLimitHeaders(t *testing.T) {
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer upstream.Close()
+	config := newTestConfig()
+	config.FreeTierRateLimit = 2
+	proxy, err := NewProxy(config)
+	require.NoError(t, err)
+	proxy.proxies[common.BTCService.String()] = common.MustParseURL(upstream.URL)
+	proxy.MemStore.SetHeight(10)
+	contract := newWebsocketContract(1, 3, 100)
+	proxy.MemStore.Put(contract)
+	router := proxy.getRouter()
+
+	serve := func(query string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/"+common.BTCService.String()+query, nil))
+		return w
+	}
+	reset := func(w *httptest.ResponseRecorder) time.Duration {
+		unix, err := strconv.ParseInt(w.Header().Get(RateLimitResetHeader), 10, 64)
+		require.NoError(t, err)
+		return time.Until(time.Unix(unix, 0))
+	}
+
+	// a burst of paid requests crossing the contract's 3 queries per minute, one refills every 20 seconds
+	for nonce, remaining := range []string{"2", "1", "0"} {
+		w := serve(fmt.Sprintf("?%s=%d:%d", QueryArkAuth, contract.Id, nonce+1))
+		require.Equal(t, http.StatusOK, w.Code)
+		require.Equal(t, "3", w.Header().Get(RateLimitLimitHeader))
+		require.Equal(t, remaining, w.Header().Get(RateLimitRemainingHeader))
+		require.InDelta(t, time.Duration(nonce+1)*20*time.Second, reset(w), float64(2*time.Second))
+		require.Empty(t, w.Header().Get("Retry-After"))
+	}
+	w := serve(fmt.Sprintf("?%s=%d:4", QueryArkAuth, contract.Id))
+	require.Equal(t, http.StatusTooManyRequests, w.Code)
+	require.Equal(t, "3", w.Header().Get(RateLimitLimitHeader))
+	require.Equal(t, "0", w.Header().Get(RateLimitRemainingHeader))
+	require.Equal(t, "20", w.Header().Get("Retry-After"))
+	require.InDelta(t, time.Minute, reset(w), float64(2*time.Second))
+
+	// the free tier reports its tightest window the same way
+	for _, remaining := range []string{"1", "0"} {
+		w := serve("")
+		require.Equal(t, http.StatusOK, w.Code)
+		require.Equal(t, "2", w.Header().Get(RateLimitLimitHeader))
+		require.Equal(t, remaining, w.Header().Get(RateLimitRemainingHeader))
+		require.Equal(t, w.Header().Get("X-Free-Tier-Reset-Minute"), w.Header().Get(RateLimitResetHeader))
+	}
+	w = serve("")
+	require.Equal(t, http.StatusTooManyRequests, w.Code)
+	require.Equal(t, "0", w.Header().Get(RateLimitRemainingHeader))
+	retryAfter, err := strconv.Atoi(w.Header().Get("Retry-After"))
+	require.NoError(t, err)
+	require.InDelta(t, reset(w).Seconds(), retryAfter, 1)
+}
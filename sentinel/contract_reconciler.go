@@ -0,0 +1,79 @@
+package sentinel
+
+import (
+	"time"
+
+	"github.com/cometbft/cometbft/libs/log"
+)
+
+// ContractReconciliation is the outcome of a reconciliation of the contract cache with the chain
+type ContractReconciliation struct {
+	Refreshed int // contracts replaced with their state on chain
+	Removed   int // contracts that can no longer be used
+	Failed    int // contracts the chain didn't return, kept as they are
+}
+
+// ContractReconciler refresh the cached contracts from the chain. The chain events keep the cache up to date, the
+// reconciliation catches up with the events missed: periodically, and as soon as blocks were missed, which is how a
+// reconnection of the event stream shows
+type ContractReconciler struct {
+	contracts *MemStore
+	interval  time.Duration
+	trigger   chan struct{}
+	logger    log.Logger
+}
+
+func NewContractReconciler(contracts *MemStore, interval time.Duration, logger log.Logger) *ContractReconciler {
+	return &ContractReconciler{
+		contracts: contracts,
+		interval:  interval,
+		trigger:   make(chan struct{}, 1),
+		logger:    logger.With("module", "contract-reconciler"),
+	}
+}
+
+// Run reconcile the cache periodically, when configured, and when triggered until done is closed
+func (c *ContractReconciler) Run(done <-chan struct{}) {
+	var tick <-chan time.Time
+	if c.interval > 0 {
+		ticker := time.NewTicker(c.interval)
+		defer ticker.Stop()
+		tick = ticker.C
+	}
+	for {
+		select {
+		case <-done:
+			return
+		case <-tick:
+		case <-c.trigger:
+		}
+		c.Reconcile()
+	}
+}
+
+// Trigger ask for a reconciliation, without waiting for it. Triggers received while one is pending are merged
+func (c *ContractReconciler) Trigger() {
+	select {
+	case c.trigger <- struct{}{}:
+	default:
+	}
+}
+
+// Reconcile refresh every cached contract from the chain
+func (c *ContractReconciler) Reconcile() ContractReconciliation {
+	var result ContractReconciliation
+	for _, key := range c.contracts.Keys() {
+		removed, err := c.contracts.Refresh(key)
+		switch {
+		case err != nil:
+			c.logger.Error("fail to refresh contract", "key", key, "error", err)
+			result.Failed++
+		case removed:
+			result.Removed++
+		default:
+			result.Refreshed++
+		}
+	}
+	c.logger.Info("reconciled contracts", "refreshed", result.Refreshed, "removed", result.Removed, "failed", result.Failed)
+	return result
+}
@@ -0,0 +1,140 @@
+package sentinel
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/arkeonetwork/arkeo/common"
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+)
+
+func TestOpenContractEventServesNextRequest(t *testing.T) {
+	// the chain doesn't know the contract yet
+	chain := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"code":5,"message":"not found"}`))
+	}))
+	defer chain.Close()
+
+	config := newTestConfig()
+	config.SourceChain = chain.URL
+	proxy, err := NewProxy(config)
+	require.NoError(t, err)
+	proxy.MemStore.SetHeight(10)
+	router := proxy.getRouter()
+	contract := newWebsocketContract(1, 1000, 1000)
+	contract.Provider = config.ProviderPubKey
+
+	var nonce atomic.Int64
+	tier := func() string {
+		w := httptest.NewRecorder()
+		path := fmt.Sprintf("/%s?%s=%d:%d", common.BTCService, QueryArkAuth, contract.Id, nonce.Add(1))
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+		return w.Header().Get("tier")
+	}
+	require.Equal(t, "free", tier())
+
+	// requests authenticate while the events update the cache
+	done := make(chan struct{})
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-done:
+					return
+				default:
+					_, _ = proxy.MemStore.Get(contract.Key())
+					_ = proxy.MemStore.List()
+				}
+			}
+		}()
+	}
+
+	openEvent := types.NewOpenContractEvent(100, &contract)
+	sdkEvt, err := sdk.TypedEventToEvent(&openEvent)
+	require.NoError(t, err)
+	proxy.handleOpenContractEvent(makeResultEvent(sdkEvt, contract.Height))
+	require.Equal(t, "paid", tier())
+
+	closeEvent := types.EventCloseContract{
+		ContractId: contract.Id,
+		Provider:   contract.Provider,
+		Service:    contract.Service.String(),
+		Client:     contract.Client,
+		Delegate:   contract.Delegate,
+	}
+	sdkEvt, err = sdk.TypedEventToEvent(&closeEvent)
+	require.NoError(t, err)
+	proxy.handleCloseContractEvent(makeResultEvent(sdkEvt, 10))
+	require.Equal(t, "free", tier())
+
+	close(done)
+	wg.Wait()
+}
+
+func TestContractReconciler(t *testing.T) {
+	fetching := make(chan struct{})
+	release := make(chan struct{})
+	chain := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:]
+		switch id {
+		case "1":
+			// settled while the sentinel missed the event
+			_, _ = w.Write([]byte(`{"contract":{"id":"1","type":"PAY_AS_YOU_GO","height":"5","duration":"100",
+				"deposit":"1000","settlement_height":"8"}}`))
+		case "2":
+			_, _ = w.Write([]byte(`{"contract":{"id":"2","type":"PAY_AS_YOU_GO","height":"5","duration":"100",
+				"deposit":"1000","nonce":"42","queries_per_minute":"10"}}`))
+		case "3":
+			// an event arrives while the contract is fetched
+			fetching <- struct{}{}
+			<-release
+			_, _ = w.Write([]byte(`{"contract":{"id":"3","type":"PAY_AS_YOU_GO","height":"5","duration":"100",
+				"deposit":"1000","settlement_height":"8"}}`))
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer chain.Close()
+
+	config := newTestConfig()
+	config.SourceChain = chain.URL
+	proxy, err := NewProxy(config)
+	require.NoError(t, err)
+	proxy.MemStore.SetHeight(10)
+	for id := uint64(1); id <= 4; id++ {
+		proxy.MemStore.Put(newWebsocketContract(id, 10, 1000))
+	}
+
+	go func() {
+		<-fetching
+		proxy.MemStore.Put(newWebsocketContract(3, 20, 1000))
+		close(release)
+	}()
+	result := proxy.ContractReconciler.Reconcile()
+	require.Equal(t, ContractReconciliation{Refreshed: 2, Removed: 1, Failed: 1}, result)
+
+	_, err = proxy.MemStore.Get("1")
+	require.NoError(t, err)
+	require.NotContains(t, proxy.MemStore.Keys(), "1")
+	refreshed, err := proxy.MemStore.Get("2")
+	require.NoError(t, err)
+	require.EqualValues(t, 42, refreshed.Nonce)
+	kept, err := proxy.MemStore.Get("3")
+	require.NoError(t, err)
+	require.EqualValues(t, 20, kept.QueriesPerMinute)
+	failed, err := proxy.MemStore.Get("4")
+	require.NoError(t, err)
+	require.EqualValues(t, 10, failed.QueriesPerMinute)
+}
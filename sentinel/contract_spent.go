@@ -0,0 +1,76 @@
+package sentinel
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/arkeonetwork/arkeo/common/cosmos"
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+)
+
+const (
+	AuthErrorSpent = "contract_spent" // the deposit of the pay as you go contract doesn't cover the queries requested
+	// ContractActionOpen tell the client to open a new contract, the chain can't top up the deposit of an open one
+	ContractActionOpen = "open_contract"
+)
+
+// ContractSpent is the body returned to a paid request whose pay as you go contract's deposit is spent
+type ContractSpent struct {
+	Error      string `json:"error"`
+	Code       string `json:"code"`
+	ContractId uint64 `json:"contract_id"`
+	Deposit    string `json:"deposit"`
+	Consumed   string `json:"consumed"`  // what the queries served so far cost, claimed or not
+	Remaining  string `json:"remaining"` // deposit left once they are paid
+	Requested  string `json:"requested"` // what the refused queries cost
+	Action     string `json:"action"`    // what the client should do to be served again
+	Hint       string `json:"hint"`
+}
+
+// contractSpentError is returned for a pay as you go contract whose deposit doesn't cover the queries requested
+type contractSpentError struct {
+	contract types.Contract
+	consumed int64 // queries served so far
+	queries  int64 // queries requested
+}
+
+func (e *contractSpentError) Error() string {
+	return fmt.Sprintf("%s: contract %d has no deposit left for %d more queries", ErrContractSpent, e.contract.Id, e.queries)
+}
+
+// Unwrap let the websocket and grpc streams cut for a spent contract match ErrContractSpent
+func (e *contractSpentError) Unwrap() error {
+	return ErrContractSpent
+}
+
+// checkDeposit return an error when the deposit of a pay as you go contract, with the queries served so far paid,
+// doesn't cover queries more, past the overdraft allowed. The queries served so far are the highest nonce used and the
+// queries charged on top of it, streams and response bytes, so what was already claimed is included
+func (p Proxy) checkDeposit(contract types.Contract, consumed, queries int64) error {
+	if !contract.IsPayAsYouGo() || queries <= 0 {
+		return nil
+	}
+	if depositCovers(contract, consumed+queries-p.Config.ContractOverdraft) {
+		return nil
+	}
+	return &contractSpentError{contract: contract, consumed: consumed, queries: queries}
+}
+
+func respondWithContractSpent(w http.ResponseWriter, err *contractSpentError) {
+	contract := err.contract
+	deposit := cosmos.ZeroInt()
+	if !contract.Deposit.IsNil() {
+		deposit = contract.Deposit
+	}
+	respondWithJSON(w, http.StatusPaymentRequired, ContractSpent{
+		Error:      err.Error(),
+		Code:       AuthErrorSpent,
+		ContractId: contract.Id,
+		Deposit:    cosmos.Coin{Denom: contract.Rate.Denom, Amount: deposit}.String(),
+		Consumed:   queriesCost(contract, err.consumed).String(),
+		Remaining:  remainingDeposit(contract, err.consumed, 0).String(),
+		Requested:  queriesCost(contract, err.queries).String(),
+		Action:     ContractActionOpen,
+		Hint:       fmt.Sprintf("the deposit of an open contract can't be topped up, close contract %d and open a new one with a larger deposit", contract.Id),
+	})
+}
@@ -0,0 +1,76 @@
+package sentinel
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/arkeonetwork/arkeo/common"
+	"github.com/arkeonetwork/arkeo/common/cosmos"
+	"github.com/arkeonetwork/arkeo/sentinel/conf"
+)
+
+func TestContractSpent(t *testing.T) {
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("{}"))
+	}))
+	defer upstream.Close()
+
+	config := newTestConfig()
+	config.Services = map[string]conf.ServiceConfiguration{
+		common.BTCService.String(): {Upstream: upstream.URL, Costs: []conf.ServiceCost{{Path: "/heavy", Cost: 3}}},
+	}
+	proxy, err := NewProxy(config)
+	require.NoError(t, err)
+	// the deposit pays for 10 queries at 2uarkeo, 3 of them already claimed on chain
+	contract := newWebsocketContract(1, 100, 20)
+	contract.Rate = cosmos.NewInt64Coin("uarkeo", 2)
+	contract.Nonce = 3
+	contract.Paid = cosmos.NewInt(6)
+	proxy.MemStore.SetHeight(10)
+	proxy.MemStore.Put(contract)
+	router := proxy.getRouter()
+	serve := func(path string, nonce int64) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, fmt.Sprintf("/%s%s?%s=%d:%d", common.BTCService, path, QueryArkAuth, contract.Id, nonce), nil))
+		return w
+	}
+	requireSpent := func(w *httptest.ResponseRecorder, consumed, remaining, requested string) {
+		require.Equal(t, http.StatusPaymentRequired, w.Code)
+		require.NotEqual(t, "free", w.Header().Get("tier"))
+		var spent ContractSpent
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &spent))
+		require.Equal(t, AuthErrorSpent, spent.Code)
+		require.Equal(t, contract.Id, spent.ContractId)
+		require.Equal(t, "20uarkeo", spent.Deposit)
+		require.Equal(t, consumed, spent.Consumed)
+		require.Equal(t, remaining, spent.Remaining)
+		require.Equal(t, requested, spent.Requested)
+		require.Equal(t, ContractActionOpen, spent.Action)
+		require.Contains(t, spent.Hint, "close contract 1")
+	}
+
+	// billed requests down to the last query of the deposit
+	for nonce := int64(4); nonce <= 6; nonce++ {
+		require.Equal(t, http.StatusOK, serve("/", nonce).Code)
+	}
+	require.Equal(t, http.StatusOK, serve("/heavy", 9).Code)
+	// a request costing more than the deposit left is refused, the cheaper one still fits
+	requireSpent(serve("/heavy", 12), "18uarkeo", "2uarkeo", "6uarkeo")
+	require.Equal(t, http.StatusOK, serve("/", 10).Code)
+	require.Equal(t, "0uarkeo", proxy.contractUsage(contract).RemainingDeposit)
+	// exhausted
+	requireSpent(serve("/", 11), "20uarkeo", "0uarkeo", "2uarkeo")
+
+	// the queries charged on top of the nonce count against the deposit
+	proxy.StreamUsage.Add(contract.Id, 1)
+	config.ContractOverdraft = 2
+	_, err = proxy.Reload(config)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, serve("/", 11).Code)
+	requireSpent(serve("/", 12), "24uarkeo", "0uarkeo", "2uarkeo")
+}
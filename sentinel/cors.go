@@ -0,0 +1,70 @@
+package sentinel
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// corsAuthHeaders are the request headers carrying the arkeo auth, always allowed so a browser can send them
+var corsAuthHeaders = []string{QueryArkAuth, QueryContract, ServiceHeader, QueryClientPubKey}
+
+// corsExposedHeaders are the response headers a browser client reads, the tier, cost and allowances of its requests
+var corsExposedHeaders = []string{
+	"tier", CostHeader, "Retry-After",
+	"X-Free-Tier-Remaining-Minute", "X-Free-Tier-Reset-Minute", "X-Free-Tier-Remaining-Day", "X-Free-Tier-Reset-Day",
+	RateLimitLimitHeader, RateLimitRemainingHeader, RateLimitResetHeader,
+}
+
+// allowedOrigin return the value of the allow origin header for an origin, empty when it isn't allowed
+func allowedOrigin(origins []string, origin string) string {
+	if len(origin) == 0 {
+		return ""
+	}
+	for _, allowed := range origins {
+		if allowed == "*" {
+			return "*"
+		}
+		if strings.EqualFold(allowed, origin) {
+			return origin
+		}
+	}
+	return ""
+}
+
+// cors add the CORS headers of the configured origins to the responses of the browser requests
+func (p Proxy) cors(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		cors := p.current().Config.CORS
+		if origin := allowedOrigin(cors.AllowOrigins, r.Header.Get("Origin")); len(origin) > 0 {
+			w.Header().Set("Access-Control-Allow-Origin", origin)
+			w.Header().Set("Access-Control-Expose-Headers", strings.Join(corsExposedHeaders, ", "))
+			if origin != "*" {
+				w.Header().Add("Vary", "Origin")
+			}
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// handlePreflight answer the OPTIONS requests without authenticating them, they are neither forwarded upstream nor
+// charged
+func (p Proxy) handlePreflight(w http.ResponseWriter, r *http.Request) {
+	cors := p.current().Config.CORS
+	if len(allowedOrigin(cors.AllowOrigins, r.Header.Get("Origin"))) > 0 {
+		headers := append(append([]string{}, cors.AllowHeaders...), corsAuthHeaders...)
+		w.Header().Set("Access-Control-Allow-Methods", strings.Join(cors.AllowMethods, ", "))
+		w.Header().Set("Access-Control-Allow-Headers", strings.Join(headers, ", "))
+		w.Header().Set("Access-Control-Max-Age", strconv.FormatInt(cors.MaxAge, 10))
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// stripCORSHeaders drop the CORS headers of an upstream response, the sentinel sends its own
+func stripCORSHeaders(header http.Header) {
+	for key := range header {
+		if strings.HasPrefix(key, "Access-Control-") {
+			header.Del(key)
+		}
+	}
+}
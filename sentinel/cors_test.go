@@ -0,0 +1,96 @@
+package sentinel
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/arkeonetwork/arkeo/common"
+	"github.com/arkeonetwork/arkeo/sentinel/conf"
+)
+
+func TestCORS(t *testing.T) {
+	var upstreamRequests atomic.Int64
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		upstreamRequests.Add(1)
+		w.Header().Set("Access-Control-Allow-Origin", "*")
+		_, _ = w.Write([]byte("{}"))
+	}))
+	defer upstream.Close()
+
+	config := newTestConfig()
+	config.CORS = conf.CORSConfiguration{
+		AllowOrigins: []string{"https://dapp.example"},
+		AllowMethods: []string{http.MethodGet, http.MethodPost},
+		AllowHeaders: []string{"Content-Type"},
+		MaxAge:       600,
+	}
+	config.Services = map[string]conf.ServiceConfiguration{
+		"btc-mainnet-fullnode": {Upstream: upstream.URL, RateLimit: 1},
+	}
+	proxy, err := NewProxy(config)
+	require.NoError(t, err)
+	contract := newWebsocketContract(1, 100, 100)
+	proxy.MemStore.SetHeight(10)
+	proxy.MemStore.Put(contract)
+	router := proxy.getRouter()
+
+	serve := func(method, path, origin string, headers map[string]string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(method, path, strings.NewReader(""))
+		r.Header.Set("Origin", origin)
+		for key, value := range headers {
+			r.Header.Set(key, value)
+		}
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, r)
+		return w
+	}
+	path := fmt.Sprintf("/%s", common.BTCService)
+
+	// the preflight is answered by the sentinel, whatever the service limit
+	for i := 0; i < 3; i++ {
+		w := serve(http.MethodOptions, path, "https://dapp.example", map[string]string{
+			"Access-Control-Request-Method":  http.MethodPost,
+			"Access-Control-Request-Headers": "arkauth, content-type",
+		})
+		require.Equal(t, http.StatusNoContent, w.Code)
+		require.Equal(t, "https://dapp.example", w.Header().Get("Access-Control-Allow-Origin"))
+		require.Equal(t, "GET, POST", w.Header().Get("Access-Control-Allow-Methods"))
+		require.Contains(t, w.Header().Get("Access-Control-Allow-Headers"), QueryArkAuth)
+		require.Contains(t, w.Header().Get("Access-Control-Allow-Headers"), "Content-Type")
+		require.Equal(t, "600", w.Header().Get("Access-Control-Max-Age"))
+	}
+	require.Zero(t, upstreamRequests.Load())
+	require.False(t, proxy.ClaimStore.Has(contract.Key()))
+
+	// the actual request carries the auth in a header, the upstream CORS headers are replaced
+	w := serve(http.MethodPost, path, "https://dapp.example", map[string]string{QueryArkAuth: fmt.Sprintf("%d:1", contract.Id)})
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, []string{"https://dapp.example"}, w.Header().Values("Access-Control-Allow-Origin"))
+	require.Contains(t, w.Header().Get("Access-Control-Expose-Headers"), CostHeader)
+	require.Equal(t, "paid", w.Header().Get("tier"))
+	require.EqualValues(t, 1, upstreamRequests.Load())
+	claim, err := proxy.ClaimStore.Get(contract.Key())
+	require.NoError(t, err)
+	require.EqualValues(t, 1, claim.Nonce)
+
+	// the metadata can be read from the browser too
+	w = serve(http.MethodOptions, RoutesMetaData, "https://dapp.example", map[string]string{"Access-Control-Request-Method": http.MethodGet})
+	require.Equal(t, http.StatusNoContent, w.Code)
+	w = serve(http.MethodGet, RoutesMetaData, "https://dapp.example", nil)
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, "https://dapp.example", w.Header().Get("Access-Control-Allow-Origin"))
+
+	// other origins get no CORS headers
+	w = serve(http.MethodOptions, path, "https://evil.example", map[string]string{"Access-Control-Request-Method": http.MethodPost})
+	require.Equal(t, http.StatusNoContent, w.Code)
+	require.Empty(t, w.Header().Get("Access-Control-Allow-Origin"))
+	require.Empty(t, w.Header().Get("Access-Control-Allow-Headers"))
+	w = serve(http.MethodGet, RoutesMetaData, "https://evil.example", nil)
+	require.Empty(t, w.Header().Get("Access-Control-Allow-Origin"))
+}
@@ -0,0 +1,156 @@
+package sentinel
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/gorilla/websocket"
+
+	"github.com/arkeonetwork/arkeo/sentinel/conf"
+)
+
+// validateCosts return an error when a cost entry of a service can't be matched or charge less than a query
+func validateCosts(name string, costs []conf.ServiceCost) error {
+	for _, cost := range costs {
+		if cost.Cost < 1 {
+			return fmt.Errorf("service %s cost of %s%s%s must be at least 1", name, cost.Method, cost.Path, cost.RPCMethod)
+		}
+		if _, err := path.Match(cost.Path, ""); err != nil {
+			return fmt.Errorf("service %s cost path %s: %w", name, cost.Path, err)
+		}
+	}
+	return nil
+}
+
+const (
+	AuthErrorBatchTooLarge = "batch_too_large" // the JSON-RPC batch holds more calls than the service accepts
+	// defaultMaxBatchSize is the most calls a JSON-RPC batch can hold unless the service says otherwise
+	defaultMaxBatchSize = 100
+)
+
+// rpcCall is the part of a JSON-RPC call the costs are matched on
+type rpcCall struct {
+	Method string `json:"method"`
+}
+
+// requestCost return the number of queries a request is charged, one unless it matches an entry of the service
+// costs. The JSON-RPC body is only read when the service has costs per rpc method, or is a JSON-RPC service whose
+// batches are charged one query per call: each call of a batch is then charged on its own. A body that can't be parsed
+// is charged as one request and forwarded as is
+func (p Proxy) requestCost(r *http.Request, service string) (int64, error) {
+	settings := p.Config.Services[service]
+	costs := settings.Costs
+	batches := settings.JSONRPC && r.Method == http.MethodPost
+	if len(costs) == 0 && !batches {
+		return 1, nil
+	}
+	reqPath := servicePath(r, service)
+	// a streamed body isn't read to be charged, its request is charged by method and path as it is accepted
+	if (!hasRPCCosts(costs) && !batches) || isGRPCRequest(r) || websocket.IsWebSocketUpgrade(r) || isStreamedRequest(r) || r.Body == nil || r.Body == http.NoBody {
+		return matchCost(costs, r.Method, reqPath, ""), nil
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return 0, fmt.Errorf("fail to read request body: %w", err)
+	}
+	r.Body = io.NopCloser(bytes.NewReader(body))
+
+	trimmed := bytes.TrimSpace(body)
+	if calls, ok := parseRPCBatch(trimmed); ok {
+		if maxSize := p.maxBatchSize(service); batches && len(calls) > maxSize {
+			return 0, &batchTooLargeError{size: len(calls), max: maxSize}
+		}
+		var total int64
+		for _, call := range calls {
+			total += matchCost(costs, r.Method, reqPath, call.Method)
+		}
+		return total, nil
+	}
+	// a body that isn't JSON-RPC is matched without a method
+	var call rpcCall
+	_ = json.Unmarshal(trimmed, &call)
+	return matchCost(costs, r.Method, reqPath, call.Method), nil
+}
+
+// parseRPCBatch return the calls of a JSON-RPC batch, false when the body isn't a non empty JSON array. The elements
+// that aren't calls are counted without a method
+func parseRPCBatch(body []byte) ([]rpcCall, bool) {
+	if len(body) == 0 || body[0] != '[' {
+		return nil, false
+	}
+	var elements []json.RawMessage
+	if err := json.Unmarshal(body, &elements); err != nil || len(elements) == 0 {
+		return nil, false
+	}
+	calls := make([]rpcCall, len(elements))
+	for i, element := range elements {
+		_ = json.Unmarshal(element, &calls[i])
+	}
+	return calls, true
+}
+
+// maxBatchSize return the most calls a JSON-RPC batch to a service can hold
+func (p Proxy) maxBatchSize(service string) int {
+	if size := p.Config.Services[service].MaxBatchSize; size > 0 {
+		return size
+	}
+	return defaultMaxBatchSize
+}
+
+// batchTooLargeError is returned for a JSON-RPC batch holding more calls than its service accepts
+type batchTooLargeError struct {
+	size int
+	max  int
+}
+
+func (e *batchTooLargeError) Error() string {
+	return fmt.Sprintf("batch of %d calls is over the maximum of %d", e.size, e.max)
+}
+
+func respondWithBatchTooLarge(w http.ResponseWriter, err *batchTooLargeError) {
+	respondWithJSON(w, http.StatusRequestEntityTooLarge, AuthError{Error: err.Error(), Code: AuthErrorBatchTooLarge})
+}
+
+// matchCost return the cost of the first entry matching the request, one when none does
+func matchCost(costs []conf.ServiceCost, method, reqPath, rpcMethod string) int64 {
+	for _, cost := range costs {
+		if len(cost.Method) > 0 && !strings.EqualFold(cost.Method, method) {
+			continue
+		}
+		if len(cost.Path) > 0 {
+			if ok, _ := path.Match(cost.Path, reqPath); !ok {
+				continue
+			}
+		}
+		if len(cost.RPCMethod) > 0 && cost.RPCMethod != rpcMethod {
+			continue
+		}
+		return cost.Cost
+	}
+	return 1
+}
+
+func hasRPCCosts(costs []conf.ServiceCost) bool {
+	for _, cost := range costs {
+		if len(cost.RPCMethod) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// servicePath return the request path past the service segment, the whole path when the service is sent in a header.
+// It is matched without its trailing slash
+func servicePath(r *http.Request, service string) string {
+	reqPath := r.URL.Path
+	if len(r.Header.Get(ServiceHeader)) == 0 {
+		reqPath = strings.TrimPrefix(reqPath, "/"+service)
+	}
+	return path.Clean("/" + reqPath)
+}
@@ -0,0 +1,198 @@
+package sentinel
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/arkeonetwork/arkeo/common"
+	"github.com/arkeonetwork/arkeo/sentinel/conf"
+)
+
+func TestRequestCost(t *testing.T) {
+	config := newTestConfig()
+	config.Services = map[string]conf.ServiceConfiguration{
+		"btc-mainnet-fullnode": {Costs: []conf.ServiceCost{
+			{Method: http.MethodPost, RPCMethod: "getblock", Cost: 5},
+			{Path: "/rest/block/*", Cost: 3},
+		}},
+	}
+	proxy, err := NewProxy(config)
+	require.NoError(t, err)
+
+	cost := func(method, path, body string) int64 {
+		r := httptest.NewRequest(method, path, strings.NewReader(body))
+		cost, err := proxy.requestCost(r, "btc-mainnet-fullnode")
+		require.NoError(t, err)
+		// the body is still there for the upstream
+		forwarded, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		require.Equal(t, body, string(forwarded))
+		return cost
+	}
+	require.EqualValues(t, 5, cost(http.MethodPost, "/btc-mainnet-fullnode", `{"method":"getblock"}`))
+	require.EqualValues(t, 1, cost(http.MethodPost, "/btc-mainnet-fullnode", `{"method":"getblockcount"}`))
+	require.EqualValues(t, 6, cost(http.MethodPost, "/btc-mainnet-fullnode", `[{"method":"getblock"},{"method":"getblockcount"}]`))
+	require.EqualValues(t, 3, cost(http.MethodGet, "/btc-mainnet-fullnode/rest/block/abc", ""))
+	require.EqualValues(t, 1, cost(http.MethodGet, "/btc-mainnet-fullnode/rest/block/abc/txs", ""))
+	require.EqualValues(t, 1, cost(http.MethodPost, "/btc-mainnet-fullnode", "not json"))
+
+	// the service named in the header isn't part of the path
+	r := httptest.NewRequest(http.MethodGet, "/rest/block/abc", nil)
+	r.Header.Set(ServiceHeader, "btc-mainnet-fullnode")
+	c, err := proxy.requestCost(r, "btc-mainnet-fullnode")
+	require.NoError(t, err)
+	require.EqualValues(t, 3, c)
+
+	config.Services["btc-mainnet-fullnode"] = conf.ServiceConfiguration{Costs: []conf.ServiceCost{{Path: "/rest", Cost: 0}}}
+	_, err = NewProxy(config)
+	require.Error(t, err)
+}
+
+func TestServiceCosts(t *testing.T) {
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("{}"))
+	}))
+	defer upstream.Close()
+
+	costs := []conf.ServiceCost{{Method: http.MethodPost, RPCMethod: "getblock", Cost: 5}}
+	config := newTestConfig()
+	config.Services = map[string]conf.ServiceConfiguration{
+		"btc-mainnet-fullnode": {Upstream: upstream.URL, Costs: costs},
+	}
+	proxy, err := NewProxy(config)
+	require.NoError(t, err)
+	contract := newWebsocketContract(1, 10, 100)
+	proxy.MemStore.SetHeight(10)
+	proxy.MemStore.Put(contract)
+	router := proxy.getRouter()
+
+	serve := func(method string, nonce int64, body string) *httptest.ResponseRecorder {
+		path := fmt.Sprintf("/%s?%s=%d:%d", common.BTCService, QueryArkAuth, contract.Id, nonce)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(method, path, strings.NewReader(body)))
+		return w
+	}
+	claimed := func() int64 {
+		claim, err := proxy.ClaimStore.Get(contract.Key())
+		require.NoError(t, err)
+		return pendingIncome(contract, claim, 10).Amount.Int64()
+	}
+
+	// the nonce of a cost 5 request must advance by 5
+	w := serve(http.MethodPost, 1, `{"method":"getblock"}`)
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	var authErr AuthError
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &authErr))
+	require.Equal(t, AuthErrorCost, authErr.Code)
+
+	w = serve(http.MethodPost, 5, `{"method":"getblock"}`)
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, "5", w.Header().Get(CostHeader))
+	require.EqualValues(t, 5*contract.Rate.Amount.Int64(), claimed())
+
+	w = serve(http.MethodPost, 10, `{"method":"getblock"}`)
+	require.Equal(t, http.StatusOK, w.Code)
+	require.EqualValues(t, 10*contract.Rate.Amount.Int64(), claimed())
+
+	// the two requests took the 10 queries per minute of the contract, even the cheapest request is refused
+	w = serve(http.MethodGet, 11, "")
+	require.Equal(t, http.StatusTooManyRequests, w.Code)
+	proxy.ContractLimiter.Remove(contract.Id)
+	w = serve(http.MethodGet, 11, "")
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, "1", w.Header().Get(CostHeader))
+	require.EqualValues(t, 11*contract.Rate.Amount.Int64(), claimed())
+
+	// the costs are advertised
+	w = httptest.NewRecorder()
+	proxy.handleMetadata(w, httptest.NewRequest(http.MethodGet, RoutesMetaData, nil))
+	var metadata Metadata
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &metadata))
+	require.Equal(t, costs, metadata.Configuration.Services["btc-mainnet-fullnode"].Costs)
+}
+
+func TestJSONRPCBatches(t *testing.T) {
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		_, _ = w.Write(body)
+	}))
+	defer upstream.Close()
+
+	config := newTestConfig()
+	config.Services = map[string]conf.ServiceConfiguration{
+		common.BTCService.String(): {
+			Upstream:          upstream.URL,
+			Costs:             []conf.ServiceCost{{Method: http.MethodPost, RPCMethod: "getblock", Cost: 5}},
+			JSONRPC:           true,
+			MaxBatchSize:      4,
+			FreeTierRateLimit: 6,
+		},
+	}
+	proxy, err := NewProxy(config)
+	require.NoError(t, err)
+	contract := newWebsocketContract(1, 100, 100)
+	proxy.MemStore.SetHeight(10)
+	proxy.MemStore.Put(contract)
+	router := proxy.getRouter()
+
+	serve := func(nonce int64, body string) *httptest.ResponseRecorder {
+		path := fmt.Sprintf("/%s", common.BTCService)
+		if nonce > 0 {
+			path = fmt.Sprintf("%s?%s=%d:%d", path, QueryArkAuth, contract.Id, nonce)
+		}
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodPost, path, strings.NewReader(body)))
+		return w
+	}
+
+	// each call of a batch is charged, by its method's cost, even the elements that aren't calls
+	batch := `[{"method":"getblock"},{"method":"getblockcount"},{"method":"getblockcount"},1]`
+	w := serve(7, batch)
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	w = serve(8, batch)
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, "8", w.Header().Get(CostHeader))
+	require.Equal(t, batch, w.Body.String())
+
+	// a batch over the maximum size is refused before it is charged
+	w = serve(13, `[{"method":"getblockcount"},{"method":"getblockcount"},{"method":"getblockcount"},{"method":"getblockcount"},{"method":"getblockcount"}]`)
+	require.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+	var authErr AuthError
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &authErr))
+	require.Equal(t, AuthErrorBatchTooLarge, authErr.Code)
+	claim, err := proxy.ClaimStore.Get(contract.Key())
+	require.NoError(t, err)
+	require.EqualValues(t, 8, claim.Nonce)
+
+	// a malformed body is charged as one query and forwarded as is
+	for i, body := range []string{`[{"method":"getblock"},`, `[]`, `not json`} {
+		w = serve(int64(9+i), body)
+		require.Equal(t, http.StatusOK, w.Code, body)
+		require.Equal(t, "1", w.Header().Get(CostHeader), body)
+		require.Equal(t, body, w.Body.String(), body)
+	}
+
+	// the free tier allowance is taken by the calls of the batches, the batch that doesn't fit in what is left is
+	// refused whole
+	w = serve(0, `[{"method":"getblockcount"},{"method":"getblockcount"},{"method":"getblockcount"}]`)
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, "3", w.Header().Get("X-Free-Tier-Remaining-Minute"))
+	w = serve(0, `[{"method":"getblockcount"},{"method":"getblockcount"},{"method":"getblockcount"},{"method":"getblockcount"}]`)
+	require.Equal(t, http.StatusTooManyRequests, w.Code)
+	w = serve(0, `[{"method":"getblockcount"},{"method":"getblockcount"}]`)
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, "1", w.Header().Get("X-Free-Tier-Remaining-Minute"))
+	w = serve(0, `[{"method":"getblockcount"},{"method":"getblockcount"},{"method":"getblockcount"},{"method":"getblockcount"},{"method":"getblockcount"}]`)
+	require.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+
+	config.Services[common.BTCService.String()] = conf.ServiceConfiguration{JSONRPC: true, MaxBatchSize: -1}
+	_, err = NewProxy(config)
+	require.ErrorContains(t, err, "max batch size")
+}
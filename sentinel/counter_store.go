@@ -0,0 +1,188 @@
+package sentinel
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/redis/go-redis/v9"
+
+	"github.com/arkeonetwork/arkeo/sentinel/conf"
+)
+
+const (
+	CounterStoreTypeMemory = "memory"
+	CounterStoreTypeRedis  = "redis"
+
+	defaultCounterStoreTimeout = 100 * time.Millisecond
+	// counterStoreRetry is how long the limiters count in memory, or refuse, after the shared store failed before
+	// trying it again, so an unreachable store doesn't slow down every request by its timeout
+	counterStoreRetry = 5 * time.Second
+)
+
+// CounterStore keep the counters of the rate limiters, each counter expiring after the ttl it was created with
+type CounterStore interface {
+	// Incr add n to the counter of key, created with the ttl when it doesn't exist, and return its new value
+	Incr(ctx context.Context, key string, n int64, ttl time.Duration) (int64, error)
+	// Get return the value of the counter of key, zero when it doesn't exist
+	Get(ctx context.Context, key string) (int64, error)
+}
+
+var _ CounterStore = &RedisCounterStore{}
+
+// errCounterStoreDown is returned while the shared store isn't tried after a failure
+var errCounterStoreDown = errors.New("rate limit store unreachable")
+
+// RedisCounterStore keep the counters in redis, shared by the sentinels using it
+type RedisCounterStore struct {
+	client *redis.Client
+}
+
+// incrScript add to a counter and set its ttl when it has none, at once so a counter never outlives its window
+var incrScript = redis.NewScript(`
+local value = redis.call("INCRBY", KEYS[1], ARGV[1])
+if redis.call("PTTL", KEYS[1]) < 0 then
+	redis.call("PEXPIRE", KEYS[1], ARGV[2])
+end
+return value`)
+
+// NewRedisCounterStore connect to the redis of the url, e.g. redis://:password@host:6379/0
+func NewRedisCounterStore(url string) (*RedisCounterStore, error) {
+	options, err := redis.ParseURL(url)
+	if err != nil {
+		return nil, fmt.Errorf("invalid redis url: %w", err)
+	}
+	return &RedisCounterStore{client: redis.NewClient(options)}, nil
+}
+
+func (s *RedisCounterStore) Incr(ctx context.Context, key string, n int64, ttl time.Duration) (int64, error) {
+	return incrScript.Run(ctx, s.client, []string{key}, n, ttl.Milliseconds()).Int64()
+}
+
+func (s *RedisCounterStore) Get(ctx context.Context, key string) (int64, error) {
+	value, err := s.client.Get(ctx, key).Int64()
+	if errors.Is(err, redis.Nil) {
+		return 0, nil
+	}
+	return value, err
+}
+
+func (s *RedisCounterStore) Close() error {
+	return s.client.Close()
+}
+
+// SharedCounters count the requests of the free tier and contract limiters in a store shared by the sentinel
+// replicas. Once the store fails the limiters fall back to their own in memory counters, or refuse the requests when
+// failing closed, until the store is tried again
+type SharedCounters struct {
+	store      CounterStore
+	prefix     string // keeps the counters of the providers sharing a store apart
+	timeout    time.Duration
+	failClosed bool
+	logger     log.Logger
+	metrics    *Metrics
+	now        func() time.Time
+
+	lock      sync.Mutex
+	downUntil time.Time
+}
+
+// NewSharedCounters return the shared counters of the store configured, nil when the counters are kept in memory
+func NewSharedCounters(config conf.RateLimitStoreConfiguration, prefix string, logger log.Logger) (*SharedCounters, error) {
+	var store CounterStore
+	switch strings.ToLower(config.Type) {
+	case "", CounterStoreTypeMemory:
+		return nil, nil
+	case CounterStoreTypeRedis:
+		redisStore, err := NewRedisCounterStore(config.URL)
+		if err != nil {
+			return nil, err
+		}
+		store = redisStore
+	default:
+		return nil, fmt.Errorf("unsupported rate limit store type: %s", config.Type)
+	}
+	timeout := time.Duration(config.TimeoutMs) * time.Millisecond
+	if timeout <= 0 {
+		timeout = defaultCounterStoreTimeout
+	}
+	return &SharedCounters{
+		store:      store,
+		prefix:     prefix,
+		timeout:    timeout,
+		failClosed: config.FailClosed,
+		logger:     logger,
+		now:        time.Now,
+	}, nil
+}
+
+// available return false while the store is deemed down since its last failure
+func (c *SharedCounters) available() bool {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return !c.now().Before(c.downUntil)
+}
+
+// failed record a failure of the store, it isn't tried again for counterStoreRetry
+func (c *SharedCounters) failed(err error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.now().Before(c.downUntil) {
+		return
+	}
+	c.downUntil = c.now().Add(counterStoreRetry)
+	c.logger.Error("rate limit store unreachable", "error", err, "fail_closed", c.failClosed, "retry_in", counterStoreRetry.String())
+	c.metrics.counterStoreFailed()
+}
+
+// incr add n to the counter of key in the fixed window of now, the counter expiring with the window. An error is
+// returned when the store is down, the caller then counts in memory or refuses the request
+func (c *SharedCounters) incr(key string, now time.Time, window time.Duration, n int64) (int64, error) {
+	if !c.available() {
+		return 0, errCounterStoreDown
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+	start := now.Truncate(window)
+	// the counter is kept a little past its window so a skew between the replicas' clocks doesn't reset it early
+	value, err := c.store.Incr(ctx, c.key(key, start), n, start.Add(window).Sub(now)+time.Minute)
+	if err != nil {
+		c.failed(err)
+		return 0, err
+	}
+	return value, nil
+}
+
+// get return the counter of key in the fixed window of now
+func (c *SharedCounters) get(key string, now time.Time, window time.Duration) (int64, error) {
+	if !c.available() {
+		return 0, errCounterStoreDown
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+	value, err := c.store.Get(ctx, c.key(key, now.Truncate(window)))
+	if err != nil {
+		c.failed(err)
+		return 0, err
+	}
+	return value, nil
+}
+
+// Close release the connections to the store
+func (c *SharedCounters) Close() error {
+	if c == nil {
+		return nil
+	}
+	if closer, ok := c.store.(interface{ Close() error }); ok {
+		return closer.Close()
+	}
+	return nil
+}
+
+func (c *SharedCounters) key(key string, start time.Time) string {
+	return fmt.Sprintf("%s:%s:%d", c.prefix, key, start.Unix())
+}
@@ -0,0 +1,148 @@
+package sentinel
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/arkeonetwork/arkeo/sentinel/conf"
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+)
+
+// newSharedCountersTest return counters in a miniredis, with a clock the test moves
+func newSharedCountersTest(t *testing.T, failClosed bool) (*miniredis.Miniredis, *SharedCounters, *time.Time) {
+	store := miniredis.RunT(t)
+	counters, err := NewSharedCounters(conf.RateLimitStoreConfiguration{
+		Type:       CounterStoreTypeRedis,
+		URL:        "redis://" + store.Addr(),
+		FailClosed: failClosed,
+	}, "sentinel:test", log.NewNopLogger())
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = counters.Close() })
+	now := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	counters.now = func() time.Time { return now }
+	return store, counters, &now
+}
+
+func TestSharedCountersFreeTier(t *testing.T) {
+	store, counters, _ := newSharedCountersTest(t, false)
+	// two replicas of the sentinel share the allowance
+	first, now := newFreeTierTest(t, 3, 0, nil, 0)
+	first.Share(counters, "")
+	second, _ := newFreeTierTest(t, 3, 0, nil, 0)
+	second.now = first.now
+	second.Share(counters, "")
+	service, _ := newFreeTierTest(t, 3, 0, nil, 0)
+	service.now = first.now
+	service.Share(counters, "btc-mainnet-fullnode")
+
+	ok, allowances := first.AllowN("10.0.0.1", "", 2)
+	require.True(t, ok)
+	require.Equal(t, 1, allowances[0].Remaining)
+	ok, allowances = second.Allow("10.0.0.1", "")
+	require.True(t, ok)
+	require.Zero(t, allowances[0].Remaining)
+	ok, _ = first.Allow("10.0.0.1", "")
+	require.False(t, ok)
+	// the refused request isn't charged to the pubkey
+	ok, _ = second.Allow("10.0.0.1", "pubkey")
+	require.False(t, ok)
+	ok, allowances = second.Allow("10.0.0.2", "pubkey")
+	require.True(t, ok)
+	require.Equal(t, 2, allowances[0].Remaining)
+	// the allowance of a service is its own
+	ok, _ = service.Allow("10.0.0.1", "")
+	require.True(t, ok)
+
+	// the next window starts afresh
+	*now = now.Add(time.Minute)
+	ok, allowances = second.Allow("10.0.0.1", "")
+	require.True(t, ok)
+	require.Equal(t, 2, allowances[0].Remaining)
+	require.Len(t, store.Keys(), 5)
+	// the counters expire a minute past their window
+	store.FastForward(time.Minute + time.Second)
+	require.Len(t, store.Keys(), 5)
+	store.FastForward(time.Minute)
+	require.Empty(t, store.Keys())
+}
+
+func TestSharedCountersContracts(t *testing.T) {
+	_, counters, now := newSharedCountersTest(t, false)
+	first, second := NewContractRateLimiter(), NewContractRateLimiter()
+	first.Share(counters)
+	second.Share(counters)
+	contract := types.Contract{Id: 1, QueriesPerMinute: 3}
+
+	require.True(t, first.Take(contract, 2).Allowed)
+	state := second.Take(contract, 1)
+	require.True(t, state.Allowed)
+	require.Zero(t, state.Remaining)
+	require.Equal(t, now.Add(time.Minute), state.Reset)
+	state = first.Take(contract, 1)
+	require.False(t, state.Allowed)
+	require.Equal(t, time.Minute, state.RetryAfter)
+	require.Zero(t, second.Tokens(contract))
+
+	*now = now.Add(time.Minute)
+	require.EqualValues(t, 3, first.Tokens(contract))
+	require.True(t, second.Take(contract, 3).Allowed)
+	// the buckets of the replicas weren't used
+	require.Zero(t, first.Len())
+	require.Zero(t, second.Len())
+}
+
+func TestSharedCountersUnreachable(t *testing.T) {
+	// failing open, the replica counts in memory until the store is tried again
+	store, counters, now := newSharedCountersTest(t, false)
+	limiter, _ := newFreeTierTest(t, 2, 0, nil, 0)
+	limiter.Share(counters, "")
+	contracts := NewContractRateLimiter()
+	contracts.Share(counters)
+	contract := types.Contract{Id: 1, QueriesPerMinute: 2}
+
+	ok, _ := limiter.Allow("10.0.0.1", "")
+	require.True(t, ok)
+	store.Close()
+	for i := 0; i < 2; i++ {
+		ok, _ = limiter.Allow("10.0.0.1", "")
+		require.True(t, ok)
+		require.True(t, contracts.Take(contract, 1).Allowed)
+	}
+	ok, _ = limiter.Allow("10.0.0.1", "")
+	require.False(t, ok)
+	require.False(t, contracts.Take(contract, 1).Allowed)
+	require.Equal(t, 1, contracts.Len())
+
+	// the store is back, it is used again once the retry delay is over
+	require.NoError(t, store.Restart())
+	*now = now.Add(counterStoreRetry)
+	ok, allowances := limiter.Allow("10.0.0.1", "")
+	require.True(t, ok)
+	require.Zero(t, allowances[0].Remaining)
+	require.True(t, contracts.Take(types.Contract{Id: 2, QueriesPerMinute: 2}, 1).Allowed)
+	require.Equal(t, 1, contracts.Len())
+
+	// failing closed, the requests are refused while the store is unreachable
+	store, counters, _ = newSharedCountersTest(t, true)
+	limiter, _ = newFreeTierTest(t, 2, 0, nil, 0)
+	limiter.Share(counters, "")
+	contracts = NewContractRateLimiter()
+	contracts.Share(counters)
+	store.Close()
+	ok, allowances = limiter.Allow("10.0.0.1", "")
+	require.False(t, ok)
+	require.Equal(t, counterStoreRetry, freeTierRetryAfter(allowances, limiter.now()))
+	state := contracts.Take(contract, 1)
+	require.False(t, state.Allowed)
+	require.Equal(t, counterStoreRetry, state.RetryAfter)
+
+	_, err := NewSharedCounters(conf.RateLimitStoreConfiguration{Type: "memcached"}, "", log.NewNopLogger())
+	require.ErrorContains(t, err, "unsupported rate limit store type")
+	counters, err = NewSharedCounters(conf.RateLimitStoreConfiguration{Type: CounterStoreTypeMemory}, "", log.NewNopLogger())
+	require.NoError(t, err)
+	require.Nil(t, counters)
+}
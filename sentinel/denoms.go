@@ -0,0 +1,64 @@
+package sentinel
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/arkeonetwork/arkeo/common/cosmos"
+	"github.com/arkeonetwork/arkeo/sentinel/conf"
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+)
+
+// denomRejectedError is returned for a contract priced in a denom the provider doesn't accept
+type denomRejectedError struct {
+	denom string
+}
+
+func (e *denomRejectedError) Error() string {
+	return fmt.Sprintf("contracts in %s aren't accepted", e.denom)
+}
+
+// validateDenoms return an error when an accepted denom or an auto claim threshold can't be parsed
+func validateDenoms(config conf.Configuration) error {
+	for _, denom := range config.AcceptedDenoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return err
+		}
+	}
+	for denom, threshold := range config.AutoClaim.DenomThresholds {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return err
+		}
+		if amount, ok := cosmos.NewIntFromString(threshold); !ok || amount.IsNegative() {
+			return fmt.Errorf("auto claim threshold of %s isn't an amount: %s", denom, threshold)
+		}
+	}
+	return nil
+}
+
+// acceptsDenom return true when contracts priced in denom are served
+func (p Proxy) acceptsDenom(denom string) bool {
+	if len(p.Config.AcceptedDenoms) == 0 {
+		return true
+	}
+	for _, accepted := range p.Config.AcceptedDenoms {
+		if accepted == denom {
+			return true
+		}
+	}
+	return false
+}
+
+// queriesCost return what the queries cost the contract, in its rate denom
+func queriesCost(contract types.Contract, queries int64) cosmos.Coin {
+	if contract.Rate.Amount.IsNil() {
+		return cosmos.Coin{Denom: contract.Rate.Denom, Amount: cosmos.ZeroInt()}
+	}
+	return cosmos.Coin{Denom: contract.Rate.Denom, Amount: contract.Rate.Amount.MulRaw(queries)}
+}
+
+// depositCovers return true when the deposit of the contract pays for the queries
+func depositCovers(contract types.Contract, queries int64) bool {
+	return !contract.Deposit.IsNil() && contract.Deposit.GTE(queriesCost(contract, queries).Amount)
+}
@@ -0,0 +1,122 @@
+package sentinel
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/arkeonetwork/arkeo/common"
+	"github.com/arkeonetwork/arkeo/common/cosmos"
+	"github.com/arkeonetwork/arkeo/sentinel/conf"
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+)
+
+// an 18 decimals denom, its rate alone doesn't fit an int64
+const largeDenom = "aevmos"
+
+func mustInt(t *testing.T, amount string) cosmos.Int {
+	i, ok := cosmos.NewIntFromString(amount)
+	require.True(t, ok, amount)
+	return i
+}
+
+func newLargeDenomContract(t *testing.T, id uint64) types.Contract {
+	contract := newWebsocketContract(id, 1000, 0)
+	contract.Rate = cosmos.NewCoin(largeDenom, mustInt(t, "20000000000000000000"))
+	contract.Deposit = mustInt(t, "200000000000000000000")
+	return contract
+}
+
+func TestQueriesCost(t *testing.T) {
+	contract := newLargeDenomContract(t, 1)
+	cost := queriesCost(contract, 3)
+	require.Equal(t, largeDenom, cost.Denom)
+	require.Equal(t, "60000000000000000000", cost.Amount.String())
+	require.True(t, depositCovers(contract, 10))
+	require.False(t, depositCovers(contract, 11))
+
+	pending := pendingIncome(contract, Claim{Nonce: 7}, 10)
+	require.Equal(t, "140000000000000000000"+largeDenom, pending.String())
+	// capped by the deposit
+	require.Equal(t, contract.Deposit, pendingIncome(contract, Claim{Nonce: 50}, 10).Amount)
+}
+
+func TestLargeDenomPaidTier(t *testing.T) {
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("{}"))
+	}))
+	defer upstream.Close()
+
+	config := newTestConfig()
+	config.Services = map[string]conf.ServiceConfiguration{
+		"btc-mainnet-fullnode": {Upstream: upstream.URL},
+	}
+	proxy, err := NewProxy(config)
+	require.NoError(t, err)
+	contract := newLargeDenomContract(t, 1)
+	proxy.MemStore.SetHeight(10)
+	proxy.MemStore.Put(contract)
+	router := proxy.getRouter()
+	serve := func(nonce int) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		path := fmt.Sprintf("/%s?%s=%d:%d", common.BTCService, QueryArkAuth, contract.Id, nonce)
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+		return w
+	}
+
+	w := serve(10)
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, "paid", w.Header().Get("tier"))
+	// the deposit pays for ten queries
+	w = serve(11)
+	require.Equal(t, http.StatusPaymentRequired, w.Code)
+	var spent ContractSpent
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &spent))
+	require.Equal(t, AuthErrorSpent, spent.Code)
+	require.Equal(t, "0"+largeDenom, spent.Remaining)
+
+	// the provider only accepts its native denom
+	config.AcceptedDenoms = []string{"uarkeo"}
+	_, err = proxy.Reload(config)
+	require.NoError(t, err)
+	w = serve(12)
+	require.Equal(t, http.StatusPaymentRequired, w.Code)
+	var body map[string]string
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	require.Equal(t, "contracts in aevmos aren't accepted", body["error"])
+
+	config.AcceptedDenoms = []string{"not a denom"}
+	_, err = proxy.Reload(config)
+	require.Error(t, err)
+}
+
+func TestAutoClaimDenomThresholds(t *testing.T) {
+	config := conf.AutoClaimConfiguration{
+		Threshold:       500,
+		ExpiryBlocks:    10,
+		DenomThresholds: map[string]string{largeDenom: "100000000000000000000"},
+	}
+	// pending 600uarkeo, above the default threshold
+	native := newAutoClaimContract(1, types.ContractType_PAY_AS_YOU_GO, 10, 10000, 0)
+	// pending 4e19aevmos, below the threshold of its denom
+	below := newLargeDenomContract(t, 2)
+	// pending 1.2e20aevmos
+	above := newLargeDenomContract(t, 3)
+	a, claims, broadcaster := newAutoClaimTest(t, config, 10, native, below, above)
+	require.NoError(t, claims.Set(NewClaim(1, native.Client, 60, "aabb")))
+	require.NoError(t, claims.Set(NewClaim(2, below.Client, 2, "aabb")))
+	require.NoError(t, claims.Set(NewClaim(3, above.Client, 6, "aabb")))
+
+	require.Equal(t, 2, a.ClaimDue(context.Background()))
+	ids := []uint64{broadcaster.msgs[0].ContractId, broadcaster.msgs[1].ContractId}
+	require.ElementsMatch(t, []uint64{1, 3}, ids)
+
+	invalid := newTestConfig()
+	invalid.AutoClaim.DenomThresholds = map[string]string{largeDenom: "1e20"}
+	require.Error(t, validateDenoms(invalid))
+}
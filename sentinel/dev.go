@@ -0,0 +1,278 @@
+package sentinel
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+
+	"github.com/arkeonetwork/arkeo/common"
+	"github.com/arkeonetwork/arkeo/common/cosmos"
+	"github.com/arkeonetwork/arkeo/sentinel/conf"
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+)
+
+const (
+	// DevTestKey is the hex secp256k1 private key of the documented test key of the dev mode. It is public, never
+	// fund it nor use it on a real chain
+	DevTestKey = "1adf8624a4b75857cba49a757992a25d9176a3dbf755a3cc55616a13d0b0630f"
+	// DevWatermark is advertised in the metadata and logged at startup while the dev mode is enabled
+	DevWatermark = "DEVELOPMENT MODE: fake chain, contracts and claims are not real and nothing is settled on chain"
+
+	devHeight           = 100           // block height of the fake chain
+	devContractDuration = 1_000_000_000 // blocks, the dev contracts don't expire
+	devDepositQueries   = 1_000_000_000 // queries the deposit of a dev contract pays for
+	devQueriesPerMinute = 600
+	devRate             = "1uarkeo"
+)
+
+// DevTestPrivKey return the documented test key of the dev mode
+func DevTestPrivKey() *secp256k1.PrivKey {
+	key, _ := hex.DecodeString(DevTestKey)
+	return &secp256k1.PrivKey{Key: key}
+}
+
+// DevTestPubKey return the pubkey of the documented test key of the dev mode
+func DevTestPubKey() common.PubKey {
+	pk, err := common.NewPubKeyFromCrypto(DevTestPrivKey().PubKey())
+	if err != nil {
+		panic(fmt.Errorf("fail to derive the dev test pubkey: %w", err))
+	}
+	return pk
+}
+
+// isMainnetChainID return true for the chain ids of a mainnet, e.g. arkeo-main-v1, the dev mode is refused on them
+func isMainnetChainID(chainID string) bool {
+	return strings.Contains(strings.ToLower(chainID), "main")
+}
+
+// validateDevMode refuse a dev mode configured along with a mainnet chain id, or with contracts it can't open
+func validateDevMode(config conf.Configuration) error {
+	if !config.Dev.Enabled {
+		return nil
+	}
+	if isMainnetChainID(config.AutoClaim.ChainID) {
+		return fmt.Errorf("dev mode can't be enabled on the mainnet chain %s", config.AutoClaim.ChainID)
+	}
+	ids := make(map[uint64]bool)
+	for _, contract := range config.Dev.Contracts {
+		if ids[contract.Id] {
+			return fmt.Errorf("dev contract %d is listed twice", contract.Id)
+		}
+		ids[contract.Id] = true
+		if _, err := newDevContract(contract, config.ProviderPubKey); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// newDevContract return the contract open on the fake chain for a dev contract
+func newDevContract(dev conf.DevContract, provider common.PubKey) (types.Contract, error) {
+	service, err := common.NewService(dev.Service)
+	if err != nil {
+		return types.Contract{}, fmt.Errorf("dev contract %d: unknown service %s", dev.Id, dev.Service)
+	}
+	client := DevTestPubKey()
+	if len(dev.Client) > 0 {
+		if client, err = common.NewPubKey(dev.Client); err != nil {
+			return types.Contract{}, fmt.Errorf("dev contract %d: invalid client pubkey %s: %w", dev.Id, dev.Client, err)
+		}
+	}
+	rate := devRate
+	if len(dev.Rate) > 0 {
+		rate = dev.Rate
+	}
+	coin, err := cosmos.ParseCoin(rate)
+	if err != nil || !coin.IsPositive() {
+		return types.Contract{}, fmt.Errorf("dev contract %d: invalid rate %s", dev.Id, rate)
+	}
+	qpm := dev.QueriesPerMinute
+	if qpm <= 0 {
+		qpm = devQueriesPerMinute
+	}
+	contractType := types.ContractType_PAY_AS_YOU_GO
+	if dev.Subscription {
+		contractType = types.ContractType_SUBSCRIPTION
+	}
+	return types.Contract{
+		Id:                 dev.Id,
+		Provider:           provider,
+		Service:            service,
+		Client:             client,
+		Delegate:           common.EmptyPubKey,
+		Type:               contractType,
+		Height:             1,
+		Duration:           devContractDuration,
+		Rate:               coin,
+		Deposit:            coin.Amount.MulRaw(devDepositQueries),
+		Paid:               cosmos.ZeroInt(),
+		SettlementDuration: 10,
+		Authorization:      types.ContractAuthorization_STRICT,
+		QueriesPerMinute:   qpm,
+	}, nil
+}
+
+// devContracts return the contracts of the dev mode, one contract of the test key on each service served when none
+// is configured, numbered from 1 in the order of the service names
+func devContracts(config conf.Configuration) []conf.DevContract {
+	if len(config.Dev.Contracts) > 0 {
+		return config.Dev.Contracts
+	}
+	services := serviceNames(loadProxies(config.Services))
+	contracts := make([]conf.DevContract, 0, len(services))
+	for i, service := range services {
+		contracts = append(contracts, conf.DevContract{Id: uint64(i + 1), Service: service})
+	}
+	return contracts
+}
+
+// DevChain is the in-process chain of the dev mode. It has the dev contracts open, registers the provider on their
+// services and logs the claims submitted to it instead of broadcasting them
+type DevChain struct {
+	lock      sync.Mutex
+	provider  common.PubKey
+	contracts map[uint64]types.Contract
+	logger    log.Logger
+}
+
+var (
+	_ ClaimBroadcaster = &DevChain{}
+	_ ProviderQuerier  = &DevChain{}
+)
+
+func NewDevChain(config conf.Configuration, logger log.Logger) (*DevChain, error) {
+	chain := &DevChain{
+		provider:  config.ProviderPubKey,
+		contracts: make(map[uint64]types.Contract),
+		logger:    logger.With("module", "dev-chain"),
+	}
+	for _, dev := range devContracts(config) {
+		contract, err := newDevContract(dev, config.ProviderPubKey)
+		if err != nil {
+			return nil, err
+		}
+		chain.contracts[contract.Id] = contract
+	}
+	return chain, nil
+}
+
+// Height return the block height of the fake chain
+func (c *DevChain) Height() int64 {
+	return devHeight
+}
+
+// Contracts return the contracts open on the fake chain
+func (c *DevChain) Contracts() []types.Contract {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	contracts := make([]types.Contract, 0, len(c.contracts))
+	for _, contract := range c.contracts {
+		contracts = append(contracts, contract)
+	}
+	sort.Slice(contracts, func(i, j int) bool { return contracts[i].Id < contracts[j].Id })
+	return contracts
+}
+
+// FetchContract return a contract by its id, an empty contract when it isn't open, as the chain does
+func (c *DevChain) FetchContract(key string) (types.Contract, error) {
+	id, err := strconv.ParseUint(key, 10, 64)
+	if err != nil {
+		return types.Contract{}, fmt.Errorf("bad contract id %s: %w", key, err)
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.contracts[id], nil
+}
+
+// FetchProviders implement ProviderQuerier, the provider is registered on the services of the dev contracts at their
+// rates
+func (c *DevChain) FetchProviders(_ context.Context, pubkey common.PubKey) ([]ChainProvider, error) {
+	providers := make(map[string]*ChainProvider)
+	for _, contract := range c.Contracts() {
+		if !contract.Provider.Equals(pubkey) {
+			continue
+		}
+		service := contract.Service.String()
+		provider, ok := providers[service]
+		if !ok {
+			provider = &ChainProvider{
+				Service:             service,
+				Status:              types.ProviderStatus_ONLINE.String(),
+				MinContractDuration: 1,
+				MaxContractDuration: devContractDuration,
+				SettlementDuration:  contract.SettlementDuration,
+				MetadataURI:         "dev",
+			}
+			providers[service] = provider
+		}
+		if contract.IsSubscription() {
+			provider.SubscriptionRate = append(provider.SubscriptionRate, contract.Rate)
+		} else {
+			provider.PayAsYouGoRate = append(provider.PayAsYouGoRate, contract.Rate)
+		}
+	}
+	result := make([]ChainProvider, 0, len(providers))
+	for _, provider := range providers {
+		result = append(result, *provider)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Service < result[j].Service })
+	return result, nil
+}
+
+// Address implement ClaimBroadcaster, the claims are paid by the provider
+func (c *DevChain) Address() cosmos.AccAddress {
+	address, _ := c.provider.GetMyAddress()
+	return address
+}
+
+// BroadcastClaim implement ClaimBroadcaster, the claim is logged and its nonce recorded on the contract as the chain
+// would, nothing is broadcast. The hash returned is a made up one
+func (c *DevChain) BroadcastClaim(_ context.Context, msg *types.MsgClaimContractIncome) (string, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	contract, ok := c.contracts[msg.ContractId]
+	if !ok {
+		return "", fmt.Errorf("contract %d is not open on the dev chain", msg.ContractId)
+	}
+	if msg.Nonce <= contract.Nonce {
+		return "", fmt.Errorf("claim nonce %d is not above the claimed nonce %d", msg.Nonce, contract.Nonce)
+	}
+	contract.Nonce = msg.Nonce
+	c.contracts[msg.ContractId] = contract
+	hash := sha256.Sum256([]byte(fmt.Sprintf("%d:%d", msg.ContractId, msg.Nonce)))
+	txHash := fmt.Sprintf("DEV%X", hash[:16])
+	c.logger.Info("dev mode, claim logged instead of broadcast", "contract_id", msg.ContractId, "nonce", msg.Nonce, "tx", txHash)
+	return txHash, nil
+}
+
+// ResetSequence implement ClaimBroadcaster, the fake chain has no account sequence
+func (c *DevChain) ResetSequence() {}
+
+// acceptsDevTestKey return true when the dev mode accepts the test key for an open contract
+func (p Proxy) acceptsDevTestKey(contract types.Contract) bool {
+	return p.DevChain != nil && p.Config.Dev.AcceptTestKey && !contract.IsEmpty()
+}
+
+// isDevTestKeySignature return true when the dev mode accepts the test key and it signed the arkauth of an open
+// contract
+func (p Proxy) isDevTestKeySignature(aa ArkAuth, contract types.Contract) bool {
+	if !p.acceptsDevTestKey(contract) {
+		return false
+	}
+	testKey := DevTestPrivKey().PubKey()
+	spender, err := common.NewPubKeyFromCrypto(testKey)
+	if err != nil {
+		return false
+	}
+	return p.Signatures.Verify(aa.ContractId, spender, aa.Nonce, aa.Signature, func() bool {
+		return testKey.VerifySignature(types.GetBytesToSign(aa.ContractId, aa.Nonce), aa.Signature)
+	})
+}
@@ -0,0 +1,172 @@
+package sentinel
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/stretchr/testify/require"
+
+	"github.com/arkeonetwork/arkeo/common"
+	"github.com/arkeonetwork/arkeo/sentinel/conf"
+)
+
+func newDevTestConfig(upstream string, contracts ...conf.DevContract) conf.Configuration {
+	config := newTestConfig()
+	config.FreeTierRateLimit = 0 // the free tier is closed, only the paid requests are served
+	config.Services = map[string]conf.ServiceConfiguration{common.BTCService.String(): {Upstream: upstream}}
+	config.AutoClaim = conf.AutoClaimConfiguration{Enabled: true, ChainID: "arkeo-dev", Threshold: 1}
+	config.Dev = conf.DevConfiguration{Enabled: true, Contracts: contracts, AcceptTestKey: true}
+	return config
+}
+
+func TestDevMode(t *testing.T) {
+	served := make(chan string, 10)
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		served <- r.URL.Path
+		_, _ = w.Write([]byte(`{"result":"ok"}`))
+	}))
+	defer upstream.Close()
+
+	clientKey := secp256k1.GenPrivKey()
+	client, err := common.NewPubKeyFromCrypto(clientKey.PubKey())
+	require.NoError(t, err)
+	config := newDevTestConfig(upstream.URL,
+		conf.DevContract{Id: 1, Service: common.BTCService.String()},
+		conf.DevContract{Id: 2, Service: common.BTCService.String(), Client: client.String(), Rate: "2uarkeo", QueriesPerMinute: 2},
+	)
+	proxy, err := NewProxy(config)
+	require.NoError(t, err)
+	require.NotNil(t, proxy.DevChain)
+	router := proxy.getRouter()
+
+	get := func(auth string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, fmt.Sprintf("/%s/block?%s=%s", common.BTCService, QueryArkAuth, auth), nil))
+		return w
+	}
+
+	// a request signed with the test key is served and billed to the dev contract, without any chain
+	w := get(signArkAuth(t, DevTestPrivKey(), 1, 1))
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	require.Equal(t, "paid", w.Header().Get("tier"))
+	require.Equal(t, "1", w.Header().Get(CostHeader))
+	require.Equal(t, "/block", <-served)
+	claim, err := proxy.ClaimStore.Get("1")
+	require.NoError(t, err)
+	require.Equal(t, int64(1), claim.Nonce)
+
+	// the nonce is checked as usual
+	w = get(signArkAuth(t, DevTestPrivKey(), 1, 1))
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	require.Contains(t, w.Body.String(), AuthErrorReplayed)
+
+	// the client of a contract signs its own requests, the test key is accepted on top of it
+	require.Equal(t, "paid", get(signArkAuth(t, clientKey, 2, 1)).Header().Get("tier"))
+	<-served
+	require.Equal(t, "paid", get(signArkAuth(t, DevTestPrivKey(), 2, 2)).Header().Get("tier"))
+	<-served
+	// within the queries per minute of the contract
+	w = get(signArkAuth(t, clientKey, 2, 3))
+	require.Equal(t, http.StatusTooManyRequests, w.Code)
+	require.Equal(t, "paid", w.Header().Get("tier"))
+
+	// a contract the dev chain doesn't have open isn't served
+	w = get(signArkAuth(t, DevTestPrivKey(), 3, 1))
+	require.Equal(t, http.StatusTooManyRequests, w.Code)
+	require.Equal(t, "free", w.Header().Get("tier"))
+	require.Empty(t, served)
+
+	// the auto claims are logged by the dev chain and recorded on its contract instead of being broadcast
+	txHash, err := proxy.AutoClaimer.ClaimContract(context.Background(), 1)
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(txHash, "DEV"), txHash)
+	contract, err := proxy.DevChain.FetchContract("1")
+	require.NoError(t, err)
+	require.Equal(t, int64(1), contract.Nonce)
+
+	// the metadata are watermarked and the provider terms come from the dev chain
+	w = httptest.NewRecorder()
+	proxy.handleMetadata(w, httptest.NewRequest(http.MethodGet, RoutesMetaData, nil))
+	var metadata Metadata
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &metadata))
+	require.Equal(t, DevWatermark, metadata.Dev)
+	require.NotNil(t, metadata.Chain)
+	require.Len(t, metadata.Chain.Providers, 1)
+	require.Equal(t, common.BTCService.String(), metadata.Chain.Providers[0].Service)
+	require.Equal(t, "1uarkeo,2uarkeo", fmt.Sprintf("%s,%s", metadata.Chain.Providers[0].PayAsYouGoRate[0], metadata.Chain.Providers[0].PayAsYouGoRate[1]))
+
+	// the health doesn't probe a chain
+	for _, component := range proxy.Health.Check().Components {
+		require.False(t, strings.HasPrefix(component.Name, "chain:rest") || strings.HasPrefix(component.Name, "chain:rpc"), component.Name)
+		if component.Name == "chain:dev" {
+			require.Equal(t, HealthStatusOK, component.Status)
+		}
+	}
+}
+
+func TestDevModeTestKey(t *testing.T) {
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer upstream.Close()
+
+	clientKey := secp256k1.GenPrivKey()
+	client, err := common.NewPubKeyFromCrypto(clientKey.PubKey())
+	require.NoError(t, err)
+	config := newDevTestConfig(upstream.URL, conf.DevContract{Id: 5, Service: common.BTCService.String(), Client: client.String()})
+	config.Dev.AcceptTestKey = false
+	proxy, err := NewProxy(config)
+	require.NoError(t, err)
+	router := proxy.getRouter()
+
+	get := func(auth string) string {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, fmt.Sprintf("/%s?%s=%s", common.BTCService, QueryArkAuth, auth), nil))
+		return w.Header().Get("tier")
+	}
+	// the test key is refused once it isn't accepted, the client's signatures still are
+	require.Equal(t, "free", get(signArkAuth(t, DevTestPrivKey(), 5, 1)))
+	require.Equal(t, "paid", get(signArkAuth(t, clientKey, 5, 1)))
+}
+
+func TestDevModeValidation(t *testing.T) {
+	// the dev mode is refused on a mainnet
+	config := newDevTestConfig("http://localhost:1")
+	config.AutoClaim.ChainID = "arkeo-main-v1"
+	_, err := NewProxy(config)
+	require.ErrorContains(t, err, "mainnet")
+
+	// a mainnet without the dev mode is fine
+	config.Dev.Enabled = false
+	require.NoError(t, validateDevMode(config))
+
+	for _, contract := range []conf.DevContract{
+		{Id: 1, Service: "unknown-service"},
+		{Id: 1, Service: common.BTCService.String(), Client: "not-a-pubkey"},
+		{Id: 1, Service: common.BTCService.String(), Rate: "uarkeo"},
+	} {
+		config := newDevTestConfig("http://localhost:1", contract)
+		require.Error(t, validateDevMode(config), contract)
+	}
+	config = newDevTestConfig("http://localhost:1",
+		conf.DevContract{Id: 1, Service: common.BTCService.String()},
+		conf.DevContract{Id: 1, Service: common.BTCService.String()},
+	)
+	require.ErrorContains(t, validateDevMode(config), "twice")
+
+	// without contracts the test key has one on each service served
+	config = newDevTestConfig("http://localhost:1")
+	chain, err := NewDevChain(config, log.NewNopLogger())
+	require.NoError(t, err)
+	contracts := chain.Contracts()
+	require.Len(t, contracts, 1)
+	require.Equal(t, uint64(1), contracts[0].Id)
+	require.Equal(t, common.BTCService, contracts[0].Service)
+	require.Equal(t, DevTestPubKey(), contracts[0].Client)
+	require.False(t, contracts[0].IsExpired(chain.Height()))
+}
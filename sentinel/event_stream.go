@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"os/signal"
+	"strconv"
 	"strings"
 	"syscall"
 
@@ -25,7 +26,7 @@ import (
 	"github.com/arkeonetwork/arkeo/x/arkeo/types"
 )
 
-var numOfWebSocketClients = 2
+var numOfWebSocketClients = 3
 
 func subscribe(client *tmclient.HTTP, logger log.Logger, query string) <-chan tmCoreTypes.ResultEvent {
 	out, err := client.Subscribe(context.Background(), "", query)
@@ -52,7 +53,7 @@ func (p Proxy) EventListener(host string) {
 
 	logger.Info("starting realtime indexing using /websocket")
 
-	// as maximum allowed connection is 5 per ws client(cometbft) we split this into 2 client to handle 3 connection each
+	// as maximum allowed connection is 5 per ws client(cometbft) we split the subscriptions over several clients
 	clients := make([]*tmclient.HTTP, numOfWebSocketClients)
 
 	for i := 0; i < numOfWebSocketClients; i++ {
@@ -97,12 +98,20 @@ func (p Proxy) EventListener(host string) {
 		"tm.event = 'NewBlock'",
 		"tm.event = 'Tx' AND message.action='/arkeo.arkeo.MsgOpenContract'",
 		"tm.event = 'Tx' AND message.action='/arkeo.arkeo.MsgCloseContract'",
+		"tm.event = 'Tx' AND message.action='/arkeo.arkeo.MsgRenewContract'",
+		"tm.event = 'Tx' AND message.action='/arkeo.arkeo.MsgProviderCloseContract'",
 	)
 
 	go subscribeToEvents(clients[1],
 		"tm.event = 'Tx' AND message.action='/arkeo.arkeo.MsgClaimContractIncome'",
 		"tm.event = 'Tx' AND message.action='/arkeo.arkeo.MsgBondProvider'",
 		"tm.event = 'Tx' AND message.action='/arkeo.arkeo.MsgModProvider'",
+		"tm.event = 'Tx' AND message.action='/arkeo.arkeo.MsgRotateDelegate'",
+		"tm.event = 'Tx' AND message.action='/arkeo.arkeo.MsgTopUpContract'",
+	)
+
+	go subscribeToEvents(clients[2],
+		"tm.event = 'Tx' AND message.action='/arkeo.arkeo.MsgClaimContractIncomeBatch'",
 	)
 
 	dispatchEvents := func(result tmCoreTypes.ResultEvent) {
@@ -113,9 +122,20 @@ func (p Proxy) EventListener(host string) {
 		case strings.Contains(result.Query, "MsgOpenContract"):
 			p.handleOpenContractEvent(result)
 
-		case strings.Contains(result.Query, "MsgCloseContract"):
+		case strings.Contains(result.Query, "MsgCloseContract"),
+			strings.Contains(result.Query, "MsgProviderCloseContract"):
 			p.handleCloseContractEvent(result)
 
+		case strings.Contains(result.Query, "MsgRenewContract"):
+			p.handleRenewContractEvent(result)
+
+		case strings.Contains(result.Query, "MsgTopUpContract"):
+			p.handleTopUpContractEvent(result)
+
+		case strings.Contains(result.Query, "MsgRotateDelegate"):
+			p.handleRotateDelegateEvent(result)
+
+		// the batches of claims match too, settling several contracts
 		case strings.Contains(result.Query, "MsgClaimContractIncome"):
 			p.handleContractSettlementEvent(result)
 
@@ -145,21 +165,28 @@ func (p Proxy) EventListener(host string) {
 
 // handleContractSettlementEvent
 func (p Proxy) handleContractSettlementEvent(result tmCoreTypes.ResultEvent) {
-	typedEvent, err := parseTypedEvent(result, "arkeo.arkeo.EventSettleContract")
+	typedEvents, err := parseTypedEvents(result, "arkeo.arkeo.EventSettleContract")
 	if err != nil {
 		p.logger.Error("failed to parse typed event", "error", err)
 		return
 	}
 
-	evt, ok := typedEvent.(*types.EventSettleContract)
-	if !ok {
-		p.logger.Error(fmt.Sprintf("failed to cast %T to EventSettleContract", typedEvent))
-		return
+	for _, typedEvent := range typedEvents {
+		evt, ok := typedEvent.(*types.EventSettleContract)
+		if !ok {
+			p.logger.Error(fmt.Sprintf("failed to cast %T to EventSettleContract", typedEvent))
+			continue
+		}
+		p.handleContractSettlement(evt)
 	}
+}
 
+// handleContractSettlement mark the claim of the contract settled as claimed
+func (p Proxy) handleContractSettlement(evt *types.EventSettleContract) {
 	if !p.isMyPubKey(evt.Provider) {
 		return
 	}
+	go p.refreshContract(evt.ContractId)
 
 	service := common.Service(common.ServiceLookup[evt.Service])
 	contract := types.Contract{
@@ -211,6 +238,76 @@ func (p Proxy) handleCloseContractEvent(result tmCoreTypes.ResultEvent) {
 		return
 	}
 	p.MemStore.Put(contract)
+	p.ContractLimiter.Remove(contract.Id)
+	p.StreamUsage.Remove(contract.Id)
+	p.QueryUsage.Remove(contract.Id)
+	p.Metrics.removeContract(contract.Id)
+	p.Signatures.Remove(contract.Id)
+}
+
+// handleRenewContractEvent refresh a renewed contract, its expiration and deposit changed
+func (p Proxy) handleRenewContractEvent(result tmCoreTypes.ResultEvent) {
+	typedEvent, err := parseTypedEvent(result, "arkeo.arkeo.EventRenewContract")
+	if err != nil {
+		p.logger.Error("failed to parse typed event", "error", err)
+		return
+	}
+
+	evt, ok := typedEvent.(*types.EventRenewContract)
+	if !ok {
+		p.logger.Error(fmt.Sprintf("failed to cast %T to EventRenewContract", typedEvent))
+		return
+	}
+
+	if !p.isMyPubKey(evt.Provider) {
+		return
+	}
+	p.logger.Info("contract renewed", "contract_id", evt.ContractId, "old_expiration", evt.OldExpiration, "new_expiration", evt.NewExpiration)
+	go p.refreshContract(evt.ContractId)
+}
+
+// handleTopUpContractEvent refresh a topped up contract, its expiration and deposit changed unless it auto-renews
+func (p Proxy) handleTopUpContractEvent(result tmCoreTypes.ResultEvent) {
+	typedEvent, err := parseTypedEvent(result, "arkeo.arkeo.EventTopUpContract")
+	if err != nil {
+		p.logger.Error("failed to parse typed event", "error", err)
+		return
+	}
+
+	evt, ok := typedEvent.(*types.EventTopUpContract)
+	if !ok {
+		p.logger.Error(fmt.Sprintf("failed to cast %T to EventTopUpContract", typedEvent))
+		return
+	}
+
+	if !p.isMyPubKey(evt.Provider) {
+		return
+	}
+	p.logger.Info("contract topped up", "contract_id", evt.ContractId, "old_expiration", evt.OldExpiration, "new_expiration", evt.NewExpiration)
+	go p.refreshContract(evt.ContractId)
+}
+
+// handleRotateDelegateEvent refresh a contract whose delegate was rotated, the signatures of the old delegate are no
+// longer accepted
+func (p Proxy) handleRotateDelegateEvent(result tmCoreTypes.ResultEvent) {
+	typedEvent, err := parseTypedEvent(result, "arkeo.arkeo.EventRotateDelegate")
+	if err != nil {
+		p.logger.Error("failed to parse typed event", "error", err)
+		return
+	}
+
+	evt, ok := typedEvent.(*types.EventRotateDelegate)
+	if !ok {
+		p.logger.Error(fmt.Sprintf("failed to cast %T to EventRotateDelegate", typedEvent))
+		return
+	}
+
+	if !p.isMyPubKey(evt.Provider) {
+		return
+	}
+	p.logger.Info("contract delegate rotated", "contract_id", evt.ContractId, "old_delegate", evt.OldDelegate, "new_delegate", evt.NewDelegate)
+	p.Signatures.Remove(evt.ContractId)
+	go p.refreshContract(evt.ContractId)
 }
 
 func (p Proxy) handleOpenContractEvent(result tmCoreTypes.ResultEvent) {
@@ -228,19 +325,21 @@ func (p Proxy) handleOpenContractEvent(result tmCoreTypes.ResultEvent) {
 
 	service := common.Service(common.ServiceLookup[evt.Service])
 	contract := types.Contract{
-		Provider:           evt.Provider,
-		Service:            service,
-		Client:             evt.Client,
-		Delegate:           evt.Delegate,
-		Type:               evt.Type,
-		Height:             evt.Height,
-		Duration:           evt.Duration,
-		Rate:               evt.Rate,
-		Deposit:            evt.Deposit,
-		Id:                 evt.ContractId,
-		SettlementDuration: evt.SettlementDuration,
-		Authorization:      evt.Authorization,
-		QueriesPerMinute:   evt.QueriesPerMinute,
+		Provider:              evt.Provider,
+		Service:               service,
+		Client:                evt.Client,
+		Delegate:              evt.Delegate,
+		Type:                  evt.Type,
+		Height:                evt.Height,
+		Duration:              evt.Duration,
+		Rate:                  evt.Rate,
+		Deposit:               evt.Deposit,
+		Id:                    evt.ContractId,
+		SettlementDuration:    evt.SettlementDuration,
+		Authorization:         evt.Authorization,
+		QueriesPerMinute:      evt.QueriesPerMinute,
+		SettlementGracePeriod: evt.SettlementGracePeriod,
+		StartHeight:           evt.StartHeight,
 	}
 
 	if !p.isMyPubKey(evt.Provider) {
@@ -261,9 +360,28 @@ func (p Proxy) handleNewBlockHeaderEvent(result tmCoreTypes.ResultEvent) {
 	}
 	height := data.Block.Header.Height
 	p.logger.Info("New height detected", "height", height)
+	// the events of the blocks missed, while the event stream reconnected, are caught up with from the chain
+	if last := p.MemStore.GetHeight(); last > 0 && height > last+1 {
+		p.logger.Info("missed blocks, reconciling contracts", "last_height", last, "height", height)
+		p.ContractReconciler.Trigger()
+	}
 	p.MemStore.SetHeight(height)
+	p.ContractLimiter.Prune(height)
 
 	for _, evt := range data.ResultFinalizeBlock.Events {
+		// an auto-renewed contract runs on past the expiration it was cached with
+		if evt.Type == types.EventTypeContractAutoRenewed {
+			typedEvent, err := sdk.ParseTypedEvent(evt)
+			if err != nil {
+				p.logger.Error("failed to parse contract auto-renewed event", "error", err)
+				continue
+			}
+			renewed, ok := typedEvent.(*types.EventContractAutoRenewed)
+			if ok && p.isMyPubKey(renewed.Provider) {
+				go p.refreshContract(renewed.ContractId)
+			}
+			continue
+		}
 		if evt.Type == types.EventTypeSettleContract {
 			input := make(map[string]string)
 			for _, attr := range evt.Attributes {
@@ -277,6 +395,7 @@ func (p Proxy) handleNewBlockHeaderEvent(result tmCoreTypes.ResultEvent) {
 			if !p.isMyPubKey(evt.Contract.Provider) {
 				continue
 			}
+			go p.refreshContract(evt.Contract.Id)
 			spender := evt.Contract.GetSpender()
 			newClaim := NewClaim(evt.Contract.Id, spender, evt.Contract.Nonce, "")
 			currClaim, err := p.ClaimStore.Get(newClaim.Key())
@@ -294,8 +413,11 @@ func (p Proxy) handleNewBlockHeaderEvent(result tmCoreTypes.ResultEvent) {
 	}
 }
 
-func (p Proxy) isMyPubKey(pk common.PubKey) bool {
-	return pk.Equals(p.Config.ProviderPubKey)
+// refreshContract update a cached contract settled on chain, its nonce and whether it is still open
+func (p Proxy) refreshContract(contractId uint64) {
+	if _, err := p.MemStore.Refresh(strconv.FormatUint(contractId, 10)); err != nil {
+		p.logger.Error("fail to refresh settled contract", "contract_id", contractId, "error", err)
+	}
 }
 
 func parseTypedEvent(result tmCoreTypes.ResultEvent, eventType string) (proto.Message, error) {
@@ -317,6 +439,30 @@ func parseTypedEvent(result tmCoreTypes.ResultEvent, eventType string) (proto.Me
 	return msg, fmt.Errorf("event %s not found", eventType)
 }
 
+// parseTypedEvents parse all the events of the type the tx emitted, as a batch of claims emits one per contract settled
+func parseTypedEvents(result tmCoreTypes.ResultEvent, eventType string) ([]proto.Message, error) {
+	eventDataTx, ok := result.Data.(tmtypes.EventDataTx)
+	if !ok {
+		return nil, fmt.Errorf("failed cast %T to EventDataTx", result.Data)
+	}
+
+	var msgs []proto.Message
+	for _, evt := range eventDataTx.TxResult.Result.Events {
+		if evt.Type != eventType {
+			continue
+		}
+		msg, err := sdk.ParseTypedEvent(evt)
+		if err != nil {
+			return nil, err
+		}
+		msgs = append(msgs, msg)
+	}
+	if len(msgs) == 0 {
+		return nil, fmt.Errorf("event %s not found", eventType)
+	}
+	return msgs, nil
+}
+
 func (p Proxy) handleBondProviderEvent(result tmCoreTypes.ResultEvent) {
 	typedEvent, err := parseTypedEvent(result, "arkeo.arkeo.EventBondProvider")
 	if err != nil {
@@ -387,16 +533,35 @@ func (p Proxy) handleModProviderEvent(result tmCoreTypes.ResultEvent) {
 		return
 	}
 
-	providerConfig.Bond = evt.Bond
+	// the event carries the fields updated only, the bond being left out unless it carries all of them
+	if len(evt.UpdatedFields) == 0 {
+		providerConfig.Bond = evt.Bond
+	}
 	providerConfig.Service = service
-	providerConfig.MetadataUri = evt.MetadataUri
-	providerConfig.MetadataNonce = evt.MetadataNonce
-	providerConfig.Status = evt.Status
-	providerConfig.MinContractDuration = evt.MinContractDuration
-	providerConfig.MaxContractDuration = evt.MaxContractDuration
-	providerConfig.SubscriptionRate = evt.SubscriptionRate
-	providerConfig.PayAsYouGoRate = evt.PayAsYouGoRate
-	providerConfig.SettlementDuration = evt.SettlementDuration
+	if evt.Updates(types.ModProviderFieldMetadataUri) {
+		providerConfig.MetadataUri = evt.MetadataUri
+	}
+	if evt.Updates(types.ModProviderFieldMetadataNonce) {
+		providerConfig.MetadataNonce = evt.MetadataNonce
+	}
+	if evt.Updates(types.ModProviderFieldStatus) {
+		providerConfig.Status = evt.Status
+	}
+	if evt.Updates(types.ModProviderFieldMinContractDuration) {
+		providerConfig.MinContractDuration = evt.MinContractDuration
+	}
+	if evt.Updates(types.ModProviderFieldMaxContractDuration) {
+		providerConfig.MaxContractDuration = evt.MaxContractDuration
+	}
+	if evt.Updates(types.ModProviderFieldSubscriptionRate) {
+		providerConfig.SubscriptionRate = evt.SubscriptionRate
+	}
+	if evt.Updates(types.ModProviderFieldPayAsYouGoRate) {
+		providerConfig.PayAsYouGoRate = evt.PayAsYouGoRate
+	}
+	if evt.Updates(types.ModProviderFieldSettlementDuration) {
+		providerConfig.SettlementDuration = evt.SettlementDuration
+	}
 
 	err = p.ProviderConfigStore.Set(providerConfig)
 	if err != nil {
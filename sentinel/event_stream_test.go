@@ -195,7 +195,7 @@ func TestHandleHandleContractSettlementEvent(t *testing.T) {
 		Spender:    inputContract.Client,
 		Nonce:      10,
 	}
-	_, err = proxy.paidTier(arkAuth, "")
+	_, _, err = proxy.paidTier(arkAuth, "", 1)
 	require.NoError(t, err)
 
 	// confirm our claim exists in the claim store
@@ -236,8 +236,81 @@ func TestHandleHandleContractSettlementEvent(t *testing.T) {
 	require.True(t, claim.Claimed)
 }
 
+func TestHandleContractSettlementBatchEvent(t *testing.T) {
+	testConfig := newTestConfig()
+	proxy, err := NewProxy(testConfig)
+	require.NoError(t, err)
+
+	// two contracts served up to nonce 5 each
+	contracts := make([]types.Contract, 2)
+	for i := range contracts {
+		contracts[i] = types.Contract{
+			Provider:           testConfig.ProviderPubKey,
+			Service:            common.BTCService,
+			Client:             types.GetRandomPubKey(),
+			Delegate:           common.EmptyPubKey,
+			Type:               types.ContractType_PAY_AS_YOU_GO,
+			Height:             100,
+			Duration:           100,
+			Rate:               cosmos.NewInt64Coin("uarkeo", 1),
+			Deposit:            cosmos.NewInt(100),
+			Id:                 uint64(i + 1),
+			SettlementDuration: 10,
+			QueriesPerMinute:   1,
+		}
+		openEvent := types.NewOpenContractEvent(100, &contracts[i])
+		sdkEvt, err := sdk.TypedEventToEvent(&openEvent)
+		require.NoError(t, err)
+		proxy.handleOpenContractEvent(makeResultEvent(sdkEvt, openEvent.Height))
+
+		_, _, err = proxy.paidTier(ArkAuth{ContractId: contracts[i].Id, Spender: contracts[i].Client, Nonce: 5}, "", 1)
+		require.NoError(t, err)
+	}
+
+	// a batch of claims settles both contracts in the same tx
+	proxy.MemStore.SetHeight(150)
+	var resultEvent tmCoreTypes.ResultEvent
+	for i := range contracts {
+		contracts[i].Nonce = 5
+		settlementEvent := types.NewContractSettlementEvent(cosmos.NewInt(5), cosmos.NewInt(0), &contracts[i])
+		sdkEvt, err := sdk.TypedEventToEvent(&settlementEvent)
+		require.NoError(t, err)
+		if i == 0 {
+			resultEvent = makeResultEvent(sdkEvt, 151)
+			continue
+		}
+		txResult := resultEvent.Data.(tmtypes.EventDataTx)
+		txResult.Result.Events = append(txResult.Result.Events, abciTypes.Event(sdkEvt))
+		resultEvent.Data = txResult
+	}
+	proxy.handleContractSettlementEvent(resultEvent)
+
+	for _, contract := range contracts {
+		claim, err := proxy.ClaimStore.Get(Claim{ContractId: contract.Id}.Key())
+		require.NoError(t, err)
+		require.True(t, claim.Claimed)
+	}
+}
+
 func TestHandleNewBlockHeaderEvent(t *testing.T) {
-	// TODO: add tests
+	proxy, err := NewProxy(newTestConfig())
+	require.NoError(t, err)
+	newBlock := func(height int64) tmCoreTypes.ResultEvent {
+		return tmCoreTypes.ResultEvent{
+			Query: "tm.event = 'NewBlock'",
+			Data:  tmtypes.EventDataNewBlock{Block: &tmtypes.Block{Header: tmtypes.Header{Height: height}}},
+		}
+	}
+
+	proxy.handleNewBlockHeaderEvent(newBlock(10))
+	proxy.handleNewBlockHeaderEvent(newBlock(11))
+	require.EqualValues(t, 11, proxy.MemStore.GetHeight())
+	require.Empty(t, proxy.ContractReconciler.trigger)
+
+	// blocks were missed, the contracts are reconciled with the chain
+	proxy.handleNewBlockHeaderEvent(newBlock(14))
+	require.EqualValues(t, 14, proxy.MemStore.GetHeight())
+	require.Len(t, proxy.ContractReconciler.trigger, 1)
 }
 
 func makeResultEvent(sdkEvent sdk.Event, height int64) tmCoreTypes.ResultEvent {
@@ -0,0 +1,108 @@
+package sentinel
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+)
+
+const (
+	AuthErrorExpired = "contract_expired" // the contract expired, or was closed, at the height the sentinel knows of
+	AuthErrorPending = "contract_pending" // the contract is scheduled to start past the height the sentinel knows of
+	// ContractGraceHeader flag the responses to a contract served past its expiration, within the grace blocks, with
+	// the height it expired at
+	ContractGraceHeader = "X-Contract-Expired"
+)
+
+// ContractExpired is the body returned to a paid request whose contract is past its expiration and grace blocks
+type ContractExpired struct {
+	Error            string `json:"error"`
+	Code             string `json:"code"`
+	ContractId       uint64 `json:"contract_id"`
+	ExpirationHeight int64  `json:"expiration_height"` // last height the contract was served at
+	Height           int64  `json:"height"`            // height the sentinel is at
+}
+
+// ContractPending is the body returned to a paid request whose contract is scheduled to start later on
+type ContractPending struct {
+	Error       string `json:"error"`
+	Code        string `json:"code"`
+	ContractId  uint64 `json:"contract_id"`
+	StartHeight int64  `json:"start_height"` // first height the contract is served at
+	Height      int64  `json:"height"`       // height the sentinel is at
+}
+
+// contractExpiredError is returned for a contract expired, or closed, at the height of the sentinel
+type contractExpiredError struct {
+	contractId uint64
+	expiration int64
+	height     int64
+}
+
+func (e *contractExpiredError) Error() string {
+	return fmt.Sprintf("contract %d expired at height %d, the chain is at %d", e.contractId, e.expiration, e.height)
+}
+
+// contractPendingError is returned for a scheduled contract not started at the height of the sentinel
+type contractPendingError struct {
+	contractId  uint64
+	startHeight int64
+	height      int64
+}
+
+func (e *contractPendingError) Error() string {
+	return fmt.Sprintf("contract %d starts at height %d, the chain is at %d", e.contractId, e.startHeight, e.height)
+}
+
+// contractEnd return the last height a contract is served at, its expiration or the height it was closed at
+func contractEnd(contract types.Contract) int64 {
+	if contract.SettlementHeight > 0 && contract.SettlementHeight < contract.Expiration() {
+		return contract.SettlementHeight
+	}
+	return contract.Expiration()
+}
+
+// contractGrace tell whether a contract can be served at height, and if so whether past its expiration and whether the
+// queries served are charged. A contract that expired, rather than being closed, is served for the grace blocks to
+// absorb a skew of the height; the chain only honors the claims of a pay as you go contract within its settlement
+// period, the queries served otherwise aren't charged so the claims never hold more than the settlement pays
+func (p Proxy) contractGrace(contract types.Contract, height int64) (grace, charged bool, err error) {
+	if contract.IsPending(height) {
+		return false, false, &contractPendingError{contractId: contract.Id, startHeight: contract.StartHeight, height: height}
+	}
+	if !contract.IsExpired(height) {
+		return false, true, nil
+	}
+	end := contractEnd(contract)
+	if contract.SettlementHeight > 0 || height > end+p.Config.ContractGraceBlocks {
+		return false, false, &contractExpiredError{contractId: contract.Id, expiration: end, height: height}
+	}
+	return true, contract.IsPayAsYouGo() && height < contract.SettlementPeriodEnd(), nil
+}
+
+func respondWithContractExpired(w http.ResponseWriter, err *contractExpiredError) {
+	respondWithJSON(w, http.StatusPaymentRequired, ContractExpired{
+		Error:            err.Error(),
+		Code:             AuthErrorExpired,
+		ContractId:       err.contractId,
+		ExpirationHeight: err.expiration,
+		Height:           err.height,
+	})
+}
+
+func respondWithContractPending(w http.ResponseWriter, err *contractPendingError) {
+	respondWithJSON(w, http.StatusTooEarly, ContractPending{
+		Error:       err.Error(),
+		Code:        AuthErrorPending,
+		ContractId:  err.contractId,
+		StartHeight: err.startHeight,
+		Height:      err.height,
+	})
+}
+
+// setContractGrace flag a response served within the grace blocks of its contract
+func setContractGrace(w http.ResponseWriter, contract types.Contract) {
+	w.Header().Set(ContractGraceHeader, strconv.FormatInt(contractEnd(contract), 10))
+}
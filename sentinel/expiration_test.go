@@ -0,0 +1,141 @@
+package sentinel
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/arkeonetwork/arkeo/common"
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+)
+
+// contractChain serve the contracts the memstore fetches once they expired from its cache
+type contractChain map[string]types.Contract
+
+func (c contractChain) FetchContract(key string) (types.Contract, error) {
+	contract, ok := c[key]
+	if !ok {
+		return contract, fmt.Errorf("contract %s not found", key)
+	}
+	return contract, nil
+}
+
+func TestContractExpiration(t *testing.T) {
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer upstream.Close()
+	config := newTestConfig()
+	config.FreeTierRateLimit = 0
+	config.ContractGraceBlocks = 4
+	proxy, err := NewProxy(config)
+	require.NoError(t, err)
+	proxy.proxies[common.BTCService.String()] = common.MustParseURL(upstream.URL)
+	proxy.MemStore.SetHeight(10)
+
+	// expiring at 105, the settlement honors the claims of the pay as you go contract up to 108
+	payg := newWebsocketContract(1, 100, 100)
+	payg.SettlementDuration = 3
+	subscription := newWebsocketContract(2, 100, 100)
+	subscription.Type = types.ContractType_SUBSCRIPTION
+	closed := newWebsocketContract(3, 100, 100)
+	closed.SettlementHeight = 50
+	// scheduled to start at 50, it expires at 150
+	scheduled := newWebsocketContract(4, 100, 100)
+	scheduled.StartHeight = 50
+	chain := contractChain{}
+	for _, contract := range []types.Contract{payg, subscription, closed, scheduled} {
+		proxy.MemStore.Put(contract)
+		chain[strconv.FormatUint(contract.Id, 10)] = contract
+	}
+	proxy.MemStore.UseChain(chain)
+	router := proxy.getRouter()
+
+	nonces := make(map[uint64]int64)
+	get := func(contract types.Contract, height int64) *httptest.ResponseRecorder {
+		proxy.MemStore.SetHeight(height)
+		nonces[contract.Id]++
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, fmt.Sprintf("/%s?%s=%d:%d", common.BTCService, QueryArkAuth, contract.Id, nonces[contract.Id]), nil))
+		return w
+	}
+	claimed := func(contract types.Contract) int64 {
+		claim, err := proxy.ClaimStore.Get(contract.Key())
+		require.NoError(t, err)
+		return claim.Nonce
+	}
+	expired := func(w *httptest.ResponseRecorder) ContractExpired {
+		require.Equal(t, http.StatusPaymentRequired, w.Code, w.Body.String())
+		var body ContractExpired
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+		require.Equal(t, AuthErrorExpired, body.Code)
+		return body
+	}
+
+	// served and charged up to its expiration height
+	w := get(payg, 105)
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Empty(t, w.Header().Get(ContractGraceHeader))
+	require.Equal(t, "1", w.Header().Get(CostHeader))
+	require.Equal(t, int64(1), claimed(payg))
+
+	// then flagged within the grace blocks, charged while the settlement still pays for the queries
+	w = get(payg, 106)
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, "105", w.Header().Get(ContractGraceHeader))
+	require.Equal(t, "1", w.Header().Get(CostHeader))
+	require.Equal(t, int64(2), claimed(payg))
+	w = get(payg, 108)
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, "105", w.Header().Get(ContractGraceHeader))
+	require.Equal(t, "0", w.Header().Get(CostHeader))
+	require.Equal(t, int64(2), claimed(payg))
+
+	// and refused with the expiration height past the grace blocks
+	require.Equal(t, ContractExpired{
+		Error:            "contract 1 expired at height 105, the chain is at 110",
+		Code:             AuthErrorExpired,
+		ContractId:       payg.Id,
+		ExpirationHeight: 105,
+		Height:           110,
+	}, expired(get(payg, 110)))
+	require.Equal(t, int64(2), claimed(payg))
+
+	// a subscription settles at its expiration, the queries of its grace blocks aren't charged
+	require.Equal(t, http.StatusOK, get(subscription, 100).Code)
+	w = get(subscription, 107)
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, "105", w.Header().Get(ContractGraceHeader))
+	require.Equal(t, "0", w.Header().Get(CostHeader))
+	require.Equal(t, int64(1), claimed(subscription))
+
+	// a closed contract has no grace blocks
+	require.Equal(t, int64(50), expired(get(closed, 51)).ExpirationHeight)
+	require.False(t, proxy.ClaimStore.Has(closed.Key()))
+
+	// a scheduled contract is refused until its start height, and charged from then on
+	w = get(scheduled, 49)
+	require.Equal(t, http.StatusTooEarly, w.Code, w.Body.String())
+	var pending ContractPending
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &pending))
+	require.Equal(t, ContractPending{
+		Error:       "contract 4 starts at height 50, the chain is at 49",
+		Code:        AuthErrorPending,
+		ContractId:  scheduled.Id,
+		StartHeight: 50,
+		Height:      49,
+	}, pending)
+	require.False(t, proxy.ClaimStore.Has(scheduled.Key()))
+	w = get(scheduled, 50)
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, "1", w.Header().Get(CostHeader))
+	require.Equal(t, int64(2), claimed(scheduled))
+
+	// nor any contract without them
+	proxy.Config.ContractGraceBlocks = 0
+	router = proxy.getRouter()
+	require.Equal(t, int64(105), expired(get(payg, 106)).ExpirationHeight)
+}
@@ -0,0 +1,111 @@
+package sentinel
+
+import (
+	"fmt"
+	"net/http"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/gorilla/websocket"
+
+	"github.com/arkeonetwork/arkeo/sentinel/conf"
+)
+
+const defaultFreePathRateLimit = 10
+
+// newServiceFreePaths return the anonymous rate limiters of the services with free paths
+func newServiceFreePaths(config conf.Configuration) (map[string]*FreeTierLimiter, error) {
+	limiters := make(map[string]*FreeTierLimiter)
+	for name, service := range config.Services {
+		if len(service.FreePaths) == 0 {
+			continue
+		}
+		limit := defaultFreePathRateLimit
+		if service.FreePathRateLimit > 0 {
+			limit = service.FreePathRateLimit
+		}
+		limiter, err := NewFreeTierLimiter([]FreeTierWindow{
+			{Name: "Minute", Duration: time.Minute, Limit: limit},
+		}, config.FreeTierAllowCIDRs, config.FreeTierMaxKeys)
+		if err != nil {
+			return nil, fmt.Errorf("service %s: %w", name, err)
+		}
+		limiters[name] = limiter
+	}
+	return limiters, nil
+}
+
+// freePathPatterns return the free path patterns of each service having some
+func freePathPatterns(services map[string]conf.ServiceConfiguration) map[string][]string {
+	patterns := make(map[string][]string)
+	for name, service := range services {
+		if len(service.FreePaths) > 0 {
+			patterns[name] = service.FreePaths
+		}
+	}
+	return patterns
+}
+
+// validateFreePaths return an error when a free path pattern of the service is malformed
+func validateFreePaths(name string, service conf.ServiceConfiguration) error {
+	for _, pattern := range service.FreePaths {
+		if !strings.HasPrefix(pattern, "/") {
+			return fmt.Errorf("service %s free path %s must start with /", name, pattern)
+		}
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("service %s free path %s: %w", name, pattern, err)
+		}
+	}
+	if service.FreePathRateLimit < 0 {
+		return fmt.Errorf("service %s free path rate limit must not be negative", name)
+	}
+	return nil
+}
+
+// isFreePath return true when a plain http request is for a free path of the service. The path is normalized before it
+// is matched, its dot segments and repeated slashes resolved, and the request rewritten to it so the upstream serves
+// the very path that was matched
+func (p Proxy) isFreePath(r *http.Request, service string) bool {
+	patterns := p.Config.Services[service].FreePaths
+	if len(patterns) == 0 || isGRPCRequest(r) || websocket.IsWebSocketUpgrade(r) {
+		return false
+	}
+	// some upstreams take backslashes for separators, they never match
+	if strings.Contains(r.URL.Path, "\\") {
+		return false
+	}
+	cleaned := path.Clean("/" + r.URL.Path)
+	reqPath := cleaned
+	if len(r.Header.Get(ServiceHeader)) == 0 {
+		rest, ok := strings.CutPrefix(cleaned, "/"+service)
+		if !ok || (len(rest) > 0 && !strings.HasPrefix(rest, "/")) {
+			return false
+		}
+		reqPath = "/" + strings.TrimPrefix(rest, "/")
+	}
+	for _, pattern := range patterns {
+		if ok, _ := path.Match(pattern, reqPath); ok {
+			r.URL.Path = cleaned
+			r.URL.RawPath = ""
+			return true
+		}
+	}
+	return false
+}
+
+// serveFreePath serve a request for a free path without a contract nor billing, under the anonymous rate limit of the
+// service per client ip
+func (p Proxy) serveFreePath(w http.ResponseWriter, r *http.Request, next http.Handler, service string) {
+	ip := clientIP(p.getRemoteAddr(r))
+	if limiter, ok := p.serviceFreePaths[service]; ok && !limiter.IsAllowListed(ip) {
+		if allowed, _ := limiter.Allow(ip, ""); !allowed {
+			p.Metrics.freePathRejected(service)
+			respondWithError(w, "free path rate limit exceeded", http.StatusTooManyRequests)
+			return
+		}
+	}
+	w.Header().Set("tier", accessTierFreePath)
+	accessRecordFrom(r).freePath()
+	next.ServeHTTP(w, r)
+}
@@ -0,0 +1,125 @@
+package sentinel
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/arkeonetwork/arkeo/common"
+	"github.com/arkeonetwork/arkeo/sentinel/conf"
+)
+
+func TestFreePaths(t *testing.T) {
+	// the upstream records the paths it serves
+	served := make(chan string, 1)
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		served <- r.URL.Path
+		_, _ = w.Write([]byte("{}"))
+	}))
+	defer upstream.Close()
+
+	config := newTestConfig()
+	config.Services = map[string]conf.ServiceConfiguration{
+		"btc-mainnet-fullnode": {
+			Upstream:          upstream.URL,
+			FreeTierRateLimit: -1, // the free tier is closed, only the free paths are served without a contract
+			FreePaths:         []string{"/health", "/status/*"},
+			FreePathRateLimit: 3,
+		},
+	}
+	proxy, err := NewProxy(config)
+	require.NoError(t, err)
+	router := proxy.getRouter()
+
+	serve := func(path string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		req.RemoteAddr = "192.0.2.1:1234"
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	// a free path is served without a contract
+	w := serve("/" + common.BTCService.String() + "/health")
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, "free_path", w.Header().Get("tier"))
+	require.Empty(t, w.Header().Get(CostHeader))
+	require.Equal(t, "/health", <-served)
+	w = serve("/" + common.BTCService.String() + "/status/peers")
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, "/status/peers", <-served)
+
+	// a near miss isn't free
+	for _, path := range []string{"/health/x", "/healthz", "/status/peers/1", "/status"} {
+		w = serve("/" + common.BTCService.String() + path)
+		require.Equal(t, http.StatusTooManyRequests, w.Code, path)
+		require.Equal(t, "free", w.Header().Get("tier"), path)
+	}
+
+	// nor a traversal, the path is normalized before it is matched
+	w = serve("/" + common.BTCService.String() + "/health/../admin")
+	require.Equal(t, http.StatusTooManyRequests, w.Code)
+	require.Equal(t, "free", w.Header().Get("tier"))
+	require.Empty(t, served)
+
+	// the anonymous rate limit applies
+	w = serve("/" + common.BTCService.String() + "/health")
+	require.Equal(t, http.StatusOK, w.Code)
+	<-served
+	w = serve("/" + common.BTCService.String() + "/health")
+	require.Equal(t, http.StatusTooManyRequests, w.Code)
+	require.Empty(t, served)
+
+	// the free paths are advertised
+	w = httptest.NewRecorder()
+	proxy.handleMetadata(w, httptest.NewRequest(http.MethodGet, "/metadata.json", nil))
+	var metadata Metadata
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &metadata))
+	require.Equal(t, map[string][]string{common.BTCService.String(): {"/health", "/status/*"}}, metadata.FreePaths)
+}
+
+func TestIsFreePath(t *testing.T) {
+	proxy := Proxy{Config: conf.Configuration{Services: map[string]conf.ServiceConfiguration{
+		"btc-mainnet-fullnode": {FreePaths: []string{"/health", "/status/*"}},
+	}}}
+	service := common.BTCService.String()
+
+	for _, tc := range []struct {
+		path     string
+		free     bool
+		upstream string
+	}{
+		{path: "/btc-mainnet-fullnode/health", free: true, upstream: "/btc-mainnet-fullnode/health"},
+		{path: "/btc-mainnet-fullnode//health", free: true, upstream: "/btc-mainnet-fullnode/health"},
+		{path: "/btc-mainnet-fullnode/./status/peers", free: true, upstream: "/btc-mainnet-fullnode/status/peers"},
+		{path: "/btc-mainnet-fullnode/status/../health", free: true, upstream: "/btc-mainnet-fullnode/health"},
+		{path: "/btc-mainnet-fullnode/health/../admin"},
+		{path: "/btc-mainnet-fullnode/status/.."},
+		{path: "/btc-mainnet-fullnode/status/%2e%2e"},
+		{path: "/btc-mainnet-fullnode/status/..%2fadmin"},
+		{path: "/btc-mainnet-fullnode/health/..%5cadmin"},
+		{path: "/btc-mainnet-fullnode/../health"},
+		{path: "/btc-mainnet-fullnodex/health"},
+		{path: "/btc-mainnet-fullnode/healthz"},
+	} {
+		req := httptest.NewRequest(http.MethodGet, tc.path, nil)
+		require.Equal(t, tc.free, proxy.isFreePath(req, service), tc.path)
+		if tc.free {
+			require.Equal(t, tc.upstream, req.URL.Path, tc.path)
+			require.Empty(t, req.URL.RawPath, tc.path)
+		}
+	}
+
+	// websocket sessions are never free
+	req := httptest.NewRequest(http.MethodGet, "/btc-mainnet-fullnode/health", nil)
+	req.Header.Set("Connection", "upgrade")
+	req.Header.Set("Upgrade", "websocket")
+	require.False(t, proxy.isFreePath(req, service))
+
+	require.Error(t, validateFreePaths(service, conf.ServiceConfiguration{FreePaths: []string{"health"}}))
+	require.Error(t, validateFreePaths(service, conf.ServiceConfiguration{FreePaths: []string{"/[health"}}))
+	require.NoError(t, validateFreePaths(service, conf.ServiceConfiguration{FreePaths: []string{"/status/*"}}))
+}
@@ -0,0 +1,326 @@
+package sentinel
+
+import (
+	"container/list"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// QueryClientPubKey optionally identify the client of a free tier request, as a header or query arg
+	QueryClientPubKey = "arkpubkey"
+
+	defaultFreeTierMaxKeys = 100000
+)
+
+// FreeTierWindow is a fixed window allowance, a limit of zero disable the window
+type FreeTierWindow struct {
+	Name     string
+	Duration time.Duration
+	Limit    int
+}
+
+// FreeTierLimiter account free tier requests per requesting IP and, when provided, per client pubkey. A request is
+// charged against every key it carries and refused as soon as one of them is out of allowance in any window, so
+// neither rotating pubkeys from one IP nor using one pubkey from many IPs get more than the allowance.
+// The number of tracked keys is bounded, the least recently seen keys are evicted first
+type FreeTierLimiter struct {
+	lock     sync.Mutex
+	windows  []FreeTierWindow
+	allowed  []*net.IPNet
+	maxKeys  int
+	entries  map[string]*list.Element
+	lru      *list.List
+	now      func() time.Time
+	maxRange time.Duration
+	// shared keep the counts in a store shared by the sentinel replicas, under scope, rather than in memory
+	shared *SharedCounters
+	scope  string
+}
+
+type freeTierUsage struct {
+	key      string
+	starts   []time.Time
+	counts   []int
+	lastSeen time.Time
+}
+
+// FreeTierAllowance is what remains of each window after a request
+type FreeTierAllowance struct {
+	Window    FreeTierWindow
+	Remaining int
+	Reset     time.Time
+}
+
+func NewFreeTierLimiter(windows []FreeTierWindow, allowCIDRs []string, maxKeys int) (*FreeTierLimiter, error) {
+	if maxKeys <= 0 {
+		maxKeys = defaultFreeTierMaxKeys
+	}
+	l := &FreeTierLimiter{
+		maxKeys: maxKeys,
+		entries: make(map[string]*list.Element),
+		lru:     list.New(),
+		now:     time.Now,
+	}
+	for _, w := range windows {
+		if w.Limit <= 0 || w.Duration <= 0 {
+			continue
+		}
+		l.windows = append(l.windows, w)
+		if w.Duration > l.maxRange {
+			l.maxRange = w.Duration
+		}
+	}
+	for _, raw := range allowCIDRs {
+		raw = strings.TrimSpace(raw)
+		if raw == "" {
+			continue
+		}
+		ipNet, err := parseCIDR(raw)
+		if err != nil {
+			return nil, fmt.Errorf("invalid free tier allowlist entry %s: %w", raw, err)
+		}
+		l.allowed = append(l.allowed, ipNet)
+	}
+	return l, nil
+}
+
+// Share count the requests in the shared counters, scope keeps the counts of the limiters sharing them apart. The
+// limiter counts in memory while the shared store is down, unless it fails closed
+func (l *FreeTierLimiter) Share(counters *SharedCounters, scope string) {
+	l.shared = counters
+	l.scope = scope
+}
+
+// IsAllowListed return true when the ip bypass the free tier limits
+func (l *FreeTierLimiter) IsAllowListed(ip string) bool {
+	parsed := net.ParseIP(ip)
+	if parsed == nil {
+		return false
+	}
+	for _, ipNet := range l.allowed {
+		if ipNet.Contains(parsed) {
+			return true
+		}
+	}
+	return false
+}
+
+// Allow charge a request from ip and the optional client pubkey. It returns whether the request is allowed and the
+// allowance left in each window, across the keys of the request
+func (l *FreeTierLimiter) Allow(ip, pubkey string) (bool, []FreeTierAllowance) {
+	return l.AllowN(ip, pubkey, 1)
+}
+
+// AllowN charge a request counting as n requests, e.g. a JSON-RPC batch of n calls, it is only allowed when the whole
+// of n fits in the allowance
+func (l *FreeTierLimiter) AllowN(ip, pubkey string, n int) (bool, []FreeTierAllowance) {
+	keys := []string{"ip:" + ip}
+	if pubkey != "" {
+		keys = append(keys, "pk:"+pubkey)
+	}
+	if l.shared != nil && len(l.windows) > 0 {
+		allowed, allowances, err := l.allowShared(keys, n)
+		if err == nil {
+			return allowed, allowances
+		}
+		if l.shared.failClosed {
+			return false, l.allowances(l.now(), nil)
+		}
+	}
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	now := l.now()
+	usages := make([]*freeTierUsage, len(keys))
+	for i, key := range keys {
+		usages[i] = l.usage(key, now)
+	}
+
+	allowed := true
+	for _, u := range usages {
+		for i, w := range l.windows {
+			if u.counts[i]+n > w.Limit {
+				allowed = false
+			}
+		}
+	}
+	if allowed {
+		for _, u := range usages {
+			for i := range l.windows {
+				u.counts[i] += n
+			}
+		}
+	}
+
+	counts := make([][]int, len(usages))
+	for i, u := range usages {
+		counts[i] = u.counts
+	}
+	return allowed, l.allowances(now, counts)
+}
+
+// allowShared charge the request to the counts of its keys in the shared store, the counts are given back when the
+// request is refused
+func (l *FreeTierLimiter) allowShared(keys []string, n int) (bool, []FreeTierAllowance, error) {
+	now := l.now()
+	counts := make([][]int, len(keys))
+	allowed := true
+	for i, key := range keys {
+		counts[i] = make([]int, len(l.windows))
+		for j, w := range l.windows {
+			count, err := l.shared.incr(l.sharedKey(key, w), now, w.Duration, int64(n))
+			if err != nil {
+				return false, nil, err
+			}
+			counts[i][j] = int(count)
+			if counts[i][j] > w.Limit {
+				allowed = false
+			}
+		}
+	}
+	if !allowed {
+		for i, key := range keys {
+			for j, w := range l.windows {
+				counts[i][j] -= n
+				// a count that isn't given back only makes the window tighter
+				_, _ = l.shared.incr(l.sharedKey(key, w), now, w.Duration, -int64(n))
+			}
+		}
+	}
+	return allowed, l.allowances(now, counts), nil
+}
+
+func (l *FreeTierLimiter) sharedKey(key string, w FreeTierWindow) string {
+	return fmt.Sprintf("free:%s:%s:%s", l.scope, key, w.Name)
+}
+
+// allowances return what remains of each window given the counts of each key of a request. Without counts, the
+// shared store being down, nothing remains until the store is tried again
+func (l *FreeTierLimiter) allowances(now time.Time, counts [][]int) []FreeTierAllowance {
+	allowances := make([]FreeTierAllowance, len(l.windows))
+	for i, w := range l.windows {
+		allowances[i] = FreeTierAllowance{Window: w, Remaining: w.Limit, Reset: now.Truncate(w.Duration).Add(w.Duration)}
+		if counts == nil {
+			allowances[i].Remaining, allowances[i].Reset = 0, now.Add(counterStoreRetry)
+		}
+		for _, keyCounts := range counts {
+			if remaining := w.Limit - keyCounts[i]; remaining < allowances[i].Remaining {
+				allowances[i].Remaining = remaining
+			}
+		}
+		if allowances[i].Remaining < 0 {
+			allowances[i].Remaining = 0
+		}
+	}
+	return allowances
+}
+
+// usage return the usage of key with its windows rolled over to now, creating it when needed
+func (l *FreeTierLimiter) usage(key string, now time.Time) *freeTierUsage {
+	if elem, ok := l.entries[key]; ok {
+		l.lru.MoveToFront(elem)
+		u := elem.Value.(*freeTierUsage)
+		for i, w := range l.windows {
+			if start := now.Truncate(w.Duration); start.After(u.starts[i]) {
+				u.starts[i] = start
+				u.counts[i] = 0
+			}
+		}
+		u.lastSeen = now
+		return u
+	}
+
+	l.evict(now)
+	u := &freeTierUsage{
+		key:      key,
+		starts:   make([]time.Time, len(l.windows)),
+		counts:   make([]int, len(l.windows)),
+		lastSeen: now,
+	}
+	for i, w := range l.windows {
+		u.starts[i] = now.Truncate(w.Duration)
+	}
+	l.entries[key] = l.lru.PushFront(u)
+	return u
+}
+
+// evict drop the keys whose windows all rolled over, and the least recently seen ones while at capacity
+func (l *FreeTierLimiter) evict(now time.Time) {
+	for elem := l.lru.Back(); elem != nil; {
+		u := elem.Value.(*freeTierUsage)
+		expired := now.Truncate(l.maxRange).After(u.lastSeen.Truncate(l.maxRange))
+		if !expired && l.lru.Len() < l.maxKeys {
+			return
+		}
+		prev := elem.Prev()
+		l.lru.Remove(elem)
+		delete(l.entries, u.key)
+		elem = prev
+	}
+}
+
+// Len return the number of tracked keys
+func (l *FreeTierLimiter) Len() int {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	return l.lru.Len()
+}
+
+// setFreeTierHeaders expose the remaining allowance of each window to the client, and the one of the window closest
+// to running out in the rate limit headers of the paid requests
+func setFreeTierHeaders(w http.ResponseWriter, allowances []FreeTierAllowance) {
+	for _, a := range allowances {
+		w.Header().Set("X-Free-Tier-Remaining-"+a.Window.Name, strconv.Itoa(a.Remaining))
+		w.Header().Set("X-Free-Tier-Reset-"+a.Window.Name, strconv.FormatInt(a.Reset.Unix(), 10))
+	}
+	if len(allowances) == 0 {
+		return
+	}
+	tightest := allowances[0]
+	for _, a := range allowances[1:] {
+		if a.Remaining < tightest.Remaining || (a.Remaining == tightest.Remaining && a.Reset.After(tightest.Reset)) {
+			tightest = a
+		}
+	}
+	setRateLimitHeaders(w, int64(tightest.Window.Limit), int64(tightest.Remaining), tightest.Reset)
+}
+
+// freeTierRetryAfter return how long a refused client waits for its exhausted windows to roll over
+func freeTierRetryAfter(allowances []FreeTierAllowance, now time.Time) time.Duration {
+	var retryAfter time.Duration
+	for _, a := range allowances {
+		if wait := a.Reset.Sub(now); a.Remaining == 0 && wait > retryAfter {
+			retryAfter = wait
+		}
+	}
+	return retryAfter
+}
+
+// parseCIDR parse an ip range, a single ip is a range of its own
+func parseCIDR(raw string) (*net.IPNet, error) {
+	if !strings.Contains(raw, "/") {
+		if strings.Contains(raw, ":") {
+			raw += "/128"
+		} else {
+			raw += "/32"
+		}
+	}
+	_, ipNet, err := net.ParseCIDR(raw)
+	return ipNet, err
+}
+
+// clientIP extract the ip of the client from a remote address, dropping the port and any proxy appended to a
+// forwarded for header
+func clientIP(remoteAddr string) string {
+	addr := strings.TrimSpace(strings.Split(remoteAddr, ",")[0])
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		return host
+	}
+	return strings.Trim(addr, "[]")
+}
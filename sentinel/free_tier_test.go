@@ -0,0 +1,125 @@
+package sentinel
+
+import (
+	"fmt"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newFreeTierTest(t *testing.T, perMinute, perDay int, allow []string, maxKeys int) (*FreeTierLimiter, *time.Time) {
+	limiter, err := NewFreeTierLimiter([]FreeTierWindow{
+		{Name: "Minute", Duration: time.Minute, Limit: perMinute},
+		{Name: "Day", Duration: 24 * time.Hour, Limit: perDay},
+	}, allow, maxKeys)
+	require.NoError(t, err)
+	now := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	limiter.now = func() time.Time { return now }
+	return limiter, &now
+}
+
+func TestFreeTierLimiterKeys(t *testing.T) {
+	limiter, _ := newFreeTierTest(t, 2, 0, nil, 0)
+
+	// ip only
+	ok, allowances := limiter.Allow("10.0.0.1", "")
+	require.True(t, ok)
+	require.Len(t, allowances, 1)
+	require.Equal(t, 1, allowances[0].Remaining)
+	ok, _ = limiter.Allow("10.0.0.1", "")
+	require.True(t, ok)
+	ok, allowances = limiter.Allow("10.0.0.1", "")
+	require.False(t, ok)
+	require.Equal(t, 0, allowances[0].Remaining)
+
+	// rotating pubkeys from the same ip doesn't help
+	ok, _ = limiter.Allow("10.0.0.1", "pubkey1")
+	require.False(t, ok)
+
+	// the same pubkey from many ips is charged once per request
+	ok, _ = limiter.Allow("10.0.0.2", "pubkey2")
+	require.True(t, ok)
+	ok, allowances = limiter.Allow("10.0.0.3", "pubkey2")
+	require.True(t, ok)
+	require.Equal(t, 0, allowances[0].Remaining)
+	ok, _ = limiter.Allow("10.0.0.4", "pubkey2")
+	require.False(t, ok)
+	// a refused request isn't charged to the fresh ip
+	ok, allowances = limiter.Allow("10.0.0.4", "")
+	require.True(t, ok)
+	require.Equal(t, 1, allowances[0].Remaining)
+}
+
+func TestFreeTierLimiterWindows(t *testing.T) {
+	limiter, now := newFreeTierTest(t, 2, 3, nil, 0)
+
+	ok, allowances := limiter.Allow("10.0.0.1", "")
+	require.True(t, ok)
+	require.Len(t, allowances, 2)
+	require.Equal(t, "Day", allowances[1].Window.Name)
+	require.Equal(t, 2, allowances[1].Remaining)
+	require.Equal(t, now.Add(time.Minute), allowances[0].Reset)
+	ok, _ = limiter.Allow("10.0.0.1", "")
+	require.True(t, ok)
+	ok, _ = limiter.Allow("10.0.0.1", "")
+	require.False(t, ok)
+
+	// the minute window rolls over, the daily one doesn't
+	*now = now.Add(time.Minute)
+	ok, allowances = limiter.Allow("10.0.0.1", "")
+	require.True(t, ok)
+	require.Equal(t, 1, allowances[0].Remaining)
+	require.Equal(t, 0, allowances[1].Remaining)
+	*now = now.Add(time.Minute)
+	ok, _ = limiter.Allow("10.0.0.1", "")
+	require.False(t, ok)
+
+	*now = now.Add(24 * time.Hour)
+	ok, _ = limiter.Allow("10.0.0.1", "")
+	require.True(t, ok)
+}
+
+func TestFreeTierLimiterAllowList(t *testing.T) {
+	_, err := NewFreeTierLimiter(nil, []string{"not-an-ip"}, 0)
+	require.Error(t, err)
+
+	limiter, _ := newFreeTierTest(t, 1, 0, []string{"192.168.0.0/16", "10.0.0.1", "::1"}, 0)
+	require.True(t, limiter.IsAllowListed("192.168.4.20"))
+	require.True(t, limiter.IsAllowListed("10.0.0.1"))
+	require.True(t, limiter.IsAllowListed("::1"))
+	require.False(t, limiter.IsAllowListed("10.0.0.2"))
+	require.False(t, limiter.IsAllowListed("garbage"))
+}
+
+func TestFreeTierLimiterEviction(t *testing.T) {
+	limiter, now := newFreeTierTest(t, 1, 0, nil, 10)
+	for i := 0; i < 100; i++ {
+		limiter.Allow(fmt.Sprintf("10.0.0.%d", i), "")
+		require.LessOrEqual(t, limiter.Len(), 10)
+	}
+
+	// keys with expired windows are dropped first
+	*now = now.Add(time.Minute)
+	limiter.Allow("10.0.1.1", "")
+	require.Equal(t, 1, limiter.Len())
+}
+
+func TestSetFreeTierHeaders(t *testing.T) {
+	limiter, now := newFreeTierTest(t, 5, 100, nil, 0)
+	_, allowances := limiter.Allow("10.0.0.1", "")
+	w := httptest.NewRecorder()
+	setFreeTierHeaders(w, allowances)
+	require.Equal(t, "4", w.Header().Get("X-Free-Tier-Remaining-Minute"))
+	require.Equal(t, "99", w.Header().Get("X-Free-Tier-Remaining-Day"))
+	require.Equal(t, fmt.Sprintf("%d", now.Add(time.Minute).Unix()), w.Header().Get("X-Free-Tier-Reset-Minute"))
+}
+
+func TestClientIP(t *testing.T) {
+	require.Equal(t, "127.0.0.1", clientIP("127.0.0.1:8000"))
+	require.Equal(t, "127.0.0.1", clientIP("127.0.0.1"))
+	require.Equal(t, "203.0.113.7", clientIP("203.0.113.7, 10.0.0.1"))
+	require.Equal(t, "::1", clientIP("[::1]:8000"))
+	require.Equal(t, "::1", clientIP("::1"))
+}
@@ -0,0 +1,352 @@
+package sentinel
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+
+	"golang.org/x/net/http2"
+	"google.golang.org/grpc/codes"
+
+	"github.com/arkeonetwork/arkeo/common"
+)
+
+const (
+	// GRPCUpstreamH2C talk to the upstream over cleartext http/2, the default
+	GRPCUpstreamH2C = "h2c"
+	// GRPCUpstreamTLS talk to the upstream over http/2 with tls
+	GRPCUpstreamTLS = "tls"
+	// GRPCUpstreamTLSInsecure talk to the upstream over http/2 with tls, without verifying its certificate
+	GRPCUpstreamTLSInsecure = "tls-insecure"
+
+	// GRPCAccountingCall charge one query per call, the default
+	GRPCAccountingCall = "call"
+	// GRPCAccountingMessage also charge one query per client message after the first of a stream
+	GRPCAccountingMessage = "message"
+
+	grpcContentType    = "application/grpc"
+	grpcWebContentType = "application/grpc-web"
+	grpcFrameHeaderLen = 5
+)
+
+// isGRPCRequest return true for grpc and grpc-web requests
+func isGRPCRequest(r *http.Request) bool {
+	return strings.HasPrefix(r.Header.Get("Content-Type"), grpcContentType)
+}
+
+func isGRPCWebRequest(r *http.Request) bool {
+	return strings.HasPrefix(r.Header.Get("Content-Type"), grpcWebContentType)
+}
+
+// newGRPCTransports return the http/2 transports to the grpc upstreams, by mode
+func newGRPCTransports() map[string]http.RoundTripper {
+	return map[string]http.RoundTripper{
+		GRPCUpstreamH2C: &http2.Transport{
+			AllowHTTP: true,
+			DialTLSContext: func(ctx context.Context, network, addr string, _ *tls.Config) (net.Conn, error) {
+				var d net.Dialer
+				return d.DialContext(ctx, network, addr)
+			},
+		},
+		GRPCUpstreamTLS: &http2.Transport{
+			TLSClientConfig: &tls.Config{MinVersion: tls.VersionTLS12},
+		},
+		GRPCUpstreamTLSInsecure: &http2.Transport{
+			// #nosec G402 opted in by the provider for upstreams with self signed certificates
+			TLSClientConfig: &tls.Config{MinVersion: tls.VersionTLS12, InsecureSkipVerify: true},
+		},
+	}
+}
+
+// grpcUpstream return how to reach the grpc upstream of a service
+func (p Proxy) grpcUpstream(service string) string {
+	switch mode := p.Config.GRPCUpstream[service]; mode {
+	case GRPCUpstreamTLS, GRPCUpstreamTLSInsecure:
+		return mode
+	default:
+		return GRPCUpstreamH2C
+	}
+}
+
+// grpcAccounting return how the calls of a service are charged
+func (p Proxy) grpcAccounting(service string) string {
+	if p.Config.GRPCAccounting[service] == GRPCAccountingMessage {
+		return GRPCAccountingMessage
+	}
+	return GRPCAccountingCall
+}
+
+// handleGRPC forward a grpc or grpc-web call to the upstream url with streaming intact, the request was already
+// authenticated and charged as one query
+func (p Proxy) handleGRPC(w http.ResponseWriter, r *http.Request, service string, upstreamURL url.URL, pubkey string) {
+	// grpc-web is plain http, served by the upstream's grpc-web endpoint
+	if isGRPCWebRequest(r) {
+		proxy := common.NewSingleHostReverseProxy(&upstreamURL)
+		proxy.ErrorHandler = func(w http.ResponseWriter, r *http.Request, err error) {
+			p.logger.Error("failed to proxy grpc-web call", "error", err, "service", service)
+			writeGRPCStatus(w, r, codes.Unavailable, "upstream unavailable")
+		}
+		p.withHeaderPolicy(proxy, service)
+		proxy.ServeHTTP(w, r)
+		return
+	}
+
+	mode := p.grpcUpstream(service)
+	upstreamURL.Scheme = "http"
+	if mode != GRPCUpstreamH2C {
+		upstreamURL.Scheme = "https"
+	}
+
+	var body *grpcMeteredBody
+	if p.grpcAccounting(service) == GRPCAccountingMessage {
+		body = &grpcMeteredBody{ReadCloser: r.Body, meter: p.newStreamMeter(r, pubkey)}
+		r.Body = body
+	}
+
+	proxy := common.NewSingleHostReverseProxy(&upstreamURL)
+	proxy.Transport = p.grpcTransports[mode]
+	proxy.FlushInterval = -1
+	p.withHeaderPolicy(proxy, service)
+	proxy.ModifyResponse = func(res *http.Response) error {
+		p.stripResponseHeaders(res.Header, service)
+		if body != nil {
+			res.Body = &grpcTrailerBody{ReadCloser: res.Body, request: body, w: w}
+		}
+		return nil
+	}
+	proxy.ErrorHandler = func(w http.ResponseWriter, r *http.Request, err error) {
+		if meterErr := body.Err(); meterErr != nil {
+			code, msg := grpcStatusFromError(meterErr)
+			writeGRPCStatus(w, r, code, msg)
+			return
+		}
+		p.logger.Error("failed to proxy grpc call", "error", err, "service", service)
+		writeGRPCStatus(w, r, codes.Unavailable, "upstream unavailable")
+	}
+	proxy.ServeHTTP(w, r)
+}
+
+// grpcMeteredBody charge each client message of a stream past the first one, the first is paid by the call
+type grpcMeteredBody struct {
+	io.ReadCloser
+	meter     streamMeter
+	header    [grpcFrameHeaderLen]byte
+	headerLen int
+	remaining uint32
+	messages  int64
+	lock      sync.Mutex
+	err       error
+}
+
+func (b *grpcMeteredBody) Read(p []byte) (int, error) {
+	if err := b.Err(); err != nil {
+		return 0, err
+	}
+	n, err := b.ReadCloser.Read(p)
+	for data := p[:n]; len(data) > 0; {
+		if b.remaining > 0 {
+			consumed := uint32(len(data))
+			if consumed > b.remaining {
+				consumed = b.remaining
+			}
+			b.remaining -= consumed
+			data = data[consumed:]
+			continue
+		}
+		copied := copy(b.header[b.headerLen:], data)
+		b.headerLen += copied
+		data = data[copied:]
+		if b.headerLen < grpcFrameHeaderLen {
+			continue
+		}
+		b.headerLen = 0
+		b.remaining = binary.BigEndian.Uint32(b.header[1:])
+		b.messages++
+		if b.messages == 1 {
+			continue
+		}
+		meterErr := b.meter.limit()
+		if meterErr == nil {
+			meterErr = b.meter.charge(1)
+		}
+		if meterErr != nil {
+			b.lock.Lock()
+			b.err = meterErr
+			b.lock.Unlock()
+			return 0, meterErr
+		}
+	}
+	return n, err
+}
+
+// Err return why the stream was cut, nil while it is allowed
+func (b *grpcMeteredBody) Err() error {
+	if b == nil {
+		return nil
+	}
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return b.err
+}
+
+// grpcTrailerBody end the response of a stream cut by the meter with a grpc status, rather than a reset stream
+type grpcTrailerBody struct {
+	io.ReadCloser
+	request *grpcMeteredBody
+	w       http.ResponseWriter
+}
+
+func (b *grpcTrailerBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	if err != nil && err != io.EOF {
+		if meterErr := b.request.Err(); meterErr != nil {
+			code, msg := grpcStatusFromError(meterErr)
+			b.w.Header().Set(http.TrailerPrefix+"Grpc-Status", strconv.Itoa(int(code)))
+			b.w.Header().Set(http.TrailerPrefix+"Grpc-Message", encodeGRPCMessage(msg))
+			return n, io.EOF
+		}
+	}
+	return n, err
+}
+
+// grpcStatusFromError map a meter error to a grpc status
+func grpcStatusFromError(err error) (codes.Code, string) {
+	var limitErr *contractRateLimitError
+	var depositErr *minDepositError
+	switch {
+	case errors.Is(err, ErrContractExpired), errors.Is(err, ErrContractSpent), errors.As(err, &depositErr):
+		return codes.FailedPrecondition, err.Error()
+	case errors.As(err, &limitErr), errors.Is(err, ErrFreeTierRateLimited):
+		return codes.ResourceExhausted, err.Error()
+	default:
+		return codes.Internal, err.Error()
+	}
+}
+
+// grpcCodeFromHTTP map the http status the sentinel reply with to a grpc status
+func grpcCodeFromHTTP(code int) codes.Code {
+	switch code {
+	case http.StatusOK:
+		return codes.OK
+	case http.StatusBadRequest:
+		return codes.InvalidArgument
+	case http.StatusUnauthorized:
+		return codes.Unauthenticated
+	case http.StatusPaymentRequired:
+		return codes.FailedPrecondition
+	case http.StatusForbidden:
+		return codes.PermissionDenied
+	case http.StatusNotFound:
+		return codes.NotFound
+	case http.StatusTooManyRequests:
+		return codes.ResourceExhausted
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return codes.Unavailable
+	default:
+		return codes.Internal
+	}
+}
+
+// writeGRPCStatus reply with a trailers only grpc response
+func writeGRPCStatus(w http.ResponseWriter, r *http.Request, code codes.Code, msg string) {
+	contentType := r.Header.Get("Content-Type")
+	if !strings.HasPrefix(contentType, grpcContentType) {
+		contentType = grpcContentType
+	}
+	w.Header().Del("Content-Length")
+	w.Header().Del("X-Content-Type-Options")
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Grpc-Status", strconv.Itoa(int(code)))
+	w.Header().Set("Grpc-Message", encodeGRPCMessage(msg))
+	w.WriteHeader(http.StatusOK)
+}
+
+// encodeGRPCMessage percent encode a status message as required by the grpc http/2 protocol
+func encodeGRPCMessage(msg string) string {
+	var sb strings.Builder
+	for i := 0; i < len(msg); i++ {
+		c := msg[i]
+		if c >= ' ' && c <= '~' && c != '%' {
+			sb.WriteByte(c)
+			continue
+		}
+		fmt.Fprintf(&sb, "%%%02X", c)
+	}
+	return sb.String()
+}
+
+// grpcErrors turn the http errors the sentinel reply to grpc calls with into grpc statuses
+func (p Proxy) grpcErrors(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !isGRPCRequest(r) {
+			next.ServeHTTP(w, r)
+			return
+		}
+		gw := &grpcErrorWriter{ResponseWriter: w}
+		next.ServeHTTP(gw, r)
+		if gw.status == 0 || gw.status == http.StatusOK {
+			return
+		}
+		msg := strings.TrimSpace(gw.body.String())
+		var body struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(gw.body.Bytes(), &body); err == nil && body.Error != "" {
+			msg = body.Error
+		}
+		writeGRPCStatus(w, r, grpcCodeFromHTTP(gw.status), msg)
+	})
+}
+
+// grpcErrorWriter hold back non 200 responses so they can be sent as a grpc status
+type grpcErrorWriter struct {
+	http.ResponseWriter
+	status int
+	body   bytes.Buffer
+}
+
+func (w *grpcErrorWriter) WriteHeader(code int) {
+	if w.status != 0 {
+		return
+	}
+	w.status = code
+	if code == http.StatusOK {
+		w.ResponseWriter.WriteHeader(code)
+	}
+}
+
+func (w *grpcErrorWriter) Write(b []byte) (int, error) {
+	if w.status == 0 {
+		w.WriteHeader(http.StatusOK)
+	}
+	if w.status != http.StatusOK {
+		return w.body.Write(b)
+	}
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *grpcErrorWriter) Flush() {
+	if w.status != http.StatusOK {
+		return
+	}
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+func (w *grpcErrorWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+var _ http.Flusher = &grpcErrorWriter{}
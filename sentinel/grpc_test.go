@@ -0,0 +1,161 @@
+package sentinel
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/reflection"
+	reflectionpb "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
+	"google.golang.org/grpc/status"
+
+	"github.com/arkeonetwork/arkeo/common"
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+)
+
+const grpcTestService = "gaia-mainnet-grpc"
+
+// newGRPCTest start an in-process grpc server (health and reflection services) behind a sentinel
+func newGRPCTest(t *testing.T, accounting string, contract types.Contract) (Proxy, *grpc.ClientConn) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	upstream := grpc.NewServer()
+	healthpb.RegisterHealthServer(upstream, health.NewServer())
+	reflection.Register(upstream)
+	go func() { _ = upstream.Serve(lis) }()
+	t.Cleanup(upstream.Stop)
+
+	config := newTestConfig()
+	config.GRPCAccounting = map[string]string{grpcTestService: accounting}
+	proxy, err := NewProxy(config)
+	require.NoError(t, err)
+	proxy.proxies[grpcTestService] = common.MustParseURL(fmt.Sprintf("http://%s", lis.Addr()))
+	proxy.MemStore.SetHeight(10)
+	if contract.Id > 0 {
+		proxy.MemStore.Put(contract)
+	}
+
+	server := httptest.NewUnstartedServer(h2c.NewHandler(proxy.getRouter(), &http2.Server{}))
+	server.Start()
+	t.Cleanup(server.Close)
+	conn, err := grpc.NewClient(strings.TrimPrefix(server.URL, "http://"), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+	return proxy, conn
+}
+
+func newGRPCContract(id uint64, qpm, deposit int64) types.Contract {
+	service, _ := common.NewService(grpcTestService)
+	contract := newWebsocketContract(id, qpm, deposit)
+	contract.Service = service
+	return contract
+}
+
+// grpcAuth carry the arkeo auth in the call metadata
+func grpcAuth(contractId uint64, nonce int64) context.Context {
+	md := []string{ServiceHeader, grpcTestService}
+	if contractId > 0 {
+		md = append(md, QueryArkAuth, fmt.Sprintf("%d:%d", contractId, nonce))
+	}
+	return metadata.AppendToOutgoingContext(context.Background(), md...)
+}
+
+func TestGRPCUnary(t *testing.T) {
+	contract := newGRPCContract(1, 2, 100)
+	proxy, conn := newGRPCTest(t, GRPCAccountingCall, contract)
+	client := healthpb.NewHealthClient(conn)
+
+	for nonce := int64(1); nonce <= 2; nonce++ {
+		resp, err := client.Check(grpcAuth(contract.Id, nonce), &healthpb.HealthCheckRequest{})
+		require.NoError(t, err)
+		require.Equal(t, healthpb.HealthCheckResponse_SERVING, resp.Status)
+	}
+	claim, err := proxy.ClaimStore.Get(contract.Key())
+	require.NoError(t, err)
+	require.Equal(t, int64(2), claim.Nonce)
+
+	// over the contract's queries per minute
+	_, err = client.Check(grpcAuth(contract.Id, 3), &healthpb.HealthCheckRequest{})
+	require.Equal(t, codes.ResourceExhausted, status.Code(err), err)
+
+	// free tier
+	_, err = client.Check(grpcAuth(0, 0), &healthpb.HealthCheckRequest{})
+	require.NoError(t, err)
+}
+
+func TestGRPCErrors(t *testing.T) {
+	_, conn := newGRPCTest(t, GRPCAccountingCall, types.Contract{})
+
+	// unknown service
+	ctx := metadata.AppendToOutgoingContext(context.Background(), ServiceHeader, "not-a-service")
+	_, err := healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{})
+	require.Equal(t, codes.InvalidArgument, status.Code(err), err)
+	require.Contains(t, status.Convert(err).Message(), "could not find service")
+}
+
+func TestGRPCStreamAccounting(t *testing.T) {
+	// the call paid nonce 1, the deposit cover two more messages
+	contract := newGRPCContract(2, 100, 3)
+	proxy, conn := newGRPCTest(t, GRPCAccountingMessage, contract)
+
+	stream, err := reflectionpb.NewServerReflectionClient(conn).ServerReflectionInfo(grpcAuth(contract.Id, 1))
+	require.NoError(t, err)
+	request := &reflectionpb.ServerReflectionRequest{
+		MessageRequest: &reflectionpb.ServerReflectionRequest_ListServices{},
+	}
+	for i := 0; i < 3; i++ {
+		require.NoError(t, stream.Send(request))
+		_, err := stream.Recv()
+		require.NoError(t, err)
+	}
+	require.Equal(t, int64(2), proxy.StreamUsage.Get(contract.Id))
+
+	require.NoError(t, stream.Send(request))
+	_, err = stream.Recv()
+	require.Equal(t, codes.FailedPrecondition, status.Code(err), err)
+	require.Contains(t, status.Convert(err).Message(), ErrContractSpent.Error())
+}
+
+func TestGRPCMeteredBody(t *testing.T) {
+	frame := func(payload string) []byte {
+		return append([]byte{0, 0, 0, 0, byte(len(payload))}, payload...)
+	}
+	var stream []byte
+	for _, payload := range []string{"one", "", "three"} {
+		stream = append(stream, frame(payload)...)
+	}
+
+	contract := newGRPCContract(3, 100, 100)
+	proxy, _ := newGRPCTest(t, GRPCAccountingMessage, contract)
+	body := &grpcMeteredBody{
+		ReadCloser: io.NopCloser(bytes.NewReader(stream)),
+		meter:      streamMeter{proxy: proxy, contract: contract},
+	}
+	// read byte by byte to split the frame headers
+	buf := make([]byte, 1)
+	for {
+		if _, err := body.Read(buf); err != nil {
+			break
+		}
+	}
+	require.Equal(t, int64(3), body.messages)
+	require.Equal(t, int64(2), proxy.StreamUsage.Get(contract.Id))
+}
+
+func TestEncodeGRPCMessage(t *testing.T) {
+	require.Equal(t, "contract 1: 100%25 spent%0A", encodeGRPCMessage("contract 1: 100% spent\n"))
+}
@@ -0,0 +1,115 @@
+package sentinel
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httputil"
+	"strings"
+)
+
+// arkeoHeaders are the headers of the sentinel auth, they are never sent upstream
+var arkeoHeaders = []string{QueryArkAuth, QueryClientPubKey, QueryContract, ServiceHeader}
+
+// newTrustedProxies parse the ip ranges of the proxies trusted to give the client address
+func newTrustedProxies(cidrs []string) ([]*net.IPNet, error) {
+	var trusted []*net.IPNet
+	for _, raw := range cidrs {
+		ipNet, err := parseCIDR(strings.TrimSpace(raw))
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy %s: %w", raw, err)
+		}
+		trusted = append(trusted, ipNet)
+	}
+	return trusted, nil
+}
+
+// isTrustedProxy return true when ip is in the ranges of the trusted proxies
+func (p Proxy) isTrustedProxy(ip string) bool {
+	parsed := net.ParseIP(ip)
+	if parsed == nil {
+		return false
+	}
+	for _, ipNet := range p.trustedProxies {
+		if ipNet.Contains(parsed) {
+			return true
+		}
+	}
+	return false
+}
+
+// forwardedFor return the hops of the X-Forwarded-For headers of a request, the closest last
+func forwardedFor(header http.Header) []string {
+	var hops []string
+	for _, value := range header.Values(forwardHeaderName) {
+		for _, hop := range strings.Split(value, ",") {
+			if hop = strings.TrimSpace(hop); len(hop) > 0 {
+				hops = append(hops, hop)
+			}
+		}
+	}
+	return hops
+}
+
+// withHeaderPolicy apply the header policy of the service to the requests the reverse proxy sends upstream, the
+// reverse proxy appends the client address to X-Forwarded-For itself
+func (p Proxy) withHeaderPolicy(proxy *httputil.ReverseProxy, service string) {
+	director := proxy.Director
+	proxy.Director = func(req *http.Request) {
+		director(req)
+		p.upstreamRequestHeaders(req.Header, req, service, false)
+	}
+}
+
+// upstreamRequestHeaders strip the arkeo auth headers and those of the service policy from the headers sent upstream,
+// inject the headers of the policy and the upstream credentials, and pass on the client address unless the service
+// hides it. The X-Forwarded-For hops are only kept when a trusted proxy sent them, appendClient append the address the
+// request came from
+func (p Proxy) upstreamRequestHeaders(header http.Header, r *http.Request, service string, appendClient bool) {
+	settings := p.Config.Services[service]
+	for _, name := range arkeoHeaders {
+		header.Del(name)
+	}
+	for _, name := range settings.StripHeaders {
+		header.Del(name)
+	}
+	// the credentials a client sent for the upstream never reach it
+	if len(settings.UpstreamAuth.Header) > 0 {
+		header.Del(settings.UpstreamAuth.Header)
+	}
+
+	if settings.HideClientIP {
+		header.Del(xRealIPName)
+		// a nil value stop the reverse proxy from appending the client
+		header[forwardHeaderName] = nil
+	} else {
+		peer := clientIP(r.RemoteAddr)
+		var hops []string
+		if p.isTrustedProxy(peer) {
+			hops = forwardedFor(header)
+		}
+		if appendClient {
+			hops = append(hops, peer)
+		}
+		if len(hops) > 0 {
+			header.Set(forwardHeaderName, strings.Join(hops, ", "))
+		} else {
+			header.Del(forwardHeaderName)
+		}
+		header.Set(xRealIPName, clientIP(p.getRemoteAddr(r)))
+	}
+
+	for name, value := range settings.InjectHeaders {
+		header.Set(name, value)
+	}
+	if len(settings.UpstreamAuth.Header) > 0 {
+		header.Set(settings.UpstreamAuth.Header, settings.UpstreamAuth.Value)
+	}
+}
+
+// stripResponseHeaders remove the headers of the service policy from an upstream response
+func (p Proxy) stripResponseHeaders(header http.Header, service string) {
+	for _, name := range p.Config.Services[service].StripResponseHeaders {
+		header.Del(name)
+	}
+}
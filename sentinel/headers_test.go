@@ -0,0 +1,184 @@
+package sentinel
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/arkeonetwork/arkeo/common"
+	"github.com/arkeonetwork/arkeo/sentinel/conf"
+)
+
+func TestHeaderPolicy(t *testing.T) {
+	// the upstream records the headers it receives
+	received := make(chan http.Header, 1)
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Empty(t, r.URL.RawQuery)
+		received <- r.Header.Clone()
+		w.Header().Set("Server", "geth/v1.13.0")
+		w.Header().Set("X-Upstream", "ok")
+		_, _ = w.Write([]byte("{}"))
+	}))
+	defer upstream.Close()
+
+	config := newTestConfig()
+	config.TrustedProxyCIDRs = []string{"10.0.0.0/8"}
+	config.Services = map[string]conf.ServiceConfiguration{
+		"btc-mainnet-fullnode": {
+			Upstream:             upstream.URL,
+			StripHeaders:         []string{"X-Debug"},
+			InjectHeaders:        map[string]string{"X-Api-Key": "secret"},
+			StripResponseHeaders: []string{"Server"},
+		},
+		"eth-mainnet-fullnode": {
+			Upstream:     upstream.URL,
+			HideClientIP: true,
+		},
+	}
+	proxy, err := NewProxy(config)
+	require.NoError(t, err)
+	contract := newWebsocketContract(1, 1000, 1000)
+	proxy.MemStore.SetHeight(10)
+	proxy.MemStore.Put(contract)
+	router := proxy.getRouter()
+
+	// a client reaching the sentinel directly, its forwarding headers are ignored
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/%s?%s=%s", common.BTCService, QueryClientPubKey, contract.Client), nil)
+	req.RemoteAddr = "192.0.2.1:1234"
+	req.Header.Set(QueryArkAuth, fmt.Sprintf("%d:1", contract.Id))
+	req.Header.Set(QueryContract, "1:1:aa")
+	req.Header.Set("X-Debug", "1")
+	req.Header.Set("X-Api-Key", "forged")
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set(forwardHeaderName, "1.2.3.4")
+	req.Header.Set(xRealIPName, "1.2.3.4")
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, "paid", w.Header().Get("tier"))
+	require.Equal(t, map[string][]string{
+		"Accept":          {"application/json"},
+		"Accept-Encoding": {"gzip"},
+		"X-Api-Key":       {"secret"},
+		"X-Forwarded-For": {"192.0.2.1"},
+		"X-Real-Ip":       {"192.0.2.1"},
+	}, map[string][]string(<-received))
+	require.Empty(t, w.Header().Get("Server"))
+	require.Equal(t, "ok", w.Header().Get("X-Upstream"))
+
+	// a client behind a trusted proxy
+	w = httptest.NewRecorder()
+	req = httptest.NewRequest(http.MethodGet, fmt.Sprintf("/%s", common.BTCService), nil)
+	req.RemoteAddr = "10.0.0.5:1234"
+	req.Header.Set(forwardHeaderName, "1.2.3.4, 10.0.0.7")
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, map[string][]string{
+		"Accept-Encoding": {"gzip"},
+		"X-Api-Key":       {"secret"},
+		"X-Forwarded-For": {"1.2.3.4, 10.0.0.7, 10.0.0.5"},
+		"X-Real-Ip":       {"1.2.3.4"},
+	}, map[string][]string(<-received))
+
+	// a service hiding the client address
+	w = httptest.NewRecorder()
+	req = httptest.NewRequest(http.MethodGet, "/eth-mainnet-fullnode", nil)
+	req.RemoteAddr = "10.0.0.5:1234"
+	req.Header.Set(forwardHeaderName, "1.2.3.4")
+	req.Header.Set(xRealIPName, "1.2.3.4")
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, map[string][]string{
+		"Accept-Encoding": {"gzip"},
+	}, map[string][]string(<-received))
+	require.Equal(t, "geth/v1.13.0", w.Header().Get("Server"))
+}
+
+func TestGetRemoteAddr(t *testing.T) {
+	trusted, err := newTrustedProxies([]string{"10.0.0.0/8", "192.168.1.1"})
+	require.NoError(t, err)
+	proxy := Proxy{trustedProxies: trusted}
+
+	for _, tc := range []struct {
+		remoteAddr string
+		realIP     string
+		forwarded  string
+		expected   string
+	}{
+		{remoteAddr: "192.0.2.1:1234", expected: "192.0.2.1:1234"},
+		{remoteAddr: "192.0.2.1:1234", realIP: "1.2.3.4", forwarded: "1.2.3.4", expected: "192.0.2.1:1234"},
+		{remoteAddr: "10.0.0.5:1234", realIP: "1.2.3.4", forwarded: "5.6.7.8", expected: "1.2.3.4"},
+		{remoteAddr: "10.0.0.5:1234", forwarded: "9.9.9.9, 1.2.3.4, 192.168.1.1", expected: "1.2.3.4"},
+		{remoteAddr: "10.0.0.5:1234", forwarded: "10.0.0.7, 10.0.0.8", expected: "10.0.0.7"},
+		{remoteAddr: "10.0.0.5:1234", expected: "10.0.0.5:1234"},
+	} {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.RemoteAddr = tc.remoteAddr
+		if len(tc.realIP) > 0 {
+			req.Header.Set(xRealIPName, tc.realIP)
+		}
+		if len(tc.forwarded) > 0 {
+			req.Header.Set(forwardHeaderName, tc.forwarded)
+		}
+		require.Equal(t, tc.expected, proxy.getRemoteAddr(req), tc)
+	}
+
+	_, err = newTrustedProxies([]string{"not-a-range"})
+	require.Error(t, err)
+}
+
+func TestUpstreamAuth(t *testing.T) {
+	received := make(chan http.Header, 1)
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received <- r.Header.Clone()
+		_, _ = w.Write([]byte("{}"))
+	}))
+	defer upstream.Close()
+
+	config := newTestConfig()
+	config.Services = map[string]conf.ServiceConfiguration{
+		common.BTCService.String(): {
+			Upstream:     upstream.URL,
+			UpstreamAuth: conf.UpstreamAuth{Type: "bearer", Header: "Authorization", Value: "Bearer upstream-secret"},
+		},
+		common.ETHService.String(): {
+			Upstream:     upstream.URL,
+			UpstreamAuth: conf.UpstreamAuth{Type: "header", Header: "X-Api-Key", Value: "Token upstream-secret"},
+		},
+	}
+	proxy, err := NewProxy(config)
+	require.NoError(t, err)
+	var out bytes.Buffer
+	proxy.AccessLog = NewAccessLogger(&out)
+	router := proxy.getRouter()
+
+	// the credentials of the service replace those the client sent
+	for service, expected := range map[common.Service]http.Header{
+		common.BTCService: {"Authorization": {"Bearer upstream-secret"}, "X-Api-Key": {"forged"}},
+		common.ETHService: {"Authorization": {"Bearer forged"}, "X-Api-Key": {"Token upstream-secret"}},
+	} {
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/%s", service), nil)
+		req.Header.Set("Authorization", "Bearer forged")
+		req.Header.Add("X-Api-Key", "forged")
+		if service == common.ETHService {
+			req.Header.Add("X-Api-Key", "forged-again")
+		}
+		router.ServeHTTP(w, req)
+		require.Equal(t, http.StatusOK, w.Code)
+		header := <-received
+		require.Equal(t, expected["Authorization"], header.Values("Authorization"), service)
+		require.Equal(t, expected["X-Api-Key"], header.Values("X-Api-Key"), service)
+	}
+
+	// nor are they advertised or logged
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, RoutesMetaData, nil))
+	require.Equal(t, http.StatusOK, w.Code)
+	require.NotContains(t, w.Body.String(), "upstream-secret")
+	require.NotContains(t, out.String(), "upstream-secret")
+}
@@ -0,0 +1,226 @@
+package sentinel
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/arkeonetwork/arkeo/sentinel/conf"
+)
+
+const (
+	HealthStatusOK       = "ok"
+	HealthStatusDown     = "down"
+	HealthStatusDisabled = "disabled"
+
+	defaultHealthTimeout = 3 * time.Second
+)
+
+// ComponentHealth is the result of the check of one component the sentinel depends on
+type ComponentHealth struct {
+	Name      string `json:"name"`
+	Status    string `json:"status"`
+	Error     string `json:"error,omitempty"`
+	LatencyMs int64  `json:"latency_ms"`
+}
+
+// HealthReport is the body of the health endpoint, Status is down as soon as one component is
+type HealthReport struct {
+	Status     string            `json:"status"`
+	Components []ComponentHealth `json:"components"`
+	CheckedAt  time.Time         `json:"checked_at"`
+}
+
+// errHealthDisabled is returned by the probes of components the sentinel isn't configured to use
+var errHealthDisabled = errors.New("disabled")
+
+// healthProbe check one component, it must give up once ctx is done
+type healthProbe struct {
+	name  string
+	probe func(ctx context.Context) error
+}
+
+// HealthChecker run the health probes concurrently and cache the report for a few seconds, so the health endpoint
+// can't be used to hammer the upstreams
+type HealthChecker struct {
+	lock         sync.Mutex
+	probes       []healthProbe
+	ttl          time.Duration
+	timeout      time.Duration
+	probeTimeout time.Duration
+	now          func() time.Time
+	report       *HealthReport
+}
+
+func NewHealthChecker(config conf.HealthConfiguration, probes []healthProbe) *HealthChecker {
+	timeout := time.Duration(config.TimeoutMs) * time.Millisecond
+	if timeout <= 0 {
+		timeout = defaultHealthTimeout
+	}
+	probeTimeout := time.Duration(config.ProbeTimeoutMs) * time.Millisecond
+	if probeTimeout <= 0 || probeTimeout > timeout {
+		probeTimeout = timeout
+	}
+	return &HealthChecker{
+		probes:       probes,
+		ttl:          time.Duration(config.CacheSeconds) * time.Second,
+		timeout:      timeout,
+		probeTimeout: probeTimeout,
+		now:          time.Now,
+	}
+}
+
+// Check return the cached report, or run the probes once it expired. The probes don't depend on the request
+// context, a client going away must not cache a failed report
+func (h *HealthChecker) Check() HealthReport {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	if h.report != nil && h.now().Sub(h.report.CheckedAt) < h.ttl {
+		return *h.report
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), h.timeout)
+	defer cancel()
+	components := make([]ComponentHealth, len(h.probes))
+	var wg sync.WaitGroup
+	for i, probe := range h.probes {
+		wg.Add(1)
+		go func(i int, probe healthProbe) {
+			defer wg.Done()
+			components[i] = h.run(ctx, probe)
+		}(i, probe)
+	}
+	wg.Wait()
+
+	report := HealthReport{Status: HealthStatusOK, Components: components, CheckedAt: h.now()}
+	for _, component := range components {
+		if component.Status == HealthStatusDown {
+			report.Status = HealthStatusDown
+		}
+	}
+	h.report = &report
+	return report
+}
+
+func (h *HealthChecker) run(ctx context.Context, probe healthProbe) ComponentHealth {
+	ctx, cancel := context.WithTimeout(ctx, h.probeTimeout)
+	defer cancel()
+	start := time.Now()
+	result := make(chan error, 1)
+	go func() { result <- probe.probe(ctx) }()
+
+	var err error
+	select {
+	case err = <-result:
+	case <-ctx.Done():
+		err = ctx.Err()
+	}
+	component := ComponentHealth{Name: probe.name, Status: HealthStatusOK, LatencyMs: time.Since(start).Milliseconds()}
+	switch {
+	case errors.Is(err, errHealthDisabled):
+		component.Status = HealthStatusDisabled
+	case err != nil:
+		component.Status = HealthStatusDown
+		component.Error = err.Error()
+	}
+	return component
+}
+
+// healthProbes return the probes of the upstreams configured to be checked, the chain, the claim store and the
+// provider key
+func (p Proxy) healthProbes() []healthProbe {
+	client := &http.Client{}
+	services := make([]string, 0, len(p.Config.Health.Probes))
+	for service := range p.Config.Health.Probes {
+		services = append(services, service)
+	}
+	sort.Strings(services)
+
+	probes := make([]healthProbe, 0, len(services)+4)
+	for _, service := range services {
+		service, probePath := service, p.Config.Health.Probes[service]
+		probes = append(probes, healthProbe{
+			name: "upstream:" + service,
+			probe: func(ctx context.Context) error {
+				uri, ok := p.current().proxies[service]
+				if !ok {
+					return fmt.Errorf("unknown service %s", service)
+				}
+				target := *uri
+				target.Path = path.Join("/", uri.Path, probePath)
+				return probeURL(ctx, client, target, http.StatusInternalServerError)
+			},
+		})
+	}
+
+	if p.DevChain != nil {
+		// the fake chain of the dev mode is in process
+		probes = append(probes, healthProbe{name: "chain:dev", probe: func(_ context.Context) error { return nil }})
+	} else {
+		probes = append(probes,
+			healthProbe{name: "chain:rest", probe: func(ctx context.Context) error {
+				target, err := url.Parse(p.Config.SourceChain + "/cosmos/base/tendermint/v1beta1/syncing")
+				if err != nil {
+					return err
+				}
+				return probeURL(ctx, client, *target, http.StatusMultipleChoices)
+			}},
+			healthProbe{name: "chain:rpc", probe: func(ctx context.Context) error {
+				target := url.URL{Scheme: "http", Host: p.Config.EventStreamHost, Path: "/health"}
+				return probeURL(ctx, client, target, http.StatusMultipleChoices)
+			}},
+		)
+	}
+	probes = append(probes,
+		healthProbe{name: "claim_store", probe: func(_ context.Context) error {
+			_, err := p.ClaimStore.Get("health")
+			return err
+		}},
+		healthProbe{name: "provider_key", probe: func(_ context.Context) error {
+			if p.Config.ProviderPubKey.IsEmpty() {
+				return fmt.Errorf("provider pubkey is not set")
+			}
+			if p.AutoClaimer == nil || p.AutoClaimer.broadcaster == nil {
+				return errHealthDisabled
+			}
+			checker, ok := p.AutoClaimer.broadcaster.(interface{ CheckKey() error })
+			if !ok {
+				return nil
+			}
+			return checker.CheckKey()
+		}},
+	)
+	return probes
+}
+
+// probeURL request target, any status below maxStatus means the component is up
+func probeURL(ctx context.Context, client *http.Client, target url.URL, maxStatus int) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, target.String(), nil)
+	if err != nil {
+		return fmt.Errorf("fail to create http request: %w", err)
+	}
+	res, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	_ = res.Body.Close()
+	if res.StatusCode >= maxStatus {
+		return fmt.Errorf("unexpected status %d", res.StatusCode)
+	}
+	return nil
+}
+
+func (p Proxy) handleHealth(w http.ResponseWriter, _ *http.Request) {
+	report := p.Health.Check()
+	code := http.StatusOK
+	if report.Status != HealthStatusOK {
+		code = http.StatusServiceUnavailable
+	}
+	respondWithJSON(w, code, report)
+}
@@ -0,0 +1,101 @@
+package sentinel
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/arkeonetwork/arkeo/common"
+	"github.com/arkeonetwork/arkeo/sentinel/conf"
+)
+
+func TestHealth(t *testing.T) {
+	var upstreamCalls int32
+	healthy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&upstreamCalls, 1)
+		// chain rest and rpc probes land here too
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer healthy.Close()
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer failing.Close()
+
+	config := newTestConfig()
+	config.SourceChain = healthy.URL
+	config.EventStreamHost = strings.TrimPrefix(healthy.URL, "http://")
+	config.Health = conf.HealthConfiguration{
+		Probes:       map[string]string{"btc-mainnet-fullnode": "/", "eth-mainnet-fullnode": "/health"},
+		CacheSeconds: 5,
+		TimeoutMs:    1000,
+	}
+	proxy, err := NewProxy(config)
+	require.NoError(t, err)
+	proxy.proxies["btc-mainnet-fullnode"] = common.MustParseURL(healthy.URL)
+	proxy.proxies["eth-mainnet-fullnode"] = common.MustParseURL(failing.URL)
+	router := proxy.getRouter()
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, RoutesHealth, nil))
+	require.Equal(t, http.StatusServiceUnavailable, w.Code)
+	var report HealthReport
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &report))
+	require.Equal(t, HealthStatusDown, report.Status)
+	statuses := make(map[string]ComponentHealth)
+	for _, component := range report.Components {
+		statuses[component.Name] = component
+	}
+	require.Equal(t, HealthStatusOK, statuses["upstream:btc-mainnet-fullnode"].Status)
+	require.Equal(t, HealthStatusDown, statuses["upstream:eth-mainnet-fullnode"].Status)
+	require.Contains(t, statuses["upstream:eth-mainnet-fullnode"].Error, "502")
+	require.Equal(t, HealthStatusOK, statuses["chain:rest"].Status)
+	require.Equal(t, HealthStatusOK, statuses["chain:rpc"].Status)
+	require.Equal(t, HealthStatusOK, statuses["claim_store"].Status)
+	require.Equal(t, HealthStatusDisabled, statuses["provider_key"].Status)
+	calls := atomic.LoadInt32(&upstreamCalls)
+	require.Equal(t, int32(3), calls)
+
+	// cached, the upstreams are not probed again
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, RoutesHealth, nil))
+	require.Equal(t, http.StatusServiceUnavailable, w.Code)
+	require.Equal(t, calls, atomic.LoadInt32(&upstreamCalls))
+
+	// back up once the cache expire
+	proxy.proxies["eth-mainnet-fullnode"] = common.MustParseURL(healthy.URL)
+	proxy.Health.now = func() time.Time { return time.Now().Add(time.Minute) }
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, RoutesHealth, nil))
+	require.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestHealthTimeout(t *testing.T) {
+	slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(5 * time.Second):
+		}
+	}))
+	defer slow.Close()
+
+	// a probe timeout above the budget is capped to it
+	checker := NewHealthChecker(conf.HealthConfiguration{TimeoutMs: 100, ProbeTimeoutMs: 10000}, []healthProbe{
+		{name: "slow", probe: func(ctx context.Context) error {
+			return probeURL(ctx, http.DefaultClient, *common.MustParseURL(slow.URL), http.StatusInternalServerError)
+		}},
+	})
+	require.Equal(t, 100*time.Millisecond, checker.probeTimeout)
+	start := time.Now()
+	report := checker.Check()
+	require.Less(t, time.Since(start), time.Second)
+	require.Equal(t, HealthStatusDown, report.Status)
+	require.Equal(t, HealthStatusDown, report.Components[0].Status)
+}
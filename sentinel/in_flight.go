@@ -0,0 +1,119 @@
+package sentinel
+
+import (
+	"net/http"
+	"sync"
+)
+
+const (
+	// InFlightLimitSentinel is the limit of the requests served at once across the sentinel
+	InFlightLimitSentinel = "sentinel"
+	// InFlightLimitContract is the limit of the requests served at once per contract
+	InFlightLimitContract = "contract"
+)
+
+// InFlightLimiter count the requests being served, across the sentinel and per contract, so a client opening many
+// requests at once can't take the upstream connections the others need. Requests over a limit are refused rather than
+// queued. Websocket sessions and grpc streams hold their slot until they close
+type InFlightLimiter struct {
+	lock      sync.Mutex
+	total     int
+	contracts map[uint64]int // contracts with requests in flight only
+}
+
+// InFlightExceeded is the body returned to a client refused for having, or the sentinel serving, too many requests
+// at once
+type InFlightExceeded struct {
+	Error       string `json:"error"`
+	Limit       string `json:"limit"` // sentinel or contract
+	MaxInFlight int    `json:"max_in_flight"`
+	ContractId  uint64 `json:"contract_id,omitempty"`
+}
+
+func NewInFlightLimiter() *InFlightLimiter {
+	return &InFlightLimiter{
+		contracts: make(map[uint64]int),
+	}
+}
+
+// Acquire take one of the maxInFlight slots of the sentinel, false when they are all taken. A limit that isn't
+// positive is no limit, the request is still counted
+func (l *InFlightLimiter) Acquire(maxInFlight int) bool {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	if maxInFlight > 0 && l.total >= maxInFlight {
+		return false
+	}
+	l.total++
+	return true
+}
+
+// Release free the slot of a request served
+func (l *InFlightLimiter) Release() {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	l.total--
+}
+
+// AcquireContract take one of the maxInFlight slots of the contract, false when they are all taken
+func (l *InFlightLimiter) AcquireContract(contractId uint64, maxInFlight int) bool {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	if maxInFlight > 0 && l.contracts[contractId] >= maxInFlight {
+		return false
+	}
+	l.contracts[contractId]++
+	return true
+}
+
+// ReleaseContract free the slot of a request of the contract served
+func (l *InFlightLimiter) ReleaseContract(contractId uint64) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	if l.contracts[contractId] <= 1 {
+		delete(l.contracts, contractId)
+		return
+	}
+	l.contracts[contractId]--
+}
+
+// InFlight return the requests being served across the sentinel
+func (l *InFlightLimiter) InFlight() int {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	return l.total
+}
+
+// ContractsInFlight return the requests being served per contract, for the contracts with requests in flight
+func (l *InFlightLimiter) ContractsInFlight() map[uint64]int {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	contracts := make(map[uint64]int, len(l.contracts))
+	for id, count := range l.contracts {
+		contracts[id] = count
+	}
+	return contracts
+}
+
+// limitInFlight refuse the requests over the sentinel wide ceiling before anything else is done with them
+func (p Proxy) limitInFlight(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !p.InFlight.Acquire(p.Config.MaxInFlight) {
+			p.Metrics.inFlightRejected(InFlightLimitSentinel)
+			respondWithInFlightExceeded(w, InFlightLimitSentinel, p.Config.MaxInFlight, 0)
+			return
+		}
+		defer p.InFlight.Release()
+		next.ServeHTTP(w, r)
+	})
+}
+
+func respondWithInFlightExceeded(w http.ResponseWriter, limit string, maxInFlight int, contractId uint64) {
+	w.Header().Set("Retry-After", "1")
+	respondWithJSON(w, http.StatusTooManyRequests, InFlightExceeded{
+		Error:       "too many requests in flight",
+		Limit:       limit,
+		MaxInFlight: maxInFlight,
+		ContractId:  contractId,
+	})
+}
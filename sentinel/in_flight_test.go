@@ -0,0 +1,105 @@
+package sentinel
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/arkeonetwork/arkeo/common"
+	"github.com/arkeonetwork/arkeo/sentinel/conf"
+)
+
+func TestInFlightLimits(t *testing.T) {
+	// the upstream holds the requests until they are released
+	arrived := make(chan struct{}, 10)
+	release := make(chan struct{})
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		arrived <- struct{}{}
+		<-release
+		_, _ = w.Write([]byte("{}"))
+	}))
+	defer upstream.Close()
+
+	config := newTestConfig()
+	config.MaxInFlight = 3
+	config.ContractMaxInFlight = 2
+	config.Services = map[string]conf.ServiceConfiguration{
+		"btc-mainnet-fullnode": {Upstream: upstream.URL},
+	}
+	proxy, err := NewProxy(config)
+	require.NoError(t, err)
+	busy := newWebsocketContract(1, 1000, 1000)
+	quiet := newWebsocketContract(2, 1000, 1000)
+	proxy.MemStore.SetHeight(10)
+	proxy.MemStore.Put(busy)
+	proxy.MemStore.Put(quiet)
+	router := proxy.getRouter()
+
+	serve := func(contractId uint64, nonce int) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		path := fmt.Sprintf("/%s?%s=%d:%d", common.BTCService, QueryArkAuth, contractId, nonce)
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+		return w
+	}
+	var wg sync.WaitGroup
+	codes := make(chan int, 10)
+	hold := func(contractId uint64, nonce int) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			codes <- serve(contractId, nonce).Code
+		}()
+		select {
+		case <-arrived:
+		case <-time.After(5 * time.Second):
+			t.Fatal("request didn't reach the upstream")
+		}
+	}
+	exceeded := func(w *httptest.ResponseRecorder) InFlightExceeded {
+		require.Equal(t, http.StatusTooManyRequests, w.Code)
+		require.Equal(t, "1", w.Header().Get("Retry-After"))
+		var body InFlightExceeded
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+		return body
+	}
+
+	// the busy contract takes its two slots, its next request is refused without being charged
+	hold(busy.Id, 1)
+	hold(busy.Id, 2)
+	body := exceeded(serve(busy.Id, 3))
+	require.Equal(t, InFlightExceeded{Error: "too many requests in flight", Limit: InFlightLimitContract, MaxInFlight: 2, ContractId: busy.Id}, body)
+	claim, err := proxy.ClaimStore.Get(busy.Key())
+	require.NoError(t, err)
+	require.EqualValues(t, 2, claim.Nonce)
+
+	// the other contract is still served
+	hold(quiet.Id, 1)
+	require.Equal(t, 3, proxy.InFlight.InFlight())
+	require.Equal(t, map[uint64]int{busy.Id: 2, quiet.Id: 1}, proxy.InFlight.ContractsInFlight())
+
+	// until the sentinel ceiling is reached, for every client
+	body = exceeded(serve(quiet.Id, 2))
+	require.Equal(t, InFlightLimitSentinel, body.Limit)
+	require.Equal(t, 3, body.MaxInFlight)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, fmt.Sprintf("/%s", common.BTCService), nil))
+	require.Equal(t, InFlightLimitSentinel, exceeded(w).Limit)
+
+	close(release)
+	wg.Wait()
+	close(codes)
+	for code := range codes {
+		require.Equal(t, http.StatusOK, code)
+	}
+	require.Zero(t, proxy.InFlight.InFlight())
+	require.Empty(t, proxy.InFlight.ContractsInFlight())
+
+	// the slots are free again
+	require.Equal(t, http.StatusOK, serve(busy.Id, 4).Code)
+}
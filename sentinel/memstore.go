@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"strconv"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/cometbft/cometbft/libs/log"
@@ -19,14 +20,21 @@ import (
 
 var ModuleBasics = module.NewBasicManager()
 
-// TODO: this should receive events from arkeo chain to update its database
-// TODO: clean up contracts from memory after they expire
+// ContractFetcher fetch a contract from the chain by its id
+type ContractFetcher interface {
+	FetchContract(key string) (types.Contract, error)
+}
+
+// MemStore cache the contracts of the provider. It is kept up to date by the chain events, contracts missing from the
+// cache are fetched from the chain and the whole cache is reconciled with the chain periodically
 type MemStore struct {
 	storeLock   *sync.Mutex
 	db          map[string]types.Contract
+	versions    map[string]uint64 // bumped on every change of a contract, so a fetch doesn't overwrite a newer event
 	client      http.Client
 	baseURL     string
-	blockHeight int64
+	chain       ContractFetcher // replace the rest api of the chain when set, with the fake chain of the dev mode
+	blockHeight atomic.Int64
 	logger      log.Logger
 }
 
@@ -34,6 +42,7 @@ func NewMemStore(baseURL string, logger log.Logger) *MemStore {
 	return &MemStore{
 		storeLock: &sync.Mutex{},
 		db:        make(map[string]types.Contract),
+		versions:  make(map[string]uint64),
 		client: http.Client{
 			Timeout: 10 * time.Second,
 		},
@@ -42,48 +51,114 @@ func NewMemStore(baseURL string, logger log.Logger) *MemStore {
 	}
 }
 
+// UseChain fetch the contracts from chain instead of the rest api
+func (k *MemStore) UseChain(chain ContractFetcher) {
+	k.chain = chain
+}
+
 func (k *MemStore) Key(pubkey, service, spender string) string {
 	return fmt.Sprintf("%s/%s/%s", pubkey, service, spender)
 }
 
 func (k *MemStore) GetHeight() int64 {
-	return k.blockHeight
+	return k.blockHeight.Load()
 }
 
 func (k *MemStore) SetHeight(height int64) {
-	k.blockHeight = height
+	k.blockHeight.Store(height)
 }
 
 func (k *MemStore) Get(key string) (types.Contract, error) {
 	k.storeLock.Lock()
-	defer k.storeLock.Unlock()
 	contract, ok := k.db[key]
-	// contract is not in cache or contract expired , fetch it
-	if !ok || contract.IsExpired(k.blockHeight) {
-		crtUpStream, err := k.fetchContract(key)
-		if err != nil {
-			return crtUpStream, err
-		}
-		if !crtUpStream.IsExpired(k.blockHeight) {
-			k.db[key] = crtUpStream
+	version := k.versions[key]
+	k.storeLock.Unlock()
+	// contract still valid
+	if ok && !contract.IsExpired(k.GetHeight()) {
+		return contract, nil
+	}
+	// contract is not in cache or contract expired, fetch it without holding up the other requests
+	crtUpStream, err := k.fetchContract(key)
+	if err != nil {
+		return crtUpStream, err
+	}
+	k.storeLock.Lock()
+	defer k.storeLock.Unlock()
+	if k.versions[key] != version {
+		// an event changed the contract while it was fetched, it is more recent
+		if contract, ok := k.db[key]; ok {
+			return contract, nil
 		}
 		return crtUpStream, nil
 	}
-	// contract still valid
-	return contract, nil
+	if !crtUpStream.IsExpired(k.GetHeight()) {
+		k.db[key] = crtUpStream
+		k.versions[key]++
+	}
+	return crtUpStream, nil
 }
 
 func (k *MemStore) Put(contract types.Contract) {
 	k.storeLock.Lock()
 	defer k.storeLock.Unlock()
 	key := contract.Key()
-	if contract.IsExpired(k.blockHeight) {
+	k.versions[key]++
+	if contract.IsExpired(k.GetHeight()) {
 		delete(k.db, key)
 		return
 	}
 	k.db[key] = contract
 }
 
+// Refresh replace a cached contract with its state on chain and return whether it was removed, once it can no longer
+// be used. The cached contract is kept when the chain can't be reached, or an event changed it while it was fetched
+func (k *MemStore) Refresh(key string) (bool, error) {
+	k.storeLock.Lock()
+	version := k.versions[key]
+	k.storeLock.Unlock()
+	contract, err := k.fetchContract(key)
+	if err != nil {
+		return false, err
+	}
+	k.storeLock.Lock()
+	defer k.storeLock.Unlock()
+	if k.versions[key] != version {
+		return false, nil
+	}
+	k.versions[key]++
+	if contract.IsExpired(k.GetHeight()) {
+		_, cached := k.db[key]
+		delete(k.db, key)
+		return cached, nil
+	}
+	k.db[key] = contract
+	return false, nil
+}
+
+// Keys return the keys of the cached contracts
+func (k *MemStore) Keys() []string {
+	k.storeLock.Lock()
+	defer k.storeLock.Unlock()
+	keys := make([]string, 0, len(k.db))
+	for key := range k.db {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// List return the cached contracts that are not expired
+func (k *MemStore) List() []types.Contract {
+	k.storeLock.Lock()
+	defer k.storeLock.Unlock()
+	contracts := make([]types.Contract, 0, len(k.db))
+	for _, contract := range k.db {
+		if !contract.IsExpired(k.GetHeight()) {
+			contracts = append(contracts, contract)
+		}
+	}
+	return contracts
+}
+
 func (k *MemStore) GetActiveContract(provider common.PubKey, service common.Service, spender common.PubKey) (types.Contract, error) {
 	k.storeLock.Lock()
 	defer k.storeLock.Unlock()
@@ -100,6 +175,9 @@ func (k *MemStore) GetActiveContract(provider common.PubKey, service common.Serv
 
 func (k *MemStore) fetchContract(key string) (types.Contract, error) {
 	// TODO: this should cache a "miss" for 5 seconds, to stop DoS/thrashing
+	if k.chain != nil {
+		return k.chain.FetchContract(key)
+	}
 	var contract types.Contract
 
 	type fetchContract struct {
@@ -118,6 +196,7 @@ func (k *MemStore) fetchContract(key string) (types.Contract, error) {
 		SettlementHeight string                      `protobuf:"varint,12,opt,name=settlement_height,json=settlementHeight,proto3" json:"settlement_height,omitempty"`
 		Authorization    types.ContractAuthorization `protobuf:"varint,15,opt,name=authorization,proto3,enum=arkeo.arkeo.ContractAuthorization" json:"authorization,omitempty"`
 		QueriesPerMinute string                      `protobuf:"varint,16,opt,name=queries_per_minute,json=queriesPerMinute,proto3" json:"queries_per_minute,omitempty"`
+		StartHeight      string                      `protobuf:"varint,21,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
 	}
 
 	type fetch struct {
@@ -168,6 +247,7 @@ func (k *MemStore) fetchContract(key string) (types.Contract, error) {
 	contract.SettlementHeight, _ = strconv.ParseInt(data.Contract.SettlementHeight, 10, 64)
 	contract.Authorization = data.Contract.Authorization
 	contract.QueriesPerMinute, _ = strconv.ParseInt(data.Contract.QueriesPerMinute, 10, 64)
+	contract.StartHeight, _ = strconv.ParseInt(data.Contract.StartHeight, 10, 64)
 
 	return contract, nil
 }
@@ -9,11 +9,19 @@ var Version = "0.0.0"
 type Metadata struct {
 	Configuration conf.Configuration `json:"config"`
 	Version       string             `json:"version"`
+	URL           string             `json:"url,omitempty"`   // public url of the sentinel, https when tls is enabled
+	Chain         *ChainSnapshot     `json:"chain,omitempty"` // provider terms as registered on chain
+	Services      []string           `json:"services"`        // services served by the sentinel
+	// FreePaths are the path patterns of each service served without a contract
+	FreePaths map[string][]string `json:"free_paths,omitempty"`
+	// Dev is the watermark of a sentinel running in dev mode against a fake chain, its contracts aren't real
+	Dev string `json:"dev,omitempty"`
 }
 
 func NewMetadata(config conf.Configuration) Metadata {
 	return Metadata{
 		Version:       Version,
 		Configuration: config,
+		URL:           publicURL(config),
 	}
 }
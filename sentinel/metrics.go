@@ -0,0 +1,500 @@
+package sentinel
+
+import (
+	"bufio"
+	"fmt"
+	"math/big"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+)
+
+const (
+	metricsNamespace = "arkeo"
+	metricsSubsystem = "sentinel"
+
+	// metricsOtherContract is the label of the requests of the contracts past the tracked ones
+	metricsOtherContract = "other"
+	// metricsUnknownService is the label of the requests to a service the sentinel doesn't proxy
+	metricsUnknownService = "unknown"
+
+	AutoClaimResultSubmitted = "submitted"
+	AutoClaimResultFailed    = "failed"
+	AutoClaimResultDryRun    = "dry_run"
+)
+
+// Metrics is the prometheus instrumentation of the sentinel. It has its own registry, served on the admin listener
+// only, rather than the default one
+type Metrics struct {
+	registry           *prometheus.Registry
+	requests           *prometheus.CounterVec
+	requestDuration    *prometheus.HistogramVec
+	contractRequests   *prometheus.CounterVec
+	freeTierRejections prometheus.Counter
+	inFlightRejections *prometheus.CounterVec
+	upstreamRetries    *prometheus.CounterVec
+	breakerRejections  *prometheus.CounterVec
+	freePathRejections *prometheus.CounterVec
+	upstreamConns      *prometheus.CounterVec
+	autoClaims         *prometheus.CounterVec
+	arkAuthRequests    *prometheus.CounterVec
+	cacheRequests      *prometheus.CounterVec
+	awaitingSettlement prometheus.Gauge
+	counterStoreErrors prometheus.Counter
+
+	// contracts labelled in contractRequests, at most maxContracts of them so the cardinality stays bounded
+	lock         sync.Mutex
+	contracts    map[uint64]struct{}
+	maxContracts int
+}
+
+func NewMetrics(maxContracts int, claims ClaimStorage, contracts *MemStore, inFlight *InFlightLimiter) *Metrics {
+	m := &Metrics{
+		registry: prometheus.NewRegistry(),
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "requests_total",
+			Help:      "proxied requests by service and result class",
+		}, []string{"service", "result"}),
+		requestDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "request_duration_seconds",
+			Help:      "time to serve proxied requests by service and result class",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"service", "result"}),
+		contractRequests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "contract_requests_total",
+			Help:      "paid requests by contract, the contracts past the tracked ones are counted as other",
+		}, []string{"contract"}),
+		freeTierRejections: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "free_tier_rejections_total",
+			Help:      "free tier requests rejected for being over the allowance",
+		}),
+		inFlightRejections: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "in_flight_rejections_total",
+			Help:      "requests rejected for being over the in flight limit, sentinel or contract",
+		}, []string{"limit"}),
+		upstreamRetries: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "upstream_retries_total",
+			Help:      "requests sent again to the upstream after a transient failure by service",
+		}, []string{"service"}),
+		breakerRejections: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "breaker_rejections_total",
+			Help:      "requests rejected while the circuit breaker of the service is open by service",
+		}, []string{"service"}),
+		freePathRejections: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "free_path_rejections_total",
+			Help:      "free path requests rejected for being over the anonymous rate limit by service",
+		}, []string{"service"}),
+		upstreamConns: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "upstream_connections_total",
+			Help:      "connections the upstream requests were sent on by service, reused from the pool or newly dialed",
+		}, []string{"service", "reused"}),
+		autoClaims: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "auto_claims_total",
+			Help:      "claims submitted by the auto claimer by result",
+		}, []string{"result"}),
+		arkAuthRequests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "arkauth_requests_total",
+			Help:      "paid requests by arkauth version, to follow the clients left on v1",
+		}, []string{"version"}),
+		cacheRequests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "cache_requests_total",
+			Help:      "requests to the services caching their responses by service, answered from the cache or not",
+		}, []string{"service", "result"}),
+		awaitingSettlement: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "contracts_awaiting_settlement",
+			Help:      "expired pay as you go contracts whose final claim isn't on chain yet",
+		}),
+		counterStoreErrors: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "rate_limit_store_failures_total",
+			Help:      "failures of the shared rate limit store, the limiters count in memory for a while after each",
+		}),
+		contracts:    make(map[uint64]struct{}),
+		maxContracts: maxContracts,
+	}
+	m.registry.MustRegister(
+		m.requests,
+		m.requestDuration,
+		m.contractRequests,
+		m.freeTierRejections,
+		m.inFlightRejections,
+		m.upstreamRetries,
+		m.breakerRejections,
+		m.freePathRejections,
+		m.upstreamConns,
+		m.autoClaims,
+		m.arkAuthRequests,
+		m.cacheRequests,
+		m.awaitingSettlement,
+		m.counterStoreErrors,
+		newClaimCollector(claims, contracts),
+		newInFlightCollector(inFlight),
+	)
+	return m
+}
+
+// Handler serve the metrics in the prometheus exposition format
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{})
+}
+
+// serveAdmin expose the sentinel prometheus metrics and the admin endpoints on the admin address
+func (p Proxy) serveAdmin() {
+	p.logger.Info("serving metrics", "address", p.Config.MetricsListenAddr)
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", p.Metrics.Handler())
+	mux.HandleFunc(RoutesAdminReload, p.handleReload)
+	mux.HandleFunc(RoutesAdminCompact, p.handleCompactClaims)
+	mux.HandleFunc(RoutesAdminContracts, p.handleAdminContracts)
+	mux.HandleFunc(RoutesAdminClaims, p.handleAdminClaims)
+	mux.HandleFunc(RoutesAdminLimits, p.handleAdminLimits)
+	mux.HandleFunc(RoutesAdminSubmit, p.handleAdminSubmitClaim)
+	mux.HandleFunc(RoutesAdminExport, p.handleAdminExportClaims)
+	mux.HandleFunc(RoutesAdminRequests, p.handleAdminRequests)
+	server := &http.Server{
+		Addr:              p.Config.MetricsListenAddr,
+		Handler:           mux,
+		ReadHeaderTimeout: time.Second,
+	}
+	if err := server.ListenAndServe(); err != nil {
+		p.logger.Error("fail to serve admin endpoints", "error", err)
+	}
+}
+
+// observeRequest count and time a proxied request, by the class of its status
+func (m *Metrics) observeRequest(service string, status int, duration time.Duration) {
+	if m == nil {
+		return
+	}
+	result := fmt.Sprintf("%dxx", status/100)
+	m.requests.WithLabelValues(service, result).Inc()
+	m.requestDuration.WithLabelValues(service, result).Observe(duration.Seconds())
+}
+
+// contractRequest count a paid request of the contract
+func (m *Metrics) contractRequest(contractId uint64) {
+	if m == nil {
+		return
+	}
+	m.contractRequests.WithLabelValues(m.contractLabel(contractId)).Inc()
+}
+
+// contractLabel return the label of the contract, other once maxContracts are tracked
+func (m *Metrics) contractLabel(contractId uint64) string {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if _, ok := m.contracts[contractId]; !ok {
+		if len(m.contracts) >= m.maxContracts {
+			return metricsOtherContract
+		}
+		m.contracts[contractId] = struct{}{}
+	}
+	return strconv.FormatUint(contractId, 10)
+}
+
+// removeContract drop the series of a closed contract, freeing its slot
+func (m *Metrics) removeContract(contractId uint64) {
+	if m == nil {
+		return
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if _, ok := m.contracts[contractId]; ok {
+		delete(m.contracts, contractId)
+		m.contractRequests.DeleteLabelValues(strconv.FormatUint(contractId, 10))
+	}
+}
+
+// freeTierRejected count a free tier request over the allowance
+func (m *Metrics) freeTierRejected() {
+	if m == nil {
+		return
+	}
+	m.freeTierRejections.Inc()
+}
+
+// inFlightRejected count a request over one of the in flight limits
+func (m *Metrics) inFlightRejected(limit string) {
+	if m == nil {
+		return
+	}
+	m.inFlightRejections.WithLabelValues(limit).Inc()
+}
+
+// upstreamRetried count a request sent again to the upstream of a service
+func (m *Metrics) upstreamRetried(service string) {
+	if m == nil {
+		return
+	}
+	m.upstreamRetries.WithLabelValues(service).Inc()
+}
+
+// breakerRejected count a request refused by the open circuit breaker of a service
+func (m *Metrics) breakerRejected(service string) {
+	if m == nil {
+		return
+	}
+	m.breakerRejections.WithLabelValues(service).Inc()
+}
+
+// freePathRejected count a free path request refused by the anonymous rate limit of a service
+func (m *Metrics) freePathRejected(service string) {
+	if m == nil {
+		return
+	}
+	m.freePathRejections.WithLabelValues(service).Inc()
+}
+
+// cacheRequest count a request to a service caching its responses, a hit or a miss
+func (m *Metrics) cacheRequest(service string, hit bool) {
+	if m == nil {
+		return
+	}
+	result := "miss"
+	if hit {
+		result = "hit"
+	}
+	m.cacheRequests.WithLabelValues(service, result).Inc()
+}
+
+// upstreamConnection count a connection an upstream request was sent on, reused or new
+func (m *Metrics) upstreamConnection(service string, reused bool) {
+	if m == nil {
+		return
+	}
+	m.upstreamConns.WithLabelValues(service, strconv.FormatBool(reused)).Inc()
+}
+
+// autoClaim count a claim handled by the auto claimer
+func (m *Metrics) autoClaim(result string) {
+	if m == nil {
+		return
+	}
+	m.autoClaims.WithLabelValues(result).Inc()
+}
+
+// setAwaitingSettlement set the number of contracts whose final claim is awaited
+func (m *Metrics) setAwaitingSettlement(count int) {
+	if m == nil {
+		return
+	}
+	m.awaitingSettlement.Set(float64(count))
+}
+
+// counterStoreFailed count a failure of the shared rate limit store
+func (m *Metrics) counterStoreFailed() {
+	if m == nil {
+		return
+	}
+	m.counterStoreErrors.Inc()
+}
+
+// arkAuthRequest count a paid request by the version of its arkauth
+func (m *Metrics) arkAuthRequest(version int) {
+	if m == nil {
+		return
+	}
+	m.arkAuthRequests.WithLabelValues(strconv.Itoa(version)).Inc()
+}
+
+// claimCollector compute the active contracts and the pending claims at scrape time, from the contracts the sentinel
+// already knows about
+type claimCollector struct {
+	claims          ClaimStorage
+	contracts       *MemStore
+	activeContracts *prometheus.Desc
+	pendingClaims   *prometheus.Desc
+	pendingAmount   *prometheus.Desc
+}
+
+func newClaimCollector(claims ClaimStorage, contracts *MemStore) *claimCollector {
+	return &claimCollector{
+		claims:    claims,
+		contracts: contracts,
+		activeContracts: prometheus.NewDesc(prometheus.BuildFQName(metricsNamespace, metricsSubsystem, "active_contracts"),
+			"contracts of the provider that are not expired", nil, nil),
+		pendingClaims: prometheus.NewDesc(prometheus.BuildFQName(metricsNamespace, metricsSubsystem, "pending_claims"),
+			"signed claims not claimed on chain yet", nil, nil),
+		pendingAmount: prometheus.NewDesc(prometheus.BuildFQName(metricsNamespace, metricsSubsystem, "pending_claim_amount"),
+			"income that can be claimed with the pending claims by denom", []string{"denom"}, nil),
+	}
+}
+
+func (c *claimCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.activeContracts
+	ch <- c.pendingClaims
+	ch <- c.pendingAmount
+}
+
+func (c *claimCollector) Collect(ch chan<- prometheus.Metric) {
+	height := c.contracts.GetHeight()
+	contracts := make(map[uint64]types.Contract)
+	for _, contract := range c.contracts.List() {
+		contracts[contract.Id] = contract
+	}
+	ch <- prometheus.MustNewConstMetric(c.activeContracts, prometheus.GaugeValue, float64(len(contracts)))
+
+	pending := 0
+	amounts := make(map[string]float64)
+	for _, claim := range c.claims.List() {
+		if claim.Claimed || claim.Signature == "" {
+			continue
+		}
+		pending++
+		contract, ok := contracts[claim.ContractId]
+		if !ok {
+			continue
+		}
+		income := pendingIncome(contract, claim, height)
+		if income.Amount.IsPositive() {
+			amount, _ := new(big.Float).SetInt(income.Amount.BigInt()).Float64()
+			amounts[income.Denom] += amount
+		}
+	}
+	ch <- prometheus.MustNewConstMetric(c.pendingClaims, prometheus.GaugeValue, float64(pending))
+	for denom, amount := range amounts {
+		ch <- prometheus.MustNewConstMetric(c.pendingAmount, prometheus.GaugeValue, amount, denom)
+	}
+}
+
+// inFlightCollector report the requests being served at scrape time, per contract for the contracts with requests in
+// flight, so the series come and go with them
+type inFlightCollector struct {
+	inFlight         *InFlightLimiter
+	requests         *prometheus.Desc
+	contractRequests *prometheus.Desc
+}
+
+func newInFlightCollector(inFlight *InFlightLimiter) *inFlightCollector {
+	return &inFlightCollector{
+		inFlight: inFlight,
+		requests: prometheus.NewDesc(prometheus.BuildFQName(metricsNamespace, metricsSubsystem, "in_flight_requests"),
+			"requests being served", nil, nil),
+		contractRequests: prometheus.NewDesc(prometheus.BuildFQName(metricsNamespace, metricsSubsystem, "contract_in_flight_requests"),
+			"paid requests being served by contract", []string{"contract"}, nil),
+	}
+}
+
+func (c *inFlightCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.requests
+	ch <- c.contractRequests
+}
+
+func (c *inFlightCollector) Collect(ch chan<- prometheus.Metric) {
+	ch <- prometheus.MustNewConstMetric(c.requests, prometheus.GaugeValue, float64(c.inFlight.InFlight()))
+	for id, count := range c.inFlight.ContractsInFlight() {
+		ch <- prometheus.MustNewConstMetric(c.contractRequests, prometheus.GaugeValue, float64(count), strconv.FormatUint(id, 10))
+	}
+}
+
+// instrument count and time the requests to the proxied services
+func (p Proxy) instrument(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// the service is read before the request is rewritten for the upstream
+		service := p.metricsService(r)
+		start := time.Now()
+		sw := &statusWriter{ResponseWriter: w}
+		next.ServeHTTP(sw, r)
+		p.Metrics.observeRequest(service, sw.Status(), time.Since(start))
+	})
+}
+
+// metricsService return the service a request is for, services the sentinel doesn't proxy share one label
+func (p Proxy) metricsService(r *http.Request) string {
+	service := requestService(r)
+	if _, ok := p.proxies[service]; !ok {
+		return metricsUnknownService
+	}
+	return service
+}
+
+// statusWriter record the status of the response, websocket upgrades and streaming responses go through it
+type statusWriter struct {
+	http.ResponseWriter
+	status  int
+	written int64 // bytes of the body written, not counting hijacked connections
+}
+
+func (w *statusWriter) WriteHeader(code int) {
+	if w.status == 0 {
+		w.status = code
+	}
+	w.ResponseWriter.WriteHeader(code)
+}
+
+func (w *statusWriter) Write(b []byte) (int, error) {
+	if w.status == 0 {
+		w.status = http.StatusOK
+	}
+	n, err := w.ResponseWriter.Write(b)
+	w.written += int64(n)
+	return n, err
+}
+
+func (w *statusWriter) Flush() {
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+func (w *statusWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	hijacker, ok := w.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, fmt.Errorf("response writer doesn't support hijacking")
+	}
+	if w.status == 0 {
+		w.status = http.StatusSwitchingProtocols
+	}
+	return hijacker.Hijack()
+}
+
+func (w *statusWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+// Status return the status of the response, 200 when nothing was written
+func (w *statusWriter) Status() int {
+	if w.status == 0 {
+		return http.StatusOK
+	}
+	return w.status
+}
+
+var (
+	_ http.Flusher  = &statusWriter{}
+	_ http.Hijacker = &statusWriter{}
+)
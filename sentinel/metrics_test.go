@@ -0,0 +1,82 @@
+package sentinel
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/arkeonetwork/arkeo/common"
+)
+
+func TestMetricsScrape(t *testing.T) {
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("{}"))
+	}))
+	defer upstream.Close()
+
+	config := newTestConfig()
+	config.FreeTierRateLimit = 1
+	config.MetricsMaxContracts = 1
+	proxy, err := NewProxy(config)
+	require.NoError(t, err)
+	proxy.proxies[common.BTCService.String()] = common.MustParseURL(upstream.URL)
+	proxy.MemStore.SetHeight(10)
+	first := newWebsocketContract(1, 100, 100)
+	second := newWebsocketContract(2, 100, 100)
+	proxy.MemStore.Put(first)
+	proxy.MemStore.Put(second)
+	server := httptest.NewServer(proxy.getRouter())
+	defer server.Close()
+
+	get := func(path string) int {
+		resp, err := http.Get(server.URL + path)
+		require.NoError(t, err)
+		_ = resp.Body.Close()
+		return resp.StatusCode
+	}
+	for nonce := 1; nonce <= 2; nonce++ {
+		require.Equal(t, http.StatusOK, get(fmt.Sprintf("/%s?%s=%d:%d", common.BTCService, QueryArkAuth, first.Id, nonce)))
+	}
+	require.Equal(t, http.StatusOK, get(fmt.Sprintf("/%s?%s=%d:1", common.BTCService, QueryArkAuth, second.Id)))
+	// free tier, the second request is over the allowance
+	require.Equal(t, http.StatusOK, get(fmt.Sprintf("/%s", common.BTCService)))
+	require.Equal(t, http.StatusTooManyRequests, get(fmt.Sprintf("/%s", common.BTCService)))
+	require.Equal(t, http.StatusUnauthorized, get(fmt.Sprintf("/not-a-service?%s=%d:3", QueryArkAuth, first.Id)))
+
+	claim := NewClaim(first.Id, first.GetSpender(), 5, "signature")
+	require.NoError(t, proxy.ClaimStore.Set(claim))
+
+	// the metrics are only served by the admin handler, the public port treat it as a free tier request
+	require.Equal(t, http.StatusTooManyRequests, get("/metrics"))
+	metricsServer := httptest.NewServer(proxy.Metrics.Handler())
+	defer metricsServer.Close()
+	resp, err := http.Get(metricsServer.URL)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	scrape := string(body)
+
+	for _, line := range []string{
+		`arkeo_sentinel_requests_total{result="2xx",service="btc-mainnet-fullnode"} 4`,
+		`arkeo_sentinel_requests_total{result="4xx",service="btc-mainnet-fullnode"} 1`,
+		`arkeo_sentinel_requests_total{result="4xx",service="unknown"} 2`,
+		`arkeo_sentinel_request_duration_seconds_count{result="2xx",service="btc-mainnet-fullnode"} 4`,
+		`arkeo_sentinel_contract_requests_total{contract="1"} 2`,
+		`arkeo_sentinel_contract_requests_total{contract="other"} 1`,
+		`arkeo_sentinel_free_tier_rejections_total 2`,
+		`arkeo_sentinel_active_contracts 2`,
+		`arkeo_sentinel_pending_claims 1`,
+		`arkeo_sentinel_pending_claim_amount{denom="uarkeo"} 5`,
+	} {
+		require.Contains(t, scrape, line)
+	}
+
+	// a closed contract free its label
+	proxy.Metrics.removeContract(first.Id)
+	require.Equal(t, "2", proxy.Metrics.contractLabel(second.Id))
+}
@@ -0,0 +1,102 @@
+package sentinel
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/arkeonetwork/arkeo/common/cosmos"
+	"github.com/arkeonetwork/arkeo/sentinel/conf"
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+)
+
+// MinDepositRequired is the body returned to a paid request whose contract has less deposit left than the minimum of
+// its service
+type MinDepositRequired struct {
+	Error      string `json:"error"`
+	ContractId uint64 `json:"contract_id"`
+	Remaining  string `json:"remaining"` // deposit left once the queries served so far are paid
+	MinDeposit string `json:"min_deposit"`
+	TopUp      string `json:"top_up"` // deposit missing to be served again
+}
+
+// minDepositError is returned for a contract with less deposit left than the minimum of its service
+type minDepositError struct {
+	contractId uint64
+	remaining  cosmos.Coin
+	minimum    cosmos.Coin
+}
+
+func (e *minDepositError) Error() string {
+	return fmt.Sprintf("contract %d has %s of deposit left, below the minimum of %s", e.contractId, e.remaining, e.minimum)
+}
+
+// parseMinDeposits return the minimum deposits of a service by denom
+func parseMinDeposits(name string, raw []string) (cosmos.Coins, error) {
+	seen := make(map[string]bool, len(raw))
+	coins := make([]cosmos.Coin, 0, len(raw))
+	for _, amount := range raw {
+		coin, err := cosmos.ParseCoin(amount)
+		if err != nil {
+			return nil, fmt.Errorf("invalid min deposit %s of service %s: %w", amount, name, err)
+		}
+		if seen[coin.Denom] {
+			return nil, fmt.Errorf("service %s has several min deposits in %s", name, coin.Denom)
+		}
+		seen[coin.Denom] = true
+		coins = append(coins, coin)
+	}
+	return cosmos.NewCoins(coins...), nil
+}
+
+// newServiceMinDeposits return the minimum deposits of the services that have one, the configuration is validated
+// beforehand
+func newServiceMinDeposits(services map[string]conf.ServiceConfiguration) map[string]cosmos.Coins {
+	minDeposits := make(map[string]cosmos.Coins)
+	for name, service := range services {
+		if coins, err := parseMinDeposits(name, service.MinDeposits); err == nil && !coins.IsZero() {
+			minDeposits[name] = coins
+		}
+	}
+	return minDeposits
+}
+
+// remainingDeposit return the deposit of the contract left once the queries up to nonce, or the blocks up to height
+// for a subscription, are paid
+func remainingDeposit(contract types.Contract, nonce, height int64) cosmos.Coin {
+	remaining := cosmos.ZeroInt()
+	if !contract.Deposit.IsNil() {
+		remaining = contract.Deposit.Sub(contractDebt(contract, nonce, height))
+	}
+	if remaining.IsNegative() {
+		remaining = cosmos.ZeroInt()
+	}
+	return cosmos.Coin{Denom: contract.Rate.Denom, Amount: remaining}
+}
+
+// checkMinDeposit return an error when the contract has less deposit left than the minimum of its service, once the
+// queries up to nonce are paid
+func (p Proxy) checkMinDeposit(contract types.Contract, nonce int64) error {
+	minimum := p.serviceMinDeposits[contract.Service.String()].AmountOfNoDenomValidation(contract.Rate.Denom)
+	if !minimum.IsPositive() {
+		return nil
+	}
+	remaining := remainingDeposit(contract, nonce, p.MemStore.GetHeight())
+	if remaining.Amount.GTE(minimum) {
+		return nil
+	}
+	return &minDepositError{
+		contractId: contract.Id,
+		remaining:  remaining,
+		minimum:    cosmos.Coin{Denom: contract.Rate.Denom, Amount: minimum},
+	}
+}
+
+func respondWithMinDepositRequired(w http.ResponseWriter, err *minDepositError) {
+	respondWithJSON(w, http.StatusPaymentRequired, MinDepositRequired{
+		Error:      err.Error(),
+		ContractId: err.contractId,
+		Remaining:  err.remaining.String(),
+		MinDeposit: err.minimum.String(),
+		TopUp:      cosmos.Coin{Denom: err.minimum.Denom, Amount: err.minimum.Amount.Sub(err.remaining.Amount)}.String(),
+	})
+}
@@ -0,0 +1,93 @@
+package sentinel
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
+
+	"github.com/arkeonetwork/arkeo/common"
+	"github.com/arkeonetwork/arkeo/sentinel/conf"
+)
+
+func TestMinDeposit(t *testing.T) {
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("{}"))
+	}))
+	defer upstream.Close()
+
+	config := newTestConfig()
+	config.Services = map[string]conf.ServiceConfiguration{
+		"btc-mainnet-fullnode": {Upstream: upstream.URL, MinDeposits: []string{"5uarkeo", "1000000000000000000aevmos"}},
+	}
+	proxy, err := NewProxy(config)
+	require.NoError(t, err)
+	contract := newWebsocketContract(1, 100, 10)
+	proxy.MemStore.SetHeight(10)
+	proxy.MemStore.Put(contract)
+	router := proxy.getRouter()
+	serve := func(auth string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		path := fmt.Sprintf("/%s", common.BTCService)
+		if len(auth) > 0 {
+			path = fmt.Sprintf("%s?%s=%s", path, QueryArkAuth, auth)
+		}
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+		return w
+	}
+
+	// the deposit left goes down to the minimum as the queries are claimed
+	for nonce := 1; nonce <= 6; nonce++ {
+		w := serve(fmt.Sprintf("%d:%d", contract.Id, nonce))
+		require.Equal(t, http.StatusOK, w.Code)
+		require.Equal(t, "paid", w.Header().Get("tier"))
+	}
+	w := serve(fmt.Sprintf("%d:7", contract.Id))
+	require.Equal(t, http.StatusPaymentRequired, w.Code)
+	var body MinDepositRequired
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	require.Equal(t, MinDepositRequired{
+		Error:      "contract 1 has 4uarkeo of deposit left, below the minimum of 5uarkeo",
+		ContractId: contract.Id,
+		Remaining:  "4uarkeo",
+		MinDeposit: "5uarkeo",
+		TopUp:      "1uarkeo",
+	}, body)
+
+	// the free tier isn't affected
+	w = serve("")
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, "free", w.Header().Get("tier"))
+
+	// the minimum is lowered without a restart
+	config.Services["btc-mainnet-fullnode"] = conf.ServiceConfiguration{Upstream: upstream.URL, MinDeposits: []string{"4uarkeo"}}
+	_, err = proxy.Reload(config)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, serve(fmt.Sprintf("%d:7", contract.Id)).Code)
+	require.Equal(t, http.StatusPaymentRequired, serve(fmt.Sprintf("%d:8", contract.Id)).Code)
+
+	config.Services["btc-mainnet-fullnode"] = conf.ServiceConfiguration{Upstream: upstream.URL, MinDeposits: []string{"4uarkeo", "6uarkeo"}}
+	_, err = proxy.Reload(config)
+	require.Error(t, err)
+}
+
+func TestWebsocketMinDeposit(t *testing.T) {
+	contract := newWebsocketContract(1, 100, 10)
+	proxy, server := newWebsocketTest(t, contract)
+	proxy.serviceMinDeposits = newServiceMinDeposits(map[string]conf.ServiceConfiguration{
+		common.BTCService.String(): {MinDeposits: []string{"5uarkeo"}},
+	})
+	server.Config.Handler = proxy.getRouter()
+	conn := dialSentinel(t, server, contract.Id)
+
+	// the upgrade request signed nonce 1, the session crosses the minimum after five messages
+	for i := 0; i < 5; i++ {
+		requireEcho(t, conn, fmt.Sprintf("hello %d", i))
+	}
+	require.NoError(t, conn.WriteMessage(websocket.TextMessage, []byte("over")))
+	requireClosed(t, conn, CloseContractSpent)
+}
@@ -0,0 +1,129 @@
+package sentinel
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+var errPathTraversal = errors.New("path climbs out of the service")
+
+// normalizePath rewrite the request to its canonical path and query before it is routed, billed, rate limited and
+// logged, so the variants of a path are matched, charged and logged as one. An invalid path, or one climbing out of
+// the service it is sent to, is refused
+func (p Proxy) normalizePath(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		escaped, err := normalizeRequestPath(r.URL.EscapedPath(), len(r.Header.Get(ServiceHeader)) == 0)
+		if err != nil {
+			respondWithError(w, fmt.Sprintf("bad request path: %s", err), http.StatusBadRequest)
+			return
+		}
+		decoded, err := url.PathUnescape(escaped)
+		if err != nil {
+			respondWithError(w, fmt.Sprintf("bad request path: %s", err), http.StatusBadRequest)
+			return
+		}
+		r.URL.Path, r.URL.RawPath = decoded, escaped
+		if len(r.URL.RawQuery) > 0 {
+			r.URL.RawQuery = r.URL.Query().Encode()
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// normalizeRequestPath return the canonical form of an escaped path: the percent encodings of the unreserved
+// characters decoded and the others upper cased, the repeated slashes collapsed and the dot segments resolved. The
+// trailing slash is kept, some upstreams tell the two apart, the matching ignores it. A dot segment can't climb above
+// the root, nor above the service segment when the service is the first one of the path. Encoded separators stay
+// encoded, a segment decoding to a dot segment through them is refused as the upstream could resolve it
+func normalizeRequestPath(escaped string, serviceInPath bool) (string, error) {
+	decoded, err := decodeUnreserved(escaped)
+	if err != nil {
+		return "", err
+	}
+	raw := strings.Split(decoded, "/")
+	segments := make([]string, 0, len(raw))
+	for _, segment := range raw {
+		switch segment {
+		case "", ".":
+			continue
+		case "..":
+			if len(segments) == 0 || (serviceInPath && len(segments) == 1) {
+				return "", errPathTraversal
+			}
+			segments = segments[:len(segments)-1]
+			continue
+		}
+		if hasEncodedDotSegment(segment) {
+			return "", errPathTraversal
+		}
+		segments = append(segments, segment)
+	}
+	normalized := "/" + strings.Join(segments, "/")
+	if last := raw[len(raw)-1]; len(segments) > 0 && (last == "" || last == "." || last == "..") {
+		normalized += "/"
+	}
+	return normalized, nil
+}
+
+// decodeUnreserved decode the percent encodings of the unreserved characters, RFC 3986 section 2.3, and upper case
+// the hex digits of the others
+func decodeUnreserved(escaped string) (string, error) {
+	if !strings.Contains(escaped, "%") {
+		return escaped, nil
+	}
+	var b strings.Builder
+	b.Grow(len(escaped))
+	for i := 0; i < len(escaped); i++ {
+		if escaped[i] != '%' {
+			b.WriteByte(escaped[i])
+			continue
+		}
+		if i+2 >= len(escaped) || !isHex(escaped[i+1]) || !isHex(escaped[i+2]) {
+			return "", fmt.Errorf("invalid percent encoding at %d", i)
+		}
+		c := unhex(escaped[i+1])<<4 | unhex(escaped[i+2])
+		if isUnreserved(c) {
+			b.WriteByte(c)
+		} else {
+			b.WriteString(fmt.Sprintf("%%%02X", c))
+		}
+		i += 2
+	}
+	return b.String(), nil
+}
+
+// hasEncodedDotSegment return true for a segment holding a dot segment between encoded slashes or backslashes
+func hasEncodedDotSegment(segment string) bool {
+	decoded, err := url.PathUnescape(segment)
+	if err != nil || !strings.ContainsAny(decoded, "/\\") {
+		return false
+	}
+	for _, part := range strings.FieldsFunc(decoded, func(r rune) bool { return r == '/' || r == '\\' }) {
+		if part == "." || part == ".." {
+			return true
+		}
+	}
+	return false
+}
+
+func isUnreserved(c byte) bool {
+	return 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || '0' <= c && c <= '9' || c == '-' || c == '.' || c == '_' || c == '~'
+}
+
+func isHex(c byte) bool {
+	return '0' <= c && c <= '9' || 'a' <= c && c <= 'f' || 'A' <= c && c <= 'F'
+}
+
+func unhex(c byte) byte {
+	switch {
+	case '0' <= c && c <= '9':
+		return c - '0'
+	case 'a' <= c && c <= 'f':
+		return c - 'a' + 10
+	default:
+		return c - 'A' + 10
+	}
+}
@@ -0,0 +1,127 @@
+package sentinel
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/arkeonetwork/arkeo/common"
+	"github.com/arkeonetwork/arkeo/sentinel/conf"
+)
+
+func TestNormalizeRequestPath(t *testing.T) {
+	for _, tc := range []struct {
+		path       string
+		normalized string
+		header     bool // the service is sent in a header, not in the path
+		err        bool
+	}{
+		{path: "/btc-mainnet-fullnode/rest/block", normalized: "/btc-mainnet-fullnode/rest/block"},
+		{path: "", normalized: "/"},
+		{path: "/", normalized: "/"},
+		{path: "//btc-mainnet-fullnode///rest//block", normalized: "/btc-mainnet-fullnode/rest/block"},
+		{path: "/btc-mainnet-fullnode/rest/block/", normalized: "/btc-mainnet-fullnode/rest/block/"},
+		{path: "/btc-mainnet-fullnode/rest/block//", normalized: "/btc-mainnet-fullnode/rest/block/"},
+		{path: "/btc-mainnet-fullnode/./rest/./block/.", normalized: "/btc-mainnet-fullnode/rest/block/"},
+		{path: "/btc-mainnet-fullnode/rest/tx/../block", normalized: "/btc-mainnet-fullnode/rest/block"},
+		{path: "/btc-mainnet-fullnode/rest/block/..", normalized: "/btc-mainnet-fullnode/rest/"},
+		// the unreserved characters are decoded, the others kept encoded and upper cased
+		{path: "/btc-mainnet-fullnode/%72est/%62lock", normalized: "/btc-mainnet-fullnode/rest/block"},
+		{path: "/%62tc-mainnet-fullnode/rest", normalized: "/btc-mainnet-fullnode/rest"},
+		{path: "/btc-mainnet-fullnode/a%7eb%2Dc%5F", normalized: "/btc-mainnet-fullnode/a~b-c_"},
+		{path: "/btc-mainnet-fullnode/a%2fb%3a%20", normalized: "/btc-mainnet-fullnode/a%2Fb%3A%20"},
+		{path: "/btc-mainnet-fullnode/rest/tx/%2e%2e/block", normalized: "/btc-mainnet-fullnode/rest/block"},
+		{path: "/btc-mainnet-fullnode/rest/%2E/block", normalized: "/btc-mainnet-fullnode/rest/block"},
+		{path: "/btc-mainnet-fullnode/%", err: true},
+		{path: "/btc-mainnet-fullnode/%zz", err: true},
+		// a traversal out of the service prefix, plain, encoded or through encoded separators
+		{path: "/btc-mainnet-fullnode/../eth-mainnet-fullnode/rest", err: true},
+		{path: "/btc-mainnet-fullnode/rest/../../eth-mainnet-fullnode", err: true},
+		{path: "/btc-mainnet-fullnode/%2e%2e/eth-mainnet-fullnode", err: true},
+		{path: "/btc-mainnet-fullnode/.%2E", err: true},
+		{path: "/btc-mainnet-fullnode/rest/..%2f..%2fadmin", err: true},
+		{path: "/btc-mainnet-fullnode/rest/..%5C..%5Cadmin", err: true},
+		{path: "/btc-mainnet-fullnode/rest/%2e%2e%2fadmin", err: true},
+		{path: "/../etc/passwd", err: true},
+		// a service sent in a header doesn't take the first segment, the path can't climb above its root though
+		{path: "/rest/../block", normalized: "/block", header: true},
+		{path: "/rest/../../block", header: true, err: true},
+	} {
+		normalized, err := normalizeRequestPath(tc.path, !tc.header)
+		if tc.err {
+			require.Error(t, err, tc.path)
+			continue
+		}
+		require.NoError(t, err, tc.path)
+		require.Equal(t, tc.normalized, normalized, tc.path)
+		// the normalized form is stable
+		again, err := normalizeRequestPath(normalized, !tc.header)
+		require.NoError(t, err, tc.path)
+		require.Equal(t, normalized, again, tc.path)
+	}
+}
+
+func TestNormalizedRequests(t *testing.T) {
+	served := make(chan string, 10)
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		served <- r.URL.EscapedPath()
+	}))
+	defer upstream.Close()
+	config := newTestConfig()
+	config.FreeTierRateLimit = 0
+	config.Services = map[string]conf.ServiceConfiguration{
+		common.BTCService.String(): {
+			Upstream:  upstream.URL,
+			Costs:     []conf.ServiceCost{{Path: "/rest/block/*", Cost: 3}},
+			FreePaths: []string{"/health"},
+		},
+		common.ETHService.String(): {Upstream: upstream.URL},
+	}
+	proxy, err := NewProxy(config)
+	require.NoError(t, err)
+	proxy.MemStore.SetHeight(10)
+	contract := newWebsocketContract(1, 100, 100)
+	proxy.MemStore.Put(contract)
+	router := proxy.getRouter()
+
+	nonce := int64(0)
+	serve := func(path string) *httptest.ResponseRecorder {
+		nonce += 3
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, fmt.Sprintf("%s?z=1&%s=%d:%d&a=2", path, QueryArkAuth, contract.Id, nonce), nil))
+		return w
+	}
+
+	// the variants of a path are charged as the path, and the upstream gets the normalized one
+	for _, path := range []string{
+		"/btc-mainnet-fullnode/rest/block/abc",
+		"/btc-mainnet-fullnode//rest/block/abc/",
+		"/btc-mainnet-fullnode/rest/./tx/../block/abc",
+		"/btc-mainnet-fullnode/%72est/block/%61bc",
+	} {
+		w := serve(path)
+		require.Equal(t, http.StatusOK, w.Code, path)
+		require.Equal(t, "3", w.Header().Get(CostHeader), path)
+		require.Contains(t, []string{"/rest/block/abc", "/rest/block/abc/"}, <-served, path)
+	}
+
+	// as are the free paths
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/btc-mainnet-fullnode//%68ealth/", nil))
+	require.Equal(t, "free_path", w.Header().Get("tier"))
+	<-served
+
+	// a traversal out of the service prefix is refused before it is billed or forwarded
+	w = serve("/btc-mainnet-fullnode/../eth-mainnet-fullnode/rest")
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	require.Contains(t, w.Body.String(), errPathTraversal.Error())
+	w = serve("/btc-mainnet-fullnode/rest/..%2F..%2Fadmin")
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	require.Empty(t, served)
+	claim, err := proxy.ClaimStore.Get(contract.Key())
+	require.NoError(t, err)
+	require.Equal(t, int64(12), claim.Nonce)
+}
@@ -0,0 +1,150 @@
+package sentinel
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/gorilla/mux"
+
+	"github.com/arkeonetwork/arkeo/common"
+	"github.com/arkeonetwork/arkeo/sentinel/conf"
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+)
+
+// QueryProvider select the provider identity of the claims, active contracts and provider data endpoints, by name or
+// pubkey. The identity of PROVIDER_PUBKEY when missing
+const QueryProvider = "provider"
+
+// validateProviders refuse provider identities sharing a name or pubkey, or served for a service the sentinel doesn't
+// serve
+func validateProviders(config conf.Configuration) error {
+	served := make(map[string]bool)
+	for _, name := range serviceNames(loadProxies(config.Services)) {
+		served[name] = true
+	}
+	names := make(map[string]bool)
+	pubkeys := make(map[string]bool)
+	for _, identity := range config.Identities() {
+		if identity.Name != conf.DefaultProviderName && identity.PubKey.IsEmpty() {
+			return fmt.Errorf("provider %s has no pubkey", identity.Name)
+		}
+		if names[identity.Name] {
+			return fmt.Errorf("provider name %s is used twice", identity.Name)
+		}
+		if pubkeys[identity.PubKey.String()] {
+			return fmt.Errorf("provider pubkey %s is used twice", identity.PubKey)
+		}
+		names[identity.Name], pubkeys[identity.PubKey.String()] = true, true
+		for _, service := range identity.Services {
+			if !served[service] {
+				return fmt.Errorf("provider %s is served for %s, a service the sentinel doesn't serve", identity.Name, service)
+			}
+		}
+	}
+	return nil
+}
+
+// servesFor return true when the identity is served for the service
+func servesFor(identity conf.ProviderIdentity, service string) bool {
+	if len(identity.Services) == 0 {
+		return true
+	}
+	for _, name := range identity.Services {
+		if name == service {
+			return true
+		}
+	}
+	return false
+}
+
+// providerOf return the identity a contract is served as: the one of its provider, for the service it was opened for.
+// A sentinel serving PROVIDER_PUBKEY only serves every contract as it
+func (p Proxy) providerOf(contract types.Contract) (conf.ProviderIdentity, bool) {
+	identities := p.Config.Identities()
+	if len(identities) == 1 {
+		return identities[0], true
+	}
+	for _, identity := range identities {
+		if identity.PubKey.Equals(contract.Provider) {
+			return identity, servesFor(identity, contract.Service.String())
+		}
+	}
+	return conf.ProviderIdentity{}, false
+}
+
+// lookupProvider return the identity by name or pubkey
+func (p Proxy) lookupProvider(raw string) (conf.ProviderIdentity, bool) {
+	for _, identity := range p.Config.Identities() {
+		if identity.Name == raw || identity.PubKey.String() == raw {
+			return identity, true
+		}
+	}
+	return conf.ProviderIdentity{}, false
+}
+
+// requestProvider return the identity selected by the provider query arg, the one of PROVIDER_PUBKEY without it
+func (p Proxy) requestProvider(r *http.Request) (conf.ProviderIdentity, bool) {
+	raw := r.URL.Query().Get(QueryProvider)
+	if len(raw) == 0 {
+		return p.Config.Identities()[0], true
+	}
+	return p.lookupProvider(raw)
+}
+
+// isMyPubKey return true for the pubkeys of the provider identities served
+func (p Proxy) isMyPubKey(pk common.PubKey) bool {
+	for _, identity := range p.Config.Identities() {
+		if pk.Equals(identity.PubKey) {
+			return true
+		}
+	}
+	return false
+}
+
+// newProviderChainMetadata return a cache of the on chain registrations of each provider identity, by pubkey
+func newProviderChainMetadata(querier ProviderQuerier, config conf.Configuration, logger log.Logger) map[string]*ChainMetadataCache {
+	caches := make(map[string]*ChainMetadataCache)
+	for _, identity := range config.Identities() {
+		caches[identity.PubKey.String()] = NewChainMetadataCache(querier, identity.PubKey, time.Duration(config.MetadataChainTTL)*time.Second, logger)
+	}
+	return caches
+}
+
+// providerMetadata return the metadata of one provider identity, the services served for it and its on chain terms
+func (p Proxy) providerMetadata(r *http.Request, identity conf.ProviderIdentity) Metadata {
+	metadata := p.Metadata
+	metadata.Configuration.ProviderPubKey = identity.PubKey
+	metadata.Configuration.Providers = nil
+	services := make([]string, 0, len(metadata.Services))
+	freePaths := make(map[string][]string)
+	for _, service := range metadata.Services {
+		if servesFor(identity, service) {
+			services = append(services, service)
+			if patterns, ok := metadata.FreePaths[service]; ok {
+				freePaths[service] = patterns
+			}
+		}
+	}
+	metadata.Services = services
+	if len(metadata.FreePaths) > 0 {
+		metadata.FreePaths = freePaths
+	}
+	if cache, ok := p.providerChains[identity.PubKey.String()]; ok {
+		chain := cache.Get(r.Context())
+		metadata.Chain = &chain
+	}
+	return metadata
+}
+
+// handleProviderMetadata serve the metadata of a provider identity, by name or pubkey
+func (p Proxy) handleProviderMetadata(w http.ResponseWriter, r *http.Request) {
+	current := p.current()
+	identity, ok := current.lookupProvider(mux.Vars(r)["provider"])
+	if !ok {
+		respondWithError(w, "unknown provider", http.StatusNotFound)
+		return
+	}
+	respondWithJSON(w, http.StatusOK, current.providerMetadata(r, identity))
+}
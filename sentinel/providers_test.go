@@ -0,0 +1,138 @@
+package sentinel
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/arkeonetwork/arkeo/common"
+	"github.com/arkeonetwork/arkeo/sentinel/conf"
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+)
+
+func TestProviderIdentities(t *testing.T) {
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer upstream.Close()
+	config := newTestConfig()
+	config.FreeTierRateLimit = 0
+	config.Services = map[string]conf.ServiceConfiguration{
+		common.BTCService.String(): {Upstream: upstream.URL},
+		common.ETHService.String(): {Upstream: upstream.URL},
+	}
+	eu := types.GetRandomPubKey()
+	config.Providers = []conf.ProviderIdentity{{Name: "eu", PubKey: eu, Services: []string{common.BTCService.String()}}}
+	proxy, err := NewProxy(config)
+	require.NoError(t, err)
+	proxy.MemStore.SetHeight(10)
+
+	newContract := func(id uint64, provider common.PubKey, service common.Service) types.Contract {
+		contract := newWebsocketContract(id, 1, 100)
+		contract.Provider = provider
+		contract.Service = service
+		proxy.MemStore.Put(contract)
+		return contract
+	}
+	main := newContract(1, config.ProviderPubKey, common.BTCService)
+	regional := newContract(2, eu, common.BTCService)
+	newContract(3, eu, common.ETHService)                      // a service not served for eu
+	newContract(4, types.GetRandomPubKey(), common.BTCService) // another provider
+	router := proxy.getRouter()
+
+	get := func(service common.Service, contractId uint64, nonce int64) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, fmt.Sprintf("/%s?%s=%d:%d", service, QueryArkAuth, contractId, nonce), nil))
+		return w
+	}
+
+	// the contracts of each identity are served, each within its own rate limit
+	require.Equal(t, http.StatusOK, get(common.BTCService, main.Id, 1).Code)
+	w := get(common.BTCService, main.Id, 2)
+	require.Equal(t, http.StatusTooManyRequests, w.Code)
+	require.Equal(t, "paid", w.Header().Get("tier"))
+	w = get(common.BTCService, regional.Id, 1)
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, "paid", w.Header().Get("tier"))
+
+	// a contract for a service not served for its provider, or of a provider not served, isn't paid
+	require.Equal(t, "free", get(common.ETHService, 3, 1).Header().Get("tier"))
+	require.Equal(t, "free", get(common.BTCService, 4, 1).Header().Get("tier"))
+
+	// each identity has its own claims
+	openClaims := func(provider string) []Claim {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, RoutesOpenClaims+"?"+QueryProvider+"="+provider, nil))
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		var claims []Claim
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &claims))
+		return claims
+	}
+	claims := openClaims("")
+	require.Len(t, claims, 1)
+	require.Equal(t, main.Id, claims[0].ContractId)
+	claims = openClaims(eu.String())
+	require.Len(t, claims, 1)
+	require.Equal(t, regional.Id, claims[0].ContractId)
+	require.Equal(t, claims, openClaims("eu"))
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, RoutesOpenClaims+"?"+QueryProvider+"=us", nil))
+	require.Equal(t, http.StatusNotFound, w.Code)
+
+	// and submits them with its own key
+	for _, contract := range []types.Contract{main, regional} {
+		require.NoError(t, proxy.ClaimStore.Set(NewClaim(contract.Id, contract.Client, 1, "aabb")))
+	}
+	mainBroadcaster := &mockBroadcaster{address: types.GetRandomBech32Addr()}
+	euBroadcaster := &mockBroadcaster{address: types.GetRandomBech32Addr()}
+	claimer := NewAutoClaimer(conf.AutoClaimConfiguration{Threshold: 1}, proxy.ClaimStore, proxy.MemStore, mainBroadcaster, proxy.logger)
+	claimer.UseBroadcaster(eu, euBroadcaster)
+	require.Equal(t, 2, claimer.ClaimDue(context.Background()))
+	require.Len(t, mainBroadcaster.msgs, 1)
+	require.Equal(t, main.Id, mainBroadcaster.msgs[0].ContractId)
+	require.Equal(t, mainBroadcaster.address.String(), mainBroadcaster.msgs[0].Creator)
+	require.Len(t, euBroadcaster.msgs, 1)
+	require.Equal(t, regional.Id, euBroadcaster.msgs[0].ContractId)
+	require.Equal(t, euBroadcaster.address.String(), euBroadcaster.msgs[0].Creator)
+
+	// the metadata are served per identity
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/providers/eu/metadata.json", nil))
+	require.Equal(t, http.StatusOK, w.Code)
+	var metadata Metadata
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &metadata))
+	require.Equal(t, eu, metadata.Configuration.ProviderPubKey)
+	require.Equal(t, []string{common.BTCService.String()}, metadata.Services)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, fmt.Sprintf("/providers/%s/metadata.json", config.ProviderPubKey), nil))
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &metadata))
+	require.Equal(t, config.ProviderPubKey, metadata.Configuration.ProviderPubKey)
+	require.Len(t, metadata.Services, 2)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/providers/us/metadata.json", nil))
+	require.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestValidateProviders(t *testing.T) {
+	config := newTestConfig()
+	config.Services = map[string]conf.ServiceConfiguration{common.BTCService.String(): {Upstream: "http://localhost:1"}}
+	eu := types.GetRandomPubKey()
+	config.Providers = []conf.ProviderIdentity{{Name: "eu", PubKey: eu, Services: []string{common.BTCService.String()}}}
+	require.NoError(t, validateProviders(config))
+
+	for _, providers := range [][]conf.ProviderIdentity{
+		{{Name: "eu"}},
+		{{Name: conf.DefaultProviderName, PubKey: eu}},
+		{{Name: "eu", PubKey: eu}, {Name: "eu", PubKey: types.GetRandomPubKey()}},
+		{{Name: "eu", PubKey: config.ProviderPubKey}},
+		{{Name: "eu", PubKey: eu, Services: []string{common.ETHService.String()}}},
+	} {
+		config.Providers = providers
+		require.Error(t, validateProviders(config), providers)
+	}
+	_, err := NewProxy(config)
+	require.ErrorContains(t, err, "invalid providers configuration")
+}
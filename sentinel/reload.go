@@ -0,0 +1,246 @@
+package sentinel
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"reflect"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"syscall"
+	"unicode"
+
+	"github.com/arkeonetwork/arkeo/sentinel/conf"
+)
+
+// reloadableSettings is the configuration read while serving the requests, a reload applies them right away. The
+// other settings are only read at startup, a change is reported but needs a restart
+var reloadableSettings = map[string]bool{
+	"Moniker":                 true,
+	"Website":                 true,
+	"Description":             true,
+	"Location":                true,
+	"AcceptedDenoms":          true,
+	"FreeTierRateLimit":       true,
+	"FreeTierDailyLimit":      true,
+	"FreeTierMaxKeys":         true,
+	"FreeTierAllowCIDRs":      true,
+	"MaxInFlight":             true,
+	"ContractMaxInFlight":     true,
+	"WebsocketAccounting":     true,
+	"Services":                true,
+	"GRPCUpstream":            true,
+	"GRPCAccounting":          true,
+	"ClientAllowList":         true,
+	"ClientDenyList":          true,
+	"ClientAllowListFreeTier": true,
+	"AdminToken":              true,
+	"AdminTokens":             true,
+	"CORS":                    true,
+	"ContractGraceBlocks":     true,
+	"ContractOverdraft":       true,
+}
+
+// ReloadResult is the settings a reload changed
+type ReloadResult struct {
+	Applied         []string `json:"applied"`          // settings in use from now on
+	RestartRequired []string `json:"restart_required"` // settings only read at startup, their previous value is kept
+}
+
+// liveProxy hold the proxy the requests are served with. A reload swap it as a whole, so a request is served with one
+// configuration from start to end and the requests in flight finish with the previous one
+type liveProxy struct {
+	lock     sync.Mutex // serialize the reloads
+	snapshot atomic.Pointer[liveSnapshot]
+}
+
+type liveSnapshot struct {
+	proxy   Proxy
+	handler http.Handler
+}
+
+func (l *liveProxy) store(p Proxy) {
+	l.snapshot.Store(&liveSnapshot{proxy: p, handler: p.requestHandler()})
+}
+
+func (l *liveProxy) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	l.snapshot.Load().handler.ServeHTTP(w, r)
+}
+
+// current return the proxy with the last configuration reloaded
+func (p Proxy) current() Proxy {
+	if p.live != nil {
+		if snapshot := p.live.snapshot.Load(); snapshot != nil {
+			return snapshot.proxy
+		}
+	}
+	return p
+}
+
+// Reload apply the settings of config that can change at runtime
+func (p Proxy) Reload(config conf.Configuration) (ReloadResult, error) {
+	if p.live == nil {
+		return ReloadResult{}, fmt.Errorf("sentinel isn't serving requests")
+	}
+	p.live.lock.Lock()
+	defer p.live.lock.Unlock()
+
+	if err := validateServices(config.Services); err != nil {
+		return ReloadResult{}, fmt.Errorf("invalid services configuration: %w", err)
+	}
+	if err := validateDenoms(config); err != nil {
+		return ReloadResult{}, fmt.Errorf("invalid denoms configuration: %w", err)
+	}
+	current := p.current()
+	next, result := reloadConfiguration(current.Config, config)
+
+	// the free tier counters are kept unless the allowances changed
+	freeTier, serviceFreeTiers := current.FreeTier, current.serviceFreeTiers
+	if changed(result.Applied, "free_tier_rate_limit", "free_tier_daily_limit", "free_tier_max_keys", "free_tier_allow_cidrs") {
+		var err error
+		if freeTier, err = newFreeTier(next, current.sharedCounters); err != nil {
+			return ReloadResult{}, fmt.Errorf("failed to create free tier limiter with error: %w", err)
+		}
+		serviceFreeTiers = nil
+	}
+	if serviceFreeTiers == nil || changed(result.Applied, "services") {
+		var err error
+		if serviceFreeTiers, err = newServiceFreeTiers(next, current.sharedCounters); err != nil {
+			return ReloadResult{}, fmt.Errorf("failed to create service free tier limiter with error: %w", err)
+		}
+	}
+
+	// the breakers keep their state unless the services changed
+	serviceBreakers := current.serviceBreakers
+	if changed(result.Applied, "services") {
+		serviceBreakers = newServiceBreakers(next.Services)
+	}
+
+	// the free path counters too, unless the services or the free tier allowlist changed
+	serviceFreePaths := current.serviceFreePaths
+	if changed(result.Applied, "services", "free_tier_max_keys", "free_tier_allow_cidrs") {
+		var err error
+		if serviceFreePaths, err = newServiceFreePaths(next); err != nil {
+			return ReloadResult{}, fmt.Errorf("failed to create free path limiter with error: %w", err)
+		}
+	}
+
+	clientAccess := current.ClientAccess
+	if changed(result.Applied, "client_allow_list", "client_deny_list", "client_allow_list_free_tier") {
+		var err error
+		if clientAccess, err = NewClientAccess(next); err != nil {
+			return ReloadResult{}, fmt.Errorf("failed to create client access lists with error: %w", err)
+		}
+		clientAccess.logChanges(current.ClientAccess, p.logger)
+	}
+
+	proxy := current
+	proxy.Config = next
+	proxy.proxies = loadProxies(next.Services)
+	proxy.serviceLimiters = newServiceLimiters(next.Services)
+	proxy.serviceFreeTiers = serviceFreeTiers
+	proxy.serviceTransports = newServiceTransports(next.Services, proxy.proxies, current.serviceTransports)
+	proxy.serviceMinDeposits = newServiceMinDeposits(next.Services)
+	proxy.serviceBreakers = serviceBreakers
+	proxy.serviceFreePaths = serviceFreePaths
+	proxy.serviceCaches = newServiceCaches(next.Services, current.serviceCaches)
+	proxy.FreeTier = freeTier
+	proxy.ClientAccess = clientAccess
+	proxy.Metadata = NewMetadata(next)
+	proxy.Metadata.Services = serviceNames(proxy.proxies)
+	proxy.Metadata.FreePaths = freePathPatterns(next.Services)
+	p.live.store(proxy)
+	// the requests in flight keep their connections
+	for name, transport := range current.serviceTransports {
+		if proxy.serviceTransports[name] != transport {
+			transport.CloseIdleConnections()
+		}
+	}
+	return result, nil
+}
+
+// ReloadFromEnv read the configuration again, from the config file and the env, and reload it
+func (p Proxy) ReloadFromEnv() (ReloadResult, error) {
+	config, err := conf.ReloadConfiguration()
+	if err != nil {
+		return ReloadResult{}, err
+	}
+	return p.Reload(config)
+}
+
+// reloadOnSignal reload the configuration on SIGHUP
+func (p Proxy) reloadOnSignal() {
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGHUP)
+	for range signals {
+		result, err := p.ReloadFromEnv()
+		if err != nil {
+			p.logger.Error("fail to reload configuration", "error", err)
+			continue
+		}
+		p.logger.Info("configuration reloaded", "applied", strings.Join(result.Applied, ","), "restart_required", strings.Join(result.RestartRequired, ","))
+	}
+}
+
+// handleReload reload the configuration, for the holder of the admin token only
+func (p Proxy) handleReload(w http.ResponseWriter, r *http.Request) {
+	if _, ok := p.authorizeAdmin(w, r, http.MethodPost); !ok {
+		return
+	}
+	result, err := p.ReloadFromEnv()
+	if err != nil {
+		p.logger.Error("fail to reload configuration", "error", err)
+		respondWithError(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	respondWithJSON(w, http.StatusOK, result)
+}
+
+// reloadConfiguration return the current configuration with the reloadable settings of next, and the settings changed
+func reloadConfiguration(current, next conf.Configuration) (conf.Configuration, ReloadResult) {
+	result := ReloadResult{Applied: []string{}, RestartRequired: []string{}}
+	reloaded := current
+	currentValue, nextValue := reflect.ValueOf(current), reflect.ValueOf(next)
+	reloadedValue := reflect.ValueOf(&reloaded).Elem()
+	for i := 0; i < currentValue.NumField(); i++ {
+		if reflect.DeepEqual(currentValue.Field(i).Interface(), nextValue.Field(i).Interface()) {
+			continue
+		}
+		field := currentValue.Type().Field(i)
+		if !reloadableSettings[field.Name] {
+			result.RestartRequired = append(result.RestartRequired, settingName(field))
+			continue
+		}
+		reloadedValue.Field(i).Set(nextValue.Field(i))
+		result.Applied = append(result.Applied, settingName(field))
+	}
+	return reloaded, result
+}
+
+// settingName return the json name of a setting, its snake cased field name for the settings not published
+func settingName(field reflect.StructField) string {
+	if name, _, _ := strings.Cut(field.Tag.Get("json"), ","); len(name) > 0 && name != "-" {
+		return name
+	}
+	var name strings.Builder
+	for i, r := range field.Name {
+		if unicode.IsUpper(r) && i > 0 {
+			name.WriteByte('_')
+		}
+		name.WriteRune(unicode.ToLower(r))
+	}
+	return name.String()
+}
+
+func changed(settings []string, names ...string) bool {
+	for _, setting := range settings {
+		for _, name := range names {
+			if setting == name {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,171 @@
+package sentinel
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/arkeonetwork/arkeo/sentinel/conf"
+)
+
+func TestReload(t *testing.T) {
+	release := make(chan struct{})
+	received := make(chan struct{})
+	first := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/slow" {
+			close(received)
+			<-release
+		}
+		_, _ = w.Write([]byte("first"))
+	}))
+	defer first.Close()
+	second := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("second"))
+	}))
+	defer second.Close()
+
+	config := newTestConfig()
+	config.Services = map[string]conf.ServiceConfiguration{
+		"eth-mainnet-fullnode": {Upstream: first.URL, RateLimit: 2},
+	}
+	proxy, err := NewProxy(config)
+	require.NoError(t, err)
+	server := httptest.NewServer(proxy.getRouter())
+	defer server.Close()
+
+	get := func(path string) (int, string) {
+		resp, err := http.Get(server.URL + path)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		return resp.StatusCode, string(body)
+	}
+
+	// a request in flight during the reload
+	inflight := make(chan string)
+	go func() {
+		resp, err := http.Get(server.URL + "/eth-mainnet-fullnode/slow")
+		if err != nil {
+			inflight <- err.Error()
+			return
+		}
+		defer resp.Body.Close()
+		body, _ := io.ReadAll(resp.Body)
+		inflight <- string(body)
+	}()
+	<-received
+
+	code, _ := get("/eth-mainnet-fullnode/")
+	require.Equal(t, http.StatusOK, code)
+	code, _ = get("/eth-mainnet-fullnode/")
+	require.Equal(t, http.StatusTooManyRequests, code)
+
+	next := config
+	next.Port = "4000"
+	next.Services = map[string]conf.ServiceConfiguration{
+		"eth-mainnet-fullnode": {Upstream: second.URL, RateLimit: 100},
+	}
+	result, err := proxy.Reload(next)
+	require.NoError(t, err)
+	require.Equal(t, []string{"services"}, result.Applied)
+	require.Equal(t, []string{"port"}, result.RestartRequired)
+
+	// the new limit and upstream apply to the next request, the listen port is kept until a restart
+	code, body := get("/eth-mainnet-fullnode/")
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, "second", body)
+	require.Equal(t, "3636", proxy.current().Config.Port)
+
+	// the request in flight finish with the previous upstream
+	close(release)
+	require.Equal(t, "first", <-inflight)
+
+	// an invalid configuration is refused and the current one kept
+	next.Services = map[string]conf.ServiceConfiguration{"not-a-service": {}}
+	_, err = proxy.Reload(next)
+	require.Error(t, err)
+	code, body = get("/eth-mainnet-fullnode/")
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, "second", body)
+}
+
+func TestHandleReload(t *testing.T) {
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("{}"))
+	}))
+	defer upstream.Close()
+
+	config := newTestConfig()
+	config.AdminToken = "secret"
+	proxy, err := NewProxy(config)
+	require.NoError(t, err)
+	server := httptest.NewServer(proxy.getRouter())
+	defer server.Close()
+
+	// the reload read the config file named in the env
+	lines := []string{
+		"MONIKER=" + config.Moniker,
+		"WEBSITE=" + config.Website,
+		"DESCRIPTION=" + config.Description,
+		"LOCATION=" + config.Location,
+		"SOURCE_CHAIN=" + config.SourceChain,
+		"EVENT_STREAM_HOST=" + config.EventStreamHost,
+		"PROVIDER_PUBKEY=" + config.ProviderPubKey.String(),
+		"FREE_RATE_LIMIT=100",
+		"CLAIM_STORE_LOCATION=",
+		"CONTRACT_CONFIG_STORE_LOCATION=",
+		"PROVIDER_CONFIG_STORE_LOCATION=",
+		"ADMIN_TOKEN=secret",
+		"SERVICES=eth-mainnet-fullnode",
+		"SERVICE_UPSTREAMS=eth-mainnet-fullnode=" + upstream.URL,
+		"SERVICE_RATE_LIMITS=eth-mainnet-fullnode=1",
+	}
+	for _, line := range lines {
+		key, _, _ := strings.Cut(line, "=")
+		t.Setenv(key, "")
+	}
+	file := filepath.Join(t.TempDir(), "sentinel.env")
+	require.NoError(t, os.WriteFile(file, []byte(strings.Join(lines, "\n")), 0o600))
+	t.Setenv("CONFIG_FILE", file)
+
+	reload := func(method, token string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, RoutesAdminReload, nil)
+		if len(token) > 0 {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		w := httptest.NewRecorder()
+		proxy.handleReload(w, req)
+		return w
+	}
+	require.Equal(t, http.StatusUnauthorized, reload(http.MethodPost, "").Code)
+	require.Equal(t, http.StatusUnauthorized, reload(http.MethodPost, "wrong").Code)
+	require.Equal(t, http.StatusMethodNotAllowed, reload(http.MethodGet, "secret").Code)
+
+	w := reload(http.MethodPost, "secret")
+	require.Equal(t, http.StatusOK, w.Code)
+	var result ReloadResult
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &result))
+	require.Contains(t, result.Applied, "services")
+	require.Contains(t, result.RestartRequired, "config_file")
+
+	for i, expected := range []int{http.StatusOK, http.StatusTooManyRequests} {
+		resp, err := http.Get(fmt.Sprintf("%s/eth-mainnet-fullnode/%d", server.URL, i))
+		require.NoError(t, err)
+		_ = resp.Body.Close()
+		require.Equal(t, expected, resp.StatusCode)
+	}
+
+	// a broken config file is reported, the configuration is kept
+	require.NoError(t, os.WriteFile(file, []byte("FREE_RATE_LIMIT"), 0o600))
+	require.Equal(t, http.StatusBadRequest, reload(http.MethodPost, "secret").Code)
+	require.Equal(t, []string{"eth-mainnet-fullnode"}, proxy.current().Metadata.Services)
+}
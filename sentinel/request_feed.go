@@ -0,0 +1,186 @@
+package sentinel
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// requestFeedBuffer is the summaries a subscriber of the request feed can fall behind by before it is dropped
+const requestFeedBuffer = 256
+
+// RequestSummary is what the request feed streams of each request to the services once it is served
+type RequestSummary struct {
+	Time       time.Time `json:"time"`
+	Service    string    `json:"service"`
+	Method     string    `json:"method"`
+	Path       string    `json:"path"` // normalized request path
+	Tier       string    `json:"tier,omitempty"`
+	ContractId uint64    `json:"contract_id,omitempty"` // paid requests only
+	Status     int       `json:"status"`
+	LatencyMs  int64     `json:"latency_ms"`
+	Queries    int64     `json:"queries"` // queries charged, to the contract or the free tier
+}
+
+// RequestFeed fan the summaries of the requests served out to its subscribers. A subscriber that doesn't keep up is
+// dropped once its buffer is full, the requests are never held up by a slow one
+type RequestFeed struct {
+	lock        sync.Mutex
+	subscribers map[*FeedSubscriber]struct{}
+	count       atomic.Int32
+}
+
+// FeedSubscriber receive the summaries matching its filter on C, closed once it is dropped or unsubscribed
+type FeedSubscriber struct {
+	C         chan RequestSummary
+	services  map[string]bool // all when empty
+	contracts map[uint64]bool // all when empty
+	dropped   bool
+}
+
+func NewRequestFeed() *RequestFeed {
+	return &RequestFeed{
+		subscribers: make(map[*FeedSubscriber]struct{}),
+	}
+}
+
+// Subscribe return a subscriber to the summaries of the requests to services and of contracts, all of them when empty,
+// buffering up to buffer summaries
+func (f *RequestFeed) Subscribe(services []string, contracts []uint64, buffer int) *FeedSubscriber {
+	subscriber := &FeedSubscriber{
+		C:         make(chan RequestSummary, buffer),
+		services:  make(map[string]bool, len(services)),
+		contracts: make(map[uint64]bool, len(contracts)),
+	}
+	for _, service := range services {
+		subscriber.services[service] = true
+	}
+	for _, contract := range contracts {
+		subscriber.contracts[contract] = true
+	}
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.subscribers[subscriber] = struct{}{}
+	f.count.Add(1)
+	return subscriber
+}
+
+// Unsubscribe stop sending summaries to a subscriber
+func (f *RequestFeed) Unsubscribe(subscriber *FeedSubscriber) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.remove(subscriber)
+}
+
+// Dropped tell whether the subscriber was dropped for falling behind, once C is closed
+func (f *RequestFeed) Dropped(subscriber *FeedSubscriber) bool {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	return subscriber.dropped
+}
+
+func (f *RequestFeed) remove(subscriber *FeedSubscriber) {
+	if _, ok := f.subscribers[subscriber]; !ok {
+		return
+	}
+	delete(f.subscribers, subscriber)
+	f.count.Add(-1)
+	close(subscriber.C)
+}
+
+// HasSubscribers tell whether the summaries are wanted, so they aren't built for nobody
+func (f *RequestFeed) HasSubscribers() bool {
+	return f != nil && f.count.Load() > 0
+}
+
+// Publish send a summary to the subscribers it matches, those with a full buffer are dropped
+func (f *RequestFeed) Publish(summary RequestSummary) {
+	if !f.HasSubscribers() {
+		return
+	}
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	for subscriber := range f.subscribers {
+		if !subscriber.matches(summary) {
+			continue
+		}
+		select {
+		case subscriber.C <- summary:
+		default:
+			subscriber.dropped = true
+			f.remove(subscriber)
+		}
+	}
+}
+
+func (s *FeedSubscriber) matches(summary RequestSummary) bool {
+	if len(s.services) > 0 && !s.services[summary.Service] {
+		return false
+	}
+	return len(s.contracts) == 0 || s.contracts[summary.ContractId]
+}
+
+// handleAdminRequests stream a summary of each request served as server sent events, for the holder of an admin token
+// only. The service and contract_id query args, comma separated or repeated, narrow the requests streamed. A subscriber
+// falling behind gets a dropped event and the stream ends
+func (p Proxy) handleAdminRequests(w http.ResponseWriter, r *http.Request) {
+	if _, ok := p.authorizeAdmin(w, r, http.MethodGet); !ok {
+		return
+	}
+	var services []string
+	var contracts []uint64
+	for _, raw := range r.URL.Query()["service"] {
+		services = append(services, strings.Split(raw, ",")...)
+	}
+	for _, raw := range r.URL.Query()["contract_id"] {
+		for _, id := range strings.Split(raw, ",") {
+			contractId, err := strconv.ParseUint(strings.TrimSpace(id), 10, 64)
+			if err != nil {
+				respondWithError(w, fmt.Sprintf("bad contract id: %s", err), http.StatusBadRequest)
+				return
+			}
+			contracts = append(contracts, contractId)
+		}
+	}
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		respondWithError(w, "streaming unsupported", http.StatusInternalServerError)
+		return
+	}
+	subscriber := p.RequestFeed.Subscribe(services, contracts, requestFeedBuffer)
+	defer p.RequestFeed.Unsubscribe(subscriber)
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-p.done:
+			return
+		case summary, ok := <-subscriber.C:
+			if !ok {
+				if p.RequestFeed.Dropped(subscriber) {
+					_, _ = fmt.Fprintf(w, "event: dropped\ndata: {\"error\":\"subscriber fell behind by %d requests\"}\n\n", requestFeedBuffer)
+					flusher.Flush()
+				}
+				return
+			}
+			data, err := json.Marshal(summary)
+			if err != nil {
+				continue
+			}
+			if _, err := fmt.Fprintf(w, "data: %s\n\n", data); err != nil {
+				return
+			}
+			flusher.Flush()
+		}
+	}
+}
@@ -0,0 +1,132 @@
+package sentinel
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/arkeonetwork/arkeo/common"
+	"github.com/arkeonetwork/arkeo/sentinel/conf"
+)
+
+func TestRequestFeed(t *testing.T) {
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("{}"))
+	}))
+	defer upstream.Close()
+
+	config := newTestConfig()
+	config.AdminToken = "secret"
+	config.Services = map[string]conf.ServiceConfiguration{
+		common.BTCService.String(): {Upstream: upstream.URL},
+		common.ETHService.String(): {Upstream: upstream.URL},
+	}
+	proxy, err := NewProxy(config)
+	require.NoError(t, err)
+	contract := newWebsocketContract(1, 1000, 1000)
+	proxy.MemStore.SetHeight(10)
+	proxy.MemStore.Put(contract)
+	router := proxy.getRouter()
+	mux := http.NewServeMux()
+	mux.HandleFunc(RoutesAdminRequests, proxy.handleAdminRequests)
+	admin := httptest.NewServer(mux)
+	defer admin.Close()
+
+	subscribe := func(query, token string) *http.Response {
+		req, err := http.NewRequest(http.MethodGet, admin.URL+RoutesAdminRequests+query, nil)
+		require.NoError(t, err)
+		req.Header.Set("Authorization", "Bearer "+token)
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		return resp
+	}
+	resp := subscribe("", "wrong")
+	resp.Body.Close()
+	require.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	resp = subscribe("?contract_id=abc", "secret")
+	resp.Body.Close()
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	// no summary is built without a subscriber
+	require.False(t, proxy.RequestFeed.HasSubscribers())
+	resp = subscribe("?service="+common.BTCService.String(), "secret")
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+	require.True(t, proxy.RequestFeed.HasSubscribers())
+
+	// the requests to other services are filtered out
+	for _, path := range []string{
+		fmt.Sprintf("/%s/block", common.ETHService),
+		fmt.Sprintf("/%s//blocks/../height?%s=%d:1", common.BTCService, QueryArkAuth, contract.Id),
+		fmt.Sprintf("/%s/missing", common.BTCService),
+	} {
+		router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, path, nil))
+	}
+	events := bufio.NewReader(resp.Body)
+	next := func() RequestSummary {
+		line, err := events.ReadString('\n')
+		require.NoError(t, err)
+		blank, err := events.ReadString('\n')
+		require.NoError(t, err)
+		require.Equal(t, "\n", blank)
+		var summary RequestSummary
+		require.NoError(t, json.Unmarshal([]byte(strings.TrimPrefix(line, "data: ")), &summary))
+		return summary
+	}
+	summary := next()
+	require.Equal(t, common.BTCService.String(), summary.Service)
+	require.Equal(t, "/"+common.BTCService.String()+"/height", summary.Path)
+	require.Equal(t, contract.Id, summary.ContractId)
+	require.Equal(t, http.StatusOK, summary.Status)
+	require.Equal(t, int64(1), summary.Queries)
+	require.Equal(t, accessTierPaid, summary.Tier)
+	summary = next()
+	require.Equal(t, "/"+common.BTCService.String()+"/missing", summary.Path)
+	require.Zero(t, summary.ContractId)
+	require.Equal(t, accessTierFree, summary.Tier)
+
+	// the subscription ends with its stream
+	resp.Body.Close()
+	require.Eventually(t, func() bool { return !proxy.RequestFeed.HasSubscribers() }, time.Second, 10*time.Millisecond)
+}
+
+func TestRequestFeedDropSlow(t *testing.T) {
+	feed := NewRequestFeed()
+	slow := feed.Subscribe(nil, nil, 2)
+	fast := feed.Subscribe(nil, nil, 10)
+	contract := feed.Subscribe(nil, []uint64{7}, 1)
+
+	// publishing never blocks, the subscriber whose buffer is full is dropped and the others keep receiving
+	for i := 0; i < 3; i++ {
+		feed.Publish(RequestSummary{Service: common.BTCService.String(), Status: http.StatusOK})
+		<-fast.C
+	}
+	require.Len(t, slow.C, 2)
+	<-slow.C
+	<-slow.C
+	_, ok := <-slow.C
+	require.False(t, ok)
+	require.True(t, feed.Dropped(slow))
+	require.False(t, feed.Dropped(fast))
+
+	// only the requests of its contract count against a filtered subscriber
+	require.Empty(t, contract.C)
+	feed.Publish(RequestSummary{ContractId: 7})
+	require.Equal(t, uint64(7), (<-contract.C).ContractId)
+	<-fast.C
+
+	feed.Unsubscribe(fast)
+	_, ok = <-fast.C
+	require.False(t, ok)
+	require.False(t, feed.Dropped(fast))
+	feed.Unsubscribe(contract)
+	require.False(t, feed.HasSubscribers())
+}
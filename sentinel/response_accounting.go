@@ -0,0 +1,90 @@
+package sentinel
+
+import (
+	"io"
+	"net/http"
+	"strconv"
+)
+
+const (
+	// ResponseAccountingRequest charge the requests of a service by their cost only, the default
+	ResponseAccountingRequest = "request"
+	// ResponseAccountingBytes charge the responses by the units of bytes they started, the cost of the request paying
+	// for the first ones
+	ResponseAccountingBytes = "bytes"
+	// ResponseAccountingRequestBytes charge the units of bytes of the responses on top of the cost of the requests
+	ResponseAccountingRequestBytes = "request_bytes"
+
+	// defaultResponseByteUnit is the bytes charged as one query, 1MiB
+	defaultResponseByteUnit = 1 << 20
+)
+
+// responseAccounting return how the responses of a service are charged, and the bytes charged as one query
+func (p Proxy) responseAccounting(service string) (string, int64) {
+	settings := p.Config.Services[service]
+	unit := settings.ResponseByteUnit
+	if unit <= 0 {
+		unit = defaultResponseByteUnit
+	}
+	switch mode := settings.ResponseAccounting; mode {
+	case ResponseAccountingBytes, ResponseAccountingRequestBytes:
+		return mode, unit
+	default:
+		return ResponseAccountingRequest, unit
+	}
+}
+
+// meterResponseBytes charge the body of a paid response to its contract by the units of bytes it starts, as it is
+// read and flushed to the client. The responses served within the grace blocks of an expired contract are charged as
+// their request only
+func (p Proxy) meterResponseBytes(w http.ResponseWriter, r *http.Request, resp *http.Response, service, pubkey string) {
+	mode, unit := p.responseAccounting(service)
+	if mode == ResponseAccountingRequest {
+		return
+	}
+	meter := p.newStreamMeter(r, pubkey)
+	if meter.contract.Id == 0 || meter.contract.IsExpired(p.MemStore.GetHeight()) {
+		return
+	}
+	var paid int64
+	if mode == ResponseAccountingBytes {
+		// the request's cost is set on the response before it is proxied
+		paid, _ = strconv.ParseInt(w.Header().Get(CostHeader), 10, 64)
+	}
+	resp.Body = &meteredBody{ReadCloser: resp.Body, meter: meter, unit: unit, paid: paid}
+}
+
+// meteredBody charge the units of bytes a response starts as they are read, the response is cut with the error of the
+// charge once the contract can't pay for the next unit
+type meteredBody struct {
+	io.ReadCloser
+	meter streamMeter
+	unit  int64
+	bytes int64 // relayed so far
+	paid  int64 // units paid for, by the request and charged since
+	err   error // charge refused
+}
+
+func (b *meteredBody) Read(p []byte) (int, error) {
+	if b.err != nil {
+		return 0, b.err
+	}
+	n, err := b.ReadCloser.Read(p)
+	if n <= 0 {
+		return n, err
+	}
+	var queries int64
+	if units := (b.bytes + int64(n) + b.unit - 1) / b.unit; units > b.paid {
+		queries = units - b.paid
+		if chargeErr := b.meter.charge(queries); chargeErr != nil {
+			// the chunk isn't paid for, it isn't relayed
+			b.err = chargeErr
+			b.meter.proxy.logger.Info("cutting response", "reason", chargeErr, "contract_id", b.meter.contract.Id, "bytes", b.bytes)
+			return 0, chargeErr
+		}
+		b.paid = units
+	}
+	b.bytes += int64(n)
+	b.meter.proxy.QueryUsage.ChargeBytes(b.meter.contract.Id, int64(n), queries)
+	return n, err
+}
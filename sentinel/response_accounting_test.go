@@ -0,0 +1,135 @@
+package sentinel
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/arkeonetwork/arkeo/common"
+	"github.com/arkeonetwork/arkeo/sentinel/conf"
+)
+
+func TestResponseAccounting(t *testing.T) {
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		size := 2500
+		if r.URL.Path == "/small" {
+			size = 10
+		}
+		_, _ = w.Write([]byte(strings.Repeat("a", size)))
+	}))
+	defer upstream.Close()
+
+	for _, tc := range []struct {
+		mode    string
+		path    string
+		queries int64 // charged on top of the request
+	}{
+		{mode: ResponseAccountingRequest, path: "/large"},
+		// 3 units of 1000 bytes started, the first paid by the request
+		{mode: ResponseAccountingBytes, path: "/large", queries: 2},
+		{mode: ResponseAccountingBytes, path: "/small"},
+		{mode: ResponseAccountingRequestBytes, path: "/large", queries: 3},
+		{mode: ResponseAccountingRequestBytes, path: "/small", queries: 1},
+	} {
+		config := newTestConfig()
+		config.Services = map[string]conf.ServiceConfiguration{
+			common.BTCService.String(): {Upstream: upstream.URL, ResponseAccounting: tc.mode, ResponseByteUnit: 1000},
+		}
+		proxy, err := NewProxy(config)
+		require.NoError(t, err)
+		contract := newWebsocketContract(1, 100, 100)
+		proxy.MemStore.SetHeight(10)
+		proxy.MemStore.Put(contract)
+
+		w := httptest.NewRecorder()
+		proxy.getRouter().ServeHTTP(w, httptest.NewRequest(http.MethodGet, fmt.Sprintf("/%s%s?%s=%d:1", common.BTCService, tc.path, QueryArkAuth, contract.Id), nil))
+		require.Equal(t, http.StatusOK, w.Code, tc.mode)
+		require.Equal(t, tc.queries, proxy.StreamUsage.Get(contract.Id), tc.mode)
+
+		usage := proxy.contractUsage(contract)
+		require.Equal(t, 1+tc.queries, usage.QueriesCharged, tc.mode)
+		require.Equal(t, tc.queries, usage.ByteQueries, tc.mode)
+		require.Zero(t, usage.StreamQueries, tc.mode)
+		require.Equal(t, fmt.Sprintf("%duarkeo", 100-1-tc.queries), usage.RemainingDeposit, tc.mode)
+		if tc.mode == ResponseAccountingRequest {
+			require.Zero(t, usage.ResponseBytes)
+		} else {
+			require.Equal(t, int64(w.Body.Len()), usage.ResponseBytes, tc.mode)
+		}
+
+		// the mode and unit are advertised
+		w = httptest.NewRecorder()
+		proxy.handleMetadata(w, httptest.NewRequest(http.MethodGet, RoutesMetaData, nil))
+		var metadata Metadata
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &metadata))
+		require.Equal(t, tc.mode, metadata.Configuration.Services[common.BTCService.String()].ResponseAccounting)
+		require.Equal(t, int64(1000), metadata.Configuration.Services[common.BTCService.String()].ResponseByteUnit)
+	}
+
+	config := newTestConfig()
+	config.Services = map[string]conf.ServiceConfiguration{
+		common.BTCService.String(): {Upstream: upstream.URL, ResponseAccounting: "bandwidth"},
+	}
+	_, err := NewProxy(config)
+	require.ErrorContains(t, err, "unknown response accounting")
+}
+
+func TestResponseAccountingTruncated(t *testing.T) {
+	// the upstream streams 400 bytes at a time, and drops the connection past 1600 bytes when asked to
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		for i := 0; i < 10; i++ {
+			if i == 4 && r.URL.Path == "/drop" {
+				panic(http.ErrAbortHandler)
+			}
+			_, _ = w.Write([]byte(strings.Repeat("a", 400)))
+			w.(http.Flusher).Flush()
+			time.Sleep(10 * time.Millisecond)
+		}
+	}))
+	defer upstream.Close()
+
+	config := newTestConfig()
+	config.Services = map[string]conf.ServiceConfiguration{
+		common.BTCService.String(): {Upstream: upstream.URL, ResponseAccounting: ResponseAccountingBytes, ResponseByteUnit: 1000},
+	}
+	proxy, err := NewProxy(config)
+	require.NoError(t, err)
+	server := httptest.NewServer(proxy.getRouter())
+	defer server.Close()
+	proxy.MemStore.SetHeight(10)
+
+	// the upstream cut the stream, the bytes relayed until then are charged
+	dropped := newWebsocketContract(1, 100, 100)
+	proxy.MemStore.Put(dropped)
+	resp, err := http.Get(fmt.Sprintf("%s/%s/drop?%s=%d:1", server.URL, common.BTCService, QueryArkAuth, dropped.Id))
+	require.NoError(t, err)
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	require.Error(t, err)
+	require.Len(t, body, 1600)
+	require.EqualValues(t, 1, proxy.StreamUsage.Get(dropped.Id))
+	responseBytes, _ := proxy.QueryUsage.GetBytes(dropped.Id)
+	require.EqualValues(t, 1600, responseBytes)
+
+	// the deposit covers the request and 2 more units, the response is cut before its fourth unit
+	spent := newWebsocketContract(2, 100, 3)
+	proxy.MemStore.Put(spent)
+	resp, err = http.Get(fmt.Sprintf("%s/%s/stream?%s=%d:1", server.URL, common.BTCService, QueryArkAuth, spent.Id))
+	require.NoError(t, err)
+	body, _ = io.ReadAll(resp.Body)
+	resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.LessOrEqual(t, len(body), 3000)
+	require.Greater(t, len(body), 2000)
+	require.EqualValues(t, 2, proxy.StreamUsage.Get(spent.Id))
+	responseBytes, byteQueries := proxy.QueryUsage.GetBytes(spent.Id)
+	require.EqualValues(t, len(body), responseBytes)
+	require.EqualValues(t, 2, byteQueries)
+}
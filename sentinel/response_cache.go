@@ -0,0 +1,259 @@
+package sentinel
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+
+	"github.com/arkeonetwork/arkeo/sentinel/conf"
+)
+
+const (
+	// defaultCacheMaxEntryBytes is the largest response a service keeps in cache unless it says otherwise
+	defaultCacheMaxEntryBytes = 64 << 10
+	// cacheMaxEntries bound the responses a service keeps in cache, a full cache takes no more until some expire
+	cacheMaxEntries = 1024
+)
+
+// ResponseCache hold the responses of a service to its GET requests for a while, the identical requests of the clients
+// within the ttl are answered without reaching the upstream
+type ResponseCache struct {
+	ttl           time.Duration
+	maxEntryBytes int64
+	now           func() time.Time
+
+	lock    sync.Mutex
+	entries map[string]cachedResponse
+}
+
+type cachedResponse struct {
+	status  int
+	header  http.Header
+	body    []byte
+	stored  time.Time
+	expires time.Time
+}
+
+func NewResponseCache(ttl time.Duration, maxEntryBytes int64) *ResponseCache {
+	if maxEntryBytes <= 0 {
+		maxEntryBytes = defaultCacheMaxEntryBytes
+	}
+	return &ResponseCache{
+		ttl:           ttl,
+		maxEntryBytes: maxEntryBytes,
+		now:           time.Now,
+		entries:       make(map[string]cachedResponse),
+	}
+}
+
+// newServiceCaches return the response caches of the services caching their responses, a cache of current is kept
+// when its settings didn't change
+func newServiceCaches(services map[string]conf.ServiceConfiguration, current map[string]*ResponseCache) map[string]*ResponseCache {
+	caches := make(map[string]*ResponseCache)
+	for name, service := range services {
+		if service.CacheTTLSeconds <= 0 {
+			continue
+		}
+		cache := NewResponseCache(time.Duration(service.CacheTTLSeconds)*time.Second, service.CacheMaxEntryBytes)
+		if previous, ok := current[name]; ok && previous.ttl == cache.ttl && previous.maxEntryBytes == cache.maxEntryBytes {
+			cache = previous
+		}
+		caches[name] = cache
+	}
+	return caches
+}
+
+// Get return the response cached for key, unless it expired
+func (c *ResponseCache) Get(key string) (cachedResponse, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	entry, ok := c.entries[key]
+	if !ok {
+		return cachedResponse{}, false
+	}
+	if !c.now().Before(entry.expires) {
+		delete(c.entries, key)
+		return cachedResponse{}, false
+	}
+	return entry, true
+}
+
+// put keep a response, the expired ones are dropped first when the cache is full
+func (c *ResponseCache) put(key string, entry cachedResponse) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if _, ok := c.entries[key]; !ok && len(c.entries) >= cacheMaxEntries {
+		now := c.now()
+		for k, e := range c.entries {
+			if !now.Before(e.expires) {
+				delete(c.entries, k)
+			}
+		}
+		if len(c.entries) >= cacheMaxEntries {
+			return
+		}
+	}
+	c.entries[key] = entry
+}
+
+// responseCacheKey return the key of a request in the cache of its service, false for the requests that aren't cached:
+// other than GET, with a body, carrying credentials of the client, asking for a part of the response or not to store
+// it. The query is the normalized one, without the arkauth nor the client pubkey
+func responseCacheKey(r *http.Request, service string) (string, bool) {
+	if r.Method != http.MethodGet || r.ContentLength != 0 || isGRPCRequest(r) || websocket.IsWebSocketUpgrade(r) {
+		return "", false
+	}
+	for _, header := range []string{"Authorization", "Cookie", "Range"} {
+		if len(r.Header.Get(header)) > 0 {
+			return "", false
+		}
+	}
+	if _, ok := cacheControl(r.Header)["no-store"]; ok {
+		return "", false
+	}
+	values := r.URL.Query()
+	values.Del(QueryArkAuth)
+	values.Del(QueryClientPubKey)
+	return strings.Join([]string{service, r.URL.EscapedPath(), values.Encode(), r.Header.Get("Accept-Encoding")}, "\n"), true
+}
+
+// cacheTTL return how long an upstream response can be kept, false when it can't: other than a 200, streamed, over the
+// entry size, setting a cookie, varying on more than its encoding, private to the client or not to be stored. A max-age
+// shorter than the ttl of the service applies
+func (c *ResponseCache) cacheTTL(resp *http.Response) (time.Duration, bool) {
+	if resp.StatusCode != http.StatusOK || isStreamingResponse(resp) || resp.ContentLength > c.maxEntryBytes {
+		return 0, false
+	}
+	if len(resp.Header.Values("Set-Cookie")) > 0 {
+		return 0, false
+	}
+	for _, vary := range resp.Header.Values("Vary") {
+		for _, header := range strings.Split(vary, ",") {
+			if !strings.EqualFold(strings.TrimSpace(header), "Accept-Encoding") {
+				return 0, false
+			}
+		}
+	}
+	directives := cacheControl(resp.Header)
+	for _, directive := range []string{"no-store", "no-cache", "private"} {
+		if _, ok := directives[directive]; ok {
+			return 0, false
+		}
+	}
+	ttl := c.ttl
+	for _, directive := range []string{"s-maxage", "max-age"} {
+		value, ok := directives[directive]
+		if !ok {
+			continue
+		}
+		seconds, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || seconds <= 0 {
+			return 0, false
+		}
+		if maxAge := time.Duration(seconds) * time.Second; maxAge < ttl {
+			ttl = maxAge
+		}
+		break
+	}
+	return ttl, true
+}
+
+// cacheControl return the directives of the Cache-Control headers by lower cased name, with their unquoted value
+func cacheControl(header http.Header) map[string]string {
+	directives := make(map[string]string)
+	for _, value := range header.Values("Cache-Control") {
+		for _, directive := range strings.Split(value, ",") {
+			name, arg, _ := strings.Cut(strings.TrimSpace(directive), "=")
+			if len(name) > 0 {
+				directives[strings.ToLower(name)] = strings.Trim(arg, `"`)
+			}
+		}
+	}
+	return directives
+}
+
+// storeResponses wrap modify, the cacheable responses are kept for key once the client read them whole
+func (c *ResponseCache) storeResponses(key string, modify func(*http.Response) error) func(*http.Response) error {
+	return func(resp *http.Response) error {
+		ttl, cacheable := c.cacheTTL(resp)
+		if err := modify(resp); err != nil || !cacheable {
+			return err
+		}
+		resp.Body = &cachingBody{ReadCloser: resp.Body, cache: c, key: key, ttl: ttl, status: resp.StatusCode, header: resp.Header.Clone()}
+		return nil
+	}
+}
+
+// cachingBody copy a response as it is relayed and cache it once read to its end, unless it went over the entry size
+type cachingBody struct {
+	io.ReadCloser
+	cache  *ResponseCache
+	key    string
+	ttl    time.Duration
+	status int
+	header http.Header
+	body   bytes.Buffer
+	done   bool // stored, or too large to be
+}
+
+func (b *cachingBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	if b.done {
+		return n, err
+	}
+	if int64(b.body.Len()+n) > b.cache.maxEntryBytes {
+		b.done = true
+		b.body = bytes.Buffer{}
+		return n, err
+	}
+	b.body.Write(p[:n])
+	if errors.Is(err, io.EOF) {
+		b.done = true
+		now := b.cache.now()
+		b.cache.put(b.key, cachedResponse{status: b.status, header: b.header, body: b.body.Bytes(), stored: now, expires: now.Add(b.ttl)})
+	}
+	return n, err
+}
+
+// serve write a cached response, its Age the seconds since the upstream answered it
+func (e cachedResponse) serve(w http.ResponseWriter, now time.Time) {
+	header := w.Header()
+	for name, values := range e.header {
+		for _, value := range values {
+			header.Add(name, value)
+		}
+	}
+	header.Set("Age", strconv.FormatInt(int64(now.Sub(e.stored)/time.Second), 10))
+	w.WriteHeader(e.status)
+	_, _ = w.Write(e.body)
+}
+
+// serveFreeCacheHit serve a request from the cache of its service when its hits are free, before the request is
+// authenticated or charged. A miss goes on to be charged as any request
+func (p Proxy) serveFreeCacheHit(w http.ResponseWriter, r *http.Request) bool {
+	service := requestService(r)
+	cache, ok := p.serviceCaches[service]
+	if !ok || !p.Config.Services[service].CacheFreeHits {
+		return false
+	}
+	key, ok := responseCacheKey(r, service)
+	if !ok {
+		return false
+	}
+	cached, ok := cache.Get(key)
+	if !ok {
+		return false
+	}
+	p.Metrics.cacheRequest(service, true)
+	w.Header().Set("tier", accessTierCache)
+	accessRecordFrom(r).cacheHit(true)
+	cached.serve(w, cache.now())
+	return true
+}
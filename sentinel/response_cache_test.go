@@ -0,0 +1,183 @@
+package sentinel
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/arkeonetwork/arkeo/common"
+	"github.com/arkeonetwork/arkeo/sentinel/conf"
+)
+
+func TestResponseCache(t *testing.T) {
+	var lock sync.Mutex
+	calls := make(map[string]int)
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		calls[r.URL.Path]++
+		call := calls[r.URL.Path]
+		lock.Unlock()
+		switch r.URL.Path {
+		case "/nostore":
+			w.Header().Set("Cache-Control", "no-store")
+		case "/cookie":
+			w.Header().Set("Set-Cookie", "session=abc")
+		case "/private":
+			w.Header().Set("Cache-Control", "private, max-age=60")
+		case "/short":
+			w.Header().Set("Cache-Control", "public, max-age=2")
+		case "/large":
+			_, _ = w.Write([]byte(strings.Repeat("a", 100)))
+			return
+		case "/missing":
+			w.WriteHeader(http.StatusNotFound)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"call":%d}`, call)
+	}))
+	defer upstream.Close()
+	called := func(path string) int {
+		lock.Lock()
+		defer lock.Unlock()
+		return calls[path]
+	}
+
+	config := newTestConfig()
+	config.Services = map[string]conf.ServiceConfiguration{
+		common.BTCService.String(): {Upstream: upstream.URL, CacheTTLSeconds: 10, CacheMaxEntryBytes: 64},
+		common.ETHService.String(): {Upstream: upstream.URL, CacheTTLSeconds: 10, CacheFreeHits: true},
+	}
+	proxy, err := NewProxy(config)
+	require.NoError(t, err)
+	proxy.MemStore.SetHeight(10)
+	now := time.Now()
+	for _, cache := range proxy.serviceCaches {
+		cache.now = func() time.Time { return now }
+	}
+	btc := newWebsocketContract(1, 100, 100)
+	proxy.MemStore.Put(btc)
+	eth := newWebsocketContract(2, 100, 100)
+	eth.Service = common.ETHService
+	proxy.MemStore.Put(eth)
+	router := proxy.getRouter()
+
+	nonces := make(map[uint64]int64)
+	get := func(service common.Service, contractId uint64, path string, header http.Header) *httptest.ResponseRecorder {
+		uri := fmt.Sprintf("/%s%s", service, path)
+		if contractId > 0 {
+			nonces[contractId]++
+			separator := "?"
+			if strings.Contains(path, "?") {
+				separator = "&"
+			}
+			uri = fmt.Sprintf("%s%s%s=%d:%d", uri, separator, QueryArkAuth, contractId, nonces[contractId])
+		}
+		req := httptest.NewRequest(http.MethodGet, uri, nil)
+		for name, values := range header {
+			req.Header[name] = values
+		}
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	// a miss reaches the upstream, the identical requests within the ttl are answered from the cache and charged
+	w := get(common.BTCService, btc.Id, "/height?b=2&a=1", nil)
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, `{"call":1}`, w.Body.String())
+	require.Empty(t, w.Header().Get("Age"))
+	now = now.Add(3 * time.Second)
+	w = get(common.BTCService, btc.Id, "/height?a=1&b=2", nil)
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, `{"call":1}`, w.Body.String())
+	require.Equal(t, "3", w.Header().Get("Age"))
+	require.Equal(t, "application/json", w.Header().Get("Content-Type"))
+	require.Equal(t, "paid", w.Header().Get("tier"))
+	require.Equal(t, "1", w.Header().Get(CostHeader))
+	require.Equal(t, 1, called("/height"))
+	claim, err := proxy.ClaimStore.Get(btc.Key())
+	require.NoError(t, err)
+	require.Equal(t, int64(2), claim.Nonce)
+
+	// the free tier is served the same response, charged to its allowance
+	w = get(common.BTCService, 0, "/height?a=1&b=2", nil)
+	require.Equal(t, `{"call":1}`, w.Body.String())
+	require.Equal(t, "free", w.Header().Get("tier"))
+	require.Equal(t, 1, called("/height"))
+
+	// another query is another entry, the entry expires with the ttl
+	require.Equal(t, `{"call":2}`, get(common.BTCService, btc.Id, "/height?a=2", nil).Body.String())
+	now = now.Add(8 * time.Second)
+	w = get(common.BTCService, btc.Id, "/height?a=1&b=2", nil)
+	require.Equal(t, `{"call":3}`, w.Body.String())
+	require.Empty(t, w.Header().Get("Age"))
+
+	// the responses not to store, private, setting a cookie, over the entry size or other than a 200 aren't cached,
+	// nor the requests with credentials
+	for _, path := range []string{"/nostore", "/cookie", "/private", "/large", "/missing"} {
+		get(common.BTCService, btc.Id, path, nil)
+		get(common.BTCService, btc.Id, path, nil)
+		require.Equal(t, 2, called(path), path)
+	}
+	get(common.BTCService, btc.Id, "/auth", http.Header{"Authorization": {"Basic abc"}})
+	get(common.BTCService, btc.Id, "/auth", http.Header{"Authorization": {"Basic abc"}})
+	require.Equal(t, 2, called("/auth"))
+
+	// a max-age shorter than the ttl of the service applies
+	get(common.BTCService, btc.Id, "/short", nil)
+	now = now.Add(time.Second)
+	get(common.BTCService, btc.Id, "/short", nil)
+	require.Equal(t, 1, called("/short"))
+	now = now.Add(time.Second)
+	get(common.BTCService, btc.Id, "/short", nil)
+	require.Equal(t, 2, called("/short"))
+
+	// the hits of a service with free hits are neither authenticated nor charged
+	require.Equal(t, `{"call":1}`, get(common.ETHService, eth.Id, "/block", nil).Body.String())
+	w = get(common.ETHService, eth.Id, "/block", nil)
+	require.Equal(t, `{"call":1}`, w.Body.String())
+	require.Equal(t, "cache", w.Header().Get("tier"))
+	require.Empty(t, w.Header().Get(CostHeader))
+	claim, err = proxy.ClaimStore.Get(eth.Key())
+	require.NoError(t, err)
+	require.Equal(t, int64(1), claim.Nonce)
+	w = get(common.ETHService, 0, "/block", nil)
+	require.Equal(t, "cache", w.Header().Get("tier"))
+	require.Empty(t, w.Header().Get("X-Free-Tier-Remaining-Minute"))
+	require.Equal(t, 1, called("/block"))
+
+	// the cache settings are advertised
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, RoutesMetaData, nil))
+	var metadata Metadata
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &metadata))
+	require.Equal(t, int64(10), metadata.Configuration.Services[common.BTCService.String()].CacheTTLSeconds)
+	require.False(t, metadata.Configuration.Services[common.BTCService.String()].CacheFreeHits)
+	require.True(t, metadata.Configuration.Services[common.ETHService.String()].CacheFreeHits)
+}
+
+func TestResponseCacheFull(t *testing.T) {
+	cache := NewResponseCache(time.Minute, 0)
+	now := time.Now()
+	cache.now = func() time.Time { return now }
+	for i := 0; i < cacheMaxEntries; i++ {
+		cache.put(fmt.Sprint(i), cachedResponse{expires: now.Add(time.Duration(i+1) * time.Second)})
+	}
+	// a full cache takes no more entries until some expired
+	cache.put("new", cachedResponse{expires: now.Add(time.Minute)})
+	_, ok := cache.Get("new")
+	require.False(t, ok)
+	now = now.Add(2 * time.Second)
+	cache.put("new", cachedResponse{expires: now.Add(time.Minute)})
+	_, ok = cache.Get("new")
+	require.True(t, ok)
+	_, ok = cache.Get("0")
+	require.False(t, ok)
+}
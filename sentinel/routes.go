@@ -2,9 +2,20 @@ package sentinel
 
 const (
 	RoutesMetaData       = "/metadata.json"
+	RoutesProviderMeta   = "/providers/{provider}/metadata.json" // metadata of a provider identity, by name or pubkey
 	RoutesActiveContract = "/active-contract/{service}/{spender}"
 	RoutesClaim          = "/claim/{id}"
 	RoutesOpenClaims     = "/open-claims"
+	RoutesUsage          = "/usage" // usage of the caller's contract, authenticated with a contract auth
 	RouteManage          = "/manage/contract/{id}"
 	RouteProviderData    = "/provider/{service}"
+	RoutesHealth         = "/health"
+	RoutesAdminReload    = "/admin/reload"         // served on the admin listener only
+	RoutesAdminCompact   = "/admin/compact-claims" // served on the admin listener only
+	RoutesAdminContracts = "/admin/contracts"      // served on the admin listener only
+	RoutesAdminClaims    = "/admin/claims"         // served on the admin listener only
+	RoutesAdminLimits    = "/admin/limits"         // served on the admin listener only
+	RoutesAdminSubmit    = "/admin/submit-claim"   // served on the admin listener only
+	RoutesAdminExport    = "/admin/export-claims"  // served on the admin listener only
+	RoutesAdminRequests  = "/admin/requests"       // served on the admin listener only
 )
@@ -20,7 +20,7 @@ func setUpTest(t *testing.T, pk1, pk2 common.PubKey) *httptest.Server {
 	return httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
 		switch {
 		case strings.HasPrefix(req.RequestURI, "/arkeo/active-contract/"):
-			httpTestHandler(t, rw, fmt.Sprintf(`{"provider":"%s","service":10,"client":"%s","type":1,"height":100,"duration":100,"rate":{"denom":"uarkeo","amount":"1"},"deposit":"100","paid":"0","id":1,"settlement_duration":10,"queries_per_minute":100}`, pk1, pk2))
+			httpTestHandler(t, rw, fmt.Sprintf(`{"provider":"%s","service":10,"client":"%s","type":1,"height":100,"duration":100,"rate":{"denom":"uarkeo","amount":"1"},"deposit":"100","paid":"0","id":1,"settlement_duration":10,"queries_per_minute":100,"top_up":"0"}`, pk1, pk2))
 		default:
 			panic(fmt.Sprintf("could not serve request: %s", req.RequestURI))
 		}
@@ -117,7 +117,7 @@ func TestHandleClaim(t *testing.T) {
 		Spender:    inputContract.Client,
 		Nonce:      10,
 	}
-	_, err = proxy.paidTier(arkAuth, "")
+	_, _, err = proxy.paidTier(arkAuth, "", 1)
 	require.NoError(t, err)
 
 	// get the expected claim
@@ -185,7 +185,7 @@ func TestHandleOpenClaims(t *testing.T) {
 		Spender:    inputContract.Client,
 		Nonce:      10,
 	}
-	_, err = proxy.paidTier(arkAuth, "")
+	_, _, err = proxy.paidTier(arkAuth, "", 1)
 	require.NoError(t, err)
 
 	// repeat for a second contract rom a different client
@@ -202,7 +202,7 @@ func TestHandleOpenClaims(t *testing.T) {
 		Spender:    inputContract.Client,
 		Nonce:      15,
 	}
-	_, err = proxy.paidTier(arkAuth, "")
+	_, _, err = proxy.paidTier(arkAuth, "", 1)
 	require.NoError(t, err)
 
 	// we should have 2 valid claim in our store.
@@ -0,0 +1,401 @@
+package sentinel
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"github.com/arkeonetwork/arkeo/common"
+	"github.com/arkeonetwork/arkeo/sentinel/conf"
+)
+
+// validateServices return an error when a configured service isn't a known one, or its costs, minimum deposits,
+// connections, retries, circuit breaker, cache, accounting or free paths are invalid
+func validateServices(services map[string]conf.ServiceConfiguration) error {
+	for name, service := range services {
+		if _, ok := common.ServiceLookup[name]; !ok {
+			return fmt.Errorf("unknown service %s", name)
+		}
+		if err := validateCosts(name, service.Costs); err != nil {
+			return err
+		}
+		switch service.StreamAccounting {
+		case "", StreamAccountingRequest, StreamAccountingMinute, StreamAccountingEvent:
+		default:
+			return fmt.Errorf("unknown stream accounting %s of service %s", service.StreamAccounting, name)
+		}
+		switch service.ResponseAccounting {
+		case "", ResponseAccountingRequest, ResponseAccountingBytes, ResponseAccountingRequestBytes:
+		default:
+			return fmt.Errorf("unknown response accounting %s of service %s", service.ResponseAccounting, name)
+		}
+		if service.ResponseByteUnit < 0 {
+			return fmt.Errorf("service %s response byte unit must not be negative", name)
+		}
+		if service.MaxBatchSize < 0 {
+			return fmt.Errorf("service %s max batch size must not be negative", name)
+		}
+		if _, err := parseMinDeposits(name, service.MinDeposits); err != nil {
+			return err
+		}
+		if service.MaxIdleConns < 0 || service.IdleConnTimeoutSeconds < 0 || service.MaxConns < 0 {
+			return fmt.Errorf("service %s connection settings must not be negative", name)
+		}
+		if service.Retries < 0 {
+			return fmt.Errorf("service %s retries must not be negative", name)
+		}
+		if service.BreakerErrorRate < 0 || service.BreakerErrorRate > 100 {
+			return fmt.Errorf("service %s breaker error rate must be a percentage", name)
+		}
+		if service.CacheTTLSeconds < 0 || service.CacheMaxEntryBytes < 0 {
+			return fmt.Errorf("service %s cache settings must not be negative", name)
+		}
+		if err := validateFreePaths(name, service); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// serviceNames return the sorted names of the services served
+func serviceNames(proxies map[string]*url.URL) []string {
+	names := make([]string, 0, len(proxies))
+	for name := range proxies {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// newServiceLimiters return the limiters of the services capped across all clients
+func newServiceLimiters(services map[string]conf.ServiceConfiguration) map[string]*rate.Limiter {
+	limiters := make(map[string]*rate.Limiter)
+	for name, service := range services {
+		if service.RateLimit > 0 {
+			limiters[name] = rate.NewLimiter(rate.Every(time.Minute/time.Duration(service.RateLimit)), service.RateLimit)
+		}
+	}
+	return limiters
+}
+
+// newFreeTier return the sentinel wide free tier limiter, counting in the shared counters when there are some
+func newFreeTier(config conf.Configuration, shared *SharedCounters) (*FreeTierLimiter, error) {
+	limiter, err := NewFreeTierLimiter([]FreeTierWindow{
+		{Name: "Minute", Duration: time.Minute, Limit: config.FreeTierRateLimit},
+		{Name: "Day", Duration: 24 * time.Hour, Limit: config.FreeTierDailyLimit},
+	}, config.FreeTierAllowCIDRs, config.FreeTierMaxKeys)
+	if err == nil && shared != nil {
+		limiter.Share(shared, "")
+	}
+	return limiter, err
+}
+
+// newServiceFreeTiers return the free tier limiters of the services with their own allowance
+func newServiceFreeTiers(config conf.Configuration, shared *SharedCounters) (map[string]*FreeTierLimiter, error) {
+	limiters := make(map[string]*FreeTierLimiter)
+	for name, service := range config.Services {
+		if !service.HasFreeTierOverride() {
+			continue
+		}
+		minute, day := config.FreeTierRateLimit, config.FreeTierDailyLimit
+		if service.FreeTierRateLimit != 0 {
+			minute = service.FreeTierRateLimit
+		}
+		if service.FreeTierDailyLimit != 0 {
+			day = service.FreeTierDailyLimit
+		}
+		limiter, err := NewFreeTierLimiter([]FreeTierWindow{
+			{Name: "Minute", Duration: time.Minute, Limit: minute},
+			{Name: "Day", Duration: 24 * time.Hour, Limit: day},
+		}, config.FreeTierAllowCIDRs, config.FreeTierMaxKeys)
+		if err != nil {
+			return nil, fmt.Errorf("service %s: %w", name, err)
+		}
+		if shared != nil {
+			limiter.Share(shared, name)
+		}
+		limiters[name] = limiter
+	}
+	return limiters, nil
+}
+
+// requestService return the service a request is for, from the service header or the first path segment
+func requestService(r *http.Request) string {
+	if service := r.Header.Get(ServiceHeader); len(service) > 0 {
+		return service
+	}
+	if parts := strings.Split(r.URL.Path, "/"); len(parts) > 1 {
+		return parts[1]
+	}
+	return ""
+}
+
+// freeTierFor return the free tier limiter of a service and its per minute allowance
+func (p Proxy) freeTierFor(service string) (*FreeTierLimiter, int) {
+	if limiter, ok := p.serviceFreeTiers[service]; ok {
+		limit := p.Config.Services[service].FreeTierRateLimit
+		if limit == 0 {
+			limit = p.Config.FreeTierRateLimit
+		}
+		return limiter, limit
+	}
+	return p.FreeTier, p.Config.FreeTierRateLimit
+}
+
+// serviceRateLimit refuse the requests to a service over its limit across all clients
+func (p Proxy) serviceRateLimit(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if limiter, ok := p.serviceLimiters[requestService(r)]; ok && !limiter.Allow() {
+			respondWithError(w, "service rate limit exceeded", http.StatusTooManyRequests)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// requestDeadline cancel a proxied request once its time is up, the deadline of a plain http request is replaced by
+// the stream budget once its response turns out to be a stream
+type requestDeadline struct {
+	timer  *time.Timer
+	cancel context.CancelCauseFunc
+}
+
+// extend replace the deadline with one timeout from now, no deadline when the timeout isn't positive
+func (d *requestDeadline) extend(timeout time.Duration) {
+	if d.timer != nil {
+		d.timer.Stop()
+		d.timer = nil
+	}
+	if timeout > 0 {
+		d.timer = time.AfterFunc(timeout, func() { d.cancel(context.DeadlineExceeded) })
+	}
+}
+
+// stop release the deadline once the request is served
+func (d *requestDeadline) stop() {
+	d.extend(0)
+	d.cancel(context.Canceled)
+}
+
+// withServiceTimeout bound the time the upstream has to answer a plain http request
+func (p Proxy) withServiceTimeout(r *http.Request, service string) (*http.Request, *requestDeadline) {
+	ctx, cancel := context.WithCancelCause(r.Context())
+	deadline := &requestDeadline{cancel: cancel}
+	deadline.extend(time.Duration(p.Config.Services[service].TimeoutSeconds) * time.Second)
+	return r.WithContext(ctx), deadline
+}
+
+// upstreamErrorHandler reply with a gateway timeout when the upstream took longer than one of the service timeouts
+func (p Proxy) upstreamErrorHandler(service string) func(http.ResponseWriter, *http.Request, error) {
+	return func(w http.ResponseWriter, r *http.Request, err error) {
+		p.logger.Error("failed to proxy request", "error", err, "service", service)
+		var netErr net.Error
+		switch {
+		case errors.Is(err, context.DeadlineExceeded), errors.Is(context.Cause(r.Context()), context.DeadlineExceeded),
+			errors.As(err, &netErr) && netErr.Timeout():
+			respondWithError(w, "upstream timeout", http.StatusGatewayTimeout)
+		case errors.Is(err, errRequestTooLarge):
+			respondWithError(w, errRequestTooLarge.Error(), http.StatusRequestEntityTooLarge)
+		case errors.Is(err, errResponseTooLarge):
+			respondWithError(w, errResponseTooLarge.Error(), http.StatusBadGateway)
+		case errors.Is(err, errBodyHashMismatch):
+			respondWithJSON(w, http.StatusBadRequest, AuthError{Error: errBodyHashMismatch.Error(), Code: AuthErrorBodyHash})
+		default:
+			respondWithError(w, "upstream unavailable", http.StatusBadGateway)
+		}
+	}
+}
+
+// defaultMaxRequestBytes is the size limit of the request bodies of the services without their own
+const defaultMaxRequestBytes = 1 << 20
+
+// maxRequestBytes return the size limit of the request bodies of a service
+func (p Proxy) maxRequestBytes(service string) int64 {
+	if limit := p.Config.Services[service].MaxRequestBytes; limit > 0 {
+		return limit
+	}
+	return defaultMaxRequestBytes
+}
+
+var errRequestTooLarge = errors.New("request body too large")
+
+// limitRequestBody refuse the request bodies over the service limit before the request is charged, the bodies of
+// unknown length are read up to the limit first. A service streaming its request bodies has those of unknown length,
+// or over the limit, streamed to the upstream up to its streamed limit instead, counted as they are read. grpc streams
+// are metered per message instead
+func (p Proxy) limitRequestBody(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if isGRPCRequest(r) || r.Body == nil || r.Body == http.NoBody {
+			next.ServeHTTP(w, r)
+			return
+		}
+		service := requestService(r)
+		limit := p.maxRequestBytes(service)
+		if streamed := p.Config.Services[service].MaxStreamedRequestBytes; streamed > 0 && (r.ContentLength < 0 || r.ContentLength > limit) {
+			if r.ContentLength > streamed {
+				respondWithError(w, errRequestTooLarge.Error(), http.StatusRequestEntityTooLarge)
+				return
+			}
+			r.Body = &limitedBody{ReadCloser: r.Body, remaining: streamed, err: errRequestTooLarge}
+			next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), streamedBodyContextKey, true)))
+			return
+		}
+		if r.ContentLength > limit {
+			respondWithError(w, errRequestTooLarge.Error(), http.StatusRequestEntityTooLarge)
+			return
+		}
+		if r.ContentLength < 0 {
+			body, err := io.ReadAll(io.LimitReader(r.Body, limit+1))
+			if err != nil {
+				respondWithError(w, "fail to read request body", http.StatusBadRequest)
+				return
+			}
+			if int64(len(body)) > limit {
+				respondWithError(w, errRequestTooLarge.Error(), http.StatusRequestEntityTooLarge)
+				return
+			}
+			r.Body = io.NopCloser(bytes.NewReader(body))
+			r.ContentLength = int64(len(body))
+		}
+		r.Body = http.MaxBytesReader(w, r.Body, limit)
+		next.ServeHTTP(w, r)
+	})
+}
+
+// isStreamedRequest return true when the request body is streamed to the upstream as it is read, it can't be read
+// beforehand
+func isStreamedRequest(r *http.Request) bool {
+	streamed, _ := r.Context().Value(streamedBodyContextKey).(bool)
+	return streamed
+}
+
+var errResponseTooLarge = errors.New("upstream response too large")
+
+// limitResponseBody refuse the upstream responses over limit, with a bad gateway when their length is known upfront.
+// Otherwise the response is aborted once the limit is reached, the status is already sent by then
+func limitResponseBody(limit int64) func(*http.Response) error {
+	return func(resp *http.Response) error {
+		if resp.ContentLength > limit {
+			return errResponseTooLarge
+		}
+		resp.Body = &limitedBody{ReadCloser: resp.Body, remaining: limit, err: errResponseTooLarge}
+		return nil
+	}
+}
+
+// limitedBody fail with err once a body goes over its remaining bytes
+type limitedBody struct {
+	io.ReadCloser
+	remaining int64
+	err       error
+}
+
+func (b *limitedBody) Read(p []byte) (int, error) {
+	if b.remaining <= 0 {
+		// the body may end right at the limit
+		var probe [1]byte
+		n, err := b.ReadCloser.Read(probe[:])
+		if n > 0 {
+			return 0, b.err
+		}
+		return 0, err
+	}
+	if int64(len(p)) > b.remaining {
+		p = p[:b.remaining]
+	}
+	n, err := b.ReadCloser.Read(p)
+	b.remaining -= int64(n)
+	return n, err
+}
+
+// dialUpstream open the connections to the upstreams
+var dialUpstream = (&net.Dialer{Timeout: 30 * time.Second, KeepAlive: 30 * time.Second}).DialContext
+
+const (
+	defaultMaxIdleConns    = 64
+	defaultIdleConnTimeout = 90 * time.Second
+)
+
+// transportSettings is what the transport of a service is built from
+type transportSettings struct {
+	dialTimeout           time.Duration
+	responseHeaderTimeout time.Duration
+	maxIdleConns          int
+	idleConnTimeout       time.Duration
+	maxConns              int
+	http2                 bool
+}
+
+func newTransportSettings(service conf.ServiceConfiguration) transportSettings {
+	settings := transportSettings{
+		dialTimeout:           time.Duration(service.DialTimeoutMs) * time.Millisecond,
+		responseHeaderTimeout: time.Duration(service.ResponseHeaderTimeoutMs) * time.Millisecond,
+		maxIdleConns:          defaultMaxIdleConns,
+		idleConnTimeout:       defaultIdleConnTimeout,
+		maxConns:              service.MaxConns,
+		http2:                 !service.DisableHTTP2,
+	}
+	if service.MaxIdleConns > 0 {
+		settings.maxIdleConns = service.MaxIdleConns
+	}
+	if service.IdleConnTimeoutSeconds > 0 {
+		settings.idleConnTimeout = time.Duration(service.IdleConnTimeoutSeconds) * time.Second
+	}
+	return settings
+}
+
+// serviceTransport is the pool of connections to the upstream of a service, shared by all its requests. Https
+// upstreams are spoken to in http/2 when they support it, a single connection then carries the concurrent requests
+type serviceTransport struct {
+	*http.Transport
+	settings transportSettings
+}
+
+func newServiceTransport(settings transportSettings) *serviceTransport {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if settings.dialTimeout > 0 {
+		timeout := settings.dialTimeout
+		transport.DialContext = func(ctx context.Context, network, addr string) (net.Conn, error) {
+			ctx, cancel := context.WithTimeout(ctx, timeout)
+			defer cancel()
+			return dialUpstream(ctx, network, addr)
+		}
+	}
+	transport.ResponseHeaderTimeout = settings.responseHeaderTimeout
+	transport.MaxIdleConns = settings.maxIdleConns
+	transport.MaxIdleConnsPerHost = settings.maxIdleConns
+	transport.IdleConnTimeout = settings.idleConnTimeout
+	transport.MaxConnsPerHost = settings.maxConns
+	transport.ForceAttemptHTTP2 = settings.http2
+	if !settings.http2 {
+		// a non nil map keeps the transport from negotiating http/2
+		transport.TLSNextProto = make(map[string]func(string, *tls.Conn) http.RoundTripper)
+	}
+	return &serviceTransport{Transport: transport, settings: settings}
+}
+
+// newServiceTransports return the transports of the services served. The current transport of a service is kept, with
+// its connections, while its settings don't change
+func newServiceTransports(services map[string]conf.ServiceConfiguration, proxies map[string]*url.URL, current map[string]*serviceTransport) map[string]*serviceTransport {
+	transports := make(map[string]*serviceTransport, len(proxies))
+	for name := range proxies {
+		settings := newTransportSettings(services[name])
+		if transport, ok := current[name]; ok && transport.settings == settings {
+			transports[name] = transport
+			continue
+		}
+		transports[name] = newServiceTransport(settings)
+	}
+	return transports
+}
@@ -0,0 +1,382 @@
+package sentinel
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/arkeonetwork/arkeo/common"
+	"github.com/arkeonetwork/arkeo/sentinel/conf"
+)
+
+func TestServices(t *testing.T) {
+	newUpstream := func(name string) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(name + " " + r.URL.Path))
+		}))
+	}
+	btc := newUpstream("btc")
+	defer btc.Close()
+	eth := newUpstream("eth")
+	defer eth.Close()
+	slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(5 * time.Second):
+		}
+	}))
+	defer slow.Close()
+
+	config := newTestConfig()
+	config.Services = map[string]conf.ServiceConfiguration{
+		"btc-mainnet-fullnode": {Upstream: btc.URL, PathRewrite: "/v1", FreeTierRateLimit: 1},
+		"eth-mainnet-fullnode": {Upstream: eth.URL, RateLimit: 2},
+		"gaia-mainnet-rpc":     {Upstream: slow.URL, TimeoutSeconds: 1},
+	}
+	proxy, err := NewProxy(config)
+	require.NoError(t, err)
+	proxy.MemStore.SetHeight(10)
+	contract := newWebsocketContract(1, 100, 100)
+	proxy.MemStore.Put(contract)
+	server := httptest.NewServer(proxy.getRouter())
+	defer server.Close()
+
+	get := func(path string) (int, string) {
+		resp, err := http.Get(server.URL + path)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		return resp.StatusCode, string(body)
+	}
+
+	// each service goes to its own upstream, the path rewrite replace the service segment
+	code, body := get("/btc-mainnet-fullnode/block")
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, "btc /v1/block", body)
+	code, body = get("/eth-mainnet-fullnode/block")
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, "eth /block", body)
+
+	// the btc free tier allowance is its own, paid requests aren't bound by it
+	code, _ = get("/btc-mainnet-fullnode/block")
+	require.Equal(t, http.StatusTooManyRequests, code)
+	code, body = get(fmt.Sprintf("/btc-mainnet-fullnode/block?%s=%d:1", QueryArkAuth, contract.Id))
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, "btc /v1/block", body)
+
+	// a btc contract doesn't grant access to eth
+	code, _ = get(fmt.Sprintf("/eth-mainnet-fullnode/block?%s=%d:2", QueryArkAuth, contract.Id))
+	require.Equal(t, http.StatusUnauthorized, code)
+
+	// eth is capped across all clients
+	code, body = get("/eth-mainnet-fullnode/block")
+	require.Equal(t, http.StatusTooManyRequests, code)
+	require.Contains(t, body, "service rate limit exceeded")
+
+	// services not configured aren't served
+	code, _ = get("/doge-mainnet-fullnode/block")
+	require.Equal(t, http.StatusBadRequest, code)
+
+	// an upstream slower than the service timeout
+	code, body = get("/gaia-mainnet-rpc/status")
+	require.Equal(t, http.StatusGatewayTimeout, code)
+	require.Contains(t, body, "upstream timeout")
+
+	w := httptest.NewRecorder()
+	proxy.handleMetadata(w, httptest.NewRequest(http.MethodGet, RoutesMetaData, nil))
+	var metadata Metadata
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &metadata))
+	require.Equal(t, []string{"btc-mainnet-fullnode", "eth-mainnet-fullnode", "gaia-mainnet-rpc"}, metadata.Services)
+
+	// unknown services are refused
+	config.Services = map[string]conf.ServiceConfiguration{"not-a-service": {Upstream: btc.URL}}
+	_, err = NewProxy(config)
+	require.Error(t, err)
+
+	// all the known services are served when none is configured
+	require.Len(t, loadProxies(nil), len(common.ServiceLookup))
+}
+
+func TestServiceLimits(t *testing.T) {
+	defer func(dial func(context.Context, string, string) (net.Conn, error)) { dialUpstream = dial }(dialUpstream)
+	dialUpstream = func(ctx context.Context, _, _ string) (net.Conn, error) {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}
+
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/large":
+			_, _ = w.Write([]byte(strings.Repeat("a", 32)))
+		case "/stream":
+			for i := 0; i < 4; i++ {
+				_, _ = w.Write([]byte(strings.Repeat("a", 8)))
+				w.(http.Flusher).Flush()
+			}
+		case "/slow":
+			time.Sleep(500 * time.Millisecond)
+		default:
+			body, _ := io.ReadAll(r.Body)
+			_, _ = w.Write(body)
+		}
+	}))
+	defer upstream.Close()
+
+	config := newTestConfig()
+	config.Services = map[string]conf.ServiceConfiguration{
+		"btc-mainnet-fullnode": {Upstream: upstream.URL, MaxRequestBytes: 10, MaxResponseBytes: 16, StreamMaxBytes: 16},
+		"eth-mainnet-fullnode": {Upstream: upstream.URL, ResponseHeaderTimeoutMs: 50},
+		"gaia-mainnet-rpc":     {Upstream: upstream.URL, DialTimeoutMs: 50},
+	}
+	proxy, err := NewProxy(config)
+	require.NoError(t, err)
+	proxy.MemStore.SetHeight(10)
+	contract := newWebsocketContract(1, 100, 100)
+	proxy.MemStore.Put(contract)
+	server := httptest.NewServer(proxy.getRouter())
+	defer server.Close()
+
+	post := func(path string, body io.Reader) (int, string) {
+		resp, err := http.Post(server.URL+path, "application/json", body)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		data, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		return resp.StatusCode, string(data)
+	}
+	paid := func(path string, nonce int) string {
+		return fmt.Sprintf("%s?%s=%d:%d", path, QueryArkAuth, contract.Id, nonce)
+	}
+	claimKey := NewClaim(contract.Id, nil, 0, "").Key()
+
+	// oversized requests are refused before they are charged, whether their length is known or not
+	code, _ := post(paid("/btc-mainnet-fullnode/", 1), strings.NewReader(strings.Repeat("a", 11)))
+	require.Equal(t, http.StatusRequestEntityTooLarge, code)
+	code, _ = post(paid("/btc-mainnet-fullnode/", 1), io.MultiReader(strings.NewReader(strings.Repeat("a", 11))))
+	require.Equal(t, http.StatusRequestEntityTooLarge, code)
+	require.False(t, proxy.ClaimStore.Has(claimKey))
+
+	code, body := post(paid("/btc-mainnet-fullnode/", 1), io.MultiReader(strings.NewReader("0123456789")))
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, "0123456789", body)
+	claim, err := proxy.ClaimStore.Get(claimKey)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), claim.Nonce)
+
+	// oversized responses, the chunked ones within the stream budget
+	code, body = post("/btc-mainnet-fullnode/large", nil)
+	require.Equal(t, http.StatusBadGateway, code)
+	require.Contains(t, body, "upstream response too large")
+	resp, err := http.Post(server.URL+"/btc-mainnet-fullnode/stream", "application/json", nil)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	data, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	require.Error(t, err)
+	require.LessOrEqual(t, len(data), 16)
+
+	// upstream timeouts
+	code, body = post("/eth-mainnet-fullnode/slow", nil)
+	require.Equal(t, http.StatusGatewayTimeout, code)
+	require.Contains(t, body, "upstream timeout")
+	code, _ = post("/gaia-mainnet-rpc/", nil)
+	require.Equal(t, http.StatusGatewayTimeout, code)
+}
+
+func TestServiceTransports(t *testing.T) {
+	// an https upstream speaking http/2, counting the connections it accepts
+	var conns, http1 atomic.Int64
+	upstream := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.ProtoMajor != 2 {
+			http1.Add(1)
+		}
+		_, _ = w.Write([]byte("{}"))
+	}))
+	upstream.EnableHTTP2 = true
+	upstream.Config.ConnState = func(_ net.Conn, state http.ConnState) {
+		if state == http.StateNew {
+			conns.Add(1)
+		}
+	}
+	upstream.StartTLS()
+	defer upstream.Close()
+
+	config := newTestConfig()
+	config.FreeTierRateLimit = 1000
+	config.Services = map[string]conf.ServiceConfiguration{
+		"btc-mainnet-fullnode": {Upstream: upstream.URL},
+	}
+	proxy, err := NewProxy(config)
+	require.NoError(t, err)
+	transport := proxy.serviceTransports[common.BTCService.String()]
+	transport.TLSClientConfig = upstream.Client().Transport.(*http.Transport).TLSClientConfig.Clone()
+	router := proxy.getRouter()
+
+	get := func() {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, fmt.Sprintf("/%s", common.BTCService), nil))
+		require.Equal(t, http.StatusOK, w.Code)
+	}
+	get()
+	// concurrent bursts share the connection
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 20; j++ {
+				get()
+			}
+		}()
+	}
+	wg.Wait()
+	require.EqualValues(t, 1, conns.Load())
+	require.Zero(t, http1.Load())
+
+	metricsServer := httptest.NewServer(proxy.Metrics.Handler())
+	defer metricsServer.Close()
+	resp, err := http.Get(metricsServer.URL)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.Contains(t, string(body), `arkeo_sentinel_upstream_connections_total{reused="false",service="btc-mainnet-fullnode"} 1`)
+	require.Contains(t, string(body), `arkeo_sentinel_upstream_connections_total{reused="true",service="btc-mainnet-fullnode"} 200`)
+
+	// the transport and its connections are kept across reloads until its settings change
+	_, err = proxy.Reload(config)
+	require.NoError(t, err)
+	require.Same(t, transport, proxy.current().serviceTransports[common.BTCService.String()])
+	config.Services = map[string]conf.ServiceConfiguration{
+		"btc-mainnet-fullnode": {Upstream: upstream.URL, MaxIdleConns: 8, DisableHTTP2: true},
+	}
+	_, err = proxy.Reload(config)
+	require.NoError(t, err)
+	reloaded := proxy.current().serviceTransports[common.BTCService.String()]
+	require.NotSame(t, transport, reloaded)
+	require.Equal(t, 8, reloaded.MaxIdleConnsPerHost)
+	require.False(t, reloaded.ForceAttemptHTTP2)
+}
+
+func TestStreamedRequestBodies(t *testing.T) {
+	type upload struct {
+		size     int
+		checksum string
+		trailer  string
+	}
+	uploads := make(chan upload, 2)
+	started := make(chan struct{}, 2)
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hash := sha256.New()
+		first := make([]byte, 1)
+		n, _ := io.ReadFull(r.Body, first)
+		started <- struct{}{}
+		hash.Write(first[:n])
+		size, err := io.Copy(hash, r.Body)
+		if err != nil {
+			return
+		}
+		uploads <- upload{size: n + int(size), checksum: hex.EncodeToString(hash.Sum(nil)), trailer: r.Trailer.Get("X-Checksum")}
+	}))
+	defer upstream.Close()
+
+	config := newTestConfig()
+	config.Services = map[string]conf.ServiceConfiguration{
+		"btc-mainnet-fullnode": {
+			Upstream:                upstream.URL,
+			MaxStreamedRequestBytes: 8 << 20,
+			Costs:                   []conf.ServiceCost{{Method: http.MethodPost, Path: "/upload", Cost: 2}, {RPCMethod: "upload", Cost: 5}},
+		},
+		"eth-mainnet-fullnode": {Upstream: upstream.URL, MaxStreamedRequestBytes: 1 << 20},
+	}
+	proxy, err := NewProxy(config)
+	require.NoError(t, err)
+	proxy.MemStore.SetHeight(10)
+	contract := newWebsocketContract(1, 100, 100)
+	contract.Service = common.BTCService
+	proxy.MemStore.Put(contract)
+	other := newWebsocketContract(2, 100, 100)
+	other.Service = common.ETHService
+	proxy.MemStore.Put(other)
+	server := httptest.NewServer(proxy.getRouter())
+	defer server.Close()
+
+	// post a body of unknown length, written in chunks of 1MB, the next one once the upstream got the previous one
+	post := func(path string, contractId uint64, size int, checksum bool) (*http.Response, error) {
+		reader, writer := io.Pipe()
+		req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s%s?%s=%d:2", server.URL, path, QueryArkAuth, contractId), reader)
+		require.NoError(t, err)
+		req.ContentLength = -1
+		if checksum {
+			req.Trailer = http.Header{"X-Checksum": nil}
+		}
+		go func() {
+			hash := sha256.New()
+			chunk := []byte(strings.Repeat(`{"method":"upload"}`, 1<<20/19+1))[:1<<20]
+			for written := 0; written < size; written += len(chunk) {
+				hash.Write(chunk)
+				if _, err := writer.Write(chunk); err != nil {
+					return
+				}
+				if written == 0 {
+					select {
+					case <-started:
+					case <-time.After(5 * time.Second):
+						_ = writer.CloseWithError(fmt.Errorf("the upstream got nothing of the body before its end"))
+						return
+					}
+				}
+			}
+			if checksum {
+				req.Trailer.Set("X-Checksum", hex.EncodeToString(hash.Sum(nil)))
+			}
+			_ = writer.Close()
+		}()
+		return http.DefaultClient.Do(req)
+	}
+
+	// a multi megabytes body is streamed through as it comes, along with its trailers, and charged by path
+	resp, err := post("/btc-mainnet-fullnode/upload", contract.Id, 4<<20, true)
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, "2", resp.Header.Get(CostHeader))
+	got := <-uploads
+	require.Equal(t, 4<<20, got.size)
+	require.Equal(t, got.checksum, got.trailer)
+
+	// a body going over the streamed limit is cut mid stream, the upload was charged as it was accepted
+	resp, err = post("/eth-mainnet-fullnode/upload", other.Id, 3<<20, false)
+	require.NoError(t, err)
+	body, _ := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	require.Equal(t, http.StatusRequestEntityTooLarge, resp.StatusCode)
+	require.Contains(t, string(body), errRequestTooLarge.Error())
+	require.Empty(t, uploads)
+	claim, err := proxy.ClaimStore.Get(other.Key())
+	require.NoError(t, err)
+	require.Equal(t, int64(2), claim.Nonce)
+
+	// a body known to be over the streamed limit is refused before it is charged
+	resp, err = http.Post(fmt.Sprintf("%s/eth-mainnet-fullnode/upload?%s=%d:3", server.URL, QueryArkAuth, other.Id), "application/octet-stream", strings.NewReader(strings.Repeat("a", 1<<20+1)))
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+	require.Equal(t, http.StatusRequestEntityTooLarge, resp.StatusCode)
+	claim, err = proxy.ClaimStore.Get(other.Key())
+	require.NoError(t, err)
+	require.Equal(t, int64(2), claim.Nonce)
+}
@@ -0,0 +1,187 @@
+package sentinel
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+)
+
+const (
+	defaultSettleBackoff = 30 * time.Second
+	// settleMaxBackoff cap the time between two attempts at the final claim of a contract
+	settleMaxBackoff = 10 * time.Minute
+)
+
+// ContractSettler submit the final claim of the pay as you go contracts served once they expired. The chain settles a
+// contract by itself at the end of its settlement period, the settlement duration past its expiration, paying the
+// provider for the nonce claimed by then only: the queries served since the last claim are lost unless it is claimed
+// within the period. The claims are submitted through the auto claimer, with its key, dry run and submitted nonces
+type ContractSettler struct {
+	claimer     *AutoClaimer
+	graceBlocks func() int64 // blocks the expired contracts are still served, and charged, for
+	backoff     time.Duration
+	now         func() time.Time
+
+	lock     sync.Mutex
+	awaiting map[uint64]*settlementAttempt // contracts whose final claim isn't on chain yet
+}
+
+// settlementAttempt is the failed attempts at the final claim of a contract, the next one is held until retryAt
+type settlementAttempt struct {
+	failures int
+	retryAt  time.Time
+}
+
+// NewContractSettler return a settler of the claims of claimer, graceBlocks is read on each check as it can be reloaded
+func NewContractSettler(claimer *AutoClaimer, graceBlocks func() int64) *ContractSettler {
+	backoff := time.Duration(claimer.config.SettleBackoff) * time.Second
+	if backoff <= 0 {
+		backoff = defaultSettleBackoff
+	}
+	return &ContractSettler{
+		claimer:     claimer,
+		graceBlocks: graceBlocks,
+		backoff:     backoff,
+		now:         time.Now,
+		awaiting:    make(map[uint64]*settlementAttempt),
+	}
+}
+
+// Run submit the final claims due at the interval of the auto claimer until done is closed
+func (s *ContractSettler) Run(done <-chan struct{}) {
+	interval := time.Duration(s.claimer.config.IntervalSeconds) * time.Second
+	if interval <= 0 {
+		interval = defaultAutoClaimInterval
+	}
+	s.claimer.logger.Info("starting contract settlement", "interval", interval.String(), "dry_run", s.claimer.config.DryRun)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+			s.SettleDue(context.Background())
+		}
+	}
+}
+
+// settleFrom return the height the final claim of a contract is due from: past its expiration and the grace blocks it
+// is still charged within, but a block before its settlement period ends at the latest so the claim can be included
+func (s *ContractSettler) settleFrom(contract types.Contract, graceBlocks int64) int64 {
+	from := contract.Expiration() + graceBlocks + 1
+	if last := contract.SettlementPeriodEnd() - 1; from > last {
+		return last
+	}
+	return from
+}
+
+// SettleDue submit the final claims due, it returns the number of claims submitted (or logged in dry run mode). The
+// contracts are read again from the chain once expired, a contract the client closed, or already claimed up to the
+// last nonce, isn't claimed again
+func (s *ContractSettler) SettleDue(ctx context.Context) int {
+	a := s.claimer
+	a.submitLock.Lock()
+	defer a.submitLock.Unlock()
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	defer func() { a.metrics.setAwaitingSettlement(len(s.awaiting)) }()
+
+	height := a.contracts.GetHeight()
+	graceBlocks := s.graceBlocks()
+	now := s.now()
+	count := 0
+	claims := a.claims.List()
+	listed := make(map[uint64]bool, len(claims))
+	for _, claim := range claims {
+		listed[claim.ContractId] = true
+	}
+	// the claims compacted away are no longer awaited
+	for contractId := range s.awaiting {
+		if !listed[contractId] {
+			delete(s.awaiting, contractId)
+		}
+	}
+	for _, claim := range claims {
+		if claim.Claimed || claim.Signature == "" {
+			delete(s.awaiting, claim.ContractId)
+			continue
+		}
+		contract, err := a.contracts.Get(claim.Key())
+		if err != nil {
+			a.logger.Error("fail to get contract", "error", err, "contract_id", claim.ContractId)
+			continue
+		}
+		if !contract.IsPayAsYouGo() || height < s.settleFrom(contract, graceBlocks) {
+			continue
+		}
+		log := a.logger.With("contract_id", claim.ContractId, "nonce", claim.Nonce, "settlement_end", contract.SettlementPeriodEnd())
+		switch {
+		case contract.SettlementHeight > 0 || contract.Nonce >= claim.Nonce:
+			// settled on chain, or claimed up to the last nonce
+			delete(s.awaiting, claim.ContractId)
+			continue
+		case contract.IsSettled(height):
+			if _, ok := s.awaiting[claim.ContractId]; ok {
+				log.Error("settlement period over before the final claim was included, the queries since the last claim are lost", "claimed_nonce", contract.Nonce)
+				delete(s.awaiting, claim.ContractId)
+			}
+			continue
+		}
+		attempt, ok := s.awaiting[claim.ContractId]
+		if !ok {
+			attempt = &settlementAttempt{}
+			s.awaiting[claim.ContractId] = attempt
+		}
+		if a.submitted[claim.ContractId] >= claim.Nonce || now.Before(attempt.retryAt) {
+			continue
+		}
+		if a.config.DryRun {
+			log.Info("dry run, would submit the final claim of the contract")
+			a.metrics.autoClaim(AutoClaimResultDryRun)
+			a.submitted[claim.ContractId] = claim.Nonce
+			count++
+			continue
+		}
+		txHash, err := a.submit(ctx, contract, claim)
+		if err != nil {
+			if isAlreadySettled(err) {
+				log.Info("contract already settled", "error", err)
+				delete(s.awaiting, claim.ContractId)
+				continue
+			}
+			attempt.failures++
+			attempt.retryAt = now.Add(s.retryBackoff(attempt.failures))
+			log.Error("fail to submit the final claim of the contract", "error", err, "failures", attempt.failures, "retry_at", attempt.retryAt)
+			a.metrics.autoClaim(AutoClaimResultFailed)
+			continue
+		}
+		log.Info("submitted the final claim of the contract", "tx", txHash)
+		a.metrics.autoClaim(AutoClaimResultSubmitted)
+		a.submitted[claim.ContractId] = claim.Nonce
+		*attempt = settlementAttempt{}
+		count++
+	}
+	return count
+}
+
+// retryBackoff return the time to wait after failures attempts, doubled on each failure up to settleMaxBackoff
+func (s *ContractSettler) retryBackoff(failures int) time.Duration {
+	backoff := s.backoff
+	for i := 1; i < failures && backoff < settleMaxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > settleMaxBackoff {
+		return settleMaxBackoff
+	}
+	return backoff
+}
+
+// isAlreadySettled return true when the claim was rejected for the contract being settled, or claimed up to its nonce
+func isAlreadySettled(err error) bool {
+	return err != nil && (strings.Contains(err.Error(), types.ErrClaimContractIncomeClosed.Error()) ||
+		strings.Contains(err.Error(), "is greater than msg nonce"))
+}
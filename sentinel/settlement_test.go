@@ -0,0 +1,129 @@
+package sentinel
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/arkeonetwork/arkeo/sentinel/conf"
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+)
+
+// newSettlementTest return a settler with 2 grace blocks whose contracts are read from chain once expired, the
+// contracts expire at 110 and the chain settles them at 120
+func newSettlementTest(t *testing.T, config conf.AutoClaimConfiguration, contracts ...types.Contract) (*ContractSettler, *ClaimStore, *mockBroadcaster, contractChain) {
+	chain := contractChain{}
+	for i := range contracts {
+		contracts[i].SettlementDuration = 10
+		chain[strconv.FormatUint(contracts[i].Id, 10)] = contracts[i]
+	}
+	a, claims, broadcaster := newAutoClaimTest(t, config, 50, contracts...)
+	a.contracts.UseChain(chain)
+	for _, contract := range contracts {
+		require.NoError(t, claims.Set(NewClaim(contract.Id, contract.Client, 20, "aabb")))
+	}
+	settler := NewContractSettler(a, func() int64 { return 2 })
+	return settler, claims, broadcaster, chain
+}
+
+func TestContractSettlerTiming(t *testing.T) {
+	newTestConfig()
+	payg := newAutoClaimContract(1, types.ContractType_PAY_AS_YOU_GO, 10, 10000, 0)
+	subscription := newAutoClaimContract(2, types.ContractType_SUBSCRIPTION, 10, 10000, 0)
+	late := newAutoClaimContract(3, types.ContractType_PAY_AS_YOU_GO, 10, 10000, 0)
+	late.Duration = 105
+	settler, _, broadcaster, chain := newSettlementTest(t, conf.AutoClaimConfiguration{}, payg, subscription, late)
+	ctx := context.Background()
+
+	// nothing is due while the contracts are open or within the grace blocks
+	for _, height := range []int64{50, 110, 112} {
+		settler.claimer.contracts.SetHeight(height)
+		require.Zero(t, settler.SettleDue(ctx))
+	}
+	require.Empty(t, broadcaster.msgs)
+
+	// past the grace blocks, the final claim of the pay as you go contracts is submitted once, the subscriptions are
+	// paid by the chain on settlement
+	settler.claimer.contracts.SetHeight(113)
+	require.Equal(t, 1, settler.SettleDue(ctx))
+	require.Len(t, broadcaster.msgs, 1)
+	require.Equal(t, payg.Id, broadcaster.msgs[0].ContractId)
+	require.Equal(t, int64(20), broadcaster.msgs[0].Nonce)
+	require.Len(t, settler.awaiting, 1)
+	require.Zero(t, settler.SettleDue(ctx))
+	require.Len(t, broadcaster.msgs, 1)
+
+	// the claim is on chain, the contract is no longer awaited
+	payg.Nonce = 20
+	chain["1"] = payg
+	require.Zero(t, settler.SettleDue(ctx))
+	require.Empty(t, settler.awaiting)
+
+	// the failed claim is retried after a backoff, doubled on each failure
+	start := time.Now()
+	settler.now = func() time.Time { return start }
+	broadcaster.errs = []error{errors.New("connection refused"), errors.New("connection refused")}
+	settler.claimer.contracts.SetHeight(118)
+	require.Zero(t, settler.SettleDue(ctx))
+	require.Equal(t, start.Add(defaultSettleBackoff), settler.awaiting[late.Id].retryAt)
+	require.Zero(t, settler.SettleDue(ctx))
+	settler.now = func() time.Time { return start.Add(defaultSettleBackoff) }
+	require.Zero(t, settler.SettleDue(ctx))
+	require.Equal(t, 2, settler.awaiting[late.Id].failures)
+	require.Equal(t, start.Add(3*defaultSettleBackoff), settler.awaiting[late.Id].retryAt)
+	settler.now = func() time.Time { return start.Add(2 * defaultSettleBackoff) }
+	require.Zero(t, settler.SettleDue(ctx))
+	settler.now = func() time.Time { return start.Add(3 * defaultSettleBackoff) }
+	require.Equal(t, 1, settler.SettleDue(ctx))
+	require.Equal(t, late.Id, broadcaster.msgs[1].ContractId)
+	require.Zero(t, settler.awaiting[late.Id].failures)
+
+	require.Equal(t, settleMaxBackoff, settler.retryBackoff(20))
+}
+
+func TestContractSettlerAlreadySettled(t *testing.T) {
+	newTestConfig()
+	closed := newAutoClaimContract(1, types.ContractType_PAY_AS_YOU_GO, 10, 10000, 0)
+	closed.SettlementHeight = 111
+	claimed := newAutoClaimContract(2, types.ContractType_PAY_AS_YOU_GO, 10, 10000, 0)
+	claimed.Nonce = 20
+	rejected := newAutoClaimContract(3, types.ContractType_PAY_AS_YOU_GO, 10, 10000, 0)
+	lost := newAutoClaimContract(4, types.ContractType_PAY_AS_YOU_GO, 10, 10000, 0)
+	settler, _, broadcaster, _ := newSettlementTest(t, conf.AutoClaimConfiguration{}, closed, claimed, rejected, lost)
+	ctx := context.Background()
+
+	// the contracts the client closed, or claimed up to the last nonce on chain, aren't claimed again. The claim the
+	// chain rejects for the contract being settled isn't retried
+	broadcaster.errs = []error{
+		types.ErrClaimContractIncomeClosed,
+		errors.New("connection refused"),
+	}
+	settler.claimer.contracts.SetHeight(113)
+	require.Zero(t, settler.SettleDue(ctx))
+	require.Empty(t, broadcaster.msgs)
+	require.Len(t, settler.awaiting, 1)
+	require.Equal(t, 1, settler.awaiting[lost.Id].failures)
+
+	// the claim still failing by the end of the settlement period is given up
+	settler.claimer.contracts.SetHeight(120)
+	require.Zero(t, settler.SettleDue(ctx))
+	require.Empty(t, settler.awaiting)
+	require.Empty(t, broadcaster.msgs)
+}
+
+func TestContractSettlerDryRun(t *testing.T) {
+	newTestConfig()
+	payg := newAutoClaimContract(1, types.ContractType_PAY_AS_YOU_GO, 10, 10000, 0)
+	settler, _, broadcaster, _ := newSettlementTest(t, conf.AutoClaimConfiguration{DryRun: true}, payg)
+	settler.claimer.broadcaster = nil
+
+	settler.claimer.contracts.SetHeight(113)
+	require.Equal(t, 1, settler.SettleDue(context.Background()))
+	require.Equal(t, int64(20), settler.claimer.submitted[payg.Id])
+	require.Zero(t, settler.SettleDue(context.Background()))
+	require.Empty(t, broadcaster.msgs)
+}
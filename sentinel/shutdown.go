@@ -0,0 +1,71 @@
+package sentinel
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+)
+
+// serve run a server until it is shut down, reporting why it stopped otherwise
+func serve(errs chan<- error, listen func() error) {
+	if err := listen(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		errs <- err
+	}
+}
+
+// Shutdown stop the sentinel: the servers stop accepting connections, the requests in flight and the websocket
+// sessions have the grace period to finish, the claims due are submitted when configured, then the claim store is
+// synced to disk and closed
+func (p Proxy) Shutdown(servers ...*http.Server) {
+	grace := time.Duration(p.Config.ShutdownGracePeriod) * time.Second
+	p.logger.Info("shutting down", "grace_period", grace.String(), "in_flight", p.InFlight.InFlight())
+	ctx, cancel := context.WithTimeout(context.Background(), grace)
+	defer cancel()
+	for _, server := range servers {
+		if err := server.Shutdown(ctx); err != nil {
+			p.logger.Error("fail to shut down server", "addr", server.Addr, "error", err)
+		}
+	}
+	// the websocket sessions and the http/2 cleartext connections are hijacked, the servers don't wait for them
+	if remaining := p.drain(ctx); remaining > 0 {
+		p.logger.Error("grace period over, cutting requests in flight", "in_flight", remaining)
+	}
+	close(p.done)
+
+	if p.AutoClaimer != nil && p.Config.AutoClaim.OnShutdown {
+		claimCtx, cancel := context.WithTimeout(context.Background(), grace)
+		defer cancel()
+		p.logger.Info("submitted claims due on shutdown", "claims", p.AutoClaimer.ClaimDue(claimCtx))
+	}
+	if err := p.ClaimStore.Flush(); err != nil {
+		p.logger.Error("fail to flush claim store", "error", err)
+	}
+	if err := p.ClaimStore.Close(); err != nil {
+		p.logger.Error("fail to close claim store", "error", err)
+	}
+	if err := p.AccessLog.Close(); err != nil {
+		p.logger.Error("fail to close access log", "error", err)
+	}
+	if err := p.sharedCounters.Close(); err != nil {
+		p.logger.Error("fail to close rate limit store", "error", err)
+	}
+	p.logger.Info("shut down")
+}
+
+// drain wait for the requests in flight to finish until ctx is done, it returns the number left
+func (p Proxy) drain(ctx context.Context) int {
+	ticker := time.NewTicker(50 * time.Millisecond)
+	defer ticker.Stop()
+	for {
+		inFlight := p.InFlight.InFlight()
+		if inFlight == 0 {
+			return 0
+		}
+		select {
+		case <-ctx.Done():
+			return inFlight
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,111 @@
+package sentinel
+
+import (
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/arkeonetwork/arkeo/common"
+	"github.com/arkeonetwork/arkeo/sentinel/conf"
+)
+
+func TestShutdown(t *testing.T) {
+	// the upstream answers once the shutdown started
+	arrived := make(chan struct{})
+	release := make(chan struct{})
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		close(arrived)
+		<-release
+		_, _ = w.Write([]byte("{}"))
+	}))
+	defer upstream.Close()
+
+	location := filepath.Join(t.TempDir(), "claims")
+	config := newTestConfig()
+	config.ClaimStoreLocation = location
+	config.ShutdownGracePeriod = 5
+	config.Services = map[string]conf.ServiceConfiguration{
+		"btc-mainnet-fullnode": {Upstream: upstream.URL},
+	}
+	proxy, err := NewProxy(config)
+	require.NoError(t, err)
+	contract := newWebsocketContract(1, 100, 100)
+	proxy.MemStore.SetHeight(10)
+	proxy.MemStore.Put(contract)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := &http.Server{Handler: proxy.getRouter()}
+	errs := make(chan error, 1)
+	go serve(errs, func() error { return server.Serve(listener) })
+	url := fmt.Sprintf("http://%s/%s?%s=%d:1", listener.Addr(), common.BTCService, QueryArkAuth, contract.Id)
+
+	// the claim of the request in flight is recorded just before the shutdown
+	type response struct {
+		code int
+		body string
+		err  error
+	}
+	responses := make(chan response, 1)
+	go func() {
+		resp, err := http.Get(url)
+		if err != nil {
+			responses <- response{err: err}
+			return
+		}
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+		responses <- response{code: resp.StatusCode, body: string(body), err: err}
+	}()
+	select {
+	case <-arrived:
+	case <-time.After(5 * time.Second):
+		t.Fatal("request didn't reach the upstream")
+	}
+
+	shutdown := make(chan struct{})
+	go func() {
+		proxy.Shutdown(server)
+		close(shutdown)
+	}()
+	// new connections are refused while the request in flight is served
+	require.Eventually(t, func() bool {
+		conn, err := net.Dial("tcp", listener.Addr().String())
+		if err == nil {
+			_ = conn.Close()
+		}
+		return err != nil
+	}, 5*time.Second, 10*time.Millisecond)
+	close(release)
+
+	resp := <-responses
+	require.NoError(t, resp.err)
+	require.Equal(t, http.StatusOK, resp.code)
+	require.Equal(t, "{}", resp.body)
+	select {
+	case <-shutdown:
+	case <-time.After(5 * time.Second):
+		t.Fatal("shutdown didn't return")
+	}
+	require.Empty(t, errs)
+	select {
+	case <-proxy.done:
+	default:
+		t.Fatal("background tasks weren't stopped")
+	}
+
+	// the claim is there after the restart
+	store, err := NewClaimStorage(ClaimStoreTypeLevelDB, location)
+	require.NoError(t, err)
+	defer store.Close()
+	claim, err := store.Get(contract.Key())
+	require.NoError(t, err)
+	require.EqualValues(t, 1, claim.Nonce)
+}
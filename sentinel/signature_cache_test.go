@@ -0,0 +1,221 @@
+package sentinel
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/stretchr/testify/require"
+
+	"github.com/arkeonetwork/arkeo/common"
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+)
+
+func TestSignatureCache(t *testing.T) {
+	cache := NewSignatureCache(2, time.Minute)
+	spender := types.GetRandomPubKey()
+	now := time.Unix(1000, 0)
+	cache.now = func() time.Time { return now }
+	var calls int
+	verify := func(valid bool) func() bool {
+		return func() bool {
+			calls++
+			return valid
+		}
+	}
+
+	// positive
+	require.True(t, cache.Verify(1, spender, 1, []byte("good"), verify(true)))
+	require.True(t, cache.Verify(1, spender, 1, []byte("good"), verify(true)))
+	require.Equal(t, 1, calls)
+
+	// negative, remembered until the ttl expire
+	require.False(t, cache.Verify(1, spender, 2, []byte("bad"), verify(false)))
+	require.False(t, cache.Verify(1, spender, 2, []byte("bad"), verify(true)))
+	require.Equal(t, 2, calls)
+	now = now.Add(2 * time.Minute)
+	require.True(t, cache.Verify(1, spender, 2, []byte("bad"), verify(true)))
+	require.Equal(t, 3, calls)
+
+	// the nonce and signature are part of the key
+	require.False(t, cache.Verify(1, spender, 1, []byte("other"), verify(false)))
+	require.Equal(t, 4, calls)
+	require.Equal(t, 2, cache.Len())
+
+	// least recently used is evicted, (1, 1, good) wasn't used since
+	require.True(t, cache.Verify(1, spender, 1, []byte("good"), verify(true)))
+	require.Equal(t, 5, calls)
+
+	// closed contract
+	require.True(t, cache.Verify(2, spender, 1, []byte("good"), verify(true)))
+	cache.Remove(1)
+	require.Equal(t, 1, cache.Len())
+	require.True(t, cache.Verify(2, spender, 1, []byte("good"), verify(true)))
+	require.Equal(t, 6, calls)
+}
+
+func TestSignatureCacheConcurrent(t *testing.T) {
+	cache := NewSignatureCache(100, time.Minute)
+	spender := types.GetRandomPubKey()
+	var calls int32
+	var wg sync.WaitGroup
+	results := make([]bool, 50)
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			nonce := int64(i % 5)
+			results[i] = cache.Verify(1, spender, nonce, []byte("sig"), func() bool {
+				atomic.AddInt32(&calls, 1)
+				time.Sleep(10 * time.Millisecond)
+				return nonce%2 == 0
+			})
+		}(i)
+	}
+	wg.Wait()
+	for i, valid := range results {
+		require.Equal(t, (i%5)%2 == 0, valid)
+	}
+	// concurrent verifications of the same auth wait for the first
+	require.Equal(t, int32(5), atomic.LoadInt32(&calls))
+}
+
+func newSignedContract(t *testing.T, id uint64) (types.Contract, *secp256k1.PrivKey) {
+	key := secp256k1.GenPrivKey()
+	spender, err := common.NewPubKeyFromCrypto(key.PubKey())
+	require.NoError(t, err)
+	contract := newWebsocketContract(id, 100, 100)
+	contract.Client = spender
+	contract.Authorization = types.ContractAuthorization_STRICT
+	return contract, key
+}
+
+func signArkAuth(t *testing.T, key *secp256k1.PrivKey, contractId uint64, nonce int64) string {
+	signature, err := key.Sign(types.GetBytesToSign(contractId, nonce))
+	require.NoError(t, err)
+	return GenerateArkAuthString(contractId, nonce, signature)
+}
+
+func TestVerifyArkAuth(t *testing.T) {
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer upstream.Close()
+	config := newTestConfig()
+	config.FreeTierRateLimit = 0
+	proxy, err := NewProxy(config)
+	require.NoError(t, err)
+	proxy.proxies[common.BTCService.String()] = common.MustParseURL(upstream.URL)
+	proxy.MemStore.SetHeight(10)
+	contract, key := newSignedContract(t, 7)
+	proxy.MemStore.Put(contract)
+	router := proxy.getRouter()
+
+	get := func(auth string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, fmt.Sprintf("/%s?%s=%s", common.BTCService, QueryArkAuth, auth), nil))
+		return w
+	}
+
+	w := get(signArkAuth(t, key, contract.Id, 1))
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, "paid", w.Header().Get("tier"))
+
+	// signed by someone else, falls back to the closed free tier
+	w = get(signArkAuth(t, secp256k1.GenPrivKey(), contract.Id, 2))
+	require.Equal(t, http.StatusTooManyRequests, w.Code)
+	require.Equal(t, "free", w.Header().Get("tier"))
+	require.Equal(t, 2, proxy.Signatures.Len())
+
+	proxy.Signatures.Remove(contract.Id)
+	require.Zero(t, proxy.Signatures.Len())
+}
+
+func TestDelegateArkAuth(t *testing.T) {
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer upstream.Close()
+	config := newTestConfig()
+	config.FreeTierRateLimit = 0
+	proxy, err := NewProxy(config)
+	require.NoError(t, err)
+	proxy.proxies[common.BTCService.String()] = common.MustParseURL(upstream.URL)
+	proxy.MemStore.SetHeight(10)
+	router := proxy.getRouter()
+
+	newDelegate := func() (common.PubKey, *secp256k1.PrivKey) {
+		key := secp256k1.GenPrivKey()
+		pk, err := common.NewPubKeyFromCrypto(key.PubKey())
+		require.NoError(t, err)
+		return pk, key
+	}
+	delegated, clientKey := newSignedContract(t, 7)
+	var delegateKey *secp256k1.PrivKey
+	delegated.Delegate, delegateKey = newDelegate()
+	proxy.MemStore.Put(delegated)
+	undelegated, undelegatedKey := newSignedContract(t, 8)
+	proxy.MemStore.Put(undelegated)
+
+	get := func(auth string) string {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, fmt.Sprintf("/%s?%s=%s", common.BTCService, QueryArkAuth, auth), nil))
+		return w.Header().Get("tier")
+	}
+
+	// the delegate spend the contract, its usage is the contract's
+	require.Equal(t, "paid", get(signArkAuth(t, delegateKey, delegated.Id, 1)))
+	claim, err := proxy.ClaimStore.Get(delegated.Key())
+	require.NoError(t, err)
+	require.Equal(t, int64(1), claim.Nonce)
+	// the client of a delegated contract can't sign claims the chain would accept
+	require.Equal(t, "free", get(signArkAuth(t, clientKey, delegated.Id, 2)))
+
+	// without a delegate only the client is accepted
+	require.Equal(t, "free", get(signArkAuth(t, delegateKey, undelegated.Id, 1)))
+	require.Equal(t, "paid", get(signArkAuth(t, undelegatedKey, undelegated.Id, 1)))
+
+	// a rotated delegate, the previous key is refused even for a signature verified before
+	aa, err := parseArkAuth(signArkAuth(t, delegateKey, delegated.Id, 5))
+	require.NoError(t, err)
+	require.NoError(t, proxy.verifyArkAuth(aa, delegated))
+	var rotatedKey *secp256k1.PrivKey
+	delegated.Delegate, rotatedKey = newDelegate()
+	proxy.MemStore.Put(delegated)
+	require.Error(t, proxy.verifyArkAuth(aa, delegated))
+	require.Equal(t, "free", get(signArkAuth(t, delegateKey, delegated.Id, 3)))
+	require.Equal(t, "paid", get(signArkAuth(t, rotatedKey, delegated.Id, 3)))
+}
+
+// BenchmarkVerifyArkAuth compare a burst of identical auth material with and without the cache
+func BenchmarkVerifyArkAuth(b *testing.B) {
+	key := secp256k1.GenPrivKey()
+	msg := types.GetBytesToSign(1, 1)
+	signature, err := key.Sign(msg)
+	require.NoError(b, err)
+	pub := key.PubKey()
+	var verifications int64
+	verify := func() bool {
+		atomic.AddInt64(&verifications, 1)
+		return pub.VerifySignature(msg, signature)
+	}
+
+	b.Run("uncached", func(b *testing.B) {
+		verifications = 0
+		for i := 0; i < b.N; i++ {
+			verify()
+		}
+		b.ReportMetric(float64(verifications)/float64(b.N), "verifications/op")
+	})
+	b.Run("cached", func(b *testing.B) {
+		verifications = 0
+		cache := NewSignatureCache(defaultSignatureCacheSize, defaultSignatureNegativeTTL)
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				cache.Verify(1, common.EmptyPubKey, 1, signature, verify)
+			}
+		})
+		b.ReportMetric(float64(verifications)/float64(b.N), "verifications/op")
+	})
+}
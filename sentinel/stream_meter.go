@@ -0,0 +1,153 @@
+package sentinel
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"sync"
+
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+)
+
+type contextKey int
+
+const (
+	// contractContextKey hold the contract a paid request is served under
+	contractContextKey contextKey = iota
+	// accessRecordContextKey hold how a request is billed, for the access log
+	accessRecordContextKey
+	// upstreamOutcomeContextKey hold what the upstream made of a request, for the circuit breaker
+	upstreamOutcomeContextKey
+	// streamedBodyContextKey flag the requests whose body is streamed to the upstream as it is read
+	streamedBodyContextKey
+)
+
+var (
+	ErrContractExpired     = errors.New("contract expired")
+	ErrContractSpent       = errors.New("contract deposit spent")
+	ErrFreeTierRateLimited = errors.New("free tier rate limit exceeded")
+)
+
+// StreamUsage count the queries consumed over long lived connections (websockets, grpc streams) per contract, on
+// top of the signed nonce of the contract's claim
+type StreamUsage struct {
+	lock  sync.Mutex
+	usage map[uint64]int64
+}
+
+func NewStreamUsage() *StreamUsage {
+	return &StreamUsage{
+		usage: make(map[uint64]int64),
+	}
+}
+
+// Add record queries consumed by a contract and return its total
+func (u *StreamUsage) Add(contractId uint64, queries int64) int64 {
+	u.lock.Lock()
+	defer u.lock.Unlock()
+	u.usage[contractId] += queries
+	return u.usage[contractId]
+}
+
+// Get return the queries consumed by a contract over long lived connections
+func (u *StreamUsage) Get(contractId uint64) int64 {
+	u.lock.Lock()
+	defer u.lock.Unlock()
+	return u.usage[contractId]
+}
+
+// Remove forget the usage of a closed contract
+func (u *StreamUsage) Remove(contractId uint64) {
+	u.lock.Lock()
+	defer u.lock.Unlock()
+	delete(u.usage, contractId)
+}
+
+// streamMeter charge the messages of an already authenticated connection to its contract, or to the free tier
+// allowance of the client when there is no contract
+type streamMeter struct {
+	proxy    Proxy
+	contract types.Contract
+	ip       string
+	pubkey   string
+	record   *accessRecord
+}
+
+// newStreamMeter return the meter of a request that passed the auth middleware
+func (p Proxy) newStreamMeter(r *http.Request, pubkey string) streamMeter {
+	m := streamMeter{
+		proxy:  p,
+		ip:     clientIP(p.getRemoteAddr(r)),
+		pubkey: pubkey,
+		record: accessRecordFrom(r),
+	}
+	if contract, ok := r.Context().Value(contractContextKey).(types.Contract); ok {
+		m.contract = contract
+	}
+	return m
+}
+
+// withContract attach the contract a paid request is served under
+func withContract(r *http.Request, contract types.Contract) *http.Request {
+	return r.WithContext(context.WithValue(r.Context(), contractContextKey, contract))
+}
+
+// refresh return the latest state of the contract
+func (m streamMeter) refresh() types.Contract {
+	contract, err := m.proxy.MemStore.Get(strconv.FormatUint(m.contract.Id, 10))
+	if err != nil {
+		return m.contract
+	}
+	return contract
+}
+
+// limit take a token from the contract's rate limit, or the free tier allowance
+func (m streamMeter) limit() error {
+	if m.contract.Id == 0 {
+		if m.proxy.FreeTier.IsAllowListed(m.ip) {
+			return nil
+		}
+		if ok, _ := m.proxy.FreeTier.Allow(m.ip, m.pubkey); !ok {
+			return ErrFreeTierRateLimited
+		}
+		return nil
+	}
+	contract := m.refresh()
+	if ok, retryAfter := m.proxy.ContractLimiter.Allow(contract); !ok {
+		return &contractRateLimitError{contract: contract, retryAfter: retryAfter}
+	}
+	return nil
+}
+
+// check the contract is still open and its deposit cover the queries about to be consumed
+func (m streamMeter) check(queries int64) error {
+	if m.contract.Id == 0 {
+		return nil
+	}
+	contract := m.refresh()
+	if contract.IsExpired(m.proxy.MemStore.GetHeight()) {
+		return ErrContractExpired
+	}
+	var nonce int64
+	if claim, err := m.proxy.ClaimStore.Get(contract.Key()); err == nil {
+		nonce = claim.Nonce
+	}
+	used := nonce + m.proxy.StreamUsage.Get(contract.Id)
+	if err := m.proxy.checkDeposit(contract, used, queries); err != nil {
+		return err
+	}
+	return m.proxy.checkMinDeposit(contract, used)
+}
+
+// charge account queries against the contract
+func (m streamMeter) charge(queries int64) error {
+	if err := m.check(queries); err != nil {
+		return err
+	}
+	if m.contract.Id > 0 {
+		m.proxy.StreamUsage.Add(m.contract.Id, queries)
+		m.record.charge(queries)
+	}
+	return nil
+}
@@ -0,0 +1,228 @@
+package sentinel
+
+import (
+	"io"
+	"mime"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/arkeonetwork/arkeo/sentinel/conf"
+)
+
+const (
+	// StreamAccountingRequest charge a streamed response as the one request it answers
+	StreamAccountingRequest = "request"
+	// StreamAccountingMinute also charge one query per started minute of stream after the first
+	StreamAccountingMinute = "minute"
+	// StreamAccountingEvent also charge one query per server sent event, or per chunk of other streams
+	StreamAccountingEvent = "event"
+)
+
+// the length of a billed minute of stream. Variable so tests don't have to wait
+var streamMinute = time.Minute
+
+// isStreamingResponse tell whether the upstream streams its response, server sent events or a chunked body, rather
+// than sending it at once
+func isStreamingResponse(resp *http.Response) bool {
+	if isEventStream(resp) {
+		return true
+	}
+	for _, encoding := range resp.TransferEncoding {
+		if encoding == "chunked" {
+			return true
+		}
+	}
+	return false
+}
+
+// isEventStream tell whether the response is a stream of server sent events
+func isEventStream(resp *http.Response) bool {
+	mediaType, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	return err == nil && mediaType == "text/event-stream"
+}
+
+// streamAccounting return how the streamed responses of a service are charged, once per request unless configured
+// otherwise
+func (p Proxy) streamAccounting(service string) string {
+	switch mode := p.Config.Services[service].StreamAccounting; mode {
+	case StreamAccountingMinute, StreamAccountingEvent:
+		return mode
+	default:
+		return StreamAccountingRequest
+	}
+}
+
+// modifyResponse return the hook preparing the upstream responses of a service for the client. A streamed response is
+// relayed as it comes, the reverse proxy flushing each write, within the stream budget of the service instead of its
+// timeout and response size limit. The bytes of the responses are charged as they are relayed when so configured
+func (p Proxy) modifyResponse(w http.ResponseWriter, r *http.Request, service, pubkey string, deadline *requestDeadline) func(*http.Response) error {
+	settings := p.Config.Services[service]
+	stripCORS := len(p.Config.CORS.AllowOrigins) > 0
+	return func(resp *http.Response) error {
+		accessRecordFrom(r).upstreamResponded()
+		p.stripResponseHeaders(resp.Header, service)
+		if stripCORS {
+			stripCORSHeaders(resp.Header)
+		}
+		if isStreamingResponse(resp) {
+			p.streamResponse(w, r, resp, service, settings, pubkey, deadline)
+		} else if settings.MaxResponseBytes > 0 {
+			if err := limitResponseBody(settings.MaxResponseBytes)(resp); err != nil {
+				return err
+			}
+		}
+		p.meterResponseBytes(w, r, resp, service, pubkey)
+		return nil
+	}
+}
+
+// streamResponse give a streamed response the stream budget of its service and meter it as configured
+func (p Proxy) streamResponse(w http.ResponseWriter, r *http.Request, resp *http.Response, service string, settings conf.ServiceConfiguration, pubkey string, deadline *requestDeadline) {
+	budget := time.Duration(settings.StreamMaxSeconds) * time.Second
+	deadline.extend(budget)
+
+	// the server read and write timeouts are meant for plain requests, they would cut the stream (an expired read
+	// deadline cancel the request). Unsupported by the writer when served by a test recorder
+	var until time.Time
+	if budget > 0 {
+		until = time.Now().Add(budget)
+	}
+	controller := http.NewResponseController(w)
+	_ = controller.SetReadDeadline(until)
+	_ = controller.SetWriteDeadline(until)
+
+	if settings.StreamMaxBytes > 0 {
+		resp.Body = &limitedBody{ReadCloser: resp.Body, remaining: settings.StreamMaxBytes, err: errResponseTooLarge}
+	}
+	switch p.streamAccounting(service) {
+	case StreamAccountingEvent:
+		resp.Body = newMeteredStream(resp.Body, p.newStreamMeter(r, pubkey), true, isEventStream(resp), p)
+	case StreamAccountingMinute:
+		stream := newMeteredStream(resp.Body, p.newStreamMeter(r, pubkey), false, false, p)
+		go stream.watch()
+		resp.Body = stream
+	}
+}
+
+// meteredStream charge a streamed response to the contract (or the free tier allowance) of its request as it is read,
+// per event or per started minute. The stream ends, as if the upstream was done, once a charge is refused
+type meteredStream struct {
+	io.ReadCloser
+	meter streamMeter
+	proxy Proxy
+	// perEvent charge the server sent events when sse is set, each chunk read otherwise
+	perEvent bool
+	sse      bool
+	// state of the event parsing across reads
+	lineStart bool
+	comment   bool
+	pending   bool
+
+	ended atomic.Bool
+	done  chan struct{}
+	once  sync.Once
+}
+
+func newMeteredStream(body io.ReadCloser, meter streamMeter, perEvent, sse bool, p Proxy) *meteredStream {
+	return &meteredStream{
+		ReadCloser: body,
+		meter:      meter,
+		proxy:      p,
+		perEvent:   perEvent,
+		sse:        sse,
+		lineStart:  true,
+		done:       make(chan struct{}),
+	}
+}
+
+func (s *meteredStream) Read(b []byte) (int, error) {
+	if s.ended.Load() {
+		return 0, io.EOF
+	}
+	n, err := s.ReadCloser.Read(b)
+	// closed by the minute meter
+	if s.ended.Load() {
+		return 0, io.EOF
+	}
+	if s.perEvent && n > 0 {
+		events := int64(1)
+		if s.sse {
+			events = s.events(b[:n])
+		}
+		for i := int64(0); i < events; i++ {
+			if chargeErr := s.charge(); chargeErr != nil {
+				// the chunk isn't paid for, it isn't relayed
+				s.end(chargeErr)
+				return 0, io.EOF
+			}
+		}
+	}
+	return n, err
+}
+
+// events return the number of server sent events completed by a chunk, an event ends with a blank line and comment
+// lines aren't events
+func (s *meteredStream) events(chunk []byte) int64 {
+	var events int64
+	for _, c := range chunk {
+		switch c {
+		case '\r':
+		case '\n':
+			if s.lineStart && s.pending {
+				events++
+				s.pending = false
+			}
+			s.lineStart = true
+		default:
+			if s.lineStart {
+				s.comment = c == ':'
+				s.lineStart = false
+			}
+			if !s.comment {
+				s.pending = true
+			}
+		}
+	}
+	return events
+}
+
+// charge take one query from the rate limit and the deposit of the stream
+func (s *meteredStream) charge() error {
+	if err := s.meter.limit(); err != nil {
+		return err
+	}
+	return s.meter.charge(1)
+}
+
+// watch bill the started minutes of the stream, ending it once the contract can no longer pay for it
+func (s *meteredStream) watch() {
+	minutes := time.NewTicker(streamMinute)
+	defer minutes.Stop()
+	for {
+		select {
+		case <-s.done:
+			return
+		case <-minutes.C:
+			if err := s.meter.charge(1); err != nil {
+				s.end(err)
+				// unblock the pending read
+				_ = s.ReadCloser.Close()
+				return
+			}
+		}
+	}
+}
+
+// end stop relaying the stream, the client sees the response end
+func (s *meteredStream) end(err error) {
+	if s.ended.CompareAndSwap(false, true) {
+		s.proxy.logger.Info("ending stream", "reason", err, "contract_id", s.meter.contract.Id)
+	}
+}
+
+func (s *meteredStream) Close() error {
+	s.once.Do(func() { close(s.done) })
+	return s.ReadCloser.Close()
+}
@@ -0,0 +1,105 @@
+package sentinel
+
+import (
+	"bufio"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/arkeonetwork/arkeo/common"
+	"github.com/arkeonetwork/arkeo/sentinel/conf"
+)
+
+func TestStreamingResponse(t *testing.T) {
+	// the upstream sends an event, then waits for the client to have received it before the next one
+	received := make(chan struct{})
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/event-stream")
+		_, _ = w.Write([]byte(": connected\n\n"))
+		for i := 0; i < 5; i++ {
+			_, _ = fmt.Fprintf(w, "id: %d\r\ndata: event %d\r\n\r\n", i, i)
+			w.(http.Flusher).Flush()
+			select {
+			case <-received:
+			case <-time.After(5 * time.Second):
+				return
+			}
+			time.Sleep(400 * time.Millisecond)
+		}
+	}))
+	defer upstream.Close()
+
+	config := newTestConfig()
+	config.Services = map[string]conf.ServiceConfiguration{
+		// the stream outlives the timeout of the plain requests and the response size limit
+		"btc-mainnet-fullnode": {Upstream: upstream.URL, TimeoutSeconds: 1, MaxResponseBytes: 16, StreamAccounting: StreamAccountingEvent},
+	}
+	proxy, err := NewProxy(config)
+	require.NoError(t, err)
+	contract := newWebsocketContract(1, 100, 100)
+	proxy.MemStore.SetHeight(10)
+	proxy.MemStore.Put(contract)
+
+	server := httptest.NewUnstartedServer(proxy.getRouter())
+	server.Config.ReadTimeout = 500 * time.Millisecond
+	server.Config.WriteTimeout = 500 * time.Millisecond
+	server.Start()
+	defer server.Close()
+
+	resp, err := http.Get(fmt.Sprintf("%s/%s?%s=%d:1", server.URL, common.BTCService, QueryArkAuth, contract.Id))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, "paid", resp.Header.Get("tier"))
+
+	start := time.Now()
+	scanner := bufio.NewScanner(resp.Body)
+	events := 0
+	for scanner.Scan() {
+		if !strings.HasPrefix(scanner.Text(), "data: ") {
+			continue
+		}
+		require.Equal(t, fmt.Sprintf("data: event %d", events), scanner.Text())
+		events++
+		received <- struct{}{}
+	}
+	require.NoError(t, scanner.Err())
+	require.Equal(t, 5, events)
+	require.Greater(t, time.Since(start), 1500*time.Millisecond)
+	// one query per event on top of the request
+	require.EqualValues(t, 5, proxy.StreamUsage.Get(contract.Id))
+}
+
+func TestStreamingResponseSpent(t *testing.T) {
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/event-stream")
+		for i := 0; i < 10; i++ {
+			_, _ = fmt.Fprintf(w, "data: event %d\n\n", i)
+			w.(http.Flusher).Flush()
+			time.Sleep(10 * time.Millisecond)
+		}
+	}))
+	defer upstream.Close()
+
+	config := newTestConfig()
+	config.Services = map[string]conf.ServiceConfiguration{
+		"btc-mainnet-fullnode": {Upstream: upstream.URL, StreamAccounting: StreamAccountingEvent},
+	}
+	proxy, err := NewProxy(config)
+	require.NoError(t, err)
+	// the deposit covers the request and three events
+	contract := newWebsocketContract(1, 100, 4)
+	proxy.MemStore.SetHeight(10)
+	proxy.MemStore.Put(contract)
+
+	w := httptest.NewRecorder()
+	proxy.getRouter().ServeHTTP(w, httptest.NewRequest(http.MethodGet, fmt.Sprintf("/%s?%s=%d:1", common.BTCService, QueryArkAuth, contract.Id), nil))
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, "data: event 0\n\ndata: event 1\n\ndata: event 2\n\n", w.Body.String())
+	require.EqualValues(t, 3, proxy.StreamUsage.Get(contract.Id))
+}
@@ -0,0 +1,91 @@
+package sentinel
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/http"
+
+	"golang.org/x/crypto/acme/autocert"
+
+	"github.com/arkeonetwork/arkeo/sentinel/conf"
+)
+
+// defaultTLSConfig follow the modern compatibility recommendations: tls 1.2 and up, forward secret AEAD cipher
+// suites only. Tls 1.3 suites are not configurable and all fine
+func defaultTLSConfig() *tls.Config {
+	return &tls.Config{
+		MinVersion:       tls.VersionTLS12,
+		CurvePreferences: []tls.CurveID{tls.X25519, tls.CurveP256},
+		CipherSuites: []uint16{
+			tls.TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256,
+			tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256,
+			tls.TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384,
+			tls.TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384,
+			tls.TLS_ECDHE_ECDSA_WITH_CHACHA20_POLY1305_SHA256,
+			tls.TLS_ECDHE_RSA_WITH_CHACHA20_POLY1305_SHA256,
+		},
+		NextProtos: []string{"h2", "http/1.1"},
+	}
+}
+
+// newAutocertManager return the ACME client obtaining certificates for the configured hosts only
+func newAutocertManager(config conf.TLSConfiguration) *autocert.Manager {
+	return &autocert.Manager{
+		Prompt:     autocert.AcceptTOS,
+		HostPolicy: autocert.HostWhitelist(config.Hosts...),
+		Cache:      autocert.DirCache(expandHome(config.ACMECacheDir)),
+		Email:      config.ACMEEmail,
+	}
+}
+
+// newTLSConfig return the tls configuration of the https listener and the handler of the plain http listener,
+// redirecting to https and, with ACME, answering the http-01 challenges. Tls-alpn-01 challenges are answered by the
+// https listener itself
+func newTLSConfig(config conf.TLSConfiguration) (*tls.Config, http.Handler, error) {
+	redirect := redirectToHTTPS(config.Port)
+	if config.HasACME() {
+		manager := newAutocertManager(config)
+		tlsConfig := defaultTLSConfig()
+		tlsConfig.GetCertificate = manager.GetCertificate
+		tlsConfig.NextProtos = append(tlsConfig.NextProtos, "acme-tls/1")
+		return tlsConfig, manager.HTTPHandler(redirect), nil
+	}
+
+	cert, err := tls.LoadX509KeyPair(config.Cert, config.Key)
+	if err != nil {
+		return nil, nil, fmt.Errorf("fail to load tls certificate: %w", err)
+	}
+	tlsConfig := defaultTLSConfig()
+	tlsConfig.Certificates = []tls.Certificate{cert}
+	return tlsConfig, redirect, nil
+}
+
+// redirectToHTTPS send plain http clients to the same url on the https port
+func redirectToHTTPS(port string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		host := r.Host
+		if h, _, err := net.SplitHostPort(r.Host); err == nil {
+			host = h
+		}
+		if port != "" && port != "443" {
+			host = net.JoinHostPort(host, port)
+		}
+		http.Redirect(w, r, "https://"+host+r.URL.RequestURI(), http.StatusMovedPermanently)
+	})
+}
+
+// publicURL return the url clients should reach the sentinel at, empty when the public host isn't configured
+func publicURL(config conf.Configuration) string {
+	if len(config.TLS.Hosts) == 0 {
+		return ""
+	}
+	host := config.TLS.Hosts[0]
+	if !config.TLS.HasTLS() {
+		return fmt.Sprintf("http://%s", net.JoinHostPort(host, config.Port))
+	}
+	if config.TLS.Port != "" && config.TLS.Port != "443" {
+		host = net.JoinHostPort(host, config.TLS.Port)
+	}
+	return fmt.Sprintf("https://%s", host)
+}
@@ -0,0 +1,135 @@
+package sentinel
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/arkeonetwork/arkeo/sentinel/conf"
+)
+
+// writeSelfSignedCert write a certificate for localhost and return the cert and key paths
+func writeSelfSignedCert(t *testing.T) (string, string, *x509.Certificate) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "localhost"},
+		DNSNames:     []string{"localhost"},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	certPath := filepath.Join(dir, "cert.pem")
+	keyPath := filepath.Join(dir, "key.pem")
+	require.NoError(t, os.WriteFile(certPath, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600))
+	require.NoError(t, os.WriteFile(keyPath, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0o600))
+	return certPath, keyPath, cert
+}
+
+func TestStaticCertServing(t *testing.T) {
+	certPath, keyPath, cert := writeSelfSignedCert(t)
+	config := newTestConfig()
+	config.TLS = conf.TLSConfiguration{Cert: certPath, Key: keyPath, Hosts: []string{"sentinel.example.com"}, Port: "8443"}
+	proxy, err := NewProxy(config)
+	require.NoError(t, err)
+
+	tlsConfig, _, err := newTLSConfig(config.TLS)
+	require.NoError(t, err)
+	server := httptest.NewUnstartedServer(proxy.getRouter())
+	server.TLS = tlsConfig
+	server.StartTLS()
+	defer server.Close()
+
+	pool := x509.NewCertPool()
+	pool.AddCert(cert)
+	client := &http.Client{Transport: &http.Transport{
+		TLSClientConfig:   &tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12},
+		ForceAttemptHTTP2: true,
+	}}
+	resp, err := client.Get(server.URL + RoutesMetaData)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, "HTTP/2.0", resp.Proto)
+
+	var metadata Metadata
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&metadata))
+	require.Equal(t, "https://sentinel.example.com:8443", metadata.URL)
+
+	// old protocol versions are refused
+	_, err = (&http.Client{Transport: &http.Transport{
+		TLSClientConfig: &tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS10, MaxVersion: tls.VersionTLS11},
+	}}).Get(server.URL + RoutesMetaData)
+	require.Error(t, err)
+
+	_, _, err = newTLSConfig(conf.TLSConfiguration{Cert: "missing.pem", Key: "missing.pem"})
+	require.Error(t, err)
+}
+
+func TestRedirectToHTTPS(t *testing.T) {
+	for port, expected := range map[string]string{
+		"443":  "https://sentinel.example.com/metadata.json?a=b",
+		"8443": "https://sentinel.example.com:8443/metadata.json?a=b",
+	} {
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodGet, "http://sentinel.example.com:3636/metadata.json?a=b", nil)
+		redirectToHTTPS(port).ServeHTTP(w, r)
+		require.Equal(t, http.StatusMovedPermanently, w.Code)
+		require.Equal(t, expected, w.Header().Get("Location"))
+	}
+}
+
+func TestAutocertHostPolicy(t *testing.T) {
+	config := conf.TLSConfiguration{
+		ACME:         true,
+		Hosts:        []string{"sentinel.example.com", "rpc.example.com"},
+		ACMECacheDir: t.TempDir(),
+		Port:         "443",
+	}
+	manager := newAutocertManager(config)
+	require.NoError(t, manager.HostPolicy(context.Background(), "sentinel.example.com"))
+	require.NoError(t, manager.HostPolicy(context.Background(), "rpc.example.com"))
+	require.Error(t, manager.HostPolicy(context.Background(), "evil.example.com"))
+	require.Error(t, manager.HostPolicy(context.Background(), "127.0.0.1"))
+
+	tlsConfig, httpHandler, err := newTLSConfig(config)
+	require.NoError(t, err)
+	require.Contains(t, tlsConfig.NextProtos, "acme-tls/1")
+	require.NotNil(t, tlsConfig.GetCertificate)
+
+	// the challenge listener redirect everything but challenges
+	w := httptest.NewRecorder()
+	httpHandler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "http://sentinel.example.com/open-claims", nil))
+	require.Equal(t, http.StatusMovedPermanently, w.Code)
+	require.Equal(t, "https://sentinel.example.com/open-claims", w.Header().Get("Location"))
+
+	// no host, no ACME
+	require.False(t, conf.TLSConfiguration{ACME: true}.HasTLS())
+}
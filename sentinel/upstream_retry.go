@@ -0,0 +1,372 @@
+package sentinel
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"math/rand"
+	"net/http"
+	"net/http/httptrace"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+
+	"github.com/arkeonetwork/arkeo/sentinel/conf"
+)
+
+const (
+	BreakerClosed   = "closed"
+	BreakerOpen     = "open"
+	BreakerHalfOpen = "half-open"
+
+	defaultRetryBackoff       = 100 * time.Millisecond
+	defaultBreakerMinRequests = 10
+	defaultBreakerWindow      = time.Minute
+	defaultBreakerOpen        = 30 * time.Second
+)
+
+// CircuitBreaker stop sending requests to a service failing upstream. It opens once the error rate over the window is
+// reached, refuses the requests while open, then lets a single probe request through: the breaker closes when it
+// succeeds and opens again when it fails
+type CircuitBreaker struct {
+	lock        sync.Mutex
+	errorRate   int // percentage of failed requests
+	minRequests int
+	window      time.Duration
+	openFor     time.Duration
+	now         func() time.Time
+
+	state       string
+	windowStart time.Time
+	requests    int
+	failures    int
+	openedAt    time.Time
+	probing     bool // the probe of the half open breaker is in flight
+}
+
+// BreakerOpenError is the body returned to a client refused while the breaker of the service is open
+type BreakerOpenError struct {
+	Error             string `json:"error"`
+	Service           string `json:"service"`
+	RetryAfterSeconds int64  `json:"retry_after_seconds"`
+}
+
+func NewCircuitBreaker(errorRate, minRequests int, window, openFor time.Duration) *CircuitBreaker {
+	return &CircuitBreaker{
+		errorRate:   errorRate,
+		minRequests: minRequests,
+		window:      window,
+		openFor:     openFor,
+		now:         time.Now,
+		state:       BreakerClosed,
+	}
+}
+
+// Allow return true when a request can be sent upstream, and whether it is the probe of the half open breaker.
+// Otherwise it returns the time to wait before the breaker lets a request through
+func (b *CircuitBreaker) Allow() (allowed, probe bool, retryAfter time.Duration) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	switch b.state {
+	case BreakerOpen:
+		if wait := b.openedAt.Add(b.openFor).Sub(b.now()); wait > 0 {
+			return false, false, wait
+		}
+		b.state = BreakerHalfOpen
+		fallthrough
+	case BreakerHalfOpen:
+		if b.probing {
+			return false, false, time.Second
+		}
+		b.probing = true
+		return true, true, 0
+	}
+	return true, false, 0
+}
+
+// Record count the outcome of a request allowed through
+func (b *CircuitBreaker) Record(probe, failed bool) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	now := b.now()
+	if probe {
+		b.probing = false
+		if failed {
+			b.open(now)
+		} else {
+			b.state = BreakerClosed
+			b.windowStart, b.requests, b.failures = now, 0, 0
+		}
+		return
+	}
+	// the requests let through before the breaker opened don't change its state
+	if b.state != BreakerClosed {
+		return
+	}
+	if now.Sub(b.windowStart) >= b.window {
+		b.windowStart, b.requests, b.failures = now, 0, 0
+	}
+	b.requests++
+	if failed {
+		b.failures++
+	}
+	if b.requests >= b.minRequests && b.failures*100 >= b.errorRate*b.requests {
+		b.open(now)
+	}
+}
+
+// Release give the probe back when the request allowed didn't reach the upstream
+func (b *CircuitBreaker) Release(probe bool) {
+	if !probe {
+		return
+	}
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	b.probing = false
+}
+
+// State return whether the breaker is closed, open or half open
+func (b *CircuitBreaker) State() string {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	if b.state == BreakerOpen && !b.now().Before(b.openedAt.Add(b.openFor)) {
+		return BreakerHalfOpen
+	}
+	return b.state
+}
+
+func (b *CircuitBreaker) open(now time.Time) {
+	b.state = BreakerOpen
+	b.openedAt = now
+}
+
+// newServiceBreakers return the circuit breakers of the services with an error rate
+func newServiceBreakers(services map[string]conf.ServiceConfiguration) map[string]*CircuitBreaker {
+	breakers := make(map[string]*CircuitBreaker)
+	for name, service := range services {
+		if service.BreakerErrorRate <= 0 {
+			continue
+		}
+		minRequests := defaultBreakerMinRequests
+		if service.BreakerMinRequests > 0 {
+			minRequests = service.BreakerMinRequests
+		}
+		window := defaultBreakerWindow
+		if service.BreakerWindowSeconds > 0 {
+			window = time.Duration(service.BreakerWindowSeconds) * time.Second
+		}
+		openFor := defaultBreakerOpen
+		if service.BreakerOpenSeconds > 0 {
+			openFor = time.Duration(service.BreakerOpenSeconds) * time.Second
+		}
+		breakers[name] = NewCircuitBreaker(service.BreakerErrorRate, minRequests, window, openFor)
+	}
+	return breakers
+}
+
+// upstreamOutcome is what the upstream made of a request, once its attempts are over
+type upstreamOutcome struct {
+	reached bool
+	failed  bool
+}
+
+// circuitBreak refuse the plain http requests to a service whose breaker is open, before they are charged, and record
+// the outcome of the requests let through. Websocket sessions and grpc streams aren't counted
+func (p Proxy) circuitBreak(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		service := requestService(r)
+		breaker, ok := p.serviceBreakers[service]
+		if !ok || isGRPCRequest(r) || websocket.IsWebSocketUpgrade(r) {
+			next.ServeHTTP(w, r)
+			return
+		}
+		allowed, probe, retryAfter := breaker.Allow()
+		if !allowed {
+			p.Metrics.breakerRejected(service)
+			respondWithBreakerOpen(w, service, retryAfter)
+			return
+		}
+		outcome := &upstreamOutcome{}
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), upstreamOutcomeContextKey, outcome)))
+		if outcome.reached {
+			breaker.Record(probe, outcome.failed)
+		} else {
+			breaker.Release(probe)
+		}
+	})
+}
+
+func respondWithBreakerOpen(w http.ResponseWriter, service string, retryAfter time.Duration) {
+	seconds := int64(retryAfter.Round(time.Second).Seconds())
+	if seconds < 1 {
+		seconds = 1
+	}
+	w.Header().Set("Retry-After", strconv.FormatInt(seconds, 10))
+	respondWithJSON(w, http.StatusServiceUnavailable, BreakerOpenError{
+		Error:             "service unavailable",
+		Service:           service,
+		RetryAfterSeconds: seconds,
+	})
+}
+
+// retryTransport send the idempotent requests to the upstream again after a transient failure. The request is charged
+// once, before it reaches the transport, whatever the number of attempts
+type retryTransport struct {
+	next    http.RoundTripper
+	service string
+	retries int
+	backoff time.Duration
+	methods map[string]struct{} // JSON-RPC methods that can be sent again
+	metrics *Metrics
+}
+
+// upstreamTransport return the transport of the requests to a service
+func (p Proxy) upstreamTransport(service string) http.RoundTripper {
+	var next http.RoundTripper = http.DefaultTransport
+	if transport, ok := p.serviceTransports[service]; ok {
+		next = transport.Transport
+	}
+	config := p.Config.Services[service]
+	backoff := defaultRetryBackoff
+	if config.RetryBackoffMs > 0 {
+		backoff = time.Duration(config.RetryBackoffMs) * time.Millisecond
+	}
+	methods := make(map[string]struct{}, len(config.RetryRPCMethods))
+	for _, method := range config.RetryRPCMethods {
+		methods[method] = struct{}{}
+	}
+	return &retryTransport{
+		next:    next,
+		service: service,
+		retries: config.Retries,
+		backoff: backoff,
+		methods: methods,
+		metrics: p.Metrics,
+	}
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.roundTrip(req)
+	// a request the client gave up on says nothing of the upstream
+	if outcome, ok := req.Context().Value(upstreamOutcomeContextKey).(*upstreamOutcome); ok &&
+		!errors.Is(context.Cause(req.Context()), context.Canceled) {
+		outcome.reached = true
+		outcome.failed = upstreamFailed(resp, err)
+	}
+	return resp, err
+}
+
+func (t *retryTransport) roundTrip(req *http.Request) (*http.Response, error) {
+	req = req.WithContext(httptrace.WithClientTrace(req.Context(), &httptrace.ClientTrace{
+		GotConn: func(info httptrace.GotConnInfo) {
+			t.metrics.upstreamConnection(t.service, info.Reused)
+		},
+	}))
+	body, retryable, err := t.retryable(req)
+	if err != nil {
+		return nil, err
+	}
+	attempts := 1
+	if retryable {
+		attempts += t.retries
+	}
+	for attempt := 1; ; attempt++ {
+		attemptReq := req
+		if body != nil {
+			attemptReq = req.Clone(req.Context())
+			attemptReq.Body = io.NopCloser(bytes.NewReader(body))
+			attemptReq.ContentLength = int64(len(body))
+		}
+		resp, err := t.next.RoundTrip(attemptReq)
+		if attempt >= attempts || !upstreamFailed(resp, err) || req.Context().Err() != nil {
+			return resp, err
+		}
+		if resp != nil {
+			_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+			_ = resp.Body.Close()
+		}
+		t.metrics.upstreamRetried(t.service)
+		timer := time.NewTimer(jitter(t.backoff << (attempt - 1)))
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// retryable return true for the requests that can be sent again, GET, HEAD and the JSON-RPC calls all in the methods
+// allowed, along with the body read to send it again
+func (t *retryTransport) retryable(req *http.Request) ([]byte, bool, error) {
+	// a streamed body can't be read ahead to be sent again
+	if t.retries <= 0 || isStreamedRequest(req) {
+		return nil, false, nil
+	}
+	switch req.Method {
+	case http.MethodGet, http.MethodHead:
+	case http.MethodPost:
+		if len(t.methods) == 0 {
+			return nil, false, nil
+		}
+	default:
+		return nil, false, nil
+	}
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, req.Method != http.MethodPost, nil
+	}
+	body, err := io.ReadAll(req.Body)
+	_ = req.Body.Close()
+	if err != nil {
+		return nil, false, err
+	}
+	return body, req.Method != http.MethodPost || t.idempotentCalls(body), nil
+}
+
+// idempotentCalls return true when the body is a JSON-RPC call, or batch of calls, of the methods allowed
+func (t *retryTransport) idempotentCalls(body []byte) bool {
+	var calls []rpcCall
+	trimmed := bytes.TrimSpace(body)
+	if len(trimmed) > 0 && trimmed[0] == '[' {
+		if err := json.Unmarshal(trimmed, &calls); err != nil || len(calls) == 0 {
+			return false
+		}
+	} else {
+		var call rpcCall
+		if err := json.Unmarshal(trimmed, &call); err != nil {
+			return false
+		}
+		calls = append(calls, call)
+	}
+	for _, call := range calls {
+		if _, ok := t.methods[call.Method]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// upstreamFailed return true when the upstream couldn't be reached or answered it is unavailable
+func upstreamFailed(resp *http.Response, err error) bool {
+	if err != nil {
+		return true
+	}
+	switch resp.StatusCode {
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// jitter return a random duration between half of d and d, so the clients retrying don't hit the upstream together
+func jitter(d time.Duration) time.Duration {
+	if d <= 1 {
+		return d
+	}
+	half := d / 2
+	return half + time.Duration(rand.Int63n(int64(half)+1))
+}
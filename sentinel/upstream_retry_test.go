@@ -0,0 +1,203 @@
+package sentinel
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/arkeonetwork/arkeo/common"
+	"github.com/arkeonetwork/arkeo/sentinel/conf"
+)
+
+// flakyUpstream reset the connection of the next resets requests, then answer a bad gateway to the next failures ones
+type flakyUpstream struct {
+	*httptest.Server
+	hits     atomic.Int64
+	resets   atomic.Int64
+	failures atomic.Int64
+}
+
+func newFlakyUpstream(t *testing.T) *flakyUpstream {
+	upstream := &flakyUpstream{}
+	upstream.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		upstream.hits.Add(1)
+		if upstream.resets.Add(-1) >= 0 {
+			conn, _, err := w.(http.Hijacker).Hijack()
+			require.NoError(t, err)
+			_ = conn.Close()
+			return
+		}
+		if upstream.failures.Add(-1) >= 0 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		_, _ = w.Write([]byte("{}"))
+	}))
+	t.Cleanup(upstream.Close)
+	return upstream
+}
+
+func TestUpstreamRetries(t *testing.T) {
+	upstream := newFlakyUpstream(t)
+	config := newTestConfig()
+	config.Services = map[string]conf.ServiceConfiguration{
+		"btc-mainnet-fullnode": {
+			Upstream:        upstream.URL,
+			Retries:         2,
+			RetryBackoffMs:  1,
+			RetryRPCMethods: []string{"getblockcount"},
+		},
+	}
+	proxy, err := NewProxy(config)
+	require.NoError(t, err)
+	contract := newWebsocketContract(1, 1000, 1000)
+	proxy.MemStore.SetHeight(10)
+	proxy.MemStore.Put(contract)
+	router := proxy.getRouter()
+
+	serve := func(method string, nonce int, body string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		path := fmt.Sprintf("/%s?%s=%d:%d", common.BTCService, QueryArkAuth, contract.Id, nonce)
+		router.ServeHTTP(w, httptest.NewRequest(method, path, strings.NewReader(body)))
+		return w
+	}
+
+	// the connection reset and the bad gateway are retried, the request is charged once
+	upstream.resets.Store(1)
+	upstream.failures.Store(1)
+	w := serve(http.MethodGet, 1, "")
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, "{}", w.Body.String())
+	require.EqualValues(t, 3, upstream.hits.Load())
+	require.Equal(t, "1", w.Header().Get(CostHeader))
+	claim, err := proxy.ClaimStore.Get(contract.Key())
+	require.NoError(t, err)
+	require.EqualValues(t, 1, claim.Nonce)
+
+	// the attempts are bounded
+	upstream.hits.Store(0)
+	upstream.failures.Store(3)
+	require.Equal(t, http.StatusBadGateway, serve(http.MethodGet, 2, "").Code)
+	require.EqualValues(t, 3, upstream.hits.Load())
+
+	// the JSON-RPC calls allowed are retried with their body
+	upstream.hits.Store(0)
+	upstream.failures.Store(1)
+	require.Equal(t, http.StatusOK, serve(http.MethodPost, 3, `[{"method":"getblockcount"},{"method":"getblockcount"}]`).Code)
+	require.EqualValues(t, 2, upstream.hits.Load())
+
+	// the others are not
+	upstream.hits.Store(0)
+	upstream.failures.Store(1)
+	require.Equal(t, http.StatusBadGateway, serve(http.MethodPost, 4, `[{"method":"getblockcount"},{"method":"sendrawtransaction"}]`).Code)
+	require.EqualValues(t, 1, upstream.hits.Load())
+}
+
+func TestCircuitBreakerOpens(t *testing.T) {
+	upstream := newFlakyUpstream(t)
+	config := newTestConfig()
+	config.Services = map[string]conf.ServiceConfiguration{
+		"btc-mainnet-fullnode": {
+			Upstream:           upstream.URL,
+			BreakerErrorRate:   50,
+			BreakerMinRequests: 4,
+			BreakerOpenSeconds: 30,
+		},
+	}
+	proxy, err := NewProxy(config)
+	require.NoError(t, err)
+	contract := newWebsocketContract(1, 1000, 1000)
+	proxy.MemStore.SetHeight(10)
+	proxy.MemStore.Put(contract)
+	breaker := proxy.serviceBreakers[common.BTCService.String()]
+	now := time.Now()
+	breaker.now = func() time.Time { return now }
+	router := proxy.getRouter()
+
+	serve := func(nonce int) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		path := fmt.Sprintf("/%s?%s=%d:%d", common.BTCService, QueryArkAuth, contract.Id, nonce)
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+		return w
+	}
+
+	// half of the requests fail, the breaker opens
+	require.Equal(t, http.StatusOK, serve(1).Code)
+	require.Equal(t, http.StatusOK, serve(2).Code)
+	upstream.failures.Store(2)
+	require.Equal(t, http.StatusBadGateway, serve(3).Code)
+	require.Equal(t, BreakerClosed, breaker.State())
+	require.Equal(t, http.StatusBadGateway, serve(4).Code)
+	require.Equal(t, BreakerOpen, breaker.State())
+
+	// the requests are refused without reaching the upstream nor being charged
+	hits := upstream.hits.Load()
+	w := serve(5)
+	require.Equal(t, http.StatusServiceUnavailable, w.Code)
+	require.Equal(t, "30", w.Header().Get("Retry-After"))
+	var body BreakerOpenError
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	require.Equal(t, BreakerOpenError{Error: "service unavailable", Service: common.BTCService.String(), RetryAfterSeconds: 30}, body)
+	require.Equal(t, hits, upstream.hits.Load())
+	claim, err := proxy.ClaimStore.Get(contract.Key())
+	require.NoError(t, err)
+	require.EqualValues(t, 4, claim.Nonce)
+
+	// once the upstream is back, the probe closes the breaker
+	now = now.Add(30 * time.Second)
+	require.Equal(t, BreakerHalfOpen, breaker.State())
+	require.Equal(t, http.StatusOK, serve(5).Code)
+	require.Equal(t, BreakerClosed, breaker.State())
+	require.Equal(t, http.StatusOK, serve(6).Code)
+}
+
+func TestCircuitBreakerHalfOpen(t *testing.T) {
+	breaker := NewCircuitBreaker(50, 2, time.Minute, 10*time.Second)
+	now := time.Now()
+	breaker.now = func() time.Time { return now }
+
+	breaker.Record(false, true)
+	breaker.Record(false, true)
+	allowed, _, retryAfter := breaker.Allow()
+	require.False(t, allowed)
+	require.Equal(t, 10*time.Second, retryAfter)
+
+	// a single probe is let through
+	now = now.Add(10 * time.Second)
+	allowed, probe, _ := breaker.Allow()
+	require.True(t, allowed)
+	require.True(t, probe)
+	allowed, _, _ = breaker.Allow()
+	require.False(t, allowed)
+
+	// a request let through before the breaker opened doesn't close it
+	breaker.Record(false, false)
+	require.Equal(t, BreakerHalfOpen, breaker.State())
+
+	// the probe failing opens the breaker again
+	breaker.Record(true, true)
+	require.Equal(t, BreakerOpen, breaker.State())
+
+	// a probe that didn't reach the upstream is given back
+	now = now.Add(10 * time.Second)
+	allowed, probe, _ = breaker.Allow()
+	require.True(t, allowed && probe)
+	breaker.Release(probe)
+	allowed, probe, _ = breaker.Allow()
+	require.True(t, allowed && probe)
+	breaker.Record(probe, false)
+	require.Equal(t, BreakerClosed, breaker.State())
+
+	// the failures are counted over the window
+	breaker.Record(false, true)
+	now = now.Add(time.Minute)
+	breaker.Record(false, true)
+	require.Equal(t, BreakerClosed, breaker.State())
+}
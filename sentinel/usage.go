@@ -0,0 +1,191 @@
+package sentinel
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+
+	"github.com/arkeonetwork/arkeo/common/cosmos"
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+)
+
+// QueryUsage count the requests and queries the sentinel charged to each contract over http since it started, the
+// queries consumed over long lived connections are counted by StreamUsage
+type QueryUsage struct {
+	lock    sync.Mutex
+	charged map[uint64]chargedQueries
+}
+
+type chargedQueries struct {
+	requests    int64
+	queries     int64
+	bytes       int64 // response bytes metered
+	byteQueries int64 // queries charged for the bytes, on top of the requests
+}
+
+func NewQueryUsage() *QueryUsage {
+	return &QueryUsage{
+		charged: make(map[uint64]chargedQueries),
+	}
+}
+
+// Charge record a paid request costing queries
+func (u *QueryUsage) Charge(contractId uint64, queries int64) {
+	u.lock.Lock()
+	defer u.lock.Unlock()
+	charged := u.charged[contractId]
+	charged.requests++
+	charged.queries += queries
+	u.charged[contractId] = charged
+}
+
+// ChargeBytes record response bytes relayed to a contract and the queries they were charged, the latter being
+// counted by StreamUsage as well since they are taken on top of the signed nonce
+func (u *QueryUsage) ChargeBytes(contractId uint64, bytes, queries int64) {
+	u.lock.Lock()
+	defer u.lock.Unlock()
+	charged := u.charged[contractId]
+	charged.bytes += bytes
+	charged.byteQueries += queries
+	u.charged[contractId] = charged
+}
+
+// Get return the requests and queries charged to a contract
+func (u *QueryUsage) Get(contractId uint64) (requests, queries int64) {
+	u.lock.Lock()
+	defer u.lock.Unlock()
+	charged := u.charged[contractId]
+	return charged.requests, charged.queries
+}
+
+// GetBytes return the response bytes metered for a contract and the queries they were charged
+func (u *QueryUsage) GetBytes(contractId uint64) (bytes, queries int64) {
+	u.lock.Lock()
+	defer u.lock.Unlock()
+	charged := u.charged[contractId]
+	return charged.bytes, charged.byteQueries
+}
+
+// Remove forget the usage of a closed contract
+func (u *QueryUsage) Remove(contractId uint64) {
+	u.lock.Lock()
+	defer u.lock.Unlock()
+	delete(u.charged, contractId)
+}
+
+// ContractUsage is the body of the usage endpoint, what a contract consumed so far as the sentinel accounts it. The
+// spend is an estimate until the claims are settled on chain
+type ContractUsage struct {
+	ContractId       uint64         `json:"contract_id"`
+	Service          string         `json:"service"`
+	Type             string         `json:"type"`
+	RequestsCharged  int64          `json:"requests_charged"`  // paid http requests served since the sentinel started
+	QueriesCharged   int64          `json:"queries_charged"`   // queries those requests and the streams cost
+	StreamQueries    int64          `json:"stream_queries"`    // queries consumed over websockets and grpc streams
+	ResponseBytes    int64          `json:"response_bytes"`    // bytes of the responses of the services charged by bytes
+	ByteQueries      int64          `json:"byte_queries"`      // queries those bytes cost on top of their requests
+	Nonce            int64          `json:"nonce"`             // highest nonce used, the next request must be above it
+	ClaimedNonce     int64          `json:"claimed_nonce"`     // nonce of the last claim the sentinel holds
+	EstimatedSpend   string         `json:"estimated_spend"`   // what the contract owes the provider so far
+	Deposit          string         `json:"deposit"`           // deposit of the contract
+	RemainingDeposit string         `json:"remaining_deposit"` // deposit left once the estimated spend is paid
+	RateLimit        UsageRateLimit `json:"rate_limit"`
+	Height           int64          `json:"height"`            // block height the sentinel is at
+	ExpirationHeight int64          `json:"expiration_height"` // block height the contract expires at
+	SettlementHeight int64          `json:"settlement_height"` // block height the contract can no longer be claimed after
+}
+
+// UsageRateLimit is the state of the contract's queries per minute, its bucket refills continuously so the queries
+// used are the ones not yet refilled
+type UsageRateLimit struct {
+	QueriesPerMinute int64 `json:"queries_per_minute"` // 0 when the contract isn't limited
+	Available        int64 `json:"available"`          // queries the contract can make right away
+	Used             int64 `json:"used"`               // queries of the last minute not yet refilled
+}
+
+// contractUsage return the usage of a contract, from the claim store, the counters of the sentinel and the cached
+// chain contract, nothing is fetched from the chain
+func (p Proxy) contractUsage(contract types.Contract) ContractUsage {
+	key := contract.Key()
+	claimed := int64(0)
+	if claim, err := p.ClaimStore.Get(key); err == nil {
+		claimed = claim.Nonce
+	}
+	nonce := contract.Nonce
+	if claimed > nonce {
+		nonce = claimed
+	}
+	height := p.MemStore.GetHeight()
+	streamed := p.StreamUsage.Get(contract.Id)
+	requests, queries := p.QueryUsage.Get(contract.Id)
+	// the queries of the response bytes are taken on top of the signed nonce as the stream queries are
+	responseBytes, byteQueries := p.QueryUsage.GetBytes(contract.Id)
+	deposit := cosmos.ZeroInt()
+	if !contract.Deposit.IsNil() {
+		deposit = contract.Deposit
+	}
+	usage := ContractUsage{
+		ContractId:       contract.Id,
+		Service:          contract.Service.String(),
+		Type:             contract.Type.String(),
+		RequestsCharged:  requests,
+		QueriesCharged:   queries + streamed,
+		StreamQueries:    streamed - byteQueries,
+		ResponseBytes:    responseBytes,
+		ByteQueries:      byteQueries,
+		Nonce:            nonce,
+		ClaimedNonce:     claimed,
+		EstimatedSpend:   cosmos.Coin{Denom: contract.Rate.Denom, Amount: contractDebt(contract, nonce+streamed, height)}.String(),
+		Deposit:          cosmos.Coin{Denom: contract.Rate.Denom, Amount: deposit}.String(),
+		RemainingDeposit: remainingDeposit(contract, nonce+streamed, height).String(),
+		RateLimit:        UsageRateLimit{QueriesPerMinute: contract.QueriesPerMinute},
+		Height:           height,
+		ExpirationHeight: contract.Expiration(),
+		SettlementHeight: contract.SettlementPeriodEnd(),
+	}
+	if contract.QueriesPerMinute > 0 {
+		available := int64(math.Floor(math.Max(p.ContractLimiter.Tokens(contract), 0)))
+		usage.RateLimit.Available = available
+		usage.RateLimit.Used = contract.QueriesPerMinute - available
+	}
+	return usage
+}
+
+// handleUsage serve the usage of the caller's contract, authenticated with a contract auth signed by its client as
+// the contract configuration is
+func (p Proxy) handleUsage(w http.ResponseWriter, r *http.Request) {
+	raw := r.Header.Get(QueryContract)
+	if len(raw) == 0 {
+		respondWithError(w, "missing contract auth", http.StatusUnauthorized)
+		return
+	}
+	auth, err := parseContractAuth(raw)
+	if err != nil {
+		respondWithError(w, fmt.Sprintf("bad contract auth: %s", err), http.StatusBadRequest)
+		return
+	}
+	contract, err := p.MemStore.Get(strconv.FormatUint(auth.ContractId, 10))
+	if err != nil || contract.IsEmpty() {
+		respondWithError(w, "unknown contract", http.StatusNotFound)
+		return
+	}
+	conf, err := p.ContractConfigStore.Get(contract.Id)
+	if err != nil {
+		p.logger.Error("fail to fetch contract configuration", "error", err, "id", contract.Id)
+		respondWithError(w, "fail to fetch contract configuration", http.StatusInternalServerError)
+		return
+	}
+	if err := auth.Validate(conf.LastTimeStamp, contract.Client); err != nil {
+		respondWithError(w, fmt.Sprintf("bad contract auth: %s", err), http.StatusUnauthorized)
+		return
+	}
+	conf.LastTimeStamp = auth.Timestamp
+	if err := p.ContractConfigStore.Set(conf); err != nil {
+		p.logger.Error("fail to save contract config", "error", err, "id", contract.Id)
+		respondWithError(w, "fail to save contract configuration", http.StatusInternalServerError)
+		return
+	}
+	respondWithJSON(w, http.StatusOK, p.contractUsage(contract))
+}
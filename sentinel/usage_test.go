@@ -0,0 +1,89 @@
+package sentinel
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/stretchr/testify/require"
+
+	"github.com/arkeonetwork/arkeo/common"
+)
+
+func signContractAuth(t *testing.T, key *secp256k1.PrivKey, contractId uint64, timestamp int64) string {
+	signature, err := key.Sign([]byte(fmt.Sprintf("%d:%d", contractId, timestamp)))
+	require.NoError(t, err)
+	return fmt.Sprintf("%d:%d:%x", contractId, timestamp, signature)
+}
+
+func TestUsage(t *testing.T) {
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer upstream.Close()
+	config := newTestConfig()
+	config.FreeTierRateLimit = 0
+	proxy, err := NewProxy(config)
+	require.NoError(t, err)
+	proxy.proxies[common.BTCService.String()] = common.MustParseURL(upstream.URL)
+	proxy.MemStore.SetHeight(10)
+	contract, key := newSignedContract(t, 3)
+	contract.QueriesPerMinute = 10
+	proxy.MemStore.Put(contract)
+	router := proxy.getRouter()
+
+	// a few billed requests, the last one skipping a nonce, and a stream
+	for _, nonce := range []int64{1, 2, 3, 5} {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, fmt.Sprintf("/%s?%s=%s", common.BTCService, QueryArkAuth, signArkAuth(t, key, contract.Id, nonce)), nil))
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		require.Equal(t, "paid", w.Header().Get("tier"))
+	}
+	proxy.StreamUsage.Add(contract.Id, 2)
+
+	usage := func(auth string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, RoutesUsage, nil)
+		if len(auth) > 0 {
+			req.Header.Set(QueryContract, auth)
+		}
+		router.ServeHTTP(w, req)
+		return w
+	}
+	w := usage(signContractAuth(t, key, contract.Id, 1))
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	var result ContractUsage
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &result))
+	require.Equal(t, ContractUsage{
+		ContractId:       contract.Id,
+		Service:          common.BTCService.String(),
+		Type:             contract.Type.String(),
+		RequestsCharged:  4,
+		QueriesCharged:   6,
+		StreamQueries:    2,
+		Nonce:            5,
+		ClaimedNonce:     5,
+		EstimatedSpend:   "7uarkeo",
+		Deposit:          "100uarkeo",
+		RemainingDeposit: "93uarkeo",
+		RateLimit:        UsageRateLimit{QueriesPerMinute: 10, Available: 6, Used: 4},
+		Height:           10,
+		ExpirationHeight: contract.Expiration(),
+		SettlementHeight: contract.SettlementPeriodEnd(),
+	}, result)
+
+	// the contract auth can't be replayed, nor signed by anyone but the client
+	require.Equal(t, http.StatusUnauthorized, usage(signContractAuth(t, key, contract.Id, 1)).Code)
+	require.Equal(t, http.StatusUnauthorized, usage(signContractAuth(t, secp256k1.GenPrivKey(), contract.Id, 2)).Code)
+	require.Equal(t, http.StatusUnauthorized, usage("").Code)
+	require.Equal(t, http.StatusBadRequest, usage("bad:auth").Code)
+	require.Equal(t, http.StatusNotFound, usage(signContractAuth(t, key, 4, 2)).Code)
+	require.Equal(t, http.StatusOK, usage(signContractAuth(t, key, contract.Id, 2)).Code)
+
+	// the usage is forgotten once the contract closes
+	proxy.QueryUsage.Remove(contract.Id)
+	requests, queries := proxy.QueryUsage.Get(contract.Id)
+	require.Zero(t, requests)
+	require.Zero(t, queries)
+}
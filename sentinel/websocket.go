@@ -0,0 +1,263 @@
+package sentinel
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+const (
+	// WebsocketAccountingMessage charge one query per client to server message
+	WebsocketAccountingMessage = "message"
+	// WebsocketAccountingMinute charge one query per started minute of connection
+	WebsocketAccountingMinute = "minute"
+
+	// close codes sent to the client when the sentinel ends a session, in the private use range
+	CloseContractExpired = 4001
+	CloseContractSpent   = 4002
+	CloseRateLimited     = 4029
+)
+
+// how often an open session check its contract is still valid, and the length of a billed minute. Variables so
+// tests don't have to wait
+var (
+	websocketCheckInterval = 5 * time.Second
+	websocketMinute        = time.Minute
+)
+
+var websocketUpgrader = websocket.Upgrader{
+	ReadBufferSize:  4096,
+	WriteBufferSize: 4096,
+	// CORS is enforced by the contract configuration, not the websocket origin
+	CheckOrigin: func(r *http.Request) bool { return true },
+}
+
+// handshake headers set by the websocket libraries
+var websocketSkipHeaders = map[string]bool{
+	"Upgrade":                  true,
+	"Connection":               true,
+	"Sec-Websocket-Key":        true,
+	"Sec-Websocket-Version":    true,
+	"Sec-Websocket-Extensions": true,
+}
+
+// websocketAccounting return how the sessions of a service are charged, per message unless configured otherwise
+func (p Proxy) websocketAccounting(service string) string {
+	if mode, ok := p.Config.WebsocketAccounting[service]; ok && mode == WebsocketAccountingMinute {
+		return WebsocketAccountingMinute
+	}
+	return WebsocketAccountingMessage
+}
+
+// websocketSession relay frames between a client and the upstream service, charging the contract (or the free
+// tier) as it goes
+type websocketSession struct {
+	streamMeter
+	accounting string
+	client     *websocket.Conn
+	upstream   *websocket.Conn
+	done       chan struct{}
+	closeOnce  sync.Once
+}
+
+// handleWebsocket upgrade the request and proxy it to the upstream url, the request was already authenticated
+func (p Proxy) handleWebsocket(w http.ResponseWriter, r *http.Request, service string, upstreamURL url.URL, pubkey string) {
+	switch upstreamURL.Scheme {
+	case "https":
+		upstreamURL.Scheme = "wss"
+	default:
+		upstreamURL.Scheme = "ws"
+	}
+	header := http.Header{}
+	for k, vs := range r.Header {
+		if websocketSkipHeaders[http.CanonicalHeaderKey(k)] {
+			continue
+		}
+		header[k] = vs
+	}
+	p.upstreamRequestHeaders(header, r, service, true)
+	if upstreamURL.User != nil {
+		password, _ := upstreamURL.User.Password()
+		credentials := upstreamURL.User.Username() + ":" + password
+		header.Set("Authorization", "Basic "+base64.StdEncoding.EncodeToString([]byte(credentials)))
+		upstreamURL.User = nil
+	}
+
+	upstream, resp, err := websocket.DefaultDialer.DialContext(r.Context(), upstreamURL.String(), header)
+	if err != nil {
+		p.logger.Error("failed to dial upstream websocket", "error", err, "service", service)
+		code := http.StatusBadGateway
+		if resp != nil {
+			code = resp.StatusCode
+		}
+		respondWithError(w, fmt.Sprintf("failed to connect to upstream websocket: %s", err), code)
+		return
+	}
+
+	// agree with the client on what the upstream picked
+	var responseHeader http.Header
+	if protocol := upstream.Subprotocol(); protocol != "" {
+		responseHeader = http.Header{"Sec-Websocket-Protocol": []string{protocol}}
+	}
+	client, err := websocketUpgrader.Upgrade(w, r, responseHeader)
+	if err != nil {
+		// the upgrader already replied to the client
+		p.logger.Error("failed to upgrade websocket", "error", err, "service", service)
+		_ = upstream.Close()
+		return
+	}
+
+	session := &websocketSession{
+		streamMeter: p.newStreamMeter(r, pubkey),
+		accounting:  p.websocketAccounting(service),
+		client:      client,
+		upstream:    upstream,
+		done:        make(chan struct{}),
+	}
+	session.run()
+}
+
+func (s *websocketSession) run() {
+	logger := s.proxy.logger.With("contract", s.contract.Id, "remote-addr", s.ip, "accounting", s.accounting)
+	logger.Info("websocket session opened")
+
+	// the upgrade request was already charged as the first query of the session
+	go s.watch()
+	go s.relayToClient()
+	s.relayToUpstream()
+	<-s.done
+	logger.Info("websocket session closed", "stream-usage", s.proxy.StreamUsage.Get(s.contract.Id))
+}
+
+// relayToUpstream forward client messages to the upstream, charging each one
+func (s *websocketSession) relayToUpstream() {
+	for {
+		messageType, data, err := s.client.ReadMessage()
+		if err != nil {
+			code, reason := closeCode(err)
+			s.shutdown(s.upstream, code, reason)
+			return
+		}
+		if err := s.limit(); err != nil {
+			s.close(closeReason(err))
+			return
+		}
+		if s.accounting == WebsocketAccountingMessage {
+			if err := s.charge(1); err != nil {
+				s.close(closeReason(err))
+				return
+			}
+		}
+		if err := s.upstream.WriteMessage(messageType, data); err != nil {
+			s.close(websocket.CloseGoingAway, "upstream unavailable")
+			return
+		}
+	}
+}
+
+// relayToClient forward upstream messages to the client
+func (s *websocketSession) relayToClient() {
+	for {
+		messageType, data, err := s.upstream.ReadMessage()
+		if err != nil {
+			code, reason := closeCode(err)
+			s.shutdown(s.client, code, reason)
+			return
+		}
+		if err := s.client.WriteMessage(messageType, data); err != nil {
+			s.shutdown(s.upstream, websocket.CloseGoingAway, "client unavailable")
+			return
+		}
+	}
+}
+
+// watch close the session once the contract can no longer be used, and bill the started minutes
+func (s *websocketSession) watch() {
+	checker := time.NewTicker(websocketCheckInterval)
+	defer checker.Stop()
+	minutes := time.NewTicker(websocketMinute)
+	defer minutes.Stop()
+	for {
+		select {
+		case <-s.done:
+			return
+		case <-checker.C:
+			if err := s.check(0); err != nil {
+				s.close(closeReason(err))
+				return
+			}
+		case <-minutes.C:
+			if s.accounting != WebsocketAccountingMinute {
+				continue
+			}
+			if err := s.charge(1); err != nil {
+				s.close(closeReason(err))
+				return
+			}
+		}
+	}
+}
+
+// closeReason return the close code and reason telling the client why the sentinel ended the session
+func closeReason(err error) (int, string) {
+	var limitErr *contractRateLimitError
+	var depositErr *minDepositError
+	switch {
+	case errors.Is(err, ErrContractExpired):
+		return CloseContractExpired, err.Error()
+	case errors.Is(err, ErrContractSpent), errors.As(err, &depositErr):
+		return CloseContractSpent, err.Error()
+	case errors.As(err, &limitErr):
+		seconds := int64(limitErr.retryAfter.Round(time.Second).Seconds())
+		if seconds < 1 {
+			seconds = 1
+		}
+		return CloseRateLimited, fmt.Sprintf("contract rate limit exceeded, retry after %ds", seconds)
+	default:
+		return CloseRateLimited, err.Error()
+	}
+}
+
+// close end the session, telling the client why and the upstream we're going away
+func (s *websocketSession) close(code int, reason string) {
+	sendClose(s.upstream, websocket.CloseNormalClosure, "")
+	s.shutdown(s.client, code, reason)
+}
+
+// shutdown send a close frame to one side and tear down both connections
+func (s *websocketSession) shutdown(conn *websocket.Conn, code int, reason string) {
+	sendClose(conn, code, reason)
+	s.closeOnce.Do(func() {
+		close(s.done)
+		_ = s.client.Close()
+		_ = s.upstream.Close()
+	})
+}
+
+// sendClose write a close frame, safe to call concurrently with the relays
+func sendClose(conn *websocket.Conn, code int, reason string) {
+	// control frames are limited to 125 bytes, including the code
+	if len(reason) > 123 {
+		reason = reason[:123]
+	}
+	_ = conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(code, reason), time.Now().Add(time.Second))
+}
+
+// closeCode return the code and reason to relay from a read error
+func closeCode(err error) (int, string) {
+	if ce, ok := err.(*websocket.CloseError); ok {
+		switch ce.Code {
+		case websocket.CloseNoStatusReceived, websocket.CloseAbnormalClosure, websocket.CloseTLSHandshake:
+			// reserved codes that can't be sent on the wire
+			return websocket.CloseGoingAway, ce.Text
+		}
+		return ce.Code, ce.Text
+	}
+	return websocket.CloseGoingAway, ""
+}
@@ -0,0 +1,166 @@
+package sentinel
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
+
+	"github.com/arkeonetwork/arkeo/common"
+	"github.com/arkeonetwork/arkeo/common/cosmos"
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+)
+
+// newEchoUpstream start a websocket server echoing back every message
+func newEchoUpstream(t *testing.T) *httptest.Server {
+	upgrader := websocket.Upgrader{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		for {
+			messageType, data, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+			if err := conn.WriteMessage(messageType, data); err != nil {
+				return
+			}
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func newWebsocketTest(t *testing.T, contract types.Contract) (Proxy, *httptest.Server) {
+	proxy, err := NewProxy(newTestConfig())
+	require.NoError(t, err)
+	upstream := newEchoUpstream(t)
+	proxy.proxies[common.BTCService.String()] = common.MustParseURL(upstream.URL)
+	proxy.MemStore.SetHeight(10)
+	if contract.Id > 0 {
+		proxy.MemStore.Put(contract)
+	}
+	server := httptest.NewServer(proxy.getRouter())
+	t.Cleanup(server.Close)
+	return proxy, server
+}
+
+func newWebsocketContract(id uint64, qpm, deposit int64) types.Contract {
+	contract := types.NewContract(types.GetRandomPubKey(), common.BTCService, types.GetRandomPubKey())
+	contract.Id = id
+	contract.Type = types.ContractType_PAY_AS_YOU_GO
+	contract.Authorization = types.ContractAuthorization_OPEN
+	contract.Height = 5
+	contract.Duration = 100
+	contract.Rate = cosmos.NewInt64Coin("uarkeo", 1)
+	contract.Deposit = cosmos.NewInt(deposit)
+	contract.QueriesPerMinute = qpm
+	return contract
+}
+
+func dialSentinel(t *testing.T, server *httptest.Server, contractId uint64) *websocket.Conn {
+	url := fmt.Sprintf("ws%s/%s", strings.TrimPrefix(server.URL, "http"), common.BTCService)
+	if contractId > 0 {
+		url = fmt.Sprintf("%s?%s=%d:1", url, QueryArkAuth, contractId)
+	}
+	conn, _, err := websocket.DefaultDialer.Dial(url, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+	return conn
+}
+
+func requireEcho(t *testing.T, conn *websocket.Conn, message string) {
+	require.NoError(t, conn.WriteMessage(websocket.TextMessage, []byte(message)))
+	_, data, err := conn.ReadMessage()
+	require.NoError(t, err)
+	require.Equal(t, message, string(data))
+}
+
+func requireClosed(t *testing.T, conn *websocket.Conn, code int) {
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(5*time.Second)))
+	_, _, err := conn.ReadMessage()
+	require.True(t, websocket.IsCloseError(err, code), "unexpected error %v", err)
+}
+
+func TestWebsocketPerMessageAccounting(t *testing.T) {
+	contract := newWebsocketContract(1, 100, 100)
+	proxy, server := newWebsocketTest(t, contract)
+	conn := dialSentinel(t, server, contract.Id)
+
+	for i := 0; i < 5; i++ {
+		requireEcho(t, conn, fmt.Sprintf("hello %d", i))
+	}
+	require.Equal(t, int64(5), proxy.StreamUsage.Get(contract.Id))
+}
+
+func TestWebsocketRateLimit(t *testing.T) {
+	// the upgrade request takes the first token
+	contract := newWebsocketContract(2, 3, 100)
+	_, server := newWebsocketTest(t, contract)
+	conn := dialSentinel(t, server, contract.Id)
+
+	requireEcho(t, conn, "one")
+	requireEcho(t, conn, "two")
+	require.NoError(t, conn.WriteMessage(websocket.TextMessage, []byte("three")))
+	requireClosed(t, conn, CloseRateLimited)
+}
+
+func TestWebsocketContractSpent(t *testing.T) {
+	// the upgrade request signed nonce 1, leaving two queries of deposit
+	contract := newWebsocketContract(3, 100, 3)
+	_, server := newWebsocketTest(t, contract)
+	conn := dialSentinel(t, server, contract.Id)
+
+	requireEcho(t, conn, "one")
+	requireEcho(t, conn, "two")
+	require.NoError(t, conn.WriteMessage(websocket.TextMessage, []byte("three")))
+	requireClosed(t, conn, CloseContractSpent)
+}
+
+func TestWebsocketContractExpired(t *testing.T) {
+	defer func(interval time.Duration) { websocketCheckInterval = interval }(websocketCheckInterval)
+	websocketCheckInterval = 10 * time.Millisecond
+
+	contract := newWebsocketContract(4, 100, 100)
+	proxy, server := newWebsocketTest(t, contract)
+	conn := dialSentinel(t, server, contract.Id)
+	requireEcho(t, conn, "hello")
+
+	// the contract expires mid session, without the client sending anything
+	proxy.MemStore.SetHeight(contract.SettlementPeriodEnd() + 1)
+	requireClosed(t, conn, CloseContractExpired)
+}
+
+func TestWebsocketPerMinuteAccounting(t *testing.T) {
+	defer func(minute time.Duration) { websocketMinute = minute }(websocketMinute)
+	websocketMinute = 20 * time.Millisecond
+
+	contract := newWebsocketContract(5, 100, 100)
+	proxy, server := newWebsocketTest(t, contract)
+	proxy.Config.WebsocketAccounting = map[string]string{common.BTCService.String(): WebsocketAccountingMinute}
+	server.Config.Handler = proxy.getRouter()
+	conn := dialSentinel(t, server, contract.Id)
+
+	// messages are not charged
+	requireEcho(t, conn, "one")
+	requireEcho(t, conn, "two")
+	require.Eventually(t, func() bool {
+		return proxy.StreamUsage.Get(contract.Id) >= 3
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestWebsocketFreeTier(t *testing.T) {
+	_, server := newWebsocketTest(t, types.Contract{})
+	conn := dialSentinel(t, server, 0)
+	requireEcho(t, conn, "hello")
+	require.NoError(t, conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, "")))
+	requireClosed(t, conn, websocket.CloseNormalClosure)
+}
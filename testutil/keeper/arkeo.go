@@ -40,6 +40,12 @@ import (
 )
 
 func ArkeoKeeper(t testing.TB) (cosmos.Context, keeper.Keeper) {
+	ctx, k, _ := ArkeoKeeperWithStoreKey(t)
+	return ctx, k
+}
+
+// ArkeoKeeperWithStoreKey return the keeper along with the key of its store, for the tests reading the store directly
+func ArkeoKeeperWithStoreKey(t testing.TB) (cosmos.Context, keeper.Keeper, storetypes.StoreKey) {
 	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
 	keyAcc := cosmos.NewKVStoreKey(authtypes.StoreKey)
 	keyBank := cosmos.NewKVStoreKey(banktypes.StoreKey)
@@ -55,9 +61,13 @@ func ArkeoKeeper(t testing.TB) (cosmos.Context, keeper.Keeper) {
 	stateStore := store.NewCommitMultiStore(db, logger, storemetrics.NewNoOpMetrics())
 	stateStore.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, db)
 	stateStore.MountStoreWithDB(memStoreKey, storetypes.StoreTypeMemory, nil)
+	// the bank and the accounts the keeper moves funds with
+	stateStore.MountStoreWithDB(keyAcc, storetypes.StoreTypeIAVL, db)
+	stateStore.MountStoreWithDB(keyBank, storetypes.StoreTypeIAVL, db)
 	require.NoError(t, stateStore.LoadLatestVersion())
 
 	registry := codectypes.NewInterfaceRegistry()
+	authtypes.RegisterInterfaces(registry)
 	cdc := codec.NewProtoCodec(registry)
 	legacyCodec := utils.MakeTestCodec()
 
@@ -149,5 +159,5 @@ func ArkeoKeeper(t testing.TB) (cosmos.Context, keeper.Keeper) {
 	// Initialize params
 	k.SetParams(ctx, types.DefaultParams())
 
-	return ctx, k
+	return ctx, k, storeKey
 }
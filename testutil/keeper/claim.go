@@ -23,9 +23,12 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
 	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/stretchr/testify/require"
 
@@ -37,6 +40,7 @@ type (
 		ClaimKeeper   keeper.Keeper
 		AccountKeeper authkeeper.AccountKeeper
 		BankKeeper    bankkeeper.Keeper
+		DistrKeeper   distrkeeper.Keeper
 	}
 )
 
@@ -59,6 +63,8 @@ func CreateTestClaimKeepers(t testing.TB) (TestKeepers, sdk.Context) {
 	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
 	keyAcc := storetypes.NewKVStoreKey(authtypes.StoreKey)
 	keyBank := storetypes.NewKVStoreKey(banktypes.StoreKey)
+	keyStake := storetypes.NewKVStoreKey(stakingtypes.StoreKey)
+	keyDistr := storetypes.NewKVStoreKey(distrtypes.StoreKey)
 	keyParams := storetypes.NewKVStoreKey(paramstypes.StoreKey)
 	tkeyParams := storetypes.NewTransientStoreKey(paramstypes.TStoreKey)
 	memStoreKey := storetypes.NewMemoryStoreKey(types.MemStoreKey)
@@ -76,6 +82,8 @@ func CreateTestClaimKeepers(t testing.TB) (TestKeepers, sdk.Context) {
 	stateStore.MountStoreWithDB(memStoreKey, storetypes.StoreTypeMemory, nil)
 	stateStore.MountStoreWithDB(keyAcc, storetypes.StoreTypeIAVL, db)
 	stateStore.MountStoreWithDB(keyBank, storetypes.StoreTypeIAVL, db)
+	stateStore.MountStoreWithDB(keyStake, storetypes.StoreTypeIAVL, db)
+	stateStore.MountStoreWithDB(keyDistr, storetypes.StoreTypeIAVL, db)
 	stateStore.MountStoreWithDB(tkeyParams, storetypes.StoreTypeIAVL, db)
 	stateStore.MountStoreWithDB(keyParams, storetypes.StoreTypeIAVL, db)
 	require.NoError(t, stateStore.LoadLatestVersion())
@@ -104,6 +112,7 @@ func CreateTestClaimKeepers(t testing.TB) (TestKeepers, sdk.Context) {
 		map[string][]string{
 			stakingtypes.BondedPoolName:    {authtypes.Burner, authtypes.Staking},
 			stakingtypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
+			distrtypes.ModuleName:          nil,
 			types.ModuleName:               {authtypes.Minter},
 			arkeotypes.ReserveName:         {authtypes.Minter},
 			arkeotypes.ProviderName:        {},
@@ -129,13 +138,36 @@ func CreateTestClaimKeepers(t testing.TB) (TestKeepers, sdk.Context) {
 		panic(err)
 	}
 
+	stakingKeeper := stakingkeeper.NewKeeper(
+		cdc,
+		runtime.NewKVStoreService(keyStake),
+		accountKeeper,
+		bankKeeper,
+		govModuleAddr,
+		authcodec.NewBech32Codec(sdk.GetConfig().GetBech32ValidatorAddrPrefix()),
+		authcodec.NewBech32Codec(sdk.GetConfig().GetBech32ConsensusAddrPrefix()),
+	)
+
+	distrKeeper := distrkeeper.NewKeeper(
+		cdc,
+		runtime.NewKVStoreService(keyDistr),
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		authtypes.FeeCollectorName,
+		govModuleAddr,
+	)
+	require.NoError(t, distrKeeper.FeePool.Set(ctx, distrtypes.InitialFeePool()))
+
 	k := keeper.NewKeeper(
 		cdc,
 		storeKey,
 		accountKeeper,
 		bankKeeper,
+		distrKeeper,
 		memStoreKey,
 		paramsSubspace,
+		govModuleAddr,
 		logger,
 	)
 
@@ -153,5 +185,6 @@ func CreateTestClaimKeepers(t testing.TB) (TestKeepers, sdk.Context) {
 		ClaimKeeper:   k,
 		AccountKeeper: accountKeeper,
 		BankKeeper:    bankKeeper,
+		DistrKeeper:   distrKeeper,
 	}, ctx
 }
@@ -25,6 +25,13 @@ func GetQueryCmd() *cobra.Command {
 
 	cmd.AddCommand(CmdQueryParams())
 	cmd.AddCommand(CmdActiveContract())
+	cmd.AddCommand(CmdContractsByProvider())
+	cmd.AddCommand(CmdContractsByOwner())
+	cmd.AddCommand(CmdProviderEarnings())
+	cmd.AddCommand(CmdProviders())
+	cmd.AddCommand(CmdContractSettlementPreview())
+	cmd.AddCommand(CmdClaimableIncome())
+	cmd.AddCommand(CmdQueryNetworkStats())
 
 	// this line is used by starport scaffolding # 1
 
@@ -11,6 +11,11 @@ import (
 	"github.com/spf13/cobra"
 )
 
+const (
+	flagState           = "state"
+	flagIncludeDelegate = "include-delegate"
+)
+
 func CmdListContracts() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "list-contracts",
@@ -76,3 +81,165 @@ func CmdShowContract() *cobra.Command {
 
 	return cmd
 }
+
+func CmdContractsByProvider() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "contracts-by-provider [provider-pubkey] [service]",
+		Short: "list the contracts of a provider for a service",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			state, err := cmd.Flags().GetString(flagState)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryContractsByProviderRequest{
+				Provider:   args[0],
+				Service:    args[1],
+				State:      state,
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.ContractsByProvider(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagState, "", "state of the contracts, pending, open or closed, all of them when empty")
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdContractsByOwner() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "contracts-by-owner [pubkey]",
+		Short: "list the contracts of a client, with their expiration and settlement status",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			includeDelegate, err := cmd.Flags().GetBool(flagIncludeDelegate)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryContractsByOwnerRequest{
+				Pubkey:          args[0],
+				IncludeDelegate: includeDelegate,
+				Pagination:      pageReq,
+			}
+
+			res, err := queryClient.ContractsByOwner(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Bool(flagIncludeDelegate, false, "also list the contracts the pubkey is the delegate of")
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdContractSettlementPreview() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "settlement-preview [contract-id] [nonce]",
+		Short: "shows how a contract would settle now, the claim at the nonce or without it the settlement closing the contract",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			argContractId, err := cast.ToUint64E(args[0])
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryContractSettlementPreviewRequest{
+				ContractId: argContractId,
+			}
+			if len(args) > 1 {
+				params.Nonce, err = cast.ToInt64E(args[1])
+				if err != nil {
+					return err
+				}
+			}
+
+			res, err := queryClient.ContractSettlementPreview(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdClaimableIncome() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claimable-income [contract-id] [nonce]",
+		Short: "shows what the claim of the provider at the nonce would pay it now, and the deposit left once claimed",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			argContractId, err := cast.ToUint64E(args[0])
+			if err != nil {
+				return err
+			}
+			argNonce, err := cast.ToInt64E(args[1])
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryClaimableIncomeRequest{
+				ContractId: argContractId,
+				Nonce:      argNonce,
+			}
+
+			res, err := queryClient.ClaimableIncome(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
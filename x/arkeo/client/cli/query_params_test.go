@@ -0,0 +1,55 @@
+package cli_test
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	"github.com/stretchr/testify/require"
+
+	"github.com/arkeonetwork/arkeo/x/arkeo/client/cli"
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+)
+
+var updateGolden = flag.Bool("update-golden", false, "rewrite the golden outputs of the commands")
+
+func TestCmdQueryParams(t *testing.T) {
+	registry := codectypes.NewInterfaceRegistry()
+	types.RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+
+	res := types.QueryParamsResponse{
+		Params:           types.DefaultParams(),
+		LastChangeHeight: 10,
+		ConsensusVersion: types.ConsensusVersion,
+		Version:          1,
+	}
+	bz, err := cdc.Marshal(&res)
+	require.NoError(t, err)
+	clientCtx := client.Context{}.
+		WithCodec(cdc).
+		WithInterfaceRegistry(registry).
+		WithClient(clitestutil.NewMockCometRPC(abci.ResponseQuery{Value: bz}))
+
+	// the output format is read from the flags by the root command, set on the context here
+	for _, format := range []string{"json", "text"} {
+		t.Run(format, func(t *testing.T) {
+			out, err := clitestutil.ExecTestCLICmd(clientCtx.WithOutputFormat(format), cli.CmdQueryParams(), []string{})
+			require.NoError(t, err)
+
+			golden := filepath.Join("testdata", "query_params."+format+".golden")
+			if *updateGolden {
+				require.NoError(t, os.WriteFile(golden, out.Bytes(), 0o600))
+			}
+			expected, err := os.ReadFile(golden)
+			require.NoError(t, err)
+			require.Equal(t, string(expected), out.String())
+		})
+	}
+}
@@ -43,6 +43,48 @@ func CmdListProviders() *cobra.Command {
 	return cmd
 }
 
+func CmdProviders() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "providers [service]",
+		Short: "list the providers of a service",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			status, err := cmd.Flags().GetString(flagStatus)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryProvidersRequest{
+				Service:      args[0],
+				StatusFilter: status,
+				Pagination:   pageReq,
+			}
+
+			res, err := queryClient.Providers(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagStatus, "", "status of the providers, online or offline, all of them when empty")
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func CmdShowProvider() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "show-provider [pubkey] [service]",
@@ -74,3 +116,32 @@ func CmdShowProvider() *cobra.Command {
 
 	return cmd
 }
+
+func CmdProviderEarnings() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "provider-earnings [pubkey] [service]",
+		Short: "shows the income, settled contracts and escrowed deposits of a provider",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryProviderEarningsRequest{
+				Pubkey:  args[0],
+				Service: args[1],
+			}
+
+			res, err := queryClient.ProviderEarnings(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
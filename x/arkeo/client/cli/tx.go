@@ -9,6 +9,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	// "github.com/cosmos/cosmos-sdk/client/flags"
 )
 
@@ -36,8 +37,33 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdOpenContract())
 	cmd.AddCommand(CmdCloseContract())
 	cmd.AddCommand(CmdClaimContractIncome())
+	cmd.AddCommand(CmdRenewContract())
+	cmd.AddCommand(CmdProviderCloseContract())
+	cmd.AddCommand(CmdRotateDelegate())
+	cmd.AddCommand(CmdSetAutoRenew())
+	cmd.AddCommand(CmdTopUpContract())
+	cmd.AddCommand(CmdClaimContractIncomeBatch())
+	cmd.AddCommand(CmdPurgeExpiredContracts())
+	cmd.AddCommand(CmdSetProviderAllowlist())
 	cmd.AddCommand(CmdSetVersion())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
 }
+
+// authzHelp explain how the provider account lets another key send msg, a generic grant of its type URL being the
+// only grant type supported
+func authzHelp(msg sdk.Msg, example string) string {
+	typeURL := sdk.MsgTypeURL(msg)
+	return fmt.Sprintf(`
+
+The provider account can let another key (e.g. an ops key) send it through x/authz, with a generic grant of %[1]s,
+optionally expiring:
+
+  arkeod tx authz grant [grantee] generic --msg-type=%[1]s --expiration=[unix-timestamp] --from [provider]
+
+The grantee then generates the message for the provider account and executes it:
+
+  %[2]s --from [provider-address] --generate-only > msg.json
+  arkeod tx authz exec msg.json --from [grantee]`, typeURL, example)
+}
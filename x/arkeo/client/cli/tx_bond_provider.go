@@ -17,6 +17,7 @@ func CmdBondProvider() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "bond-provider [pubkey] [service] [bond]",
 		Short: "Broadcast message bondProvider",
+		Long:  "Broadcast message bondProvider, adding to (or removing from, when negative) the bond of the provider." + authzHelp(&types.MsgBondProvider{}, "arkeod tx arkeo bond-provider [pubkey] [service] [bond]"),
 		Args:  cobra.ExactArgs(3),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			argPubkey := args[0]
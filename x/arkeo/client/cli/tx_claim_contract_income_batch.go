@@ -0,0 +1,57 @@
+package cli
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+func CmdClaimContractIncomeBatch() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim-contract-income-batch [contract-id:nonce:signature]...",
+		Short: "Broadcast message claimContractIncomeBatch",
+		Long:  "Claim the income of several contracts at once, each claim succeeding or failing on its own",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			claims := make([]types.ContractClaim, len(args))
+			for i, arg := range args {
+				parts := strings.Split(arg, ":")
+				if len(parts) != 3 {
+					return fmt.Errorf("invalid claim %q, expected contract-id:nonce:signature", arg)
+				}
+				if claims[i].ContractId, err = cast.ToUint64E(parts[0]); err != nil {
+					return err
+				}
+				if claims[i].Nonce, err = cast.ToInt64E(parts[1]); err != nil {
+					return err
+				}
+				if claims[i].Signature, err = hex.DecodeString(parts[2]); err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgClaimContractIncomeBatch(clientCtx.GetFromAddress(), claims)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
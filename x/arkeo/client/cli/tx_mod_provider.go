@@ -1,6 +1,9 @@
 package cli
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/arkeonetwork/arkeo/common"
 	"github.com/arkeonetwork/arkeo/common/cosmos"
 	"github.com/arkeonetwork/arkeo/x/arkeo/types"
@@ -12,11 +15,37 @@ import (
 	"github.com/spf13/cobra"
 )
 
+const (
+	flagMetadataURI         = "metadata-uri"
+	flagMetadataNonce       = "metadata-nonce"
+	flagStatus              = "status"
+	flagMinContractDuration = "min-contract-duration"
+	flagMaxContractDuration = "max-contract-duration"
+	flagSubscriptionRates   = "subscription-rates"
+	flagPayAsYouGoRates     = "pay-as-you-go-rates"
+	flagSettlementDuration  = "settlement-duration"
+	flagMaxOpenContracts    = "max-open-contracts"
+)
+
+// modProviderFlags map the flags of mod-provider to the fields of the provider they update
+var modProviderFlags = map[string]string{
+	flagMetadataURI:         types.ModProviderFieldMetadataUri,
+	flagMetadataNonce:       types.ModProviderFieldMetadataNonce,
+	flagStatus:              types.ModProviderFieldStatus,
+	flagMinContractDuration: types.ModProviderFieldMinContractDuration,
+	flagMaxContractDuration: types.ModProviderFieldMaxContractDuration,
+	flagSubscriptionRates:   types.ModProviderFieldSubscriptionRate,
+	flagPayAsYouGoRates:     types.ModProviderFieldPayAsYouGoRate,
+	flagSettlementDuration:  types.ModProviderFieldSettlementDuration,
+	flagMaxOpenContracts:    types.ModProviderFieldMaxOpenContracts,
+}
+
 func CmdModProvider() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "mod-provider [pubkey] [service] [metatadata-uri] [metadata-nonce] [status] [min-contract-duration] [max-contract-duration] [subscription-rates] [pay-as-you-go-rates] [settlement-duration]",
+		Use:   "mod-provider [pubkey] [service]",
 		Short: "Broadcast message modProvider",
-		Args:  cobra.ExactArgs(10),
+		Long:  "Broadcast message modProvider, updating the fields of the provider passed as flags only." + authzHelp(&types.MsgModProvider{}, "arkeod tx arkeo mod-provider [pubkey] [service] --metadata-nonce [nonce]"),
+		Args:  cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			argPubkey := args[0]
 			pubkey, err := common.NewPubKey(argPubkey)
@@ -25,55 +54,69 @@ func CmdModProvider() *cobra.Command {
 			}
 			argService := args[1]
 
-			argMetatadataURI := args[2]
-			argMetadataNonce, err := cast.ToUint64E(args[3])
+			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
-			argStatus, err := cast.ToInt32E(args[4])
-			if err != nil {
+
+			msg := &types.MsgModProvider{
+				Creator:  clientCtx.GetFromAddress().String(),
+				Provider: pubkey,
+				Service:  argService,
+			}
+			// the mask follows the order of the fields, whatever the order of the flags
+			for _, field := range types.ModProviderFields {
+				for flag, flagField := range modProviderFlags {
+					if flagField == field && cmd.Flags().Changed(flag) {
+						msg.UpdateMask = append(msg.UpdateMask, field)
+					}
+				}
+			}
+			if len(msg.UpdateMask) == 0 {
+				return fmt.Errorf("no field to update, pass at least one of the flags")
+			}
+
+			if msg.MetadataUri, err = cmd.Flags().GetString(flagMetadataURI); err != nil {
 				return err
 			}
-			argMinContractDuration, err := cast.ToInt64E(args[5])
-			if err != nil {
+			if msg.MetadataNonce, err = cmd.Flags().GetUint64(flagMetadataNonce); err != nil {
 				return err
 			}
-			argMaxContractDuration, err := cast.ToInt64E(args[6])
+			argStatus, err := cmd.Flags().GetString(flagStatus)
 			if err != nil {
 				return err
 			}
-			argSubscriptionRate, err := cosmos.ParseCoins(args[7])
-			if err != nil {
+			if cmd.Flags().Changed(flagStatus) {
+				if msg.Status, err = parseProviderStatus(argStatus); err != nil {
+					return err
+				}
+			}
+			if msg.MinContractDuration, err = cmd.Flags().GetInt64(flagMinContractDuration); err != nil {
 				return err
 			}
-			argPayAsYouGoRate, err := cosmos.ParseCoins(args[8])
-			if err != nil {
+			if msg.MaxContractDuration, err = cmd.Flags().GetInt64(flagMaxContractDuration); err != nil {
 				return err
 			}
-
-			argSettlementDuration, err := cast.ToInt64E(args[9])
+			argSubscriptionRate, err := cmd.Flags().GetString(flagSubscriptionRates)
 			if err != nil {
 				return err
 			}
-
-			clientCtx, err := client.GetClientTxContext(cmd)
+			if msg.SubscriptionRate, err = cosmos.ParseCoins(argSubscriptionRate); err != nil {
+				return err
+			}
+			argPayAsYouGoRate, err := cmd.Flags().GetString(flagPayAsYouGoRates)
 			if err != nil {
 				return err
 			}
-
-			msg := types.NewMsgModProvider(
-				clientCtx.GetFromAddress(),
-				pubkey,
-				argService,
-				argMetatadataURI,
-				argMetadataNonce,
-				types.ProviderStatus(argStatus),
-				argMinContractDuration,
-				argMaxContractDuration,
-				argSubscriptionRate,
-				argPayAsYouGoRate,
-				argSettlementDuration,
-			)
+			if msg.PayAsYouGoRate, err = cosmos.ParseCoins(argPayAsYouGoRate); err != nil {
+				return err
+			}
+			if msg.SettlementDuration, err = cmd.Flags().GetInt64(flagSettlementDuration); err != nil {
+				return err
+			}
+			if msg.MaxOpenContracts, err = cmd.Flags().GetUint64(flagMaxOpenContracts); err != nil {
+				return err
+			}
 
 			if err := msg.ValidateBasic(); err != nil {
 				return err
@@ -82,7 +125,31 @@ func CmdModProvider() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(flagMetadataURI, "", "uri of the provider metadata")
+	cmd.Flags().Uint64(flagMetadataNonce, 0, "nonce of the provider metadata, only increased")
+	cmd.Flags().String(flagStatus, "", "status of the provider, online or offline (or 1 and 0)")
+	cmd.Flags().Int64(flagMinContractDuration, 0, "min contract duration, in blocks")
+	cmd.Flags().Int64(flagMaxContractDuration, 0, "max contract duration, in blocks")
+	cmd.Flags().String(flagSubscriptionRates, "", "subscription rates, e.g. 10uarkeo")
+	cmd.Flags().String(flagPayAsYouGoRates, "", "pay-as-you-go rates, e.g. 10uarkeo")
+	cmd.Flags().Int64(flagSettlementDuration, 0, "settlement duration of the contracts, in blocks")
+	cmd.Flags().Uint64(flagMaxOpenContracts, 0, "open contracts accepted at most, 0 for the chain cap")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
 }
+
+// parseProviderStatus parse a provider status from its name or its number
+func parseProviderStatus(status string) (types.ProviderStatus, error) {
+	if value, ok := types.ProviderStatus_value[strings.ToUpper(status)]; ok {
+		return types.ProviderStatus(value), nil
+	}
+	value, err := cast.ToInt32E(status)
+	if err != nil {
+		return 0, fmt.Errorf("invalid provider status: %s", status)
+	}
+	if _, ok := types.ProviderStatus_name[value]; !ok {
+		return 0, fmt.Errorf("invalid provider status: %s", status)
+	}
+	return types.ProviderStatus(value), nil
+}
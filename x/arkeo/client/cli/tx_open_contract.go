@@ -14,6 +14,12 @@ import (
 	"github.com/spf13/cobra"
 )
 
+const (
+	flagAutoRenew   = "auto-renew"
+	flagPayer       = "payer"
+	flagStartHeight = "start-height"
+)
+
 func CmdOpenContract() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "open-contract [provider_pubkey] [service] [client_pubkey] [c-type] [deposit] [duration] [rate] [queries-per-minute] [settlement-duration] [authorization-optional] [delegation-optional]",
@@ -99,6 +105,15 @@ func CmdOpenContract() *cobra.Command {
 				types.ContractAuthorization(argContractAuth),
 				argQPM,
 			)
+			if msg.Payer, err = cmd.Flags().GetString(flagPayer); err != nil {
+				return err
+			}
+			if msg.AutoRenew, err = cmd.Flags().GetBool(flagAutoRenew); err != nil {
+				return err
+			}
+			if msg.StartHeight, err = cmd.Flags().GetInt64(flagStartHeight); err != nil {
+				return err
+			}
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -106,6 +121,9 @@ func CmdOpenContract() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().Bool(flagAutoRenew, false, "renew the subscription at its expiration out of its top-up balance")
+	cmd.Flags().String(flagPayer, "", "address funding the deposit and getting its refund, signing the tx along with the client")
+	cmd.Flags().Int64(flagStartHeight, 0, "height the contract starts at, the deposit escrowed at once, zero to start it right away")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -0,0 +1,44 @@
+package cli
+
+import (
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+func CmdProviderCloseContract() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "provider-close-contract [contract-id]",
+		Short: "Broadcast message providerCloseContract",
+		Long:  "Broadcast message providerCloseContract, closing a contract as its provider. A penalty is paid from the provider bond to the client",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argContractId, err := cast.ToUint64E(args[0])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgProviderCloseContract(
+				clientCtx.GetFromAddress(),
+				argContractId,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
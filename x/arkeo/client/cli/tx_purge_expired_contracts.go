@@ -0,0 +1,49 @@
+package cli
+
+import (
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+const flagLimit = "limit"
+
+func CmdPurgeExpiredContracts() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "purge-expired-contracts [contract-id]...",
+		Short: "Broadcast message purgeExpiredContracts",
+		Long:  "Purge the contracts long expired for a reward, either those listed or the first ones found up to --limit",
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contractIds := make([]uint64, len(args))
+			for i, arg := range args {
+				if contractIds[i], err = cast.ToUint64E(arg); err != nil {
+					return err
+				}
+			}
+			limit, err := cmd.Flags().GetUint64(flagLimit)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgPurgeExpiredContracts(clientCtx.GetFromAddress(), contractIds, limit)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Uint64(flagLimit, 0, "without contract ids, purge the first contracts found purgeable up to this many")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,68 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/arkeonetwork/arkeo/common/cosmos"
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+func CmdRenewContract() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "renew-contract [contract-id] [additional-duration] [extra-deposit] [accepted-rate-optional]",
+		Short: "Broadcast message renewContract",
+		Long:  "Broadcast message renewContract, the accepted rate being required when the provider changed its rate since the contract opened",
+		Args:  cobra.RangeArgs(3, 4),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argContractId, err := cast.ToUint64E(args[0])
+			if err != nil {
+				return err
+			}
+
+			argAdditionalDuration, err := cast.ToInt64E(args[1])
+			if err != nil {
+				return err
+			}
+
+			argExtraDeposit, ok := cosmos.NewIntFromString(args[2])
+			if !ok {
+				return fmt.Errorf("bad extra deposit amount: %s", args[2])
+			}
+
+			var argRate cosmos.Coin
+			if len(args) > 3 {
+				argRate, err = cosmos.ParseCoin(args[3])
+				if err != nil {
+					return err
+				}
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRenewContract(
+				clientCtx.GetFromAddress(),
+				argContractId,
+				argAdditionalDuration,
+				argExtraDeposit,
+				argRate,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
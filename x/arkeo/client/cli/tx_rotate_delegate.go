@@ -0,0 +1,51 @@
+package cli
+
+import (
+	"github.com/arkeonetwork/arkeo/common"
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+func CmdRotateDelegate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rotate-delegate [contract-id] [new-delegate-pubkey]",
+		Short: "Broadcast message rotateDelegate",
+		Long:  "Broadcast message rotateDelegate, replacing the delegate spending a contract as its client. The claims signed by the old delegate are rejected from then on",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argContractId, err := cast.ToUint64E(args[0])
+			if err != nil {
+				return err
+			}
+
+			newDelegate, err := common.NewPubKey(args[1])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRotateDelegate(
+				clientCtx.GetFromAddress(),
+				argContractId,
+				newDelegate,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
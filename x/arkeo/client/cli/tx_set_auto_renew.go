@@ -0,0 +1,50 @@
+package cli
+
+import (
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+func CmdSetAutoRenew() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-auto-renew [contract-id] [true|false]",
+		Short: "Broadcast message setAutoRenew",
+		Long:  "Broadcast message setAutoRenew, turning the auto-renewal of a subscription on or off as its client. Turned off, the top-up balance of the contract is refunded",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argContractId, err := cast.ToUint64E(args[0])
+			if err != nil {
+				return err
+			}
+
+			argAutoRenew, err := cast.ToBoolE(args[1])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetAutoRenew(
+				clientCtx.GetFromAddress(),
+				argContractId,
+				argAutoRenew,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
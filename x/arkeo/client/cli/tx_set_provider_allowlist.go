@@ -0,0 +1,55 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/arkeonetwork/arkeo/common"
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+)
+
+func CmdSetProviderAllowlist() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-provider-allowlist [pubkey] [service] [replace|add|remove] [client-pubkey]...",
+		Short: "Broadcast message setProviderAllowlist",
+		Long: "Set the clients allowed to open contracts with the provider for the service, replacing with no clients opens it to all again." +
+			authzHelp(&types.MsgSetProviderAllowlist{}, "arkeod tx arkeo set-provider-allowlist [pubkey] [service] add [client-pubkey]"),
+		Args: cobra.MinimumNArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			pubkey, err := common.NewPubKey(args[0])
+			if err != nil {
+				return err
+			}
+			action, ok := types.AllowlistAction_value["ALLOWLIST_"+strings.ToUpper(args[2])]
+			if !ok {
+				return fmt.Errorf("invalid action %s, must be replace, add or remove", args[2])
+			}
+			clients := make([]common.PubKey, len(args[3:]))
+			for i, arg := range args[3:] {
+				if clients[i], err = common.NewPubKey(arg); err != nil {
+					return err
+				}
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetProviderAllowlist(clientCtx.GetFromAddress(), pubkey, args[1], types.AllowlistAction(action), clients)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,53 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/arkeonetwork/arkeo/common/cosmos"
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+func CmdTopUpContract() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "top-up-contract [contract-id] [deposit]",
+		Short: "Broadcast message topUpContract",
+		Long:  "Broadcast message topUpContract, extending a subscription by deposit/(rate*qpm) blocks. An auto-renewed subscription keeps the deposit in its top-up balance until it expires",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argContractId, err := cast.ToUint64E(args[0])
+			if err != nil {
+				return err
+			}
+
+			deposit, ok := cosmos.NewIntFromString(args[1])
+			if !ok {
+				return fmt.Errorf("bad deposit amount: %s", args[1])
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgTopUpContract(
+				clientCtx.GetFromAddress(),
+				argContractId,
+				deposit,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -6,21 +6,31 @@ import "github.com/arkeonetwork/arkeo/common"
 func NewConfigValue010() *ConfigVals {
 	return &ConfigVals{
 		int64values: map[ConfigName]int64{
-			HandlerBondProvider:        0,                          // enable/disable bond provider handler
-			HandlerModProvider:         0,                          // enable/disable mod provider handler
-			HandlerOpenContract:        0,                          // enable/disable open contract handler
-			HandlerCloseContract:       0,                          // enable/disable close contract handler
-			HandlerClaimContractIncome: 0,                          // enable/disable claim contract income handler
-			HandlerSetVersion:          0,                          // enable/disable set version handler
-			MaxContractLength:          5256000,                    // one year
-			MaxSupply:                  common.Tokens(121_000_000), // max supply of tokens
-			OpenContractCost:           common.Tokens(1),           // cost to open a contract
-			MinProviderBond:            common.Tokens(1),           // min bond for a data provider to be able to open contracts with
-			ReserveTax:                 1000,                       // reserve income off provider income, in basis points
-			BlocksPerYear:              6311520,                    // blocks per year
-			EmissionCurve:              6,                          // rate in which the reserve is depleted to pay validators
-			ValidatorPayoutCycle:       1,                          // how often validators are paid out rewards
-			VersionConsensus:           90,                         // out of 100, percentage of nodes on a specific version before it is accepted
+			HandlerBondProvider:             0,                          // enable/disable bond provider handler
+			HandlerModProvider:              0,                          // enable/disable mod provider handler
+			HandlerOpenContract:             0,                          // enable/disable open contract handler
+			HandlerCloseContract:            0,                          // enable/disable close contract handler
+			HandlerClaimContractIncome:      0,                          // enable/disable claim contract income handler
+			HandlerSetVersion:               0,                          // enable/disable set version handler
+			HandlerRenewContract:            0,                          // enable/disable renew contract handler
+			HandlerProviderCloseContract:    0,                          // enable/disable provider close contract handler
+			HandlerRotateDelegate:           0,                          // enable/disable rotate delegate handler
+			HandlerSetAutoRenew:             0,                          // enable/disable set auto-renew handler
+			HandlerTopUpContract:            0,                          // enable/disable top up contract handler
+			HandlerClaimContractIncomeBatch: 0,                          // enable/disable claim contract income batch handler
+			HandlerPurgeExpiredContracts:    0,                          // enable/disable purge expired contracts handler
+			HandlerSetProviderAllowlist:     0,                          // enable/disable set provider allowlist handler
+			MaxContractLength:               5256000,                    // one year
+			MaxSupply:                       common.Tokens(121_000_000), // max supply of tokens
+			OpenContractCost:                common.Tokens(1),           // cost to open a contract
+			MinProviderBond:                 common.Tokens(1),           // min bond for a data provider to be able to open contracts with
+			ReserveTax:                      1000,                       // reserve income off provider income, in basis points
+			BlocksPerYear:                   6311520,                    // blocks per year
+			EmissionCurve:                   6,                          // rate in which the reserve is depleted to pay validators
+			ValidatorPayoutCycle:            1,                          // how often validators are paid out rewards
+			VersionConsensus:                90,                         // out of 100, percentage of nodes on a specific version before it is accepted
+			ClaimBatchItemGas:               1000,                       // gas consumed by each claim of a batch, for the signature it verifies
+			PurgeContractItemGas:            1000,                       // gas consumed by each contract a purge looks at, on top of the store access
 		},
 		boolValues:   map[ConfigName]bool{},
 		stringValues: map[ConfigName]string{},
@@ -23,24 +23,44 @@ const (
 	EmissionCurve
 	ValidatorPayoutCycle
 	VersionConsensus
+	HandlerRenewContract
+	HandlerProviderCloseContract
+	HandlerRotateDelegate
+	HandlerSetAutoRenew
+	HandlerTopUpContract
+	HandlerClaimContractIncomeBatch
+	ClaimBatchItemGas
+	HandlerPurgeExpiredContracts
+	PurgeContractItemGas
+	HandlerSetProviderAllowlist
 )
 
 var nameToString = map[ConfigName]string{
-	HandlerBondProvider:        "HandlerBondProvider",
-	HandlerModProvider:         "HandlerModProvider",
-	HandlerOpenContract:        "HandlerOpenContract",
-	HandlerCloseContract:       "HandlerCloseContract",
-	HandlerClaimContractIncome: "HandlerClaimContractIncome",
-	HandlerSetVersion:          "HandlerSetVersion",
-	MaxSupply:                  "MaxSupply",
-	MaxContractLength:          "MaxContractLength",
-	OpenContractCost:           "OpenContractCost",
-	MinProviderBond:            "MinProviderBond",
-	ReserveTax:                 "ReserveTax",
-	BlocksPerYear:              "BlocksPerYear",
-	EmissionCurve:              "EmissionCurve",
-	ValidatorPayoutCycle:       "ValidatorPayoutCycle",
-	VersionConsensus:           "VersionConsensus",
+	HandlerBondProvider:             "HandlerBondProvider",
+	HandlerModProvider:              "HandlerModProvider",
+	HandlerOpenContract:             "HandlerOpenContract",
+	HandlerCloseContract:            "HandlerCloseContract",
+	HandlerClaimContractIncome:      "HandlerClaimContractIncome",
+	HandlerSetVersion:               "HandlerSetVersion",
+	MaxSupply:                       "MaxSupply",
+	MaxContractLength:               "MaxContractLength",
+	OpenContractCost:                "OpenContractCost",
+	MinProviderBond:                 "MinProviderBond",
+	ReserveTax:                      "ReserveTax",
+	BlocksPerYear:                   "BlocksPerYear",
+	EmissionCurve:                   "EmissionCurve",
+	ValidatorPayoutCycle:            "ValidatorPayoutCycle",
+	VersionConsensus:                "VersionConsensus",
+	HandlerRenewContract:            "HandlerRenewContract",
+	HandlerProviderCloseContract:    "HandlerProviderCloseContract",
+	HandlerRotateDelegate:           "HandlerRotateDelegate",
+	HandlerSetAutoRenew:             "HandlerSetAutoRenew",
+	HandlerTopUpContract:            "HandlerTopUpContract",
+	HandlerClaimContractIncomeBatch: "HandlerClaimContractIncomeBatch",
+	ClaimBatchItemGas:               "ClaimBatchItemGas",
+	HandlerPurgeExpiredContracts:    "HandlerPurgeExpiredContracts",
+	PurgeContractItemGas:            "PurgeContractItemGas",
+	HandlerSetProviderAllowlist:     "HandlerSetProviderAllowlist",
 }
 
 // String implement fmt.stringer
@@ -32,11 +32,53 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 		}
 	}
 
+	for _, expirationSet := range genState.PayAsYouGoExpirationSets {
+		if err := k.SetPayAsYouGoExpirationSet(ctx, expirationSet); err != nil {
+			ctx.Logger().Error("unable to set pay-as-you-go expiration set", "height", expirationSet.Height, "error", err)
+		}
+	}
+
+	for _, startSet := range genState.ContractStartSets {
+		if err := k.SetContractStartSet(ctx, startSet); err != nil {
+			ctx.Logger().Error("unable to set contract start set", "height", startSet.Height, "error", err)
+		}
+	}
+
 	for _, userContractSet := range genState.UserContractSets {
 		if err := k.SetUserContractSet(ctx, userContractSet); err != nil {
 			ctx.Logger().Error("unable to set user contract set", "user", userContractSet.User, "error", err)
 		}
 	}
+
+	for _, earnings := range genState.ProviderEarnings {
+		if err := k.SetProviderEarnings(ctx, earnings); err != nil {
+			ctx.Logger().Error("unable to set provider earnings", "provider", earnings.Provider, "service", earnings.Service, "error", err)
+		}
+	}
+
+	for _, allowlist := range genState.ProviderAllowlists {
+		if err := k.SetProviderAllowlist(ctx, allowlist); err != nil {
+			ctx.Logger().Error("unable to set provider allowlist", "provider", allowlist.Provider, "service", allowlist.Service, "error", err)
+		}
+	}
+
+	for _, validatorVersion := range genState.ValidatorVersions {
+		addr, err := sdk.ValAddressFromBech32(validatorVersion.Address)
+		if err != nil {
+			ctx.Logger().Error("unable to parse validator address", "address", validatorVersion.Address, "error", err)
+			continue
+		}
+		k.SetVersionForAddress(ctx, addr, validatorVersion.Version)
+	}
+
+	if genState.ParamsRecord != nil {
+		k.SetParamsRecord(ctx, *genState.ParamsRecord)
+	}
+
+	// the providers and contracts were counted as they were set, the exported counters replace them
+	if genState.NetworkStats != nil {
+		k.SetNetworkStats(ctx, *genState.NetworkStats)
+	}
 }
 
 // ExportGenesis returns the module's exported genesis
@@ -80,6 +122,31 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		}
 		genesis.ContractExpirationSets = append(genesis.ContractExpirationSets, expirationSet)
 	}
+	iter.Close()
+
+	// pay-as-you-go expiration sets
+	iter = k.GetPayAsYouGoExpirationSetIterator(ctx)
+	for ; iter.Valid(); iter.Next() {
+		var expirationSet types.ContractExpirationSet
+		if err := k.Cdc().Unmarshal(iter.Value(), &expirationSet); err != nil {
+			ctx.Logger().Error("unable to get pay-as-you-go expiration set", "contract", iter.Key(), "error", err)
+			continue
+		}
+		genesis.PayAsYouGoExpirationSets = append(genesis.PayAsYouGoExpirationSets, expirationSet)
+	}
+	iter.Close()
+
+	// contract start sets
+	iter = k.GetContractStartSetIterator(ctx)
+	for ; iter.Valid(); iter.Next() {
+		var startSet types.ContractExpirationSet
+		if err := k.Cdc().Unmarshal(iter.Value(), &startSet); err != nil {
+			ctx.Logger().Error("unable to get contract start set", "contract", iter.Key(), "error", err)
+			continue
+		}
+		genesis.ContractStartSets = append(genesis.ContractStartSets, startSet)
+	}
+	iter.Close()
 
 	// user contract sets
 	iter = k.GetUserContractSetIterator(ctx)
@@ -91,6 +158,52 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		}
 		genesis.UserContractSets = append(genesis.UserContractSets, userContractSet)
 	}
+	iter.Close()
+
+	// provider earnings
+	iter = k.GetProviderEarningsIterator(ctx)
+	for ; iter.Valid(); iter.Next() {
+		var earnings types.ProviderEarnings
+		if err := k.Cdc().Unmarshal(iter.Value(), &earnings); err != nil {
+			ctx.Logger().Error("unable to get provider earnings", "provider", iter.Key(), "error", err)
+			continue
+		}
+		genesis.ProviderEarnings = append(genesis.ProviderEarnings, earnings)
+	}
+	iter.Close()
+
+	// provider allowlists
+	iter = k.GetProviderAllowlistIterator(ctx)
+	for ; iter.Valid(); iter.Next() {
+		var allowlist types.ProviderAllowlist
+		if err := k.Cdc().Unmarshal(iter.Value(), &allowlist); err != nil {
+			ctx.Logger().Error("unable to get provider allowlist", "provider", iter.Key(), "error", err)
+			continue
+		}
+		genesis.ProviderAllowlists = append(genesis.ProviderAllowlists, allowlist)
+	}
+	iter.Close()
+
+	validatorVersions, err := k.GetValidatorVersions(ctx)
+	if err != nil {
+		ctx.Logger().Error("unable to get validator versions", "error", err)
+	}
+	genesis.ValidatorVersions = validatorVersions
+
+	record, found, err := k.GetParamsRecord(ctx)
+	if err != nil {
+		ctx.Logger().Error("unable to get params record", "error", err)
+	}
+	if found {
+		genesis.ParamsRecord = &record
+	}
+
+	stats, err := k.GetNetworkStats(ctx)
+	if err != nil {
+		ctx.Logger().Error("unable to get network stats", "error", err)
+	} else {
+		genesis.NetworkStats = &stats
+	}
 
 	return genesis
 }
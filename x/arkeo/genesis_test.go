@@ -6,11 +6,16 @@ import (
 	"github.com/arkeonetwork/arkeo/common"
 	"github.com/arkeonetwork/arkeo/common/cosmos"
 	keepertest "github.com/arkeonetwork/arkeo/testutil/keeper"
+	"github.com/arkeonetwork/arkeo/x/arkeo/configs"
 
 	"github.com/arkeonetwork/arkeo/testutil/nullify"
 	"github.com/arkeonetwork/arkeo/x/arkeo"
+	"github.com/arkeonetwork/arkeo/x/arkeo/keeper"
 	"github.com/arkeonetwork/arkeo/x/arkeo/types"
 
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	"github.com/stretchr/testify/require"
 )
 
@@ -62,6 +67,7 @@ func TestGenesisWithContracts(t *testing.T) {
 			Id:       0,
 			Deposit:  cosmos.NewInt(500),
 			Paid:     cosmos.ZeroInt(),
+			TopUp:    cosmos.ZeroInt(),
 			Height:   100,
 		},
 		{
@@ -73,6 +79,7 @@ func TestGenesisWithContracts(t *testing.T) {
 			Id:       1,
 			Deposit:  cosmos.NewInt(500),
 			Paid:     cosmos.ZeroInt(),
+			TopUp:    cosmos.ZeroInt(),
 			Height:   100,
 		},
 		{
@@ -84,6 +91,7 @@ func TestGenesisWithContracts(t *testing.T) {
 			Id:       2,
 			Deposit:  cosmos.NewInt(200),
 			Paid:     cosmos.ZeroInt(),
+			TopUp:    cosmos.ZeroInt(),
 			Height:   100,
 		},
 	}
@@ -132,6 +140,18 @@ func TestGenesisWithContracts(t *testing.T) {
 	err = k.SetContractExpirationSet(ctx, contractExpirationSet2)
 	require.NoError(t, err)
 
+	// provider earnings
+	earnings := types.NewProviderEarnings(providerPubkey, common.BTCService)
+	earnings.Earn(cosmos.NewInt64Coin("uarkeo", 900))
+	earnings.Escrow(cosmos.NewInt64Coin("uarkeo", 700))
+	earnings.SettledContracts = 3
+	require.NoError(t, k.SetProviderEarnings(ctx, earnings))
+
+	// provider allowlist
+	allowlist := types.NewProviderAllowlist(providerPubkey, common.BTCService)
+	allowlist.Clients = []common.PubKey{user1PubKey}
+	require.NoError(t, k.SetProviderAllowlist(ctx, allowlist))
+
 	exportedGenesis := arkeo.ExportGenesis(ctx, k)
 	require.NotNil(t, exportedGenesis)
 
@@ -140,6 +160,11 @@ func TestGenesisWithContracts(t *testing.T) {
 	require.ElementsMatch(t, exportedGenesis.Contracts, contracts)
 	require.ElementsMatch(t, exportedGenesis.UserContractSets, []types.UserContractSet{user1ContractSet, user2ContractSet})
 	require.ElementsMatch(t, exportedGenesis.ContractExpirationSets, []types.ContractExpirationSet{contractExpirationSet1, contractExpirationSet2})
+	require.ElementsMatch(t, exportedGenesis.ProviderEarnings, []types.ProviderEarnings{earnings})
+	require.ElementsMatch(t, exportedGenesis.ProviderAllowlists, []types.ProviderAllowlist{allowlist})
+	stats, err := k.GetNetworkStats(ctx)
+	require.NoError(t, err)
+	require.Equal(t, &stats, exportedGenesis.NetworkStats)
 
 	ctx, freshKeeper := keepertest.ArkeoKeeper(t)
 	contract, err := freshKeeper.GetContract(ctx, 0)
@@ -156,4 +181,146 @@ func TestGenesisWithContracts(t *testing.T) {
 	require.ElementsMatch(t, exportedGenesis2.Contracts, contracts)
 	require.ElementsMatch(t, exportedGenesis2.UserContractSets, []types.UserContractSet{user1ContractSet, user2ContractSet})
 	require.ElementsMatch(t, exportedGenesis2.ContractExpirationSets, []types.ContractExpirationSet{contractExpirationSet1, contractExpirationSet2})
+	require.ElementsMatch(t, exportedGenesis2.ProviderEarnings, []types.ProviderEarnings{earnings})
+	require.ElementsMatch(t, exportedGenesis2.ProviderAllowlists, []types.ProviderAllowlist{allowlist})
+	require.Equal(t, exportedGenesis.NetworkStats, exportedGenesis2.NetworkStats)
+}
+
+// dumpStore return every key/value pair of the store
+func dumpStore(ctx cosmos.Context, key storetypes.StoreKey) map[string][]byte {
+	kvs := make(map[string][]byte)
+	iter := ctx.KVStore(key).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		kvs[string(iter.Key())] = iter.Value()
+	}
+	return kvs
+}
+
+func TestGenesisRoundTrip(t *testing.T) {
+	ctx, k, storeKey := keepertest.ArkeoKeeperWithStoreKey(t)
+	ctx = ctx.WithBlockHeight(10)
+	s := keeper.NewMsgServerImpl(k, stakingkeeper.Keeper{})
+	mgr := keeper.NewManager(k, stakingkeeper.Keeper{})
+
+	providerPubKey := types.GetRandomPubKey()
+	providerAddress, err := providerPubKey.GetMyAddress()
+	require.NoError(t, err)
+	provider := types.NewProvider(providerPubKey, common.BTCService)
+	provider.Bond = cosmos.NewInt(20000000000)
+	require.NoError(t, k.SetProvider(ctx, provider))
+	require.NoError(t, k.MintToModule(ctx, types.ModuleName, cosmos.NewCoin(configs.Denom, provider.Bond)))
+	require.NoError(t, k.SendFromModuleToModule(ctx, types.ModuleName, types.ProviderName, cosmos.NewCoins(cosmos.NewCoin(configs.Denom, provider.Bond))))
+	rates, err := cosmos.ParseCoins("15uarkeo")
+	require.NoError(t, err)
+	_, err = s.ModProvider(ctx, &types.MsgModProvider{
+		Creator:             providerAddress.String(),
+		Provider:            provider.PubKey,
+		Service:             provider.Service.String(),
+		MetadataNonce:       1,
+		MinContractDuration: 10,
+		MaxContractDuration: 500,
+		Status:              types.ProviderStatus_ONLINE,
+		PayAsYouGoRate:      rates,
+		SubscriptionRate:    rates,
+		UpdateMask:          types.ModProviderFields,
+	})
+	require.NoError(t, err)
+
+	openContract := func(contractType types.ContractType, duration int64) types.Contract {
+		client := types.GetRandomPubKey()
+		address, err := client.GetMyAddress()
+		require.NoError(t, err)
+		require.NoError(t, k.MintAndSendToAccount(ctx, address, cosmos.NewCoin(configs.Denom, cosmos.NewInt(common.Tokens(10)))))
+		_, err = s.OpenContract(ctx, &types.MsgOpenContract{
+			Provider:         providerPubKey.String(),
+			Service:          provider.Service.String(),
+			Creator:          address.String(),
+			Client:           client.String(),
+			ContractType:     contractType,
+			Duration:         duration,
+			Rate:             rates[0],
+			Deposit:          cosmos.NewInt(15 * duration),
+			QueriesPerMinute: 1,
+		})
+		require.NoError(t, err)
+		contract, err := k.GetActiveContractForUser(ctx, client, providerPubKey, provider.Service)
+		require.NoError(t, err)
+		return contract
+	}
+	// expired at 30, settled at 40 after its grace period
+	expired := openContract(types.ContractType_PAY_AS_YOU_GO, 20)
+	// expires at 110, settled at 120
+	openContract(types.ContractType_PAY_AS_YOU_GO, 100)
+	// expires and settles at 60
+	openContract(types.ContractType_SUBSCRIPTION, 50)
+	renewed := openContract(types.ContractType_PAY_AS_YOU_GO, 30)
+	closed := openContract(types.ContractType_SUBSCRIPTION, 80)
+
+	ctx = ctx.WithBlockHeight(20)
+	_, err = s.RenewContract(ctx, &types.MsgRenewContract{
+		Creator:            renewed.ClientAddress().String(),
+		ContractId:         renewed.Id,
+		AdditionalDuration: 30,
+		ExtraDeposit:       cosmos.NewInt(15 * 30),
+	})
+	require.NoError(t, err)
+	_, err = s.ProviderCloseContract(ctx, &types.MsgProviderCloseContract{
+		Creator:    providerAddress.String(),
+		ContractId: closed.Id,
+	})
+	require.NoError(t, err)
+
+	valAddress := sdk.ValAddress(types.GetRandomBech32Addr())
+	k.SetVersionForAddress(ctx, valAddress, 2)
+	params := k.GetParams(ctx)
+	params.MaxOpenContracts = 10
+	k.SetParams(ctx, params)
+
+	// run the end blocker until the first contract expired, its settlement still pending
+	for height := int64(20); height <= 32; height++ {
+		ctx = ctx.WithBlockHeight(height)
+		require.NoError(t, mgr.ContractEndBlock(ctx))
+		require.NoError(t, mgr.ParamsEndBlock(ctx))
+	}
+	contract, err := k.GetContract(ctx, expired.Id)
+	require.NoError(t, err)
+	require.False(t, contract.IsSettled(ctx.BlockHeight()))
+
+	genesis := arkeo.ExportGenesis(ctx, k)
+	require.NoError(t, genesis.Validate())
+	require.NotEmpty(t, genesis.PayAsYouGoExpirationSets)
+	require.Len(t, genesis.ValidatorVersions, 1)
+	require.NotNil(t, genesis.ParamsRecord)
+
+	freshCtx, freshKeeper, freshStoreKey := keepertest.ArkeoKeeperWithStoreKey(t)
+	freshCtx = freshCtx.WithBlockHeight(ctx.BlockHeight())
+	arkeo.InitGenesis(freshCtx, freshKeeper, *genesis)
+	// the bank genesis restores the balances of the modules
+	for _, module := range []string{types.ContractName, types.ProviderName} {
+		balance := k.GetBalanceOfModule(ctx, module, configs.Denom)
+		if balance.IsZero() {
+			continue
+		}
+		require.NoError(t, freshKeeper.MintToModule(freshCtx, types.ModuleName, cosmos.NewCoin(configs.Denom, balance)))
+		require.NoError(t, freshKeeper.SendFromModuleToModule(freshCtx, types.ModuleName, module, cosmos.NewCoins(cosmos.NewCoin(configs.Denom, balance))))
+	}
+
+	// the imported state is the exported one, key for key
+	require.Equal(t, dumpStore(ctx, storeKey), dumpStore(freshCtx, freshStoreKey))
+	require.Equal(t, genesis, arkeo.ExportGenesis(freshCtx, freshKeeper))
+
+	// the next blocks settle the remaining contracts the same way
+	freshMgr := keeper.NewManager(freshKeeper, stakingkeeper.Keeper{})
+	for height := int64(33); height <= 125; height++ {
+		ctx = ctx.WithBlockHeight(height).WithEventManager(sdk.NewEventManager())
+		freshCtx = freshCtx.WithBlockHeight(height).WithEventManager(sdk.NewEventManager())
+		require.NoError(t, mgr.ContractEndBlock(ctx))
+		require.NoError(t, freshMgr.ContractEndBlock(freshCtx))
+		require.Equal(t, ctx.EventManager().Events(), freshCtx.EventManager().Events(), "height %d", height)
+	}
+	require.Equal(t, dumpStore(ctx, storeKey), dumpStore(freshCtx, freshStoreKey))
+	contract, err = freshKeeper.GetContract(freshCtx, expired.Id)
+	require.NoError(t, err)
+	require.True(t, contract.IsSettled(freshCtx.BlockHeight()))
 }
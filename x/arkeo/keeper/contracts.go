@@ -2,8 +2,10 @@ package keeper
 
 import (
 	"errors"
+	"fmt"
 	"strconv"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	gogotypes "github.com/gogo/protobuf/types"
 
 	"github.com/arkeonetwork/arkeo/common"
@@ -17,8 +19,11 @@ func (k KVStore) setContract(ctx cosmos.Context, contract types.Contract) {
 	buf := k.cdc.MustMarshal(&contract)
 	if buf == nil {
 		store.Delete([]byte(key))
+		store.Delete([]byte(k.getProviderContractKey(ctx, contract)))
 	} else {
 		store.Set([]byte(key), buf)
+		// index the contract by its provider, the state of the contract is read from the contract itself
+		store.Set([]byte(k.getProviderContractKey(ctx, contract)), sdk.Uint64ToBigEndian(contract.Id))
 	}
 }
 
@@ -53,6 +58,20 @@ func (k KVStore) SetContract(ctx cosmos.Context, contract types.Contract) error
 	if contract.Provider.IsEmpty() || contract.Service.IsEmpty() || contract.Client.IsEmpty() {
 		return errors.New("cannot save a contract with an empty provider pubkey, service, or client address")
 	}
+	var previous types.Contract
+	found, err := k.getContract(ctx, contract.Id, &previous)
+	if err != nil {
+		return err
+	}
+	err = k.updateNetworkStats(ctx, func(stats *types.NetworkStats) {
+		if found {
+			stats.RemoveContract(previous)
+		}
+		stats.AddContract(contract)
+	})
+	if err != nil {
+		return err
+	}
 	k.setContract(ctx, contract)
 	return nil
 }
@@ -62,8 +81,19 @@ func (k KVStore) ContractExists(ctx cosmos.Context, id uint64) bool {
 	return k.has(ctx, k.GetContractKey(ctx, id))
 }
 
-func (k KVStore) RemoveContract(ctx cosmos.Context, id uint64) {
+func (k KVStore) RemoveContract(ctx cosmos.Context, id uint64) error {
+	contract, err := k.GetContract(ctx, id)
+	if err == nil && !contract.IsEmpty() {
+		k.del(ctx, k.getProviderContractKey(ctx, contract))
+		err = k.updateNetworkStats(ctx, func(stats *types.NetworkStats) {
+			stats.RemoveContract(contract)
+		})
+		if err != nil {
+			return err
+		}
+	}
 	k.del(ctx, k.GetContractKey(ctx, id))
+	return nil
 }
 
 func (k KVStore) setContractExpirationSet(ctx cosmos.Context, key string, record types.ContractExpirationSet) {
@@ -101,6 +131,16 @@ func (k KVStore) GetContractKey(ctx cosmos.Context, id uint64) string {
 	return k.GetKey(ctx, prefixContract, strconv.FormatUint(id, 10))
 }
 
+// getProviderContractPrefix the prefix of the keys indexing the contracts of a provider for a service
+func (k KVStore) getProviderContractPrefix(ctx cosmos.Context, provider common.PubKey, service common.Service) string {
+	return k.GetKey(ctx, prefixProviderContract, fmt.Sprintf("%s/%s/", provider, service))
+}
+
+// getProviderContractKey the id is zero padded for the contracts of a provider to iterate in the order they opened
+func (k KVStore) getProviderContractKey(ctx cosmos.Context, contract types.Contract) string {
+	return fmt.Sprintf("%s%020d", k.getProviderContractPrefix(ctx, contract.Provider, contract.Service), contract.Id)
+}
+
 // GetContractExpirationSetIterator iterate contract expiration sets
 func (k KVStore) GetContractExpirationSetIterator(ctx cosmos.Context) cosmos.Iterator {
 	return k.getIterator(ctx, prefixContractExpirationSet)
@@ -127,10 +167,142 @@ func (k KVStore) SetContractExpirationSet(ctx cosmos.Context, record types.Contr
 	return nil
 }
 
+// AddToContractExpirationSet add a contract to the contracts settled at the given height
+func (k KVStore) AddToContractExpirationSet(ctx cosmos.Context, height int64, contractId uint64) error {
+	expirationSet, err := k.GetContractExpirationSet(ctx, height)
+	if err != nil {
+		return err
+	}
+	expirationSet.Append(contractId)
+	return k.SetContractExpirationSet(ctx, expirationSet)
+}
+
+// RemoveFromContractExpirationSet remove a contract from the contracts settled at the given height, the set is
+// deleted once empty
+func (k KVStore) RemoveFromContractExpirationSet(ctx cosmos.Context, height int64, contractId uint64) error {
+	expirationSet, err := k.GetContractExpirationSet(ctx, height)
+	if err != nil {
+		return err
+	}
+	expirationSet.Remove(contractId)
+	return k.SetContractExpirationSet(ctx, expirationSet)
+}
+
 func (k KVStore) RemoveContractExpirationSet(ctx cosmos.Context, height int64) {
 	k.del(ctx, k.GetKey(ctx, prefixContractExpirationSet, strconv.FormatInt(height, 10)))
 }
 
+func (k KVStore) getPayAsYouGoExpirationSetKey(ctx cosmos.Context, height int64) string {
+	return k.GetKey(ctx, prefixPaygExpirationSet, strconv.FormatInt(height, 10))
+}
+
+// GetPayAsYouGoExpirationSetIterator iterate the pay-as-you-go expiration sets
+func (k KVStore) GetPayAsYouGoExpirationSetIterator(ctx cosmos.Context) cosmos.Iterator {
+	return k.getIterator(ctx, prefixPaygExpirationSet)
+}
+
+// GetPayAsYouGoExpirationSet get the pay-as-you-go contracts expiring at the given height, ahead of the end of their
+// settlement period
+func (k KVStore) GetPayAsYouGoExpirationSet(ctx cosmos.Context, height int64) (types.ContractExpirationSet, error) {
+	record := types.ContractExpirationSet{
+		Height: height,
+	}
+	_, err := k.getContractExpirationSet(ctx, k.getPayAsYouGoExpirationSetKey(ctx, height), &record)
+	if record.ContractSet == nil {
+		record.ContractSet = &types.ContractSet{}
+	}
+	return record, err
+}
+
+// SetPayAsYouGoExpirationSet save the pay-as-you-go contracts expiring at a height
+func (k KVStore) SetPayAsYouGoExpirationSet(ctx cosmos.Context, record types.ContractExpirationSet) error {
+	if record.Height <= 0 {
+		return errors.New("cannot save a pay-as-you-go expiration set with an invalid height (less than or equal to zero)")
+	}
+	k.setContractExpirationSet(ctx, k.getPayAsYouGoExpirationSetKey(ctx, record.Height), record)
+	return nil
+}
+
+// AddToPayAsYouGoExpirationSet add a pay-as-you-go contract to the contracts expiring at the given height
+func (k KVStore) AddToPayAsYouGoExpirationSet(ctx cosmos.Context, height int64, contractId uint64) error {
+	expirationSet, err := k.GetPayAsYouGoExpirationSet(ctx, height)
+	if err != nil {
+		return err
+	}
+	expirationSet.Append(contractId)
+	return k.SetPayAsYouGoExpirationSet(ctx, expirationSet)
+}
+
+// RemoveFromPayAsYouGoExpirationSet remove a pay-as-you-go contract from the contracts expiring at the given height,
+// the set is deleted once empty
+func (k KVStore) RemoveFromPayAsYouGoExpirationSet(ctx cosmos.Context, height int64, contractId uint64) error {
+	expirationSet, err := k.GetPayAsYouGoExpirationSet(ctx, height)
+	if err != nil {
+		return err
+	}
+	expirationSet.Remove(contractId)
+	return k.SetPayAsYouGoExpirationSet(ctx, expirationSet)
+}
+
+func (k KVStore) RemovePayAsYouGoExpirationSet(ctx cosmos.Context, height int64) {
+	k.del(ctx, k.getPayAsYouGoExpirationSetKey(ctx, height))
+}
+
+func (k KVStore) getContractStartSetKey(ctx cosmos.Context, height int64) string {
+	return k.GetKey(ctx, prefixContractStartSet, strconv.FormatInt(height, 10))
+}
+
+// GetContractStartSetIterator iterate the contract start sets
+func (k KVStore) GetContractStartSetIterator(ctx cosmos.Context) cosmos.Iterator {
+	return k.getIterator(ctx, prefixContractStartSet)
+}
+
+// GetContractStartSet get the scheduled contracts starting at the given height
+func (k KVStore) GetContractStartSet(ctx cosmos.Context, height int64) (types.ContractExpirationSet, error) {
+	record := types.ContractExpirationSet{
+		Height: height,
+	}
+	_, err := k.getContractExpirationSet(ctx, k.getContractStartSetKey(ctx, height), &record)
+	if record.ContractSet == nil {
+		record.ContractSet = &types.ContractSet{}
+	}
+	return record, err
+}
+
+// SetContractStartSet save the scheduled contracts starting at a height
+func (k KVStore) SetContractStartSet(ctx cosmos.Context, record types.ContractExpirationSet) error {
+	if record.Height <= 0 {
+		return errors.New("cannot save a contract start set with an invalid height (less than or equal to zero)")
+	}
+	k.setContractExpirationSet(ctx, k.getContractStartSetKey(ctx, record.Height), record)
+	return nil
+}
+
+// AddToContractStartSet add a scheduled contract to the contracts starting at the given height
+func (k KVStore) AddToContractStartSet(ctx cosmos.Context, height int64, contractId uint64) error {
+	startSet, err := k.GetContractStartSet(ctx, height)
+	if err != nil {
+		return err
+	}
+	startSet.Append(contractId)
+	return k.SetContractStartSet(ctx, startSet)
+}
+
+// RemoveFromContractStartSet remove a scheduled contract from the contracts starting at the given height, the set is
+// deleted once empty
+func (k KVStore) RemoveFromContractStartSet(ctx cosmos.Context, height int64, contractId uint64) error {
+	startSet, err := k.GetContractStartSet(ctx, height)
+	if err != nil {
+		return err
+	}
+	startSet.Remove(contractId)
+	return k.SetContractStartSet(ctx, startSet)
+}
+
+func (k KVStore) RemoveContractStartSet(ctx cosmos.Context, height int64) {
+	k.del(ctx, k.getContractStartSetKey(ctx, height))
+}
+
 func (kvStore KVStore) GetAndIncrementNextContractId(ctx cosmos.Context) uint64 {
 	contractId := kvStore.GetNextContractId(ctx)
 	kvStore.SetNextContractId(ctx, contractId+1) // increment and set
@@ -216,6 +388,10 @@ func (k KVStore) GetActiveContractForUser(ctx cosmos.Context, user, provider com
 		if err != nil {
 			return types.Contract{}, err
 		}
+		// the set of a client also holds the contracts it delegated, those are spent by the delegate only
+		if !contract.GetSpender().Equals(user) {
+			continue
+		}
 		if contract.Provider.Equals(provider) && contract.Service.Equals(service) && contract.IsOpen(ctx.BlockHeight()) {
 			return contract, nil
 		}
@@ -224,6 +400,23 @@ func (k KVStore) GetActiveContractForUser(ctx cosmos.Context, user, provider com
 	return types.Contract{}, nil
 }
 
+// AddToUserContractSet add a contract to a user's contract set and saves the updated set to the store
+func (k KVStore) AddToUserContractSet(ctx cosmos.Context, user common.PubKey, contractId uint64) error {
+	contractSet, err := k.GetUserContractSet(ctx, user)
+	if err != nil {
+		return err
+	}
+
+	if contractSet.Contains(contractId) {
+		return nil
+	}
+	if contractSet.ContractSet == nil {
+		contractSet.ContractSet = &types.ContractSet{}
+	}
+	contractSet.ContractSet.ContractIds = append(contractSet.ContractSet.ContractIds, contractId)
+	return k.SetUserContractSet(ctx, contractSet)
+}
+
 // RemoveFromUserContractSet remove a contract from a user's contract set and saves the updated set to the store
 func (k KVStore) RemoveFromUserContractSet(ctx cosmos.Context, user common.PubKey, contractId uint64) error {
 	contractSet, err := k.GetUserContractSet(ctx, user)
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"math/rand"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -24,7 +25,7 @@ func TestContract(t *testing.T) {
 	require.True(t, k.ContractExists(ctx, contract.Id))
 	require.False(t, k.ContractExists(ctx, contract.Id+1))
 
-	k.RemoveContract(ctx, contract.Id)
+	require.NoError(t, k.RemoveContract(ctx, contract.Id))
 	require.False(t, k.ContractExists(ctx, contract.Id))
 }
 
@@ -51,3 +52,28 @@ func TestContractExpirationSet(t *testing.T) {
 	require.NoError(t, err)
 	require.Len(t, set.ContractSet.ContractIds, 0)
 }
+
+func TestContractExpirationSetOrder(t *testing.T) {
+	ctx, k := SetupKeeper(t)
+
+	// the ids are kept sorted, and once, whatever order they're added in
+	ids := []uint64{7, 3, 12, 1, 9, 3, 5}
+	for i := 0; i < 10; i++ {
+		rand.Shuffle(len(ids), func(i, j int) { ids[i], ids[j] = ids[j], ids[i] })
+		height := int64(100 + i)
+		for _, id := range ids {
+			require.NoError(t, k.AddToContractExpirationSet(ctx, height, id))
+		}
+		set, err := k.GetContractExpirationSet(ctx, height)
+		require.NoError(t, err)
+		require.Equal(t, []uint64{1, 3, 5, 7, 9, 12}, set.ContractSet.ContractIds)
+	}
+
+	// a set stored unsorted is read sorted
+	set := types.ContractExpirationSet{Height: 200, ContractSet: &types.ContractSet{ContractIds: []uint64{4, 2, 8}}}
+	require.NoError(t, k.SetContractExpirationSet(ctx, set))
+	set, err := k.GetContractExpirationSet(ctx, 200)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{2, 4, 8}, set.SortedContractIds())
+	require.Equal(t, []uint64{4, 2, 8}, set.ContractSet.ContractIds)
+}
@@ -0,0 +1,94 @@
+package keeper
+
+import (
+	"fmt"
+	"strings"
+
+	gogotypes "github.com/cosmos/gogoproto/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's value to the corresponding arkeo
+// type, for the simulations to print the differences of two stores
+func NewDecodeStore(cdc codec.BinaryCodec) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		key := string(kvA.Key)
+		switch {
+		case strings.HasPrefix(key, prefixVersion.String()):
+			var verA, verB types.ProtoInt64
+			cdc.MustUnmarshal(kvA.Value, &verA)
+			cdc.MustUnmarshal(kvB.Value, &verB)
+			return fmt.Sprintf("%v\n%v", verA, verB)
+
+		case strings.HasPrefix(key, prefixProvider.String()):
+			var providerA, providerB types.Provider
+			cdc.MustUnmarshal(kvA.Value, &providerA)
+			cdc.MustUnmarshal(kvB.Value, &providerB)
+			return fmt.Sprintf("%v\n%v", providerA, providerB)
+
+		case strings.HasPrefix(key, prefixContract.String()):
+			var contractA, contractB types.Contract
+			cdc.MustUnmarshal(kvA.Value, &contractA)
+			cdc.MustUnmarshal(kvB.Value, &contractB)
+			return fmt.Sprintf("%v\n%v", contractA, contractB)
+
+		case strings.HasPrefix(key, prefixContractNextId.String()):
+			var idA, idB gogotypes.UInt64Value
+			cdc.MustUnmarshal(kvA.Value, &idA)
+			cdc.MustUnmarshal(kvB.Value, &idB)
+			return fmt.Sprintf("%v\n%v", idA.Value, idB.Value)
+
+		case strings.HasPrefix(key, prefixContractExpirationSet.String()),
+			strings.HasPrefix(key, prefixPaygExpirationSet.String()),
+			strings.HasPrefix(key, prefixContractStartSet.String()):
+			var setA, setB types.ContractExpirationSet
+			cdc.MustUnmarshal(kvA.Value, &setA)
+			cdc.MustUnmarshal(kvB.Value, &setB)
+			return fmt.Sprintf("%v\n%v", setA, setB)
+
+		case strings.HasPrefix(key, prefixUserContractSet.String()):
+			var setA, setB types.UserContractSet
+			cdc.MustUnmarshal(kvA.Value, &setA)
+			cdc.MustUnmarshal(kvB.Value, &setB)
+			return fmt.Sprintf("%v\n%v", setA, setB)
+
+		case strings.HasPrefix(key, prefixProviderContract.String()):
+			return fmt.Sprintf("%d\n%d", sdk.BigEndianToUint64(kvA.Value), sdk.BigEndianToUint64(kvB.Value))
+
+		case strings.HasPrefix(key, prefixServiceProvider.String()):
+			return fmt.Sprintf("%s\n%s", kvA.Value, kvB.Value)
+
+		case strings.HasPrefix(key, prefixProviderEarnings.String()):
+			var earningsA, earningsB types.ProviderEarnings
+			cdc.MustUnmarshal(kvA.Value, &earningsA)
+			cdc.MustUnmarshal(kvB.Value, &earningsB)
+			return fmt.Sprintf("%v\n%v", earningsA, earningsB)
+
+		case strings.HasPrefix(key, prefixProviderAllowlist.String()):
+			var allowlistA, allowlistB types.ProviderAllowlist
+			cdc.MustUnmarshal(kvA.Value, &allowlistA)
+			cdc.MustUnmarshal(kvB.Value, &allowlistB)
+			return fmt.Sprintf("%v\n%v", allowlistA, allowlistB)
+
+		case strings.HasPrefix(key, prefixParamsRecord.String()):
+			var recordA, recordB types.ParamsRecord
+			cdc.MustUnmarshal(kvA.Value, &recordA)
+			cdc.MustUnmarshal(kvB.Value, &recordB)
+			return fmt.Sprintf("%v\n%v", recordA, recordB)
+
+		case strings.HasPrefix(key, prefixNetworkStats.String()):
+			var statsA, statsB types.NetworkStats
+			cdc.MustUnmarshal(kvA.Value, &statsA)
+			cdc.MustUnmarshal(kvB.Value, &statsB)
+			return fmt.Sprintf("%v\n%v", statsA, statsB)
+
+		default:
+			panic(fmt.Sprintf("invalid arkeo key prefix %X", kvA.Key))
+		}
+	}
+}
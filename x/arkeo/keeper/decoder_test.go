@@ -0,0 +1,115 @@
+package keeper
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	gogotypes "github.com/cosmos/gogoproto/types"
+
+	"github.com/arkeonetwork/arkeo/common"
+	"github.com/arkeonetwork/arkeo/common/cosmos"
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+)
+
+func TestDecodeStore(t *testing.T) {
+	ctx, k := SetupKeeper(t)
+	cdc := k.Cdc()
+	dec := NewDecodeStore(cdc)
+
+	provider := types.NewProvider(types.GetRandomPubKey(), common.BTCService)
+	provider.Bond = cosmos.NewInt(500)
+	contract := types.NewContract(provider.PubKey, common.BTCService, types.GetRandomPubKey())
+	contract.Id = 7
+	allowlist := types.NewProviderAllowlist(provider.PubKey, common.BTCService)
+	allowlist.Clients = append(allowlist.Clients, contract.Client)
+	stats := types.NetworkStats{Providers: 1, OpenContracts: 1}
+	set := types.ContractExpirationSet{Height: 30, ContractSet: &types.ContractSet{ContractIds: []uint64{7}}}
+
+	key := func(prefix dbPrefix, id string) []byte {
+		return []byte(k.GetKey(ctx, prefix, id))
+	}
+	pair := func(key, value []byte) kv.Pair {
+		return kv.Pair{Key: key, Value: value}
+	}
+
+	tests := []struct {
+		name     string
+		kvA, kvB kv.Pair
+		expected string
+	}{
+		{
+			name:     "Version",
+			kvA:      pair(key(prefixVersion, "version"), cdc.MustMarshal(&types.ProtoInt64{Value: 1})),
+			kvB:      pair(key(prefixVersion, "version"), cdc.MustMarshal(&types.ProtoInt64{Value: 2})),
+			expected: fmt.Sprintf("%v\n%v", types.ProtoInt64{Value: 1}, types.ProtoInt64{Value: 2}),
+		},
+		{
+			name:     "Provider",
+			kvA:      pair(key(prefixProvider, provider.Key()), cdc.MustMarshal(&provider)),
+			kvB:      pair(key(prefixProvider, provider.Key()), cdc.MustMarshal(&provider)),
+			expected: fmt.Sprintf("%v\n%v", provider, provider),
+		},
+		{
+			name:     "Contract",
+			kvA:      pair(key(prefixContract, "7"), cdc.MustMarshal(&contract)),
+			kvB:      pair(key(prefixContract, "7"), cdc.MustMarshal(&contract)),
+			expected: fmt.Sprintf("%v\n%v", contract, contract),
+		},
+		{
+			name:     "ContractNextId",
+			kvA:      pair(key(prefixContractNextId, ""), cdc.MustMarshal(&gogotypes.UInt64Value{Value: 8})),
+			kvB:      pair(key(prefixContractNextId, ""), cdc.MustMarshal(&gogotypes.UInt64Value{Value: 9})),
+			expected: "8\n9",
+		},
+		{
+			name:     "PaygExpirationSet",
+			kvA:      pair(key(prefixPaygExpirationSet, "30"), cdc.MustMarshal(&set)),
+			kvB:      pair(key(prefixPaygExpirationSet, "30"), cdc.MustMarshal(&set)),
+			expected: fmt.Sprintf("%v\n%v", set, set),
+		},
+		{
+			name:     "ContractStartSet",
+			kvA:      pair(key(prefixContractStartSet, "30"), cdc.MustMarshal(&set)),
+			kvB:      pair(key(prefixContractStartSet, "30"), cdc.MustMarshal(&set)),
+			expected: fmt.Sprintf("%v\n%v", set, set),
+		},
+		{
+			name:     "ProviderContract",
+			kvA:      pair(key(prefixProviderContract, provider.Key()), sdk.Uint64ToBigEndian(2)),
+			kvB:      pair(key(prefixProviderContract, provider.Key()), sdk.Uint64ToBigEndian(3)),
+			expected: "2\n3",
+		},
+		{
+			name:     "ServiceProvider",
+			kvA:      pair(key(prefixServiceProvider, provider.Key()), []byte(provider.PubKey.String())),
+			kvB:      pair(key(prefixServiceProvider, provider.Key()), []byte(provider.PubKey.String())),
+			expected: fmt.Sprintf("%s\n%s", provider.PubKey, provider.PubKey),
+		},
+		{
+			name:     "ProviderAllowlist",
+			kvA:      pair(key(prefixProviderAllowlist, allowlist.Key()), cdc.MustMarshal(&allowlist)),
+			kvB:      pair(key(prefixProviderAllowlist, allowlist.Key()), cdc.MustMarshal(&allowlist)),
+			expected: fmt.Sprintf("%v\n%v", allowlist, allowlist),
+		},
+		{
+			name:     "NetworkStats",
+			kvA:      pair(key(prefixNetworkStats, ""), cdc.MustMarshal(&stats)),
+			kvB:      pair(key(prefixNetworkStats, ""), cdc.MustMarshal(&stats)),
+			expected: fmt.Sprintf("%v\n%v", stats, stats),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, dec(tt.kvA, tt.kvB))
+		})
+	}
+
+	require.Panics(t, func() {
+		dec(pair([]byte("unknown/key"), nil), pair([]byte("unknown/key"), nil))
+	})
+}
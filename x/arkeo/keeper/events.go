@@ -7,6 +7,7 @@ import (
 
 	"github.com/arkeonetwork/arkeo/common"
 	"github.com/arkeonetwork/arkeo/common/cosmos"
+	"github.com/arkeonetwork/arkeo/x/arkeo/configs"
 	"github.com/arkeonetwork/arkeo/x/arkeo/types"
 )
 
@@ -17,28 +18,54 @@ func (k msgServer) EmitBondProviderEvent(ctx cosmos.Context, bond math.Int, msg
 	}
 	return ctx.EventManager().EmitTypedEvent(
 		&types.EventBondProvider{
-			Provider: provider,
-			Service:  msg.Service,
-			BondRel:  msg.Bond,
-			BondAbs:  bond,
+			Provider:  provider,
+			Service:   msg.Service,
+			BondRel:   msg.Bond,
+			BondAbs:   bond,
+			BondDenom: configs.Denom,
 		},
 	)
 }
 
-func (k msgServer) EmitCloseContractEvent(ctx cosmos.Context, contract *types.Contract) error {
+// EmitCloseContractEvent emit the close of a contract by its client, with the split of the deposit of a subscription
+// closed early
+func (k msgServer) EmitCloseContractEvent(ctx cosmos.Context, split earlyCloseSplit, contract *types.Contract) error {
 	return ctx.EventManager().EmitTypedEvent(
 		&types.EventCloseContract{
-			ContractId: contract.Id,
-			Provider:   contract.Provider,
-			Service:    contract.Service.String(),
-			Client:     contract.Client,
-			Delegate:   contract.Delegate,
+			ContractId:   contract.Id,
+			Provider:     contract.Provider,
+			Service:      contract.Service.String(),
+			Client:       contract.Client,
+			Delegate:     contract.Delegate,
+			Penalty:      cosmos.ZeroInt(),
+			Compensation: split.compensation,
+			Refund:       split.refund,
+			Dust:         split.dust,
 		},
 	)
 }
 
-func (k msgServer) EmitModProviderEvent(ctx cosmos.Context, msg *types.MsgModProvider, provider *types.Provider) error {
+// EmitProviderCloseContractEvent emit the close of a contract by its provider, with the penalty paid from its bond
+func (k msgServer) EmitProviderCloseContractEvent(ctx cosmos.Context, penalty cosmos.Int, contract *types.Contract) error {
 	return ctx.EventManager().EmitTypedEvent(
+		&types.EventCloseContract{
+			ContractId:   contract.Id,
+			Provider:     contract.Provider,
+			Service:      contract.Service.String(),
+			Client:       contract.Client,
+			Delegate:     contract.Delegate,
+			ByProvider:   true,
+			Penalty:      penalty,
+			Compensation: cosmos.ZeroInt(),
+			Refund:       cosmos.ZeroInt(),
+			Dust:         cosmos.ZeroInt(),
+		},
+	)
+}
+
+// EmitModProviderEvent emit the provider with the attributes of the fields updated only, besides the ones naming it
+func (k msgServer) EmitModProviderEvent(ctx cosmos.Context, msg *types.MsgModProvider, provider *types.Provider) error {
+	event, err := sdk.TypedEventToEvent(
 		&types.EventModProvider{
 			Creator:             sdk.MustAccAddressFromBech32(msg.Creator),
 			Provider:            provider.PubKey,
@@ -52,6 +79,122 @@ func (k msgServer) EmitModProviderEvent(ctx cosmos.Context, msg *types.MsgModPro
 			PayAsYouGoRate:      provider.PayAsYouGoRate,
 			Bond:                provider.Bond,
 			SettlementDuration:  provider.SettlementDuration,
+			UpdatedFields:       msg.UpdateMask,
+			MaxOpenContracts:    provider.MaxOpenContracts,
+		},
+	)
+	if err != nil {
+		return err
+	}
+	attributes := event.Attributes[:0]
+	for _, attribute := range event.Attributes {
+		switch attribute.Key {
+		case "creator", "provider", "service", "updated_fields":
+		default:
+			if !msg.Updates(attribute.Key) {
+				continue
+			}
+		}
+		attributes = append(attributes, attribute)
+	}
+	event.Attributes = attributes
+	ctx.EventManager().EmitEvent(event)
+	return nil
+}
+
+func (k msgServer) EmitRenewContractEvent(ctx cosmos.Context, oldExpiration int64, extraDeposit cosmos.Int, contract *types.Contract) error {
+	return ctx.EventManager().EmitTypedEvent(
+		&types.EventRenewContract{
+			Provider:      contract.Provider,
+			ContractId:    contract.Id,
+			Service:       contract.Service.String(),
+			Client:        contract.Client,
+			Delegate:      contract.Delegate,
+			Type:          contract.Type,
+			OldExpiration: oldExpiration,
+			NewExpiration: contract.Expiration(),
+			Rate:          contract.Rate,
+			ExtraDeposit:  extraDeposit,
+			Deposit:       contract.Deposit,
+		},
+	)
+}
+
+// EmitSetAutoRenewEvent emit the auto-renewal of the subscription turned on or off, with the top-up balance refunded
+func (k msgServer) EmitSetAutoRenewEvent(ctx cosmos.Context, refund cosmos.Int, contract *types.Contract) error {
+	return ctx.EventManager().EmitTypedEvent(
+		&types.EventSetAutoRenew{
+			Provider:   contract.Provider,
+			ContractId: contract.Id,
+			Service:    contract.Service.String(),
+			Client:     contract.Client,
+			AutoRenew:  contract.AutoRenew,
+			Refund:     refund,
+		},
+	)
+}
+
+// EmitTopUpContractEvent emit the top-up of the subscription, its expiration unchanged while the top-up waits in its
+// balance
+func (k msgServer) EmitTopUpContractEvent(ctx cosmos.Context, oldExpiration int64, topUp cosmos.Int, contract *types.Contract) error {
+	return ctx.EventManager().EmitTypedEvent(
+		&types.EventTopUpContract{
+			Provider:      contract.Provider,
+			ContractId:    contract.Id,
+			Service:       contract.Service.String(),
+			Client:        contract.Client,
+			OldExpiration: oldExpiration,
+			NewExpiration: contract.Expiration(),
+			TopUp:         topUp,
+			Deposit:       contract.Deposit,
+			Balance:       contract.TopUpBalance(),
+		},
+	)
+}
+
+// EmitClaimContractIncomeBatchEvent emit the outcome of each claim of the batch of creator
+func (k msgServer) EmitClaimContractIncomeBatchEvent(ctx cosmos.Context, creator string, results []types.ContractClaimResult) error {
+	return ctx.EventManager().EmitTypedEvent(
+		&types.EventClaimContractIncomeBatch{
+			Creator: creator,
+			Results: results,
+		},
+	)
+}
+
+// EmitPurgeExpiredContractsEvent emit the outcome of the purge of each contract of creator, along with its reward
+func (k msgServer) EmitPurgeExpiredContractsEvent(ctx cosmos.Context, creator string, results []types.ContractPurgeResult, reward cosmos.Int) error {
+	return ctx.EventManager().EmitTypedEvent(
+		&types.EventPurgeExpiredContracts{
+			Creator: creator,
+			Results: results,
+			Reward:  reward,
+		},
+	)
+}
+
+// EmitSetProviderAllowlistEvent emit the allowlist the provider is left with, for the sentinels to enforce it
+func (k msgServer) EmitSetProviderAllowlistEvent(ctx cosmos.Context, action types.AllowlistAction, allowlist *types.ProviderAllowlist) error {
+	return ctx.EventManager().EmitTypedEvent(
+		&types.EventSetProviderAllowlist{
+			Provider: allowlist.Provider,
+			Service:  allowlist.Service.String(),
+			Action:   action,
+			Clients:  allowlist.Clients,
+		},
+	)
+}
+
+// EmitRotateDelegateEvent emit the replacement of the delegate of the contract, for the sentinels to refresh it
+func (k msgServer) EmitRotateDelegateEvent(ctx cosmos.Context, oldDelegate common.PubKey, contract *types.Contract) error {
+	return ctx.EventManager().EmitTypedEvent(
+		&types.EventRotateDelegate{
+			Provider:    contract.Provider,
+			ContractId:  contract.Id,
+			Service:     contract.Service.String(),
+			Client:      contract.Client,
+			OldDelegate: oldDelegate,
+			NewDelegate: contract.Delegate,
 		},
 	)
 }
@@ -59,25 +202,33 @@ func (k msgServer) EmitModProviderEvent(ctx cosmos.Context, msg *types.MsgModPro
 func (k msgServer) EmitOpenContractEvent(ctx cosmos.Context, openCost int64, contract *types.Contract) error {
 	return ctx.EventManager().EmitTypedEvent(
 		&types.EventOpenContract{
-			Provider:           contract.Provider,
-			ContractId:         contract.Id,
-			Service:            contract.Service.String(),
-			Client:             contract.Client,
-			Delegate:           contract.Delegate,
-			Type:               contract.Type,
-			Height:             contract.Height,
-			Duration:           contract.Duration,
-			Rate:               contract.Rate,
-			OpenCost:           openCost,
-			Deposit:            contract.Deposit,
-			SettlementDuration: contract.SettlementDuration,
-			Authorization:      contract.Authorization,
-			QueriesPerMinute:   contract.QueriesPerMinute,
+			Provider:              contract.Provider,
+			ContractId:            contract.Id,
+			Service:               contract.Service.String(),
+			Client:                contract.Client,
+			Delegate:              contract.Delegate,
+			Type:                  contract.Type,
+			Height:                contract.Height,
+			Duration:              contract.Duration,
+			Rate:                  contract.Rate,
+			OpenCost:              openCost,
+			Deposit:               contract.Deposit,
+			SettlementDuration:    contract.SettlementDuration,
+			Authorization:         contract.Authorization,
+			QueriesPerMinute:      contract.QueriesPerMinute,
+			SettlementGracePeriod: contract.SettlementGracePeriod,
+			AutoRenew:             contract.AutoRenew,
+			RateDenom:             contract.Rate.Denom,
+			RateAmount:            contract.Rate.Amount,
+			Payer:                 contract.Payer,
+			StartHeight:           contract.StartHeight,
 		},
 	)
 }
 
-func (mgr Manager) EmitContractSettlementEvent(ctx cosmos.Context, debt, valIncome cosmos.Int, contract *types.Contract) error {
+// EmitContractSettlementEvent emit the breakdown of the settlement, the amounts paid and unpaid always summing up to
+// the amount claimed
+func (mgr Manager) EmitContractSettlementEvent(ctx cosmos.Context, settlement types.ContractSettlement, contract *types.Contract) error {
 	return ctx.EventManager().EmitTypedEvent(
 		&types.EventSettleContract{
 			Provider:   contract.Provider,
@@ -88,8 +239,13 @@ func (mgr Manager) EmitContractSettlementEvent(ctx cosmos.Context, debt, valInco
 			Type:       contract.Type,
 			Nonce:      contract.Nonce,
 			Height:     contract.Height,
-			Paid:       debt,
-			Reserve:    valIncome,
+			Paid:       settlement.Owed.Amount,
+			Reserve:    settlement.ReserveTax.Amount,
+			Unpaid:     settlement.Unpaid.Amount,
+			Denom:      contract.Rate.Denom,
+			Refunded:   settlement.Refund.Amount,
+			Claimed:    settlement.Owed.Amount.Add(settlement.Unpaid.Amount),
+			Dust:       settlement.Dust.Amount,
 		},
 	)
 }
@@ -102,3 +258,85 @@ func (mgr Manager) EmitValidatorPayoutEvent(ctx cosmos.Context, acc cosmos.AccAd
 		},
 	)
 }
+
+func (mgr Manager) EmitSlashProviderEvent(ctx cosmos.Context, reason string, amount cosmos.Int, contract *types.Contract, provider *types.Provider) error {
+	return ctx.EventManager().EmitTypedEvent(
+		&types.EventSlashProvider{
+			Provider:   provider.PubKey,
+			Service:    provider.Service.String(),
+			ContractId: contract.Id,
+			Reason:     reason,
+			Amount:     amount,
+			Bond:       provider.Bond,
+			Faults:     provider.Faults,
+		},
+	)
+}
+
+func (mgr Manager) EmitContractExpiredEvent(ctx cosmos.Context, contract *types.Contract) error {
+	return ctx.EventManager().EmitTypedEvent(
+		&types.EventContractExpired{
+			ContractId:        contract.Id,
+			Provider:          contract.Provider,
+			Service:           contract.Service.String(),
+			Client:            contract.Client,
+			Type:              contract.Type,
+			Expiration:        contract.Expiration(),
+			SettlementPending: contract.SettlesAfterExpiration(),
+		},
+	)
+}
+
+// EmitProviderDemotedEvent emit the provider set offline, its bond below the minimum of its service
+func (mgr Manager) EmitProviderDemotedEvent(ctx cosmos.Context, minBond cosmos.Int, provider *types.Provider) error {
+	return ctx.EventManager().EmitTypedEvent(
+		&types.EventProviderDemoted{
+			Provider: provider.PubKey,
+			Service:  provider.Service.String(),
+			Bond:     provider.Bond,
+			MinBond:  minBond,
+		},
+	)
+}
+
+// EmitContractActivatedEvent emit the start of the scheduled contract, its duration running from now on
+func (mgr Manager) EmitContractActivatedEvent(ctx cosmos.Context, contract *types.Contract) error {
+	return ctx.EventManager().EmitTypedEvent(
+		&types.EventContractActivated{
+			ContractId:  contract.Id,
+			Provider:    contract.Provider,
+			Service:     contract.Service.String(),
+			Client:      contract.Client,
+			Type:        contract.Type,
+			StartHeight: contract.StartHeight,
+			Expiration:  contract.Expiration(),
+		},
+	)
+}
+
+// EmitContractAutoRenewedEvent emit the extension of the subscription out of its top-up balance, in place of its
+// expiration
+func (mgr Manager) EmitContractAutoRenewedEvent(ctx cosmos.Context, oldExpiration int64, spent cosmos.Int, contract *types.Contract) error {
+	return ctx.EventManager().EmitTypedEvent(
+		&types.EventContractAutoRenewed{
+			ContractId:    contract.Id,
+			Provider:      contract.Provider,
+			Service:       contract.Service.String(),
+			Client:        contract.Client,
+			OldExpiration: oldExpiration,
+			NewExpiration: contract.Expiration(),
+			Spent:         spent,
+			Deposit:       contract.Deposit,
+			Balance:       contract.TopUpBalance(),
+		},
+	)
+}
+
+func (mgr Manager) EmitParamsUpdatedEvent(ctx cosmos.Context, changes []types.ParamChange) error {
+	return ctx.EventManager().EmitTypedEvent(
+		&types.EventParamsUpdated{
+			Height:  ctx.BlockHeight(),
+			Changes: changes,
+		},
+	)
+}
@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/arkeonetwork/arkeo/common"
+	"github.com/arkeonetwork/arkeo/common/cosmos"
+	"github.com/arkeonetwork/arkeo/x/arkeo/configs"
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+)
+
+// eventAttributes return the attributes of the last event of the type emitted, by key
+func eventAttributes(t *testing.T, ctx cosmos.Context, eventType string) map[string]string {
+	events := ctx.EventManager().Events()
+	for i := len(events) - 1; i >= 0; i-- {
+		if events[i].Type != eventType {
+			continue
+		}
+		attributes := make(map[string]string)
+		for _, attr := range events[i].Attributes {
+			attributes[attr.Key] = attr.Value
+		}
+		return attributes
+	}
+	require.FailNow(t, "event not emitted", eventType)
+	return nil
+}
+
+func TestEventDenomAttributes(t *testing.T) {
+	ctx, k, sk := SetupKeeperWithStaking(t)
+	s := newMsgServer(k, sk)
+	mgr := NewManager(k, sk)
+
+	// an ibc denom, holding a slash
+	ibcDenom := "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+	contract := types.NewContract(types.GetRandomPubKey(), common.BTCService, types.GetRandomPubKey())
+	contract.Id = 3
+	contract.Type = types.ContractType_PAY_AS_YOU_GO
+	contract.Rate = cosmos.NewInt64Coin(ibcDenom, 15)
+	contract.Deposit = cosmos.NewInt(1500)
+	contract.Nonce = 10
+
+	require.NoError(t, s.EmitOpenContractEvent(ctx, 0, &contract))
+	attributes := eventAttributes(t, ctx, types.EventTypeOpenContract)
+	require.Equal(t, `"`+ibcDenom+`"`, attributes["rate_denom"])
+	require.Equal(t, `"15"`, attributes["rate_amount"])
+	// the legacy rate attribute is still there
+	require.Equal(t, `{"denom":"`+ibcDenom+`","amount":"15"}`, attributes["rate"])
+
+	require.NoError(t, mgr.EmitContractSettlementEvent(ctx, types.ContractSettlement{
+		Owed:       cosmos.NewInt64Coin(ibcDenom, 150),
+		ReserveTax: cosmos.NewInt64Coin(ibcDenom, 15),
+		Refund:     cosmos.NewInt64Coin(ibcDenom, 0),
+		Unpaid:     cosmos.NewInt64Coin(ibcDenom, 0),
+	}, &contract))
+	attributes = eventAttributes(t, ctx, types.EventTypeSettleContract)
+	require.Equal(t, `"`+ibcDenom+`"`, attributes["denom"])
+	require.Equal(t, `"150"`, attributes["paid"])
+	require.Equal(t, `"15"`, attributes["reserve"])
+
+	provider := types.GetRandomPubKey()
+	require.NoError(t, s.EmitBondProviderEvent(ctx, cosmos.NewInt(common.Tokens(3)), &types.MsgBondProvider{
+		Provider: provider.String(),
+		Service:  common.BTCService.String(),
+		Bond:     cosmos.NewInt(common.Tokens(1)),
+	}))
+	attributes = eventAttributes(t, ctx, types.EventTypeBondProvider)
+	require.Equal(t, `"`+configs.Denom+`"`, attributes["bond_denom"])
+	require.Equal(t, `"100000000"`, attributes["bond_rel"])
+	require.Equal(t, `"300000000"`, attributes["bond_abs"])
+}
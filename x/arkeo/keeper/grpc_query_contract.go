@@ -2,10 +2,15 @@ package keeper
 
 import (
 	"context"
+	"fmt"
+	"sort"
 
 	"github.com/arkeonetwork/arkeo/common"
+	"github.com/arkeonetwork/arkeo/common/cosmos"
+	"github.com/arkeonetwork/arkeo/x/arkeo/configs"
 	"github.com/arkeonetwork/arkeo/x/arkeo/types"
 
+	"cosmossdk.io/errors"
 	"cosmossdk.io/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
@@ -55,7 +60,232 @@ func (k KVStore) FetchContract(c context.Context, req *types.QueryFetchContractR
 		return nil, status.Error(codes.NotFound, "not found")
 	}
 
-	return &types.QueryFetchContractResponse{Contract: val}, nil
+	return &types.QueryFetchContractResponse{
+		Contract:            val,
+		SettlementPeriodEnd: val.SettlementPeriodEnd(),
+		Pending:             val.IsPending(ctx.BlockHeight()),
+	}, nil
+}
+
+// ContractSettlementPreview run the settlement of the contract at the current height without writing it. With a
+// nonce it is the claim of the provider at that nonce, checked as the claim is, otherwise the settlement closing the
+// contract, the close of a subscription or the end of the settlement period of a pay-as-you-go contract.
+func (k KVStore) ContractSettlementPreview(c context.Context, req *types.QueryContractSettlementPreviewRequest) (*types.QueryContractSettlementPreviewResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	contract, err := k.GetContract(ctx, req.ContractId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if contract.IsEmpty() {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+	if contract.SettlementHeight > 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "contract settled on block %d", contract.SettlementHeight)
+	}
+
+	nonce := req.Nonce
+	if nonce > 0 {
+		if nonce, err = claimNonce(contract, nonce, ctx.BlockHeight()); err != nil {
+			return nil, claimStatusError(err)
+		}
+	}
+
+	reserveTax := configs.GetConfigValues(k.GetVersion(ctx)).GetInt64Value(configs.ReserveTax)
+	_, settlement, err := contractSettlement(ctx, contract, nonce, reserveTax, req.Nonce == 0)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryContractSettlementPreviewResponse{Settlement: settlement}, nil
+}
+
+// ClaimableIncome compute what the claim of the provider at the nonce pays it at the current height, checked and
+// settled as the claim is, and the deposit left in the contract once claimed
+func (k KVStore) ClaimableIncome(c context.Context, req *types.QueryClaimableIncomeRequest) (*types.QueryClaimableIncomeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if !k.ContractExists(ctx, req.ContractId) {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+	contract, err := k.GetContract(ctx, req.ContractId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	nonce, err := claimNonce(contract, req.Nonce, ctx.BlockHeight())
+	if err != nil {
+		return nil, claimStatusError(err)
+	}
+	reserveTax := configs.GetConfigValues(k.GetVersion(ctx)).GetInt64Value(configs.ReserveTax)
+	contract, settlement, err := contractSettlement(ctx, contract, nonce, reserveTax, false)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryClaimableIncomeResponse{
+		Nonce:           contract.Nonce,
+		Claimable:       settlement.ProviderIncome,
+		ReserveTax:      settlement.ReserveTax,
+		Dust:            settlement.Dust,
+		RemainingEscrow: cosmos.NewCoin(contract.Rate.Denom, contract.Deposit.Sub(contract.Paid).Sub(settlement.Owed.Amount)),
+	}, nil
+}
+
+// claimStatusError map the error of a claim checked by a query to its grpc status
+func claimStatusError(err error) error {
+	if errors.IsOf(err, types.ErrClaimContractIncomeBadNonce) {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return status.Error(codes.FailedPrecondition, err.Error())
+}
+
+func (k KVStore) ContractsByProvider(c context.Context, req *types.QueryContractsByProviderRequest) (*types.QueryContractsByProviderResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	providerPubKey, err := common.NewPubKey(req.Provider)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid provider pubkey")
+	}
+	service, err := common.NewService(req.Service)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid service")
+	}
+	if err := types.ValidateContractState(req.State); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var contracts []types.Contract
+	store := ctx.KVStore(k.storeKey)
+	indexStore := prefix.NewStore(store, []byte(k.getProviderContractPrefix(ctx, providerPubKey, service)))
+
+	pageRes, err := query.FilteredPaginate(indexStore, req.Pagination, func(key, value []byte, accumulate bool) (bool, error) {
+		contract, err := k.GetContract(ctx, sdk.BigEndianToUint64(value))
+		if err != nil {
+			return false, err
+		}
+		if !contract.InState(req.State, ctx.BlockHeight()) {
+			return false, nil
+		}
+		if accumulate {
+			contracts = append(contracts, contract)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryContractsByProviderResponse{Contract: contracts, Pagination: pageRes}, nil
+}
+
+func (k KVStore) ContractsByOwner(c context.Context, req *types.QueryContractsByOwnerRequest) (*types.QueryContractsByOwnerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	owner, err := common.NewPubKey(req.Pubkey)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid pubkey")
+	}
+
+	contractSet, err := k.GetUserContractSet(ctx, owner)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// the set holds the contracts of the client and the ones it is the delegate of, a contract
+	// where the pubkey is both is in the set once but is skipped if listed twice anyway
+	var contracts []types.OwnerContract
+	seen := make(map[uint64]bool)
+	for _, id := range contractSet.ContractSet.GetContractIds() {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		contract, err := k.GetContract(ctx, id)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		if contract.IsEmpty() {
+			continue
+		}
+		if !contract.Client.Equals(owner) && !(req.IncludeDelegate && contract.Delegate.Equals(owner)) {
+			continue
+		}
+		contracts = append(contracts, types.OwnerContract{
+			Contract:            contract,
+			Expiration:          contract.Expiration(),
+			SettlementPeriodEnd: contract.SettlementPeriodEnd(),
+			Expired:             contract.IsExpired(ctx.BlockHeight()),
+			Pending:             contract.IsPending(ctx.BlockHeight()),
+		})
+	}
+	sort.Slice(contracts, func(i, j int) bool { return contracts[i].Contract.Id < contracts[j].Contract.Id })
+
+	contracts, pageRes, err := paginateOwnerContracts(contracts, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryContractsByOwnerResponse{Contracts: contracts, Pagination: pageRes}, nil
+}
+
+// paginateOwnerContracts page through contracts sorted by id, the next key being the id of the first contract
+// of the next page
+func paginateOwnerContracts(contracts []types.OwnerContract, pageReq *query.PageRequest) ([]types.OwnerContract, *query.PageResponse, error) {
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+	if len(pageReq.Key) > 0 && pageReq.Offset > 0 {
+		return nil, nil, fmt.Errorf("invalid request, either offset or key is expected, got both")
+	}
+	if pageReq.Reverse {
+		return nil, nil, fmt.Errorf("reverse pagination is not supported")
+	}
+
+	limit := pageReq.Limit
+	if limit == 0 {
+		limit = query.DefaultLimit
+	}
+
+	pageRes := &query.PageResponse{}
+	start := uint64(0)
+	if len(pageReq.Key) > 0 {
+		if len(pageReq.Key) != 8 {
+			return nil, nil, fmt.Errorf("invalid pagination key")
+		}
+		next := sdk.BigEndianToUint64(pageReq.Key)
+		for start < uint64(len(contracts)) && contracts[start].Contract.Id < next {
+			start++
+		}
+	} else {
+		start = pageReq.Offset
+		if pageReq.CountTotal {
+			pageRes.Total = uint64(len(contracts))
+		}
+	}
+
+	if start >= uint64(len(contracts)) {
+		return nil, pageRes, nil
+	}
+	end := start + limit
+	if end < uint64(len(contracts)) {
+		pageRes.NextKey = sdk.Uint64ToBigEndian(contracts[end].Contract.Id)
+	} else {
+		end = uint64(len(contracts))
+	}
+	return contracts[start:end], pageRes, nil
 }
 
 func (k KVStore) ActiveContract(goCtx context.Context, req *types.QueryActiveContractRequest) (*types.QueryActiveContractResponse, error) {
@@ -86,5 +316,9 @@ func (k KVStore) ActiveContract(goCtx context.Context, req *types.QueryActiveCon
 		return nil, status.Error(codes.NotFound, "not found")
 	}
 
-	return &types.QueryActiveContractResponse{Contract: activeContract}, nil
+	return &types.QueryActiveContractResponse{
+		Contract:            activeContract,
+		SettlementPeriodEnd: activeContract.SettlementPeriodEnd(),
+		Pending:             activeContract.IsPending(ctx.BlockHeight()),
+	}, nil
 }
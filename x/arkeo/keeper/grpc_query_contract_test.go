@@ -0,0 +1,558 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	cKeys "github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/std"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/arkeonetwork/arkeo/common"
+	"github.com/arkeonetwork/arkeo/common/cosmos"
+	"github.com/arkeonetwork/arkeo/x/arkeo/configs"
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+)
+
+func TestContractsByProvider(t *testing.T) {
+	ctx, k := SetupKeeper(t)
+	ctx = ctx.WithBlockHeight(50)
+
+	provider := types.GetRandomPubKey()
+	service := common.BTCService
+
+	// 5 contracts of the provider, the even ones settled
+	for id := uint64(1); id <= 5; id++ {
+		contract := types.NewContract(provider, service, types.GetRandomPubKey())
+		contract.Id = id
+		contract.Height = 10
+		contract.Duration = 100
+		contract.Rate = cosmos.NewInt64Coin("uarkeo", 10)
+		if id%2 == 0 {
+			contract.SettlementHeight = 20
+		}
+		require.NoError(t, k.SetContract(ctx, contract))
+	}
+	// contracts of another provider, and of the provider for another service
+	other := types.NewContract(types.GetRandomPubKey(), service, types.GetRandomPubKey())
+	other.Id = 6
+	other.Height = 10
+	require.NoError(t, k.SetContract(ctx, other))
+	other = types.NewContract(provider, common.ETHService, types.GetRandomPubKey())
+	other.Id = 7
+	other.Height = 10
+	require.NoError(t, k.SetContract(ctx, other))
+
+	ids := func(contracts []types.Contract) []uint64 {
+		result := make([]uint64, 0, len(contracts))
+		for _, contract := range contracts {
+			result = append(result, contract.Id)
+		}
+		return result
+	}
+
+	// pages of 2 contracts
+	req := &types.QueryContractsByProviderRequest{
+		Provider:   provider.String(),
+		Service:    service.String(),
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	}
+	res, err := k.ContractsByProvider(ctx, req)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{1, 2}, ids(res.Contract))
+	require.Equal(t, uint64(5), res.Pagination.Total)
+	require.NotNil(t, res.Pagination.NextKey)
+
+	req.Pagination = &query.PageRequest{Limit: 2, Key: res.Pagination.NextKey}
+	res, err = k.ContractsByProvider(ctx, req)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{3, 4}, ids(res.Contract))
+
+	req.Pagination = &query.PageRequest{Limit: 2, Key: res.Pagination.NextKey}
+	res, err = k.ContractsByProvider(ctx, req)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{5}, ids(res.Contract))
+	require.Nil(t, res.Pagination.NextKey)
+
+	// state filter, across pages as well
+	req.State = types.ContractStateOpen
+	req.Pagination = &query.PageRequest{Limit: 2, CountTotal: true}
+	res, err = k.ContractsByProvider(ctx, req)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{1, 3}, ids(res.Contract))
+	require.Equal(t, uint64(3), res.Pagination.Total)
+	req.Pagination = &query.PageRequest{Limit: 2, Key: res.Pagination.NextKey}
+	res, err = k.ContractsByProvider(ctx, req)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{5}, ids(res.Contract))
+
+	req.State = types.ContractStateClosed
+	req.Pagination = nil
+	res, err = k.ContractsByProvider(ctx, req)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{2, 4}, ids(res.Contract))
+
+	// the contracts expire too
+	res, err = k.ContractsByProvider(ctx.WithBlockHeight(111), req)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{1, 2, 3, 4, 5}, ids(res.Contract))
+
+	// a removed contract is gone from the index
+	require.NoError(t, k.RemoveContract(ctx, 4))
+	req.State = ""
+	res, err = k.ContractsByProvider(ctx, req)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{1, 2, 3, 5}, ids(res.Contract))
+
+	// a scheduled contract is pending until its start height, rather than open
+	contract, err := k.GetContract(ctx, 5)
+	require.NoError(t, err)
+	contract.StartHeight = 60
+	require.NoError(t, k.SetContract(ctx, contract))
+	req.State = types.ContractStatePending
+	res, err = k.ContractsByProvider(ctx, req)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{5}, ids(res.Contract))
+	req.State = types.ContractStateOpen
+	res, err = k.ContractsByProvider(ctx, req)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{1, 3}, ids(res.Contract))
+	res, err = k.ContractsByProvider(ctx.WithBlockHeight(60), req)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{1, 3, 5}, ids(res.Contract))
+
+	req.State = "expired"
+	_, err = k.ContractsByProvider(ctx, req)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestContractsByOwner(t *testing.T) {
+	ctx, k := SetupKeeper(t)
+	ctx = ctx.WithBlockHeight(50)
+
+	provider := types.GetRandomPubKey()
+	service := common.BTCService
+	owner := types.GetRandomPubKey()
+	other := types.GetRandomPubKey()
+
+	// nothing is returned for a pubkey without contracts
+	req := &types.QueryContractsByOwnerRequest{Pubkey: owner.String(), IncludeDelegate: true}
+	res, err := k.ContractsByOwner(ctx, req)
+	require.NoError(t, err)
+	require.Empty(t, res.Contracts)
+
+	setContract := func(id uint64, client, delegate common.PubKey, duration int64) types.Contract {
+		contract := types.NewContract(provider, service, client)
+		contract.Id = id
+		contract.Delegate = delegate
+		contract.Height = 10
+		contract.Duration = duration
+		contract.Rate = cosmos.NewInt64Coin("uarkeo", 10)
+		require.NoError(t, k.SetContract(ctx, contract))
+		for _, pubkey := range contract.Owners() {
+			require.NoError(t, k.AddToUserContractSet(ctx, pubkey, contract.Id))
+		}
+		return contract
+	}
+	setContract(1, owner, common.EmptyPubKey, 100)
+	setContract(2, owner, other, 100)
+	setContract(3, other, owner, 100)
+	setContract(4, owner, owner, 100)
+	setContract(5, owner, common.EmptyPubKey, 20)
+
+	// the same contract twice in the set is returned once
+	userSet, err := k.GetUserContractSet(ctx, owner)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{1, 2, 3, 4, 5}, userSet.ContractSet.ContractIds)
+	userSet.ContractSet.ContractIds = append(userSet.ContractSet.ContractIds, 4)
+	require.NoError(t, k.SetUserContractSet(ctx, userSet))
+
+	ids := func(contracts []types.OwnerContract) []uint64 {
+		result := make([]uint64, 0, len(contracts))
+		for _, contract := range contracts {
+			result = append(result, contract.Contract.Id)
+		}
+		return result
+	}
+
+	res, err = k.ContractsByOwner(ctx, req)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{1, 2, 3, 4, 5}, ids(res.Contracts))
+	require.Equal(t, int64(30), res.Contracts[4].Expiration)
+	require.True(t, res.Contracts[4].Expired)
+	require.False(t, res.Contracts[0].Expired)
+
+	// without the delegated contracts, contract 4 is still the owner's as a client
+	req.IncludeDelegate = false
+	res, err = k.ContractsByOwner(ctx, req)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{1, 2, 4, 5}, ids(res.Contracts))
+
+	// the other pubkey has contract 3 as a client and contract 2 as a delegate
+	res, err = k.ContractsByOwner(ctx, &types.QueryContractsByOwnerRequest{Pubkey: other.String()})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{3}, ids(res.Contracts))
+	res, err = k.ContractsByOwner(ctx, &types.QueryContractsByOwnerRequest{Pubkey: other.String(), IncludeDelegate: true})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{2, 3}, ids(res.Contracts))
+
+	// the active contract of a client is not the one it delegated
+	active, err := k.GetActiveContractForUser(ctx, owner, provider, service)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), active.Id)
+	active, err = k.GetActiveContractForUser(ctx, other, provider, service)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), active.Id)
+
+	// pages of 2 contracts
+	req.IncludeDelegate = true
+	req.Pagination = &query.PageRequest{Limit: 2, CountTotal: true}
+	res, err = k.ContractsByOwner(ctx, req)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{1, 2}, ids(res.Contracts))
+	require.Equal(t, uint64(5), res.Pagination.Total)
+	require.NotNil(t, res.Pagination.NextKey)
+
+	req.Pagination = &query.PageRequest{Limit: 2, Key: res.Pagination.NextKey}
+	res, err = k.ContractsByOwner(ctx, req)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{3, 4}, ids(res.Contracts))
+
+	req.Pagination = &query.PageRequest{Limit: 2, Key: res.Pagination.NextKey}
+	res, err = k.ContractsByOwner(ctx, req)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{5}, ids(res.Contracts))
+	require.Nil(t, res.Pagination.NextKey)
+
+	req.Pagination = &query.PageRequest{Limit: 2, Offset: 4}
+	res, err = k.ContractsByOwner(ctx, req)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{5}, ids(res.Contracts))
+
+	// past the last contract
+	req.Pagination = &query.PageRequest{Offset: 10}
+	res, err = k.ContractsByOwner(ctx, req)
+	require.NoError(t, err)
+	require.Empty(t, res.Contracts)
+
+	_, err = k.ContractsByOwner(ctx, &types.QueryContractsByOwnerRequest{Pubkey: "bogus"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestContractSettlementPreview(t *testing.T) {
+	ctx, k, sk := SetupKeeperWithStaking(t)
+	ctx = ctx.WithBlockHeight(10)
+	s := newMsgServer(k, sk)
+
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	std.RegisterInterfaces(interfaceRegistry)
+	module.NewBasicManager().RegisterInterfaces(interfaceRegistry)
+	types.RegisterInterfaces(interfaceRegistry)
+	cdc := codec.NewProtoCodec(interfaceRegistry)
+
+	// set up provider
+	providerPubKey := types.GetRandomPubKey()
+	providerAddress, err := providerPubKey.GetMyAddress()
+	require.NoError(t, err)
+	service := common.BTCService
+	provider := types.NewProvider(providerPubKey, service)
+	provider.Bond = cosmos.NewInt(common.Tokens(1))
+	require.NoError(t, k.SetProvider(ctx, provider))
+
+	rates, err := cosmos.ParseCoins("15uarkeo")
+	require.NoError(t, err)
+	require.NoError(t, s.ModProviderHandle(ctx, &types.MsgModProvider{
+		Creator:             providerAddress.String(),
+		Provider:            provider.PubKey,
+		Service:             provider.Service.String(),
+		MinContractDuration: 10,
+		MaxContractDuration: 500,
+		Status:              types.ProviderStatus_ONLINE,
+		PayAsYouGoRate:      rates,
+		SubscriptionRate:    rates,
+		UpdateMask:          types.ModProviderFields,
+	}))
+
+	// the pay-as-you-go client signs its claims
+	kb := cKeys.NewInMemory(cdc)
+	info, _, err := kb.NewMnemonic("whatever", cKeys.English, `m/44'/931'/0'/0/0`, "", hd.Secp256k1)
+	require.NoError(t, err)
+	pk, err := info.GetPubKey()
+	require.NoError(t, err)
+	paygClient, err := common.NewPubKeyFromCrypto(pk)
+	require.NoError(t, err)
+
+	openContract := func(client common.PubKey, contractType types.ContractType) types.Contract {
+		address, err := client.GetMyAddress()
+		require.NoError(t, err)
+		require.NoError(t, k.MintAndSendToAccount(ctx, address, getCoin(common.Tokens(10))))
+		_, err = s.OpenContract(ctx, &types.MsgOpenContract{
+			Provider:         providerPubKey.String(),
+			Service:          service.String(),
+			Creator:          address.String(),
+			Client:           client.String(),
+			ContractType:     contractType,
+			Duration:         100,
+			Rate:             rates[0],
+			Deposit:          cosmos.NewInt(1500),
+			QueriesPerMinute: 1,
+		})
+		require.NoError(t, err)
+		contract, err := k.GetActiveContractForUser(ctx, client, providerPubKey, service)
+		require.NoError(t, err)
+		return contract
+	}
+	paygContract := openContract(paygClient, types.ContractType_PAY_AS_YOU_GO)
+	idleClient := types.GetRandomPubKey()
+	idleContract := openContract(idleClient, types.ContractType_PAY_AS_YOU_GO)
+	subClient := types.GetRandomPubKey()
+	subContract := openContract(subClient, types.ContractType_SUBSCRIPTION)
+
+	preview := func(id uint64, nonce int64) types.ContractSettlement {
+		res, err := k.ContractSettlementPreview(ctx, &types.QueryContractSettlementPreviewRequest{ContractId: id, Nonce: nonce})
+		require.NoError(t, err)
+		return res.Settlement
+	}
+	balance := func(pubkey common.PubKey) int64 {
+		address, err := pubkey.GetMyAddress()
+		require.NoError(t, err)
+		return k.GetBalance(ctx, address).AmountOf(configs.Denom).Int64()
+	}
+	reserve := func() int64 {
+		return k.GetBalanceOfModule(ctx, types.ModuleName, configs.Denom).Int64()
+	}
+	// the balances moved by the settlement previewed, the provider is paid net of the tax kept by the reserve and the
+	// client refunded what is left
+	requireSettled := func(settlement types.ContractSettlement, client common.PubKey, settle func()) {
+		providerBefore, reserveBefore, clientBefore := balance(providerPubKey), reserve(), balance(client)
+		settle()
+		require.Equal(t, settlement.ProviderIncome.Amount.Int64(), balance(providerPubKey)-providerBefore)
+		require.Equal(t, settlement.ReserveTax.Amount.Int64(), reserve()-reserveBefore)
+		require.Equal(t, settlement.Refund.Amount.Int64(), balance(client)-clientBefore)
+	}
+	tax := func(amount int64) int64 {
+		return amount * s.FetchConfig(ctx, configs.ReserveTax) / configs.MaxBasisPoints
+	}
+
+	// the claim of 20 queries, nothing is written
+	settlement := preview(paygContract.Id, 20)
+	require.Equal(t, paygContract.Id, settlement.ContractId)
+	require.Equal(t, int64(20), settlement.Nonce)
+	require.False(t, settlement.Final)
+	require.Equal(t, int64(300), settlement.Owed.Amount.Int64())
+	require.Equal(t, int64(300-tax(300)), settlement.ProviderIncome.Amount.Int64())
+	require.Equal(t, tax(300), settlement.ReserveTax.Amount.Int64())
+	require.True(t, settlement.Refund.IsZero())
+	contract, err := k.GetContract(ctx, paygContract.Id)
+	require.NoError(t, err)
+	require.Equal(t, paygContract, contract)
+
+	// the claim pays out the preview to the unit
+	claim := types.MsgClaimContractIncome{
+		ContractId: paygContract.Id,
+		Creator:    providerAddress.String(),
+		Nonce:      20,
+	}
+	claim.Signature, _, err = kb.Sign("whatever", claim.GetBytesToSign(), signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+	requireSettled(settlement, paygClient, func() {
+		_, err = s.ClaimContractIncome(ctx, &claim)
+		require.NoError(t, err)
+	})
+	contract, err = k.GetContract(ctx, paygContract.Id)
+	require.NoError(t, err)
+	require.Equal(t, int64(20), contract.Nonce)
+	require.Equal(t, settlement.Owed.Amount, contract.Paid)
+
+	// the nonce claimed already is rejected as the claim is, a nonce over the queries the contract allows is paid
+	// for those only
+	_, err = k.ContractSettlementPreview(ctx, &types.QueryContractSettlementPreviewRequest{ContractId: paygContract.Id, Nonce: 20})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	settlement = preview(paygContract.Id, 500)
+	require.Equal(t, paygContract.MaxQueries(), settlement.Nonce)
+	require.Equal(t, int64(1500-300), settlement.Owed.Amount.Int64())
+
+	// the subscription closed 20 blocks in settles for good, the rest of its deposit is refunded
+	ctx = ctx.WithBlockHeight(30)
+	settlement = preview(subContract.Id, 0)
+	require.True(t, settlement.Final)
+	require.Equal(t, int64(300), settlement.Owed.Amount.Int64())
+	require.Equal(t, tax(300), settlement.ReserveTax.Amount.Int64())
+	require.Equal(t, int64(1200), settlement.Refund.Amount.Int64())
+	subAddress, err := subClient.GetMyAddress()
+	require.NoError(t, err)
+	requireSettled(settlement, subClient, func() {
+		_, err = s.CloseContract(ctx, &types.MsgCloseContract{
+			Creator:    subAddress.String(),
+			ContractId: subContract.Id,
+			Client:     subClient,
+		})
+		require.NoError(t, err)
+	})
+	// there is nothing left to settle
+	_, err = k.ContractSettlementPreview(ctx, &types.QueryContractSettlementPreviewRequest{ContractId: subContract.Id})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	// the pay-as-you-go contract never claimed expired, it settles at the end of its settlement period with its
+	// whole deposit refunded
+	ctx = ctx.WithBlockHeight(idleContract.Expiration() + 1)
+	settlement = preview(idleContract.Id, 0)
+	require.True(t, settlement.Final)
+	require.True(t, settlement.Owed.IsZero())
+	require.True(t, settlement.ProviderIncome.IsZero())
+	require.Equal(t, int64(1500), settlement.Refund.Amount.Int64())
+
+	ctx = ctx.WithBlockHeight(idleContract.SettlementPeriodEnd())
+	require.Equal(t, settlement, preview(idleContract.Id, 0))
+	requireSettled(settlement, idleClient, func() {
+		require.NoError(t, s.mgr.ContractEndBlock(ctx))
+	})
+
+	// the settlement period over, the claim is closed
+	_, err = k.ContractSettlementPreview(ctx, &types.QueryContractSettlementPreviewRequest{ContractId: paygContract.Id, Nonce: 40})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	_, err = k.ContractSettlementPreview(ctx, &types.QueryContractSettlementPreviewRequest{ContractId: 1000})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestClaimableIncome(t *testing.T) {
+	ctx, k, sk := SetupKeeperWithStaking(t)
+	ctx = ctx.WithBlockHeight(10)
+	s := newMsgServer(k, sk)
+
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	std.RegisterInterfaces(interfaceRegistry)
+	module.NewBasicManager().RegisterInterfaces(interfaceRegistry)
+	types.RegisterInterfaces(interfaceRegistry)
+	cdc := codec.NewProtoCodec(interfaceRegistry)
+
+	// set up provider
+	providerPubKey := types.GetRandomPubKey()
+	providerAddress, err := providerPubKey.GetMyAddress()
+	require.NoError(t, err)
+	service := common.BTCService
+	provider := types.NewProvider(providerPubKey, service)
+	provider.Bond = cosmos.NewInt(common.Tokens(1))
+	require.NoError(t, k.SetProvider(ctx, provider))
+
+	rates, err := cosmos.ParseCoins("15uarkeo")
+	require.NoError(t, err)
+	require.NoError(t, s.ModProviderHandle(ctx, &types.MsgModProvider{
+		Creator:             providerAddress.String(),
+		Provider:            provider.PubKey,
+		Service:             provider.Service.String(),
+		MinContractDuration: 10,
+		MaxContractDuration: 500,
+		Status:              types.ProviderStatus_ONLINE,
+		PayAsYouGoRate:      rates,
+		SubscriptionRate:    rates,
+		UpdateMask:          types.ModProviderFields,
+	}))
+
+	// the clients sign the claims of the provider
+	kb := cKeys.NewInMemory(cdc)
+	newClient := func(uid string) common.PubKey {
+		info, _, err := kb.NewMnemonic(uid, cKeys.English, `m/44'/931'/0'/0/0`, "", hd.Secp256k1)
+		require.NoError(t, err)
+		pk, err := info.GetPubKey()
+		require.NoError(t, err)
+		client, err := common.NewPubKeyFromCrypto(pk)
+		require.NoError(t, err)
+		return client
+	}
+	openContract := func(client common.PubKey, contractType types.ContractType) types.Contract {
+		address, err := client.GetMyAddress()
+		require.NoError(t, err)
+		require.NoError(t, k.MintAndSendToAccount(ctx, address, getCoin(common.Tokens(10))))
+		_, err = s.OpenContract(ctx, &types.MsgOpenContract{
+			Provider:         providerPubKey.String(),
+			Service:          service.String(),
+			Creator:          address.String(),
+			Client:           client.String(),
+			ContractType:     contractType,
+			Duration:         100,
+			Rate:             rates[0],
+			Deposit:          cosmos.NewInt(1500),
+			QueriesPerMinute: 1,
+		})
+		require.NoError(t, err)
+		contract, err := k.GetActiveContractForUser(ctx, client, providerPubKey, service)
+		require.NoError(t, err)
+		return contract
+	}
+	paygContract := openContract(newClient("payg"), types.ContractType_PAY_AS_YOU_GO)
+	subContract := openContract(newClient("sub"), types.ContractType_SUBSCRIPTION)
+
+	claimable := func(id uint64, nonce int64) *types.QueryClaimableIncomeResponse {
+		res, err := k.ClaimableIncome(ctx, &types.QueryClaimableIncomeRequest{ContractId: id, Nonce: nonce})
+		require.NoError(t, err)
+		return res
+	}
+	tax := func(amount int64) int64 {
+		return amount * s.FetchConfig(ctx, configs.ReserveTax) / configs.MaxBasisPoints
+	}
+	// the claim pays the provider what the query returned, leaving the escrow it returned in the contract
+	requireClaimed := func(res *types.QueryClaimableIncomeResponse, contract types.Contract, uid string, nonce int64) {
+		claim := types.MsgClaimContractIncome{
+			ContractId: contract.Id,
+			Creator:    providerAddress.String(),
+			Nonce:      nonce,
+		}
+		claim.Signature, _, err = kb.Sign(uid, claim.GetBytesToSign(), signing.SignMode_SIGN_MODE_DIRECT)
+		require.NoError(t, err)
+		before := k.GetBalance(ctx, providerAddress).AmountOf(configs.Denom)
+		_, err = s.ClaimContractIncome(ctx, &claim)
+		require.NoError(t, err)
+		require.Equal(t, res.Claimable.Amount, k.GetBalance(ctx, providerAddress).AmountOf(configs.Denom).Sub(before))
+		contract, err := k.GetContract(ctx, contract.Id)
+		require.NoError(t, err)
+		require.Equal(t, res.Nonce, contract.Nonce)
+		require.Equal(t, res.RemainingEscrow.Amount, contract.Deposit.Sub(contract.Paid))
+	}
+
+	// 20 queries of the pay-as-you-go contract
+	res := claimable(paygContract.Id, 20)
+	require.Equal(t, int64(20), res.Nonce)
+	require.Equal(t, int64(300-tax(300)), res.Claimable.Amount.Int64())
+	require.Equal(t, tax(300), res.ReserveTax.Amount.Int64())
+	require.True(t, res.Dust.Amount.IsZero())
+	require.Equal(t, int64(1200), res.RemainingEscrow.Amount.Int64())
+	require.Equal(t, configs.Denom, res.Claimable.Denom)
+	requireClaimed(res, paygContract, "payg", 20)
+
+	// the amount claimed already is left out, the nonce claimed already is rejected as the claim is
+	res = claimable(paygContract.Id, 30)
+	require.Equal(t, int64(150-tax(150)), res.Claimable.Amount.Int64())
+	require.Equal(t, int64(1050), res.RemainingEscrow.Amount.Int64())
+	_, err = k.ClaimableIncome(ctx, &types.QueryClaimableIncomeRequest{ContractId: paygContract.Id, Nonce: 20})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// a nonce over the queries the contract allows is paid for those only, emptying the escrow
+	res = claimable(paygContract.Id, 500)
+	require.Equal(t, paygContract.MaxQueries(), res.Nonce)
+	require.Equal(t, int64(1200-tax(1200)), res.Claimable.Amount.Int64())
+	require.True(t, res.RemainingEscrow.IsZero())
+
+	// the subscription is owed the blocks elapsed, whatever the nonce
+	ctx = ctx.WithBlockHeight(30)
+	res = claimable(subContract.Id, 1)
+	require.Equal(t, int64(300-tax(300)), res.Claimable.Amount.Int64())
+	require.Equal(t, int64(1200), res.RemainingEscrow.Amount.Int64())
+	requireClaimed(res, subContract, "sub", 1)
+
+	// the settlement period over, the claim is closed
+	ctx = ctx.WithBlockHeight(paygContract.SettlementPeriodEnd())
+	_, err = k.ClaimableIncome(ctx, &types.QueryClaimableIncomeRequest{ContractId: paygContract.Id, Nonce: 40})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	_, err = k.ClaimableIncome(ctx, &types.QueryClaimableIncomeRequest{ContractId: 1000, Nonce: 1})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
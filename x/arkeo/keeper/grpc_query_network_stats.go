@@ -0,0 +1,25 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+)
+
+func (k KVStore) NetworkStats(c context.Context, req *types.QueryNetworkStatsRequest) (*types.QueryNetworkStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	stats, err := k.GetNetworkStats(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryNetworkStatsResponse{Stats: stats}, nil
+}
@@ -0,0 +1,34 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/arkeonetwork/arkeo/common"
+	"github.com/arkeonetwork/arkeo/common/cosmos"
+	testkeeper "github.com/arkeonetwork/arkeo/testutil/keeper"
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+)
+
+func TestNetworkStatsQuery(t *testing.T) {
+	ctx, k := testkeeper.ArkeoKeeper(t)
+
+	res, err := k.NetworkStats(ctx, &types.QueryNetworkStatsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, types.NetworkStats{}, res.Stats)
+
+	provider := types.NewProvider(types.GetRandomPubKey(), common.BTCService)
+	provider.Bond = cosmos.NewInt(500)
+	provider.Status = types.ProviderStatus_ONLINE
+	require.NoError(t, k.SetProvider(ctx, provider))
+
+	res, err = k.NetworkStats(ctx, &types.QueryNetworkStatsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), res.Stats.Providers)
+	require.Equal(t, uint64(1), res.Stats.ProvidersOnline)
+	require.Equal(t, cosmos.NewCoins(cosmos.NewInt64Coin("uarkeo", 500)), cosmos.Coins(res.Stats.Bonded))
+
+	_, err = k.NetworkStats(ctx, nil)
+	require.Error(t, err)
+}
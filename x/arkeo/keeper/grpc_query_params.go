@@ -16,5 +16,15 @@ func (k KVStore) Params(c context.Context, req *types.QueryParamsRequest) (*type
 	}
 	ctx := sdk.UnwrapSDKContext(c)
 
-	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
+	record, _, err := k.GetParamsRecord(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryParamsResponse{
+		Params:           k.GetParams(ctx),
+		LastChangeHeight: record.LastChangeHeight,
+		ConsensusVersion: types.ConsensusVersion,
+		Version:          k.GetVersion(ctx),
+	}, nil
 }
@@ -1 +1,28 @@
 package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	testkeeper "github.com/arkeonetwork/arkeo/testutil/keeper"
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+)
+
+func TestParamsQuery(t *testing.T) {
+	ctx, k := testkeeper.ArkeoKeeper(t)
+	params := types.DefaultParams()
+	params.MaxClaimBatchSize = 5
+	params.ServiceMinBonds = []types.ServiceMinBond{{Service: "btc-mainnet-fullnode", MinBond: 500}}
+	k.SetParams(ctx, params)
+	k.SetVersion(ctx, 3)
+
+	res, err := k.Params(ctx, &types.QueryParamsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, params, res.Params)
+	require.Equal(t, uint64(types.ConsensusVersion), res.ConsensusVersion)
+	require.Equal(t, int64(3), res.Version)
+
+	_, err = k.Params(ctx, nil)
+	require.Error(t, err)
+}
@@ -40,6 +40,48 @@ func (k KVStore) ProviderAll(c context.Context, req *types.QueryAllProviderReque
 	return &types.QueryAllProviderResponse{Provider: providers, Pagination: pageRes}, nil
 }
 
+func (k KVStore) Providers(c context.Context, req *types.QueryProvidersRequest) (*types.QueryProvidersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	service, err := common.NewService(req.Service)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid service")
+	}
+	if err := types.ValidateProviderStatusFilter(req.StatusFilter); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var providers []types.Provider
+	store := ctx.KVStore(k.storeKey)
+	indexStore := prefix.NewStore(store, []byte(k.getServiceProviderPrefix(ctx, service)))
+
+	pageRes, err := query.FilteredPaginate(indexStore, req.Pagination, func(key, value []byte, accumulate bool) (bool, error) {
+		pubkey, err := common.NewPubKey(string(value))
+		if err != nil {
+			return false, err
+		}
+		provider, err := k.GetProvider(ctx, pubkey, service)
+		if err != nil {
+			return false, err
+		}
+		if !provider.InStatus(req.StatusFilter) {
+			return false, nil
+		}
+		if accumulate {
+			providers = append(providers, provider)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryProvidersResponse{Providers: providers, Pagination: pageRes}, nil
+}
+
 func (k KVStore) FetchProvider(c context.Context, req *types.QueryFetchProviderRequest) (*types.QueryFetchProviderResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
@@ -67,3 +109,27 @@ func (k KVStore) FetchProvider(c context.Context, req *types.QueryFetchProviderR
 
 	return &types.QueryFetchProviderResponse{Provider: val}, nil
 }
+
+func (k KVStore) ProviderEarnings(c context.Context, req *types.QueryProviderEarningsRequest) (*types.QueryProviderEarningsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	pk, err := common.NewPubKey(req.Pubkey)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid pubkey")
+	}
+
+	service, err := common.NewService(req.Service)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid service")
+	}
+
+	earnings, err := k.GetProviderEarnings(ctx, pk, service)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryProviderEarningsResponse{Earnings: earnings}, nil
+}
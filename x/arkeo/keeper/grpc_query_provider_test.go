@@ -0,0 +1,269 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	cKeys "github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/std"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/arkeonetwork/arkeo/common"
+	"github.com/arkeonetwork/arkeo/common/cosmos"
+	"github.com/arkeonetwork/arkeo/x/arkeo/configs"
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+)
+
+func TestProviderEarnings(t *testing.T) {
+	ctx, k, sk := SetupKeeperWithStaking(t)
+	ctx = ctx.WithBlockHeight(10)
+	s := newMsgServer(k, sk)
+
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	std.RegisterInterfaces(interfaceRegistry)
+	module.NewBasicManager().RegisterInterfaces(interfaceRegistry)
+	types.RegisterInterfaces(interfaceRegistry)
+	cdc := codec.NewProtoCodec(interfaceRegistry)
+
+	// set up provider
+	providerPubKey := types.GetRandomPubKey()
+	providerAddress, err := providerPubKey.GetMyAddress()
+	require.NoError(t, err)
+	service := common.BTCService
+	provider := types.NewProvider(providerPubKey, service)
+	provider.Bond = cosmos.NewInt(common.Tokens(1))
+	require.NoError(t, k.SetProvider(ctx, provider))
+
+	rates, err := cosmos.ParseCoins("15uarkeo")
+	require.NoError(t, err)
+	require.NoError(t, s.ModProviderHandle(ctx, &types.MsgModProvider{
+		Creator:             providerAddress.String(),
+		Provider:            provider.PubKey,
+		Service:             provider.Service.String(),
+		MinContractDuration: 10,
+		MaxContractDuration: 500,
+		Status:              types.ProviderStatus_ONLINE,
+		PayAsYouGoRate:      rates,
+		SubscriptionRate:    rates,
+		UpdateMask:          types.ModProviderFields,
+	}))
+
+	earnings := func() types.ProviderEarnings {
+		res, err := k.ProviderEarnings(ctx, &types.QueryProviderEarningsRequest{
+			Pubkey:  providerPubKey.String(),
+			Service: service.String(),
+		})
+		require.NoError(t, err)
+		return res.Earnings
+	}
+	require.True(t, cosmos.NewCoins(earnings().Income...).IsZero())
+	require.True(t, cosmos.NewCoins(earnings().Escrowed...).IsZero())
+	require.Zero(t, earnings().SettledContracts)
+
+	// the pay-as-you-go client signs its claims
+	kb := cKeys.NewInMemory(cdc)
+	info, _, err := kb.NewMnemonic("whatever", cKeys.English, `m/44'/931'/0'/0/0`, "", hd.Secp256k1)
+	require.NoError(t, err)
+	pk, err := info.GetPubKey()
+	require.NoError(t, err)
+	paygClient, err := common.NewPubKeyFromCrypto(pk)
+	require.NoError(t, err)
+	paygAddress, err := paygClient.GetMyAddress()
+	require.NoError(t, err)
+	require.NoError(t, k.MintAndSendToAccount(ctx, paygAddress, getCoin(common.Tokens(10))))
+
+	subClient := types.GetRandomPubKey()
+	subAddress, err := subClient.GetMyAddress()
+	require.NoError(t, err)
+	require.NoError(t, k.MintAndSendToAccount(ctx, subAddress, getCoin(common.Tokens(10))))
+
+	// open a contract of each type, their deposits are escrowed
+	_, err = s.OpenContract(ctx, &types.MsgOpenContract{
+		Provider:     providerPubKey.String(),
+		Service:      service.String(),
+		Creator:      paygAddress.String(),
+		Client:       paygClient.String(),
+		ContractType: types.ContractType_PAY_AS_YOU_GO,
+		Duration:     100,
+		Rate:         rates[0],
+		Deposit:      cosmos.NewInt(1500),
+	})
+	require.NoError(t, err)
+	_, err = s.OpenContract(ctx, &types.MsgOpenContract{
+		Provider:         providerPubKey.String(),
+		Service:          service.String(),
+		Creator:          subAddress.String(),
+		Client:           subClient.String(),
+		ContractType:     types.ContractType_SUBSCRIPTION,
+		Duration:         100,
+		Rate:             rates[0],
+		Deposit:          cosmos.NewInt(1500),
+		QueriesPerMinute: 1,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(3000), cosmos.NewCoins(earnings().Escrowed...).AmountOf(configs.Denom).Int64())
+
+	// the provider claims 20 queries of the pay-as-you-go contract, the reserve keeps its tax
+	paygContract, err := k.GetActiveContractForUser(ctx, paygClient, providerPubKey, service)
+	require.NoError(t, err)
+	claim := types.MsgClaimContractIncome{
+		ContractId: paygContract.Id,
+		Creator:    providerAddress.String(),
+		Nonce:      20,
+	}
+	claim.Signature, _, err = kb.Sign("whatever", claim.GetBytesToSign(), signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+	_, err = s.ClaimContractIncome(ctx, &claim)
+	require.NoError(t, err)
+
+	tax := func(amount int64) int64 {
+		return amount * s.FetchConfig(ctx, configs.ReserveTax) / configs.MaxBasisPoints
+	}
+	income := 300 - tax(300)
+	require.Equal(t, income, cosmos.NewCoins(earnings().Income...).AmountOf(configs.Denom).Int64())
+	require.Equal(t, int64(2700), cosmos.NewCoins(earnings().Escrowed...).AmountOf(configs.Denom).Int64())
+	require.Zero(t, earnings().SettledContracts)
+
+	// the subscription is closed 20 blocks in, the rest of its deposit is refunded
+	subContract, err := k.GetActiveContractForUser(ctx, subClient, providerPubKey, service)
+	require.NoError(t, err)
+	ctx = ctx.WithBlockHeight(30)
+	_, err = s.CloseContract(ctx, &types.MsgCloseContract{
+		Creator:    subAddress.String(),
+		ContractId: subContract.Id,
+		Client:     subClient,
+	})
+	require.NoError(t, err)
+	income += 300 - tax(300)
+	require.Equal(t, income, cosmos.NewCoins(earnings().Income...).AmountOf(configs.Denom).Int64())
+	require.Equal(t, int64(1200), cosmos.NewCoins(earnings().Escrowed...).AmountOf(configs.Denom).Int64())
+	require.Equal(t, uint64(1), earnings().SettledContracts)
+
+	// the pay-as-you-go contract settles at the end of its settlement period, nothing more to claim
+	paygContract, err = k.GetContract(ctx, paygContract.Id)
+	require.NoError(t, err)
+	ctx = ctx.WithBlockHeight(paygContract.SettlementPeriodEnd())
+	require.NoError(t, s.mgr.ContractEndBlock(ctx))
+	require.Equal(t, income, cosmos.NewCoins(earnings().Income...).AmountOf(configs.Denom).Int64())
+	require.True(t, cosmos.NewCoins(earnings().Escrowed...).IsZero())
+	require.Equal(t, uint64(2), earnings().SettledContracts)
+	require.Equal(t, income, k.GetBalance(ctx, providerAddress).AmountOf(configs.Denom).Int64())
+
+	// the counters are per service
+	res, err := k.ProviderEarnings(ctx, &types.QueryProviderEarningsRequest{
+		Pubkey:  providerPubKey.String(),
+		Service: common.ETHService.String(),
+	})
+	require.NoError(t, err)
+	require.Zero(t, res.Earnings.SettledContracts)
+
+	_, err = k.ProviderEarnings(ctx, &types.QueryProviderEarningsRequest{Pubkey: "bogus", Service: service.String()})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestProviders(t *testing.T) {
+	ctx, k, sk := SetupKeeperWithStaking(t)
+	ctx = ctx.WithBlockHeight(10)
+	s := newMsgServer(k, sk)
+
+	rates := cosmos.NewCoins(cosmos.NewInt64Coin(configs.Denom, 15))
+	bond := func(pubkey common.PubKey, service common.Service, amount int64) {
+		addr, err := pubkey.GetMyAddress()
+		require.NoError(t, err)
+		if amount > 0 {
+			require.NoError(t, k.MintAndSendToAccount(ctx, addr, getCoin(amount)))
+		}
+		require.NoError(t, s.BondProviderHandle(ctx, types.NewMsgBondProvider(addr, pubkey, service.String(), cosmos.NewInt(amount))))
+	}
+	setOnline := func(pubkey common.PubKey, service common.Service) {
+		addr, err := pubkey.GetMyAddress()
+		require.NoError(t, err)
+		require.NoError(t, s.ModProviderHandle(ctx, &types.MsgModProvider{
+			Creator:             addr.String(),
+			Provider:            pubkey,
+			Service:             service.String(),
+			MetadataNonce:       1,
+			MinContractDuration: 10,
+			MaxContractDuration: 500,
+			Status:              types.ProviderStatus_ONLINE,
+			SubscriptionRate:    rates,
+			PayAsYouGoRate:      rates,
+			UpdateMask:          types.ModProviderFields,
+		}))
+	}
+	queryProviders := func(service, status string, pageReq *query.PageRequest) *types.QueryProvidersResponse {
+		res, err := k.Providers(ctx, &types.QueryProvidersRequest{Service: service, StatusFilter: status, Pagination: pageReq})
+		require.NoError(t, err)
+		return res
+	}
+	pubkeys := func(providers []types.Provider) []common.PubKey {
+		res := make([]common.PubKey, len(providers))
+		for i, provider := range providers {
+			res[i] = provider.PubKey
+		}
+		return res
+	}
+
+	online1, online2, offline := types.GetRandomPubKey(), types.GetRandomPubKey(), types.GetRandomPubKey()
+	for _, pubkey := range []common.PubKey{online1, online2, offline} {
+		bond(pubkey, common.BTCService, common.Tokens(1))
+	}
+	setOnline(online1, common.BTCService)
+	setOnline(online2, common.BTCService)
+	// another service of the online provider
+	bond(online1, common.ETHService, common.Tokens(1))
+
+	res := queryProviders(common.BTCService.String(), "", nil)
+	require.ElementsMatch(t, []common.PubKey{online1, online2, offline}, pubkeys(res.Providers))
+	require.Equal(t, uint64(3), res.Pagination.Total)
+	for _, provider := range res.Providers {
+		if !provider.PubKey.Equals(offline) {
+			require.Equal(t, rates, cosmos.NewCoins(provider.SubscriptionRate...))
+		}
+	}
+	res = queryProviders(common.BTCService.String(), "online", nil)
+	require.ElementsMatch(t, []common.PubKey{online1, online2}, pubkeys(res.Providers))
+	res = queryProviders(common.BTCService.String(), "OFFLINE", nil)
+	require.Equal(t, []common.PubKey{offline}, pubkeys(res.Providers))
+	res = queryProviders(common.ETHService.String(), "", nil)
+	require.Equal(t, []common.PubKey{online1}, pubkeys(res.Providers))
+	require.Empty(t, queryProviders(common.MockService.String(), "", nil).Providers)
+
+	// one page after the other
+	var paged []types.Provider
+	pageReq := &query.PageRequest{Limit: 2}
+	for {
+		res = queryProviders(common.BTCService.String(), "", pageReq)
+		require.LessOrEqual(t, len(res.Providers), 2)
+		paged = append(paged, res.Providers...)
+		if len(res.Pagination.NextKey) == 0 {
+			break
+		}
+		pageReq = &query.PageRequest{Key: res.Pagination.NextKey, Limit: 2}
+	}
+	require.ElementsMatch(t, []common.PubKey{online1, online2, offline}, pubkeys(paged))
+
+	// unbonding under the minimum bond takes the provider offline, unbonding it all drops it from the service
+	bond(online2, common.BTCService, -common.Tokens(1)/2)
+	res = queryProviders(common.BTCService.String(), "online", nil)
+	require.Equal(t, []common.PubKey{online1}, pubkeys(res.Providers))
+	bond(offline, common.BTCService, -common.Tokens(1))
+	res = queryProviders(common.BTCService.String(), "", nil)
+	require.ElementsMatch(t, []common.PubKey{online1, online2}, pubkeys(res.Providers))
+	// bonding again adds it back
+	bond(offline, common.BTCService, common.Tokens(1))
+	res = queryProviders(common.BTCService.String(), "", nil)
+	require.ElementsMatch(t, []common.PubKey{online1, online2, offline}, pubkeys(res.Providers))
+
+	_, err := k.Providers(ctx, &types.QueryProvidersRequest{Service: "bogus"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = k.Providers(ctx, &types.QueryProvidersRequest{Service: common.BTCService.String(), StatusFilter: "busy"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
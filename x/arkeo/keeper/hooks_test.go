@@ -0,0 +1,283 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/arkeonetwork/arkeo/common"
+	"github.com/arkeonetwork/arkeo/common/cosmos"
+	"github.com/arkeonetwork/arkeo/x/arkeo/configs"
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+)
+
+type hookCall struct {
+	name       string
+	provider   types.Provider
+	bond       cosmos.Int
+	contract   types.Contract
+	settlement types.ContractSettlement
+}
+
+// recordingHooks record the hooks called, failing the ones listed in errs
+type recordingHooks struct {
+	calls []hookCall
+	errs  map[string]error
+}
+
+var _ types.ArkeoHooks = &recordingHooks{}
+
+func (h *recordingHooks) record(call hookCall) error {
+	if err := h.errs[call.name]; err != nil {
+		return err
+	}
+	h.calls = append(h.calls, call)
+	return nil
+}
+
+func (h *recordingHooks) AfterProviderBonded(_ context.Context, provider types.Provider, bond cosmos.Int) error {
+	return h.record(hookCall{name: "AfterProviderBonded", provider: provider, bond: bond})
+}
+
+func (h *recordingHooks) AfterProviderModified(_ context.Context, provider types.Provider) error {
+	return h.record(hookCall{name: "AfterProviderModified", provider: provider})
+}
+
+func (h *recordingHooks) AfterContractOpened(_ context.Context, contract types.Contract) error {
+	return h.record(hookCall{name: "AfterContractOpened", contract: contract})
+}
+
+func (h *recordingHooks) AfterContractClosed(_ context.Context, contract types.Contract) error {
+	return h.record(hookCall{name: "AfterContractClosed", contract: contract})
+}
+
+func (h *recordingHooks) AfterContractSettled(_ context.Context, contract types.Contract, settlement types.ContractSettlement) error {
+	return h.record(hookCall{name: "AfterContractSettled", contract: contract, settlement: settlement})
+}
+
+// setupHookedKeeper return a keeper calling the hooks, with an online provider ready to open contracts
+func setupHookedKeeper(t *testing.T, hooks types.ArkeoHooks) (cosmos.Context, Keeper, *msgServer, Manager, common.PubKey) {
+	ctx, k, sk := SetupKeeperWithStaking(t)
+	kvStore := k.(KVStore)
+	k = *kvStore.SetHooks(types.NewMultiArkeoHooks(hooks))
+	ctx = ctx.WithBlockHeight(10)
+
+	providerPubKey := types.GetRandomPubKey()
+	providerAddress, err := providerPubKey.GetMyAddress()
+	require.NoError(t, err)
+	require.NoError(t, k.MintAndSendToAccount(ctx, providerAddress, getCoin(common.Tokens(10))))
+
+	return ctx, k, newMsgServer(k, sk), NewManager(k, sk), providerPubKey
+}
+
+func TestHooks(t *testing.T) {
+	hooks := &recordingHooks{}
+	ctx, k, s, mgr, providerPubKey := setupHookedKeeper(t, hooks)
+	providerAddress, err := providerPubKey.GetMyAddress()
+	require.NoError(t, err)
+
+	_, err = s.BondProvider(ctx, &types.MsgBondProvider{
+		Creator:  providerAddress.String(),
+		Provider: providerPubKey.String(),
+		Service:  common.BTCService.String(),
+		Bond:     cosmos.NewInt(common.Tokens(8)),
+	})
+	require.NoError(t, err)
+	rates := getCoins(15)
+	_, err = s.ModProvider(ctx, &types.MsgModProvider{
+		Creator:             providerAddress.String(),
+		Provider:            providerPubKey,
+		Service:             common.BTCService.String(),
+		MetadataNonce:       1,
+		MinContractDuration: 10,
+		MaxContractDuration: 500,
+		Status:              types.ProviderStatus_ONLINE,
+		PayAsYouGoRate:      rates,
+		SubscriptionRate:    rates,
+		UpdateMask:          types.ModProviderFields,
+	})
+	require.NoError(t, err)
+
+	clientPubKey := types.GetRandomPubKey()
+	clientAddress, err := clientPubKey.GetMyAddress()
+	require.NoError(t, err)
+	require.NoError(t, k.MintAndSendToAccount(ctx, clientAddress, getCoin(common.Tokens(10))))
+	openContract := func(contractType types.ContractType) types.Contract {
+		_, err := s.OpenContract(ctx, &types.MsgOpenContract{
+			Provider:         providerPubKey.String(),
+			Service:          common.BTCService.String(),
+			Creator:          clientAddress.String(),
+			Client:           clientPubKey.String(),
+			ContractType:     contractType,
+			Duration:         100,
+			Rate:             rates[0],
+			Deposit:          cosmos.NewInt(15 * 100),
+			QueriesPerMinute: 1,
+		})
+		require.NoError(t, err)
+		contract, err := k.GetActiveContractForUser(ctx, clientPubKey, providerPubKey, common.BTCService)
+		require.NoError(t, err)
+		return contract
+	}
+
+	// the subscription is settled as its client closes it
+	sub := openContract(types.ContractType_SUBSCRIPTION)
+	_, err = s.CloseContract(ctx, &types.MsgCloseContract{
+		Creator:    clientAddress.String(),
+		ContractId: sub.Id,
+	})
+	require.NoError(t, err)
+
+	// the pay-as-you-go contract is settled by the end blocker at the end of its settlement period
+	payg := openContract(types.ContractType_PAY_AS_YOU_GO)
+	ctx = ctx.WithBlockHeight(payg.SettlementPeriodEnd())
+	require.NoError(t, mgr.ContractEndBlock(ctx))
+
+	_, err = s.BondProvider(ctx, &types.MsgBondProvider{
+		Creator:  providerAddress.String(),
+		Provider: providerPubKey.String(),
+		Service:  common.BTCService.String(),
+		Bond:     cosmos.NewInt(-common.Tokens(8)),
+	})
+	require.NoError(t, err)
+
+	names := make([]string, len(hooks.calls))
+	for i, call := range hooks.calls {
+		names[i] = call.name
+	}
+	require.Equal(t, []string{
+		"AfterProviderBonded",
+		"AfterProviderModified",
+		"AfterContractOpened",
+		"AfterContractSettled",
+		"AfterContractClosed",
+		"AfterContractOpened",
+		"AfterContractSettled",
+		"AfterProviderBonded",
+	}, names)
+
+	// the provider bonded then unbonded
+	require.Equal(t, cosmos.NewInt(common.Tokens(8)), hooks.calls[0].bond)
+	require.Equal(t, cosmos.NewInt(common.Tokens(8)), hooks.calls[0].provider.Bond)
+	require.Equal(t, types.ProviderStatus_ONLINE, hooks.calls[1].provider.Status)
+	require.Equal(t, cosmos.NewInt(-common.Tokens(8)), hooks.calls[7].bond)
+	require.True(t, hooks.calls[7].provider.Bond.IsZero())
+
+	// the contracts opened then settled
+	require.Equal(t, sub.Id, hooks.calls[2].contract.Id)
+	require.Zero(t, hooks.calls[2].contract.SettlementHeight)
+	for _, call := range hooks.calls[3:5] {
+		require.Equal(t, sub.Id, call.contract.Id)
+		require.Equal(t, int64(10), call.contract.SettlementHeight)
+	}
+	require.True(t, hooks.calls[3].settlement.Final)
+	require.Equal(t, payg.Id, hooks.calls[5].contract.Id)
+	require.Equal(t, payg.Id, hooks.calls[6].contract.Id)
+	require.Equal(t, payg.SettlementPeriodEnd(), hooks.calls[6].contract.SettlementHeight)
+	// nothing was claimed, the whole deposit goes back to the client
+	require.Equal(t, cosmos.NewInt(15*100), hooks.calls[6].settlement.Refund.Amount)
+}
+
+func TestHooksAbort(t *testing.T) {
+	errHook := errors.New("hook failed")
+	hooks := &recordingHooks{errs: make(map[string]error)}
+	ctx, k, s, mgr, providerPubKey := setupHookedKeeper(t, hooks)
+	providerAddress, err := providerPubKey.GetMyAddress()
+	require.NoError(t, err)
+
+	// the bond isn't taken
+	hooks.errs["AfterProviderBonded"] = errHook
+	bondMsg := &types.MsgBondProvider{
+		Creator:  providerAddress.String(),
+		Provider: providerPubKey.String(),
+		Service:  common.BTCService.String(),
+		Bond:     cosmos.NewInt(common.Tokens(8)),
+	}
+	_, err = s.BondProvider(ctx, bondMsg)
+	require.ErrorIs(t, err, errHook)
+	require.False(t, k.ProviderExists(ctx, providerPubKey, common.BTCService))
+	require.Equal(t, common.Tokens(10), k.GetBalance(ctx, providerAddress).AmountOf(configs.Denom).Int64())
+
+	delete(hooks.errs, "AfterProviderBonded")
+	_, err = s.BondProvider(ctx, bondMsg)
+	require.NoError(t, err)
+	rates := getCoins(15)
+	_, err = s.ModProvider(ctx, &types.MsgModProvider{
+		Creator:             providerAddress.String(),
+		Provider:            providerPubKey,
+		Service:             common.BTCService.String(),
+		MetadataNonce:       1,
+		MinContractDuration: 10,
+		MaxContractDuration: 500,
+		Status:              types.ProviderStatus_ONLINE,
+		PayAsYouGoRate:      rates,
+		SubscriptionRate:    rates,
+		UpdateMask:          types.ModProviderFields,
+	})
+	require.NoError(t, err)
+
+	clientPubKey := types.GetRandomPubKey()
+	clientAddress, err := clientPubKey.GetMyAddress()
+	require.NoError(t, err)
+	require.NoError(t, k.MintAndSendToAccount(ctx, clientAddress, getCoin(common.Tokens(10))))
+	openMsg := &types.MsgOpenContract{
+		Provider:         providerPubKey.String(),
+		Service:          common.BTCService.String(),
+		Creator:          clientAddress.String(),
+		Client:           clientPubKey.String(),
+		ContractType:     types.ContractType_PAY_AS_YOU_GO,
+		Duration:         100,
+		Rate:             rates[0],
+		Deposit:          cosmos.NewInt(15 * 100),
+		QueriesPerMinute: 1,
+	}
+
+	// the contract isn't opened, nor the deposit taken
+	hooks.errs["AfterContractOpened"] = errHook
+	nextContractId := k.GetNextContractId(ctx)
+	_, err = s.OpenContract(ctx, openMsg)
+	require.ErrorIs(t, err, errHook)
+	require.Equal(t, nextContractId, k.GetNextContractId(ctx))
+	require.Equal(t, common.Tokens(10), k.GetBalance(ctx, clientAddress).AmountOf(configs.Denom).Int64())
+
+	delete(hooks.errs, "AfterContractOpened")
+	_, err = s.OpenContract(ctx, openMsg)
+	require.NoError(t, err)
+	contract, err := k.GetActiveContractForUser(ctx, clientPubKey, providerPubKey, common.BTCService)
+	require.NoError(t, err)
+
+	// the end blocker leaves the contract unsettled, its deposit kept in the contract module
+	hooks.errs["AfterContractSettled"] = errHook
+	ctx = ctx.WithBlockHeight(contract.SettlementPeriodEnd())
+	require.NoError(t, mgr.ContractEndBlock(ctx))
+	contract, err = k.GetContract(ctx, contract.Id)
+	require.NoError(t, err)
+	require.Zero(t, contract.SettlementHeight)
+	require.Equal(t, cosmos.NewInt(15*100), k.GetBalanceOfModule(ctx, types.ContractName, configs.Denom))
+	provider, err := k.GetProvider(ctx, providerPubKey, common.BTCService)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), provider.OpenContracts)
+
+	// it is settled again on the next blocks until it succeeds
+	set, err := k.GetContractExpirationSet(ctx, ctx.BlockHeight()+1)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{contract.Id}, set.ContractSet.ContractIds)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	require.NoError(t, mgr.ContractEndBlock(ctx))
+	contract, err = k.GetContract(ctx, contract.Id)
+	require.NoError(t, err)
+	require.Zero(t, contract.SettlementHeight)
+
+	delete(hooks.errs, "AfterContractSettled")
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	require.NoError(t, mgr.ContractEndBlock(ctx))
+	contract, err = k.GetContract(ctx, contract.Id)
+	require.NoError(t, err)
+	require.Equal(t, ctx.BlockHeight(), contract.SettlementHeight)
+	require.True(t, k.GetBalanceOfModule(ctx, types.ContractName, configs.Denom).IsZero())
+	set, err = k.GetContractExpirationSet(ctx, ctx.BlockHeight()+1)
+	require.NoError(t, err)
+	require.Empty(t, set.ContractSet.GetContractIds())
+}
@@ -0,0 +1,126 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/arkeonetwork/arkeo/common/cosmos"
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+)
+
+// RegisterInvariants register the invariants of the module with the crisis module
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "module-solvency", ModuleSolvencyInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "contract-escrow", ContractEscrowInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "network-stats", NetworkStatsInvariant(k))
+}
+
+// AllInvariants run all the invariants of the module, the first one broken reported
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		if res, stop := ModuleSolvencyInvariant(k)(ctx); stop {
+			return res, stop
+		}
+		if res, stop := ContractEscrowInvariant(k)(ctx); stop {
+			return res, stop
+		}
+		return NetworkStatsInvariant(k)(ctx)
+	}
+}
+
+// ModuleSolvencyInvariant check the provider module holds the bonds of the providers, and the contract module the
+// escrows of the contracts not settled yet. The module accounts can hold more than they owe, the dust of the
+// settlements goes to the reserve and the coins sent to them some other way are left alone, never less.
+func ModuleSolvencyInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		broken := false
+
+		// recounted from the records, the stored counters are checked by the network stats invariant
+		counted, err := countNetworkStats(ctx, k)
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "module-solvency", err.Error()), true
+		}
+		bonds, escrows := cosmos.Coins(counted.Bonded), cosmos.Coins(counted.Escrowed)
+
+		for _, owed := range []struct {
+			module string
+			coins  cosmos.Coins
+		}{{types.ProviderName, bonds}, {types.ContractName, escrows}} {
+			for _, coin := range owed.coins {
+				if balance := k.GetBalanceOfModule(ctx, owed.module, coin.Denom); balance.LT(coin.Amount) {
+					broken = true
+					msg += fmt.Sprintf("\t%s module holds %s%s, owes %s\n", owed.module, balance, coin.Denom, coin)
+				}
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "module-solvency", fmt.Sprintf(
+			"provider bonds %s, contract escrows %s\n%s", bonds, escrows, msg)), broken
+	}
+}
+
+// ContractEscrowInvariant check the contracts never paid more than their deposit, the settled ones holding nothing in
+// escrow anymore, and the escrow the network stats count is the escrow of the contracts
+func ContractEscrowInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		broken := false
+
+		iter := k.GetContractIterator(ctx)
+		defer iter.Close()
+		for ; iter.Valid(); iter.Next() {
+			var contract types.Contract
+			if err := k.Cdc().Unmarshal(iter.Value(), &contract); err != nil {
+				return sdk.FormatInvariant(types.ModuleName, "contract-escrow", err.Error()), true
+			}
+			switch {
+			case !contract.Paid.IsNil() && !contract.Deposit.IsNil() && contract.Paid.GT(contract.Deposit):
+				broken = true
+				msg += fmt.Sprintf("\tcontract %d paid %s over its deposit %s\n", contract.Id, contract.Paid, contract.Deposit)
+			case contract.TopUpBalance().IsNegative():
+				broken = true
+				msg += fmt.Sprintf("\tcontract %d top-up balance %s is negative\n", contract.Id, contract.TopUpBalance())
+			case contract.SettlementHeight > 0 && contract.TopUpBalance().IsPositive():
+				broken = true
+				msg += fmt.Sprintf("\tcontract %d settled at %d still holds its top-up balance %s\n", contract.Id, contract.SettlementHeight, contract.TopUpBalance())
+			}
+		}
+
+		counted, err := countNetworkStats(ctx, k)
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "contract-escrow", err.Error()), true
+		}
+		stats, err := k.GetNetworkStats(ctx)
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "contract-escrow", err.Error()), true
+		}
+		if !cosmos.Coins(counted.Escrowed).Equal(stats.Escrowed) {
+			broken = true
+			msg += fmt.Sprintf("\tnetwork stats escrow %s, contracts escrow %s\n", cosmos.Coins(stats.Escrowed), cosmos.Coins(counted.Escrowed))
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "contract-escrow", msg), broken
+	}
+}
+
+// NetworkStatsInvariant check the network stats, kept up to date as the providers and contracts are stored, count the
+// providers and contracts stored. It iterates over all of them, it is left to the crisis module and the simulation.
+func NetworkStatsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		counted, err := countNetworkStats(ctx, k)
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "network-stats", err.Error()), true
+		}
+		stats, err := k.GetNetworkStats(ctx)
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "network-stats", err.Error()), true
+		}
+		if !stats.Equals(counted) {
+			return sdk.FormatInvariant(types.ModuleName, "network-stats", fmt.Sprintf(
+				"\tnetwork stats %s, providers and contracts %s\n", &stats, &counted)), true
+		}
+		return sdk.FormatInvariant(types.ModuleName, "network-stats", ""), false
+	}
+}
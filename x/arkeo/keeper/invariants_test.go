@@ -0,0 +1,134 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/arkeonetwork/arkeo/common"
+	"github.com/arkeonetwork/arkeo/common/cosmos"
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+)
+
+func TestInvariants(t *testing.T) {
+	ctx, k, sk := SetupKeeperWithStaking(t)
+	ctx = ctx.WithBlockHeight(10)
+	s := newMsgServer(k, sk)
+
+	requireIntact := func() {
+		t.Helper()
+		for _, invariant := range []sdk.Invariant{ModuleSolvencyInvariant(k), ContractEscrowInvariant(k), NetworkStatsInvariant(k), AllInvariants(k)} {
+			msg, broken := invariant(ctx)
+			require.False(t, broken, msg)
+		}
+	}
+	requireIntact()
+
+	providerPubKey := types.GetRandomPubKey()
+	providerAddress, err := providerPubKey.GetMyAddress()
+	require.NoError(t, err)
+	require.NoError(t, k.MintAndSendToAccount(ctx, providerAddress, getCoin(common.Tokens(10))))
+	require.NoError(t, s.BondProviderHandle(ctx, &types.MsgBondProvider{
+		Creator:  providerAddress.String(),
+		Provider: providerPubKey.String(),
+		Service:  common.BTCService.String(),
+		Bond:     cosmos.NewInt(common.Tokens(10)),
+	}))
+	rates, err := cosmos.ParseCoins("15uarkeo")
+	require.NoError(t, err)
+	require.NoError(t, s.ModProviderHandle(ctx, &types.MsgModProvider{
+		Creator:             providerAddress.String(),
+		Provider:            providerPubKey,
+		Service:             common.BTCService.String(),
+		MinContractDuration: 10,
+		MaxContractDuration: 500,
+		Status:              types.ProviderStatus_ONLINE,
+		PayAsYouGoRate:      rates,
+		SubscriptionRate:    rates,
+		UpdateMask:          types.ModProviderFields,
+	}))
+
+	clientPubKey := types.GetRandomPubKey()
+	clientAddress, err := clientPubKey.GetMyAddress()
+	require.NoError(t, err)
+	require.NoError(t, k.MintAndSendToAccount(ctx, clientAddress, getCoin(common.Tokens(10))))
+	_, err = s.OpenContract(ctx, &types.MsgOpenContract{
+		Provider:         providerPubKey.String(),
+		Service:          common.BTCService.String(),
+		Creator:          clientAddress.String(),
+		Client:           clientPubKey.String(),
+		ContractType:     types.ContractType_SUBSCRIPTION,
+		Duration:         100,
+		Rate:             rates[0],
+		Deposit:          cosmos.NewInt(1500),
+		QueriesPerMinute: 1,
+	})
+	require.NoError(t, err)
+	requireIntact()
+
+	// the module accounts holding more than they owe, the dust of the settlements and anything sent to them, is fine
+	require.NoError(t, k.MintAndSendToAccount(ctx, clientAddress, getCoin(10)))
+	require.NoError(t, k.SendFromAccountToModule(ctx, clientAddress, types.ContractName, getCoins(7)))
+	require.NoError(t, k.SendFromAccountToModule(ctx, clientAddress, types.ProviderName, getCoins(3)))
+	requireIntact()
+
+	contract, err := k.GetActiveContractForUser(ctx, clientPubKey, providerPubKey, common.BTCService)
+	require.NoError(t, err)
+
+	// a bond not backed by the provider module
+	provider, err := k.GetProvider(ctx, providerPubKey, common.BTCService)
+	require.NoError(t, err)
+	bond := provider.Bond
+	provider.Bond = provider.Bond.AddRaw(common.Tokens(1))
+	require.NoError(t, k.SetProvider(ctx, provider))
+	msg, broken := ModuleSolvencyInvariant(k)(ctx)
+	require.True(t, broken)
+	require.Contains(t, msg, types.ProviderName)
+	_, broken = AllInvariants(k)(ctx)
+	require.True(t, broken)
+	provider.Bond = bond
+	require.NoError(t, k.SetProvider(ctx, provider))
+	requireIntact()
+
+	// an escrow not backed by the contract module
+	corrupted := contract
+	corrupted.Deposit = corrupted.Deposit.AddRaw(100)
+	require.NoError(t, k.SetContract(ctx, corrupted))
+	msg, broken = ModuleSolvencyInvariant(k)(ctx)
+	require.True(t, broken)
+	require.Contains(t, msg, types.ContractName)
+	require.NoError(t, k.SetContract(ctx, contract))
+	requireIntact()
+
+	// a contract paid more than its deposit
+	corrupted = contract
+	corrupted.Paid = corrupted.Deposit.AddRaw(1)
+	require.NoError(t, k.SetContract(ctx, corrupted))
+	msg, broken = ContractEscrowInvariant(k)(ctx)
+	require.True(t, broken)
+	require.Contains(t, msg, "over its deposit")
+	require.NoError(t, k.SetContract(ctx, contract))
+	requireIntact()
+
+	// a settled contract still holding its top-up balance
+	corrupted = contract
+	corrupted.SettlementHeight = ctx.BlockHeight()
+	corrupted.TopUp = cosmos.NewInt(10)
+	require.NoError(t, k.SetContract(ctx, corrupted))
+	msg, broken = ContractEscrowInvariant(k)(ctx)
+	require.True(t, broken)
+	require.Contains(t, msg, "still holds its top-up balance")
+	require.NoError(t, k.SetContract(ctx, contract))
+	requireIntact()
+
+	// the network stats drifting from the escrow of the contracts
+	stats, err := k.GetNetworkStats(ctx)
+	require.NoError(t, err)
+	k.SetNetworkStats(ctx, types.NetworkStats{Providers: stats.Providers, ProvidersOnline: stats.ProvidersOnline, Bonded: stats.Bonded, OpenContracts: stats.OpenContracts})
+	msg, broken = ContractEscrowInvariant(k)(ctx)
+	require.True(t, broken)
+	require.Contains(t, msg, "network stats escrow")
+	k.SetNetworkStats(ctx, stats)
+	requireIntact()
+}
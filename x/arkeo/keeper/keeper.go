@@ -34,8 +34,13 @@ func (p dbPrefix) String() string {
 
 type Keeper interface {
 	Logger() log.Logger
+	Hooks() types.ArkeoHooks
 	GetParams(ctx sdk.Context) types.Params
 	SetParams(ctx sdk.Context, params types.Params)
+	GetParamsRecord(ctx cosmos.Context) (types.ParamsRecord, bool, error)
+	SetParamsRecord(ctx cosmos.Context, record types.ParamsRecord)
+	GetNetworkStats(ctx cosmos.Context) (types.NetworkStats, error)
+	SetNetworkStats(ctx cosmos.Context, stats types.NetworkStats)
 	Cdc() codec.BinaryCodec
 	GetComputedVersion(ctx cosmos.Context) int64
 	GetVersion(ctx cosmos.Context) int64
@@ -43,6 +48,7 @@ type Keeper interface {
 	GetKey(ctx cosmos.Context, prefix dbPrefix, key string) string
 	GetVersionForAddress(ctx cosmos.Context, _ cosmos.ValAddress) int64
 	SetVersionForAddress(ctx cosmos.Context, _ cosmos.ValAddress, ver int64)
+	GetValidatorVersions(ctx cosmos.Context) ([]types.ValidatorVersion, error)
 	GetSupply(ctx cosmos.Context, denom string) cosmos.Coin
 	GetBalanceOfModule(ctx cosmos.Context, moduleName, denom string) cosmos.Int
 	SendFromModuleToModule(ctx cosmos.Context, from, to string, coin cosmos.Coins) error
@@ -69,10 +75,17 @@ type Keeper interface {
 	// Query
 	Params(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error)
 	FetchProvider(c context.Context, req *types.QueryFetchProviderRequest) (*types.QueryFetchProviderResponse, error)
+	ProviderEarnings(c context.Context, req *types.QueryProviderEarningsRequest) (*types.QueryProviderEarningsResponse, error)
 	ProviderAll(c context.Context, req *types.QueryAllProviderRequest) (*types.QueryAllProviderResponse, error)
+	Providers(c context.Context, req *types.QueryProvidersRequest) (*types.QueryProvidersResponse, error)
 	FetchContract(c context.Context, req *types.QueryFetchContractRequest) (*types.QueryFetchContractResponse, error)
+	ContractSettlementPreview(c context.Context, req *types.QueryContractSettlementPreviewRequest) (*types.QueryContractSettlementPreviewResponse, error)
+	ClaimableIncome(c context.Context, req *types.QueryClaimableIncomeRequest) (*types.QueryClaimableIncomeResponse, error)
 	ContractAll(c context.Context, req *types.QueryAllContractRequest) (*types.QueryAllContractResponse, error)
 	ActiveContract(goCtx context.Context, req *types.QueryActiveContractRequest) (*types.QueryActiveContractResponse, error)
+	ContractsByProvider(c context.Context, req *types.QueryContractsByProviderRequest) (*types.QueryContractsByProviderResponse, error)
+	ContractsByOwner(c context.Context, req *types.QueryContractsByOwnerRequest) (*types.QueryContractsByOwnerResponse, error)
+	NetworkStats(c context.Context, req *types.QueryNetworkStatsRequest) (*types.QueryNetworkStatsResponse, error)
 
 	// Keeper Interfaces
 	KeeperProvider
@@ -84,7 +97,13 @@ type KeeperProvider interface {
 	GetProvider(_ cosmos.Context, _ common.PubKey, _ common.Service) (types.Provider, error)
 	SetProvider(_ cosmos.Context, _ types.Provider) error
 	ProviderExists(_ cosmos.Context, _ common.PubKey, _ common.Service) bool
-	RemoveProvider(_ cosmos.Context, _ common.PubKey, _ common.Service)
+	RemoveProvider(_ cosmos.Context, _ common.PubKey, _ common.Service) error
+	GetProviderEarningsIterator(_ cosmos.Context) cosmos.Iterator
+	GetProviderEarnings(_ cosmos.Context, _ common.PubKey, _ common.Service) (types.ProviderEarnings, error)
+	SetProviderEarnings(_ cosmos.Context, _ types.ProviderEarnings) error
+	GetProviderAllowlistIterator(_ cosmos.Context) cosmos.Iterator
+	GetProviderAllowlist(_ cosmos.Context, _ common.PubKey, _ common.Service) (types.ProviderAllowlist, error)
+	SetProviderAllowlist(_ cosmos.Context, _ types.ProviderAllowlist) error
 }
 
 type KeeperContract interface {
@@ -92,12 +111,27 @@ type KeeperContract interface {
 	GetContract(_ cosmos.Context, _ uint64) (types.Contract, error)
 	SetContract(_ cosmos.Context, _ types.Contract) error
 	ContractExists(_ cosmos.Context, _ uint64) bool
-	RemoveContract(_ cosmos.Context, _ uint64)
+	RemoveContract(_ cosmos.Context, _ uint64) error
 	GetContractExpirationSetIterator(_ cosmos.Context) cosmos.Iterator
 	GetUserContractSetIterator(_ cosmos.Context) cosmos.Iterator
 	GetContractExpirationSet(_ cosmos.Context, _ int64) (types.ContractExpirationSet, error)
 	SetContractExpirationSet(_ cosmos.Context, _ types.ContractExpirationSet) error
 	RemoveContractExpirationSet(_ cosmos.Context, _ int64)
+	AddToContractExpirationSet(_ cosmos.Context, _ int64, _ uint64) error
+	RemoveFromContractExpirationSet(_ cosmos.Context, _ int64, _ uint64) error
+	GetPayAsYouGoExpirationSetIterator(_ cosmos.Context) cosmos.Iterator
+	GetPayAsYouGoExpirationSet(_ cosmos.Context, _ int64) (types.ContractExpirationSet, error)
+	SetPayAsYouGoExpirationSet(_ cosmos.Context, _ types.ContractExpirationSet) error
+	RemovePayAsYouGoExpirationSet(_ cosmos.Context, _ int64)
+	AddToPayAsYouGoExpirationSet(_ cosmos.Context, _ int64, _ uint64) error
+	RemoveFromPayAsYouGoExpirationSet(_ cosmos.Context, _ int64, _ uint64) error
+	GetContractStartSetIterator(_ cosmos.Context) cosmos.Iterator
+	GetContractStartSet(_ cosmos.Context, _ int64) (types.ContractExpirationSet, error)
+	SetContractStartSet(_ cosmos.Context, _ types.ContractExpirationSet) error
+	RemoveContractStartSet(_ cosmos.Context, _ int64)
+	AddToContractStartSet(_ cosmos.Context, _ int64, _ uint64) error
+	RemoveFromContractStartSet(_ cosmos.Context, _ int64, _ uint64) error
+	AddToUserContractSet(ctx cosmos.Context, user common.PubKey, contractId uint64) error
 	RemoveFromUserContractSet(ctx cosmos.Context, user common.PubKey, contractId uint64) error
 	GetNextContractId(_ cosmos.Context) uint64
 	SetNextContractId(ctx cosmos.Context, contractId uint64)
@@ -114,6 +148,14 @@ const (
 	prefixContractNextId        dbPrefix = "cni/"
 	prefixContractExpirationSet dbPrefix = "ces/"
 	prefixUserContractSet       dbPrefix = "ucs/"
+	prefixProviderContract      dbPrefix = "pc/"
+	prefixProviderEarnings      dbPrefix = "pe/"
+	prefixParamsRecord          dbPrefix = "pr/"
+	prefixPaygExpirationSet     dbPrefix = "pxs/"
+	prefixServiceProvider       dbPrefix = "sp/"
+	prefixContractStartSet      dbPrefix = "css/"
+	prefixProviderAllowlist     dbPrefix = "pal/"
+	prefixNetworkStats          dbPrefix = "ns/"
 )
 
 type KVStore struct {
@@ -128,6 +170,7 @@ type KVStore struct {
 	logger             log.Logger
 	mintKeeper         minttypes.Keeper
 	distributionKeeper distkeeper.Keeper
+	hooks              types.ArkeoHooks
 }
 
 func NewKVStore(
@@ -163,6 +206,23 @@ func NewKVStore(
 	}
 }
 
+// SetHooks set the arkeo hooks, only once
+func (k *KVStore) SetHooks(hooks types.ArkeoHooks) *KVStore {
+	if k.hooks != nil {
+		panic("cannot set arkeo hooks twice")
+	}
+	k.hooks = hooks
+	return k
+}
+
+// Hooks return the arkeo hooks, none when they were never set
+func (k KVStore) Hooks() types.ArkeoHooks {
+	if k.hooks == nil {
+		return types.MultiArkeoHooks{}
+	}
+	return k.hooks
+}
+
 func (k KVStore) Logger() log.Logger {
 	return k.logger.With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
@@ -179,7 +239,9 @@ func (k KVStore) Cdc() codec.BinaryCodec {
 
 // GetParams get all parameters as types.Params
 func (k KVStore) GetParams(ctx sdk.Context) types.Params {
-	return types.NewParams()
+	params := types.NewParams()
+	k.paramstore.GetParamSetIfExists(ctx, &params)
+	return params
 }
 
 // SetParams set the params
@@ -187,9 +249,29 @@ func (k KVStore) SetParams(ctx sdk.Context, params types.Params) {
 	k.paramstore.SetParamSet(ctx, &params)
 }
 
+// GetParamsRecord get the params as the end blocker last saw them, false when it never did
+func (k KVStore) GetParamsRecord(ctx cosmos.Context) (types.ParamsRecord, bool, error) {
+	var record types.ParamsRecord
+	key := k.GetKey(ctx, prefixParamsRecord, "")
+	store := ctx.KVStore(k.storeKey)
+	if !store.Has([]byte(key)) {
+		return record, false, nil
+	}
+	err := k.cdc.Unmarshal(store.Get([]byte(key)), &record)
+	return record, true, err
+}
+
+// SetParamsRecord save the params as the end blocker saw them
+func (k KVStore) SetParamsRecord(ctx cosmos.Context, record types.ParamsRecord) {
+	key := k.GetKey(ctx, prefixParamsRecord, "")
+	store := ctx.KVStore(k.storeKey)
+	store.Set([]byte(key), k.cdc.MustMarshal(&record))
+}
+
 // TODO: Check Thi Again
 func (k KVStore) GetComputedVersion(ctx cosmos.Context) int64 {
-	versions := make(map[int64]int64) // maps are safe in blockchains, but should be okay in this case
+	// the map is only looked up, never ranged over, the validators are walked in the order of their power
+	versions := make(map[int64]int64)
 	validators, err := k.stakingKeeper.GetBondedValidatorsByPower(ctx)
 	if err != nil {
 		ctx.Logger().Error(fmt.Sprintf("get Bonded Validator error :%s ", err.Error()))
@@ -273,6 +355,28 @@ func (k KVStore) GetVersionForAddress(ctx cosmos.Context, addr cosmos.ValAddress
 	return ver.Value
 }
 
+// GetValidatorVersions get the versions set for the validators, by address
+func (k KVStore) GetValidatorVersions(ctx cosmos.Context) ([]types.ValidatorVersion, error) {
+	// the store version is kept under the prefix of the validator versions
+	prefix := k.GetKey(ctx, prefixVersion, "")
+	iter := k.getIterator(ctx, dbPrefix(prefix))
+	defer iter.Close()
+
+	var versions []types.ValidatorVersion
+	for ; iter.Valid(); iter.Next() {
+		address := strings.TrimPrefix(string(iter.Key()), prefix)
+		if address == "" {
+			continue
+		}
+		var ver types.ProtoInt64
+		if err := k.cdc.Unmarshal(iter.Value(), &ver); err != nil {
+			return nil, err
+		}
+		versions = append(versions, types.ValidatorVersion{Address: strings.ToLower(address), Version: ver.Value})
+	}
+	return versions, nil
+}
+
 // getIterator - get an iterator for given prefix
 func (k KVStore) getIterator(ctx cosmos.Context, prefix dbPrefix) cosmos.Iterator {
 	store := ctx.KVStore(k.storeKey)
@@ -31,10 +31,23 @@ func (k KVStoreDummy) GetParams(ctx sdk.Context) types.Params {
 func (k KVStoreDummy) SetParams(ctx sdk.Context, params types.Params) {}
 func (k KVStoreDummy) CoinKeeper() bankkeeper.Keeper                  { return bankkeeper.BaseKeeper{} }
 func (k KVStoreDummy) AccountKeeper() authkeeper.AccountKeeper        { return authkeeper.AccountKeeper{} }
+func (k KVStoreDummy) Hooks() types.ArkeoHooks                        { return types.MultiArkeoHooks{} }
 func (k KVStoreDummy) Logger(ctx cosmos.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
 
+func (k KVStoreDummy) GetParamsRecord(ctx cosmos.Context) (types.ParamsRecord, bool, error) {
+	return types.ParamsRecord{}, false, kaboom
+}
+
+func (k KVStoreDummy) SetParamsRecord(ctx cosmos.Context, record types.ParamsRecord) {}
+
+func (k KVStoreDummy) GetNetworkStats(ctx cosmos.Context) (types.NetworkStats, error) {
+	return types.NetworkStats{}, kaboom
+}
+
+func (k KVStoreDummy) SetNetworkStats(ctx cosmos.Context, stats types.NetworkStats) {}
+
 func (k KVStoreDummy) GetKey(_ cosmos.Context, prefix dbPrefix, key string) string {
 	return fmt.Sprintf("%s/1/%s", prefix, key)
 }
@@ -114,8 +127,18 @@ func (k KVStoreDummy) SetProvider(_ cosmos.Context, _ types.Provider) error { re
 func (k KVStoreDummy) ProviderExists(_ cosmos.Context, _ common.PubKey, _ common.Service) bool {
 	return false
 }
-func (k KVStoreDummy) RemoveProvider(_ cosmos.Context, _ common.PubKey, _ common.Service) {}
-func (k KVStoreDummy) GetContractIterator(_ cosmos.Context) cosmos.Iterator               { return nil }
+func (k KVStoreDummy) RemoveProvider(_ cosmos.Context, _ common.PubKey, _ common.Service) error {
+	return kaboom
+}
+
+func (k KVStoreDummy) GetProviderAllowlistIterator(_ cosmos.Context) cosmos.Iterator { return nil }
+func (k KVStoreDummy) GetProviderAllowlist(_ cosmos.Context, _ common.PubKey, _ common.Service) (types.ProviderAllowlist, error) {
+	return types.ProviderAllowlist{}, kaboom
+}
+func (k KVStoreDummy) SetProviderAllowlist(_ cosmos.Context, _ types.ProviderAllowlist) error {
+	return kaboom
+}
+func (k KVStoreDummy) GetContractIterator(_ cosmos.Context) cosmos.Iterator { return nil }
 func (k KVStoreDummy) GetContract(_ cosmos.Context, _ common.PubKey, _ common.Service, _ common.PubKey) (types.Contract, error) {
 	return types.Contract{}, kaboom
 }
@@ -124,7 +147,8 @@ func (k KVStoreDummy) ContractExists(_ cosmos.Context, _ common.PubKey, _ common
 	return false
 }
 
-func (k KVStoreDummy) RemoveContract(_ cosmos.Context, _ common.PubKey, _ common.Service, _ common.PubKey) {
+func (k KVStoreDummy) RemoveContract(_ cosmos.Context, _ common.PubKey, _ common.Service, _ common.PubKey) error {
+	return kaboom
 }
 
 func (k KVStoreDummy) GetContractExpirationSetIterator(_ cosmos.Context) cosmos.Iterator { return nil }
@@ -137,6 +161,50 @@ func (k KVStoreDummy) SetContractExpirationSet(_ cosmos.Context, _ types.Contrac
 }
 func (k KVStoreDummy) RemoveContractExpirationSet(_ cosmos.Context, _ int64) {}
 
+func (k KVStoreDummy) GetPayAsYouGoExpirationSetIterator(_ cosmos.Context) cosmos.Iterator {
+	return nil
+}
+
+func (k KVStoreDummy) GetPayAsYouGoExpirationSet(_ cosmos.Context, _ int64) (types.ContractExpirationSet, error) {
+	return types.ContractExpirationSet{}, kaboom
+}
+
+func (k KVStoreDummy) SetPayAsYouGoExpirationSet(_ cosmos.Context, _ types.ContractExpirationSet) error {
+	return kaboom
+}
+
+func (k KVStoreDummy) RemovePayAsYouGoExpirationSet(_ cosmos.Context, _ int64) {}
+
+func (k KVStoreDummy) AddToPayAsYouGoExpirationSet(_ cosmos.Context, _ int64, _ uint64) error {
+	return kaboom
+}
+
+func (k KVStoreDummy) GetContractStartSetIterator(_ cosmos.Context) cosmos.Iterator {
+	return nil
+}
+
+func (k KVStoreDummy) GetContractStartSet(_ cosmos.Context, _ int64) (types.ContractExpirationSet, error) {
+	return types.ContractExpirationSet{}, kaboom
+}
+
+func (k KVStoreDummy) SetContractStartSet(_ cosmos.Context, _ types.ContractExpirationSet) error {
+	return kaboom
+}
+
+func (k KVStoreDummy) RemoveContractStartSet(_ cosmos.Context, _ int64) {}
+
+func (k KVStoreDummy) AddToContractStartSet(_ cosmos.Context, _ int64, _ uint64) error {
+	return kaboom
+}
+
+func (k KVStoreDummy) RemoveFromContractStartSet(_ cosmos.Context, _ int64, _ uint64) error {
+	return kaboom
+}
+
+func (k KVStoreDummy) RemoveFromPayAsYouGoExpirationSet(_ cosmos.Context, _ int64, _ uint64) error {
+	return kaboom
+}
+
 func (k KVStoreDummy) Params(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
 	return nil, kaboom
 }
@@ -149,10 +217,22 @@ func (k KVStoreDummy) ProviderAll(c context.Context, req *types.QueryAllProvider
 	return nil, kaboom
 }
 
+func (k KVStoreDummy) Providers(c context.Context, req *types.QueryProvidersRequest) (*types.QueryProvidersResponse, error) {
+	return nil, kaboom
+}
+
 func (k KVStoreDummy) FetchContract(c context.Context, req *types.QueryFetchContractRequest) (*types.QueryFetchContractResponse, error) {
 	return nil, kaboom
 }
 
+func (k KVStoreDummy) ContractSettlementPreview(c context.Context, req *types.QueryContractSettlementPreviewRequest) (*types.QueryContractSettlementPreviewResponse, error) {
+	return nil, kaboom
+}
+
+func (k KVStoreDummy) ClaimableIncome(c context.Context, req *types.QueryClaimableIncomeRequest) (*types.QueryClaimableIncomeResponse, error) {
+	return nil, kaboom
+}
+
 func (k KVStoreDummy) ContractAll(c context.Context, req *types.QueryAllContractRequest) (*types.QueryAllContractResponse, error) {
 	return nil, kaboom
 }
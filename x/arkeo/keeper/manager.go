@@ -87,6 +87,9 @@ func (mgr Manager) EndBlock(ctx cosmos.Context) error {
 	if err := mgr.ContractEndBlock(ctx); err != nil {
 		mgr.keeper.Logger().Error("unable to settle contracts", "error", err)
 	}
+	if err := mgr.ParamsEndBlock(ctx); err != nil {
+		mgr.keeper.Logger().Error("unable to track params changes", "error", err)
+	}
 
 	// invariant checks
 	if err := mgr.invariantBondModule(ctx); err != nil {
@@ -143,7 +146,7 @@ func (mgr Manager) invariantContractModule(ctx cosmos.Context) error {
 		if contract.IsSettled(ctx.BlockHeight()) {
 			continue
 		}
-		sums = sums.Add(cosmos.NewCoin(contract.Rate.Denom, contract.Deposit.Sub(contract.Paid)))
+		sums = sums.Add(cosmos.NewCoin(contract.Rate.Denom, contract.Deposit.Sub(contract.Paid).Add(contract.TopUpBalance())))
 	}
 
 	for _, sum := range sums {
@@ -172,6 +175,13 @@ func (mgr Manager) invariantMaxSupply(ctx cosmos.Context) error {
 }
 
 func (mgr Manager) ContractEndBlock(ctx cosmos.Context) error {
+	if err := mgr.activateContracts(ctx); err != nil {
+		return err
+	}
+	if err := mgr.expirePayAsYouGoContracts(ctx); err != nil {
+		return err
+	}
+
 	set, err := mgr.keeper.GetContractExpirationSet(ctx, ctx.BlockHeight())
 	if err != nil {
 		return err
@@ -181,26 +191,204 @@ func (mgr Manager) ContractEndBlock(ctx cosmos.Context) error {
 		return nil
 	}
 
-	for _, contractId := range set.ContractSet.ContractIds {
+	// the contracts settle in the order of their ids, the settlements and their events are the same on every node
+	var failed []uint64
+	for _, contractId := range set.SortedContractIds() {
 		contract, err := mgr.keeper.GetContract(ctx, contractId)
 		if err != nil {
 			ctx.Logger().Error("unable to fetch contract", "id", contractId, "error", err)
 			continue
 		}
-		if contract.Client.IsEmpty() {
+		// contracts settled early are removed from their set, those indexed before are skipped
+		if contract.Client.IsEmpty() || contract.SettlementHeight > 0 {
 			continue
 		}
 
-		_, err = mgr.SettleContract(ctx, contract, 0, true)
+		// an auto-renewed subscription is extended out of its top-up balance, it settles once the balance is exhausted
+		if contract.AutoRenew && contract.Expiration() == ctx.BlockHeight() {
+			cacheCtx, commit := ctx.CacheContext()
+			renewed, err := mgr.autoRenewContract(cacheCtx, contract)
+			if err != nil {
+				ctx.Logger().Error("unable to auto-renew contract", "id", contractId, "error", err)
+			}
+			if err == nil && renewed {
+				commit()
+				continue
+			}
+		}
+
+		// the contracts without a settlement period expire as they settle, the others expired already
+		if !contract.SettlesAfterExpiration() && contract.Expiration() == ctx.BlockHeight() {
+			if err := mgr.EmitContractExpiredEvent(ctx, &contract); err != nil {
+				return err
+			}
+		}
+
+		// a contract failing to settle, a hook aborting it included, is left as it was and settled again on the next
+		// block
+		cacheCtx, commit := ctx.CacheContext()
+		_, err = mgr.SettleContract(cacheCtx, contract, 0, true)
 		if err != nil {
 			ctx.Logger().Error("unable to settle contract", "id", contractId, "error", err)
+			failed = append(failed, contractId)
 			continue
 		}
+		commit()
+	}
+
+	// the contracts of this height are settled, the set is never read again
+	mgr.keeper.RemoveContractExpirationSet(ctx, ctx.BlockHeight())
+	for _, contractId := range failed {
+		if err := mgr.keeper.AddToContractExpirationSet(ctx, ctx.BlockHeight()+1, contractId); err != nil {
+			return err
+		}
 	}
 
 	return nil
 }
 
+// autoRenewContract extend the expiring subscription out of its top-up balance, for as long as the provider allows. It
+// isn't renewed, false returned, when the balance doesn't afford a block or the provider no longer serves it at its rate
+func (mgr Manager) autoRenewContract(ctx cosmos.Context, contract types.Contract) (bool, error) {
+	provider, err := mgr.keeper.GetProvider(ctx, contract.Provider, contract.Service)
+	if err != nil {
+		return false, err
+	}
+	if provider.Status != types.ProviderStatus_ONLINE {
+		return false, nil
+	}
+	// the client agreed to the rate the contract runs at only, the provider changing it ends the renewals
+	if !cosmos.NewCoins(provider.SubscriptionRate...).AmountOf(contract.Rate.Denom).Equal(contract.Rate.Amount) {
+		return false, nil
+	}
+
+	oldExpiration := contract.Expiration()
+	contract, spent, err := mgr.extendContract(ctx, contract, provider.MaxContractDuration)
+	if err != nil {
+		return false, err
+	}
+	if spent.IsZero() {
+		return false, nil
+	}
+	if err := mgr.keeper.SetContract(ctx, contract); err != nil {
+		return false, err
+	}
+	return true, mgr.EmitContractAutoRenewedEvent(ctx, oldExpiration, spent, &contract)
+}
+
+// extendContract extend the subscription by as many blocks as its top-up balance affords, maxBlocks at most, moving
+// their cost from the balance to the deposit escrowed for the provider. The contract is indexed by its new expiration,
+// it is left for the caller to save.
+func (mgr Manager) extendContract(ctx cosmos.Context, contract types.Contract, maxBlocks int64) (types.Contract, cosmos.Int, error) {
+	cost := contract.BlockCost()
+	if !cost.IsPositive() || maxBlocks <= 0 {
+		return contract, cosmos.ZeroInt(), nil
+	}
+	balance := contract.TopUpBalance()
+	blocks := sdkmath.MinInt(balance.Quo(cost), cosmos.NewInt(maxBlocks))
+	if !blocks.IsPositive() {
+		return contract, cosmos.ZeroInt(), nil
+	}
+	spent := cost.Mul(blocks)
+
+	if err := mgr.keeper.RemoveFromContractExpirationSet(ctx, contract.SettlementPeriodEnd(), contract.Id); err != nil {
+		return contract, cosmos.ZeroInt(), err
+	}
+	contract.Duration += blocks.Int64()
+	contract.Deposit = contract.Deposit.Add(spent)
+	contract.TopUp = balance.Sub(spent)
+	if err := mgr.keeper.AddToContractExpirationSet(ctx, contract.SettlementPeriodEnd(), contract.Id); err != nil {
+		return contract, cosmos.ZeroInt(), err
+	}
+
+	earnings, err := mgr.keeper.GetProviderEarnings(ctx, contract.Provider, contract.Service)
+	if err != nil {
+		return contract, cosmos.ZeroInt(), err
+	}
+	earnings.Escrow(cosmos.NewCoin(contract.Rate.Denom, spent))
+	if err := mgr.keeper.SetProviderEarnings(ctx, earnings); err != nil {
+		return contract, cosmos.ZeroInt(), err
+	}
+	return contract, spent, nil
+}
+
+// activateContracts emit the start of the scheduled contracts starting at this height, those closed before they started
+// are skipped
+func (mgr Manager) activateContracts(ctx cosmos.Context) error {
+	set, err := mgr.keeper.GetContractStartSet(ctx, ctx.BlockHeight())
+	if err != nil {
+		return err
+	}
+
+	for _, contractId := range set.SortedContractIds() {
+		contract, err := mgr.keeper.GetContract(ctx, contractId)
+		if err != nil {
+			ctx.Logger().Error("unable to fetch contract", "id", contractId, "error", err)
+			continue
+		}
+		if contract.IsEmpty() || contract.SettlementHeight > 0 || contract.StartHeight != ctx.BlockHeight() {
+			continue
+		}
+		if err := mgr.EmitContractActivatedEvent(ctx, &contract); err != nil {
+			return err
+		}
+	}
+
+	mgr.keeper.RemoveContractStartSet(ctx, ctx.BlockHeight())
+	return nil
+}
+
+// expirePayAsYouGoContracts emit the expiration of the pay-as-you-go contracts expiring at this height, their
+// settlement comes at the end of their settlement period
+func (mgr Manager) expirePayAsYouGoContracts(ctx cosmos.Context) error {
+	set, err := mgr.keeper.GetPayAsYouGoExpirationSet(ctx, ctx.BlockHeight())
+	if err != nil {
+		return err
+	}
+
+	for _, contractId := range set.SortedContractIds() {
+		contract, err := mgr.keeper.GetContract(ctx, contractId)
+		if err != nil {
+			ctx.Logger().Error("unable to fetch contract", "id", contractId, "error", err)
+			continue
+		}
+		if contract.IsEmpty() || contract.SettlementHeight > 0 || contract.Expiration() != ctx.BlockHeight() {
+			continue
+		}
+		if err := mgr.EmitContractExpiredEvent(ctx, &contract); err != nil {
+			return err
+		}
+	}
+
+	mgr.keeper.RemovePayAsYouGoExpirationSet(ctx, ctx.BlockHeight())
+	return nil
+}
+
+// ParamsEndBlock compare the params to the ones last seen, whatever changed them (governance or an upgrade), and
+// emit the changes. The params seen for the first time are only recorded.
+func (mgr Manager) ParamsEndBlock(ctx cosmos.Context) error {
+	params := mgr.keeper.GetParams(ctx)
+	record, found, err := mgr.keeper.GetParamsRecord(ctx)
+	if err != nil {
+		return err
+	}
+	if !found {
+		mgr.keeper.SetParamsRecord(ctx, types.ParamsRecord{Params: params})
+		return nil
+	}
+
+	changes, err := params.Changes(record.Params)
+	if err != nil {
+		return err
+	}
+	if len(changes) == 0 {
+		return nil
+	}
+
+	mgr.keeper.SetParamsRecord(ctx, types.ParamsRecord{Params: params, LastChangeHeight: ctx.BlockHeight()})
+	return mgr.EmitParamsUpdatedEvent(ctx, changes)
+}
+
 // This function pays out rewards to validators.
 // TODO: the method of accomplishing this is admittedly quite inefficient. The
 // better approach would be to track live allocation via assigning "units" to
@@ -355,37 +543,75 @@ func (mgr Manager) FetchConfig(ctx cosmos.Context, name configs.ConfigName) int6
 
 // any owed debt is paid to data provider
 func (mgr Manager) SettleContract(ctx cosmos.Context, contract types.Contract, nonce int64, isFinal bool) (types.Contract, error) {
-	if nonce > contract.Nonce {
-		contract.Nonce = nonce
-	}
-	totalDebt, err := mgr.contractDebt(ctx, contract)
-	valIncome := common.GetSafeShare(cosmos.NewDec(mgr.FetchConfig(ctx, configs.ReserveTax)), cosmos.NewDec(configs.MaxBasisPoints), totalDebt.ToLegacyDec())
-	debt := totalDebt.Sub(valIncome.RoundInt())
+	contract, settlement, err := contractSettlement(ctx, contract, nonce, mgr.FetchConfig(ctx, configs.ReserveTax), isFinal)
 	if err != nil {
 		return contract, err
 	}
-	if !debt.IsZero() {
+	return mgr.settle(ctx, contract, settlement)
+}
+
+// SettleEarlyClose settle the subscription its client closes before its expiration, the deposit left once the blocks
+// served are paid split along the early close params
+func (mgr Manager) SettleEarlyClose(ctx cosmos.Context, contract types.Contract) (types.Contract, earlyCloseSplit, error) {
+	reserveTax := mgr.FetchConfig(ctx, configs.ReserveTax)
+	contract, settlement, err := contractSettlement(ctx, contract, 0, reserveTax, true)
+	if err != nil {
+		return contract, earlyCloseSplit{}, err
+	}
+	split := splitEarlyClose(contract, ctx.BlockHeight(), settlement.Refund.Amount, mgr.keeper.GetParams(ctx))
+
+	// the compensation is income of the provider, taxed as the blocks served are, the dust of the split joins the
+	// dust of the tax
+	owed := settlement.Owed.Amount.Add(split.compensation)
+	income, tax, dust := splitReserveTax(owed, reserveTax)
+	settlement.Owed.Amount = owed.Add(split.dust)
+	settlement.ProviderIncome.Amount = income
+	settlement.ReserveTax.Amount = tax
+	settlement.Dust.Amount = dust.Add(split.dust)
+	settlement.Refund.Amount = split.refund
+
+	contract, err = mgr.settle(ctx, contract, settlement)
+	return contract, split, err
+}
+
+// settle pay the settlement of the contract, the deposit left refunded when it is final
+func (mgr Manager) settle(ctx cosmos.Context, contract types.Contract, settlement types.ContractSettlement) (types.Contract, error) {
+	isFinal := settlement.Final
+	totalDebt, debt := settlement.Owed.Amount, settlement.ProviderIncome.Amount
+	if debt.IsPositive() {
 		provider, err := contract.Provider.GetMyAddress()
 		if err != nil {
 			return contract, err
 		}
-		if err := mgr.keeper.SendFromModuleToAccount(ctx, types.ContractName, provider, cosmos.NewCoins(cosmos.NewCoin(contract.Rate.Denom, debt))); err != nil {
+		if err := mgr.keeper.SendFromModuleToAccount(ctx, types.ContractName, provider, cosmos.NewCoins(settlement.ProviderIncome)); err != nil {
 			return contract, err
 		}
-		if err := mgr.keeper.SendFromModuleToModule(ctx, types.ContractName, types.ModuleName, cosmos.NewCoins(cosmos.NewCoin(contract.Rate.Denom, valIncome.RoundInt()))); err != nil {
+	}
+	// the tax and the dust are paid even when the provider income rounds down to nothing
+	if reserve := settlement.ReserveTax.Amount.Add(settlement.Dust.Amount); reserve.IsPositive() {
+		if err := mgr.keeper.SendFromModuleToModule(ctx, types.ContractName, types.ModuleName, cosmos.NewCoins(cosmos.NewCoin(contract.Rate.Denom, reserve))); err != nil {
 			return contract, err
 		}
 	}
 
+	earnings, err := mgr.keeper.GetProviderEarnings(ctx, contract.Provider, contract.Service)
+	if err != nil {
+		return contract, err
+	}
+	earnings.Earn(cosmos.NewCoin(contract.Rate.Denom, debt))
+	earnings.Release(cosmos.NewCoin(contract.Rate.Denom, totalDebt))
+
 	contract.Paid = contract.Paid.Add(totalDebt)
 	if isFinal {
-		remainder := contract.Deposit.Sub(contract.Paid)
+		remainder := settlement.Refund.Amount
+		earnings.Release(cosmos.NewCoin(contract.Rate.Denom, remainder))
+		earnings.SettledContracts++
+		if err := mgr.releaseProviderSlot(ctx, contract); err != nil {
+			return contract, err
+		}
 		if !remainder.IsZero() {
-			client, err := contract.Client.GetMyAddress()
-			if err != nil {
-				return contract, err
-			}
-			if err := mgr.keeper.SendFromModuleToAccount(ctx, types.ContractName, client, cosmos.NewCoins(cosmos.NewCoin(contract.Rate.Denom, remainder))); err != nil {
+			// the deposit goes back to whoever funded it, the payer of a sponsored contract
+			if err := mgr.keeper.SendFromModuleToAccount(ctx, types.ContractName, contract.RefundAddress(), cosmos.NewCoins(cosmos.NewCoin(contract.Rate.Denom, remainder))); err != nil {
 				return contract, err
 			}
 			// now that the user has some of their funds refunded, the deposit
@@ -394,12 +620,39 @@ func (mgr Manager) SettleContract(ctx cosmos.Context, contract types.Contract, n
 			// space
 			contract.Deposit = contract.Paid
 		}
+		// the top-up balance was never part of the deposit, it goes back to the client as a whole
+		if topUp := contract.TopUpBalance(); topUp.IsPositive() {
+			client, err := contract.Client.GetMyAddress()
+			if err != nil {
+				return contract, err
+			}
+			if err := mgr.keeper.SendFromModuleToAccount(ctx, types.ContractName, client, cosmos.NewCoins(cosmos.NewCoin(contract.Rate.Denom, topUp))); err != nil {
+				return contract, err
+			}
+			contract.TopUp = cosmos.ZeroInt()
+		}
 		contract.SettlementHeight = ctx.BlockHeight()
 		// this contract can now be removed from the users list of contracts
 		err = mgr.keeper.RemoveFromUserContractSet(ctx, contract.GetSpender(), contract.Id)
 		if err != nil {
 			return contract, err
 		}
+		// the client of a delegated contract has it in its set as well
+		if !contract.GetSpender().Equals(contract.Client) {
+			clientSet, err := mgr.keeper.GetUserContractSet(ctx, contract.Client)
+			if err != nil {
+				return contract, err
+			}
+			if clientSet.Contains(contract.Id) {
+				if err := mgr.keeper.RemoveFromUserContractSet(ctx, contract.Client, contract.Id); err != nil {
+					return contract, err
+				}
+			}
+		}
+	}
+
+	if err := mgr.keeper.SetProviderEarnings(ctx, earnings); err != nil {
+		return contract, err
 	}
 
 	err = mgr.keeper.SetContract(ctx, contract)
@@ -407,14 +660,185 @@ func (mgr Manager) SettleContract(ctx cosmos.Context, contract types.Contract, n
 		return contract, err
 	}
 
-	if err = mgr.EmitContractSettlementEvent(ctx, totalDebt, valIncome.RoundInt(), &contract); err != nil {
+	if isFinal {
+		if err := mgr.keeper.Hooks().AfterContractSettled(ctx, contract, settlement); err != nil {
+			return contract, err
+		}
+	}
+
+	if err = mgr.EmitContractSettlementEvent(ctx, settlement, &contract); err != nil {
 		return contract, err
 	}
 
 	return contract, nil
 }
 
-func (mgr Manager) contractDebt(ctx cosmos.Context, contract types.Contract) (cosmos.Int, error) {
+// SlashProvider slash the bond of the provider of the contract for a fault, to the reserve. The slashed fraction
+// grows with each fault of the provider
+func (mgr Manager) SlashProvider(ctx cosmos.Context, contract types.Contract, reason string) error {
+	provider, err := mgr.keeper.GetProvider(ctx, contract.Provider, contract.Service)
+	if err != nil {
+		return err
+	}
+
+	params := mgr.keeper.GetParams(ctx)
+	fraction := params.SlashFraction + provider.Faults*params.SlashEscalation
+	if fraction > configs.MaxBasisPoints {
+		fraction = configs.MaxBasisPoints
+	}
+	amount := provider.Bond.MulRaw(fraction).QuoRaw(configs.MaxBasisPoints)
+	if amount.IsPositive() {
+		if err := mgr.keeper.SendFromModuleToModule(ctx, types.ProviderName, types.ReserveName, cosmos.NewCoins(cosmos.NewCoin(configs.Denom, amount))); err != nil {
+			return err
+		}
+		provider.Bond = provider.Bond.Sub(amount)
+	}
+	provider.Faults++
+	// the slash can leave an online provider below the minimum bond of its service
+	if err := mgr.demoteUnderbondedProvider(ctx, &provider); err != nil {
+		return err
+	}
+	if err := mgr.keeper.SetProvider(ctx, provider); err != nil {
+		return err
+	}
+
+	ctx.Logger().Info("slashed provider", "provider", provider.PubKey, "service", provider.Service, "reason", reason, "amount", amount, "faults", provider.Faults)
+	return mgr.EmitSlashProviderEvent(ctx, reason, amount, &contract, &provider)
+}
+
+// minProviderBond returns the bond a provider of the service needs at least to be online
+func (mgr Manager) minProviderBond(ctx cosmos.Context, service common.Service) cosmos.Int {
+	minBond := mgr.keeper.GetParams(ctx).MinBond(service)
+	if minBond == 0 {
+		minBond = mgr.FetchConfig(ctx, configs.MinProviderBond)
+	}
+	return cosmos.NewInt(minBond)
+}
+
+// demoteUnderbondedProvider set offline the online provider left with a bond below the minimum of its service
+func (mgr Manager) demoteUnderbondedProvider(ctx cosmos.Context, provider *types.Provider) error {
+	if provider.Status != types.ProviderStatus_ONLINE {
+		return nil
+	}
+	minBond := mgr.minProviderBond(ctx, provider.Service)
+	if provider.Bond.GTE(minBond) {
+		return nil
+	}
+	provider.Status = types.ProviderStatus_OFFLINE
+	return mgr.EmitProviderDemotedEvent(ctx, minBond, provider)
+}
+
+// releaseProviderSlot decrement the open contracts of the provider of a settled contract, the providers removed or
+// whose contracts opened before they were counted are left alone
+func (mgr Manager) releaseProviderSlot(ctx cosmos.Context, contract types.Contract) error {
+	provider, err := mgr.keeper.GetProvider(ctx, contract.Provider, contract.Service)
+	if err != nil {
+		return err
+	}
+	if provider.OpenContracts == 0 {
+		return nil
+	}
+	provider.OpenContracts--
+	return mgr.keeper.SetProvider(ctx, provider)
+}
+
+// claimNonce check the claim of the provider at the nonce can be settled at the height, returning the nonce it is paid
+// for. A nonce over what the contract allows is capped to the queries it could have served.
+func claimNonce(contract types.Contract, nonce, height int64) (int64, error) {
+	if contract.Nonce >= nonce {
+		return nonce, errors.Wrapf(types.ErrClaimContractIncomeBadNonce, "contract nonce (%d) is greater than msg nonce (%d)", contract.Nonce, nonce)
+	}
+	if contract.IsSettled(height) {
+		return nonce, errors.Wrapf(types.ErrClaimContractIncomeClosed, "settled on block: %d", contract.SettlementPeriodEnd())
+	}
+	if contract.IsPending(height) {
+		return nonce, errors.Wrapf(types.ErrClaimContractIncomePending, "starts on block: %d", contract.StartHeight)
+	}
+	if maxQueries := contract.MaxQueries(); maxQueries > 0 && nonce > maxQueries {
+		return maxQueries, nil
+	}
+	return nonce, nil
+}
+
+// earlyCloseSplit split of the deposit a subscription closed early leaves once the blocks served are paid
+type earlyCloseSplit struct {
+	compensation cosmos.Int
+	refund       cosmos.Int
+	dust         cosmos.Int
+}
+
+// splitEarlyClose split the deposit left of a subscription closed early. The blocks of the min period not served yet
+// are paid to the provider along with its compensation share of the rest, the rest is refunded. Both shares round
+// down, what they leave of the deposit is dust.
+func splitEarlyClose(contract types.Contract, height int64, left cosmos.Int, params types.Params) earlyCloseSplit {
+	split := earlyCloseSplit{
+		compensation: cosmos.ZeroInt(),
+		refund:       left,
+		dust:         cosmos.ZeroInt(),
+	}
+	if !left.IsPositive() {
+		return split
+	}
+
+	minPeriod := params.EarlyCloseMinPeriod
+	if minPeriod > contract.Duration {
+		minPeriod = contract.Duration
+	}
+	nonRefundable := cosmos.ZeroInt()
+	if unserved := minPeriod - (height - contract.Start()); unserved > 0 {
+		nonRefundable = sdkmath.MinInt(contract.Rate.Amount.MulRaw(unserved), left)
+	}
+
+	unused := left.Sub(nonRefundable)
+	share := unused.MulRaw(params.EarlyCloseCompensation).QuoRaw(configs.MaxBasisPoints)
+	split.refund = unused.MulRaw(configs.MaxBasisPoints - params.EarlyCloseCompensation).QuoRaw(configs.MaxBasisPoints)
+	split.compensation = nonRefundable.Add(share)
+	split.dust = unused.Sub(share).Sub(split.refund)
+	return split
+}
+
+// splitReserveTax split the amount owed by a contract between the provider and the reserve tax, both rounding down.
+// What they leave of the amount is dust, accrued to the reserve along the tax, so the split never depends on how the
+// shares would round.
+func splitReserveTax(owed cosmos.Int, reserveTax int64) (income, tax, dust cosmos.Int) {
+	income = owed.MulRaw(configs.MaxBasisPoints - reserveTax).QuoRaw(configs.MaxBasisPoints)
+	tax = owed.MulRaw(reserveTax).QuoRaw(configs.MaxBasisPoints)
+	return income, tax, owed.Sub(income).Sub(tax)
+}
+
+// contractSettlement compute the settlement of the contract at the nonce, the debt split between the provider and
+// the reserve tax, without moving any funds. The contract is returned at its new nonce, the debt not added to what
+// it paid yet.
+func contractSettlement(ctx cosmos.Context, contract types.Contract, nonce, reserveTax int64, isFinal bool) (types.Contract, types.ContractSettlement, error) {
+	if nonce > contract.Nonce {
+		contract.Nonce = nonce
+	}
+	settlement := types.ContractSettlement{
+		ContractId:     contract.Id,
+		Nonce:          contract.Nonce,
+		Final:          isFinal,
+		Owed:           cosmos.NewCoin(contract.Rate.Denom, cosmos.ZeroInt()),
+		ProviderIncome: cosmos.NewCoin(contract.Rate.Denom, cosmos.ZeroInt()),
+		ReserveTax:     cosmos.NewCoin(contract.Rate.Denom, cosmos.ZeroInt()),
+		Refund:         cosmos.NewCoin(contract.Rate.Denom, cosmos.ZeroInt()),
+		Unpaid:         cosmos.NewCoin(contract.Rate.Denom, cosmos.ZeroInt()),
+		Dust:           cosmos.NewCoin(contract.Rate.Denom, cosmos.ZeroInt()),
+	}
+	totalDebt, unpaid, err := contractDebt(ctx, contract)
+	if err != nil {
+		return contract, settlement, err
+	}
+	settlement.Owed.Amount = totalDebt
+	settlement.Unpaid.Amount = unpaid
+	settlement.ProviderIncome.Amount, settlement.ReserveTax.Amount, settlement.Dust.Amount = splitReserveTax(totalDebt, reserveTax)
+	if isFinal {
+		settlement.Refund.Amount = contract.Deposit.Sub(contract.Paid).Sub(totalDebt)
+	}
+	return contract, settlement, nil
+}
+
+// contractDebt return the debt of the contract the deposit left pays, and what it claims beyond it
+func contractDebt(ctx cosmos.Context, contract types.Contract) (cosmos.Int, cosmos.Int, error) {
 	var debt cosmos.Int
 	switch contract.Type {
 	case types.ContractType_SUBSCRIPTION:
@@ -422,23 +846,23 @@ func (mgr Manager) contractDebt(ctx cosmos.Context, contract types.Contract) (co
 		if height > contract.SettlementPeriodEnd() {
 			height = contract.SettlementPeriodEnd()
 		}
-		debt = contract.Rate.Amount.MulRaw(height - contract.Height).Sub(contract.Paid)
+		debt = contract.Rate.Amount.MulRaw(height - contract.Start()).Sub(contract.Paid)
 	case types.ContractType_PAY_AS_YOU_GO:
 		debt = contract.Rate.Amount.MulRaw(contract.Nonce).Sub(contract.Paid)
 	default:
-		return cosmos.ZeroInt(), errors.Wrapf(types.ErrInvalidContractType, "%s", contract.Type.String())
+		return cosmos.ZeroInt(), cosmos.ZeroInt(), errors.Wrapf(types.ErrInvalidContractType, "%s", contract.Type.String())
 	}
 
 	if debt.IsNegative() {
-		return cosmos.ZeroInt(), nil
+		return cosmos.ZeroInt(), cosmos.ZeroInt(), nil
 	}
 
 	// sanity check, ensure provider cannot take more than deposited into the contract
-	if contract.Paid.Add(debt).GT(contract.Deposit) {
-		return contract.Deposit.Sub(contract.Paid), nil
+	if left := contract.Deposit.Sub(contract.Paid); debt.GT(left) {
+		return left, debt.Sub(left), nil
 	}
 
-	return debt, nil
+	return debt, cosmos.ZeroInt(), nil
 }
 
 func (mgr Manager) reserveSupply(ctx cosmos.Context) (sdk.DecCoin, error) {
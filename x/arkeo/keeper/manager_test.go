@@ -5,10 +5,12 @@ import (
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/stretchr/testify/require"
 
 	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
 
 	"github.com/arkeonetwork/arkeo/common"
 	"github.com/arkeonetwork/arkeo/common/cosmos"
@@ -44,6 +46,7 @@ func TestContractEndBlock(t *testing.T) {
 		Status:              types.ProviderStatus_ONLINE,
 		PayAsYouGoRate:      rates,
 		SubscriptionRate:    rates,
+		UpdateMask:          types.ModProviderFields,
 	}
 	err = s.ModProviderHandle(ctx, &modProviderMsg)
 	require.NoError(t, err)
@@ -108,8 +111,9 @@ func TestContractEndBlock(t *testing.T) {
 	contractIdExpiring := contractSet.ContractSet.ContractIds[0]
 	require.Len(t, contractSet.ContractSet.ContractIds, 2)
 
-	// advance 100 blocks and call end block
-	ctx = ctx.WithBlockHeight(110)
+	// advance 100 blocks, past the settlement grace period, and call end block
+	grace := k.GetParams(ctx).SettlementGracePeriod
+	ctx = ctx.WithBlockHeight(110 + grace)
 	err = mgr.ContractEndBlock(ctx)
 	require.NoError(t, err)
 
@@ -128,7 +132,7 @@ func TestContractEndBlock(t *testing.T) {
 	require.True(t, activeContract.IsEmpty())
 
 	// advance 100 more blocks and call end block to ensure user 2 has no contracts left.
-	ctx = ctx.WithBlockHeight(210)
+	ctx = ctx.WithBlockHeight(210 + grace)
 	err = mgr.ContractEndBlock(ctx)
 	require.NoError(t, err)
 	contractSet, err = k.GetUserContractSet(ctx, user2PubKey)
@@ -167,6 +171,7 @@ func TestContractEndBlockWithSettlementDuration(t *testing.T) {
 		PayAsYouGoRate:      rates,
 		SubscriptionRate:    rates,
 		SettlementDuration:  10,
+		UpdateMask:          types.ModProviderFields,
 	}
 
 	err = s.ModProviderHandle(ctx, &modProviderMsg)
@@ -280,6 +285,33 @@ func TestInvariantMaxSupply(t *testing.T) {
 	require.ErrorIs(t, mgr.invariantMaxSupply(ctx), types.ErrInvariantMaxSupply)
 }
 
+func TestSlashProviderDemotion(t *testing.T) {
+	ctx, k, sk := SetupKeeperWithStaking(t)
+	mgr := NewManager(k, sk)
+
+	// online provider bonded at the minimum
+	pubkey := types.GetRandomPubKey()
+	provider := types.NewProvider(pubkey, common.BTCService)
+	provider.Bond = cosmos.NewInt(common.Tokens(1))
+	provider.Status = types.ProviderStatus_ONLINE
+	require.NoError(t, k.SetProvider(ctx, provider))
+	require.NoError(t, k.MintToModule(ctx, types.ModuleName, getCoin(common.Tokens(1))))
+	require.NoError(t, k.SendFromModuleToModule(ctx, types.ModuleName, types.ProviderName, getCoins(common.Tokens(1))))
+
+	// the slash leaves the bond below the minimum, the provider is set offline
+	contract := types.NewContract(pubkey, common.BTCService, types.GetRandomPubKey())
+	require.NoError(t, mgr.SlashProvider(ctx, contract, types.SlashReasonOverClaim))
+	provider, err := k.GetProvider(ctx, pubkey, common.BTCService)
+	require.NoError(t, err)
+	require.True(t, provider.Bond.LT(cosmos.NewInt(common.Tokens(1))))
+	require.Equal(t, types.ProviderStatus_OFFLINE, provider.Status)
+	demoted := false
+	for _, evt := range ctx.EventManager().Events() {
+		demoted = demoted || evt.Type == types.EventTypeProviderDemoted
+	}
+	require.True(t, demoted)
+}
+
 func TestParamsRewardsPercentage(t *testing.T) {
 	ctx, k, _ := SetupKeeperWithStaking(t)
 
@@ -440,3 +472,620 @@ func TestValidatorPayouts(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, p.CommunityPool.AmountOf(configs.Denom), sdkmath.LegacyNewDec(10003))
 }
+
+// setupExpiringContracts set a subscription contract settled at height 50, and total contracts settled far later
+func setupExpiringContracts(t testing.TB, ctx cosmos.Context, k Keeper, total int) types.Contract {
+	provider := types.GetRandomPubKey()
+	rate := cosmos.NewInt64Coin(configs.Denom, 5)
+
+	contract := types.NewContract(provider, common.BTCService, types.GetRandomPubKey())
+	contract.Id = 1
+	contract.Height = 10
+	contract.Duration = 40
+	contract.Rate = rate
+	contract.Deposit = cosmos.NewInt(500)
+	contract.QueriesPerMinute = 1
+	require.NoError(t, k.SetContract(ctx, contract))
+	require.NoError(t, k.AddToContractExpirationSet(ctx, contract.SettlementPeriodEnd(), contract.Id))
+	require.NoError(t, k.AddToUserContractSet(ctx, contract.Client, contract.Id))
+	require.NoError(t, k.MintToModule(ctx, types.ModuleName, getCoin(500)))
+	require.NoError(t, k.SendFromModuleToModule(ctx, types.ModuleName, types.ContractName, getCoins(500)))
+
+	for i := 0; i < total; i++ {
+		other := types.NewContract(provider, common.BTCService, types.GetRandomPubKey())
+		other.Id = uint64(i + 2)
+		other.Height = 10
+		other.Duration = int64(1000 + i)
+		other.Rate = rate
+		other.Deposit = cosmos.NewInt(500)
+		require.NoError(t, k.SetContract(ctx, other))
+		require.NoError(t, k.AddToContractExpirationSet(ctx, other.SettlementPeriodEnd(), other.Id))
+	}
+	return contract
+}
+
+func TestContractEndBlockConstantWork(t *testing.T) {
+	// the gas the end block consumes reading and writing the store
+	endBlockGas := func(total int) uint64 {
+		ctx, k, sk := SetupKeeperWithStaking(t)
+		contract := setupExpiringContracts(t, ctx, k, total)
+
+		ctx = ctx.WithBlockHeight(contract.SettlementPeriodEnd()).WithGasMeter(storetypes.NewInfiniteGasMeter())
+		require.NoError(t, NewManager(k, sk).ContractEndBlock(ctx))
+		contract, err := k.GetContract(ctx, contract.Id)
+		require.NoError(t, err)
+		require.Equal(t, contract.SettlementPeriodEnd(), contract.SettlementHeight)
+		return ctx.GasMeter().GasConsumed()
+	}
+
+	// the work is the same whatever the number of contracts still open, the counts of both runs encoding in as many
+	// bytes in the network stats
+	gas := endBlockGas(200)
+	require.NotZero(t, gas)
+	require.Equal(t, gas, endBlockGas(1000))
+}
+
+func BenchmarkContractEndBlock(b *testing.B) {
+	ctx, k, sk := SetupKeeperWithStaking(b)
+	setupExpiringContracts(b, ctx, k, 10000)
+	mgr := NewManager(k, sk)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if err := mgr.ContractEndBlock(ctx.WithBlockHeight(int64(100 + i%900))); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func TestContractEndBlockRenewedContract(t *testing.T) {
+	ctx, k, sk := SetupKeeperWithStaking(t)
+	ctx = ctx.WithBlockHeight(10)
+	s := newMsgServer(k, sk)
+	mgr := NewManager(k, sk)
+
+	providerPubKey := types.GetRandomPubKey()
+	providerAddress, err := providerPubKey.GetMyAddress()
+	require.NoError(t, err)
+	provider := types.NewProvider(providerPubKey, common.BTCService)
+	provider.Bond = cosmos.NewInt(20000000000)
+	require.NoError(t, k.SetProvider(ctx, provider))
+	rates, err := cosmos.ParseCoins("15uarkeo")
+	require.NoError(t, err)
+	require.NoError(t, s.ModProviderHandle(ctx, &types.MsgModProvider{
+		Creator:             providerAddress.String(),
+		Provider:            provider.PubKey,
+		Service:             provider.Service.String(),
+		MinContractDuration: 10,
+		MaxContractDuration: 500,
+		Status:              types.ProviderStatus_ONLINE,
+		PayAsYouGoRate:      rates,
+		SubscriptionRate:    rates,
+		UpdateMask:          types.ModProviderFields,
+	}))
+
+	userPubKey := types.GetRandomPubKey()
+	userAddress, err := userPubKey.GetMyAddress()
+	require.NoError(t, err)
+	require.NoError(t, k.MintAndSendToAccount(ctx, userAddress, getCoin(common.Tokens(10))))
+	_, err = s.OpenContract(ctx, &types.MsgOpenContract{
+		Provider:         providerPubKey.String(),
+		Service:          common.BTCService.String(),
+		Creator:          userAddress.String(),
+		Client:           userPubKey.String(),
+		ContractType:     types.ContractType_SUBSCRIPTION,
+		Duration:         100,
+		Rate:             rates[0],
+		Deposit:          cosmos.NewInt(1500),
+		QueriesPerMinute: 1,
+	})
+	require.NoError(t, err)
+	contract, err := k.GetActiveContractForUser(ctx, userPubKey, providerPubKey, common.BTCService)
+	require.NoError(t, err)
+
+	// the renewal moves the contract from the set at 110 to the one at 160
+	_, err = s.RenewContract(ctx, &types.MsgRenewContract{
+		Creator:            userAddress.String(),
+		ContractId:         contract.Id,
+		AdditionalDuration: 50,
+		ExtraDeposit:       cosmos.NewInt(750),
+	})
+	require.NoError(t, err)
+	set, err := k.GetContractExpirationSet(ctx, 110)
+	require.NoError(t, err)
+	require.Empty(t, set.ContractSet.ContractIds)
+	set, err = k.GetContractExpirationSet(ctx, 160)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{contract.Id}, set.ContractSet.ContractIds)
+
+	// nothing is settled at the former expiration
+	require.NoError(t, mgr.ContractEndBlock(ctx.WithBlockHeight(110)))
+	contract, err = k.GetContract(ctx, contract.Id)
+	require.NoError(t, err)
+	require.Zero(t, contract.SettlementHeight)
+
+	// the contract is settled at its new expiration, and the set removed
+	ctx = ctx.WithBlockHeight(160)
+	require.NoError(t, mgr.ContractEndBlock(ctx))
+	contract, err = k.GetContract(ctx, contract.Id)
+	require.NoError(t, err)
+	require.Equal(t, int64(160), contract.SettlementHeight)
+	iter := k.GetContractExpirationSetIterator(ctx)
+	require.False(t, iter.Valid())
+	iter.Close()
+}
+
+func TestContractEndBlockExpiredEvent(t *testing.T) {
+	ctx, k, sk := SetupKeeperWithStaking(t)
+	ctx = ctx.WithBlockHeight(10)
+	s := newMsgServer(k, sk)
+	mgr := NewManager(k, sk)
+
+	providerPubKey := types.GetRandomPubKey()
+	providerAddress, err := providerPubKey.GetMyAddress()
+	require.NoError(t, err)
+	provider := types.NewProvider(providerPubKey, common.BTCService)
+	provider.Bond = cosmos.NewInt(20000000000)
+	require.NoError(t, k.SetProvider(ctx, provider))
+	// the bond pays the penalty of the contract the provider closes
+	require.NoError(t, k.MintToModule(ctx, types.ModuleName, getCoin(provider.Bond.Int64())))
+	require.NoError(t, k.SendFromModuleToModule(ctx, types.ModuleName, types.ProviderName, getCoins(provider.Bond.Int64())))
+	rates, err := cosmos.ParseCoins("15uarkeo")
+	require.NoError(t, err)
+	require.NoError(t, s.ModProviderHandle(ctx, &types.MsgModProvider{
+		Creator:             providerAddress.String(),
+		Provider:            provider.PubKey,
+		Service:             provider.Service.String(),
+		MinContractDuration: 10,
+		MaxContractDuration: 500,
+		Status:              types.ProviderStatus_ONLINE,
+		PayAsYouGoRate:      rates,
+		SubscriptionRate:    rates,
+		UpdateMask:          types.ModProviderFields,
+	}))
+
+	openContract := func(contractType types.ContractType, duration int64) types.Contract {
+		client := types.GetRandomPubKey()
+		address, err := client.GetMyAddress()
+		require.NoError(t, err)
+		require.NoError(t, k.MintAndSendToAccount(ctx, address, getCoin(common.Tokens(10))))
+		_, err = s.OpenContract(ctx, &types.MsgOpenContract{
+			Provider:         providerPubKey.String(),
+			Service:          provider.Service.String(),
+			Creator:          address.String(),
+			Client:           client.String(),
+			ContractType:     contractType,
+			Duration:         duration,
+			Rate:             rates[0],
+			Deposit:          cosmos.NewInt(15 * duration),
+			QueriesPerMinute: 1,
+		})
+		require.NoError(t, err)
+		contract, err := k.GetActiveContractForUser(ctx, client, providerPubKey, provider.Service)
+		require.NoError(t, err)
+		return contract
+	}
+	// expires at 110, settled at 120 after its grace period
+	payg := openContract(types.ContractType_PAY_AS_YOU_GO, 100)
+	// expires and settles at 110
+	sub := openContract(types.ContractType_SUBSCRIPTION, 100)
+	// renewed to expire at 90 instead of 60
+	renewed := openContract(types.ContractType_PAY_AS_YOU_GO, 50)
+	// settled before it expires
+	closed := openContract(types.ContractType_PAY_AS_YOU_GO, 50)
+	// without a settlement period, expires and settles at 70
+	params := k.GetParams(ctx)
+	params.SettlementGracePeriod = 0
+	k.SetParams(ctx, params)
+	immediate := openContract(types.ContractType_PAY_AS_YOU_GO, 60)
+	require.False(t, immediate.SettlesAfterExpiration())
+
+	ctx = ctx.WithBlockHeight(20)
+	_, err = s.RenewContract(ctx, &types.MsgRenewContract{
+		Creator:            renewed.ClientAddress().String(),
+		ContractId:         renewed.Id,
+		AdditionalDuration: 30,
+		ExtraDeposit:       cosmos.NewInt(15 * 30),
+	})
+	require.NoError(t, err)
+	_, err = s.ProviderCloseContract(ctx, &types.MsgProviderCloseContract{
+		Creator:    providerAddress.String(),
+		ContractId: closed.Id,
+	})
+	require.NoError(t, err)
+
+	// run the end blocker block after block, past the settlement of every contract
+	expired := make(map[uint64][]types.EventContractExpired)
+	for height := int64(11); height <= payg.SettlementPeriodEnd()+5; height++ {
+		ctx = ctx.WithBlockHeight(height).WithEventManager(sdk.NewEventManager())
+		require.NoError(t, mgr.ContractEndBlock(ctx))
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type != types.EventTypeContractExpired {
+				continue
+			}
+			typedEvent, err := sdk.ParseTypedEvent(abci.Event(event))
+			require.NoError(t, err)
+			evt := typedEvent.(*types.EventContractExpired)
+			// emitted at the height the contract expires
+			require.Equal(t, height, evt.Expiration)
+			expired[evt.ContractId] = append(expired[evt.ContractId], *evt)
+		}
+	}
+
+	// each contract expired exactly once, the contract closed early never did
+	require.Len(t, expired, 4)
+	require.NotContains(t, expired, closed.Id)
+	for id, expiration := range map[uint64]int64{payg.Id: 110, sub.Id: 110, renewed.Id: 90, immediate.Id: 70} {
+		require.Len(t, expired[id], 1)
+		require.Equal(t, expiration, expired[id][0].Expiration)
+	}
+	require.Equal(t, types.EventContractExpired{
+		ContractId:        payg.Id,
+		Provider:          providerPubKey,
+		Service:           provider.Service.String(),
+		Client:            payg.Client,
+		Type:              types.ContractType_PAY_AS_YOU_GO,
+		Expiration:        110,
+		SettlementPending: true,
+	}, expired[payg.Id][0])
+	require.True(t, expired[renewed.Id][0].SettlementPending)
+	require.False(t, expired[sub.Id][0].SettlementPending)
+	require.False(t, expired[immediate.Id][0].SettlementPending)
+
+	// the sets of the heights processed are gone
+	for _, height := range []int64{60, 70, 90, 110} {
+		set, err := k.GetPayAsYouGoExpirationSet(ctx, height)
+		require.NoError(t, err)
+		require.Empty(t, set.ContractSet.ContractIds)
+	}
+}
+
+func TestParamsEndBlock(t *testing.T) {
+	ctx, k, sk := SetupKeeperWithStaking(t)
+	ctx = ctx.WithBlockHeight(10)
+	mgr := NewManager(k, sk)
+
+	paramsEvents := func(ctx cosmos.Context) []*types.EventParamsUpdated {
+		var events []*types.EventParamsUpdated
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type != types.EventTypeParamsUpdated {
+				continue
+			}
+			typedEvent, err := sdk.ParseTypedEvent(abci.Event(event))
+			require.NoError(t, err)
+			events = append(events, typedEvent.(*types.EventParamsUpdated))
+		}
+		return events
+	}
+
+	// the params seen first are recorded only
+	require.NoError(t, mgr.ParamsEndBlock(ctx))
+	require.Empty(t, paramsEvents(ctx))
+	res, err := k.Params(ctx, &types.QueryParamsRequest{})
+	require.NoError(t, err)
+	require.Zero(t, res.LastChangeHeight)
+
+	// setting the same params again is a no-op
+	ctx = ctx.WithBlockHeight(11).WithEventManager(sdk.NewEventManager())
+	k.SetParams(ctx, k.GetParams(ctx))
+	require.NoError(t, mgr.ParamsEndBlock(ctx))
+	require.Empty(t, paramsEvents(ctx))
+
+	ctx = ctx.WithBlockHeight(12).WithEventManager(sdk.NewEventManager())
+	params := k.GetParams(ctx)
+	params.SlashFraction = 1000
+	params.AllowedDenoms = []string{configs.Denom, "uatom"}
+	k.SetParams(ctx, params)
+	require.NoError(t, mgr.ParamsEndBlock(ctx))
+	events := paramsEvents(ctx)
+	require.Len(t, events, 1)
+	require.Equal(t, int64(12), events[0].Height)
+	require.Equal(t, []types.ParamChange{
+		{Key: string(types.KeySlashFraction), OldValue: "500", NewValue: "1000"},
+		{Key: string(types.KeyAllowedDenoms), OldValue: `["uarkeo"]`, NewValue: `["uarkeo","uatom"]`},
+	}, events[0].Changes)
+
+	res, err = k.Params(ctx, &types.QueryParamsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, int64(12), res.LastChangeHeight)
+
+	// the changes are emitted once
+	ctx = ctx.WithBlockHeight(13).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, mgr.ParamsEndBlock(ctx))
+	require.Empty(t, paramsEvents(ctx))
+	res, err = k.Params(ctx, &types.QueryParamsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, int64(12), res.LastChangeHeight)
+}
+
+func TestContractEndBlockAutoRenew(t *testing.T) {
+	f := newSubscriptionFixture(t)
+	contract := f.open(types.ContractType_SUBSCRIPTION, true)
+	// not auto-renewed, an other contract expiring at the same height settles
+	other := f.open(types.ContractType_SUBSCRIPTION, false)
+
+	// the balance affords 150 blocks and a bit
+	_, err := f.s.TopUpContract(f.ctx, types.NewMsgTopUpContract(contract.ClientAddress(), contract.Id, cosmos.NewInt(30*150+10)))
+	require.NoError(t, err)
+
+	// the contract is renewed at its expiration instead of settling
+	f.ctx = f.ctx.WithBlockHeight(110).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, f.mgr.ContractEndBlock(f.ctx))
+	contract = f.contract(contract.Id)
+	require.Zero(t, contract.SettlementHeight)
+	require.Equal(t, int64(260), contract.Expiration())
+	require.Equal(t, cosmos.NewInt(30*250), contract.Deposit)
+	require.Equal(t, cosmos.NewInt(10), contract.TopUp)
+	require.True(t, contract.IsOpen(111))
+	require.Equal(t, int64(110), f.contract(other.Id).SettlementHeight)
+
+	renewals := f.events(types.EventTypeContractAutoRenewed)
+	require.Len(t, renewals, 1)
+	require.Equal(t, &types.EventContractAutoRenewed{
+		ContractId:    contract.Id,
+		Provider:      f.provider,
+		Service:       common.BTCService.String(),
+		Client:        contract.Client,
+		OldExpiration: 110,
+		NewExpiration: 260,
+		Spent:         cosmos.NewInt(30 * 150),
+		Deposit:       cosmos.NewInt(30 * 250),
+		Balance:       cosmos.NewInt(10),
+	}, renewals[0])
+	// only the contract which wasn't renewed expired
+	expirations := f.events(types.EventTypeContractExpired)
+	require.Len(t, expirations, 1)
+	require.Equal(t, other.Id, expirations[0].(*types.EventContractExpired).ContractId)
+
+	// the balance left doesn't afford a block, the contract settles at its new expiration, refunding it
+	balance := f.balance(contract)
+	f.ctx = f.ctx.WithBlockHeight(260).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, f.mgr.ContractEndBlock(f.ctx))
+	require.Empty(t, f.events(types.EventTypeContractAutoRenewed))
+	require.Len(t, f.events(types.EventTypeContractExpired), 1)
+	contract = f.contract(contract.Id)
+	require.Equal(t, int64(260), contract.SettlementHeight)
+	require.True(t, contract.TopUp.IsZero())
+	// the provider is paid the rate of the 250 blocks the contract ran
+	require.Equal(t, cosmos.NewInt(15*250), contract.Paid)
+	require.Equal(t, balance+30*250-15*250+10, f.balance(contract))
+	require.NoError(t, f.mgr.invariantContractModule(f.ctx))
+}
+
+func TestContractEndBlockDeterministic(t *testing.T) {
+	var providerPubKey common.PubKey
+	var clients []common.PubKey
+
+	// settle the same contracts, with their ids stored in the given order in
+	// the expiration set, as sets written before they were sorted may be
+	settle := func(order []int) sdk.Events {
+		ctx, k, sk := SetupKeeperWithStaking(t)
+		ctx = ctx.WithBlockHeight(10)
+		s := newMsgServer(k, sk)
+		mgr := NewManager(k, sk)
+		// the keys are made once the keeper set the bech32 prefixes
+		if clients == nil {
+			providerPubKey = types.GetRandomPubKey()
+			for i := 0; i < 5; i++ {
+				clients = append(clients, types.GetRandomPubKey())
+			}
+		}
+
+		provider := types.NewProvider(providerPubKey, common.BTCService)
+		provider.Bond = cosmos.NewInt(20000000000)
+		provider.Status = types.ProviderStatus_ONLINE
+		provider.LastUpdate = ctx.BlockHeight()
+		provider.MinContractDuration = 10
+		provider.MaxContractDuration = 500
+		provider.SubscriptionRate = getCoins(15)
+		require.NoError(t, k.SetProvider(ctx, provider))
+
+		ids := make([]uint64, len(clients))
+		for i, client := range clients {
+			address, err := client.GetMyAddress()
+			require.NoError(t, err)
+			require.NoError(t, k.MintAndSendToAccount(ctx, address, getCoin(common.Tokens(10))))
+			_, err = s.OpenContract(ctx, &types.MsgOpenContract{
+				Provider:         providerPubKey.String(),
+				Service:          common.BTCService.String(),
+				Creator:          address.String(),
+				Client:           client.String(),
+				ContractType:     types.ContractType_SUBSCRIPTION,
+				Duration:         100,
+				Rate:             getCoin(15),
+				Deposit:          cosmos.NewInt(1500),
+				QueriesPerMinute: 1,
+			})
+			require.NoError(t, err)
+			contract, err := k.GetActiveContractForUser(ctx, client, providerPubKey, common.BTCService)
+			require.NoError(t, err)
+			ids[i] = contract.Id
+		}
+
+		set, err := k.GetContractExpirationSet(ctx, 110)
+		require.NoError(t, err)
+		require.Equal(t, ids, set.ContractSet.ContractIds)
+		set.ContractSet.ContractIds = make([]uint64, len(order))
+		for i, j := range order {
+			set.ContractSet.ContractIds[i] = ids[j]
+		}
+		require.NoError(t, k.SetContractExpirationSet(ctx, set))
+
+		ctx = ctx.WithBlockHeight(110).WithEventManager(sdk.NewEventManager())
+		require.NoError(t, mgr.ContractEndBlock(ctx))
+		return ctx.EventManager().Events()
+	}
+
+	events := settle([]int{0, 1, 2, 3, 4})
+	var settled []uint64
+	for _, event := range events {
+		if event.Type != types.EventTypeSettleContract {
+			continue
+		}
+		typedEvent, err := sdk.ParseTypedEvent(abci.Event(event))
+		require.NoError(t, err)
+		settled = append(settled, typedEvent.(*types.EventSettleContract).ContractId)
+	}
+	require.Equal(t, []uint64{1, 2, 3, 4, 5}, settled)
+
+	require.Equal(t, events, settle([]int{4, 2, 0, 3, 1}))
+	require.Equal(t, events, settle([]int{1, 0, 4, 3, 2}))
+}
+
+func TestSplitReserveTax(t *testing.T) {
+	huge, ok := sdkmath.NewIntFromString("1000000000000000000000000000057")
+	require.True(t, ok)
+	for _, owed := range []cosmos.Int{cosmos.ZeroInt(), sdkmath.OneInt(), cosmos.NewInt(9), cosmos.NewInt(7919), cosmos.NewInt(99991), huge} {
+		for _, reserveTax := range []int64{0, 1, 997, 1000, 3333, 9999, configs.MaxBasisPoints} {
+			income, tax, dust := splitReserveTax(owed, reserveTax)
+			require.Equal(t, owed, income.Add(tax).Add(dust), "%s at %d", owed, reserveTax)
+			// both shares round down, the dust is less than a unit of each
+			require.False(t, dust.IsNegative(), "%s at %d", owed, reserveTax)
+			require.True(t, dust.LTE(sdkmath.OneInt()), "%s at %d", owed, reserveTax)
+			require.Equal(t, owed.MulRaw(reserveTax).QuoRaw(configs.MaxBasisPoints), tax)
+		}
+	}
+	income, tax, dust := splitReserveTax(cosmos.NewInt(9), 1000)
+	require.Equal(t, []int64{8, 0, 1}, []int64{income.Int64(), tax.Int64(), dust.Int64()})
+}
+
+func TestSettlementDust(t *testing.T) {
+	huge, ok := sdkmath.NewIntFromString("1000000000000000000000007")
+	require.True(t, ok)
+	for _, tc := range []struct {
+		desc         string
+		contractType types.ContractType
+		rate         cosmos.Coin
+		duration     int64
+		deposit      cosmos.Int
+		// heights past the start of a subscription, nonces of a pay-as-you-go, the last one settling for good
+		claims []int64
+	}{
+		{
+			desc:         "prime subscription",
+			contractType: types.ContractType_SUBSCRIPTION,
+			rate:         cosmos.NewInt64Coin(configs.Denom, 7919),
+			duration:     997,
+			deposit:      cosmos.NewInt(7919 * 997),
+			claims:       []int64{13, 499, 601},
+		},
+		{
+			desc:         "one unit subscription",
+			contractType: types.ContractType_SUBSCRIPTION,
+			rate:         cosmos.NewInt64Coin(configs.Denom, 1),
+			duration:     1,
+			deposit:      cosmos.NewInt(1),
+			claims:       []int64{1},
+		},
+		{
+			desc:         "subscription deposit off the rate",
+			contractType: types.ContractType_SUBSCRIPTION,
+			rate:         cosmos.NewInt64Coin(configs.Denom, 3),
+			duration:     7,
+			deposit:      cosmos.NewInt(23),
+			claims:       []int64{1, 2, 3, 5, 7},
+		},
+		{
+			desc:         "huge subscription",
+			contractType: types.ContractType_SUBSCRIPTION,
+			rate:         cosmos.NewCoin("ibc/ED07A3391A112B175915CD8FAF43A2DA8E4790EDE12566649D0C2F97716B8518", huge),
+			duration:     101,
+			deposit:      huge.MulRaw(101).AddRaw(3),
+			claims:       []int64{17, 89, 101},
+		},
+		{
+			desc:         "one unit pay-as-you-go",
+			contractType: types.ContractType_PAY_AS_YOU_GO,
+			rate:         cosmos.NewInt64Coin(configs.Denom, 3),
+			duration:     10,
+			deposit:      cosmos.NewInt(1),
+			claims:       []int64{1},
+		},
+		{
+			desc:         "prime pay-as-you-go",
+			contractType: types.ContractType_PAY_AS_YOU_GO,
+			rate:         cosmos.NewInt64Coin(configs.Denom, 13),
+			duration:     100,
+			deposit:      cosmos.NewInt(1009),
+			claims:       []int64{1, 7, 31, 53},
+		},
+		{
+			desc:         "pay-as-you-go claiming past the deposit",
+			contractType: types.ContractType_PAY_AS_YOU_GO,
+			rate:         cosmos.NewInt64Coin(configs.Denom, 9999),
+			duration:     100,
+			deposit:      cosmos.NewInt(99991),
+			claims:       []int64{3, 11},
+		},
+		{
+			desc:         "huge pay-as-you-go",
+			contractType: types.ContractType_PAY_AS_YOU_GO,
+			rate:         cosmos.NewCoin(configs.Denom, huge),
+			duration:     100,
+			deposit:      huge.MulRaw(61).AddRaw(1),
+			claims:       []int64{1, 29, 59},
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			ctx, k, sk := SetupKeeperWithStaking(t)
+			ctx = ctx.WithBlockHeight(10)
+			mgr := NewManager(k, sk)
+			denom := tc.rate.Denom
+
+			providerPubKey := types.GetRandomPubKey()
+			providerAddress, err := providerPubKey.GetMyAddress()
+			require.NoError(t, err)
+			clientPubKey := types.GetRandomPubKey()
+			clientAddress, err := clientPubKey.GetMyAddress()
+			require.NoError(t, err)
+			require.NoError(t, k.MintAndSendToAccount(ctx, clientAddress, cosmos.NewCoin(denom, tc.deposit)))
+			require.NoError(t, k.SendFromAccountToModule(ctx, clientAddress, types.ContractName, cosmos.NewCoins(cosmos.NewCoin(denom, tc.deposit))))
+
+			contract := types.NewContract(providerPubKey, common.BTCService, clientPubKey)
+			contract.Id = 1
+			contract.Type = tc.contractType
+			contract.Height = ctx.BlockHeight()
+			contract.Duration = tc.duration
+			contract.Rate = tc.rate
+			contract.Deposit = tc.deposit
+			contract.QueriesPerMinute = 1
+			require.NoError(t, k.SetContract(ctx, contract))
+			require.NoError(t, k.AddToUserContractSet(ctx, clientPubKey, contract.Id))
+			reserveBefore := k.GetBalanceOfModule(ctx, types.ModuleName, denom)
+
+			reserve, dust := cosmos.ZeroInt(), cosmos.ZeroInt()
+			ctx = ctx.WithEventManager(cosmos.NewEventManager())
+			for i, claim := range tc.claims {
+				isFinal := i == len(tc.claims)-1
+				nonce := int64(0)
+				if tc.contractType == types.ContractType_SUBSCRIPTION {
+					ctx = ctx.WithBlockHeight(contract.Height + claim)
+				} else {
+					nonce = claim
+				}
+				contract, err = mgr.SettleContract(ctx, contract, nonce, isFinal)
+				require.NoError(t, err)
+			}
+			for _, event := range ctx.EventManager().Events() {
+				if event.Type != types.EventTypeSettleContract {
+					continue
+				}
+				typedEvent, err := sdk.ParseTypedEvent(abci.Event(event))
+				require.NoError(t, err)
+				settled := typedEvent.(*types.EventSettleContract)
+				// the dust never exceeds a unit, the tax and the split of the amount paid rounding down
+				require.True(t, settled.Dust.LTE(sdkmath.OneInt()))
+				reserve = reserve.Add(settled.Reserve)
+				dust = dust.Add(settled.Dust)
+			}
+
+			// the deposit is accounted for to the unit, the provider, the client and the reserve splitting it
+			paid := k.GetBalance(ctx, providerAddress).AmountOf(denom)
+			refunded := k.GetBalance(ctx, clientAddress).AmountOf(denom)
+			require.Equal(t, tc.deposit, paid.Add(refunded).Add(reserve).Add(dust))
+			require.Equal(t, reserve.Add(dust), k.GetBalanceOfModule(ctx, types.ModuleName, denom).Sub(reserveBefore))
+			require.True(t, k.GetBalanceOfModule(ctx, types.ContractName, denom).IsZero())
+			require.Equal(t, ctx.BlockHeight(), contract.SettlementHeight)
+		})
+	}
+}
@@ -0,0 +1,317 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/arkeonetwork/arkeo/common/cosmos"
+	"github.com/arkeonetwork/arkeo/x/arkeo/configs"
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+)
+
+// Migrator is a struct for handling in-place store migrations
+type Migrator struct {
+	keeper Keeper
+}
+
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 rebuild the contract expiration sets the end blocker settles the contracts from. The sets of the
+// heights passed were never removed, they are dropped, so are the settled contracts of the sets left. An unsettled
+// contract missing from the sets is added at the end of its settlement period, if it is still to come.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	var sets []types.ContractExpirationSet
+	iter := m.keeper.GetContractExpirationSetIterator(ctx)
+	for ; iter.Valid(); iter.Next() {
+		var set types.ContractExpirationSet
+		if err := m.keeper.Cdc().Unmarshal(iter.Value(), &set); err != nil {
+			iter.Close()
+			return err
+		}
+		sets = append(sets, set)
+	}
+	iter.Close()
+
+	indexed := make(map[uint64]bool)
+	for _, set := range sets {
+		if set.Height < ctx.BlockHeight() {
+			m.keeper.RemoveContractExpirationSet(ctx, set.Height)
+			continue
+		}
+		ids := set.ContractSet.GetContractIds()
+		set.ContractSet = &types.ContractSet{}
+		for _, id := range ids {
+			contract, err := m.keeper.GetContract(ctx, id)
+			if err != nil {
+				return err
+			}
+			if contract.IsEmpty() || contract.SettlementHeight > 0 {
+				continue
+			}
+			set.ContractSet.ContractIds = append(set.ContractSet.ContractIds, id)
+			indexed[id] = true
+		}
+		if err := m.keeper.SetContractExpirationSet(ctx, set); err != nil {
+			return err
+		}
+	}
+
+	var missing []types.Contract
+	iter = m.keeper.GetContractIterator(ctx)
+	for ; iter.Valid(); iter.Next() {
+		var contract types.Contract
+		if err := m.keeper.Cdc().Unmarshal(iter.Value(), &contract); err != nil {
+			iter.Close()
+			return err
+		}
+		if contract.SettlementHeight > 0 || indexed[contract.Id] || contract.SettlementPeriodEnd() < ctx.BlockHeight() {
+			continue
+		}
+		missing = append(missing, contract)
+	}
+	iter.Close()
+
+	for _, contract := range missing {
+		if err := m.keeper.AddToContractExpirationSet(ctx, contract.SettlementPeriodEnd(), contract.Id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Migrate2to3 count the contracts of each provider not settled yet, the slots they hold against the open contracts
+// cap of the provider
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	counts := make(map[string]uint64)
+	iter := m.keeper.GetContractIterator(ctx)
+	for ; iter.Valid(); iter.Next() {
+		var contract types.Contract
+		if err := m.keeper.Cdc().Unmarshal(iter.Value(), &contract); err != nil {
+			iter.Close()
+			return err
+		}
+		if contract.SettlementHeight > 0 {
+			continue
+		}
+		counts[types.NewProvider(contract.Provider, contract.Service).Key()]++
+	}
+	iter.Close()
+
+	var providers []types.Provider
+	iter = m.keeper.GetProviderIterator(ctx)
+	for ; iter.Valid(); iter.Next() {
+		provider, _, err := unmarshalLegacyProvider(m.keeper.Cdc(), iter.Value())
+		if err != nil {
+			iter.Close()
+			return err
+		}
+		providers = append(providers, provider)
+	}
+	iter.Close()
+
+	for _, provider := range providers {
+		provider.OpenContracts = counts[provider.Key()]
+		if err := m.keeper.SetProvider(ctx, provider); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Migrate3to4 index the open pay-as-you-go contracts by the height they expire at, ahead of their settlement, for the
+// end blocker to emit their expiration
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	var contracts []types.Contract
+	iter := m.keeper.GetContractIterator(ctx)
+	for ; iter.Valid(); iter.Next() {
+		var contract types.Contract
+		if err := m.keeper.Cdc().Unmarshal(iter.Value(), &contract); err != nil {
+			iter.Close()
+			return err
+		}
+		if contract.SettlementHeight > 0 || !contract.SettlesAfterExpiration() || contract.Expiration() < ctx.BlockHeight() {
+			continue
+		}
+		contracts = append(contracts, contract)
+	}
+	iter.Close()
+
+	for _, contract := range contracts {
+		if err := m.keeper.AddToPayAsYouGoExpirationSet(ctx, contract.Expiration(), contract.Id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Migrate4to5 rewrite the providers stored before the multi-denom rates, with int64 subscription and pay-as-you-go
+// rates of the native denom, into rate coins. The providers stored since are left as they are.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	var providers []types.Provider
+	iter := m.keeper.GetProviderIterator(ctx)
+	for ; iter.Valid(); iter.Next() {
+		provider, legacy, err := unmarshalLegacyProvider(m.keeper.Cdc(), iter.Value())
+		if err != nil {
+			iter.Close()
+			return err
+		}
+		if legacy {
+			providers = append(providers, provider)
+		}
+	}
+	iter.Close()
+
+	for _, provider := range providers {
+		if err := m.keeper.SetProvider(ctx, provider); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// unmarshalLegacyProvider decode a provider whose rates may be encoded as the legacy int64 amounts of the native denom,
+// the varint fields 8 and 9 the rate coins took the numbers of. False when the provider has none of the legacy fields.
+// The migrations run ahead of Migrate4to5 in an upgrade, every migration reading the providers decode them so.
+func unmarshalLegacyProvider(cdc codec.BinaryCodec, bz []byte) (types.Provider, bool, error) {
+	const (
+		subscriptionRateField = 8
+		payAsYouGoRateField   = 9
+	)
+	var provider types.Provider
+	var legacy bool
+	var subscriptionRate, payAsYouGoRate int64
+	rest := make([]byte, 0, len(bz))
+	for b := bz; len(b) > 0; {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return provider, false, protowire.ParseError(n)
+		}
+		if (num == subscriptionRateField || num == payAsYouGoRateField) && typ == protowire.VarintType {
+			v, m := protowire.ConsumeVarint(b[n:])
+			if m < 0 {
+				return provider, false, protowire.ParseError(m)
+			}
+			if num == subscriptionRateField {
+				subscriptionRate = int64(v)
+			} else {
+				payAsYouGoRate = int64(v)
+			}
+			legacy = true
+			b = b[n+m:]
+			continue
+		}
+		m := protowire.ConsumeFieldValue(num, typ, b[n:])
+		if m < 0 {
+			return provider, false, protowire.ParseError(m)
+		}
+		rest = append(rest, b[:n+m]...)
+		b = b[n+m:]
+	}
+	if err := cdc.Unmarshal(rest, &provider); err != nil {
+		return provider, false, err
+	}
+	if legacy {
+		provider.SubscriptionRate = legacyRate(subscriptionRate)
+		provider.PayAsYouGoRate = legacyRate(payAsYouGoRate)
+	}
+	return provider, legacy, nil
+}
+
+// legacyRate return the rate coins of a legacy rate, none when it was zero, the provider not offering the contract type
+func legacyRate(amount int64) cosmos.Coins {
+	if amount <= 0 {
+		return cosmos.Coins{}
+	}
+	return cosmos.NewCoins(cosmos.NewInt64Coin(configs.Denom, amount))
+}
+
+// Migrate5to6 index the providers by their service, for the providers query to page through the providers of a service
+func (m Migrator) Migrate5to6(ctx sdk.Context) error {
+	var providers []types.Provider
+	iter := m.keeper.GetProviderIterator(ctx)
+	for ; iter.Valid(); iter.Next() {
+		provider, _, err := unmarshalLegacyProvider(m.keeper.Cdc(), iter.Value())
+		if err != nil {
+			iter.Close()
+			return err
+		}
+		providers = append(providers, provider)
+	}
+	iter.Close()
+
+	for _, provider := range providers {
+		if err := m.keeper.SetProvider(ctx, provider); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Migrate6to7 count the providers and contracts stored, the network stats kept up to date as they are stored since
+func (m Migrator) Migrate6to7(ctx sdk.Context) error {
+	stats, err := countNetworkStats(ctx, m.keeper)
+	if err != nil {
+		return err
+	}
+	m.keeper.SetNetworkStats(ctx, stats)
+	return nil
+}
+
+// Migrate7to8 store the params along with the provider close contract penalty, moved from the configs to the params, at
+// its default
+func (m Migrator) Migrate7to8(ctx sdk.Context) error {
+	m.keeper.SetParams(ctx, m.keeper.GetParams(ctx))
+	return nil
+}
+
+// Migrate8to9 index the contracts by their provider, for the contracts by provider query to list the contracts opened
+// before the index
+func (m Migrator) Migrate8to9(ctx sdk.Context) error {
+	var contracts []types.Contract
+	iter := m.keeper.GetContractIterator(ctx)
+	for ; iter.Valid(); iter.Next() {
+		var contract types.Contract
+		if err := m.keeper.Cdc().Unmarshal(iter.Value(), &contract); err != nil {
+			iter.Close()
+			return err
+		}
+		contracts = append(contracts, contract)
+	}
+	iter.Close()
+
+	for _, contract := range contracts {
+		if err := m.keeper.SetContract(ctx, contract); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Migrate9to10 add the open delegated contracts to the set of their client, for the contracts by owner query to list
+// the delegated contracts opened before the clients were indexed
+func (m Migrator) Migrate9to10(ctx sdk.Context) error {
+	var contracts []types.Contract
+	iter := m.keeper.GetContractIterator(ctx)
+	for ; iter.Valid(); iter.Next() {
+		var contract types.Contract
+		if err := m.keeper.Cdc().Unmarshal(iter.Value(), &contract); err != nil {
+			iter.Close()
+			return err
+		}
+		if contract.SettlementHeight > 0 || contract.GetSpender().Equals(contract.Client) {
+			continue
+		}
+		contracts = append(contracts, contract)
+	}
+	iter.Close()
+
+	for _, contract := range contracts {
+		if err := m.keeper.AddToUserContractSet(ctx, contract.Client, contract.Id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,453 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/arkeonetwork/arkeo/common"
+	"github.com/arkeonetwork/arkeo/common/cosmos"
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+)
+
+func TestMigrate1to2(t *testing.T) {
+	ctx, k := SetupKeeper(t)
+	ctx = ctx.WithBlockHeight(100)
+
+	setContract := func(id uint64, duration, settlementHeight int64) types.Contract {
+		contract := types.NewContract(types.GetRandomPubKey(), common.BTCService, types.GetRandomPubKey())
+		contract.Id = id
+		contract.Height = 10
+		contract.Duration = duration
+		contract.Rate = cosmos.NewInt64Coin("uarkeo", 10)
+		contract.Deposit = cosmos.NewInt(100)
+		contract.SettlementHeight = settlementHeight
+		require.NoError(t, k.SetContract(ctx, contract))
+		return contract
+	}
+	setContract(1, 50, 60)  // settled at its expiration
+	setContract(2, 150, 0)  // open
+	setContract(3, 150, 90) // closed early
+	setContract(4, 200, 0)  // open, missing from the sets
+	setContract(5, 60, 0)   // never settled, its settlement height is passed
+
+	// the set at 60 was processed already, 3 was settled before 160
+	require.NoError(t, k.SetContractExpirationSet(ctx, types.ContractExpirationSet{
+		Height:      60,
+		ContractSet: &types.ContractSet{ContractIds: []uint64{1}},
+	}))
+	require.NoError(t, k.SetContractExpirationSet(ctx, types.ContractExpirationSet{
+		Height:      160,
+		ContractSet: &types.ContractSet{ContractIds: []uint64{2, 3}},
+	}))
+
+	require.NoError(t, NewMigrator(k).Migrate1to2(ctx))
+
+	var sets []types.ContractExpirationSet
+	iter := k.GetContractExpirationSetIterator(ctx)
+	for ; iter.Valid(); iter.Next() {
+		var set types.ContractExpirationSet
+		require.NoError(t, k.Cdc().Unmarshal(iter.Value(), &set))
+		sets = append(sets, set)
+	}
+	iter.Close()
+	require.ElementsMatch(t, []types.ContractExpirationSet{
+		{Height: 160, ContractSet: &types.ContractSet{ContractIds: []uint64{2}}},
+		{Height: 210, ContractSet: &types.ContractSet{ContractIds: []uint64{4}}},
+	}, sets)
+}
+
+func TestMigrate2to3(t *testing.T) {
+	ctx, k := SetupKeeper(t)
+
+	provider := types.NewProvider(types.GetRandomPubKey(), common.BTCService)
+	provider.Bond = cosmos.NewInt(common.Tokens(1))
+	provider.OpenContracts = 7
+	require.NoError(t, k.SetProvider(ctx, provider))
+	idle := types.NewProvider(types.GetRandomPubKey(), common.BTCService)
+	idle.Bond = cosmos.NewInt(common.Tokens(1))
+	idle.OpenContracts = 2
+	require.NoError(t, k.SetProvider(ctx, idle))
+
+	for id, settlementHeight := range []int64{0, 0, 40, 0} {
+		contract := types.NewContract(provider.PubKey, provider.Service, types.GetRandomPubKey())
+		contract.Id = uint64(id + 1)
+		contract.Rate = cosmos.NewInt64Coin("uarkeo", 10)
+		contract.Deposit = cosmos.NewInt(100)
+		contract.SettlementHeight = settlementHeight
+		require.NoError(t, k.SetContract(ctx, contract))
+	}
+	// the same pubkey for another service
+	contract := types.NewContract(provider.PubKey, common.ETHService, types.GetRandomPubKey())
+	contract.Id = 5
+	contract.Rate = cosmos.NewInt64Coin("uarkeo", 10)
+	contract.Deposit = cosmos.NewInt(100)
+	require.NoError(t, k.SetContract(ctx, contract))
+
+	require.NoError(t, NewMigrator(k).Migrate2to3(ctx))
+
+	provider, err := k.GetProvider(ctx, provider.PubKey, provider.Service)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), provider.OpenContracts)
+	idle, err = k.GetProvider(ctx, idle.PubKey, idle.Service)
+	require.NoError(t, err)
+	require.Zero(t, idle.OpenContracts)
+	require.False(t, k.ProviderExists(ctx, provider.PubKey, common.ETHService))
+}
+
+func TestMigrate3to4(t *testing.T) {
+	ctx, k := SetupKeeper(t)
+	ctx = ctx.WithBlockHeight(100)
+
+	setContract := func(id uint64, contractType types.ContractType, duration, settlementHeight int64) {
+		contract := types.NewContract(types.GetRandomPubKey(), common.BTCService, types.GetRandomPubKey())
+		contract.Id = id
+		contract.Type = contractType
+		contract.Height = 10
+		contract.Duration = duration
+		contract.SettlementGracePeriod = 10
+		contract.Rate = cosmos.NewInt64Coin("uarkeo", 10)
+		contract.Deposit = cosmos.NewInt(100)
+		contract.SettlementHeight = settlementHeight
+		require.NoError(t, k.SetContract(ctx, contract))
+	}
+	setContract(1, types.ContractType_PAY_AS_YOU_GO, 150, 0)  // expires at 160
+	setContract(2, types.ContractType_PAY_AS_YOU_GO, 80, 0)   // expired already, in its settlement period
+	setContract(3, types.ContractType_PAY_AS_YOU_GO, 150, 50) // settled
+	setContract(4, types.ContractType_SUBSCRIPTION, 150, 0)   // expires as it settles
+	setContract(5, types.ContractType_PAY_AS_YOU_GO, 90, 0)   // expires at this height
+
+	require.NoError(t, NewMigrator(k).Migrate3to4(ctx))
+
+	for height, ids := range map[int64][]uint64{160: {1}, 90: nil, 100: {5}} {
+		set, err := k.GetPayAsYouGoExpirationSet(ctx, height)
+		require.NoError(t, err)
+		require.Equal(t, ids, set.ContractSet.ContractIds)
+	}
+}
+
+func TestMigrate4to5(t *testing.T) {
+	ctx, k, sk := SetupKeeperWithStaking(t)
+	ctx = ctx.WithBlockHeight(10)
+	store := ctx.KVStore(k.(KVStore).storeKey)
+
+	newProvider := func() types.Provider {
+		provider := types.NewProvider(types.GetRandomPubKey(), common.BTCService)
+		provider.Status = types.ProviderStatus_ONLINE
+		provider.MinContractDuration = 10
+		provider.MaxContractDuration = 500
+		provider.Bond = cosmos.NewInt(common.Tokens(1))
+		return provider
+	}
+	// setLegacyProvider store the provider with its rates encoded as the legacy int64 amounts of uarkeo
+	setLegacyProvider := func(provider types.Provider, subscriptionRate, payAsYouGoRate int64) []byte {
+		bz := k.Cdc().MustMarshal(&provider)
+		for num, rate := range []int64{8: subscriptionRate, 9: payAsYouGoRate} {
+			if rate > 0 {
+				bz = protowire.AppendTag(bz, protowire.Number(num), protowire.VarintType)
+				bz = protowire.AppendVarint(bz, uint64(rate))
+			}
+		}
+		store.Set([]byte(k.GetKey(ctx, prefixProvider, provider.Key())), bz)
+		return bz
+	}
+	getBytes := func(provider types.Provider) []byte {
+		return store.Get([]byte(k.GetKey(ctx, prefixProvider, provider.Key())))
+	}
+
+	both := newProvider()
+	setLegacyProvider(both, 15, 2)
+	subscription := newProvider()
+	setLegacyProvider(subscription, 15, 0)
+	// stored since the multi-denom rates
+	current := newProvider()
+	current.SubscriptionRate = cosmos.NewCoins(cosmos.NewInt64Coin("uarkeo", 20), cosmos.NewInt64Coin("uatom", 1))
+	require.NoError(t, k.SetProvider(ctx, current))
+	currentBytes := getBytes(current)
+
+	// the legacy records don't decode as providers anymore
+	_, err := k.GetProvider(ctx, both.PubKey, both.Service)
+	require.Error(t, err)
+
+	// a contract opened with the legacy provider at its rate
+	client := types.GetRandomPubKey()
+	contract := types.NewContract(subscription.PubKey, subscription.Service, client)
+	contract.Id = 1
+	contract.Type = types.ContractType_SUBSCRIPTION
+	contract.Height = 5
+	contract.Duration = 100
+	contract.QueriesPerMinute = 1
+	contract.Rate = cosmos.NewInt64Coin("uarkeo", 15)
+	contract.Deposit = cosmos.NewInt(1500)
+	require.NoError(t, k.SetContract(ctx, contract))
+
+	require.NoError(t, NewMigrator(k).Migrate4to5(ctx))
+
+	both.SubscriptionRate = cosmos.NewCoins(cosmos.NewInt64Coin("uarkeo", 15))
+	both.PayAsYouGoRate = cosmos.NewCoins(cosmos.NewInt64Coin("uarkeo", 2))
+	require.Equal(t, k.Cdc().MustMarshal(&both), getBytes(both))
+	subscription.SubscriptionRate = cosmos.NewCoins(cosmos.NewInt64Coin("uarkeo", 15))
+	require.Equal(t, k.Cdc().MustMarshal(&subscription), getBytes(subscription))
+	require.Equal(t, currentBytes, getBytes(current))
+
+	migrated, err := k.GetProvider(ctx, subscription.PubKey, subscription.Service)
+	require.NoError(t, err)
+	require.Empty(t, migrated.PayAsYouGoRate)
+
+	// the contract still runs at the rate of its provider
+	clientAddress, err := client.GetMyAddress()
+	require.NoError(t, err)
+	require.NoError(t, newMsgServer(k, sk).TopUpContractValidate(ctx, types.NewMsgTopUpContract(clientAddress, contract.Id, cosmos.NewInt(150))))
+
+	// running it again changes nothing
+	require.NoError(t, NewMigrator(k).Migrate4to5(ctx))
+	require.Equal(t, k.Cdc().MustMarshal(&both), getBytes(both))
+}
+
+func TestMigrate5to6(t *testing.T) {
+	ctx, k := SetupKeeper(t)
+	store := ctx.KVStore(k.(KVStore).storeKey)
+
+	// providers stored before the service index
+	var providers []types.Provider
+	for _, service := range []common.Service{common.BTCService, common.BTCService, common.ETHService} {
+		provider := types.NewProvider(types.GetRandomPubKey(), service)
+		provider.Bond = cosmos.NewInt(common.Tokens(1))
+		store.Set([]byte(k.GetKey(ctx, prefixProvider, provider.Key())), k.Cdc().MustMarshal(&provider))
+		providers = append(providers, provider)
+	}
+	res, err := k.Providers(ctx, &types.QueryProvidersRequest{Service: common.BTCService.String()})
+	require.NoError(t, err)
+	require.Empty(t, res.Providers)
+
+	require.NoError(t, NewMigrator(k).Migrate5to6(ctx))
+
+	keys := func(providers []types.Provider) []string {
+		res := make([]string, len(providers))
+		for i, provider := range providers {
+			res[i] = provider.Key()
+		}
+		return res
+	}
+	res, err = k.Providers(ctx, &types.QueryProvidersRequest{Service: common.BTCService.String()})
+	require.NoError(t, err)
+	require.ElementsMatch(t, keys(providers[:2]), keys(res.Providers))
+	res, err = k.Providers(ctx, &types.QueryProvidersRequest{Service: common.ETHService.String()})
+	require.NoError(t, err)
+	require.Equal(t, keys(providers[2:]), keys(res.Providers))
+}
+
+func TestMigrate6to7(t *testing.T) {
+	ctx, k := SetupKeeper(t)
+	store := ctx.KVStore(k.(KVStore).storeKey)
+
+	// providers and contracts stored before the network stats
+	provider := types.NewProvider(types.GetRandomPubKey(), common.BTCService)
+	provider.Bond = cosmos.NewInt(common.Tokens(1))
+	provider.Status = types.ProviderStatus_ONLINE
+	store.Set([]byte(k.GetKey(ctx, prefixProvider, provider.Key())), k.Cdc().MustMarshal(&provider))
+	for id, settlementHeight := range []int64{0, 0, 20} {
+		contract := types.NewContract(provider.PubKey, common.BTCService, types.GetRandomPubKey())
+		contract.Id = uint64(id + 1)
+		contract.Rate = cosmos.NewInt64Coin("uarkeo", 10)
+		contract.Deposit = cosmos.NewInt(100)
+		contract.Paid = cosmos.NewInt(40)
+		contract.SettlementHeight = settlementHeight
+		store.Set([]byte(k.(KVStore).GetContractKey(ctx, contract.Id)), k.Cdc().MustMarshal(&contract))
+	}
+	stats, err := k.GetNetworkStats(ctx)
+	require.NoError(t, err)
+	require.Equal(t, types.NetworkStats{}, stats)
+
+	require.NoError(t, NewMigrator(k).Migrate6to7(ctx))
+
+	stats, err = k.GetNetworkStats(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), stats.Providers)
+	require.Equal(t, uint64(1), stats.ProvidersOnline)
+	require.Equal(t, cosmos.NewCoins(cosmos.NewInt64Coin("uarkeo", common.Tokens(1))), cosmos.Coins(stats.Bonded))
+	require.Equal(t, uint64(2), stats.OpenContracts)
+	require.Equal(t, cosmos.NewCoins(cosmos.NewInt64Coin("uarkeo", 120)), cosmos.Coins(stats.Escrowed))
+}
+
+func TestMigrate1to7Legacy(t *testing.T) {
+	ctx, k := SetupKeeper(t)
+	ctx = ctx.WithBlockHeight(100)
+	store := ctx.KVStore(k.(KVStore).storeKey)
+
+	// providers stored with their rates encoded as the legacy int64 amounts of uarkeo, as the upgrade finds them
+	setLegacyProvider := func(service common.Service, subscriptionRate, payAsYouGoRate int64) types.Provider {
+		provider := types.NewProvider(types.GetRandomPubKey(), service)
+		provider.Status = types.ProviderStatus_ONLINE
+		provider.Bond = cosmos.NewInt(common.Tokens(1))
+		bz := k.Cdc().MustMarshal(&provider)
+		for num, rate := range []int64{8: subscriptionRate, 9: payAsYouGoRate} {
+			if rate > 0 {
+				bz = protowire.AppendTag(bz, protowire.Number(num), protowire.VarintType)
+				bz = protowire.AppendVarint(bz, uint64(rate))
+			}
+		}
+		store.Set([]byte(k.GetKey(ctx, prefixProvider, provider.Key())), bz)
+		return provider
+	}
+	btc := setLegacyProvider(common.BTCService, 15, 2)
+	eth := setLegacyProvider(common.ETHService, 15, 0)
+
+	for id, settlementHeight := range []int64{0, 80} {
+		contract := types.NewContract(btc.PubKey, btc.Service, types.GetRandomPubKey())
+		contract.Id = uint64(id + 1)
+		contract.Type = types.ContractType_PAY_AS_YOU_GO
+		contract.Height = 90
+		contract.Duration = 50
+		contract.Rate = cosmos.NewInt64Coin("uarkeo", 2)
+		contract.Deposit = cosmos.NewInt(100)
+		contract.SettlementHeight = settlementHeight
+		store.Set([]byte(k.(KVStore).GetContractKey(ctx, contract.Id)), k.Cdc().MustMarshal(&contract))
+	}
+
+	m := NewMigrator(k)
+	for _, migrate := range []func(cosmos.Context) error{m.Migrate1to2, m.Migrate2to3, m.Migrate3to4, m.Migrate4to5, m.Migrate5to6, m.Migrate6to7} {
+		require.NoError(t, migrate(ctx))
+	}
+
+	provider, err := k.GetProvider(ctx, btc.PubKey, btc.Service)
+	require.NoError(t, err)
+	require.Equal(t, cosmos.NewCoins(cosmos.NewInt64Coin("uarkeo", 15)), cosmos.Coins(provider.SubscriptionRate))
+	require.Equal(t, cosmos.NewCoins(cosmos.NewInt64Coin("uarkeo", 2)), cosmos.Coins(provider.PayAsYouGoRate))
+	require.Equal(t, uint64(1), provider.OpenContracts)
+	provider, err = k.GetProvider(ctx, eth.PubKey, eth.Service)
+	require.NoError(t, err)
+	require.Equal(t, cosmos.NewCoins(cosmos.NewInt64Coin("uarkeo", 15)), cosmos.Coins(provider.SubscriptionRate))
+	require.Empty(t, provider.PayAsYouGoRate)
+
+	res, err := k.Providers(ctx, &types.QueryProvidersRequest{Service: common.ETHService.String()})
+	require.NoError(t, err)
+	require.Len(t, res.Providers, 1)
+	require.Equal(t, eth.Key(), res.Providers[0].Key())
+
+	stats, err := k.GetNetworkStats(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), stats.Providers)
+	require.Equal(t, uint64(2), stats.ProvidersOnline)
+	require.Equal(t, uint64(1), stats.OpenContracts)
+}
+
+func TestMigrate7to8(t *testing.T) {
+	ctx, k := SetupKeeper(t)
+
+	// the penalty a token, as it was in the configs
+	require.Equal(t, common.Tokens(1), k.GetParams(ctx).ProviderCloseContractPenalty)
+
+	require.NoError(t, NewMigrator(k).Migrate7to8(ctx))
+	require.True(t, k.(KVStore).paramstore.Has(ctx, types.KeyProviderCloseContractPenalty))
+	require.Equal(t, types.DefaultProviderCloseContractPenalty, k.GetParams(ctx).ProviderCloseContractPenalty)
+
+	// a penalty set by governance since is kept
+	params := k.GetParams(ctx)
+	params.ProviderCloseContractPenalty = 5
+	k.SetParams(ctx, params)
+	require.NoError(t, NewMigrator(k).Migrate7to8(ctx))
+	require.Equal(t, int64(5), k.GetParams(ctx).ProviderCloseContractPenalty)
+}
+
+func TestMigrate8to9(t *testing.T) {
+	ctx, k := SetupKeeper(t)
+	ctx = ctx.WithBlockHeight(100)
+	store := ctx.KVStore(k.(KVStore).storeKey)
+
+	// contracts stored before the provider index, one of them settled
+	provider := types.GetRandomPubKey()
+	for id, settlementHeight := range []int64{0, 0, 20} {
+		contract := types.NewContract(provider, common.BTCService, types.GetRandomPubKey())
+		contract.Id = uint64(id + 1)
+		contract.Height = 10
+		contract.Duration = 200
+		contract.Rate = cosmos.NewInt64Coin("uarkeo", 10)
+		contract.Deposit = cosmos.NewInt(100)
+		contract.SettlementHeight = settlementHeight
+		store.Set([]byte(k.(KVStore).GetContractKey(ctx, contract.Id)), k.Cdc().MustMarshal(&contract))
+	}
+	require.NoError(t, NewMigrator(k).Migrate6to7(ctx))
+	stats, err := k.GetNetworkStats(ctx)
+	require.NoError(t, err)
+	req := &types.QueryContractsByProviderRequest{Provider: provider.String(), Service: common.BTCService.String()}
+	res, err := k.ContractsByProvider(ctx, req)
+	require.NoError(t, err)
+	require.Empty(t, res.Contract)
+
+	require.NoError(t, NewMigrator(k).Migrate8to9(ctx))
+
+	ids := func(contracts []types.Contract) []uint64 {
+		res := make([]uint64, len(contracts))
+		for i, contract := range contracts {
+			res[i] = contract.Id
+		}
+		return res
+	}
+	res, err = k.ContractsByProvider(ctx, req)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{1, 2, 3}, ids(res.Contract))
+	req.State = types.ContractStateClosed
+	res, err = k.ContractsByProvider(ctx, req)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{3}, ids(res.Contract))
+
+	// the contracts are stored as they were, the stats untouched
+	after, err := k.GetNetworkStats(ctx)
+	require.NoError(t, err)
+	require.Equal(t, stats, after)
+
+	// running it again changes nothing
+	require.NoError(t, NewMigrator(k).Migrate8to9(ctx))
+	req.State = ""
+	res, err = k.ContractsByProvider(ctx, req)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{1, 2, 3}, ids(res.Contract))
+}
+
+func TestMigrate9to10(t *testing.T) {
+	ctx, k := SetupKeeper(t)
+	ctx = ctx.WithBlockHeight(100)
+
+	// delegated contracts opened before the clients were indexed, in the set of their delegate only
+	client := types.GetRandomPubKey()
+	delegate := types.GetRandomPubKey()
+	for id, settlementHeight := range []int64{0, 0, 20} {
+		contract := types.NewContract(types.GetRandomPubKey(), common.BTCService, client)
+		contract.Id = uint64(id + 1)
+		contract.Height = 10
+		contract.Duration = 200
+		contract.Rate = cosmos.NewInt64Coin("uarkeo", 10)
+		contract.SettlementHeight = settlementHeight
+		// the first contract isn't delegated
+		if id > 0 {
+			contract.Delegate = delegate
+		}
+		require.NoError(t, k.SetContract(ctx, contract))
+		if settlementHeight == 0 {
+			require.NoError(t, k.AddToUserContractSet(ctx, contract.GetSpender(), contract.Id))
+		}
+	}
+	ids := func(pubkey common.PubKey) []uint64 {
+		res, err := k.ContractsByOwner(ctx, &types.QueryContractsByOwnerRequest{Pubkey: pubkey.String(), IncludeDelegate: true})
+		require.NoError(t, err)
+		result := make([]uint64, 0, len(res.Contracts))
+		for _, contract := range res.Contracts {
+			result = append(result, contract.Contract.Id)
+		}
+		return result
+	}
+	require.Equal(t, []uint64{1}, ids(client))
+	require.Equal(t, []uint64{2}, ids(delegate))
+
+	require.NoError(t, NewMigrator(k).Migrate9to10(ctx))
+	require.Equal(t, []uint64{1, 2}, ids(client))
+	require.Equal(t, []uint64{2}, ids(delegate))
+
+	// running it again changes nothing
+	require.NoError(t, NewMigrator(k).Migrate9to10(ctx))
+	set, err := k.GetUserContractSet(ctx, client)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{1, 2}, set.ContractSet.ContractIds)
+}
@@ -0,0 +1,134 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/x/tx/signing"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/std"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authcodec "github.com/cosmos/cosmos-sdk/x/auth/codec"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/arkeonetwork/arkeo/common"
+	"github.com/arkeonetwork/arkeo/common/cosmos"
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+)
+
+// authzAccountKeeper only provides the address codec, the grants being saved directly
+type authzAccountKeeper struct {
+	authz.AccountKeeper
+}
+
+func (authzAccountKeeper) AddressCodec() address.Codec {
+	return authcodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+}
+
+// setupAuthz return an authz keeper dispatching the messages it executes to the arkeo msg server, along with the
+// codec it gets the signers of the messages from
+func setupAuthz(t *testing.T, k Keeper, sk stakingkeeper.Keeper) (authzkeeper.Keeper, codec.Codec) {
+	registry, err := codectypes.NewInterfaceRegistryWithOptions(codectypes.InterfaceRegistryOptions{
+		ProtoFiles: proto.HybridResolver,
+		SigningOptions: signing.Options{
+			AddressCodec:          authzAccountKeeper{}.AddressCodec(),
+			ValidatorAddressCodec: authcodec.NewBech32Codec(sdk.GetConfig().GetBech32ValidatorAddrPrefix()),
+		},
+	})
+	require.NoError(t, err)
+	std.RegisterInterfaces(registry)
+	authz.RegisterInterfaces(registry)
+	types.RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+
+	router := baseapp.NewMsgServiceRouter()
+	router.SetInterfaceRegistry(registry)
+	types.RegisterMsgServer(router, newMsgServer(k, sk))
+	return authzkeeper.NewKeeper(runtime.NewKVStoreService(keyAuthz), cdc, router, authzAccountKeeper{}), cdc
+}
+
+func TestProviderMsgsAuthz(t *testing.T) {
+	ctx, k, sk := SetupKeeperWithStaking(t)
+	now := time.Now().UTC()
+	ctx = ctx.WithBlockHeight(10).WithBlockTime(now)
+	ak, cdc := setupAuthz(t, k, sk)
+
+	// the type URLs grants are given for
+	require.Equal(t, "/arkeo.arkeo.MsgBondProvider", sdk.MsgTypeURL(&types.MsgBondProvider{}))
+	require.Equal(t, "/arkeo.arkeo.MsgModProvider", sdk.MsgTypeURL(&types.MsgModProvider{}))
+
+	providerPubKey := types.GetRandomPubKey()
+	providerAddress, err := providerPubKey.GetMyAddress()
+	require.NoError(t, err)
+	require.NoError(t, k.MintAndSendToAccount(ctx, providerAddress, getCoin(common.Tokens(10))))
+	service := common.BTCService
+
+	// the provider account itself is the signer of the messages
+	bondMsg := types.NewMsgBondProvider(providerAddress, providerPubKey, service.String(), cosmos.NewInt(common.Tokens(1)))
+	signers, _, err := cdc.GetMsgV1Signers(bondMsg)
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{providerAddress}, signers)
+
+	modMsg := &types.MsgModProvider{
+		Creator:       providerAddress.String(),
+		Provider:      providerPubKey,
+		Service:       service.String(),
+		MetadataNonce: 5,
+		UpdateMask:    []string{types.ModProviderFieldMetadataNonce},
+	}
+
+	// the ops key has no grant yet
+	ops := types.GetRandomBech32Addr()
+	exec := func(msg sdk.Msg) error {
+		execMsg := authz.NewMsgExec(ops, []sdk.Msg{msg})
+		_, err := ak.Exec(ctx, &execMsg)
+		return err
+	}
+	require.ErrorIs(t, exec(bondMsg), authz.ErrNoAuthorizationFound)
+	require.ErrorIs(t, exec(modMsg), authz.ErrNoAuthorizationFound)
+
+	// the provider grants both messages to the ops key for an hour
+	expiration := now.Add(time.Hour)
+	for _, msg := range []sdk.Msg{bondMsg, modMsg} {
+		require.NoError(t, ak.SaveGrant(ctx, ops, providerAddress, authz.NewGenericAuthorization(sdk.MsgTypeURL(msg)), &expiration))
+	}
+
+	require.NoError(t, exec(bondMsg))
+	provider, err := k.GetProvider(ctx, providerPubKey, service)
+	require.NoError(t, err)
+	require.Equal(t, common.Tokens(1), provider.Bond.Int64())
+	require.Equal(t, common.Tokens(9), k.GetBalance(ctx, providerAddress).AmountOf("uarkeo").Int64())
+
+	require.NoError(t, exec(modMsg))
+	provider, err = k.GetProvider(ctx, providerPubKey, service)
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), provider.MetadataNonce)
+
+	// the grant only covers the provider account, another provider can't be bonded from it
+	otherPubKey := types.GetRandomPubKey()
+	otherAddress, err := otherPubKey.GetMyAddress()
+	require.NoError(t, err)
+	require.ErrorIs(t, exec(types.NewMsgBondProvider(otherAddress, otherPubKey, service.String(), cosmos.NewInt(1))), authz.ErrNoAuthorizationFound)
+
+	// nor is it given to another key
+	stranger := types.GetRandomBech32Addr()
+	strangerMsg := authz.NewMsgExec(stranger, []sdk.Msg{modMsg})
+	_, err = ak.Exec(ctx, &strangerMsg)
+	require.ErrorIs(t, err, authz.ErrNoAuthorizationFound)
+
+	// the grant expired
+	ctx = ctx.WithBlockTime(expiration.Add(time.Second))
+	modMsg.MetadataNonce = 6
+	require.ErrorIs(t, exec(modMsg), authz.ErrAuthorizationExpired)
+	provider, err = k.GetProvider(ctx, providerPubKey, service)
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), provider.MetadataNonce)
+}
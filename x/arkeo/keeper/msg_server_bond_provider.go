@@ -47,7 +47,8 @@ func (k msgServer) BondProviderValidate(ctx cosmos.Context, msg *types.MsgBondPr
 	// is because A) users can cancel their owned contracts at any time, and B)
 	// this is the way the provider signals to the service that they don't want
 	// to open any new contracts (as there is a min bond requirement for new
-	// contracts to be opened)
+	// contracts to be opened). A provider left with a bond below the minimum
+	// of its service is set offline by the handler.
 
 	return nil
 }
@@ -94,15 +95,22 @@ func (k msgServer) BondProviderHandle(ctx cosmos.Context, msg *types.MsgBondProv
 	}
 	provider.Bond = provider.Bond.Add(msg.Bond)
 	if provider.Bond.IsZero() {
-		k.RemoveProvider(ctx, provider.PubKey, provider.Service)
-		return k.EmitBondProviderEvent(ctx, provider.Bond, msg)
+		if err := k.RemoveProvider(ctx, provider.PubKey, provider.Service); err != nil {
+			return err
+		}
+	} else {
+		provider.LastUpdate = ctx.BlockHeight()
+		// the provider can bond in several steps, it just can't stay online below the minimum
+		if err := k.mgr.demoteUnderbondedProvider(ctx, &provider); err != nil {
+			return err
+		}
+		if err := k.SetProvider(ctx, provider); err != nil {
+			return err
+		}
 	}
 
-	provider.LastUpdate = ctx.BlockHeight()
-
-	err = k.SetProvider(ctx, provider)
-	if err == nil {
-		return k.EmitBondProviderEvent(ctx, provider.Bond, msg)
+	if err := k.Hooks().AfterProviderBonded(ctx, provider, msg.Bond); err != nil {
+		return err
 	}
-	return err
+	return k.EmitBondProviderEvent(ctx, provider.Bond, msg)
 }
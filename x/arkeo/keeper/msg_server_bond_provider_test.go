@@ -62,3 +62,62 @@ func TestHandle(t *testing.T) {
 	require.Equal(t, bal.AmountOf(configs.Denom).Int64(), common.Tokens(10))
 	require.False(t, k.ProviderExists(ctx, providerPubKey, common.BTCService)) // should be removed
 }
+
+func TestHandleMinProviderBond(t *testing.T) {
+	ctx, k, sk := SetupKeeperWithStaking(t)
+	s := newMsgServer(k, sk)
+
+	providerPubKey := types.GetRandomPubKey()
+	acct, err := providerPubKey.GetMyAddress()
+	require.NoError(t, err)
+	require.NoError(t, k.MintAndSendToAccount(ctx, acct, getCoin(common.Tokens(10))))
+
+	bond := func(amt int64) {
+		require.NoError(t, s.BondProviderHandle(ctx, &types.MsgBondProvider{
+			Creator:  acct.String(),
+			Provider: providerPubKey.String(),
+			Service:  common.BTCService.String(),
+			Bond:     cosmos.NewInt(amt),
+		}))
+	}
+	online := &types.MsgModProvider{
+		Creator:    acct.String(),
+		Provider:   providerPubKey,
+		Service:    common.BTCService.String(),
+		Status:     types.ProviderStatus_ONLINE,
+		UpdateMask: []string{types.ModProviderFieldStatus},
+	}
+
+	// bonding below the minimum is allowed, going online isn't
+	bond(common.Tokens(1) / 2)
+	err = s.ModProviderValidate(ctx, online)
+	require.ErrorIs(t, err, types.ErrProviderBondBelowMinimum)
+	require.ErrorContains(t, err, cosmos.NewInt(common.Tokens(1)).String())
+
+	// once the bond reaches the minimum, the provider can go online
+	bond(common.Tokens(1) / 2)
+	require.NoError(t, s.ModProviderValidate(ctx, online))
+	require.NoError(t, s.ModProviderHandle(ctx, online))
+
+	// the service requires more bond, the next bond leaves the provider offline
+	params := k.GetParams(ctx)
+	params.ServiceMinBonds = []types.ServiceMinBond{{Service: common.BTCService.String(), MinBond: common.Tokens(3)}}
+	k.SetParams(ctx, params)
+	ctx = ctx.WithEventManager(cosmos.NewEventManager())
+	bond(common.Tokens(1))
+	provider, err := k.GetProvider(ctx, providerPubKey, common.BTCService)
+	require.NoError(t, err)
+	require.Equal(t, types.ProviderStatus_OFFLINE, provider.Status)
+	demoted := false
+	for _, evt := range ctx.EventManager().Events() {
+		demoted = demoted || evt.Type == types.EventTypeProviderDemoted
+	}
+	require.True(t, demoted)
+
+	// the other services keep the global minimum
+	require.Equal(t, cosmos.NewInt(common.Tokens(3)), s.mgr.minProviderBond(ctx, common.BTCService))
+	require.Equal(t, cosmos.NewInt(common.Tokens(1)), s.mgr.minProviderBond(ctx, common.ETHService))
+	params.MinProviderBond = common.Tokens(2)
+	k.SetParams(ctx, params)
+	require.Equal(t, cosmos.NewInt(common.Tokens(2)), s.mgr.minProviderBond(ctx, common.ETHService))
+}
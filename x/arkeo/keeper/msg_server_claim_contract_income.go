@@ -36,22 +36,24 @@ func (k msgServer) HandlerClaimContractIncome(ctx cosmos.Context, msg *types.Msg
 		return errors.Wrapf(types.ErrDisabledHandler, "Claim Contract Income")
 	}
 
+	if !k.ContractExists(ctx, msg.ContractId) {
+		return errors.Wrapf(types.ErrContractNotFound, "id: %d", msg.ContractId)
+	}
+
 	contract, err := k.GetContract(ctx, msg.ContractId)
 
 	if err != nil {
 		return err
 	}
 
-	if contract.Nonce >= msg.Nonce {
-		return errors.Wrapf(types.ErrClaimContractIncomeBadNonce, "contract nonce (%d) is greater than msg nonce (%d)", contract.Nonce, msg.Nonce)
-	}
-
-	if contract.IsSettled(ctx.BlockHeight()) {
-		return errors.Wrapf(types.ErrClaimContractIncomeClosed, "settled on block: %d", contract.SettlementPeriodEnd())
+	nonce, err := claimNonce(contract, msg.Nonce, ctx.BlockHeight())
+	if err != nil {
+		return err
 	}
 
 	// open subscription contracts do NOT need to verify the signature
-	if !(contract.IsSubscription() && contract.IsOpenAuthorization()) {
+	signed := !(contract.IsSubscription() && contract.IsOpenAuthorization())
+	if signed {
 		pk, err := cosmos.GetPubKeyFromBech32(cosmos.Bech32PubKeyTypeAccPub, contract.GetSpender().String())
 		if err != nil {
 			return err
@@ -61,9 +63,25 @@ func (k msgServer) HandlerClaimContractIncome(ctx cosmos.Context, msg *types.Msg
 		}
 	}
 
+	// a nonce over what the contract allows proves the provider claims queries it never served, it is slashed and
+	// paid for the queries it could have served only. It is a proof only as the provider submit a nonce the client
+	// signed, anyone else could slash the provider at will, the claim is rejected.
+	if nonce < msg.Nonce {
+		providerAddress, err := contract.Provider.GetMyAddress()
+		if err != nil {
+			return errors.Wrapf(types.ErrInvalidPubKey, "Provider: %s", contract.Provider.String())
+		}
+		if !signed || !providerAddress.Equals(msg.MustGetSigner()) {
+			return errors.Wrapf(types.ErrClaimContractIncomeOverLimit, "nonce %d, at most %d", msg.Nonce, nonce)
+		}
+		if err := k.mgr.SlashProvider(ctx, contract, types.SlashReasonOverClaim); err != nil {
+			return err
+		}
+	}
+
 	// excute settlement
 
-	_, err = k.mgr.SettleContract(ctx, contract, msg.Nonce, false)
+	_, err = k.mgr.SettleContract(ctx, contract, nonce, false)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,77 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/arkeonetwork/arkeo/common/cosmos"
+	"github.com/arkeonetwork/arkeo/x/arkeo/configs"
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+)
+
+func (k msgServer) ClaimContractIncomeBatch(goCtx context.Context, msg *types.MsgClaimContractIncomeBatch) (*types.MsgClaimContractIncomeBatchResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	ctx.Logger().Info(
+		"receive MsgClaimContractIncomeBatch",
+		"claims", len(msg.Claims),
+	)
+
+	if err := k.ClaimContractIncomeBatchValidate(ctx, msg); err != nil {
+		ctx.Logger().Error("failed claim contract income batch validation", "err", err)
+		return nil, err
+	}
+
+	results, err := k.ClaimContractIncomeBatchHandle(ctx, msg)
+	if err != nil {
+		ctx.Logger().Error("failed claim contract income batch handler", "err", err)
+		return nil, err
+	}
+
+	return &types.MsgClaimContractIncomeBatchResponse{Results: results}, nil
+}
+
+func (k msgServer) ClaimContractIncomeBatchValidate(ctx cosmos.Context, msg *types.MsgClaimContractIncomeBatch) error {
+	if k.FetchConfig(ctx, configs.HandlerClaimContractIncomeBatch) > 0 {
+		return errors.Wrapf(types.ErrDisabledHandler, "claim contract income batch")
+	}
+
+	if maxSize := k.GetParams(ctx).MaxClaimBatchSize; uint64(len(msg.Claims)) > maxSize {
+		return errors.Wrapf(types.ErrClaimContractIncomeBatchSize, "%d claims, at most %d allowed", len(msg.Claims), maxSize)
+	}
+
+	return nil
+}
+
+// ClaimContractIncomeBatchHandle process each claim of the batch as a MsgClaimContractIncome of its own, a failed claim
+// leaving the state untouched without failing the others
+func (k msgServer) ClaimContractIncomeBatchHandle(ctx cosmos.Context, msg *types.MsgClaimContractIncomeBatch) ([]types.ContractClaimResult, error) {
+	// the signature of each claim is verified on top of the one of the tx, charge it as the ante handler would
+	itemGas := uint64(k.FetchConfig(ctx, configs.ClaimBatchItemGas))
+
+	results := make([]types.ContractClaimResult, 0, len(msg.Claims))
+	for _, claim := range msg.Claims {
+		ctx.GasMeter().ConsumeGas(itemGas, "claim contract income batch item")
+
+		result := types.ContractClaimResult{
+			ContractId: claim.ContractId,
+			Nonce:      claim.Nonce,
+		}
+		cacheCtx, commit := ctx.CacheContext()
+		if err := k.HandlerClaimContractIncome(cacheCtx, claim.ClaimMsg(msg.Creator)); err != nil {
+			ctx.Logger().Info("failed to claim contract income of batch", "contract_id", claim.ContractId, "err", err)
+			result.Error = err.Error()
+		} else {
+			commit()
+			result.Success = true
+		}
+		results = append(results, result)
+	}
+
+	if err := k.EmitClaimContractIncomeBatchEvent(ctx, msg.Creator, results); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
@@ -0,0 +1,154 @@
+package keeper
+
+import (
+	"testing"
+
+	storetypes "cosmossdk.io/store/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	cKeys "github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/std"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/stretchr/testify/require"
+
+	"github.com/arkeonetwork/arkeo/common"
+	"github.com/arkeonetwork/arkeo/common/cosmos"
+	"github.com/arkeonetwork/arkeo/x/arkeo/configs"
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+)
+
+func TestClaimContractIncomeBatch(t *testing.T) {
+	ctx, k, sk := SetupKeeperWithStaking(t)
+	ctx = ctx.WithBlockHeight(20)
+	s := newMsgServer(k, sk)
+
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	std.RegisterInterfaces(interfaceRegistry)
+	module.NewBasicManager().RegisterInterfaces(interfaceRegistry)
+	types.RegisterInterfaces(interfaceRegistry)
+	cdc := codec.NewProtoCodec(interfaceRegistry)
+
+	kb := cKeys.NewInMemory(cdc)
+	info, _, err := kb.NewMnemonic("whatever", cKeys.English, `m/44'/931'/0'/0/0`, "", hd.Secp256k1)
+	require.NoError(t, err)
+	pk, err := info.GetPubKey()
+	require.NoError(t, err)
+	client, err := common.NewPubKeyFromCrypto(pk)
+	require.NoError(t, err)
+	rate, err := cosmos.ParseCoin("10uarkeo")
+	require.NoError(t, err)
+
+	providerPubKey := types.GetRandomPubKey()
+	newContract := func(id uint64, service common.Service, nonce int64) types.Contract {
+		contract := types.NewContract(providerPubKey, service, client)
+		contract.Id = id
+		contract.Type = types.ContractType_PAY_AS_YOU_GO
+		contract.Height = 10
+		contract.Duration = 100
+		contract.Rate = rate
+		contract.Nonce = nonce
+		contract.Deposit = cosmos.NewInt(contract.Duration * contract.Rate.Amount.Int64())
+		require.NoError(t, k.SetContract(ctx, contract))
+		return contract
+	}
+	valid := newContract(1, common.BTCService, 0)
+	stale := newContract(2, common.ETHService, 30)
+	require.NoError(t, k.MintToModule(ctx, types.ModuleName, getCoin(common.Tokens(10))))
+	require.NoError(t, k.SendFromModuleToModule(ctx, types.ModuleName, types.ContractName, getCoins(2000)))
+
+	claim := func(contractId uint64, nonce int64) types.ContractClaim {
+		signature, _, err := kb.Sign("whatever", types.GetBytesToSign(contractId, nonce), signing.SignMode_SIGN_MODE_DIRECT)
+		require.NoError(t, err)
+		return types.ContractClaim{ContractId: contractId, Nonce: nonce, Signature: signature}
+	}
+	creator := types.GetRandomBech32Addr()
+	msg := types.NewMsgClaimContractIncomeBatch(creator, []types.ContractClaim{
+		claim(valid.Id, 20),
+		claim(stale.Id, 10),
+		claim(99, 5),
+	})
+	require.NoError(t, msg.ValidateBasic())
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	res, err := s.ClaimContractIncomeBatch(ctx, msg)
+	require.NoError(t, err)
+	require.Len(t, res.Results, 3)
+
+	// the valid claim settles its contract
+	require.Equal(t, types.ContractClaimResult{ContractId: valid.Id, Nonce: 20, Success: true}, res.Results[0])
+	contract, err := k.GetContract(ctx, valid.Id)
+	require.NoError(t, err)
+	require.Equal(t, int64(20), contract.Nonce)
+	require.Equal(t, cosmos.NewInt(200), contract.Paid)
+
+	// the stale nonce and the unknown contract fail on their own, leaving the state as it was
+	require.False(t, res.Results[1].Success)
+	require.Contains(t, res.Results[1].Error, types.ErrClaimContractIncomeBadNonce.Error())
+	contract, err = k.GetContract(ctx, stale.Id)
+	require.NoError(t, err)
+	require.Equal(t, int64(30), contract.Nonce)
+	require.True(t, contract.Paid.IsZero())
+	require.False(t, res.Results[2].Success)
+	require.Contains(t, res.Results[2].Error, types.ErrContractNotFound.Error())
+	require.False(t, k.ContractExists(ctx, 99))
+
+	// the results are emitted along the settlement of the valid claim only
+	var batchEvents []*types.EventClaimContractIncomeBatch
+	settled := 0
+	for _, event := range ctx.EventManager().Events() {
+		switch event.Type {
+		case types.EventTypeSettleContract:
+			settled++
+		case types.EventTypeClaimContractIncomeBatch:
+			typedEvent, err := sdk.ParseTypedEvent(abci.Event(event))
+			require.NoError(t, err)
+			batchEvents = append(batchEvents, typedEvent.(*types.EventClaimContractIncomeBatch))
+		}
+	}
+	require.Equal(t, 1, settled)
+	require.Len(t, batchEvents, 1)
+	require.Equal(t, creator.String(), batchEvents[0].Creator)
+	require.Equal(t, res.Results, batchEvents[0].Results)
+
+	// an over claim batched by anyone but the provider is rejected rather than slashing the provider
+	capped := newContract(3, common.BTCService, 0)
+	capped.QueriesPerMinute = 1
+	require.NoError(t, k.SetContract(ctx, capped))
+	slashCtx := ctx.WithEventManager(sdk.NewEventManager())
+	res, err = s.ClaimContractIncomeBatch(slashCtx, types.NewMsgClaimContractIncomeBatch(creator, []types.ContractClaim{
+		claim(capped.Id, capped.MaxQueries()+1),
+	}))
+	require.NoError(t, err)
+	require.False(t, res.Results[0].Success)
+	require.Contains(t, res.Results[0].Error, types.ErrClaimContractIncomeOverLimit.Error())
+	for _, event := range slashCtx.EventManager().Events() {
+		require.NotEqual(t, types.EventTypeSlashProvider, event.Type)
+	}
+
+	// the gas grows with the claims of the batch
+	gasUsed := func(claims ...types.ContractClaim) uint64 {
+		gasCtx := ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+		_, err := s.ClaimContractIncomeBatch(gasCtx, types.NewMsgClaimContractIncomeBatch(creator, claims))
+		require.NoError(t, err)
+		return gasCtx.GasMeter().GasConsumed()
+	}
+	itemGas := uint64(s.FetchConfig(ctx, configs.ClaimBatchItemGas))
+	one := gasUsed(claim(99, 5))
+	three := gasUsed(claim(99, 5), claim(99, 6), claim(99, 7))
+	require.GreaterOrEqual(t, three-one, 2*itemGas)
+
+	// the batch can't carry more claims than the params allow
+	params := k.GetParams(ctx)
+	params.MaxClaimBatchSize = 2
+	k.SetParams(ctx, params)
+	_, err = s.ClaimContractIncomeBatch(ctx, msg)
+	require.ErrorIs(t, err, types.ErrClaimContractIncomeBatchSize)
+	params.MaxClaimBatchSize = 0
+	k.SetParams(ctx, params)
+	_, err = s.ClaimContractIncomeBatch(ctx, types.NewMsgClaimContractIncomeBatch(creator, []types.ContractClaim{claim(99, 5)}))
+	require.ErrorIs(t, err, types.ErrClaimContractIncomeBatchSize)
+}
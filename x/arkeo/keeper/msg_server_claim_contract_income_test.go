@@ -1,15 +1,19 @@
 package keeper
 
 import (
+	"strings"
 	"testing"
 
+	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
 	cKeys "github.com/cosmos/cosmos-sdk/crypto/keyring"
 	"github.com/cosmos/cosmos-sdk/std"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/spf13/cast"
 	"github.com/stretchr/testify/require"
 
 	"github.com/arkeonetwork/arkeo/common"
@@ -81,6 +85,133 @@ func TestValidate(t *testing.T) {
 	require.ErrorIs(t, err, types.ErrClaimContractIncomeClosed)
 }
 
+func TestClaimContractIncomeSettlementGracePeriod(t *testing.T) {
+	var err error
+	ctx, k, sk := SetupKeeperWithStaking(t)
+	ctx = ctx.WithBlockHeight(20)
+
+	s := newMsgServer(k, sk)
+
+	// setup
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	std.RegisterInterfaces(interfaceRegistry)
+	module.NewBasicManager().RegisterInterfaces(interfaceRegistry)
+	types.RegisterInterfaces(interfaceRegistry)
+	cdc := codec.NewProtoCodec(interfaceRegistry)
+
+	pubkey := types.GetRandomPubKey()
+	acc := types.GetRandomBech32Addr()
+	kb := cKeys.NewInMemory(cdc)
+	info, _, err := kb.NewMnemonic("whatever", cKeys.English, `m/44'/931'/0'/0/0`, "", hd.Secp256k1)
+	require.NoError(t, err)
+	pk, err := info.GetPubKey()
+	require.NoError(t, err)
+	client, err := common.NewPubKeyFromCrypto(pk)
+	require.NoError(t, err)
+	rate, err := cosmos.ParseCoin("10uarkeo")
+	require.NoError(t, err)
+
+	// expires at 110, the settlement period and its grace period end at 125
+	contract := types.NewContract(pubkey, common.BTCService, client)
+	contract.Duration = 100
+	contract.Rate = rate
+	contract.Height = 10
+	contract.Type = types.ContractType_PAY_AS_YOU_GO
+	contract.Deposit = cosmos.NewInt(contract.Duration * contract.Rate.Amount.Int64())
+	contract.SettlementDuration = 5
+	contract.SettlementGracePeriod = 10
+	contract.Id = 1
+	require.NoError(t, k.SetContract(ctx, contract))
+	require.NoError(t, k.MintToModule(ctx, types.ModuleName, getCoin(common.Tokens(10000*100*2))))
+	require.NoError(t, k.SendFromModuleToModule(ctx, types.ModuleName, types.ContractName, getCoins(1000*100)))
+
+	claim := func(nonce int64) types.MsgClaimContractIncome {
+		msg := types.MsgClaimContractIncome{
+			ContractId: contract.Id,
+			Creator:    acc.String(),
+			Nonce:      nonce,
+		}
+		msg.Signature, _, err = kb.Sign("whatever", msg.GetBytesToSign(), signing.SignMode_SIGN_MODE_DIRECT)
+		require.NoError(t, err)
+		return msg
+	}
+
+	// a claim past the settlement duration, inside the grace period
+	msg := claim(20)
+	require.NoError(t, s.HandlerClaimContractIncome(ctx.WithBlockHeight(124), &msg))
+
+	// a claim just outside the grace period
+	msg = claim(30)
+	err = s.HandlerClaimContractIncome(ctx.WithBlockHeight(125), &msg)
+	require.ErrorIs(t, err, types.ErrClaimContractIncomeClosed)
+
+	contract, err = k.GetContract(ctx, contract.Id)
+	require.NoError(t, err)
+	require.Equal(t, int64(20), contract.Nonce)
+}
+
+func TestClaimContractIncomeGracePeriodAfterClientClose(t *testing.T) {
+	var err error
+	ctx, k, sk := SetupKeeperWithStaking(t)
+	ctx = ctx.WithBlockHeight(20)
+
+	s := newMsgServer(k, sk)
+
+	// setup
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	std.RegisterInterfaces(interfaceRegistry)
+	module.NewBasicManager().RegisterInterfaces(interfaceRegistry)
+	types.RegisterInterfaces(interfaceRegistry)
+	cdc := codec.NewProtoCodec(interfaceRegistry)
+
+	pubkey := types.GetRandomPubKey()
+	acc := types.GetRandomBech32Addr()
+	kb := cKeys.NewInMemory(cdc)
+	info, _, err := kb.NewMnemonic("whatever", cKeys.English, `m/44'/931'/0'/0/0`, "", hd.Secp256k1)
+	require.NoError(t, err)
+	pk, err := info.GetPubKey()
+	require.NoError(t, err)
+	client, err := common.NewPubKeyFromCrypto(pk)
+	require.NoError(t, err)
+	rate, err := cosmos.ParseCoin("10uarkeo")
+	require.NoError(t, err)
+
+	contract := types.NewContract(pubkey, common.BTCService, client)
+	contract.Duration = 100
+	contract.Rate = rate
+	contract.Height = 10
+	contract.Type = types.ContractType_PAY_AS_YOU_GO
+	contract.Deposit = cosmos.NewInt(contract.Duration * contract.Rate.Amount.Int64())
+	contract.SettlementDuration = 5
+	contract.SettlementGracePeriod = 10
+	contract.Id = 1
+	require.NoError(t, k.SetContract(ctx, contract))
+	require.NoError(t, k.AddToContractExpirationSet(ctx, contract.SettlementPeriodEnd(), contract.Id))
+	require.NoError(t, k.AddToUserContractSet(ctx, client, contract.Id))
+	require.NoError(t, k.MintToModule(ctx, types.ModuleName, getCoin(common.Tokens(10000*100*2))))
+	require.NoError(t, k.SendFromModuleToModule(ctx, types.ModuleName, types.ContractName, getCoins(1000*100)))
+
+	// closed by its client at 20, its settlement duration is over at 25 and its grace period at 35
+	closeMsg := types.MsgCloseContract{Creator: contract.ClientAddress().String(), ContractId: contract.Id, Client: client}
+	require.NoError(t, s.CloseContractHandle(ctx, &closeMsg))
+	require.NoError(t, s.mgr.ContractEndBlock(ctx.WithBlockHeight(25)))
+
+	// a claim inside the grace period is paid
+	msg := types.MsgClaimContractIncome{ContractId: contract.Id, Creator: acc.String(), Nonce: 20}
+	msg.Signature, _, err = kb.Sign("whatever", msg.GetBytesToSign(), signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+	require.NoError(t, s.HandlerClaimContractIncome(ctx.WithBlockHeight(34), &msg))
+
+	// the contract is settled once the grace period is over
+	ctx = ctx.WithBlockHeight(35)
+	require.NoError(t, s.mgr.ContractEndBlock(ctx))
+	contract, err = k.GetContract(ctx, contract.Id)
+	require.NoError(t, err)
+	require.Equal(t, int64(20), contract.Nonce)
+	require.Equal(t, int64(200), contract.Paid.Int64())
+	require.Equal(t, int64(35), contract.SettlementHeight)
+}
+
 func TestHandlePayAsYouGo(t *testing.T) {
 	var err error
 	ctx, k, sk := SetupKeeperWithStaking(t)
@@ -381,3 +512,229 @@ func TestClaimContractIncomeHandlerSignatureVerification(t *testing.T) {
 	err = s.HandlerClaimContractIncome(ctx, &msg)
 	require.Error(t, err, types.ErrClaimContractIncomeInvalidSignature)
 }
+
+func TestClaimContractIncomeSlash(t *testing.T) {
+	var err error
+	ctx, k, sk := SetupKeeperWithStaking(t)
+	ctx = ctx.WithBlockHeight(20)
+
+	s := newMsgServer(k, sk)
+
+	// setup
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	std.RegisterInterfaces(interfaceRegistry)
+	module.NewBasicManager().RegisterInterfaces(interfaceRegistry)
+	types.RegisterInterfaces(interfaceRegistry)
+	cdc := codec.NewProtoCodec(interfaceRegistry)
+
+	pubkey := types.GetRandomPubKey()
+	acc, err := pubkey.GetMyAddress()
+	require.NoError(t, err)
+	service := common.BTCService
+	kb := cKeys.NewInMemory(cdc)
+	info, _, err := kb.NewMnemonic("whatever", cKeys.English, `m/44'/931'/0'/0/0`, "", hd.Secp256k1)
+	require.NoError(t, err)
+	pk, err := info.GetPubKey()
+	require.NoError(t, err)
+	client, err := common.NewPubKeyFromCrypto(pk)
+	require.NoError(t, err)
+	rate, err := cosmos.ParseCoin("10uarkeo")
+	require.NoError(t, err)
+
+	bond := cosmos.NewInt(common.Tokens(100))
+	provider := types.NewProvider(pubkey, service)
+	provider.Bond = bond
+	require.NoError(t, k.SetProvider(ctx, provider))
+	require.NoError(t, k.MintToModule(ctx, types.ModuleName, getCoin(common.Tokens(10000*100*2))))
+	require.NoError(t, k.SendFromModuleToModule(ctx, types.ModuleName, types.ProviderName, getCoins(bond.Int64())))
+	require.NoError(t, k.SendFromModuleToModule(ctx, types.ModuleName, types.ContractName, getCoins(1000*100)))
+
+	// at most 100 queries over the contract
+	contract := types.NewContract(pubkey, service, client)
+	contract.Duration = 50
+	contract.QueriesPerMinute = 2
+	contract.Rate = rate
+	contract.Height = 10
+	contract.Type = types.ContractType_PAY_AS_YOU_GO
+	contract.Deposit = cosmos.NewInt(contract.MaxQueries() * contract.Rate.Amount.Int64())
+	contract.Id = 1
+	require.NoError(t, k.SetContract(ctx, contract))
+	require.Equal(t, int64(100), contract.MaxQueries())
+
+	claimBy := func(creator cosmos.AccAddress, contractId uint64, nonce int64) *types.MsgClaimContractIncome {
+		msg := types.MsgClaimContractIncome{
+			ContractId: contractId,
+			Creator:    creator.String(),
+			Nonce:      nonce,
+		}
+		msg.Signature, _, err = kb.Sign("whatever", msg.GetBytesToSign(), signing.SignMode_SIGN_MODE_DIRECT)
+		require.NoError(t, err)
+		return &msg
+	}
+	claim := func(nonce int64) *types.MsgClaimContractIncome {
+		return claimBy(acc, contract.Id, nonce)
+	}
+	slashEvents := func(ctx cosmos.Context) []*types.EventSlashProvider {
+		var events []*types.EventSlashProvider
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type != types.EventTypeSlashProvider {
+				continue
+			}
+			typedEvent, err := sdk.ParseTypedEvent(abci.Event(event))
+			require.NoError(t, err)
+			events = append(events, typedEvent.(*types.EventSlashProvider))
+		}
+		return events
+	}
+	reserve := k.GetBalanceOfModule(ctx, types.ReserveName, configs.Denom)
+
+	// no fault, the bond is left untouched
+	noFaultCtx := ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, s.HandlerClaimContractIncome(noFaultCtx, claim(40)))
+	provider, err = k.GetProvider(ctx, pubkey, service)
+	require.NoError(t, err)
+	require.Equal(t, bond, provider.Bond)
+	require.Zero(t, provider.Faults)
+	require.Empty(t, slashEvents(noFaultCtx))
+
+	// an over claim submitted by anyone but the provider is rejected, the provider is not slashed
+	otherCtx := ctx.WithEventManager(sdk.NewEventManager())
+	err = s.HandlerClaimContractIncome(otherCtx, claimBy(types.GetRandomBech32Addr(), contract.Id, 150))
+	require.ErrorIs(t, err, types.ErrClaimContractIncomeOverLimit)
+	provider, err = k.GetProvider(ctx, pubkey, service)
+	require.NoError(t, err)
+	require.Equal(t, bond, provider.Bond)
+	require.Empty(t, slashEvents(otherCtx))
+
+	// the nonce of an open subscription is not signed, its over claim is rejected even from the provider
+	open := types.NewContract(pubkey, service, client)
+	open.Id = 2
+	open.Type = types.ContractType_SUBSCRIPTION
+	open.Authorization = types.ContractAuthorization_OPEN
+	open.Duration = 50
+	open.QueriesPerMinute = 2
+	open.Rate = rate
+	open.Height = 10
+	open.Deposit = cosmos.NewInt(open.Duration * open.Rate.Amount.Int64())
+	require.NoError(t, k.SetContract(ctx, open))
+	err = s.HandlerClaimContractIncome(otherCtx, claimBy(acc, open.Id, 150))
+	require.ErrorIs(t, err, types.ErrClaimContractIncomeOverLimit)
+	provider, err = k.GetProvider(ctx, pubkey, service)
+	require.NoError(t, err)
+	require.Equal(t, bond, provider.Bond)
+	require.Zero(t, provider.Faults)
+
+	// over claim, the provider is paid the max queries and slashed 5% of its bond to the reserve
+	require.NoError(t, s.HandlerClaimContractIncome(ctx, claim(150)))
+	contract, err = k.GetContract(ctx, contract.Id)
+	require.NoError(t, err)
+	require.Equal(t, int64(100), contract.Nonce)
+	require.Equal(t, int64(1000), contract.Paid.Int64())
+	slashed := bond.MulRaw(types.DefaultSlashFraction).QuoRaw(configs.MaxBasisPoints)
+	provider, err = k.GetProvider(ctx, pubkey, service)
+	require.NoError(t, err)
+	require.Equal(t, bond.Sub(slashed), provider.Bond)
+	require.Equal(t, int64(1), provider.Faults)
+	require.Equal(t, bond.Sub(slashed), k.GetBalanceOfModule(ctx, types.ProviderName, configs.Denom))
+
+	events := slashEvents(ctx)
+	require.Len(t, events, 1)
+	require.Equal(t, types.SlashReasonOverClaim, events[0].Reason)
+	require.Equal(t, contract.Id, events[0].ContractId)
+	require.Equal(t, slashed, events[0].Amount)
+	require.Equal(t, int64(1), events[0].Faults)
+
+	// a repeated fault is slashed a larger fraction
+	require.NoError(t, s.HandlerClaimContractIncome(ctx, claim(200)))
+	secondSlash := bond.Sub(slashed).MulRaw(types.DefaultSlashFraction + types.DefaultSlashEscalation).QuoRaw(configs.MaxBasisPoints)
+	provider, err = k.GetProvider(ctx, pubkey, service)
+	require.NoError(t, err)
+	require.Equal(t, bond.Sub(slashed).Sub(secondSlash), provider.Bond)
+	require.Equal(t, int64(2), provider.Faults)
+	contract, err = k.GetContract(ctx, contract.Id)
+	require.NoError(t, err)
+	require.Equal(t, int64(1000), contract.Paid.Int64())
+
+	// the slashed bond went to the reserve
+	require.Equal(t, reserve.Add(slashed).Add(secondSlash), k.GetBalanceOfModule(ctx, types.ReserveName, configs.Denom))
+}
+
+func TestClaimContractIncomeSettlementEvent(t *testing.T) {
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	std.RegisterInterfaces(interfaceRegistry)
+	types.RegisterInterfaces(interfaceRegistry)
+	kb := cKeys.NewInMemory(codec.NewProtoCodec(interfaceRegistry))
+	info, _, err := kb.NewMnemonic("whatever", cKeys.English, `m/44'/931'/0'/0/0`, "", hd.Secp256k1)
+	require.NoError(t, err)
+	pk, err := info.GetPubKey()
+	require.NoError(t, err)
+	client, err := common.NewPubKeyFromCrypto(pk)
+	require.NoError(t, err)
+
+	// a pay-as-you-go deposit of 100 queries at 10 per query, a 10% reserve tax
+	testCases := []struct {
+		name                                  string
+		nonce                                 int64
+		paid, reserve, unpaid, claimed, funds int64
+	}{
+		{name: "underfunded", nonce: 130, paid: 1000, reserve: 100, unpaid: 300, claimed: 1300, funds: 0},
+		{name: "exactly funded", nonce: 100, paid: 1000, reserve: 100, unpaid: 0, claimed: 1000, funds: 0},
+		{name: "overfunded", nonce: 40, paid: 400, reserve: 40, unpaid: 0, claimed: 400, funds: 600},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, k, sk := SetupKeeperWithStaking(t)
+			ctx = ctx.WithBlockHeight(20)
+			s := newMsgServer(k, sk)
+
+			provider := types.GetRandomPubKey()
+			providerAddress, err := provider.GetMyAddress()
+			require.NoError(t, err)
+			require.NoError(t, k.MintToModule(ctx, types.ModuleName, getCoin(1000)))
+			require.NoError(t, k.SendFromModuleToModule(ctx, types.ModuleName, types.ContractName, getCoins(1000)))
+
+			contract := types.NewContract(provider, common.BTCService, client)
+			contract.Id = 1
+			contract.Type = types.ContractType_PAY_AS_YOU_GO
+			contract.Duration = 100
+			contract.Rate = cosmos.NewInt64Coin(configs.Denom, 10)
+			contract.Deposit = cosmos.NewInt(1000)
+			require.NoError(t, k.SetContract(ctx, contract))
+			require.NoError(t, k.AddToUserContractSet(ctx, client, contract.Id))
+
+			msg := types.MsgClaimContractIncome{
+				ContractId: contract.Id,
+				Creator:    providerAddress.String(),
+				Nonce:      tc.nonce,
+			}
+			msg.Signature, _, err = kb.Sign("whatever", msg.GetBytesToSign(), signing.SignMode_SIGN_MODE_DIRECT)
+			require.NoError(t, err)
+			require.NoError(t, s.HandlerClaimContractIncome(ctx, &msg))
+
+			// zero unpaid is emitted rather than omitted, paid and unpaid summing up to claimed
+			attributes := eventAttributes(t, ctx, types.EventTypeSettleContract)
+			amount := func(key string) int64 {
+				value, ok := attributes[key]
+				require.True(t, ok, key)
+				return cast.ToInt64(strings.Trim(value, `"`))
+			}
+			require.Equal(t, tc.paid, amount("paid"))
+			require.Equal(t, tc.reserve, amount("reserve"))
+			require.Equal(t, tc.unpaid, amount("unpaid"))
+			require.Equal(t, tc.claimed, amount("claimed"))
+			require.Equal(t, int64(0), amount("refunded"))
+			require.Equal(t, amount("claimed"), amount("paid")+amount("unpaid"))
+			require.Equal(t, tc.paid-tc.reserve, k.GetBalance(ctx, providerAddress).AmountOf(configs.Denom).Int64())
+			require.Equal(t, tc.funds, k.GetBalanceOfModule(ctx, types.ContractName, configs.Denom).Int64())
+
+			// the deposit left is refunded as the contract settles for good
+			contract, err = k.GetContract(ctx, contract.Id)
+			require.NoError(t, err)
+			_, err = s.mgr.SettleContract(ctx, contract, contract.Nonce, true)
+			require.NoError(t, err)
+			attributes = eventAttributes(t, ctx, types.EventTypeSettleContract)
+			require.Equal(t, tc.funds, amount("refunded"))
+			require.Equal(t, amount("claimed"), amount("paid")+amount("unpaid"))
+		})
+	}
+}
@@ -89,24 +89,40 @@ func (k msgServer) CloseContractHandle(ctx cosmos.Context, msg *types.MsgCloseCo
 		return errors.Wrap(types.ErrCloseContractUnauthorized, "only the client can close the contract")
 	}
 
-	if contract.IsPayAsYouGo() {
-		// add a new expiration return deposit to user
-		newHeight := ctx.BlockHeight() + contract.SettlementDuration
-		expirationSet, err := k.GetContractExpirationSet(ctx, newHeight)
-		if err != nil {
+	// a contract closed before its start height served nothing, it is never activated and settled now whatever its
+	// type, its deposit refunded in full
+	pending := contract.IsPending(ctx.BlockHeight())
+	if pending {
+		if err := k.RemoveFromContractStartSet(ctx, contract.StartHeight, contract.Id); err != nil {
 			return err
 		}
-		expirationSet.Append(contract.Id)
-		err = k.SetContractExpirationSet(ctx, expirationSet)
-		if err != nil {
+	}
+
+	// the contract isn't settled at the end of its settlement period anymore, a subscription is settled now and a
+	// pay-as-you-go contract once its settlement duration and grace period are over, as the claims are accepted until
+	// then, returning the deposit left to the user
+	if err := k.RemoveFromContractExpirationSet(ctx, contract.SettlementPeriodEnd(), contract.Id); err != nil {
+		return err
+	}
+	if contract.IsPayAsYouGo() && !pending {
+		if err := k.AddToContractExpirationSet(ctx, ctx.BlockHeight()+contract.SettlementDuration+contract.SettlementGracePeriod, contract.Id); err != nil {
 			return err
 		}
 	}
 
-	_, err = k.mgr.SettleContract(ctx, contract, 0, contract.IsSubscription())
+	// a subscription closed early compensates its provider for the capacity it reserved, along the early close params
+	split := earlyCloseSplit{compensation: cosmos.ZeroInt(), refund: cosmos.ZeroInt(), dust: cosmos.ZeroInt()}
+	if contract.IsSubscription() && !pending {
+		contract, split, err = k.mgr.SettleEarlyClose(ctx, contract)
+	} else {
+		contract, err = k.mgr.SettleContract(ctx, contract, 0, contract.IsSubscription() || pending)
+	}
 	if err != nil {
 		return err
 	}
+	if err := k.Hooks().AfterContractClosed(ctx, contract); err != nil {
+		return err
+	}
 
-	return k.EmitCloseContractEvent(ctx, &contract)
+	return k.EmitCloseContractEvent(ctx, split, &contract)
 }
@@ -3,6 +3,8 @@ package keeper
 import (
 	"testing"
 
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
 	"github.com/arkeonetwork/arkeo/common"
@@ -64,14 +66,15 @@ func TestCloseContractHandle(t *testing.T) {
 	require.NoError(t, err)
 
 	openContractMessage := types.MsgOpenContract{
-		Creator:      clientAccount.String(),
-		Client:       clientPubKey.String(),
-		Service:      service.String(),
-		Provider:     providerPubKey.String(),
-		Deposit:      cosmos.NewInt(500),
-		Rate:         rate,
-		Duration:     100,
-		ContractType: types.ContractType_SUBSCRIPTION,
+		Creator:          clientAccount.String(),
+		Client:           clientPubKey.String(),
+		Service:          service.String(),
+		Provider:         providerPubKey.String(),
+		Deposit:          cosmos.NewInt(500),
+		Rate:             rate,
+		Duration:         100,
+		ContractType:     types.ContractType_SUBSCRIPTION,
+		QueriesPerMinute: 1,
 	}
 
 	require.NoError(t, k.MintAndSendToAccount(ctx, clientAccount, getCoin(common.Tokens(10))))
@@ -136,6 +139,7 @@ func TestCloseSubscriptionContract(t *testing.T) {
 		Status:              types.ProviderStatus_ONLINE,
 		PayAsYouGoRate:      rates,
 		SubscriptionRate:    rates,
+		UpdateMask:          types.ModProviderFields,
 	}
 	err = s.ModProviderHandle(ctx, &modProviderMsg)
 
@@ -244,6 +248,7 @@ func TestClosePayAsYouGoContract(t *testing.T) {
 		Status:              types.ProviderStatus_ONLINE,
 		PayAsYouGoRate:      rates,
 		SubscriptionRate:    rates,
+		UpdateMask:          types.ModProviderFields,
 	}
 	err = s.ModProviderHandle(ctx, &modProviderMsg)
 
@@ -301,7 +306,7 @@ func TestClosePayAsYouGoContract(t *testing.T) {
 
 	// unbond provider , unbond 100% will remove the provider
 	closeContractMsg.Creator = userAddress.String()
-	k.RemoveProvider(ctx, providerPubKey, service)
+	require.NoError(t, k.RemoveProvider(ctx, providerPubKey, service))
 	_, err = s.CloseContract(ctx, &closeContractMsg)
 	require.NoError(t, err)
 }
@@ -415,3 +420,96 @@ func TestCloseContractWithNoClient(t *testing.T) {
 	err = s.CloseContractValidate(ctx, &msg)
 	require.ErrorIs(t, err, types.ErrCloseContractUnauthorized)
 }
+
+func TestCloseContractEarlyClosePolicy(t *testing.T) {
+	// a subscription of 100 blocks at 7 per block, closed by its client after a share of its duration
+	type split struct{ compensation, refund, dust int64 }
+	for _, tc := range []struct {
+		desc         string
+		compensation int64
+		minPeriod    int64
+		elapsed      int64
+		expected     split
+	}{
+		// the blocks served only, the rest refunded in full
+		{desc: "no policy at 10%", elapsed: 10, expected: split{0, 630, 0}},
+		{desc: "no policy at 50%", elapsed: 50, expected: split{0, 350, 0}},
+		{desc: "no policy at 99%", elapsed: 99, expected: split{0, 7, 0}},
+		// a quarter of the unused deposit compensates the provider, the halves rounded down leave dust
+		{desc: "compensation at 10%", compensation: 2500, elapsed: 10, expected: split{157, 472, 1}},
+		{desc: "compensation at 50%", compensation: 2500, elapsed: 50, expected: split{87, 262, 1}},
+		{desc: "compensation at 99%", compensation: 2500, elapsed: 99, expected: split{1, 5, 1}},
+		// the first 50 blocks are paid whatever, half of the rest compensates the provider
+		{desc: "min period at 10%", compensation: 5000, minPeriod: 50, elapsed: 10, expected: split{455, 175, 0}},
+		{desc: "min period at 50%", compensation: 5000, minPeriod: 50, elapsed: 50, expected: split{175, 175, 0}},
+		{desc: "min period at 99%", compensation: 5000, minPeriod: 50, elapsed: 99, expected: split{3, 3, 1}},
+		// a min period longer than the contract holds the whole deposit
+		{desc: "min period past the duration", minPeriod: 500, elapsed: 10, expected: split{630, 0, 0}},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			ctx, k, sk := SetupKeeperWithStaking(t)
+			ctx = ctx.WithBlockHeight(10)
+			s := newMsgServer(k, sk)
+			params := k.GetParams(ctx)
+			params.EarlyCloseCompensation = tc.compensation
+			params.EarlyCloseMinPeriod = tc.minPeriod
+			k.SetParams(ctx, params)
+
+			providerPubKey := types.GetRandomPubKey()
+			providerAddress, err := providerPubKey.GetMyAddress()
+			require.NoError(t, err)
+			clientPubKey := types.GetRandomPubKey()
+			clientAddress, err := clientPubKey.GetMyAddress()
+			require.NoError(t, err)
+			require.NoError(t, k.MintAndSendToAccount(ctx, clientAddress, getCoin(common.Tokens(10))))
+			rate := cosmos.NewInt64Coin(configs.Denom, 7)
+			require.NoError(t, s.OpenContractHandle(ctx, &types.MsgOpenContract{
+				Creator:          clientAddress.String(),
+				Client:           clientPubKey.String(),
+				Service:          common.BTCService.String(),
+				Provider:         providerPubKey.String(),
+				Deposit:          cosmos.NewInt(700),
+				Rate:             rate,
+				Duration:         100,
+				ContractType:     types.ContractType_SUBSCRIPTION,
+				QueriesPerMinute: 1,
+			}))
+			contract, err := k.GetActiveContractForUser(ctx, clientPubKey, providerPubKey, common.BTCService)
+			require.NoError(t, err)
+
+			clientBalance := k.GetBalance(ctx, clientAddress).AmountOf(configs.Denom)
+			reserve := k.GetBalanceOfModule(ctx, types.ModuleName, configs.Denom)
+			ctx = ctx.WithBlockHeight(10 + tc.elapsed).WithEventManager(cosmos.NewEventManager())
+			_, err = s.CloseContract(ctx, &types.MsgCloseContract{
+				Creator:    clientAddress.String(),
+				ContractId: contract.Id,
+				Client:     clientPubKey,
+			})
+			require.NoError(t, err)
+
+			var closed *types.EventCloseContract
+			for _, event := range ctx.EventManager().Events() {
+				if event.Type != types.EventTypeCloseContract {
+					continue
+				}
+				typedEvent, err := sdk.ParseTypedEvent(abci.Event(event))
+				require.NoError(t, err)
+				closed = typedEvent.(*types.EventCloseContract)
+			}
+			require.NotNil(t, closed)
+			require.Equal(t, tc.expected, split{closed.Compensation.Int64(), closed.Refund.Int64(), closed.Dust.Int64()})
+
+			// the deposit is split exactly, the provider paid for the blocks served and its compensation less the
+			// tax, the tax and the dust to the reserve
+			served := rate.Amount.Int64() * tc.elapsed
+			require.Equal(t, int64(700), served+tc.expected.compensation+tc.expected.refund+tc.expected.dust)
+			require.Equal(t, tc.expected.refund, k.GetBalance(ctx, clientAddress).AmountOf(configs.Denom).Sub(clientBalance).Int64())
+			taxed := k.GetBalanceOfModule(ctx, types.ModuleName, configs.Denom).Sub(reserve).Int64()
+			require.Equal(t, served+tc.expected.compensation+tc.expected.dust, k.GetBalance(ctx, providerAddress).AmountOf(configs.Denom).Int64()+taxed)
+			require.True(t, k.GetBalanceOfModule(ctx, types.ContractName, configs.Denom).IsZero())
+			contract, err = k.GetContract(ctx, contract.Id)
+			require.NoError(t, err)
+			require.Equal(t, ctx.BlockHeight(), contract.SettlementHeight)
+		})
+	}
+}
@@ -27,6 +27,7 @@ func (k msgServer) ModProvider(goCtx context.Context, msg *types.MsgModProvider)
 		"subscription rate", msg.SubscriptionRate,
 		"pay-as-you-go rate", msg.PayAsYouGoRate,
 		"settlement duration", msg.SettlementDuration,
+		"update mask", msg.UpdateMask,
 	)
 
 	cacheCtx, commit := ctx.CacheContext()
@@ -49,14 +50,8 @@ func (k msgServer) ModProviderValidate(ctx cosmos.Context, msg *types.MsgModProv
 	if k.FetchConfig(ctx, configs.HandlerModProvider) > 0 {
 		return errors.Wrapf(types.ErrDisabledHandler, "mod provider")
 	}
-	maxContractDuration := k.FetchConfig(ctx, configs.MaxContractLength)
-	if maxContractDuration > 0 {
-		if msg.MaxContractDuration > maxContractDuration {
-			return errors.Wrapf(types.ErrInvalidModProviderMaxContractDuration, "max contract duration is too long (%d/%d)", msg.MaxContractDuration, maxContractDuration)
-		}
-		if msg.MinContractDuration > maxContractDuration {
-			return errors.Wrapf(types.ErrInvalidModProviderMinContractDuration, "min contract duration is too long (%d/%d)", msg.MaxContractDuration, maxContractDuration)
-		}
+	if len(msg.UpdateMask) == 0 {
+		return errors.Wrapf(types.ErrInvalidModProviderUpdateMask, "update mask cannot be empty")
 	}
 
 	service, err := common.NewService(msg.Service)
@@ -71,6 +66,64 @@ func (k msgServer) ModProviderValidate(ctx cosmos.Context, msg *types.MsgModProv
 		return errors.Wrapf(types.ErrInvalidModProviderNoBond, "bond cannot be zero")
 	}
 
+	// a provider can only go online with the bond its service requires
+	if msg.Updates(types.ModProviderFieldStatus) && msg.Status == types.ProviderStatus_ONLINE {
+		if minBond := k.mgr.minProviderBond(ctx, service); provider.Bond.LT(minBond) {
+			return errors.Wrapf(types.ErrProviderBondBelowMinimum, "bond %s below the minimum %s of service %s", provider.Bond, minBond, service)
+		}
+	}
+
+	// the metadata is checked when updated only, the providers which set it before the limits keep it
+	params := k.GetParams(ctx)
+	if msg.Updates(types.ModProviderFieldMetadataUri) && params.MaxMetadataUriLength > 0 && uint64(len(msg.MetadataUri)) > params.MaxMetadataUriLength {
+		return errors.Wrapf(types.ErrInvalidModProviderMetadataUriLength, "length is too long (%d/%d)", len(msg.MetadataUri), params.MaxMetadataUriLength)
+	}
+	if msg.Updates(types.ModProviderFieldMetadataNonce) && msg.MetadataNonce <= provider.MetadataNonce {
+		return errors.Wrapf(types.ErrInvalidModProviderMetadataNonce, "metadata nonce must go up from %d: %d", provider.MetadataNonce, msg.MetadataNonce)
+	}
+
+	// the rates are checked when updated only, the ones set before a denom was disallowed are kept
+	if msg.Updates(types.ModProviderFieldSubscriptionRate) {
+		for _, rate := range msg.SubscriptionRate {
+			if !params.IsDenomAllowed(rate.Denom) {
+				return errors.Wrapf(types.ErrDenomNotAllowed, "subscription rate denom %s", rate.Denom)
+			}
+		}
+	}
+	if msg.Updates(types.ModProviderFieldPayAsYouGoRate) {
+		for _, rate := range msg.PayAsYouGoRate {
+			if !params.IsDenomAllowed(rate.Denom) {
+				return errors.Wrapf(types.ErrDenomNotAllowed, "pay-as-you-go rate denom %s", rate.Denom)
+			}
+		}
+	}
+
+	// a provider can only lower the chain cap of its open contracts
+	if msg.Updates(types.ModProviderFieldMaxOpenContracts) && params.MaxOpenContracts > 0 && msg.MaxOpenContracts > params.MaxOpenContracts {
+		return errors.Wrapf(types.ErrInvalidModProviderMaxOpenContracts, "max open contracts %d over the chain cap %d", msg.MaxOpenContracts, params.MaxOpenContracts)
+	}
+
+	// the durations are checked as the provider will have them, the fields not updated being kept
+	if !msg.Updates(types.ModProviderFieldMinContractDuration) && !msg.Updates(types.ModProviderFieldMaxContractDuration) {
+		return nil
+	}
+	msg.Apply(&provider)
+	maxContractDuration := k.FetchConfig(ctx, configs.MaxContractLength)
+	if maxContractDuration > 0 {
+		if provider.MaxContractDuration > maxContractDuration {
+			return errors.Wrapf(types.ErrInvalidModProviderMaxContractDuration, "max contract duration is too long (%d/%d)", provider.MaxContractDuration, maxContractDuration)
+		}
+		if provider.MinContractDuration > maxContractDuration {
+			return errors.Wrapf(types.ErrInvalidModProviderMinContractDuration, "min contract duration is too long (%d/%d)", provider.MinContractDuration, maxContractDuration)
+		}
+	}
+	if provider.MinContractDuration <= 0 {
+		return errors.Wrapf(types.ErrInvalidModProviderMinContractDuration, "min contraction duration cannot be zero")
+	}
+	if provider.MinContractDuration > provider.MaxContractDuration {
+		return errors.Wrapf(types.ErrInvalidModProviderMinContractDuration, "min contract duration is too long (%d/%d)", provider.MinContractDuration, provider.MaxContractDuration)
+	}
+
 	return nil
 }
 
@@ -84,32 +137,20 @@ func (k msgServer) ModProviderHandle(ctx cosmos.Context, msg *types.MsgModProvid
 		return err
 	}
 
-	// update metadata URI
-	if len(msg.MetadataUri) > 0 {
-		provider.MetadataUri = msg.MetadataUri
-	}
-
-	// update metadata nonce
-	if provider.MetadataNonce < msg.MetadataNonce {
-		provider.MetadataNonce = msg.MetadataNonce
+	// update the fields of the mask only, the others are left untouched
+	msg.Apply(&provider)
+	// an online provider whose service requires more bond since it went online is set offline
+	if err := k.mgr.demoteUnderbondedProvider(ctx, &provider); err != nil {
+		return err
 	}
 
-	// update status
-	provider.Status = msg.Status
-
-	// update contract durations
-	provider.MinContractDuration = msg.MinContractDuration
-	provider.MaxContractDuration = msg.MaxContractDuration
-
-	// update contract rates
-	provider.SubscriptionRate = msg.SubscriptionRate
-	provider.PayAsYouGoRate = msg.PayAsYouGoRate
-	provider.SettlementDuration = msg.SettlementDuration
-
 	provider.LastUpdate = ctx.BlockHeight()
 
 	if err := k.SetProvider(ctx, provider); err != nil {
 		return err
 	}
+	if err := k.Hooks().AfterProviderModified(ctx, provider); err != nil {
+		return err
+	}
 	return k.EmitModProviderEvent(ctx, msg, &provider)
 }
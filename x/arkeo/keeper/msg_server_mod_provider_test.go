@@ -3,6 +3,8 @@ package keeper
 import (
 	"testing"
 
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
 	"github.com/arkeonetwork/arkeo/common"
@@ -19,16 +21,20 @@ func TestModProviderValidate(t *testing.T) {
 	pubkey := types.GetRandomPubKey()
 
 	provider := types.NewProvider(pubkey, common.BTCService)
-	provider.Bond = cosmos.NewInt(500)
+	provider.Bond = cosmos.NewInt(common.Tokens(1))
+	provider.MetadataNonce = 4
 	require.NoError(t, k.SetProvider(ctx, provider))
 
 	// happy path
 	msg := types.MsgModProvider{
 		Provider:            provider.PubKey,
 		Service:             provider.Service.String(),
+		MetadataUri:         "https://provider.example.com/metadata.json",
+		MetadataNonce:       5,
 		MinContractDuration: 10,
 		MaxContractDuration: 500,
 		Status:              types.ProviderStatus_ONLINE,
+		UpdateMask:          types.ModProviderFields,
 	}
 	require.NoError(t, s.ModProviderValidate(ctx, &msg))
 
@@ -42,6 +48,32 @@ func TestModProviderValidate(t *testing.T) {
 	msg.MaxContractDuration = 5256000 * 2
 	err = s.ModProviderValidate(ctx, &msg)
 	require.ErrorIs(t, err, types.ErrInvalidModProviderMaxContractDuration)
+	msg.MaxContractDuration = 500
+
+	// the metadata nonce must go up
+	for _, nonce := range []uint64{3, 4} {
+		msg.MetadataNonce = nonce
+		err = s.ModProviderValidate(ctx, &msg)
+		require.ErrorIs(t, err, types.ErrInvalidModProviderMetadataNonce)
+	}
+	msg.MetadataNonce = 5
+
+	// the metadata uri is bounded by the params
+	params := k.GetParams(ctx)
+	params.MaxMetadataUriLength = 20
+	k.SetParams(ctx, params)
+	err = s.ModProviderValidate(ctx, &msg)
+	require.ErrorIs(t, err, types.ErrInvalidModProviderMetadataUriLength)
+
+	// the metadata of the provider set before the limits is kept when other fields are updated
+	provider.MetadataUri = "https://provider.example.com/metadata.json"
+	require.NoError(t, k.SetProvider(ctx, provider))
+	msg.UpdateMask = []string{types.ModProviderFieldStatus}
+	require.NoError(t, s.ModProviderValidate(ctx, &msg))
+	stored, err := k.GetProvider(ctx, provider.PubKey, provider.Service)
+	require.NoError(t, err)
+	require.Equal(t, provider.MetadataUri, stored.MetadataUri)
+	require.Equal(t, uint64(4), stored.MetadataNonce)
 }
 
 func TestModProviderHandle(t *testing.T) {
@@ -54,7 +86,7 @@ func TestModProviderHandle(t *testing.T) {
 	acct, err := pubkey.GetMyAddress()
 	require.NoError(t, err)
 	provider := types.NewProvider(pubkey, common.BTCService)
-	provider.Bond = cosmos.NewInt(500)
+	provider.Bond = cosmos.NewInt(common.Tokens(1))
 	require.NoError(t, k.SetProvider(ctx, provider))
 
 	sRates, err := cosmos.ParseCoins("11uarkeo")
@@ -74,6 +106,7 @@ func TestModProviderHandle(t *testing.T) {
 		Status:              types.ProviderStatus_ONLINE,
 		SubscriptionRate:    sRates,
 		PayAsYouGoRate:      pRates,
+		UpdateMask:          types.ModProviderFields,
 	}
 	require.NoError(t, s.ModProviderHandle(ctx, &msg))
 
@@ -87,3 +120,86 @@ func TestModProviderHandle(t *testing.T) {
 	require.Equal(t, provider.SubscriptionRate[0].Amount.Int64(), int64(11))
 	require.Equal(t, provider.PayAsYouGoRate[0].Amount.Int64(), int64(12))
 }
+
+func TestModProviderHandleUpdateMask(t *testing.T) {
+	ctx, k, sk := SetupKeeperWithStaking(t)
+
+	s := newMsgServer(k, sk)
+
+	// setup
+	pubkey := types.GetRandomPubKey()
+	acct, err := pubkey.GetMyAddress()
+	require.NoError(t, err)
+	rates, err := cosmos.ParseCoins("11uarkeo")
+	require.NoError(t, err)
+	provider := types.NewProvider(pubkey, common.BTCService)
+	provider.Bond = cosmos.NewInt(common.Tokens(1))
+	provider.MetadataUri = "foobar"
+	provider.MetadataNonce = 3
+	provider.Status = types.ProviderStatus_ONLINE
+	provider.MinContractDuration = 10
+	provider.MaxContractDuration = 500
+	provider.SubscriptionRate = rates
+	provider.PayAsYouGoRate = rates
+	provider.SettlementDuration = 7
+	require.NoError(t, k.SetProvider(ctx, provider))
+
+	// only the metadata nonce is updated, the zero values of the other fields are ignored
+	msg := types.MsgModProvider{
+		Creator:       acct.String(),
+		Provider:      pubkey,
+		Service:       common.BTCService.String(),
+		MetadataNonce: 4,
+		UpdateMask:    []string{types.ModProviderFieldMetadataNonce},
+	}
+	require.NoError(t, msg.ValidateBasic())
+	require.NoError(t, s.ModProviderValidate(ctx, &msg))
+	require.NoError(t, s.ModProviderHandle(ctx, &msg))
+
+	updated, err := k.GetProvider(ctx, pubkey, common.BTCService)
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), updated.MetadataNonce)
+	updated.MetadataNonce = provider.MetadataNonce
+	updated.LastUpdate = provider.LastUpdate
+	require.Equal(t, provider, updated)
+
+	// the event carries the fields updated only
+	events := ctx.EventManager().Events()
+	event := events[len(events)-1]
+	require.Equal(t, types.EventTypeModProvider, event.Type)
+	attributes := make(map[string]string)
+	for _, attribute := range event.Attributes {
+		attributes[attribute.Key] = attribute.Value
+	}
+	require.Equal(t, "\"4\"", attributes["metadata_nonce"])
+	require.Equal(t, "[\"metadata_nonce\"]", attributes["updated_fields"])
+	require.Contains(t, attributes, "provider")
+	require.NotContains(t, attributes, "metadata_uri")
+	require.NotContains(t, attributes, "status")
+	require.NotContains(t, attributes, "bond")
+
+	// the event decodes with the fields not updated left out
+	typedEvent, err := sdk.ParseTypedEvent(abci.Event(event))
+	require.NoError(t, err)
+	evt, ok := typedEvent.(*types.EventModProvider)
+	require.True(t, ok)
+	require.True(t, evt.Updates(types.ModProviderFieldMetadataNonce))
+	require.False(t, evt.Updates(types.ModProviderFieldStatus))
+
+	// the durations are checked against the ones kept
+	msg = types.MsgModProvider{
+		Creator:             acct.String(),
+		Provider:            pubkey,
+		Service:             common.BTCService.String(),
+		MinContractDuration: 600,
+		UpdateMask:          []string{types.ModProviderFieldMinContractDuration},
+	}
+	require.NoError(t, msg.ValidateBasic())
+	err = s.ModProviderValidate(ctx, &msg)
+	require.ErrorIs(t, err, types.ErrInvalidModProviderMinContractDuration)
+
+	// an empty mask is refused
+	msg.UpdateMask = nil
+	err = s.ModProviderValidate(ctx, &msg)
+	require.ErrorIs(t, err, types.ErrInvalidModProviderUpdateMask)
+}
@@ -26,6 +26,7 @@ func (k msgServer) OpenContract(goCtx context.Context, msg *types.MsgOpenContrac
 		"rate", msg.Rate,
 		"settlement duration", msg.SettlementDuration,
 		"authorization", msg.Authorization,
+		"start height", msg.StartHeight,
 	)
 
 	// CacheContext implies NewEventManager
@@ -66,15 +67,31 @@ func (k msgServer) OpenContractValidate(ctx cosmos.Context, msg *types.MsgOpenCo
 		return errors.Wrapf(types.ErrProviderNotFound, "provider %s for service %s not found", msg.Provider, msg.Service)
 	}
 
-	minBond := k.FetchConfig(ctx, configs.MinProviderBond)
-	if provider.Bond.LT(cosmos.NewInt(minBond)) {
-		return errors.Wrapf(types.ErrInvalidBond, "not enough provider bond to open a contract (%d/%d)", provider.Bond.Int64(), minBond)
+	if minBond := k.mgr.minProviderBond(ctx, service); provider.Bond.LT(minBond) {
+		return errors.Wrapf(types.ErrInvalidBond, "not enough provider bond to open a contract (%s/%s)", provider.Bond, minBond)
 	}
 
 	if provider.Status != types.ProviderStatus_ONLINE {
 		return errors.Wrapf(types.ErrOpenContractBadProviderStatus, "has status %s", provider.Status.String())
 	}
 
+	if maxOpen := provider.OpenContractsCap(k.GetParams(ctx).MaxOpenContracts); maxOpen > 0 && provider.OpenContracts >= maxOpen {
+		return errors.Wrapf(types.ErrOpenContractProviderFull, "%d open contracts", provider.OpenContracts)
+	}
+
+	// a provider with an allowlist only takes contracts from its clients, the contracts opened before are kept
+	allowlist, err := k.GetProviderAllowlist(ctx, providerPubKey, service)
+	if err != nil {
+		return err
+	}
+	clientPubKey, err := common.NewPubKey(msg.Client)
+	if err != nil {
+		return err
+	}
+	if !allowlist.Allows(clientPubKey) {
+		return errors.Wrapf(types.ErrOpenContractClientNotAllowed, "client %s", msg.Client)
+	}
+
 	if msg.Duration > provider.MaxContractDuration {
 		return errors.Wrapf(types.ErrOpenContractDuration, "duration exceeds allowed maximum duration from provider")
 	}
@@ -91,8 +108,11 @@ func (k msgServer) OpenContractValidate(ctx cosmos.Context, msg *types.MsgOpenCo
 		if !msg.Rate.Amount.Equal(cosmos.NewCoins(provider.SubscriptionRate...).AmountOf(msg.Rate.Denom)) {
 			return errors.Wrapf(types.ErrOpenContractMismatchRate, "provider rates is %d, client sent %d", cosmos.NewCoins(provider.SubscriptionRate...).AmountOf(msg.Rate.Denom).Int64(), msg.Rate.Amount.Int64())
 		}
-		if !cosmos.NewInt(msg.Rate.Amount.Int64() * msg.Duration * msg.QueriesPerMinute).Equal(msg.Deposit) {
-			return errors.Wrapf(types.ErrOpenContractMismatchRate, "mismatch of rate*duration and deposit: %d * %d * %d != %d", msg.Rate.Amount.Int64(), msg.Duration, msg.QueriesPerMinute, msg.Deposit.Int64())
+		// a small over-payment is left with the client, the contract escrowing the deposit it needs
+		required := msg.SubscriptionDeposit()
+		tolerance := required.MulRaw(k.GetParams(ctx).DepositRefundTolerance).QuoRaw(configs.MaxBasisPoints)
+		if msg.Deposit.LT(required) || msg.Deposit.GT(required.Add(tolerance)) {
+			return errors.Wrapf(types.ErrOpenContractDeposit, "deposit must be %s%s (rate*duration*qpm: %s * %d * %d), client sent %s%s", required, msg.Rate.Denom, msg.Rate.Amount, msg.Duration, msg.QueriesPerMinute, msg.Deposit, msg.Rate.Denom)
 		}
 	case types.ContractType_PAY_AS_YOU_GO:
 		if cosmos.NewCoins(provider.PayAsYouGoRate...).AmountOf(msg.Rate.Denom).IsZero() {
@@ -104,10 +124,33 @@ func (k msgServer) OpenContractValidate(ctx cosmos.Context, msg *types.MsgOpenCo
 		if msg.SettlementDuration != provider.SettlementDuration {
 			return errors.Wrapf(types.ErrOpenContractMismatchSettlementDuration, "pay-as-you-go provider settlement duration is %d, client sent %d", provider.SettlementDuration, msg.SettlementDuration)
 		}
+		minQueries := k.GetParams(ctx).MinPayAsYouGoDeposit
+		if minDeposit := msg.Rate.Amount.MulRaw(minQueries); msg.Deposit.LT(minDeposit) {
+			return errors.Wrapf(types.ErrOpenContractDeposit, "deposit must be at least %s%s (%d queries at the rate), client sent %s%s", minDeposit, msg.Rate.Denom, minQueries, msg.Deposit, msg.Rate.Denom)
+		}
 	default:
 		return errors.Wrapf(types.ErrInvalidContractType, "%s", msg.ContractType.String())
 	}
 
+	// a contract scheduled to start later on can't start in the past, nor further ahead than the params allow
+	if msg.StartHeight > 0 {
+		maxDelay := k.GetParams(ctx).MaxContractStartDelay
+		if maxDelay == 0 {
+			return errors.Wrapf(types.ErrOpenContractStartHeight, "scheduled contracts are disabled")
+		}
+		if msg.StartHeight < ctx.BlockHeight() {
+			return errors.Wrapf(types.ErrOpenContractStartHeight, "start height %d is in the past (%d)", msg.StartHeight, ctx.BlockHeight())
+		}
+		if msg.StartHeight > ctx.BlockHeight()+maxDelay {
+			return errors.Wrapf(types.ErrOpenContractStartHeight, "start height %d is more than %d blocks ahead (%d)", msg.StartHeight, maxDelay, ctx.BlockHeight())
+		}
+	}
+
+	// the provider may have kept rates in a denom since disallowed, the contracts opened before keep settling in it
+	if !k.GetParams(ctx).IsDenomAllowed(msg.Rate.Denom) {
+		return errors.Wrapf(types.ErrDenomNotAllowed, "rate denom %s", msg.Rate.Denom)
+	}
+
 	spender, err := msg.GetSpender()
 	if err != nil {
 		return err
@@ -126,15 +169,19 @@ func (k msgServer) OpenContractValidate(ctx cosmos.Context, msg *types.MsgOpenCo
 }
 
 func (k msgServer) OpenContractHandle(ctx cosmos.Context, msg *types.MsgOpenContract) error {
+	// the payer, when there is one, funds the contract in place of its creator
+	payer := msg.MustGetPayer()
 	openCost := k.FetchConfig(ctx, configs.OpenContractCost)
 	if openCost > 0 {
-		if err := k.SendFromAccountToModule(ctx, msg.MustGetSigner(), types.ModuleName, getCoins(openCost)); err != nil {
+		if err := k.SendFromAccountToModule(ctx, payer, types.ModuleName, getCoins(openCost)); err != nil {
 			return errors.Wrapf(err, "failed to send open contract costs openCost=%d", openCost)
 		}
 	}
 
-	if err := k.SendFromAccountToModule(ctx, msg.MustGetSigner(), types.ContractName, cosmos.NewCoins(cosmos.NewCoin(msg.Rate.Denom, msg.Deposit))); err != nil {
-		return errors.Wrapf(err, "failed to send deposit=%d", msg.Deposit.Int64())
+	// the over-payment of a subscription is refunded right away, by only escrowing the deposit it needs
+	deposit := msg.EscrowedDeposit()
+	if err := k.SendFromAccountToModule(ctx, payer, types.ContractName, cosmos.NewCoins(cosmos.NewCoin(msg.Rate.Denom, deposit))); err != nil {
+		return errors.Wrapf(err, "failed to send deposit=%s", deposit)
 	}
 
 	service, err := common.NewService(msg.Service)
@@ -166,53 +213,77 @@ func (k msgServer) OpenContractHandle(ctx cosmos.Context, msg *types.MsgOpenCont
 		Delegate:           delegatePubKey,
 		Duration:           msg.Duration,
 		Rate:               msg.Rate,
-		Deposit:            msg.Deposit,
+		Deposit:            deposit,
 		Paid:               cosmos.ZeroInt(),
 		Height:             ctx.BlockHeight(),
 		SettlementDuration: msg.SettlementDuration,
 		Authorization:      msg.Authorization,
 		QueriesPerMinute:   msg.QueriesPerMinute,
+		// the grace period is kept with the contract, a change of the param only affects the contracts opened after it
+		SettlementGracePeriod: k.GetParams(ctx).SettlementGracePeriod,
+		AutoRenew:             msg.AutoRenew,
+		TopUp:                 cosmos.ZeroInt(),
+		Payer:                 msg.Payer,
+	}
+	// a contract scheduled for the current height starts right away
+	if msg.StartHeight > ctx.BlockHeight() {
+		contract.StartHeight = msg.StartHeight
 	}
 
 	// create expiration set
 	// these are used by the end blocker to settle contracts. We need to
 	// use the additional settlement period for pay as you go contracts.
-	expirationSet, err := k.GetContractExpirationSet(ctx, contract.SettlementPeriodEnd())
+	err = k.AddToContractExpirationSet(ctx, contract.SettlementPeriodEnd(), contract.Id)
 	if err != nil {
 		return err
 	}
+	// the contracts settled later on expire ahead of their settlement
+	if contract.SettlesAfterExpiration() {
+		if err := k.AddToPayAsYouGoExpirationSet(ctx, contract.Expiration(), contract.Id); err != nil {
+			return err
+		}
+	}
 
-	expirationSet.Append(contract.Id)
-	err = k.SetContractExpirationSet(ctx, expirationSet)
-	if err != nil {
-		return err
+	// the scheduled contracts are activated by the end blocker at their start height
+	if contract.StartHeight > 0 {
+		if err := k.AddToContractStartSet(ctx, contract.StartHeight, contract.Id); err != nil {
+			return err
+		}
 	}
 
-	spender, err := msg.GetSpender()
+	// index the contract by its client and its delegate
+	for _, owner := range contract.Owners() {
+		if err := k.AddToUserContractSet(ctx, owner, contract.Id); err != nil {
+			return err
+		}
+	}
+
+	err = k.SetContract(ctx, contract)
 	if err != nil {
 		return err
 	}
 
-	// create user set.
-	userSet, err := k.GetUserContractSet(ctx, spender)
+	// the contract holds a slot of the provider until it is settled
+	provider, err := k.GetProvider(ctx, contract.Provider, contract.Service)
 	if err != nil {
 		return err
 	}
-
-	if userSet.ContractSet == nil {
-		userSet.ContractSet = &types.ContractSet{}
+	provider.OpenContracts++
+	if err := k.SetProvider(ctx, provider); err != nil {
+		return err
 	}
 
-	userSet.ContractSet.ContractIds = append(userSet.ContractSet.ContractIds, contract.Id)
-	err = k.SetUserContractSet(ctx, userSet)
+	earnings, err := k.GetProviderEarnings(ctx, contract.Provider, contract.Service)
 	if err != nil {
 		return err
 	}
-
-	err = k.SetContract(ctx, contract)
-	if err != nil {
+	earnings.Escrow(cosmos.NewCoin(contract.Rate.Denom, contract.Deposit))
+	if err := k.SetProviderEarnings(ctx, earnings); err != nil {
 		return err
 	}
 
+	if err := k.Hooks().AfterContractOpened(ctx, contract); err != nil {
+		return err
+	}
 	return k.EmitOpenContractEvent(ctx, openCost, &contract)
 }
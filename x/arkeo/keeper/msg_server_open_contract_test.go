@@ -3,11 +3,13 @@ package keeper
 import (
 	"testing"
 
+	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
 	cKeys "github.com/cosmos/cosmos-sdk/crypto/keyring"
 	"github.com/cosmos/cosmos-sdk/std"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	"github.com/stretchr/testify/require"
@@ -147,10 +149,11 @@ func TestOpenContractHandle(t *testing.T) {
 	bal := k.GetBalance(ctx, acc) // check balance
 	require.Equal(t, bal.AmountOf(configs.Denom).Int64(), int64(899999000))
 
-	// check that contract expiration has been set
-	set, err := k.GetContractExpirationSet(ctx, contract.Expiration())
+	// check that contract expiration has been set, at the end of the settlement grace period
+	require.Equal(t, k.GetParams(ctx).SettlementGracePeriod, contract.SettlementGracePeriod)
+	set, err := k.GetContractExpirationSet(ctx, contract.SettlementPeriodEnd())
 	require.NoError(t, err)
-	require.Equal(t, set.Height, contract.Expiration())
+	require.Equal(t, set.Height, contract.SettlementPeriodEnd())
 	require.Len(t, set.ContractSet.ContractIds, 1)
 
 	// check that contract has been added to the user
@@ -187,6 +190,7 @@ func TestOpenContract(t *testing.T) {
 		Status:              types.ProviderStatus_ONLINE,
 		PayAsYouGoRate:      rates,
 		SubscriptionRate:    rates,
+		UpdateMask:          types.ModProviderFields,
 	}
 	err = s.ModProviderHandle(ctx, &modProviderMsg)
 
@@ -277,6 +281,7 @@ func TestOpenContractWithSettlementPeriod(t *testing.T) {
 		PayAsYouGoRate:      rates,
 		SubscriptionRate:    rates,
 		SettlementDuration:  10,
+		UpdateMask:          types.ModProviderFields,
 	}
 	err = s.ModProviderHandle(ctx, &modProviderMsg)
 
@@ -361,3 +366,642 @@ func TestOpenContractWithSettlementPeriod(t *testing.T) {
 	_, err = s.ClaimContractIncome(ctx, &claimMsg)
 	require.ErrorIs(t, err, types.ErrClaimContractIncomeClosed)
 }
+
+func TestOpenContractSettlementGracePeriod(t *testing.T) {
+	ctx, k, sk := SetupKeeperWithStaking(t)
+	ctx = ctx.WithBlockHeight(10)
+	s := newMsgServer(k, sk)
+
+	pubkey := types.GetRandomPubKey()
+	acc, err := pubkey.GetMyAddress()
+	require.NoError(t, err)
+	require.NoError(t, k.MintAndSendToAccount(ctx, acc, getCoin(common.Tokens(10))))
+
+	msg := types.MsgOpenContract{
+		Provider:           pubkey.String(),
+		Service:            common.BTCService.String(),
+		Creator:            acc.String(),
+		Client:             pubkey.String(),
+		ContractType:       types.ContractType_PAY_AS_YOU_GO,
+		Duration:           100,
+		Rate:               cosmos.NewInt64Coin("uarkeo", 15),
+		Deposit:            cosmos.NewInt(1000),
+		SettlementDuration: 5,
+		QueriesPerMinute:   1,
+	}
+	require.NoError(t, s.OpenContractHandle(ctx, &msg))
+	first, err := k.GetActiveContractForUser(ctx, pubkey, pubkey, common.BTCService)
+	require.NoError(t, err)
+	require.Equal(t, types.DefaultSettlementGracePeriod, first.SettlementGracePeriod)
+	require.Equal(t, int64(110+5)+types.DefaultSettlementGracePeriod, first.SettlementPeriodEnd())
+
+	// the effective settlement height is part of the query response
+	res, err := k.FetchContract(ctx, &types.QueryFetchContractRequest{ContractId: first.Id})
+	require.NoError(t, err)
+	require.Equal(t, first.SettlementPeriodEnd(), res.SettlementPeriodEnd)
+	active, err := k.ActiveContract(ctx, &types.QueryActiveContractRequest{
+		Spender:  pubkey.String(),
+		Provider: pubkey.String(),
+		Service:  common.BTCService.String(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, first.SettlementPeriodEnd(), active.SettlementPeriodEnd)
+
+	// changing the param only affects the contracts opened afterwards
+	params := k.GetParams(ctx)
+	params.SettlementGracePeriod = 30
+	k.SetParams(ctx, params)
+	msg.Service = common.ETHService.String()
+	require.NoError(t, s.OpenContractHandle(ctx, &msg))
+	second, err := k.GetActiveContractForUser(ctx, pubkey, pubkey, common.ETHService)
+	require.NoError(t, err)
+	require.Equal(t, int64(30), second.SettlementGracePeriod)
+	require.Equal(t, int64(110+5+30), second.SettlementPeriodEnd())
+
+	first, err = k.GetContract(ctx, first.Id)
+	require.NoError(t, err)
+	require.Equal(t, types.DefaultSettlementGracePeriod, first.SettlementGracePeriod)
+	set, err := k.GetContractExpirationSet(ctx, first.SettlementPeriodEnd())
+	require.NoError(t, err)
+	require.Equal(t, []uint64{first.Id}, set.ContractSet.GetContractIds())
+}
+
+func TestOpenContractAllowedDenoms(t *testing.T) {
+	ctx, k, sk := SetupKeeperWithStaking(t)
+	ctx = ctx.WithBlockHeight(10)
+	s := newMsgServer(k, sk)
+
+	providerPubKey := types.GetRandomPubKey()
+	providerAddress, err := providerPubKey.GetMyAddress()
+	require.NoError(t, err)
+	provider := types.NewProvider(providerPubKey, common.BTCService)
+	provider.Bond = cosmos.NewInt(common.Tokens(1))
+	require.NoError(t, k.SetProvider(ctx, provider))
+
+	modProviderMsg := types.MsgModProvider{
+		Creator:             providerAddress.String(),
+		Provider:            provider.PubKey,
+		Service:             provider.Service.String(),
+		MinContractDuration: 10,
+		MaxContractDuration: 500,
+		Status:              types.ProviderStatus_ONLINE,
+		SubscriptionRate:    cosmos.NewCoins(cosmos.NewInt64Coin("uatom", 15)),
+		UpdateMask:          []string{types.ModProviderFieldSubscriptionRate},
+	}
+	require.ErrorIs(t, s.ModProviderValidate(ctx, &modProviderMsg), types.ErrDenomNotAllowed)
+
+	// the rates not updated aren't checked
+	modProviderMsg.UpdateMask = []string{types.ModProviderFieldStatus}
+	require.NoError(t, s.ModProviderValidate(ctx, &modProviderMsg))
+
+	params := k.GetParams(ctx)
+	params.AllowedDenoms = []string{configs.Denom, "uatom"}
+	k.SetParams(ctx, params)
+	modProviderMsg.UpdateMask = types.ModProviderFields
+	require.NoError(t, s.ModProviderHandle(ctx, &modProviderMsg))
+
+	clientPubKey := types.GetRandomPubKey()
+	clientAddress, err := clientPubKey.GetMyAddress()
+	require.NoError(t, err)
+	require.NoError(t, k.MintAndSendToAccount(ctx, clientAddress, getCoin(common.Tokens(10))))
+	require.NoError(t, k.MintAndSendToAccount(ctx, clientAddress, cosmos.NewInt64Coin("uatom", 10000)))
+
+	msg := types.MsgOpenContract{
+		Provider:         providerPubKey.String(),
+		Service:          common.BTCService.String(),
+		Creator:          clientAddress.String(),
+		Client:           clientPubKey.String(),
+		ContractType:     types.ContractType_SUBSCRIPTION,
+		Duration:         100,
+		Rate:             cosmos.NewInt64Coin("uatom", 15),
+		Deposit:          cosmos.NewInt(1500),
+		QueriesPerMinute: 1,
+	}
+	_, err = s.OpenContract(ctx, &msg)
+	require.NoError(t, err)
+	contract, err := k.GetActiveContractForUser(ctx, clientPubKey, providerPubKey, common.BTCService)
+	require.NoError(t, err)
+
+	// uatom is disallowed again, the provider keeps its rate but no new contract is opened in it
+	params.AllowedDenoms = []string{configs.Denom}
+	k.SetParams(ctx, params)
+	msg.Service = common.ETHService.String()
+	require.NoError(t, k.SetProvider(ctx, types.Provider{
+		PubKey:              providerPubKey,
+		Service:             common.ETHService,
+		Bond:                cosmos.NewInt(common.Tokens(1)),
+		Status:              types.ProviderStatus_ONLINE,
+		MinContractDuration: 10,
+		MaxContractDuration: 500,
+		SubscriptionRate:    cosmos.NewCoins(cosmos.NewInt64Coin("uatom", 15)),
+		LastUpdate:          ctx.BlockHeight(),
+	}))
+	err = s.OpenContractValidate(ctx, &msg)
+	require.ErrorIs(t, err, types.ErrDenomNotAllowed)
+
+	// the contract opened before still settles in uatom
+	ctx = ctx.WithBlockHeight(30)
+	_, err = s.CloseContract(ctx, &types.MsgCloseContract{
+		Creator:    clientAddress.String(),
+		ContractId: contract.Id,
+		Client:     clientPubKey,
+	})
+	require.NoError(t, err)
+	contract, err = k.GetContract(ctx, contract.Id)
+	require.NoError(t, err)
+	require.Equal(t, int64(30), contract.SettlementHeight)
+	require.True(t, k.GetBalanceOfModule(ctx, types.ContractName, "uatom").IsZero())
+	require.Equal(t, int64(10000-300), k.GetBalance(ctx, clientAddress).AmountOf("uatom").Int64())
+	require.Positive(t, k.GetBalance(ctx, providerAddress).AmountOf("uatom").Int64())
+}
+
+func TestOpenContractProviderCap(t *testing.T) {
+	ctx, k, sk := SetupKeeperWithStaking(t)
+	ctx = ctx.WithBlockHeight(10)
+	s := newMsgServer(k, sk)
+
+	params := k.GetParams(ctx)
+	params.MaxOpenContracts = 2
+	k.SetParams(ctx, params)
+
+	providerPubKey := types.GetRandomPubKey()
+	providerAddress, err := providerPubKey.GetMyAddress()
+	require.NoError(t, err)
+	service := common.BTCService
+	provider := types.NewProvider(providerPubKey, service)
+	provider.Bond = cosmos.NewInt(common.Tokens(1))
+	require.NoError(t, k.SetProvider(ctx, provider))
+
+	rates := cosmos.NewCoins(cosmos.NewInt64Coin(configs.Denom, 15))
+	modProviderMsg := types.MsgModProvider{
+		Creator:             providerAddress.String(),
+		Provider:            provider.PubKey,
+		Service:             provider.Service.String(),
+		MetadataNonce:       1,
+		MinContractDuration: 10,
+		MaxContractDuration: 500,
+		Status:              types.ProviderStatus_ONLINE,
+		SubscriptionRate:    rates,
+		PayAsYouGoRate:      rates,
+		UpdateMask:          types.ModProviderFields,
+	}
+	require.NoError(t, s.ModProviderHandle(ctx, &modProviderMsg))
+
+	openContract := func() (types.Contract, error) {
+		client := types.GetRandomPubKey()
+		clientAddress, err := client.GetMyAddress()
+		require.NoError(t, err)
+		require.NoError(t, k.MintAndSendToAccount(ctx, clientAddress, getCoin(common.Tokens(10))))
+		_, err = s.OpenContract(ctx, &types.MsgOpenContract{
+			Provider:         providerPubKey.String(),
+			Service:          service.String(),
+			Creator:          clientAddress.String(),
+			Client:           client.String(),
+			ContractType:     types.ContractType_SUBSCRIPTION,
+			Duration:         100,
+			Rate:             rates[0],
+			Deposit:          cosmos.NewInt(1500),
+			QueriesPerMinute: 1,
+		})
+		if err != nil {
+			return types.Contract{}, err
+		}
+		return k.GetActiveContractForUser(ctx, client, providerPubKey, service)
+	}
+	openContracts := func() uint64 {
+		res, err := k.FetchProvider(ctx, &types.QueryFetchProviderRequest{Pubkey: providerPubKey.String(), Service: service.String()})
+		require.NoError(t, err)
+		return res.Provider.OpenContracts
+	}
+
+	first, err := openContract()
+	require.NoError(t, err)
+	_, err = openContract()
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), openContracts())
+
+	// the provider is full
+	_, err = openContract()
+	require.ErrorIs(t, err, types.ErrOpenContractProviderFull)
+	require.Equal(t, uint64(2), openContracts())
+
+	// settling a contract frees its slot
+	ctx = ctx.WithBlockHeight(20)
+	_, err = s.CloseContract(ctx, &types.MsgCloseContract{
+		Creator:    first.ClientAddress().String(),
+		ContractId: first.Id,
+		Client:     first.Client,
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), openContracts())
+	_, err = openContract()
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), openContracts())
+
+	// the cap is raised mid-flight
+	params.MaxOpenContracts = 3
+	k.SetParams(ctx, params)
+	_, err = openContract()
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), openContracts())
+
+	// the provider can lower its own cap, never above the chain one
+	modProviderMsg.UpdateMask = []string{types.ModProviderFieldMaxOpenContracts}
+	modProviderMsg.MaxOpenContracts = 4
+	_, err = s.ModProvider(ctx, &modProviderMsg)
+	require.ErrorIs(t, err, types.ErrInvalidModProviderMaxOpenContracts)
+
+	params.MaxOpenContracts = 10
+	k.SetParams(ctx, params)
+	modProviderMsg.MaxOpenContracts = 3
+	_, err = s.ModProvider(ctx, &modProviderMsg)
+	require.NoError(t, err)
+	_, err = openContract()
+	require.ErrorIs(t, err, types.ErrOpenContractProviderFull)
+
+	// no cap at all
+	params.MaxOpenContracts = 0
+	k.SetParams(ctx, params)
+	modProviderMsg.MaxOpenContracts = 0
+	_, err = s.ModProvider(ctx, &modProviderMsg)
+	require.NoError(t, err)
+	_, err = openContract()
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), openContracts())
+}
+
+func TestOpenContractDeposit(t *testing.T) {
+	ctx, k, sk := SetupKeeperWithStaking(t)
+	ctx = ctx.WithBlockHeight(10)
+	s := newMsgServer(k, sk)
+
+	params := k.GetParams(ctx)
+	params.DepositRefundTolerance = 100 // 1%
+	params.MinPayAsYouGoDeposit = 20
+	k.SetParams(ctx, params)
+
+	providerPubKey := types.GetRandomPubKey()
+	providerAddress, err := providerPubKey.GetMyAddress()
+	require.NoError(t, err)
+	service := common.BTCService
+	provider := types.NewProvider(providerPubKey, service)
+	provider.Bond = cosmos.NewInt(common.Tokens(1))
+	require.NoError(t, k.SetProvider(ctx, provider))
+
+	rates := cosmos.NewCoins(cosmos.NewInt64Coin(configs.Denom, 15))
+	require.NoError(t, s.ModProviderHandle(ctx, &types.MsgModProvider{
+		Creator:             providerAddress.String(),
+		Provider:            provider.PubKey,
+		Service:             provider.Service.String(),
+		MetadataNonce:       1,
+		MinContractDuration: 10,
+		MaxContractDuration: 500,
+		Status:              types.ProviderStatus_ONLINE,
+		SubscriptionRate:    rates,
+		PayAsYouGoRate:      rates,
+		UpdateMask:          types.ModProviderFields,
+	}))
+
+	// each contract is opened by a new client, holding 10 tokens
+	openContract := func(contractType types.ContractType, rate cosmos.Coin, deposit int64) (types.Contract, cosmos.AccAddress, error) {
+		client := types.GetRandomPubKey()
+		clientAddress, err := client.GetMyAddress()
+		require.NoError(t, err)
+		require.NoError(t, k.MintAndSendToAccount(ctx, clientAddress, getCoin(common.Tokens(10))))
+		_, err = s.OpenContract(ctx, &types.MsgOpenContract{
+			Provider:         providerPubKey.String(),
+			Service:          service.String(),
+			Creator:          clientAddress.String(),
+			Client:           client.String(),
+			ContractType:     contractType,
+			Duration:         100,
+			Rate:             rate,
+			Deposit:          cosmos.NewInt(deposit),
+			QueriesPerMinute: 2,
+			Authorization:    types.ContractAuthorization_STRICT,
+		})
+		if err != nil {
+			return types.Contract{}, clientAddress, err
+		}
+		contract, err := k.GetActiveContractForUser(ctx, client, providerPubKey, service)
+		require.NoError(t, err)
+		return contract, clientAddress, nil
+	}
+	spent := func(clientAddress cosmos.AccAddress) int64 {
+		return common.Tokens(10) - k.GetBalance(ctx, clientAddress).AmountOf(configs.Denom).Int64()
+	}
+	openCost := s.FetchConfig(ctx, configs.OpenContractCost)
+	escrowed := k.GetBalanceOfModule(ctx, types.ContractName, configs.Denom)
+
+	// exact deposit, 15 * 100 * 2
+	contract, clientAddress, err := openContract(types.ContractType_SUBSCRIPTION, rates[0], 3000)
+	require.NoError(t, err)
+	require.Equal(t, cosmos.NewInt(3000), contract.Deposit)
+	require.Equal(t, openCost+3000, spent(clientAddress))
+	escrowed = escrowed.AddRaw(3000)
+
+	// over-payment within the tolerance, the excess is refunded
+	contract, clientAddress, err = openContract(types.ContractType_SUBSCRIPTION, rates[0], 3030)
+	require.NoError(t, err)
+	require.Equal(t, cosmos.NewInt(3000), contract.Deposit)
+	require.Equal(t, openCost+3000, spent(clientAddress))
+	escrowed = escrowed.AddRaw(3000)
+	require.Equal(t, escrowed, k.GetBalanceOfModule(ctx, types.ContractName, configs.Denom))
+
+	// over-payment beyond the tolerance
+	_, clientAddress, err = openContract(types.ContractType_SUBSCRIPTION, rates[0], 3031)
+	require.ErrorIs(t, err, types.ErrOpenContractDeposit)
+	require.ErrorContains(t, err, "deposit must be 3000uarkeo")
+	require.Zero(t, spent(clientAddress))
+
+	// under-payment
+	_, clientAddress, err = openContract(types.ContractType_SUBSCRIPTION, rates[0], 2999)
+	require.ErrorIs(t, err, types.ErrOpenContractDeposit)
+	require.ErrorContains(t, err, "deposit must be 3000uarkeo")
+	require.Zero(t, spent(clientAddress))
+
+	// no tolerance, only the exact deposit goes
+	params.DepositRefundTolerance = 0
+	k.SetParams(ctx, params)
+	_, _, err = openContract(types.ContractType_SUBSCRIPTION, rates[0], 3001)
+	require.ErrorIs(t, err, types.ErrOpenContractDeposit)
+
+	// wrong denom, the provider has no rate in it
+	_, clientAddress, err = openContract(types.ContractType_SUBSCRIPTION, cosmos.NewInt64Coin("uatom", 15), 3000)
+	require.ErrorIs(t, err, types.ErrOpenContractMismatchRate)
+	require.Zero(t, spent(clientAddress))
+
+	// pay-as-you-go deposits cover the min queries at the rate, 15 * 20
+	_, clientAddress, err = openContract(types.ContractType_PAY_AS_YOU_GO, rates[0], 299)
+	require.ErrorIs(t, err, types.ErrOpenContractDeposit)
+	require.ErrorContains(t, err, "deposit must be at least 300uarkeo")
+	require.Zero(t, spent(clientAddress))
+	contract, clientAddress, err = openContract(types.ContractType_PAY_AS_YOU_GO, rates[0], 301)
+	require.NoError(t, err)
+	require.Equal(t, cosmos.NewInt(301), contract.Deposit)
+	require.Equal(t, openCost+301, spent(clientAddress))
+	escrowed = escrowed.AddRaw(301)
+	require.Equal(t, escrowed, k.GetBalanceOfModule(ctx, types.ContractName, configs.Denom))
+	_, _, err = openContract(types.ContractType_PAY_AS_YOU_GO, cosmos.NewInt64Coin("uatom", 15), 300)
+	require.ErrorIs(t, err, types.ErrOpenContractMismatchRate)
+}
+
+func TestOpenContractPayer(t *testing.T) {
+	ctx, k, sk := SetupKeeperWithStaking(t)
+	ctx = ctx.WithBlockHeight(10)
+	s := newMsgServer(k, sk)
+
+	providerPubKey := types.GetRandomPubKey()
+	providerAddress, err := providerPubKey.GetMyAddress()
+	require.NoError(t, err)
+	service := common.BTCService
+	provider := types.NewProvider(providerPubKey, service)
+	provider.Bond = cosmos.NewInt(common.Tokens(1))
+	require.NoError(t, k.SetProvider(ctx, provider))
+
+	rates := cosmos.NewCoins(cosmos.NewInt64Coin(configs.Denom, 15))
+	require.NoError(t, s.ModProviderHandle(ctx, &types.MsgModProvider{
+		Creator:             providerAddress.String(),
+		Provider:            provider.PubKey,
+		Service:             provider.Service.String(),
+		MetadataNonce:       1,
+		MinContractDuration: 10,
+		MaxContractDuration: 500,
+		Status:              types.ProviderStatus_ONLINE,
+		SubscriptionRate:    rates,
+		PayAsYouGoRate:      rates,
+		UpdateMask:          types.ModProviderFields,
+	}))
+
+	openCost := s.FetchConfig(ctx, configs.OpenContractCost)
+	balance := func(addr cosmos.AccAddress) int64 {
+		return k.GetBalance(ctx, addr).AmountOf(configs.Denom).Int64()
+	}
+	openContract := func(client common.PubKey, payer string) (types.Contract, error) {
+		clientAddress, err := client.GetMyAddress()
+		require.NoError(t, err)
+		_, err = s.OpenContract(ctx, &types.MsgOpenContract{
+			Provider:         providerPubKey.String(),
+			Service:          service.String(),
+			Creator:          clientAddress.String(),
+			Client:           client.String(),
+			ContractType:     types.ContractType_SUBSCRIPTION,
+			Duration:         100,
+			Rate:             rates[0],
+			Deposit:          cosmos.NewInt(1500),
+			QueriesPerMinute: 1,
+			Authorization:    types.ContractAuthorization_STRICT,
+			Payer:            payer,
+		})
+		if err != nil {
+			return types.Contract{}, err
+		}
+		return k.GetActiveContractForUser(ctx, client, providerPubKey, service)
+	}
+	closeContract := func(contract types.Contract) types.Contract {
+		_, err := s.CloseContract(ctx, &types.MsgCloseContract{
+			Creator:    contract.ClientAddress().String(),
+			ContractId: contract.Id,
+		})
+		require.NoError(t, err)
+		contract, err = k.GetContract(ctx, contract.Id)
+		require.NoError(t, err)
+		return contract
+	}
+
+	// the client funds its own contract and gets the refund of its deposit
+	client := types.GetRandomPubKey()
+	clientAddress, err := client.GetMyAddress()
+	require.NoError(t, err)
+	require.NoError(t, k.MintAndSendToAccount(ctx, clientAddress, getCoin(common.Tokens(10))))
+	contract, err := openContract(client, "")
+	require.NoError(t, err)
+	require.Empty(t, contract.Payer)
+	require.Equal(t, common.Tokens(10)-openCost-1500, balance(clientAddress))
+	ctx = ctx.WithBlockHeight(20)
+	contract = closeContract(contract)
+	require.Equal(t, common.Tokens(10)-openCost-contract.Paid.Int64(), balance(clientAddress))
+
+	// the sponsor funds the contract of a client holding nothing, the refund goes back to the sponsor
+	client = types.GetRandomPubKey()
+	clientAddress, err = client.GetMyAddress()
+	require.NoError(t, err)
+	sponsor := types.GetRandomBech32Addr()
+	require.NoError(t, k.MintAndSendToAccount(ctx, sponsor, getCoin(common.Tokens(10))))
+	contract, err = openContract(client, sponsor.String())
+	require.NoError(t, err)
+	require.Equal(t, sponsor.String(), contract.Payer)
+	require.True(t, contract.Client.Equals(client))
+	require.Equal(t, common.Tokens(10)-openCost-1500, balance(sponsor))
+	require.Zero(t, balance(clientAddress))
+	ctx = ctx.WithBlockHeight(30)
+	contract = closeContract(contract)
+	require.True(t, contract.Paid.IsPositive())
+	require.Equal(t, common.Tokens(10)-openCost-contract.Paid.Int64(), balance(sponsor))
+	require.Zero(t, balance(clientAddress))
+
+	// a sponsor short of funds fails the opening, the client is charged nothing either
+	client = types.GetRandomPubKey()
+	clientAddress, err = client.GetMyAddress()
+	require.NoError(t, err)
+	require.NoError(t, k.MintAndSendToAccount(ctx, clientAddress, getCoin(common.Tokens(10))))
+	_, err = openContract(client, types.GetRandomBech32Addr().String())
+	require.ErrorContains(t, err, "insufficient funds")
+	require.Equal(t, common.Tokens(10), balance(clientAddress))
+}
+
+func TestOpenScheduledContract(t *testing.T) {
+	ctx, k, sk := SetupKeeperWithStaking(t)
+	ctx = ctx.WithBlockHeight(10)
+	s := newMsgServer(k, sk)
+	mgr := NewManager(k, sk)
+	params := k.GetParams(ctx)
+	params.MaxContractStartDelay = 100
+	k.SetParams(ctx, params)
+
+	providerPubKey := types.GetRandomPubKey()
+	providerAddress, err := providerPubKey.GetMyAddress()
+	require.NoError(t, err)
+	service := common.BTCService
+	provider := types.NewProvider(providerPubKey, service)
+	provider.Bond = cosmos.NewInt(common.Tokens(1))
+	require.NoError(t, k.SetProvider(ctx, provider))
+
+	rates := cosmos.NewCoins(cosmos.NewInt64Coin(configs.Denom, 15))
+	require.NoError(t, s.ModProviderHandle(ctx, &types.MsgModProvider{
+		Creator:             providerAddress.String(),
+		Provider:            provider.PubKey,
+		Service:             provider.Service.String(),
+		MetadataNonce:       1,
+		MinContractDuration: 10,
+		MaxContractDuration: 500,
+		Status:              types.ProviderStatus_ONLINE,
+		SubscriptionRate:    rates,
+		PayAsYouGoRate:      rates,
+		UpdateMask:          types.ModProviderFields,
+	}))
+
+	openCost := s.FetchConfig(ctx, configs.OpenContractCost)
+	balance := func(addr cosmos.AccAddress) int64 {
+		return k.GetBalance(ctx, addr).AmountOf(configs.Denom).Int64()
+	}
+	openContract := func(client common.PubKey, startHeight int64) (types.Contract, error) {
+		clientAddress, err := client.GetMyAddress()
+		require.NoError(t, err)
+		if balance(clientAddress) == 0 {
+			require.NoError(t, k.MintAndSendToAccount(ctx, clientAddress, getCoin(common.Tokens(10))))
+		}
+		_, err = s.OpenContract(ctx, &types.MsgOpenContract{
+			Provider:         providerPubKey.String(),
+			Service:          service.String(),
+			Creator:          clientAddress.String(),
+			Client:           client.String(),
+			ContractType:     types.ContractType_SUBSCRIPTION,
+			Duration:         100,
+			Rate:             rates[0],
+			Deposit:          cosmos.NewInt(1500),
+			QueriesPerMinute: 1,
+			Authorization:    types.ContractAuthorization_OPEN,
+			StartHeight:      startHeight,
+		})
+		if err != nil {
+			return types.Contract{}, err
+		}
+		return k.GetActiveContractForUser(ctx, client, providerPubKey, service)
+	}
+	claim := func(contract types.Contract) error {
+		_, err := s.ClaimContractIncome(ctx, &types.MsgClaimContractIncome{
+			Creator:    providerAddress.String(),
+			ContractId: contract.Id,
+			Nonce:      1,
+		})
+		return err
+	}
+
+	// the start height can't be in the past, nor further ahead than the params allow
+	client := types.GetRandomPubKey()
+	_, err = openContract(client, 9)
+	require.ErrorIs(t, err, types.ErrOpenContractStartHeight)
+	_, err = openContract(client, 111)
+	require.ErrorIs(t, err, types.ErrOpenContractStartHeight)
+	params.MaxContractStartDelay = 0
+	k.SetParams(ctx, params)
+	_, err = openContract(client, 50)
+	require.ErrorIs(t, err, types.ErrOpenContractStartHeight)
+	params.MaxContractStartDelay = 100
+	k.SetParams(ctx, params)
+
+	// the deposit is escrowed at once, the duration runs from the start height
+	clientAddress, err := client.GetMyAddress()
+	require.NoError(t, err)
+	contract, err := openContract(client, 50)
+	require.NoError(t, err)
+	require.EqualValues(t, 50, contract.StartHeight)
+	require.EqualValues(t, 150, contract.Expiration())
+	require.True(t, contract.IsPending(ctx.BlockHeight()))
+	require.Equal(t, common.Tokens(10)-openCost-1500, balance(clientAddress))
+	startSet, err := k.GetContractStartSet(ctx, 50)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{contract.Id}, startSet.ContractSet.ContractIds)
+	expirationSet, err := k.GetContractExpirationSet(ctx, 150)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{contract.Id}, expirationSet.ContractSet.ContractIds)
+	fetched, err := k.FetchContract(ctx, &types.QueryFetchContractRequest{ContractId: contract.Id})
+	require.NoError(t, err)
+	require.True(t, fetched.Pending)
+
+	// nothing is served before the start height, nothing can be claimed
+	ctx = ctx.WithBlockHeight(20)
+	require.ErrorIs(t, claim(contract), types.ErrClaimContractIncomePending)
+
+	// a contract closed before it starts is settled right away, its deposit refunded in full
+	cancelled := types.GetRandomPubKey()
+	cancelledAddress, err := cancelled.GetMyAddress()
+	require.NoError(t, err)
+	cancelledContract, err := openContract(cancelled, 60)
+	require.NoError(t, err)
+	_, err = s.CloseContract(ctx, &types.MsgCloseContract{
+		Creator:    cancelledAddress.String(),
+		ContractId: cancelledContract.Id,
+	})
+	require.NoError(t, err)
+	require.Equal(t, common.Tokens(10)-openCost, balance(cancelledAddress))
+	cancelledContract, err = k.GetContract(ctx, cancelledContract.Id)
+	require.NoError(t, err)
+	require.EqualValues(t, 20, cancelledContract.SettlementHeight)
+	require.False(t, cancelledContract.IsPending(ctx.BlockHeight()))
+	startSet, err = k.GetContractStartSet(ctx, 60)
+	require.NoError(t, err)
+	require.Empty(t, startSet.ContractSet.ContractIds)
+
+	// the end blocker activates the contract at its start height
+	activated := func(height int64) []uint64 {
+		ctx = ctx.WithBlockHeight(height).WithEventManager(sdk.NewEventManager())
+		require.NoError(t, mgr.ContractEndBlock(ctx))
+		var ids []uint64
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type != types.EventTypeContractActivated {
+				continue
+			}
+			typedEvent, err := sdk.ParseTypedEvent(abci.Event(event))
+			require.NoError(t, err)
+			evt := typedEvent.(*types.EventContractActivated)
+			require.EqualValues(t, height, evt.StartHeight)
+			require.Equal(t, contract.Expiration(), evt.Expiration)
+			ids = append(ids, evt.ContractId)
+		}
+		return ids
+	}
+	require.Empty(t, activated(49))
+	require.Equal(t, []uint64{contract.Id}, activated(50))
+	require.Empty(t, activated(60))
+	fetched, err = k.FetchContract(ctx, &types.QueryFetchContractRequest{ContractId: contract.Id})
+	require.NoError(t, err)
+	require.False(t, fetched.Pending)
+
+	// once started the blocks served are paid from the start height
+	require.NoError(t, claim(contract))
+	contract, err = k.GetContract(ctx, contract.Id)
+	require.NoError(t, err)
+	require.EqualValues(t, 15*10, contract.Paid.Int64())
+}
@@ -0,0 +1,117 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/arkeonetwork/arkeo/common/cosmos"
+	"github.com/arkeonetwork/arkeo/x/arkeo/configs"
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+)
+
+func (k msgServer) ProviderCloseContract(goCtx context.Context, msg *types.MsgProviderCloseContract) (*types.MsgProviderCloseContractResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	ctx.Logger().Info(
+		"receive MsgProviderCloseContract",
+		"contract_id", msg.ContractId,
+	)
+
+	cacheCtx, commit := ctx.CacheContext()
+	if err := k.ProviderCloseContractValidate(cacheCtx, msg); err != nil {
+		ctx.Logger().Error("failed provider close contract validation", "err", err)
+		return nil, err
+	}
+
+	if err := k.ProviderCloseContractHandle(cacheCtx, msg); err != nil {
+		ctx.Logger().Error("failed provider close contract handler", "err", err)
+		return nil, err
+	}
+
+	commit()
+	return &types.MsgProviderCloseContractResponse{}, nil
+}
+
+func (k msgServer) ProviderCloseContractValidate(ctx cosmos.Context, msg *types.MsgProviderCloseContract) error {
+	if k.FetchConfig(ctx, configs.HandlerProviderCloseContract) > 0 {
+		return errors.Wrapf(types.ErrDisabledHandler, "provider close contract")
+	}
+
+	contract, err := k.GetContract(ctx, msg.ContractId)
+	if err != nil {
+		return err
+	}
+
+	if contract.IsEmpty() {
+		return errors.Wrapf(types.ErrContractNotFound, "id: %d", msg.ContractId)
+	}
+
+	providerAddress, err := contract.Provider.GetMyAddress()
+	if err != nil {
+		return errors.Wrapf(types.ErrInvalidPubKey, "Provider: %s", contract.Provider.String())
+	}
+
+	if !providerAddress.Equals(msg.MustGetSigner()) {
+		return errors.Wrap(types.ErrCloseContractUnauthorized, "only the provider can close the contract")
+	}
+
+	if contract.SettlementHeight > 0 || contract.IsExpired(ctx.BlockHeight()) {
+		return errors.Wrapf(types.ErrCloseContractAlreadyClosed, "closed %d", contract.Expiration())
+	}
+
+	return nil
+}
+
+// ProviderCloseContractHandle settle the contract right away, the provider is paid what the contract owes it so far
+// and the client refunded the rest of its deposit, then pay the penalty from the provider bond to the client
+func (k msgServer) ProviderCloseContractHandle(ctx cosmos.Context, msg *types.MsgProviderCloseContract) error {
+	contract, err := k.GetContract(ctx, msg.ContractId)
+	if err != nil {
+		return err
+	}
+
+	// the contract is settled now, not at the end of its settlement period
+	if err := k.RemoveFromContractExpirationSet(ctx, contract.SettlementPeriodEnd(), contract.Id); err != nil {
+		return err
+	}
+	if contract.SettlesAfterExpiration() {
+		if err := k.RemoveFromPayAsYouGoExpirationSet(ctx, contract.Expiration(), contract.Id); err != nil {
+			return err
+		}
+	}
+
+	contract, err = k.mgr.SettleContract(ctx, contract, 0, true)
+	if err != nil {
+		return err
+	}
+
+	provider, err := k.GetProvider(ctx, contract.Provider, contract.Service)
+	if err != nil {
+		return err
+	}
+
+	penalty := cosmos.NewInt(k.GetParams(ctx).ProviderCloseContractPenalty)
+	if penalty.GT(provider.Bond) {
+		penalty = provider.Bond
+	}
+	if penalty.IsPositive() {
+		if err := k.SendFromModuleToAccount(ctx, types.ProviderName, contract.ClientAddress(), getCoins(penalty.Int64())); err != nil {
+			return errors.Wrapf(err, "failed to send close contract penalty=%d", penalty.Int64())
+		}
+		provider.Bond = provider.Bond.Sub(penalty)
+		// the penalty can leave an online provider below the minimum bond of its service
+		if err := k.mgr.demoteUnderbondedProvider(ctx, &provider); err != nil {
+			return err
+		}
+		if err := k.SetProvider(ctx, provider); err != nil {
+			return err
+		}
+	}
+
+	if err := k.Hooks().AfterContractClosed(ctx, contract); err != nil {
+		return err
+	}
+	return k.EmitProviderCloseContractEvent(ctx, penalty, &contract)
+}
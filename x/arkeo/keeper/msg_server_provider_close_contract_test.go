@@ -0,0 +1,212 @@
+package keeper
+
+import (
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/arkeonetwork/arkeo/common"
+	"github.com/arkeonetwork/arkeo/common/cosmos"
+	"github.com/arkeonetwork/arkeo/x/arkeo/configs"
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+)
+
+func TestProviderCloseContractSubscription(t *testing.T) {
+	ctx, k, sk := SetupKeeperWithStaking(t)
+	ctx = ctx.WithBlockHeight(10)
+	s := newMsgServer(k, sk)
+
+	// set up provider, with its bond held by the provider module
+	providerPubKey := types.GetRandomPubKey()
+	providerAddress, err := providerPubKey.GetMyAddress()
+	require.NoError(t, err)
+	service := common.BTCService
+	provider := types.NewProvider(providerPubKey, service)
+	provider.Bond = cosmos.NewInt(common.Tokens(100))
+	require.NoError(t, k.SetProvider(ctx, provider))
+	require.NoError(t, k.MintToModule(ctx, types.ModuleName, getCoin(common.Tokens(100))))
+	require.NoError(t, k.SendFromModuleToModule(ctx, types.ModuleName, types.ProviderName, getCoins(common.Tokens(100))))
+
+	rates, err := cosmos.ParseCoins("15uarkeo")
+	require.NoError(t, err)
+
+	modProviderMsg := types.MsgModProvider{
+		Creator:             providerAddress.String(),
+		Provider:            provider.PubKey,
+		Service:             provider.Service.String(),
+		MinContractDuration: 10,
+		MaxContractDuration: 500,
+		Status:              types.ProviderStatus_ONLINE,
+		PayAsYouGoRate:      rates,
+		SubscriptionRate:    rates,
+		UpdateMask:          types.ModProviderFields,
+	}
+	require.NoError(t, s.ModProviderHandle(ctx, &modProviderMsg))
+
+	// set up a subscription contract, expiring at 110
+	userPubKey := types.GetRandomPubKey()
+	userAddress, err := userPubKey.GetMyAddress()
+	require.NoError(t, err)
+	require.NoError(t, k.MintAndSendToAccount(ctx, userAddress, getCoin(common.Tokens(10))))
+
+	_, err = s.OpenContract(ctx, &types.MsgOpenContract{
+		Provider:         providerPubKey.String(),
+		Service:          service.String(),
+		Creator:          userAddress.String(),
+		Client:           userPubKey.String(),
+		ContractType:     types.ContractType_SUBSCRIPTION,
+		Duration:         100,
+		Rate:             rates[0],
+		Deposit:          cosmos.NewInt(1500),
+		QueriesPerMinute: 1,
+	})
+	require.NoError(t, err)
+	contract, err := s.GetActiveContractForUser(ctx, userPubKey, providerPubKey, service)
+	require.NoError(t, err)
+
+	msg := types.NewMsgProviderCloseContract(providerAddress, contract.Id)
+	require.NoError(t, msg.ValidateBasic())
+
+	// only the provider can close the contract this way
+	_, err = s.ProviderCloseContract(ctx, types.NewMsgProviderCloseContract(userAddress, contract.Id))
+	require.ErrorIs(t, err, types.ErrCloseContractUnauthorized)
+
+	// the service now requires the whole bond, the penalty leaves the provider below it
+	params := k.GetParams(ctx)
+	params.ServiceMinBonds = []types.ServiceMinBond{{Service: service.String(), MinBond: common.Tokens(100)}}
+	k.SetParams(ctx, params)
+
+	// happy path, 20 blocks are paid to the provider, the rest of the deposit is refunded along with the penalty
+	ctx = ctx.WithBlockHeight(30)
+	_, err = s.ProviderCloseContract(ctx, msg)
+	require.NoError(t, err)
+
+	penalty := common.Tokens(1)
+	require.Equal(t, int64(270), k.GetBalance(ctx, providerAddress).AmountOf(configs.Denom).Int64())
+	openCost := s.FetchConfig(ctx, configs.OpenContractCost)
+	require.Equal(t, common.Tokens(10)-openCost-1500+1200+penalty, k.GetBalance(ctx, userAddress).AmountOf(configs.Denom).Int64())
+	require.True(t, k.GetBalanceOfModule(ctx, types.ContractName, configs.Denom).IsZero())
+	require.Equal(t, common.Tokens(100)-penalty, k.GetBalanceOfModule(ctx, types.ProviderName, configs.Denom).Int64())
+	provider, err = k.GetProvider(ctx, providerPubKey, service)
+	require.NoError(t, err)
+	require.Equal(t, common.Tokens(100)-penalty, provider.Bond.Int64())
+	require.Equal(t, types.ProviderStatus_OFFLINE, provider.Status)
+
+	// the contract is settled and gone from the user and expiration sets
+	contract, err = k.GetContract(ctx, contract.Id)
+	require.NoError(t, err)
+	require.Equal(t, int64(30), contract.SettlementHeight)
+	userSet, err := k.GetUserContractSet(ctx, userPubKey)
+	require.NoError(t, err)
+	require.NotContains(t, userSet.ContractSet.GetContractIds(), contract.Id)
+	expirationSet, err := k.GetContractExpirationSet(ctx, contract.SettlementPeriodEnd())
+	require.NoError(t, err)
+	require.NotContains(t, expirationSet.ContractSet.GetContractIds(), contract.Id)
+
+	// the close event is attributed to the provider, the provider demoted
+	var closed *types.EventCloseContract
+	demoted := false
+	for _, event := range ctx.EventManager().Events() {
+		demoted = demoted || event.Type == types.EventTypeProviderDemoted
+		if event.Type != types.EventTypeCloseContract {
+			continue
+		}
+		typedEvent, err := sdk.ParseTypedEvent(abci.Event(event))
+		require.NoError(t, err)
+		closed = typedEvent.(*types.EventCloseContract)
+	}
+	require.NotNil(t, closed)
+	require.True(t, demoted)
+	require.True(t, closed.ByProvider)
+	require.Equal(t, penalty, closed.Penalty.Int64())
+
+	// a closed contract can't be closed again
+	_, err = s.ProviderCloseContract(ctx, msg)
+	require.ErrorIs(t, err, types.ErrCloseContractAlreadyClosed)
+}
+
+func TestProviderCloseContractPayAsYouGo(t *testing.T) {
+	ctx, k, sk := SetupKeeperWithStaking(t)
+	ctx = ctx.WithBlockHeight(10)
+	s := newMsgServer(k, sk)
+
+	// set up provider
+	providerPubKey := types.GetRandomPubKey()
+	providerAddress, err := providerPubKey.GetMyAddress()
+	require.NoError(t, err)
+	service := common.BTCService
+	provider := types.NewProvider(providerPubKey, service)
+	provider.Bond = cosmos.NewInt(common.Tokens(1))
+	require.NoError(t, k.SetProvider(ctx, provider))
+
+	rates, err := cosmos.ParseCoins("15uarkeo")
+	require.NoError(t, err)
+
+	modProviderMsg := types.MsgModProvider{
+		Creator:             providerAddress.String(),
+		Provider:            provider.PubKey,
+		Service:             provider.Service.String(),
+		MinContractDuration: 10,
+		MaxContractDuration: 500,
+		Status:              types.ProviderStatus_ONLINE,
+		PayAsYouGoRate:      rates,
+		SubscriptionRate:    rates,
+		UpdateMask:          types.ModProviderFields,
+	}
+	require.NoError(t, s.ModProviderHandle(ctx, &modProviderMsg))
+
+	userPubKey := types.GetRandomPubKey()
+	userAddress, err := userPubKey.GetMyAddress()
+	require.NoError(t, err)
+	require.NoError(t, k.MintAndSendToAccount(ctx, userAddress, getCoin(common.Tokens(10))))
+
+	_, err = s.OpenContract(ctx, &types.MsgOpenContract{
+		Provider:     providerPubKey.String(),
+		Service:      service.String(),
+		Creator:      userAddress.String(),
+		Client:       userPubKey.String(),
+		ContractType: types.ContractType_PAY_AS_YOU_GO,
+		Duration:     100,
+		Rate:         rates[0],
+		Deposit:      cosmos.NewInt(1000),
+	})
+	require.NoError(t, err)
+	contract, err := s.GetActiveContractForUser(ctx, userPubKey, providerPubKey, service)
+	require.NoError(t, err)
+
+	// 20 queries were served, not claimed yet
+	contract.Nonce = 20
+	require.NoError(t, k.SetContract(ctx, contract))
+
+	// the provider unbonded since, down to less than the penalty
+	bond := common.Tokens(1) / 2
+	provider, err = k.GetProvider(ctx, providerPubKey, service)
+	require.NoError(t, err)
+	provider.Bond = cosmos.NewInt(bond)
+	require.NoError(t, k.SetProvider(ctx, provider))
+	require.NoError(t, k.MintToModule(ctx, types.ModuleName, getCoin(bond)))
+	require.NoError(t, k.SendFromModuleToModule(ctx, types.ModuleName, types.ProviderName, getCoins(bond)))
+
+	ctx = ctx.WithBlockHeight(30)
+	_, err = s.ProviderCloseContract(ctx, types.NewMsgProviderCloseContract(providerAddress, contract.Id))
+	require.NoError(t, err)
+
+	// the queries served are paid, the rest of the deposit is refunded, the penalty is capped at the bond
+	require.Equal(t, int64(270), k.GetBalance(ctx, providerAddress).AmountOf(configs.Denom).Int64())
+	openCost := s.FetchConfig(ctx, configs.OpenContractCost)
+	require.Equal(t, common.Tokens(10)-openCost-1000+700+bond, k.GetBalance(ctx, userAddress).AmountOf(configs.Denom).Int64())
+	require.True(t, k.GetBalanceOfModule(ctx, types.ContractName, configs.Denom).IsZero())
+	require.True(t, k.GetBalanceOfModule(ctx, types.ProviderName, configs.Denom).IsZero())
+	provider, err = k.GetProvider(ctx, providerPubKey, service)
+	require.NoError(t, err)
+	require.True(t, provider.Bond.IsZero())
+
+	userSet, err := k.GetUserContractSet(ctx, userPubKey)
+	require.NoError(t, err)
+	require.NotContains(t, userSet.ContractSet.GetContractIds(), contract.Id)
+	expirationSet, err := k.GetContractExpirationSet(ctx, contract.SettlementPeriodEnd())
+	require.NoError(t, err)
+	require.NotContains(t, expirationSet.ContractSet.GetContractIds(), contract.Id)
+}
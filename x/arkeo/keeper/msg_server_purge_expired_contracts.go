@@ -0,0 +1,174 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/arkeonetwork/arkeo/common/cosmos"
+	"github.com/arkeonetwork/arkeo/x/arkeo/configs"
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+)
+
+func (k msgServer) PurgeExpiredContracts(goCtx context.Context, msg *types.MsgPurgeExpiredContracts) (*types.MsgPurgeExpiredContractsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	ctx.Logger().Info(
+		"receive MsgPurgeExpiredContracts",
+		"contracts", len(msg.ContractIds),
+		"limit", msg.Limit,
+	)
+
+	if err := k.PurgeExpiredContractsValidate(ctx, msg); err != nil {
+		ctx.Logger().Error("failed purge expired contracts validation", "err", err)
+		return nil, err
+	}
+
+	results, reward, err := k.PurgeExpiredContractsHandle(ctx, msg)
+	if err != nil {
+		ctx.Logger().Error("failed purge expired contracts handler", "err", err)
+		return nil, err
+	}
+
+	return &types.MsgPurgeExpiredContractsResponse{Results: results, Reward: reward}, nil
+}
+
+func (k msgServer) PurgeExpiredContractsValidate(ctx cosmos.Context, msg *types.MsgPurgeExpiredContracts) error {
+	if k.FetchConfig(ctx, configs.HandlerPurgeExpiredContracts) > 0 {
+		return errors.Wrapf(types.ErrDisabledHandler, "purge expired contracts")
+	}
+
+	if k.GetParams(ctx).ContractDormancyPeriod == 0 {
+		return errors.Wrap(types.ErrContractNotPurgeable, "purge disabled")
+	}
+
+	return nil
+}
+
+// PurgeExpiredContractsHandle purge each contract listed, or the first ones found purgeable up to the limit. A failed
+// purge leaves the state untouched without failing the others.
+func (k msgServer) PurgeExpiredContractsHandle(ctx cosmos.Context, msg *types.MsgPurgeExpiredContracts) ([]types.ContractPurgeResult, cosmos.Coin, error) {
+	itemGas := uint64(k.FetchConfig(ctx, configs.PurgeContractItemGas))
+	creator := msg.MustGetSigner()
+
+	contractIds := msg.ContractIds
+	if len(contractIds) == 0 {
+		contractIds = k.findPurgeableContracts(ctx, msg.Limit, itemGas)
+	}
+
+	reward := cosmos.NewCoin(configs.Denom, cosmos.ZeroInt())
+	results := make([]types.ContractPurgeResult, 0, len(contractIds))
+	for _, contractId := range contractIds {
+		// the contracts found by the scan were charged already
+		if len(msg.ContractIds) > 0 {
+			ctx.GasMeter().ConsumeGas(itemGas, "purge expired contracts item")
+		}
+
+		result := types.ContractPurgeResult{ContractId: contractId}
+		cacheCtx, commit := ctx.CacheContext()
+		paid, err := k.purgeExpiredContract(cacheCtx, creator, contractId)
+		if err != nil {
+			ctx.Logger().Info("failed to purge expired contract", "contract_id", contractId, "err", err)
+			result.Error = err.Error()
+		} else {
+			commit()
+			result.Success = true
+			reward = reward.Add(paid)
+		}
+		results = append(results, result)
+	}
+
+	if err := k.EmitPurgeExpiredContractsEvent(ctx, msg.Creator, results, reward.Amount); err != nil {
+		return nil, reward, err
+	}
+	return results, reward, nil
+}
+
+// findPurgeableContracts return up to limit contracts purgeable at the current height, charging the gas of each
+// contract looked at
+func (k msgServer) findPurgeableContracts(ctx cosmos.Context, limit, itemGas uint64) []uint64 {
+	dormancy := k.GetParams(ctx).ContractDormancyPeriod
+
+	var contractIds []uint64
+	iter := k.GetContractIterator(ctx)
+	defer iter.Close()
+	for ; iter.Valid() && uint64(len(contractIds)) < limit; iter.Next() {
+		ctx.GasMeter().ConsumeGas(itemGas, "purge expired contracts scan")
+		var contract types.Contract
+		if err := k.Cdc().Unmarshal(iter.Value(), &contract); err != nil {
+			continue
+		}
+		if contract.SettlementPeriodEnd()+dormancy < ctx.BlockHeight() {
+			contractIds = append(contractIds, contract.Id)
+		}
+	}
+	return contractIds
+}
+
+// purgeExpiredContract settle the contract when it wasn't already, remove it and all its indexes then pay the reward of
+// the purge out of the reserve, as much of it as the reserve holds. The contracts settled already pay no reward, purging
+// them frees their state only, a reward for them would let anyone drain the reserve.
+func (k msgServer) purgeExpiredContract(ctx cosmos.Context, creator cosmos.AccAddress, contractId uint64) (cosmos.Coin, error) {
+	// a contract purged already is no longer found
+	if !k.ContractExists(ctx, contractId) {
+		return cosmos.Coin{}, errors.Wrapf(types.ErrContractNotFound, "id: %d", contractId)
+	}
+	contract, err := k.GetContract(ctx, contractId)
+	if err != nil {
+		return cosmos.Coin{}, err
+	}
+
+	params := k.GetParams(ctx)
+	// the contract can still be settled, or claimed by its provider, until the end of its dormancy
+	if dormantUntil := contract.SettlementPeriodEnd() + params.ContractDormancyPeriod; ctx.BlockHeight() <= dormantUntil {
+		return cosmos.Coin{}, errors.Wrapf(types.ErrContractNotPurgeable, "dormant until block %d", dormantUntil)
+	}
+
+	unsettled := contract.SettlementHeight == 0
+	if unsettled {
+		contract, err = k.mgr.SettleContract(ctx, contract, 0, true)
+		if err != nil {
+			return cosmos.Coin{}, err
+		}
+		if err := k.RemoveFromContractExpirationSet(ctx, contract.SettlementPeriodEnd(), contract.Id); err != nil {
+			return cosmos.Coin{}, err
+		}
+		if contract.IsPayAsYouGo() {
+			if err := k.RemoveFromPayAsYouGoExpirationSet(ctx, contract.Expiration(), contract.Id); err != nil {
+				return cosmos.Coin{}, err
+			}
+		}
+	}
+	if err := k.RemoveContract(ctx, contract.Id); err != nil {
+		return cosmos.Coin{}, err
+	}
+
+	reward := cosmos.NewCoin(configs.Denom, cosmos.ZeroInt())
+	if unsettled {
+		reward.Amount = k.purgeReward(ctx, params)
+	}
+	if reserve := k.GetBalanceOfModule(ctx, types.ReserveName, configs.Denom); reserve.LT(reward.Amount) {
+		reward.Amount = reserve
+	}
+	if reward.IsPositive() {
+		if err := k.SendFromModuleToAccount(ctx, types.ReserveName, creator, cosmos.NewCoins(reward)); err != nil {
+			return cosmos.Coin{}, err
+		}
+	}
+	return reward, nil
+}
+
+// purgeReward the reward of purging an unsettled contract, capped by the cost of the state it frees, the cost of opening
+// the contract, and kept below it so that opening contracts to purge them never pays
+func (k msgServer) purgeReward(ctx cosmos.Context, params types.Params) cosmos.Int {
+	reward := cosmos.NewInt(params.PurgeReward)
+	openCost := cosmos.NewInt(k.FetchConfig(ctx, configs.OpenContractCost))
+	if reward.LT(openCost) {
+		return reward
+	}
+	if !openCost.IsPositive() {
+		return cosmos.ZeroInt()
+	}
+	return openCost.SubRaw(1)
+}
@@ -0,0 +1,145 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/arkeonetwork/arkeo/common"
+	"github.com/arkeonetwork/arkeo/common/cosmos"
+	"github.com/arkeonetwork/arkeo/x/arkeo/configs"
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+)
+
+func TestPurgeExpiredContracts(t *testing.T) {
+	ctx, k, s, _, providerPubKey := setupHookedKeeper(t, &recordingHooks{})
+	providerAddress, err := providerPubKey.GetMyAddress()
+	require.NoError(t, err)
+
+	params := k.GetParams(ctx)
+	params.ContractDormancyPeriod = 50
+	params.PurgeReward = 300
+	k.SetParams(ctx, params)
+	require.NoError(t, k.MintToModule(ctx, types.ReserveName, getCoin(500)))
+
+	_, err = s.BondProvider(ctx, &types.MsgBondProvider{
+		Creator:  providerAddress.String(),
+		Provider: providerPubKey.String(),
+		Service:  common.BTCService.String(),
+		Bond:     cosmos.NewInt(common.Tokens(8)),
+	})
+	require.NoError(t, err)
+	rates := getCoins(15)
+	_, err = s.ModProvider(ctx, &types.MsgModProvider{
+		Creator:             providerAddress.String(),
+		Provider:            providerPubKey,
+		Service:             common.BTCService.String(),
+		MetadataNonce:       1,
+		MinContractDuration: 10,
+		MaxContractDuration: 500,
+		Status:              types.ProviderStatus_ONLINE,
+		PayAsYouGoRate:      rates,
+		SubscriptionRate:    rates,
+		UpdateMask:          types.ModProviderFields,
+	})
+	require.NoError(t, err)
+
+	openContract := func(ctx cosmos.Context, contractType types.ContractType) (types.Contract, cosmos.AccAddress) {
+		clientPubKey := types.GetRandomPubKey()
+		clientAddress, err := clientPubKey.GetMyAddress()
+		require.NoError(t, err)
+		require.NoError(t, k.MintAndSendToAccount(ctx, clientAddress, getCoin(common.Tokens(10))))
+		_, err = s.OpenContract(ctx, &types.MsgOpenContract{
+			Provider:         providerPubKey.String(),
+			Service:          common.BTCService.String(),
+			Creator:          clientAddress.String(),
+			Client:           clientPubKey.String(),
+			ContractType:     contractType,
+			Duration:         100,
+			Rate:             rates[0],
+			Deposit:          cosmos.NewInt(15 * 100),
+			QueriesPerMinute: 1,
+		})
+		require.NoError(t, err)
+		contract, err := k.GetActiveContractForUser(ctx, clientPubKey, providerPubKey, common.BTCService)
+		require.NoError(t, err)
+		return contract, clientAddress
+	}
+
+	// the end blocker never settled the contract
+	contract, clientAddress := openContract(ctx, types.ContractType_PAY_AS_YOU_GO)
+	creator := types.GetRandomBech32Addr()
+	msg := types.NewMsgPurgeExpiredContracts(creator, []uint64{contract.Id}, 0)
+	require.NoError(t, msg.ValidateBasic())
+
+	// a contract in its settlement window, or dormant still, is left alone
+	for _, height := range []int64{contract.SettlementPeriodEnd(), contract.SettlementPeriodEnd() + 50} {
+		res, err := s.PurgeExpiredContracts(ctx.WithBlockHeight(height), msg)
+		require.NoError(t, err)
+		require.False(t, res.Results[0].Success)
+		require.Contains(t, res.Results[0].Error, types.ErrContractNotPurgeable.Error())
+		require.True(t, res.Reward.IsZero())
+		require.True(t, k.ContractExists(ctx, contract.Id))
+	}
+
+	// the contract is settled, refunding its client, and removed along with its indexes
+	ctx = ctx.WithBlockHeight(contract.SettlementPeriodEnd() + 51)
+	clientBalance := k.GetBalance(ctx, clientAddress).AmountOf(configs.Denom)
+	res, err := s.PurgeExpiredContracts(ctx, msg)
+	require.NoError(t, err)
+	require.Equal(t, []types.ContractPurgeResult{{ContractId: contract.Id, Success: true}}, res.Results)
+	require.Equal(t, getCoin(300), res.Reward)
+	require.Equal(t, int64(300), k.GetBalance(ctx, creator).AmountOf(configs.Denom).Int64())
+	require.Equal(t, int64(200), k.GetBalanceOfModule(ctx, types.ReserveName, configs.Denom).Int64())
+	require.Equal(t, clientBalance.AddRaw(15*100), k.GetBalance(ctx, clientAddress).AmountOf(configs.Denom))
+	require.False(t, k.ContractExists(ctx, contract.Id))
+	userSet, err := k.GetUserContractSet(ctx, contract.GetSpender())
+	require.NoError(t, err)
+	require.False(t, userSet.Contains(contract.Id))
+	provider, err := k.GetProvider(ctx, providerPubKey, common.BTCService)
+	require.NoError(t, err)
+	require.Zero(t, provider.OpenContracts)
+
+	// purging it again fails without paying anything
+	res, err = s.PurgeExpiredContracts(ctx, msg)
+	require.NoError(t, err)
+	require.False(t, res.Results[0].Success)
+	require.Contains(t, res.Results[0].Error, types.ErrContractNotFound.Error())
+	require.True(t, res.Reward.IsZero())
+
+	// the contracts found purgeable up to the limit, the reward capped by what is left in the reserve
+	sub, _ := openContract(ctx, types.ContractType_SUBSCRIPTION)
+	ctx = ctx.WithBlockHeight(sub.SettlementPeriodEnd() + 51)
+	res, err = s.PurgeExpiredContracts(ctx, types.NewMsgPurgeExpiredContracts(creator, nil, 5))
+	require.NoError(t, err)
+	require.Equal(t, []types.ContractPurgeResult{{ContractId: sub.Id, Success: true}}, res.Results)
+	require.Equal(t, getCoin(200), res.Reward)
+	require.False(t, k.ContractExists(ctx, sub.Id))
+	require.True(t, k.GetBalanceOfModule(ctx, types.ReserveName, configs.Denom).IsZero())
+
+	// a contract settled already is purged without a reward
+	require.NoError(t, k.MintToModule(ctx, types.ReserveName, getCoin(500)))
+	settled, _ := openContract(ctx, types.ContractType_PAY_AS_YOU_GO)
+	_, err = s.mgr.SettleContract(ctx, settled, 0, true)
+	require.NoError(t, err)
+	ctx = ctx.WithBlockHeight(settled.SettlementPeriodEnd() + 51)
+	res, err = s.PurgeExpiredContracts(ctx, types.NewMsgPurgeExpiredContracts(creator, []uint64{settled.Id}, 0))
+	require.NoError(t, err)
+	require.Equal(t, []types.ContractPurgeResult{{ContractId: settled.Id, Success: true}}, res.Results)
+	require.True(t, res.Reward.IsZero())
+	require.False(t, k.ContractExists(ctx, settled.Id))
+	require.Equal(t, int64(500), k.GetBalanceOfModule(ctx, types.ReserveName, configs.Denom).Int64())
+
+	// the reward stays below the cost of opening a contract
+	openCost := s.FetchConfig(ctx, configs.OpenContractCost)
+	params.PurgeReward = openCost * 2
+	require.Equal(t, openCost-1, s.purgeReward(ctx, params).Int64())
+	params.PurgeReward = openCost - 10
+	require.Equal(t, openCost-10, s.purgeReward(ctx, params).Int64())
+
+	// a zero dormancy period disables the purge
+	params.ContractDormancyPeriod = 0
+	k.SetParams(ctx, params)
+	_, err = s.PurgeExpiredContracts(ctx, msg)
+	require.ErrorIs(t, err, types.ErrContractNotPurgeable)
+}
@@ -0,0 +1,198 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/arkeonetwork/arkeo/common/cosmos"
+	"github.com/arkeonetwork/arkeo/x/arkeo/configs"
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+)
+
+func (k msgServer) RenewContract(goCtx context.Context, msg *types.MsgRenewContract) (*types.MsgRenewContractResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	ctx.Logger().Info(
+		"receive MsgRenewContract",
+		"contract_id", msg.ContractId,
+		"additional duration", msg.AdditionalDuration,
+		"extra deposit", msg.ExtraDeposit,
+		"rate", msg.Rate,
+	)
+
+	cacheCtx, commit := ctx.CacheContext()
+	if err := k.RenewContractValidate(cacheCtx, msg); err != nil {
+		ctx.Logger().Error("failed renew contract validation", "err", err)
+		return nil, err
+	}
+
+	if err := k.RenewContractHandle(cacheCtx, msg); err != nil {
+		ctx.Logger().Error("failed renew contract handler", "err", err)
+		return nil, err
+	}
+
+	commit()
+	return &types.MsgRenewContractResponse{}, nil
+}
+
+func (k msgServer) RenewContractValidate(ctx cosmos.Context, msg *types.MsgRenewContract) error {
+	if k.FetchConfig(ctx, configs.HandlerRenewContract) > 0 {
+		return errors.Wrapf(types.ErrDisabledHandler, "renew contract")
+	}
+
+	contract, err := k.GetContract(ctx, msg.ContractId)
+	if err != nil {
+		return err
+	}
+
+	if contract.IsEmpty() {
+		return errors.Wrapf(types.ErrContractNotFound, "id: %d", msg.ContractId)
+	}
+
+	contractClientAddress, err := contract.Client.GetMyAddress()
+	if err != nil {
+		return errors.Wrapf(types.ErrInvalidPubKey, "Client: %s", contract.Client.String())
+	}
+
+	if !contractClientAddress.Equals(msg.MustGetSigner()) {
+		return errors.Wrap(types.ErrRenewContractUnauthorized, "only the client can renew the contract")
+	}
+
+	if contract.SettlementHeight > 0 {
+		return errors.Wrapf(types.ErrRenewContractClosed, "closed %d", contract.SettlementHeight)
+	}
+
+	if contract.IsExpired(ctx.BlockHeight()) {
+		return errors.Wrapf(types.ErrRenewContractClosed, "expired %d", contract.Expiration())
+	}
+
+	provider, err := k.GetProvider(ctx, contract.Provider, contract.Service)
+	if err != nil {
+		return err
+	}
+
+	// the contract can't run for longer than the provider allows from now on
+	remaining := contract.Expiration() - ctx.BlockHeight()
+	if remaining+msg.AdditionalDuration > provider.MaxContractDuration {
+		return errors.Wrapf(types.ErrOpenContractDuration, "duration exceeds allowed maximum duration from provider (%d/%d)", remaining+msg.AdditionalDuration, provider.MaxContractDuration)
+	}
+
+	rate, err := renewalRate(contract, provider, msg)
+	if err != nil {
+		return err
+	}
+
+	if contract.IsSubscription() {
+		expected := rate.Amount.MulRaw(msg.AdditionalDuration * contract.QueriesPerMinute)
+		if !expected.Equal(msg.ExtraDeposit) {
+			return errors.Wrapf(types.ErrRenewContractDeposit, "mismatch of rate*duration and deposit: %d * %d * %d != %d", rate.Amount.Int64(), msg.AdditionalDuration, contract.QueriesPerMinute, msg.ExtraDeposit.Int64())
+		}
+	}
+
+	return nil
+}
+
+func (k msgServer) RenewContractHandle(ctx cosmos.Context, msg *types.MsgRenewContract) error {
+	contract, err := k.GetContract(ctx, msg.ContractId)
+	if err != nil {
+		return err
+	}
+
+	provider, err := k.GetProvider(ctx, contract.Provider, contract.Service)
+	if err != nil {
+		return err
+	}
+
+	rate, err := renewalRate(contract, provider, msg)
+	if err != nil {
+		return err
+	}
+
+	if msg.ExtraDeposit.IsPositive() {
+		if err := k.SendFromAccountToModule(ctx, msg.MustGetSigner(), types.ContractName, cosmos.NewCoins(cosmos.NewCoin(contract.Rate.Denom, msg.ExtraDeposit))); err != nil {
+			return errors.Wrapf(err, "failed to send extra deposit=%d", msg.ExtraDeposit.Int64())
+		}
+	}
+
+	if !rate.Amount.Equal(contract.Rate.Amount) {
+		// what is owed so far is paid at the rate the contract was opened with, the contract is then charged the
+		// new rate from its start so its debt is zero and the funds it has left are kept, a contract scheduled to start
+		// later charged nothing yet
+		contract, err = k.mgr.SettleContract(ctx, contract, 0, false)
+		if err != nil {
+			return err
+		}
+		var paid cosmos.Int
+		if contract.IsSubscription() {
+			paid = rate.Amount.MulRaw(max(ctx.BlockHeight()-contract.Start(), 0))
+		} else {
+			paid = rate.Amount.MulRaw(contract.Nonce)
+		}
+		contract.Deposit = contract.Deposit.Sub(contract.Paid).Add(paid)
+		contract.Paid = paid
+		contract.Rate = rate
+	}
+
+	// the contract is settled at the end of its new settlement period instead
+	oldExpiration := contract.Expiration()
+	if err := k.RemoveFromContractExpirationSet(ctx, contract.SettlementPeriodEnd(), contract.Id); err != nil {
+		return err
+	}
+	if contract.SettlesAfterExpiration() {
+		if err := k.RemoveFromPayAsYouGoExpirationSet(ctx, oldExpiration, contract.Id); err != nil {
+			return err
+		}
+	}
+
+	contract.Duration += msg.AdditionalDuration
+	contract.Deposit = contract.Deposit.Add(msg.ExtraDeposit)
+
+	if err := k.AddToContractExpirationSet(ctx, contract.SettlementPeriodEnd(), contract.Id); err != nil {
+		return err
+	}
+	if contract.SettlesAfterExpiration() {
+		if err := k.AddToPayAsYouGoExpirationSet(ctx, contract.Expiration(), contract.Id); err != nil {
+			return err
+		}
+	}
+
+	if err := k.SetContract(ctx, contract); err != nil {
+		return err
+	}
+
+	earnings, err := k.GetProviderEarnings(ctx, contract.Provider, contract.Service)
+	if err != nil {
+		return err
+	}
+	earnings.Escrow(cosmos.NewCoin(contract.Rate.Denom, msg.ExtraDeposit))
+	if err := k.SetProviderEarnings(ctx, earnings); err != nil {
+		return err
+	}
+
+	return k.EmitRenewContractEvent(ctx, oldExpiration, msg.ExtraDeposit, &contract)
+}
+
+// renewalRate return the rate of the renewed contract. It is the rate the contract opened with, unless the provider
+// changed it since, then the client has to accept the current one
+func renewalRate(contract types.Contract, provider types.Provider, msg *types.MsgRenewContract) (cosmos.Coin, error) {
+	rates := provider.SubscriptionRate
+	if contract.IsPayAsYouGo() {
+		rates = provider.PayAsYouGoRate
+	}
+	current := cosmos.NewCoins(rates...).AmountOf(contract.Rate.Denom)
+	if current.Equal(contract.Rate.Amount) {
+		return contract.Rate, nil
+	}
+	if current.IsZero() {
+		return cosmos.Coin{}, errors.Wrapf(types.ErrRenewContractRateChanged, "provider has no %s rate anymore", contract.Rate.Denom)
+	}
+	if !msg.AcceptsRate() {
+		return cosmos.Coin{}, errors.Wrapf(types.ErrRenewContractRateChanged, "provider rate is %d, contract opened at %d", current.Int64(), contract.Rate.Amount.Int64())
+	}
+	if msg.Rate.Denom != contract.Rate.Denom || !msg.Rate.Amount.Equal(current) {
+		return cosmos.Coin{}, errors.Wrapf(types.ErrOpenContractMismatchRate, "provider rate is %d%s, client accepted %s", current.Int64(), contract.Rate.Denom, msg.Rate)
+	}
+	return cosmos.NewCoin(contract.Rate.Denom, current), nil
+}
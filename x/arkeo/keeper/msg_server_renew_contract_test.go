@@ -0,0 +1,246 @@
+package keeper
+
+import (
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/arkeonetwork/arkeo/common"
+	"github.com/arkeonetwork/arkeo/common/cosmos"
+	"github.com/arkeonetwork/arkeo/x/arkeo/configs"
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+)
+
+func TestRenewContract(t *testing.T) {
+	ctx, k, sk := SetupKeeperWithStaking(t)
+	ctx = ctx.WithBlockHeight(10)
+	s := newMsgServer(k, sk)
+
+	// set up provider
+	providerPubKey := types.GetRandomPubKey()
+	providerAddress, err := providerPubKey.GetMyAddress()
+	require.NoError(t, err)
+	service := common.BTCService
+	provider := types.NewProvider(providerPubKey, service)
+	provider.Bond = cosmos.NewInt(10000000000)
+	require.NoError(t, k.SetProvider(ctx, provider))
+
+	rates, err := cosmos.ParseCoins("15uarkeo")
+	require.NoError(t, err)
+
+	modProviderMsg := types.MsgModProvider{
+		Creator:             providerAddress.String(),
+		Provider:            provider.PubKey,
+		Service:             provider.Service.String(),
+		MinContractDuration: 10,
+		MaxContractDuration: 500,
+		Status:              types.ProviderStatus_ONLINE,
+		PayAsYouGoRate:      rates,
+		SubscriptionRate:    rates,
+		UpdateMask:          types.ModProviderFields,
+	}
+	require.NoError(t, s.ModProviderHandle(ctx, &modProviderMsg))
+
+	// set up a subscription contract, expiring at 110
+	userPubKey := types.GetRandomPubKey()
+	userAddress, err := userPubKey.GetMyAddress()
+	require.NoError(t, err)
+	require.NoError(t, k.MintAndSendToAccount(ctx, userAddress, getCoin(common.Tokens(10))))
+
+	_, err = s.OpenContract(ctx, &types.MsgOpenContract{
+		Provider:         providerPubKey.String(),
+		Service:          service.String(),
+		Creator:          userAddress.String(),
+		Client:           userPubKey.String(),
+		ContractType:     types.ContractType_SUBSCRIPTION,
+		Duration:         100,
+		Rate:             rates[0],
+		Deposit:          cosmos.NewInt(1500),
+		QueriesPerMinute: 1,
+	})
+	require.NoError(t, err)
+	contract, err := s.GetActiveContractForUser(ctx, userPubKey, providerPubKey, service)
+	require.NoError(t, err)
+	require.Equal(t, int64(110), contract.Expiration())
+
+	msg := types.MsgRenewContract{
+		Creator:            userAddress.String(),
+		ContractId:         contract.Id,
+		AdditionalDuration: 50,
+		ExtraDeposit:       cosmos.NewInt(750),
+	}
+	require.NoError(t, msg.ValidateBasic())
+
+	// only the client can renew the contract
+	other := types.GetRandomBech32Addr()
+	otherMsg := msg
+	otherMsg.Creator = other.String()
+	_, err = s.RenewContract(ctx, &otherMsg)
+	require.ErrorIs(t, err, types.ErrRenewContractUnauthorized)
+
+	// the contract can't run longer than the provider max duration from now on
+	longMsg := msg
+	longMsg.AdditionalDuration = 450
+	longMsg.ExtraDeposit = cosmos.NewInt(15 * 450)
+	_, err = s.RenewContract(ctx, &longMsg)
+	require.ErrorIs(t, err, types.ErrOpenContractDuration)
+
+	// the extra deposit must pay for the extension
+	cheapMsg := msg
+	cheapMsg.ExtraDeposit = cosmos.NewInt(700)
+	_, err = s.RenewContract(ctx, &cheapMsg)
+	require.ErrorIs(t, err, types.ErrRenewContractDeposit)
+
+	// an expired contract can't be renewed
+	_, err = s.RenewContract(ctx.WithBlockHeight(111), &msg)
+	require.ErrorIs(t, err, types.ErrRenewContractClosed)
+
+	// happy path, the contract now expires at 160
+	_, err = s.RenewContract(ctx, &msg)
+	require.NoError(t, err)
+	res, err := k.ActiveContract(ctx, &types.QueryActiveContractRequest{
+		Spender:  userPubKey.String(),
+		Provider: providerPubKey.String(),
+		Service:  service.String(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, contract.Id, res.Contract.Id)
+	require.Equal(t, int64(150), res.Contract.Duration)
+	require.Equal(t, int64(160), res.Contract.Expiration())
+	require.Equal(t, int64(2250), res.Contract.Deposit.Int64())
+	require.Equal(t, int64(2250), k.GetBalanceOfModule(ctx, types.ContractName, configs.Denom).Int64())
+
+	// the contract is settled at its new expiration only
+	expirationSet, err := k.GetContractExpirationSet(ctx, 110)
+	require.NoError(t, err)
+	require.NotContains(t, expirationSet.ContractSet.ContractIds, contract.Id)
+	expirationSet, err = k.GetContractExpirationSet(ctx, 160)
+	require.NoError(t, err)
+	require.Contains(t, expirationSet.ContractSet.ContractIds, contract.Id)
+
+	// the renewal event carries the old and new expiration
+	var renewal *types.EventRenewContract
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != types.EventTypeRenewContract {
+			continue
+		}
+		typedEvent, err := sdk.ParseTypedEvent(abci.Event(event))
+		require.NoError(t, err)
+		renewal = typedEvent.(*types.EventRenewContract)
+	}
+	require.NotNil(t, renewal)
+	require.Equal(t, int64(110), renewal.OldExpiration)
+	require.Equal(t, int64(160), renewal.NewExpiration)
+	require.Equal(t, int64(750), renewal.ExtraDeposit.Int64())
+
+	// the provider raised its rate, the client has to accept it
+	ctx = ctx.WithBlockHeight(20)
+	modProviderMsg.SubscriptionRate = cosmos.NewCoins(cosmos.NewInt64Coin(configs.Denom, 20))
+	modProviderMsg.UpdateMask = []string{types.ModProviderFieldSubscriptionRate}
+	require.NoError(t, s.ModProviderHandle(ctx, &modProviderMsg))
+	msg.ExtraDeposit = cosmos.NewInt(20 * 50)
+	_, err = s.RenewContract(ctx, &msg)
+	require.ErrorIs(t, err, types.ErrRenewContractRateChanged)
+
+	msg.Rate = cosmos.NewInt64Coin(configs.Denom, 25)
+	_, err = s.RenewContract(ctx, &msg)
+	require.ErrorIs(t, err, types.ErrOpenContractMismatchRate)
+
+	// the blocks served so far are paid at the previous rate, the funds left are kept
+	msg.Rate = cosmos.NewInt64Coin(configs.Denom, 20)
+	_, err = s.RenewContract(ctx, &msg)
+	require.NoError(t, err)
+	contract, err = k.GetContract(ctx, contract.Id)
+	require.NoError(t, err)
+	require.Equal(t, int64(210), contract.Expiration())
+	require.Equal(t, int64(20), contract.Rate.Amount.Int64())
+	require.Equal(t, int64(2250-150+1000), contract.Deposit.Sub(contract.Paid).Int64())
+	require.Equal(t, int64(2250-150+1000), k.GetBalanceOfModule(ctx, types.ContractName, configs.Denom).Int64())
+
+	// a closed contract can't be renewed
+	_, err = s.CloseContract(ctx, &types.MsgCloseContract{
+		Creator:    userAddress.String(),
+		ContractId: contract.Id,
+		Client:     userPubKey,
+	})
+	require.NoError(t, err)
+	_, err = s.RenewContract(ctx, &msg)
+	require.ErrorIs(t, err, types.ErrRenewContractClosed)
+}
+
+func TestRenewScheduledContract(t *testing.T) {
+	ctx, k, sk := SetupKeeperWithStaking(t)
+	ctx = ctx.WithBlockHeight(10)
+	s := newMsgServer(k, sk)
+
+	providerPubKey := types.GetRandomPubKey()
+	providerAddress, err := providerPubKey.GetMyAddress()
+	require.NoError(t, err)
+	service := common.BTCService
+	provider := types.NewProvider(providerPubKey, service)
+	provider.Bond = cosmos.NewInt(10000000000)
+	require.NoError(t, k.SetProvider(ctx, provider))
+
+	rates, err := cosmos.ParseCoins("15uarkeo")
+	require.NoError(t, err)
+	modProviderMsg := types.MsgModProvider{
+		Creator:             providerAddress.String(),
+		Provider:            provider.PubKey,
+		Service:             provider.Service.String(),
+		MinContractDuration: 10,
+		MaxContractDuration: 500,
+		Status:              types.ProviderStatus_ONLINE,
+		PayAsYouGoRate:      rates,
+		SubscriptionRate:    rates,
+		UpdateMask:          types.ModProviderFields,
+	}
+	require.NoError(t, s.ModProviderHandle(ctx, &modProviderMsg))
+
+	// a subscription scheduled to start at 50, expiring at 150
+	userPubKey := types.GetRandomPubKey()
+	userAddress, err := userPubKey.GetMyAddress()
+	require.NoError(t, err)
+	require.NoError(t, k.MintAndSendToAccount(ctx, userAddress, getCoin(common.Tokens(10))))
+	_, err = s.OpenContract(ctx, &types.MsgOpenContract{
+		Provider:         providerPubKey.String(),
+		Service:          service.String(),
+		Creator:          userAddress.String(),
+		Client:           userPubKey.String(),
+		ContractType:     types.ContractType_SUBSCRIPTION,
+		Duration:         100,
+		Rate:             rates[0],
+		Deposit:          cosmos.NewInt(1500),
+		QueriesPerMinute: 1,
+		StartHeight:      50,
+	})
+	require.NoError(t, err)
+	contract, err := s.GetActiveContractForUser(ctx, userPubKey, providerPubKey, service)
+	require.NoError(t, err)
+	require.Equal(t, int64(150), contract.Expiration())
+
+	// renewed at a new rate before it starts, nothing is charged for the blocks before its start
+	ctx = ctx.WithBlockHeight(20)
+	modProviderMsg.SubscriptionRate = cosmos.NewCoins(cosmos.NewInt64Coin(configs.Denom, 20))
+	modProviderMsg.UpdateMask = []string{types.ModProviderFieldSubscriptionRate}
+	require.NoError(t, s.ModProviderHandle(ctx, &modProviderMsg))
+	_, err = s.RenewContract(ctx, &types.MsgRenewContract{
+		Creator:            userAddress.String(),
+		ContractId:         contract.Id,
+		AdditionalDuration: 50,
+		ExtraDeposit:       cosmos.NewInt(20 * 50),
+		Rate:               cosmos.NewInt64Coin(configs.Denom, 20),
+	})
+	require.NoError(t, err)
+	contract, err = k.GetContract(ctx, contract.Id)
+	require.NoError(t, err)
+	require.Equal(t, int64(200), contract.Expiration())
+	require.True(t, contract.Paid.IsZero())
+	require.Equal(t, int64(1500+1000), contract.Deposit.Int64())
+
+	// the provider is paid the new rate for the blocks served since the start
+	contract, err = s.mgr.SettleContract(ctx.WithBlockHeight(60), contract, 0, false)
+	require.NoError(t, err)
+	require.Equal(t, int64(20*10), contract.Paid.Int64())
+}
@@ -0,0 +1,112 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/arkeonetwork/arkeo/common/cosmos"
+	"github.com/arkeonetwork/arkeo/x/arkeo/configs"
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+)
+
+func (k msgServer) RotateDelegate(goCtx context.Context, msg *types.MsgRotateDelegate) (*types.MsgRotateDelegateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	ctx.Logger().Info(
+		"receive MsgRotateDelegate",
+		"contract_id", msg.ContractId,
+		"new delegate", msg.NewDelegate,
+	)
+
+	cacheCtx, commit := ctx.CacheContext()
+	if err := k.RotateDelegateValidate(cacheCtx, msg); err != nil {
+		ctx.Logger().Error("failed rotate delegate validation", "err", err)
+		return nil, err
+	}
+
+	if err := k.RotateDelegateHandle(cacheCtx, msg); err != nil {
+		ctx.Logger().Error("failed rotate delegate handler", "err", err)
+		return nil, err
+	}
+
+	commit()
+	return &types.MsgRotateDelegateResponse{}, nil
+}
+
+func (k msgServer) RotateDelegateValidate(ctx cosmos.Context, msg *types.MsgRotateDelegate) error {
+	if k.FetchConfig(ctx, configs.HandlerRotateDelegate) > 0 {
+		return errors.Wrapf(types.ErrDisabledHandler, "rotate delegate")
+	}
+
+	contract, err := k.GetContract(ctx, msg.ContractId)
+	if err != nil {
+		return err
+	}
+
+	if contract.IsEmpty() {
+		return errors.Wrapf(types.ErrContractNotFound, "id: %d", msg.ContractId)
+	}
+
+	if !contract.ClientAddress().Equals(msg.MustGetSigner()) {
+		return errors.Wrap(types.ErrRotateDelegateUnauthorized, "only the client can rotate the contract delegate")
+	}
+
+	if contract.SettlementHeight > 0 {
+		return errors.Wrapf(types.ErrRotateDelegateClosed, "closed %d", contract.SettlementHeight)
+	}
+
+	if contract.IsExpired(ctx.BlockHeight()) {
+		return errors.Wrapf(types.ErrRotateDelegateClosed, "expired %d", contract.Expiration())
+	}
+
+	// the provider would sign the claims it is paid for itself
+	if msg.NewDelegate.Equals(contract.Provider) {
+		return errors.Wrap(types.ErrRotateDelegateInvalid, "the provider cannot be the delegate")
+	}
+
+	if msg.NewDelegate.Equals(contract.GetSpender()) {
+		return errors.Wrapf(types.ErrRotateDelegateInvalid, "%s already spends the contract", msg.NewDelegate)
+	}
+
+	// a spender has a single open contract with a provider for a service
+	active, err := k.GetActiveContractForUser(ctx, msg.NewDelegate, contract.Provider, contract.Service)
+	if err != nil {
+		return err
+	}
+	if !active.IsEmpty() {
+		return errors.Wrapf(types.ErrRotateDelegateInvalid, "%s already spends contract %d with the provider", msg.NewDelegate, active.Id)
+	}
+
+	return nil
+}
+
+// RotateDelegateHandle replace the delegate of the contract, along with the contract sets indexing it. The claims are
+// checked against the new delegate from now on, the contract is otherwise left untouched
+func (k msgServer) RotateDelegateHandle(ctx cosmos.Context, msg *types.MsgRotateDelegate) error {
+	contract, err := k.GetContract(ctx, msg.ContractId)
+	if err != nil {
+		return err
+	}
+
+	oldDelegate := contract.Delegate
+	if !oldDelegate.IsEmpty() && !oldDelegate.Equals(contract.Client) {
+		if err := k.RemoveFromUserContractSet(ctx, oldDelegate, contract.Id); err != nil {
+			return err
+		}
+	}
+
+	contract.Delegate = msg.NewDelegate
+	for _, owner := range contract.Owners() {
+		if err := k.AddToUserContractSet(ctx, owner, contract.Id); err != nil {
+			return err
+		}
+	}
+
+	if err := k.SetContract(ctx, contract); err != nil {
+		return err
+	}
+
+	return k.EmitRotateDelegateEvent(ctx, oldDelegate, &contract)
+}
@@ -0,0 +1,197 @@
+package keeper
+
+import (
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	cKeys "github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/std"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/stretchr/testify/require"
+
+	"github.com/arkeonetwork/arkeo/common"
+	"github.com/arkeonetwork/arkeo/common/cosmos"
+	"github.com/arkeonetwork/arkeo/x/arkeo/configs"
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+)
+
+func TestRotateDelegate(t *testing.T) {
+	ctx, k, sk := SetupKeeperWithStaking(t)
+	ctx = ctx.WithBlockHeight(10)
+	s := newMsgServer(k, sk)
+
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	std.RegisterInterfaces(interfaceRegistry)
+	types.RegisterInterfaces(interfaceRegistry)
+	kb := cKeys.NewInMemory(codec.NewProtoCodec(interfaceRegistry))
+	newKey := func(name string) common.PubKey {
+		info, _, err := kb.NewMnemonic(name, cKeys.English, `m/44'/931'/0'/0/0`, "", hd.Secp256k1)
+		require.NoError(t, err)
+		pk, err := info.GetPubKey()
+		require.NoError(t, err)
+		pubkey, err := common.NewPubKeyFromCrypto(pk)
+		require.NoError(t, err)
+		return pubkey
+	}
+	oldDelegate := newKey("old")
+	newDelegate := newKey("new")
+
+	providerPubKey := types.GetRandomPubKey()
+	providerAddress, err := providerPubKey.GetMyAddress()
+	require.NoError(t, err)
+	service := common.BTCService
+	provider := types.NewProvider(providerPubKey, service)
+	provider.Bond = cosmos.NewInt(common.Tokens(1))
+	require.NoError(t, k.SetProvider(ctx, provider))
+	require.NoError(t, k.MintToModule(ctx, types.ModuleName, getCoin(provider.Bond.Int64())))
+	require.NoError(t, k.SendFromModuleToModule(ctx, types.ModuleName, types.ProviderName, getCoins(provider.Bond.Int64())))
+	rates := cosmos.NewCoins(cosmos.NewInt64Coin(configs.Denom, 15))
+	require.NoError(t, s.ModProviderHandle(ctx, &types.MsgModProvider{
+		Creator:             providerAddress.String(),
+		Provider:            provider.PubKey,
+		Service:             provider.Service.String(),
+		MetadataNonce:       1,
+		MinContractDuration: 10,
+		MaxContractDuration: 500,
+		Status:              types.ProviderStatus_ONLINE,
+		SubscriptionRate:    rates,
+		PayAsYouGoRate:      rates,
+		UpdateMask:          types.ModProviderFields,
+	}))
+
+	openContract := func(client, delegate common.PubKey) types.Contract {
+		clientAddress, err := client.GetMyAddress()
+		require.NoError(t, err)
+		require.NoError(t, k.MintAndSendToAccount(ctx, clientAddress, getCoin(common.Tokens(10))))
+		_, err = s.OpenContract(ctx, &types.MsgOpenContract{
+			Provider:         providerPubKey.String(),
+			Service:          service.String(),
+			Creator:          clientAddress.String(),
+			Client:           client.String(),
+			Delegate:         delegate.String(),
+			ContractType:     types.ContractType_PAY_AS_YOU_GO,
+			Duration:         100,
+			Rate:             rates[0],
+			Deposit:          cosmos.NewInt(1500),
+			QueriesPerMinute: 1,
+			Authorization:    types.ContractAuthorization_STRICT,
+		})
+		require.NoError(t, err)
+		contract, err := k.GetActiveContractForUser(ctx, delegate, providerPubKey, service)
+		require.NoError(t, err)
+		require.False(t, contract.IsEmpty())
+		return contract
+	}
+	claim := func(contractId uint64, signer string, nonce int64) error {
+		msg := types.MsgClaimContractIncome{
+			ContractId: contractId,
+			Creator:    providerAddress.String(),
+			Nonce:      nonce,
+		}
+		msg.Signature, _, err = kb.Sign(signer, msg.GetBytesToSign(), signing.SignMode_SIGN_MODE_DIRECT)
+		require.NoError(t, err)
+		_, err := s.ClaimContractIncome(ctx, &msg)
+		return err
+	}
+
+	client := types.GetRandomPubKey()
+	contract := openContract(client, oldDelegate)
+	require.NoError(t, claim(contract.Id, "old", 5))
+
+	msg := types.NewMsgRotateDelegate(contract.ClientAddress(), contract.Id, newDelegate)
+
+	// only the client rotates its delegate
+	oldDelegateAddress, err := oldDelegate.GetMyAddress()
+	require.NoError(t, err)
+	_, err = s.RotateDelegate(ctx, types.NewMsgRotateDelegate(oldDelegateAddress, contract.Id, newDelegate))
+	require.ErrorIs(t, err, types.ErrRotateDelegateUnauthorized)
+
+	// the provider can't spend the contract, nor does the current delegate change
+	msg.NewDelegate = providerPubKey
+	_, err = s.RotateDelegate(ctx, msg)
+	require.ErrorIs(t, err, types.ErrRotateDelegateInvalid)
+	msg.NewDelegate = oldDelegate
+	_, err = s.RotateDelegate(ctx, msg)
+	require.ErrorIs(t, err, types.ErrRotateDelegateInvalid)
+
+	// a delegate already spending a contract with the provider
+	other := openContract(types.GetRandomPubKey(), types.GetRandomPubKey())
+	msg.NewDelegate = other.Delegate
+	_, err = s.RotateDelegate(ctx, msg)
+	require.ErrorIs(t, err, types.ErrRotateDelegateInvalid)
+
+	// happy path
+	ctx = ctx.WithEventManager(cosmos.NewEventManager())
+	msg.NewDelegate = newDelegate
+	_, err = s.RotateDelegate(ctx, msg)
+	require.NoError(t, err)
+
+	rotated, err := k.GetContract(ctx, contract.Id)
+	require.NoError(t, err)
+	require.Equal(t, newDelegate, rotated.Delegate)
+	// nothing else changed
+	rotated.Delegate = contract.Delegate
+	contract.Nonce = 5
+	contract.Paid = rotated.Paid
+	require.Equal(t, contract, rotated)
+
+	// the contract is indexed by its new delegate
+	active, err := k.GetActiveContractForUser(ctx, newDelegate, providerPubKey, service)
+	require.NoError(t, err)
+	require.Equal(t, contract.Id, active.Id)
+	active, err = k.GetActiveContractForUser(ctx, oldDelegate, providerPubKey, service)
+	require.NoError(t, err)
+	require.True(t, active.IsEmpty())
+	set, err := k.GetUserContractSet(ctx, oldDelegate)
+	require.NoError(t, err)
+	require.False(t, set.Contains(contract.Id))
+	set, err = k.GetUserContractSet(ctx, client)
+	require.NoError(t, err)
+	require.True(t, set.Contains(contract.Id))
+
+	var event *types.EventRotateDelegate
+	for _, evt := range ctx.EventManager().Events() {
+		if evt.Type != types.EventTypeRotateDelegate {
+			continue
+		}
+		typedEvent, err := sdk.ParseTypedEvent(abci.Event(evt))
+		require.NoError(t, err)
+		event = typedEvent.(*types.EventRotateDelegate)
+	}
+	require.NotNil(t, event)
+	require.Equal(t, contract.Id, event.ContractId)
+	require.Equal(t, oldDelegate, event.OldDelegate)
+	require.Equal(t, newDelegate, event.NewDelegate)
+
+	// the claims signed by the old delegate are rejected, the new delegate's go through
+	err = claim(contract.Id, "old", 10)
+	require.ErrorIs(t, err, types.ErrClaimContractIncomeInvalidSignature)
+	require.NoError(t, claim(contract.Id, "new", 10))
+
+	// back to the client spending it
+	msg.NewDelegate = client
+	_, err = s.RotateDelegate(ctx, msg)
+	require.NoError(t, err)
+	set, err = k.GetUserContractSet(ctx, newDelegate)
+	require.NoError(t, err)
+	require.False(t, set.Contains(contract.Id))
+	active, err = k.GetActiveContractForUser(ctx, client, providerPubKey, service)
+	require.NoError(t, err)
+	require.Equal(t, contract.Id, active.Id)
+
+	// expired
+	msg.NewDelegate = newDelegate
+	_, err = s.RotateDelegate(ctx.WithBlockHeight(contract.Expiration()+1), msg)
+	require.ErrorIs(t, err, types.ErrRotateDelegateClosed)
+
+	// settled, the provider closing it
+	_, err = s.ProviderCloseContract(ctx, types.NewMsgProviderCloseContract(providerAddress, other.Id))
+	require.NoError(t, err)
+	_, err = s.RotateDelegate(ctx, types.NewMsgRotateDelegate(other.ClientAddress(), other.Id, newDelegate))
+	require.ErrorIs(t, err, types.ErrRotateDelegateClosed)
+}
@@ -0,0 +1,94 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/arkeonetwork/arkeo/common/cosmos"
+	"github.com/arkeonetwork/arkeo/x/arkeo/configs"
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+)
+
+func (k msgServer) SetAutoRenew(goCtx context.Context, msg *types.MsgSetAutoRenew) (*types.MsgSetAutoRenewResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	ctx.Logger().Info(
+		"receive MsgSetAutoRenew",
+		"contract_id", msg.ContractId,
+		"auto renew", msg.AutoRenew,
+	)
+
+	cacheCtx, commit := ctx.CacheContext()
+	if err := k.SetAutoRenewValidate(cacheCtx, msg); err != nil {
+		ctx.Logger().Error("failed set auto-renew validation", "err", err)
+		return nil, err
+	}
+
+	if err := k.SetAutoRenewHandle(cacheCtx, msg); err != nil {
+		ctx.Logger().Error("failed set auto-renew handler", "err", err)
+		return nil, err
+	}
+
+	commit()
+	return &types.MsgSetAutoRenewResponse{}, nil
+}
+
+func (k msgServer) SetAutoRenewValidate(ctx cosmos.Context, msg *types.MsgSetAutoRenew) error {
+	if k.FetchConfig(ctx, configs.HandlerSetAutoRenew) > 0 {
+		return errors.Wrapf(types.ErrDisabledHandler, "set auto-renew")
+	}
+
+	contract, err := k.GetContract(ctx, msg.ContractId)
+	if err != nil {
+		return err
+	}
+
+	if contract.IsEmpty() {
+		return errors.Wrapf(types.ErrContractNotFound, "id: %d", msg.ContractId)
+	}
+
+	if !contract.ClientAddress().Equals(msg.MustGetSigner()) {
+		return errors.Wrap(types.ErrSetAutoRenewUnauthorized, "only the client can set the contract auto-renew")
+	}
+
+	if !contract.IsSubscription() {
+		return errors.Wrapf(types.ErrContractNotSubscription, "%s contract cannot auto-renew", contract.Type)
+	}
+
+	if contract.SettlementHeight > 0 {
+		return errors.Wrapf(types.ErrSetAutoRenewClosed, "closed %d", contract.SettlementHeight)
+	}
+
+	if contract.IsExpired(ctx.BlockHeight()) {
+		return errors.Wrapf(types.ErrSetAutoRenewClosed, "expired %d", contract.Expiration())
+	}
+
+	return nil
+}
+
+// SetAutoRenewHandle turn the auto-renewal of the subscription on or off. Turned off, the top-up balance it had for its
+// renewals is refunded to the client
+func (k msgServer) SetAutoRenewHandle(ctx cosmos.Context, msg *types.MsgSetAutoRenew) error {
+	contract, err := k.GetContract(ctx, msg.ContractId)
+	if err != nil {
+		return err
+	}
+
+	refund := cosmos.ZeroInt()
+	if !msg.AutoRenew && contract.TopUpBalance().IsPositive() {
+		refund = contract.TopUpBalance()
+		if err := k.SendFromModuleToAccount(ctx, types.ContractName, msg.MustGetSigner(), cosmos.NewCoins(cosmos.NewCoin(contract.Rate.Denom, refund))); err != nil {
+			return errors.Wrapf(err, "failed to refund top-up=%s", refund)
+		}
+	}
+
+	contract.AutoRenew = msg.AutoRenew
+	contract.TopUp = contract.TopUpBalance().Sub(refund)
+	if err := k.SetContract(ctx, contract); err != nil {
+		return err
+	}
+
+	return k.EmitSetAutoRenewEvent(ctx, refund, &contract)
+}
@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/arkeonetwork/arkeo/common"
+	"github.com/arkeonetwork/arkeo/common/cosmos"
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+)
+
+func TestSetAutoRenew(t *testing.T) {
+	f := newSubscriptionFixture(t)
+	contract := f.open(types.ContractType_SUBSCRIPTION, true)
+
+	// only the client sets the auto-renewal of a subscription
+	_, err := f.s.SetAutoRenew(f.ctx, types.NewMsgSetAutoRenew(types.GetRandomBech32Addr(), contract.Id, false))
+	require.ErrorIs(t, err, types.ErrSetAutoRenewUnauthorized)
+	payg := f.open(types.ContractType_PAY_AS_YOU_GO, false)
+	_, err = f.s.SetAutoRenew(f.ctx, types.NewMsgSetAutoRenew(payg.ClientAddress(), payg.Id, true))
+	require.ErrorIs(t, err, types.ErrContractNotSubscription)
+
+	// the top-up waits in the balance of the auto-renewed contract
+	_, err = f.s.TopUpContract(f.ctx, types.NewMsgTopUpContract(contract.ClientAddress(), contract.Id, cosmos.NewInt(30*50)))
+	require.NoError(t, err)
+	contract = f.contract(contract.Id)
+	require.Equal(t, int64(110), contract.Expiration())
+	require.Equal(t, cosmos.NewInt(30*50), contract.TopUp)
+
+	// turned off before its expiration, the balance is refunded
+	balance := f.balance(contract)
+	f.ctx = f.ctx.WithBlockHeight(50).WithEventManager(sdk.NewEventManager())
+	_, err = f.s.SetAutoRenew(f.ctx, types.NewMsgSetAutoRenew(contract.ClientAddress(), contract.Id, false))
+	require.NoError(t, err)
+	contract = f.contract(contract.Id)
+	require.False(t, contract.AutoRenew)
+	require.True(t, contract.TopUp.IsZero())
+	require.Equal(t, balance+30*50, f.balance(contract))
+	events := f.events(types.EventTypeSetAutoRenew)
+	require.Len(t, events, 1)
+	require.Equal(t, &types.EventSetAutoRenew{
+		Provider:   f.provider,
+		ContractId: contract.Id,
+		Service:    common.BTCService.String(),
+		Client:     contract.Client,
+		AutoRenew:  false,
+		Refund:     cosmos.NewInt(30 * 50),
+	}, events[0])
+
+	// the contract then expires as any other
+	f.ctx = f.ctx.WithBlockHeight(contract.Expiration()).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, f.mgr.ContractEndBlock(f.ctx))
+	require.Empty(t, f.events(types.EventTypeContractAutoRenewed))
+	expirations := f.events(types.EventTypeContractExpired)
+	require.Len(t, expirations, 2) // the pay-as-you-go contract opened at the same height too
+	require.Equal(t, contract.Id, expirations[1].(*types.EventContractExpired).ContractId)
+	contract = f.contract(contract.Id)
+	require.Equal(t, int64(110), contract.SettlementHeight)
+
+	// settled, its auto-renewal can't be set anymore
+	_, err = f.s.SetAutoRenew(f.ctx, types.NewMsgSetAutoRenew(contract.ClientAddress(), contract.Id, true))
+	require.ErrorIs(t, err, types.ErrSetAutoRenewClosed)
+}
@@ -0,0 +1,90 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/arkeonetwork/arkeo/common"
+	"github.com/arkeonetwork/arkeo/common/cosmos"
+	"github.com/arkeonetwork/arkeo/x/arkeo/configs"
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+)
+
+func (k msgServer) SetProviderAllowlist(goCtx context.Context, msg *types.MsgSetProviderAllowlist) (*types.MsgSetProviderAllowlistResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	ctx.Logger().Info(
+		"receive MsgSetProviderAllowlist",
+		"provider", msg.Provider,
+		"service", msg.Service,
+		"action", msg.Action,
+		"clients", len(msg.Clients),
+	)
+
+	cacheCtx, commit := ctx.CacheContext()
+	if err := k.SetProviderAllowlistValidate(cacheCtx, msg); err != nil {
+		ctx.Logger().Error("failed set provider allowlist validation", "err", err)
+		return nil, err
+	}
+
+	if err := k.SetProviderAllowlistHandle(cacheCtx, msg); err != nil {
+		ctx.Logger().Error("failed set provider allowlist handle", "err", err)
+		return nil, err
+	}
+
+	commit()
+
+	return &types.MsgSetProviderAllowlistResponse{}, nil
+}
+
+func (k msgServer) SetProviderAllowlistValidate(ctx cosmos.Context, msg *types.MsgSetProviderAllowlist) error {
+	if k.FetchConfig(ctx, configs.HandlerSetProviderAllowlist) > 0 {
+		return errors.Wrapf(types.ErrDisabledHandler, "set provider allowlist")
+	}
+
+	service, err := common.NewService(msg.Service)
+	if err != nil {
+		return err
+	}
+	provider, err := k.GetProvider(ctx, msg.Provider, service)
+	if err != nil {
+		return err
+	}
+	if provider.Bond.IsZero() {
+		return errors.Wrapf(types.ErrProviderNotFound, "provider %s for service %s not found", msg.Provider, msg.Service)
+	}
+
+	// the allowlist is capped as the provider is left with it, it can always be shrunk
+	allowlist, err := k.GetProviderAllowlist(ctx, msg.Provider, service)
+	if err != nil {
+		return err
+	}
+	size := len(allowlist.Clients)
+	allowlist.Apply(msg.Action, msg.Clients)
+	if maxSize := k.GetParams(ctx).MaxAllowlistSize; uint64(len(allowlist.Clients)) > maxSize && len(allowlist.Clients) > size {
+		return errors.Wrapf(types.ErrInvalidAllowlist, "%d clients, at most %d allowed", len(allowlist.Clients), maxSize)
+	}
+
+	return nil
+}
+
+func (k msgServer) SetProviderAllowlistHandle(ctx cosmos.Context, msg *types.MsgSetProviderAllowlist) error {
+	service, err := common.NewService(msg.Service)
+	if err != nil {
+		return err
+	}
+	allowlist, err := k.GetProviderAllowlist(ctx, msg.Provider, service)
+	if err != nil {
+		return err
+	}
+
+	// the contracts already open are left untouched, the allowlist only applies to the ones opened next
+	allowlist.Apply(msg.Action, msg.Clients)
+	if err := k.Keeper.SetProviderAllowlist(ctx, allowlist); err != nil {
+		return err
+	}
+
+	return k.EmitSetProviderAllowlistEvent(ctx, msg.Action, &allowlist)
+}
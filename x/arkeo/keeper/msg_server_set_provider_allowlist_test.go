@@ -0,0 +1,130 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/arkeonetwork/arkeo/common"
+	"github.com/arkeonetwork/arkeo/common/cosmos"
+	"github.com/arkeonetwork/arkeo/x/arkeo/configs"
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+)
+
+func TestSetProviderAllowlist(t *testing.T) {
+	ctx, k, sk := SetupKeeperWithStaking(t)
+	ctx = ctx.WithBlockHeight(10)
+	s := newMsgServer(k, sk)
+	params := k.GetParams(ctx)
+	params.MaxAllowlistSize = 2
+	k.SetParams(ctx, params)
+
+	providerPubKey := types.GetRandomPubKey()
+	providerAddress, err := providerPubKey.GetMyAddress()
+	require.NoError(t, err)
+	service := common.BTCService
+	provider := types.NewProvider(providerPubKey, service)
+	provider.Bond = cosmos.NewInt(common.Tokens(1))
+	require.NoError(t, k.SetProvider(ctx, provider))
+
+	rates := cosmos.NewCoins(cosmos.NewInt64Coin(configs.Denom, 15))
+	require.NoError(t, s.ModProviderHandle(ctx, &types.MsgModProvider{
+		Creator:             providerAddress.String(),
+		Provider:            provider.PubKey,
+		Service:             provider.Service.String(),
+		MetadataNonce:       1,
+		MinContractDuration: 10,
+		MaxContractDuration: 500,
+		Status:              types.ProviderStatus_ONLINE,
+		SubscriptionRate:    rates,
+		PayAsYouGoRate:      rates,
+		UpdateMask:          types.ModProviderFields,
+	}))
+
+	setAllowlist := func(action types.AllowlistAction, clients ...common.PubKey) error {
+		_, err := s.SetProviderAllowlist(ctx, types.NewMsgSetProviderAllowlist(providerAddress, providerPubKey, service.String(), action, clients))
+		return err
+	}
+	openContract := func(client common.PubKey) (types.Contract, error) {
+		clientAddress, err := client.GetMyAddress()
+		require.NoError(t, err)
+		require.NoError(t, k.MintAndSendToAccount(ctx, clientAddress, getCoin(common.Tokens(10))))
+		_, err = s.OpenContract(ctx, &types.MsgOpenContract{
+			Provider:         providerPubKey.String(),
+			Service:          service.String(),
+			Creator:          clientAddress.String(),
+			Client:           client.String(),
+			ContractType:     types.ContractType_SUBSCRIPTION,
+			Duration:         100,
+			Rate:             rates[0],
+			Deposit:          cosmos.NewInt(1500),
+			QueriesPerMinute: 1,
+			Authorization:    types.ContractAuthorization_OPEN,
+		})
+		if err != nil {
+			return types.Contract{}, err
+		}
+		return k.GetActiveContractForUser(ctx, client, providerPubKey, service)
+	}
+	allowlisted := func() []common.PubKey {
+		allowlist, err := k.GetProviderAllowlist(ctx, providerPubKey, service)
+		require.NoError(t, err)
+		return allowlist.Clients
+	}
+
+	// without an allowlist any client opens a contract
+	alice, bob, carol := types.GetRandomPubKey(), types.GetRandomPubKey(), types.GetRandomPubKey()
+	_, err = openContract(carol)
+	require.NoError(t, err)
+
+	// with one, only the clients on it
+	require.NoError(t, setAllowlist(types.AllowlistAction_ALLOWLIST_REPLACE, alice))
+	require.Equal(t, []common.PubKey{alice}, allowlisted())
+	_, err = openContract(bob)
+	require.ErrorIs(t, err, types.ErrOpenContractClientNotAllowed)
+	aliceContract, err := openContract(alice)
+	require.NoError(t, err)
+	require.False(t, aliceContract.IsEmpty())
+
+	// the contracts already open are kept as the allowlist changes, the one of carol opened before it
+	require.NoError(t, setAllowlist(types.AllowlistAction_ALLOWLIST_ADD, bob, alice))
+	require.Equal(t, []common.PubKey{alice, bob}, allowlisted())
+	require.NoError(t, setAllowlist(types.AllowlistAction_ALLOWLIST_REMOVE, alice))
+	require.Equal(t, []common.PubKey{bob}, allowlisted())
+	for _, client := range []common.PubKey{alice, carol} {
+		contract, err := k.GetActiveContractForUser(ctx, client, providerPubKey, service)
+		require.NoError(t, err)
+		require.False(t, contract.IsEmpty())
+		require.False(t, contract.IsExpired(ctx.BlockHeight()))
+	}
+	_, err = s.ClaimContractIncome(ctx, &types.MsgClaimContractIncome{
+		Creator:    providerAddress.String(),
+		ContractId: aliceContract.Id,
+		Nonce:      1,
+	})
+	require.NoError(t, err)
+	_, err = openContract(bob)
+	require.NoError(t, err)
+
+	// the allowlist is capped, shrinking it always goes through
+	err = setAllowlist(types.AllowlistAction_ALLOWLIST_ADD, alice, carol)
+	require.ErrorIs(t, err, types.ErrInvalidAllowlist)
+	require.Equal(t, []common.PubKey{bob}, allowlisted())
+	require.NoError(t, setAllowlist(types.AllowlistAction_ALLOWLIST_REPLACE, alice, carol))
+	params.MaxAllowlistSize = 1
+	k.SetParams(ctx, params)
+	require.NoError(t, setAllowlist(types.AllowlistAction_ALLOWLIST_REMOVE, alice))
+	require.Equal(t, []common.PubKey{carol}, allowlisted())
+	err = setAllowlist(types.AllowlistAction_ALLOWLIST_REPLACE, alice, bob)
+	require.ErrorIs(t, err, types.ErrInvalidAllowlist)
+
+	// clearing it opens the provider to all clients again
+	require.NoError(t, setAllowlist(types.AllowlistAction_ALLOWLIST_REPLACE))
+	require.Empty(t, allowlisted())
+	_, err = openContract(types.GetRandomPubKey())
+	require.NoError(t, err)
+
+	// an unbonded provider has no allowlist
+	_, err = s.SetProviderAllowlist(ctx, types.NewMsgSetProviderAllowlist(providerAddress, providerPubKey, common.ETHService.String(), types.AllowlistAction_ALLOWLIST_ADD, []common.PubKey{alice}))
+	require.ErrorIs(t, err, types.ErrProviderNotFound)
+}
@@ -0,0 +1,129 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/arkeonetwork/arkeo/common/cosmos"
+	"github.com/arkeonetwork/arkeo/x/arkeo/configs"
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+)
+
+func (k msgServer) TopUpContract(goCtx context.Context, msg *types.MsgTopUpContract) (*types.MsgTopUpContractResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	ctx.Logger().Info(
+		"receive MsgTopUpContract",
+		"contract_id", msg.ContractId,
+		"deposit", msg.Deposit,
+	)
+
+	cacheCtx, commit := ctx.CacheContext()
+	if err := k.TopUpContractValidate(cacheCtx, msg); err != nil {
+		ctx.Logger().Error("failed top up contract validation", "err", err)
+		return nil, err
+	}
+
+	if err := k.TopUpContractHandle(cacheCtx, msg); err != nil {
+		ctx.Logger().Error("failed top up contract handler", "err", err)
+		return nil, err
+	}
+
+	commit()
+	return &types.MsgTopUpContractResponse{}, nil
+}
+
+func (k msgServer) TopUpContractValidate(ctx cosmos.Context, msg *types.MsgTopUpContract) error {
+	if k.FetchConfig(ctx, configs.HandlerTopUpContract) > 0 {
+		return errors.Wrapf(types.ErrDisabledHandler, "top up contract")
+	}
+
+	contract, err := k.GetContract(ctx, msg.ContractId)
+	if err != nil {
+		return err
+	}
+
+	if contract.IsEmpty() {
+		return errors.Wrapf(types.ErrContractNotFound, "id: %d", msg.ContractId)
+	}
+
+	if !contract.ClientAddress().Equals(msg.MustGetSigner()) {
+		return errors.Wrap(types.ErrTopUpContractUnauthorized, "only the client can top up the contract")
+	}
+
+	if !contract.IsSubscription() {
+		return errors.Wrapf(types.ErrContractNotSubscription, "%s contract cannot be topped up", contract.Type)
+	}
+
+	if contract.SettlementHeight > 0 {
+		return errors.Wrapf(types.ErrTopUpContractClosed, "closed %d", contract.SettlementHeight)
+	}
+
+	if contract.IsExpired(ctx.BlockHeight()) {
+		return errors.Wrapf(types.ErrTopUpContractClosed, "expired %d", contract.Expiration())
+	}
+
+	cost := contract.BlockCost()
+	if msg.Deposit.LT(cost) {
+		return errors.Wrapf(types.ErrTopUpContractDeposit, "deposit must afford a block at least (rate*qpm: %s * %d), client sent %s", contract.Rate.Amount, contract.QueriesPerMinute, msg.Deposit)
+	}
+
+	// an auto-renewed contract keeps the top-up for its renewals, the others are extended right away
+	if contract.AutoRenew {
+		return nil
+	}
+
+	provider, err := k.GetProvider(ctx, contract.Provider, contract.Service)
+	if err != nil {
+		return err
+	}
+
+	// the extension is priced at the rate the contract runs at, a changed rate is accepted by renewing it instead
+	if !cosmos.NewCoins(provider.SubscriptionRate...).AmountOf(contract.Rate.Denom).Equal(contract.Rate.Amount) {
+		return errors.Wrapf(types.ErrRenewContractRateChanged, "contract runs at %s", contract.Rate)
+	}
+
+	// the contract can't run for longer than the provider allows from now on
+	remaining := contract.Expiration() - ctx.BlockHeight()
+	blocks := contract.TopUpBalance().Add(msg.Deposit).Quo(cost)
+	if blocks.AddRaw(remaining).GT(cosmos.NewInt(provider.MaxContractDuration)) {
+		return errors.Wrapf(types.ErrOpenContractDuration, "duration exceeds allowed maximum duration from provider (%s/%d)", blocks.AddRaw(remaining), provider.MaxContractDuration)
+	}
+
+	return nil
+}
+
+// TopUpContractHandle escrow the deposit in the top-up balance of the subscription. The contract is extended by the
+// blocks the balance affords at once, unless it auto-renews, the balance is then spent as it reaches its expiration
+func (k msgServer) TopUpContractHandle(ctx cosmos.Context, msg *types.MsgTopUpContract) error {
+	contract, err := k.GetContract(ctx, msg.ContractId)
+	if err != nil {
+		return err
+	}
+
+	if err := k.SendFromAccountToModule(ctx, msg.MustGetSigner(), types.ContractName, cosmos.NewCoins(cosmos.NewCoin(contract.Rate.Denom, msg.Deposit))); err != nil {
+		return errors.Wrapf(err, "failed to send top-up deposit=%s", msg.Deposit)
+	}
+	contract.TopUp = contract.TopUpBalance().Add(msg.Deposit)
+
+	oldExpiration := contract.Expiration()
+	if !contract.AutoRenew {
+		provider, err := k.GetProvider(ctx, contract.Provider, contract.Service)
+		if err != nil {
+			return err
+		}
+		remaining := contract.Expiration() - ctx.BlockHeight()
+		contract, _, err = k.mgr.extendContract(ctx, contract, provider.MaxContractDuration-remaining)
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := k.SetContract(ctx, contract); err != nil {
+		return err
+	}
+
+	return k.EmitTopUpContractEvent(ctx, oldExpiration, msg.Deposit, &contract)
+}
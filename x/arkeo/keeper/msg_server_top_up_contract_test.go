@@ -0,0 +1,175 @@
+package keeper
+
+import (
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/arkeonetwork/arkeo/common"
+	"github.com/arkeonetwork/arkeo/common/cosmos"
+	"github.com/arkeonetwork/arkeo/x/arkeo/configs"
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+)
+
+// subscriptionFixture is an online provider charging 15 a block for each query per minute, opening contracts of
+// clients funded with 10 tokens
+type subscriptionFixture struct {
+	t        *testing.T
+	ctx      cosmos.Context
+	k        Keeper
+	s        *msgServer
+	mgr      Manager
+	provider common.PubKey
+	rate     cosmos.Coin
+}
+
+func newSubscriptionFixture(t *testing.T) *subscriptionFixture {
+	ctx, k, sk := SetupKeeperWithStaking(t)
+	ctx = ctx.WithBlockHeight(10)
+	s := newMsgServer(k, sk)
+
+	providerPubKey := types.GetRandomPubKey()
+	providerAddress, err := providerPubKey.GetMyAddress()
+	require.NoError(t, err)
+	require.NoError(t, k.MintAndSendToAccount(ctx, providerAddress, getCoin(common.Tokens(10))))
+	_, err = s.BondProvider(ctx, &types.MsgBondProvider{
+		Creator:  providerAddress.String(),
+		Provider: providerPubKey.String(),
+		Service:  common.BTCService.String(),
+		Bond:     cosmos.NewInt(common.Tokens(8)),
+	})
+	require.NoError(t, err)
+	rates := getCoins(15)
+	_, err = s.ModProvider(ctx, &types.MsgModProvider{
+		Creator:             providerAddress.String(),
+		Provider:            providerPubKey,
+		Service:             common.BTCService.String(),
+		MetadataNonce:       1,
+		MinContractDuration: 10,
+		MaxContractDuration: 500,
+		Status:              types.ProviderStatus_ONLINE,
+		PayAsYouGoRate:      rates,
+		SubscriptionRate:    rates,
+		UpdateMask:          types.ModProviderFields,
+	})
+	require.NoError(t, err)
+
+	return &subscriptionFixture{t: t, ctx: ctx, k: k, s: s, mgr: NewManager(k, sk), provider: providerPubKey, rate: rates[0]}
+}
+
+// open a contract of 100 blocks at 2 queries per minute, a block costing 30
+func (f *subscriptionFixture) open(contractType types.ContractType, autoRenew bool) types.Contract {
+	client := types.GetRandomPubKey()
+	clientAddress, err := client.GetMyAddress()
+	require.NoError(f.t, err)
+	require.NoError(f.t, f.k.MintAndSendToAccount(f.ctx, clientAddress, getCoin(common.Tokens(10))))
+	_, err = f.s.OpenContract(f.ctx, &types.MsgOpenContract{
+		Provider:         f.provider.String(),
+		Service:          common.BTCService.String(),
+		Creator:          clientAddress.String(),
+		Client:           client.String(),
+		ContractType:     contractType,
+		Duration:         100,
+		Rate:             f.rate,
+		Deposit:          cosmos.NewInt(30 * 100),
+		QueriesPerMinute: 2,
+		AutoRenew:        autoRenew,
+	})
+	require.NoError(f.t, err)
+	contract, err := f.k.GetActiveContractForUser(f.ctx, client, f.provider, common.BTCService)
+	require.NoError(f.t, err)
+	require.Equal(f.t, autoRenew, contract.AutoRenew)
+	return contract
+}
+
+func (f *subscriptionFixture) contract(id uint64) types.Contract {
+	contract, err := f.k.GetContract(f.ctx, id)
+	require.NoError(f.t, err)
+	return contract
+}
+
+func (f *subscriptionFixture) balance(contract types.Contract) int64 {
+	return f.k.GetBalance(f.ctx, contract.ClientAddress()).AmountOf(configs.Denom).Int64()
+}
+
+// events return the typed events of the type emitted so far
+func (f *subscriptionFixture) events(eventType string) []sdk.Msg {
+	var events []sdk.Msg
+	for _, event := range f.ctx.EventManager().Events() {
+		if event.Type != eventType {
+			continue
+		}
+		typedEvent, err := sdk.ParseTypedEvent(abci.Event(event))
+		require.NoError(f.t, err)
+		events = append(events, typedEvent)
+	}
+	return events
+}
+
+func TestTopUpContract(t *testing.T) {
+	f := newSubscriptionFixture(t)
+	contract := f.open(types.ContractType_SUBSCRIPTION, false)
+	require.Equal(t, int64(110), contract.Expiration())
+	require.Equal(t, cosmos.NewInt(30), contract.BlockCost())
+	msg := types.NewMsgTopUpContract(contract.ClientAddress(), contract.Id, cosmos.NewInt(30*50+10))
+
+	// only the client tops up its contract
+	_, err := f.s.TopUpContract(f.ctx, types.NewMsgTopUpContract(types.GetRandomBech32Addr(), contract.Id, msg.Deposit))
+	require.ErrorIs(t, err, types.ErrTopUpContractUnauthorized)
+
+	// a pay-as-you-go contract has no blocks to extend
+	payg := f.open(types.ContractType_PAY_AS_YOU_GO, false)
+	_, err = f.s.TopUpContract(f.ctx, types.NewMsgTopUpContract(payg.ClientAddress(), payg.Id, msg.Deposit))
+	require.ErrorIs(t, err, types.ErrContractNotSubscription)
+
+	// the deposit affords a block at least, and the contract runs no longer than the provider allows
+	_, err = f.s.TopUpContract(f.ctx, types.NewMsgTopUpContract(contract.ClientAddress(), contract.Id, cosmos.NewInt(29)))
+	require.ErrorIs(t, err, types.ErrTopUpContractDeposit)
+	_, err = f.s.TopUpContract(f.ctx, types.NewMsgTopUpContract(contract.ClientAddress(), contract.Id, cosmos.NewInt(30*401)))
+	require.ErrorIs(t, err, types.ErrOpenContractDuration)
+
+	// the contract is extended by the blocks the deposit affords right away, what is left over is kept
+	balance := f.balance(contract)
+	f.ctx = f.ctx.WithEventManager(sdk.NewEventManager())
+	_, err = f.s.TopUpContract(f.ctx, msg)
+	require.NoError(t, err)
+	contract = f.contract(contract.Id)
+	require.Equal(t, int64(160), contract.Expiration())
+	require.Equal(t, cosmos.NewInt(30*150), contract.Deposit)
+	require.Equal(t, cosmos.NewInt(10), contract.TopUp)
+	require.Equal(t, balance-(30*50+10), f.balance(contract))
+	set, err := f.k.GetContractExpirationSet(f.ctx, 160)
+	require.NoError(t, err)
+	require.Contains(t, set.ContractSet.ContractIds, contract.Id)
+	set, err = f.k.GetContractExpirationSet(f.ctx, 110)
+	require.NoError(t, err)
+	require.NotContains(t, set.ContractSet.ContractIds, contract.Id)
+
+	events := f.events(types.EventTypeTopUpContract)
+	require.Len(t, events, 1)
+	require.Equal(t, &types.EventTopUpContract{
+		Provider:      f.provider,
+		ContractId:    contract.Id,
+		Service:       common.BTCService.String(),
+		Client:        contract.Client,
+		OldExpiration: 110,
+		NewExpiration: 160,
+		TopUp:         cosmos.NewInt(30*50 + 10),
+		Deposit:       cosmos.NewInt(30 * 150),
+		Balance:       cosmos.NewInt(10),
+	}, events[0])
+
+	// the left over is refunded as the contract settles
+	balance = f.balance(contract)
+	_, err = f.s.CloseContract(f.ctx, types.NewMsgCloseContract(contract.ClientAddress(), contract.Id, contract.Client, common.EmptyPubKey))
+	require.NoError(t, err)
+	contract = f.contract(contract.Id)
+	require.True(t, contract.TopUp.IsZero())
+	require.Equal(t, balance+30*150+10, f.balance(contract))
+
+	// closed, it can't be topped up anymore
+	_, err = f.s.TopUpContract(f.ctx, msg)
+	require.ErrorIs(t, err, types.ErrTopUpContractClosed)
+}
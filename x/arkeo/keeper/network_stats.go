@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	"github.com/arkeonetwork/arkeo/common/cosmos"
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+)
+
+// GetNetworkStats get the counters of the providers and contracts, zero until the first of them is stored
+func (k KVStore) GetNetworkStats(ctx cosmos.Context) (types.NetworkStats, error) {
+	var stats types.NetworkStats
+	key := k.GetKey(ctx, prefixNetworkStats, "")
+	store := ctx.KVStore(k.storeKey)
+	if !store.Has([]byte(key)) {
+		return stats, nil
+	}
+	err := k.cdc.Unmarshal(store.Get([]byte(key)), &stats)
+	return stats, err
+}
+
+// SetNetworkStats save the counters of the providers and contracts
+func (k KVStore) SetNetworkStats(ctx cosmos.Context, stats types.NetworkStats) {
+	key := k.GetKey(ctx, prefixNetworkStats, "")
+	store := ctx.KVStore(k.storeKey)
+	store.Set([]byte(key), k.cdc.MustMarshal(&stats))
+}
+
+// updateNetworkStats apply the change of a stored record to the network stats
+func (k KVStore) updateNetworkStats(ctx cosmos.Context, update func(stats *types.NetworkStats)) error {
+	stats, err := k.GetNetworkStats(ctx)
+	if err != nil {
+		return err
+	}
+	update(&stats)
+	k.SetNetworkStats(ctx, stats)
+	return nil
+}
+
+// countNetworkStats count the providers and contracts stored, iterating over all of them
+func countNetworkStats(ctx cosmos.Context, k Keeper) (types.NetworkStats, error) {
+	var stats types.NetworkStats
+	iter := k.GetProviderIterator(ctx)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var provider types.Provider
+		if err := k.Cdc().Unmarshal(iter.Value(), &provider); err != nil {
+			return stats, err
+		}
+		stats.AddProvider(provider)
+	}
+
+	contractIter := k.GetContractIterator(ctx)
+	defer contractIter.Close()
+	for ; contractIter.Valid(); contractIter.Next() {
+		var contract types.Contract
+		if err := k.Cdc().Unmarshal(contractIter.Value(), &contract); err != nil {
+			return stats, err
+		}
+		stats.AddContract(contract)
+	}
+	return stats, nil
+}
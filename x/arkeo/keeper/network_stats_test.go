@@ -0,0 +1,107 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/arkeonetwork/arkeo/common"
+	"github.com/arkeonetwork/arkeo/common/cosmos"
+	"github.com/arkeonetwork/arkeo/x/arkeo/configs"
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+)
+
+func TestNetworkStats(t *testing.T) {
+	ctx, k, sk := SetupKeeperWithStaking(t)
+	ctx = ctx.WithBlockHeight(10)
+	s := newMsgServer(k, sk)
+
+	requireStats := func(providers, online, bonded, open, escrowed int64) {
+		t.Helper()
+		stats, err := k.GetNetworkStats(ctx)
+		require.NoError(t, err)
+		require.Equal(t, uint64(providers), stats.Providers)
+		require.Equal(t, uint64(online), stats.ProvidersOnline)
+		require.Equal(t, bonded, cosmos.Coins(stats.Bonded).AmountOf(configs.Denom).Int64())
+		require.Equal(t, uint64(open), stats.OpenContracts)
+		require.Equal(t, escrowed, cosmos.Coins(stats.Escrowed).AmountOf(configs.Denom).Int64())
+		// the counters always match the providers and contracts stored
+		_, broken := NetworkStatsInvariant(k)(ctx)
+		require.False(t, broken)
+	}
+	requireStats(0, 0, 0, 0, 0)
+
+	// bonded provider, offline until it says otherwise
+	providerPubKey := types.GetRandomPubKey()
+	providerAddress, err := providerPubKey.GetMyAddress()
+	require.NoError(t, err)
+	require.NoError(t, k.MintAndSendToAccount(ctx, providerAddress, getCoin(common.Tokens(10))))
+	bond := types.MsgBondProvider{
+		Creator:  providerAddress.String(),
+		Provider: providerPubKey.String(),
+		Service:  common.BTCService.String(),
+		Bond:     cosmos.NewInt(common.Tokens(10)),
+	}
+	require.NoError(t, s.BondProviderHandle(ctx, &bond))
+	requireStats(1, 0, common.Tokens(10), 0, 0)
+
+	rates, err := cosmos.ParseCoins("15uarkeo")
+	require.NoError(t, err)
+	require.NoError(t, s.ModProviderHandle(ctx, &types.MsgModProvider{
+		Creator:             providerAddress.String(),
+		Provider:            providerPubKey,
+		Service:             common.BTCService.String(),
+		MinContractDuration: 10,
+		MaxContractDuration: 500,
+		Status:              types.ProviderStatus_ONLINE,
+		PayAsYouGoRate:      rates,
+		SubscriptionRate:    rates,
+		UpdateMask:          types.ModProviderFields,
+	}))
+	requireStats(1, 1, common.Tokens(10), 0, 0)
+
+	// the deposit of the contract is escrowed until it settles
+	clientPubKey := types.GetRandomPubKey()
+	clientAddress, err := clientPubKey.GetMyAddress()
+	require.NoError(t, err)
+	require.NoError(t, k.MintAndSendToAccount(ctx, clientAddress, getCoin(common.Tokens(10))))
+	_, err = s.OpenContract(ctx, &types.MsgOpenContract{
+		Provider:         providerPubKey.String(),
+		Service:          common.BTCService.String(),
+		Creator:          clientAddress.String(),
+		Client:           clientPubKey.String(),
+		ContractType:     types.ContractType_SUBSCRIPTION,
+		Duration:         100,
+		Rate:             rates[0],
+		Deposit:          cosmos.NewInt(1500),
+		QueriesPerMinute: 1,
+	})
+	require.NoError(t, err)
+	requireStats(1, 1, common.Tokens(10), 1, 1500)
+
+	// closing the contract settles it, the penalty comes out of the bond
+	contract, err := k.GetActiveContractForUser(ctx, clientPubKey, providerPubKey, common.BTCService)
+	require.NoError(t, err)
+	ctx = ctx.WithBlockHeight(30)
+	_, err = s.ProviderCloseContract(ctx, types.NewMsgProviderCloseContract(providerAddress, contract.Id))
+	require.NoError(t, err)
+	penalty := k.GetParams(ctx).ProviderCloseContractPenalty
+	requireStats(1, 1, common.Tokens(10)-penalty, 0, 0)
+
+	// the provider left without bond is removed
+	bond.Bond = cosmos.NewInt(penalty - common.Tokens(10))
+	require.NoError(t, s.BondProviderHandle(ctx, &bond))
+	requireStats(0, 0, 0, 0, 0)
+
+	// counters drifting from the records break the invariant
+	k.SetNetworkStats(ctx, types.NetworkStats{Providers: 1})
+	msg, broken := NetworkStatsInvariant(k)(ctx)
+	require.True(t, broken)
+	require.Contains(t, msg, "network stats")
+
+	// network stats failing to decode fail the records stored rather than going stale
+	store := ctx.KVStore(k.(KVStore).storeKey)
+	store.Set([]byte(k.GetKey(ctx, prefixNetworkStats, "")), []byte{0xff})
+	require.Error(t, k.SetContract(ctx, contract))
+	require.Error(t, k.SetProvider(ctx, types.NewProvider(providerPubKey, common.BTCService)))
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"errors"
+	"fmt"
 
 	"github.com/arkeonetwork/arkeo/common"
 	"github.com/arkeonetwork/arkeo/common/cosmos"
@@ -13,8 +14,11 @@ func (k KVStore) setProvider(ctx cosmos.Context, key string, record types.Provid
 	buf := k.cdc.MustMarshal(&record)
 	if buf == nil || record.Bond.IsZero() {
 		store.Delete([]byte(key))
+		store.Delete([]byte(k.getServiceProviderKey(ctx, record.PubKey, record.Service)))
 	} else {
 		store.Set([]byte(key), buf)
+		// index the provider by its service, as long as it is bonded to it
+		store.Set([]byte(k.getServiceProviderKey(ctx, record.PubKey, record.Service)), []byte(record.PubKey.String()))
 	}
 }
 
@@ -49,7 +53,20 @@ func (k KVStore) SetProvider(ctx cosmos.Context, provider types.Provider) error
 	if provider.PubKey.IsEmpty() || provider.Service.IsEmpty() {
 		return errors.New("cannot save a provider with an empty pubkey or service")
 	}
-	k.setProvider(ctx, k.GetKey(ctx, prefixProvider, provider.Key()), provider)
+	key := k.GetKey(ctx, prefixProvider, provider.Key())
+	// the provider stored before is uncounted, unless it fails to decode as the legacy providers being migrated do
+	var previous types.Provider
+	found, decodeErr := k.getProvider(ctx, key, &previous)
+	err := k.updateNetworkStats(ctx, func(stats *types.NetworkStats) {
+		if found && decodeErr == nil {
+			stats.RemoveProvider(previous)
+		}
+		stats.AddProvider(provider)
+	})
+	if err != nil {
+		return err
+	}
+	k.setProvider(ctx, key, provider)
 	return nil
 }
 
@@ -59,7 +76,88 @@ func (k KVStore) ProviderExists(ctx cosmos.Context, pubkey common.PubKey, servic
 	return k.has(ctx, k.GetKey(ctx, prefixProvider, record.Key()))
 }
 
-func (k KVStore) RemoveProvider(ctx cosmos.Context, pubkey common.PubKey, service common.Service) {
+func (k KVStore) RemoveProvider(ctx cosmos.Context, pubkey common.PubKey, service common.Service) error {
 	record := types.NewProvider(pubkey, service)
-	k.del(ctx, k.GetKey(ctx, prefixProvider, record.Key()))
+	key := k.GetKey(ctx, prefixProvider, record.Key())
+	if found, err := k.getProvider(ctx, key, &record); found && err == nil {
+		err = k.updateNetworkStats(ctx, func(stats *types.NetworkStats) {
+			stats.RemoveProvider(record)
+		})
+		if err != nil {
+			return err
+		}
+	}
+	k.del(ctx, key)
+	k.del(ctx, k.getServiceProviderKey(ctx, pubkey, service))
+	return nil
+}
+
+// getServiceProviderPrefix the prefix of the keys indexing the providers of a service
+func (k KVStore) getServiceProviderPrefix(ctx cosmos.Context, service common.Service) string {
+	return k.GetKey(ctx, prefixServiceProvider, fmt.Sprintf("%s/", service))
+}
+
+// getServiceProviderKey the providers of a service iterate in the order of their pubkeys
+func (k KVStore) getServiceProviderKey(ctx cosmos.Context, pubkey common.PubKey, service common.Service) string {
+	return k.GetKey(ctx, prefixServiceProvider, fmt.Sprintf("%s/%s", service, pubkey))
+}
+
+// GetProviderEarningsIterator iterate the earnings of the providers
+func (k KVStore) GetProviderEarningsIterator(ctx cosmos.Context) cosmos.Iterator {
+	return k.getIterator(ctx, prefixProviderEarnings)
+}
+
+// GetProviderEarnings get the earnings counters of a provider for a service
+func (k KVStore) GetProviderEarnings(ctx cosmos.Context, pubkey common.PubKey, service common.Service) (types.ProviderEarnings, error) {
+	record := types.NewProviderEarnings(pubkey, service)
+	key := k.GetKey(ctx, prefixProviderEarnings, record.Key())
+	store := ctx.KVStore(k.storeKey)
+	if !store.Has([]byte(key)) {
+		return record, nil
+	}
+	err := k.cdc.Unmarshal(store.Get([]byte(key)), &record)
+	return record, err
+}
+
+// SetProviderEarnings save the earnings counters of a provider for a service
+func (k KVStore) SetProviderEarnings(ctx cosmos.Context, earnings types.ProviderEarnings) error {
+	if earnings.Provider.IsEmpty() || earnings.Service.IsEmpty() {
+		return errors.New("cannot save provider earnings with an empty pubkey or service")
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set([]byte(k.GetKey(ctx, prefixProviderEarnings, earnings.Key())), k.cdc.MustMarshal(&earnings))
+	return nil
+}
+
+// GetProviderAllowlistIterator iterate the allowlists of the providers
+func (k KVStore) GetProviderAllowlistIterator(ctx cosmos.Context) cosmos.Iterator {
+	return k.getIterator(ctx, prefixProviderAllowlist)
+}
+
+// GetProviderAllowlist get the clients allowed to open contracts with a provider for a service, empty when any client
+// can
+func (k KVStore) GetProviderAllowlist(ctx cosmos.Context, pubkey common.PubKey, service common.Service) (types.ProviderAllowlist, error) {
+	record := types.NewProviderAllowlist(pubkey, service)
+	key := k.GetKey(ctx, prefixProviderAllowlist, record.Key())
+	store := ctx.KVStore(k.storeKey)
+	if !store.Has([]byte(key)) {
+		return record, nil
+	}
+	err := k.cdc.Unmarshal(store.Get([]byte(key)), &record)
+	return record, err
+}
+
+// SetProviderAllowlist save the allowlist of a provider for a service, an empty one is deleted
+func (k KVStore) SetProviderAllowlist(ctx cosmos.Context, allowlist types.ProviderAllowlist) error {
+	if allowlist.Provider.IsEmpty() || allowlist.Service.IsEmpty() {
+		return errors.New("cannot save a provider allowlist with an empty pubkey or service")
+	}
+	key := []byte(k.GetKey(ctx, prefixProviderAllowlist, allowlist.Key()))
+	store := ctx.KVStore(k.storeKey)
+	if len(allowlist.Clients) == 0 {
+		store.Delete(key)
+		return nil
+	}
+	store.Set(key, k.cdc.MustMarshal(&allowlist))
+	return nil
 }
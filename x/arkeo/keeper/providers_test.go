@@ -26,6 +26,6 @@ func TestProvider(t *testing.T) {
 	require.True(t, k.ProviderExists(ctx, provider.PubKey, provider.Service))
 	require.False(t, k.ProviderExists(ctx, provider.PubKey, common.ETHService))
 
-	k.RemoveProvider(ctx, provider.PubKey, provider.Service)
+	require.NoError(t, k.RemoveProvider(ctx, provider.PubKey, provider.Service))
 	require.False(t, k.ProviderExists(ctx, provider.PubKey, provider.Service))
 }
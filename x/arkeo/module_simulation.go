@@ -6,6 +6,7 @@ import (
 	arkeosimulation "github.com/arkeonetwork/arkeo/x/arkeo/simulation"
 
 	"github.com/arkeonetwork/arkeo/testutil/sample"
+	"github.com/arkeonetwork/arkeo/x/arkeo/keeper"
 	"github.com/arkeonetwork/arkeo/x/arkeo/types"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
@@ -44,24 +45,48 @@ const (
 	// TODO: Determine the simulation weight value
 	defaultWeightMsgClaimContractIncome int = 100
 
+	opWeightMsgRenewContract = "op_weight_msg_renew_contract" // nolint
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgRenewContract int = 100
+
+	opWeightMsgProviderCloseContract = "op_weight_msg_provider_close_contract" // nolint
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgProviderCloseContract int = 100
+
 	opWeightMsgSetVersion = "op_weight_msg_set_version" // nolint
 	// TODO: Determine the simulation weight value
 	defaultWeightMsgSetVersion int = 100
 
+	opWeightMsgRotateDelegate = "op_weight_msg_rotate_delegate" // nolint
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgRotateDelegate int = 100
+
+	opWeightMsgSetAutoRenew = "op_weight_msg_set_auto_renew" // nolint
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgSetAutoRenew int = 100
+
+	opWeightMsgTopUpContract = "op_weight_msg_top_up_contract" // nolint
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgTopUpContract int = 100
+
+	opWeightMsgClaimContractIncomeBatch = "op_weight_msg_claim_contract_income_batch" // nolint
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgClaimContractIncomeBatch int = 100
+
+	opWeightMsgPurgeExpiredContracts = "op_weight_msg_purge_expired_contracts" // nolint
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgPurgeExpiredContracts int = 50
+
+	opWeightMsgSetProviderAllowlist = "op_weight_msg_set_provider_allowlist" // nolint
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgSetProviderAllowlist int = 20
+
 	// this line is used by starport scaffolding # simapp/module/const
 )
 
 // GenerateGenesisState creates a randomized GenState of the module
 func (AppModule) GenerateGenesisState(simState *module.SimulationState) {
-	accs := make([]string, len(simState.Accounts))
-	for i, acc := range simState.Accounts {
-		accs[i] = acc.Address.String()
-	}
-	arkeoGenesis := types.GenesisState{
-		Params: types.DefaultParams(),
-		// this line is used by starport scaffolding # simapp/module/genesisState
-	}
-	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(&arkeoGenesis)
+	arkeosimulation.RandomizedGenState(simState)
 }
 
 // ProposalContents doesn't return any content functions for governance proposals
@@ -75,7 +100,9 @@ func (am AppModule) RandomizedParams(_ *rand.Rand) []simtypes.LegacyParamChange
 }
 
 // RegisterStoreDecoder registers a decoder
-func (am AppModule) RegisterStoreDecoder(_ simtypes.StoreDecoderRegistry) {}
+func (am AppModule) RegisterStoreDecoder(sdr simtypes.StoreDecoderRegistry) {
+	sdr[types.StoreKey] = keeper.NewDecodeStore(am.cdc)
+}
 
 // WeightedOperations returns the all the gov module operations with their respective weights.
 func (am AppModule) WeightedOperations(simState module.SimulationState) []simtypes.WeightedOperation {
@@ -136,6 +163,28 @@ func (am AppModule) WeightedOperations(simState module.SimulationState) []simtyp
 		arkeosimulation.SimulateMsgClaimContractIncome(am.accountKeeper, am.bankKeeper, am.keeper),
 	))
 
+	var weightMsgRenewContract int
+	simState.AppParams.GetOrGenerate(opWeightMsgRenewContract, &weightMsgRenewContract, nil,
+		func(_ *rand.Rand) {
+			weightMsgRenewContract = defaultWeightMsgRenewContract
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgRenewContract,
+		arkeosimulation.SimulateMsgRenewContract(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgProviderCloseContract int
+	simState.AppParams.GetOrGenerate(opWeightMsgProviderCloseContract, &weightMsgProviderCloseContract, nil,
+		func(_ *rand.Rand) {
+			weightMsgProviderCloseContract = defaultWeightMsgProviderCloseContract
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgProviderCloseContract,
+		arkeosimulation.SimulateMsgProviderCloseContract(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
 	var weightMsgSetVersion int
 	simState.AppParams.GetOrGenerate(opWeightMsgSetVersion, &weightMsgSetVersion, nil,
 		func(_ *rand.Rand) {
@@ -147,6 +196,72 @@ func (am AppModule) WeightedOperations(simState module.SimulationState) []simtyp
 		arkeosimulation.SimulateMsgSetVersion(am.accountKeeper, am.bankKeeper, am.keeper),
 	))
 
+	var weightMsgRotateDelegate int
+	simState.AppParams.GetOrGenerate(opWeightMsgRotateDelegate, &weightMsgRotateDelegate, nil,
+		func(_ *rand.Rand) {
+			weightMsgRotateDelegate = defaultWeightMsgRotateDelegate
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgRotateDelegate,
+		arkeosimulation.SimulateMsgRotateDelegate(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgSetAutoRenew int
+	simState.AppParams.GetOrGenerate(opWeightMsgSetAutoRenew, &weightMsgSetAutoRenew, nil,
+		func(_ *rand.Rand) {
+			weightMsgSetAutoRenew = defaultWeightMsgSetAutoRenew
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgSetAutoRenew,
+		arkeosimulation.SimulateMsgSetAutoRenew(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgTopUpContract int
+	simState.AppParams.GetOrGenerate(opWeightMsgTopUpContract, &weightMsgTopUpContract, nil,
+		func(_ *rand.Rand) {
+			weightMsgTopUpContract = defaultWeightMsgTopUpContract
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgTopUpContract,
+		arkeosimulation.SimulateMsgTopUpContract(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgClaimContractIncomeBatch int
+	simState.AppParams.GetOrGenerate(opWeightMsgClaimContractIncomeBatch, &weightMsgClaimContractIncomeBatch, nil,
+		func(_ *rand.Rand) {
+			weightMsgClaimContractIncomeBatch = defaultWeightMsgClaimContractIncomeBatch
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgClaimContractIncomeBatch,
+		arkeosimulation.SimulateMsgClaimContractIncomeBatch(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgPurgeExpiredContracts int
+	simState.AppParams.GetOrGenerate(opWeightMsgPurgeExpiredContracts, &weightMsgPurgeExpiredContracts, nil,
+		func(_ *rand.Rand) {
+			weightMsgPurgeExpiredContracts = defaultWeightMsgPurgeExpiredContracts
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgPurgeExpiredContracts,
+		arkeosimulation.SimulateMsgPurgeExpiredContracts(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgSetProviderAllowlist int
+	simState.AppParams.GetOrGenerate(opWeightMsgSetProviderAllowlist, &weightMsgSetProviderAllowlist, nil,
+		func(_ *rand.Rand) {
+			weightMsgSetProviderAllowlist = defaultWeightMsgSetProviderAllowlist
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgSetProviderAllowlist,
+		arkeosimulation.SimulateMsgSetProviderAllowlist(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
 	// this line is used by starport scaffolding # simapp/module/operation
 
 	return operations
@@ -3,6 +3,8 @@ package simulation
 import (
 	"math/rand"
 
+	"github.com/arkeonetwork/arkeo/common/cosmos"
+	"github.com/arkeonetwork/arkeo/x/arkeo/configs"
 	"github.com/arkeonetwork/arkeo/x/arkeo/keeper"
 	"github.com/arkeonetwork/arkeo/x/arkeo/types"
 
@@ -11,20 +13,39 @@ import (
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 )
 
+// SimulateMsgBondProvider bond a random account as the provider of a random service, or unbond part of the bond of
+// an existing provider
 func SimulateMsgBondProvider(
 	ak types.AccountKeeper,
 	bk types.BankKeeper,
 	k keeper.Keeper,
 ) simtypes.Operation {
-	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, serviceID string,
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
 	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
 		simAccount, _ := simtypes.RandomAcc(r, accs)
-		msg := &types.MsgBondProvider{
-			Creator: simAccount.Address.String(),
+		service := services[r.Intn(len(services))]
+		pubKey := accountPubKey(simAccount)
+		msg := types.NewMsgBondProvider(simAccount.Address, pubKey, service.String(), cosmos.ZeroInt())
+
+		provider, err := k.GetProvider(ctx, pubKey, service)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "unable to get provider"), nil, err
 		}
 
-		// TODO: Handling the BondProvider simulation
+		// a quarter of the bonded providers unbond, some of them entirely
+		if provider.Bond.IsPositive() && r.Intn(4) == 0 {
+			msg.Bond = simtypes.RandomAmount(r, provider.Bond).Neg()
+			if msg.Bond.IsZero() {
+				msg.Bond = provider.Bond.Neg()
+			}
+			return deliver(r, app, ctx, ak, bk, simAccount, msg, nil)
+		}
 
-		return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "BondProvider simulation not implemented"), nil, nil
+		spendable := bk.SpendableCoins(ctx, simAccount.Address).AmountOf(configs.Denom)
+		msg.Bond = simtypes.RandomAmount(r, spendable.QuoRaw(2))
+		if !msg.Bond.IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "no funds to bond"), nil, nil
+		}
+		return deliver(r, app, ctx, ak, bk, simAccount, msg, cosmos.NewCoins(cosmos.NewCoin(configs.Denom, msg.Bond)))
 	}
 }
@@ -11,20 +11,49 @@ import (
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 )
 
+// SimulateMsgClaimContractIncome claim the income of a random contract not settled yet for its provider, with a
+// nonce signed by the spender of the contract. Once in a while the provider claims over what the contract allows,
+// and is slashed for it.
 func SimulateMsgClaimContractIncome(
 	ak types.AccountKeeper,
 	bk types.BankKeeper,
 	k keeper.Keeper,
 ) simtypes.Operation {
-	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, serviceID string,
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
 	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
-		simAccount, _ := simtypes.RandomAcc(r, accs)
-		msg := &types.MsgClaimContractIncome{
-			Creator: simAccount.Address.String(),
+		msgType := sdk.MsgTypeURL(&types.MsgClaimContractIncome{})
+		overClaim := r.Intn(20) == 0
+		contract, found := randomContract(r, ctx, k, func(contract types.Contract) bool {
+			_, providerFound := findPubKeyAccount(accs, contract.Provider)
+			_, spenderFound := findPubKeyAccount(accs, contract.GetSpender())
+			if !providerFound || !spenderFound || contract.IsSettled(ctx.BlockHeight()) {
+				return false
+			}
+			// the nonce of an open subscription is not signed, its over claim is rejected
+			if overClaim {
+				return !(contract.IsSubscription() && contract.IsOpenAuthorization())
+			}
+			return contract.Nonce < contract.MaxQueries()
+		})
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no contract to claim"), nil, nil
 		}
 
-		// TODO: Handling the ClaimContractIncome simulation
+		var nonce int64
+		if overClaim {
+			nonce = max(contract.Nonce, contract.MaxQueries()) + 1 + r.Int63n(100)
+		} else {
+			nonce = contract.Nonce + 1 + r.Int63n(contract.MaxQueries()-contract.Nonce)
+		}
+
+		spender, _ := findPubKeyAccount(accs, contract.GetSpender())
+		signature, err := spender.PrivKey.Sign(types.GetBytesToSign(contract.Id, nonce))
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to sign the nonce"), nil, err
+		}
 
-		return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "ClaimContractIncome simulation not implemented"), nil, nil
+		simAccount, _ := findPubKeyAccount(accs, contract.Provider)
+		msg := types.NewMsgClaimContractIncome(simAccount.Address, contract.Id, nonce, signature)
+		return deliver(r, app, ctx, ak, bk, simAccount, msg, nil)
 	}
 }
@@ -0,0 +1,56 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/arkeonetwork/arkeo/x/arkeo/keeper"
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+// SimulateMsgClaimContractIncomeBatch claim the income of a few contracts not settled yet at once, by a random account.
+// Once in a while a claim repeats the nonce the contract settled at, failing on its own.
+func SimulateMsgClaimContractIncomeBatch(
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgClaimContractIncomeBatch{})
+		maxSize := k.GetParams(ctx).MaxClaimBatchSize
+		if maxSize == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "claim batches disabled"), nil, nil
+		}
+
+		contracts := randomContracts(r, ctx, k, 1+r.Intn(int(maxSize)), func(contract types.Contract) bool {
+			_, spenderFound := findPubKeyAccount(accs, contract.GetSpender())
+			return spenderFound && !contract.IsSettled(ctx.BlockHeight()) && contract.Nonce < contract.MaxQueries()
+		})
+		if len(contracts) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no contract to claim"), nil, nil
+		}
+
+		claims := make([]types.ContractClaim, len(contracts))
+		for i, contract := range contracts {
+			nonce := contract.Nonce + 1 + r.Int63n(contract.MaxQueries()-contract.Nonce)
+			if contract.Nonce > 0 && r.Intn(10) == 0 {
+				nonce = contract.Nonce
+			}
+
+			spender, _ := findPubKeyAccount(accs, contract.GetSpender())
+			signature, err := spender.PrivKey.Sign(types.GetBytesToSign(contract.Id, nonce))
+			if err != nil {
+				return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to sign the nonce"), nil, err
+			}
+			claims[i] = types.ContractClaim{ContractId: contract.Id, Nonce: nonce, Signature: signature}
+		}
+
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		msg := types.NewMsgClaimContractIncomeBatch(simAccount.Address, claims)
+		return deliver(r, app, ctx, ak, bk, simAccount, msg, nil)
+	}
+}
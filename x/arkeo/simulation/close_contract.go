@@ -3,6 +3,7 @@ package simulation
 import (
 	"math/rand"
 
+	"github.com/arkeonetwork/arkeo/common"
 	"github.com/arkeonetwork/arkeo/x/arkeo/keeper"
 	"github.com/arkeonetwork/arkeo/x/arkeo/types"
 
@@ -11,20 +12,24 @@ import (
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 )
 
+// SimulateMsgCloseContract close a random contract still running, by its client
 func SimulateMsgCloseContract(
 	ak types.AccountKeeper,
 	bk types.BankKeeper,
 	k keeper.Keeper,
 ) simtypes.Operation {
-	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, serviceID string,
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
 	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
-		simAccount, _ := simtypes.RandomAcc(r, accs)
-		msg := &types.MsgCloseContract{
-			Creator: simAccount.Address.String(),
+		contract, found := randomContract(r, ctx, k, func(contract types.Contract) bool {
+			_, found := findPubKeyAccount(accs, contract.Client)
+			return found && contract.SettlementHeight == 0 && !contract.IsExpired(ctx.BlockHeight())
+		})
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(&types.MsgCloseContract{}), "no running contract"), nil, nil
 		}
 
-		// TODO: Handling the CloseContract simulation
-
-		return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "CloseContract simulation not implemented"), nil, nil
+		simAccount, _ := findPubKeyAccount(accs, contract.Client)
+		msg := types.NewMsgCloseContract(simAccount.Address, contract.Id, contract.Client, common.EmptyPubKey)
+		return deliver(r, app, ctx, ak, bk, simAccount, msg, nil)
 	}
 }
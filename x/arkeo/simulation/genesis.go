@@ -0,0 +1,170 @@
+package simulation
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+
+	"github.com/arkeonetwork/arkeo/x/arkeo/configs"
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+// Simulation parameter constants
+const (
+	SettlementGracePeriod  = "settlement_grace_period"
+	SlashFraction          = "slash_fraction"
+	SlashEscalation        = "slash_escalation"
+	MaxOpenContracts       = "max_open_contracts"
+	MinPayAsYouGoDeposit   = "min_pay_as_you_go_deposit"
+	DepositRefundTolerance = "deposit_refund_tolerance"
+	MaxClaimBatchSize      = "max_claim_batch_size"
+	MaxMetadataUriLength   = "max_metadata_uri_length"
+	MinProviderBond        = "min_provider_bond"
+	ServiceMinBonds        = "service_min_bonds"
+	ContractDormancyPeriod = "contract_dormancy_period"
+	PurgeReward            = "purge_reward"
+	MaxContractStartDelay  = "max_contract_start_delay"
+	MaxAllowlistSize       = "max_allowlist_size"
+	EarlyCloseCompensation = "early_close_compensation"
+	EarlyCloseMinPeriod    = "early_close_min_period"
+)
+
+// GenSettlementGracePeriod randomized SettlementGracePeriod
+func GenSettlementGracePeriod(r *rand.Rand) int64 {
+	return r.Int63n(20)
+}
+
+// GenSlashFraction randomized SlashFraction
+func GenSlashFraction(r *rand.Rand) int64 {
+	return r.Int63n(configs.MaxBasisPoints + 1)
+}
+
+// GenSlashEscalation randomized SlashEscalation
+func GenSlashEscalation(r *rand.Rand) int64 {
+	return r.Int63n(configs.MaxBasisPoints + 1)
+}
+
+// GenMaxOpenContracts randomized MaxOpenContracts, zero leaving the providers uncapped
+func GenMaxOpenContracts(r *rand.Rand) uint64 {
+	return uint64(r.Intn(20))
+}
+
+// GenMinPayAsYouGoDeposit randomized MinPayAsYouGoDeposit
+func GenMinPayAsYouGoDeposit(r *rand.Rand) int64 {
+	return r.Int63n(100)
+}
+
+// GenDepositRefundTolerance randomized DepositRefundTolerance
+func GenDepositRefundTolerance(r *rand.Rand) int64 {
+	return r.Int63n(configs.MaxBasisPoints/10 + 1)
+}
+
+// GenMaxClaimBatchSize randomized MaxClaimBatchSize
+func GenMaxClaimBatchSize(r *rand.Rand) uint64 {
+	return uint64(1 + r.Intn(10))
+}
+
+// GenMaxMetadataUriLength randomized MaxMetadataUriLength, long enough for the uri of the simulated providers
+func GenMaxMetadataUriLength(r *rand.Rand) uint64 {
+	return uint64(simtypes.RandIntBetween(r, 50, 2*int(types.DefaultMaxMetadataUriLength)+1))
+}
+
+// GenMinProviderBond randomized MinProviderBond, zero leaving it to the config
+func GenMinProviderBond(r *rand.Rand) int64 {
+	if r.Intn(4) == 0 {
+		return 0
+	}
+	return r.Int63n(types.DefaultMinProviderBond) + 1
+}
+
+// GenServiceMinBonds randomized ServiceMinBonds, overriding the minimum bond of some of the simulated services
+func GenServiceMinBonds(r *rand.Rand) []types.ServiceMinBond {
+	var minBonds []types.ServiceMinBond
+	for _, service := range services {
+		if r.Intn(2) == 0 {
+			minBonds = append(minBonds, types.ServiceMinBond{Service: service.String(), MinBond: r.Int63n(2 * types.DefaultMinProviderBond)})
+		}
+	}
+	return minBonds
+}
+
+// GenContractDormancyPeriod randomized ContractDormancyPeriod, short for the simulated contracts to be purged
+func GenContractDormancyPeriod(r *rand.Rand) int64 {
+	return int64(simtypes.RandIntBetween(r, 1, 51))
+}
+
+// GenPurgeReward randomized PurgeReward
+func GenPurgeReward(r *rand.Rand) int64 {
+	return r.Int63n(2 * types.DefaultPurgeReward)
+}
+
+// GenMaxContractStartDelay randomized MaxContractStartDelay, zero disabling the scheduled contracts
+func GenMaxContractStartDelay(r *rand.Rand) int64 {
+	return r.Int63n(101)
+}
+
+// GenMaxAllowlistSize randomized MaxAllowlistSize, small for the simulated allowlists to hit it
+func GenMaxAllowlistSize(r *rand.Rand) uint64 {
+	return uint64(r.Intn(6))
+}
+
+// GenEarlyCloseCompensation randomized EarlyCloseCompensation
+func GenEarlyCloseCompensation(r *rand.Rand) int64 {
+	return r.Int63n(configs.MaxBasisPoints + 1)
+}
+
+// GenEarlyCloseMinPeriod randomized EarlyCloseMinPeriod, short for the simulated closes to fall before and after it
+func GenEarlyCloseMinPeriod(r *rand.Rand) int64 {
+	return r.Int63n(51)
+}
+
+// RandomizedGenState generates a random GenesisState for arkeo, the providers and the contracts are left to the
+// operations
+func RandomizedGenState(simState *module.SimulationState) {
+	params := types.DefaultParams()
+	simState.AppParams.GetOrGenerate(SettlementGracePeriod, &params.SettlementGracePeriod, simState.Rand,
+		func(r *rand.Rand) { params.SettlementGracePeriod = GenSettlementGracePeriod(r) })
+	simState.AppParams.GetOrGenerate(SlashFraction, &params.SlashFraction, simState.Rand,
+		func(r *rand.Rand) { params.SlashFraction = GenSlashFraction(r) })
+	simState.AppParams.GetOrGenerate(SlashEscalation, &params.SlashEscalation, simState.Rand,
+		func(r *rand.Rand) { params.SlashEscalation = GenSlashEscalation(r) })
+	simState.AppParams.GetOrGenerate(MaxOpenContracts, &params.MaxOpenContracts, simState.Rand,
+		func(r *rand.Rand) { params.MaxOpenContracts = GenMaxOpenContracts(r) })
+	simState.AppParams.GetOrGenerate(MinPayAsYouGoDeposit, &params.MinPayAsYouGoDeposit, simState.Rand,
+		func(r *rand.Rand) { params.MinPayAsYouGoDeposit = GenMinPayAsYouGoDeposit(r) })
+	simState.AppParams.GetOrGenerate(DepositRefundTolerance, &params.DepositRefundTolerance, simState.Rand,
+		func(r *rand.Rand) { params.DepositRefundTolerance = GenDepositRefundTolerance(r) })
+	simState.AppParams.GetOrGenerate(MaxClaimBatchSize, &params.MaxClaimBatchSize, simState.Rand,
+		func(r *rand.Rand) { params.MaxClaimBatchSize = GenMaxClaimBatchSize(r) })
+	simState.AppParams.GetOrGenerate(MaxMetadataUriLength, &params.MaxMetadataUriLength, simState.Rand,
+		func(r *rand.Rand) { params.MaxMetadataUriLength = GenMaxMetadataUriLength(r) })
+	simState.AppParams.GetOrGenerate(MinProviderBond, &params.MinProviderBond, simState.Rand,
+		func(r *rand.Rand) { params.MinProviderBond = GenMinProviderBond(r) })
+	simState.AppParams.GetOrGenerate(ServiceMinBonds, &params.ServiceMinBonds, simState.Rand,
+		func(r *rand.Rand) { params.ServiceMinBonds = GenServiceMinBonds(r) })
+	simState.AppParams.GetOrGenerate(ContractDormancyPeriod, &params.ContractDormancyPeriod, simState.Rand,
+		func(r *rand.Rand) { params.ContractDormancyPeriod = GenContractDormancyPeriod(r) })
+	simState.AppParams.GetOrGenerate(PurgeReward, &params.PurgeReward, simState.Rand,
+		func(r *rand.Rand) { params.PurgeReward = GenPurgeReward(r) })
+	simState.AppParams.GetOrGenerate(MaxContractStartDelay, &params.MaxContractStartDelay, simState.Rand,
+		func(r *rand.Rand) { params.MaxContractStartDelay = GenMaxContractStartDelay(r) })
+	simState.AppParams.GetOrGenerate(MaxAllowlistSize, &params.MaxAllowlistSize, simState.Rand,
+		func(r *rand.Rand) { params.MaxAllowlistSize = GenMaxAllowlistSize(r) })
+	simState.AppParams.GetOrGenerate(EarlyCloseCompensation, &params.EarlyCloseCompensation, simState.Rand,
+		func(r *rand.Rand) { params.EarlyCloseCompensation = GenEarlyCloseCompensation(r) })
+	simState.AppParams.GetOrGenerate(EarlyCloseMinPeriod, &params.EarlyCloseMinPeriod, simState.Rand,
+		func(r *rand.Rand) { params.EarlyCloseMinPeriod = GenEarlyCloseMinPeriod(r) })
+
+	arkeoGenesis := types.DefaultGenesis()
+	arkeoGenesis.Params = params
+
+	bz, err := json.MarshalIndent(&arkeoGenesis.Params, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated arkeo parameters:\n%s\n", bz)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(arkeoGenesis)
+}
@@ -1,10 +1,24 @@
 package simulation
 
 import (
+	"math/rand"
+
+	"github.com/arkeonetwork/arkeo/common"
+	"github.com/arkeonetwork/arkeo/common/cosmos"
+	"github.com/arkeonetwork/arkeo/x/arkeo/configs"
+	"github.com/arkeonetwork/arkeo/x/arkeo/keeper"
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
 )
 
+// services the simulated providers serve, a few of them so the providers share some
+var services = []common.Service{common.MockService, common.BTCService, common.ETHService}
+
 // FindAccount find a specific address from an account list
 func FindAccount(accs []simtypes.Account, address string) (simtypes.Account, bool) {
 	creator, err := sdk.AccAddressFromBech32(address)
@@ -13,3 +27,114 @@ func FindAccount(accs []simtypes.Account, address string) (simtypes.Account, boo
 	}
 	return simtypes.FindAccount(accs, creator)
 }
+
+// accountPubKey return the pubkey of the account as arkeo uses it
+func accountPubKey(acc simtypes.Account) common.PubKey {
+	pk, err := common.NewPubKeyFromCrypto(acc.PubKey)
+	if err != nil {
+		panic(err)
+	}
+	return pk
+}
+
+// findPubKeyAccount find the account of the pubkey from an account list
+func findPubKeyAccount(accs []simtypes.Account, pk common.PubKey) (simtypes.Account, bool) {
+	addr, err := pk.GetMyAddress()
+	if err != nil {
+		return simtypes.Account{}, false
+	}
+	return simtypes.FindAccount(accs, addr)
+}
+
+// randomProvider pick one of the providers matching the filter, along with its account
+func randomProvider(r *rand.Rand, ctx sdk.Context, k keeper.Keeper, accs []simtypes.Account, filter func(types.Provider) bool) (types.Provider, simtypes.Account, bool) {
+	var providers []types.Provider
+	iter := k.GetProviderIterator(ctx)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var provider types.Provider
+		if err := k.Cdc().Unmarshal(iter.Value(), &provider); err != nil {
+			continue
+		}
+		if _, found := findPubKeyAccount(accs, provider.PubKey); found && filter(provider) {
+			providers = append(providers, provider)
+		}
+	}
+	if len(providers) == 0 {
+		return types.Provider{}, simtypes.Account{}, false
+	}
+
+	provider := providers[r.Intn(len(providers))]
+	acc, _ := findPubKeyAccount(accs, provider.PubKey)
+	return provider, acc, true
+}
+
+// randomContract pick one of the contracts matching the filter
+func randomContract(r *rand.Rand, ctx sdk.Context, k keeper.Keeper, filter func(types.Contract) bool) (types.Contract, bool) {
+	var contracts []types.Contract
+	iter := k.GetContractIterator(ctx)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var contract types.Contract
+		if err := k.Cdc().Unmarshal(iter.Value(), &contract); err != nil {
+			continue
+		}
+		if filter(contract) {
+			contracts = append(contracts, contract)
+		}
+	}
+	if len(contracts) == 0 {
+		return types.Contract{}, false
+	}
+	return contracts[r.Intn(len(contracts))], true
+}
+
+// randomContracts pick up to n distinct contracts matching the filter
+func randomContracts(r *rand.Rand, ctx sdk.Context, k keeper.Keeper, n int, filter func(types.Contract) bool) []types.Contract {
+	var contracts []types.Contract
+	iter := k.GetContractIterator(ctx)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var contract types.Contract
+		if err := k.Cdc().Unmarshal(iter.Value(), &contract); err != nil {
+			continue
+		}
+		if filter(contract) {
+			contracts = append(contracts, contract)
+		}
+	}
+	r.Shuffle(len(contracts), func(i, j int) { contracts[i], contracts[j] = contracts[j], contracts[i] })
+	if len(contracts) > n {
+		contracts = contracts[:n]
+	}
+	return contracts
+}
+
+// minProviderBond return the bond a provider of the service needs at least to be online
+func minProviderBond(ctx sdk.Context, k keeper.Keeper, service common.Service) cosmos.Int {
+	minBond := k.GetParams(ctx).MinBond(service)
+	if minBond == 0 {
+		minBond = configs.GetConfigValues(k.GetVersion(ctx)).GetInt64Value(configs.MinProviderBond)
+	}
+	return cosmos.NewInt(minBond)
+}
+
+// deliver sign the msg by the account and deliver it, paying random fees out of what the msg does not spend
+func deliver(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, ak types.AccountKeeper, bk types.BankKeeper,
+	simAccount simtypes.Account, msg sdk.Msg, spent sdk.Coins,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	txCtx := simulation.OperationInput{
+		R:               r,
+		App:             app,
+		TxGen:           moduletestutil.MakeTestEncodingConfig().TxConfig,
+		Cdc:             nil,
+		Msg:             msg,
+		Context:         ctx,
+		SimAccount:      simAccount,
+		AccountKeeper:   ak,
+		Bankkeeper:      bk,
+		ModuleName:      types.ModuleName,
+		CoinsSpentInMsg: spent,
+	}
+	return simulation.GenAndDeliverTxWithRandFees(txCtx)
+}
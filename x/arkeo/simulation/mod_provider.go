@@ -3,6 +3,8 @@ package simulation
 import (
 	"math/rand"
 
+	"github.com/arkeonetwork/arkeo/common/cosmos"
+	"github.com/arkeonetwork/arkeo/x/arkeo/configs"
 	"github.com/arkeonetwork/arkeo/x/arkeo/keeper"
 	"github.com/arkeonetwork/arkeo/x/arkeo/types"
 
@@ -11,20 +13,51 @@ import (
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 )
 
+// SimulateMsgModProvider set random terms to a bonded provider, mostly online and with short contracts so they
+// expire and settle within the simulation
 func SimulateMsgModProvider(
 	ak types.AccountKeeper,
 	bk types.BankKeeper,
 	k keeper.Keeper,
 ) simtypes.Operation {
-	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, serviceID string,
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
 	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
-		simAccount, _ := simtypes.RandomAcc(r, accs)
-		msg := &types.MsgModProvider{
-			Creator: simAccount.Address.String(),
+		provider, simAccount, found := randomProvider(r, ctx, k, accs, func(provider types.Provider) bool {
+			return provider.Bond.IsPositive()
+		})
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(&types.MsgModProvider{}), "no bonded provider"), nil, nil
 		}
 
-		// TODO: Handling the ModProvider simulation
+		// a provider needs the minimum bond of its service to go online
+		status := types.ProviderStatus_ONLINE
+		if r.Intn(10) == 0 || provider.Bond.LT(minProviderBond(ctx, k, provider.Service)) {
+			status = types.ProviderStatus_OFFLINE
+		}
+		minContractDuration := simtypes.RandIntBetween(r, 1, 50)
+		maxContractDuration := minContractDuration + r.Intn(500)
+		// a provider can only lower the chain cap of its open contracts
+		var maxOpenContracts uint64
+		if chainCap := k.GetParams(ctx).MaxOpenContracts; chainCap > 0 {
+			maxOpenContracts = uint64(r.Int63n(int64(chainCap) + 1))
+		} else {
+			maxOpenContracts = uint64(r.Intn(20))
+		}
 
-		return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "ModProvider simulation not implemented"), nil, nil
+		msg := types.NewMsgModProvider(
+			simAccount.Address,
+			provider.PubKey,
+			provider.Service.String(),
+			"http://localhost:3636/metadata.json",
+			provider.MetadataNonce+1,
+			status,
+			int64(minContractDuration),
+			int64(maxContractDuration),
+			cosmos.NewCoins(cosmos.NewInt64Coin(configs.Denom, int64(simtypes.RandIntBetween(r, 1, 1000)))),
+			cosmos.NewCoins(cosmos.NewInt64Coin(configs.Denom, int64(simtypes.RandIntBetween(r, 1, 1000)))),
+			r.Int63n(10),
+			maxOpenContracts,
+		)
+		return deliver(r, app, ctx, ak, bk, simAccount, msg, nil)
 	}
 }
@@ -3,6 +3,9 @@ package simulation
 import (
 	"math/rand"
 
+	"github.com/arkeonetwork/arkeo/common"
+	"github.com/arkeonetwork/arkeo/common/cosmos"
+	"github.com/arkeonetwork/arkeo/x/arkeo/configs"
 	"github.com/arkeonetwork/arkeo/x/arkeo/keeper"
 	"github.com/arkeonetwork/arkeo/x/arkeo/types"
 
@@ -11,20 +14,108 @@ import (
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 )
 
+// SimulateMsgOpenContract open a contract of a random account with a provider taking contracts, on the terms of
+// the provider
 func SimulateMsgOpenContract(
 	ak types.AccountKeeper,
 	bk types.BankKeeper,
 	k keeper.Keeper,
 ) simtypes.Operation {
-	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, serviceID string,
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
 	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgOpenContract{})
+		configValues := configs.GetConfigValues(k.GetVersion(ctx))
+		params := k.GetParams(ctx)
+		contractType := types.ContractType_SUBSCRIPTION
+		if r.Intn(2) == 0 {
+			contractType = types.ContractType_PAY_AS_YOU_GO
+		}
+
+		provider, _, found := randomProvider(r, ctx, k, accs, func(provider types.Provider) bool {
+			maxOpen := provider.OpenContractsCap(params.MaxOpenContracts)
+			rates := provider.SubscriptionRate
+			if contractType == types.ContractType_PAY_AS_YOU_GO {
+				rates = provider.PayAsYouGoRate
+			}
+			return provider.Status == types.ProviderStatus_ONLINE &&
+				provider.Bond.GTE(minProviderBond(ctx, k, provider.Service)) &&
+				(maxOpen == 0 || provider.OpenContracts < maxOpen) &&
+				provider.MinContractDuration > 0 &&
+				provider.MinContractDuration <= provider.MaxContractDuration &&
+				cosmos.NewCoins(rates...).AmountOf(configs.Denom).IsPositive()
+		})
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no provider taking contracts"), nil, nil
+		}
+
+		// a provider with an allowlist only takes contracts from its clients
 		simAccount, _ := simtypes.RandomAcc(r, accs)
-		msg := &types.MsgOpenContract{
-			Creator: simAccount.Address.String(),
+		allowlist, err := k.GetProviderAllowlist(ctx, provider.PubKey, provider.Service)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to get allowlist"), nil, err
+		}
+		if len(allowlist.Clients) > 0 {
+			acc, found := findPubKeyAccount(accs, allowlist.Clients[r.Intn(len(allowlist.Clients))])
+			if !found {
+				return simtypes.NoOpMsg(types.ModuleName, msgType, "allowlisted client not found"), nil, nil
+			}
+			simAccount = acc
+		}
+		client := accountPubKey(simAccount)
+		active, err := k.GetActiveContractForUser(ctx, client, provider.PubKey, provider.Service)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to get active contract"), nil, err
+		}
+		if !active.IsEmpty() && !active.IsExpired(ctx.BlockHeight()) {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "contract already open"), nil, nil
 		}
 
-		// TODO: Handling the OpenContract simulation
+		duration := provider.MinContractDuration + r.Int63n(provider.MaxContractDuration-provider.MinContractDuration+1)
+		qpm := int64(simtypes.RandIntBetween(r, 1, 10))
+		var (
+			rate               cosmos.Coin
+			deposit            cosmos.Int
+			settlementDuration int64
+			authorization      = types.ContractAuthorization_STRICT
+		)
+		if contractType == types.ContractType_SUBSCRIPTION {
+			rate = cosmos.NewCoin(configs.Denom, cosmos.NewCoins(provider.SubscriptionRate...).AmountOf(configs.Denom))
+			deposit = rate.Amount.MulRaw(duration * qpm)
+			// overpay within the tolerance now and then, the excess is refunded
+			if r.Intn(4) == 0 {
+				tolerance := deposit.MulRaw(params.DepositRefundTolerance).QuoRaw(configs.MaxBasisPoints)
+				deposit = deposit.Add(simtypes.RandomAmount(r, tolerance))
+			}
+			if r.Intn(2) == 0 {
+				authorization = types.ContractAuthorization_OPEN
+			}
+		} else {
+			rate = cosmos.NewCoin(configs.Denom, cosmos.NewCoins(provider.PayAsYouGoRate...).AmountOf(configs.Denom))
+			deposit = rate.Amount.MulRaw(params.MinPayAsYouGoDeposit + int64(r.Intn(1000)))
+			settlementDuration = provider.SettlementDuration
+		}
+
+		spent := cosmos.NewCoins(cosmos.NewCoin(configs.Denom, deposit.AddRaw(configValues.GetInt64Value(configs.OpenContractCost))))
+		if !bk.SpendableCoins(ctx, simAccount.Address).IsAllGTE(spent) {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "not enough funds for the deposit"), nil, nil
+		}
 
-		return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "OpenContract simulation not implemented"), nil, nil
+		msg := types.NewMsgOpenContract(
+			simAccount.Address,
+			provider.PubKey,
+			provider.Service.String(),
+			client,
+			common.EmptyPubKey,
+			contractType,
+			duration,
+			settlementDuration,
+			rate,
+			deposit,
+			authorization,
+			qpm,
+		)
+		// a subscription auto-renews now and then, out of the top-ups it gets
+		msg.AutoRenew = contractType == types.ContractType_SUBSCRIPTION && r.Intn(2) == 0
+		return deliver(r, app, ctx, ak, bk, simAccount, msg, spent)
 	}
 }
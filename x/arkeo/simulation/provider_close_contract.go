@@ -0,0 +1,30 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"github.com/arkeonetwork/arkeo/x/arkeo/keeper"
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+)
+
+func SimulateMsgProviderCloseContract(
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		msg := &types.MsgProviderCloseContract{
+			Creator: simAccount.Address.String(),
+		}
+
+		// TODO: Handling the ProviderCloseContract simulation
+
+		return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "ProviderCloseContract simulation not implemented"), nil, nil
+	}
+}
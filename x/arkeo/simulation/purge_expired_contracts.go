@@ -0,0 +1,47 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/arkeonetwork/arkeo/x/arkeo/keeper"
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+// SimulateMsgPurgeExpiredContracts purge the contracts past their dormancy by a random account, either a few of them
+// picked or the first ones found up to a limit
+func SimulateMsgPurgeExpiredContracts(
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgPurgeExpiredContracts{})
+		dormancy := k.GetParams(ctx).ContractDormancyPeriod
+		if dormancy == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "purge disabled"), nil, nil
+		}
+
+		contracts := randomContracts(r, ctx, k, 1+r.Intn(10), func(contract types.Contract) bool {
+			return contract.SettlementPeriodEnd()+dormancy < ctx.BlockHeight()
+		})
+		if len(contracts) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no contract to purge"), nil, nil
+		}
+
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		msg := types.NewMsgPurgeExpiredContracts(simAccount.Address, nil, uint64(len(contracts)))
+		if r.Intn(2) == 0 {
+			contractIds := make([]uint64, len(contracts))
+			for i, contract := range contracts {
+				contractIds[i] = contract.Id
+			}
+			msg = types.NewMsgPurgeExpiredContracts(simAccount.Address, contractIds, 0)
+		}
+		return deliver(r, app, ctx, ak, bk, simAccount, msg, nil)
+	}
+}
@@ -0,0 +1,48 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/arkeonetwork/arkeo/x/arkeo/keeper"
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+// SimulateMsgRotateDelegate rotate the delegate of a random contract still running to a random account, by its client
+func SimulateMsgRotateDelegate(
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		contract, found := randomContract(r, ctx, k, func(contract types.Contract) bool {
+			_, found := findPubKeyAccount(accs, contract.Client)
+			return found && contract.SettlementHeight == 0 && !contract.IsExpired(ctx.BlockHeight())
+		})
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(&types.MsgRotateDelegate{}), "no running contract"), nil, nil
+		}
+
+		// the new delegate must not spend another contract with the provider for the service
+		delegateAccount, _ := simtypes.RandomAcc(r, accs)
+		delegate := accountPubKey(delegateAccount)
+		if delegate.Equals(contract.Provider) || delegate.Equals(contract.GetSpender()) {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(&types.MsgRotateDelegate{}), "delegate unchanged"), nil, nil
+		}
+		active, err := k.GetActiveContractForUser(ctx, delegate, contract.Provider, contract.Service)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(&types.MsgRotateDelegate{}), "unable to get active contract"), nil, err
+		}
+		if !active.IsEmpty() {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(&types.MsgRotateDelegate{}), "delegate already spends a contract"), nil, nil
+		}
+
+		simAccount, _ := findPubKeyAccount(accs, contract.Client)
+		msg := types.NewMsgRotateDelegate(simAccount.Address, contract.Id, delegate)
+		return deliver(r, app, ctx, ak, bk, simAccount, msg, nil)
+	}
+}
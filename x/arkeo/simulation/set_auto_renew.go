@@ -0,0 +1,34 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/arkeonetwork/arkeo/x/arkeo/keeper"
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+// SimulateMsgSetAutoRenew turn the auto-renewal of a random subscription still running on or off, by its client
+func SimulateMsgSetAutoRenew(
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		contract, found := randomContract(r, ctx, k, func(contract types.Contract) bool {
+			_, found := findPubKeyAccount(accs, contract.Client)
+			return found && contract.IsSubscription() && contract.SettlementHeight == 0 && !contract.IsExpired(ctx.BlockHeight())
+		})
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(&types.MsgSetAutoRenew{}), "no running subscription"), nil, nil
+		}
+
+		simAccount, _ := findPubKeyAccount(accs, contract.Client)
+		msg := types.NewMsgSetAutoRenew(simAccount.Address, contract.Id, !contract.AutoRenew)
+		return deliver(r, app, ctx, ak, bk, simAccount, msg, nil)
+	}
+}
@@ -0,0 +1,73 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/arkeonetwork/arkeo/common"
+	"github.com/arkeonetwork/arkeo/x/arkeo/keeper"
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+// SimulateMsgSetProviderAllowlist update the allowlist of a bonded provider with random accounts, within the size cap,
+// clearing it now and then so most providers stay open to all
+func SimulateMsgSetProviderAllowlist(
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgSetProviderAllowlist{})
+		provider, simAccount, found := randomProvider(r, ctx, k, accs, func(provider types.Provider) bool {
+			return provider.Bond.IsPositive()
+		})
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no bonded provider"), nil, nil
+		}
+		allowlist, err := k.GetProviderAllowlist(ctx, provider.PubKey, provider.Service)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to get allowlist"), nil, err
+		}
+
+		action := types.AllowlistAction(r.Intn(len(types.AllowlistAction_name)))
+		var clients []common.PubKey
+		switch action {
+		case types.AllowlistAction_ALLOWLIST_REPLACE:
+			// an empty replacement clears the allowlist
+			if r.Intn(2) == 0 {
+				clients = randomClients(r, accs, r.Intn(int(k.GetParams(ctx).MaxAllowlistSize)+1))
+			}
+		case types.AllowlistAction_ALLOWLIST_ADD:
+			clients = randomClients(r, accs, 1+r.Intn(3))
+		case types.AllowlistAction_ALLOWLIST_REMOVE:
+			if len(allowlist.Clients) == 0 {
+				return simtypes.NoOpMsg(types.ModuleName, msgType, "empty allowlist"), nil, nil
+			}
+			clients = []common.PubKey{allowlist.Clients[r.Intn(len(allowlist.Clients))]}
+		}
+
+		allowlist.Apply(action, clients)
+		if uint64(len(allowlist.Clients)) > k.GetParams(ctx).MaxAllowlistSize {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "allowlist full"), nil, nil
+		}
+
+		msg := types.NewMsgSetProviderAllowlist(simAccount.Address, provider.PubKey, provider.Service.String(), action, clients)
+		return deliver(r, app, ctx, ak, bk, simAccount, msg, nil)
+	}
+}
+
+// randomClients pick up to n distinct accounts as clients
+func randomClients(r *rand.Rand, accs []simtypes.Account, n int) []common.PubKey {
+	clients := make([]common.PubKey, 0, n)
+	for _, i := range r.Perm(len(accs)) {
+		if len(clients) == n {
+			break
+		}
+		clients = append(clients, accountPubKey(accs[i]))
+	}
+	return clients
+}
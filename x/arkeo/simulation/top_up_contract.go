@@ -0,0 +1,62 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/arkeonetwork/arkeo/common/cosmos"
+	"github.com/arkeonetwork/arkeo/x/arkeo/keeper"
+	"github.com/arkeonetwork/arkeo/x/arkeo/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+// SimulateMsgTopUpContract top up a random subscription still running by a few blocks, by its client
+func SimulateMsgTopUpContract(
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgTopUpContract{})
+		contract, found := randomContract(r, ctx, k, func(contract types.Contract) bool {
+			_, found := findPubKeyAccount(accs, contract.Client)
+			return found && contract.IsSubscription() && contract.SettlementHeight == 0 && !contract.IsExpired(ctx.BlockHeight())
+		})
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no running subscription"), nil, nil
+		}
+
+		// the top-up of an auto-renewed contract waits for its expiration, the others are extended right away within
+		// the duration the provider allows, at the rate they run at
+		maxBlocks := int64(100)
+		if !contract.AutoRenew {
+			provider, err := k.GetProvider(ctx, contract.Provider, contract.Service)
+			if err != nil {
+				return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to get provider"), nil, err
+			}
+			if !cosmos.NewCoins(provider.SubscriptionRate...).AmountOf(contract.Rate.Denom).Equal(contract.Rate.Amount) {
+				return simtypes.NoOpMsg(types.ModuleName, msgType, "provider rate changed"), nil, nil
+			}
+			remaining := contract.Expiration() - ctx.BlockHeight() + contract.TopUpBalance().Quo(contract.BlockCost()).Int64()
+			if provider.MaxContractDuration-remaining < maxBlocks {
+				maxBlocks = provider.MaxContractDuration - remaining
+			}
+		}
+		if maxBlocks < 1 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "contract runs for as long as allowed"), nil, nil
+		}
+		deposit := contract.BlockCost().MulRaw(1 + r.Int63n(maxBlocks))
+
+		simAccount, _ := findPubKeyAccount(accs, contract.Client)
+		spent := cosmos.NewCoins(cosmos.NewCoin(contract.Rate.Denom, deposit))
+		if !bk.SpendableCoins(ctx, simAccount.Address).IsAllGTE(spent) {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "not enough funds for the deposit"), nil, nil
+		}
+
+		msg := types.NewMsgTopUpContract(simAccount.Address, contract.Id, deposit)
+		return deliver(r, app, ctx, ak, bk, simAccount, msg, spent)
+	}
+}
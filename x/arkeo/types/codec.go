@@ -14,6 +14,14 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgOpenContract{}, "arkeo/OpenContract", nil)
 	cdc.RegisterConcrete(&MsgCloseContract{}, "arkeo/CloseContract", nil)
 	cdc.RegisterConcrete(&MsgClaimContractIncome{}, "arkeo/ClaimContractIncome", nil)
+	cdc.RegisterConcrete(&MsgRenewContract{}, "arkeo/RenewContract", nil)
+	cdc.RegisterConcrete(&MsgProviderCloseContract{}, "arkeo/ProviderCloseContract", nil)
+	cdc.RegisterConcrete(&MsgRotateDelegate{}, "arkeo/RotateDelegate", nil)
+	cdc.RegisterConcrete(&MsgSetAutoRenew{}, "arkeo/SetAutoRenew", nil)
+	cdc.RegisterConcrete(&MsgTopUpContract{}, "arkeo/TopUpContract", nil)
+	cdc.RegisterConcrete(&MsgClaimContractIncomeBatch{}, "arkeo/ClaimContractIncomeBatch", nil)
+	cdc.RegisterConcrete(&MsgPurgeExpiredContracts{}, "arkeo/PurgeExpiredContracts", nil)
+	cdc.RegisterConcrete(&MsgSetProviderAllowlist{}, "arkeo/SetProviderAllowlist", nil)
 	cdc.RegisterConcrete(&MsgSetVersion{}, "arkeo/SetVersion", nil)
 	// this line is used by starport scaffolding # 2
 }
@@ -25,6 +33,14 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgOpenContract{},
 		&MsgCloseContract{},
 		&MsgClaimContractIncome{},
+		&MsgRenewContract{},
+		&MsgProviderCloseContract{},
+		&MsgRotateDelegate{},
+		&MsgSetAutoRenew{},
+		&MsgTopUpContract{},
+		&MsgClaimContractIncomeBatch{},
+		&MsgPurgeExpiredContracts{},
+		&MsgSetProviderAllowlist{},
 		&MsgSetVersion{},
 	)
 	// this line is used by starport scaffolding # 3
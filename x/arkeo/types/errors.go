@@ -41,4 +41,33 @@ var (
 	ErrInvariantMaxSupply                     = errors.Register(ModuleName, 32, "max supply invariant")
 	ErrInvalidAuthorization                   = errors.Register(ModuleName, 33, "invalid authorization")
 	ErrInvalidVersion                         = errors.Register(ModuleName, 34, "version cannot be zero or lower")
+	ErrInvalidModProviderUpdateMask           = errors.Register(ModuleName, 35, "invalid mod provider update mask")
+	ErrRenewContractClosed                    = errors.Register(ModuleName, 36, "contract is expired or closed")
+	ErrRenewContractUnauthorized              = errors.Register(ModuleName, 37, "unauthorized to renew contract")
+	ErrRenewContractRateChanged               = errors.Register(ModuleName, 38, "provider rate changed since the contract opened")
+	ErrRenewContractDeposit                   = errors.Register(ModuleName, 39, "invalid renew contract deposit")
+	ErrDenomNotAllowed                        = errors.Register(ModuleName, 40, "denom not allowed")
+	ErrOpenContractProviderFull               = errors.Register(ModuleName, 41, "provider reached its open contracts cap")
+	ErrInvalidModProviderMaxOpenContracts     = errors.Register(ModuleName, 42, "invalid max open contracts")
+	ErrOpenContractDeposit                    = errors.Register(ModuleName, 43, "invalid open contract deposit")
+	ErrRotateDelegateClosed                   = errors.Register(ModuleName, 44, "contract is expired or closed")
+	ErrRotateDelegateUnauthorized             = errors.Register(ModuleName, 45, "unauthorized to rotate the contract delegate")
+	ErrRotateDelegateInvalid                  = errors.Register(ModuleName, 46, "invalid new delegate")
+	ErrContractNotSubscription                = errors.Register(ModuleName, 47, "contract is not a subscription")
+	ErrTopUpContractClosed                    = errors.Register(ModuleName, 48, "contract is expired or closed")
+	ErrTopUpContractUnauthorized              = errors.Register(ModuleName, 49, "unauthorized to top up contract")
+	ErrTopUpContractDeposit                   = errors.Register(ModuleName, 50, "invalid top-up deposit")
+	ErrSetAutoRenewClosed                     = errors.Register(ModuleName, 51, "contract is expired or closed")
+	ErrSetAutoRenewUnauthorized               = errors.Register(ModuleName, 52, "unauthorized to set contract auto-renew")
+	ErrClaimContractIncomeBatchSize           = errors.Register(ModuleName, 53, "invalid claim batch size")
+	ErrInvalidModProviderMetadataUriLength    = errors.Register(ModuleName, 54, "mod provider metadata uri is too long")
+	ErrInvalidModProviderMetadataNonce        = errors.Register(ModuleName, 55, "invalid mod provider metadata nonce")
+	ErrProviderBondBelowMinimum               = errors.Register(ModuleName, 56, "provider bond below the minimum of its service")
+	ErrPurgeExpiredContractsSize              = errors.Register(ModuleName, 57, "invalid purge size")
+	ErrContractNotPurgeable                   = errors.Register(ModuleName, 58, "contract cannot be purged")
+	ErrOpenContractStartHeight                = errors.Register(ModuleName, 59, "invalid contract start height")
+	ErrClaimContractIncomePending             = errors.Register(ModuleName, 60, "contract has not started yet")
+	ErrOpenContractClientNotAllowed           = errors.Register(ModuleName, 61, "client not on the provider allowlist")
+	ErrInvalidAllowlist                       = errors.Register(ModuleName, 62, "invalid provider allowlist")
+	ErrClaimContractIncomeOverLimit           = errors.Register(ModuleName, 64, "nonce over the contract max queries")
 )
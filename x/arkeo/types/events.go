@@ -6,14 +6,30 @@ import (
 )
 
 const (
-	EventTypeBondProvider    = "arkeo.arkeo.EventBondProvider"
-	EventTypeModProvider     = "arkeo.arkeo.EventModProvider"
-	EventTypeOpenContract    = "arkeo.arkeo.EventOpenContract"
-	EventTypeSettleContract  = "arkeo.arkeo.EventSettleContract"
-	EventTypeCloseContract   = "arkeo.arkeo.EventCloseContract"
-	EventTypeValidatorPayout = "arkeo.arkeo.EventValidatorPayout"
+	EventTypeBondProvider             = "arkeo.arkeo.EventBondProvider"
+	EventTypeModProvider              = "arkeo.arkeo.EventModProvider"
+	EventTypeOpenContract             = "arkeo.arkeo.EventOpenContract"
+	EventTypeSettleContract           = "arkeo.arkeo.EventSettleContract"
+	EventTypeCloseContract            = "arkeo.arkeo.EventCloseContract"
+	EventTypeRenewContract            = "arkeo.arkeo.EventRenewContract"
+	EventTypeRotateDelegate           = "arkeo.arkeo.EventRotateDelegate"
+	EventTypeSetAutoRenew             = "arkeo.arkeo.EventSetAutoRenew"
+	EventTypeTopUpContract            = "arkeo.arkeo.EventTopUpContract"
+	EventTypeClaimContractIncomeBatch = "arkeo.arkeo.EventClaimContractIncomeBatch"
+	EventTypePurgeExpiredContracts    = "arkeo.arkeo.EventPurgeExpiredContracts"
+	EventTypeValidatorPayout          = "arkeo.arkeo.EventValidatorPayout"
+	EventTypeSlashProvider            = "arkeo.arkeo.EventSlashProvider"
+	EventTypeProviderDemoted          = "arkeo.arkeo.EventProviderDemoted"
+	EventTypeParamsUpdated            = "arkeo.arkeo.EventParamsUpdated"
+	EventTypeContractExpired          = "arkeo.arkeo.EventContractExpired"
+	EventTypeContractAutoRenewed      = "arkeo.arkeo.EventContractAutoRenewed"
+	EventTypeContractActivated        = "arkeo.arkeo.EventContractActivated"
+	EventTypeSetProviderAllowlist     = "arkeo.arkeo.EventSetProviderAllowlist"
 )
 
+// SlashReasonOverClaim the provider claimed more queries than the contract allows
+const SlashReasonOverClaim = "over_claim"
+
 func NewOpenContractEvent(openCost int64, contract *Contract) EventOpenContract {
 	return EventOpenContract{
 		Provider:           contract.Provider,
@@ -30,6 +46,9 @@ func NewOpenContractEvent(openCost int64, contract *Contract) EventOpenContract
 		SettlementDuration: contract.SettlementDuration,
 		Authorization:      contract.Authorization,
 		QueriesPerMinute:   contract.QueriesPerMinute,
+		AutoRenew:          contract.AutoRenew,
+		Payer:              contract.Payer,
+		StartHeight:        contract.StartHeight,
 	}
 }
 
@@ -45,6 +64,10 @@ func NewContractSettlementEvent(debt, valIncome cosmos.Int, contract *Contract)
 		Height:     contract.Height,
 		Paid:       debt,
 		Reserve:    valIncome,
+		Unpaid:     cosmos.ZeroInt(),
+		Denom:      contract.Rate.Denom,
+		Refunded:   cosmos.ZeroInt(),
+		Claimed:    debt,
 	}
 }
 
@@ -55,6 +78,7 @@ func NewCloseContractEvent(contract *Contract) EventCloseContract {
 		Service:    contract.Service.String(),
 		Client:     contract.Client,
 		Delegate:   contract.Delegate,
+		Penalty:    cosmos.ZeroInt(),
 	}
 }
 
@@ -77,3 +101,17 @@ func NewValidatorPayoutEvent(acc cosmos.AccAddress, reward cosmos.Int) EventVali
 		Reward:    reward,
 	}
 }
+
+// Updates return true when the event carries field of the provider, all of them when it doesn't name the fields
+// updated
+func (e EventModProvider) Updates(field string) bool {
+	if len(e.UpdatedFields) == 0 {
+		return true
+	}
+	for _, f := range e.UpdatedFields {
+		if f == field {
+			return true
+		}
+	}
+	return false
+}
@@ -34,6 +34,8 @@ type EventBondProvider struct {
 	Service  string                                      `protobuf:"bytes,2,opt,name=service,proto3" json:"service,omitempty"`
 	BondRel  cosmossdk_io_math.Int                       `protobuf:"bytes,3,opt,name=bond_rel,json=bondRel,proto3,customtype=cosmossdk.io/math.Int" json:"bond_rel"`
 	BondAbs  cosmossdk_io_math.Int                       `protobuf:"bytes,4,opt,name=bond_abs,json=bondAbs,proto3,customtype=cosmossdk.io/math.Int" json:"bond_abs"`
+	// denom of the bond amounts
+	BondDenom string `protobuf:"bytes,5,opt,name=bond_denom,json=bondDenom,proto3" json:"bond_denom,omitempty"`
 }
 
 func (m *EventBondProvider) Reset()         { *m = EventBondProvider{} }
@@ -83,6 +85,13 @@ func (m *EventBondProvider) GetService() string {
 	return ""
 }
 
+func (m *EventBondProvider) GetBondDenom() string {
+	if m != nil {
+		return m.BondDenom
+	}
+	return ""
+}
+
 type EventModProvider struct {
 	Creator             github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=creator,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"creator,omitempty"`
 	Provider            github_com_arkeonetwork_arkeo_common.PubKey   `protobuf:"bytes,2,opt,name=provider,proto3,casttype=github.com/arkeonetwork/arkeo/common.PubKey" json:"provider,omitempty"`
@@ -96,6 +105,9 @@ type EventModProvider struct {
 	PayAsYouGoRate      []types.Coin                                  `protobuf:"bytes,10,rep,name=pay_as_you_go_rate,json=payAsYouGoRate,proto3" json:"pay_as_you_go_rate"`
 	Bond                cosmossdk_io_math.Int                         `protobuf:"bytes,11,opt,name=bond,proto3,customtype=cosmossdk.io/math.Int" json:"bond"`
 	SettlementDuration  int64                                         `protobuf:"varint,12,opt,name=settlement_duration,json=settlementDuration,proto3" json:"settlement_duration,omitempty"`
+	// updated_fields names the fields of the provider the event carries, all of them when empty
+	UpdatedFields    []string `protobuf:"bytes,13,rep,name=updated_fields,json=updatedFields,proto3" json:"updated_fields,omitempty"`
+	MaxOpenContracts uint64   `protobuf:"varint,14,opt,name=max_open_contracts,json=maxOpenContracts,proto3" json:"max_open_contracts,omitempty"`
 }
 
 func (m *EventModProvider) Reset()         { *m = EventModProvider{} }
@@ -208,21 +220,45 @@ func (m *EventModProvider) GetSettlementDuration() int64 {
 	return 0
 }
 
+func (m *EventModProvider) GetUpdatedFields() []string {
+	if m != nil {
+		return m.UpdatedFields
+	}
+	return nil
+}
+
+func (m *EventModProvider) GetMaxOpenContracts() uint64 {
+	if m != nil {
+		return m.MaxOpenContracts
+	}
+	return 0
+}
+
 type EventOpenContract struct {
-	Provider           github_com_arkeonetwork_arkeo_common.PubKey `protobuf:"bytes,1,opt,name=provider,proto3,casttype=github.com/arkeonetwork/arkeo/common.PubKey" json:"provider,omitempty"`
-	ContractId         uint64                                      `protobuf:"varint,2,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
-	Service            string                                      `protobuf:"bytes,3,opt,name=service,proto3" json:"service,omitempty"`
-	Client             github_com_arkeonetwork_arkeo_common.PubKey `protobuf:"bytes,4,opt,name=client,proto3,casttype=github.com/arkeonetwork/arkeo/common.PubKey" json:"client,omitempty"`
-	Delegate           github_com_arkeonetwork_arkeo_common.PubKey `protobuf:"bytes,5,opt,name=delegate,proto3,casttype=github.com/arkeonetwork/arkeo/common.PubKey" json:"delegate,omitempty"`
-	Type               ContractType                                `protobuf:"varint,6,opt,name=type,proto3,enum=arkeo.arkeo.ContractType" json:"type,omitempty"`
-	Height             int64                                       `protobuf:"varint,7,opt,name=height,proto3" json:"height,omitempty"`
-	Duration           int64                                       `protobuf:"varint,8,opt,name=duration,proto3" json:"duration,omitempty"`
-	Rate               types.Coin                                  `protobuf:"bytes,9,opt,name=rate,proto3" json:"rate"`
-	OpenCost           int64                                       `protobuf:"varint,10,opt,name=open_cost,json=openCost,proto3" json:"open_cost,omitempty"`
-	Deposit            cosmossdk_io_math.Int                       `protobuf:"bytes,11,opt,name=deposit,proto3,customtype=cosmossdk.io/math.Int" json:"deposit"`
-	SettlementDuration int64                                       `protobuf:"varint,12,opt,name=settlement_duration,json=settlementDuration,proto3" json:"settlement_duration,omitempty"`
-	Authorization      ContractAuthorization                       `protobuf:"varint,13,opt,name=authorization,proto3,enum=arkeo.arkeo.ContractAuthorization" json:"authorization,omitempty"`
-	QueriesPerMinute   int64                                       `protobuf:"varint,14,opt,name=queries_per_minute,json=queriesPerMinute,proto3" json:"queries_per_minute,omitempty"`
+	Provider   github_com_arkeonetwork_arkeo_common.PubKey `protobuf:"bytes,1,opt,name=provider,proto3,casttype=github.com/arkeonetwork/arkeo/common.PubKey" json:"provider,omitempty"`
+	ContractId uint64                                      `protobuf:"varint,2,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	Service    string                                      `protobuf:"bytes,3,opt,name=service,proto3" json:"service,omitempty"`
+	Client     github_com_arkeonetwork_arkeo_common.PubKey `protobuf:"bytes,4,opt,name=client,proto3,casttype=github.com/arkeonetwork/arkeo/common.PubKey" json:"client,omitempty"`
+	Delegate   github_com_arkeonetwork_arkeo_common.PubKey `protobuf:"bytes,5,opt,name=delegate,proto3,casttype=github.com/arkeonetwork/arkeo/common.PubKey" json:"delegate,omitempty"`
+	Type       ContractType                                `protobuf:"varint,6,opt,name=type,proto3,enum=arkeo.arkeo.ContractType" json:"type,omitempty"`
+	Height     int64                                       `protobuf:"varint,7,opt,name=height,proto3" json:"height,omitempty"`
+	Duration   int64                                       `protobuf:"varint,8,opt,name=duration,proto3" json:"duration,omitempty"`
+	// deprecated, kept for one release, use rate_denom and rate_amount
+	Rate                  types.Coin            `protobuf:"bytes,9,opt,name=rate,proto3" json:"rate"`
+	OpenCost              int64                 `protobuf:"varint,10,opt,name=open_cost,json=openCost,proto3" json:"open_cost,omitempty"`
+	Deposit               cosmossdk_io_math.Int `protobuf:"bytes,11,opt,name=deposit,proto3,customtype=cosmossdk.io/math.Int" json:"deposit"`
+	SettlementDuration    int64                 `protobuf:"varint,12,opt,name=settlement_duration,json=settlementDuration,proto3" json:"settlement_duration,omitempty"`
+	Authorization         ContractAuthorization `protobuf:"varint,13,opt,name=authorization,proto3,enum=arkeo.arkeo.ContractAuthorization" json:"authorization,omitempty"`
+	QueriesPerMinute      int64                 `protobuf:"varint,14,opt,name=queries_per_minute,json=queriesPerMinute,proto3" json:"queries_per_minute,omitempty"`
+	SettlementGracePeriod int64                 `protobuf:"varint,15,opt,name=settlement_grace_period,json=settlementGracePeriod,proto3" json:"settlement_grace_period,omitempty"`
+	AutoRenew             bool                  `protobuf:"varint,16,opt,name=auto_renew,json=autoRenew,proto3" json:"auto_renew,omitempty"`
+	RateDenom             string                `protobuf:"bytes,17,opt,name=rate_denom,json=rateDenom,proto3" json:"rate_denom,omitempty"`
+	RateAmount            cosmossdk_io_math.Int `protobuf:"bytes,18,opt,name=rate_amount,json=rateAmount,proto3,customtype=cosmossdk.io/math.Int" json:"rate_amount"`
+	// payer funded the deposit instead of the client, empty when it did not
+	Payer string `protobuf:"bytes,19,opt,name=payer,proto3" json:"payer,omitempty"`
+	// start_height the contract is scheduled to start at, zero when it started
+	// as it opened
+	StartHeight int64 `protobuf:"varint,20,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
 }
 
 func (m *EventOpenContract) Reset()         { *m = EventOpenContract{} }
@@ -349,6 +385,41 @@ func (m *EventOpenContract) GetQueriesPerMinute() int64 {
 	return 0
 }
 
+func (m *EventOpenContract) GetSettlementGracePeriod() int64 {
+	if m != nil {
+		return m.SettlementGracePeriod
+	}
+	return 0
+}
+
+func (m *EventOpenContract) GetAutoRenew() bool {
+	if m != nil {
+		return m.AutoRenew
+	}
+	return false
+}
+
+func (m *EventOpenContract) GetRateDenom() string {
+	if m != nil {
+		return m.RateDenom
+	}
+	return ""
+}
+
+func (m *EventOpenContract) GetPayer() string {
+	if m != nil {
+		return m.Payer
+	}
+	return ""
+}
+
+func (m *EventOpenContract) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
 type EventSettleContract struct {
 	Provider   github_com_arkeonetwork_arkeo_common.PubKey `protobuf:"bytes,1,opt,name=provider,proto3,casttype=github.com/arkeonetwork/arkeo/common.PubKey" json:"provider,omitempty"`
 	ContractId uint64                                      `protobuf:"varint,2,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
@@ -358,8 +429,21 @@ type EventSettleContract struct {
 	Type       ContractType                                `protobuf:"varint,6,opt,name=type,proto3,enum=arkeo.arkeo.ContractType" json:"type,omitempty"`
 	Nonce      int64                                       `protobuf:"varint,7,opt,name=nonce,proto3" json:"nonce,omitempty"`
 	Height     int64                                       `protobuf:"varint,8,opt,name=height,proto3" json:"height,omitempty"`
-	Paid       cosmossdk_io_math.Int                       `protobuf:"bytes,9,opt,name=paid,proto3,customtype=cosmossdk.io/math.Int" json:"paid"`
-	Reserve    cosmossdk_io_math.Int                       `protobuf:"bytes,10,opt,name=reserve,proto3,customtype=cosmossdk.io/math.Int" json:"reserve"`
+	// paid out of the deposit, the reserve tax included
+	Paid cosmossdk_io_math.Int `protobuf:"bytes,9,opt,name=paid,proto3,customtype=cosmossdk.io/math.Int" json:"paid"`
+	// reserve tax taken out of the amount paid
+	Reserve cosmossdk_io_math.Int `protobuf:"bytes,10,opt,name=reserve,proto3,customtype=cosmossdk.io/math.Int" json:"reserve"`
+	// claimed beyond the deposit left, paid and unpaid summing up to claimed
+	Unpaid cosmossdk_io_math.Int `protobuf:"bytes,11,opt,name=unpaid,proto3,customtype=cosmossdk.io/math.Int" json:"unpaid"`
+	// denom of the amounts of the settlement
+	Denom string `protobuf:"bytes,12,opt,name=denom,proto3" json:"denom,omitempty"`
+	// deposit left refunded as the settlement closes the contract
+	Refunded cosmossdk_io_math.Int `protobuf:"bytes,13,opt,name=refunded,proto3,customtype=cosmossdk.io/math.Int" json:"refunded"`
+	// owed for the queries or blocks served since the last settlement
+	Claimed cosmossdk_io_math.Int `protobuf:"bytes,14,opt,name=claimed,proto3,customtype=cosmossdk.io/math.Int" json:"claimed"`
+	// rounding remainder accrued to the reserve, part of the amount paid along
+	// the reserve tax
+	Dust cosmossdk_io_math.Int `protobuf:"bytes,15,opt,name=dust,proto3,customtype=cosmossdk.io/math.Int" json:"dust"`
 }
 
 func (m *EventSettleContract) Reset()         { *m = EventSettleContract{} }
@@ -451,12 +535,28 @@ func (m *EventSettleContract) GetHeight() int64 {
 	return 0
 }
 
+func (m *EventSettleContract) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 type EventCloseContract struct {
 	ContractId uint64                                      `protobuf:"varint,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
 	Provider   github_com_arkeonetwork_arkeo_common.PubKey `protobuf:"bytes,2,opt,name=provider,proto3,casttype=github.com/arkeonetwork/arkeo/common.PubKey" json:"provider,omitempty"`
 	Service    string                                      `protobuf:"bytes,3,opt,name=service,proto3" json:"service,omitempty"`
 	Client     github_com_arkeonetwork_arkeo_common.PubKey `protobuf:"bytes,4,opt,name=client,proto3,casttype=github.com/arkeonetwork/arkeo/common.PubKey" json:"client,omitempty"`
 	Delegate   github_com_arkeonetwork_arkeo_common.PubKey `protobuf:"bytes,5,opt,name=delegate,proto3,casttype=github.com/arkeonetwork/arkeo/common.PubKey" json:"delegate,omitempty"`
+	// by_provider is true when the provider closed the contract, paying the penalty from its bond to the client
+	ByProvider bool                  `protobuf:"varint,6,opt,name=by_provider,json=byProvider,proto3" json:"by_provider,omitempty"`
+	Penalty    cosmossdk_io_math.Int `protobuf:"bytes,7,opt,name=penalty,proto3,customtype=cosmossdk.io/math.Int" json:"penalty"`
+	// split of the deposit a subscription closed early by its client left once
+	// the blocks served were paid: the compensation paid to the provider, the
+	// refund and the dust of the integer split, which goes to the reserve
+	Compensation cosmossdk_io_math.Int `protobuf:"bytes,8,opt,name=compensation,proto3,customtype=cosmossdk.io/math.Int" json:"compensation"`
+	Refund       cosmossdk_io_math.Int `protobuf:"bytes,9,opt,name=refund,proto3,customtype=cosmossdk.io/math.Int" json:"refund"`
+	Dust         cosmossdk_io_math.Int `protobuf:"bytes,10,opt,name=dust,proto3,customtype=cosmossdk.io/math.Int" json:"dust"`
 }
 
 func (m *EventCloseContract) Reset()         { *m = EventCloseContract{} }
@@ -527,23 +627,39 @@ func (m *EventCloseContract) GetDelegate() github_com_arkeonetwork_arkeo_common.
 	return nil
 }
 
-type EventValidatorPayout struct {
-	Validator github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=validator,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"validator,omitempty"`
-	Reward    cosmossdk_io_math.Int                         `protobuf:"bytes,2,opt,name=reward,proto3,customtype=cosmossdk.io/math.Int" json:"reward"`
+func (m *EventCloseContract) GetByProvider() bool {
+	if m != nil {
+		return m.ByProvider
+	}
+	return false
 }
 
-func (m *EventValidatorPayout) Reset()         { *m = EventValidatorPayout{} }
-func (m *EventValidatorPayout) String() string { return proto.CompactTextString(m) }
-func (*EventValidatorPayout) ProtoMessage()    {}
-func (*EventValidatorPayout) Descriptor() ([]byte, []int) {
+type EventRenewContract struct {
+	Provider      github_com_arkeonetwork_arkeo_common.PubKey `protobuf:"bytes,1,opt,name=provider,proto3,casttype=github.com/arkeonetwork/arkeo/common.PubKey" json:"provider,omitempty"`
+	ContractId    uint64                                      `protobuf:"varint,2,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	Service       string                                      `protobuf:"bytes,3,opt,name=service,proto3" json:"service,omitempty"`
+	Client        github_com_arkeonetwork_arkeo_common.PubKey `protobuf:"bytes,4,opt,name=client,proto3,casttype=github.com/arkeonetwork/arkeo/common.PubKey" json:"client,omitempty"`
+	Delegate      github_com_arkeonetwork_arkeo_common.PubKey `protobuf:"bytes,5,opt,name=delegate,proto3,casttype=github.com/arkeonetwork/arkeo/common.PubKey" json:"delegate,omitempty"`
+	Type          ContractType                                `protobuf:"varint,6,opt,name=type,proto3,enum=arkeo.arkeo.ContractType" json:"type,omitempty"`
+	OldExpiration int64                                       `protobuf:"varint,7,opt,name=old_expiration,json=oldExpiration,proto3" json:"old_expiration,omitempty"`
+	NewExpiration int64                                       `protobuf:"varint,8,opt,name=new_expiration,json=newExpiration,proto3" json:"new_expiration,omitempty"`
+	Rate          types.Coin                                  `protobuf:"bytes,9,opt,name=rate,proto3" json:"rate"`
+	ExtraDeposit  cosmossdk_io_math.Int                       `protobuf:"bytes,10,opt,name=extra_deposit,json=extraDeposit,proto3,customtype=cosmossdk.io/math.Int" json:"extra_deposit"`
+	Deposit       cosmossdk_io_math.Int                       `protobuf:"bytes,11,opt,name=deposit,proto3,customtype=cosmossdk.io/math.Int" json:"deposit"`
+}
+
+func (m *EventRenewContract) Reset()         { *m = EventRenewContract{} }
+func (m *EventRenewContract) String() string { return proto.CompactTextString(m) }
+func (*EventRenewContract) ProtoMessage()    {}
+func (*EventRenewContract) Descriptor() ([]byte, []int) {
 	return fileDescriptor_39b4417094f69f41, []int{5}
 }
-func (m *EventValidatorPayout) XXX_Unmarshal(b []byte) error {
+func (m *EventRenewContract) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventValidatorPayout) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventRenewContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventValidatorPayout.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventRenewContract.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -553,797 +669,6914 @@ func (m *EventValidatorPayout) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *EventValidatorPayout) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventValidatorPayout.Merge(m, src)
+func (m *EventRenewContract) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRenewContract.Merge(m, src)
 }
-func (m *EventValidatorPayout) XXX_Size() int {
+func (m *EventRenewContract) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventValidatorPayout) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventValidatorPayout.DiscardUnknown(m)
+func (m *EventRenewContract) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRenewContract.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventValidatorPayout proto.InternalMessageInfo
+var xxx_messageInfo_EventRenewContract proto.InternalMessageInfo
 
-func (m *EventValidatorPayout) GetValidator() github_com_cosmos_cosmos_sdk_types.AccAddress {
+func (m *EventRenewContract) GetProvider() github_com_arkeonetwork_arkeo_common.PubKey {
 	if m != nil {
-		return m.Validator
+		return m.Provider
 	}
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*EventBondProvider)(nil), "arkeo.arkeo.EventBondProvider")
-	proto.RegisterType((*EventModProvider)(nil), "arkeo.arkeo.EventModProvider")
-	proto.RegisterType((*EventOpenContract)(nil), "arkeo.arkeo.EventOpenContract")
-	proto.RegisterType((*EventSettleContract)(nil), "arkeo.arkeo.EventSettleContract")
-	proto.RegisterType((*EventCloseContract)(nil), "arkeo.arkeo.EventCloseContract")
-	proto.RegisterType((*EventValidatorPayout)(nil), "arkeo.arkeo.EventValidatorPayout")
+func (m *EventRenewContract) GetContractId() uint64 {
+	if m != nil {
+		return m.ContractId
+	}
+	return 0
 }
 
-func init() { proto.RegisterFile("arkeo/arkeo/events.proto", fileDescriptor_39b4417094f69f41) }
-
-var fileDescriptor_39b4417094f69f41 = []byte{
-	// 922 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x96, 0x4d, 0x6f, 0xe3, 0x44,
-	0x18, 0xc7, 0xeb, 0x36, 0x6f, 0x9d, 0xb4, 0x55, 0x77, 0xda, 0x45, 0x6e, 0x57, 0x4a, 0x42, 0x24,
-	0xa4, 0x48, 0x4b, 0x6d, 0xb5, 0xfd, 0x00, 0xab, 0xa4, 0x94, 0xa5, 0x2a, 0xcb, 0x56, 0x5e, 0x40,
-	0x82, 0x8b, 0x35, 0xb6, 0x1f, 0x25, 0xa3, 0xc4, 0x33, 0x66, 0x66, 0x9c, 0x6d, 0xf8, 0x08, 0x9c,
-	0x38, 0xf2, 0x21, 0x38, 0x21, 0x4e, 0x7c, 0x82, 0x3d, 0xae, 0x38, 0x20, 0xc4, 0xa1, 0x42, 0xed,
-	0xb7, 0xd8, 0x13, 0xf2, 0x78, 0x9c, 0x26, 0xbb, 0x2b, 0xd8, 0x44, 0x0b, 0xe2, 0xb0, 0x97, 0xd8,
-	0xf3, 0xbc, 0x79, 0xe6, 0xff, 0xfc, 0x9e, 0xd8, 0xc8, 0x26, 0x62, 0x08, 0xdc, 0xcd, 0x7f, 0x61,
-	0x0c, 0x4c, 0x49, 0x27, 0x11, 0x5c, 0x71, 0x5c, 0xd7, 0x36, 0x47, 0xff, 0xee, 0xef, 0xf6, 0x79,
-	0x9f, 0x6b, 0xbb, 0x9b, 0xdd, 0xe5, 0x21, 0xfb, 0x7b, 0x21, 0x97, 0x31, 0x97, 0x7e, 0xee, 0xc8,
-	0x17, 0xc6, 0xd5, 0xc8, 0x57, 0x6e, 0x40, 0x24, 0xb8, 0xe3, 0xc3, 0x00, 0x14, 0x39, 0x74, 0x43,
-	0x4e, 0x99, 0xf1, 0xcf, 0x3d, 0x77, 0x08, 0x90, 0x80, 0xc8, 0x3d, 0xed, 0xef, 0x56, 0xd1, 0x9d,
-	0xd3, 0x6c, 0x23, 0x3d, 0xce, 0xa2, 0x0b, 0xc1, 0xc7, 0x34, 0x02, 0x81, 0xcf, 0x51, 0x2d, 0x31,
-	0xf7, 0xb6, 0xd5, 0xb2, 0x3a, 0x1b, 0x3d, 0xf7, 0xc5, 0x55, 0xf3, 0x7e, 0x9f, 0xaa, 0x41, 0x1a,
-	0x38, 0x21, 0x8f, 0xf3, 0x52, 0x0c, 0xd4, 0x53, 0x2e, 0x86, 0xa6, 0x6e, 0xc8, 0xe3, 0x98, 0x33,
-	0xe7, 0x22, 0x0d, 0xce, 0x61, 0xe2, 0x4d, 0x0b, 0x60, 0x1b, 0x55, 0x25, 0x88, 0x31, 0x0d, 0xc1,
-	0x5e, 0x6d, 0x59, 0x9d, 0x75, 0xaf, 0x58, 0xe2, 0x8f, 0x51, 0x2d, 0xe0, 0x2c, 0xf2, 0x05, 0x8c,
-	0xec, 0xb5, 0xcc, 0xd5, 0xbb, 0xff, 0xec, 0xaa, 0xb9, 0xf2, 0xc7, 0x55, 0xf3, 0x6e, 0x7e, 0x20,
-	0x19, 0x0d, 0x1d, 0xca, 0xdd, 0x98, 0xa8, 0x81, 0x73, 0xc6, 0xd4, 0xaf, 0x3f, 0x1f, 0x20, 0x73,
-	0xee, 0x33, 0xa6, 0xbc, 0x6a, 0x96, 0xec, 0xc1, 0x68, 0x5a, 0x87, 0x04, 0xd2, 0x2e, 0x2d, 0x59,
-	0xa7, 0x1b, 0xc8, 0xf6, 0x2f, 0x65, 0xb4, 0xad, 0xc5, 0x78, 0xc4, 0x67, 0xb5, 0xa8, 0x86, 0x02,
-	0x88, 0xe2, 0x85, 0x14, 0x87, 0x2f, 0xae, 0x9a, 0x07, 0x33, 0x52, 0x18, 0xed, 0xf3, 0xcb, 0x81,
-	0x8c, 0x86, 0xae, 0x9a, 0x24, 0x20, 0x9d, 0x6e, 0x18, 0x76, 0xa3, 0x48, 0x80, 0x94, 0x5e, 0x51,
-	0x61, 0x4e, 0xd8, 0xd5, 0xb7, 0x28, 0xec, 0xda, 0xbc, 0xb0, 0xef, 0xa3, 0x8d, 0x18, 0x14, 0x89,
-	0x88, 0x22, 0x7e, 0x2a, 0x68, 0x2e, 0x8a, 0x57, 0x2f, 0x6c, 0x5f, 0x08, 0x8a, 0x3f, 0x40, 0x5b,
-	0xd3, 0x10, 0xc6, 0x59, 0x08, 0x76, 0xb9, 0x65, 0x75, 0x4a, 0xde, 0x66, 0x61, 0xfd, 0x2c, 0x33,
-	0xe2, 0x63, 0x54, 0x91, 0x8a, 0xa8, 0x54, 0xda, 0x95, 0x96, 0xd5, 0xd9, 0x3a, 0xba, 0xe7, 0xcc,
-	0x80, 0xea, 0x14, 0x22, 0x3d, 0xd1, 0x21, 0x9e, 0x09, 0xc5, 0x47, 0xe8, 0x6e, 0x4c, 0x99, 0x1f,
-	0x72, 0xa6, 0x04, 0x09, 0x95, 0x1f, 0xa5, 0x82, 0x28, 0xca, 0x99, 0x5d, 0x6d, 0x59, 0x9d, 0x35,
-	0x6f, 0x27, 0xa6, 0xec, 0xc4, 0xf8, 0x3e, 0x32, 0x2e, 0x9d, 0x43, 0x2e, 0x5f, 0x93, 0x53, 0x33,
-	0x39, 0xe4, 0xf2, 0x95, 0x9c, 0x4f, 0xd1, 0x1d, 0x99, 0x06, 0x32, 0x14, 0x34, 0xc9, 0xd6, 0xbe,
-	0x20, 0x0a, 0xec, 0xf5, 0xd6, 0x5a, 0xa7, 0x7e, 0xb4, 0xe7, 0x98, 0x06, 0x67, 0x23, 0xe1, 0x98,
-	0x91, 0x70, 0x4e, 0x38, 0x65, 0xbd, 0x52, 0xc6, 0x86, 0xb7, 0x3d, 0x9b, 0xe9, 0x11, 0x05, 0xf8,
-	0x1c, 0xe1, 0x84, 0x4c, 0x7c, 0x22, 0xfd, 0x09, 0x4f, 0xfd, 0x3e, 0xcf, 0xcb, 0xa1, 0x37, 0x2b,
-	0xb7, 0x95, 0x90, 0x49, 0x57, 0x7e, 0xc5, 0xd3, 0x87, 0x5c, 0x17, 0x7b, 0x80, 0x4a, 0x19, 0x55,
-	0x76, 0x7d, 0x71, 0x1c, 0x75, 0x22, 0x76, 0xd1, 0x8e, 0x04, 0xa5, 0x46, 0x10, 0x03, 0x9b, 0x51,
-	0x63, 0x43, 0xab, 0x81, 0x6f, 0x5d, 0x85, 0x18, 0xed, 0xdf, 0xca, 0x66, 0x92, 0x1f, 0x27, 0x30,
-	0x95, 0xf7, 0xed, 0x4e, 0x72, 0x13, 0xd5, 0xa7, 0xfd, 0xa1, 0x91, 0x06, 0xb8, 0xe4, 0xa1, 0xc2,
-	0x74, 0x16, 0xfd, 0x0d, 0x91, 0x0f, 0x51, 0x25, 0x1c, 0x51, 0x60, 0x4a, 0xb3, 0xb8, 0xc4, 0x2e,
-	0x4c, 0x7a, 0x76, 0xa0, 0x08, 0x46, 0xd0, 0xcf, 0x7a, 0x53, 0x5e, 0xf2, 0x40, 0x45, 0x01, 0x7c,
-	0x80, 0x4a, 0xd9, 0xac, 0x1a, 0xb6, 0xf7, 0xe6, 0xd8, 0x2e, 0x24, 0xfc, 0x7c, 0x92, 0x80, 0xa7,
-	0xc3, 0xf0, 0x7b, 0xa8, 0x32, 0x00, 0xda, 0x1f, 0x28, 0x03, 0xb2, 0x59, 0xe1, 0x7d, 0x54, 0x7b,
-	0x09, 0xd7, 0xe9, 0x1a, 0x1f, 0xa3, 0x92, 0xc1, 0xd2, 0x7a, 0x13, 0x8e, 0x74, 0x30, 0xbe, 0x87,
-	0xd6, 0x79, 0x02, 0xd9, 0x04, 0x49, 0x65, 0xa3, 0xbc, 0x22, 0xd7, 0x6d, 0x95, 0x0a, 0x9f, 0xa2,
-	0x6a, 0x04, 0x09, 0x97, 0x54, 0x2d, 0x43, 0x57, 0x91, 0xbb, 0x30, 0x60, 0xf8, 0x13, 0xb4, 0x49,
-	0x52, 0x35, 0xe0, 0x82, 0x7e, 0x9b, 0x87, 0x6e, 0x6a, 0xd5, 0xda, 0xaf, 0x55, 0xad, 0x3b, 0x1b,
-	0xe9, 0xcd, 0x27, 0xe2, 0x0f, 0x11, 0xfe, 0x26, 0x05, 0x41, 0x41, 0xfa, 0x09, 0x08, 0x3f, 0xa6,
-	0x2c, 0x55, 0x60, 0x6f, 0xe9, 0x27, 0x6f, 0x1b, 0xcf, 0x05, 0x88, 0x47, 0xda, 0xde, 0xfe, 0xa1,
-	0x84, 0x76, 0x34, 0xd8, 0x4f, 0xf4, 0x9e, 0xde, 0xa1, 0xfd, 0x6f, 0xa0, 0xbd, 0x8b, 0xca, 0xf9,
-	0x5b, 0x20, 0x27, 0x3b, 0x5f, 0xcc, 0x00, 0x5f, 0x9b, 0x03, 0xfe, 0x01, 0x2a, 0x25, 0x84, 0x46,
-	0x1a, 0xea, 0x45, 0xff, 0xdd, 0xb2, 0xc4, 0x8c, 0x61, 0x01, 0x99, 0x80, 0xa0, 0xf1, 0x5e, 0x94,
-	0x61, 0x93, 0xdb, 0xfe, 0x69, 0x15, 0x61, 0x8d, 0xc6, 0xc9, 0x88, 0xcb, 0x5b, 0x32, 0x5e, 0x6a,
-	0xa6, 0xf5, 0x4a, 0x33, 0xff, 0xa3, 0xd7, 0xf0, 0xff, 0x92, 0x8c, 0xf6, 0x8f, 0x16, 0xda, 0xd5,
-	0xa2, 0x7d, 0x49, 0x46, 0x34, 0xca, 0x3e, 0x4b, 0x2e, 0xc8, 0x84, 0xa7, 0x0a, 0x3f, 0x46, 0xeb,
-	0xe3, 0xc2, 0xb4, 0xfc, 0xb7, 0xce, 0x6d, 0x0d, 0x7c, 0x82, 0x2a, 0x02, 0x9e, 0x12, 0x91, 0xcf,
-	0xd3, 0x82, 0x4d, 0x36, 0xa9, 0xbd, 0xd3, 0x67, 0xd7, 0x0d, 0xeb, 0xf9, 0x75, 0xc3, 0xfa, 0xf3,
-	0xba, 0x61, 0x7d, 0x7f, 0xd3, 0x58, 0x79, 0x7e, 0xd3, 0x58, 0xf9, 0xfd, 0xa6, 0xb1, 0xf2, 0xf5,
-	0x3f, 0x9c, 0xff, 0xd2, 0x5c, 0xf5, 0x0e, 0x83, 0x8a, 0xfe, 0xde, 0x3d, 0xfe, 0x2b, 0x00, 0x00,
-	0xff, 0xff, 0xb9, 0xcc, 0xc8, 0x4b, 0x83, 0x0b, 0x00, 0x00,
+func (m *EventRenewContract) GetService() string {
+	if m != nil {
+		return m.Service
+	}
+	return ""
 }
 
-func (m *EventBondProvider) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventRenewContract) GetClient() github_com_arkeonetwork_arkeo_common.PubKey {
+	if m != nil {
+		return m.Client
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *EventBondProvider) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventRenewContract) GetDelegate() github_com_arkeonetwork_arkeo_common.PubKey {
+	if m != nil {
+		return m.Delegate
+	}
+	return nil
 }
 
-func (m *EventBondProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.BondAbs.Size()
-		i -= size
-		if _, err := m.BondAbs.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintEvents(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.BondRel.Size()
-		i -= size
-		if _, err := m.BondRel.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintEvents(dAtA, i, uint64(size))
+func (m *EventRenewContract) GetType() ContractType {
+	if m != nil {
+		return m.Type
 	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.Service) > 0 {
-		i -= len(m.Service)
-		copy(dAtA[i:], m.Service)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Service)))
-		i--
-		dAtA[i] = 0x12
+	return ContractType_SUBSCRIPTION
+}
+
+func (m *EventRenewContract) GetOldExpiration() int64 {
+	if m != nil {
+		return m.OldExpiration
 	}
-	if len(m.Provider) > 0 {
-		i -= len(m.Provider)
-		copy(dAtA[i:], m.Provider)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Provider)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *EventRenewContract) GetNewExpiration() int64 {
+	if m != nil {
+		return m.NewExpiration
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *EventModProvider) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventRenewContract) GetRate() types.Coin {
+	if m != nil {
+		return m.Rate
 	}
-	return dAtA[:n], nil
+	return types.Coin{}
 }
 
-func (m *EventModProvider) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventRotateDelegate is emitted once the client of the contract replaced its delegate, the claims signed by the old
+// one are no longer accepted
+type EventRotateDelegate struct {
+	Provider    github_com_arkeonetwork_arkeo_common.PubKey `protobuf:"bytes,1,opt,name=provider,proto3,casttype=github.com/arkeonetwork/arkeo/common.PubKey" json:"provider,omitempty"`
+	ContractId  uint64                                      `protobuf:"varint,2,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	Service     string                                      `protobuf:"bytes,3,opt,name=service,proto3" json:"service,omitempty"`
+	Client      github_com_arkeonetwork_arkeo_common.PubKey `protobuf:"bytes,4,opt,name=client,proto3,casttype=github.com/arkeonetwork/arkeo/common.PubKey" json:"client,omitempty"`
+	OldDelegate github_com_arkeonetwork_arkeo_common.PubKey `protobuf:"bytes,5,opt,name=old_delegate,json=oldDelegate,proto3,casttype=github.com/arkeonetwork/arkeo/common.PubKey" json:"old_delegate,omitempty"`
+	NewDelegate github_com_arkeonetwork_arkeo_common.PubKey `protobuf:"bytes,6,opt,name=new_delegate,json=newDelegate,proto3,casttype=github.com/arkeonetwork/arkeo/common.PubKey" json:"new_delegate,omitempty"`
 }
 
-func (m *EventModProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.SettlementDuration != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.SettlementDuration))
-		i--
-		dAtA[i] = 0x60
-	}
-	{
-		size := m.Bond.Size()
-		i -= size
-		if _, err := m.Bond.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *EventRotateDelegate) Reset()         { *m = EventRotateDelegate{} }
+func (m *EventRotateDelegate) String() string { return proto.CompactTextString(m) }
+func (*EventRotateDelegate) ProtoMessage()    {}
+func (*EventRotateDelegate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_39b4417094f69f41, []int{6}
+}
+func (m *EventRotateDelegate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRotateDelegate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRotateDelegate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintEvents(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x5a
-	if len(m.PayAsYouGoRate) > 0 {
-		for iNdEx := len(m.PayAsYouGoRate) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PayAsYouGoRate[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintEvents(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x52
-		}
+}
+func (m *EventRotateDelegate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRotateDelegate.Merge(m, src)
+}
+func (m *EventRotateDelegate) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRotateDelegate) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRotateDelegate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventRotateDelegate proto.InternalMessageInfo
+
+func (m *EventRotateDelegate) GetProvider() github_com_arkeonetwork_arkeo_common.PubKey {
+	if m != nil {
+		return m.Provider
 	}
-	if len(m.SubscriptionRate) > 0 {
-		for iNdEx := len(m.SubscriptionRate) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.SubscriptionRate[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintEvents(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x4a
-		}
+	return nil
+}
+
+func (m *EventRotateDelegate) GetContractId() uint64 {
+	if m != nil {
+		return m.ContractId
 	}
-	if m.MaxContractDuration != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.MaxContractDuration))
-		i--
-		dAtA[i] = 0x40
+	return 0
+}
+
+func (m *EventRotateDelegate) GetService() string {
+	if m != nil {
+		return m.Service
 	}
-	if m.MinContractDuration != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.MinContractDuration))
-		i--
-		dAtA[i] = 0x38
+	return ""
+}
+
+func (m *EventRotateDelegate) GetClient() github_com_arkeonetwork_arkeo_common.PubKey {
+	if m != nil {
+		return m.Client
 	}
-	if m.Status != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.MetadataNonce != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.MetadataNonce))
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.MetadataUri) > 0 {
-		i -= len(m.MetadataUri)
-		copy(dAtA[i:], m.MetadataUri)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.MetadataUri)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Service) > 0 {
-		i -= len(m.Service)
-		copy(dAtA[i:], m.Service)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Service)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Provider) > 0 {
-		i -= len(m.Provider)
-		copy(dAtA[i:], m.Provider)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Provider)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+func (m *EventRotateDelegate) GetOldDelegate() github_com_arkeonetwork_arkeo_common.PubKey {
+	if m != nil {
+		return m.OldDelegate
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *EventOpenContract) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventRotateDelegate) GetNewDelegate() github_com_arkeonetwork_arkeo_common.PubKey {
+	if m != nil {
+		return m.NewDelegate
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *EventOpenContract) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventSetAutoRenew is emitted once the client of the subscription turned its
+// auto-renewal on or off, the top-up balance being refunded when off
+type EventSetAutoRenew struct {
+	Provider   github_com_arkeonetwork_arkeo_common.PubKey `protobuf:"bytes,1,opt,name=provider,proto3,casttype=github.com/arkeonetwork/arkeo/common.PubKey" json:"provider,omitempty"`
+	ContractId uint64                                      `protobuf:"varint,2,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	Service    string                                      `protobuf:"bytes,3,opt,name=service,proto3" json:"service,omitempty"`
+	Client     github_com_arkeonetwork_arkeo_common.PubKey `protobuf:"bytes,4,opt,name=client,proto3,casttype=github.com/arkeonetwork/arkeo/common.PubKey" json:"client,omitempty"`
+	AutoRenew  bool                                        `protobuf:"varint,5,opt,name=auto_renew,json=autoRenew,proto3" json:"auto_renew,omitempty"`
+	Refund     cosmossdk_io_math.Int                       `protobuf:"bytes,6,opt,name=refund,proto3,customtype=cosmossdk.io/math.Int" json:"refund"`
 }
 
-func (m *EventOpenContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.QueriesPerMinute != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.QueriesPerMinute))
-		i--
-		dAtA[i] = 0x70
+func (m *EventSetAutoRenew) Reset()         { *m = EventSetAutoRenew{} }
+func (m *EventSetAutoRenew) String() string { return proto.CompactTextString(m) }
+func (*EventSetAutoRenew) ProtoMessage()    {}
+func (*EventSetAutoRenew) Descriptor() ([]byte, []int) {
+	return fileDescriptor_39b4417094f69f41, []int{7}
+}
+func (m *EventSetAutoRenew) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSetAutoRenew) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSetAutoRenew.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.Authorization != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.Authorization))
-		i--
-		dAtA[i] = 0x68
+}
+func (m *EventSetAutoRenew) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSetAutoRenew.Merge(m, src)
+}
+func (m *EventSetAutoRenew) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSetAutoRenew) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSetAutoRenew.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSetAutoRenew proto.InternalMessageInfo
+
+func (m *EventSetAutoRenew) GetProvider() github_com_arkeonetwork_arkeo_common.PubKey {
+	if m != nil {
+		return m.Provider
 	}
-	if m.SettlementDuration != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.SettlementDuration))
-		i--
-		dAtA[i] = 0x60
+	return nil
+}
+
+func (m *EventSetAutoRenew) GetContractId() uint64 {
+	if m != nil {
+		return m.ContractId
 	}
-	{
-		size := m.Deposit.Size()
-		i -= size
-		if _, err := m.Deposit.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintEvents(dAtA, i, uint64(size))
+	return 0
+}
+
+func (m *EventSetAutoRenew) GetService() string {
+	if m != nil {
+		return m.Service
 	}
-	i--
-	dAtA[i] = 0x5a
-	if m.OpenCost != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.OpenCost))
-		i--
-		dAtA[i] = 0x50
+	return ""
+}
+
+func (m *EventSetAutoRenew) GetClient() github_com_arkeonetwork_arkeo_common.PubKey {
+	if m != nil {
+		return m.Client
 	}
-	{
-		size, err := m.Rate.MarshalToSizedBuffer(dAtA[:i])
+	return nil
+}
+
+func (m *EventSetAutoRenew) GetAutoRenew() bool {
+	if m != nil {
+		return m.AutoRenew
+	}
+	return false
+}
+
+// EventTopUpContract is emitted once the client topped up the subscription.
+// The expiration is unchanged while the contract auto-renews, the top-up
+// waiting in its balance until then
+type EventTopUpContract struct {
+	Provider      github_com_arkeonetwork_arkeo_common.PubKey `protobuf:"bytes,1,opt,name=provider,proto3,casttype=github.com/arkeonetwork/arkeo/common.PubKey" json:"provider,omitempty"`
+	ContractId    uint64                                      `protobuf:"varint,2,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	Service       string                                      `protobuf:"bytes,3,opt,name=service,proto3" json:"service,omitempty"`
+	Client        github_com_arkeonetwork_arkeo_common.PubKey `protobuf:"bytes,4,opt,name=client,proto3,casttype=github.com/arkeonetwork/arkeo/common.PubKey" json:"client,omitempty"`
+	OldExpiration int64                                       `protobuf:"varint,5,opt,name=old_expiration,json=oldExpiration,proto3" json:"old_expiration,omitempty"`
+	NewExpiration int64                                       `protobuf:"varint,6,opt,name=new_expiration,json=newExpiration,proto3" json:"new_expiration,omitempty"`
+	TopUp         cosmossdk_io_math.Int                       `protobuf:"bytes,7,opt,name=top_up,json=topUp,proto3,customtype=cosmossdk.io/math.Int" json:"top_up"`
+	Deposit       cosmossdk_io_math.Int                       `protobuf:"bytes,8,opt,name=deposit,proto3,customtype=cosmossdk.io/math.Int" json:"deposit"`
+	// top-up balance left
+	Balance cosmossdk_io_math.Int `protobuf:"bytes,9,opt,name=balance,proto3,customtype=cosmossdk.io/math.Int" json:"balance"`
+}
+
+func (m *EventTopUpContract) Reset()         { *m = EventTopUpContract{} }
+func (m *EventTopUpContract) String() string { return proto.CompactTextString(m) }
+func (*EventTopUpContract) ProtoMessage()    {}
+func (*EventTopUpContract) Descriptor() ([]byte, []int) {
+	return fileDescriptor_39b4417094f69f41, []int{8}
+}
+func (m *EventTopUpContract) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventTopUpContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventTopUpContract.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintEvents(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x4a
-	if m.Duration != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.Duration))
-		i--
-		dAtA[i] = 0x40
-	}
-	if m.Height != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x38
-	}
-	if m.Type != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.Type))
-		i--
-		dAtA[i] = 0x30
+		return b[:n], nil
 	}
-	if len(m.Delegate) > 0 {
-		i -= len(m.Delegate)
-		copy(dAtA[i:], m.Delegate)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Delegate)))
-		i--
-		dAtA[i] = 0x2a
+}
+func (m *EventTopUpContract) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventTopUpContract.Merge(m, src)
+}
+func (m *EventTopUpContract) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventTopUpContract) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventTopUpContract.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventTopUpContract proto.InternalMessageInfo
+
+func (m *EventTopUpContract) GetProvider() github_com_arkeonetwork_arkeo_common.PubKey {
+	if m != nil {
+		return m.Provider
 	}
-	if len(m.Client) > 0 {
-		i -= len(m.Client)
-		copy(dAtA[i:], m.Client)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Client)))
-		i--
-		dAtA[i] = 0x22
+	return nil
+}
+
+func (m *EventTopUpContract) GetContractId() uint64 {
+	if m != nil {
+		return m.ContractId
 	}
-	if len(m.Service) > 0 {
-		i -= len(m.Service)
-		copy(dAtA[i:], m.Service)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Service)))
-		i--
-		dAtA[i] = 0x1a
+	return 0
+}
+
+func (m *EventTopUpContract) GetService() string {
+	if m != nil {
+		return m.Service
 	}
-	if m.ContractId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.ContractId))
-		i--
-		dAtA[i] = 0x10
+	return ""
+}
+
+func (m *EventTopUpContract) GetClient() github_com_arkeonetwork_arkeo_common.PubKey {
+	if m != nil {
+		return m.Client
 	}
-	if len(m.Provider) > 0 {
-		i -= len(m.Provider)
-		copy(dAtA[i:], m.Provider)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Provider)))
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+func (m *EventTopUpContract) GetOldExpiration() int64 {
+	if m != nil {
+		return m.OldExpiration
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *EventSettleContract) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventTopUpContract) GetNewExpiration() int64 {
+	if m != nil {
+		return m.NewExpiration
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *EventSettleContract) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type EventSlashProvider struct {
+	Provider   github_com_arkeonetwork_arkeo_common.PubKey `protobuf:"bytes,1,opt,name=provider,proto3,casttype=github.com/arkeonetwork/arkeo/common.PubKey" json:"provider,omitempty"`
+	Service    string                                      `protobuf:"bytes,2,opt,name=service,proto3" json:"service,omitempty"`
+	ContractId uint64                                      `protobuf:"varint,3,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	Reason     string                                      `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	// slashed from the bond to the reserve
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	// bond left
+	Bond   cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=bond,proto3,customtype=cosmossdk.io/math.Int" json:"bond"`
+	Faults int64                 `protobuf:"varint,7,opt,name=faults,proto3" json:"faults,omitempty"`
 }
 
-func (m *EventSettleContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Reserve.Size()
-		i -= size
-		if _, err := m.Reserve.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *EventSlashProvider) Reset()         { *m = EventSlashProvider{} }
+func (m *EventSlashProvider) String() string { return proto.CompactTextString(m) }
+func (*EventSlashProvider) ProtoMessage()    {}
+func (*EventSlashProvider) Descriptor() ([]byte, []int) {
+	return fileDescriptor_39b4417094f69f41, []int{9}
+}
+func (m *EventSlashProvider) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSlashProvider) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSlashProvider.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintEvents(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x52
-	{
-		size := m.Paid.Size()
-		i -= size
-		if _, err := m.Paid.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintEvents(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x4a
-	if m.Height != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x40
-	}
-	if m.Nonce != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.Nonce))
-		i--
-		dAtA[i] = 0x38
-	}
-	if m.Type != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.Type))
-		i--
-		dAtA[i] = 0x30
-	}
-	if len(m.Delegate) > 0 {
-		i -= len(m.Delegate)
-		copy(dAtA[i:], m.Delegate)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Delegate)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Client) > 0 {
-		i -= len(m.Client)
-		copy(dAtA[i:], m.Client)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Client)))
-		i--
-		dAtA[i] = 0x22
+}
+func (m *EventSlashProvider) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSlashProvider.Merge(m, src)
+}
+func (m *EventSlashProvider) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSlashProvider) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSlashProvider.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSlashProvider proto.InternalMessageInfo
+
+func (m *EventSlashProvider) GetProvider() github_com_arkeonetwork_arkeo_common.PubKey {
+	if m != nil {
+		return m.Provider
 	}
-	if len(m.Service) > 0 {
-		i -= len(m.Service)
-		copy(dAtA[i:], m.Service)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Service)))
-		i--
-		dAtA[i] = 0x1a
+	return nil
+}
+
+func (m *EventSlashProvider) GetService() string {
+	if m != nil {
+		return m.Service
 	}
-	if m.ContractId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.ContractId))
-		i--
-		dAtA[i] = 0x10
+	return ""
+}
+
+func (m *EventSlashProvider) GetContractId() uint64 {
+	if m != nil {
+		return m.ContractId
 	}
-	if len(m.Provider) > 0 {
-		i -= len(m.Provider)
-		copy(dAtA[i:], m.Provider)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Provider)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *EventSlashProvider) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventCloseContract) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventSlashProvider) GetFaults() int64 {
+	if m != nil {
+		return m.Faults
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *EventCloseContract) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type EventValidatorPayout struct {
+	Validator github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=validator,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"validator,omitempty"`
+	Reward    cosmossdk_io_math.Int                         `protobuf:"bytes,2,opt,name=reward,proto3,customtype=cosmossdk.io/math.Int" json:"reward"`
 }
 
-func (m *EventCloseContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Delegate) > 0 {
-		i -= len(m.Delegate)
-		copy(dAtA[i:], m.Delegate)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Delegate)))
-		i--
-		dAtA[i] = 0x2a
+func (m *EventValidatorPayout) Reset()         { *m = EventValidatorPayout{} }
+func (m *EventValidatorPayout) String() string { return proto.CompactTextString(m) }
+func (*EventValidatorPayout) ProtoMessage()    {}
+func (*EventValidatorPayout) Descriptor() ([]byte, []int) {
+	return fileDescriptor_39b4417094f69f41, []int{10}
+}
+func (m *EventValidatorPayout) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventValidatorPayout) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventValidatorPayout.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Client) > 0 {
-		i -= len(m.Client)
-		copy(dAtA[i:], m.Client)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Client)))
-		i--
-		dAtA[i] = 0x22
+}
+func (m *EventValidatorPayout) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventValidatorPayout.Merge(m, src)
+}
+func (m *EventValidatorPayout) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventValidatorPayout) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventValidatorPayout.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventValidatorPayout proto.InternalMessageInfo
+
+func (m *EventValidatorPayout) GetValidator() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Validator
 	}
-	if len(m.Service) > 0 {
-		i -= len(m.Service)
-		copy(dAtA[i:], m.Service)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Service)))
-		i--
-		dAtA[i] = 0x1a
+	return nil
+}
+
+// ParamChange is a module param changed, its values are JSON encoded
+type ParamChange struct {
+	Key      string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	OldValue string `protobuf:"bytes,2,opt,name=old_value,json=oldValue,proto3" json:"old_value,omitempty"`
+	NewValue string `protobuf:"bytes,3,opt,name=new_value,json=newValue,proto3" json:"new_value,omitempty"`
+}
+
+func (m *ParamChange) Reset()         { *m = ParamChange{} }
+func (m *ParamChange) String() string { return proto.CompactTextString(m) }
+func (*ParamChange) ProtoMessage()    {}
+func (*ParamChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_39b4417094f69f41, []int{11}
+}
+func (m *ParamChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ParamChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ParamChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Provider) > 0 {
-		i -= len(m.Provider)
-		copy(dAtA[i:], m.Provider)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Provider)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *ParamChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParamChange.Merge(m, src)
+}
+func (m *ParamChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *ParamChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParamChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParamChange proto.InternalMessageInfo
+
+func (m *ParamChange) GetKey() string {
+	if m != nil {
+		return m.Key
 	}
-	if m.ContractId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.ContractId))
-		i--
-		dAtA[i] = 0x8
+	return ""
+}
+
+func (m *ParamChange) GetOldValue() string {
+	if m != nil {
+		return m.OldValue
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventValidatorPayout) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ParamChange) GetNewValue() string {
+	if m != nil {
+		return m.NewValue
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventValidatorPayout) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type EventParamsUpdated struct {
+	Height  int64         `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Changes []ParamChange `protobuf:"bytes,2,rep,name=changes,proto3" json:"changes"`
 }
 
-func (m *EventValidatorPayout) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Reward.Size()
-		i -= size
-		if _, err := m.Reward.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *EventParamsUpdated) Reset()         { *m = EventParamsUpdated{} }
+func (m *EventParamsUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventParamsUpdated) ProtoMessage()    {}
+func (*EventParamsUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_39b4417094f69f41, []int{12}
+}
+func (m *EventParamsUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventParamsUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventParamsUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintEvents(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Validator) > 0 {
-		i -= len(m.Validator)
-		copy(dAtA[i:], m.Validator)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Validator)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *EventParamsUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventParamsUpdated.Merge(m, src)
+}
+func (m *EventParamsUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventParamsUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventParamsUpdated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventParamsUpdated proto.InternalMessageInfo
+
+func (m *EventParamsUpdated) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvents(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *EventParamsUpdated) GetChanges() []ParamChange {
+	if m != nil {
+		return m.Changes
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *EventBondProvider) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Provider)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.Service)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = m.BondRel.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	l = m.BondAbs.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	return n
+
+// EventContractExpired is emitted once by the end blocker, at the height the
+// contract expires
+type EventContractExpired struct {
+	ContractId uint64                                      `protobuf:"varint,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	Provider   github_com_arkeonetwork_arkeo_common.PubKey `protobuf:"bytes,2,opt,name=provider,proto3,casttype=github.com/arkeonetwork/arkeo/common.PubKey" json:"provider,omitempty"`
+	Service    string                                      `protobuf:"bytes,3,opt,name=service,proto3" json:"service,omitempty"`
+	Client     github_com_arkeonetwork_arkeo_common.PubKey `protobuf:"bytes,4,opt,name=client,proto3,casttype=github.com/arkeonetwork/arkeo/common.PubKey" json:"client,omitempty"`
+	Type       ContractType                                `protobuf:"varint,5,opt,name=type,proto3,enum=arkeo.arkeo.ContractType" json:"type,omitempty"`
+	Expiration int64                                       `protobuf:"varint,6,opt,name=expiration,proto3" json:"expiration,omitempty"`
+	// settlement_pending is true when the contract is settled at the end of its
+	// settlement period, later on, claims being still accepted until then
+	SettlementPending bool `protobuf:"varint,7,opt,name=settlement_pending,json=settlementPending,proto3" json:"settlement_pending,omitempty"`
 }
 
-func (m *EventModProvider) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.Provider)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+func (m *EventContractExpired) Reset()         { *m = EventContractExpired{} }
+func (m *EventContractExpired) String() string { return proto.CompactTextString(m) }
+func (*EventContractExpired) ProtoMessage()    {}
+func (*EventContractExpired) Descriptor() ([]byte, []int) {
+	return fileDescriptor_39b4417094f69f41, []int{13}
+}
+func (m *EventContractExpired) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventContractExpired) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventContractExpired.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.Service)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+}
+func (m *EventContractExpired) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventContractExpired.Merge(m, src)
+}
+func (m *EventContractExpired) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventContractExpired) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventContractExpired.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventContractExpired proto.InternalMessageInfo
+
+func (m *EventContractExpired) GetContractId() uint64 {
+	if m != nil {
+		return m.ContractId
 	}
-	l = len(m.MetadataUri)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	return 0
+}
+
+func (m *EventContractExpired) GetProvider() github_com_arkeonetwork_arkeo_common.PubKey {
+	if m != nil {
+		return m.Provider
 	}
-	if m.MetadataNonce != 0 {
-		n += 1 + sovEvents(uint64(m.MetadataNonce))
+	return nil
+}
+
+func (m *EventContractExpired) GetService() string {
+	if m != nil {
+		return m.Service
 	}
-	if m.Status != 0 {
-		n += 1 + sovEvents(uint64(m.Status))
+	return ""
+}
+
+func (m *EventContractExpired) GetClient() github_com_arkeonetwork_arkeo_common.PubKey {
+	if m != nil {
+		return m.Client
 	}
-	if m.MinContractDuration != 0 {
-		n += 1 + sovEvents(uint64(m.MinContractDuration))
+	return nil
+}
+
+func (m *EventContractExpired) GetType() ContractType {
+	if m != nil {
+		return m.Type
 	}
-	if m.MaxContractDuration != 0 {
-		n += 1 + sovEvents(uint64(m.MaxContractDuration))
+	return ContractType_SUBSCRIPTION
+}
+
+func (m *EventContractExpired) GetExpiration() int64 {
+	if m != nil {
+		return m.Expiration
 	}
-	if len(m.SubscriptionRate) > 0 {
-		for _, e := range m.SubscriptionRate {
-			l = e.Size()
-			n += 1 + l + sovEvents(uint64(l))
-		}
+	return 0
+}
+
+func (m *EventContractExpired) GetSettlementPending() bool {
+	if m != nil {
+		return m.SettlementPending
 	}
-	if len(m.PayAsYouGoRate) > 0 {
-		for _, e := range m.PayAsYouGoRate {
-			l = e.Size()
-			n += 1 + l + sovEvents(uint64(l))
+	return false
+}
+
+// EventContractAutoRenewed is emitted by the end blocker in place of the
+// expiration of an auto-renewed subscription, extended out of its top-up
+// balance
+type EventContractAutoRenewed struct {
+	ContractId    uint64                                      `protobuf:"varint,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	Provider      github_com_arkeonetwork_arkeo_common.PubKey `protobuf:"bytes,2,opt,name=provider,proto3,casttype=github.com/arkeonetwork/arkeo/common.PubKey" json:"provider,omitempty"`
+	Service       string                                      `protobuf:"bytes,3,opt,name=service,proto3" json:"service,omitempty"`
+	Client        github_com_arkeonetwork_arkeo_common.PubKey `protobuf:"bytes,4,opt,name=client,proto3,casttype=github.com/arkeonetwork/arkeo/common.PubKey" json:"client,omitempty"`
+	OldExpiration int64                                       `protobuf:"varint,5,opt,name=old_expiration,json=oldExpiration,proto3" json:"old_expiration,omitempty"`
+	NewExpiration int64                                       `protobuf:"varint,6,opt,name=new_expiration,json=newExpiration,proto3" json:"new_expiration,omitempty"`
+	// spent out of the top-up balance, added to the deposit
+	Spent   cosmossdk_io_math.Int `protobuf:"bytes,7,opt,name=spent,proto3,customtype=cosmossdk.io/math.Int" json:"spent"`
+	Deposit cosmossdk_io_math.Int `protobuf:"bytes,8,opt,name=deposit,proto3,customtype=cosmossdk.io/math.Int" json:"deposit"`
+	// top-up balance left
+	Balance cosmossdk_io_math.Int `protobuf:"bytes,9,opt,name=balance,proto3,customtype=cosmossdk.io/math.Int" json:"balance"`
+}
+
+func (m *EventContractAutoRenewed) Reset()         { *m = EventContractAutoRenewed{} }
+func (m *EventContractAutoRenewed) String() string { return proto.CompactTextString(m) }
+func (*EventContractAutoRenewed) ProtoMessage()    {}
+func (*EventContractAutoRenewed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_39b4417094f69f41, []int{14}
+}
+func (m *EventContractAutoRenewed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventContractAutoRenewed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventContractAutoRenewed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	l = m.Bond.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	if m.SettlementDuration != 0 {
-		n += 1 + sovEvents(uint64(m.SettlementDuration))
-	}
-	return n
+}
+func (m *EventContractAutoRenewed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventContractAutoRenewed.Merge(m, src)
+}
+func (m *EventContractAutoRenewed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventContractAutoRenewed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventContractAutoRenewed.DiscardUnknown(m)
 }
 
-func (m *EventOpenContract) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Provider)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if m.ContractId != 0 {
-		n += 1 + sovEvents(uint64(m.ContractId))
+var xxx_messageInfo_EventContractAutoRenewed proto.InternalMessageInfo
+
+func (m *EventContractAutoRenewed) GetContractId() uint64 {
+	if m != nil {
+		return m.ContractId
 	}
-	l = len(m.Service)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	return 0
+}
+
+func (m *EventContractAutoRenewed) GetProvider() github_com_arkeonetwork_arkeo_common.PubKey {
+	if m != nil {
+		return m.Provider
 	}
-	l = len(m.Client)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	return nil
+}
+
+func (m *EventContractAutoRenewed) GetService() string {
+	if m != nil {
+		return m.Service
 	}
-	l = len(m.Delegate)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	return ""
+}
+
+func (m *EventContractAutoRenewed) GetClient() github_com_arkeonetwork_arkeo_common.PubKey {
+	if m != nil {
+		return m.Client
 	}
-	if m.Type != 0 {
-		n += 1 + sovEvents(uint64(m.Type))
+	return nil
+}
+
+func (m *EventContractAutoRenewed) GetOldExpiration() int64 {
+	if m != nil {
+		return m.OldExpiration
 	}
-	if m.Height != 0 {
-		n += 1 + sovEvents(uint64(m.Height))
+	return 0
+}
+
+func (m *EventContractAutoRenewed) GetNewExpiration() int64 {
+	if m != nil {
+		return m.NewExpiration
 	}
-	if m.Duration != 0 {
-		n += 1 + sovEvents(uint64(m.Duration))
+	return 0
+}
+
+// EventClaimContractIncomeBatch is emitted once the claims of a batch are
+// processed, with the outcome of each of them
+type EventClaimContractIncomeBatch struct {
+	Creator string                `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Results []ContractClaimResult `protobuf:"bytes,2,rep,name=results,proto3" json:"results"`
+}
+
+func (m *EventClaimContractIncomeBatch) Reset()         { *m = EventClaimContractIncomeBatch{} }
+func (m *EventClaimContractIncomeBatch) String() string { return proto.CompactTextString(m) }
+func (*EventClaimContractIncomeBatch) ProtoMessage()    {}
+func (*EventClaimContractIncomeBatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_39b4417094f69f41, []int{15}
+}
+func (m *EventClaimContractIncomeBatch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventClaimContractIncomeBatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventClaimContractIncomeBatch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = m.Rate.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	if m.OpenCost != 0 {
-		n += 1 + sovEvents(uint64(m.OpenCost))
+}
+func (m *EventClaimContractIncomeBatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventClaimContractIncomeBatch.Merge(m, src)
+}
+func (m *EventClaimContractIncomeBatch) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventClaimContractIncomeBatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventClaimContractIncomeBatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventClaimContractIncomeBatch proto.InternalMessageInfo
+
+func (m *EventClaimContractIncomeBatch) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	l = m.Deposit.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	if m.SettlementDuration != 0 {
-		n += 1 + sovEvents(uint64(m.SettlementDuration))
+	return ""
+}
+
+func (m *EventClaimContractIncomeBatch) GetResults() []ContractClaimResult {
+	if m != nil {
+		return m.Results
 	}
-	if m.Authorization != 0 {
-		n += 1 + sovEvents(uint64(m.Authorization))
+	return nil
+}
+
+// EventPurgeExpiredContracts is emitted once the contracts of a purge are
+// processed, with the outcome of each of them and the reward of the creator
+type EventPurgeExpiredContracts struct {
+	Creator string                `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Results []ContractPurgeResult `protobuf:"bytes,2,rep,name=results,proto3" json:"results"`
+	Reward  cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=reward,proto3,customtype=cosmossdk.io/math.Int" json:"reward"`
+}
+
+func (m *EventPurgeExpiredContracts) Reset()         { *m = EventPurgeExpiredContracts{} }
+func (m *EventPurgeExpiredContracts) String() string { return proto.CompactTextString(m) }
+func (*EventPurgeExpiredContracts) ProtoMessage()    {}
+func (*EventPurgeExpiredContracts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_39b4417094f69f41, []int{16}
+}
+func (m *EventPurgeExpiredContracts) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventPurgeExpiredContracts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventPurgeExpiredContracts.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.QueriesPerMinute != 0 {
-		n += 1 + sovEvents(uint64(m.QueriesPerMinute))
+}
+func (m *EventPurgeExpiredContracts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPurgeExpiredContracts.Merge(m, src)
+}
+func (m *EventPurgeExpiredContracts) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventPurgeExpiredContracts) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPurgeExpiredContracts.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventPurgeExpiredContracts proto.InternalMessageInfo
+
+func (m *EventPurgeExpiredContracts) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return n
+	return ""
 }
 
-func (m *EventSettleContract) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventPurgeExpiredContracts) GetResults() []ContractPurgeResult {
+	if m != nil {
+		return m.Results
 	}
-	var l int
-	_ = l
-	l = len(m.Provider)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	return nil
+}
+
+// EventProviderDemoted is emitted as the bond of an online provider is left
+// below the minimum of its service, setting it offline
+type EventProviderDemoted struct {
+	Provider github_com_arkeonetwork_arkeo_common.PubKey `protobuf:"bytes,1,opt,name=provider,proto3,casttype=github.com/arkeonetwork/arkeo/common.PubKey" json:"provider,omitempty"`
+	Service  string                                      `protobuf:"bytes,2,opt,name=service,proto3" json:"service,omitempty"`
+	Bond     cosmossdk_io_math.Int                       `protobuf:"bytes,3,opt,name=bond,proto3,customtype=cosmossdk.io/math.Int" json:"bond"`
+	MinBond  cosmossdk_io_math.Int                       `protobuf:"bytes,4,opt,name=min_bond,json=minBond,proto3,customtype=cosmossdk.io/math.Int" json:"min_bond"`
+}
+
+func (m *EventProviderDemoted) Reset()         { *m = EventProviderDemoted{} }
+func (m *EventProviderDemoted) String() string { return proto.CompactTextString(m) }
+func (*EventProviderDemoted) ProtoMessage()    {}
+func (*EventProviderDemoted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_39b4417094f69f41, []int{17}
+}
+func (m *EventProviderDemoted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventProviderDemoted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventProviderDemoted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.ContractId != 0 {
-		n += 1 + sovEvents(uint64(m.ContractId))
+}
+func (m *EventProviderDemoted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventProviderDemoted.Merge(m, src)
+}
+func (m *EventProviderDemoted) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventProviderDemoted) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventProviderDemoted.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventProviderDemoted proto.InternalMessageInfo
+
+func (m *EventProviderDemoted) GetProvider() github_com_arkeonetwork_arkeo_common.PubKey {
+	if m != nil {
+		return m.Provider
 	}
-	l = len(m.Service)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	return nil
+}
+
+func (m *EventProviderDemoted) GetService() string {
+	if m != nil {
+		return m.Service
 	}
-	l = len(m.Client)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	return ""
+}
+
+// EventContractActivated is emitted by the end blocker as a scheduled contract
+// reaches its start height, its duration running from then on
+type EventContractActivated struct {
+	ContractId  uint64                                      `protobuf:"varint,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	Provider    github_com_arkeonetwork_arkeo_common.PubKey `protobuf:"bytes,2,opt,name=provider,proto3,casttype=github.com/arkeonetwork/arkeo/common.PubKey" json:"provider,omitempty"`
+	Service     string                                      `protobuf:"bytes,3,opt,name=service,proto3" json:"service,omitempty"`
+	Client      github_com_arkeonetwork_arkeo_common.PubKey `protobuf:"bytes,4,opt,name=client,proto3,casttype=github.com/arkeonetwork/arkeo/common.PubKey" json:"client,omitempty"`
+	Type        ContractType                                `protobuf:"varint,5,opt,name=type,proto3,enum=arkeo.arkeo.ContractType" json:"type,omitempty"`
+	StartHeight int64                                       `protobuf:"varint,6,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	Expiration  int64                                       `protobuf:"varint,7,opt,name=expiration,proto3" json:"expiration,omitempty"`
+}
+
+func (m *EventContractActivated) Reset()         { *m = EventContractActivated{} }
+func (m *EventContractActivated) String() string { return proto.CompactTextString(m) }
+func (*EventContractActivated) ProtoMessage()    {}
+func (*EventContractActivated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_39b4417094f69f41, []int{18}
+}
+func (m *EventContractActivated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventContractActivated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventContractActivated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.Delegate)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+}
+func (m *EventContractActivated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventContractActivated.Merge(m, src)
+}
+func (m *EventContractActivated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventContractActivated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventContractActivated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventContractActivated proto.InternalMessageInfo
+
+func (m *EventContractActivated) GetContractId() uint64 {
+	if m != nil {
+		return m.ContractId
 	}
-	if m.Type != 0 {
-		n += 1 + sovEvents(uint64(m.Type))
+	return 0
+}
+
+func (m *EventContractActivated) GetProvider() github_com_arkeonetwork_arkeo_common.PubKey {
+	if m != nil {
+		return m.Provider
 	}
-	if m.Nonce != 0 {
-		n += 1 + sovEvents(uint64(m.Nonce))
+	return nil
+}
+
+func (m *EventContractActivated) GetService() string {
+	if m != nil {
+		return m.Service
 	}
-	if m.Height != 0 {
-		n += 1 + sovEvents(uint64(m.Height))
+	return ""
+}
+
+func (m *EventContractActivated) GetClient() github_com_arkeonetwork_arkeo_common.PubKey {
+	if m != nil {
+		return m.Client
 	}
-	l = m.Paid.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	l = m.Reserve.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	return n
+	return nil
 }
 
-func (m *EventCloseContract) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventContractActivated) GetType() ContractType {
+	if m != nil {
+		return m.Type
+	}
+	return ContractType_SUBSCRIPTION
+}
+
+func (m *EventContractActivated) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *EventContractActivated) GetExpiration() int64 {
+	if m != nil {
+		return m.Expiration
+	}
+	return 0
+}
+
+// EventSetProviderAllowlist is emitted as a provider updates the clients
+// allowed to open contracts with it, carrying the allowlist it is left with
+type EventSetProviderAllowlist struct {
+	Provider github_com_arkeonetwork_arkeo_common.PubKey   `protobuf:"bytes,1,opt,name=provider,proto3,casttype=github.com/arkeonetwork/arkeo/common.PubKey" json:"provider,omitempty"`
+	Service  string                                        `protobuf:"bytes,2,opt,name=service,proto3" json:"service,omitempty"`
+	Action   AllowlistAction                               `protobuf:"varint,3,opt,name=action,proto3,enum=arkeo.arkeo.AllowlistAction" json:"action,omitempty"`
+	Clients  []github_com_arkeonetwork_arkeo_common.PubKey `protobuf:"bytes,4,rep,name=clients,proto3,casttype=github.com/arkeonetwork/arkeo/common.PubKey" json:"clients,omitempty"`
+}
+
+func (m *EventSetProviderAllowlist) Reset()         { *m = EventSetProviderAllowlist{} }
+func (m *EventSetProviderAllowlist) String() string { return proto.CompactTextString(m) }
+func (*EventSetProviderAllowlist) ProtoMessage()    {}
+func (*EventSetProviderAllowlist) Descriptor() ([]byte, []int) {
+	return fileDescriptor_39b4417094f69f41, []int{19}
+}
+func (m *EventSetProviderAllowlist) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSetProviderAllowlist) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSetProviderAllowlist.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventSetProviderAllowlist) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSetProviderAllowlist.Merge(m, src)
+}
+func (m *EventSetProviderAllowlist) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSetProviderAllowlist) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSetProviderAllowlist.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSetProviderAllowlist proto.InternalMessageInfo
+
+func (m *EventSetProviderAllowlist) GetProvider() github_com_arkeonetwork_arkeo_common.PubKey {
+	if m != nil {
+		return m.Provider
+	}
+	return nil
+}
+
+func (m *EventSetProviderAllowlist) GetService() string {
+	if m != nil {
+		return m.Service
+	}
+	return ""
+}
+
+func (m *EventSetProviderAllowlist) GetAction() AllowlistAction {
+	if m != nil {
+		return m.Action
+	}
+	return AllowlistAction_ALLOWLIST_REPLACE
+}
+
+func (m *EventSetProviderAllowlist) GetClients() []github_com_arkeonetwork_arkeo_common.PubKey {
+	if m != nil {
+		return m.Clients
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*EventBondProvider)(nil), "arkeo.arkeo.EventBondProvider")
+	proto.RegisterType((*EventModProvider)(nil), "arkeo.arkeo.EventModProvider")
+	proto.RegisterType((*EventOpenContract)(nil), "arkeo.arkeo.EventOpenContract")
+	proto.RegisterType((*EventSettleContract)(nil), "arkeo.arkeo.EventSettleContract")
+	proto.RegisterType((*EventCloseContract)(nil), "arkeo.arkeo.EventCloseContract")
+	proto.RegisterType((*EventRenewContract)(nil), "arkeo.arkeo.EventRenewContract")
+	proto.RegisterType((*EventRotateDelegate)(nil), "arkeo.arkeo.EventRotateDelegate")
+	proto.RegisterType((*EventSetAutoRenew)(nil), "arkeo.arkeo.EventSetAutoRenew")
+	proto.RegisterType((*EventTopUpContract)(nil), "arkeo.arkeo.EventTopUpContract")
+	proto.RegisterType((*EventSlashProvider)(nil), "arkeo.arkeo.EventSlashProvider")
+	proto.RegisterType((*EventValidatorPayout)(nil), "arkeo.arkeo.EventValidatorPayout")
+	proto.RegisterType((*ParamChange)(nil), "arkeo.arkeo.ParamChange")
+	proto.RegisterType((*EventParamsUpdated)(nil), "arkeo.arkeo.EventParamsUpdated")
+	proto.RegisterType((*EventContractExpired)(nil), "arkeo.arkeo.EventContractExpired")
+	proto.RegisterType((*EventContractAutoRenewed)(nil), "arkeo.arkeo.EventContractAutoRenewed")
+	proto.RegisterType((*EventClaimContractIncomeBatch)(nil), "arkeo.arkeo.EventClaimContractIncomeBatch")
+	proto.RegisterType((*EventPurgeExpiredContracts)(nil), "arkeo.arkeo.EventPurgeExpiredContracts")
+	proto.RegisterType((*EventProviderDemoted)(nil), "arkeo.arkeo.EventProviderDemoted")
+	proto.RegisterType((*EventContractActivated)(nil), "arkeo.arkeo.EventContractActivated")
+	proto.RegisterType((*EventSetProviderAllowlist)(nil), "arkeo.arkeo.EventSetProviderAllowlist")
+}
+
+func init() { proto.RegisterFile("arkeo/arkeo/events.proto", fileDescriptor_39b4417094f69f41) }
+
+var fileDescriptor_39b4417094f69f41 = []byte{
+	// 1822 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0x4b, 0x6f, 0xdc, 0xc8,
+	0x11, 0xf6, 0x68, 0xde, 0x35, 0x1a, 0xad, 0x4c, 0x3f, 0x42, 0x7b, 0xb3, 0x92, 0x42, 0xc0, 0x80,
+	0x80, 0x8d, 0x66, 0x60, 0x3b, 0x08, 0x72, 0xdb, 0x8c, 0x64, 0xd9, 0x2b, 0x78, 0x37, 0x16, 0xe8,
+	0xf5, 0x02, 0x9b, 0x0b, 0xd1, 0x43, 0x96, 0x47, 0x84, 0xc8, 0x6e, 0x86, 0xdd, 0x94, 0x34, 0xc9,
+	0x31, 0x48, 0x0e, 0x39, 0x24, 0xf9, 0x1b, 0x01, 0x72, 0xcc, 0x35, 0xb7, 0x1c, 0x7c, 0x5c, 0x6c,
+	0x2e, 0x41, 0x02, 0x18, 0x81, 0x8d, 0xfc, 0x80, 0x5c, 0x17, 0x08, 0x10, 0xf4, 0x83, 0x1c, 0x8e,
+	0x24, 0x24, 0x1e, 0xfa, 0x81, 0x85, 0xb0, 0x17, 0xcd, 0x74, 0x55, 0x57, 0x4d, 0x77, 0xf5, 0x57,
+	0x5f, 0x35, 0x8b, 0x02, 0x9b, 0xa4, 0x87, 0xc8, 0x86, 0xfa, 0x2f, 0x1e, 0x21, 0x15, 0x7c, 0x90,
+	0xa4, 0x4c, 0x30, 0xab, 0xa7, 0x64, 0x03, 0xf5, 0xf7, 0xe6, 0xd5, 0x09, 0x9b, 0x30, 0x25, 0x1f,
+	0xca, 0x6f, 0x7a, 0xca, 0xcd, 0x1b, 0x3e, 0xe3, 0x31, 0xe3, 0x9e, 0x56, 0xe8, 0x81, 0x51, 0xad,
+	0xe9, 0xd1, 0x70, 0x4c, 0x38, 0x0e, 0x8f, 0x6e, 0x8f, 0x51, 0x90, 0xdb, 0x43, 0x9f, 0x85, 0xd4,
+	0xe8, 0xe7, 0x7e, 0xf7, 0x10, 0x31, 0xc1, 0x54, 0x6b, 0x9c, 0x3f, 0x2c, 0xc1, 0xe5, 0x5d, 0xb9,
+	0x90, 0x6d, 0x46, 0x83, 0xfd, 0x94, 0x1d, 0x85, 0x01, 0xa6, 0xd6, 0x43, 0xe8, 0x24, 0xe6, 0xbb,
+	0x5d, 0xdb, 0xa8, 0x6d, 0x2e, 0x6f, 0x0f, 0xbf, 0x7e, 0xbe, 0xfe, 0xe1, 0x24, 0x14, 0x07, 0xd9,
+	0x78, 0xe0, 0xb3, 0x58, 0xbb, 0xa2, 0x28, 0x8e, 0x59, 0x7a, 0x68, 0xfc, 0xfa, 0x2c, 0x8e, 0x19,
+	0x1d, 0xec, 0x67, 0xe3, 0x87, 0x38, 0x75, 0x0b, 0x07, 0x96, 0x0d, 0x6d, 0x8e, 0xe9, 0x51, 0xe8,
+	0xa3, 0xbd, 0xb4, 0x51, 0xdb, 0xec, 0xba, 0xf9, 0xd0, 0xba, 0x0f, 0x9d, 0x31, 0xa3, 0x81, 0x97,
+	0x62, 0x64, 0xd7, 0xa5, 0x6a, 0xfb, 0xc3, 0x67, 0xcf, 0xd7, 0x2f, 0xfd, 0xfd, 0xf9, 0xfa, 0x35,
+	0xbd, 0x21, 0x1e, 0x1c, 0x0e, 0x42, 0x36, 0x8c, 0x89, 0x38, 0x18, 0xec, 0x51, 0xf1, 0xd5, 0x9f,
+	0xb6, 0xc0, 0xec, 0x7b, 0x8f, 0x0a, 0xb7, 0x2d, 0x8d, 0x5d, 0x8c, 0x0a, 0x3f, 0x64, 0xcc, 0xed,
+	0x46, 0x45, 0x3f, 0xa3, 0x31, 0xb7, 0x3e, 0x00, 0x50, 0x7e, 0x02, 0xa4, 0x2c, 0xb6, 0x9b, 0x6a,
+	0xb1, 0x5d, 0x29, 0xb9, 0x27, 0x05, 0xce, 0xef, 0x5a, 0xb0, 0xaa, 0x62, 0xf5, 0x29, 0x2b, 0x87,
+	0xaa, 0xed, 0xa7, 0x48, 0x04, 0xcb, 0x23, 0x75, 0xfb, 0xeb, 0xe7, 0xeb, 0x5b, 0xa5, 0x48, 0x99,
+	0xa3, 0xd1, 0x1f, 0x5b, 0x3c, 0x38, 0x1c, 0x8a, 0x69, 0x82, 0x7c, 0x30, 0xf2, 0xfd, 0x51, 0x10,
+	0xa4, 0xc8, 0xb9, 0x9b, 0x7b, 0x98, 0x8b, 0xfb, 0xd2, 0x1b, 0x8c, 0x7b, 0x7d, 0x3e, 0xee, 0xdf,
+	0x83, 0xe5, 0x18, 0x05, 0x09, 0x88, 0x20, 0x5e, 0x96, 0x86, 0x3a, 0x66, 0x6e, 0x2f, 0x97, 0x3d,
+	0x49, 0x43, 0xeb, 0x16, 0xac, 0x14, 0x53, 0x28, 0xa3, 0x3e, 0xaa, 0x70, 0x34, 0xdc, 0x7e, 0x2e,
+	0xfd, 0x89, 0x14, 0x5a, 0x77, 0xa1, 0xc5, 0x05, 0x11, 0x19, 0xb7, 0x5b, 0x1b, 0xb5, 0xcd, 0x95,
+	0x3b, 0xef, 0x0f, 0x4a, 0x38, 0x1e, 0xe4, 0x41, 0x7a, 0xac, 0xa6, 0xb8, 0x66, 0xaa, 0x75, 0x07,
+	0xae, 0xc5, 0x21, 0xf5, 0x7c, 0x46, 0x45, 0x4a, 0x7c, 0xe1, 0x05, 0x59, 0x4a, 0x44, 0xc8, 0xa8,
+	0xdd, 0xde, 0xa8, 0x6d, 0xd6, 0xdd, 0x2b, 0x71, 0x48, 0x77, 0x8c, 0xee, 0x9e, 0x51, 0x29, 0x1b,
+	0x72, 0x72, 0x8e, 0x4d, 0xc7, 0xd8, 0x90, 0x93, 0x33, 0x36, 0x9f, 0xc0, 0x65, 0x9e, 0x8d, 0xb9,
+	0x9f, 0x86, 0x89, 0x1c, 0x7b, 0x29, 0x11, 0x68, 0x77, 0x37, 0xea, 0x9b, 0xbd, 0x3b, 0x37, 0x06,
+	0xe6, 0xfc, 0x65, 0xc6, 0x0c, 0x4c, 0xc6, 0x0c, 0x76, 0x58, 0x48, 0xb7, 0x1b, 0x12, 0x3a, 0xee,
+	0x6a, 0xd9, 0xd2, 0x25, 0x02, 0xad, 0x87, 0x60, 0x25, 0x64, 0xea, 0x11, 0xee, 0x4d, 0x59, 0xe6,
+	0x4d, 0x98, 0x76, 0x07, 0xaf, 0xe6, 0x6e, 0x25, 0x21, 0xd3, 0x11, 0xff, 0x82, 0x65, 0x0f, 0x98,
+	0x72, 0xf6, 0x11, 0x34, 0x24, 0xae, 0xec, 0xde, 0xe2, 0x68, 0x55, 0x86, 0xd6, 0x10, 0xae, 0x70,
+	0x14, 0x22, 0xc2, 0x18, 0x69, 0x29, 0x1a, 0xcb, 0x2a, 0x1a, 0xd6, 0x4c, 0x55, 0x04, 0xe3, 0x16,
+	0xac, 0x64, 0x49, 0x40, 0x04, 0x06, 0xde, 0xd3, 0x10, 0xa3, 0x80, 0xdb, 0xfd, 0x8d, 0xfa, 0x66,
+	0xd7, 0xed, 0x1b, 0xe9, 0x7d, 0x25, 0xb4, 0xbe, 0x0f, 0x96, 0x8c, 0x33, 0x4b, 0x70, 0x76, 0x40,
+	0xdc, 0x5e, 0x51, 0x67, 0xbf, 0x1a, 0x93, 0x93, 0x47, 0x09, 0x16, 0x87, 0xc3, 0x9d, 0xbf, 0xb6,
+	0x0d, 0x7b, 0x94, 0xc5, 0x6f, 0x96, 0x3d, 0xd6, 0xa1, 0x57, 0x1c, 0x7a, 0x18, 0xa8, 0xac, 0x68,
+	0xb8, 0x90, 0x8b, 0xf6, 0x82, 0xff, 0x01, 0xf3, 0x07, 0xd0, 0xf2, 0xa3, 0x10, 0xa9, 0xb0, 0x1b,
+	0xd5, 0x56, 0x61, 0xcc, 0xe5, 0x86, 0x02, 0x8c, 0x70, 0x42, 0x84, 0x4e, 0x83, 0x2a, 0x1b, 0xca,
+	0x1d, 0x58, 0x5b, 0xd0, 0x90, 0x04, 0x60, 0x12, 0xe6, 0xc6, 0x5c, 0xc2, 0xe4, 0x21, 0xfc, 0x6c,
+	0x9a, 0xa0, 0xab, 0xa6, 0x59, 0xd7, 0xa1, 0x75, 0x80, 0xe1, 0xe4, 0x40, 0x98, 0xec, 0x30, 0x23,
+	0xeb, 0x26, 0x74, 0x4e, 0xe5, 0x40, 0x31, 0xb6, 0xee, 0x42, 0xc3, 0x60, 0xbd, 0xf6, 0x2a, 0xe0,
+	0x54, 0x93, 0xad, 0xf7, 0xa1, 0x6b, 0x4e, 0x9d, 0x0b, 0x1b, 0xb4, 0x47, 0xa6, 0x8e, 0x95, 0x0b,
+	0x6b, 0x17, 0xda, 0x01, 0x26, 0x8c, 0x87, 0xa2, 0x0a, 0x64, 0x73, 0xdb, 0xc5, 0x51, 0xfb, 0x31,
+	0xf4, 0x49, 0x26, 0x0e, 0x58, 0x1a, 0xfe, 0x5c, 0x4f, 0xed, 0xab, 0xa8, 0x39, 0xe7, 0x46, 0x6d,
+	0x54, 0x9e, 0xe9, 0xce, 0x1b, 0x4a, 0x60, 0xff, 0x2c, 0xc3, 0x34, 0x44, 0xee, 0x25, 0x98, 0x7a,
+	0x71, 0x48, 0x33, 0x81, 0x0a, 0xd8, 0x75, 0x77, 0xd5, 0x68, 0xf6, 0x31, 0xfd, 0x54, 0xc9, 0xad,
+	0x1f, 0xc2, 0x77, 0x4a, 0x0b, 0x9d, 0xa4, 0xc4, 0x47, 0x69, 0x16, 0xb2, 0xc0, 0x7e, 0x4f, 0x99,
+	0x5c, 0x9b, 0xa9, 0x1f, 0x48, 0xed, 0xbe, 0x52, 0xca, 0x0a, 0x42, 0x32, 0xc1, 0xbc, 0x14, 0x29,
+	0x1e, 0xdb, 0xab, 0x1b, 0xb5, 0xcd, 0x8e, 0xdb, 0x95, 0x12, 0x57, 0x0a, 0xa4, 0x5a, 0xc6, 0xda,
+	0x14, 0x98, 0xcb, 0xba, 0xc0, 0x48, 0x89, 0x2a, 0x30, 0xd6, 0x27, 0xd0, 0x53, 0x6a, 0x12, 0xb3,
+	0x8c, 0x0a, 0xdb, 0x5a, 0x3c, 0xd2, 0xca, 0xfd, 0x48, 0x99, 0x5b, 0x57, 0xa1, 0x99, 0x90, 0x29,
+	0xa6, 0xf6, 0x15, 0xf5, 0x3b, 0x7a, 0x20, 0xb9, 0x9f, 0x0b, 0x92, 0x0a, 0xcf, 0xa0, 0xea, 0xaa,
+	0xda, 0x4e, 0x4f, 0xc9, 0x3e, 0x56, 0x22, 0xe7, 0x2f, 0x2d, 0xb8, 0xa2, 0xb2, 0xfa, 0xb1, 0xda,
+	0xe3, 0xb7, 0x79, 0xfd, 0x36, 0xf2, 0xfa, 0x2a, 0x34, 0x75, 0x5d, 0xd5, 0x69, 0xad, 0x07, 0xa5,
+	0x6c, 0xef, 0xcc, 0x65, 0xfb, 0x47, 0xd0, 0x48, 0x48, 0x18, 0xd8, 0xdd, 0xc5, 0x21, 0xa1, 0x0c,
+	0x65, 0x02, 0xa7, 0x28, 0x03, 0x88, 0x36, 0x2c, 0xee, 0x23, 0xb7, 0xb5, 0x76, 0xa0, 0x95, 0x51,
+	0xb5, 0x92, 0x0a, 0x34, 0x60, 0x4c, 0xe5, 0xd6, 0x75, 0x02, 0x2c, 0x6b, 0x60, 0xaa, 0x81, 0xf5,
+	0x00, 0x3a, 0x29, 0x3e, 0xcd, 0x68, 0x80, 0x81, 0xdd, 0x5f, 0xdc, 0x79, 0x61, 0x2c, 0xb7, 0xea,
+	0x47, 0x24, 0x8c, 0x31, 0xb0, 0x57, 0x16, 0xf7, 0x93, 0xdb, 0xca, 0x90, 0x07, 0x19, 0x17, 0xf6,
+	0x7b, 0x8b, 0xfb, 0x50, 0x86, 0xce, 0xbf, 0x1b, 0x60, 0xa9, 0x34, 0xda, 0x89, 0x18, 0x9f, 0x65,
+	0xd1, 0x29, 0xe0, 0xd7, 0xce, 0x00, 0xff, 0x1d, 0x5d, 0x02, 0xbf, 0x99, 0x59, 0xb4, 0x0e, 0xbd,
+	0xf1, 0xd4, 0x2b, 0xf6, 0xdf, 0x52, 0x0c, 0x0a, 0xe3, 0x69, 0x71, 0xdf, 0xde, 0x85, 0x76, 0x82,
+	0x94, 0x44, 0x62, 0x6a, 0xb7, 0x17, 0x3f, 0x99, 0xdc, 0xd6, 0x7a, 0x04, 0xcb, 0x3e, 0x8b, 0x13,
+	0xa4, 0x7c, 0x56, 0x42, 0x17, 0xf4, 0x35, 0xe7, 0x40, 0x66, 0x86, 0x46, 0x60, 0x95, 0x1c, 0x35,
+	0xa6, 0x05, 0xe6, 0xa0, 0x2a, 0xe6, 0xfe, 0x93, 0x63, 0x4e, 0xd5, 0x9b, 0x6f, 0x99, 0xfb, 0x6d,
+	0x30, 0xf7, 0x2d, 0x58, 0x61, 0x51, 0xe0, 0xe1, 0x49, 0x12, 0xce, 0x3d, 0xb7, 0xf4, 0x59, 0x14,
+	0xec, 0x16, 0x42, 0x39, 0x8d, 0xe2, 0x71, 0x79, 0x9a, 0xa6, 0xf4, 0x3e, 0xc5, 0xe3, 0xd2, 0xb4,
+	0x4a, 0x77, 0xb5, 0x7d, 0xe8, 0xe3, 0x89, 0x48, 0x89, 0x97, 0x5f, 0xca, 0x2a, 0x00, 0x66, 0x59,
+	0x79, 0xb8, 0x67, 0x6e, 0x66, 0x6f, 0xe6, 0x82, 0xe7, 0xfc, 0xb6, 0x6e, 0xae, 0x0e, 0x2e, 0x13,
+	0xea, 0x5a, 0x63, 0x42, 0x7c, 0xe1, 0x00, 0xe8, 0xc2, 0xb2, 0x04, 0xc1, 0xeb, 0x82, 0xb0, 0xc7,
+	0xa2, 0xa0, 0x08, 0x92, 0x0b, 0xcb, 0x12, 0x31, 0x85, 0xcf, 0x56, 0x45, 0x9f, 0x14, 0x8f, 0x73,
+	0x9f, 0xce, 0xb3, 0xbc, 0xbf, 0xf3, 0x18, 0xc5, 0xa8, 0xb8, 0x87, 0x5e, 0xb8, 0xe3, 0x98, 0xbf,
+	0x77, 0x37, 0x4f, 0xdf, 0xbb, 0x67, 0xe4, 0xdc, 0xaa, 0x4c, 0xce, 0xce, 0xaf, 0x72, 0x6e, 0xfd,
+	0x8c, 0x25, 0x4f, 0x92, 0x8b, 0xcb, 0xad, 0x67, 0xf9, 0xad, 0xf9, 0x6a, 0xfc, 0xd6, 0x3a, 0x8f,
+	0xdf, 0xb6, 0xa1, 0x25, 0x58, 0xe2, 0x65, 0x49, 0x95, 0x72, 0xdd, 0x14, 0x32, 0xd4, 0x65, 0x72,
+	0xea, 0xbc, 0xc6, 0xd3, 0xe7, 0x2e, 0xb4, 0xc7, 0x24, 0x22, 0xd4, 0xd7, 0x6c, 0xbb, 0xa8, 0x1b,
+	0x63, 0xeb, 0x7c, 0xb5, 0x64, 0x70, 0xf0, 0x38, 0x22, 0xfc, 0xe0, 0x5d, 0xf7, 0x4c, 0x4f, 0x21,
+	0xa4, 0x7e, 0x06, 0x21, 0xd7, 0x25, 0xd6, 0x09, 0x67, 0xd4, 0xb4, 0xf5, 0xcc, 0x48, 0xe6, 0x80,
+	0x79, 0xae, 0x6c, 0x56, 0xc8, 0x01, 0x6d, 0x5a, 0xf4, 0xad, 0x5a, 0x55, 0xfb, 0x56, 0xd7, 0xa1,
+	0xf5, 0x94, 0x64, 0x91, 0xe0, 0x79, 0x3b, 0x43, 0x8f, 0x9c, 0x3f, 0xd6, 0xe0, 0xaa, 0x0a, 0xea,
+	0xe7, 0x24, 0x0a, 0x03, 0x22, 0x58, 0xba, 0x4f, 0xa6, 0x2c, 0x13, 0xd6, 0x23, 0xe8, 0x1e, 0xe5,
+	0xa2, 0xea, 0x1d, 0xd6, 0x99, 0x0f, 0xcd, 0x05, 0xc7, 0x24, 0xd5, 0xd9, 0xb5, 0x38, 0x17, 0x48,
+	0x53, 0xe7, 0x0b, 0xe8, 0xed, 0x93, 0x94, 0xc4, 0x3b, 0x07, 0x84, 0x4e, 0xd0, 0x5a, 0x85, 0xfa,
+	0x21, 0x4e, 0xd5, 0xf2, 0xba, 0xae, 0xfc, 0xaa, 0xba, 0x29, 0x51, 0xe0, 0x1d, 0x91, 0x28, 0xcb,
+	0x8f, 0xb0, 0xc3, 0xa2, 0xe0, 0x73, 0x39, 0x96, 0x4a, 0x99, 0x3a, 0x5a, 0xa9, 0xd3, 0xb8, 0x43,
+	0xf1, 0x58, 0x29, 0x9d, 0xa7, 0x06, 0x5d, 0xca, 0x3f, 0x7f, 0xa2, 0xbb, 0x73, 0xa5, 0x07, 0xc3,
+	0xda, 0xdc, 0x83, 0xe1, 0x8f, 0xa0, 0xed, 0xab, 0x35, 0x70, 0x7b, 0x49, 0xb5, 0x22, 0xed, 0xf9,
+	0x0e, 0xec, 0x6c, 0x91, 0xe6, 0x02, 0x91, 0x4f, 0x77, 0xfe, 0xb5, 0x64, 0x22, 0x9e, 0x33, 0x99,
+	0x4a, 0x5a, 0x0c, 0x2e, 0xde, 0x03, 0x4a, 0x7e, 0xbf, 0x6b, 0xbe, 0xda, 0xfd, 0x6e, 0x0d, 0xe0,
+	0x0c, 0xa9, 0x95, 0x24, 0xd6, 0x16, 0x94, 0x3a, 0x55, 0x5e, 0x82, 0x34, 0x08, 0xe9, 0x44, 0xc1,
+	0xb9, 0xe3, 0x5e, 0x9e, 0x69, 0xf6, 0xb5, 0xc2, 0xf9, 0x4d, 0x03, 0xec, 0xb9, 0x38, 0x17, 0x65,
+	0xf8, 0x22, 0xc6, 0xfa, 0xcd, 0x16, 0x8f, 0x11, 0x34, 0x79, 0x22, 0x57, 0x55, 0xa5, 0x76, 0x28,
+	0xcb, 0x6f, 0x58, 0xed, 0xf8, 0x05, 0x7c, 0x60, 0x5a, 0x02, 0x24, 0x8c, 0x73, 0x40, 0xec, 0x51,
+	0x9f, 0xc5, 0xb8, 0x4d, 0x84, 0x7f, 0x20, 0x8f, 0xa8, 0xfc, 0x3a, 0xa9, 0x3b, 0x7b, 0x37, 0xf4,
+	0x63, 0xd5, 0xc1, 0x51, 0xd4, 0xa9, 0x33, 0x7d, 0xe3, 0x5c, 0x20, 0x2b, 0xcf, 0xae, 0x9a, 0x98,
+	0x67, 0xbc, 0x31, 0x73, 0xfe, 0x5c, 0x83, 0x9b, 0x9a, 0x5a, 0xb2, 0x74, 0x82, 0x26, 0xdd, 0x73,
+	0x3b, 0xfe, 0xfa, 0x3f, 0xad, 0xdc, 0x9e, 0xfb, 0xd3, 0x25, 0xd2, 0xad, 0x57, 0x27, 0xdd, 0x5f,
+	0xe7, 0x8c, 0x95, 0xd7, 0xdc, 0x7b, 0x18, 0x33, 0x81, 0xf3, 0x49, 0xf2, 0x16, 0x4b, 0x6f, 0x5e,
+	0xfc, 0xea, 0x55, 0x8b, 0xdf, 0x7d, 0xe8, 0xc8, 0x17, 0x5f, 0xca, 0x49, 0x95, 0xf7, 0x94, 0x71,
+	0x48, 0xe5, 0x6b, 0x5a, 0xe7, 0x1f, 0x4b, 0x70, 0x7d, 0x9e, 0x52, 0x7c, 0x11, 0x1e, 0x11, 0x71,
+	0x11, 0x09, 0x65, 0x41, 0xf2, 0x3e, 0xdd, 0xde, 0x6e, 0x9d, 0x69, 0x6f, 0x9f, 0xe2, 0xf7, 0xf6,
+	0x69, 0x7e, 0x77, 0x7e, 0xb9, 0x04, 0x37, 0xf2, 0x47, 0xa6, 0x1c, 0x69, 0xa3, 0x28, 0x62, 0xc7,
+	0x51, 0xc8, 0xc5, 0xbb, 0xc2, 0xda, 0x0f, 0xa0, 0x45, 0x7c, 0xb5, 0xc0, 0xba, 0xda, 0xf8, 0x77,
+	0xe7, 0x36, 0x5e, 0x2c, 0x67, 0xa4, 0xe6, 0xb8, 0x66, 0xae, 0xb5, 0x07, 0x6d, 0x1d, 0x36, 0xf9,
+	0x1e, 0xbc, 0x5e, 0x65, 0x6d, 0xb9, 0xfd, 0xf6, 0xee, 0xb3, 0x17, 0x6b, 0xb5, 0x2f, 0x5f, 0xac,
+	0xd5, 0xfe, 0xf9, 0x62, 0xad, 0xf6, 0xfb, 0x97, 0x6b, 0x97, 0xbe, 0x7c, 0xb9, 0x76, 0xe9, 0x6f,
+	0x2f, 0xd7, 0x2e, 0xfd, 0xf4, 0xff, 0xf8, 0x3b, 0x31, 0x9f, 0xea, 0x0e, 0x36, 0x6e, 0xa9, 0x7f,
+	0x33, 0xb8, 0xfb, 0xdf, 0x01, 0x00, 0xf1, 0xb1, 0x39, 0x9a, 0xfa, 0x20, 0x00, 0x00,
+}
+
+func (m *EventBondProvider) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventBondProvider) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventBondProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ContractId != 0 {
-		n += 1 + sovEvents(uint64(m.ContractId))
+	if len(m.BondDenom) > 0 {
+		i -= len(m.BondDenom)
+		copy(dAtA[i:], m.BondDenom)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.BondDenom)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.Provider)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	{
+		size := m.BondAbs.Size()
+		i -= size
+		if _, err := m.BondAbs.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
-	l = len(m.Service)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.BondRel.Size()
+		i -= size
+		if _, err := m.BondRel.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
-	l = len(m.Client)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Service) > 0 {
+		i -= len(m.Service)
+		copy(dAtA[i:], m.Service)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Service)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Delegate)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventModProvider) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventModProvider) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventModProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxOpenContracts != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MaxOpenContracts))
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.UpdatedFields) > 0 {
+		for iNdEx := len(m.UpdatedFields) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.UpdatedFields[iNdEx])
+			copy(dAtA[i:], m.UpdatedFields[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.UpdatedFields[iNdEx])))
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if m.SettlementDuration != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.SettlementDuration))
+		i--
+		dAtA[i] = 0x60
+	}
+	{
+		size := m.Bond.Size()
+		i -= size
+		if _, err := m.Bond.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if len(m.PayAsYouGoRate) > 0 {
+		for iNdEx := len(m.PayAsYouGoRate) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PayAsYouGoRate[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.SubscriptionRate) > 0 {
+		for iNdEx := len(m.SubscriptionRate) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SubscriptionRate[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.MaxContractDuration != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MaxContractDuration))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.MinContractDuration != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MinContractDuration))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Status != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MetadataNonce != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MetadataNonce))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.MetadataUri) > 0 {
+		i -= len(m.MetadataUri)
+		copy(dAtA[i:], m.MetadataUri)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MetadataUri)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Service) > 0 {
+		i -= len(m.Service)
+		copy(dAtA[i:], m.Service)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Service)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventOpenContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventOpenContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventOpenContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StartHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if len(m.Payer) > 0 {
+		i -= len(m.Payer)
+		copy(dAtA[i:], m.Payer)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Payer)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	{
+		size := m.RateAmount.Size()
+		i -= size
+		if _, err := m.RateAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x92
+	if len(m.RateDenom) > 0 {
+		i -= len(m.RateDenom)
+		copy(dAtA[i:], m.RateDenom)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RateDenom)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.AutoRenew {
+		i--
+		if m.AutoRenew {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.SettlementGracePeriod != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.SettlementGracePeriod))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.QueriesPerMinute != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.QueriesPerMinute))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.Authorization != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Authorization))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.SettlementDuration != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.SettlementDuration))
+		i--
+		dAtA[i] = 0x60
+	}
+	{
+		size := m.Deposit.Size()
+		i -= size
+		if _, err := m.Deposit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if m.OpenCost != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.OpenCost))
+		i--
+		dAtA[i] = 0x50
+	}
+	{
+		size, err := m.Rate.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if m.Duration != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Duration))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Height != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Type != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Delegate) > 0 {
+		i -= len(m.Delegate)
+		copy(dAtA[i:], m.Delegate)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Delegate)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Client) > 0 {
+		i -= len(m.Client)
+		copy(dAtA[i:], m.Client)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Client)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Service) > 0 {
+		i -= len(m.Service)
+		copy(dAtA[i:], m.Service)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Service)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ContractId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ContractId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSettleContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSettleContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSettleContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Dust.Size()
+		i -= size
+		if _, err := m.Dust.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x7a
+	{
+		size := m.Claimed.Size()
+		i -= size
+		if _, err := m.Claimed.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
+	{
+		size := m.Refunded.Size()
+		i -= size
+		if _, err := m.Refunded.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x62
+	}
+	{
+		size := m.Unpaid.Size()
+		i -= size
+		if _, err := m.Unpaid.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.Reserve.Size()
+		i -= size
+		if _, err := m.Reserve.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.Paid.Size()
+		i -= size
+		if _, err := m.Paid.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if m.Height != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Type != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Delegate) > 0 {
+		i -= len(m.Delegate)
+		copy(dAtA[i:], m.Delegate)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Delegate)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Client) > 0 {
+		i -= len(m.Client)
+		copy(dAtA[i:], m.Client)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Client)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Service) > 0 {
+		i -= len(m.Service)
+		copy(dAtA[i:], m.Service)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Service)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ContractId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ContractId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventCloseContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCloseContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCloseContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Dust.Size()
+		i -= size
+		if _, err := m.Dust.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.Refund.Size()
+		i -= size
+		if _, err := m.Refund.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.Compensation.Size()
+		i -= size
+		if _, err := m.Compensation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.Penalty.Size()
+		i -= size
+		if _, err := m.Penalty.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.ByProvider {
+		i--
+		if m.ByProvider {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Delegate) > 0 {
+		i -= len(m.Delegate)
+		copy(dAtA[i:], m.Delegate)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Delegate)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Client) > 0 {
+		i -= len(m.Client)
+		copy(dAtA[i:], m.Client)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Client)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Service) > 0 {
+		i -= len(m.Service)
+		copy(dAtA[i:], m.Service)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Service)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ContractId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ContractId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventRenewContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRenewContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRenewContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Deposit.Size()
+		i -= size
+		if _, err := m.Deposit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.ExtraDeposit.Size()
+		i -= size
+		if _, err := m.ExtraDeposit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size, err := m.Rate.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if m.NewExpiration != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.NewExpiration))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.OldExpiration != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.OldExpiration))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Type != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Delegate) > 0 {
+		i -= len(m.Delegate)
+		copy(dAtA[i:], m.Delegate)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Delegate)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Client) > 0 {
+		i -= len(m.Client)
+		copy(dAtA[i:], m.Client)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Client)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Service) > 0 {
+		i -= len(m.Service)
+		copy(dAtA[i:], m.Service)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Service)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ContractId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ContractId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventRotateDelegate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRotateDelegate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRotateDelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewDelegate) > 0 {
+		i -= len(m.NewDelegate)
+		copy(dAtA[i:], m.NewDelegate)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.NewDelegate)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.OldDelegate) > 0 {
+		i -= len(m.OldDelegate)
+		copy(dAtA[i:], m.OldDelegate)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.OldDelegate)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Client) > 0 {
+		i -= len(m.Client)
+		copy(dAtA[i:], m.Client)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Client)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Service) > 0 {
+		i -= len(m.Service)
+		copy(dAtA[i:], m.Service)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Service)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ContractId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ContractId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSetAutoRenew) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSetAutoRenew) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSetAutoRenew) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Refund.Size()
+		i -= size
+		if _, err := m.Refund.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.AutoRenew {
+		i--
+		if m.AutoRenew {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Client) > 0 {
+		i -= len(m.Client)
+		copy(dAtA[i:], m.Client)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Client)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Service) > 0 {
+		i -= len(m.Service)
+		copy(dAtA[i:], m.Service)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Service)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ContractId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ContractId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventTopUpContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventTopUpContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventTopUpContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Balance.Size()
+		i -= size
+		if _, err := m.Balance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.Deposit.Size()
+		i -= size
+		if _, err := m.Deposit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.TopUp.Size()
+		i -= size
+		if _, err := m.TopUp.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.NewExpiration != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.NewExpiration))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.OldExpiration != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.OldExpiration))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Client) > 0 {
+		i -= len(m.Client)
+		copy(dAtA[i:], m.Client)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Client)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Service) > 0 {
+		i -= len(m.Service)
+		copy(dAtA[i:], m.Service)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Service)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ContractId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ContractId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSlashProvider) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSlashProvider) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSlashProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Faults != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Faults))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.Bond.Size()
+		i -= size
+		if _, err := m.Bond.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ContractId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ContractId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Service) > 0 {
+		i -= len(m.Service)
+		copy(dAtA[i:], m.Service)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Service)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventValidatorPayout) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventValidatorPayout) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventValidatorPayout) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Reward.Size()
+		i -= size
+		if _, err := m.Reward.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ParamChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParamChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParamChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewValue) > 0 {
+		i -= len(m.NewValue)
+		copy(dAtA[i:], m.NewValue)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.NewValue)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OldValue) > 0 {
+		i -= len(m.OldValue)
+		copy(dAtA[i:], m.OldValue)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.OldValue)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventParamsUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventParamsUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventParamsUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Changes) > 0 {
+		for iNdEx := len(m.Changes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Changes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Height != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventContractExpired) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventContractExpired) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventContractExpired) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SettlementPending {
+		i--
+		if m.SettlementPending {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Expiration != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Expiration))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Type != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Client) > 0 {
+		i -= len(m.Client)
+		copy(dAtA[i:], m.Client)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Client)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Service) > 0 {
+		i -= len(m.Service)
+		copy(dAtA[i:], m.Service)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Service)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ContractId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ContractId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventContractAutoRenewed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventContractAutoRenewed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventContractAutoRenewed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Balance.Size()
+		i -= size
+		if _, err := m.Balance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.Deposit.Size()
+		i -= size
+		if _, err := m.Deposit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.Spent.Size()
+		i -= size
+		if _, err := m.Spent.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.NewExpiration != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.NewExpiration))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.OldExpiration != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.OldExpiration))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Client) > 0 {
+		i -= len(m.Client)
+		copy(dAtA[i:], m.Client)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Client)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Service) > 0 {
+		i -= len(m.Service)
+		copy(dAtA[i:], m.Service)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Service)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ContractId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ContractId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventClaimContractIncomeBatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventClaimContractIncomeBatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventClaimContractIncomeBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventPurgeExpiredContracts) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPurgeExpiredContracts) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPurgeExpiredContracts) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Reward.Size()
+		i -= size
+		if _, err := m.Reward.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventProviderDemoted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventProviderDemoted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventProviderDemoted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MinBond.Size()
+		i -= size
+		if _, err := m.MinBond.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Bond.Size()
+		i -= size
+		if _, err := m.Bond.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Service) > 0 {
+		i -= len(m.Service)
+		copy(dAtA[i:], m.Service)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Service)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventContractActivated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventContractActivated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventContractActivated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Expiration != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Expiration))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Type != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Client) > 0 {
+		i -= len(m.Client)
+		copy(dAtA[i:], m.Client)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Client)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Service) > 0 {
+		i -= len(m.Service)
+		copy(dAtA[i:], m.Service)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Service)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ContractId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ContractId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSetProviderAllowlist) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSetProviderAllowlist) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSetProviderAllowlist) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Clients) > 0 {
+		for iNdEx := len(m.Clients) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Clients[iNdEx])
+			copy(dAtA[i:], m.Clients[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.Clients[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Action != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Action))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Service) > 0 {
+		i -= len(m.Service)
+		copy(dAtA[i:], m.Service)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Service)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventBondProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Service)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.BondRel.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.BondAbs.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = len(m.BondDenom)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventModProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Service)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.MetadataUri)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.MetadataNonce != 0 {
+		n += 1 + sovEvents(uint64(m.MetadataNonce))
+	}
+	if m.Status != 0 {
+		n += 1 + sovEvents(uint64(m.Status))
+	}
+	if m.MinContractDuration != 0 {
+		n += 1 + sovEvents(uint64(m.MinContractDuration))
+	}
+	if m.MaxContractDuration != 0 {
+		n += 1 + sovEvents(uint64(m.MaxContractDuration))
+	}
+	if len(m.SubscriptionRate) > 0 {
+		for _, e := range m.SubscriptionRate {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.PayAsYouGoRate) > 0 {
+		for _, e := range m.PayAsYouGoRate {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	l = m.Bond.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.SettlementDuration != 0 {
+		n += 1 + sovEvents(uint64(m.SettlementDuration))
+	}
+	if len(m.UpdatedFields) > 0 {
+		for _, s := range m.UpdatedFields {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if m.MaxOpenContracts != 0 {
+		n += 1 + sovEvents(uint64(m.MaxOpenContracts))
+	}
+	return n
+}
+
+func (m *EventOpenContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.ContractId != 0 {
+		n += 1 + sovEvents(uint64(m.ContractId))
+	}
+	l = len(m.Service)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Client)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Delegate)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Type != 0 {
+		n += 1 + sovEvents(uint64(m.Type))
+	}
+	if m.Height != 0 {
+		n += 1 + sovEvents(uint64(m.Height))
+	}
+	if m.Duration != 0 {
+		n += 1 + sovEvents(uint64(m.Duration))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.OpenCost != 0 {
+		n += 1 + sovEvents(uint64(m.OpenCost))
+	}
+	l = m.Deposit.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.SettlementDuration != 0 {
+		n += 1 + sovEvents(uint64(m.SettlementDuration))
+	}
+	if m.Authorization != 0 {
+		n += 1 + sovEvents(uint64(m.Authorization))
+	}
+	if m.QueriesPerMinute != 0 {
+		n += 1 + sovEvents(uint64(m.QueriesPerMinute))
+	}
+	if m.SettlementGracePeriod != 0 {
+		n += 1 + sovEvents(uint64(m.SettlementGracePeriod))
+	}
+	if m.AutoRenew {
+		n += 3
+	}
+	l = len(m.RateDenom)
+	if l > 0 {
+		n += 2 + l + sovEvents(uint64(l))
+	}
+	l = m.RateAmount.Size()
+	n += 2 + l + sovEvents(uint64(l))
+	l = len(m.Payer)
+	if l > 0 {
+		n += 2 + l + sovEvents(uint64(l))
+	}
+	if m.StartHeight != 0 {
+		n += 2 + sovEvents(uint64(m.StartHeight))
+	}
+	return n
+}
+
+func (m *EventSettleContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.ContractId != 0 {
+		n += 1 + sovEvents(uint64(m.ContractId))
+	}
+	l = len(m.Service)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Client)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Delegate)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Type != 0 {
+		n += 1 + sovEvents(uint64(m.Type))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovEvents(uint64(m.Nonce))
+	}
+	if m.Height != 0 {
+		n += 1 + sovEvents(uint64(m.Height))
+	}
+	l = m.Paid.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.Reserve.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.Unpaid.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Refunded.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.Claimed.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.Dust.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventCloseContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ContractId != 0 {
+		n += 1 + sovEvents(uint64(m.ContractId))
+	}
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Service)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Client)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Delegate)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.ByProvider {
+		n += 2
+	}
+	l = m.Penalty.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.Compensation.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.Refund.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.Dust.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventRenewContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.ContractId != 0 {
+		n += 1 + sovEvents(uint64(m.ContractId))
+	}
+	l = len(m.Service)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Client)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Delegate)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Type != 0 {
+		n += 1 + sovEvents(uint64(m.Type))
+	}
+	if m.OldExpiration != 0 {
+		n += 1 + sovEvents(uint64(m.OldExpiration))
+	}
+	if m.NewExpiration != 0 {
+		n += 1 + sovEvents(uint64(m.NewExpiration))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.ExtraDeposit.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.Deposit.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventRotateDelegate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.ContractId != 0 {
+		n += 1 + sovEvents(uint64(m.ContractId))
+	}
+	l = len(m.Service)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Client)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.OldDelegate)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.NewDelegate)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventSetAutoRenew) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.ContractId != 0 {
+		n += 1 + sovEvents(uint64(m.ContractId))
+	}
+	l = len(m.Service)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Client)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.AutoRenew {
+		n += 2
+	}
+	l = m.Refund.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventTopUpContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.ContractId != 0 {
+		n += 1 + sovEvents(uint64(m.ContractId))
+	}
+	l = len(m.Service)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Client)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.OldExpiration != 0 {
+		n += 1 + sovEvents(uint64(m.OldExpiration))
+	}
+	if m.NewExpiration != 0 {
+		n += 1 + sovEvents(uint64(m.NewExpiration))
+	}
+	l = m.TopUp.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.Deposit.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.Balance.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventSlashProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Service)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.ContractId != 0 {
+		n += 1 + sovEvents(uint64(m.ContractId))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.Bond.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.Faults != 0 {
+		n += 1 + sovEvents(uint64(m.Faults))
+	}
+	return n
+}
+
+func (m *EventValidatorPayout) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Reward.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *ParamChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.OldValue)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.NewValue)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventParamsUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovEvents(uint64(m.Height))
+	}
+	if len(m.Changes) > 0 {
+		for _, e := range m.Changes {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventContractExpired) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ContractId != 0 {
+		n += 1 + sovEvents(uint64(m.ContractId))
+	}
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Service)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Client)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Type != 0 {
+		n += 1 + sovEvents(uint64(m.Type))
+	}
+	if m.Expiration != 0 {
+		n += 1 + sovEvents(uint64(m.Expiration))
+	}
+	if m.SettlementPending {
+		n += 2
+	}
+	return n
+}
+
+func (m *EventContractAutoRenewed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ContractId != 0 {
+		n += 1 + sovEvents(uint64(m.ContractId))
+	}
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Service)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Client)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.OldExpiration != 0 {
+		n += 1 + sovEvents(uint64(m.OldExpiration))
+	}
+	if m.NewExpiration != 0 {
+		n += 1 + sovEvents(uint64(m.NewExpiration))
+	}
+	l = m.Spent.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.Deposit.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.Balance.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventClaimContractIncomeBatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventPurgeExpiredContracts) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	l = m.Reward.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventProviderDemoted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Service)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Bond.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.MinBond.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventContractActivated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ContractId != 0 {
+		n += 1 + sovEvents(uint64(m.ContractId))
+	}
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Service)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Client)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Type != 0 {
+		n += 1 + sovEvents(uint64(m.Type))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovEvents(uint64(m.StartHeight))
+	}
+	if m.Expiration != 0 {
+		n += 1 + sovEvents(uint64(m.Expiration))
+	}
+	return n
+}
+
+func (m *EventSetProviderAllowlist) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Service)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Action != 0 {
+		n += 1 + sovEvents(uint64(m.Action))
+	}
+	if len(m.Clients) > 0 {
+		for _, b := range m.Clients {
+			l = len(b)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventBondProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventBondProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventBondProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = append(m.Provider[:0], dAtA[iNdEx:postIndex]...)
+			if m.Provider == nil {
+				m.Provider = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Service = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondRel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BondRel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondAbs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BondAbs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BondDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventModProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventModProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventModProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = append(m.Creator[:0], dAtA[iNdEx:postIndex]...)
+			if m.Creator == nil {
+				m.Creator = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = append(m.Provider[:0], dAtA[iNdEx:postIndex]...)
+			if m.Provider == nil {
+				m.Provider = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Service = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetadataUri", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MetadataUri = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetadataNonce", wireType)
+			}
+			m.MetadataNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MetadataNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ProviderStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinContractDuration", wireType)
+			}
+			m.MinContractDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinContractDuration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxContractDuration", wireType)
+			}
+			m.MaxContractDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxContractDuration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubscriptionRate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubscriptionRate = append(m.SubscriptionRate, types.Coin{})
+			if err := m.SubscriptionRate[len(m.SubscriptionRate)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayAsYouGoRate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PayAsYouGoRate = append(m.PayAsYouGoRate, types.Coin{})
+			if err := m.PayAsYouGoRate[len(m.PayAsYouGoRate)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bond", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Bond.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementDuration", wireType)
+			}
+			m.SettlementDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SettlementDuration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedFields", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdatedFields = append(m.UpdatedFields, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOpenContracts", wireType)
+			}
+			m.MaxOpenContracts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxOpenContracts |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventOpenContract) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventOpenContract: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventOpenContract: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = append(m.Provider[:0], dAtA[iNdEx:postIndex]...)
+			if m.Provider == nil {
+				m.Provider = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			m.ContractId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Service = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Client", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Client = append(m.Client[:0], dAtA[iNdEx:postIndex]...)
+			if m.Client == nil {
+				m.Client = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegate", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegate = append(m.Delegate[:0], dAtA[iNdEx:postIndex]...)
+			if m.Delegate == nil {
+				m.Delegate = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= ContractType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			m.Duration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Duration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpenCost", wireType)
+			}
+			m.OpenCost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OpenCost |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementDuration", wireType)
+			}
+			m.SettlementDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SettlementDuration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authorization", wireType)
+			}
+			m.Authorization = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Authorization |= ContractAuthorization(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueriesPerMinute", wireType)
+			}
+			m.QueriesPerMinute = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueriesPerMinute |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementGracePeriod", wireType)
+			}
+			m.SettlementGracePeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SettlementGracePeriod |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoRenew", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoRenew = bool(v != 0)
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RateDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RateAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSettleContract) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSettleContract: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSettleContract: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = append(m.Provider[:0], dAtA[iNdEx:postIndex]...)
+			if m.Provider == nil {
+				m.Provider = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			m.ContractId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Service = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Client", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Client = append(m.Client[:0], dAtA[iNdEx:postIndex]...)
+			if m.Client == nil {
+				m.Client = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegate", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegate = append(m.Delegate[:0], dAtA[iNdEx:postIndex]...)
+			if m.Delegate == nil {
+				m.Delegate = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= ContractType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Paid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reserve", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Reserve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unpaid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Unpaid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Refunded", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Refunded.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Claimed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dust", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Dust.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventCloseContract) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCloseContract: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCloseContract: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			m.ContractId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = append(m.Provider[:0], dAtA[iNdEx:postIndex]...)
+			if m.Provider == nil {
+				m.Provider = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Service = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Client", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Client = append(m.Client[:0], dAtA[iNdEx:postIndex]...)
+			if m.Client == nil {
+				m.Client = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegate", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegate = append(m.Delegate[:0], dAtA[iNdEx:postIndex]...)
+			if m.Delegate == nil {
+				m.Delegate = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ByProvider", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ByProvider = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Penalty", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Penalty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Compensation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Compensation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Refund", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Refund.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dust", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Dust.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventRenewContract) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventRenewContract: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventRenewContract: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = append(m.Provider[:0], dAtA[iNdEx:postIndex]...)
+			if m.Provider == nil {
+				m.Provider = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			m.ContractId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Service = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Client", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Client = append(m.Client[:0], dAtA[iNdEx:postIndex]...)
+			if m.Client == nil {
+				m.Client = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegate", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegate = append(m.Delegate[:0], dAtA[iNdEx:postIndex]...)
+			if m.Delegate == nil {
+				m.Delegate = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= ContractType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldExpiration", wireType)
+			}
+			m.OldExpiration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OldExpiration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewExpiration", wireType)
+			}
+			m.NewExpiration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NewExpiration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtraDeposit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExtraDeposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventRotateDelegate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventRotateDelegate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventRotateDelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = append(m.Provider[:0], dAtA[iNdEx:postIndex]...)
+			if m.Provider == nil {
+				m.Provider = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			m.ContractId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Service = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Client", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Client = append(m.Client[:0], dAtA[iNdEx:postIndex]...)
+			if m.Client == nil {
+				m.Client = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldDelegate", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OldDelegate = append(m.OldDelegate[:0], dAtA[iNdEx:postIndex]...)
+			if m.OldDelegate == nil {
+				m.OldDelegate = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewDelegate", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewDelegate = append(m.NewDelegate[:0], dAtA[iNdEx:postIndex]...)
+			if m.NewDelegate == nil {
+				m.NewDelegate = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSetAutoRenew) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSetAutoRenew: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSetAutoRenew: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = append(m.Provider[:0], dAtA[iNdEx:postIndex]...)
+			if m.Provider == nil {
+				m.Provider = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			m.ContractId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Service = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Client", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Client = append(m.Client[:0], dAtA[iNdEx:postIndex]...)
+			if m.Client == nil {
+				m.Client = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoRenew", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoRenew = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Refund", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Refund.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventTopUpContract) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventTopUpContract: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventTopUpContract: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = append(m.Provider[:0], dAtA[iNdEx:postIndex]...)
+			if m.Provider == nil {
+				m.Provider = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			m.ContractId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Service = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Client", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Client = append(m.Client[:0], dAtA[iNdEx:postIndex]...)
+			if m.Client == nil {
+				m.Client = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldExpiration", wireType)
+			}
+			m.OldExpiration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OldExpiration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewExpiration", wireType)
+			}
+			m.NewExpiration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NewExpiration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopUp", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TopUp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSlashProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSlashProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSlashProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = append(m.Provider[:0], dAtA[iNdEx:postIndex]...)
+			if m.Provider == nil {
+				m.Provider = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Service = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			m.ContractId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bond", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Bond.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Faults", wireType)
+			}
+			m.Faults = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Faults |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *EventValidatorPayout) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Validator)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	l = m.Reward.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	return n
-}
-
-func sovEvents(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEvents(x uint64) (n int) {
-	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *EventBondProvider) Unmarshal(dAtA []byte) error {
+func (m *EventValidatorPayout) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1366,15 +7599,15 @@ func (m *EventBondProvider) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventBondProvider: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventValidatorPayout: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventBondProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventValidatorPayout: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1401,14 +7634,14 @@ func (m *EventBondProvider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Provider = append(m.Provider[:0], dAtA[iNdEx:postIndex]...)
-			if m.Provider == nil {
-				m.Provider = []byte{}
+			m.Validator = append(m.Validator[:0], dAtA[iNdEx:postIndex]...)
+			if m.Validator == nil {
+				m.Validator = []byte{}
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reward", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1436,11 +7669,127 @@ func (m *EventBondProvider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Service = string(dAtA[iNdEx:postIndex])
+			if err := m.Reward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ParamChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParamChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParamChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OldValue = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BondRel", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewValue", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1452,31 +7801,98 @@ func (m *EventBondProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewValue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventParamsUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventParamsUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventParamsUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.BondRel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BondAbs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1486,23 +7902,23 @@ func (m *EventBondProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BondAbs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Changes = append(m.Changes, ParamChange{})
+			if err := m.Changes[len(m.Changes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1527,7 +7943,7 @@ func (m *EventBondProvider) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventModProvider) Unmarshal(dAtA []byte) error {
+func (m *EventContractExpired) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1550,17 +7966,17 @@ func (m *EventModProvider) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventModProvider: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventContractExpired: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventModProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventContractExpired: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
 			}
-			var byteLen int
+			m.ContractId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1570,26 +7986,11 @@ func (m *EventModProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.ContractId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Creator = append(m.Creator[:0], dAtA[iNdEx:postIndex]...)
-			if m.Creator == nil {
-				m.Creator = []byte{}
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
@@ -1658,9 +8059,9 @@ func (m *EventModProvider) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetadataUri", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Client", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1670,29 +8071,31 @@ func (m *EventModProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MetadataUri = string(dAtA[iNdEx:postIndex])
+			m.Client = append(m.Client[:0], dAtA[iNdEx:postIndex]...)
+			if m.Client == nil {
+				m.Client = []byte{}
+			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetadataNonce", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			m.MetadataNonce = 0
+			m.Type = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1702,16 +8105,16 @@ func (m *EventModProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MetadataNonce |= uint64(b&0x7F) << shift
+				m.Type |= ContractType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
 			}
-			m.Status = 0
+			m.Expiration = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1721,156 +8124,16 @@ func (m *EventModProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= ProviderStatus(b&0x7F) << shift
+				m.Expiration |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinContractDuration", wireType)
-			}
-			m.MinContractDuration = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MinContractDuration |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxContractDuration", wireType)
-			}
-			m.MaxContractDuration = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MaxContractDuration |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubscriptionRate", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SubscriptionRate = append(m.SubscriptionRate, types.Coin{})
-			if err := m.SubscriptionRate[len(m.SubscriptionRate)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PayAsYouGoRate", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PayAsYouGoRate = append(m.PayAsYouGoRate, types.Coin{})
-			if err := m.PayAsYouGoRate[len(m.PayAsYouGoRate)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bond", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Bond.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SettlementDuration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementPending", wireType)
 			}
-			m.SettlementDuration = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1880,11 +8143,12 @@ func (m *EventModProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SettlementDuration |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.SettlementPending = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -1906,7 +8170,7 @@ func (m *EventModProvider) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventOpenContract) Unmarshal(dAtA []byte) error {
+func (m *EventContractAutoRenewed) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1929,17 +8193,17 @@ func (m *EventOpenContract) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventOpenContract: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventContractAutoRenewed: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventOpenContract: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventContractAutoRenewed: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
 			}
-			var byteLen int
+			m.ContractId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1949,31 +8213,16 @@ func (m *EventOpenContract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.ContractId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Provider = append(m.Provider[:0], dAtA[iNdEx:postIndex]...)
-			if m.Provider == nil {
-				m.Provider = []byte{}
-			}
-			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
 			}
-			m.ContractId = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1983,11 +8232,26 @@ func (m *EventOpenContract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ContractId |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = append(m.Provider[:0], dAtA[iNdEx:postIndex]...)
+			if m.Provider == nil {
+				m.Provider = []byte{}
+			}
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
@@ -2055,10 +8319,10 @@ func (m *EventOpenContract) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delegate", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldExpiration", wireType)
 			}
-			var byteLen int
+			m.OldExpiration = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -2068,31 +8332,16 @@ func (m *EventOpenContract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.OldExpiration |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Delegate = append(m.Delegate[:0], dAtA[iNdEx:postIndex]...)
-			if m.Delegate == nil {
-				m.Delegate = []byte{}
-			}
-			iNdEx = postIndex
 		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewExpiration", wireType)
 			}
-			m.Type = 0
+			m.NewExpiration = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -2102,16 +8351,16 @@ func (m *EventOpenContract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= ContractType(b&0x7F) << shift
+				m.NewExpiration |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spent", wireType)
 			}
-			m.Height = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -2121,16 +8370,31 @@ func (m *EventOpenContract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Spent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
 			}
-			m.Duration = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -2140,16 +8404,31 @@ func (m *EventOpenContract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Duration |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -2159,47 +8438,79 @@ func (m *EventOpenContract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OpenCost", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.OpenCost = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.OpenCost |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
 			}
-		case 11:
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventClaimContractIncomeBatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventClaimContractIncomeBatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventClaimContractIncomeBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2227,15 +8538,13 @@ func (m *EventOpenContract) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SettlementDuration", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
 			}
-			m.SettlementDuration = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -2245,49 +8554,26 @@ func (m *EventOpenContract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SettlementDuration |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authorization", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
 			}
-			m.Authorization = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Authorization |= ContractAuthorization(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
 			}
-		case 14:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QueriesPerMinute", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.QueriesPerMinute = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.QueriesPerMinute |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.Results = append(m.Results, ContractClaimResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -2309,7 +8595,7 @@ func (m *EventOpenContract) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventSettleContract) Unmarshal(dAtA []byte) error {
+func (m *EventPurgeExpiredContracts) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2332,17 +8618,17 @@ func (m *EventSettleContract) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventSettleContract: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventPurgeExpiredContracts: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventSettleContract: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventPurgeExpiredContracts: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -2352,31 +8638,29 @@ func (m *EventSettleContract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Provider = append(m.Provider[:0], dAtA[iNdEx:postIndex]...)
-			if m.Provider == nil {
-				m.Provider = []byte{}
-			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
 			}
-			m.ContractId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -2386,14 +8670,29 @@ func (m *EventSettleContract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ContractId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, ContractPurgeResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reward", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2421,45 +8720,63 @@ func (m *EventSettleContract) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Service = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Client", wireType)
+			if err := m.Reward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if byteLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventProviderDemoted) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Client = append(m.Client[:0], dAtA[iNdEx:postIndex]...)
-			if m.Client == nil {
-				m.Client = []byte{}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 5:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventProviderDemoted: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventProviderDemoted: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delegate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -2486,16 +8803,16 @@ func (m *EventSettleContract) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Delegate = append(m.Delegate[:0], dAtA[iNdEx:postIndex]...)
-			if m.Delegate == nil {
-				m.Delegate = []byte{}
+			m.Provider = append(m.Provider[:0], dAtA[iNdEx:postIndex]...)
+			if m.Provider == nil {
+				m.Provider = []byte{}
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
 			}
-			m.Type = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -2505,52 +8822,27 @@ func (m *EventSettleContract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= ContractType(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
-			}
-			m.Nonce = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Nonce |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
 			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
 			}
-			m.Height = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Height |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		case 9:
+			m.Service = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Paid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bond", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2578,13 +8870,13 @@ func (m *EventSettleContract) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Paid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Bond.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reserve", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBond", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2612,7 +8904,7 @@ func (m *EventSettleContract) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Reserve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MinBond.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2637,7 +8929,7 @@ func (m *EventSettleContract) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventCloseContract) Unmarshal(dAtA []byte) error {
+func (m *EventContractActivated) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2660,10 +8952,10 @@ func (m *EventCloseContract) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventCloseContract: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventContractActivated: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventCloseContract: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventContractActivated: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2786,10 +9078,10 @@ func (m *EventCloseContract) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delegate", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var byteLen int
+			m.Type = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -2799,26 +9091,49 @@ func (m *EventCloseContract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Type |= ContractType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthEvents
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
 			}
-			m.Delegate = append(m.Delegate[:0], dAtA[iNdEx:postIndex]...)
-			if m.Delegate == nil {
-				m.Delegate = []byte{}
+			m.Expiration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Expiration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -2840,7 +9155,7 @@ func (m *EventCloseContract) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventValidatorPayout) Unmarshal(dAtA []byte) error {
+func (m *EventSetProviderAllowlist) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2863,15 +9178,15 @@ func (m *EventValidatorPayout) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventValidatorPayout: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventSetProviderAllowlist: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventValidatorPayout: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventSetProviderAllowlist: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -2898,14 +9213,14 @@ func (m *EventValidatorPayout) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Validator = append(m.Validator[:0], dAtA[iNdEx:postIndex]...)
-			if m.Validator == nil {
-				m.Validator = []byte{}
+			m.Provider = append(m.Provider[:0], dAtA[iNdEx:postIndex]...)
+			if m.Provider == nil {
+				m.Provider = []byte{}
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reward", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2933,9 +9248,58 @@ func (m *EventValidatorPayout) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Reward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Service = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			m.Action = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Action |= AllowlistAction(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Clients", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Clients = append(m.Clients, make([]byte, postIndex-iNdEx))
+			copy(m.Clients[len(m.Clients)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
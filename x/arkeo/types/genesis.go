@@ -1,5 +1,11 @@
 package types
 
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 // this line is used by starport scaffolding # genesis/types/import
 
 // DefaultIndex is the default global index
@@ -21,5 +27,41 @@ func DefaultGenesis() *GenesisState {
 func (gs GenesisState) Validate() error {
 	// this line is used by starport scaffolding # genesis/types/validate
 
+	// the contracts opened after the import must not overwrite the ones imported, zero is read as the first id
+	nextContractId := gs.NextContractId
+	if nextContractId == 0 {
+		nextContractId = 1
+	}
+	ids := make(map[uint64]bool, len(gs.Contracts))
+	for _, contract := range gs.Contracts {
+		if ids[contract.Id] {
+			return fmt.Errorf("duplicate contract id %d", contract.Id)
+		}
+		ids[contract.Id] = true
+		if contract.Id >= nextContractId {
+			return fmt.Errorf("contract id %d is not below the next contract id %d", contract.Id, nextContractId)
+		}
+	}
+
+	// the counters imported along with the providers and contracts must be theirs
+	if gs.NetworkStats != nil {
+		var stats NetworkStats
+		for _, provider := range gs.Providers {
+			stats.AddProvider(provider)
+		}
+		for _, contract := range gs.Contracts {
+			stats.AddContract(contract)
+		}
+		if !stats.Equals(*gs.NetworkStats) {
+			return fmt.Errorf("network stats %s do not match the providers and contracts %s", gs.NetworkStats, &stats)
+		}
+	}
+
+	for _, validatorVersion := range gs.ValidatorVersions {
+		if _, err := sdk.ValAddressFromBech32(validatorVersion.Address); err != nil {
+			return fmt.Errorf("invalid validator address %s: %w", validatorVersion.Address, err)
+		}
+	}
+
 	return gs.Params.Validate()
 }
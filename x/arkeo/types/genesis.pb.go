@@ -26,13 +26,24 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the arkeo module's genesis state.
 type GenesisState struct {
-	Params                 Params                  `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	Providers              []Provider              `protobuf:"bytes,2,rep,name=providers,proto3" json:"providers"`
-	Contracts              []Contract              `protobuf:"bytes,3,rep,name=contracts,proto3" json:"contracts"`
-	NextContractId         uint64                  `protobuf:"varint,4,opt,name=next_contract_id,json=nextContractId,proto3" json:"next_contract_id,omitempty"`
-	ContractExpirationSets []ContractExpirationSet `protobuf:"bytes,5,rep,name=contract_expiration_sets,json=contractExpirationSets,proto3" json:"contract_expiration_sets"`
-	UserContractSets       []UserContractSet       `protobuf:"bytes,6,rep,name=user_contract_sets,json=userContractSets,proto3" json:"user_contract_sets"`
-	Version                int64                   `protobuf:"varint,7,opt,name=version,proto3" json:"version,omitempty"`
+	Params                   Params                  `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	Providers                []Provider              `protobuf:"bytes,2,rep,name=providers,proto3" json:"providers"`
+	Contracts                []Contract              `protobuf:"bytes,3,rep,name=contracts,proto3" json:"contracts"`
+	NextContractId           uint64                  `protobuf:"varint,4,opt,name=next_contract_id,json=nextContractId,proto3" json:"next_contract_id,omitempty"`
+	ContractExpirationSets   []ContractExpirationSet `protobuf:"bytes,5,rep,name=contract_expiration_sets,json=contractExpirationSets,proto3" json:"contract_expiration_sets"`
+	UserContractSets         []UserContractSet       `protobuf:"bytes,6,rep,name=user_contract_sets,json=userContractSets,proto3" json:"user_contract_sets"`
+	Version                  int64                   `protobuf:"varint,7,opt,name=version,proto3" json:"version,omitempty"`
+	ProviderEarnings         []ProviderEarnings      `protobuf:"bytes,8,rep,name=provider_earnings,json=providerEarnings,proto3" json:"provider_earnings"`
+	PayAsYouGoExpirationSets []ContractExpirationSet `protobuf:"bytes,9,rep,name=pay_as_you_go_expiration_sets,json=payAsYouGoExpirationSets,proto3" json:"pay_as_you_go_expiration_sets"`
+	ValidatorVersions        []ValidatorVersion      `protobuf:"bytes,10,rep,name=validator_versions,json=validatorVersions,proto3" json:"validator_versions"`
+	// params_record is the params last seen by the end blocker, unset until it
+	// ran once
+	ParamsRecord       *ParamsRecord           `protobuf:"bytes,11,opt,name=params_record,json=paramsRecord,proto3" json:"params_record,omitempty"`
+	ContractStartSets  []ContractExpirationSet `protobuf:"bytes,12,rep,name=contract_start_sets,json=contractStartSets,proto3" json:"contract_start_sets"`
+	ProviderAllowlists []ProviderAllowlist     `protobuf:"bytes,13,rep,name=provider_allowlists,json=providerAllowlists,proto3" json:"provider_allowlists"`
+	// network_stats is the counters of the providers and contracts, recounted
+	// on import when unset
+	NetworkStats *NetworkStats `protobuf:"bytes,14,opt,name=network_stats,json=networkStats,proto3" json:"network_stats,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -117,37 +128,152 @@ func (m *GenesisState) GetVersion() int64 {
 	return 0
 }
 
+func (m *GenesisState) GetProviderEarnings() []ProviderEarnings {
+	if m != nil {
+		return m.ProviderEarnings
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPayAsYouGoExpirationSets() []ContractExpirationSet {
+	if m != nil {
+		return m.PayAsYouGoExpirationSets
+	}
+	return nil
+}
+
+func (m *GenesisState) GetValidatorVersions() []ValidatorVersion {
+	if m != nil {
+		return m.ValidatorVersions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetParamsRecord() *ParamsRecord {
+	if m != nil {
+		return m.ParamsRecord
+	}
+	return nil
+}
+
+func (m *GenesisState) GetContractStartSets() []ContractExpirationSet {
+	if m != nil {
+		return m.ContractStartSets
+	}
+	return nil
+}
+
+func (m *GenesisState) GetProviderAllowlists() []ProviderAllowlist {
+	if m != nil {
+		return m.ProviderAllowlists
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNetworkStats() *NetworkStats {
+	if m != nil {
+		return m.NetworkStats
+	}
+	return nil
+}
+
+// ValidatorVersion is the software version a validator runs
+type ValidatorVersion struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Version int64  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (m *ValidatorVersion) Reset()         { *m = ValidatorVersion{} }
+func (m *ValidatorVersion) String() string { return proto.CompactTextString(m) }
+func (*ValidatorVersion) ProtoMessage()    {}
+func (*ValidatorVersion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_caae968dd754c6d4, []int{1}
+}
+func (m *ValidatorVersion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorVersion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorVersion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorVersion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorVersion.Merge(m, src)
+}
+func (m *ValidatorVersion) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorVersion) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorVersion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorVersion proto.InternalMessageInfo
+
+func (m *ValidatorVersion) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ValidatorVersion) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "arkeo.arkeo.GenesisState")
+	proto.RegisterType((*ValidatorVersion)(nil), "arkeo.arkeo.ValidatorVersion")
 }
 
 func init() { proto.RegisterFile("arkeo/arkeo/genesis.proto", fileDescriptor_caae968dd754c6d4) }
 
 var fileDescriptor_caae968dd754c6d4 = []byte{
-	// 354 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xcd, 0x6a, 0xc2, 0x40,
-	0x14, 0x85, 0x33, 0x8d, 0x55, 0x3a, 0x96, 0x22, 0xd3, 0x1f, 0xa6, 0x52, 0xd2, 0xe0, 0x2a, 0x50,
-	0x88, 0xd4, 0xae, 0xba, 0xb5, 0x48, 0xe9, 0x4e, 0x94, 0x6e, 0xba, 0x91, 0x18, 0x2f, 0xe9, 0x20,
-	0x66, 0xc2, 0xdc, 0xd1, 0xda, 0xb7, 0xe8, 0xab, 0xf4, 0x2d, 0x5c, 0xba, 0xec, 0xaa, 0x14, 0x7d,
-	0x91, 0xe2, 0x24, 0xf1, 0x07, 0xb2, 0x99, 0x24, 0xe7, 0x9c, 0xef, 0x9e, 0x81, 0x1b, 0x7a, 0x1d,
-	0xa8, 0x31, 0xc8, 0x66, 0x7a, 0x46, 0x10, 0x03, 0x0a, 0xf4, 0x13, 0x25, 0xb5, 0x64, 0x55, 0x23,
-	0xfa, 0xe6, 0xac, 0x5f, 0x44, 0x32, 0x92, 0x46, 0x6f, 0x6e, 0xde, 0xd2, 0x48, 0x9d, 0xef, 0xd3,
-	0x49, 0xa0, 0x82, 0x09, 0x16, 0x39, 0x63, 0x80, 0x04, 0x54, 0xea, 0x34, 0xbe, 0x6d, 0x7a, 0xfa,
-	0x9c, 0x16, 0xf5, 0x75, 0xa0, 0x81, 0xdd, 0xd3, 0x72, 0x8a, 0x72, 0xe2, 0x12, 0xaf, 0xda, 0x3a,
-	0xf7, 0xf7, 0x8a, 0xfd, 0xae, 0xb1, 0xda, 0xa5, 0xc5, 0xef, 0xad, 0xd5, 0xcb, 0x82, 0xec, 0x91,
-	0x9e, 0x24, 0x4a, 0xce, 0xc4, 0x08, 0x14, 0xf2, 0x23, 0xd7, 0xf6, 0xaa, 0xad, 0xcb, 0x43, 0x2a,
-	0x73, 0x33, 0x6e, 0x97, 0xde, 0xa0, 0xa1, 0x8c, 0xb5, 0x0a, 0x42, 0x8d, 0xdc, 0x2e, 0x40, 0x9f,
-	0x32, 0x37, 0x47, 0xb7, 0x69, 0xe6, 0xd1, 0x5a, 0x0c, 0x73, 0x3d, 0xc8, 0x95, 0x81, 0x18, 0xf1,
-	0x92, 0x4b, 0xbc, 0x52, 0xef, 0x6c, 0xa3, 0xe7, 0xe0, 0xcb, 0x88, 0x0d, 0x29, 0xdf, 0x86, 0x60,
-	0x9e, 0x08, 0x15, 0x68, 0x21, 0xe3, 0x01, 0x82, 0x46, 0x7e, 0x6c, 0x3a, 0x1b, 0x85, 0x9d, 0x9d,
-	0x6d, 0xb6, 0x0f, 0xf9, 0x05, 0xae, 0xc2, 0x22, 0x13, 0x59, 0x97, 0xb2, 0x29, 0x82, 0xda, 0xdd,
-	0xc6, 0x4c, 0x2f, 0x9b, 0xe9, 0x37, 0x07, 0xd3, 0x5f, 0x11, 0x54, 0xde, 0xb0, 0x9b, 0x5b, 0x9b,
-	0x1e, 0xca, 0xc8, 0x38, 0xad, 0xcc, 0x40, 0xa1, 0x90, 0x31, 0xaf, 0xb8, 0xc4, 0xb3, 0x7b, 0xf9,
-	0x67, 0xbb, 0xb3, 0x58, 0x39, 0x64, 0xb9, 0x72, 0xc8, 0xdf, 0xca, 0x21, 0x5f, 0x6b, 0xc7, 0x5a,
-	0xae, 0x1d, 0xeb, 0x67, 0xed, 0x58, 0x6f, 0x77, 0x91, 0xd0, 0xef, 0xd3, 0xa1, 0x1f, 0xca, 0x49,
-	0xba, 0xec, 0x18, 0xf4, 0x87, 0x54, 0xe3, 0x6c, 0xf3, 0xf3, 0xec, 0xa9, 0x3f, 0x13, 0xc0, 0x61,
-	0xd9, 0xfc, 0x01, 0x0f, 0xff, 0x01, 0x00, 0x00, 0xff, 0xff, 0xcf, 0x72, 0x30, 0x30, 0x75, 0x02,
-	0x00, 0x00,
+	// 560 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xcd, 0x6e, 0xda, 0x4e,
+	0x10, 0xc7, 0x49, 0xfe, 0xf0, 0x67, 0x21, 0x11, 0x2c, 0x6d, 0xb5, 0x89, 0x1a, 0x17, 0x71, 0x42,
+	0xaa, 0x04, 0x6a, 0x7a, 0xea, 0xa5, 0x52, 0x52, 0xd1, 0xa8, 0x97, 0x0a, 0x19, 0x25, 0x6a, 0x7b,
+	0xb1, 0x16, 0xbc, 0x72, 0x2d, 0x88, 0x77, 0xb5, 0xb3, 0x10, 0x78, 0x8b, 0x3e, 0x56, 0x8e, 0x39,
+	0xf6, 0x54, 0x55, 0xf0, 0x08, 0x7d, 0x81, 0xca, 0xfb, 0xc1, 0x57, 0x7d, 0xc9, 0xc5, 0x30, 0x33,
+	0xbf, 0x8f, 0x9d, 0xd9, 0xb1, 0xd1, 0x29, 0x95, 0x63, 0xc6, 0xbb, 0xe6, 0x19, 0xb3, 0x94, 0x41,
+	0x02, 0x1d, 0x21, 0xb9, 0xe2, 0xb8, 0xa2, 0x93, 0x1d, 0xfd, 0x3c, 0x7b, 0x16, 0xf3, 0x98, 0xeb,
+	0x7c, 0x37, 0xfb, 0x67, 0x20, 0x67, 0x64, 0x9b, 0x2d, 0xa8, 0xa4, 0x77, 0x90, 0x57, 0x19, 0x33,
+	0x26, 0x98, 0x34, 0x95, 0xd6, 0x9f, 0x12, 0xaa, 0x5e, 0x1b, 0xa3, 0x81, 0xa2, 0x8a, 0xe1, 0x37,
+	0xa8, 0x68, 0xa8, 0xc4, 0x6b, 0x7a, 0xed, 0xca, 0x45, 0xa3, 0xb3, 0x65, 0xdc, 0xe9, 0xeb, 0xd2,
+	0xd5, 0xd1, 0xc3, 0xaf, 0x57, 0x85, 0xc0, 0x02, 0xf1, 0x3b, 0x54, 0x16, 0x92, 0xcf, 0x92, 0x88,
+	0x49, 0x20, 0x07, 0xcd, 0xc3, 0x76, 0xe5, 0xe2, 0xf9, 0x2e, 0xcb, 0x56, 0x2d, 0x6f, 0x83, 0xce,
+	0xa8, 0x23, 0x9e, 0x2a, 0x49, 0x47, 0x0a, 0xc8, 0x61, 0x0e, 0xf5, 0x83, 0xad, 0x3a, 0xea, 0x1a,
+	0x8d, 0xdb, 0xa8, 0x96, 0xb2, 0xb9, 0x0a, 0x5d, 0x26, 0x4c, 0x22, 0x72, 0xd4, 0xf4, 0xda, 0x47,
+	0xc1, 0x49, 0x96, 0x77, 0xc4, 0x4f, 0x11, 0x1e, 0x22, 0xb2, 0x06, 0xb1, 0xb9, 0x48, 0x24, 0x55,
+	0x09, 0x4f, 0x43, 0x60, 0x0a, 0xc8, 0x7f, 0xda, 0xb3, 0x95, 0xeb, 0xd9, 0x5b, 0x63, 0x07, 0xcc,
+	0x1d, 0xe0, 0xc5, 0x28, 0xaf, 0x08, 0xb8, 0x8f, 0xf0, 0x14, 0x98, 0xdc, 0x9c, 0x46, 0xab, 0x17,
+	0xb5, 0xfa, 0xcb, 0x1d, 0xf5, 0x1b, 0x60, 0xd2, 0x39, 0x6c, 0x74, 0x6b, 0xd3, 0xdd, 0x34, 0x60,
+	0x82, 0x4a, 0x33, 0x26, 0x21, 0xe1, 0x29, 0x29, 0x35, 0xbd, 0xf6, 0x61, 0xe0, 0x42, 0xdc, 0x47,
+	0x75, 0x37, 0xc1, 0x90, 0x51, 0x99, 0x26, 0x69, 0x0c, 0xe4, 0x7f, 0x6d, 0x75, 0x9e, 0x3b, 0xf7,
+	0x9e, 0x05, 0x39, 0x2f, 0xb1, 0x97, 0xc7, 0x09, 0x3a, 0x17, 0x74, 0x11, 0x52, 0x08, 0x17, 0x7c,
+	0x1a, 0xc6, 0xfc, 0x9f, 0x31, 0x95, 0x9f, 0x38, 0x26, 0x22, 0xe8, 0xe2, 0x12, 0xbe, 0xf2, 0xe9,
+	0x35, 0xdf, 0x1b, 0x54, 0x80, 0xf0, 0x8c, 0x4e, 0x92, 0x88, 0x2a, 0x2e, 0x43, 0xdb, 0x11, 0x10,
+	0x94, 0x73, 0xfa, 0x5b, 0x07, 0xbb, 0x35, 0x28, 0x2b, 0x5d, 0x9f, 0xed, 0xe5, 0x01, 0xbf, 0x47,
+	0xc7, 0x66, 0x15, 0x43, 0xc9, 0x46, 0x5c, 0x46, 0xa4, 0xa2, 0x57, 0xf7, 0x34, 0x67, 0x75, 0x03,
+	0x0d, 0x08, 0xaa, 0x62, 0x2b, 0xc2, 0x5f, 0x50, 0x63, 0x73, 0x6f, 0x8a, 0x4a, 0x7b, 0x7b, 0xd5,
+	0x27, 0x36, 0x5d, 0x77, 0x22, 0x83, 0x4c, 0x43, 0x77, 0x7b, 0x83, 0x1a, 0xeb, 0xab, 0xa2, 0x93,
+	0x09, 0xbf, 0x9f, 0x24, 0xa0, 0x80, 0x1c, 0x6b, 0x65, 0x3f, 0xf7, 0xb2, 0x2e, 0x1d, 0xcc, 0xaa,
+	0x62, 0xb1, 0x5f, 0xd0, 0x0d, 0xa7, 0x4c, 0xdd, 0x73, 0x39, 0xce, 0xce, 0xab, 0x80, 0x9c, 0xe4,
+	0x34, 0xfc, 0xd9, 0x20, 0xb2, 0xd7, 0x1a, 0x82, 0x6a, 0xba, 0x15, 0xb5, 0x3e, 0xa2, 0xda, 0xfe,
+	0x74, 0xb3, 0x7d, 0xa3, 0x51, 0x24, 0x19, 0x98, 0x37, 0xbf, 0x1c, 0xb8, 0x70, 0x7b, 0x13, 0x0f,
+	0x76, 0x36, 0xf1, 0xaa, 0xf7, 0xb0, 0xf4, 0xbd, 0xc7, 0xa5, 0xef, 0xfd, 0x5e, 0xfa, 0xde, 0x8f,
+	0x95, 0x5f, 0x78, 0x5c, 0xf9, 0x85, 0x9f, 0x2b, 0xbf, 0xf0, 0xed, 0x75, 0x9c, 0xa8, 0xef, 0xd3,
+	0x61, 0x67, 0xc4, 0xef, 0xcc, 0x67, 0xc7, 0xfa, 0x9b, 0xa0, 0x3b, 0xb7, 0xbf, 0x6a, 0x21, 0x18,
+	0x0c, 0x8b, 0xfa, 0x5b, 0xf4, 0xf6, 0xef, 0x00, 0xc4, 0xf3, 0x55, 0xac, 0xff, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -170,6 +296,100 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.NetworkStats != nil {
+		{
+			size, err := m.NetworkStats.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.ProviderAllowlists) > 0 {
+		for iNdEx := len(m.ProviderAllowlists) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProviderAllowlists[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.ContractStartSets) > 0 {
+		for iNdEx := len(m.ContractStartSets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ContractStartSets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if m.ParamsRecord != nil {
+		{
+			size, err := m.ParamsRecord.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.ValidatorVersions) > 0 {
+		for iNdEx := len(m.ValidatorVersions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorVersions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.PayAsYouGoExpirationSets) > 0 {
+		for iNdEx := len(m.PayAsYouGoExpirationSets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PayAsYouGoExpirationSets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.ProviderEarnings) > 0 {
+		for iNdEx := len(m.ProviderEarnings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProviderEarnings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	if m.Version != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.Version))
 		i--
@@ -249,6 +469,41 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ValidatorVersion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorVersion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorVersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Version != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -298,6 +553,60 @@ func (m *GenesisState) Size() (n int) {
 	if m.Version != 0 {
 		n += 1 + sovGenesis(uint64(m.Version))
 	}
+	if len(m.ProviderEarnings) > 0 {
+		for _, e := range m.ProviderEarnings {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PayAsYouGoExpirationSets) > 0 {
+		for _, e := range m.PayAsYouGoExpirationSets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ValidatorVersions) > 0 {
+		for _, e := range m.ValidatorVersions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.ParamsRecord != nil {
+		l = m.ParamsRecord.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.ContractStartSets) > 0 {
+		for _, e := range m.ContractStartSets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ProviderAllowlists) > 0 {
+		for _, e := range m.ProviderAllowlists {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NetworkStats != nil {
+		l = m.NetworkStats.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *ValidatorVersion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovGenesis(uint64(m.Version))
+	}
 	return n
 }
 
@@ -543,6 +852,349 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderEarnings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderEarnings = append(m.ProviderEarnings, ProviderEarnings{})
+			if err := m.ProviderEarnings[len(m.ProviderEarnings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayAsYouGoExpirationSets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PayAsYouGoExpirationSets = append(m.PayAsYouGoExpirationSets, ContractExpirationSet{})
+			if err := m.PayAsYouGoExpirationSets[len(m.PayAsYouGoExpirationSets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorVersions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorVersions = append(m.ValidatorVersions, ValidatorVersion{})
+			if err := m.ValidatorVersions[len(m.ValidatorVersions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParamsRecord", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ParamsRecord == nil {
+				m.ParamsRecord = &ParamsRecord{}
+			}
+			if err := m.ParamsRecord.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractStartSets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractStartSets = append(m.ContractStartSets, ContractExpirationSet{})
+			if err := m.ContractStartSets[len(m.ContractStartSets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderAllowlists", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderAllowlists = append(m.ProviderAllowlists, ProviderAllowlist{})
+			if err := m.ProviderAllowlists[len(m.ProviderAllowlists)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetworkStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NetworkStats == nil {
+				m.NetworkStats = &NetworkStats{}
+			}
+			if err := m.NetworkStats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorVersion) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorVersion: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorVersion: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -3,6 +3,7 @@ package types_test
 import (
 	"testing"
 
+	"github.com/arkeonetwork/arkeo/common/cosmos"
 	"github.com/arkeonetwork/arkeo/x/arkeo/types"
 
 	"github.com/stretchr/testify/require"
@@ -27,6 +28,61 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: true,
 		},
+		{
+			desc: "contracts below the next contract id",
+			genState: &types.GenesisState{
+				Contracts:      []types.Contract{{Id: 1}, {Id: 2}},
+				NextContractId: 3,
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicate contract id",
+			genState: &types.GenesisState{
+				Contracts:      []types.Contract{{Id: 1}, {Id: 1}},
+				NextContractId: 3,
+			},
+			valid: false,
+		},
+		{
+			desc: "contract id the next contract would take",
+			genState: &types.GenesisState{
+				Contracts:      []types.Contract{{Id: 1}, {Id: 3}},
+				NextContractId: 3,
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid validator address",
+			genState: &types.GenesisState{
+				ValidatorVersions: []types.ValidatorVersion{{Address: "bogus", Version: 2}},
+			},
+			valid: false,
+		},
+		{
+			desc: "network stats of the providers and contracts",
+			genState: &types.GenesisState{
+				Providers:      []types.Provider{{Bond: cosmos.NewInt(500), Status: types.ProviderStatus_ONLINE}},
+				Contracts:      []types.Contract{{Id: 1, Rate: cosmos.NewInt64Coin("uarkeo", 1), Deposit: cosmos.NewInt(100), Paid: cosmos.NewInt(40)}},
+				NextContractId: 2,
+				NetworkStats: &types.NetworkStats{
+					Providers:       1,
+					ProvidersOnline: 1,
+					Bonded:          cosmos.NewCoins(cosmos.NewInt64Coin("uarkeo", 500)),
+					OpenContracts:   1,
+					Escrowed:        cosmos.NewCoins(cosmos.NewInt64Coin("uarkeo", 60)),
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "network stats not matching the providers",
+			genState: &types.GenesisState{
+				Providers:    []types.Provider{{Bond: cosmos.NewInt(500)}},
+				NetworkStats: &types.NetworkStats{Providers: 2},
+			},
+			valid: false,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
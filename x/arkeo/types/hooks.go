@@ -0,0 +1,78 @@
+package types
+
+import (
+	"context"
+
+	"github.com/arkeonetwork/arkeo/common/cosmos"
+)
+
+// ArkeoHooks is the interface of the modules reacting to the providers and the contracts of arkeo. A hook returning an
+// error aborts the state transition which triggered it.
+type ArkeoHooks interface {
+	// AfterProviderBonded is called once the bond of the provider changed by bond, negative when it unbonded. The
+	// provider is removed when its bond is back to zero.
+	AfterProviderBonded(ctx context.Context, provider Provider, bond cosmos.Int) error
+	// AfterProviderModified is called once the provider is modified
+	AfterProviderModified(ctx context.Context, provider Provider) error
+	// AfterContractOpened is called once the contract is opened
+	AfterContractOpened(ctx context.Context, contract Contract) error
+	// AfterContractClosed is called once the contract is closed by its client or its provider, after its settlement
+	// when it is settled right away
+	AfterContractClosed(ctx context.Context, contract Contract) error
+	// AfterContractSettled is called once the contract is settled for good, with the amounts of its last settlement
+	AfterContractSettled(ctx context.Context, contract Contract, settlement ContractSettlement) error
+}
+
+var _ ArkeoHooks = MultiArkeoHooks{}
+
+// MultiArkeoHooks combine multiple arkeo hooks, all hook functions are run in array sequence
+type MultiArkeoHooks []ArkeoHooks
+
+func NewMultiArkeoHooks(hooks ...ArkeoHooks) MultiArkeoHooks {
+	return hooks
+}
+
+func (h MultiArkeoHooks) AfterProviderBonded(ctx context.Context, provider Provider, bond cosmos.Int) error {
+	for i := range h {
+		if err := h[i].AfterProviderBonded(ctx, provider, bond); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiArkeoHooks) AfterProviderModified(ctx context.Context, provider Provider) error {
+	for i := range h {
+		if err := h[i].AfterProviderModified(ctx, provider); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiArkeoHooks) AfterContractOpened(ctx context.Context, contract Contract) error {
+	for i := range h {
+		if err := h[i].AfterContractOpened(ctx, contract); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiArkeoHooks) AfterContractClosed(ctx context.Context, contract Contract) error {
+	for i := range h {
+		if err := h[i].AfterContractClosed(ctx, contract); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiArkeoHooks) AfterContractSettled(ctx context.Context, contract Contract, settlement ContractSettlement) error {
+	for i := range h {
+		if err := h[i].AfterContractSettled(ctx, contract, settlement); err != nil {
+			return err
+		}
+	}
+	return nil
+}
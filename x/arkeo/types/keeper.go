@@ -3,12 +3,42 @@ package types
 import (
 	"encoding/json"
 	fmt "fmt"
+	"sort"
 	"strconv"
+	"strings"
+
+	sdkmath "cosmossdk.io/math"
 
 	"github.com/arkeonetwork/arkeo/common"
 	"github.com/arkeonetwork/arkeo/common/cosmos"
+	"github.com/arkeonetwork/arkeo/x/arkeo/configs"
+)
+
+// states of the contracts the contracts by provider query filters on
+const (
+	ContractStatePending = "pending"
+	ContractStateOpen    = "open"
+	ContractStateClosed  = "closed"
 )
 
+// ValidateContractState returns an error when the state is none of the contract states, or empty
+func ValidateContractState(state string) error {
+	switch state {
+	case "", ContractStatePending, ContractStateOpen, ContractStateClosed:
+		return nil
+	default:
+		return fmt.Errorf("invalid contract state: %s", state)
+	}
+}
+
+// ValidateProviderStatusFilter returns an error when the filter is none of the provider statuses, or empty
+func ValidateProviderStatusFilter(filter string) error {
+	if _, ok := ProviderStatus_value[strings.ToUpper(filter)]; !ok && filter != "" {
+		return fmt.Errorf("invalid provider status: %s", filter)
+	}
+	return nil
+}
+
 func NewProvider(pubkey common.PubKey, service common.Service) Provider {
 	return Provider{
 		PubKey:           pubkey,
@@ -23,6 +53,113 @@ func (provider Provider) Key() string {
 	return fmt.Sprintf("%s/%s", provider.PubKey, provider.Service)
 }
 
+// InStatus whether the provider has the status of the filter, any status when it is empty
+func (provider Provider) InStatus(filter string) bool {
+	if filter == "" {
+		return true
+	}
+	status, ok := ProviderStatus_value[strings.ToUpper(filter)]
+	return ok && provider.Status == ProviderStatus(status)
+}
+
+// OpenContractsCap return the open contracts the provider accepts at most, its own cap within the chain one, zero
+// when neither caps them
+func (provider Provider) OpenContractsCap(maxOpenContracts uint64) uint64 {
+	if provider.MaxOpenContracts > 0 && (maxOpenContracts == 0 || provider.MaxOpenContracts < maxOpenContracts) {
+		return provider.MaxOpenContracts
+	}
+	return maxOpenContracts
+}
+
+func NewProviderEarnings(pubkey common.PubKey, service common.Service) ProviderEarnings {
+	return ProviderEarnings{
+		Provider: pubkey,
+		Service:  service,
+		Income:   make([]cosmos.Coin, 0),
+		Escrowed: make([]cosmos.Coin, 0),
+	}
+}
+
+func (earnings ProviderEarnings) Key() string {
+	return fmt.Sprintf("%s/%s", earnings.Provider, earnings.Service)
+}
+
+// Earn add income paid to the provider
+func (earnings *ProviderEarnings) Earn(coin cosmos.Coin) {
+	earnings.Income = cosmos.NewCoins(earnings.Income...).Add(coin)
+}
+
+// Escrow add a deposit the provider can claim
+func (earnings *ProviderEarnings) Escrow(coin cosmos.Coin) {
+	earnings.Escrowed = cosmos.NewCoins(earnings.Escrowed...).Add(coin)
+}
+
+// Release remove from the escrowed deposits what was paid or refunded. It stops at zero, the deposits of the
+// contracts opened before the earnings were counted were never escrowed
+func (earnings *ProviderEarnings) Release(coin cosmos.Coin) {
+	escrowed := cosmos.NewCoins(earnings.Escrowed...)
+	amount := sdkmath.MinInt(escrowed.AmountOf(coin.Denom), coin.Amount)
+	if !amount.IsPositive() {
+		return
+	}
+	earnings.Escrowed = escrowed.Sub(cosmos.NewCoin(coin.Denom, amount))
+}
+
+func NewProviderAllowlist(pubkey common.PubKey, service common.Service) ProviderAllowlist {
+	return ProviderAllowlist{
+		Provider: pubkey,
+		Service:  service,
+		Clients:  make([]common.PubKey, 0),
+	}
+}
+
+func (allowlist ProviderAllowlist) Key() string {
+	return fmt.Sprintf("%s/%s", allowlist.Provider, allowlist.Service)
+}
+
+// Allows return true when the client can open a contract with the provider, any client can when the allowlist is
+// empty
+func (allowlist ProviderAllowlist) Allows(client common.PubKey) bool {
+	if len(allowlist.Clients) == 0 {
+		return true
+	}
+	for _, c := range allowlist.Clients {
+		if c.Equals(client) {
+			return true
+		}
+	}
+	return false
+}
+
+// Apply update the clients of the allowlist, adding the clients missing or removing the ones on it
+func (allowlist *ProviderAllowlist) Apply(action AllowlistAction, clients []common.PubKey) {
+	switch action {
+	case AllowlistAction_ALLOWLIST_REPLACE:
+		allowlist.Clients = append(make([]common.PubKey, 0, len(clients)), clients...)
+	case AllowlistAction_ALLOWLIST_ADD:
+		for _, client := range clients {
+			if len(allowlist.Clients) == 0 || !allowlist.Allows(client) {
+				allowlist.Clients = append(allowlist.Clients, client)
+			}
+		}
+	case AllowlistAction_ALLOWLIST_REMOVE:
+		kept := make([]common.PubKey, 0, len(allowlist.Clients))
+		for _, c := range allowlist.Clients {
+			removed := false
+			for _, client := range clients {
+				if c.Equals(client) {
+					removed = true
+					break
+				}
+			}
+			if !removed {
+				kept = append(kept, c)
+			}
+		}
+		allowlist.Clients = kept
+	}
+}
+
 func NewContract(provider common.PubKey, service common.Service, client common.PubKey) Contract {
 	return Contract{
 		Provider: provider,
@@ -31,6 +168,7 @@ func NewContract(provider common.PubKey, service common.Service, client common.P
 		Delegate: common.EmptyPubKey,
 		Deposit:  cosmos.ZeroInt(),
 		Paid:     cosmos.ZeroInt(),
+		TopUp:    cosmos.ZeroInt(),
 	}
 }
 
@@ -45,26 +183,80 @@ func (contract Contract) GetSpender() common.PubKey {
 	return contract.Client
 }
 
+// Owners returns the pubkeys the contract is indexed by in the user contract
+// sets, its client and its delegate when it has a different one
+func (contract Contract) Owners() []common.PubKey {
+	owners := []common.PubKey{contract.Client}
+	if !contract.Delegate.IsEmpty() && !contract.Delegate.Equals(contract.Client) {
+		owners = append(owners, contract.Delegate)
+	}
+	return owners
+}
+
+// Start returns the height the duration of the contract runs from, its start height when it was scheduled, the height
+// it opened at otherwise
+func (contract Contract) Start() int64 {
+	if contract.StartHeight > 0 {
+		return contract.StartHeight
+	}
+	return contract.Height
+}
+
+// IsPending returns true while the scheduled contract hasn't reached its start height, and wasn't closed before
+func (contract Contract) IsPending(height int64) bool {
+	return contract.StartHeight > height && contract.SettlementHeight == 0
+}
+
 // Expiration Contracts progress through the following states
-// Open -> Expired -> Settled
+// (Pending ->) Open -> Expired -> Settled
 // for Subscription contracts, they expire and settle on the same block
 // for PayAsYouGo contracts, they can expire and settle on different blocks, based on the settlement duration
 func (contract Contract) Expiration() int64 {
-	return contract.Height + contract.Duration
+	return contract.Start() + contract.Duration
 }
 
 // SettlementPeriodEnd returns the end of the settlement period
 // for a contract. For PAY_AS_YOU_GO contracts, the settlement period is
 // a period of time in which no additional API calls should be allowed
 // but a claim can still be posted for previously made calls in order
-// to correctly settle the contract.
+// to correctly settle the contract. It is extended by the settlement grace
+// period the contract was opened with.
 func (contract Contract) SettlementPeriodEnd() int64 {
 	if contract.IsPayAsYouGo() {
-		return contract.Expiration() + contract.SettlementDuration
+		return contract.Expiration() + contract.SettlementDuration + contract.SettlementGracePeriod
 	}
 	return contract.Expiration()
 }
 
+// MaxQueries returns the most queries the client can make over the contract.
+// Contracts are priced by queries per minute per block, a block lasting less
+// than a minute. Zero when the contract has no queries per minute.
+func (contract Contract) MaxQueries() int64 {
+	return contract.Duration * contract.QueriesPerMinute
+}
+
+// BlockCost returns the deposit a block of the subscription costs, its rate
+// for each of its queries per minute, as its renewals and top-ups are priced
+func (contract Contract) BlockCost() cosmos.Int {
+	return contract.Rate.Amount.MulRaw(contract.QueriesPerMinute)
+}
+
+// TopUpBalance returns the top-up balance of the contract, zero for the
+// contracts stored before they had one
+func (contract Contract) TopUpBalance() cosmos.Int {
+	if contract.TopUp.IsNil() {
+		return cosmos.ZeroInt()
+	}
+	return contract.TopUp
+}
+
+// SettlesAfterExpiration returns true when the contract has a settlement
+// period, a pay-as-you-go contract with a settlement duration or grace
+// period. It then expires and settles on different blocks.
+func (contract Contract) SettlesAfterExpiration() bool {
+	return contract.SettlementPeriodEnd() > contract.Expiration()
+}
+
 func (contract Contract) IsPayAsYouGo() bool {
 	return contract.Type == ContractType_PAY_AS_YOU_GO
 }
@@ -117,6 +309,23 @@ func (contract Contract) IsSettlementPeriod(height int64) bool {
 	return contract.Expiration() < height && contract.SettlementPeriodEnd() > height
 }
 
+// InState returns true when the contract is in the given state at the given
+// height, pending, open or closed. Any contract is in the empty state.
+func (contract Contract) InState(state string, height int64) bool {
+	switch state {
+	case "":
+		return true
+	case ContractStatePending:
+		return contract.IsPending(height)
+	case ContractStateOpen:
+		return contract.IsOpen(height) && !contract.IsPending(height)
+	case ContractStateClosed:
+		return !contract.IsOpen(height)
+	default:
+		return false
+	}
+}
+
 func (contract Contract) IsEmpty() bool {
 	return contract.Height == 0
 }
@@ -129,6 +338,18 @@ func (contract Contract) ClientAddress() cosmos.AccAddress {
 	return addr
 }
 
+// RefundAddress return the address the refund of the deposit goes to, the payer of the deposit when it wasn't the client
+func (contract Contract) RefundAddress() cosmos.AccAddress {
+	if contract.Payer == "" {
+		return contract.ClientAddress()
+	}
+	addr, err := cosmos.AccAddressFromBech32(contract.Payer)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
 func (contractType *ContractType) UnmarshalJSON(b []byte) error {
 	var item interface{}
 	if err := json.Unmarshal(b, &item); err != nil {
@@ -143,8 +364,39 @@ func (contractType *ContractType) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
+// Append add the contract to the set, once. The ids are kept in ascending order, whatever order the contracts are
+// added in, for the end blocker to settle the contracts of a height in the order they opened
 func (exp *ContractExpirationSet) Append(id uint64) {
-	exp.ContractSet.ContractIds = append(exp.ContractSet.ContractIds, id)
+	ids := exp.ContractSet.ContractIds
+	i := sort.Search(len(ids), func(i int) bool { return ids[i] >= id })
+	if i < len(ids) && ids[i] == id {
+		return
+	}
+	ids = append(ids, 0)
+	copy(ids[i+1:], ids[i:])
+	ids[i] = id
+	exp.ContractSet.ContractIds = ids
+}
+
+// SortedContractIds return a copy of the ids of the set in ascending order, the sets stored before Append kept them
+// sorted included
+func (exp ContractExpirationSet) SortedContractIds() []uint64 {
+	ids := append([]uint64(nil), exp.ContractSet.GetContractIds()...)
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+// Remove remove the contract from the set, when it is in it
+func (exp *ContractExpirationSet) Remove(id uint64) {
+	if exp.ContractSet == nil {
+		return
+	}
+	for i, contractId := range exp.ContractSet.ContractIds {
+		if contractId == id {
+			exp.ContractSet.ContractIds = append(exp.ContractSet.ContractIds[:i], exp.ContractSet.ContractIds[i+1:]...)
+			return
+		}
+	}
 }
 
 func (contractAuth *ContractAuthorization) UnmarshalJSON(b []byte) error {
@@ -161,6 +413,106 @@ func (contractAuth *ContractAuthorization) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
+// AddProvider count the provider, the providers without bond are not stored and not counted
+func (stats *NetworkStats) AddProvider(provider Provider) {
+	if provider.Bond.IsNil() || !provider.Bond.IsPositive() {
+		return
+	}
+	stats.Providers++
+	if provider.Status == ProviderStatus_ONLINE {
+		stats.ProvidersOnline++
+	}
+	stats.Bonded = addCoin(stats.Bonded, cosmos.NewCoin(configs.Denom, provider.Bond))
+}
+
+// RemoveProvider uncount the provider, as it was counted when stored
+func (stats *NetworkStats) RemoveProvider(provider Provider) {
+	if provider.Bond.IsNil() || !provider.Bond.IsPositive() {
+		return
+	}
+	stats.Providers = decrement(stats.Providers)
+	if provider.Status == ProviderStatus_ONLINE {
+		stats.ProvidersOnline = decrement(stats.ProvidersOnline)
+	}
+	stats.Bonded = subCoin(stats.Bonded, cosmos.NewCoin(configs.Denom, provider.Bond))
+}
+
+// AddContract count the contract, the settled contracts hold nothing in escrow and are not counted
+func (stats *NetworkStats) AddContract(contract Contract) {
+	if contract.SettlementHeight > 0 {
+		return
+	}
+	stats.OpenContracts++
+	stats.Escrowed = addCoin(stats.Escrowed, contract.escrowed())
+}
+
+// RemoveContract uncount the contract, as it was counted when stored
+func (stats *NetworkStats) RemoveContract(contract Contract) {
+	if contract.SettlementHeight > 0 {
+		return
+	}
+	stats.OpenContracts = decrement(stats.OpenContracts)
+	stats.Escrowed = subCoin(stats.Escrowed, contract.escrowed())
+}
+
+// escrowed the deposit not paid yet and the top-up balance of the contract
+func (contract Contract) escrowed() cosmos.Coin {
+	amount := contract.TopUpBalance()
+	if !contract.Deposit.IsNil() && !contract.Paid.IsNil() && contract.Deposit.GT(contract.Paid) {
+		amount = amount.Add(contract.Deposit.Sub(contract.Paid))
+	}
+	// the coin is left unvalidated, the contracts stored without a rate escrow nothing
+	return cosmos.Coin{Denom: contract.Rate.Denom, Amount: amount}
+}
+
+// Equals return true when both stats hold the same counters
+func (stats NetworkStats) Equals(other NetworkStats) bool {
+	return stats.Providers == other.Providers &&
+		stats.ProvidersOnline == other.ProvidersOnline &&
+		stats.OpenContracts == other.OpenContracts &&
+		cosmos.Coins(stats.Bonded).Equal(other.Bonded) &&
+		cosmos.Coins(stats.Escrowed).Equal(other.Escrowed)
+}
+
+func addCoin(coins []cosmos.Coin, coin cosmos.Coin) []cosmos.Coin {
+	if coin.Denom == "" || !coin.IsPositive() {
+		return coins
+	}
+	return cosmos.NewCoins(coins...).Add(coin)
+}
+
+// subCoin subtract the coin down to zero, the counters never go negative
+func subCoin(coins []cosmos.Coin, coin cosmos.Coin) []cosmos.Coin {
+	total := cosmos.NewCoins(coins...)
+	if coin.Denom == "" {
+		return total
+	}
+	if amount := total.AmountOf(coin.Denom); coin.Amount.GT(amount) {
+		coin.Amount = amount
+	}
+	if !coin.IsPositive() {
+		return total
+	}
+	return total.Sub(coin)
+}
+
+func decrement(count uint64) uint64 {
+	if count == 0 {
+		return 0
+	}
+	return count - 1
+}
+
+// Contains return true when the contract is in the set
+func (userContractSet *UserContractSet) Contains(id uint64) bool {
+	for _, contractId := range userContractSet.ContractSet.GetContractIds() {
+		if contractId == id {
+			return true
+		}
+	}
+	return false
+}
+
 func (userContractSet *UserContractSet) RemoveContractFromSet(contractIdToRemove uint64) error {
 	if userContractSet == nil {
 		return fmt.Errorf("user contract set is nil")
@@ -103,6 +103,36 @@ func (ContractAuthorization) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_f833050061122841, []int{2}
 }
 
+// AllowlistAction how the clients of a MsgSetProviderAllowlist update the
+// allowlist of the provider
+type AllowlistAction int32
+
+const (
+	AllowlistAction_ALLOWLIST_REPLACE AllowlistAction = 0
+	AllowlistAction_ALLOWLIST_ADD     AllowlistAction = 1
+	AllowlistAction_ALLOWLIST_REMOVE  AllowlistAction = 2
+)
+
+var AllowlistAction_name = map[int32]string{
+	0: "ALLOWLIST_REPLACE",
+	1: "ALLOWLIST_ADD",
+	2: "ALLOWLIST_REMOVE",
+}
+
+var AllowlistAction_value = map[string]int32{
+	"ALLOWLIST_REPLACE": 0,
+	"ALLOWLIST_ADD":     1,
+	"ALLOWLIST_REMOVE":  2,
+}
+
+func (x AllowlistAction) String() string {
+	return proto.EnumName(AllowlistAction_name, int32(x))
+}
+
+func (AllowlistAction) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f833050061122841, []int{3}
+}
+
 type Provider struct {
 	PubKey              github_com_arkeonetwork_arkeo_common.PubKey  `protobuf:"bytes,1,opt,name=pub_key,json=pubKey,proto3,casttype=github.com/arkeonetwork/arkeo/common.PubKey" json:"pub_key,omitempty"`
 	Service             github_com_arkeonetwork_arkeo_common.Service `protobuf:"varint,2,opt,name=service,proto3,casttype=github.com/arkeonetwork/arkeo/common.Service" json:"service,omitempty"`
@@ -116,6 +146,12 @@ type Provider struct {
 	Bond                cosmossdk_io_math.Int                        `protobuf:"bytes,10,opt,name=bond,proto3,customtype=cosmossdk.io/math.Int" json:"bond"`
 	LastUpdate          int64                                        `protobuf:"varint,11,opt,name=last_update,json=lastUpdate,proto3" json:"last_update,omitempty"`
 	SettlementDuration  int64                                        `protobuf:"varint,12,opt,name=settlement_duration,json=settlementDuration,proto3" json:"settlement_duration,omitempty"`
+	// faults the provider was slashed for
+	Faults int64 `protobuf:"varint,13,opt,name=faults,proto3" json:"faults,omitempty"`
+	// contracts opened with the provider and not settled yet
+	OpenContracts uint64 `protobuf:"varint,14,opt,name=open_contracts,json=openContracts,proto3" json:"open_contracts,omitempty"`
+	// open contracts the provider accepts, zero for the max_open_contracts param
+	MaxOpenContracts uint64 `protobuf:"varint,15,opt,name=max_open_contracts,json=maxOpenContracts,proto3" json:"max_open_contracts,omitempty"`
 }
 
 func (m *Provider) Reset()         { *m = Provider{} }
@@ -228,6 +264,27 @@ func (m *Provider) GetSettlementDuration() int64 {
 	return 0
 }
 
+func (m *Provider) GetFaults() int64 {
+	if m != nil {
+		return m.Faults
+	}
+	return 0
+}
+
+func (m *Provider) GetOpenContracts() uint64 {
+	if m != nil {
+		return m.OpenContracts
+	}
+	return 0
+}
+
+func (m *Provider) GetMaxOpenContracts() uint64 {
+	if m != nil {
+		return m.MaxOpenContracts
+	}
+	return 0
+}
+
 type Contract struct {
 	Provider           github_com_arkeonetwork_arkeo_common.PubKey  `protobuf:"bytes,1,opt,name=provider,proto3,casttype=github.com/arkeonetwork/arkeo/common.PubKey" json:"provider,omitempty"`
 	Service            github_com_arkeonetwork_arkeo_common.Service `protobuf:"varint,2,opt,name=service,proto3,casttype=github.com/arkeonetwork/arkeo/common.Service" json:"service,omitempty"`
@@ -245,6 +302,20 @@ type Contract struct {
 	SettlementDuration int64                                        `protobuf:"varint,14,opt,name=settlement_duration,json=settlementDuration,proto3" json:"settlement_duration,omitempty"`
 	Authorization      ContractAuthorization                        `protobuf:"varint,15,opt,name=authorization,proto3,enum=arkeo.arkeo.ContractAuthorization" json:"authorization,omitempty"`
 	QueriesPerMinute   int64                                        `protobuf:"varint,16,opt,name=queries_per_minute,json=queriesPerMinute,proto3" json:"queries_per_minute,omitempty"`
+	// settlement grace period in effect when the contract was opened
+	SettlementGracePeriod int64 `protobuf:"varint,17,opt,name=settlement_grace_period,json=settlementGracePeriod,proto3" json:"settlement_grace_period,omitempty"`
+	// auto_renew extends the subscription at its expiration out of its top-up
+	// balance, instead of settling it
+	AutoRenew bool `protobuf:"varint,18,opt,name=auto_renew,json=autoRenew,proto3" json:"auto_renew,omitempty"`
+	// top_up is the balance the client escrowed for the auto-renewals, not part
+	// of the deposit until it renews the contract
+	TopUp cosmossdk_io_math.Int `protobuf:"bytes,19,opt,name=top_up,json=topUp,proto3,customtype=cosmossdk.io/math.Int" json:"top_up"`
+	// payer is the account which funded the deposit instead of the client, the
+	// refund of the deposit goes back to it. Empty when the client funded it.
+	Payer string `protobuf:"bytes,20,opt,name=payer,proto3" json:"payer,omitempty"`
+	// start_height is the height a scheduled contract starts at, its duration
+	// running from it. Zero when the contract started as it opened.
+	StartHeight int64 `protobuf:"varint,21,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
 }
 
 func (m *Contract) Reset()         { *m = Contract{} }
@@ -378,6 +449,34 @@ func (m *Contract) GetQueriesPerMinute() int64 {
 	return 0
 }
 
+func (m *Contract) GetSettlementGracePeriod() int64 {
+	if m != nil {
+		return m.SettlementGracePeriod
+	}
+	return 0
+}
+
+func (m *Contract) GetAutoRenew() bool {
+	if m != nil {
+		return m.AutoRenew
+	}
+	return false
+}
+
+func (m *Contract) GetPayer() string {
+	if m != nil {
+		return m.Payer
+	}
+	return ""
+}
+
+func (m *Contract) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
 type ContractSet struct {
 	ContractIds []uint64 `protobuf:"varint,1,rep,packed,name=contract_ids,json=contractIds,proto3" json:"contract_ids,omitempty"`
 }
@@ -526,319 +625,598 @@ func (m *UserContractSet) GetContractSet() *ContractSet {
 	return nil
 }
 
-func init() {
-	proto.RegisterEnum("arkeo.arkeo.ProviderStatus", ProviderStatus_name, ProviderStatus_value)
-	proto.RegisterEnum("arkeo.arkeo.ContractType", ContractType_name, ContractType_value)
-	proto.RegisterEnum("arkeo.arkeo.ContractAuthorization", ContractAuthorization_name, ContractAuthorization_value)
-	proto.RegisterType((*Provider)(nil), "arkeo.arkeo.Provider")
-	proto.RegisterType((*Contract)(nil), "arkeo.arkeo.Contract")
-	proto.RegisterType((*ContractSet)(nil), "arkeo.arkeo.ContractSet")
-	proto.RegisterType((*ContractExpirationSet)(nil), "arkeo.arkeo.ContractExpirationSet")
-	proto.RegisterType((*UserContractSet)(nil), "arkeo.arkeo.UserContractSet")
+// ProviderEarnings counters of a provider for a service, kept up to date as
+// its contracts open and settle
+type ProviderEarnings struct {
+	Provider github_com_arkeonetwork_arkeo_common.PubKey  `protobuf:"bytes,1,opt,name=provider,proto3,casttype=github.com/arkeonetwork/arkeo/common.PubKey" json:"provider,omitempty"`
+	Service  github_com_arkeonetwork_arkeo_common.Service `protobuf:"varint,2,opt,name=service,proto3,casttype=github.com/arkeonetwork/arkeo/common.Service" json:"service,omitempty"`
+	// income paid to the provider, net of the reserve tax
+	Income           []types.Coin `protobuf:"bytes,3,rep,name=income,proto3" json:"income"`
+	SettledContracts uint64       `protobuf:"varint,4,opt,name=settled_contracts,json=settledContracts,proto3" json:"settled_contracts,omitempty"`
+	// deposits of the unsettled contracts not paid yet, the most the provider
+	// can still claim
+	Escrowed []types.Coin `protobuf:"bytes,5,rep,name=escrowed,proto3" json:"escrowed"`
 }
 
-func init() { proto.RegisterFile("arkeo/arkeo/keeper.proto", fileDescriptor_f833050061122841) }
-
-var fileDescriptor_f833050061122841 = []byte{
-	// 938 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x41, 0x4f, 0xe3, 0x46,
-	0x14, 0x8e, 0xc1, 0x84, 0xf0, 0x12, 0xb2, 0x66, 0x76, 0xa9, 0x0c, 0x95, 0x42, 0x1a, 0x69, 0xa5,
-	0x14, 0x16, 0xa7, 0x0b, 0xbd, 0xf5, 0x50, 0x91, 0x34, 0x0b, 0x29, 0xdb, 0x24, 0x72, 0xc8, 0x81,
-	0x5e, 0xac, 0x89, 0x3d, 0x4a, 0x46, 0x89, 0x3d, 0xae, 0x67, 0x4c, 0x49, 0x7f, 0x45, 0xa5, 0xfe,
-	0x95, 0xf6, 0x3f, 0xec, 0x71, 0x55, 0xa9, 0x52, 0xd5, 0x03, 0xaa, 0xe0, 0x5f, 0xec, 0xa9, 0xf2,
-	0xd8, 0x26, 0x49, 0xcb, 0xaa, 0x34, 0xdd, 0x8b, 0xed, 0x79, 0xef, 0x7d, 0x1f, 0x33, 0xdf, 0xfb,
-	0xde, 0x10, 0xd0, 0x71, 0x30, 0x26, 0xac, 0x16, 0x3f, 0xc7, 0x84, 0xf8, 0x24, 0x30, 0xfc, 0x80,
-	0x09, 0x86, 0xf2, 0x32, 0x66, 0xc8, 0xe7, 0xee, 0xb3, 0x21, 0x1b, 0x32, 0x19, 0xaf, 0x45, 0x5f,
-	0x71, 0xc9, 0xee, 0x8e, 0xcd, 0xb8, 0xcb, 0xb8, 0x15, 0x27, 0xe2, 0x45, 0x92, 0x2a, 0xc5, 0xab,
-	0xda, 0x00, 0x73, 0x52, 0xbb, 0x7a, 0x39, 0x20, 0x02, 0xbf, 0xac, 0xd9, 0x8c, 0x7a, 0x71, 0xbe,
-	0xf2, 0xcb, 0x1a, 0xe4, 0xba, 0x01, 0xbb, 0xa2, 0x0e, 0x09, 0xd0, 0x19, 0xac, 0xfb, 0xe1, 0xc0,
-	0x1a, 0x93, 0xa9, 0xae, 0x94, 0x95, 0x6a, 0xa1, 0x5e, 0x7b, 0x77, 0xb3, 0x77, 0x30, 0xa4, 0x62,
-	0x14, 0x0e, 0x0c, 0x9b, 0xb9, 0xf1, 0xf6, 0x3c, 0x22, 0xbe, 0x67, 0xc1, 0x38, 0xd9, 0xab, 0xcd,
-	0x5c, 0x97, 0x79, 0x46, 0x37, 0x1c, 0x9c, 0x93, 0xa9, 0x99, 0xf5, 0xe5, 0x1b, 0x7d, 0x0d, 0xeb,
-	0x9c, 0x04, 0x57, 0xd4, 0x26, 0xfa, 0x4a, 0x59, 0xa9, 0xae, 0xd5, 0x3f, 0x7b, 0x77, 0xb3, 0xf7,
-	0xe2, 0x51, 0x4c, 0xbd, 0x18, 0x67, 0xa6, 0x04, 0xe8, 0x13, 0x28, 0xb8, 0x44, 0x60, 0x07, 0x0b,
-	0x6c, 0x85, 0x01, 0xd5, 0x57, 0xcb, 0x4a, 0x75, 0xc3, 0xcc, 0xa7, 0xb1, 0x7e, 0x40, 0xd1, 0x73,
-	0x28, 0xde, 0x97, 0x78, 0xcc, 0xb3, 0x89, 0xae, 0x96, 0x95, 0xaa, 0x6a, 0x6e, 0xa6, 0xd1, 0x76,
-	0x14, 0x44, 0xc7, 0x90, 0xe5, 0x02, 0x8b, 0x90, 0xeb, 0x6b, 0x65, 0xa5, 0x5a, 0x3c, 0xfa, 0xd8,
-	0x98, 0xd3, 0xd6, 0x48, 0x65, 0xe8, 0xc9, 0x12, 0x33, 0x29, 0x45, 0x47, 0xb0, 0xed, 0x52, 0xcf,
-	0xb2, 0x99, 0x27, 0x02, 0x6c, 0x0b, 0xcb, 0x09, 0x03, 0x2c, 0x28, 0xf3, 0xf4, 0x6c, 0x59, 0xa9,
-	0xae, 0x9a, 0x4f, 0x5d, 0xea, 0x35, 0x92, 0xdc, 0x57, 0x49, 0x4a, 0x62, 0xf0, 0xf5, 0x03, 0x98,
-	0xf5, 0x04, 0x83, 0xaf, 0xff, 0x81, 0x79, 0x0d, 0x5b, 0x3c, 0x1c, 0x70, 0x3b, 0xa0, 0x7e, 0xb4,
-	0xb6, 0x02, 0x2c, 0x88, 0x9e, 0x2b, 0xaf, 0x56, 0xf3, 0x47, 0x3b, 0x46, 0xd2, 0xd3, 0xa8, 0x8b,
-	0x46, 0xd2, 0x45, 0xa3, 0xc1, 0xa8, 0x57, 0x57, 0xdf, 0xdc, 0xec, 0x65, 0x4c, 0x6d, 0x1e, 0x69,
-	0x62, 0x41, 0xd0, 0x39, 0x20, 0x1f, 0x4f, 0x2d, 0xcc, 0xad, 0x29, 0x0b, 0xad, 0x21, 0x8b, 0xe9,
-	0x36, 0x1e, 0x47, 0x57, 0xf4, 0xf1, 0xf4, 0x84, 0x5f, 0xb2, 0xf0, 0x94, 0x49, 0xb2, 0x2f, 0x41,
-	0x1d, 0x30, 0xcf, 0xd1, 0x21, 0x52, 0xbe, 0x7e, 0x10, 0xd5, 0xfc, 0x71, 0xb3, 0xb7, 0x1d, 0xb3,
-	0x70, 0x67, 0x6c, 0x50, 0x56, 0x73, 0xb1, 0x18, 0x19, 0x2d, 0x4f, 0xfc, 0xfa, 0xf3, 0x21, 0x24,
-	0xf4, 0x2d, 0x4f, 0x98, 0x12, 0x88, 0xf6, 0x20, 0x3f, 0xc1, 0x5c, 0x58, 0xa1, 0xef, 0x44, 0xdb,
-	0xc8, 0x4b, 0x15, 0x20, 0x0a, 0xf5, 0x65, 0x04, 0xd5, 0xe0, 0x29, 0x27, 0x42, 0x4c, 0x88, 0x4b,
-	0xbc, 0x39, 0xb9, 0x0a, 0xb2, 0x10, 0xcd, 0x52, 0xa9, 0x5a, 0x95, 0xdf, 0xb2, 0x90, 0x4b, 0x25,
-	0x44, 0xe7, 0x90, 0xf3, 0x93, 0xe6, 0x2d, 0x6b, 0xdc, 0x7b, 0x82, 0x0f, 0x6a, 0xdd, 0x53, 0xc8,
-	0xda, 0x13, 0x4a, 0x3c, 0x21, 0x4d, 0xbb, 0xcc, 0x3c, 0xc5, 0xf0, 0xe8, 0x84, 0x0e, 0x99, 0x90,
-	0x61, 0xa4, 0x9e, 0xba, 0xe4, 0x09, 0x53, 0x02, 0x74, 0x08, 0xaa, 0x98, 0xfa, 0x24, 0x19, 0x82,
-	0x9d, 0x85, 0x21, 0x48, 0x35, 0xbd, 0x98, 0xfa, 0xc4, 0x94, 0x65, 0xe8, 0x23, 0xc8, 0x8e, 0x08,
-	0x1d, 0x8e, 0x44, 0xe2, 0xf8, 0x64, 0x85, 0x76, 0x21, 0xf7, 0x37, 0x5f, 0xdf, 0xaf, 0xd1, 0x31,
-	0xa8, 0x89, 0x7f, 0x95, 0xc7, 0x18, 0x4e, 0x16, 0xa3, 0x26, 0xac, 0x3b, 0xc4, 0x67, 0x9c, 0x0a,
-	0x7d, 0xe3, 0xbf, 0x3b, 0x2d, 0xc5, 0x46, 0x6e, 0xf5, 0x31, 0x5d, 0xce, 0xad, 0x11, 0x10, 0x3d,
-	0x83, 0xb5, 0xf8, 0x12, 0x89, 0x7d, 0x1a, 0x2f, 0xd0, 0x01, 0x6c, 0xcd, 0x59, 0x34, 0x51, 0x24,
-	0x36, 0xa8, 0x36, 0x4b, 0x9c, 0xc5, 0xda, 0x14, 0x61, 0x85, 0x3a, 0xfa, 0xa6, 0xbc, 0x84, 0x56,
-	0xa8, 0xf3, 0x3e, 0x7f, 0x17, 0xdf, 0xe7, 0x6f, 0x74, 0x06, 0x9b, 0x38, 0x14, 0x23, 0x16, 0xd0,
-	0x1f, 0xe2, 0xd2, 0x27, 0xb2, 0x59, 0x95, 0x07, 0x9b, 0x75, 0x32, 0x5f, 0x69, 0x2e, 0x02, 0xd1,
-	0x0b, 0x40, 0xdf, 0x85, 0x24, 0xa0, 0x84, 0x5b, 0x3e, 0x09, 0x2c, 0x97, 0x7a, 0xa1, 0x20, 0xba,
-	0x16, 0x6f, 0x3c, 0xc9, 0x74, 0x49, 0xf0, 0x8d, 0x8c, 0x57, 0x3e, 0x87, 0x7c, 0xca, 0xda, 0x23,
-	0x02, 0x3d, 0x87, 0xc2, 0xfd, 0x25, 0x46, 0x1d, 0xae, 0x2b, 0xe5, 0xd5, 0xaa, 0x5a, 0x5f, 0xd1,
-	0x14, 0x33, 0x9f, 0xc6, 0x5b, 0x0e, 0xaf, 0x4c, 0x60, 0x3b, 0x45, 0x35, 0xaf, 0x7d, 0x1a, 0x9f,
-	0x21, 0xc2, 0xcf, 0xbc, 0xa3, 0x2c, 0x78, 0xe7, 0x8b, 0x39, 0x5e, 0x4e, 0x84, 0x9c, 0xb4, 0xfc,
-	0x91, 0xfe, 0xe0, 0xe9, 0x7a, 0x44, 0xcc, 0xfe, 0x5a, 0x8f, 0x88, 0xca, 0x4f, 0x0a, 0x3c, 0xe9,
-	0x73, 0x12, 0xcc, 0x6f, 0xb4, 0x01, 0x6a, 0xc8, 0x97, 0x1f, 0x7f, 0x09, 0xfe, 0x5f, 0xbb, 0xda,
-	0xff, 0x14, 0x8a, 0x8b, 0xff, 0x41, 0x50, 0x1e, 0xd6, 0x3b, 0xaf, 0x5e, 0xbd, 0x6e, 0xb5, 0x9b,
-	0x5a, 0x06, 0x01, 0x64, 0x3b, 0x6d, 0xf9, 0xad, 0xec, 0x1f, 0x43, 0x61, 0x7e, 0xce, 0x90, 0x06,
-	0x85, 0x5e, 0xbf, 0xde, 0x6b, 0x98, 0xad, 0xee, 0x45, 0xab, 0xd3, 0xd6, 0x32, 0x68, 0x0b, 0x36,
-	0xbb, 0x27, 0x97, 0xd6, 0x49, 0xcf, 0xba, 0xec, 0xf4, 0xad, 0xd3, 0x8e, 0xa6, 0xec, 0x1f, 0xce,
-	0x34, 0x5e, 0xe8, 0x77, 0xc4, 0xdc, 0xbb, 0x30, 0x5b, 0x8d, 0x0b, 0x2d, 0x83, 0x72, 0xa0, 0x76,
-	0xba, 0xcd, 0xb6, 0xa6, 0xd4, 0x9b, 0x6f, 0x6e, 0x4b, 0xca, 0xdb, 0xdb, 0x92, 0xf2, 0xe7, 0x6d,
-	0x49, 0xf9, 0xf1, 0xae, 0x94, 0x79, 0x7b, 0x57, 0xca, 0xfc, 0x7e, 0x57, 0xca, 0x7c, 0xfb, 0x2f,
-	0xc2, 0x5c, 0x27, 0xef, 0x68, 0xf6, 0xf9, 0x20, 0x2b, 0x7f, 0x26, 0x1c, 0xff, 0x15, 0x00, 0x00,
-	0xff, 0xff, 0xf0, 0x10, 0xd0, 0xfd, 0xa0, 0x08, 0x00, 0x00,
+func (m *ProviderEarnings) Reset()         { *m = ProviderEarnings{} }
+func (m *ProviderEarnings) String() string { return proto.CompactTextString(m) }
+func (*ProviderEarnings) ProtoMessage()    {}
+func (*ProviderEarnings) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f833050061122841, []int{5}
+}
+func (m *ProviderEarnings) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProviderEarnings) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProviderEarnings.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProviderEarnings) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProviderEarnings.Merge(m, src)
+}
+func (m *ProviderEarnings) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProviderEarnings) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProviderEarnings.DiscardUnknown(m)
 }
 
-func (m *Provider) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ProviderEarnings proto.InternalMessageInfo
+
+func (m *ProviderEarnings) GetProvider() github_com_arkeonetwork_arkeo_common.PubKey {
+	if m != nil {
+		return m.Provider
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Provider) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ProviderEarnings) GetService() github_com_arkeonetwork_arkeo_common.Service {
+	if m != nil {
+		return m.Service
+	}
+	return 0
 }
 
-func (m *Provider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.SettlementDuration != 0 {
-		i = encodeVarintKeeper(dAtA, i, uint64(m.SettlementDuration))
-		i--
-		dAtA[i] = 0x60
+func (m *ProviderEarnings) GetIncome() []types.Coin {
+	if m != nil {
+		return m.Income
 	}
-	if m.LastUpdate != 0 {
-		i = encodeVarintKeeper(dAtA, i, uint64(m.LastUpdate))
-		i--
-		dAtA[i] = 0x58
+	return nil
+}
+
+func (m *ProviderEarnings) GetSettledContracts() uint64 {
+	if m != nil {
+		return m.SettledContracts
 	}
-	{
-		size := m.Bond.Size()
-		i -= size
-		if _, err := m.Bond.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintKeeper(dAtA, i, uint64(size))
+	return 0
+}
+
+func (m *ProviderEarnings) GetEscrowed() []types.Coin {
+	if m != nil {
+		return m.Escrowed
 	}
-	i--
-	dAtA[i] = 0x52
-	if len(m.PayAsYouGoRate) > 0 {
-		for iNdEx := len(m.PayAsYouGoRate) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PayAsYouGoRate[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintKeeper(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x4a
+	return nil
+}
+
+// ContractSettlement breakdown of the settlement of a contract
+type ContractSettlement struct {
+	ContractId uint64 `protobuf:"varint,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	// nonce the contract settles at
+	Nonce int64 `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// the settlement closes the contract, refunding the deposit left
+	Final bool `protobuf:"varint,3,opt,name=final,proto3" json:"final,omitempty"`
+	// owed for the queries or blocks served since the last settlement
+	Owed types.Coin `protobuf:"bytes,4,opt,name=owed,proto3" json:"owed"`
+	// paid to the provider, the amount owed net of the reserve tax
+	ProviderIncome types.Coin `protobuf:"bytes,5,opt,name=provider_income,json=providerIncome,proto3" json:"provider_income"`
+	ReserveTax     types.Coin `protobuf:"bytes,6,opt,name=reserve_tax,json=reserveTax,proto3" json:"reserve_tax"`
+	// deposit left refunded to the client, when final
+	Refund types.Coin `protobuf:"bytes,7,opt,name=refund,proto3" json:"refund"`
+	// claimed beyond the deposit left, the provider goes without
+	Unpaid types.Coin `protobuf:"bytes,8,opt,name=unpaid,proto3" json:"unpaid"`
+	// rounding remainder of the settlement, accrued to the reserve along the
+	// reserve tax
+	Dust types.Coin `protobuf:"bytes,9,opt,name=dust,proto3" json:"dust"`
+}
+
+func (m *ContractSettlement) Reset()         { *m = ContractSettlement{} }
+func (m *ContractSettlement) String() string { return proto.CompactTextString(m) }
+func (*ContractSettlement) ProtoMessage()    {}
+func (*ContractSettlement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f833050061122841, []int{6}
+}
+func (m *ContractSettlement) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractSettlement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractSettlement.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.SubscriptionRate) > 0 {
-		for iNdEx := len(m.SubscriptionRate) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.SubscriptionRate[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintKeeper(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x42
-		}
+}
+func (m *ContractSettlement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractSettlement.Merge(m, src)
+}
+func (m *ContractSettlement) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractSettlement) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractSettlement.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractSettlement proto.InternalMessageInfo
+
+func (m *ContractSettlement) GetContractId() uint64 {
+	if m != nil {
+		return m.ContractId
 	}
-	if m.MaxContractDuration != 0 {
-		i = encodeVarintKeeper(dAtA, i, uint64(m.MaxContractDuration))
-		i--
-		dAtA[i] = 0x38
+	return 0
+}
+
+func (m *ContractSettlement) GetNonce() int64 {
+	if m != nil {
+		return m.Nonce
 	}
-	if m.MinContractDuration != 0 {
-		i = encodeVarintKeeper(dAtA, i, uint64(m.MinContractDuration))
-		i--
-		dAtA[i] = 0x30
+	return 0
+}
+
+func (m *ContractSettlement) GetFinal() bool {
+	if m != nil {
+		return m.Final
 	}
-	if m.Status != 0 {
-		i = encodeVarintKeeper(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x28
+	return false
+}
+
+func (m *ContractSettlement) GetOwed() types.Coin {
+	if m != nil {
+		return m.Owed
 	}
-	if m.MetadataNonce != 0 {
-		i = encodeVarintKeeper(dAtA, i, uint64(m.MetadataNonce))
-		i--
-		dAtA[i] = 0x20
+	return types.Coin{}
+}
+
+func (m *ContractSettlement) GetProviderIncome() types.Coin {
+	if m != nil {
+		return m.ProviderIncome
 	}
-	if len(m.MetadataUri) > 0 {
-		i -= len(m.MetadataUri)
-		copy(dAtA[i:], m.MetadataUri)
-		i = encodeVarintKeeper(dAtA, i, uint64(len(m.MetadataUri)))
-		i--
-		dAtA[i] = 0x1a
+	return types.Coin{}
+}
+
+func (m *ContractSettlement) GetReserveTax() types.Coin {
+	if m != nil {
+		return m.ReserveTax
 	}
-	if m.Service != 0 {
-		i = encodeVarintKeeper(dAtA, i, uint64(m.Service))
-		i--
-		dAtA[i] = 0x10
+	return types.Coin{}
+}
+
+func (m *ContractSettlement) GetRefund() types.Coin {
+	if m != nil {
+		return m.Refund
 	}
-	if len(m.PubKey) > 0 {
-		i -= len(m.PubKey)
-		copy(dAtA[i:], m.PubKey)
-		i = encodeVarintKeeper(dAtA, i, uint64(len(m.PubKey)))
-		i--
-		dAtA[i] = 0xa
+	return types.Coin{}
+}
+
+func (m *ContractSettlement) GetUnpaid() types.Coin {
+	if m != nil {
+		return m.Unpaid
 	}
-	return len(dAtA) - i, nil
+	return types.Coin{}
 }
 
-func (m *Contract) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ContractSettlement) GetDust() types.Coin {
+	if m != nil {
+		return m.Dust
 	}
-	return dAtA[:n], nil
+	return types.Coin{}
 }
 
-func (m *Contract) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ContractClaimResult outcome of one of the claims of a batch
+type ContractClaimResult struct {
+	ContractId uint64 `protobuf:"varint,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	Nonce      int64  `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	Success    bool   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	// why the claim failed, empty when it succeeded
+	Error string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
 }
 
-func (m *Contract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.QueriesPerMinute != 0 {
-		i = encodeVarintKeeper(dAtA, i, uint64(m.QueriesPerMinute))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x80
-	}
-	if m.Authorization != 0 {
-		i = encodeVarintKeeper(dAtA, i, uint64(m.Authorization))
-		i--
-		dAtA[i] = 0x78
+func (m *ContractClaimResult) Reset()         { *m = ContractClaimResult{} }
+func (m *ContractClaimResult) String() string { return proto.CompactTextString(m) }
+func (*ContractClaimResult) ProtoMessage()    {}
+func (*ContractClaimResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f833050061122841, []int{7}
+}
+func (m *ContractClaimResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractClaimResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractClaimResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.SettlementDuration != 0 {
-		i = encodeVarintKeeper(dAtA, i, uint64(m.SettlementDuration))
-		i--
-		dAtA[i] = 0x70
+}
+func (m *ContractClaimResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractClaimResult.Merge(m, src)
+}
+func (m *ContractClaimResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractClaimResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractClaimResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractClaimResult proto.InternalMessageInfo
+
+func (m *ContractClaimResult) GetContractId() uint64 {
+	if m != nil {
+		return m.ContractId
 	}
-	if m.Id != 0 {
-		i = encodeVarintKeeper(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x68
+	return 0
+}
+
+func (m *ContractClaimResult) GetNonce() int64 {
+	if m != nil {
+		return m.Nonce
 	}
-	if m.SettlementHeight != 0 {
-		i = encodeVarintKeeper(dAtA, i, uint64(m.SettlementHeight))
-		i--
-		dAtA[i] = 0x60
+	return 0
+}
+
+func (m *ContractClaimResult) GetSuccess() bool {
+	if m != nil {
+		return m.Success
 	}
-	if m.Nonce != 0 {
-		i = encodeVarintKeeper(dAtA, i, uint64(m.Nonce))
-		i--
-		dAtA[i] = 0x58
+	return false
+}
+
+func (m *ContractClaimResult) GetError() string {
+	if m != nil {
+		return m.Error
 	}
-	{
-		size := m.Paid.Size()
-		i -= size
-		if _, err := m.Paid.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	return ""
+}
+
+// ContractPurgeResult outcome of the purge of one of the contracts of a
+// MsgPurgeExpiredContracts
+type ContractPurgeResult struct {
+	ContractId uint64 `protobuf:"varint,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	Success    bool   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	// why the purge failed, empty when it succeeded
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *ContractPurgeResult) Reset()         { *m = ContractPurgeResult{} }
+func (m *ContractPurgeResult) String() string { return proto.CompactTextString(m) }
+func (*ContractPurgeResult) ProtoMessage()    {}
+func (*ContractPurgeResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f833050061122841, []int{8}
+}
+func (m *ContractPurgeResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractPurgeResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractPurgeResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintKeeper(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x52
-	{
-		size := m.Deposit.Size()
-		i -= size
-		if _, err := m.Deposit.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintKeeper(dAtA, i, uint64(size))
+}
+func (m *ContractPurgeResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractPurgeResult.Merge(m, src)
+}
+func (m *ContractPurgeResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractPurgeResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractPurgeResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractPurgeResult proto.InternalMessageInfo
+
+func (m *ContractPurgeResult) GetContractId() uint64 {
+	if m != nil {
+		return m.ContractId
 	}
-	i--
-	dAtA[i] = 0x4a
-	{
-		size, err := m.Rate.MarshalToSizedBuffer(dAtA[:i])
+	return 0
+}
+
+func (m *ContractPurgeResult) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+func (m *ContractPurgeResult) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+// ProviderAllowlist clients allowed to open contracts with a provider for a
+// service, any client can when it is empty
+type ProviderAllowlist struct {
+	Provider github_com_arkeonetwork_arkeo_common.PubKey   `protobuf:"bytes,1,opt,name=provider,proto3,casttype=github.com/arkeonetwork/arkeo/common.PubKey" json:"provider,omitempty"`
+	Service  github_com_arkeonetwork_arkeo_common.Service  `protobuf:"varint,2,opt,name=service,proto3,casttype=github.com/arkeonetwork/arkeo/common.Service" json:"service,omitempty"`
+	Clients  []github_com_arkeonetwork_arkeo_common.PubKey `protobuf:"bytes,3,rep,name=clients,proto3,casttype=github.com/arkeonetwork/arkeo/common.PubKey" json:"clients,omitempty"`
+}
+
+func (m *ProviderAllowlist) Reset()         { *m = ProviderAllowlist{} }
+func (m *ProviderAllowlist) String() string { return proto.CompactTextString(m) }
+func (*ProviderAllowlist) ProtoMessage()    {}
+func (*ProviderAllowlist) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f833050061122841, []int{9}
+}
+func (m *ProviderAllowlist) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProviderAllowlist) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProviderAllowlist.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintKeeper(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x42
-	if m.Duration != 0 {
-		i = encodeVarintKeeper(dAtA, i, uint64(m.Duration))
-		i--
-		dAtA[i] = 0x38
+}
+func (m *ProviderAllowlist) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProviderAllowlist.Merge(m, src)
+}
+func (m *ProviderAllowlist) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProviderAllowlist) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProviderAllowlist.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProviderAllowlist proto.InternalMessageInfo
+
+func (m *ProviderAllowlist) GetProvider() github_com_arkeonetwork_arkeo_common.PubKey {
+	if m != nil {
+		return m.Provider
 	}
-	if m.Height != 0 {
-		i = encodeVarintKeeper(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x30
+	return nil
+}
+
+func (m *ProviderAllowlist) GetService() github_com_arkeonetwork_arkeo_common.Service {
+	if m != nil {
+		return m.Service
 	}
-	if m.Type != 0 {
-		i = encodeVarintKeeper(dAtA, i, uint64(m.Type))
-		i--
-		dAtA[i] = 0x28
+	return 0
+}
+
+func (m *ProviderAllowlist) GetClients() []github_com_arkeonetwork_arkeo_common.PubKey {
+	if m != nil {
+		return m.Clients
 	}
-	if len(m.Delegate) > 0 {
-		i -= len(m.Delegate)
-		copy(dAtA[i:], m.Delegate)
-		i = encodeVarintKeeper(dAtA, i, uint64(len(m.Delegate)))
-		i--
-		dAtA[i] = 0x22
+	return nil
+}
+
+// NetworkStats counters of the providers and contracts of the network, kept up
+// to date as they are stored
+type NetworkStats struct {
+	// providers bonded to a service, a provider of several services counting
+	// once for each of them
+	Providers       uint64       `protobuf:"varint,1,opt,name=providers,proto3" json:"providers,omitempty"`
+	ProvidersOnline uint64       `protobuf:"varint,2,opt,name=providers_online,json=providersOnline,proto3" json:"providers_online,omitempty"`
+	Bonded          []types.Coin `protobuf:"bytes,3,rep,name=bonded,proto3" json:"bonded"`
+	// contracts not settled yet
+	OpenContracts uint64 `protobuf:"varint,4,opt,name=open_contracts,json=openContracts,proto3" json:"open_contracts,omitempty"`
+	// deposits not paid yet and top-up balances of the open contracts
+	Escrowed []types.Coin `protobuf:"bytes,5,rep,name=escrowed,proto3" json:"escrowed"`
+}
+
+func (m *NetworkStats) Reset()         { *m = NetworkStats{} }
+func (m *NetworkStats) String() string { return proto.CompactTextString(m) }
+func (*NetworkStats) ProtoMessage()    {}
+func (*NetworkStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f833050061122841, []int{10}
+}
+func (m *NetworkStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NetworkStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NetworkStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Client) > 0 {
-		i -= len(m.Client)
-		copy(dAtA[i:], m.Client)
-		i = encodeVarintKeeper(dAtA, i, uint64(len(m.Client)))
-		i--
-		dAtA[i] = 0x1a
+}
+func (m *NetworkStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NetworkStats.Merge(m, src)
+}
+func (m *NetworkStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *NetworkStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_NetworkStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NetworkStats proto.InternalMessageInfo
+
+func (m *NetworkStats) GetProviders() uint64 {
+	if m != nil {
+		return m.Providers
 	}
-	if m.Service != 0 {
-		i = encodeVarintKeeper(dAtA, i, uint64(m.Service))
-		i--
-		dAtA[i] = 0x10
+	return 0
+}
+
+func (m *NetworkStats) GetProvidersOnline() uint64 {
+	if m != nil {
+		return m.ProvidersOnline
 	}
-	if len(m.Provider) > 0 {
-		i -= len(m.Provider)
-		copy(dAtA[i:], m.Provider)
-		i = encodeVarintKeeper(dAtA, i, uint64(len(m.Provider)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *NetworkStats) GetBonded() []types.Coin {
+	if m != nil {
+		return m.Bonded
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *ContractSet) Marshal() (dAtA []byte, err error) {
+func (m *NetworkStats) GetOpenContracts() uint64 {
+	if m != nil {
+		return m.OpenContracts
+	}
+	return 0
+}
+
+func (m *NetworkStats) GetEscrowed() []types.Coin {
+	if m != nil {
+		return m.Escrowed
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("arkeo.arkeo.ProviderStatus", ProviderStatus_name, ProviderStatus_value)
+	proto.RegisterEnum("arkeo.arkeo.ContractType", ContractType_name, ContractType_value)
+	proto.RegisterEnum("arkeo.arkeo.ContractAuthorization", ContractAuthorization_name, ContractAuthorization_value)
+	proto.RegisterEnum("arkeo.arkeo.AllowlistAction", AllowlistAction_name, AllowlistAction_value)
+	proto.RegisterType((*Provider)(nil), "arkeo.arkeo.Provider")
+	proto.RegisterType((*Contract)(nil), "arkeo.arkeo.Contract")
+	proto.RegisterType((*ContractSet)(nil), "arkeo.arkeo.ContractSet")
+	proto.RegisterType((*ContractExpirationSet)(nil), "arkeo.arkeo.ContractExpirationSet")
+	proto.RegisterType((*UserContractSet)(nil), "arkeo.arkeo.UserContractSet")
+	proto.RegisterType((*ProviderEarnings)(nil), "arkeo.arkeo.ProviderEarnings")
+	proto.RegisterType((*ContractSettlement)(nil), "arkeo.arkeo.ContractSettlement")
+	proto.RegisterType((*ContractClaimResult)(nil), "arkeo.arkeo.ContractClaimResult")
+	proto.RegisterType((*ContractPurgeResult)(nil), "arkeo.arkeo.ContractPurgeResult")
+	proto.RegisterType((*ProviderAllowlist)(nil), "arkeo.arkeo.ProviderAllowlist")
+	proto.RegisterType((*NetworkStats)(nil), "arkeo.arkeo.NetworkStats")
+}
+
+func init() { proto.RegisterFile("arkeo/arkeo/keeper.proto", fileDescriptor_f833050061122841) }
+
+var fileDescriptor_f833050061122841 = []byte{
+	// 1462 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0x4f, 0x6f, 0x23, 0x49,
+	0x15, 0x4f, 0xfb, 0x7f, 0x9e, 0x13, 0xa7, 0x53, 0x49, 0xa0, 0x67, 0x80, 0xc4, 0x58, 0x5a, 0xc9,
+	0x9b, 0xd9, 0xd8, 0x6c, 0x82, 0xe0, 0xb0, 0x07, 0xb0, 0x33, 0xde, 0xc4, 0x4c, 0x36, 0x36, 0xed,
+	0x04, 0x34, 0x5c, 0x5a, 0xe5, 0xee, 0x8a, 0xd3, 0x8a, 0xdd, 0xd5, 0x54, 0x55, 0x4f, 0x62, 0xc4,
+	0x87, 0x40, 0xe2, 0x1b, 0xf0, 0x19, 0xf6, 0x1b, 0x70, 0x59, 0x2e, 0x68, 0x35, 0x27, 0xc4, 0x21,
+	0x42, 0x33, 0x5f, 0x01, 0x09, 0x69, 0x4e, 0xa8, 0xaa, 0xba, 0xed, 0xce, 0x90, 0x11, 0x9e, 0xcc,
+	0x1e, 0xe6, 0x92, 0xf8, 0xbd, 0xfa, 0xfd, 0x5e, 0x3d, 0xbf, 0x57, 0xbf, 0x57, 0x65, 0xb0, 0x30,
+	0xbb, 0x22, 0xb4, 0xa9, 0xff, 0x5e, 0x11, 0x12, 0x12, 0xd6, 0x08, 0x19, 0x15, 0x14, 0x95, 0x95,
+	0xaf, 0xa1, 0xfe, 0x3e, 0xde, 0x1c, 0xd1, 0x11, 0x55, 0xfe, 0xa6, 0xfc, 0xa4, 0x21, 0x8f, 0x1f,
+	0xb9, 0x94, 0x4f, 0x28, 0x77, 0xf4, 0x82, 0x36, 0xe2, 0xa5, 0x6d, 0x6d, 0x35, 0x87, 0x98, 0x93,
+	0xe6, 0x8b, 0xcf, 0x87, 0x44, 0xe0, 0xcf, 0x9b, 0x2e, 0xf5, 0x03, 0xbd, 0x5e, 0xfb, 0x4b, 0x01,
+	0x4a, 0x7d, 0x46, 0x5f, 0xf8, 0x1e, 0x61, 0xe8, 0x18, 0x8a, 0x61, 0x34, 0x74, 0xae, 0xc8, 0xd4,
+	0x32, 0xaa, 0x46, 0x7d, 0xa5, 0xdd, 0x7c, 0x73, 0xbb, 0xf3, 0x64, 0xe4, 0x8b, 0xcb, 0x68, 0xd8,
+	0x70, 0xe9, 0x44, 0xa7, 0x17, 0x10, 0x71, 0x4d, 0xd9, 0x55, 0x9c, 0xab, 0x4b, 0x27, 0x13, 0x1a,
+	0x34, 0xfa, 0xd1, 0xf0, 0x19, 0x99, 0xda, 0x85, 0x50, 0xfd, 0x47, 0xbf, 0x82, 0x22, 0x27, 0xec,
+	0x85, 0xef, 0x12, 0x2b, 0x53, 0x35, 0xea, 0xf9, 0xf6, 0x4f, 0xde, 0xdc, 0xee, 0x7c, 0xb6, 0x50,
+	0xa4, 0x81, 0xe6, 0xd9, 0x49, 0x00, 0xf4, 0x63, 0x58, 0x99, 0x10, 0x81, 0x3d, 0x2c, 0xb0, 0x13,
+	0x31, 0xdf, 0xca, 0x56, 0x8d, 0xfa, 0xb2, 0x5d, 0x4e, 0x7c, 0xe7, 0xcc, 0x47, 0x9f, 0x40, 0x65,
+	0x06, 0x09, 0x68, 0xe0, 0x12, 0x2b, 0x57, 0x35, 0xea, 0x39, 0x7b, 0x35, 0xf1, 0x9e, 0x4a, 0x27,
+	0x3a, 0x80, 0x02, 0x17, 0x58, 0x44, 0xdc, 0xca, 0x57, 0x8d, 0x7a, 0x65, 0xff, 0x07, 0x8d, 0x54,
+	0x6d, 0x1b, 0x49, 0x19, 0x06, 0x0a, 0x62, 0xc7, 0x50, 0xb4, 0x0f, 0x5b, 0x13, 0x3f, 0x70, 0x5c,
+	0x1a, 0x08, 0x86, 0x5d, 0xe1, 0x78, 0x11, 0xc3, 0xc2, 0xa7, 0x81, 0x55, 0xa8, 0x1a, 0xf5, 0xac,
+	0xbd, 0x31, 0xf1, 0x83, 0xc3, 0x78, 0xed, 0x69, 0xbc, 0xa4, 0x38, 0xf8, 0xe6, 0x1e, 0x4e, 0x31,
+	0xe6, 0xe0, 0x9b, 0xff, 0xe1, 0x9c, 0xc0, 0x3a, 0x8f, 0x86, 0xdc, 0x65, 0x7e, 0x28, 0x6d, 0x87,
+	0x61, 0x41, 0xac, 0x52, 0x35, 0x5b, 0x2f, 0xef, 0x3f, 0x6a, 0xc4, 0x3d, 0x95, 0x5d, 0x6c, 0xc4,
+	0x5d, 0x6c, 0x1c, 0x52, 0x3f, 0x68, 0xe7, 0xbe, 0xb9, 0xdd, 0x59, 0xb2, 0xcd, 0x34, 0xd3, 0xc6,
+	0x82, 0xa0, 0x67, 0x80, 0x42, 0x3c, 0x75, 0x30, 0x77, 0xa6, 0x34, 0x72, 0x46, 0x54, 0x87, 0x5b,
+	0x5e, 0x2c, 0x5c, 0x25, 0xc4, 0xd3, 0x16, 0x7f, 0x4e, 0xa3, 0x23, 0xaa, 0x82, 0xfd, 0x02, 0x72,
+	0x43, 0x1a, 0x78, 0x16, 0xc8, 0xca, 0xb7, 0x9f, 0x48, 0xcc, 0x3f, 0x6f, 0x77, 0xb6, 0x74, 0x14,
+	0xee, 0x5d, 0x35, 0x7c, 0xda, 0x9c, 0x60, 0x71, 0xd9, 0xe8, 0x06, 0xe2, 0xe5, 0xd7, 0x7b, 0x10,
+	0x87, 0xef, 0x06, 0xc2, 0x56, 0x44, 0xb4, 0x03, 0xe5, 0x31, 0xe6, 0xc2, 0x89, 0x42, 0x4f, 0xa6,
+	0x51, 0x56, 0x55, 0x00, 0xe9, 0x3a, 0x57, 0x1e, 0xd4, 0x84, 0x0d, 0x4e, 0x84, 0x18, 0x93, 0x09,
+	0x09, 0x52, 0xe5, 0x5a, 0x51, 0x40, 0x34, 0x5f, 0x9a, 0x55, 0xeb, 0x7b, 0x50, 0xb8, 0xc0, 0xd1,
+	0x58, 0x70, 0x6b, 0x55, 0x61, 0x62, 0x4b, 0x9e, 0x04, 0x1a, 0x92, 0x79, 0xbb, 0xb8, 0x55, 0xd1,
+	0x27, 0x41, 0x7a, 0x93, 0x9a, 0x73, 0xf4, 0x19, 0x20, 0xd9, 0xa0, 0xb7, 0xa0, 0x6b, 0x0a, 0x6a,
+	0x4e, 0xf0, 0x4d, 0x2f, 0x8d, 0xae, 0xfd, 0xb5, 0x04, 0xa5, 0xc4, 0x42, 0xcf, 0xa0, 0x14, 0xc6,
+	0x27, 0xe5, 0xa1, 0x2a, 0x99, 0x05, 0xf8, 0x4e, 0x75, 0x72, 0x04, 0x05, 0x77, 0xec, 0x93, 0x40,
+	0x58, 0xd9, 0x87, 0xa5, 0x15, 0xd3, 0xe5, 0x37, 0xf4, 0xc8, 0x98, 0x8c, 0xb0, 0xd0, 0x3a, 0x7a,
+	0xc8, 0x37, 0x4c, 0x02, 0xa0, 0x3d, 0xc8, 0x89, 0x69, 0x48, 0x62, 0xc5, 0x3d, 0xba, 0xa3, 0xb8,
+	0xa4, 0xa6, 0x67, 0xd3, 0x90, 0xd8, 0x0a, 0x26, 0xfb, 0x7a, 0x49, 0xfc, 0xd1, 0xa5, 0x88, 0xe5,
+	0x15, 0x5b, 0xe8, 0x31, 0x94, 0xde, 0x12, 0xd1, 0xcc, 0x46, 0x07, 0x90, 0x8b, 0xc5, 0x62, 0x2c,
+	0x72, 0xba, 0x15, 0x18, 0x75, 0xa0, 0xe8, 0x91, 0x90, 0x72, 0x5f, 0x58, 0xcb, 0xef, 0x7f, 0xac,
+	0x13, 0xae, 0x94, 0x46, 0x88, 0xfd, 0x87, 0x49, 0x43, 0x12, 0xd1, 0x26, 0xe4, 0xf5, 0xc4, 0xd2,
+	0xa2, 0xd0, 0x06, 0x7a, 0x02, 0xeb, 0x29, 0x3d, 0xc4, 0x15, 0xd1, 0x6a, 0x30, 0xe7, 0x0b, 0xc7,
+	0xba, 0x36, 0x15, 0xc8, 0xf8, 0x9e, 0xd2, 0x41, 0xce, 0xce, 0xf8, 0xde, 0xbb, 0xc4, 0x54, 0x79,
+	0xa7, 0x98, 0x8e, 0x61, 0x15, 0x47, 0xe2, 0x92, 0x32, 0xff, 0x0f, 0x1a, 0xba, 0xa6, 0x9a, 0x55,
+	0xbb, 0xb7, 0x59, 0xad, 0x34, 0xd2, 0xbe, 0x4b, 0x94, 0xba, 0xfa, 0x7d, 0x44, 0x98, 0x4f, 0xb8,
+	0x13, 0x12, 0xe6, 0x4c, 0xfc, 0x20, 0x12, 0xc4, 0x32, 0x75, 0xe2, 0xf1, 0x4a, 0x9f, 0xb0, 0xaf,
+	0x94, 0x1f, 0xfd, 0x0c, 0xbe, 0x9f, 0x4a, 0x74, 0xc4, 0xb0, 0x4b, 0x24, 0xcd, 0xa7, 0x9e, 0xb5,
+	0xae, 0x28, 0x5b, 0xf3, 0xe5, 0x23, 0xb9, 0xda, 0x57, 0x8b, 0xe8, 0x47, 0x00, 0x38, 0x12, 0xd4,
+	0x61, 0x24, 0x20, 0xd7, 0x16, 0xaa, 0x1a, 0xf5, 0x92, 0xbd, 0x2c, 0x3d, 0xb6, 0x74, 0xa0, 0x36,
+	0x14, 0x04, 0x0d, 0x9d, 0x28, 0xb4, 0x36, 0xde, 0xbf, 0x2b, 0x79, 0x41, 0xc3, 0xf3, 0x10, 0x35,
+	0x20, 0x1f, 0xe2, 0x29, 0x61, 0xd6, 0xa6, 0x0a, 0x61, 0xbd, 0xfc, 0x7a, 0x6f, 0x33, 0x46, 0xb5,
+	0x3c, 0x8f, 0x11, 0xce, 0x07, 0x82, 0xf9, 0xc1, 0xc8, 0xd6, 0x30, 0x79, 0x49, 0x71, 0x81, 0xd9,
+	0xac, 0x57, 0x5b, 0x2a, 0xff, 0xb2, 0xf2, 0xe9, 0x36, 0xd5, 0x7e, 0x0a, 0xe5, 0xa4, 0x86, 0x03,
+	0x22, 0xd0, 0x27, 0xb0, 0x32, 0xbb, 0x1f, 0x7c, 0x8f, 0x5b, 0x46, 0x35, 0x5b, 0xcf, 0xb5, 0x33,
+	0xa6, 0x61, 0x97, 0x13, 0x7f, 0xd7, 0xe3, 0xb5, 0x31, 0x6c, 0x25, 0xac, 0xce, 0x4d, 0xe8, 0xeb,
+	0x8e, 0x49, 0xfe, 0x5c, 0x29, 0xc6, 0x1d, 0xa5, 0x7c, 0x91, 0x8a, 0xcb, 0x89, 0x50, 0x73, 0xa5,
+	0xbc, 0x6f, 0xdd, 0xdb, 0xcb, 0x01, 0x11, 0xf3, 0xdd, 0x06, 0x44, 0xd4, 0xfe, 0x6c, 0xc0, 0xda,
+	0x39, 0x27, 0x2c, 0x9d, 0xe8, 0x21, 0xe4, 0x22, 0xfe, 0xf0, 0x61, 0xa7, 0xc8, 0x1f, 0x96, 0xd5,
+	0xdf, 0x33, 0x60, 0x26, 0xb7, 0x73, 0x07, 0xb3, 0xc0, 0x0f, 0x46, 0xfc, 0xe3, 0x9d, 0xc3, 0x3f,
+	0x87, 0x82, 0x1f, 0xb8, 0x74, 0x42, 0xac, 0xec, 0x62, 0xd7, 0x6d, 0x0c, 0x9f, 0x8b, 0xde, 0x4b,
+	0xdd, 0x49, 0xfa, 0x21, 0x13, 0x8b, 0xde, 0x9b, 0xdf, 0x60, 0x5f, 0x40, 0x89, 0x70, 0x97, 0xd1,
+	0x6b, 0xe2, 0x59, 0xf9, 0xc5, 0xf6, 0x99, 0x11, 0x6a, 0x7f, 0xcb, 0x02, 0x4a, 0x55, 0x3b, 0x96,
+	0x98, 0xbc, 0xa6, 0x53, 0x47, 0x52, 0x55, 0x35, 0x67, 0xc3, 0xfc, 0x34, 0xce, 0x87, 0x55, 0x26,
+	0x3d, 0xac, 0x36, 0x21, 0x7f, 0xe1, 0x07, 0x78, 0xac, 0xee, 0x9d, 0x92, 0xad, 0x0d, 0x39, 0x95,
+	0x55, 0x72, 0xb9, 0x05, 0xa7, 0xb2, 0x04, 0xa3, 0x63, 0x58, 0x4b, 0x7a, 0xe2, 0xc4, 0x45, 0xcc,
+	0x2f, 0xc6, 0xaf, 0x24, 0xbc, 0xae, 0x2e, 0xe6, 0x2f, 0xa1, 0xcc, 0x88, 0x6c, 0x09, 0x71, 0x04,
+	0xbe, 0xb1, 0x0a, 0x8b, 0x45, 0x81, 0x98, 0x73, 0x86, 0x6f, 0x64, 0x1f, 0x19, 0xb9, 0x88, 0x02,
+	0xcf, 0x2a, 0x2e, 0x46, 0x8e, 0xe1, 0x92, 0x18, 0x05, 0xea, 0x56, 0x58, 0xf0, 0x46, 0x8a, 0xe1,
+	0xb2, 0x64, 0x5e, 0xc4, 0xf5, 0x85, 0xb4, 0x48, 0xc9, 0x24, 0xb8, 0xf6, 0x47, 0xd8, 0x48, 0x5a,
+	0x79, 0x38, 0xc6, 0xfe, 0xc4, 0x26, 0x3c, 0x1a, 0x3f, 0xb8, 0x97, 0x16, 0x14, 0x79, 0xe4, 0xba,
+	0x84, 0xf3, 0xb8, 0x9b, 0x89, 0x29, 0xf1, 0x84, 0x31, 0xca, 0x54, 0x43, 0x97, 0x6d, 0x6d, 0xd4,
+	0x2e, 0xe6, 0xbb, 0xf7, 0x23, 0x36, 0x22, 0x8b, 0xee, 0x9e, 0xda, 0x27, 0xf3, 0x8e, 0x7d, 0xb2,
+	0xe9, 0x7d, 0xfe, 0x63, 0xc0, 0x7a, 0x32, 0x02, 0x5a, 0xe3, 0x31, 0xbd, 0x1e, 0xfb, 0xfc, 0x23,
+	0x7e, 0x8b, 0x75, 0xa1, 0xa8, 0x1f, 0x53, 0x5c, 0x0d, 0x81, 0x07, 0xe4, 0x95, 0xf0, 0x6b, 0xff,
+	0x36, 0x60, 0xe5, 0x54, 0x03, 0xe5, 0x2f, 0x13, 0x8e, 0x7e, 0x08, 0xcb, 0x49, 0xce, 0x3c, 0xae,
+	0xec, 0xdc, 0x81, 0x3e, 0x05, 0x73, 0x66, 0x38, 0x34, 0x18, 0xfb, 0x81, 0xfe, 0x3a, 0x39, 0x7b,
+	0xa6, 0x2c, 0xde, 0x53, 0x6e, 0x79, 0x4e, 0xe5, 0xeb, 0x9c, 0x78, 0x0b, 0x0f, 0x2a, 0x0d, 0xbf,
+	0xe7, 0x91, 0x9d, 0xbb, 0xef, 0x91, 0xfd, 0x21, 0x23, 0x6a, 0xf7, 0x53, 0xa8, 0xdc, 0xfd, 0x41,
+	0x86, 0xca, 0x50, 0xec, 0x7d, 0xf9, 0xe5, 0x49, 0xf7, 0xb4, 0x63, 0x2e, 0x21, 0x80, 0x42, 0xef,
+	0x54, 0x7d, 0x36, 0x76, 0x0f, 0x60, 0x25, 0xfd, 0x92, 0x44, 0x26, 0xac, 0x0c, 0xce, 0xdb, 0x83,
+	0x43, 0xbb, 0xdb, 0x3f, 0xeb, 0xf6, 0x4e, 0xcd, 0x25, 0xb4, 0x0e, 0xab, 0xfd, 0xd6, 0x73, 0xa7,
+	0x35, 0x70, 0x9e, 0xf7, 0xce, 0x9d, 0xa3, 0x9e, 0x69, 0xec, 0xee, 0xc1, 0xd6, 0xbd, 0x2f, 0x1a,
+	0x19, 0x79, 0x70, 0x66, 0x77, 0x0f, 0xcf, 0xcc, 0x25, 0x54, 0x82, 0x5c, 0xaf, 0xdf, 0x39, 0x35,
+	0x8d, 0xdd, 0x5f, 0xc3, 0xda, 0xec, 0xd8, 0xb5, 0x5c, 0x05, 0xdc, 0x82, 0xf5, 0xd6, 0xc9, 0x49,
+	0xef, 0xb7, 0x27, 0xdd, 0xc1, 0x99, 0x63, 0x77, 0xfa, 0x27, 0xad, 0xc3, 0x8e, 0xde, 0x6b, 0xee,
+	0x6e, 0x3d, 0x7d, 0x6a, 0x1a, 0x68, 0x13, 0xcc, 0x34, 0xf2, 0xab, 0xde, 0x6f, 0x3a, 0x66, 0xa6,
+	0xdd, 0xf9, 0xe6, 0xd5, 0xb6, 0xf1, 0xed, 0xab, 0x6d, 0xe3, 0x5f, 0xaf, 0xb6, 0x8d, 0x3f, 0xbd,
+	0xde, 0x5e, 0xfa, 0xf6, 0xf5, 0xf6, 0xd2, 0x3f, 0x5e, 0x6f, 0x2f, 0xfd, 0xee, 0xff, 0x1c, 0x94,
+	0x9b, 0xf8, 0xbf, 0x7c, 0x30, 0xf3, 0x61, 0x41, 0xfd, 0x90, 0x3f, 0xf8, 0xef, 0x00, 0x93, 0x58,
+	0x3a, 0xa3, 0x42, 0x10, 0x00, 0x00,
+}
+
+func (m *Provider) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -848,38 +1226,122 @@ func (m *ContractSet) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ContractSet) MarshalTo(dAtA []byte) (int, error) {
+func (m *Provider) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ContractSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Provider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ContractIds) > 0 {
-		dAtA3 := make([]byte, len(m.ContractIds)*10)
-		var j2 int
-		for _, num := range m.ContractIds {
-			for num >= 1<<7 {
-				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j2++
-			}
-			dAtA3[j2] = uint8(num)
-			j2++
-		}
-		i -= j2
-		copy(dAtA[i:], dAtA3[:j2])
-		i = encodeVarintKeeper(dAtA, i, uint64(j2))
+	if m.MaxOpenContracts != 0 {
+		i = encodeVarintKeeper(dAtA, i, uint64(m.MaxOpenContracts))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.OpenContracts != 0 {
+		i = encodeVarintKeeper(dAtA, i, uint64(m.OpenContracts))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.Faults != 0 {
+		i = encodeVarintKeeper(dAtA, i, uint64(m.Faults))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.SettlementDuration != 0 {
+		i = encodeVarintKeeper(dAtA, i, uint64(m.SettlementDuration))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.LastUpdate != 0 {
+		i = encodeVarintKeeper(dAtA, i, uint64(m.LastUpdate))
+		i--
+		dAtA[i] = 0x58
+	}
+	{
+		size := m.Bond.Size()
+		i -= size
+		if _, err := m.Bond.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintKeeper(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if len(m.PayAsYouGoRate) > 0 {
+		for iNdEx := len(m.PayAsYouGoRate) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PayAsYouGoRate[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintKeeper(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.SubscriptionRate) > 0 {
+		for iNdEx := len(m.SubscriptionRate) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SubscriptionRate[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintKeeper(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.MaxContractDuration != 0 {
+		i = encodeVarintKeeper(dAtA, i, uint64(m.MaxContractDuration))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MinContractDuration != 0 {
+		i = encodeVarintKeeper(dAtA, i, uint64(m.MinContractDuration))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Status != 0 {
+		i = encodeVarintKeeper(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MetadataNonce != 0 {
+		i = encodeVarintKeeper(dAtA, i, uint64(m.MetadataNonce))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.MetadataUri) > 0 {
+		i -= len(m.MetadataUri)
+		copy(dAtA[i:], m.MetadataUri)
+		i = encodeVarintKeeper(dAtA, i, uint64(len(m.MetadataUri)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Service != 0 {
+		i = encodeVarintKeeper(dAtA, i, uint64(m.Service))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarintKeeper(dAtA, i, uint64(len(m.PubKey)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ContractExpirationSet) Marshal() (dAtA []byte, err error) {
+func (m *Contract) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -889,37 +1351,170 @@ func (m *ContractExpirationSet) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ContractExpirationSet) MarshalTo(dAtA []byte) (int, error) {
+func (m *Contract) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ContractExpirationSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Contract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ContractSet != nil {
-		{
-			size, err := m.ContractSet.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintKeeper(dAtA, i, uint64(size))
+	if m.StartHeight != 0 {
+		i = encodeVarintKeeper(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if len(m.Payer) > 0 {
+		i -= len(m.Payer)
+		copy(dAtA[i:], m.Payer)
+		i = encodeVarintKeeper(dAtA, i, uint64(len(m.Payer)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
+	{
+		size := m.TopUp.Size()
+		i -= size
+		if _, err := m.TopUp.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintKeeper(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x9a
+	if m.AutoRenew {
+		i--
+		if m.AutoRenew {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.SettlementGracePeriod != 0 {
+		i = encodeVarintKeeper(dAtA, i, uint64(m.SettlementGracePeriod))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.QueriesPerMinute != 0 {
+		i = encodeVarintKeeper(dAtA, i, uint64(m.QueriesPerMinute))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.Authorization != 0 {
+		i = encodeVarintKeeper(dAtA, i, uint64(m.Authorization))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.SettlementDuration != 0 {
+		i = encodeVarintKeeper(dAtA, i, uint64(m.SettlementDuration))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.Id != 0 {
+		i = encodeVarintKeeper(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.SettlementHeight != 0 {
+		i = encodeVarintKeeper(dAtA, i, uint64(m.SettlementHeight))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintKeeper(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x58
+	}
+	{
+		size := m.Paid.Size()
+		i -= size
+		if _, err := m.Paid.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintKeeper(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.Deposit.Size()
+		i -= size
+		if _, err := m.Deposit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintKeeper(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size, err := m.Rate.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintKeeper(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if m.Duration != 0 {
+		i = encodeVarintKeeper(dAtA, i, uint64(m.Duration))
+		i--
+		dAtA[i] = 0x38
 	}
 	if m.Height != 0 {
 		i = encodeVarintKeeper(dAtA, i, uint64(m.Height))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x30
+	}
+	if m.Type != 0 {
+		i = encodeVarintKeeper(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Delegate) > 0 {
+		i -= len(m.Delegate)
+		copy(dAtA[i:], m.Delegate)
+		i = encodeVarintKeeper(dAtA, i, uint64(len(m.Delegate)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Client) > 0 {
+		i -= len(m.Client)
+		copy(dAtA[i:], m.Client)
+		i = encodeVarintKeeper(dAtA, i, uint64(len(m.Client)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Service != 0 {
+		i = encodeVarintKeeper(dAtA, i, uint64(m.Service))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintKeeper(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *UserContractSet) Marshal() (dAtA []byte, err error) {
+func (m *ContractSet) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -929,214 +1524,2055 @@ func (m *UserContractSet) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *UserContractSet) MarshalTo(dAtA []byte) (int, error) {
+func (m *ContractSet) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *UserContractSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ContractSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ContractSet != nil {
-		{
-			size, err := m.ContractSet.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.ContractIds) > 0 {
+		dAtA3 := make([]byte, len(m.ContractIds)*10)
+		var j2 int
+		for _, num := range m.ContractIds {
+			for num >= 1<<7 {
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j2++
 			}
-			i -= size
-			i = encodeVarintKeeper(dAtA, i, uint64(size))
+			dAtA3[j2] = uint8(num)
+			j2++
 		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.User) > 0 {
-		i -= len(m.User)
-		copy(dAtA[i:], m.User)
-		i = encodeVarintKeeper(dAtA, i, uint64(len(m.User)))
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintKeeper(dAtA, i, uint64(j2))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintKeeper(dAtA []byte, offset int, v uint64) int {
-	offset -= sovKeeper(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ContractExpirationSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Provider) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *ContractExpirationSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractExpirationSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.PubKey)
-	if l > 0 {
-		n += 1 + l + sovKeeper(uint64(l))
-	}
-	if m.Service != 0 {
-		n += 1 + sovKeeper(uint64(m.Service))
+	if m.ContractSet != nil {
+		{
+			size, err := m.ContractSet.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintKeeper(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.MetadataUri)
-	if l > 0 {
-		n += 1 + l + sovKeeper(uint64(l))
+	if m.Height != 0 {
+		i = encodeVarintKeeper(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
 	}
-	if m.MetadataNonce != 0 {
-		n += 1 + sovKeeper(uint64(m.MetadataNonce))
+	return len(dAtA) - i, nil
+}
+
+func (m *UserContractSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.Status != 0 {
-		n += 1 + sovKeeper(uint64(m.Status))
+	return dAtA[:n], nil
+}
+
+func (m *UserContractSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UserContractSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ContractSet != nil {
+		{
+			size, err := m.ContractSet.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintKeeper(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.MinContractDuration != 0 {
-		n += 1 + sovKeeper(uint64(m.MinContractDuration))
+	if len(m.User) > 0 {
+		i -= len(m.User)
+		copy(dAtA[i:], m.User)
+		i = encodeVarintKeeper(dAtA, i, uint64(len(m.User)))
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.MaxContractDuration != 0 {
-		n += 1 + sovKeeper(uint64(m.MaxContractDuration))
+	return len(dAtA) - i, nil
+}
+
+func (m *ProviderEarnings) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.SubscriptionRate) > 0 {
-		for _, e := range m.SubscriptionRate {
-			l = e.Size()
-			n += 1 + l + sovKeeper(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *ProviderEarnings) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProviderEarnings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Escrowed) > 0 {
+		for iNdEx := len(m.Escrowed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Escrowed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintKeeper(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
 		}
 	}
-	if len(m.PayAsYouGoRate) > 0 {
-		for _, e := range m.PayAsYouGoRate {
-			l = e.Size()
-			n += 1 + l + sovKeeper(uint64(l))
+	if m.SettledContracts != 0 {
+		i = encodeVarintKeeper(dAtA, i, uint64(m.SettledContracts))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Income) > 0 {
+		for iNdEx := len(m.Income) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Income[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintKeeper(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	l = m.Bond.Size()
-	n += 1 + l + sovKeeper(uint64(l))
-	if m.LastUpdate != 0 {
-		n += 1 + sovKeeper(uint64(m.LastUpdate))
+	if m.Service != 0 {
+		i = encodeVarintKeeper(dAtA, i, uint64(m.Service))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.SettlementDuration != 0 {
-		n += 1 + sovKeeper(uint64(m.SettlementDuration))
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintKeeper(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Contract) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ContractSettlement) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractSettlement) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractSettlement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Provider)
-	if l > 0 {
-		n += 1 + l + sovKeeper(uint64(l))
+	{
+		size, err := m.Dust.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintKeeper(dAtA, i, uint64(size))
 	}
-	if m.Service != 0 {
-		n += 1 + sovKeeper(uint64(m.Service))
+	i--
+	dAtA[i] = 0x4a
+	{
+		size, err := m.Unpaid.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintKeeper(dAtA, i, uint64(size))
 	}
-	l = len(m.Client)
-	if l > 0 {
-		n += 1 + l + sovKeeper(uint64(l))
+	i--
+	dAtA[i] = 0x42
+	{
+		size, err := m.Refund.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintKeeper(dAtA, i, uint64(size))
 	}
-	l = len(m.Delegate)
-	if l > 0 {
-		n += 1 + l + sovKeeper(uint64(l))
+	i--
+	dAtA[i] = 0x3a
+	{
+		size, err := m.ReserveTax.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintKeeper(dAtA, i, uint64(size))
 	}
-	if m.Type != 0 {
-		n += 1 + sovKeeper(uint64(m.Type))
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.ProviderIncome.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintKeeper(dAtA, i, uint64(size))
 	}
-	if m.Height != 0 {
-		n += 1 + sovKeeper(uint64(m.Height))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.Owed.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintKeeper(dAtA, i, uint64(size))
 	}
-	if m.Duration != 0 {
-		n += 1 + sovKeeper(uint64(m.Duration))
+	i--
+	dAtA[i] = 0x22
+	if m.Final {
+		i--
+		if m.Final {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
 	}
-	l = m.Rate.Size()
-	n += 1 + l + sovKeeper(uint64(l))
-	l = m.Deposit.Size()
-	n += 1 + l + sovKeeper(uint64(l))
-	l = m.Paid.Size()
-	n += 1 + l + sovKeeper(uint64(l))
 	if m.Nonce != 0 {
-		n += 1 + sovKeeper(uint64(m.Nonce))
+		i = encodeVarintKeeper(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.SettlementHeight != 0 {
-		n += 1 + sovKeeper(uint64(m.SettlementHeight))
+	if m.ContractId != 0 {
+		i = encodeVarintKeeper(dAtA, i, uint64(m.ContractId))
+		i--
+		dAtA[i] = 0x8
 	}
-	if m.Id != 0 {
-		n += 1 + sovKeeper(uint64(m.Id))
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractClaimResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.SettlementDuration != 0 {
-		n += 1 + sovKeeper(uint64(m.SettlementDuration))
+	return dAtA[:n], nil
+}
+
+func (m *ContractClaimResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractClaimResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintKeeper(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.Authorization != 0 {
-		n += 1 + sovKeeper(uint64(m.Authorization))
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.QueriesPerMinute != 0 {
-		n += 2 + sovKeeper(uint64(m.QueriesPerMinute))
+	if m.Nonce != 0 {
+		i = encodeVarintKeeper(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if m.ContractId != 0 {
+		i = encodeVarintKeeper(dAtA, i, uint64(m.ContractId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ContractSet) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ContractPurgeResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractPurgeResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractPurgeResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintKeeper(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ContractId != 0 {
+		i = encodeVarintKeeper(dAtA, i, uint64(m.ContractId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProviderAllowlist) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ProviderAllowlist) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProviderAllowlist) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.ContractIds) > 0 {
-		l = 0
-		for _, e := range m.ContractIds {
-			l += sovKeeper(uint64(e))
+	if len(m.Clients) > 0 {
+		for iNdEx := len(m.Clients) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Clients[iNdEx])
+			copy(dAtA[i:], m.Clients[iNdEx])
+			i = encodeVarintKeeper(dAtA, i, uint64(len(m.Clients[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
 		}
-		n += 1 + sovKeeper(uint64(l)) + l
 	}
-	return n
+	if m.Service != 0 {
+		i = encodeVarintKeeper(dAtA, i, uint64(m.Service))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintKeeper(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ContractExpirationSet) Size() (n int) {
+func (m *NetworkStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NetworkStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NetworkStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Escrowed) > 0 {
+		for iNdEx := len(m.Escrowed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Escrowed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintKeeper(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.OpenContracts != 0 {
+		i = encodeVarintKeeper(dAtA, i, uint64(m.OpenContracts))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Bonded) > 0 {
+		for iNdEx := len(m.Bonded) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Bonded[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintKeeper(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.ProvidersOnline != 0 {
+		i = encodeVarintKeeper(dAtA, i, uint64(m.ProvidersOnline))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Providers != 0 {
+		i = encodeVarintKeeper(dAtA, i, uint64(m.Providers))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintKeeper(dAtA []byte, offset int, v uint64) int {
+	offset -= sovKeeper(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Provider) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Height != 0 {
-		n += 1 + sovKeeper(uint64(m.Height))
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sovKeeper(uint64(l))
 	}
-	if m.ContractSet != nil {
-		l = m.ContractSet.Size()
+	if m.Service != 0 {
+		n += 1 + sovKeeper(uint64(m.Service))
+	}
+	l = len(m.MetadataUri)
+	if l > 0 {
 		n += 1 + l + sovKeeper(uint64(l))
 	}
+	if m.MetadataNonce != 0 {
+		n += 1 + sovKeeper(uint64(m.MetadataNonce))
+	}
+	if m.Status != 0 {
+		n += 1 + sovKeeper(uint64(m.Status))
+	}
+	if m.MinContractDuration != 0 {
+		n += 1 + sovKeeper(uint64(m.MinContractDuration))
+	}
+	if m.MaxContractDuration != 0 {
+		n += 1 + sovKeeper(uint64(m.MaxContractDuration))
+	}
+	if len(m.SubscriptionRate) > 0 {
+		for _, e := range m.SubscriptionRate {
+			l = e.Size()
+			n += 1 + l + sovKeeper(uint64(l))
+		}
+	}
+	if len(m.PayAsYouGoRate) > 0 {
+		for _, e := range m.PayAsYouGoRate {
+			l = e.Size()
+			n += 1 + l + sovKeeper(uint64(l))
+		}
+	}
+	l = m.Bond.Size()
+	n += 1 + l + sovKeeper(uint64(l))
+	if m.LastUpdate != 0 {
+		n += 1 + sovKeeper(uint64(m.LastUpdate))
+	}
+	if m.SettlementDuration != 0 {
+		n += 1 + sovKeeper(uint64(m.SettlementDuration))
+	}
+	if m.Faults != 0 {
+		n += 1 + sovKeeper(uint64(m.Faults))
+	}
+	if m.OpenContracts != 0 {
+		n += 1 + sovKeeper(uint64(m.OpenContracts))
+	}
+	if m.MaxOpenContracts != 0 {
+		n += 1 + sovKeeper(uint64(m.MaxOpenContracts))
+	}
 	return n
 }
 
-func (m *UserContractSet) Size() (n int) {
+func (m *Contract) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.User)
+	l = len(m.Provider)
 	if l > 0 {
 		n += 1 + l + sovKeeper(uint64(l))
 	}
-	if m.ContractSet != nil {
-		l = m.ContractSet.Size()
+	if m.Service != 0 {
+		n += 1 + sovKeeper(uint64(m.Service))
+	}
+	l = len(m.Client)
+	if l > 0 {
 		n += 1 + l + sovKeeper(uint64(l))
 	}
-	return n
-}
+	l = len(m.Delegate)
+	if l > 0 {
+		n += 1 + l + sovKeeper(uint64(l))
+	}
+	if m.Type != 0 {
+		n += 1 + sovKeeper(uint64(m.Type))
+	}
+	if m.Height != 0 {
+		n += 1 + sovKeeper(uint64(m.Height))
+	}
+	if m.Duration != 0 {
+		n += 1 + sovKeeper(uint64(m.Duration))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovKeeper(uint64(l))
+	l = m.Deposit.Size()
+	n += 1 + l + sovKeeper(uint64(l))
+	l = m.Paid.Size()
+	n += 1 + l + sovKeeper(uint64(l))
+	if m.Nonce != 0 {
+		n += 1 + sovKeeper(uint64(m.Nonce))
+	}
+	if m.SettlementHeight != 0 {
+		n += 1 + sovKeeper(uint64(m.SettlementHeight))
+	}
+	if m.Id != 0 {
+		n += 1 + sovKeeper(uint64(m.Id))
+	}
+	if m.SettlementDuration != 0 {
+		n += 1 + sovKeeper(uint64(m.SettlementDuration))
+	}
+	if m.Authorization != 0 {
+		n += 1 + sovKeeper(uint64(m.Authorization))
+	}
+	if m.QueriesPerMinute != 0 {
+		n += 2 + sovKeeper(uint64(m.QueriesPerMinute))
+	}
+	if m.SettlementGracePeriod != 0 {
+		n += 2 + sovKeeper(uint64(m.SettlementGracePeriod))
+	}
+	if m.AutoRenew {
+		n += 3
+	}
+	l = m.TopUp.Size()
+	n += 2 + l + sovKeeper(uint64(l))
+	l = len(m.Payer)
+	if l > 0 {
+		n += 2 + l + sovKeeper(uint64(l))
+	}
+	if m.StartHeight != 0 {
+		n += 2 + sovKeeper(uint64(m.StartHeight))
+	}
+	return n
+}
+
+func (m *ContractSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ContractIds) > 0 {
+		l = 0
+		for _, e := range m.ContractIds {
+			l += sovKeeper(uint64(e))
+		}
+		n += 1 + sovKeeper(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *ContractExpirationSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovKeeper(uint64(m.Height))
+	}
+	if m.ContractSet != nil {
+		l = m.ContractSet.Size()
+		n += 1 + l + sovKeeper(uint64(l))
+	}
+	return n
+}
+
+func (m *UserContractSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.User)
+	if l > 0 {
+		n += 1 + l + sovKeeper(uint64(l))
+	}
+	if m.ContractSet != nil {
+		l = m.ContractSet.Size()
+		n += 1 + l + sovKeeper(uint64(l))
+	}
+	return n
+}
+
+func (m *ProviderEarnings) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovKeeper(uint64(l))
+	}
+	if m.Service != 0 {
+		n += 1 + sovKeeper(uint64(m.Service))
+	}
+	if len(m.Income) > 0 {
+		for _, e := range m.Income {
+			l = e.Size()
+			n += 1 + l + sovKeeper(uint64(l))
+		}
+	}
+	if m.SettledContracts != 0 {
+		n += 1 + sovKeeper(uint64(m.SettledContracts))
+	}
+	if len(m.Escrowed) > 0 {
+		for _, e := range m.Escrowed {
+			l = e.Size()
+			n += 1 + l + sovKeeper(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ContractSettlement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ContractId != 0 {
+		n += 1 + sovKeeper(uint64(m.ContractId))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovKeeper(uint64(m.Nonce))
+	}
+	if m.Final {
+		n += 2
+	}
+	l = m.Owed.Size()
+	n += 1 + l + sovKeeper(uint64(l))
+	l = m.ProviderIncome.Size()
+	n += 1 + l + sovKeeper(uint64(l))
+	l = m.ReserveTax.Size()
+	n += 1 + l + sovKeeper(uint64(l))
+	l = m.Refund.Size()
+	n += 1 + l + sovKeeper(uint64(l))
+	l = m.Unpaid.Size()
+	n += 1 + l + sovKeeper(uint64(l))
+	l = m.Dust.Size()
+	n += 1 + l + sovKeeper(uint64(l))
+	return n
+}
+
+func (m *ContractClaimResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ContractId != 0 {
+		n += 1 + sovKeeper(uint64(m.ContractId))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovKeeper(uint64(m.Nonce))
+	}
+	if m.Success {
+		n += 2
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovKeeper(uint64(l))
+	}
+	return n
+}
+
+func (m *ContractPurgeResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ContractId != 0 {
+		n += 1 + sovKeeper(uint64(m.ContractId))
+	}
+	if m.Success {
+		n += 2
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovKeeper(uint64(l))
+	}
+	return n
+}
+
+func (m *ProviderAllowlist) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovKeeper(uint64(l))
+	}
+	if m.Service != 0 {
+		n += 1 + sovKeeper(uint64(m.Service))
+	}
+	if len(m.Clients) > 0 {
+		for _, b := range m.Clients {
+			l = len(b)
+			n += 1 + l + sovKeeper(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *NetworkStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Providers != 0 {
+		n += 1 + sovKeeper(uint64(m.Providers))
+	}
+	if m.ProvidersOnline != 0 {
+		n += 1 + sovKeeper(uint64(m.ProvidersOnline))
+	}
+	if len(m.Bonded) > 0 {
+		for _, e := range m.Bonded {
+			l = e.Size()
+			n += 1 + l + sovKeeper(uint64(l))
+		}
+	}
+	if m.OpenContracts != 0 {
+		n += 1 + sovKeeper(uint64(m.OpenContracts))
+	}
+	if len(m.Escrowed) > 0 {
+		for _, e := range m.Escrowed {
+			l = e.Size()
+			n += 1 + l + sovKeeper(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovKeeper(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozKeeper(x uint64) (n int) {
+	return sovKeeper(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Provider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeeper
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Provider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Provider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthKeeper
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeeper
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKey = append(m.PubKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PubKey == nil {
+				m.PubKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+			}
+			m.Service = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Service |= github_com_arkeonetwork_arkeo_common.Service(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetadataUri", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeeper
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeeper
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MetadataUri = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetadataNonce", wireType)
+			}
+			m.MetadataNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MetadataNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ProviderStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinContractDuration", wireType)
+			}
+			m.MinContractDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinContractDuration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxContractDuration", wireType)
+			}
+			m.MaxContractDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxContractDuration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubscriptionRate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthKeeper
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeeper
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubscriptionRate = append(m.SubscriptionRate, types.Coin{})
+			if err := m.SubscriptionRate[len(m.SubscriptionRate)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayAsYouGoRate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthKeeper
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeeper
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PayAsYouGoRate = append(m.PayAsYouGoRate, types.Coin{})
+			if err := m.PayAsYouGoRate[len(m.PayAsYouGoRate)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bond", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeeper
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeeper
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Bond.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdate", wireType)
+			}
+			m.LastUpdate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastUpdate |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementDuration", wireType)
+			}
+			m.SettlementDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SettlementDuration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Faults", wireType)
+			}
+			m.Faults = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Faults |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpenContracts", wireType)
+			}
+			m.OpenContracts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OpenContracts |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOpenContracts", wireType)
+			}
+			m.MaxOpenContracts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxOpenContracts |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeeper(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthKeeper
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Contract) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeeper
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Contract: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Contract: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthKeeper
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeeper
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = append(m.Provider[:0], dAtA[iNdEx:postIndex]...)
+			if m.Provider == nil {
+				m.Provider = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+			}
+			m.Service = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Service |= github_com_arkeonetwork_arkeo_common.Service(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Client", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthKeeper
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeeper
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Client = append(m.Client[:0], dAtA[iNdEx:postIndex]...)
+			if m.Client == nil {
+				m.Client = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegate", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthKeeper
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeeper
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegate = append(m.Delegate[:0], dAtA[iNdEx:postIndex]...)
+			if m.Delegate == nil {
+				m.Delegate = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= ContractType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			m.Duration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Duration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthKeeper
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeeper
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeeper
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeeper
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeeper
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeeper
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Paid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementHeight", wireType)
+			}
+			m.SettlementHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SettlementHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementDuration", wireType)
+			}
+			m.SettlementDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SettlementDuration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authorization", wireType)
+			}
+			m.Authorization = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Authorization |= ContractAuthorization(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueriesPerMinute", wireType)
+			}
+			m.QueriesPerMinute = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueriesPerMinute |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementGracePeriod", wireType)
+			}
+			m.SettlementGracePeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SettlementGracePeriod |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoRenew", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoRenew = bool(v != 0)
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopUp", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeeper
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeeper
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TopUp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeeper
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeeper
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeeper(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthKeeper
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeeper
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowKeeper
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ContractIds = append(m.ContractIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowKeeper
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthKeeper
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthKeeper
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ContractIds) == 0 {
+					m.ContractIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowKeeper
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ContractIds = append(m.ContractIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractIds", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeeper(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthKeeper
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractExpirationSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeeper
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractExpirationSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractExpirationSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractSet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthKeeper
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeeper
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ContractSet == nil {
+				m.ContractSet = &ContractSet{}
+			}
+			if err := m.ContractSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeeper(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthKeeper
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovKeeper(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozKeeper(x uint64) (n int) {
-	return sovKeeper(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Provider) Unmarshal(dAtA []byte) error {
+func (m *UserContractSet) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1159,15 +3595,15 @@ func (m *Provider) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Provider: wiretype end group for non-group")
+			return fmt.Errorf("proto: UserContractSet: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Provider: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UserContractSet: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1194,35 +3630,16 @@ func (m *Provider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PubKey = append(m.PubKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.PubKey == nil {
-				m.PubKey = []byte{}
+			m.User = append(m.User[:0], dAtA[iNdEx:postIndex]...)
+			if m.User == nil {
+				m.User = []byte{}
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
-			}
-			m.Service = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowKeeper
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Service |= github_com_arkeonetwork_arkeo_common.Service(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetadataUri", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractSet", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeeper
@@ -1232,67 +3649,83 @@ func (m *Provider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthKeeper
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthKeeper
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MetadataUri = string(dAtA[iNdEx:postIndex])
+			if m.ContractSet == nil {
+				m.ContractSet = &ContractSet{}
+			}
+			if err := m.ContractSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetadataNonce", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeeper(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.MetadataNonce = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowKeeper
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MetadataNonce |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthKeeper
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.Status = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowKeeper
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Status |= ProviderStatus(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProviderEarnings) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeeper
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinContractDuration", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.MinContractDuration = 0
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProviderEarnings: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProviderEarnings: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeeper
@@ -1302,16 +3735,31 @@ func (m *Provider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MinContractDuration |= int64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+			if byteLen < 0 {
+				return ErrInvalidLengthKeeper
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeeper
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = append(m.Provider[:0], dAtA[iNdEx:postIndex]...)
+			if m.Provider == nil {
+				m.Provider = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxContractDuration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
 			}
-			m.MaxContractDuration = 0
+			m.Service = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeeper
@@ -1321,14 +3769,14 @@ func (m *Provider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxContractDuration |= int64(b&0x7F) << shift
+				m.Service |= github_com_arkeonetwork_arkeo_common.Service(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubscriptionRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Income", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1355,16 +3803,16 @@ func (m *Provider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SubscriptionRate = append(m.SubscriptionRate, types.Coin{})
-			if err := m.SubscriptionRate[len(m.SubscriptionRate)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Income = append(m.Income, types.Coin{})
+			if err := m.Income[len(m.Income)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PayAsYouGoRate", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettledContracts", wireType)
 			}
-			var msglen int
+			m.SettledContracts = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeeper
@@ -1374,31 +3822,16 @@ func (m *Provider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.SettledContracts |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthKeeper
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthKeeper
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PayAsYouGoRate = append(m.PayAsYouGoRate, types.Coin{})
-			if err := m.PayAsYouGoRate[len(m.PayAsYouGoRate)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 10:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bond", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Escrowed", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeeper
@@ -1408,64 +3841,26 @@ func (m *Provider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthKeeper
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthKeeper
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Bond.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Escrowed = append(m.Escrowed, types.Coin{})
+			if err := m.Escrowed[len(m.Escrowed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdate", wireType)
-			}
-			m.LastUpdate = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowKeeper
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LastUpdate |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SettlementDuration", wireType)
-			}
-			m.SettlementDuration = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowKeeper
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SettlementDuration |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipKeeper(dAtA[iNdEx:])
@@ -1487,7 +3882,7 @@ func (m *Provider) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Contract) Unmarshal(dAtA []byte) error {
+func (m *ContractSettlement) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1510,17 +3905,17 @@ func (m *Contract) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Contract: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContractSettlement: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Contract: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContractSettlement: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
 			}
-			var byteLen int
+			m.ContractId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeeper
@@ -1530,31 +3925,16 @@ func (m *Contract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.ContractId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthKeeper
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthKeeper
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Provider = append(m.Provider[:0], dAtA[iNdEx:postIndex]...)
-			if m.Provider == nil {
-				m.Provider = []byte{}
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
 			}
-			m.Service = 0
+			m.Nonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeeper
@@ -1564,16 +3944,16 @@ func (m *Contract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Service |= github_com_arkeonetwork_arkeo_common.Service(b&0x7F) << shift
+				m.Nonce |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Client", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Final", wireType)
 			}
-			var byteLen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeeper
@@ -1583,31 +3963,17 @@ func (m *Contract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthKeeper
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthKeeper
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Client = append(m.Client[:0], dAtA[iNdEx:postIndex]...)
-			if m.Client == nil {
-				m.Client = []byte{}
-			}
-			iNdEx = postIndex
+			m.Final = bool(v != 0)
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delegate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owed", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeeper
@@ -1617,31 +3983,30 @@ func (m *Contract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthKeeper
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthKeeper
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Delegate = append(m.Delegate[:0], dAtA[iNdEx:postIndex]...)
-			if m.Delegate == nil {
-				m.Delegate = []byte{}
+			if err := m.Owed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderIncome", wireType)
 			}
-			m.Type = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeeper
@@ -1651,16 +4016,30 @@ func (m *Contract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= ContractType(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthKeeper
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeeper
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProviderIncome.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReserveTax", wireType)
 			}
-			m.Height = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeeper
@@ -1670,33 +4049,28 @@ func (m *Contract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthKeeper
 			}
-			m.Duration = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowKeeper
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Duration |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeeper
 			}
-		case 8:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReserveTax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Refund", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1723,15 +4097,15 @@ func (m *Contract) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Refund.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Unpaid", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeeper
@@ -1741,31 +4115,30 @@ func (m *Contract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthKeeper
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthKeeper
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Unpaid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Paid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Dust", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeeper
@@ -1775,69 +4148,80 @@ func (m *Contract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthKeeper
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthKeeper
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Paid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Dust.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeeper(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.Nonce = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowKeeper
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Nonce |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthKeeper
 			}
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SettlementHeight", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.SettlementHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowKeeper
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SettlementHeight |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractClaimResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeeper
 			}
-		case 13:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractClaimResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractClaimResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
 			}
-			m.Id = 0
+			m.ContractId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeeper
@@ -1847,16 +4231,16 @@ func (m *Contract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				m.ContractId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 14:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SettlementDuration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
 			}
-			m.SettlementDuration = 0
+			m.Nonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeeper
@@ -1866,16 +4250,16 @@ func (m *Contract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SettlementDuration |= int64(b&0x7F) << shift
+				m.Nonce |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 15:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authorization", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
 			}
-			m.Authorization = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeeper
@@ -1885,16 +4269,17 @@ func (m *Contract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Authorization |= ContractAuthorization(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 16:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QueriesPerMinute", wireType)
+			m.Success = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
-			m.QueriesPerMinute = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeeper
@@ -1904,11 +4289,24 @@ func (m *Contract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.QueriesPerMinute |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeeper
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeeper
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipKeeper(dAtA[iNdEx:])
@@ -1930,7 +4328,7 @@ func (m *Contract) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContractSet) Unmarshal(dAtA []byte) error {
+func (m *ContractPurgeResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1953,88 +4351,83 @@ func (m *ContractSet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContractSet: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContractPurgeResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContractSet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContractPurgeResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowKeeper
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			m.ContractId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeeper
 				}
-				m.ContractIds = append(m.ContractIds, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowKeeper
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthKeeper
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthKeeper
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeeper
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				elementCount = count
-				if elementCount != 0 && len(m.ContractIds) == 0 {
-					m.ContractIds = make([]uint64, 0, elementCount)
+			}
+			m.Success = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeeper
 				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowKeeper
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.ContractIds = append(m.ContractIds, v)
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractIds", wireType)
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeeper
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeeper
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipKeeper(dAtA[iNdEx:])
@@ -2056,7 +4449,7 @@ func (m *ContractSet) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContractExpirationSet) Unmarshal(dAtA []byte) error {
+func (m *ProviderAllowlist) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2079,17 +4472,17 @@ func (m *ContractExpirationSet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContractExpirationSet: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProviderAllowlist: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContractExpirationSet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProviderAllowlist: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
 			}
-			m.Height = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeeper
@@ -2099,16 +4492,50 @@ func (m *ContractExpirationSet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= int64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthKeeper
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeeper
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = append(m.Provider[:0], dAtA[iNdEx:postIndex]...)
+			if m.Provider == nil {
+				m.Provider = []byte{}
+			}
+			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+			}
+			m.Service = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Service |= github_com_arkeonetwork_arkeo_common.Service(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractSet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Clients", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeeper
@@ -2118,27 +4545,23 @@ func (m *ContractExpirationSet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthKeeper
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthKeeper
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ContractSet == nil {
-				m.ContractSet = &ContractSet{}
-			}
-			if err := m.ContractSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Clients = append(m.Clients, make([]byte, postIndex-iNdEx))
+			copy(m.Clients[len(m.Clients)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2161,7 +4584,7 @@ func (m *ContractExpirationSet) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UserContractSet) Unmarshal(dAtA []byte) error {
+func (m *NetworkStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2184,17 +4607,55 @@ func (m *UserContractSet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UserContractSet: wiretype end group for non-group")
+			return fmt.Errorf("proto: NetworkStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UserContractSet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NetworkStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Providers", wireType)
+			}
+			m.Providers = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Providers |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProvidersOnline", wireType)
+			}
+			m.ProvidersOnline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProvidersOnline |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bonded", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeeper
@@ -2204,29 +4665,48 @@ func (m *UserContractSet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthKeeper
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthKeeper
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.User = append(m.User[:0], dAtA[iNdEx:postIndex]...)
-			if m.User == nil {
-				m.User = []byte{}
+			m.Bonded = append(m.Bonded, types.Coin{})
+			if err := m.Bonded[len(m.Bonded)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpenContracts", wireType)
+			}
+			m.OpenContracts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OpenContracts |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractSet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Escrowed", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2253,10 +4733,8 @@ func (m *UserContractSet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ContractSet == nil {
-				m.ContractSet = &ContractSet{}
-			}
-			if err := m.ContractSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Escrowed = append(m.Escrowed, types.Coin{})
+			if err := m.Escrowed[len(m.Escrowed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -15,6 +15,9 @@ const (
 
 	// MemStoreKey defines the in-memory store key
 	MemStoreKey = "mem_arkeo"
+
+	// ConsensusVersion is the consensus version of the module, see AppModule.ConsensusVersion
+	ConsensusVersion = 10
 )
 
 func KeyPrefix(p string) []byte {
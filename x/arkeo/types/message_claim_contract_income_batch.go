@@ -0,0 +1,71 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/arkeonetwork/arkeo/common/cosmos"
+)
+
+const TypeMsgClaimContractIncomeBatch = "claim_contract_income_batch"
+
+var _ sdk.Msg = &MsgClaimContractIncomeBatch{}
+
+func NewMsgClaimContractIncomeBatch(creator cosmos.AccAddress, claims []ContractClaim) *MsgClaimContractIncomeBatch {
+	return &MsgClaimContractIncomeBatch{
+		Creator: creator.String(),
+		Claims:  claims,
+	}
+}
+
+func (msg *MsgClaimContractIncomeBatch) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgClaimContractIncomeBatch) Type() string {
+	return TypeMsgClaimContractIncomeBatch
+}
+
+func (msg *MsgClaimContractIncomeBatch) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(msg.Creator)}
+}
+
+func (msg *MsgClaimContractIncomeBatch) MustGetSigner() sdk.AccAddress {
+	return sdk.MustAccAddressFromBech32(msg.Creator)
+}
+
+func (msg *MsgClaimContractIncomeBatch) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ClaimMsg return the claim as a MsgClaimContractIncome of creator
+func (claim ContractClaim) ClaimMsg(creator string) *MsgClaimContractIncome {
+	return &MsgClaimContractIncome{
+		Creator:    creator,
+		ContractId: claim.ContractId,
+		Nonce:      claim.Nonce,
+		Signature:  claim.Signature,
+	}
+}
+
+func (msg *MsgClaimContractIncomeBatch) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	// the size of the batch is bounded by the params, checked by the handler
+	if len(msg.Claims) == 0 {
+		return errors.Wrap(ErrClaimContractIncomeBatchSize, "no claims")
+	}
+
+	for i, claim := range msg.Claims {
+		if err := claim.ClaimMsg(msg.Creator).ValidateBasic(); err != nil {
+			return errors.Wrapf(err, "claim %d", i)
+		}
+	}
+
+	return nil
+}
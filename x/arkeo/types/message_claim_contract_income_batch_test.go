@@ -0,0 +1,32 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func TestClaimContractIncomeBatchValidateBasic(t *testing.T) {
+	acct := GetRandomBech32Addr()
+
+	msg := NewMsgClaimContractIncomeBatch(acct, []ContractClaim{
+		{ContractId: 1, Nonce: 24, Signature: []byte("signature")},
+		{ContractId: 2, Nonce: 3},
+	})
+	require.NoError(t, msg.ValidateBasic())
+
+	// each claim is checked as a claim of its own
+	msg.Claims[1].Nonce = 0
+	require.ErrorIs(t, msg.ValidateBasic(), ErrClaimContractIncomeBadNonce)
+	msg.Claims[1].Nonce = 3
+	msg.Claims[0].Signature = make([]byte, 101)
+	require.ErrorIs(t, msg.ValidateBasic(), ErrClaimContractIncomeInvalidSignature)
+
+	msg.Claims = nil
+	require.ErrorIs(t, msg.ValidateBasic(), ErrClaimContractIncomeBatchSize)
+
+	msg.Creator = "bogus"
+	require.ErrorIs(t, msg.ValidateBasic(), sdkerrors.ErrInvalidAddress)
+}
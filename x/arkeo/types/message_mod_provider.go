@@ -1,6 +1,9 @@
 package types
 
 import (
+	"fmt"
+	"strings"
+
 	"cosmossdk.io/errors"
 
 	"github.com/arkeonetwork/arkeo/common"
@@ -12,11 +15,38 @@ import (
 
 const TypeMsgModProvider = "mod_provider"
 
+// the fields of a provider a MsgModProvider can update, named in its update mask
+const (
+	ModProviderFieldMetadataUri         = "metadata_uri"
+	ModProviderFieldMetadataNonce       = "metadata_nonce"
+	ModProviderFieldStatus              = "status"
+	ModProviderFieldMinContractDuration = "min_contract_duration"
+	ModProviderFieldMaxContractDuration = "max_contract_duration"
+	ModProviderFieldSubscriptionRate    = "subscription_rate"
+	ModProviderFieldPayAsYouGoRate      = "pay_as_you_go_rate"
+	ModProviderFieldSettlementDuration  = "settlement_duration"
+	ModProviderFieldMaxOpenContracts    = "max_open_contracts"
+)
+
+// ModProviderFields is every field of a provider a MsgModProvider can update
+var ModProviderFields = []string{
+	ModProviderFieldMetadataUri,
+	ModProviderFieldMetadataNonce,
+	ModProviderFieldStatus,
+	ModProviderFieldMinContractDuration,
+	ModProviderFieldMaxContractDuration,
+	ModProviderFieldSubscriptionRate,
+	ModProviderFieldPayAsYouGoRate,
+	ModProviderFieldSettlementDuration,
+	ModProviderFieldMaxOpenContracts,
+}
+
 var _ sdk.Msg = &MsgModProvider{}
 
 func NewMsgModProvider(creator cosmos.AccAddress, provider common.PubKey, service, metadataUri string,
 	metadataNonce uint64, status ProviderStatus, minContractDuration,
 	maxContractDuration int64, subscriptionRate, payAsYouGoRate types.Coins, settlementDuration int64,
+	maxOpenContracts uint64,
 ) *MsgModProvider {
 	return &MsgModProvider{
 		Creator:             creator.String(),
@@ -30,6 +60,49 @@ func NewMsgModProvider(creator cosmos.AccAddress, provider common.PubKey, servic
 		SubscriptionRate:    subscriptionRate,
 		PayAsYouGoRate:      payAsYouGoRate,
 		SettlementDuration:  settlementDuration,
+		MaxOpenContracts:    maxOpenContracts,
+		UpdateMask:          append([]string{}, ModProviderFields...),
+	}
+}
+
+// Updates return true when field is in the update mask of the message
+func (msg *MsgModProvider) Updates(field string) bool {
+	for _, f := range msg.UpdateMask {
+		if f == field {
+			return true
+		}
+	}
+	return false
+}
+
+// Apply set the fields of the update mask on provider, the metadata nonce only goes up
+func (msg *MsgModProvider) Apply(provider *Provider) {
+	if msg.Updates(ModProviderFieldMetadataUri) {
+		provider.MetadataUri = msg.MetadataUri
+	}
+	if msg.Updates(ModProviderFieldMetadataNonce) && provider.MetadataNonce < msg.MetadataNonce {
+		provider.MetadataNonce = msg.MetadataNonce
+	}
+	if msg.Updates(ModProviderFieldStatus) {
+		provider.Status = msg.Status
+	}
+	if msg.Updates(ModProviderFieldMinContractDuration) {
+		provider.MinContractDuration = msg.MinContractDuration
+	}
+	if msg.Updates(ModProviderFieldMaxContractDuration) {
+		provider.MaxContractDuration = msg.MaxContractDuration
+	}
+	if msg.Updates(ModProviderFieldSubscriptionRate) {
+		provider.SubscriptionRate = msg.SubscriptionRate
+	}
+	if msg.Updates(ModProviderFieldPayAsYouGoRate) {
+		provider.PayAsYouGoRate = msg.PayAsYouGoRate
+	}
+	if msg.Updates(ModProviderFieldSettlementDuration) {
+		provider.SettlementDuration = msg.SettlementDuration
+	}
+	if msg.Updates(ModProviderFieldMaxOpenContracts) {
+		provider.MaxOpenContracts = msg.MaxOpenContracts
 	}
 }
 
@@ -76,24 +149,39 @@ func (msg *MsgModProvider) ValidateBasic() error {
 		return errors.Wrapf(ErrProviderBadSigner, "Signer: %s, Provider Address: %s", msg.GetSigners(), provider)
 	}
 
-	// test metadataURI
-	/*
-		Disabling URI parsing check due to a potential that the underlying golang code may change its behavior between golang versions. We can assume data providers are giving valid URIs, because if they aren't, they won't be able to make income
-		if _, err := url.ParseRequestURI(msg.MetadataURI); err != nil {
+	// verify update mask
+	if len(msg.UpdateMask) == 0 {
+		return errors.Wrapf(ErrInvalidModProviderUpdateMask, "update mask cannot be empty")
+	}
+	seen := make(map[string]bool, len(msg.UpdateMask))
+	for _, field := range msg.UpdateMask {
+		if seen[field] {
+			return errors.Wrapf(ErrInvalidModProviderUpdateMask, "duplicate field (%s)", field)
+		}
+		if !isModProviderField(field) {
+			return errors.Wrapf(ErrInvalidModProviderUpdateMask, "unknown field (%s)", field)
+		}
+		seen[field] = true
+	}
+
+	// the metadata uri is an http(s) url, or empty for no metadata, the keeper checks its length against the params
+	if msg.Updates(ModProviderFieldMetadataUri) && msg.MetadataUri != "" {
+		if err := validateMetadataUri(msg.MetadataUri); err != nil {
 			return errors.Wrapf(ErrInvalidModProviderMetdataURI, "(%s)", err)
 		}
-	*/
-	// Ensure URIs don't get too long and cause chain bloat
-	if len(msg.MetadataUri) > 100 {
-		return errors.Wrapf(ErrInvalidModProviderMetdataURI, "length is too long (%d/100)", len(msg.MetadataUri))
 	}
 
-	// check durations
-	if msg.MinContractDuration <= 0 {
+	// the keeper checks the nonce goes up from the one of the provider
+	if msg.Updates(ModProviderFieldMetadataNonce) && msg.MetadataNonce == 0 {
+		return errors.Wrapf(ErrInvalidModProviderMetadataNonce, "metadata nonce cannot be zero")
+	}
+
+	// check durations, against each other when both are updated, the keeper checks them against the provider
+	if msg.Updates(ModProviderFieldMinContractDuration) && msg.MinContractDuration <= 0 {
 		return errors.Wrapf(ErrInvalidModProviderMinContractDuration, "min contraction duration cannot be zero")
 	}
 
-	if msg.MinContractDuration > msg.MaxContractDuration {
+	if msg.Updates(ModProviderFieldMinContractDuration) && msg.Updates(ModProviderFieldMaxContractDuration) && msg.MinContractDuration > msg.MaxContractDuration {
 		return errors.Wrapf(ErrInvalidModProviderMinContractDuration, "min contract duration is too long (%d/%d)", msg.MaxContractDuration, msg.MaxContractDuration)
 	}
 
@@ -101,23 +189,57 @@ func (msg *MsgModProvider) ValidateBasic() error {
 		return errors.Wrapf(ErrInvalidModProviderSettlementDuration, "settlement duration cannot be negative")
 	}
 
-	subRate := cosmos.NewCoins(msg.SubscriptionRate...)
-	if err := subRate.Validate(); err != nil {
-		return errors.Wrapf(err, "invalid subscription rate")
-	}
+	if msg.Updates(ModProviderFieldSubscriptionRate) {
+		subRate := cosmos.NewCoins(msg.SubscriptionRate...)
+		if err := subRate.Validate(); err != nil {
+			return errors.Wrapf(err, "invalid subscription rate")
+		}
 
-	if !subRate.IsAllPositive() {
-		return errors.Wrapf(ErrInvalidModProviderRate, "all subscription rates must be positive")
+		if !subRate.IsAllPositive() {
+			return errors.Wrapf(ErrInvalidModProviderRate, "all subscription rates must be positive")
+		}
 	}
 
-	payRate := cosmos.NewCoins(msg.PayAsYouGoRate...)
-	if err := payRate.Validate(); err != nil {
-		return errors.Wrapf(err, "invalid subscription rate")
-	}
+	if msg.Updates(ModProviderFieldPayAsYouGoRate) {
+		payRate := cosmos.NewCoins(msg.PayAsYouGoRate...)
+		if err := payRate.Validate(); err != nil {
+			return errors.Wrapf(err, "invalid subscription rate")
+		}
 
-	if !payRate.IsAllPositive() {
-		return errors.Wrapf(ErrInvalidModProviderRate, "all pay-as-you-go rates must be positive")
+		if !payRate.IsAllPositive() {
+			return errors.Wrapf(ErrInvalidModProviderRate, "all pay-as-you-go rates must be positive")
+		}
 	}
 
 	return nil
 }
+
+// validateMetadataUri check the uri is an http(s) url with a host. It is checked by hand rather than parsed with
+// net/url, whose parsing can change between go versions, the nodes built with different ones disagreeing on a tx.
+func validateMetadataUri(uri string) error {
+	for _, c := range uri {
+		if c <= ' ' || c == 0x7f {
+			return fmt.Errorf("invalid character %q", c)
+		}
+	}
+	rest, found := strings.CutPrefix(uri, "https://")
+	if !found {
+		rest, found = strings.CutPrefix(uri, "http://")
+	}
+	if !found {
+		return fmt.Errorf("scheme must be http or https")
+	}
+	if rest == "" || strings.IndexAny(rest, "/?#") == 0 {
+		return fmt.Errorf("missing host")
+	}
+	return nil
+}
+
+func isModProviderField(field string) bool {
+	for _, f := range ModProviderFields {
+		if f == field {
+			return true
+		}
+	}
+	return false
+}
@@ -26,14 +26,55 @@ func TestModProviderValidateBasic(t *testing.T) {
 		MinContractDuration: 12,
 		MaxContractDuration: 30,
 		MetadataUri:         "http://mad.hatter.net/test?foo=baz",
+		MetadataNonce:       1,
 		SubscriptionRate:    rates,
 		PayAsYouGoRate:      rates,
+		UpdateMask:          ModProviderFields,
 	}
 	err = msg.ValidateBasic()
 	require.NoError(t, err)
 
-	// URI is too long
+	// the length of the URI is left to the keeper, against the params
 	msg.MetadataUri = "http://mad.hatter.net/testsdkfjlsdkfjlsdfjsldfjkdsljflsdjfkdsjflsdjkfsdjlfsdjkfldsjflksjdfljsdlkfjsdlkfjdsklfjsdlkfjsdkljflksdjfklsdjflskdjflksdjflksdjfldsjflksdjfldskjflsdkfjsdlkjfksdljflskdjfsdlkjfdksljflsdkjfkldsjfsdlkfjlksdjfklsdjflkdsjfklsdjfsdkljflksdjflksdfjdklsjfl?foo=baz"
+	require.NoError(t, msg.ValidateBasic())
+	for _, uri := range []string{"https://mad.hatter.net", "http://mad.hatter.net:8080?foo=baz", "https://[::1]/test"} {
+		msg.MetadataUri = uri
+		require.NoError(t, msg.ValidateBasic(), uri)
+	}
+
+	// URI is not an http(s) url
+	for _, uri := range []string{"foobar", "ftp://mad.hatter.net/test", "https:///test", "http://", "https://?foo=baz", "http://mad hatter.net", "http://mad.hatter.net/\x00"} {
+		msg.MetadataUri = uri
+		err = msg.ValidateBasic()
+		require.ErrorIs(t, err, ErrInvalidModProviderMetdataURI, uri)
+	}
+
+	// no metadata
+	msg.MetadataUri = ""
+	require.NoError(t, msg.ValidateBasic())
+
+	// the metadata nonce starts at one
+	msg.MetadataUri = "https://mad.hatter.net/test?foo=baz"
+	msg.MetadataNonce = 0
+	err = msg.ValidateBasic()
+	require.ErrorIs(t, err, ErrInvalidModProviderMetadataNonce)
+	msg.MetadataNonce = 1
+
+	// empty update mask
+	msg.UpdateMask = nil
+	err = msg.ValidateBasic()
+	require.ErrorIs(t, err, ErrInvalidModProviderUpdateMask)
+
+	// unknown field
+	msg.UpdateMask = []string{"bond"}
 	err = msg.ValidateBasic()
-	require.ErrorIs(t, err, ErrInvalidModProviderMetdataURI)
+	require.ErrorIs(t, err, ErrInvalidModProviderUpdateMask)
+
+	// a single field, the others aren't validated
+	msg.UpdateMask = []string{ModProviderFieldMetadataNonce}
+	msg.MetadataUri = "foobar"
+	msg.MinContractDuration = 0
+	msg.SubscriptionRate = nil
+	err = msg.ValidateBasic()
+	require.NoError(t, err)
 }
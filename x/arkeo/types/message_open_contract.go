@@ -10,6 +10,8 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	protov2 "google.golang.org/protobuf/proto"
 )
 
 const TypeMsgOpenContract = "open_contract"
@@ -42,13 +44,44 @@ func (msg *MsgOpenContract) Type() string {
 }
 
 func (msg *MsgOpenContract) GetSigners() []sdk.AccAddress {
-	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(msg.Creator)}
+	if msg.Payer == "" {
+		return []sdk.AccAddress{sdk.MustAccAddressFromBech32(msg.Creator)}
+	}
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(msg.Creator), sdk.MustAccAddressFromBech32(msg.Payer)}
 }
 
 func (msg *MsgOpenContract) MustGetSigner() sdk.AccAddress {
 	return sdk.MustAccAddressFromBech32(msg.Creator)
 }
 
+// MustGetPayer return the account funding the contract, the payer when there is one, the creator otherwise
+func (msg *MsgOpenContract) MustGetPayer() sdk.AccAddress {
+	if msg.Payer == "" {
+		return msg.MustGetSigner()
+	}
+	return sdk.MustAccAddressFromBech32(msg.Payer)
+}
+
+// GetOpenContractSigners return the signers of a MsgOpenContract, the payer signing along with the creator when there
+// is one. The signer option of the msg only lists the creator, the payer being optional.
+func GetOpenContractSigners(msg protov2.Message) ([][]byte, error) {
+	m := msg.ProtoReflect()
+	fields := m.Descriptor().Fields()
+	creator, err := sdk.AccAddressFromBech32(m.Get(fields.ByName("creator")).String())
+	if err != nil {
+		return nil, err
+	}
+	payer := m.Get(fields.ByName("payer")).String()
+	if payer == "" {
+		return [][]byte{creator}, nil
+	}
+	payerAddr, err := sdk.AccAddressFromBech32(payer)
+	if err != nil {
+		return nil, err
+	}
+	return [][]byte{creator, payerAddr}, nil
+}
+
 func (msg *MsgOpenContract) GetSignBytes() []byte {
 	bz := ModuleCdc.MustMarshalJSON(msg)
 	return sdk.MustSortJSON(bz)
@@ -70,6 +103,20 @@ func (msg *MsgOpenContract) GetSpender() (common.PubKey, error) {
 	}
 }
 
+// SubscriptionDeposit return the deposit a subscription needs, its rate for each query it allows over its duration
+func (msg *MsgOpenContract) SubscriptionDeposit() cosmos.Int {
+	return msg.Rate.Amount.MulRaw(msg.Duration).MulRaw(msg.QueriesPerMinute)
+}
+
+// EscrowedDeposit return the part of the deposit the contract escrows, a subscription overpaid only escrows the
+// deposit it needs
+func (msg *MsgOpenContract) EscrowedDeposit() cosmos.Int {
+	if msg.ContractType == ContractType_SUBSCRIPTION && msg.Deposit.GT(msg.SubscriptionDeposit()) {
+		return msg.SubscriptionDeposit()
+	}
+	return msg.Deposit
+}
+
 func (msg *MsgOpenContract) ValidateBasic() error {
 	// verify pubkey
 	_, err := common.NewPubKey(msg.Provider)
@@ -98,6 +145,16 @@ func (msg *MsgOpenContract) ValidateBasic() error {
 		return errors.Wrapf(ErrInvalidPubKey, "Signer: %s, Client Address: %s", msg.GetSigners(), client)
 	}
 
+	if msg.Payer != "" {
+		payer, err := sdk.AccAddressFromBech32(msg.Payer)
+		if err != nil {
+			return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid payer address (%s)", err)
+		}
+		if payer.Equals(signer) {
+			return errors.Wrapf(sdkerrors.ErrInvalidAddress, "payer %s is the creator, leave it empty", msg.Payer)
+		}
+	}
+
 	if msg.Duration <= 0 {
 		return errors.Wrapf(ErrOpenContractDuration, "contract duration cannot be zero")
 	}
@@ -118,6 +175,10 @@ func (msg *MsgOpenContract) ValidateBasic() error {
 		return errors.Wrapf(ErrInvalidModProviderSettlementDuration, "settlement duration cannot be negative")
 	}
 
+	if msg.StartHeight < 0 {
+		return errors.Wrapf(ErrOpenContractStartHeight, "start height cannot be negative")
+	}
+
 	// cannot open pay-as-you-go contract and be "open" authorization. The
 	// reason for this is a pay-as-you-go contract that is open allows anyone
 	// (including the data provider) to completely empty the contract tokens to
@@ -127,5 +188,10 @@ func (msg *MsgOpenContract) ValidateBasic() error {
 		return errors.Wrapf(ErrInvalidAuthorization, "pay-as-you-go contract cannot use open authorization")
 	}
 
+	// a pay-as-you-go contract runs out of queries rather than blocks, it has nothing to renew
+	if msg.ContractType == ContractType_PAY_AS_YOU_GO && msg.AutoRenew {
+		return errors.Wrapf(ErrContractNotSubscription, "pay-as-you-go contract cannot auto-renew")
+	}
+
 	return nil
 }
@@ -3,6 +3,8 @@ package types
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/stretchr/testify/require"
 
 	"github.com/arkeonetwork/arkeo/common"
@@ -41,4 +43,45 @@ func TestBondProviderValidateBasic(t *testing.T) {
 	msg.ContractType = ContractType_PAY_AS_YOU_GO
 	err = msg.ValidateBasic()
 	require.ErrorIs(t, err, ErrInvalidAuthorization)
+
+	// only subscriptions auto-renew
+	msg.Authorization = ContractAuthorization_STRICT
+	msg.AutoRenew = true
+	err = msg.ValidateBasic()
+	require.ErrorIs(t, err, ErrContractNotSubscription)
+	msg.ContractType = ContractType_SUBSCRIPTION
+	require.NoError(t, msg.ValidateBasic())
+}
+
+func TestOpenContractPayerValidateBasic(t *testing.T) {
+	pubkey := GetRandomPubKey()
+	acct, err := pubkey.GetMyAddress()
+	require.NoError(t, err)
+	rate, err := cosmos.ParseCoin("100uarkeo")
+	require.NoError(t, err)
+
+	msg := MsgOpenContract{
+		Creator:          acct.String(),
+		Provider:         pubkey.String(),
+		Client:           pubkey.String(),
+		Service:          common.BTCService.String(),
+		Duration:         100,
+		Rate:             rate,
+		QueriesPerMinute: 10,
+	}
+	require.NoError(t, msg.ValidateBasic())
+	require.Equal(t, []sdk.AccAddress{acct}, msg.GetSigners())
+	require.Equal(t, acct, msg.MustGetPayer())
+
+	// the payer signs along with the creator, and funds the contract
+	payer := GetRandomBech32Addr()
+	msg.Payer = payer.String()
+	require.NoError(t, msg.ValidateBasic())
+	require.Equal(t, []sdk.AccAddress{acct, payer}, msg.GetSigners())
+	require.Equal(t, payer, msg.MustGetPayer())
+
+	msg.Payer = acct.String()
+	require.ErrorIs(t, msg.ValidateBasic(), sdkerrors.ErrInvalidAddress)
+	msg.Payer = "bogus"
+	require.ErrorIs(t, msg.ValidateBasic(), sdkerrors.ErrInvalidAddress)
 }
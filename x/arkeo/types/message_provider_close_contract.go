@@ -0,0 +1,55 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/arkeonetwork/arkeo/common/cosmos"
+)
+
+const TypeMsgProviderCloseContract = "provider_close_contract"
+
+var _ sdk.Msg = &MsgProviderCloseContract{}
+
+func NewMsgProviderCloseContract(creator cosmos.AccAddress, contractId uint64) *MsgProviderCloseContract {
+	return &MsgProviderCloseContract{
+		Creator:    creator.String(),
+		ContractId: contractId,
+	}
+}
+
+func (msg *MsgProviderCloseContract) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgProviderCloseContract) Type() string {
+	return TypeMsgProviderCloseContract
+}
+
+func (msg *MsgProviderCloseContract) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(msg.Creator)}
+}
+
+func (msg *MsgProviderCloseContract) MustGetSigner() sdk.AccAddress {
+	return sdk.MustAccAddressFromBech32(msg.Creator)
+}
+
+func (msg *MsgProviderCloseContract) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgProviderCloseContract) ValidateBasic() error {
+	if msg == nil {
+		return errors.Wrap(cosmos.ErrUnknownRequest("invalid provider close contract message"), "message cammot be empty")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errors.Wrapf(ErrCloseContractUnauthorized, "invalid creator address (%s)", err)
+	}
+
+	if msg.ContractId == 0 {
+		return errors.Wrap(ErrContractNotFound, "invalid contract id")
+	}
+
+	return nil
+}
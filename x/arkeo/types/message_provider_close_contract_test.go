@@ -0,0 +1,20 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestProviderCloseContractValidateBasic(t *testing.T) {
+	acct := GetRandomBech32Addr()
+
+	msg := NewMsgProviderCloseContract(acct, 50)
+	require.NoError(t, msg.ValidateBasic())
+
+	msg.ContractId = 0
+	require.ErrorIs(t, msg.ValidateBasic(), ErrContractNotFound)
+
+	msg = &MsgProviderCloseContract{Creator: "bogus", ContractId: 50}
+	require.ErrorIs(t, msg.ValidateBasic(), ErrCloseContractUnauthorized)
+}
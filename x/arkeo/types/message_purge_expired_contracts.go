@@ -0,0 +1,66 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/arkeonetwork/arkeo/common/cosmos"
+)
+
+const TypeMsgPurgeExpiredContracts = "purge_expired_contracts"
+
+// MaxPurgeContracts contracts a purge looks at most
+const MaxPurgeContracts = 100
+
+var _ sdk.Msg = &MsgPurgeExpiredContracts{}
+
+func NewMsgPurgeExpiredContracts(creator cosmos.AccAddress, contractIds []uint64, limit uint64) *MsgPurgeExpiredContracts {
+	return &MsgPurgeExpiredContracts{
+		Creator:     creator.String(),
+		ContractIds: contractIds,
+		Limit:       limit,
+	}
+}
+
+func (msg *MsgPurgeExpiredContracts) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgPurgeExpiredContracts) Type() string {
+	return TypeMsgPurgeExpiredContracts
+}
+
+func (msg *MsgPurgeExpiredContracts) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(msg.Creator)}
+}
+
+func (msg *MsgPurgeExpiredContracts) MustGetSigner() sdk.AccAddress {
+	return sdk.MustAccAddressFromBech32(msg.Creator)
+}
+
+func (msg *MsgPurgeExpiredContracts) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgPurgeExpiredContracts) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	// either the contracts are listed, or found up to the limit
+	switch {
+	case len(msg.ContractIds) > 0 && msg.Limit > 0:
+		return errors.Wrap(ErrPurgeExpiredContractsSize, "contract ids and limit are exclusive")
+	case len(msg.ContractIds) == 0 && msg.Limit == 0:
+		return errors.Wrap(ErrPurgeExpiredContractsSize, "no contract ids nor limit")
+	case len(msg.ContractIds) > MaxPurgeContracts:
+		return errors.Wrapf(ErrPurgeExpiredContractsSize, "%d contracts, at most %d allowed", len(msg.ContractIds), MaxPurgeContracts)
+	case msg.Limit > MaxPurgeContracts:
+		return errors.Wrapf(ErrPurgeExpiredContractsSize, "limit %d, at most %d allowed", msg.Limit, MaxPurgeContracts)
+	}
+
+	return nil
+}
@@ -0,0 +1,28 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func TestPurgeExpiredContractsValidateBasic(t *testing.T) {
+	acct := GetRandomBech32Addr()
+
+	msg := NewMsgPurgeExpiredContracts(acct, []uint64{1, 2}, 0)
+	require.NoError(t, msg.ValidateBasic())
+	require.NoError(t, NewMsgPurgeExpiredContracts(acct, nil, MaxPurgeContracts).ValidateBasic())
+
+	// either the contracts or the limit
+	require.ErrorIs(t, NewMsgPurgeExpiredContracts(acct, nil, 0).ValidateBasic(), ErrPurgeExpiredContractsSize)
+	require.ErrorIs(t, NewMsgPurgeExpiredContracts(acct, []uint64{1}, 3).ValidateBasic(), ErrPurgeExpiredContractsSize)
+
+	// bounded either way
+	require.ErrorIs(t, NewMsgPurgeExpiredContracts(acct, nil, MaxPurgeContracts+1).ValidateBasic(), ErrPurgeExpiredContractsSize)
+	require.ErrorIs(t, NewMsgPurgeExpiredContracts(acct, make([]uint64, MaxPurgeContracts+1), 0).ValidateBasic(), ErrPurgeExpiredContractsSize)
+
+	msg.Creator = "bogus"
+	require.ErrorIs(t, msg.ValidateBasic(), sdkerrors.ErrInvalidAddress)
+}
@@ -0,0 +1,80 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/arkeonetwork/arkeo/common/cosmos"
+)
+
+const TypeMsgRenewContract = "renew_contract"
+
+var _ sdk.Msg = &MsgRenewContract{}
+
+func NewMsgRenewContract(creator cosmos.AccAddress, contractId uint64, additionalDuration int64, extraDeposit cosmos.Int, rate cosmos.Coin) *MsgRenewContract {
+	return &MsgRenewContract{
+		Creator:            creator.String(),
+		ContractId:         contractId,
+		AdditionalDuration: additionalDuration,
+		ExtraDeposit:       extraDeposit,
+		Rate:               rate,
+	}
+}
+
+func (msg *MsgRenewContract) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRenewContract) Type() string {
+	return TypeMsgRenewContract
+}
+
+func (msg *MsgRenewContract) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(msg.Creator)}
+}
+
+func (msg *MsgRenewContract) MustGetSigner() sdk.AccAddress {
+	return sdk.MustAccAddressFromBech32(msg.Creator)
+}
+
+func (msg *MsgRenewContract) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// AcceptsRate return true when the client accepts a rate other than the one the contract was opened with
+func (msg *MsgRenewContract) AcceptsRate() bool {
+	return len(msg.Rate.Denom) > 0
+}
+
+func (msg *MsgRenewContract) ValidateBasic() error {
+	if msg == nil {
+		return errors.Wrap(cosmos.ErrUnknownRequest("invalid renew contract message"), "message cammot be empty")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errors.Wrapf(ErrRenewContractUnauthorized, "invalid creator address (%s)", err)
+	}
+
+	if msg.ContractId == 0 {
+		return errors.Wrap(ErrContractNotFound, "invalid contract id")
+	}
+
+	if msg.AdditionalDuration <= 0 {
+		return errors.Wrapf(ErrOpenContractDuration, "additional duration must be positive")
+	}
+
+	if msg.ExtraDeposit.IsNil() || msg.ExtraDeposit.IsNegative() {
+		return errors.Wrapf(ErrRenewContractDeposit, "extra deposit cannot be negative")
+	}
+
+	if msg.AcceptsRate() {
+		if err := msg.Rate.Validate(); err != nil {
+			return errors.Wrapf(err, "invalid rate")
+		}
+		if !msg.Rate.Amount.IsPositive() {
+			return errors.Wrapf(ErrOpenContractRate, "contract rate cannot be zero")
+		}
+	}
+
+	return nil
+}
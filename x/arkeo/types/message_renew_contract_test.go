@@ -0,0 +1,26 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/arkeonetwork/arkeo/common/cosmos"
+)
+
+func TestRenewContractValidateBasic(t *testing.T) {
+	msg := MsgRenewContract{
+		Creator:            GetRandomBech32Addr().String(),
+		ContractId:         1,
+		AdditionalDuration: 10,
+		ExtraDeposit:       cosmos.NewInt(10),
+	}
+	require.NoError(t, msg.ValidateBasic())
+
+	msg.AdditionalDuration = 0
+	require.ErrorIs(t, msg.ValidateBasic(), ErrOpenContractDuration)
+
+	msg.AdditionalDuration = 10
+	msg.ExtraDeposit = cosmos.NewInt(-1)
+	require.ErrorIs(t, msg.ValidateBasic(), ErrRenewContractDeposit)
+}
@@ -0,0 +1,64 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/arkeonetwork/arkeo/common"
+	"github.com/arkeonetwork/arkeo/common/cosmos"
+)
+
+const TypeMsgRotateDelegate = "rotate_delegate"
+
+var _ sdk.Msg = &MsgRotateDelegate{}
+
+func NewMsgRotateDelegate(creator cosmos.AccAddress, contractId uint64, newDelegate common.PubKey) *MsgRotateDelegate {
+	return &MsgRotateDelegate{
+		Creator:     creator.String(),
+		ContractId:  contractId,
+		NewDelegate: newDelegate,
+	}
+}
+
+func (msg *MsgRotateDelegate) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRotateDelegate) Type() string {
+	return TypeMsgRotateDelegate
+}
+
+func (msg *MsgRotateDelegate) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(msg.Creator)}
+}
+
+func (msg *MsgRotateDelegate) MustGetSigner() sdk.AccAddress {
+	return sdk.MustAccAddressFromBech32(msg.Creator)
+}
+
+func (msg *MsgRotateDelegate) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgRotateDelegate) ValidateBasic() error {
+	if msg == nil {
+		return errors.Wrap(cosmos.ErrUnknownRequest("invalid rotate delegate message"), "message cammot be empty")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errors.Wrapf(ErrRotateDelegateUnauthorized, "invalid creator address (%s)", err)
+	}
+
+	if msg.ContractId == 0 {
+		return errors.Wrap(ErrContractNotFound, "invalid contract id")
+	}
+
+	if msg.NewDelegate.IsEmpty() {
+		return errors.Wrap(ErrInvalidPubKey, "new delegate cannot be empty")
+	}
+	if _, err := common.NewPubKey(msg.NewDelegate.String()); err != nil {
+		return errors.Wrapf(ErrInvalidPubKey, "invalid new delegate pubkey (%s)", err)
+	}
+
+	return nil
+}
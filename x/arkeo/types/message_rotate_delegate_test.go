@@ -0,0 +1,26 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/arkeonetwork/arkeo/common"
+)
+
+func TestRotateDelegateValidateBasic(t *testing.T) {
+	msg := NewMsgRotateDelegate(GetRandomBech32Addr(), 1, GetRandomPubKey())
+	require.NoError(t, msg.ValidateBasic())
+
+	msg.ContractId = 0
+	require.ErrorIs(t, msg.ValidateBasic(), ErrContractNotFound)
+
+	msg.ContractId = 1
+	msg.NewDelegate = common.EmptyPubKey
+	require.ErrorIs(t, msg.ValidateBasic(), ErrInvalidPubKey)
+	msg.NewDelegate = common.PubKey("bogus")
+	require.ErrorIs(t, msg.ValidateBasic(), ErrInvalidPubKey)
+
+	msg = &MsgRotateDelegate{Creator: "bogus", ContractId: 1, NewDelegate: GetRandomPubKey()}
+	require.ErrorIs(t, msg.ValidateBasic(), ErrRotateDelegateUnauthorized)
+}
@@ -0,0 +1,56 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/arkeonetwork/arkeo/common/cosmos"
+)
+
+const TypeMsgSetAutoRenew = "set_auto_renew"
+
+var _ sdk.Msg = &MsgSetAutoRenew{}
+
+func NewMsgSetAutoRenew(creator cosmos.AccAddress, contractId uint64, autoRenew bool) *MsgSetAutoRenew {
+	return &MsgSetAutoRenew{
+		Creator:    creator.String(),
+		ContractId: contractId,
+		AutoRenew:  autoRenew,
+	}
+}
+
+func (msg *MsgSetAutoRenew) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetAutoRenew) Type() string {
+	return TypeMsgSetAutoRenew
+}
+
+func (msg *MsgSetAutoRenew) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(msg.Creator)}
+}
+
+func (msg *MsgSetAutoRenew) MustGetSigner() sdk.AccAddress {
+	return sdk.MustAccAddressFromBech32(msg.Creator)
+}
+
+func (msg *MsgSetAutoRenew) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSetAutoRenew) ValidateBasic() error {
+	if msg == nil {
+		return errors.Wrap(cosmos.ErrUnknownRequest("invalid set auto-renew message"), "message cammot be empty")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errors.Wrapf(ErrSetAutoRenewUnauthorized, "invalid creator address (%s)", err)
+	}
+
+	if msg.ContractId == 0 {
+		return errors.Wrap(ErrContractNotFound, "invalid contract id")
+	}
+
+	return nil
+}
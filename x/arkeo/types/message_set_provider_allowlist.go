@@ -0,0 +1,88 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/arkeonetwork/arkeo/common"
+	"github.com/arkeonetwork/arkeo/common/cosmos"
+)
+
+const TypeMsgSetProviderAllowlist = "set_provider_allowlist"
+
+var _ sdk.Msg = &MsgSetProviderAllowlist{}
+
+func NewMsgSetProviderAllowlist(creator cosmos.AccAddress, provider common.PubKey, service string, action AllowlistAction, clients []common.PubKey) *MsgSetProviderAllowlist {
+	return &MsgSetProviderAllowlist{
+		Creator:  creator.String(),
+		Provider: provider,
+		Service:  service,
+		Action:   action,
+		Clients:  clients,
+	}
+}
+
+func (msg *MsgSetProviderAllowlist) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetProviderAllowlist) Type() string {
+	return TypeMsgSetProviderAllowlist
+}
+
+func (msg *MsgSetProviderAllowlist) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(msg.Creator)}
+}
+
+func (msg *MsgSetProviderAllowlist) MustGetSigner() sdk.AccAddress {
+	return sdk.MustAccAddressFromBech32(msg.Creator)
+}
+
+func (msg *MsgSetProviderAllowlist) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSetProviderAllowlist) ValidateBasic() error {
+	// verify pubkey
+	if _, err := common.NewPubKey(msg.Provider.String()); err != nil {
+		return errors.Wrapf(ErrInvalidPubKey, "invalid provider pubkey (%s): %s", msg.Provider, err)
+	}
+
+	// verify service
+	if _, err := common.NewService(msg.Service); err != nil {
+		return errors.Wrapf(ErrInvalidService, "invalid service (%s): %s", msg.Service, err)
+	}
+
+	// only the provider sets its allowlist
+	signer := msg.MustGetSigner()
+	provider, err := msg.Provider.GetMyAddress()
+	if err != nil {
+		return err
+	}
+	if !signer.Equals(provider) {
+		return errors.Wrapf(ErrProviderBadSigner, "Signer: %s, Provider Address: %s", msg.GetSigners(), provider)
+	}
+
+	if _, ok := AllowlistAction_name[int32(msg.Action)]; !ok {
+		return errors.Wrapf(ErrInvalidAllowlist, "unknown action (%d)", msg.Action)
+	}
+	// replacing with no clients clears the allowlist, adding or removing none is a no-op
+	if msg.Action != AllowlistAction_ALLOWLIST_REPLACE && len(msg.Clients) == 0 {
+		return errors.Wrapf(ErrInvalidAllowlist, "no clients to %s", msg.Action)
+	}
+
+	seen := make(map[string]bool, len(msg.Clients))
+	for _, client := range msg.Clients {
+		if _, err := common.NewPubKey(client.String()); err != nil {
+			return errors.Wrapf(ErrInvalidPubKey, "invalid client pubkey (%s): %s", client, err)
+		}
+		if seen[client.String()] {
+			return errors.Wrapf(ErrInvalidAllowlist, "duplicate client (%s)", client)
+		}
+		seen[client.String()] = true
+	}
+
+	return nil
+}
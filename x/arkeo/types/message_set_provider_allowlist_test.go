@@ -0,0 +1,41 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/arkeonetwork/arkeo/common"
+)
+
+func TestSetProviderAllowlistValidateBasic(t *testing.T) {
+	pubkey := GetRandomPubKey()
+	acct, err := pubkey.GetMyAddress()
+	require.NoError(t, err)
+	client := GetRandomPubKey()
+
+	msg := NewMsgSetProviderAllowlist(acct, pubkey, common.BTCService.String(), AllowlistAction_ALLOWLIST_ADD, []common.PubKey{client})
+	require.NoError(t, msg.ValidateBasic())
+
+	// replacing with no clients clears the allowlist, adding or removing none is rejected
+	require.NoError(t, NewMsgSetProviderAllowlist(acct, pubkey, common.BTCService.String(), AllowlistAction_ALLOWLIST_REPLACE, nil).ValidateBasic())
+	require.ErrorIs(t, NewMsgSetProviderAllowlist(acct, pubkey, common.BTCService.String(), AllowlistAction_ALLOWLIST_REMOVE, nil).ValidateBasic(), ErrInvalidAllowlist)
+
+	msg.Clients = []common.PubKey{client, client}
+	require.ErrorIs(t, msg.ValidateBasic(), ErrInvalidAllowlist)
+	msg.Clients = []common.PubKey{common.PubKey("bogus")}
+	require.ErrorIs(t, msg.ValidateBasic(), ErrInvalidPubKey)
+	msg.Clients = []common.PubKey{client}
+
+	msg.Action = AllowlistAction(7)
+	require.ErrorIs(t, msg.ValidateBasic(), ErrInvalidAllowlist)
+	msg.Action = AllowlistAction_ALLOWLIST_ADD
+
+	msg.Service = "bogus"
+	require.ErrorIs(t, msg.ValidateBasic(), ErrInvalidService)
+	msg.Service = common.BTCService.String()
+
+	// only the provider sets its allowlist
+	msg.Creator = GetRandomBech32Addr().String()
+	require.ErrorIs(t, msg.ValidateBasic(), ErrProviderBadSigner)
+}
@@ -0,0 +1,60 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/arkeonetwork/arkeo/common/cosmos"
+)
+
+const TypeMsgTopUpContract = "top_up_contract"
+
+var _ sdk.Msg = &MsgTopUpContract{}
+
+func NewMsgTopUpContract(creator cosmos.AccAddress, contractId uint64, deposit cosmos.Int) *MsgTopUpContract {
+	return &MsgTopUpContract{
+		Creator:    creator.String(),
+		ContractId: contractId,
+		Deposit:    deposit,
+	}
+}
+
+func (msg *MsgTopUpContract) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgTopUpContract) Type() string {
+	return TypeMsgTopUpContract
+}
+
+func (msg *MsgTopUpContract) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(msg.Creator)}
+}
+
+func (msg *MsgTopUpContract) MustGetSigner() sdk.AccAddress {
+	return sdk.MustAccAddressFromBech32(msg.Creator)
+}
+
+func (msg *MsgTopUpContract) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgTopUpContract) ValidateBasic() error {
+	if msg == nil {
+		return errors.Wrap(cosmos.ErrUnknownRequest("invalid top up contract message"), "message cammot be empty")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errors.Wrapf(ErrTopUpContractUnauthorized, "invalid creator address (%s)", err)
+	}
+
+	if msg.ContractId == 0 {
+		return errors.Wrap(ErrContractNotFound, "invalid contract id")
+	}
+
+	if msg.Deposit.IsNil() || !msg.Deposit.IsPositive() {
+		return errors.Wrapf(ErrTopUpContractDeposit, "deposit must be positive")
+	}
+
+	return nil
+}
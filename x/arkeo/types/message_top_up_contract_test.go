@@ -0,0 +1,26 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/arkeonetwork/arkeo/common/cosmos"
+)
+
+func TestTopUpContractValidateBasic(t *testing.T) {
+	msg := NewMsgTopUpContract(GetRandomBech32Addr(), 1, cosmos.NewInt(100))
+	require.NoError(t, msg.ValidateBasic())
+
+	msg.ContractId = 0
+	require.ErrorIs(t, msg.ValidateBasic(), ErrContractNotFound)
+
+	msg.ContractId = 1
+	msg.Deposit = cosmos.ZeroInt()
+	require.ErrorIs(t, msg.ValidateBasic(), ErrTopUpContractDeposit)
+	msg.Deposit = cosmos.NewInt(-1)
+	require.ErrorIs(t, msg.ValidateBasic(), ErrTopUpContractDeposit)
+
+	msg = &MsgTopUpContract{Creator: "bogus", ContractId: 1, Deposit: cosmos.NewInt(100)}
+	require.ErrorIs(t, msg.ValidateBasic(), ErrTopUpContractUnauthorized)
+}
@@ -1,12 +1,79 @@
 package types
 
 import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"gopkg.in/yaml.v2"
+
+	"github.com/arkeonetwork/arkeo/common"
+	"github.com/arkeonetwork/arkeo/x/arkeo/configs"
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
 
+var (
+	KeySettlementGracePeriod        = []byte("SettlementGracePeriod")
+	KeySlashFraction                = []byte("SlashFraction")
+	KeySlashEscalation              = []byte("SlashEscalation")
+	KeyAllowedDenoms                = []byte("AllowedDenoms")
+	KeyMaxOpenContracts             = []byte("MaxOpenContracts")
+	KeyMinPayAsYouGoDeposit         = []byte("MinPayAsYouGoDeposit")
+	KeyDepositRefundTolerance       = []byte("DepositRefundTolerance")
+	KeyMaxClaimBatchSize            = []byte("MaxClaimBatchSize")
+	KeyMaxMetadataUriLength         = []byte("MaxMetadataUriLength")
+	KeyMinProviderBond              = []byte("MinProviderBond")
+	KeyServiceMinBonds              = []byte("ServiceMinBonds")
+	KeyContractDormancyPeriod       = []byte("ContractDormancyPeriod")
+	KeyPurgeReward                  = []byte("PurgeReward")
+	KeyMaxContractStartDelay        = []byte("MaxContractStartDelay")
+	KeyMaxAllowlistSize             = []byte("MaxAllowlistSize")
+	KeyEarlyCloseCompensation       = []byte("EarlyCloseCompensation")
+	KeyEarlyCloseMinPeriod          = []byte("EarlyCloseMinPeriod")
+	KeyProviderCloseContractPenalty = []byte("ProviderCloseContractPenalty")
+)
+
+const (
+	// DefaultSettlementGracePeriod blocks added to the settlement period of the contracts
+	DefaultSettlementGracePeriod int64 = 10
+	// DefaultSlashFraction basis points of the provider bond slashed for its first fault
+	DefaultSlashFraction int64 = 500
+	// DefaultSlashEscalation basis points added to the slashed fraction for each earlier fault
+	DefaultSlashEscalation int64 = 500
+	// DefaultMaxOpenContracts open contracts a provider accepts at most
+	DefaultMaxOpenContracts uint64 = 1000
+	// DefaultMinPayAsYouGoDeposit queries at the contract rate a pay-as-you-go deposit covers at least
+	DefaultMinPayAsYouGoDeposit int64 = 10
+	// DefaultDepositRefundTolerance basis points a subscription deposit can be overpaid by
+	DefaultDepositRefundTolerance int64 = 100
+	// DefaultMaxClaimBatchSize claims a batch carries at most
+	DefaultMaxClaimBatchSize uint64 = 100
+	// DefaultMaxMetadataUriLength length of the metadata uri of a provider at most
+	DefaultMaxMetadataUriLength uint64 = 100
+	// DefaultMinProviderBond bond a provider needs at least to be online
+	DefaultMinProviderBond int64 = 100000000
+	// DefaultContractDormancyPeriod blocks an unsettled contract stays past its settlement period before it can be
+	// purged, about a week
+	DefaultContractDormancyPeriod int64 = 120960
+	// DefaultPurgeReward paid out of the reserve for each unsettled contract purged
+	DefaultPurgeReward int64 = 1000000
+	// DefaultMaxContractStartDelay blocks ahead a contract can be scheduled to start at most, about a week
+	DefaultMaxContractStartDelay int64 = 120960
+	// DefaultMaxAllowlistSize clients a provider can allowlist for a service at most
+	DefaultMaxAllowlistSize uint64 = 100
+	// DefaultEarlyCloseCompensation basis points of the unused deposit of a subscription closed early paid to the
+	// provider, none of it
+	DefaultEarlyCloseCompensation int64 = 0
+	// DefaultEarlyCloseMinPeriod blocks of a subscription closed early the client pays for at least, only the blocks
+	// served
+	DefaultEarlyCloseMinPeriod int64 = 0
+	// DefaultProviderCloseContractPenalty paid from the provider bond to the client of each contract the provider closes
+	DefaultProviderCloseContractPenalty int64 = 100000000
+)
+
 // ParamKeyTable the param key table for launch module
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
@@ -15,8 +82,25 @@ func ParamKeyTable() paramtypes.KeyTable {
 // NewParams creates a new Params instance
 func NewParams() Params {
 	return Params{
-		BlockPerYear:  6311520,
-		EmissionCurve: 6,
+		BlockPerYear:                 6311520,
+		EmissionCurve:                6,
+		SettlementGracePeriod:        DefaultSettlementGracePeriod,
+		SlashFraction:                DefaultSlashFraction,
+		SlashEscalation:              DefaultSlashEscalation,
+		AllowedDenoms:                []string{configs.Denom},
+		MaxOpenContracts:             DefaultMaxOpenContracts,
+		MinPayAsYouGoDeposit:         DefaultMinPayAsYouGoDeposit,
+		DepositRefundTolerance:       DefaultDepositRefundTolerance,
+		MaxClaimBatchSize:            DefaultMaxClaimBatchSize,
+		MaxMetadataUriLength:         DefaultMaxMetadataUriLength,
+		MinProviderBond:              DefaultMinProviderBond,
+		ContractDormancyPeriod:       DefaultContractDormancyPeriod,
+		PurgeReward:                  DefaultPurgeReward,
+		MaxContractStartDelay:        DefaultMaxContractStartDelay,
+		MaxAllowlistSize:             DefaultMaxAllowlistSize,
+		EarlyCloseCompensation:       DefaultEarlyCloseCompensation,
+		EarlyCloseMinPeriod:          DefaultEarlyCloseMinPeriod,
+		ProviderCloseContractPenalty: DefaultProviderCloseContractPenalty,
 	}
 }
 
@@ -27,11 +111,234 @@ func DefaultParams() Params {
 
 // ParamSetPairs get the params.ParamSet
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
-	return paramtypes.ParamSetPairs{}
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeySettlementGracePeriod, &p.SettlementGracePeriod, validateSettlementGracePeriod),
+		paramtypes.NewParamSetPair(KeySlashFraction, &p.SlashFraction, validateBasisPoints),
+		paramtypes.NewParamSetPair(KeySlashEscalation, &p.SlashEscalation, validateBasisPoints),
+		paramtypes.NewParamSetPair(KeyAllowedDenoms, &p.AllowedDenoms, validateAllowedDenoms),
+		paramtypes.NewParamSetPair(KeyMaxOpenContracts, &p.MaxOpenContracts, validateMaxOpenContracts),
+		paramtypes.NewParamSetPair(KeyMinPayAsYouGoDeposit, &p.MinPayAsYouGoDeposit, validateMinPayAsYouGoDeposit),
+		paramtypes.NewParamSetPair(KeyDepositRefundTolerance, &p.DepositRefundTolerance, validateBasisPoints),
+		paramtypes.NewParamSetPair(KeyMaxClaimBatchSize, &p.MaxClaimBatchSize, validateMaxClaimBatchSize),
+		paramtypes.NewParamSetPair(KeyMaxMetadataUriLength, &p.MaxMetadataUriLength, validateMaxMetadataUriLength),
+		paramtypes.NewParamSetPair(KeyMinProviderBond, &p.MinProviderBond, validateMinProviderBond),
+		paramtypes.NewParamSetPair(KeyServiceMinBonds, &p.ServiceMinBonds, validateServiceMinBonds),
+		paramtypes.NewParamSetPair(KeyContractDormancyPeriod, &p.ContractDormancyPeriod, validateContractDormancyPeriod),
+		paramtypes.NewParamSetPair(KeyPurgeReward, &p.PurgeReward, validatePurgeReward),
+		paramtypes.NewParamSetPair(KeyMaxContractStartDelay, &p.MaxContractStartDelay, validateMaxContractStartDelay),
+		paramtypes.NewParamSetPair(KeyMaxAllowlistSize, &p.MaxAllowlistSize, validateMaxAllowlistSize),
+		paramtypes.NewParamSetPair(KeyEarlyCloseCompensation, &p.EarlyCloseCompensation, validateBasisPoints),
+		paramtypes.NewParamSetPair(KeyEarlyCloseMinPeriod, &p.EarlyCloseMinPeriod, validateEarlyCloseMinPeriod),
+		paramtypes.NewParamSetPair(KeyProviderCloseContractPenalty, &p.ProviderCloseContractPenalty, validateProviderCloseContractPenalty),
+	}
 }
 
 // Validate validates the set of params
 func (p Params) Validate() error {
+	if err := validateSettlementGracePeriod(p.SettlementGracePeriod); err != nil {
+		return err
+	}
+	if err := validateBasisPoints(p.SlashFraction); err != nil {
+		return err
+	}
+	if err := validateBasisPoints(p.SlashEscalation); err != nil {
+		return err
+	}
+	if err := validateAllowedDenoms(p.AllowedDenoms); err != nil {
+		return err
+	}
+	if err := validateMaxOpenContracts(p.MaxOpenContracts); err != nil {
+		return err
+	}
+	if err := validateMinPayAsYouGoDeposit(p.MinPayAsYouGoDeposit); err != nil {
+		return err
+	}
+	if err := validateBasisPoints(p.DepositRefundTolerance); err != nil {
+		return err
+	}
+	if err := validateMaxClaimBatchSize(p.MaxClaimBatchSize); err != nil {
+		return err
+	}
+	if err := validateMaxMetadataUriLength(p.MaxMetadataUriLength); err != nil {
+		return err
+	}
+	if err := validateMinProviderBond(p.MinProviderBond); err != nil {
+		return err
+	}
+	if err := validateServiceMinBonds(p.ServiceMinBonds); err != nil {
+		return err
+	}
+	if err := validateContractDormancyPeriod(p.ContractDormancyPeriod); err != nil {
+		return err
+	}
+	if err := validatePurgeReward(p.PurgeReward); err != nil {
+		return err
+	}
+	if err := validateMaxContractStartDelay(p.MaxContractStartDelay); err != nil {
+		return err
+	}
+	if err := validateMaxAllowlistSize(p.MaxAllowlistSize); err != nil {
+		return err
+	}
+	if err := validateBasisPoints(p.EarlyCloseCompensation); err != nil {
+		return err
+	}
+	if err := validateEarlyCloseMinPeriod(p.EarlyCloseMinPeriod); err != nil {
+		return err
+	}
+	return validateProviderCloseContractPenalty(p.ProviderCloseContractPenalty)
+}
+
+// IsDenomAllowed returns true when rates and contracts can be in the denom
+func (p Params) IsDenomAllowed(denom string) bool {
+	for _, allowed := range p.AllowedDenoms {
+		if allowed == denom {
+			return true
+		}
+	}
+	return false
+}
+
+// MinBond returns the bond a provider of the service needs at least to be online, the default of the params for the
+// services not listed, zero when the params leave it to the MinProviderBond config
+func (p Params) MinBond(service common.Service) int64 {
+	for _, minBond := range p.ServiceMinBonds {
+		if minBond.Service == service.String() {
+			return minBond.MinBond
+		}
+	}
+	return p.MinProviderBond
+}
+
+// Changes list the params of the set changed from old to p, with their JSON encoded values
+func (p Params) Changes(old Params) ([]ParamChange, error) {
+	var changes []ParamChange
+	oldPairs := old.ParamSetPairs()
+	for i, pair := range p.ParamSetPairs() {
+		oldValue, err := json.Marshal(oldPairs[i].Value)
+		if err != nil {
+			return nil, err
+		}
+		newValue, err := json.Marshal(pair.Value)
+		if err != nil {
+			return nil, err
+		}
+		if bytes.Equal(oldValue, newValue) {
+			continue
+		}
+		changes = append(changes, ParamChange{
+			Key:      string(pair.Key),
+			OldValue: string(oldValue),
+			NewValue: string(newValue),
+		})
+	}
+	return changes, nil
+}
+
+func validateSettlementGracePeriod(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v < 0 {
+		return fmt.Errorf("settlement grace period cannot be negative: %d", v)
+	}
+	return nil
+}
+
+func validateBasisPoints(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v < 0 || v > configs.MaxBasisPoints {
+		return fmt.Errorf("basis points must be between 0 and %d: %d", configs.MaxBasisPoints, v)
+	}
+	return nil
+}
+
+func validateAllowedDenoms(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	seen := make(map[string]bool)
+	for _, denom := range v {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return fmt.Errorf("invalid allowed denom: %w", err)
+		}
+		if seen[denom] {
+			return fmt.Errorf("duplicate allowed denom: %s", denom)
+		}
+		seen[denom] = true
+	}
+	return nil
+}
+
+// any cap is valid, zero lifting it
+func validateMaxOpenContracts(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateMinPayAsYouGoDeposit(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v < 0 {
+		return fmt.Errorf("min pay-as-you-go deposit cannot be negative: %d", v)
+	}
+	return nil
+}
+
+// any size is valid, zero disabling the batches
+func validateMaxClaimBatchSize(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+// zero for no limit
+func validateMaxMetadataUriLength(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateMinProviderBond(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v < 0 {
+		return fmt.Errorf("min provider bond cannot be negative: %d", v)
+	}
+	return nil
+}
+
+func validateServiceMinBonds(i interface{}) error {
+	v, ok := i.([]ServiceMinBond)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	seen := make(map[string]bool)
+	for _, minBond := range v {
+		if _, err := common.NewService(minBond.Service); err != nil {
+			return fmt.Errorf("invalid service min bond: %w", err)
+		}
+		if seen[minBond.Service] {
+			return fmt.Errorf("duplicate service min bond: %s", minBond.Service)
+		}
+		seen[minBond.Service] = true
+		if minBond.MinBond < 0 {
+			return fmt.Errorf("min bond of service %s cannot be negative: %d", minBond.Service, minBond.MinBond)
+		}
+	}
 	return nil
 }
 
@@ -40,3 +347,68 @@ func (p Params) String() string {
 	out, _ := yaml.Marshal(p)
 	return string(out)
 }
+
+func validateContractDormancyPeriod(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v < 0 {
+		return fmt.Errorf("contract dormancy period cannot be negative: %d", v)
+	}
+	return nil
+}
+
+func validatePurgeReward(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v < 0 {
+		return fmt.Errorf("purge reward cannot be negative: %d", v)
+	}
+	return nil
+}
+
+// zero disabling the scheduled contracts
+func validateMaxContractStartDelay(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v < 0 {
+		return fmt.Errorf("max contract start delay cannot be negative: %d", v)
+	}
+	return nil
+}
+
+// zero leaving the providers no allowlist, open to all clients
+func validateMaxAllowlistSize(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateEarlyCloseMinPeriod(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v < 0 {
+		return fmt.Errorf("early close min period cannot be negative: %d", v)
+	}
+	return nil
+}
+
+// zero for no penalty
+func validateProviderCloseContractPenalty(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v < 0 {
+		return fmt.Errorf("provider close contract penalty cannot be negative: %d", v)
+	}
+	return nil
+}
@@ -30,6 +30,54 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type Params struct {
 	BlockPerYear  uint64 `protobuf:"varint,8,opt,name=block_per_year,json=blockPerYear,proto3" json:"block_per_year,omitempty"`
 	EmissionCurve uint64 `protobuf:"varint,9,opt,name=emission_curve,json=emissionCurve,proto3" json:"emission_curve,omitempty"`
+	// blocks added to the settlement period of the contracts opened from now on, for the last claims to come in
+	SettlementGracePeriod int64 `protobuf:"varint,10,opt,name=settlement_grace_period,json=settlementGracePeriod,proto3" json:"settlement_grace_period,omitempty"`
+	// basis points of the provider bond slashed for its first fault
+	SlashFraction int64 `protobuf:"varint,11,opt,name=slash_fraction,json=slashFraction,proto3" json:"slash_fraction,omitempty"`
+	// basis points added to the slashed fraction for each earlier fault of the provider
+	SlashEscalation int64 `protobuf:"varint,12,opt,name=slash_escalation,json=slashEscalation,proto3" json:"slash_escalation,omitempty"`
+	// denoms providers can set rates in and contracts can open with, the
+	// contracts already open keep settling in their denom
+	AllowedDenoms []string `protobuf:"bytes,13,rep,name=allowed_denoms,json=allowedDenoms,proto3" json:"allowed_denoms,omitempty"`
+	// open contracts a provider accepts at most, it can lower its own cap,
+	// zero for no cap
+	MaxOpenContracts uint64 `protobuf:"varint,14,opt,name=max_open_contracts,json=maxOpenContracts,proto3" json:"max_open_contracts,omitempty"`
+	// queries at the rate of the contract the deposit of a pay-as-you-go
+	// contract covers at least
+	MinPayAsYouGoDeposit int64 `protobuf:"varint,15,opt,name=min_pay_as_you_go_deposit,json=minPayAsYouGoDeposit,proto3" json:"min_pay_as_you_go_deposit,omitempty"`
+	// basis points of the deposit a subscription needs it can be overpaid by,
+	// the excess is left with the client rather than escrowed
+	DepositRefundTolerance int64 `protobuf:"varint,16,opt,name=deposit_refund_tolerance,json=depositRefundTolerance,proto3" json:"deposit_refund_tolerance,omitempty"`
+	// claims a batch of contract income claims carries at most, zero
+	// disabling the batches
+	MaxClaimBatchSize uint64 `protobuf:"varint,17,opt,name=max_claim_batch_size,json=maxClaimBatchSize,proto3" json:"max_claim_batch_size,omitempty"`
+	// length of the metadata uri a provider sets at most, zero for no limit
+	MaxMetadataUriLength uint64 `protobuf:"varint,18,opt,name=max_metadata_uri_length,json=maxMetadataUriLength,proto3" json:"max_metadata_uri_length,omitempty"`
+	// bond a provider needs at least to be online, for the services missing
+	// from service_min_bonds, zero falling back to the MinProviderBond config
+	MinProviderBond int64 `protobuf:"varint,19,opt,name=min_provider_bond,json=minProviderBond,proto3" json:"min_provider_bond,omitempty"`
+	// bond a provider needs at least to be online, per service
+	ServiceMinBonds []ServiceMinBond `protobuf:"bytes,20,rep,name=service_min_bonds,json=serviceMinBonds,proto3" json:"service_min_bonds"`
+	// blocks an unsettled contract stays in state past the end of its
+	// settlement period before anyone can purge it, zero disabling the purge
+	ContractDormancyPeriod int64 `protobuf:"varint,21,opt,name=contract_dormancy_period,json=contractDormancyPeriod,proto3" json:"contract_dormancy_period,omitempty"`
+	// paid out of the reserve to the account purging a contract left unsettled, per contract, kept below the cost of
+	// opening a contract
+	PurgeReward int64 `protobuf:"varint,22,opt,name=purge_reward,json=purgeReward,proto3" json:"purge_reward,omitempty"`
+	// blocks ahead of the current height a contract can be scheduled to start
+	// at most, zero disabling the scheduled contracts
+	MaxContractStartDelay int64 `protobuf:"varint,23,opt,name=max_contract_start_delay,json=maxContractStartDelay,proto3" json:"max_contract_start_delay,omitempty"`
+	// clients a provider can allowlist for a service at most
+	MaxAllowlistSize uint64 `protobuf:"varint,24,opt,name=max_allowlist_size,json=maxAllowlistSize,proto3" json:"max_allowlist_size,omitempty"`
+	// basis points of the unused deposit of a subscription closed early by its
+	// client paid to the provider, the rest refunded
+	EarlyCloseCompensation int64 `protobuf:"varint,25,opt,name=early_close_compensation,json=earlyCloseCompensation,proto3" json:"early_close_compensation,omitempty"`
+	// blocks of a subscription its client pays for even when closing it before
+	// they are served, the duration of the contract at most
+	EarlyCloseMinPeriod int64 `protobuf:"varint,26,opt,name=early_close_min_period,json=earlyCloseMinPeriod,proto3" json:"early_close_min_period,omitempty"`
+	// paid from the provider bond to the client of each contract the provider
+	// closes, the bond left at most
+	ProviderCloseContractPenalty int64 `protobuf:"varint,27,opt,name=provider_close_contract_penalty,json=providerCloseContractPenalty,proto3" json:"provider_close_contract_penalty,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -78,30 +126,300 @@ func (m *Params) GetEmissionCurve() uint64 {
 	return 0
 }
 
+func (m *Params) GetSettlementGracePeriod() int64 {
+	if m != nil {
+		return m.SettlementGracePeriod
+	}
+	return 0
+}
+
+func (m *Params) GetSlashFraction() int64 {
+	if m != nil {
+		return m.SlashFraction
+	}
+	return 0
+}
+
+func (m *Params) GetSlashEscalation() int64 {
+	if m != nil {
+		return m.SlashEscalation
+	}
+	return 0
+}
+
+func (m *Params) GetAllowedDenoms() []string {
+	if m != nil {
+		return m.AllowedDenoms
+	}
+	return nil
+}
+
+func (m *Params) GetMaxOpenContracts() uint64 {
+	if m != nil {
+		return m.MaxOpenContracts
+	}
+	return 0
+}
+
+func (m *Params) GetMinPayAsYouGoDeposit() int64 {
+	if m != nil {
+		return m.MinPayAsYouGoDeposit
+	}
+	return 0
+}
+
+func (m *Params) GetDepositRefundTolerance() int64 {
+	if m != nil {
+		return m.DepositRefundTolerance
+	}
+	return 0
+}
+
+func (m *Params) GetMaxClaimBatchSize() uint64 {
+	if m != nil {
+		return m.MaxClaimBatchSize
+	}
+	return 0
+}
+
+func (m *Params) GetMaxMetadataUriLength() uint64 {
+	if m != nil {
+		return m.MaxMetadataUriLength
+	}
+	return 0
+}
+
+func (m *Params) GetMinProviderBond() int64 {
+	if m != nil {
+		return m.MinProviderBond
+	}
+	return 0
+}
+
+func (m *Params) GetServiceMinBonds() []ServiceMinBond {
+	if m != nil {
+		return m.ServiceMinBonds
+	}
+	return nil
+}
+
+func (m *Params) GetContractDormancyPeriod() int64 {
+	if m != nil {
+		return m.ContractDormancyPeriod
+	}
+	return 0
+}
+
+func (m *Params) GetPurgeReward() int64 {
+	if m != nil {
+		return m.PurgeReward
+	}
+	return 0
+}
+
+func (m *Params) GetMaxContractStartDelay() int64 {
+	if m != nil {
+		return m.MaxContractStartDelay
+	}
+	return 0
+}
+
+func (m *Params) GetMaxAllowlistSize() uint64 {
+	if m != nil {
+		return m.MaxAllowlistSize
+	}
+	return 0
+}
+
+func (m *Params) GetEarlyCloseCompensation() int64 {
+	if m != nil {
+		return m.EarlyCloseCompensation
+	}
+	return 0
+}
+
+func (m *Params) GetEarlyCloseMinPeriod() int64 {
+	if m != nil {
+		return m.EarlyCloseMinPeriod
+	}
+	return 0
+}
+
+func (m *Params) GetProviderCloseContractPenalty() int64 {
+	if m != nil {
+		return m.ProviderCloseContractPenalty
+	}
+	return 0
+}
+
+// ServiceMinBond minimum bond of the providers of a service
+type ServiceMinBond struct {
+	Service string `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
+	MinBond int64  `protobuf:"varint,2,opt,name=min_bond,json=minBond,proto3" json:"min_bond,omitempty"`
+}
+
+func (m *ServiceMinBond) Reset()         { *m = ServiceMinBond{} }
+func (m *ServiceMinBond) String() string { return proto.CompactTextString(m) }
+func (*ServiceMinBond) ProtoMessage()    {}
+func (*ServiceMinBond) Descriptor() ([]byte, []int) {
+	return fileDescriptor_47c871f4fc73dfc5, []int{1}
+}
+func (m *ServiceMinBond) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ServiceMinBond) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ServiceMinBond.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ServiceMinBond) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ServiceMinBond.Merge(m, src)
+}
+func (m *ServiceMinBond) XXX_Size() int {
+	return m.Size()
+}
+func (m *ServiceMinBond) XXX_DiscardUnknown() {
+	xxx_messageInfo_ServiceMinBond.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ServiceMinBond proto.InternalMessageInfo
+
+func (m *ServiceMinBond) GetService() string {
+	if m != nil {
+		return m.Service
+	}
+	return ""
+}
+
+func (m *ServiceMinBond) GetMinBond() int64 {
+	if m != nil {
+		return m.MinBond
+	}
+	return 0
+}
+
+// ParamsRecord is the params as the end blocker last saw them, along with the
+// height they last changed at
+type ParamsRecord struct {
+	Params           Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	LastChangeHeight int64  `protobuf:"varint,2,opt,name=last_change_height,json=lastChangeHeight,proto3" json:"last_change_height,omitempty"`
+}
+
+func (m *ParamsRecord) Reset()         { *m = ParamsRecord{} }
+func (m *ParamsRecord) String() string { return proto.CompactTextString(m) }
+func (*ParamsRecord) ProtoMessage()    {}
+func (*ParamsRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_47c871f4fc73dfc5, []int{2}
+}
+func (m *ParamsRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ParamsRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ParamsRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ParamsRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParamsRecord.Merge(m, src)
+}
+func (m *ParamsRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *ParamsRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParamsRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParamsRecord proto.InternalMessageInfo
+
+func (m *ParamsRecord) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *ParamsRecord) GetLastChangeHeight() int64 {
+	if m != nil {
+		return m.LastChangeHeight
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "arkeo.arkeo.Params")
+	proto.RegisterType((*ServiceMinBond)(nil), "arkeo.arkeo.ServiceMinBond")
+	proto.RegisterType((*ParamsRecord)(nil), "arkeo.arkeo.ParamsRecord")
 }
 
 func init() { proto.RegisterFile("arkeo/arkeo/params.proto", fileDescriptor_47c871f4fc73dfc5) }
 
 var fileDescriptor_47c871f4fc73dfc5 = []byte{
-	// 242 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x48, 0x2c, 0xca, 0x4e,
-	0xcd, 0xd7, 0x87, 0x90, 0x05, 0x89, 0x45, 0x89, 0xb9, 0xc5, 0x7a, 0x05, 0x45, 0xf9, 0x25, 0xf9,
-	0x42, 0xdc, 0x60, 0x31, 0x3d, 0x30, 0x29, 0x25, 0x92, 0x9e, 0x9f, 0x9e, 0x0f, 0x16, 0xd7, 0x07,
-	0xb1, 0x20, 0x4a, 0xa4, 0xe4, 0x92, 0xf3, 0x8b, 0x73, 0xf3, 0x8b, 0xf5, 0x93, 0x12, 0x8b, 0x53,
-	0xf5, 0xcb, 0x0c, 0x93, 0x52, 0x4b, 0x12, 0x0d, 0xf5, 0x93, 0xf3, 0x33, 0xf3, 0xa0, 0xf2, 0x92,
-	0x10, 0xf9, 0x78, 0x88, 0x46, 0x08, 0x07, 0x22, 0xa5, 0x14, 0xcd, 0xc5, 0x16, 0x00, 0xb6, 0x4d,
-	0x48, 0x85, 0x8b, 0x2f, 0x29, 0x27, 0x3f, 0x39, 0x3b, 0xbe, 0x20, 0xb5, 0x28, 0xbe, 0x32, 0x35,
-	0xb1, 0x48, 0x82, 0x43, 0x81, 0x51, 0x83, 0x25, 0x88, 0x07, 0x2c, 0x1a, 0x90, 0x5a, 0x14, 0x99,
-	0x9a, 0x58, 0x24, 0xa4, 0xca, 0xc5, 0x97, 0x9a, 0x9b, 0x59, 0x5c, 0x9c, 0x99, 0x9f, 0x17, 0x9f,
-	0x5c, 0x5a, 0x54, 0x96, 0x2a, 0xc1, 0x09, 0x56, 0xc5, 0x0b, 0x13, 0x75, 0x06, 0x09, 0x5a, 0xb1,
-	0xcc, 0x58, 0x20, 0xcf, 0xe0, 0xe4, 0x7a, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f,
-	0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c,
-	0x51, 0xda, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0x10, 0x3f, 0xe7, 0xa5,
-	0x96, 0x94, 0xe7, 0x17, 0x65, 0x43, 0x03, 0xa0, 0x02, 0x4a, 0x97, 0x54, 0x16, 0xa4, 0x16, 0x27,
-	0xb1, 0x81, 0x9d, 0x6a, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0xd2, 0x40, 0x26, 0xf7, 0x24, 0x01,
-	0x00, 0x00,
+	// 814 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x94, 0x4d, 0x6f, 0x1b, 0x37,
+	0x13, 0xc7, 0xa5, 0xc7, 0x86, 0x63, 0x53, 0x7e, 0xa5, 0x1d, 0x9b, 0x76, 0x1e, 0xc8, 0xaa, 0xd1,
+	0x02, 0xea, 0x0b, 0x24, 0x38, 0x41, 0x9b, 0xa0, 0xb7, 0x58, 0x76, 0xd3, 0x43, 0x8d, 0x0a, 0x72,
+	0x7b, 0x48, 0x2f, 0x04, 0xc5, 0x9d, 0xac, 0x08, 0xef, 0x92, 0x0b, 0x92, 0xb2, 0xbd, 0xf9, 0x08,
+	0x3d, 0xf5, 0xd8, 0x63, 0x3f, 0x4e, 0x8e, 0x39, 0xf6, 0x54, 0x14, 0xf6, 0x17, 0x29, 0x38, 0xe4,
+	0x3a, 0xf6, 0x65, 0x25, 0xfe, 0x7f, 0xff, 0xe1, 0x70, 0x86, 0x03, 0x12, 0x26, 0xec, 0x25, 0x98,
+	0x61, 0xfc, 0x56, 0xc2, 0x8a, 0xd2, 0x0d, 0x2a, 0x6b, 0xbc, 0xa1, 0x1d, 0xd4, 0x06, 0xf8, 0x3d,
+	0xd8, 0xc9, 0x4d, 0x6e, 0x50, 0x1f, 0x86, 0x7f, 0xd1, 0x72, 0xd0, 0x95, 0xc6, 0x95, 0xc6, 0x0d,
+	0xa7, 0xc2, 0xc1, 0xf0, 0xea, 0x78, 0x0a, 0x5e, 0x1c, 0x0f, 0xa5, 0x51, 0x3a, 0xf1, 0xfd, 0xc8,
+	0x79, 0x0c, 0x8c, 0x8b, 0x88, 0x8e, 0x7e, 0x5f, 0x26, 0x4b, 0x63, 0x4c, 0x47, 0x3f, 0x27, 0xeb,
+	0xd3, 0xc2, 0xc8, 0x4b, 0x5e, 0x81, 0xe5, 0x35, 0x08, 0xcb, 0x96, 0x7b, 0xed, 0xfe, 0xe2, 0x64,
+	0x15, 0xd5, 0x31, 0xd8, 0xb7, 0x20, 0x2c, 0xfd, 0x82, 0xac, 0x43, 0xa9, 0x9c, 0x53, 0x46, 0x73,
+	0x39, 0xb7, 0x57, 0xc0, 0x56, 0xd0, 0xb5, 0xd6, 0xa8, 0xa3, 0x20, 0xd2, 0xef, 0xc8, 0x9e, 0x03,
+	0xef, 0x0b, 0x28, 0x41, 0x7b, 0x9e, 0x5b, 0x21, 0x21, 0xec, 0xab, 0x4c, 0xc6, 0x48, 0xaf, 0xdd,
+	0x5f, 0x98, 0x3c, 0xfd, 0x84, 0xdf, 0x04, 0x3a, 0x46, 0x18, 0xb6, 0x77, 0x85, 0x70, 0x33, 0xfe,
+	0xce, 0x0a, 0xe9, 0x95, 0xd1, 0xac, 0x83, 0xf6, 0x35, 0x54, 0x7f, 0x48, 0x22, 0xfd, 0x92, 0x6c,
+	0x46, 0x1b, 0x38, 0x29, 0x0a, 0x81, 0xc6, 0x55, 0x34, 0x6e, 0xa0, 0x7e, 0x76, 0x2f, 0x87, 0x1d,
+	0x45, 0x51, 0x98, 0x6b, 0xc8, 0x78, 0x06, 0xda, 0x94, 0x8e, 0xad, 0xf5, 0x16, 0xfa, 0x2b, 0x93,
+	0xb5, 0xa4, 0x9e, 0xa2, 0x48, 0xbf, 0x21, 0xb4, 0x14, 0x37, 0xdc, 0x54, 0xa0, 0xb9, 0x34, 0xda,
+	0x87, 0x4c, 0x8e, 0xad, 0x63, 0x6d, 0x9b, 0xa5, 0xb8, 0xf9, 0xb9, 0x02, 0x3d, 0x6a, 0x74, 0xfa,
+	0x92, 0xec, 0x97, 0x4a, 0xf3, 0x4a, 0xd4, 0x5c, 0x38, 0x5e, 0x9b, 0x39, 0xcf, 0x0d, 0xcf, 0xa0,
+	0x32, 0x4e, 0x79, 0xb6, 0x81, 0x07, 0xd9, 0x29, 0x95, 0x1e, 0x8b, 0xfa, 0xb5, 0x7b, 0x6b, 0xe6,
+	0x6f, 0xcc, 0x69, 0x64, 0xf4, 0x15, 0x61, 0xc9, 0xc6, 0x2d, 0xbc, 0x9b, 0xeb, 0x8c, 0x7b, 0x53,
+	0x80, 0x15, 0x5a, 0x02, 0xdb, 0xc4, 0xb8, 0xdd, 0xc4, 0x27, 0x88, 0x7f, 0x69, 0x28, 0x1d, 0x92,
+	0x9d, 0x70, 0x40, 0x59, 0x08, 0x55, 0xf2, 0xa9, 0xf0, 0x72, 0xc6, 0x9d, 0x7a, 0x0f, 0x6c, 0x0b,
+	0x8f, 0xb8, 0x55, 0x8a, 0x9b, 0x51, 0x40, 0x27, 0x81, 0x5c, 0xa8, 0xf7, 0x40, 0xbf, 0x25, 0x7b,
+	0x21, 0xa0, 0x04, 0x2f, 0x32, 0xe1, 0x05, 0x9f, 0x5b, 0xc5, 0x0b, 0xd0, 0xb9, 0x9f, 0x31, 0x8a,
+	0x31, 0x61, 0xbf, 0xf3, 0x44, 0x7f, 0xb5, 0xea, 0x27, 0x64, 0xf4, 0x2b, 0xb2, 0x85, 0xa5, 0x59,
+	0x73, 0xa5, 0x32, 0xb0, 0x7c, 0x6a, 0x74, 0xc6, 0xb6, 0x63, 0x6f, 0x43, 0x49, 0x49, 0x3f, 0x31,
+	0x3a, 0xa3, 0xe7, 0x64, 0xcb, 0x81, 0xbd, 0x52, 0x12, 0x78, 0x88, 0x09, 0x56, 0xc7, 0x76, 0x7a,
+	0x0b, 0xfd, 0xce, 0xf3, 0x67, 0x83, 0x07, 0x73, 0x3b, 0xb8, 0x88, 0xae, 0x73, 0xa5, 0x43, 0xdc,
+	0xc9, 0xe2, 0x87, 0x7f, 0x0e, 0x5b, 0x93, 0x0d, 0xf7, 0x48, 0x75, 0xa1, 0x39, 0x4d, 0xeb, 0x79,
+	0x66, 0x6c, 0x29, 0xb4, 0xac, 0x9b, 0xa9, 0x79, 0x1a, 0x9b, 0xd3, 0xf0, 0xd3, 0x84, 0xd3, 0xd8,
+	0x7c, 0x46, 0x56, 0xab, 0xb9, 0xcd, 0x81, 0x5b, 0xb8, 0x16, 0x36, 0x63, 0xbb, 0xe8, 0xee, 0xa0,
+	0x36, 0x41, 0x89, 0xbe, 0x24, 0x0c, 0xfb, 0xd7, 0x24, 0x70, 0x5e, 0x58, 0xcf, 0x33, 0x28, 0x44,
+	0xcd, 0xf6, 0xe2, 0x48, 0x86, 0x1e, 0x26, 0x7c, 0x11, 0xe8, 0x69, 0x80, 0xcd, 0x64, 0xe0, 0xb8,
+	0x14, 0xca, 0xf9, 0xd8, 0x76, 0x76, 0x3f, 0x19, 0xaf, 0x1b, 0x80, 0x5d, 0x7f, 0x45, 0x18, 0x08,
+	0x5b, 0xd4, 0x5c, 0x16, 0xc6, 0x01, 0x97, 0xa6, 0xac, 0x40, 0xbb, 0x38, 0xa1, 0xfb, 0xb1, 0x06,
+	0xe4, 0xa3, 0x80, 0x47, 0x0f, 0x28, 0x7d, 0x41, 0x76, 0x1f, 0x46, 0xe2, 0x25, 0xc4, 0xda, 0x0f,
+	0x30, 0x6e, 0xfb, 0x53, 0xdc, 0xb9, 0xd2, 0xa9, 0xf0, 0x33, 0x72, 0x78, 0x7f, 0x53, 0x4d, 0xc6,
+	0x54, 0x60, 0x05, 0x5a, 0x14, 0xbe, 0x66, 0xcf, 0x30, 0xfa, 0xff, 0x8d, 0x2d, 0x25, 0x8e, 0xa6,
+	0x71, 0xf4, 0x7c, 0xbf, 0xf8, 0xe7, 0x5f, 0x87, 0xad, 0xa3, 0x33, 0xb2, 0xfe, 0xf8, 0xa2, 0x28,
+	0x23, 0x4f, 0xd2, 0x25, 0xb1, 0x76, 0xaf, 0xdd, 0x5f, 0x99, 0x34, 0x4b, 0xba, 0x4f, 0x96, 0x9b,
+	0x2b, 0x67, 0xff, 0xc3, 0x0c, 0x4f, 0xca, 0x18, 0x74, 0x64, 0xc8, 0x6a, 0x7c, 0x52, 0x26, 0x20,
+	0x8d, 0xcd, 0xe8, 0x31, 0x59, 0x8a, 0x2f, 0x1a, 0xee, 0xd1, 0x79, 0xbe, 0xfd, 0x68, 0x34, 0xa2,
+	0x35, 0x8d, 0x44, 0x32, 0x86, 0x9e, 0x17, 0xc2, 0x79, 0x2e, 0x67, 0x42, 0xe7, 0xc0, 0x67, 0xa0,
+	0xf2, 0x99, 0x4f, 0x79, 0x36, 0x03, 0x19, 0x21, 0xf8, 0x11, 0xf5, 0x93, 0xb3, 0x0f, 0xb7, 0xdd,
+	0xf6, 0xc7, 0xdb, 0x6e, 0xfb, 0xdf, 0xdb, 0x6e, 0xfb, 0x8f, 0xbb, 0x6e, 0xeb, 0xe3, 0x5d, 0xb7,
+	0xf5, 0xf7, 0x5d, 0xb7, 0xf5, 0xdb, 0xd7, 0xb9, 0xf2, 0xb3, 0xf9, 0x74, 0x20, 0x4d, 0x19, 0xdf,
+	0x56, 0x0d, 0xfe, 0xda, 0xd8, 0xcb, 0xb8, 0x18, 0xde, 0xa4, 0x5f, 0x5f, 0x57, 0xe0, 0xa6, 0x4b,
+	0xf8, 0x24, 0xbe, 0xf8, 0x6f, 0x00, 0x6c, 0x90, 0x81, 0x42, 0x8c, 0x05, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -124,6 +442,133 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ProviderCloseContractPenalty != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ProviderCloseContractPenalty))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd8
+	}
+	if m.EarlyCloseMinPeriod != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.EarlyCloseMinPeriod))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd0
+	}
+	if m.EarlyCloseCompensation != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.EarlyCloseCompensation))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc8
+	}
+	if m.MaxAllowlistSize != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxAllowlistSize))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc0
+	}
+	if m.MaxContractStartDelay != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxContractStartDelay))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
+	}
+	if m.PurgeReward != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.PurgeReward))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	if m.ContractDormancyPeriod != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ContractDormancyPeriod))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if len(m.ServiceMinBonds) > 0 {
+		for iNdEx := len(m.ServiceMinBonds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ServiceMinBonds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if m.MinProviderBond != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MinProviderBond))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.MaxMetadataUriLength != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxMetadataUriLength))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.MaxClaimBatchSize != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxClaimBatchSize))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.DepositRefundTolerance != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DepositRefundTolerance))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.MinPayAsYouGoDeposit != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MinPayAsYouGoDeposit))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.MaxOpenContracts != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxOpenContracts))
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.AllowedDenoms) > 0 {
+		for iNdEx := len(m.AllowedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedDenoms[iNdEx])
+			copy(dAtA[i:], m.AllowedDenoms[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.AllowedDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if m.SlashEscalation != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.SlashEscalation))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.SlashFraction != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.SlashFraction))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.SettlementGracePeriod != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.SettlementGracePeriod))
+		i--
+		dAtA[i] = 0x50
+	}
 	if m.EmissionCurve != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.EmissionCurve))
 		i--
@@ -137,6 +582,79 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ServiceMinBond) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ServiceMinBond) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ServiceMinBond) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MinBond != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MinBond))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Service) > 0 {
+		i -= len(m.Service)
+		copy(dAtA[i:], m.Service)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Service)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ParamsRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParamsRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParamsRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastChangeHeight != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.LastChangeHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
 	offset -= sovParams(v)
 	base := offset
@@ -160,14 +678,104 @@ func (m *Params) Size() (n int) {
 	if m.EmissionCurve != 0 {
 		n += 1 + sovParams(uint64(m.EmissionCurve))
 	}
-	return n
-}
-
-func sovParams(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozParams(x uint64) (n int) {
-	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if m.SettlementGracePeriod != 0 {
+		n += 1 + sovParams(uint64(m.SettlementGracePeriod))
+	}
+	if m.SlashFraction != 0 {
+		n += 1 + sovParams(uint64(m.SlashFraction))
+	}
+	if m.SlashEscalation != 0 {
+		n += 1 + sovParams(uint64(m.SlashEscalation))
+	}
+	if len(m.AllowedDenoms) > 0 {
+		for _, s := range m.AllowedDenoms {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.MaxOpenContracts != 0 {
+		n += 1 + sovParams(uint64(m.MaxOpenContracts))
+	}
+	if m.MinPayAsYouGoDeposit != 0 {
+		n += 1 + sovParams(uint64(m.MinPayAsYouGoDeposit))
+	}
+	if m.DepositRefundTolerance != 0 {
+		n += 2 + sovParams(uint64(m.DepositRefundTolerance))
+	}
+	if m.MaxClaimBatchSize != 0 {
+		n += 2 + sovParams(uint64(m.MaxClaimBatchSize))
+	}
+	if m.MaxMetadataUriLength != 0 {
+		n += 2 + sovParams(uint64(m.MaxMetadataUriLength))
+	}
+	if m.MinProviderBond != 0 {
+		n += 2 + sovParams(uint64(m.MinProviderBond))
+	}
+	if len(m.ServiceMinBonds) > 0 {
+		for _, e := range m.ServiceMinBonds {
+			l = e.Size()
+			n += 2 + l + sovParams(uint64(l))
+		}
+	}
+	if m.ContractDormancyPeriod != 0 {
+		n += 2 + sovParams(uint64(m.ContractDormancyPeriod))
+	}
+	if m.PurgeReward != 0 {
+		n += 2 + sovParams(uint64(m.PurgeReward))
+	}
+	if m.MaxContractStartDelay != 0 {
+		n += 2 + sovParams(uint64(m.MaxContractStartDelay))
+	}
+	if m.MaxAllowlistSize != 0 {
+		n += 2 + sovParams(uint64(m.MaxAllowlistSize))
+	}
+	if m.EarlyCloseCompensation != 0 {
+		n += 2 + sovParams(uint64(m.EarlyCloseCompensation))
+	}
+	if m.EarlyCloseMinPeriod != 0 {
+		n += 2 + sovParams(uint64(m.EarlyCloseMinPeriod))
+	}
+	if m.ProviderCloseContractPenalty != 0 {
+		n += 2 + sovParams(uint64(m.ProviderCloseContractPenalty))
+	}
+	return n
+}
+
+func (m *ServiceMinBond) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Service)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.MinBond != 0 {
+		n += 1 + sovParams(uint64(m.MinBond))
+	}
+	return n
+}
+
+func (m *ParamsRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.LastChangeHeight != 0 {
+		n += 1 + sovParams(uint64(m.LastChangeHeight))
+	}
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
 func (m *Params) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
@@ -236,6 +844,579 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementGracePeriod", wireType)
+			}
+			m.SettlementGracePeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SettlementGracePeriod |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFraction", wireType)
+			}
+			m.SlashFraction = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashFraction |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashEscalation", wireType)
+			}
+			m.SlashEscalation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashEscalation |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedDenoms = append(m.AllowedDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOpenContracts", wireType)
+			}
+			m.MaxOpenContracts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxOpenContracts |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinPayAsYouGoDeposit", wireType)
+			}
+			m.MinPayAsYouGoDeposit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinPayAsYouGoDeposit |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositRefundTolerance", wireType)
+			}
+			m.DepositRefundTolerance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DepositRefundTolerance |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxClaimBatchSize", wireType)
+			}
+			m.MaxClaimBatchSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxClaimBatchSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMetadataUriLength", wireType)
+			}
+			m.MaxMetadataUriLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMetadataUriLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinProviderBond", wireType)
+			}
+			m.MinProviderBond = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinProviderBond |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceMinBonds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServiceMinBonds = append(m.ServiceMinBonds, ServiceMinBond{})
+			if err := m.ServiceMinBonds[len(m.ServiceMinBonds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractDormancyPeriod", wireType)
+			}
+			m.ContractDormancyPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractDormancyPeriod |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PurgeReward", wireType)
+			}
+			m.PurgeReward = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PurgeReward |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxContractStartDelay", wireType)
+			}
+			m.MaxContractStartDelay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxContractStartDelay |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAllowlistSize", wireType)
+			}
+			m.MaxAllowlistSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAllowlistSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 25:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EarlyCloseCompensation", wireType)
+			}
+			m.EarlyCloseCompensation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EarlyCloseCompensation |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 26:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EarlyCloseMinPeriod", wireType)
+			}
+			m.EarlyCloseMinPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EarlyCloseMinPeriod |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 27:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderCloseContractPenalty", wireType)
+			}
+			m.ProviderCloseContractPenalty = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProviderCloseContractPenalty |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ServiceMinBond) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ServiceMinBond: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ServiceMinBond: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Service = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBond", wireType)
+			}
+			m.MinBond = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinBond |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ParamsRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParamsRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParamsRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastChangeHeight", wireType)
+			}
+			m.LastChangeHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastChangeHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
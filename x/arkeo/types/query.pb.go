@@ -7,6 +7,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -72,6 +73,13 @@ var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
 type QueryParamsResponse struct {
 	// params holds all the parameters of this module.
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// height the params last changed at, zero until the end blocker first saw
+	// them
+	LastChangeHeight int64 `protobuf:"varint,2,opt,name=last_change_height,json=lastChangeHeight,proto3" json:"last_change_height,omitempty"`
+	// consensus version of the module, bumped on each state breaking change
+	ConsensusVersion uint64 `protobuf:"varint,3,opt,name=consensus_version,json=consensusVersion,proto3" json:"consensus_version,omitempty"`
+	// version the chain runs, selecting the configs and the handlers enabled
+	Version int64 `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
 }
 
 func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
@@ -114,6 +122,27 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
+func (m *QueryParamsResponse) GetLastChangeHeight() int64 {
+	if m != nil {
+		return m.LastChangeHeight
+	}
+	return 0
+}
+
+func (m *QueryParamsResponse) GetConsensusVersion() uint64 {
+	if m != nil {
+		return m.ConsensusVersion
+	}
+	return 0
+}
+
+func (m *QueryParamsResponse) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
 type QueryFetchProviderRequest struct {
 	Pubkey  string `protobuf:"bytes,1,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
 	Service string `protobuf:"bytes,2,opt,name=service,proto3" json:"service,omitempty"`
@@ -210,6 +239,102 @@ func (m *QueryFetchProviderResponse) GetProvider() Provider {
 	return Provider{}
 }
 
+type QueryProviderEarningsRequest struct {
+	Pubkey  string `protobuf:"bytes,1,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	Service string `protobuf:"bytes,2,opt,name=service,proto3" json:"service,omitempty"`
+}
+
+func (m *QueryProviderEarningsRequest) Reset()         { *m = QueryProviderEarningsRequest{} }
+func (m *QueryProviderEarningsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProviderEarningsRequest) ProtoMessage()    {}
+func (*QueryProviderEarningsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4b28dca1d1dd051d, []int{4}
+}
+func (m *QueryProviderEarningsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProviderEarningsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProviderEarningsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProviderEarningsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProviderEarningsRequest.Merge(m, src)
+}
+func (m *QueryProviderEarningsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProviderEarningsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProviderEarningsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProviderEarningsRequest proto.InternalMessageInfo
+
+func (m *QueryProviderEarningsRequest) GetPubkey() string {
+	if m != nil {
+		return m.Pubkey
+	}
+	return ""
+}
+
+func (m *QueryProviderEarningsRequest) GetService() string {
+	if m != nil {
+		return m.Service
+	}
+	return ""
+}
+
+type QueryProviderEarningsResponse struct {
+	Earnings ProviderEarnings `protobuf:"bytes,1,opt,name=earnings,proto3" json:"earnings"`
+}
+
+func (m *QueryProviderEarningsResponse) Reset()         { *m = QueryProviderEarningsResponse{} }
+func (m *QueryProviderEarningsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProviderEarningsResponse) ProtoMessage()    {}
+func (*QueryProviderEarningsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4b28dca1d1dd051d, []int{5}
+}
+func (m *QueryProviderEarningsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProviderEarningsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProviderEarningsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProviderEarningsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProviderEarningsResponse.Merge(m, src)
+}
+func (m *QueryProviderEarningsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProviderEarningsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProviderEarningsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProviderEarningsResponse proto.InternalMessageInfo
+
+func (m *QueryProviderEarningsResponse) GetEarnings() ProviderEarnings {
+	if m != nil {
+		return m.Earnings
+	}
+	return ProviderEarnings{}
+}
+
 type QueryAllProviderRequest struct {
 	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
@@ -218,7 +343,7 @@ func (m *QueryAllProviderRequest) Reset()         { *m = QueryAllProviderRequest
 func (m *QueryAllProviderRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAllProviderRequest) ProtoMessage()    {}
 func (*QueryAllProviderRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4b28dca1d1dd051d, []int{4}
+	return fileDescriptor_4b28dca1d1dd051d, []int{6}
 }
 func (m *QueryAllProviderRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -263,7 +388,7 @@ func (m *QueryAllProviderResponse) Reset()         { *m = QueryAllProviderRespon
 func (m *QueryAllProviderResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAllProviderResponse) ProtoMessage()    {}
 func (*QueryAllProviderResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4b28dca1d1dd051d, []int{5}
+	return fileDescriptor_4b28dca1d1dd051d, []int{7}
 }
 func (m *QueryAllProviderResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -314,7 +439,7 @@ func (m *QueryFetchContractRequest) Reset()         { *m = QueryFetchContractReq
 func (m *QueryFetchContractRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryFetchContractRequest) ProtoMessage()    {}
 func (*QueryFetchContractRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4b28dca1d1dd051d, []int{6}
+	return fileDescriptor_4b28dca1d1dd051d, []int{8}
 }
 func (m *QueryFetchContractRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -352,13 +477,17 @@ func (m *QueryFetchContractRequest) GetContractId() uint64 {
 
 type QueryFetchContractResponse struct {
 	Contract Contract `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract"`
+	// height the contract is settled at, at the latest, grace period included
+	SettlementPeriodEnd int64 `protobuf:"varint,2,opt,name=settlement_period_end,json=settlementPeriodEnd,proto3" json:"settlement_period_end,omitempty"`
+	// pending is true until the scheduled contract reaches its start height
+	Pending bool `protobuf:"varint,3,opt,name=pending,proto3" json:"pending,omitempty"`
 }
 
 func (m *QueryFetchContractResponse) Reset()         { *m = QueryFetchContractResponse{} }
 func (m *QueryFetchContractResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryFetchContractResponse) ProtoMessage()    {}
 func (*QueryFetchContractResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4b28dca1d1dd051d, []int{7}
+	return fileDescriptor_4b28dca1d1dd051d, []int{9}
 }
 func (m *QueryFetchContractResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -394,22 +523,38 @@ func (m *QueryFetchContractResponse) GetContract() Contract {
 	return Contract{}
 }
 
-type QueryAllContractRequest struct {
-	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+func (m *QueryFetchContractResponse) GetSettlementPeriodEnd() int64 {
+	if m != nil {
+		return m.SettlementPeriodEnd
+	}
+	return 0
 }
 
-func (m *QueryAllContractRequest) Reset()         { *m = QueryAllContractRequest{} }
-func (m *QueryAllContractRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryAllContractRequest) ProtoMessage()    {}
-func (*QueryAllContractRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4b28dca1d1dd051d, []int{8}
+func (m *QueryFetchContractResponse) GetPending() bool {
+	if m != nil {
+		return m.Pending
+	}
+	return false
 }
-func (m *QueryAllContractRequest) XXX_Unmarshal(b []byte) error {
+
+type QueryContractSettlementPreviewRequest struct {
+	ContractId uint64 `protobuf:"varint,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	// nonce claimed, zero for the settlement closing the contract
+	Nonce int64 `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
+}
+
+func (m *QueryContractSettlementPreviewRequest) Reset()         { *m = QueryContractSettlementPreviewRequest{} }
+func (m *QueryContractSettlementPreviewRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryContractSettlementPreviewRequest) ProtoMessage()    {}
+func (*QueryContractSettlementPreviewRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4b28dca1d1dd051d, []int{10}
+}
+func (m *QueryContractSettlementPreviewRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAllContractRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryContractSettlementPreviewRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAllContractRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryContractSettlementPreviewRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -419,42 +564,50 @@ func (m *QueryAllContractRequest) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *QueryAllContractRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAllContractRequest.Merge(m, src)
+func (m *QueryContractSettlementPreviewRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContractSettlementPreviewRequest.Merge(m, src)
 }
-func (m *QueryAllContractRequest) XXX_Size() int {
+func (m *QueryContractSettlementPreviewRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAllContractRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAllContractRequest.DiscardUnknown(m)
+func (m *QueryContractSettlementPreviewRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContractSettlementPreviewRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAllContractRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryContractSettlementPreviewRequest proto.InternalMessageInfo
 
-func (m *QueryAllContractRequest) GetPagination() *query.PageRequest {
+func (m *QueryContractSettlementPreviewRequest) GetContractId() uint64 {
 	if m != nil {
-		return m.Pagination
+		return m.ContractId
 	}
-	return nil
+	return 0
 }
 
-type QueryAllContractResponse struct {
-	Contract   []Contract          `protobuf:"bytes,1,rep,name=contract,proto3" json:"contract"`
-	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+func (m *QueryContractSettlementPreviewRequest) GetNonce() int64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
 }
 
-func (m *QueryAllContractResponse) Reset()         { *m = QueryAllContractResponse{} }
-func (m *QueryAllContractResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryAllContractResponse) ProtoMessage()    {}
-func (*QueryAllContractResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4b28dca1d1dd051d, []int{9}
+type QueryContractSettlementPreviewResponse struct {
+	Settlement ContractSettlement `protobuf:"bytes,1,opt,name=settlement,proto3" json:"settlement"`
 }
-func (m *QueryAllContractResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *QueryContractSettlementPreviewResponse) Reset() {
+	*m = QueryContractSettlementPreviewResponse{}
+}
+func (m *QueryContractSettlementPreviewResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryContractSettlementPreviewResponse) ProtoMessage()    {}
+func (*QueryContractSettlementPreviewResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4b28dca1d1dd051d, []int{11}
+}
+func (m *QueryContractSettlementPreviewResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAllContractResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryContractSettlementPreviewResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAllContractResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryContractSettlementPreviewResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -464,51 +617,102 @@ func (m *QueryAllContractResponse) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *QueryAllContractResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAllContractResponse.Merge(m, src)
+func (m *QueryContractSettlementPreviewResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContractSettlementPreviewResponse.Merge(m, src)
 }
-func (m *QueryAllContractResponse) XXX_Size() int {
+func (m *QueryContractSettlementPreviewResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAllContractResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAllContractResponse.DiscardUnknown(m)
+func (m *QueryContractSettlementPreviewResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContractSettlementPreviewResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAllContractResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryContractSettlementPreviewResponse proto.InternalMessageInfo
 
-func (m *QueryAllContractResponse) GetContract() []Contract {
+func (m *QueryContractSettlementPreviewResponse) GetSettlement() ContractSettlement {
 	if m != nil {
-		return m.Contract
+		return m.Settlement
 	}
-	return nil
+	return ContractSettlement{}
 }
 
-func (m *QueryAllContractResponse) GetPagination() *query.PageResponse {
+type QueryClaimableIncomeRequest struct {
+	ContractId uint64 `protobuf:"varint,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	// highest nonce the provider holds a signature of
+	Nonce int64 `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
+}
+
+func (m *QueryClaimableIncomeRequest) Reset()         { *m = QueryClaimableIncomeRequest{} }
+func (m *QueryClaimableIncomeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryClaimableIncomeRequest) ProtoMessage()    {}
+func (*QueryClaimableIncomeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4b28dca1d1dd051d, []int{12}
+}
+func (m *QueryClaimableIncomeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClaimableIncomeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClaimableIncomeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryClaimableIncomeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClaimableIncomeRequest.Merge(m, src)
+}
+func (m *QueryClaimableIncomeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClaimableIncomeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClaimableIncomeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryClaimableIncomeRequest proto.InternalMessageInfo
+
+func (m *QueryClaimableIncomeRequest) GetContractId() uint64 {
 	if m != nil {
-		return m.Pagination
+		return m.ContractId
 	}
-	return nil
+	return 0
 }
 
-// this line is used by starport scaffolding # 3
-type QueryActiveContractRequest struct {
-	Provider string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
-	Service  string `protobuf:"bytes,2,opt,name=service,proto3" json:"service,omitempty"`
-	Spender  string `protobuf:"bytes,3,opt,name=spender,proto3" json:"spender,omitempty"`
+func (m *QueryClaimableIncomeRequest) GetNonce() int64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
 }
 
-func (m *QueryActiveContractRequest) Reset()         { *m = QueryActiveContractRequest{} }
-func (m *QueryActiveContractRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryActiveContractRequest) ProtoMessage()    {}
-func (*QueryActiveContractRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4b28dca1d1dd051d, []int{10}
+type QueryClaimableIncomeResponse struct {
+	// nonce the claim is paid for, capped to the queries the contract allows
+	Nonce int64 `protobuf:"varint,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// paid to the provider, net of the reserve tax
+	Claimable  types.Coin `protobuf:"bytes,2,opt,name=claimable,proto3" json:"claimable"`
+	ReserveTax types.Coin `protobuf:"bytes,3,opt,name=reserve_tax,json=reserveTax,proto3" json:"reserve_tax"`
+	// deposit left in the contract once claimed
+	RemainingEscrow types.Coin `protobuf:"bytes,4,opt,name=remaining_escrow,json=remainingEscrow,proto3" json:"remaining_escrow"`
+	// rounding remainder accrued to the reserve along the reserve tax
+	Dust types.Coin `protobuf:"bytes,5,opt,name=dust,proto3" json:"dust"`
 }
-func (m *QueryActiveContractRequest) XXX_Unmarshal(b []byte) error {
+
+func (m *QueryClaimableIncomeResponse) Reset()         { *m = QueryClaimableIncomeResponse{} }
+func (m *QueryClaimableIncomeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryClaimableIncomeResponse) ProtoMessage()    {}
+func (*QueryClaimableIncomeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4b28dca1d1dd051d, []int{13}
+}
+func (m *QueryClaimableIncomeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryActiveContractRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryClaimableIncomeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryActiveContractRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryClaimableIncomeResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -518,55 +722,72 @@ func (m *QueryActiveContractRequest) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *QueryActiveContractRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryActiveContractRequest.Merge(m, src)
+func (m *QueryClaimableIncomeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClaimableIncomeResponse.Merge(m, src)
 }
-func (m *QueryActiveContractRequest) XXX_Size() int {
+func (m *QueryClaimableIncomeResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryActiveContractRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryActiveContractRequest.DiscardUnknown(m)
+func (m *QueryClaimableIncomeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClaimableIncomeResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryActiveContractRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryClaimableIncomeResponse proto.InternalMessageInfo
 
-func (m *QueryActiveContractRequest) GetProvider() string {
+func (m *QueryClaimableIncomeResponse) GetNonce() int64 {
 	if m != nil {
-		return m.Provider
+		return m.Nonce
 	}
-	return ""
+	return 0
 }
 
-func (m *QueryActiveContractRequest) GetService() string {
+func (m *QueryClaimableIncomeResponse) GetClaimable() types.Coin {
 	if m != nil {
-		return m.Service
+		return m.Claimable
 	}
-	return ""
+	return types.Coin{}
 }
 
-func (m *QueryActiveContractRequest) GetSpender() string {
+func (m *QueryClaimableIncomeResponse) GetReserveTax() types.Coin {
 	if m != nil {
-		return m.Spender
+		return m.ReserveTax
 	}
-	return ""
+	return types.Coin{}
 }
 
-type QueryActiveContractResponse struct {
-	Contract Contract `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract"`
+func (m *QueryClaimableIncomeResponse) GetRemainingEscrow() types.Coin {
+	if m != nil {
+		return m.RemainingEscrow
+	}
+	return types.Coin{}
 }
 
-func (m *QueryActiveContractResponse) Reset()         { *m = QueryActiveContractResponse{} }
-func (m *QueryActiveContractResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryActiveContractResponse) ProtoMessage()    {}
-func (*QueryActiveContractResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4b28dca1d1dd051d, []int{11}
+func (m *QueryClaimableIncomeResponse) GetDust() types.Coin {
+	if m != nil {
+		return m.Dust
+	}
+	return types.Coin{}
 }
-func (m *QueryActiveContractResponse) XXX_Unmarshal(b []byte) error {
+
+type QueryProvidersRequest struct {
+	Service string `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
+	// online or offline, all the providers of the service when empty
+	StatusFilter string             `protobuf:"bytes,2,opt,name=status_filter,json=statusFilter,proto3" json:"status_filter,omitempty"`
+	Pagination   *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryProvidersRequest) Reset()         { *m = QueryProvidersRequest{} }
+func (m *QueryProvidersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProvidersRequest) ProtoMessage()    {}
+func (*QueryProvidersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4b28dca1d1dd051d, []int{14}
+}
+func (m *QueryProvidersRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryActiveContractResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryProvidersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryActiveContractResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryProvidersRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -576,1000 +797,4656 @@ func (m *QueryActiveContractResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryActiveContractResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryActiveContractResponse.Merge(m, src)
+func (m *QueryProvidersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProvidersRequest.Merge(m, src)
 }
-func (m *QueryActiveContractResponse) XXX_Size() int {
+func (m *QueryProvidersRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryActiveContractResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryActiveContractResponse.DiscardUnknown(m)
+func (m *QueryProvidersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProvidersRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryActiveContractResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryProvidersRequest proto.InternalMessageInfo
 
-func (m *QueryActiveContractResponse) GetContract() Contract {
+func (m *QueryProvidersRequest) GetService() string {
 	if m != nil {
-		return m.Contract
+		return m.Service
 	}
-	return Contract{}
+	return ""
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "arkeo.arkeo.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "arkeo.arkeo.QueryParamsResponse")
-	proto.RegisterType((*QueryFetchProviderRequest)(nil), "arkeo.arkeo.QueryFetchProviderRequest")
-	proto.RegisterType((*QueryFetchProviderResponse)(nil), "arkeo.arkeo.QueryFetchProviderResponse")
-	proto.RegisterType((*QueryAllProviderRequest)(nil), "arkeo.arkeo.QueryAllProviderRequest")
-	proto.RegisterType((*QueryAllProviderResponse)(nil), "arkeo.arkeo.QueryAllProviderResponse")
-	proto.RegisterType((*QueryFetchContractRequest)(nil), "arkeo.arkeo.QueryFetchContractRequest")
-	proto.RegisterType((*QueryFetchContractResponse)(nil), "arkeo.arkeo.QueryFetchContractResponse")
-	proto.RegisterType((*QueryAllContractRequest)(nil), "arkeo.arkeo.QueryAllContractRequest")
-	proto.RegisterType((*QueryAllContractResponse)(nil), "arkeo.arkeo.QueryAllContractResponse")
-	proto.RegisterType((*QueryActiveContractRequest)(nil), "arkeo.arkeo.QueryActiveContractRequest")
-	proto.RegisterType((*QueryActiveContractResponse)(nil), "arkeo.arkeo.QueryActiveContractResponse")
+func (m *QueryProvidersRequest) GetStatusFilter() string {
+	if m != nil {
+		return m.StatusFilter
+	}
+	return ""
 }
 
-func init() { proto.RegisterFile("arkeo/arkeo/query.proto", fileDescriptor_4b28dca1d1dd051d) }
+func (m *QueryProvidersRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
 
-var fileDescriptor_4b28dca1d1dd051d = []byte{
-	// 702 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xcf, 0x4f, 0xd4, 0x40,
-	0x14, 0xde, 0x02, 0xae, 0x32, 0x1b, 0xd4, 0x0c, 0x20, 0x6b, 0xd5, 0x5d, 0xd2, 0xf0, 0x2b, 0x18,
-	0xdb, 0x80, 0x31, 0x5c, 0xf4, 0x00, 0x46, 0xd4, 0x83, 0x09, 0x6e, 0xa2, 0x07, 0x2f, 0x66, 0xb6,
-	0x4c, 0x4a, 0xb3, 0xa5, 0x53, 0x3a, 0xb3, 0xab, 0x64, 0xc3, 0xc5, 0x8b, 0x17, 0x0f, 0x26, 0xc6,
-	0x9b, 0x27, 0xff, 0x1a, 0x8e, 0x24, 0x5e, 0x3c, 0x19, 0x03, 0xfe, 0x21, 0x66, 0x67, 0xde, 0xb8,
-	0x6d, 0xb7, 0x4d, 0x49, 0xd4, 0x4b, 0xa1, 0xf3, 0xbe, 0xf7, 0xbe, 0xef, 0xbd, 0x6f, 0xfa, 0x16,
-	0xcd, 0x91, 0xb8, 0x43, 0x99, 0xa3, 0x9e, 0x07, 0x5d, 0x1a, 0x1f, 0xda, 0x51, 0xcc, 0x04, 0xc3,
-	0x35, 0x79, 0x64, 0xcb, 0xa7, 0x39, 0xe3, 0x31, 0x8f, 0xc9, 0x73, 0x67, 0xf0, 0x9f, 0x82, 0x98,
-	0x37, 0x3d, 0xc6, 0xbc, 0x80, 0x3a, 0x24, 0xf2, 0x1d, 0x12, 0x86, 0x4c, 0x10, 0xe1, 0xb3, 0x90,
-	0x43, 0x74, 0xd5, 0x65, 0x7c, 0x9f, 0x71, 0xa7, 0x4d, 0x38, 0x55, 0x95, 0x9d, 0xde, 0x5a, 0x9b,
-	0x0a, 0xb2, 0xe6, 0x44, 0xc4, 0xf3, 0x43, 0x09, 0x06, 0x6c, 0x3d, 0xa9, 0x22, 0x22, 0x31, 0xd9,
-	0xe7, 0x79, 0x91, 0x0e, 0xa5, 0x11, 0x8d, 0x55, 0xc4, 0x9a, 0x41, 0xf8, 0xf9, 0xa0, 0xea, 0x8e,
-	0x84, 0xb7, 0xe8, 0x41, 0x97, 0x72, 0x61, 0x3d, 0x41, 0xd3, 0xa9, 0x53, 0x1e, 0xb1, 0x90, 0x53,
-	0xbc, 0x86, 0xaa, 0xaa, 0x6c, 0xdd, 0x98, 0x37, 0x56, 0x6a, 0xeb, 0xd3, 0x76, 0xa2, 0x3d, 0x5b,
-	0x81, 0xb7, 0x26, 0x8e, 0x7f, 0x34, 0x2b, 0x2d, 0x00, 0x5a, 0xcf, 0xd0, 0x75, 0x59, 0x69, 0x9b,
-	0x0a, 0x77, 0x6f, 0x27, 0x66, 0x3d, 0x7f, 0x97, 0xc6, 0x40, 0x83, 0xaf, 0xa1, 0x6a, 0xd4, 0x6d,
-	0x77, 0xe8, 0xa1, 0xac, 0x37, 0xd9, 0x82, 0x37, 0x5c, 0x47, 0x17, 0x39, 0x8d, 0x7b, 0xbe, 0x4b,
-	0xeb, 0x63, 0x32, 0xa0, 0x5f, 0xad, 0x17, 0xc8, 0xcc, 0x2b, 0x07, 0xfa, 0x36, 0xd0, 0xa5, 0x08,
-	0xce, 0x40, 0xe1, 0x6c, 0x5a, 0x21, 0x04, 0x41, 0xe3, 0x1f, 0xb0, 0x45, 0xd0, 0x9c, 0x2c, 0xbb,
-	0x19, 0x04, 0x59, 0x8d, 0xdb, 0x08, 0x0d, 0x07, 0x0d, 0x55, 0x97, 0x6c, 0xe5, 0x8a, 0x3d, 0x70,
-	0xc5, 0x56, 0x7e, 0x83, 0x2b, 0xf6, 0x0e, 0xf1, 0x28, 0xe4, 0xb6, 0x12, 0x99, 0xd6, 0x17, 0x03,
-	0xd5, 0x47, 0x39, 0x72, 0x85, 0x8f, 0x9f, 0x5b, 0x38, 0x7e, 0x9c, 0x52, 0x37, 0x26, 0xd5, 0x2d,
-	0x97, 0xaa, 0x53, 0xac, 0x29, 0x79, 0xf7, 0x93, 0x3e, 0x3d, 0x64, 0xa1, 0x88, 0x89, 0x2b, 0xf4,
-	0x0c, 0x9a, 0xa8, 0xe6, 0xc2, 0xd1, 0x6b, 0x7f, 0x57, 0x0e, 0x61, 0xa2, 0x85, 0xf4, 0xd1, 0xd3,
-	0xdd, 0xb4, 0x2d, 0xc3, 0xec, 0x61, 0x77, 0x1a, 0x9b, 0x6b, 0x8b, 0x4e, 0xd0, 0xdd, 0x69, 0x70,
-	0xd2, 0x96, 0xac, 0xa4, 0xff, 0x61, 0x4b, 0x89, 0xf0, 0xf1, 0x73, 0x0b, 0xff, 0x77, 0xb6, 0x04,
-	0x30, 0xd8, 0x4d, 0x57, 0xf8, 0x3d, 0x9a, 0x1d, 0x82, 0x99, 0xb9, 0xef, 0x93, 0x89, 0x9b, 0x51,
-	0xf8, 0x0d, 0xc9, 0x48, 0x44, 0xc3, 0x41, 0xd2, 0x38, 0x44, 0xd4, 0xab, 0xf5, 0x12, 0xdd, 0xc8,
-	0x65, 0xfb, 0x4b, 0x1f, 0xd7, 0x3f, 0x57, 0xd1, 0x05, 0x59, 0x18, 0xb7, 0x51, 0x55, 0xad, 0x09,
-	0xdc, 0x4c, 0xa5, 0x8e, 0xee, 0x20, 0x73, 0xbe, 0x18, 0xa0, 0xf4, 0x58, 0xb3, 0xef, 0xbe, 0xfd,
-	0xfa, 0x34, 0x76, 0x05, 0x4f, 0xa5, 0x56, 0x1e, 0xfe, 0x60, 0xa0, 0xa9, 0xd4, 0x7e, 0xc0, 0x4b,
-	0xa3, 0xa5, 0xf2, 0xf6, 0x91, 0xb9, 0x5c, 0x8a, 0x03, 0xe6, 0x55, 0xc9, 0xbc, 0x80, 0x2d, 0xcd,
-	0x0c, 0x00, 0xa7, 0xaf, 0x36, 0xd8, 0x91, 0xd3, 0x87, 0x69, 0x1f, 0x61, 0x81, 0x6a, 0x3a, 0x7f,
-	0x33, 0x08, 0xf0, 0xc2, 0x28, 0xc7, 0xe8, 0xd6, 0x31, 0x17, 0x4b, 0x50, 0xa0, 0xa3, 0x2e, 0x75,
-	0x60, 0x7c, 0x35, 0xa3, 0x83, 0xe3, 0xf7, 0x7a, 0x08, 0xda, 0x94, 0xc2, 0x21, 0x64, 0x2e, 0x55,
-	0xe1, 0x10, 0xb2, 0xd7, 0xc1, 0x5a, 0x94, 0xe4, 0x4d, 0x7c, 0x0b, 0xc8, 0xb5, 0xdd, 0x4e, 0x3f,
-	0xb1, 0x2c, 0x64, 0xff, 0x3a, 0xb5, 0xb8, 0xff, 0xac, 0x88, 0xc5, 0x12, 0x54, 0x41, 0xff, 0x9a,
-	0x98, 0xe3, 0xaf, 0x06, 0xba, 0x9c, 0xbe, 0xc6, 0x38, 0xa7, 0xb1, 0xdc, 0xcf, 0xca, 0x5c, 0x29,
-	0x07, 0x02, 0xff, 0x03, 0xc9, 0xbf, 0x81, 0xef, 0x01, 0x3f, 0x91, 0xb0, 0x3b, 0xc3, 0x49, 0x68,
-	0x43, 0x12, 0x17, 0xc2, 0xe9, 0xc3, 0xe7, 0x76, 0xb4, 0xf5, 0xe8, 0xf8, 0xb4, 0x61, 0x9c, 0x9c,
-	0x36, 0x8c, 0x9f, 0xa7, 0x0d, 0xe3, 0xe3, 0x59, 0xa3, 0x72, 0x72, 0xd6, 0xa8, 0x7c, 0x3f, 0x6b,
-	0x54, 0x5e, 0xdd, 0xf6, 0x7c, 0xb1, 0xd7, 0x6d, 0xdb, 0x2e, 0xdb, 0x57, 0xa5, 0x43, 0x2a, 0xde,
-	0xb0, 0xb8, 0x03, 0x3c, 0x6f, 0xe1, 0xaf, 0x38, 0x8c, 0x28, 0x6f, 0x57, 0xe5, 0x4f, 0xf9, 0xdd,
-	0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x07, 0x9a, 0xac, 0x8f, 0x86, 0x08, 0x00, 0x00,
+type QueryProvidersResponse struct {
+	Providers  []Provider          `protobuf:"bytes,1,rep,name=providers,proto3" json:"providers"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *QueryProvidersResponse) Reset()         { *m = QueryProvidersResponse{} }
+func (m *QueryProvidersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProvidersResponse) ProtoMessage()    {}
+func (*QueryProvidersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4b28dca1d1dd051d, []int{15}
+}
+func (m *QueryProvidersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProvidersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProvidersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProvidersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProvidersResponse.Merge(m, src)
+}
+func (m *QueryProvidersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProvidersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProvidersResponse.DiscardUnknown(m)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryProvidersResponse proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	FetchProvider(ctx context.Context, in *QueryFetchProviderRequest, opts ...grpc.CallOption) (*QueryFetchProviderResponse, error)
-	ProviderAll(ctx context.Context, in *QueryAllProviderRequest, opts ...grpc.CallOption) (*QueryAllProviderResponse, error)
-	FetchContract(ctx context.Context, in *QueryFetchContractRequest, opts ...grpc.CallOption) (*QueryFetchContractResponse, error)
-	ContractAll(ctx context.Context, in *QueryAllContractRequest, opts ...grpc.CallOption) (*QueryAllContractResponse, error)
-	// Queries an active contract by spender, provider and service.
-	ActiveContract(ctx context.Context, in *QueryActiveContractRequest, opts ...grpc.CallOption) (*QueryActiveContractResponse, error)
+func (m *QueryProvidersResponse) GetProviders() []Provider {
+	if m != nil {
+		return m.Providers
+	}
+	return nil
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryProvidersResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+type QueryAllContractRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/arkeo.arkeo.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryAllContractRequest) Reset()         { *m = QueryAllContractRequest{} }
+func (m *QueryAllContractRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllContractRequest) ProtoMessage()    {}
+func (*QueryAllContractRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4b28dca1d1dd051d, []int{16}
 }
-
-func (c *queryClient) FetchProvider(ctx context.Context, in *QueryFetchProviderRequest, opts ...grpc.CallOption) (*QueryFetchProviderResponse, error) {
-	out := new(QueryFetchProviderResponse)
-	err := c.cc.Invoke(ctx, "/arkeo.arkeo.Query/FetchProvider", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryAllContractRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllContractRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllContractRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryAllContractRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllContractRequest.Merge(m, src)
+}
+func (m *QueryAllContractRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllContractRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllContractRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) ProviderAll(ctx context.Context, in *QueryAllProviderRequest, opts ...grpc.CallOption) (*QueryAllProviderResponse, error) {
-	out := new(QueryAllProviderResponse)
-	err := c.cc.Invoke(ctx, "/arkeo.arkeo.Query/ProviderAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAllContractRequest proto.InternalMessageInfo
+
+func (m *QueryAllContractRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) FetchContract(ctx context.Context, in *QueryFetchContractRequest, opts ...grpc.CallOption) (*QueryFetchContractResponse, error) {
-	out := new(QueryFetchContractResponse)
-	err := c.cc.Invoke(ctx, "/arkeo.arkeo.Query/FetchContract", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryAllContractResponse struct {
+	Contract   []Contract          `protobuf:"bytes,1,rep,name=contract,proto3" json:"contract"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) ContractAll(ctx context.Context, in *QueryAllContractRequest, opts ...grpc.CallOption) (*QueryAllContractResponse, error) {
-	out := new(QueryAllContractResponse)
-	err := c.cc.Invoke(ctx, "/arkeo.arkeo.Query/ContractAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryAllContractResponse) Reset()         { *m = QueryAllContractResponse{} }
+func (m *QueryAllContractResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllContractResponse) ProtoMessage()    {}
+func (*QueryAllContractResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4b28dca1d1dd051d, []int{17}
+}
+func (m *QueryAllContractResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllContractResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllContractResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryAllContractResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllContractResponse.Merge(m, src)
+}
+func (m *QueryAllContractResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllContractResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllContractResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) ActiveContract(ctx context.Context, in *QueryActiveContractRequest, opts ...grpc.CallOption) (*QueryActiveContractResponse, error) {
-	out := new(QueryActiveContractResponse)
-	err := c.cc.Invoke(ctx, "/arkeo.arkeo.Query/ActiveContract", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAllContractResponse proto.InternalMessageInfo
+
+func (m *QueryAllContractResponse) GetContract() []Contract {
+	if m != nil {
+		return m.Contract
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	FetchProvider(context.Context, *QueryFetchProviderRequest) (*QueryFetchProviderResponse, error)
-	ProviderAll(context.Context, *QueryAllProviderRequest) (*QueryAllProviderResponse, error)
-	FetchContract(context.Context, *QueryFetchContractRequest) (*QueryFetchContractResponse, error)
-	ContractAll(context.Context, *QueryAllContractRequest) (*QueryAllContractResponse, error)
-	// Queries an active contract by spender, provider and service.
-	ActiveContract(context.Context, *QueryActiveContractRequest) (*QueryActiveContractResponse, error)
+func (m *QueryAllContractResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+type QueryContractsByProviderRequest struct {
+	Provider string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Service  string `protobuf:"bytes,2,opt,name=service,proto3" json:"service,omitempty"`
+	// pending, open or closed, all the contracts when empty
+	State      string             `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryContractsByProviderRequest) Reset()         { *m = QueryContractsByProviderRequest{} }
+func (m *QueryContractsByProviderRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryContractsByProviderRequest) ProtoMessage()    {}
+func (*QueryContractsByProviderRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4b28dca1d1dd051d, []int{18}
 }
-func (*UnimplementedQueryServer) FetchProvider(ctx context.Context, req *QueryFetchProviderRequest) (*QueryFetchProviderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FetchProvider not implemented")
+func (m *QueryContractsByProviderRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) ProviderAll(ctx context.Context, req *QueryAllProviderRequest) (*QueryAllProviderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ProviderAll not implemented")
+func (m *QueryContractsByProviderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryContractsByProviderRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) FetchContract(ctx context.Context, req *QueryFetchContractRequest) (*QueryFetchContractResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FetchContract not implemented")
+func (m *QueryContractsByProviderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContractsByProviderRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) ContractAll(ctx context.Context, req *QueryAllContractRequest) (*QueryAllContractResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ContractAll not implemented")
+func (m *QueryContractsByProviderRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) ActiveContract(ctx context.Context, req *QueryActiveContractRequest) (*QueryActiveContractResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ActiveContract not implemented")
+func (m *QueryContractsByProviderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContractsByProviderRequest.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
-}
+var xxx_messageInfo_QueryContractsByProviderRequest proto.InternalMessageInfo
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/arkeo.arkeo.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+func (m *QueryContractsByProviderRequest) GetProvider() string {
+	if m != nil {
+		return m.Provider
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_FetchProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryFetchProviderRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).FetchProvider(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/arkeo.arkeo.Query/FetchProvider",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).FetchProvider(ctx, req.(*QueryFetchProviderRequest))
+func (m *QueryContractsByProviderRequest) GetService() string {
+	if m != nil {
+		return m.Service
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_ProviderAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllProviderRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ProviderAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/arkeo.arkeo.Query/ProviderAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ProviderAll(ctx, req.(*QueryAllProviderRequest))
+func (m *QueryContractsByProviderRequest) GetState() string {
+	if m != nil {
+		return m.State
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_FetchContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryFetchContractRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).FetchContract(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/arkeo.arkeo.Query/FetchContract",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).FetchContract(ctx, req.(*QueryFetchContractRequest))
+func (m *QueryContractsByProviderRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_ContractAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllContractRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ContractAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/arkeo.arkeo.Query/ContractAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ContractAll(ctx, req.(*QueryAllContractRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryContractsByProviderResponse struct {
+	Contract   []Contract          `protobuf:"bytes,1,rep,name=contract,proto3" json:"contract"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_ActiveContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryActiveContractRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ActiveContract(ctx, in)
+func (m *QueryContractsByProviderResponse) Reset()         { *m = QueryContractsByProviderResponse{} }
+func (m *QueryContractsByProviderResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryContractsByProviderResponse) ProtoMessage()    {}
+func (*QueryContractsByProviderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4b28dca1d1dd051d, []int{19}
+}
+func (m *QueryContractsByProviderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryContractsByProviderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryContractsByProviderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/arkeo.arkeo.Query/ActiveContract",
+}
+func (m *QueryContractsByProviderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContractsByProviderResponse.Merge(m, src)
+}
+func (m *QueryContractsByProviderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryContractsByProviderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContractsByProviderResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryContractsByProviderResponse proto.InternalMessageInfo
+
+func (m *QueryContractsByProviderResponse) GetContract() []Contract {
+	if m != nil {
+		return m.Contract
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ActiveContract(ctx, req.(*QueryActiveContractRequest))
+	return nil
+}
+
+func (m *QueryContractsByProviderResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var Query_serviceDesc = _Query_serviceDesc
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "arkeo.arkeo.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "FetchProvider",
-			Handler:    _Query_FetchProvider_Handler,
-		},
-		{
-			MethodName: "ProviderAll",
-			Handler:    _Query_ProviderAll_Handler,
-		},
-		{
-			MethodName: "FetchContract",
-			Handler:    _Query_FetchContract_Handler,
-		},
-		{
-			MethodName: "ContractAll",
-			Handler:    _Query_ContractAll_Handler,
-		},
-		{
-			MethodName: "ActiveContract",
-			Handler:    _Query_ActiveContract_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "arkeo/arkeo/query.proto",
+type QueryContractsByOwnerRequest struct {
+	Pubkey string `protobuf:"bytes,1,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	// also return the contracts the pubkey is the delegate of
+	IncludeDelegate bool               `protobuf:"varint,2,opt,name=include_delegate,json=includeDelegate,proto3" json:"include_delegate,omitempty"`
+	Pagination      *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryContractsByOwnerRequest) Reset()         { *m = QueryContractsByOwnerRequest{} }
+func (m *QueryContractsByOwnerRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryContractsByOwnerRequest) ProtoMessage()    {}
+func (*QueryContractsByOwnerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4b28dca1d1dd051d, []int{20}
+}
+func (m *QueryContractsByOwnerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryContractsByOwnerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryContractsByOwnerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryContractsByOwnerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContractsByOwnerRequest.Merge(m, src)
+}
+func (m *QueryContractsByOwnerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryContractsByOwnerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContractsByOwnerRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryContractsByOwnerRequest proto.InternalMessageInfo
+
+func (m *QueryContractsByOwnerRequest) GetPubkey() string {
+	if m != nil {
+		return m.Pubkey
+	}
+	return ""
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryContractsByOwnerRequest) GetIncludeDelegate() bool {
+	if m != nil {
+		return m.IncludeDelegate
+	}
+	return false
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryContractsByOwnerRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type OwnerContract struct {
+	Contract   Contract `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract"`
+	Expiration int64    `protobuf:"varint,2,opt,name=expiration,proto3" json:"expiration,omitempty"`
+	// height the contract is settled at, at the latest, grace period included
+	SettlementPeriodEnd int64 `protobuf:"varint,3,opt,name=settlement_period_end,json=settlementPeriodEnd,proto3" json:"settlement_period_end,omitempty"`
+	Expired             bool  `protobuf:"varint,4,opt,name=expired,proto3" json:"expired,omitempty"`
+	// pending is true until the scheduled contract reaches its start height
+	Pending bool `protobuf:"varint,6,opt,name=pending,proto3" json:"pending,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *OwnerContract) Reset()         { *m = OwnerContract{} }
+func (m *OwnerContract) String() string { return proto.CompactTextString(m) }
+func (*OwnerContract) ProtoMessage()    {}
+func (*OwnerContract) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4b28dca1d1dd051d, []int{21}
+}
+func (m *OwnerContract) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OwnerContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OwnerContract.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *OwnerContract) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OwnerContract.Merge(m, src)
+}
+func (m *OwnerContract) XXX_Size() int {
+	return m.Size()
+}
+func (m *OwnerContract) XXX_DiscardUnknown() {
+	xxx_messageInfo_OwnerContract.DiscardUnknown(m)
 }
 
-func (m *QueryFetchProviderRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_OwnerContract proto.InternalMessageInfo
+
+func (m *OwnerContract) GetContract() Contract {
+	if m != nil {
+		return m.Contract
 	}
-	return dAtA[:n], nil
+	return Contract{}
 }
 
-func (m *QueryFetchProviderRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *OwnerContract) GetExpiration() int64 {
+	if m != nil {
+		return m.Expiration
+	}
+	return 0
 }
 
-func (m *QueryFetchProviderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Service) > 0 {
-		i -= len(m.Service)
-		copy(dAtA[i:], m.Service)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Service)))
-		i--
-		dAtA[i] = 0x12
+func (m *OwnerContract) GetSettlementPeriodEnd() int64 {
+	if m != nil {
+		return m.SettlementPeriodEnd
 	}
-	if len(m.Pubkey) > 0 {
-		i -= len(m.Pubkey)
-		copy(dAtA[i:], m.Pubkey)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Pubkey)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *OwnerContract) GetExpired() bool {
+	if m != nil {
+		return m.Expired
 	}
-	return len(dAtA) - i, nil
+	return false
 }
 
-func (m *QueryFetchProviderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *OwnerContract) GetPending() bool {
+	if m != nil {
+		return m.Pending
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *QueryFetchProviderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryContractsByOwnerResponse struct {
+	Contracts  []OwnerContract     `protobuf:"bytes,1,rep,name=contracts,proto3" json:"contracts"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryFetchProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Provider.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryContractsByOwnerResponse) Reset()         { *m = QueryContractsByOwnerResponse{} }
+func (m *QueryContractsByOwnerResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryContractsByOwnerResponse) ProtoMessage()    {}
+func (*QueryContractsByOwnerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4b28dca1d1dd051d, []int{22}
+}
+func (m *QueryContractsByOwnerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryContractsByOwnerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryContractsByOwnerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryAllProviderRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryContractsByOwnerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContractsByOwnerResponse.Merge(m, src)
 }
-
-func (m *QueryAllProviderRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryContractsByOwnerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryContractsByOwnerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContractsByOwnerResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAllProviderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryContractsByOwnerResponse proto.InternalMessageInfo
+
+func (m *QueryContractsByOwnerResponse) GetContracts() []OwnerContract {
+	if m != nil {
+		return m.Contracts
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryAllProviderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryContractsByOwnerResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAllProviderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// this line is used by starport scaffolding # 3
+type QueryActiveContractRequest struct {
+	Provider string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Service  string `protobuf:"bytes,2,opt,name=service,proto3" json:"service,omitempty"`
+	Spender  string `protobuf:"bytes,3,opt,name=spender,proto3" json:"spender,omitempty"`
 }
 
-func (m *QueryAllProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Provider) > 0 {
-		for iNdEx := len(m.Provider) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Provider[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryActiveContractRequest) Reset()         { *m = QueryActiveContractRequest{} }
+func (m *QueryActiveContractRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryActiveContractRequest) ProtoMessage()    {}
+func (*QueryActiveContractRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4b28dca1d1dd051d, []int{23}
+}
+func (m *QueryActiveContractRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryActiveContractRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryActiveContractRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryFetchContractRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryActiveContractRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryActiveContractRequest.Merge(m, src)
+}
+func (m *QueryActiveContractRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryActiveContractRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryActiveContractRequest.DiscardUnknown(m)
 }
 
-func (m *QueryFetchContractRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryActiveContractRequest proto.InternalMessageInfo
+
+func (m *QueryActiveContractRequest) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
 }
 
-func (m *QueryFetchContractRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ContractId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ContractId))
-		i--
-		dAtA[i] = 0x8
+func (m *QueryActiveContractRequest) GetService() string {
+	if m != nil {
+		return m.Service
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryFetchContractResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryActiveContractRequest) GetSpender() string {
+	if m != nil {
+		return m.Spender
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryFetchContractResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryActiveContractResponse struct {
+	Contract Contract `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract"`
+	// height the contract is settled at, at the latest, grace period included
+	SettlementPeriodEnd int64 `protobuf:"varint,2,opt,name=settlement_period_end,json=settlementPeriodEnd,proto3" json:"settlement_period_end,omitempty"`
+	// pending is true until the scheduled contract reaches its start height
+	Pending bool `protobuf:"varint,3,opt,name=pending,proto3" json:"pending,omitempty"`
 }
 
-func (m *QueryFetchContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Contract.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryActiveContractResponse) Reset()         { *m = QueryActiveContractResponse{} }
+func (m *QueryActiveContractResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryActiveContractResponse) ProtoMessage()    {}
+func (*QueryActiveContractResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4b28dca1d1dd051d, []int{24}
+}
+func (m *QueryActiveContractResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryActiveContractResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryActiveContractResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryAllContractRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryActiveContractResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryActiveContractResponse.Merge(m, src)
+}
+func (m *QueryActiveContractResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryActiveContractResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryActiveContractResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAllContractRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryActiveContractResponse proto.InternalMessageInfo
+
+func (m *QueryActiveContractResponse) GetContract() Contract {
+	if m != nil {
+		return m.Contract
+	}
+	return Contract{}
 }
 
-func (m *QueryAllContractRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (m *QueryActiveContractResponse) GetSettlementPeriodEnd() int64 {
+	if m != nil {
+		return m.SettlementPeriodEnd
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryAllContractResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryActiveContractResponse) GetPending() bool {
+	if m != nil {
+		return m.Pending
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *QueryAllContractResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryNetworkStatsRequest struct {
 }
 
-func (m *QueryAllContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryNetworkStatsRequest) Reset()         { *m = QueryNetworkStatsRequest{} }
+func (m *QueryNetworkStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryNetworkStatsRequest) ProtoMessage()    {}
+func (*QueryNetworkStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4b28dca1d1dd051d, []int{25}
+}
+func (m *QueryNetworkStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNetworkStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNetworkStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Contract) > 0 {
-		for iNdEx := len(m.Contract) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Contract[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+}
+func (m *QueryNetworkStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNetworkStatsRequest.Merge(m, src)
+}
+func (m *QueryNetworkStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNetworkStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNetworkStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNetworkStatsRequest proto.InternalMessageInfo
+
+type QueryNetworkStatsResponse struct {
+	Stats NetworkStats `protobuf:"bytes,1,opt,name=stats,proto3" json:"stats"`
+}
+
+func (m *QueryNetworkStatsResponse) Reset()         { *m = QueryNetworkStatsResponse{} }
+func (m *QueryNetworkStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryNetworkStatsResponse) ProtoMessage()    {}
+func (*QueryNetworkStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4b28dca1d1dd051d, []int{26}
+}
+func (m *QueryNetworkStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNetworkStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNetworkStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryNetworkStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNetworkStatsResponse.Merge(m, src)
+}
+func (m *QueryNetworkStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNetworkStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNetworkStatsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryActiveContractRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryNetworkStatsResponse proto.InternalMessageInfo
+
+func (m *QueryNetworkStatsResponse) GetStats() NetworkStats {
+	if m != nil {
+		return m.Stats
 	}
-	return dAtA[:n], nil
+	return NetworkStats{}
 }
 
-func (m *QueryActiveContractRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "arkeo.arkeo.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "arkeo.arkeo.QueryParamsResponse")
+	proto.RegisterType((*QueryFetchProviderRequest)(nil), "arkeo.arkeo.QueryFetchProviderRequest")
+	proto.RegisterType((*QueryFetchProviderResponse)(nil), "arkeo.arkeo.QueryFetchProviderResponse")
+	proto.RegisterType((*QueryProviderEarningsRequest)(nil), "arkeo.arkeo.QueryProviderEarningsRequest")
+	proto.RegisterType((*QueryProviderEarningsResponse)(nil), "arkeo.arkeo.QueryProviderEarningsResponse")
+	proto.RegisterType((*QueryAllProviderRequest)(nil), "arkeo.arkeo.QueryAllProviderRequest")
+	proto.RegisterType((*QueryAllProviderResponse)(nil), "arkeo.arkeo.QueryAllProviderResponse")
+	proto.RegisterType((*QueryFetchContractRequest)(nil), "arkeo.arkeo.QueryFetchContractRequest")
+	proto.RegisterType((*QueryFetchContractResponse)(nil), "arkeo.arkeo.QueryFetchContractResponse")
+	proto.RegisterType((*QueryContractSettlementPreviewRequest)(nil), "arkeo.arkeo.QueryContractSettlementPreviewRequest")
+	proto.RegisterType((*QueryContractSettlementPreviewResponse)(nil), "arkeo.arkeo.QueryContractSettlementPreviewResponse")
+	proto.RegisterType((*QueryClaimableIncomeRequest)(nil), "arkeo.arkeo.QueryClaimableIncomeRequest")
+	proto.RegisterType((*QueryClaimableIncomeResponse)(nil), "arkeo.arkeo.QueryClaimableIncomeResponse")
+	proto.RegisterType((*QueryProvidersRequest)(nil), "arkeo.arkeo.QueryProvidersRequest")
+	proto.RegisterType((*QueryProvidersResponse)(nil), "arkeo.arkeo.QueryProvidersResponse")
+	proto.RegisterType((*QueryAllContractRequest)(nil), "arkeo.arkeo.QueryAllContractRequest")
+	proto.RegisterType((*QueryAllContractResponse)(nil), "arkeo.arkeo.QueryAllContractResponse")
+	proto.RegisterType((*QueryContractsByProviderRequest)(nil), "arkeo.arkeo.QueryContractsByProviderRequest")
+	proto.RegisterType((*QueryContractsByProviderResponse)(nil), "arkeo.arkeo.QueryContractsByProviderResponse")
+	proto.RegisterType((*QueryContractsByOwnerRequest)(nil), "arkeo.arkeo.QueryContractsByOwnerRequest")
+	proto.RegisterType((*OwnerContract)(nil), "arkeo.arkeo.OwnerContract")
+	proto.RegisterType((*QueryContractsByOwnerResponse)(nil), "arkeo.arkeo.QueryContractsByOwnerResponse")
+	proto.RegisterType((*QueryActiveContractRequest)(nil), "arkeo.arkeo.QueryActiveContractRequest")
+	proto.RegisterType((*QueryActiveContractResponse)(nil), "arkeo.arkeo.QueryActiveContractResponse")
+	proto.RegisterType((*QueryNetworkStatsRequest)(nil), "arkeo.arkeo.QueryNetworkStatsRequest")
+	proto.RegisterType((*QueryNetworkStatsResponse)(nil), "arkeo.arkeo.QueryNetworkStatsResponse")
 }
 
-func (m *QueryActiveContractRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Spender) > 0 {
-		i -= len(m.Spender)
-		copy(dAtA[i:], m.Spender)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Spender)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Service) > 0 {
-		i -= len(m.Service)
-		copy(dAtA[i:], m.Service)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Service)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Provider) > 0 {
-		i -= len(m.Provider)
-		copy(dAtA[i:], m.Provider)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Provider)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func init() { proto.RegisterFile("arkeo/arkeo/query.proto", fileDescriptor_4b28dca1d1dd051d) }
+
+var fileDescriptor_4b28dca1d1dd051d = []byte{
+	// 1513 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcd, 0x73, 0xdb, 0x54,
+	0x10, 0x8f, 0x62, 0x27, 0x8d, 0x37, 0x4d, 0x63, 0x5e, 0x92, 0xd6, 0x51, 0x53, 0x27, 0xa8, 0xcd,
+	0x67, 0x1b, 0xab, 0x4d, 0xa6, 0x14, 0x66, 0x28, 0xd0, 0x96, 0x14, 0xda, 0x19, 0x20, 0xa8, 0x85,
+	0x03, 0x07, 0x8c, 0x2c, 0x3f, 0x1c, 0x4d, 0x6c, 0x49, 0x95, 0x9e, 0xf3, 0x31, 0x99, 0x5c, 0xb8,
+	0x70, 0xe1, 0xc0, 0xc0, 0x81, 0x0b, 0xd3, 0x03, 0x70, 0x00, 0xee, 0x70, 0x83, 0x73, 0x87, 0x53,
+	0x67, 0xb8, 0x70, 0x02, 0xa6, 0xe1, 0x0f, 0x61, 0xf4, 0xb4, 0xcf, 0xfa, 0xb0, 0x1c, 0x9b, 0x34,
+	0xcc, 0xf4, 0x92, 0xfa, 0xed, 0xfb, 0xed, 0xee, 0xef, 0xad, 0xf6, 0xed, 0xbe, 0x2d, 0x9c, 0xd1,
+	0xdd, 0x4d, 0x6a, 0xab, 0xc1, 0xdf, 0x07, 0x4d, 0xea, 0xee, 0x96, 0x1c, 0xd7, 0x66, 0x36, 0x19,
+	0xe6, 0xa2, 0x12, 0xff, 0x2b, 0x8f, 0xd7, 0xec, 0x9a, 0xcd, 0xe5, 0xaa, 0xff, 0x2b, 0x80, 0xc8,
+	0x53, 0x35, 0xdb, 0xae, 0xd5, 0xa9, 0xaa, 0x3b, 0xa6, 0xaa, 0x5b, 0x96, 0xcd, 0x74, 0x66, 0xda,
+	0x96, 0x87, 0xbb, 0x4b, 0x86, 0xed, 0x35, 0x6c, 0x4f, 0xad, 0xe8, 0x1e, 0x0d, 0x2c, 0xab, 0x5b,
+	0x57, 0x2a, 0x94, 0xe9, 0x57, 0x54, 0x47, 0xaf, 0x99, 0x16, 0x07, 0x23, 0xb6, 0x18, 0xc5, 0x0a,
+	0x94, 0x61, 0x9b, 0x62, 0xbf, 0x10, 0x65, 0xe9, 0xe8, 0xae, 0xde, 0xf0, 0xd2, 0x76, 0x36, 0x29,
+	0x75, 0xa8, 0x1b, 0xec, 0x28, 0xe3, 0x40, 0xde, 0xf5, 0xbd, 0xae, 0x73, 0xb8, 0x46, 0x1f, 0x34,
+	0xa9, 0xc7, 0x94, 0x5f, 0x24, 0x18, 0x8b, 0x89, 0x3d, 0xc7, 0xb6, 0x3c, 0x4a, 0xae, 0xc0, 0x60,
+	0x60, 0xb7, 0x20, 0xcd, 0x48, 0x0b, 0xc3, 0x2b, 0x63, 0xa5, 0xc8, 0xf9, 0x4b, 0x01, 0xf8, 0x66,
+	0xf6, 0xd1, 0x9f, 0xd3, 0x7d, 0x1a, 0x02, 0xc9, 0x25, 0x20, 0x75, 0xdd, 0x63, 0x65, 0x63, 0x43,
+	0xb7, 0x6a, 0xb4, 0xbc, 0x41, 0xcd, 0xda, 0x06, 0x2b, 0xf4, 0xcf, 0x48, 0x0b, 0x19, 0x2d, 0xef,
+	0xef, 0xdc, 0xe2, 0x1b, 0x6f, 0x72, 0x39, 0xb9, 0x08, 0xcf, 0x19, 0xbe, 0x27, 0xcb, 0x6b, 0x7a,
+	0xe5, 0x2d, 0xea, 0x7a, 0xa6, 0x6d, 0x15, 0x32, 0x33, 0xd2, 0x42, 0x56, 0xcb, 0xb7, 0x36, 0xde,
+	0x0f, 0xe4, 0xa4, 0x00, 0x27, 0x04, 0x24, 0xcb, 0xed, 0x89, 0xa5, 0xf2, 0x16, 0x4c, 0x72, 0xfa,
+	0xb7, 0x29, 0x33, 0x36, 0xd6, 0x5d, 0x7b, 0xcb, 0xac, 0x52, 0x17, 0x0f, 0x47, 0x4e, 0xc3, 0xa0,
+	0xd3, 0xac, 0x6c, 0xd2, 0x5d, 0x7e, 0x88, 0x9c, 0x86, 0x2b, 0xdf, 0x9c, 0x47, 0xdd, 0x2d, 0xd3,
+	0xa0, 0x9c, 0x5e, 0x4e, 0x13, 0x4b, 0xe5, 0x3d, 0x90, 0xd3, 0xcc, 0x61, 0x50, 0xae, 0xc1, 0x90,
+	0x83, 0x32, 0x0c, 0xcb, 0x44, 0x3c, 0x2c, 0xb8, 0x89, 0x81, 0x69, 0x81, 0x95, 0x75, 0x98, 0x0a,
+	0x82, 0x8c, 0x82, 0x35, 0xdd, 0xb5, 0x4c, 0xab, 0xe6, 0x1d, 0x9d, 0xe8, 0x47, 0x70, 0xae, 0x83,
+	0x45, 0xe4, 0xfa, 0x2a, 0x0c, 0x51, 0x94, 0x21, 0xd7, 0x73, 0xa9, 0x5c, 0x85, 0xa2, 0xe0, 0x2c,
+	0x94, 0x14, 0x1d, 0xce, 0x70, 0x0f, 0x37, 0xea, 0xf5, 0x64, 0x5c, 0x6f, 0x03, 0x84, 0x29, 0x8b,
+	0xd6, 0xe7, 0x4a, 0x41, 0xce, 0x96, 0xfc, 0x9c, 0x2d, 0x05, 0x37, 0x07, 0x33, 0xb7, 0xb4, 0xae,
+	0xd7, 0x28, 0xea, 0x6a, 0x11, 0x4d, 0xe5, 0x6b, 0x09, 0x0a, 0xed, 0x3e, 0x52, 0x83, 0x9d, 0xe9,
+	0x39, 0xd8, 0xe4, 0x8d, 0x18, 0xbb, 0x7e, 0xce, 0x6e, 0xbe, 0x2b, 0xbb, 0xc0, 0x6b, 0x8c, 0xde,
+	0xcb, 0xd1, 0xdc, 0xba, 0x65, 0x5b, 0xcc, 0xd5, 0x0d, 0x26, 0x62, 0x30, 0x0d, 0xc3, 0x06, 0x8a,
+	0xca, 0x66, 0x95, 0x07, 0x21, 0xab, 0x81, 0x10, 0xdd, 0xa9, 0x2a, 0xdf, 0x4a, 0x20, 0xa7, 0xa9,
+	0x87, 0xc7, 0x13, 0xe0, 0xd4, 0x5c, 0x12, 0x0a, 0xe2, 0x78, 0x02, 0x4c, 0x56, 0x60, 0xc2, 0xa3,
+	0x8c, 0xd5, 0x69, 0x83, 0x5a, 0xac, 0xec, 0x50, 0xd7, 0xb4, 0xab, 0x65, 0x6a, 0x55, 0xf1, 0xa6,
+	0x8d, 0x85, 0x9b, 0xeb, 0x7c, 0x6f, 0xcd, 0xaa, 0xfa, 0x79, 0xe4, 0x50, 0xab, 0x6a, 0x5a, 0x35,
+	0x7e, 0xc5, 0x86, 0x34, 0xb1, 0x54, 0x3e, 0x84, 0x59, 0x4e, 0x52, 0xb8, 0xbb, 0x17, 0x6a, 0xbb,
+	0x74, 0xcb, 0xa4, 0xdb, 0xbd, 0x9e, 0x97, 0x8c, 0xc3, 0x80, 0x65, 0x5b, 0x98, 0xa9, 0x19, 0x2d,
+	0x58, 0x28, 0x36, 0xcc, 0x75, 0xb3, 0x8f, 0x01, 0x59, 0x03, 0x08, 0xa9, 0x63, 0x48, 0xa6, 0x53,
+	0x43, 0x12, 0xda, 0xc0, 0xe0, 0x44, 0x14, 0x95, 0xfb, 0x70, 0x36, 0x70, 0x58, 0xd7, 0xcd, 0x86,
+	0x5e, 0xa9, 0xd3, 0x3b, 0x96, 0x61, 0x37, 0xe8, 0x53, 0x1e, 0xe3, 0xe7, 0x7e, 0x98, 0x4a, 0x37,
+	0x8b, 0xec, 0x5b, 0x6a, 0x52, 0x44, 0x8d, 0x5c, 0x87, 0x9c, 0x21, 0x14, 0x30, 0x13, 0x27, 0x63,
+	0x99, 0x28, 0x72, 0xf0, 0x96, 0x6d, 0x5a, 0x78, 0x98, 0x50, 0x83, 0xbc, 0x06, 0xc3, 0x2e, 0xf5,
+	0x6f, 0x3c, 0x2d, 0x33, 0x7d, 0xa7, 0x90, 0xe9, 0xcd, 0x00, 0xa0, 0xce, 0x7d, 0x7d, 0x87, 0xdc,
+	0x85, 0xbc, 0x4b, 0x1b, 0xba, 0xe9, 0x5f, 0xe9, 0x32, 0xf5, 0x0c, 0xd7, 0xde, 0x2e, 0x64, 0x7b,
+	0x33, 0x33, 0xda, 0x52, 0x5c, 0xe3, 0x7a, 0x64, 0x15, 0xb2, 0xd5, 0xa6, 0xc7, 0x0a, 0x03, 0xbd,
+	0xe9, 0x73, 0xb0, 0xf2, 0x50, 0x82, 0x89, 0x58, 0xa1, 0x6a, 0xd5, 0xbc, 0x48, 0x6d, 0x93, 0x62,
+	0xb5, 0x8d, 0x9c, 0x87, 0x11, 0x8f, 0xe9, 0xac, 0xe9, 0x95, 0x3f, 0x36, 0xeb, 0x8c, 0xba, 0x58,
+	0xfb, 0x4e, 0x06, 0xc2, 0xdb, 0x5c, 0x96, 0xa8, 0x41, 0x99, 0xa7, 0xa9, 0x41, 0xa7, 0x93, 0x04,
+	0xf1, 0x9b, 0xbe, 0x04, 0x39, 0x51, 0x54, 0xbc, 0x5e, 0x4a, 0x50, 0x88, 0x3e, 0xbe, 0x1a, 0x14,
+	0xa9, 0xc2, 0xc9, 0x0a, 0xf4, 0x7f, 0x54, 0xe1, 0x2e, 0x65, 0x2a, 0xd3, 0x7b, 0x99, 0x3a, 0xb6,
+	0x08, 0xfc, 0x24, 0xc1, 0x74, 0xac, 0x84, 0x78, 0x37, 0x77, 0x93, 0x0d, 0x49, 0x4e, 0x34, 0xe6,
+	0x5c, 0xa4, 0x1d, 0x74, 0xec, 0xa1, 0xfe, 0x9d, 0xf5, 0x53, 0x8a, 0xf2, 0xec, 0xc9, 0x69, 0xc1,
+	0x22, 0x11, 0xd6, 0xec, 0x91, 0xc3, 0xfa, 0x9d, 0x04, 0x33, 0x9d, 0x79, 0x3f, 0x33, 0xe1, 0xfd,
+	0x41, 0x12, 0x95, 0x2d, 0xa4, 0xf9, 0xce, 0xb6, 0xd5, 0xfd, 0x11, 0xb5, 0x08, 0x79, 0xd3, 0x32,
+	0xea, 0xcd, 0x2a, 0x2d, 0x57, 0x69, 0x9d, 0xd6, 0xfc, 0x40, 0xf6, 0xf3, 0xe6, 0x32, 0x8a, 0xf2,
+	0xd7, 0x51, 0x7c, 0x6c, 0x77, 0xf5, 0x2f, 0x09, 0x46, 0x38, 0x37, 0xc1, 0xf5, 0xe8, 0x5d, 0xb4,
+	0x08, 0x40, 0x77, 0x1c, 0xd3, 0x0d, 0xe3, 0x97, 0xd1, 0x22, 0x92, 0xce, 0x5d, 0x36, 0x73, 0x68,
+	0x97, 0xe5, 0x16, 0x68, 0x95, 0xa7, 0xcd, 0x90, 0x26, 0x96, 0xd1, 0xfe, 0x3b, 0x18, 0xeb, 0xbf,
+	0x77, 0xb3, 0x43, 0x03, 0xf9, 0x41, 0xed, 0x44, 0x60, 0xae, 0xaa, 0x7c, 0x2f, 0xe1, 0xbb, 0xae,
+	0xfd, 0x6b, 0x60, 0xc6, 0xbc, 0x02, 0x39, 0x71, 0x08, 0x51, 0x94, 0xe4, 0xd8, 0x91, 0x63, 0x01,
+	0x6a, 0xf5, 0x14, 0xa1, 0x72, 0x7c, 0x89, 0x53, 0xc7, 0xe7, 0xcd, 0x0d, 0x83, 0x99, 0x5b, 0x34,
+	0x59, 0x9c, 0x8e, 0x76, 0x23, 0xfd, 0x1d, 0x3f, 0x32, 0xd4, 0xc5, 0x3b, 0x29, 0x96, 0xfe, 0x6d,
+	0x3a, 0x9b, 0xea, 0xee, 0xd9, 0x7a, 0x4e, 0xc9, 0x58, 0x4a, 0xdf, 0xa6, 0x6c, 0xdb, 0x76, 0x37,
+	0xef, 0x31, 0x9d, 0xb5, 0x46, 0x2d, 0x0d, 0x26, 0x53, 0xf6, 0x90, 0xff, 0xd5, 0xa0, 0x16, 0x89,
+	0xb7, 0xfa, 0x64, 0x8c, 0x7c, 0x54, 0x03, 0x0f, 0x10, 0xa0, 0x57, 0x7e, 0x3b, 0x05, 0x03, 0xdc,
+	0x28, 0xa9, 0xc0, 0x60, 0x30, 0x95, 0x91, 0xf8, 0xa3, 0xa9, 0x7d, 0xe6, 0x93, 0x67, 0x3a, 0x03,
+	0x02, 0x36, 0xca, 0xc4, 0x27, 0xbf, 0xff, 0xf3, 0x65, 0xff, 0x28, 0x19, 0x89, 0x8d, 0x98, 0xe4,
+	0x33, 0x09, 0x46, 0x62, 0x93, 0x11, 0x99, 0x6b, 0x37, 0x95, 0x36, 0x89, 0xc9, 0xf3, 0x5d, 0x71,
+	0xe8, 0x79, 0x89, 0x7b, 0xbe, 0x40, 0x14, 0xe1, 0x19, 0x01, 0xea, 0x5e, 0x50, 0x76, 0xf6, 0xd5,
+	0x3d, 0x4c, 0x96, 0x7d, 0xf2, 0x50, 0x82, 0x7c, 0x72, 0x8c, 0x21, 0x8b, 0x29, 0x87, 0x4b, 0x9f,
+	0xba, 0xe4, 0xa5, 0x5e, 0xa0, 0xc8, 0x6b, 0x95, 0xf3, 0x5a, 0x26, 0x17, 0xbb, 0xf3, 0x52, 0xc5,
+	0x08, 0x45, 0x18, 0x0c, 0x0b, 0x83, 0x37, 0xea, 0x75, 0x72, 0xa1, 0xdd, 0x5f, 0xfb, 0x70, 0x25,
+	0xcf, 0x76, 0x41, 0x21, 0xa1, 0x02, 0x27, 0x44, 0x48, 0x3e, 0x41, 0xc8, 0x23, 0x3b, 0x90, 0x5b,
+	0x6f, 0x2d, 0x94, 0xce, 0x67, 0x6c, 0xc5, 0xe1, 0xfc, 0xa1, 0x18, 0xf4, 0xa7, 0x70, 0x7f, 0x53,
+	0x44, 0x4e, 0xfa, 0x8b, 0x7c, 0x90, 0x4f, 0x45, 0x7e, 0xb4, 0xea, 0x73, 0xa7, 0xfc, 0x48, 0x94,
+	0x0b, 0x79, 0xbe, 0x2b, 0x0e, 0x69, 0xcc, 0x72, 0x1a, 0xd3, 0xe4, 0x1c, 0xd2, 0x10, 0xf7, 0x58,
+	0xdd, 0x8b, 0x3c, 0xeb, 0xf7, 0xc9, 0xaf, 0x12, 0x4c, 0x76, 0x1c, 0x39, 0xc8, 0x4a, 0xbb, 0xb7,
+	0x6e, 0xf3, 0x8f, 0xbc, 0xfa, 0x9f, 0x74, 0x90, 0xed, 0x8b, 0x9c, 0xed, 0x0a, 0xb9, 0x7c, 0x28,
+	0x5b, 0x35, 0xac, 0x31, 0xcb, 0x0e, 0x52, 0xfc, 0x4a, 0x82, 0xd1, 0xc4, 0xac, 0x41, 0x16, 0x52,
+	0x28, 0xa4, 0x4e, 0x39, 0xf2, 0x62, 0x0f, 0x48, 0xa4, 0xa8, 0x72, 0x8a, 0x8b, 0x64, 0xfe, 0x70,
+	0x8a, 0xe1, 0x50, 0xc2, 0x60, 0x58, 0x1c, 0xbc, 0x73, 0x52, 0x27, 0xbf, 0xef, 0x6c, 0x17, 0x54,
+	0x87, 0xa4, 0x0e, 0xdb, 0xd6, 0x8f, 0x12, 0x8c, 0xa5, 0x3c, 0xa4, 0xc8, 0xa5, 0xce, 0x9f, 0xa5,
+	0xfd, 0x9d, 0x28, 0x2f, 0xf7, 0x88, 0x46, 0x3a, 0x2f, 0x70, 0x3a, 0x97, 0x49, 0x29, 0x49, 0x27,
+	0x7a, 0xfd, 0xf1, 0x57, 0xb4, 0x30, 0x7d, 0x21, 0x41, 0x3e, 0xd9, 0xc0, 0xd3, 0x0a, 0x53, 0x87,
+	0x27, 0x97, 0xbc, 0xd4, 0x0b, 0x14, 0x39, 0xce, 0x73, 0x8e, 0xcf, 0x93, 0xe9, 0x36, 0x8e, 0xf6,
+	0xb6, 0x15, 0xa9, 0x4f, 0xe4, 0x1b, 0x09, 0x4e, 0xc5, 0x9b, 0x27, 0x49, 0xb9, 0x75, 0xa9, 0xdd,
+	0x5c, 0x5e, 0xe8, 0x0e, 0x44, 0x3a, 0xd7, 0x39, 0x9d, 0x6b, 0xe4, 0x2a, 0xd2, 0xd1, 0x39, 0x6c,
+	0x39, 0xcc, 0xaa, 0x94, 0x78, 0xa9, 0x7b, 0xd8, 0xe5, 0xf7, 0xc9, 0x1e, 0x9c, 0x8c, 0x36, 0x3b,
+	0x92, 0x92, 0x37, 0x29, 0xad, 0x55, 0x9e, 0xeb, 0x06, 0x43, 0x76, 0x53, 0x9c, 0xdd, 0x69, 0x32,
+	0x8e, 0xec, 0xac, 0x00, 0xb4, 0xcc, 0x9b, 0xe9, 0xcd, 0xb5, 0x47, 0x4f, 0x8a, 0xd2, 0xe3, 0x27,
+	0x45, 0xe9, 0xef, 0x27, 0x45, 0xe9, 0xf3, 0x83, 0x62, 0xdf, 0xe3, 0x83, 0x62, 0xdf, 0x1f, 0x07,
+	0xc5, 0xbe, 0x0f, 0x2e, 0xd6, 0x4c, 0xb6, 0xd1, 0xac, 0x94, 0x0c, 0xbb, 0x11, 0x68, 0xa2, 0x22,
+	0x9a, 0xd9, 0xc1, 0x7f, 0xd9, 0xae, 0x43, 0xbd, 0xca, 0x20, 0xff, 0xff, 0xd6, 0xd5, 0x7f, 0x07,
+	0x00, 0x74, 0x58, 0x38, 0xef, 0x4b, 0x16, 0x00, 0x00,
 }
 
-func (m *QueryActiveContractResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	FetchProvider(ctx context.Context, in *QueryFetchProviderRequest, opts ...grpc.CallOption) (*QueryFetchProviderResponse, error)
+	// Queries the earnings of a provider for a service.
+	ProviderEarnings(ctx context.Context, in *QueryProviderEarningsRequest, opts ...grpc.CallOption) (*QueryProviderEarningsResponse, error)
+	ProviderAll(ctx context.Context, in *QueryAllProviderRequest, opts ...grpc.CallOption) (*QueryAllProviderResponse, error)
+	// Queries the providers of a service, of a status when asked to.
+	Providers(ctx context.Context, in *QueryProvidersRequest, opts ...grpc.CallOption) (*QueryProvidersResponse, error)
+	FetchContract(ctx context.Context, in *QueryFetchContractRequest, opts ...grpc.CallOption) (*QueryFetchContractResponse, error)
+	// Previews how a contract settles at the current height, the claim at the
+	// nonce or without a nonce the settlement closing the contract.
+	ContractSettlementPreview(ctx context.Context, in *QueryContractSettlementPreviewRequest, opts ...grpc.CallOption) (*QueryContractSettlementPreviewResponse, error)
+	// Queries what the claim of the provider at the nonce pays it at the
+	// current height.
+	ClaimableIncome(ctx context.Context, in *QueryClaimableIncomeRequest, opts ...grpc.CallOption) (*QueryClaimableIncomeResponse, error)
+	ContractAll(ctx context.Context, in *QueryAllContractRequest, opts ...grpc.CallOption) (*QueryAllContractResponse, error)
+	// Queries the contracts of a provider for a service.
+	ContractsByProvider(ctx context.Context, in *QueryContractsByProviderRequest, opts ...grpc.CallOption) (*QueryContractsByProviderResponse, error)
+	// Queries the contracts of a client, and of a delegate when asked to, that
+	// are not settled yet.
+	ContractsByOwner(ctx context.Context, in *QueryContractsByOwnerRequest, opts ...grpc.CallOption) (*QueryContractsByOwnerResponse, error)
+	// Queries an active contract by spender, provider and service.
+	ActiveContract(ctx context.Context, in *QueryActiveContractRequest, opts ...grpc.CallOption) (*QueryActiveContractResponse, error)
+	// Queries the counters of the providers and contracts of the network.
+	NetworkStats(ctx context.Context, in *QueryNetworkStatsRequest, opts ...grpc.CallOption) (*QueryNetworkStatsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/arkeo.arkeo.Query/Params", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryActiveContractResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) FetchProvider(ctx context.Context, in *QueryFetchProviderRequest, opts ...grpc.CallOption) (*QueryFetchProviderResponse, error) {
+	out := new(QueryFetchProviderResponse)
+	err := c.cc.Invoke(ctx, "/arkeo.arkeo.Query/FetchProvider", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryActiveContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Contract.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) ProviderEarnings(ctx context.Context, in *QueryProviderEarningsRequest, opts ...grpc.CallOption) (*QueryProviderEarningsResponse, error) {
+	out := new(QueryProviderEarningsResponse)
+	err := c.cc.Invoke(ctx, "/arkeo.arkeo.Query/ProviderEarnings", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (c *queryClient) ProviderAll(ctx context.Context, in *QueryAllProviderRequest, opts ...grpc.CallOption) (*QueryAllProviderResponse, error) {
+	out := new(QueryAllProviderResponse)
+	err := c.cc.Invoke(ctx, "/arkeo.arkeo.Query/ProviderAll", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return out, nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (c *queryClient) Providers(ctx context.Context, in *QueryProvidersRequest, opts ...grpc.CallOption) (*QueryProvidersResponse, error) {
+	out := new(QueryProvidersResponse)
+	err := c.cc.Invoke(ctx, "/arkeo.arkeo.Query/Providers", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) FetchContract(ctx context.Context, in *QueryFetchContractRequest, opts ...grpc.CallOption) (*QueryFetchContractResponse, error) {
+	out := new(QueryFetchContractResponse)
+	err := c.cc.Invoke(ctx, "/arkeo.arkeo.Query/FetchContract", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *QueryFetchProviderRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Pubkey)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Service)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) ContractSettlementPreview(ctx context.Context, in *QueryContractSettlementPreviewRequest, opts ...grpc.CallOption) (*QueryContractSettlementPreviewResponse, error) {
+	out := new(QueryContractSettlementPreviewResponse)
+	err := c.cc.Invoke(ctx, "/arkeo.arkeo.Query/ContractSettlementPreview", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryFetchProviderResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) ClaimableIncome(ctx context.Context, in *QueryClaimableIncomeRequest, opts ...grpc.CallOption) (*QueryClaimableIncomeResponse, error) {
+	out := new(QueryClaimableIncomeResponse)
+	err := c.cc.Invoke(ctx, "/arkeo.arkeo.Query/ClaimableIncome", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Provider.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *QueryAllProviderRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) ContractAll(ctx context.Context, in *QueryAllContractRequest, opts ...grpc.CallOption) (*QueryAllContractResponse, error) {
+	out := new(QueryAllContractResponse)
+	err := c.cc.Invoke(ctx, "/arkeo.arkeo.Query/ContractAll", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryAllProviderResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) ContractsByProvider(ctx context.Context, in *QueryContractsByProviderRequest, opts ...grpc.CallOption) (*QueryContractsByProviderResponse, error) {
+	out := new(QueryContractsByProviderResponse)
+	err := c.cc.Invoke(ctx, "/arkeo.arkeo.Query/ContractsByProvider", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Provider) > 0 {
-		for _, e := range m.Provider {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	return out, nil
+}
+
+func (c *queryClient) ContractsByOwner(ctx context.Context, in *QueryContractsByOwnerRequest, opts ...grpc.CallOption) (*QueryContractsByOwnerResponse, error) {
+	out := new(QueryContractsByOwnerResponse)
+	err := c.cc.Invoke(ctx, "/arkeo.arkeo.Query/ContractsByOwner", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) ActiveContract(ctx context.Context, in *QueryActiveContractRequest, opts ...grpc.CallOption) (*QueryActiveContractResponse, error) {
+	out := new(QueryActiveContractResponse)
+	err := c.cc.Invoke(ctx, "/arkeo.arkeo.Query/ActiveContract", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryFetchContractRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) NetworkStats(ctx context.Context, in *QueryNetworkStatsRequest, opts ...grpc.CallOption) (*QueryNetworkStatsResponse, error) {
+	out := new(QueryNetworkStatsResponse)
+	err := c.cc.Invoke(ctx, "/arkeo.arkeo.Query/NetworkStats", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.ContractId != 0 {
-		n += 1 + sovQuery(uint64(m.ContractId))
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	FetchProvider(context.Context, *QueryFetchProviderRequest) (*QueryFetchProviderResponse, error)
+	// Queries the earnings of a provider for a service.
+	ProviderEarnings(context.Context, *QueryProviderEarningsRequest) (*QueryProviderEarningsResponse, error)
+	ProviderAll(context.Context, *QueryAllProviderRequest) (*QueryAllProviderResponse, error)
+	// Queries the providers of a service, of a status when asked to.
+	Providers(context.Context, *QueryProvidersRequest) (*QueryProvidersResponse, error)
+	FetchContract(context.Context, *QueryFetchContractRequest) (*QueryFetchContractResponse, error)
+	// Previews how a contract settles at the current height, the claim at the
+	// nonce or without a nonce the settlement closing the contract.
+	ContractSettlementPreview(context.Context, *QueryContractSettlementPreviewRequest) (*QueryContractSettlementPreviewResponse, error)
+	// Queries what the claim of the provider at the nonce pays it at the
+	// current height.
+	ClaimableIncome(context.Context, *QueryClaimableIncomeRequest) (*QueryClaimableIncomeResponse, error)
+	ContractAll(context.Context, *QueryAllContractRequest) (*QueryAllContractResponse, error)
+	// Queries the contracts of a provider for a service.
+	ContractsByProvider(context.Context, *QueryContractsByProviderRequest) (*QueryContractsByProviderResponse, error)
+	// Queries the contracts of a client, and of a delegate when asked to, that
+	// are not settled yet.
+	ContractsByOwner(context.Context, *QueryContractsByOwnerRequest) (*QueryContractsByOwnerResponse, error)
+	// Queries an active contract by spender, provider and service.
+	ActiveContract(context.Context, *QueryActiveContractRequest) (*QueryActiveContractResponse, error)
+	// Queries the counters of the providers and contracts of the network.
+	NetworkStats(context.Context, *QueryNetworkStatsRequest) (*QueryNetworkStatsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) FetchProvider(ctx context.Context, req *QueryFetchProviderRequest) (*QueryFetchProviderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FetchProvider not implemented")
+}
+func (*UnimplementedQueryServer) ProviderEarnings(ctx context.Context, req *QueryProviderEarningsRequest) (*QueryProviderEarningsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProviderEarnings not implemented")
+}
+func (*UnimplementedQueryServer) ProviderAll(ctx context.Context, req *QueryAllProviderRequest) (*QueryAllProviderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProviderAll not implemented")
+}
+func (*UnimplementedQueryServer) Providers(ctx context.Context, req *QueryProvidersRequest) (*QueryProvidersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Providers not implemented")
+}
+func (*UnimplementedQueryServer) FetchContract(ctx context.Context, req *QueryFetchContractRequest) (*QueryFetchContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FetchContract not implemented")
+}
+func (*UnimplementedQueryServer) ContractSettlementPreview(ctx context.Context, req *QueryContractSettlementPreviewRequest) (*QueryContractSettlementPreviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContractSettlementPreview not implemented")
+}
+func (*UnimplementedQueryServer) ClaimableIncome(ctx context.Context, req *QueryClaimableIncomeRequest) (*QueryClaimableIncomeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimableIncome not implemented")
+}
+func (*UnimplementedQueryServer) ContractAll(ctx context.Context, req *QueryAllContractRequest) (*QueryAllContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContractAll not implemented")
+}
+func (*UnimplementedQueryServer) ContractsByProvider(ctx context.Context, req *QueryContractsByProviderRequest) (*QueryContractsByProviderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContractsByProvider not implemented")
+}
+func (*UnimplementedQueryServer) ContractsByOwner(ctx context.Context, req *QueryContractsByOwnerRequest) (*QueryContractsByOwnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContractsByOwner not implemented")
+}
+func (*UnimplementedQueryServer) ActiveContract(ctx context.Context, req *QueryActiveContractRequest) (*QueryActiveContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActiveContract not implemented")
+}
+func (*UnimplementedQueryServer) NetworkStats(ctx context.Context, req *QueryNetworkStatsRequest) (*QueryNetworkStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NetworkStats not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return n
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/arkeo.arkeo.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryFetchContractResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_FetchProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFetchProviderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Contract.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if interceptor == nil {
+		return srv.(QueryServer).FetchProvider(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/arkeo.arkeo.Query/FetchProvider",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FetchProvider(ctx, req.(*QueryFetchProviderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllContractRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_ProviderEarnings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProviderEarningsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).ProviderEarnings(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/arkeo.arkeo.Query/ProviderEarnings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProviderEarnings(ctx, req.(*QueryProviderEarningsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllContractResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_ProviderAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllProviderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Contract) > 0 {
-		for _, e := range m.Contract {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).ProviderAll(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/arkeo.arkeo.Query/ProviderAll",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProviderAll(ctx, req.(*QueryAllProviderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryActiveContractRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_Providers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProvidersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Provider)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).Providers(ctx, in)
 	}
-	l = len(m.Service)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/arkeo.arkeo.Query/Providers",
 	}
-	l = len(m.Spender)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Providers(ctx, req.(*QueryProvidersRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryActiveContractResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_FetchContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFetchContractRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Contract.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if interceptor == nil {
+		return srv.(QueryServer).FetchContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/arkeo.arkeo.Query/FetchContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FetchContract(ctx, req.(*QueryFetchContractRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func _Query_ContractSettlementPreview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryContractSettlementPreviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ContractSettlementPreview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/arkeo.arkeo.Query/ContractSettlementPreview",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContractSettlementPreview(ctx, req.(*QueryContractSettlementPreviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func _Query_ClaimableIncome_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClaimableIncomeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ClaimableIncome(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/arkeo.arkeo.Query/ClaimableIncome",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClaimableIncome(ctx, req.(*QueryClaimableIncomeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
+
+func _Query_ContractAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllContractRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ContractAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/arkeo.arkeo.Query/ContractAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContractAll(ctx, req.(*QueryAllContractRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ContractsByProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryContractsByProviderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ContractsByProvider(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/arkeo.arkeo.Query/ContractsByProvider",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContractsByProvider(ctx, req.(*QueryContractsByProviderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ContractsByOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryContractsByOwnerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ContractsByOwner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/arkeo.arkeo.Query/ContractsByOwner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContractsByOwner(ctx, req.(*QueryContractsByOwnerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ActiveContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryActiveContractRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ActiveContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/arkeo.arkeo.Query/ActiveContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ActiveContract(ctx, req.(*QueryActiveContractRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_NetworkStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNetworkStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NetworkStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/arkeo.arkeo.Query/NetworkStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NetworkStats(ctx, req.(*QueryNetworkStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "arkeo.arkeo.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "FetchProvider",
+			Handler:    _Query_FetchProvider_Handler,
+		},
+		{
+			MethodName: "ProviderEarnings",
+			Handler:    _Query_ProviderEarnings_Handler,
+		},
+		{
+			MethodName: "ProviderAll",
+			Handler:    _Query_ProviderAll_Handler,
+		},
+		{
+			MethodName: "Providers",
+			Handler:    _Query_Providers_Handler,
+		},
+		{
+			MethodName: "FetchContract",
+			Handler:    _Query_FetchContract_Handler,
+		},
+		{
+			MethodName: "ContractSettlementPreview",
+			Handler:    _Query_ContractSettlementPreview_Handler,
+		},
+		{
+			MethodName: "ClaimableIncome",
+			Handler:    _Query_ClaimableIncome_Handler,
+		},
+		{
+			MethodName: "ContractAll",
+			Handler:    _Query_ContractAll_Handler,
+		},
+		{
+			MethodName: "ContractsByProvider",
+			Handler:    _Query_ContractsByProvider_Handler,
+		},
+		{
+			MethodName: "ContractsByOwner",
+			Handler:    _Query_ContractsByOwner_Handler,
+		},
+		{
+			MethodName: "ActiveContract",
+			Handler:    _Query_ActiveContract_Handler,
+		},
+		{
+			MethodName: "NetworkStats",
+			Handler:    _Query_NetworkStats_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "arkeo/arkeo/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Version != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ConsensusVersion != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ConsensusVersion))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.LastChangeHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LastChangeHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFetchProviderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFetchProviderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFetchProviderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Service) > 0 {
+		i -= len(m.Service)
+		copy(dAtA[i:], m.Service)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Service)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Pubkey) > 0 {
+		i -= len(m.Pubkey)
+		copy(dAtA[i:], m.Pubkey)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Pubkey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFetchProviderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFetchProviderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFetchProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Provider.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProviderEarningsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProviderEarningsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProviderEarningsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Service) > 0 {
+		i -= len(m.Service)
+		copy(dAtA[i:], m.Service)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Service)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Pubkey) > 0 {
+		i -= len(m.Pubkey)
+		copy(dAtA[i:], m.Pubkey)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Pubkey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProviderEarningsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProviderEarningsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProviderEarningsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Earnings.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllProviderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllProviderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllProviderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllProviderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllProviderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Provider) > 0 {
+		for iNdEx := len(m.Provider) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Provider[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFetchContractRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFetchContractRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFetchContractRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ContractId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ContractId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFetchContractResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFetchContractResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFetchContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pending {
+		i--
+		if m.Pending {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SettlementPeriodEnd != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SettlementPeriodEnd))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Contract.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryContractSettlementPreviewRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContractSettlementPreviewRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContractSettlementPreviewRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ContractId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ContractId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryContractSettlementPreviewResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContractSettlementPreviewResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContractSettlementPreviewResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Settlement.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClaimableIncomeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClaimableIncomeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClaimableIncomeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ContractId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ContractId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClaimableIncomeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClaimableIncomeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClaimableIncomeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Dust.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.RemainingEscrow.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.ReserveTax.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Claimable.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Nonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProvidersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProvidersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProvidersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.StatusFilter) > 0 {
+		i -= len(m.StatusFilter)
+		copy(dAtA[i:], m.StatusFilter)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StatusFilter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Service) > 0 {
+		i -= len(m.Service)
+		copy(dAtA[i:], m.Service)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Service)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProvidersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProvidersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProvidersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Providers) > 0 {
+		for iNdEx := len(m.Providers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Providers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllContractRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllContractRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllContractRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllContractResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllContractResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Contract) > 0 {
+		for iNdEx := len(m.Contract) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Contract[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryContractsByProviderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContractsByProviderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContractsByProviderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.State) > 0 {
+		i -= len(m.State)
+		copy(dAtA[i:], m.State)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.State)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Service) > 0 {
+		i -= len(m.Service)
+		copy(dAtA[i:], m.Service)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Service)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryContractsByProviderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContractsByProviderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContractsByProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Contract) > 0 {
+		for iNdEx := len(m.Contract) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Contract[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryContractsByOwnerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContractsByOwnerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContractsByOwnerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.IncludeDelegate {
+		i--
+		if m.IncludeDelegate {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Pubkey) > 0 {
+		i -= len(m.Pubkey)
+		copy(dAtA[i:], m.Pubkey)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Pubkey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OwnerContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OwnerContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OwnerContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pending {
+		i--
+		if m.Pending {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Expired {
+		i--
+		if m.Expired {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.SettlementPeriodEnd != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SettlementPeriodEnd))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Expiration != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Expiration))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Contract.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryContractsByOwnerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContractsByOwnerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContractsByOwnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Contracts) > 0 {
+		for iNdEx := len(m.Contracts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Contracts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryActiveContractRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryActiveContractRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryActiveContractRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Spender) > 0 {
+		i -= len(m.Spender)
+		copy(dAtA[i:], m.Spender)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Spender)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Service) > 0 {
+		i -= len(m.Service)
+		copy(dAtA[i:], m.Service)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Service)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryActiveContractResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryActiveContractResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryActiveContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pending {
+		i--
+		if m.Pending {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SettlementPeriodEnd != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SettlementPeriodEnd))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Contract.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNetworkStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNetworkStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNetworkStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNetworkStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNetworkStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNetworkStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Stats.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.LastChangeHeight != 0 {
+		n += 1 + sovQuery(uint64(m.LastChangeHeight))
+	}
+	if m.ConsensusVersion != 0 {
+		n += 1 + sovQuery(uint64(m.ConsensusVersion))
+	}
+	if m.Version != 0 {
+		n += 1 + sovQuery(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *QueryFetchProviderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Pubkey)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Service)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFetchProviderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Provider.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryProviderEarningsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Pubkey)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Service)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProviderEarningsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Earnings.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllProviderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllProviderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Provider) > 0 {
+		for _, e := range m.Provider {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFetchContractRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ContractId != 0 {
+		n += 1 + sovQuery(uint64(m.ContractId))
+	}
+	return n
+}
+
+func (m *QueryFetchContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Contract.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.SettlementPeriodEnd != 0 {
+		n += 1 + sovQuery(uint64(m.SettlementPeriodEnd))
+	}
+	if m.Pending {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryContractSettlementPreviewRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ContractId != 0 {
+		n += 1 + sovQuery(uint64(m.ContractId))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovQuery(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *QueryContractSettlementPreviewResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Settlement.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryClaimableIncomeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ContractId != 0 {
+		n += 1 + sovQuery(uint64(m.ContractId))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovQuery(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *QueryClaimableIncomeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		n += 1 + sovQuery(uint64(m.Nonce))
+	}
+	l = m.Claimable.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ReserveTax.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.RemainingEscrow.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Dust.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryProvidersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Service)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.StatusFilter)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProvidersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Providers) > 0 {
+		for _, e := range m.Providers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllContractRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Contract) > 0 {
+		for _, e := range m.Contract {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryContractsByProviderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Service)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.State)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryContractsByProviderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Contract) > 0 {
+		for _, e := range m.Contract {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryContractsByOwnerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Pubkey)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.IncludeDelegate {
+		n += 2
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *OwnerContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Contract.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Expiration != 0 {
+		n += 1 + sovQuery(uint64(m.Expiration))
+	}
+	if m.SettlementPeriodEnd != 0 {
+		n += 1 + sovQuery(uint64(m.SettlementPeriodEnd))
+	}
+	if m.Expired {
+		n += 2
+	}
+	if m.Pending {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryContractsByOwnerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Contracts) > 0 {
+		for _, e := range m.Contracts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryActiveContractRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Service)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Spender)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryActiveContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Contract.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.SettlementPeriodEnd != 0 {
+		n += 1 + sovQuery(uint64(m.SettlementPeriodEnd))
+	}
+	if m.Pending {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryNetworkStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryNetworkStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Stats.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastChangeHeight", wireType)
+			}
+			m.LastChangeHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastChangeHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusVersion", wireType)
+			}
+			m.ConsensusVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConsensusVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFetchProviderRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFetchProviderRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFetchProviderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pubkey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pubkey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Service = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFetchProviderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFetchProviderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFetchProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Provider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProviderEarningsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProviderEarningsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProviderEarningsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pubkey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pubkey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Service = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProviderEarningsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProviderEarningsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProviderEarningsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Earnings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Earnings.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllProviderRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllProviderRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllProviderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllProviderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllProviderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = append(m.Provider, Provider{})
+			if err := m.Provider[len(m.Provider)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFetchContractRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFetchContractRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFetchContractRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			m.ContractId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFetchContractResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFetchContractResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFetchContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Contract.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementPeriodEnd", wireType)
+			}
+			m.SettlementPeriodEnd = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SettlementPeriodEnd |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pending", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Pending = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryContractSettlementPreviewRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryContractSettlementPreviewRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryContractSettlementPreviewRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			m.ContractId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryContractSettlementPreviewResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryContractSettlementPreviewResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryContractSettlementPreviewResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Settlement", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Settlement.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClaimableIncomeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClaimableIncomeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClaimableIncomeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			m.ContractId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClaimableIncomeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClaimableIncomeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClaimableIncomeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimable", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Claimable.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReserveTax", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReserveTax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingEscrow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RemainingEscrow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dust", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Dust.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProvidersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProvidersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProvidersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Service = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StatusFilter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StatusFilter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProvidersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
 			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProvidersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProvidersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Providers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Providers = append(m.Providers, Provider{})
+			if err := m.Providers[len(m.Providers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1591,7 +5468,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllContractRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1614,15 +5491,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllContractRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllContractRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1649,7 +5526,10 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1674,7 +5554,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFetchProviderRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllContractResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1697,17 +5577,17 @@ func (m *QueryFetchProviderRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFetchProviderRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllContractResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFetchProviderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pubkey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1717,29 +5597,31 @@ func (m *QueryFetchProviderRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pubkey = string(dAtA[iNdEx:postIndex])
+			m.Contract = append(m.Contract, Contract{})
+			if err := m.Contract[len(m.Contract)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1749,23 +5631,27 @@ func (m *QueryFetchProviderRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Service = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1788,7 +5674,7 @@ func (m *QueryFetchProviderRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFetchProviderResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryContractsByProviderRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1811,17 +5697,17 @@ func (m *QueryFetchProviderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFetchProviderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryContractsByProviderRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFetchProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryContractsByProviderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1831,76 +5717,89 @@ func (m *QueryFetchProviderResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Provider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryAllProviderRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			m.Service = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllProviderRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllProviderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.State = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -1957,7 +5856,7 @@ func (m *QueryAllProviderRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllProviderResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryContractsByProviderResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1980,15 +5879,15 @@ func (m *QueryAllProviderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllProviderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryContractsByProviderResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryContractsByProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2015,8 +5914,8 @@ func (m *QueryAllProviderResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Provider = append(m.Provider, Provider{})
-			if err := m.Provider[len(m.Provider)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Contract = append(m.Contract, Contract{})
+			if err := m.Contract[len(m.Contract)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2077,7 +5976,7 @@ func (m *QueryAllProviderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFetchContractRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryContractsByOwnerRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2100,17 +5999,17 @@ func (m *QueryFetchContractRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFetchContractRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryContractsByOwnerRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFetchContractRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryContractsByOwnerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pubkey", wireType)
 			}
-			m.ContractId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2120,64 +6019,47 @@ func (m *QueryFetchContractRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ContractId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryFetchContractResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.Pubkey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeDelegate", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFetchContractResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFetchContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.IncludeDelegate = bool(v != 0)
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2204,7 +6086,10 @@ func (m *QueryFetchContractResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Contract.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2229,7 +6114,7 @@ func (m *QueryFetchContractResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllContractRequest) Unmarshal(dAtA []byte) error {
+func (m *OwnerContract) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2252,15 +6137,15 @@ func (m *QueryAllContractRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllContractRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: OwnerContract: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllContractRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OwnerContract: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2287,13 +6172,88 @@ func (m *QueryAllContractRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Contract.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			m.Expiration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Expiration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementPeriodEnd", wireType)
+			}
+			m.SettlementPeriodEnd = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SettlementPeriodEnd |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expired", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Expired = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pending", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Pending = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2315,7 +6275,7 @@ func (m *QueryAllContractRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllContractResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryContractsByOwnerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2338,15 +6298,15 @@ func (m *QueryAllContractResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllContractResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryContractsByOwnerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryContractsByOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Contracts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2373,8 +6333,8 @@ func (m *QueryAllContractResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Contract = append(m.Contract, Contract{})
-			if err := m.Contract[len(m.Contract)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Contracts = append(m.Contracts, OwnerContract{})
+			if err := m.Contracts[len(m.Contracts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2643,6 +6603,178 @@ func (m *QueryActiveContractResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementPeriodEnd", wireType)
+			}
+			m.SettlementPeriodEnd = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SettlementPeriodEnd |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pending", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Pending = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNetworkStatsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNetworkStatsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNetworkStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNetworkStatsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNetworkStatsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNetworkStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
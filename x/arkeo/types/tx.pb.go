@@ -144,6 +144,9 @@ type MsgModProvider struct {
 	SubscriptionRate    []types.Coin                                `protobuf:"bytes,9,rep,name=subscription_rate,json=subscriptionRate,proto3" json:"subscription_rate"`
 	PayAsYouGoRate      []types.Coin                                `protobuf:"bytes,10,rep,name=pay_as_you_go_rate,json=payAsYouGoRate,proto3" json:"pay_as_you_go_rate"`
 	SettlementDuration  int64                                       `protobuf:"varint,11,opt,name=settlement_duration,json=settlementDuration,proto3" json:"settlement_duration,omitempty"`
+	// update_mask names the fields of the provider updated (e.g. metadata_nonce), the others are left untouched
+	UpdateMask       []string `protobuf:"bytes,12,rep,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	MaxOpenContracts uint64   `protobuf:"varint,13,opt,name=max_open_contracts,json=maxOpenContracts,proto3" json:"max_open_contracts,omitempty"`
 }
 
 func (m *MsgModProvider) Reset()         { *m = MsgModProvider{} }
@@ -256,6 +259,20 @@ func (m *MsgModProvider) GetSettlementDuration() int64 {
 	return 0
 }
 
+func (m *MsgModProvider) GetUpdateMask() []string {
+	if m != nil {
+		return m.UpdateMask
+	}
+	return nil
+}
+
+func (m *MsgModProvider) GetMaxOpenContracts() uint64 {
+	if m != nil {
+		return m.MaxOpenContracts
+	}
+	return 0
+}
+
 type MsgModProviderResponse struct {
 }
 
@@ -305,6 +322,14 @@ type MsgOpenContract struct {
 	SettlementDuration int64                 `protobuf:"varint,10,opt,name=settlement_duration,json=settlementDuration,proto3" json:"settlement_duration,omitempty"`
 	Authorization      ContractAuthorization `protobuf:"varint,11,opt,name=authorization,proto3,enum=arkeo.arkeo.ContractAuthorization" json:"authorization,omitempty"`
 	QueriesPerMinute   int64                 `protobuf:"varint,12,opt,name=queries_per_minute,json=queriesPerMinute,proto3" json:"queries_per_minute,omitempty"`
+	// auto_renew renews the subscription at its expiration, out of its top-up balance
+	AutoRenew bool `protobuf:"varint,13,opt,name=auto_renew,json=autoRenew,proto3" json:"auto_renew,omitempty"`
+	// payer funds the deposit instead of the creator, and gets the refund of the
+	// deposit at settlement. It signs the msg along with the creator.
+	Payer string `protobuf:"bytes,14,opt,name=payer,proto3" json:"payer,omitempty"`
+	// start_height schedules the contract to start at a later height, its
+	// deposit escrowed at once. Zero starts it right away.
+	StartHeight int64 `protobuf:"varint,15,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
 }
 
 func (m *MsgOpenContract) Reset()         { *m = MsgOpenContract{} }
@@ -417,6 +442,27 @@ func (m *MsgOpenContract) GetQueriesPerMinute() int64 {
 	return 0
 }
 
+func (m *MsgOpenContract) GetAutoRenew() bool {
+	if m != nil {
+		return m.AutoRenew
+	}
+	return false
+}
+
+func (m *MsgOpenContract) GetPayer() string {
+	if m != nil {
+		return m.Payer
+	}
+	return ""
+}
+
+func (m *MsgOpenContract) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
 type MsgOpenContractResponse struct {
 }
 
@@ -661,24 +707,27 @@ func (m *MsgClaimContractIncomeResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgClaimContractIncomeResponse proto.InternalMessageInfo
 
-// this line is used by starport scaffolding # proto/tx/message
-type MsgSetVersion struct {
-	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	Version int64  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+type MsgRenewContract struct {
+	Creator            string                `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ContractId         uint64                `protobuf:"varint,2,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	AdditionalDuration int64                 `protobuf:"varint,3,opt,name=additional_duration,json=additionalDuration,proto3" json:"additional_duration,omitempty"`
+	ExtraDeposit       cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=extra_deposit,json=extraDeposit,proto3,customtype=cosmossdk.io/math.Int" json:"extra_deposit"`
+	// rate is the current rate of the provider the client accepts, required when it changed since the contract opened
+	Rate types.Coin `protobuf:"bytes,5,opt,name=rate,proto3" json:"rate"`
 }
 
-func (m *MsgSetVersion) Reset()         { *m = MsgSetVersion{} }
-func (m *MsgSetVersion) String() string { return proto.CompactTextString(m) }
-func (*MsgSetVersion) ProtoMessage()    {}
-func (*MsgSetVersion) Descriptor() ([]byte, []int) {
+func (m *MsgRenewContract) Reset()         { *m = MsgRenewContract{} }
+func (m *MsgRenewContract) String() string { return proto.CompactTextString(m) }
+func (*MsgRenewContract) ProtoMessage()    {}
+func (*MsgRenewContract) Descriptor() ([]byte, []int) {
 	return fileDescriptor_a12700967a3e4015, []int{10}
 }
-func (m *MsgSetVersion) XXX_Unmarshal(b []byte) error {
+func (m *MsgRenewContract) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgSetVersion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgRenewContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgSetVersion.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgRenewContract.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -688,47 +737,61 @@ func (m *MsgSetVersion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *MsgSetVersion) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgSetVersion.Merge(m, src)
+func (m *MsgRenewContract) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRenewContract.Merge(m, src)
 }
-func (m *MsgSetVersion) XXX_Size() int {
+func (m *MsgRenewContract) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgSetVersion) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgSetVersion.DiscardUnknown(m)
+func (m *MsgRenewContract) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRenewContract.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgSetVersion proto.InternalMessageInfo
+var xxx_messageInfo_MsgRenewContract proto.InternalMessageInfo
 
-func (m *MsgSetVersion) GetCreator() string {
+func (m *MsgRenewContract) GetCreator() string {
 	if m != nil {
 		return m.Creator
 	}
 	return ""
 }
 
-func (m *MsgSetVersion) GetVersion() int64 {
+func (m *MsgRenewContract) GetContractId() uint64 {
 	if m != nil {
-		return m.Version
+		return m.ContractId
 	}
 	return 0
 }
 
-type MsgSetVersionResponse struct {
+func (m *MsgRenewContract) GetAdditionalDuration() int64 {
+	if m != nil {
+		return m.AdditionalDuration
+	}
+	return 0
 }
 
-func (m *MsgSetVersionResponse) Reset()         { *m = MsgSetVersionResponse{} }
-func (m *MsgSetVersionResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgSetVersionResponse) ProtoMessage()    {}
-func (*MsgSetVersionResponse) Descriptor() ([]byte, []int) {
+func (m *MsgRenewContract) GetRate() types.Coin {
+	if m != nil {
+		return m.Rate
+	}
+	return types.Coin{}
+}
+
+type MsgRenewContractResponse struct {
+}
+
+func (m *MsgRenewContractResponse) Reset()         { *m = MsgRenewContractResponse{} }
+func (m *MsgRenewContractResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRenewContractResponse) ProtoMessage()    {}
+func (*MsgRenewContractResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_a12700967a3e4015, []int{11}
 }
-func (m *MsgSetVersionResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgRenewContractResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgSetVersionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgRenewContractResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgSetVersionResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgRenewContractResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -738,1158 +801,4912 @@ func (m *MsgSetVersionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *MsgSetVersionResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgSetVersionResponse.Merge(m, src)
+func (m *MsgRenewContractResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRenewContractResponse.Merge(m, src)
 }
-func (m *MsgSetVersionResponse) XXX_Size() int {
+func (m *MsgRenewContractResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgSetVersionResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgSetVersionResponse.DiscardUnknown(m)
+func (m *MsgRenewContractResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRenewContractResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgSetVersionResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgRenewContractResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgBondProvider)(nil), "arkeo.arkeo.MsgBondProvider")
-	proto.RegisterType((*MsgBondProviderResponse)(nil), "arkeo.arkeo.MsgBondProviderResponse")
-	proto.RegisterType((*MsgModProvider)(nil), "arkeo.arkeo.MsgModProvider")
-	proto.RegisterType((*MsgModProviderResponse)(nil), "arkeo.arkeo.MsgModProviderResponse")
-	proto.RegisterType((*MsgOpenContract)(nil), "arkeo.arkeo.MsgOpenContract")
-	proto.RegisterType((*MsgOpenContractResponse)(nil), "arkeo.arkeo.MsgOpenContractResponse")
-	proto.RegisterType((*MsgCloseContract)(nil), "arkeo.arkeo.MsgCloseContract")
-	proto.RegisterType((*MsgCloseContractResponse)(nil), "arkeo.arkeo.MsgCloseContractResponse")
-	proto.RegisterType((*MsgClaimContractIncome)(nil), "arkeo.arkeo.MsgClaimContractIncome")
-	proto.RegisterType((*MsgClaimContractIncomeResponse)(nil), "arkeo.arkeo.MsgClaimContractIncomeResponse")
-	proto.RegisterType((*MsgSetVersion)(nil), "arkeo.arkeo.MsgSetVersion")
-	proto.RegisterType((*MsgSetVersionResponse)(nil), "arkeo.arkeo.MsgSetVersionResponse")
+type MsgProviderCloseContract struct {
+	Creator    string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ContractId uint64 `protobuf:"varint,2,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
 }
 
-func init() { proto.RegisterFile("arkeo/arkeo/tx.proto", fileDescriptor_a12700967a3e4015) }
-
-var fileDescriptor_a12700967a3e4015 = []byte{
-	// 1085 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x41, 0x4f, 0xdc, 0x46,
-	0x14, 0xc6, 0xd9, 0x05, 0xc2, 0xec, 0x42, 0xc9, 0x00, 0xc1, 0x2c, 0x64, 0xd9, 0x6e, 0x12, 0x09,
-	0x41, 0xf0, 0x8a, 0x45, 0xbd, 0x70, 0x68, 0x05, 0x34, 0x4a, 0x11, 0xd9, 0x06, 0x99, 0xa6, 0x52,
-	0x7b, 0x59, 0xcd, 0xda, 0x23, 0x33, 0x02, 0xcf, 0xb8, 0x33, 0x63, 0xca, 0xf6, 0x54, 0xf5, 0xd8,
-	0xaa, 0x52, 0xa5, 0xfe, 0x8f, 0x8a, 0x43, 0x7e, 0x44, 0x8e, 0x51, 0x4e, 0x51, 0x0f, 0x28, 0x82,
-	0x03, 0x97, 0xfe, 0x82, 0x9e, 0x2a, 0xdb, 0x63, 0xaf, 0xed, 0x5d, 0x28, 0x49, 0xd4, 0x5e, 0xbc,
-	0xfb, 0xde, 0xf7, 0xde, 0x9b, 0xf7, 0x3e, 0x7f, 0x33, 0x1e, 0x30, 0x8d, 0xf8, 0x21, 0x66, 0x8d,
-	0xe8, 0x29, 0x4f, 0x0c, 0x8f, 0x33, 0xc9, 0x60, 0x29, 0xb4, 0x8d, 0xf0, 0x59, 0x99, 0x76, 0x98,
-	0xc3, 0x42, 0x7f, 0x23, 0xf8, 0x17, 0x85, 0x54, 0xe6, 0x2c, 0x26, 0x5c, 0x26, 0xda, 0x11, 0x10,
-	0x19, 0x0a, 0xaa, 0x46, 0x56, 0xa3, 0x83, 0x04, 0x6e, 0x1c, 0xaf, 0x75, 0xb0, 0x44, 0x6b, 0x0d,
-	0x8b, 0x11, 0xaa, 0x70, 0x3d, 0xbd, 0xe6, 0x21, 0xc6, 0x1e, 0xe6, 0x0a, 0x99, 0x55, 0x99, 0xae,
-	0x70, 0x1a, 0xc7, 0x6b, 0xc1, 0x8f, 0x02, 0xee, 0x20, 0x97, 0x50, 0xd6, 0x08, 0x9f, 0x91, 0xab,
-	0xfe, 0x97, 0x06, 0x3e, 0x6a, 0x09, 0x67, 0x8b, 0x51, 0x7b, 0x8f, 0xb3, 0x63, 0x62, 0x63, 0x0e,
-	0x9b, 0x60, 0xd4, 0xe2, 0x18, 0x49, 0xc6, 0x75, 0xad, 0xa6, 0x2d, 0x8d, 0x6d, 0xe9, 0xaf, 0x5f,
-	0xac, 0x4e, 0xab, 0xe6, 0x36, 0x6d, 0x9b, 0x63, 0x21, 0xf6, 0x25, 0x27, 0xd4, 0x31, 0xe3, 0x40,
-	0x58, 0x01, 0xb7, 0x3d, 0x95, 0xaf, 0xdf, 0x0a, 0x92, 0xcc, 0xc4, 0x86, 0x3a, 0x18, 0x15, 0x98,
-	0x1f, 0x13, 0x0b, 0xeb, 0x85, 0x10, 0x8a, 0x4d, 0xf8, 0x19, 0x28, 0x76, 0x18, 0xb5, 0xf5, 0x62,
-	0xb8, 0xcc, 0xca, 0xcb, 0xb3, 0xc5, 0xa1, 0x3f, 0xcf, 0x16, 0x67, 0xa2, 0xa5, 0x84, 0x7d, 0x68,
-	0x10, 0xd6, 0x70, 0x91, 0x3c, 0x30, 0x76, 0xa8, 0x7c, 0xfd, 0x62, 0x15, 0xa8, 0x1e, 0x76, 0xa8,
-	0x34, 0xc3, 0xc4, 0x0d, 0xe3, 0xa7, 0xcb, 0xd3, 0xe5, 0xb8, 0x89, 0x9f, 0x2f, 0x4f, 0x97, 0xef,
-	0x45, 0x7c, 0x9c, 0x28, 0x5e, 0x72, 0xa3, 0xd5, 0xe7, 0xc0, 0x6c, 0xce, 0x65, 0x62, 0xe1, 0x31,
-	0x2a, 0x70, 0xfd, 0xd7, 0x61, 0x30, 0xd1, 0x12, 0x4e, 0x8b, 0x7d, 0x18, 0x11, 0xbb, 0x39, 0x22,
-	0xca, 0x5b, 0x8d, 0xbf, 0xcf, 0x16, 0x57, 0x1c, 0x22, 0x0f, 0xfc, 0x8e, 0x61, 0x31, 0x37, 0xea,
-	0x8c, 0x62, 0xf9, 0x3d, 0xe3, 0x87, 0xaa, 0x4d, 0x8b, 0xb9, 0x2e, 0xa3, 0xc6, 0x9e, 0xdf, 0xd9,
-	0xc5, 0xdd, 0x1b, 0x31, 0xf7, 0x31, 0x28, 0xbb, 0x58, 0x22, 0x1b, 0x49, 0xd4, 0xf6, 0x39, 0x89,
-	0x18, 0x34, 0x4b, 0xb1, 0xef, 0x39, 0x27, 0xf0, 0x21, 0x98, 0x48, 0x42, 0x28, 0xa3, 0x16, 0xd6,
-	0x87, 0x6b, 0xda, 0x52, 0xd1, 0x1c, 0x8f, 0xbd, 0x5f, 0x06, 0x4e, 0xb8, 0x0e, 0x46, 0x84, 0x44,
-	0xd2, 0x17, 0xfa, 0x48, 0x4d, 0x5b, 0x9a, 0x68, 0xce, 0x1b, 0x29, 0xd9, 0x1a, 0x31, 0x17, 0xfb,
-	0x61, 0x88, 0xa9, 0x42, 0x61, 0x13, 0xcc, 0xb8, 0x84, 0xb6, 0x2d, 0x46, 0x25, 0x47, 0x96, 0x6c,
-	0xdb, 0x3e, 0x47, 0x92, 0x30, 0xaa, 0x8f, 0xd6, 0xb4, 0xa5, 0x82, 0x39, 0xe5, 0x12, 0xba, 0xad,
-	0xb0, 0xcf, 0x15, 0x14, 0xe6, 0xa0, 0x93, 0x01, 0x39, 0xb7, 0x55, 0x0e, 0x3a, 0xe9, 0xcb, 0x79,
-	0x0a, 0xee, 0x08, 0xbf, 0x23, 0x2c, 0x4e, 0xbc, 0xc0, 0x6e, 0x73, 0x24, 0xb1, 0x3e, 0x56, 0x2b,
-	0x2c, 0x95, 0x9a, 0x73, 0x86, 0x7a, 0x11, 0xc1, 0x06, 0x31, 0xd4, 0x06, 0x31, 0xb6, 0x19, 0xa1,
-	0x5b, 0xc5, 0x40, 0x48, 0xe6, 0x64, 0x3a, 0xd3, 0x44, 0x12, 0xc3, 0x5d, 0x00, 0x3d, 0xd4, 0x6d,
-	0x23, 0xd1, 0xee, 0x32, 0xbf, 0xed, 0xb0, 0xa8, 0x1c, 0xb8, 0x59, 0xb9, 0x09, 0x0f, 0x75, 0x37,
-	0xc5, 0x37, 0xcc, 0x7f, 0xc2, 0xc2, 0x62, 0x0d, 0x30, 0x25, 0xb0, 0x94, 0x47, 0xd8, 0xc5, 0x34,
-	0x35, 0x4c, 0x29, 0x1c, 0x06, 0xf6, 0xa0, 0x78, 0x96, 0x8d, 0xd5, 0xbc, 0x56, 0x17, 0xfa, 0xb4,
-	0x9a, 0x12, 0x5f, 0x5d, 0x07, 0x77, 0xb3, 0x9e, 0x44, 0xa9, 0x6f, 0x8b, 0xe1, 0x9e, 0x7d, 0xe6,
-	0xe1, 0x84, 0xe4, 0xff, 0x71, 0xcf, 0xde, 0x05, 0x23, 0xd6, 0x11, 0xc1, 0x54, 0x2a, 0xcd, 0x29,
-	0x2b, 0xa8, 0x66, 0xe3, 0x23, 0xec, 0x04, 0x94, 0x0e, 0x47, 0xd5, 0x62, 0x1b, 0x7e, 0x0a, 0xc6,
-	0x93, 0xd7, 0x2e, 0xbb, 0x1e, 0x56, 0x52, 0x9b, 0xcb, 0x48, 0x2d, 0x9e, 0xe5, 0xab, 0xae, 0x87,
-	0xcd, 0xb2, 0x95, 0xb2, 0xc2, 0xda, 0x59, 0x85, 0x25, 0x36, 0x5c, 0x07, 0xc5, 0xf0, 0x35, 0x06,
-	0x2a, 0xba, 0xc1, 0x6b, 0x0c, 0x83, 0xe1, 0x63, 0x30, 0x6a, 0x63, 0x8f, 0x09, 0x22, 0xf5, 0xb1,
-	0x77, 0x3f, 0x7b, 0xe2, 0xdc, 0xab, 0x34, 0x00, 0xae, 0xd2, 0x00, 0xfc, 0x02, 0x8c, 0x23, 0x5f,
-	0x1e, 0x30, 0x4e, 0x7e, 0xe8, 0xc9, 0x65, 0xa2, 0x59, 0x1f, 0x48, 0xc4, 0x66, 0x3a, 0xd2, 0xcc,
-	0x26, 0xc2, 0x47, 0x00, 0x7e, 0xe7, 0x63, 0x4e, 0xb0, 0x68, 0x7b, 0x98, 0xb7, 0x5d, 0x42, 0x7d,
-	0x89, 0xf5, 0x72, 0xb8, 0xf2, 0xa4, 0x42, 0xf6, 0x30, 0x6f, 0x85, 0xfe, 0x9b, 0x9c, 0x93, 0x69,
-	0x39, 0xa9, 0x73, 0x32, 0xed, 0x4a, 0xd4, 0xf7, 0xc7, 0x2d, 0x30, 0xd9, 0x12, 0xce, 0xf6, 0x11,
-	0x13, 0xf8, 0x83, 0xe4, 0xb7, 0x08, 0x4a, 0x89, 0x28, 0x88, 0x1d, 0x2a, 0xb0, 0x68, 0x82, 0xd8,
-	0xb5, 0x63, 0xc3, 0x27, 0x89, 0xd2, 0x0a, 0xef, 0x77, 0x90, 0xc6, 0xd2, 0xdc, 0x4d, 0x49, 0xb3,
-	0xf8, 0x9e, 0x67, 0x72, 0x5c, 0x60, 0xa3, 0x91, 0xa7, 0xb2, 0xda, 0x47, 0x65, 0x86, 0x9b, 0x7a,
-	0x05, 0xe8, 0x79, 0x5f, 0x42, 0xe6, 0x1b, 0x2d, 0xdc, 0xe5, 0xdb, 0x47, 0x88, 0xb8, 0x31, 0xb8,
-	0x43, 0x2d, 0xe6, 0xe2, 0xff, 0x86, 0xd2, 0x05, 0x30, 0x26, 0x88, 0x43, 0x91, 0xf4, 0xb9, 0xa2,
-	0xc2, 0xec, 0x39, 0xe0, 0x34, 0x18, 0xee, 0x7d, 0x28, 0x0a, 0x66, 0x64, 0x6c, 0x7c, 0x92, 0x1f,
-	0xf8, 0xc1, 0x80, 0x81, 0xfb, 0xfa, 0xaf, 0xd7, 0x40, 0x75, 0x30, 0x92, 0x0c, 0xff, 0x8b, 0x06,
-	0xc6, 0x5b, 0xc2, 0xd9, 0xc7, 0xf2, 0x6b, 0xcc, 0x45, 0xf4, 0x89, 0x78, 0xf7, 0x99, 0x75, 0x30,
-	0x7a, 0x1c, 0xa5, 0x87, 0xf3, 0x16, 0xcc, 0xd8, 0xdc, 0x78, 0x94, 0x6f, 0x7c, 0xbe, 0xaf, 0xf1,
-	0xde, 0xda, 0xf5, 0x59, 0x30, 0x93, 0x71, 0xc4, 0x6d, 0x36, 0x7f, 0x2f, 0x82, 0x42, 0x4b, 0x38,
-	0xd0, 0x04, 0xe5, 0xcc, 0x35, 0x69, 0x21, 0xb3, 0x69, 0x73, 0xd7, 0x8a, 0xca, 0x83, 0xeb, 0xd0,
-	0xb8, 0x36, 0x7c, 0x06, 0x4a, 0xe9, 0x0b, 0xc7, 0x7c, 0x3e, 0x29, 0x05, 0x56, 0xee, 0x5f, 0x03,
-	0x26, 0x05, 0x4d, 0x50, 0xce, 0x7c, 0x17, 0xfa, 0x9a, 0x4c, 0xa3, 0xfd, 0x4d, 0x0e, 0xda, 0xf1,
-	0xf0, 0x39, 0x18, 0xcf, 0xee, 0xf6, 0x7b, 0xf9, 0xb4, 0x0c, 0x5c, 0x79, 0x78, 0x2d, 0x9c, 0x94,
-	0x75, 0xc0, 0xd4, 0x20, 0xdd, 0xdf, 0xef, 0xcf, 0xee, 0x0b, 0xaa, 0xac, 0xdc, 0x20, 0x28, 0x59,
-	0xe8, 0x29, 0x00, 0x29, 0x8d, 0x55, 0xf2, 0xa9, 0x3d, 0xac, 0x52, 0xbf, 0x1a, 0x8b, 0xab, 0x55,
-	0x86, 0x7f, 0xbc, 0x3c, 0x5d, 0xd6, 0xb6, 0x1e, 0xbf, 0x3c, 0xaf, 0x6a, 0xaf, 0xce, 0xab, 0xda,
-	0xdb, 0xf3, 0xaa, 0xf6, 0xdb, 0x45, 0x75, 0xe8, 0xd5, 0x45, 0x75, 0xe8, 0xcd, 0x45, 0x75, 0xe8,
-	0xdb, 0x7f, 0x39, 0x57, 0x62, 0xf5, 0x05, 0xdf, 0x41, 0xd1, 0x19, 0x09, 0xaf, 0xe1, 0xeb, 0xff,
-	0x04, 0x00, 0x00, 0xff, 0xff, 0xde, 0x1f, 0x86, 0xaf, 0x42, 0x0c, 0x00, 0x00,
+func (m *MsgProviderCloseContract) Reset()         { *m = MsgProviderCloseContract{} }
+func (m *MsgProviderCloseContract) String() string { return proto.CompactTextString(m) }
+func (*MsgProviderCloseContract) ProtoMessage()    {}
+func (*MsgProviderCloseContract) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a12700967a3e4015, []int{12}
+}
+func (m *MsgProviderCloseContract) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgProviderCloseContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgProviderCloseContract.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgProviderCloseContract) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgProviderCloseContract.Merge(m, src)
+}
+func (m *MsgProviderCloseContract) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgProviderCloseContract) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgProviderCloseContract.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_MsgProviderCloseContract proto.InternalMessageInfo
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	BondProvider(ctx context.Context, in *MsgBondProvider, opts ...grpc.CallOption) (*MsgBondProviderResponse, error)
-	ModProvider(ctx context.Context, in *MsgModProvider, opts ...grpc.CallOption) (*MsgModProviderResponse, error)
-	OpenContract(ctx context.Context, in *MsgOpenContract, opts ...grpc.CallOption) (*MsgOpenContractResponse, error)
-	CloseContract(ctx context.Context, in *MsgCloseContract, opts ...grpc.CallOption) (*MsgCloseContractResponse, error)
-	ClaimContractIncome(ctx context.Context, in *MsgClaimContractIncome, opts ...grpc.CallOption) (*MsgClaimContractIncomeResponse, error)
-	// this line is used by starport scaffolding # proto/tx/rpc
-	SetVersion(ctx context.Context, in *MsgSetVersion, opts ...grpc.CallOption) (*MsgSetVersionResponse, error)
+func (m *MsgProviderCloseContract) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgProviderCloseContract) GetContractId() uint64 {
+	if m != nil {
+		return m.ContractId
+	}
+	return 0
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+type MsgProviderCloseContractResponse struct {
 }
 
-func (c *msgClient) BondProvider(ctx context.Context, in *MsgBondProvider, opts ...grpc.CallOption) (*MsgBondProviderResponse, error) {
-	out := new(MsgBondProviderResponse)
-	err := c.cc.Invoke(ctx, "/arkeo.arkeo.Msg/BondProvider", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgProviderCloseContractResponse) Reset()         { *m = MsgProviderCloseContractResponse{} }
+func (m *MsgProviderCloseContractResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgProviderCloseContractResponse) ProtoMessage()    {}
+func (*MsgProviderCloseContractResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a12700967a3e4015, []int{13}
 }
-
-func (c *msgClient) ModProvider(ctx context.Context, in *MsgModProvider, opts ...grpc.CallOption) (*MsgModProviderResponse, error) {
-	out := new(MsgModProviderResponse)
-	err := c.cc.Invoke(ctx, "/arkeo.arkeo.Msg/ModProvider", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgProviderCloseContractResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgProviderCloseContractResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgProviderCloseContractResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgProviderCloseContractResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgProviderCloseContractResponse.Merge(m, src)
+}
+func (m *MsgProviderCloseContractResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgProviderCloseContractResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgProviderCloseContractResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) OpenContract(ctx context.Context, in *MsgOpenContract, opts ...grpc.CallOption) (*MsgOpenContractResponse, error) {
-	out := new(MsgOpenContractResponse)
-	err := c.cc.Invoke(ctx, "/arkeo.arkeo.Msg/OpenContract", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgProviderCloseContractResponse proto.InternalMessageInfo
+
+type MsgRotateDelegate struct {
+	Creator    string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ContractId uint64 `protobuf:"varint,2,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	// new_delegate is the pubkey spending the contract from now on, in place of its current delegate
+	NewDelegate github_com_arkeonetwork_arkeo_common.PubKey `protobuf:"bytes,3,opt,name=new_delegate,json=newDelegate,proto3,casttype=github.com/arkeonetwork/arkeo/common.PubKey" json:"new_delegate,omitempty"`
 }
 
-func (c *msgClient) CloseContract(ctx context.Context, in *MsgCloseContract, opts ...grpc.CallOption) (*MsgCloseContractResponse, error) {
-	out := new(MsgCloseContractResponse)
-	err := c.cc.Invoke(ctx, "/arkeo.arkeo.Msg/CloseContract", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRotateDelegate) Reset()         { *m = MsgRotateDelegate{} }
+func (m *MsgRotateDelegate) String() string { return proto.CompactTextString(m) }
+func (*MsgRotateDelegate) ProtoMessage()    {}
+func (*MsgRotateDelegate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a12700967a3e4015, []int{14}
+}
+func (m *MsgRotateDelegate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRotateDelegate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRotateDelegate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgRotateDelegate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRotateDelegate.Merge(m, src)
+}
+func (m *MsgRotateDelegate) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRotateDelegate) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRotateDelegate.DiscardUnknown(m)
 }
 
-func (c *msgClient) ClaimContractIncome(ctx context.Context, in *MsgClaimContractIncome, opts ...grpc.CallOption) (*MsgClaimContractIncomeResponse, error) {
-	out := new(MsgClaimContractIncomeResponse)
-	err := c.cc.Invoke(ctx, "/arkeo.arkeo.Msg/ClaimContractIncome", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgRotateDelegate proto.InternalMessageInfo
+
+func (m *MsgRotateDelegate) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) SetVersion(ctx context.Context, in *MsgSetVersion, opts ...grpc.CallOption) (*MsgSetVersionResponse, error) {
-	out := new(MsgSetVersionResponse)
-	err := c.cc.Invoke(ctx, "/arkeo.arkeo.Msg/SetVersion", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRotateDelegate) GetContractId() uint64 {
+	if m != nil {
+		return m.ContractId
 	}
-	return out, nil
+	return 0
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	BondProvider(context.Context, *MsgBondProvider) (*MsgBondProviderResponse, error)
-	ModProvider(context.Context, *MsgModProvider) (*MsgModProviderResponse, error)
-	OpenContract(context.Context, *MsgOpenContract) (*MsgOpenContractResponse, error)
-	CloseContract(context.Context, *MsgCloseContract) (*MsgCloseContractResponse, error)
-	ClaimContractIncome(context.Context, *MsgClaimContractIncome) (*MsgClaimContractIncomeResponse, error)
-	// this line is used by starport scaffolding # proto/tx/rpc
-	SetVersion(context.Context, *MsgSetVersion) (*MsgSetVersionResponse, error)
+func (m *MsgRotateDelegate) GetNewDelegate() github_com_arkeonetwork_arkeo_common.PubKey {
+	if m != nil {
+		return m.NewDelegate
+	}
+	return nil
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+type MsgRotateDelegateResponse struct {
 }
 
-func (*UnimplementedMsgServer) BondProvider(ctx context.Context, req *MsgBondProvider) (*MsgBondProviderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BondProvider not implemented")
+func (m *MsgRotateDelegateResponse) Reset()         { *m = MsgRotateDelegateResponse{} }
+func (m *MsgRotateDelegateResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRotateDelegateResponse) ProtoMessage()    {}
+func (*MsgRotateDelegateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a12700967a3e4015, []int{15}
 }
-func (*UnimplementedMsgServer) ModProvider(ctx context.Context, req *MsgModProvider) (*MsgModProviderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ModProvider not implemented")
+func (m *MsgRotateDelegateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) OpenContract(ctx context.Context, req *MsgOpenContract) (*MsgOpenContractResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OpenContract not implemented")
+func (m *MsgRotateDelegateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRotateDelegateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) CloseContract(ctx context.Context, req *MsgCloseContract) (*MsgCloseContractResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CloseContract not implemented")
+func (m *MsgRotateDelegateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRotateDelegateResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) ClaimContractIncome(ctx context.Context, req *MsgClaimContractIncome) (*MsgClaimContractIncomeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClaimContractIncome not implemented")
+func (m *MsgRotateDelegateResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) SetVersion(ctx context.Context, req *MsgSetVersion) (*MsgSetVersionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetVersion not implemented")
+func (m *MsgRotateDelegateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRotateDelegateResponse.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+var xxx_messageInfo_MsgRotateDelegateResponse proto.InternalMessageInfo
+
+type MsgSetAutoRenew struct {
+	Creator    string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ContractId uint64 `protobuf:"varint,2,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	// auto_renew turned off refunds the top-up balance of the contract
+	AutoRenew bool `protobuf:"varint,3,opt,name=auto_renew,json=autoRenew,proto3" json:"auto_renew,omitempty"`
 }
 
-func _Msg_BondProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgBondProvider)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).BondProvider(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/arkeo.arkeo.Msg/BondProvider",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).BondProvider(ctx, req.(*MsgBondProvider))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *MsgSetAutoRenew) Reset()         { *m = MsgSetAutoRenew{} }
+func (m *MsgSetAutoRenew) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAutoRenew) ProtoMessage()    {}
+func (*MsgSetAutoRenew) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a12700967a3e4015, []int{16}
 }
-
-func _Msg_ModProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgModProvider)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ModProvider(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/arkeo.arkeo.Msg/ModProvider",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ModProvider(ctx, req.(*MsgModProvider))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *MsgSetAutoRenew) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func _Msg_OpenContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgOpenContract)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).OpenContract(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/arkeo.arkeo.Msg/OpenContract",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).OpenContract(ctx, req.(*MsgOpenContract))
+func (m *MsgSetAutoRenew) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAutoRenew.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgSetAutoRenew) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAutoRenew.Merge(m, src)
+}
+func (m *MsgSetAutoRenew) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAutoRenew) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAutoRenew.DiscardUnknown(m)
 }
 
-func _Msg_CloseContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCloseContract)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CloseContract(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/arkeo.arkeo.Msg/CloseContract",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CloseContract(ctx, req.(*MsgCloseContract))
+var xxx_messageInfo_MsgSetAutoRenew proto.InternalMessageInfo
+
+func (m *MsgSetAutoRenew) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_ClaimContractIncome_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgClaimContractIncome)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ClaimContractIncome(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/arkeo.arkeo.Msg/ClaimContractIncome",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ClaimContractIncome(ctx, req.(*MsgClaimContractIncome))
+func (m *MsgSetAutoRenew) GetContractId() uint64 {
+	if m != nil {
+		return m.ContractId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_SetVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSetVersion)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SetVersion(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/arkeo.arkeo.Msg/SetVersion",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SetVersion(ctx, req.(*MsgSetVersion))
+func (m *MsgSetAutoRenew) GetAutoRenew() bool {
+	if m != nil {
+		return m.AutoRenew
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-var Msg_serviceDesc = _Msg_serviceDesc
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "arkeo.arkeo.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "BondProvider",
-			Handler:    _Msg_BondProvider_Handler,
-		},
-		{
-			MethodName: "ModProvider",
-			Handler:    _Msg_ModProvider_Handler,
-		},
-		{
-			MethodName: "OpenContract",
-			Handler:    _Msg_OpenContract_Handler,
-		},
-		{
-			MethodName: "CloseContract",
-			Handler:    _Msg_CloseContract_Handler,
-		},
-		{
-			MethodName: "ClaimContractIncome",
-			Handler:    _Msg_ClaimContractIncome_Handler,
-		},
-		{
-			MethodName: "SetVersion",
-			Handler:    _Msg_SetVersion_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "arkeo/arkeo/tx.proto",
+type MsgSetAutoRenewResponse struct {
 }
 
-func (m *MsgBondProvider) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetAutoRenewResponse) Reset()         { *m = MsgSetAutoRenewResponse{} }
+func (m *MsgSetAutoRenewResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAutoRenewResponse) ProtoMessage()    {}
+func (*MsgSetAutoRenewResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a12700967a3e4015, []int{17}
+}
+func (m *MsgSetAutoRenewResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAutoRenewResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAutoRenewResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgSetAutoRenewResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAutoRenewResponse.Merge(m, src)
+}
+func (m *MsgSetAutoRenewResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAutoRenewResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAutoRenewResponse.DiscardUnknown(m)
 }
 
-func (m *MsgBondProvider) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgSetAutoRenewResponse proto.InternalMessageInfo
+
+type MsgTopUpContract struct {
+	Creator    string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ContractId uint64 `protobuf:"varint,2,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	// deposit extends the contract by deposit/(rate*qpm) blocks, right away or at its expiration when it auto-renews
+	Deposit cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=deposit,proto3,customtype=cosmossdk.io/math.Int" json:"deposit"`
 }
 
-func (m *MsgBondProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Bond.Size()
-		i -= size
-		if _, err := m.Bond.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *MsgTopUpContract) Reset()         { *m = MsgTopUpContract{} }
+func (m *MsgTopUpContract) String() string { return proto.CompactTextString(m) }
+func (*MsgTopUpContract) ProtoMessage()    {}
+func (*MsgTopUpContract) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a12700967a3e4015, []int{18}
+}
+func (m *MsgTopUpContract) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTopUpContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTopUpContract.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	if len(m.Service) > 0 {
-		i -= len(m.Service)
-		copy(dAtA[i:], m.Service)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Service)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Provider) > 0 {
-		i -= len(m.Provider)
-		copy(dAtA[i:], m.Provider)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Provider)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgTopUpContract) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTopUpContract.Merge(m, src)
+}
+func (m *MsgTopUpContract) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTopUpContract) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTopUpContract.DiscardUnknown(m)
 }
 
-func (m *MsgBondProviderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgTopUpContract proto.InternalMessageInfo
+
+func (m *MsgTopUpContract) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgBondProviderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgTopUpContract) GetContractId() uint64 {
+	if m != nil {
+		return m.ContractId
+	}
+	return 0
 }
 
-func (m *MsgBondProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type MsgTopUpContractResponse struct {
 }
 
-func (m *MsgModProvider) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgTopUpContractResponse) Reset()         { *m = MsgTopUpContractResponse{} }
+func (m *MsgTopUpContractResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTopUpContractResponse) ProtoMessage()    {}
+func (*MsgTopUpContractResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a12700967a3e4015, []int{19}
 }
-
-func (m *MsgModProvider) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgTopUpContractResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *MsgModProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.SettlementDuration != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.SettlementDuration))
-		i--
-		dAtA[i] = 0x58
-	}
-	if len(m.PayAsYouGoRate) > 0 {
-		for iNdEx := len(m.PayAsYouGoRate) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PayAsYouGoRate[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x52
+func (m *MsgTopUpContractResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTopUpContractResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.SubscriptionRate) > 0 {
-		for iNdEx := len(m.SubscriptionRate) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.SubscriptionRate[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x4a
+}
+func (m *MsgTopUpContractResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTopUpContractResponse.Merge(m, src)
+}
+func (m *MsgTopUpContractResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTopUpContractResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTopUpContractResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTopUpContractResponse proto.InternalMessageInfo
+
+// ContractClaim claim of the income of a contract, as MsgClaimContractIncome
+type ContractClaim struct {
+	ContractId uint64 `protobuf:"varint,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	Nonce      int64  `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	Signature  []byte `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (m *ContractClaim) Reset()         { *m = ContractClaim{} }
+func (m *ContractClaim) String() string { return proto.CompactTextString(m) }
+func (*ContractClaim) ProtoMessage()    {}
+func (*ContractClaim) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a12700967a3e4015, []int{20}
+}
+func (m *ContractClaim) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractClaim) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractClaim.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.MaxContractDuration != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.MaxContractDuration))
-		i--
-		dAtA[i] = 0x40
-	}
-	if m.MinContractDuration != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.MinContractDuration))
-		i--
-		dAtA[i] = 0x38
-	}
-	if m.Status != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.MetadataNonce != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.MetadataNonce))
-		i--
-		dAtA[i] = 0x28
+}
+func (m *ContractClaim) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractClaim.Merge(m, src)
+}
+func (m *ContractClaim) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractClaim) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractClaim.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractClaim proto.InternalMessageInfo
+
+func (m *ContractClaim) GetContractId() uint64 {
+	if m != nil {
+		return m.ContractId
 	}
-	if len(m.MetadataUri) > 0 {
-		i -= len(m.MetadataUri)
-		copy(dAtA[i:], m.MetadataUri)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.MetadataUri)))
-		i--
-		dAtA[i] = 0x22
+	return 0
+}
+
+func (m *ContractClaim) GetNonce() int64 {
+	if m != nil {
+		return m.Nonce
 	}
-	if len(m.Service) > 0 {
-		i -= len(m.Service)
-		copy(dAtA[i:], m.Service)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Service)))
-		i--
-		dAtA[i] = 0x1a
+	return 0
+}
+
+func (m *ContractClaim) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
 	}
-	if len(m.Provider) > 0 {
-		i -= len(m.Provider)
-		copy(dAtA[i:], m.Provider)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Provider)))
-		i--
-		dAtA[i] = 0x12
+	return nil
+}
+
+type MsgClaimContractIncomeBatch struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// claims are processed in order, each one succeeding or failing on its own
+	Claims []ContractClaim `protobuf:"bytes,2,rep,name=claims,proto3" json:"claims"`
+}
+
+func (m *MsgClaimContractIncomeBatch) Reset()         { *m = MsgClaimContractIncomeBatch{} }
+func (m *MsgClaimContractIncomeBatch) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimContractIncomeBatch) ProtoMessage()    {}
+func (*MsgClaimContractIncomeBatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a12700967a3e4015, []int{21}
+}
+func (m *MsgClaimContractIncomeBatch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimContractIncomeBatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimContractIncomeBatch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgClaimContractIncomeBatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimContractIncomeBatch.Merge(m, src)
+}
+func (m *MsgClaimContractIncomeBatch) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimContractIncomeBatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimContractIncomeBatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimContractIncomeBatch proto.InternalMessageInfo
+
+func (m *MsgClaimContractIncomeBatch) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgModProviderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgClaimContractIncomeBatch) GetClaims() []ContractClaim {
+	if m != nil {
+		return m.Claims
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgModProviderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgClaimContractIncomeBatchResponse struct {
+	Results []ContractClaimResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results"`
 }
 
-func (m *MsgModProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgClaimContractIncomeBatchResponse) Reset()         { *m = MsgClaimContractIncomeBatchResponse{} }
+func (m *MsgClaimContractIncomeBatchResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimContractIncomeBatchResponse) ProtoMessage()    {}
+func (*MsgClaimContractIncomeBatchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a12700967a3e4015, []int{22}
+}
+func (m *MsgClaimContractIncomeBatchResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimContractIncomeBatchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimContractIncomeBatchResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimContractIncomeBatchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimContractIncomeBatchResponse.Merge(m, src)
+}
+func (m *MsgClaimContractIncomeBatchResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimContractIncomeBatchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimContractIncomeBatchResponse.DiscardUnknown(m)
 }
 
-func (m *MsgOpenContract) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgClaimContractIncomeBatchResponse proto.InternalMessageInfo
+
+func (m *MsgClaimContractIncomeBatchResponse) GetResults() []ContractClaimResult {
+	if m != nil {
+		return m.Results
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgOpenContract) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgPurgeExpiredContracts struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// contracts to purge, each one purged or failing on its own
+	ContractIds []uint64 `protobuf:"varint,2,rep,packed,name=contract_ids,json=contractIds,proto3" json:"contract_ids,omitempty"`
+	// without contract ids, purge the first contracts found purgeable, up to the limit
+	Limit uint64 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
 }
 
-func (m *MsgOpenContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.QueriesPerMinute != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.QueriesPerMinute))
-		i--
-		dAtA[i] = 0x60
+func (m *MsgPurgeExpiredContracts) Reset()         { *m = MsgPurgeExpiredContracts{} }
+func (m *MsgPurgeExpiredContracts) String() string { return proto.CompactTextString(m) }
+func (*MsgPurgeExpiredContracts) ProtoMessage()    {}
+func (*MsgPurgeExpiredContracts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a12700967a3e4015, []int{23}
+}
+func (m *MsgPurgeExpiredContracts) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPurgeExpiredContracts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPurgeExpiredContracts.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.Authorization != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Authorization))
-		i--
-		dAtA[i] = 0x58
+}
+func (m *MsgPurgeExpiredContracts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPurgeExpiredContracts.Merge(m, src)
+}
+func (m *MsgPurgeExpiredContracts) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPurgeExpiredContracts) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPurgeExpiredContracts.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPurgeExpiredContracts proto.InternalMessageInfo
+
+func (m *MsgPurgeExpiredContracts) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	if m.SettlementDuration != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.SettlementDuration))
-		i--
-		dAtA[i] = 0x50
+	return ""
+}
+
+func (m *MsgPurgeExpiredContracts) GetContractIds() []uint64 {
+	if m != nil {
+		return m.ContractIds
 	}
-	{
-		size := m.Deposit.Size()
-		i -= size
-		if _, err := m.Deposit.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	return nil
+}
+
+func (m *MsgPurgeExpiredContracts) GetLimit() uint64 {
+	if m != nil {
+		return m.Limit
 	}
-	i--
-	dAtA[i] = 0x4a
-	{
-		size, err := m.Rate.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x42
-	if m.Duration != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Duration))
-		i--
-		dAtA[i] = 0x38
-	}
-	if m.ContractType != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ContractType))
-		i--
-		dAtA[i] = 0x30
-	}
-	if len(m.Delegate) > 0 {
-		i -= len(m.Delegate)
-		copy(dAtA[i:], m.Delegate)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Delegate)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Client) > 0 {
-		i -= len(m.Client)
-		copy(dAtA[i:], m.Client)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Client)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Service) > 0 {
-		i -= len(m.Service)
-		copy(dAtA[i:], m.Service)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Service)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Provider) > 0 {
-		i -= len(m.Provider)
-		copy(dAtA[i:], m.Provider)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Provider)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *MsgOpenContractResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type MsgPurgeExpiredContractsResponse struct {
+	Results []ContractPurgeResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results"`
+	// paid to the creator for the contracts purged
+	Reward types.Coin `protobuf:"bytes,2,opt,name=reward,proto3" json:"reward"`
 }
 
-func (m *MsgOpenContractResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgPurgeExpiredContractsResponse) Reset()         { *m = MsgPurgeExpiredContractsResponse{} }
+func (m *MsgPurgeExpiredContractsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPurgeExpiredContractsResponse) ProtoMessage()    {}
+func (*MsgPurgeExpiredContractsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a12700967a3e4015, []int{24}
 }
-
-func (m *MsgOpenContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgPurgeExpiredContractsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *MsgCloseContract) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgPurgeExpiredContractsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPurgeExpiredContractsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgCloseContract) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgPurgeExpiredContractsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPurgeExpiredContractsResponse.Merge(m, src)
+}
+func (m *MsgPurgeExpiredContractsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPurgeExpiredContractsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPurgeExpiredContractsResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCloseContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Delegate) > 0 {
-		i -= len(m.Delegate)
-		copy(dAtA[i:], m.Delegate)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Delegate)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Client) > 0 {
-		i -= len(m.Client)
-		copy(dAtA[i:], m.Client)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Client)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.ContractId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ContractId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_MsgPurgeExpiredContractsResponse proto.InternalMessageInfo
+
+func (m *MsgPurgeExpiredContractsResponse) GetResults() []ContractPurgeResult {
+	if m != nil {
+		return m.Results
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *MsgCloseContractResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgPurgeExpiredContractsResponse) GetReward() types.Coin {
+	if m != nil {
+		return m.Reward
 	}
-	return dAtA[:n], nil
+	return types.Coin{}
 }
 
-func (m *MsgCloseContractResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgSetProviderAllowlist struct {
+	Creator  string                                      `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Provider github_com_arkeonetwork_arkeo_common.PubKey `protobuf:"bytes,2,opt,name=provider,proto3,casttype=github.com/arkeonetwork/arkeo/common.PubKey" json:"provider,omitempty"`
+	Service  string                                      `protobuf:"bytes,3,opt,name=service,proto3" json:"service,omitempty"`
+	Action   AllowlistAction                             `protobuf:"varint,4,opt,name=action,proto3,enum=arkeo.arkeo.AllowlistAction" json:"action,omitempty"`
+	// replacing with no clients opens the provider to all of them again
+	Clients []github_com_arkeonetwork_arkeo_common.PubKey `protobuf:"bytes,5,rep,name=clients,proto3,casttype=github.com/arkeonetwork/arkeo/common.PubKey" json:"clients,omitempty"`
 }
 
-func (m *MsgCloseContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgSetProviderAllowlist) Reset()         { *m = MsgSetProviderAllowlist{} }
+func (m *MsgSetProviderAllowlist) String() string { return proto.CompactTextString(m) }
+func (*MsgSetProviderAllowlist) ProtoMessage()    {}
+func (*MsgSetProviderAllowlist) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a12700967a3e4015, []int{25}
 }
-
-func (m *MsgClaimContractIncome) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetProviderAllowlist) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetProviderAllowlist) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetProviderAllowlist.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgClaimContractIncome) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetProviderAllowlist) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetProviderAllowlist.Merge(m, src)
+}
+func (m *MsgSetProviderAllowlist) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetProviderAllowlist) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetProviderAllowlist.DiscardUnknown(m)
 }
 
-func (m *MsgClaimContractIncome) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Nonce != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Nonce))
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.Signature) > 0 {
-		i -= len(m.Signature)
-		copy(dAtA[i:], m.Signature)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signature)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.ContractId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ContractId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_MsgSetProviderAllowlist proto.InternalMessageInfo
+
+func (m *MsgSetProviderAllowlist) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgClaimContractIncomeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetProviderAllowlist) GetProvider() github_com_arkeonetwork_arkeo_common.PubKey {
+	if m != nil {
+		return m.Provider
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgClaimContractIncomeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetProviderAllowlist) GetService() string {
+	if m != nil {
+		return m.Service
+	}
+	return ""
 }
 
-func (m *MsgClaimContractIncomeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgSetProviderAllowlist) GetAction() AllowlistAction {
+	if m != nil {
+		return m.Action
+	}
+	return AllowlistAction_ALLOWLIST_REPLACE
 }
 
-func (m *MsgSetVersion) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetProviderAllowlist) GetClients() []github_com_arkeonetwork_arkeo_common.PubKey {
+	if m != nil {
+		return m.Clients
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgSetVersion) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgSetProviderAllowlistResponse struct {
 }
 
-func (m *MsgSetVersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Version != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Version))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *MsgSetProviderAllowlistResponse) Reset()         { *m = MsgSetProviderAllowlistResponse{} }
+func (m *MsgSetProviderAllowlistResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetProviderAllowlistResponse) ProtoMessage()    {}
+func (*MsgSetProviderAllowlistResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a12700967a3e4015, []int{26}
 }
-
-func (m *MsgSetVersionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgSetProviderAllowlistResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *MsgSetVersionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetProviderAllowlistResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetProviderAllowlistResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func (m *MsgSetVersionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgSetProviderAllowlistResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetProviderAllowlistResponse.Merge(m, src)
 }
-
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *MsgSetProviderAllowlistResponse) XXX_Size() int {
+	return m.Size()
 }
-func (m *MsgBondProvider) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Provider)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Service)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.Bond.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+func (m *MsgSetProviderAllowlistResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetProviderAllowlistResponse.DiscardUnknown(m)
 }
 
-func (m *MsgBondProviderResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+var xxx_messageInfo_MsgSetProviderAllowlistResponse proto.InternalMessageInfo
+
+// this line is used by starport scaffolding # proto/tx/message
+type MsgSetVersion struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Version int64  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
 }
 
-func (m *MsgModProvider) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Provider)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Service)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.MetadataUri)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.MetadataNonce != 0 {
-		n += 1 + sovTx(uint64(m.MetadataNonce))
-	}
-	if m.Status != 0 {
-		n += 1 + sovTx(uint64(m.Status))
-	}
-	if m.MinContractDuration != 0 {
-		n += 1 + sovTx(uint64(m.MinContractDuration))
-	}
-	if m.MaxContractDuration != 0 {
-		n += 1 + sovTx(uint64(m.MaxContractDuration))
-	}
-	if len(m.SubscriptionRate) > 0 {
-		for _, e := range m.SubscriptionRate {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	if len(m.PayAsYouGoRate) > 0 {
-		for _, e := range m.PayAsYouGoRate {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+func (m *MsgSetVersion) Reset()         { *m = MsgSetVersion{} }
+func (m *MsgSetVersion) String() string { return proto.CompactTextString(m) }
+func (*MsgSetVersion) ProtoMessage()    {}
+func (*MsgSetVersion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a12700967a3e4015, []int{27}
+}
+func (m *MsgSetVersion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetVersion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetVersion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.SettlementDuration != 0 {
-		n += 1 + sovTx(uint64(m.SettlementDuration))
-	}
-	return n
 }
-
-func (m *MsgModProviderResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgSetVersion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetVersion.Merge(m, src)
 }
-
-func (m *MsgOpenContract) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Provider)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Service)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Client)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Delegate)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.ContractType != 0 {
-		n += 1 + sovTx(uint64(m.ContractType))
-	}
-	if m.Duration != 0 {
-		n += 1 + sovTx(uint64(m.Duration))
-	}
-	l = m.Rate.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.Deposit.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.SettlementDuration != 0 {
-		n += 1 + sovTx(uint64(m.SettlementDuration))
-	}
-	if m.Authorization != 0 {
-		n += 1 + sovTx(uint64(m.Authorization))
-	}
-	if m.QueriesPerMinute != 0 {
-		n += 1 + sovTx(uint64(m.QueriesPerMinute))
-	}
-	return n
+func (m *MsgSetVersion) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetVersion) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetVersion.DiscardUnknown(m)
 }
 
-func (m *MsgOpenContractResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgSetVersion proto.InternalMessageInfo
+
+func (m *MsgSetVersion) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	var l int
-	_ = l
-	return n
+	return ""
 }
 
-func (m *MsgCloseContract) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.ContractId != 0 {
-		n += 1 + sovTx(uint64(m.ContractId))
-	}
-	l = len(m.Client)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Delegate)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgSetVersion) GetVersion() int64 {
+	if m != nil {
+		return m.Version
 	}
-	return n
+	return 0
 }
 
-func (m *MsgCloseContractResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+type MsgSetVersionResponse struct {
 }
 
-func (m *MsgClaimContractIncome) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSetVersionResponse) Reset()         { *m = MsgSetVersionResponse{} }
+func (m *MsgSetVersionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetVersionResponse) ProtoMessage()    {}
+func (*MsgSetVersionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a12700967a3e4015, []int{28}
+}
+func (m *MsgSetVersionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetVersionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetVersionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgSetVersionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetVersionResponse.Merge(m, src)
+}
+func (m *MsgSetVersionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetVersionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetVersionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetVersionResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgBondProvider)(nil), "arkeo.arkeo.MsgBondProvider")
+	proto.RegisterType((*MsgBondProviderResponse)(nil), "arkeo.arkeo.MsgBondProviderResponse")
+	proto.RegisterType((*MsgModProvider)(nil), "arkeo.arkeo.MsgModProvider")
+	proto.RegisterType((*MsgModProviderResponse)(nil), "arkeo.arkeo.MsgModProviderResponse")
+	proto.RegisterType((*MsgOpenContract)(nil), "arkeo.arkeo.MsgOpenContract")
+	proto.RegisterType((*MsgOpenContractResponse)(nil), "arkeo.arkeo.MsgOpenContractResponse")
+	proto.RegisterType((*MsgCloseContract)(nil), "arkeo.arkeo.MsgCloseContract")
+	proto.RegisterType((*MsgCloseContractResponse)(nil), "arkeo.arkeo.MsgCloseContractResponse")
+	proto.RegisterType((*MsgClaimContractIncome)(nil), "arkeo.arkeo.MsgClaimContractIncome")
+	proto.RegisterType((*MsgClaimContractIncomeResponse)(nil), "arkeo.arkeo.MsgClaimContractIncomeResponse")
+	proto.RegisterType((*MsgRenewContract)(nil), "arkeo.arkeo.MsgRenewContract")
+	proto.RegisterType((*MsgRenewContractResponse)(nil), "arkeo.arkeo.MsgRenewContractResponse")
+	proto.RegisterType((*MsgProviderCloseContract)(nil), "arkeo.arkeo.MsgProviderCloseContract")
+	proto.RegisterType((*MsgProviderCloseContractResponse)(nil), "arkeo.arkeo.MsgProviderCloseContractResponse")
+	proto.RegisterType((*MsgRotateDelegate)(nil), "arkeo.arkeo.MsgRotateDelegate")
+	proto.RegisterType((*MsgRotateDelegateResponse)(nil), "arkeo.arkeo.MsgRotateDelegateResponse")
+	proto.RegisterType((*MsgSetAutoRenew)(nil), "arkeo.arkeo.MsgSetAutoRenew")
+	proto.RegisterType((*MsgSetAutoRenewResponse)(nil), "arkeo.arkeo.MsgSetAutoRenewResponse")
+	proto.RegisterType((*MsgTopUpContract)(nil), "arkeo.arkeo.MsgTopUpContract")
+	proto.RegisterType((*MsgTopUpContractResponse)(nil), "arkeo.arkeo.MsgTopUpContractResponse")
+	proto.RegisterType((*ContractClaim)(nil), "arkeo.arkeo.ContractClaim")
+	proto.RegisterType((*MsgClaimContractIncomeBatch)(nil), "arkeo.arkeo.MsgClaimContractIncomeBatch")
+	proto.RegisterType((*MsgClaimContractIncomeBatchResponse)(nil), "arkeo.arkeo.MsgClaimContractIncomeBatchResponse")
+	proto.RegisterType((*MsgPurgeExpiredContracts)(nil), "arkeo.arkeo.MsgPurgeExpiredContracts")
+	proto.RegisterType((*MsgPurgeExpiredContractsResponse)(nil), "arkeo.arkeo.MsgPurgeExpiredContractsResponse")
+	proto.RegisterType((*MsgSetProviderAllowlist)(nil), "arkeo.arkeo.MsgSetProviderAllowlist")
+	proto.RegisterType((*MsgSetProviderAllowlistResponse)(nil), "arkeo.arkeo.MsgSetProviderAllowlistResponse")
+	proto.RegisterType((*MsgSetVersion)(nil), "arkeo.arkeo.MsgSetVersion")
+	proto.RegisterType((*MsgSetVersionResponse)(nil), "arkeo.arkeo.MsgSetVersionResponse")
+}
+
+func init() { proto.RegisterFile("arkeo/arkeo/tx.proto", fileDescriptor_a12700967a3e4015) }
+
+var fileDescriptor_a12700967a3e4015 = []byte{
+	// 1744 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0xcf, 0x73, 0xdc, 0x48,
+	0x15, 0x8e, 0x3c, 0x63, 0x3b, 0x7e, 0x33, 0xf6, 0x26, 0x8a, 0xb3, 0x91, 0xe5, 0x64, 0x3c, 0x51,
+	0xe2, 0x2d, 0x93, 0xc4, 0x33, 0x1b, 0x07, 0x58, 0x6a, 0x0e, 0x80, 0x9d, 0xa4, 0x76, 0x5d, 0x59,
+	0xb3, 0x29, 0x65, 0x43, 0x01, 0x97, 0xa9, 0xf6, 0xa8, 0x6b, 0x2c, 0x3c, 0x52, 0x8b, 0xee, 0x96,
+	0x7f, 0x70, 0xa2, 0x38, 0xc2, 0x85, 0x7f, 0x80, 0x3b, 0x27, 0x2a, 0x54, 0x2d, 0x77, 0x6e, 0xec,
+	0x31, 0x95, 0xd3, 0x16, 0x45, 0x05, 0x2a, 0x39, 0xe4, 0x02, 0xfc, 0x01, 0x9c, 0x28, 0xb5, 0x5a,
+	0x6d, 0xfd, 0xf2, 0xac, 0x32, 0x29, 0x53, 0x7b, 0x99, 0xa4, 0xdf, 0xf7, 0x5e, 0xeb, 0xf5, 0xd7,
+	0x5f, 0xbf, 0xd7, 0x92, 0x61, 0x11, 0xd1, 0x7d, 0x4c, 0xba, 0xf1, 0x2f, 0x3f, 0xea, 0x04, 0x94,
+	0x70, 0xa2, 0x37, 0xc4, 0xb8, 0x23, 0x7e, 0xcd, 0xc5, 0x21, 0x19, 0x12, 0x61, 0xef, 0x46, 0xff,
+	0x8b, 0x5d, 0xcc, 0xa5, 0x01, 0x61, 0x1e, 0x61, 0xfd, 0x18, 0x88, 0x07, 0x12, 0x6a, 0xc5, 0xa3,
+	0xee, 0x2e, 0x62, 0xb8, 0x7b, 0x70, 0x77, 0x17, 0x73, 0x74, 0xb7, 0x3b, 0x20, 0xae, 0x2f, 0x71,
+	0x23, 0xfd, 0xcc, 0x7d, 0x8c, 0x03, 0x4c, 0x25, 0x72, 0x45, 0x46, 0x7a, 0x6c, 0xd8, 0x3d, 0xb8,
+	0x1b, 0xfd, 0x23, 0x81, 0x8b, 0xc8, 0x73, 0x7d, 0xd2, 0x15, 0xbf, 0xb1, 0xc9, 0xfa, 0x97, 0x06,
+	0xef, 0xed, 0xb0, 0xe1, 0x16, 0xf1, 0x9d, 0xc7, 0x94, 0x1c, 0xb8, 0x0e, 0xa6, 0xfa, 0x06, 0xcc,
+	0x0e, 0x28, 0x46, 0x9c, 0x50, 0x43, 0x6b, 0x6b, 0x6b, 0x73, 0x5b, 0xc6, 0x8b, 0x2f, 0xd6, 0x17,
+	0x65, 0x72, 0x9b, 0x8e, 0x43, 0x31, 0x63, 0x4f, 0x38, 0x75, 0xfd, 0xa1, 0x9d, 0x38, 0xea, 0x26,
+	0x9c, 0x0f, 0x64, 0xbc, 0x31, 0x15, 0x05, 0xd9, 0x6a, 0xac, 0x1b, 0x30, 0xcb, 0x30, 0x3d, 0x70,
+	0x07, 0xd8, 0xa8, 0x09, 0x28, 0x19, 0xea, 0x3f, 0x80, 0xfa, 0x2e, 0xf1, 0x1d, 0xa3, 0x2e, 0x1e,
+	0x73, 0xfb, 0xcb, 0x97, 0x2b, 0xe7, 0xfe, 0xf6, 0x72, 0xe5, 0x72, 0xfc, 0x28, 0xe6, 0xec, 0x77,
+	0x5c, 0xd2, 0xf5, 0x10, 0xdf, 0xeb, 0x6c, 0xfb, 0xfc, 0xc5, 0x17, 0xeb, 0x20, 0x73, 0xd8, 0xf6,
+	0xb9, 0x2d, 0x02, 0x7b, 0x9d, 0x5f, 0xbf, 0x79, 0x76, 0x2b, 0x49, 0xe2, 0x37, 0x6f, 0x9e, 0xdd,
+	0xba, 0x16, 0xf3, 0x71, 0x24, 0x79, 0xc9, 0x2d, 0xcd, 0x5a, 0x82, 0x2b, 0x39, 0x93, 0x8d, 0x59,
+	0x40, 0x7c, 0x86, 0xad, 0xbf, 0x4f, 0xc3, 0xc2, 0x0e, 0x1b, 0xee, 0x90, 0x77, 0x23, 0xe2, 0x51,
+	0x8e, 0x88, 0xe6, 0x56, 0xf7, 0xbf, 0x2f, 0x57, 0x6e, 0x0f, 0x5d, 0xbe, 0x17, 0xee, 0x76, 0x06,
+	0xc4, 0x8b, 0x33, 0xf3, 0x31, 0x3f, 0x24, 0x74, 0x5f, 0xa6, 0x39, 0x20, 0x9e, 0x47, 0xfc, 0xce,
+	0xe3, 0x70, 0xf7, 0x11, 0x3e, 0xae, 0xc4, 0xdc, 0x75, 0x68, 0x7a, 0x98, 0x23, 0x07, 0x71, 0xd4,
+	0x0f, 0xa9, 0x1b, 0x33, 0x68, 0x37, 0x12, 0xdb, 0x53, 0xea, 0xea, 0xab, 0xb0, 0xa0, 0x5c, 0x7c,
+	0xe2, 0x0f, 0xb0, 0x31, 0xdd, 0xd6, 0xd6, 0xea, 0xf6, 0x7c, 0x62, 0xfd, 0x51, 0x64, 0xd4, 0xef,
+	0xc1, 0x0c, 0xe3, 0x88, 0x87, 0xcc, 0x98, 0x69, 0x6b, 0x6b, 0x0b, 0x1b, 0xcb, 0x9d, 0x94, 0x6c,
+	0x3b, 0x09, 0x17, 0x4f, 0x84, 0x8b, 0x2d, 0x5d, 0xf5, 0x0d, 0xb8, 0xec, 0xb9, 0x7e, 0x7f, 0x40,
+	0x7c, 0x4e, 0xd1, 0x80, 0xf7, 0x9d, 0x90, 0x22, 0xee, 0x12, 0xdf, 0x98, 0x6d, 0x6b, 0x6b, 0x35,
+	0xfb, 0x92, 0xe7, 0xfa, 0xf7, 0x25, 0xf6, 0x40, 0x42, 0x22, 0x06, 0x1d, 0x95, 0xc4, 0x9c, 0x97,
+	0x31, 0xe8, 0xa8, 0x10, 0xf3, 0x29, 0x5c, 0x64, 0xe1, 0x2e, 0x1b, 0x50, 0x37, 0x88, 0xc6, 0x7d,
+	0x8a, 0x38, 0x36, 0xe6, 0xda, 0xb5, 0xb5, 0xc6, 0xc6, 0x52, 0x47, 0x6e, 0x44, 0x74, 0x40, 0x3a,
+	0xf2, 0x80, 0x74, 0xee, 0x13, 0xd7, 0xdf, 0xaa, 0x47, 0x42, 0xb2, 0x2f, 0xa4, 0x23, 0x6d, 0xc4,
+	0xb1, 0xfe, 0x08, 0xf4, 0x00, 0x1d, 0xf7, 0x11, 0xeb, 0x1f, 0x93, 0xb0, 0x3f, 0x24, 0xf1, 0x74,
+	0x50, 0x6d, 0xba, 0x85, 0x00, 0x1d, 0x6f, 0xb2, 0x9f, 0x92, 0xf0, 0x63, 0x22, 0x26, 0xeb, 0xc2,
+	0x25, 0x86, 0x39, 0x1f, 0x61, 0x0f, 0xfb, 0xa9, 0xc5, 0x34, 0xc4, 0x62, 0xf4, 0x13, 0x48, 0xad,
+	0x65, 0x05, 0x1a, 0x61, 0xe0, 0x20, 0x8e, 0xfb, 0x1e, 0x62, 0xfb, 0x46, 0xb3, 0x5d, 0x5b, 0x9b,
+	0xb3, 0x21, 0x36, 0xed, 0x20, 0xb6, 0xaf, 0xdf, 0x01, 0x3d, 0x22, 0x88, 0x04, 0xf8, 0x84, 0x59,
+	0x66, 0xcc, 0x8b, 0x4d, 0xbb, 0xe0, 0xa1, 0xa3, 0xcf, 0x02, 0xac, 0x58, 0x65, 0xbd, 0xf5, 0xbc,
+	0xf4, 0xaf, 0x16, 0xa4, 0x9f, 0xd2, 0xb2, 0x65, 0xc0, 0xfb, 0x59, 0x8b, 0x12, 0xfe, 0x8b, 0x69,
+	0x51, 0x02, 0xd2, 0xb3, 0xff, 0x1f, 0x4b, 0xc0, 0xfb, 0x30, 0x33, 0x18, 0xb9, 0xd8, 0xe7, 0x52,
+	0xc2, 0x72, 0x14, 0xcd, 0xe6, 0xe0, 0x11, 0x1e, 0x22, 0x1e, 0xeb, 0x76, 0xce, 0x56, 0x63, 0xfd,
+	0xfb, 0x30, 0xaf, 0x54, 0xc4, 0x8f, 0x03, 0x2c, 0x95, 0xbb, 0x94, 0x51, 0x6e, 0xb2, 0x96, 0xcf,
+	0x8f, 0x03, 0x6c, 0x37, 0x07, 0xa9, 0x91, 0x98, 0x3b, 0x2b, 0x58, 0x35, 0xd6, 0xef, 0x41, 0x5d,
+	0xa8, 0x22, 0x12, 0x65, 0x05, 0x55, 0x08, 0x67, 0xfd, 0x21, 0xcc, 0x3a, 0x38, 0x20, 0xcc, 0xe5,
+	0xc6, 0xdc, 0xdb, 0x97, 0xb2, 0x24, 0xf6, 0x34, 0x49, 0xc1, 0xa9, 0x92, 0xfa, 0x04, 0xe6, 0x51,
+	0xc8, 0xf7, 0x08, 0x75, 0x7f, 0x79, 0xa2, 0xbe, 0x85, 0x0d, 0xab, 0x94, 0x88, 0xcd, 0xb4, 0xa7,
+	0x9d, 0x0d, 0x8c, 0xb4, 0xf7, 0x8b, 0x10, 0x53, 0x17, 0xb3, 0x7e, 0x80, 0x69, 0xdf, 0x73, 0xfd,
+	0x90, 0x63, 0xa3, 0x29, 0x9e, 0x7c, 0x41, 0x22, 0x8f, 0x31, 0xdd, 0x11, 0x76, 0xfd, 0x1a, 0x00,
+	0x0a, 0x39, 0xe9, 0x53, 0xec, 0xe3, 0x43, 0xa1, 0xd0, 0xf3, 0xf6, 0x5c, 0x64, 0xb1, 0x23, 0x83,
+	0xde, 0x81, 0xe9, 0x00, 0x1d, 0x63, 0x6a, 0x2c, 0x7c, 0x8d, 0x76, 0x62, 0xb7, 0xa8, 0x98, 0x31,
+	0x8e, 0x28, 0xef, 0xef, 0x61, 0x77, 0xb8, 0xc7, 0x8d, 0xf7, 0xc4, 0x63, 0x1b, 0xc2, 0xf6, 0x89,
+	0x30, 0x55, 0x29, 0xf4, 0x69, 0x01, 0xcb, 0x42, 0x9f, 0x36, 0x29, 0xbd, 0xff, 0x71, 0x0a, 0x2e,
+	0xec, 0xb0, 0xe1, 0xfd, 0x11, 0x61, 0xf8, 0x9d, 0x04, 0xbf, 0x02, 0x0d, 0x25, 0x43, 0xd7, 0x11,
+	0x9a, 0xaf, 0xdb, 0x90, 0x98, 0xb6, 0x1d, 0xfd, 0x63, 0xa5, 0xed, 0xda, 0x64, 0x9d, 0x20, 0x39,
+	0x0c, 0x8f, 0x52, 0x87, 0xa1, 0x3e, 0x61, 0x53, 0x49, 0x26, 0xe8, 0x75, 0xf3, 0x54, 0xb6, 0x0a,
+	0x54, 0x66, 0xb8, 0xb1, 0x4c, 0x30, 0xf2, 0x36, 0x45, 0xe6, 0x57, 0x9a, 0xa8, 0x2b, 0xf7, 0x47,
+	0xc8, 0xf5, 0x12, 0x70, 0xdb, 0x1f, 0x10, 0x0f, 0x9f, 0x0d, 0xa5, 0x57, 0x61, 0x8e, 0xb9, 0x43,
+	0x1f, 0xf1, 0x90, 0x4a, 0x2a, 0xec, 0x13, 0x83, 0xbe, 0x08, 0xd3, 0x27, 0x9d, 0xae, 0x66, 0xc7,
+	0x83, 0xde, 0x77, 0xf2, 0x0b, 0xbe, 0x59, 0xb2, 0xe0, 0x42, 0xfe, 0x56, 0x1b, 0x5a, 0xe5, 0x88,
+	0x5a, 0xfc, 0xf3, 0x58, 0x49, 0x42, 0xf4, 0x67, 0xab, 0xa4, 0x2e, 0x5c, 0x42, 0x8e, 0xe3, 0x46,
+	0x47, 0x15, 0x8d, 0x4e, 0x2a, 0x43, 0x2d, 0xae, 0x0c, 0x27, 0x90, 0xaa, 0x0c, 0x8f, 0x61, 0x1e,
+	0x1f, 0x71, 0x8a, 0xfa, 0x49, 0x5d, 0x9a, 0xe0, 0x8a, 0xd5, 0x14, 0x33, 0x3c, 0x90, 0xc5, 0x29,
+	0x29, 0x8c, 0xd3, 0x6f, 0x51, 0x18, 0xab, 0x68, 0x2d, 0xc3, 0x9e, 0xd4, 0x5a, 0xc6, 0xa6, 0xe8,
+	0xfe, 0x83, 0x26, 0xc0, 0xa4, 0x81, 0x9d, 0xfd, 0x01, 0xee, 0x7d, 0x94, 0x4f, 0xff, 0x83, 0x42,
+	0xfa, 0xa5, 0xd9, 0x58, 0x16, 0xb4, 0x4f, 0xc3, 0xd4, 0x72, 0xfe, 0xa3, 0xc1, 0xc5, 0x68, 0xad,
+	0x84, 0x23, 0x8e, 0x1f, 0x24, 0xbd, 0xed, 0x4c, 0xe4, 0x63, 0x43, 0xd3, 0xc7, 0x87, 0x7d, 0x55,
+	0x43, 0x26, 0x2c, 0x47, 0x0d, 0x1f, 0x1f, 0x26, 0x89, 0xf6, 0x3e, 0xcc, 0x73, 0xb3, 0x52, 0xdc,
+	0xda, 0xcc, 0xd2, 0xac, 0x65, 0x58, 0x2a, 0x18, 0x15, 0x1b, 0x7f, 0x8e, 0x5f, 0x44, 0x9e, 0x60,
+	0xbe, 0xa9, 0xfa, 0xc8, 0x99, 0x70, 0x91, 0xed, 0x5d, 0xb5, 0x5c, 0xef, 0xaa, 0xd2, 0x68, 0xd2,
+	0x39, 0xca, 0x46, 0x93, 0x36, 0xa9, 0x25, 0xfd, 0x43, 0x13, 0xe5, 0xe1, 0x73, 0x12, 0x3c, 0x0d,
+	0xce, 0xb6, 0x3c, 0xa4, 0xee, 0x1f, 0xb5, 0xc9, 0xef, 0x1f, 0x55, 0x4e, 0x6b, 0x66, 0x31, 0xf2,
+	0xb4, 0x66, 0x6c, 0x6a, 0xf5, 0x0e, 0xcc, 0x27, 0x36, 0x51, 0x43, 0xf3, 0xab, 0xd0, 0x0a, 0xab,
+	0x50, 0xd5, 0x7b, 0x2a, 0x55, 0xbd, 0xb3, 0x15, 0xbf, 0x96, 0xab, 0xf8, 0xd6, 0x5f, 0x35, 0x58,
+	0x2e, 0xaf, 0xd2, 0x5b, 0x88, 0x0f, 0xf6, 0x26, 0xa2, 0xfb, 0x7b, 0x51, 0xdb, 0x46, 0xae, 0xc7,
+	0x8c, 0x29, 0xf1, 0x6a, 0x60, 0x96, 0x5e, 0xa7, 0xc4, 0x23, 0x65, 0xb1, 0x93, 0xfe, 0xbd, 0x5e,
+	0x9e, 0xc0, 0x6f, 0x55, 0xe9, 0x34, 0x22, 0x53, 0x6b, 0x08, 0x37, 0xc6, 0xc0, 0x09, 0xad, 0xfa,
+	0x0f, 0x61, 0x96, 0x62, 0x16, 0x8e, 0x38, 0x33, 0x34, 0x91, 0x5d, 0xfb, 0xf4, 0xec, 0x6c, 0xe1,
+	0x28, 0x73, 0x4c, 0xc2, 0xac, 0xbf, 0xc8, 0x32, 0x1a, 0xd2, 0x21, 0x7e, 0x78, 0x14, 0xb8, 0x14,
+	0x3b, 0x49, 0x14, 0x9b, 0x88, 0xaf, 0xeb, 0xd0, 0x4c, 0x6d, 0x6c, 0xcc, 0x5a, 0xdd, 0x6e, 0x9c,
+	0xec, 0x2c, 0x8b, 0xb6, 0x76, 0xe4, 0x7a, 0x52, 0x9e, 0x75, 0x3b, 0x1e, 0x54, 0x2a, 0xaf, 0x65,
+	0x59, 0x5a, 0xbf, 0xd7, 0xa0, 0x7d, 0x1a, 0xf8, 0xb6, 0x4c, 0x89, 0x49, 0x4a, 0x99, 0xd2, 0x3f,
+	0x82, 0x19, 0x8a, 0x0f, 0x11, 0x8d, 0x8f, 0x5c, 0x85, 0xa6, 0x27, 0xdd, 0xad, 0x7f, 0x4f, 0x25,
+	0x55, 0x21, 0x69, 0x01, 0x9b, 0xa3, 0x11, 0x39, 0x1c, 0xb9, 0x8c, 0x7f, 0x93, 0x3f, 0x2a, 0x7c,
+	0x1b, 0x66, 0xd0, 0x40, 0x5c, 0x2c, 0xea, 0xe2, 0x3d, 0xe2, 0x6a, 0x86, 0x30, 0xb5, 0x84, 0x4d,
+	0xe1, 0x63, 0x4b, 0x5f, 0x7d, 0x1b, 0x66, 0xe3, 0x6b, 0x2a, 0x33, 0xa6, 0xdb, 0xb5, 0x49, 0x72,
+	0x4b, 0xe2, 0x7b, 0xdf, 0xcd, 0x0b, 0x62, 0xb5, 0xac, 0xf8, 0x16, 0x38, 0xb5, 0xae, 0xc3, 0xca,
+	0x29, 0x90, 0x2a, 0x47, 0xbf, 0xd5, 0x60, 0x3e, 0xf6, 0xf9, 0x31, 0xa6, 0x2c, 0xfe, 0x1e, 0xf1,
+	0xf6, 0x1b, 0x61, 0xc0, 0xec, 0x41, 0x1c, 0x2e, 0x8b, 0x54, 0x32, 0xec, 0xdd, 0xc9, 0xa7, 0xbe,
+	0x5c, 0x96, 0xba, 0x7c, 0xb6, 0x75, 0x05, 0x2e, 0x67, 0x0c, 0x49, 0x9a, 0x1b, 0x7f, 0x02, 0xa8,
+	0xed, 0xb0, 0x61, 0xd4, 0xb1, 0x33, 0xdf, 0xe4, 0xb2, 0x5b, 0x91, 0xfb, 0x86, 0x65, 0xde, 0x1c,
+	0x87, 0xaa, 0x03, 0xf1, 0x19, 0x34, 0xd2, 0x5f, 0xb7, 0x96, 0xf3, 0x41, 0x29, 0xd0, 0xbc, 0x31,
+	0x06, 0x54, 0x13, 0xda, 0xd0, 0xcc, 0x7c, 0x35, 0x28, 0x24, 0x99, 0x46, 0xcd, 0x9b, 0xe3, 0x50,
+	0x35, 0xe7, 0x53, 0x98, 0xcf, 0x5e, 0xec, 0xae, 0xe5, 0xc3, 0x32, 0xb0, 0xb9, 0x3a, 0x16, 0x56,
+	0xd3, 0x0e, 0xe1, 0x52, 0xd9, 0x3b, 0xca, 0x8d, 0x62, 0x74, 0xc1, 0xc9, 0xbc, 0x5d, 0xc1, 0x29,
+	0x9d, 0x7f, 0xf6, 0x7d, 0xa0, 0x90, 0x7f, 0x06, 0x36, 0x57, 0xc7, 0xc2, 0x6a, 0x5a, 0x0f, 0x2e,
+	0x97, 0xdf, 0x7b, 0x0b, 0xf1, 0xa5, 0x6e, 0xe6, 0x7a, 0x25, 0x37, 0xf5, 0xb8, 0x9f, 0xc0, 0x42,
+	0xee, 0x5e, 0xda, 0x2a, 0xe4, 0x99, 0xc1, 0xcd, 0x0f, 0xc6, 0xe3, 0x69, 0xcd, 0x64, 0xee, 0x78,
+	0x05, 0xcd, 0xa4, 0x51, 0xf3, 0xe6, 0x38, 0x34, 0xcd, 0x79, 0xf6, 0x92, 0x55, 0xe0, 0x3c, 0x03,
+	0x9b, 0xab, 0x63, 0x61, 0x35, 0xed, 0x01, 0x18, 0xa7, 0xde, 0x2b, 0xd6, 0x2a, 0x68, 0x42, 0x78,
+	0x9a, 0x1f, 0x56, 0xf5, 0xcc, 0xec, 0x75, 0x69, 0x73, 0x2e, 0xee, 0x75, 0x99, 0x9b, 0xb9, 0x5e,
+	0xc9, 0x4d, 0x3d, 0xee, 0xe7, 0xb0, 0x58, 0xda, 0xa8, 0xca, 0xb8, 0x2f, 0x78, 0x99, 0x77, 0xaa,
+	0x78, 0xa9, 0x67, 0x7d, 0x0a, 0x90, 0xaa, 0xc0, 0x66, 0x49, 0xac, 0xc4, 0x4c, 0xeb, 0x74, 0x2c,
+	0x99, 0xcd, 0x9c, 0xfe, 0xd5, 0x9b, 0x67, 0xb7, 0xb4, 0xad, 0x87, 0x5f, 0xbe, 0x6a, 0x69, 0xcf,
+	0x5f, 0xb5, 0xb4, 0x7f, 0xbe, 0x6a, 0x69, 0xbf, 0x7b, 0xdd, 0x3a, 0xf7, 0xfc, 0x75, 0xeb, 0xdc,
+	0x57, 0xaf, 0x5b, 0xe7, 0x7e, 0xf6, 0x35, 0x5d, 0x28, 0xa9, 0xcd, 0xd1, 0x37, 0x44, 0xb6, 0x3b,
+	0x23, 0xfe, 0x22, 0x72, 0xef, 0x7f, 0x03, 0x00, 0x58, 0x21, 0x75, 0x9e, 0xcd, 0x19, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	BondProvider(ctx context.Context, in *MsgBondProvider, opts ...grpc.CallOption) (*MsgBondProviderResponse, error)
+	ModProvider(ctx context.Context, in *MsgModProvider, opts ...grpc.CallOption) (*MsgModProviderResponse, error)
+	OpenContract(ctx context.Context, in *MsgOpenContract, opts ...grpc.CallOption) (*MsgOpenContractResponse, error)
+	CloseContract(ctx context.Context, in *MsgCloseContract, opts ...grpc.CallOption) (*MsgCloseContractResponse, error)
+	ClaimContractIncome(ctx context.Context, in *MsgClaimContractIncome, opts ...grpc.CallOption) (*MsgClaimContractIncomeResponse, error)
+	RenewContract(ctx context.Context, in *MsgRenewContract, opts ...grpc.CallOption) (*MsgRenewContractResponse, error)
+	ProviderCloseContract(ctx context.Context, in *MsgProviderCloseContract, opts ...grpc.CallOption) (*MsgProviderCloseContractResponse, error)
+	RotateDelegate(ctx context.Context, in *MsgRotateDelegate, opts ...grpc.CallOption) (*MsgRotateDelegateResponse, error)
+	SetAutoRenew(ctx context.Context, in *MsgSetAutoRenew, opts ...grpc.CallOption) (*MsgSetAutoRenewResponse, error)
+	TopUpContract(ctx context.Context, in *MsgTopUpContract, opts ...grpc.CallOption) (*MsgTopUpContractResponse, error)
+	ClaimContractIncomeBatch(ctx context.Context, in *MsgClaimContractIncomeBatch, opts ...grpc.CallOption) (*MsgClaimContractIncomeBatchResponse, error)
+	PurgeExpiredContracts(ctx context.Context, in *MsgPurgeExpiredContracts, opts ...grpc.CallOption) (*MsgPurgeExpiredContractsResponse, error)
+	SetProviderAllowlist(ctx context.Context, in *MsgSetProviderAllowlist, opts ...grpc.CallOption) (*MsgSetProviderAllowlistResponse, error)
+	// this line is used by starport scaffolding # proto/tx/rpc
+	SetVersion(ctx context.Context, in *MsgSetVersion, opts ...grpc.CallOption) (*MsgSetVersionResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) BondProvider(ctx context.Context, in *MsgBondProvider, opts ...grpc.CallOption) (*MsgBondProviderResponse, error) {
+	out := new(MsgBondProviderResponse)
+	err := c.cc.Invoke(ctx, "/arkeo.arkeo.Msg/BondProvider", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.ContractId != 0 {
-		n += 1 + sovTx(uint64(m.ContractId))
+	return out, nil
+}
+
+func (c *msgClient) ModProvider(ctx context.Context, in *MsgModProvider, opts ...grpc.CallOption) (*MsgModProviderResponse, error) {
+	out := new(MsgModProviderResponse)
+	err := c.cc.Invoke(ctx, "/arkeo.arkeo.Msg/ModProvider", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Signature)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) OpenContract(ctx context.Context, in *MsgOpenContract, opts ...grpc.CallOption) (*MsgOpenContractResponse, error) {
+	out := new(MsgOpenContractResponse)
+	err := c.cc.Invoke(ctx, "/arkeo.arkeo.Msg/OpenContract", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Nonce != 0 {
-		n += 1 + sovTx(uint64(m.Nonce))
+	return out, nil
+}
+
+func (c *msgClient) CloseContract(ctx context.Context, in *MsgCloseContract, opts ...grpc.CallOption) (*MsgCloseContractResponse, error) {
+	out := new(MsgCloseContractResponse)
+	err := c.cc.Invoke(ctx, "/arkeo.arkeo.Msg/CloseContract", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgClaimContractIncomeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) ClaimContractIncome(ctx context.Context, in *MsgClaimContractIncome, opts ...grpc.CallOption) (*MsgClaimContractIncomeResponse, error) {
+	out := new(MsgClaimContractIncomeResponse)
+	err := c.cc.Invoke(ctx, "/arkeo.arkeo.Msg/ClaimContractIncome", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *MsgSetVersion) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) RenewContract(ctx context.Context, in *MsgRenewContract, opts ...grpc.CallOption) (*MsgRenewContractResponse, error) {
+	out := new(MsgRenewContractResponse)
+	err := c.cc.Invoke(ctx, "/arkeo.arkeo.Msg/RenewContract", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) ProviderCloseContract(ctx context.Context, in *MsgProviderCloseContract, opts ...grpc.CallOption) (*MsgProviderCloseContractResponse, error) {
+	out := new(MsgProviderCloseContractResponse)
+	err := c.cc.Invoke(ctx, "/arkeo.arkeo.Msg/ProviderCloseContract", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Version != 0 {
-		n += 1 + sovTx(uint64(m.Version))
+	return out, nil
+}
+
+func (c *msgClient) RotateDelegate(ctx context.Context, in *MsgRotateDelegate, opts ...grpc.CallOption) (*MsgRotateDelegateResponse, error) {
+	out := new(MsgRotateDelegateResponse)
+	err := c.cc.Invoke(ctx, "/arkeo.arkeo.Msg/RotateDelegate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetAutoRenew(ctx context.Context, in *MsgSetAutoRenew, opts ...grpc.CallOption) (*MsgSetAutoRenewResponse, error) {
+	out := new(MsgSetAutoRenewResponse)
+	err := c.cc.Invoke(ctx, "/arkeo.arkeo.Msg/SetAutoRenew", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) TopUpContract(ctx context.Context, in *MsgTopUpContract, opts ...grpc.CallOption) (*MsgTopUpContractResponse, error) {
+	out := new(MsgTopUpContractResponse)
+	err := c.cc.Invoke(ctx, "/arkeo.arkeo.Msg/TopUpContract", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClaimContractIncomeBatch(ctx context.Context, in *MsgClaimContractIncomeBatch, opts ...grpc.CallOption) (*MsgClaimContractIncomeBatchResponse, error) {
+	out := new(MsgClaimContractIncomeBatchResponse)
+	err := c.cc.Invoke(ctx, "/arkeo.arkeo.Msg/ClaimContractIncomeBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) PurgeExpiredContracts(ctx context.Context, in *MsgPurgeExpiredContracts, opts ...grpc.CallOption) (*MsgPurgeExpiredContractsResponse, error) {
+	out := new(MsgPurgeExpiredContractsResponse)
+	err := c.cc.Invoke(ctx, "/arkeo.arkeo.Msg/PurgeExpiredContracts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetProviderAllowlist(ctx context.Context, in *MsgSetProviderAllowlist, opts ...grpc.CallOption) (*MsgSetProviderAllowlistResponse, error) {
+	out := new(MsgSetProviderAllowlistResponse)
+	err := c.cc.Invoke(ctx, "/arkeo.arkeo.Msg/SetProviderAllowlist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetVersion(ctx context.Context, in *MsgSetVersion, opts ...grpc.CallOption) (*MsgSetVersionResponse, error) {
+	out := new(MsgSetVersionResponse)
+	err := c.cc.Invoke(ctx, "/arkeo.arkeo.Msg/SetVersion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	BondProvider(context.Context, *MsgBondProvider) (*MsgBondProviderResponse, error)
+	ModProvider(context.Context, *MsgModProvider) (*MsgModProviderResponse, error)
+	OpenContract(context.Context, *MsgOpenContract) (*MsgOpenContractResponse, error)
+	CloseContract(context.Context, *MsgCloseContract) (*MsgCloseContractResponse, error)
+	ClaimContractIncome(context.Context, *MsgClaimContractIncome) (*MsgClaimContractIncomeResponse, error)
+	RenewContract(context.Context, *MsgRenewContract) (*MsgRenewContractResponse, error)
+	ProviderCloseContract(context.Context, *MsgProviderCloseContract) (*MsgProviderCloseContractResponse, error)
+	RotateDelegate(context.Context, *MsgRotateDelegate) (*MsgRotateDelegateResponse, error)
+	SetAutoRenew(context.Context, *MsgSetAutoRenew) (*MsgSetAutoRenewResponse, error)
+	TopUpContract(context.Context, *MsgTopUpContract) (*MsgTopUpContractResponse, error)
+	ClaimContractIncomeBatch(context.Context, *MsgClaimContractIncomeBatch) (*MsgClaimContractIncomeBatchResponse, error)
+	PurgeExpiredContracts(context.Context, *MsgPurgeExpiredContracts) (*MsgPurgeExpiredContractsResponse, error)
+	SetProviderAllowlist(context.Context, *MsgSetProviderAllowlist) (*MsgSetProviderAllowlistResponse, error)
+	// this line is used by starport scaffolding # proto/tx/rpc
+	SetVersion(context.Context, *MsgSetVersion) (*MsgSetVersionResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) BondProvider(ctx context.Context, req *MsgBondProvider) (*MsgBondProviderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BondProvider not implemented")
+}
+func (*UnimplementedMsgServer) ModProvider(ctx context.Context, req *MsgModProvider) (*MsgModProviderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModProvider not implemented")
+}
+func (*UnimplementedMsgServer) OpenContract(ctx context.Context, req *MsgOpenContract) (*MsgOpenContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OpenContract not implemented")
+}
+func (*UnimplementedMsgServer) CloseContract(ctx context.Context, req *MsgCloseContract) (*MsgCloseContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseContract not implemented")
+}
+func (*UnimplementedMsgServer) ClaimContractIncome(ctx context.Context, req *MsgClaimContractIncome) (*MsgClaimContractIncomeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimContractIncome not implemented")
+}
+func (*UnimplementedMsgServer) RenewContract(ctx context.Context, req *MsgRenewContract) (*MsgRenewContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenewContract not implemented")
+}
+func (*UnimplementedMsgServer) ProviderCloseContract(ctx context.Context, req *MsgProviderCloseContract) (*MsgProviderCloseContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProviderCloseContract not implemented")
+}
+func (*UnimplementedMsgServer) RotateDelegate(ctx context.Context, req *MsgRotateDelegate) (*MsgRotateDelegateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateDelegate not implemented")
+}
+func (*UnimplementedMsgServer) SetAutoRenew(ctx context.Context, req *MsgSetAutoRenew) (*MsgSetAutoRenewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAutoRenew not implemented")
+}
+func (*UnimplementedMsgServer) TopUpContract(ctx context.Context, req *MsgTopUpContract) (*MsgTopUpContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TopUpContract not implemented")
+}
+func (*UnimplementedMsgServer) ClaimContractIncomeBatch(ctx context.Context, req *MsgClaimContractIncomeBatch) (*MsgClaimContractIncomeBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimContractIncomeBatch not implemented")
+}
+func (*UnimplementedMsgServer) PurgeExpiredContracts(ctx context.Context, req *MsgPurgeExpiredContracts) (*MsgPurgeExpiredContractsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeExpiredContracts not implemented")
+}
+func (*UnimplementedMsgServer) SetProviderAllowlist(ctx context.Context, req *MsgSetProviderAllowlist) (*MsgSetProviderAllowlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetProviderAllowlist not implemented")
+}
+func (*UnimplementedMsgServer) SetVersion(ctx context.Context, req *MsgSetVersion) (*MsgSetVersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetVersion not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_BondProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBondProvider)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BondProvider(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/arkeo.arkeo.Msg/BondProvider",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BondProvider(ctx, req.(*MsgBondProvider))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ModProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgModProvider)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ModProvider(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/arkeo.arkeo.Msg/ModProvider",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ModProvider(ctx, req.(*MsgModProvider))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_OpenContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgOpenContract)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).OpenContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/arkeo.arkeo.Msg/OpenContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).OpenContract(ctx, req.(*MsgOpenContract))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CloseContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCloseContract)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CloseContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/arkeo.arkeo.Msg/CloseContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CloseContract(ctx, req.(*MsgCloseContract))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimContractIncome_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimContractIncome)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimContractIncome(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/arkeo.arkeo.Msg/ClaimContractIncome",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimContractIncome(ctx, req.(*MsgClaimContractIncome))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RenewContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRenewContract)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RenewContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/arkeo.arkeo.Msg/RenewContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RenewContract(ctx, req.(*MsgRenewContract))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ProviderCloseContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgProviderCloseContract)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ProviderCloseContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/arkeo.arkeo.Msg/ProviderCloseContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ProviderCloseContract(ctx, req.(*MsgProviderCloseContract))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RotateDelegate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRotateDelegate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RotateDelegate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/arkeo.arkeo.Msg/RotateDelegate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RotateDelegate(ctx, req.(*MsgRotateDelegate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetAutoRenew_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAutoRenew)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetAutoRenew(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/arkeo.arkeo.Msg/SetAutoRenew",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAutoRenew(ctx, req.(*MsgSetAutoRenew))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TopUpContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTopUpContract)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TopUpContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/arkeo.arkeo.Msg/TopUpContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TopUpContract(ctx, req.(*MsgTopUpContract))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimContractIncomeBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimContractIncomeBatch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimContractIncomeBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/arkeo.arkeo.Msg/ClaimContractIncomeBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimContractIncomeBatch(ctx, req.(*MsgClaimContractIncomeBatch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PurgeExpiredContracts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPurgeExpiredContracts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PurgeExpiredContracts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/arkeo.arkeo.Msg/PurgeExpiredContracts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PurgeExpiredContracts(ctx, req.(*MsgPurgeExpiredContracts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetProviderAllowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetProviderAllowlist)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetProviderAllowlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/arkeo.arkeo.Msg/SetProviderAllowlist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetProviderAllowlist(ctx, req.(*MsgSetProviderAllowlist))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetVersion)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/arkeo.arkeo.Msg/SetVersion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetVersion(ctx, req.(*MsgSetVersion))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "arkeo.arkeo.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "BondProvider",
+			Handler:    _Msg_BondProvider_Handler,
+		},
+		{
+			MethodName: "ModProvider",
+			Handler:    _Msg_ModProvider_Handler,
+		},
+		{
+			MethodName: "OpenContract",
+			Handler:    _Msg_OpenContract_Handler,
+		},
+		{
+			MethodName: "CloseContract",
+			Handler:    _Msg_CloseContract_Handler,
+		},
+		{
+			MethodName: "ClaimContractIncome",
+			Handler:    _Msg_ClaimContractIncome_Handler,
+		},
+		{
+			MethodName: "RenewContract",
+			Handler:    _Msg_RenewContract_Handler,
+		},
+		{
+			MethodName: "ProviderCloseContract",
+			Handler:    _Msg_ProviderCloseContract_Handler,
+		},
+		{
+			MethodName: "RotateDelegate",
+			Handler:    _Msg_RotateDelegate_Handler,
+		},
+		{
+			MethodName: "SetAutoRenew",
+			Handler:    _Msg_SetAutoRenew_Handler,
+		},
+		{
+			MethodName: "TopUpContract",
+			Handler:    _Msg_TopUpContract_Handler,
+		},
+		{
+			MethodName: "ClaimContractIncomeBatch",
+			Handler:    _Msg_ClaimContractIncomeBatch_Handler,
+		},
+		{
+			MethodName: "PurgeExpiredContracts",
+			Handler:    _Msg_PurgeExpiredContracts_Handler,
+		},
+		{
+			MethodName: "SetProviderAllowlist",
+			Handler:    _Msg_SetProviderAllowlist_Handler,
+		},
+		{
+			MethodName: "SetVersion",
+			Handler:    _Msg_SetVersion_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "arkeo/arkeo/tx.proto",
+}
+
+func (m *MsgBondProvider) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBondProvider) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBondProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Bond.Size()
+		i -= size
+		if _, err := m.Bond.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Service) > 0 {
+		i -= len(m.Service)
+		copy(dAtA[i:], m.Service)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Service)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBondProviderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBondProviderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBondProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgModProvider) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgModProvider) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgModProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxOpenContracts != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxOpenContracts))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.UpdateMask) > 0 {
+		for iNdEx := len(m.UpdateMask) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.UpdateMask[iNdEx])
+			copy(dAtA[i:], m.UpdateMask[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.UpdateMask[iNdEx])))
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if m.SettlementDuration != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SettlementDuration))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.PayAsYouGoRate) > 0 {
+		for iNdEx := len(m.PayAsYouGoRate) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PayAsYouGoRate[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.SubscriptionRate) > 0 {
+		for iNdEx := len(m.SubscriptionRate) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SubscriptionRate[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.MaxContractDuration != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxContractDuration))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.MinContractDuration != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MinContractDuration))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Status != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MetadataNonce != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MetadataNonce))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.MetadataUri) > 0 {
+		i -= len(m.MetadataUri)
+		copy(dAtA[i:], m.MetadataUri)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MetadataUri)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Service) > 0 {
+		i -= len(m.Service)
+		copy(dAtA[i:], m.Service)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Service)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgModProviderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgModProviderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgModProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgOpenContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgOpenContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgOpenContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StartHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x78
+	}
+	if len(m.Payer) > 0 {
+		i -= len(m.Payer)
+		copy(dAtA[i:], m.Payer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Payer)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.AutoRenew {
+		i--
+		if m.AutoRenew {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.QueriesPerMinute != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.QueriesPerMinute))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.Authorization != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Authorization))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.SettlementDuration != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SettlementDuration))
+		i--
+		dAtA[i] = 0x50
+	}
+	{
+		size := m.Deposit.Size()
+		i -= size
+		if _, err := m.Deposit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size, err := m.Rate.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if m.Duration != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Duration))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.ContractType != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ContractType))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Delegate) > 0 {
+		i -= len(m.Delegate)
+		copy(dAtA[i:], m.Delegate)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Delegate)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Client) > 0 {
+		i -= len(m.Client)
+		copy(dAtA[i:], m.Client)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Client)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Service) > 0 {
+		i -= len(m.Service)
+		copy(dAtA[i:], m.Service)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Service)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgOpenContractResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgOpenContractResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgOpenContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCloseContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCloseContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCloseContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Delegate) > 0 {
+		i -= len(m.Delegate)
+		copy(dAtA[i:], m.Delegate)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Delegate)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Client) > 0 {
+		i -= len(m.Client)
+		copy(dAtA[i:], m.Client)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Client)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ContractId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ContractId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCloseContractResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCloseContractResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCloseContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimContractIncome) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimContractIncome) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimContractIncome) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ContractId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ContractId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimContractIncomeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimContractIncomeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimContractIncomeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRenewContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRenewContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRenewContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Rate.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.ExtraDeposit.Size()
+		i -= size
+		if _, err := m.ExtraDeposit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.AdditionalDuration != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AdditionalDuration))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ContractId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ContractId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRenewContractResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRenewContractResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRenewContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgProviderCloseContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProviderCloseContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProviderCloseContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ContractId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ContractId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgProviderCloseContractResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProviderCloseContractResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProviderCloseContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRotateDelegate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRotateDelegate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRotateDelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewDelegate) > 0 {
+		i -= len(m.NewDelegate)
+		copy(dAtA[i:], m.NewDelegate)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewDelegate)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ContractId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ContractId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRotateDelegateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRotateDelegateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRotateDelegateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAutoRenew) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAutoRenew) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAutoRenew) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AutoRenew {
+		i--
+		if m.AutoRenew {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ContractId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ContractId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAutoRenewResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAutoRenewResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAutoRenewResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTopUpContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTopUpContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTopUpContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Deposit.Size()
+		i -= size
+		if _, err := m.Deposit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.ContractId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ContractId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTopUpContractResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTopUpContractResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTopUpContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractClaim) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractClaim) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ContractId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ContractId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimContractIncomeBatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimContractIncomeBatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimContractIncomeBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Claims) > 0 {
+		for iNdEx := len(m.Claims) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Claims[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimContractIncomeBatchResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimContractIncomeBatchResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimContractIncomeBatchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPurgeExpiredContracts) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPurgeExpiredContracts) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPurgeExpiredContracts) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ContractIds) > 0 {
+		dAtA4 := make([]byte, len(m.ContractIds)*10)
+		var j3 int
+		for _, num := range m.ContractIds {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintTx(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPurgeExpiredContractsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPurgeExpiredContractsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPurgeExpiredContractsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Reward.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetProviderAllowlist) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetProviderAllowlist) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetProviderAllowlist) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Clients) > 0 {
+		for iNdEx := len(m.Clients) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Clients[iNdEx])
+			copy(dAtA[i:], m.Clients[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Clients[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Action != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Action))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Service) > 0 {
+		i -= len(m.Service)
+		copy(dAtA[i:], m.Service)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Service)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetProviderAllowlistResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetProviderAllowlistResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetProviderAllowlistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetVersion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetVersion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetVersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Version != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetVersionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetVersionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetVersionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgBondProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Service)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Bond.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgBondProviderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgModProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Service)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MetadataUri)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MetadataNonce != 0 {
+		n += 1 + sovTx(uint64(m.MetadataNonce))
+	}
+	if m.Status != 0 {
+		n += 1 + sovTx(uint64(m.Status))
+	}
+	if m.MinContractDuration != 0 {
+		n += 1 + sovTx(uint64(m.MinContractDuration))
+	}
+	if m.MaxContractDuration != 0 {
+		n += 1 + sovTx(uint64(m.MaxContractDuration))
+	}
+	if len(m.SubscriptionRate) > 0 {
+		for _, e := range m.SubscriptionRate {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.PayAsYouGoRate) > 0 {
+		for _, e := range m.PayAsYouGoRate {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.SettlementDuration != 0 {
+		n += 1 + sovTx(uint64(m.SettlementDuration))
+	}
+	if len(m.UpdateMask) > 0 {
+		for _, s := range m.UpdateMask {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.MaxOpenContracts != 0 {
+		n += 1 + sovTx(uint64(m.MaxOpenContracts))
+	}
+	return n
+}
+
+func (m *MsgModProviderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgOpenContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Service)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Client)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Delegate)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ContractType != 0 {
+		n += 1 + sovTx(uint64(m.ContractType))
+	}
+	if m.Duration != 0 {
+		n += 1 + sovTx(uint64(m.Duration))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Deposit.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.SettlementDuration != 0 {
+		n += 1 + sovTx(uint64(m.SettlementDuration))
+	}
+	if m.Authorization != 0 {
+		n += 1 + sovTx(uint64(m.Authorization))
+	}
+	if m.QueriesPerMinute != 0 {
+		n += 1 + sovTx(uint64(m.QueriesPerMinute))
+	}
+	if m.AutoRenew {
+		n += 2
+	}
+	l = len(m.Payer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovTx(uint64(m.StartHeight))
+	}
+	return n
+}
+
+func (m *MsgOpenContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCloseContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ContractId != 0 {
+		n += 1 + sovTx(uint64(m.ContractId))
+	}
+	l = len(m.Client)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Delegate)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCloseContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgClaimContractIncome) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ContractId != 0 {
+		n += 1 + sovTx(uint64(m.ContractId))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovTx(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *MsgClaimContractIncomeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRenewContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ContractId != 0 {
+		n += 1 + sovTx(uint64(m.ContractId))
+	}
+	if m.AdditionalDuration != 0 {
+		n += 1 + sovTx(uint64(m.AdditionalDuration))
+	}
+	l = m.ExtraDeposit.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Rate.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgRenewContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgProviderCloseContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ContractId != 0 {
+		n += 1 + sovTx(uint64(m.ContractId))
+	}
+	return n
+}
+
+func (m *MsgProviderCloseContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRotateDelegate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ContractId != 0 {
+		n += 1 + sovTx(uint64(m.ContractId))
+	}
+	l = len(m.NewDelegate)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRotateDelegateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetAutoRenew) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ContractId != 0 {
+		n += 1 + sovTx(uint64(m.ContractId))
+	}
+	if m.AutoRenew {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetAutoRenewResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgTopUpContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ContractId != 0 {
+		n += 1 + sovTx(uint64(m.ContractId))
+	}
+	l = m.Deposit.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgTopUpContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ContractClaim) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ContractId != 0 {
+		n += 1 + sovTx(uint64(m.ContractId))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovTx(uint64(m.Nonce))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgClaimContractIncomeBatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Claims) > 0 {
+		for _, e := range m.Claims {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgClaimContractIncomeBatchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgPurgeExpiredContracts) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.ContractIds) > 0 {
+		l = 0
+		for _, e := range m.ContractIds {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	if m.Limit != 0 {
+		n += 1 + sovTx(uint64(m.Limit))
+	}
+	return n
+}
+
+func (m *MsgPurgeExpiredContractsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = m.Reward.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetProviderAllowlist) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Service)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Action != 0 {
+		n += 1 + sovTx(uint64(m.Action))
+	}
+	if len(m.Clients) > 0 {
+		for _, b := range m.Clients {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetProviderAllowlistResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetVersion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovTx(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *MsgSetVersionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgBondProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBondProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBondProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Service = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bond", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Bond.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBondProviderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBondProviderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBondProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgModProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgModProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgModProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = append(m.Provider[:0], dAtA[iNdEx:postIndex]...)
+			if m.Provider == nil {
+				m.Provider = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Service = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetadataUri", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MetadataUri = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetadataNonce", wireType)
+			}
+			m.MetadataNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MetadataNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ProviderStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinContractDuration", wireType)
+			}
+			m.MinContractDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinContractDuration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxContractDuration", wireType)
+			}
+			m.MaxContractDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxContractDuration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubscriptionRate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubscriptionRate = append(m.SubscriptionRate, types.Coin{})
+			if err := m.SubscriptionRate[len(m.SubscriptionRate)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayAsYouGoRate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PayAsYouGoRate = append(m.PayAsYouGoRate, types.Coin{})
+			if err := m.PayAsYouGoRate[len(m.PayAsYouGoRate)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementDuration", wireType)
+			}
+			m.SettlementDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SettlementDuration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateMask", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdateMask = append(m.UpdateMask, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOpenContracts", wireType)
+			}
+			m.MaxOpenContracts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxOpenContracts |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgModProviderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgModProviderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgModProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgOpenContract) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgOpenContract: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgOpenContract: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Service = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Client", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Client = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractType", wireType)
+			}
+			m.ContractType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractType |= ContractType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			m.Duration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Duration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementDuration", wireType)
+			}
+			m.SettlementDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SettlementDuration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authorization", wireType)
+			}
+			m.Authorization = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Authorization |= ContractAuthorization(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueriesPerMinute", wireType)
+			}
+			m.QueriesPerMinute = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueriesPerMinute |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoRenew", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoRenew = bool(v != 0)
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgOpenContractResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgOpenContractResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgOpenContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCloseContract) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCloseContract: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCloseContract: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			m.ContractId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Client", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Client = append(m.Client[:0], dAtA[iNdEx:postIndex]...)
+			if m.Client == nil {
+				m.Client = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegate", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegate = append(m.Delegate[:0], dAtA[iNdEx:postIndex]...)
+			if m.Delegate == nil {
+				m.Delegate = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCloseContractResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCloseContractResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCloseContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimContractIncome) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimContractIncome: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimContractIncome: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			m.ContractId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimContractIncomeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimContractIncomeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimContractIncomeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgSetVersionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgBondProvider) Unmarshal(dAtA []byte) error {
+func (m *MsgRenewContract) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1912,10 +5729,10 @@ func (m *MsgBondProvider) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBondProvider: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRenewContract: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBondProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRenewContract: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1951,10 +5768,10 @@ func (m *MsgBondProvider) Unmarshal(dAtA []byte) error {
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
 			}
-			var stringLen uint64
+			m.ContractId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1964,27 +5781,33 @@ func (m *MsgBondProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ContractId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdditionalDuration", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.AdditionalDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AdditionalDuration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Provider = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtraDeposit", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2012,13 +5835,15 @@ func (m *MsgBondProvider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Service = string(dAtA[iNdEx:postIndex])
+			if err := m.ExtraDeposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bond", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2028,23 +5853,22 @@ func (m *MsgBondProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Bond.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2069,7 +5893,7 @@ func (m *MsgBondProvider) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBondProviderResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRenewContractResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2092,10 +5916,10 @@ func (m *MsgBondProviderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBondProviderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRenewContractResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBondProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRenewContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2119,7 +5943,7 @@ func (m *MsgBondProviderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgModProvider) Unmarshal(dAtA []byte) error {
+func (m *MsgProviderCloseContract) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2142,10 +5966,10 @@ func (m *MsgModProvider) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgModProvider: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgProviderCloseContract: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgModProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgProviderCloseContract: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2181,10 +6005,10 @@ func (m *MsgModProvider) Unmarshal(dAtA []byte) error {
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
 			}
-			var byteLen int
+			m.ContractId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2194,152 +6018,116 @@ func (m *MsgModProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.ContractId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Provider = append(m.Provider[:0], dAtA[iNdEx:postIndex]...)
-			if m.Provider == nil {
-				m.Provider = []byte{}
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgProviderCloseContractResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Service = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetadataUri", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgProviderCloseContractResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgProviderCloseContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MetadataUri = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetadataNonce", wireType)
-			}
-			m.MetadataNonce = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MetadataNonce |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			m.Status = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Status |= ProviderStatus(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRotateDelegate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinContractDuration", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.MinContractDuration = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MinContractDuration |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxContractDuration", wireType)
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRotateDelegate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRotateDelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
-			m.MaxContractDuration = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2349,16 +6137,29 @@ func (m *MsgModProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxContractDuration |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubscriptionRate", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
 			}
-			var msglen int
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			m.ContractId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2368,31 +6169,16 @@ func (m *MsgModProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ContractId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SubscriptionRate = append(m.SubscriptionRate, types.Coin{})
-			if err := m.SubscriptionRate[len(m.SubscriptionRate)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 10:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PayAsYouGoRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewDelegate", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2402,45 +6188,26 @@ func (m *MsgModProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PayAsYouGoRate = append(m.PayAsYouGoRate, types.Coin{})
-			if err := m.PayAsYouGoRate[len(m.PayAsYouGoRate)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.NewDelegate = append(m.NewDelegate[:0], dAtA[iNdEx:postIndex]...)
+			if m.NewDelegate == nil {
+				m.NewDelegate = []byte{}
 			}
 			iNdEx = postIndex
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SettlementDuration", wireType)
-			}
-			m.SettlementDuration = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SettlementDuration |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2462,7 +6229,7 @@ func (m *MsgModProvider) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgModProviderResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRotateDelegateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2485,10 +6252,10 @@ func (m *MsgModProviderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgModProviderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRotateDelegateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgModProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRotateDelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2512,7 +6279,7 @@ func (m *MsgModProviderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgOpenContract) Unmarshal(dAtA []byte) error {
+func (m *MsgSetAutoRenew) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2535,10 +6302,10 @@ func (m *MsgOpenContract) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgOpenContract: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetAutoRenew: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgOpenContract: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetAutoRenew: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2574,10 +6341,10 @@ func (m *MsgOpenContract) Unmarshal(dAtA []byte) error {
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
 			}
-			var stringLen uint64
+			m.ContractId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2587,27 +6354,134 @@ func (m *MsgOpenContract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ContractId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoRenew", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoRenew = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetAutoRenewResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetAutoRenewResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetAutoRenewResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Provider = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTopUpContract) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTopUpContract: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTopUpContract: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2635,13 +6509,13 @@ func (m *MsgOpenContract) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Service = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Client", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
 			}
-			var stringLen uint64
+			m.ContractId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2651,27 +6525,14 @@ func (m *MsgOpenContract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ContractId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Client = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delegate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2699,13 +6560,115 @@ func (m *MsgOpenContract) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Delegate = string(dAtA[iNdEx:postIndex])
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTopUpContractResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTopUpContractResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTopUpContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractClaim) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractClaim: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractClaim: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
 			}
-			m.ContractType = 0
+			m.ContractId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2715,16 +6678,16 @@ func (m *MsgOpenContract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ContractType |= ContractType(b&0x7F) << shift
+				m.ContractId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
 			}
-			m.Duration = 0
+			m.Nonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2734,16 +6697,16 @@ func (m *MsgOpenContract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Duration |= int64(b&0x7F) << shift
+				m.Nonce |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2753,28 +6716,79 @@ func (m *MsgOpenContract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
 			}
 			iNdEx = postIndex
-		case 9:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimContractIncomeBatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimContractIncomeBatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimContractIncomeBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2802,15 +6816,13 @@ func (m *MsgOpenContract) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SettlementDuration", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claims", wireType)
 			}
-			m.SettlementDuration = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2820,49 +6832,26 @@ func (m *MsgOpenContract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SettlementDuration |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authorization", wireType)
-			}
-			m.Authorization = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Authorization |= ContractAuthorization(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
 			}
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QueriesPerMinute", wireType)
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-			m.QueriesPerMinute = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.QueriesPerMinute |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Claims = append(m.Claims, ContractClaim{})
+			if err := m.Claims[len(m.Claims)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2884,7 +6873,7 @@ func (m *MsgOpenContract) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgOpenContractResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgClaimContractIncomeBatchResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2907,12 +6896,46 @@ func (m *MsgOpenContractResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgOpenContractResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClaimContractIncomeBatchResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgOpenContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClaimContractIncomeBatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, ContractClaimResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2934,7 +6957,7 @@ func (m *MsgOpenContractResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCloseContract) Unmarshal(dAtA []byte) error {
+func (m *MsgPurgeExpiredContracts) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2957,10 +6980,10 @@ func (m *MsgCloseContract) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCloseContract: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPurgeExpiredContracts: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCloseContract: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPurgeExpiredContracts: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2996,63 +7019,86 @@ func (m *MsgCloseContract) Unmarshal(dAtA []byte) error {
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
-			}
-			m.ContractId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+				m.ContractIds = append(m.ContractIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ContractId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
 				}
-			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Client", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
 				}
-				if iNdEx >= l {
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
 				}
+				elementCount = count
+				if elementCount != 0 && len(m.ContractIds) == 0 {
+					m.ContractIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ContractIds = append(m.ContractIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractIds", wireType)
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Client = append(m.Client[:0], dAtA[iNdEx:postIndex]...)
-			if m.Client == nil {
-				m.Client = []byte{}
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delegate", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 			}
-			var byteLen int
+			m.Limit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3062,26 +7108,11 @@ func (m *MsgCloseContract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Limit |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Delegate = append(m.Delegate[:0], dAtA[iNdEx:postIndex]...)
-			if m.Delegate == nil {
-				m.Delegate = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3103,7 +7134,7 @@ func (m *MsgCloseContract) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCloseContractResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgPurgeExpiredContractsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3126,12 +7157,79 @@ func (m *MsgCloseContractResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCloseContractResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPurgeExpiredContractsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCloseContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPurgeExpiredContractsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, ContractPurgeResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reward", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Reward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3153,7 +7251,7 @@ func (m *MsgCloseContractResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClaimContractIncome) Unmarshal(dAtA []byte) error {
+func (m *MsgSetProviderAllowlist) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3176,10 +7274,10 @@ func (m *MsgClaimContractIncome) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClaimContractIncome: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetProviderAllowlist: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClaimContractIncome: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetProviderAllowlist: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3215,10 +7313,10 @@ func (m *MsgClaimContractIncome) Unmarshal(dAtA []byte) error {
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
 			}
-			m.ContractId = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3228,16 +7326,31 @@ func (m *MsgClaimContractIncome) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ContractId |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = append(m.Provider[:0], dAtA[iNdEx:postIndex]...)
+			if m.Provider == nil {
+				m.Provider = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3247,31 +7360,29 @@ func (m *MsgClaimContractIncome) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
-			if m.Signature == nil {
-				m.Signature = []byte{}
-			}
+			m.Service = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
 			}
-			m.Nonce = 0
+			m.Action = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3281,11 +7392,43 @@ func (m *MsgClaimContractIncome) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Nonce |= int64(b&0x7F) << shift
+				m.Action |= AllowlistAction(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Clients", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Clients = append(m.Clients, make([]byte, postIndex-iNdEx))
+			copy(m.Clients[len(m.Clients)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3307,7 +7450,7 @@ func (m *MsgClaimContractIncome) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClaimContractIncomeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetProviderAllowlistResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3330,10 +7473,10 @@ func (m *MsgClaimContractIncomeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClaimContractIncomeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetProviderAllowlistResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClaimContractIncomeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetProviderAllowlistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -0,0 +1,413 @@
+package cli
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/cobra"
+
+	"github.com/arkeonetwork/arkeo/x/claim/types"
+)
+
+const (
+	flagThorchain   = "thorchain"
+	flagEthereum    = "ethereum"
+	flagDenom       = "denom"
+	flagDecimals    = "decimals"
+	flagCap         = "cap"
+	flagBudget      = "budget"
+	flagSkipInvalid = "skip-invalid"
+	flagOutput      = "output"
+
+	thorchainPrefix = "thor"
+)
+
+// GetClaimCmd returns the offline tooling of the claim module, building its genesis
+func GetClaimCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim",
+		Short: fmt.Sprintf("Offline tooling of the %s module", types.ModuleName),
+	}
+	cmd.AddCommand(CmdImportSnapshot())
+	return cmd
+}
+
+func CmdImportSnapshot() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import-snapshot",
+		Short: "Build the claim records of the genesis from the snapshots of the eligible addresses",
+		Long: `Read the CSV or JSON snapshots of the eligible addresses, an address and an amount of whole tokens per row, and
+print the claim module genesis fragment of their claim records, to merge into genesis.json:
+
+  jq --slurpfile claim fragment.json '.app_state.claimarkeo += $claim[0]' genesis.json
+
+The CSV snapshots have a header naming the address and amount (or distributed_amount) columns, the JSON ones are an
+array of {"address", "amount"} objects. The thorchain addresses are claimed by the arkeo address of the same key, the
+ethereum ones through claim-eth. The rows of the same address are merged, summing their amounts, before the cap per
+address is applied. The amount of a record is split evenly between its claim, vote and delegate actions.`,
+		Example: "arkeod claim import-snapshot --thorchain thor.csv --ethereum eth.json --cap 100000 --budget 30000000 --output fragment.json",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			thorFiles, err := cmd.Flags().GetStringSlice(flagThorchain)
+			if err != nil {
+				return err
+			}
+			ethFiles, err := cmd.Flags().GetStringSlice(flagEthereum)
+			if err != nil {
+				return err
+			}
+			if len(thorFiles)+len(ethFiles) == 0 {
+				return fmt.Errorf("no snapshot, see --%s and --%s", flagThorchain, flagEthereum)
+			}
+			denom, err := cmd.Flags().GetString(flagDenom)
+			if err != nil {
+				return err
+			}
+			decimals, err := cmd.Flags().GetUint32(flagDecimals)
+			if err != nil {
+				return err
+			}
+			skipInvalid, err := cmd.Flags().GetBool(flagSkipInvalid)
+			if err != nil {
+				return err
+			}
+			importer, err := newSnapshotImporter(denom, decimals, skipInvalid)
+			if err != nil {
+				return err
+			}
+			if importer.cap, err = parseAmountFlag(cmd, flagCap, decimals); err != nil {
+				return err
+			}
+			budget, err := parseAmountFlag(cmd, flagBudget, decimals)
+			if err != nil {
+				return err
+			}
+
+			for _, snapshot := range []struct {
+				chain types.Chain
+				files []string
+			}{{types.ARKEO, thorFiles}, {types.ETHEREUM, ethFiles}} {
+				for _, file := range snapshot.files {
+					if err := importer.importFile(snapshot.chain, file); err != nil {
+						return err
+					}
+				}
+			}
+
+			records, stats := importer.claimRecords()
+			fmt.Fprintln(cmd.ErrOrStderr(), stats)
+			if budget.IsPositive() && stats.Total.GT(budget) {
+				return fmt.Errorf("total allocated %s%s exceeds the budget %s%s", stats.Total, denom, budget, denom)
+			}
+
+			fragment, err := genesisFragment(records, sdk.NewCoin(denom, stats.Total))
+			if err != nil {
+				return err
+			}
+			output, err := cmd.Flags().GetString(flagOutput)
+			if err != nil {
+				return err
+			}
+			if output == "" {
+				_, err = fmt.Fprintln(cmd.OutOrStdout(), string(fragment))
+				return err
+			}
+			return os.WriteFile(output, append(fragment, '\n'), 0o600)
+		},
+	}
+
+	cmd.Flags().StringSlice(flagThorchain, nil, "snapshots of thorchain addresses, claimed by the arkeo address of the same key")
+	cmd.Flags().StringSlice(flagEthereum, nil, "snapshots of ethereum addresses, claimed through claim-eth")
+	cmd.Flags().String(flagDenom, types.DefaultClaimDenom, "denom of the claim records")
+	cmd.Flags().Uint32(flagDecimals, 8, "decimals of the denom, the snapshot amounts being in whole tokens")
+	cmd.Flags().String(flagCap, "", "cap of the amount of an address, in whole tokens, none if empty")
+	cmd.Flags().String(flagBudget, "", "budget of the airdrop in whole tokens, refused if the total allocated exceeds it")
+	cmd.Flags().Bool(flagSkipInvalid, false, "skip the malformed rows instead of failing")
+	cmd.Flags().String(flagOutput, "", "file to write the genesis fragment to, stdout if empty")
+
+	return cmd
+}
+
+// snapshotRow a row of a snapshot, its source the file and line of the row for the errors
+type snapshotRow struct {
+	address string
+	amount  string
+	source  string
+}
+
+// snapshotStats the statistics of an import
+type snapshotStats struct {
+	RecordsIn  int
+	Invalid    int
+	Duplicates int
+	Capped     int
+	Records    int
+	Total      sdkmath.Int
+}
+
+func (s snapshotStats) String() string {
+	return fmt.Sprintf("records in: %d, invalid skipped: %d, duplicates merged: %d, capped: %d, claim records: %d, total allocated: %s",
+		s.RecordsIn, s.Invalid, s.Duplicates, s.Capped, s.Records, s.Total)
+}
+
+type snapshotImporter struct {
+	denom       string
+	unit        sdkmath.LegacyDec
+	cap         sdkmath.Int
+	skipInvalid bool
+	amounts     map[types.Chain]map[string]sdkmath.Int
+	stats       snapshotStats
+}
+
+func newSnapshotImporter(denom string, decimals uint32, skipInvalid bool) (*snapshotImporter, error) {
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return nil, err
+	}
+	if decimals > sdkmath.LegacyPrecision {
+		return nil, fmt.Errorf("decimals %d above %d", decimals, sdkmath.LegacyPrecision)
+	}
+	return &snapshotImporter{
+		denom:       denom,
+		unit:        sdkmath.LegacyNewDecFromInt(sdkmath.NewIntWithDecimal(1, int(decimals))),
+		cap:         sdkmath.ZeroInt(),
+		skipInvalid: skipInvalid,
+		amounts:     map[types.Chain]map[string]sdkmath.Int{types.ARKEO: {}, types.ETHEREUM: {}},
+	}, nil
+}
+
+// importFile import the rows of the snapshot file, a JSON array if its extension is .json, a CSV otherwise
+func (im *snapshotImporter) importFile(chain types.Chain, file string) error {
+	f, err := os.Open(file)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	var rows []snapshotRow
+	if strings.EqualFold(filepath.Ext(file), ".json") {
+		rows, err = readJSONSnapshot(f, file)
+	} else {
+		rows, err = readCSVSnapshot(f, file)
+	}
+	if err != nil {
+		return err
+	}
+	return im.add(chain, rows)
+}
+
+// add the rows of the chain, merging the rows of the addresses added already. A malformed row fails the import, all of
+// them reported, unless they are skipped.
+func (im *snapshotImporter) add(chain types.Chain, rows []snapshotRow) error {
+	var invalid []string
+	for _, row := range rows {
+		im.stats.RecordsIn++
+		address, err := normalizeSnapshotAddress(chain, row.address)
+		if err == nil {
+			var amount sdkmath.Int
+			if amount, err = parseSnapshotAmount(row.amount, im.unit); err == nil {
+				if merged, ok := im.amounts[chain][address]; ok {
+					im.stats.Duplicates++
+					amount = amount.Add(merged)
+				}
+				im.amounts[chain][address] = amount
+				continue
+			}
+		}
+		im.stats.Invalid++
+		invalid = append(invalid, fmt.Sprintf("%s: %s", row.source, err))
+	}
+	if len(invalid) > 0 && !im.skipInvalid {
+		return fmt.Errorf("%d malformed rows:\n%s", len(invalid), strings.Join(invalid, "\n"))
+	}
+	return nil
+}
+
+// claimRecords the claim records of the addresses imported, sorted by chain and address, capped
+func (im *snapshotImporter) claimRecords() ([]types.ClaimRecord, snapshotStats) {
+	stats := im.stats
+	stats.Total = sdkmath.ZeroInt()
+	var records []types.ClaimRecord
+	for _, chain := range []types.Chain{types.ARKEO, types.ETHEREUM} {
+		addresses := make([]string, 0, len(im.amounts[chain]))
+		for address := range im.amounts[chain] {
+			addresses = append(addresses, address)
+		}
+		sort.Strings(addresses)
+		for _, address := range addresses {
+			amount := im.amounts[chain][address]
+			if im.cap.IsPositive() && amount.GT(im.cap) {
+				stats.Capped++
+				amount = im.cap
+			}
+			records = append(records, splitClaimRecord(chain, address, sdk.NewCoin(im.denom, amount)))
+			stats.Total = stats.Total.Add(amount)
+		}
+	}
+	stats.Records = len(records)
+	return records, stats
+}
+
+// splitClaimRecord the claim record of the amount split between the actions, the remainder of the split to delegate
+func splitClaimRecord(chain types.Chain, address string, amount sdk.Coin) types.ClaimRecord {
+	third := amount.Amount.QuoRaw(3)
+	return types.ClaimRecord{
+		Chain:          chain,
+		Address:        address,
+		AmountClaim:    sdk.NewCoin(amount.Denom, third),
+		AmountVote:     sdk.NewCoin(amount.Denom, third),
+		AmountDelegate: sdk.NewCoin(amount.Denom, amount.Amount.Sub(third.MulRaw(2))),
+	}
+}
+
+// normalizeSnapshotAddress the address of the claim record of the address of the snapshot, the arkeo address of a
+// thorchain one and the checksummed ethereum one, so the rows of an address merge whatever its encoding
+func normalizeSnapshotAddress(chain types.Chain, address string) (string, error) {
+	address = strings.TrimSpace(address)
+	switch chain {
+	case types.ARKEO:
+		hrp, bz, err := bech32.DecodeAndConvert(address)
+		if err != nil {
+			return "", fmt.Errorf("invalid address %s: %w", address, err)
+		}
+		if hrp != thorchainPrefix && hrp != sdk.GetConfig().GetBech32AccountAddrPrefix() {
+			return "", fmt.Errorf("invalid address %s: prefix %s is neither %s nor %s", address, hrp, thorchainPrefix, sdk.GetConfig().GetBech32AccountAddrPrefix())
+		}
+		if err := sdk.VerifyAddressFormat(bz); err != nil {
+			return "", fmt.Errorf("invalid address %s: %w", address, err)
+		}
+		return sdk.AccAddress(bz).String(), nil
+	case types.ETHEREUM:
+		if err := types.ValidateEthAddress(address); err != nil {
+			return "", err
+		}
+		return common.HexToAddress(address).Hex(), nil
+	default:
+		return "", fmt.Errorf("invalid chain %s", chain)
+	}
+}
+
+// parseSnapshotAmount the amount in the claim denom of the positive amount of whole tokens, no finer than the denom
+func parseSnapshotAmount(amount string, unit sdkmath.LegacyDec) (sdkmath.Int, error) {
+	dec, err := sdkmath.LegacyNewDecFromStr(strings.TrimSpace(amount))
+	if err != nil {
+		return sdkmath.Int{}, fmt.Errorf("invalid amount %q: %w", amount, err)
+	}
+	if !dec.IsPositive() {
+		return sdkmath.Int{}, fmt.Errorf("invalid amount %q: not positive", amount)
+	}
+	dec = dec.Mul(unit)
+	if !dec.IsInteger() {
+		return sdkmath.Int{}, fmt.Errorf("invalid amount %q: finer than the denom", amount)
+	}
+	return dec.TruncateInt(), nil
+}
+
+func parseAmountFlag(cmd *cobra.Command, flag string, decimals uint32) (sdkmath.Int, error) {
+	amount, err := cmd.Flags().GetString(flag)
+	if err != nil || amount == "" {
+		return sdkmath.ZeroInt(), err
+	}
+	parsed, err := parseSnapshotAmount(amount, sdkmath.LegacyNewDecFromInt(sdkmath.NewIntWithDecimal(1, int(decimals))))
+	if err != nil {
+		return sdkmath.ZeroInt(), fmt.Errorf("--%s: %w", flag, err)
+	}
+	return parsed, nil
+}
+
+func readCSVSnapshot(r io.Reader, name string) ([]snapshotRow, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("%s: failed to read the header: %w", name, err)
+	}
+	addressColumn, amountColumn := -1, -1
+	for i, column := range header {
+		switch strings.ToLower(strings.TrimSpace(column)) {
+		case "address":
+			addressColumn = i
+		case "amount", "distributed_amount":
+			amountColumn = i
+		}
+	}
+	if addressColumn < 0 || amountColumn < 0 {
+		return nil, fmt.Errorf("%s: header %v has no address and amount columns", name, header)
+	}
+
+	var rows []snapshotRow
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return rows, nil
+		}
+		line, _ := reader.FieldPos(0)
+		row := snapshotRow{source: fmt.Sprintf("%s:%d", name, line)}
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", row.source, err)
+		}
+		if addressColumn < len(record) {
+			row.address = record[addressColumn]
+		}
+		if amountColumn < len(record) {
+			row.amount = record[amountColumn]
+		}
+		rows = append(rows, row)
+	}
+}
+
+func readJSONSnapshot(r io.Reader, name string) ([]snapshotRow, error) {
+	var entries []map[string]json.RawMessage
+	if err := json.NewDecoder(r).Decode(&entries); err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+
+	rows := make([]snapshotRow, len(entries))
+	for i, entry := range entries {
+		rows[i].source = fmt.Sprintf("%s[%d]", name, i)
+		_ = json.Unmarshal(entry["address"], &rows[i].address)
+		amount, ok := entry["amount"]
+		if !ok {
+			amount = entry["distributed_amount"]
+		}
+		// the amounts as numbers or strings
+		if err := json.Unmarshal(amount, &rows[i].amount); err != nil {
+			rows[i].amount = string(amount)
+		}
+	}
+	return rows, nil
+}
+
+// genesisFragment the claim module genesis fragment of the claim records, the module account holding their total
+func genesisFragment(records []types.ClaimRecord, total sdk.Coin) ([]byte, error) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	balance, err := cdc.MarshalJSON(&total)
+	if err != nil {
+		return nil, err
+	}
+	claimRecords := make([]json.RawMessage, len(records))
+	for i := range records {
+		if claimRecords[i], err = cdc.MarshalJSON(&records[i]); err != nil {
+			return nil, err
+		}
+	}
+	if claimRecords == nil {
+		claimRecords = []json.RawMessage{}
+	}
+	return json.MarshalIndent(struct {
+		ModuleAccountBalance json.RawMessage   `json:"module_account_balance"`
+		ClaimRecords         []json.RawMessage `json:"claim_records"`
+	}{balance, claimRecords}, "", "  ")
+}
@@ -0,0 +1,170 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"github.com/stretchr/testify/require"
+
+	"github.com/arkeonetwork/arkeo/x/claim/types"
+)
+
+const (
+	checksummedEth = "0x2c7536E3605D9C16a7a3D7b1898e529396a65c23"
+	otherEth       = "0x7a89f1838933DE0bA50aF0e916050977ceACAC9e"
+)
+
+func thorAddress(t *testing.T, b byte) (string, string) {
+	bz := bytes.Repeat([]byte{b}, 20)
+	thor, err := bech32.ConvertAndEncode(thorchainPrefix, bz)
+	require.NoError(t, err)
+	return thor, sdk.AccAddress(bz).String()
+}
+
+func TestSnapshotImporterMerge(t *testing.T) {
+	thor1, arkeo1 := thorAddress(t, 1)
+	thor2, arkeo2 := thorAddress(t, 2)
+	im, err := newSnapshotImporter(types.DefaultClaimDenom, 8, false)
+	require.NoError(t, err)
+	im.cap = sdkmath.NewInt(500_000_000)
+
+	// the thorchain address and the arkeo address of the same key are merged
+	require.NoError(t, im.add(types.ARKEO, []snapshotRow{
+		{address: thor1, amount: "1.5"},
+		{address: arkeo1, amount: "0.5"},
+		{address: " " + thor2 + " ", amount: "10"},
+		{address: thor1, amount: "0.00000001"},
+	}))
+	// the ethereum addresses are merged whatever their case
+	require.NoError(t, im.add(types.ETHEREUM, []snapshotRow{
+		{address: strings.ToLower(checksummedEth), amount: "1"},
+		{address: checksummedEth, amount: "2"},
+		{address: otherEth, amount: "3"},
+	}))
+
+	records, stats := im.claimRecords()
+	require.Equal(t, 7, stats.RecordsIn)
+	require.Equal(t, 0, stats.Invalid)
+	require.Equal(t, 3, stats.Duplicates)
+	require.Equal(t, 1, stats.Capped)
+	require.Equal(t, 4, stats.Records)
+
+	expected := map[string]int64{
+		arkeo1:         200_000_001,
+		arkeo2:         500_000_000, // capped from 10
+		checksummedEth: 300_000_000,
+		otherEth:       300_000_000,
+	}
+	total := sdkmath.ZeroInt()
+	for _, record := range records {
+		amount := record.AmountClaim.Add(record.AmountVote).Add(record.AmountDelegate)
+		require.Equal(t, expected[record.Address], amount.Amount.Int64(), record.Address)
+		require.Equal(t, record.AmountClaim, record.AmountVote)
+		require.True(t, record.AmountDelegate.Amount.Sub(record.AmountClaim.Amount).LT(sdkmath.NewInt(3)))
+		require.True(t, types.IsValidAddress(record.Address, record.Chain))
+		total = total.Add(amount.Amount)
+	}
+	require.Equal(t, total, stats.Total)
+	require.Equal(t, types.ARKEO, records[0].Chain)
+	require.Equal(t, types.ETHEREUM, records[3].Chain)
+}
+
+func TestSnapshotImporterMalformed(t *testing.T) {
+	thor1, _ := thorAddress(t, 1)
+	osmo, err := bech32.ConvertAndEncode("osmo", bytes.Repeat([]byte{1}, 20))
+	require.NoError(t, err)
+	rows := []snapshotRow{
+		{address: thor1, amount: "1", source: "valid"},
+		{address: osmo, amount: "1", source: "other prefix"},
+		{address: "thor1notbech32", amount: "1", source: "not bech32"},
+		{address: "", amount: "1", source: "no address"},
+		{address: thor1, amount: "-1", source: "negative"},
+		{address: thor1, amount: "0", source: "zero"},
+		{address: thor1, amount: "0.000000001", source: "finer than the denom"},
+		{address: thor1, amount: "1e5", source: "not a decimal"},
+		{address: thor1, amount: "", source: "no amount"},
+	}
+
+	im, err := newSnapshotImporter(types.DefaultClaimDenom, 8, false)
+	require.NoError(t, err)
+	err = im.add(types.ARKEO, rows)
+	require.ErrorContains(t, err, "8 malformed rows")
+	for _, row := range rows[1:] {
+		require.ErrorContains(t, err, row.source+": ")
+	}
+
+	im, err = newSnapshotImporter(types.DefaultClaimDenom, 8, true)
+	require.NoError(t, err)
+	require.NoError(t, im.add(types.ARKEO, rows))
+	require.NoError(t, im.add(types.ETHEREUM, []snapshotRow{
+		{address: "0x2c7536e3605D9C16a7a3D7b1898e529396a65c23", amount: "1", source: "bad checksum"},
+		{address: checksummedEth[2:], amount: "1", source: "no 0x prefix"},
+		{address: thor1, amount: "1", source: "not an eth address"},
+	}))
+	records, stats := im.claimRecords()
+	require.Len(t, records, 1)
+	require.Equal(t, 12, stats.RecordsIn)
+	require.Equal(t, 11, stats.Invalid)
+	require.Equal(t, int64(100_000_000), stats.Total.Int64())
+}
+
+func TestCmdImportSnapshot(t *testing.T) {
+	thor1, arkeo1 := thorAddress(t, 1)
+	dir := t.TempDir()
+	thorFile := filepath.Join(dir, "thor.csv")
+	require.NoError(t, os.WriteFile(thorFile, []byte("address,distributed_amount\n"+thor1+",100.25\n"+arkeo1+",50\n"), 0o600))
+	ethFile := filepath.Join(dir, "eth.json")
+	require.NoError(t, os.WriteFile(ethFile, []byte(`[{"address": "`+checksummedEth+`", "amount": 20}, {"address": "`+otherEth+`", "amount": "30.5"}]`), 0o600))
+
+	run := func(args ...string) (string, string, error) {
+		cmd := CmdImportSnapshot()
+		var out, errOut bytes.Buffer
+		cmd.SetOut(&out)
+		cmd.SetErr(&errOut)
+		cmd.SetArgs(args)
+		err := cmd.Execute()
+		return out.String(), errOut.String(), err
+	}
+
+	out, summary, err := run("--thorchain", thorFile, "--ethereum", ethFile, "--budget", "200.75")
+	require.NoError(t, err)
+	require.Contains(t, summary, "records in: 4, invalid skipped: 0, duplicates merged: 1, capped: 0, claim records: 3, total allocated: 20075000000")
+
+	var fragment struct {
+		ModuleAccountBalance sdk.Coin          `json:"module_account_balance"`
+		ClaimRecords         []json.RawMessage `json:"claim_records"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(out), &fragment))
+	require.Equal(t, sdk.NewInt64Coin(types.DefaultClaimDenom, 20_075_000_000), fragment.ModuleAccountBalance)
+	require.Len(t, fragment.ClaimRecords, 3)
+	var record map[string]any
+	require.NoError(t, json.Unmarshal(fragment.ClaimRecords[0], &record))
+	require.Equal(t, "ARKEO", record["chain"])
+	require.Equal(t, arkeo1, record["address"])
+
+	// over the budget nothing is written
+	output := filepath.Join(dir, "fragment.json")
+	_, _, err = run("--thorchain", thorFile, "--ethereum", ethFile, "--budget", "200.74", "--output", output)
+	require.ErrorContains(t, err, "exceeds the budget")
+	require.NoFileExists(t, output)
+	_, _, err = run("--thorchain", thorFile, "--ethereum", ethFile, "--cap", "50", "--budget", "200", "--output", output)
+	require.NoError(t, err)
+	require.FileExists(t, output)
+
+	// the malformed rows are reported with their line
+	badFile := filepath.Join(dir, "bad.csv")
+	require.NoError(t, os.WriteFile(badFile, []byte("address,amount\n"+thor1+",1\n"+thor1+",abc\n"), 0o600))
+	_, _, err = run("--thorchain", badFile)
+	require.ErrorContains(t, err, badFile+":3: invalid amount")
+	_, _, err = run("--ethereum", thorFile)
+	require.ErrorContains(t, err, "2 malformed rows")
+	_, _, err = run()
+	require.Error(t, err)
+}
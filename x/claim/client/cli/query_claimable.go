@@ -0,0 +1,114 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/arkeonetwork/arkeo/x/claim/types"
+)
+
+const flagChain = "chain"
+
+func CmdClaimableForAction() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claimable-for-action [address] [action]",
+		Short: "Query what the address can still claim for the action (claim, vote or delegate)",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			reqAddress := args[0]
+			actionId, ok := types.Action_value["ACTION_"+strings.ToUpper(args[1])]
+			if !ok {
+				return fmt.Errorf("invalid action %s", args[1])
+			}
+			chain, err := parseChainFlag(cmd, reqAddress)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryClaimableForActionRequest{
+				Address: reqAddress,
+				Action:  types.Action(actionId),
+				Chain:   chain,
+			}
+
+			res, err := queryClient.ClaimableForAction(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagChain, types.ARKEO.String(), "chain of the address")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdTotalClaimable() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "total-claimable [address]",
+		Short: "Query what the address can still claim over all the actions",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			reqAddress := args[0]
+			chain, err := parseChainFlag(cmd, reqAddress)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryTotalClaimableRequest{
+				Address: reqAddress,
+				Chain:   chain,
+			}
+
+			res, err := queryClient.TotalClaimable(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagChain, types.ARKEO.String(), "chain of the address")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// parseChainFlag parse the chain flag, the address checked to be one of the chain
+func parseChainFlag(cmd *cobra.Command, address string) (types.Chain, error) {
+	reqChain, err := cmd.Flags().GetString(flagChain)
+	if err != nil {
+		return types.ARKEO, err
+	}
+	chainId, ok := types.Chain_value[strings.ToUpper(reqChain)]
+	if !ok {
+		return types.ARKEO, fmt.Errorf("invalid chain %s", reqChain)
+	}
+	chain := types.Chain(chainId)
+	if !types.IsValidAddress(address, chain) {
+		return chain, fmt.Errorf("invalid address %s", address)
+	}
+	return chain, nil
+}
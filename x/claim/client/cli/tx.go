@@ -27,6 +27,7 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdClaimArkeo())
 	cmd.AddCommand(CmdTransferClaim())
 	cmd.AddCommand(CmdAddClaim())
+	cmd.AddCommand(CmdClaimMerkle())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
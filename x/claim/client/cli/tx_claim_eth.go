@@ -1,6 +1,9 @@
 package cli
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
@@ -9,6 +12,8 @@ import (
 	"github.com/arkeonetwork/arkeo/x/claim/types"
 )
 
+const flagEthSignMode = "eth-sign-mode"
+
 func CmdClaimEth() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "claim-eth [eth-address] [signature]",
@@ -18,6 +23,15 @@ func CmdClaimEth() *cobra.Command {
 			argEthAdress := args[0]
 			argSignature := args[1]
 
+			reqSignMode, err := cmd.Flags().GetString(flagEthSignMode)
+			if err != nil {
+				return err
+			}
+			signMode, ok := types.EthSignMode_value["ETH_SIGN_"+strings.ToUpper(reqSignMode)]
+			if !ok {
+				return fmt.Errorf("invalid sign mode %s", reqSignMode)
+			}
+
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
@@ -27,6 +41,7 @@ func CmdClaimEth() *cobra.Command {
 				clientCtx.GetFromAddress(),
 				argEthAdress,
 				argSignature,
+				types.EthSignMode(signMode),
 			)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
@@ -35,6 +50,7 @@ func CmdClaimEth() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(flagEthSignMode, "raw_hash", "how the claim hash was signed: raw_hash (eth_signTypedData) or personal (personal_sign)")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -0,0 +1,62 @@
+package cli
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	"github.com/arkeonetwork/arkeo/common/cosmos"
+	"github.com/arkeonetwork/arkeo/x/claim/types"
+)
+
+func CmdClaimMerkle() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim-merkle [round-id] [amount] [proof]",
+		Short: "Broadcast message claim-merkle, the proof the comma separated hex hashes from the leaf of the claim up",
+		Args:  cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			roundId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			amount, ok := cosmos.NewIntFromString(args[1])
+			if !ok {
+				return fmt.Errorf("invalid amount %s", args[1])
+			}
+			var proof [][]byte
+			if len(args) == 3 && args[2] != "" {
+				for _, hash := range strings.Split(args[2], ",") {
+					bz, err := hex.DecodeString(strings.TrimPrefix(strings.TrimSpace(hash), "0x"))
+					if err != nil {
+						return fmt.Errorf("invalid proof hash %s: %w", hash, err)
+					}
+					proof = append(proof, bz)
+				}
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgClaimMerkle(
+				clientCtx.GetFromAddress(),
+				roundId,
+				amount,
+				proof,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
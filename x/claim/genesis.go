@@ -15,6 +15,19 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	if err != nil {
 		panic(err) // if genesis fails we should panic
 	}
+	nextRoundId := uint64(1)
+	for _, round := range genState.ClaimRounds {
+		if err := k.SetClaimRound(ctx, round); err != nil {
+			panic(err)
+		}
+		if round.Id >= nextRoundId {
+			nextRoundId = round.Id + 1
+		}
+	}
+	k.SetNextClaimRoundId(ctx, nextRoundId)
+	for _, claim := range genState.ClaimRoundClaims {
+		k.SetClaimRoundClaimed(ctx, claim.RoundId, claim.Address)
+	}
 }
 
 // ExportGenesis returns the module's exported genesis
@@ -27,5 +40,11 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		panic(err)
 	}
 	genesis.ClaimRecords = claimRecords
+	claimRounds, err := k.GetAllClaimRounds(ctx)
+	if err != nil {
+		panic(err)
+	}
+	genesis.ClaimRounds = claimRounds
+	genesis.ClaimRoundClaims = k.GetAllClaimRoundClaims(ctx)
 	return genesis
 }
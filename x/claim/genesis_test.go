@@ -85,4 +85,19 @@ func TestGenesis(t *testing.T) {
 	genesisExported := claim.ExportGenesis(ctx, testKeeepers.ClaimKeeper)
 	require.Equal(t, genesisExported.Params, testGenesis.Params)
 	require.ElementsMatch(t, genesisExported.ClaimRecords, testGenesis.ClaimRecords)
+
+	// the claim rounds and the claims they paid, the next round numbered after them
+	testGenesis.ClaimRounds = []types.ClaimRound{
+		{Id: 2, MerkleRoot: make([]byte, 32), Denom: types.DefaultClaimDenom, Account: types.ClaimRoundAccount(2).String()},
+		{Id: 5, MerkleRoot: make([]byte, 32), Denom: "uother", Account: types.ClaimRoundAccount(5).String()},
+	}
+	testGenesis.ClaimRoundClaims = []types.ClaimRoundClaim{{RoundId: 2, Address: addr1}, {RoundId: 5, Address: addr2}}
+	require.NoError(t, testGenesis.Validate())
+	claim.InitGenesis(ctx, testKeeepers.ClaimKeeper, testGenesis)
+	require.Equal(t, uint64(6), testKeeepers.ClaimKeeper.GetNextClaimRoundId(ctx))
+	require.True(t, testKeeepers.ClaimKeeper.IsClaimRoundClaimed(ctx, 5, addr2))
+	require.False(t, testKeeepers.ClaimKeeper.IsClaimRoundClaimed(ctx, 5, addr1))
+	genesisExported = claim.ExportGenesis(ctx, testKeeepers.ClaimKeeper)
+	require.Equal(t, testGenesis.ClaimRounds, genesisExported.ClaimRounds)
+	require.Equal(t, testGenesis.ClaimRoundClaims, genesisExported.ClaimRoundClaims)
 }
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"sort"
 	"strings"
 
 	sdkerror "cosmossdk.io/errors"
@@ -69,7 +70,8 @@ func (k Keeper) GetClaimRecords(ctx sdk.Context, chain types.Chain) ([]types.Cla
 
 func (k Keeper) GetAllClaimRecords(ctx sdk.Context) ([]types.ClaimRecord, error) {
 	claimRecords := []types.ClaimRecord{}
-	for chain := range types.Chain_name {
+	// the chains are walked in the order of their numbers, for every node to export the same genesis
+	for _, chain := range sortedEnumValues(types.Chain_name) {
 		records, err := k.GetClaimRecords(ctx, types.Chain(chain))
 		if err != nil {
 			return nil, err
@@ -109,7 +111,7 @@ func (k Keeper) GetUserTotalClaimable(ctx sdk.Context, addr string, chain types.
 	}
 
 	totalClaimable := sdk.NewCoin(claimRecord.AmountClaim.Denom, cosmos.ZeroInt())
-	for action := range types.Action_name {
+	for _, action := range sortedEnumValues(types.Action_name) {
 		claimableForAction, err := k.GetClaimableAmountForAction(ctx, addr, types.Action(action), chain)
 		if err != nil {
 			return sdk.Coin{}, err
@@ -134,6 +136,10 @@ func (k Keeper) GetClaimableAmountForAction(ctx sdk.Context, addr string, action
 	}
 
 	params := k.GetParams(ctx)
+	// past the end time set by governance the claims are rejected, whatever is left of the decay
+	if params.AirdropEnded(ctx.BlockTime()) {
+		return sdk.Coin{}, types.ErrAirdropEnded
+	}
 
 	// If we are before the start time, do nothing.
 	// This case _shouldn't_ occur on chain, since the
@@ -224,12 +230,6 @@ func (k Keeper) ClaimCoinsForAction(ctx sdk.Context, addr string, action types.A
 }
 
 // // FundRemainingsToCommunity fund remainings to the community when airdrop period end
-// func (k Keeper) fundRemainingsToCommunity(ctx sdk.Context) error {
-// 	moduleAccAddr := k.GetModuleAccountAddress(ctx)
-// 	amt := k.GetModuleAccountBalance(ctx)
-// 	return k.distrKeeper.FundCommunityPool(ctx, sdk.NewCoins(amt), moduleAccAddr)
-// }
-
 func chainToStorePrefix(chain types.Chain) []byte {
 	switch chain {
 	case types.ARKEO:
@@ -280,3 +280,14 @@ func setClaimableAmountForAllActions(claim types.ClaimRecord, amount sdk.Coin) t
 	claim.AmountVote = amount
 	return claim
 }
+
+// sortedEnumValues return the values of a proto enum in ascending order, ranging over the names map of the enum walks
+// the values in a random order
+func sortedEnumValues(names map[int32]string) []int32 {
+	values := make([]int32, 0, len(names))
+	for value := range names {
+		values = append(values, value)
+	}
+	sort.Slice(values, func(i, j int) bool { return values[i] < values[j] })
+	return values
+}
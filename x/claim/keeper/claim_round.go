@@ -0,0 +1,131 @@
+package keeper
+
+import (
+	"strings"
+	"time"
+
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pkg/errors"
+
+	"github.com/arkeonetwork/arkeo/x/claim/types"
+)
+
+// SetClaimRound sets an airdrop round in store
+func (k Keeper) SetClaimRound(ctx sdk.Context, round types.ClaimRound) error {
+	bz, err := k.cdc.Marshal(&round)
+	if err != nil {
+		return err
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.ClaimRoundsStorePrefix))
+	store.Set(sdk.Uint64ToBigEndian(round.Id), bz)
+	// a round with an end is indexed by it until its balance is swept
+	if !round.EndTime.IsZero() {
+		store = prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.ClaimRoundEndsStorePrefix))
+		store.Set(claimRoundEndKey(round), []byte{1})
+	}
+	return nil
+}
+
+func claimRoundEndKey(round types.ClaimRound) []byte {
+	return append(sdk.FormatTimeBytes(round.EndTime), sdk.Uint64ToBigEndian(round.Id)...)
+}
+
+// GetEndedClaimRoundIds returns the ids of the rounds ended by the time and not swept yet, in the order of their end
+func (k Keeper) GetEndedClaimRoundIds(ctx sdk.Context, now time.Time) []uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.ClaimRoundEndsStorePrefix))
+	// the rounds ending at the time itself are still running
+	iterator := store.Iterator(nil, sdk.FormatTimeBytes(now))
+	defer iterator.Close()
+
+	ids := []uint64{}
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		ids = append(ids, sdk.BigEndianToUint64(key[len(key)-8:]))
+	}
+	return ids
+}
+
+// RemoveClaimRoundEnd drops the round from the rounds left to sweep once ended
+func (k Keeper) RemoveClaimRoundEnd(ctx sdk.Context, round types.ClaimRound) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.ClaimRoundEndsStorePrefix))
+	store.Delete(claimRoundEndKey(round))
+}
+
+// GetClaimRound returns the airdrop round, and false when there is none of the id
+func (k Keeper) GetClaimRound(ctx sdk.Context, id uint64) (types.ClaimRound, bool, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.ClaimRoundsStorePrefix))
+	bz := store.Get(sdk.Uint64ToBigEndian(id))
+	if bz == nil {
+		return types.ClaimRound{}, false, nil
+	}
+	var round types.ClaimRound
+	if err := k.cdc.Unmarshal(bz, &round); err != nil {
+		return round, false, errors.Wrap(err, "failed to unmarshal claim round")
+	}
+	return round, true, nil
+}
+
+// GetAllClaimRounds returns the airdrop rounds in the order of their ids, for genesis export
+func (k Keeper) GetAllClaimRounds(ctx sdk.Context) ([]types.ClaimRound, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.ClaimRoundsStorePrefix))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	rounds := []types.ClaimRound{}
+	for ; iterator.Valid(); iterator.Next() {
+		var round types.ClaimRound
+		if err := k.cdc.Unmarshal(iterator.Value(), &round); err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal claim round")
+		}
+		rounds = append(rounds, round)
+	}
+	return rounds, nil
+}
+
+// GetNextClaimRoundId returns the id of the next round, the ids starting at 1
+func (k Keeper) GetNextClaimRoundId(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get([]byte(types.NextClaimRoundIdKey))
+	if bz == nil {
+		return 1
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetNextClaimRoundId sets the id of the next round
+func (k Keeper) SetNextClaimRoundId(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Set([]byte(types.NextClaimRoundIdKey), sdk.Uint64ToBigEndian(id))
+}
+
+func claimRoundClaimKey(id uint64, addr string) []byte {
+	return append(sdk.Uint64ToBigEndian(id), []byte(strings.ToLower(addr))...)
+}
+
+// IsClaimRoundClaimed returns true once the round paid the claim of the address
+func (k Keeper) IsClaimRoundClaimed(ctx sdk.Context, id uint64, addr string) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.ClaimRoundClaimsStorePrefix))
+	return store.Has(claimRoundClaimKey(id, addr))
+}
+
+// SetClaimRoundClaimed marks the claim of the address paid by the round
+func (k Keeper) SetClaimRoundClaimed(ctx sdk.Context, id uint64, addr string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.ClaimRoundClaimsStorePrefix))
+	store.Set(claimRoundClaimKey(id, addr), []byte{1})
+}
+
+// GetAllClaimRoundClaims returns the claims the rounds paid, for genesis export
+func (k Keeper) GetAllClaimRoundClaims(ctx sdk.Context) []types.ClaimRoundClaim {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.ClaimRoundClaimsStorePrefix))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	claims := []types.ClaimRoundClaim{}
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		claims = append(claims, types.ClaimRoundClaim{
+			RoundId: sdk.BigEndianToUint64(key[:8]),
+			Address: string(key[8:]),
+		})
+	}
+	return claims
+}
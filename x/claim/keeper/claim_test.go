@@ -179,6 +179,14 @@ func TestGetAllClaimRecords(t *testing.T) {
 	claims, err := keepers.ClaimKeeper.GetAllClaimRecords(ctx)
 	require.NoError(t, err)
 	require.Equal(t, len(claims), len(claimRecords))
+
+	// the records of a chain come after the ones of the chains numbered before it, on every call
+	require.Equal(t, types.ETHEREUM, claims[2].Chain)
+	for i := 0; i < 20; i++ {
+		again, err := keepers.ClaimKeeper.GetAllClaimRecords(ctx)
+		require.NoError(t, err)
+		require.Equal(t, claims, again)
+	}
 }
 
 func TestClaimFlow(t *testing.T) {
@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pkg/errors"
+
+	"github.com/arkeonetwork/arkeo/x/claim/types"
+)
+
+// ClawbackEndedAirdrops sweep the balances left unclaimed in the airdrops ended, by the block time, to the community
+// pool. The claim records keep what was claimed of them, the remaining portion alone forfeited, the module account
+// holding nothing else of the claim denom. A round is swept once, the airdrop of the claim records on every block past
+// its end.
+func (k Keeper) ClawbackEndedAirdrops(ctx sdk.Context) error {
+	params := k.GetParams(ctx)
+	if params.AirdropEnded(ctx.BlockTime()) {
+		if err := k.clawback(ctx, 0, k.GetModuleAccountAddress(ctx), params.ClaimDenom); err != nil {
+			return err
+		}
+	}
+
+	for _, id := range k.GetEndedClaimRoundIds(ctx, ctx.BlockTime()) {
+		round, found, err := k.GetClaimRound(ctx, id)
+		if err != nil {
+			return err
+		}
+		if !found {
+			return errors.Errorf("claim round %d not found", id)
+		}
+		if err := k.clawback(ctx, round.Id, types.ClaimRoundAccount(round.Id), round.Denom); err != nil {
+			return err
+		}
+		k.RemoveClaimRoundEnd(ctx, round)
+	}
+	return nil
+}
+
+// clawback sweep the balance of the account to the community pool, the round 0 the airdrop of the claim records
+func (k Keeper) clawback(ctx sdk.Context, roundId uint64, account sdk.AccAddress, denom string) error {
+	balance := k.bankKeeper.GetBalance(ctx, account, denom)
+	if !balance.IsPositive() {
+		return nil
+	}
+	if err := k.distrKeeper.FundCommunityPool(ctx, sdk.NewCoins(balance), account); err != nil {
+		return errors.Wrapf(err, "failed to sweep %s of claim round %d to the community pool", balance, roundId)
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeClawback,
+			sdk.NewAttribute(types.AttributeKeyClaimRound, strconv.FormatUint(roundId, 10)),
+			sdk.NewAttribute(types.AttributeKeyAccount, account.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, balance.String()),
+		),
+	})
+	return nil
+}
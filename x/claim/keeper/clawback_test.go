@@ -0,0 +1,179 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/arkeonetwork/arkeo/testutil/utils"
+	"github.com/arkeonetwork/arkeo/x/claim/merkle"
+	"github.com/arkeonetwork/arkeo/x/claim/types"
+)
+
+func TestClawbackAirdrop(t *testing.T) {
+	msgServer, keepers, ctx := setupMsgServer(t)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	k := keepers.ClaimKeeper
+	params := k.GetParams(sdkCtx)
+	decayEnd := params.AirdropStartTime.Add(params.DurationUntilDecay + params.DurationOfDecay)
+	moduleAddr := k.GetModuleAccountAddress(sdkCtx)
+	communityPool := func(ctx sdk.Context) sdkmath.Int {
+		pool, err := keepers.DistrKeeper.FeePool.Get(ctx)
+		require.NoError(t, err)
+		return pool.CommunityPool.AmountOf(types.DefaultClaimDenom).TruncateInt()
+	}
+
+	addrs := []sdk.AccAddress{utils.GetRandomArkeoAddress(), utils.GetRandomArkeoAddress()}
+	for _, addr := range addrs {
+		require.NoError(t, k.SetClaimRecord(sdkCtx, types.ClaimRecord{
+			Chain:          types.ARKEO,
+			Address:        addr.String(),
+			AmountClaim:    sdk.NewInt64Coin(types.DefaultClaimDenom, 1000),
+			AmountVote:     sdk.NewInt64Coin(types.DefaultClaimDenom, 1000),
+			AmountDelegate: sdk.NewInt64Coin(types.DefaultClaimDenom, 1000),
+		}))
+	}
+	require.NoError(t, keepers.BankKeeper.MintCoins(sdkCtx, types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(types.DefaultClaimDenom, 6000))))
+
+	// without an end time nothing is swept, even past the decay
+	require.True(t, params.AirdropEndTime.IsZero())
+	require.NoError(t, k.ClawbackEndedAirdrops(sdkCtx.WithBlockTime(decayEnd.Add(time.Hour))))
+	require.Equal(t, int64(6000), k.GetModuleAccountBalance(sdkCtx).Amount.Int64())
+
+	// the end set by governance, at the end of the decay
+	params.AirdropEndTime = decayEnd
+	k.SetParams(sdkCtx, params)
+	end := params.AirdropEndTime
+
+	// claimed in full before the decay, nothing swept before the end
+	_, err := msgServer.ClaimArkeo(sdkCtx, &types.MsgClaimArkeo{Creator: addrs[0].String()})
+	require.NoError(t, err)
+	require.Equal(t, int64(1000), keepers.BankKeeper.GetBalance(sdkCtx, addrs[0], types.DefaultClaimDenom).Amount.Int64())
+	require.NoError(t, k.ClawbackEndedAirdrops(sdkCtx))
+	require.Equal(t, int64(5000), k.GetModuleAccountBalance(sdkCtx).Amount.Int64())
+
+	// just before the end the decayed claim is paid
+	beforeCtx := sdkCtx.WithBlockTime(end.Add(-time.Minute))
+	_, err = msgServer.ClaimArkeo(beforeCtx, &types.MsgClaimArkeo{Creator: addrs[1].String()})
+	require.NoError(t, err)
+	decayed := keepers.BankKeeper.GetBalance(beforeCtx, addrs[1], types.DefaultClaimDenom).Amount
+	require.True(t, decayed.IsPositive())
+	require.True(t, decayed.LT(sdkmath.NewInt(1000)))
+
+	// the end itself is still part of the airdrop
+	endCtx := sdkCtx.WithBlockTime(end)
+	require.NoError(t, k.ClawbackEndedAirdrops(endCtx))
+	require.Equal(t, int64(5000)-decayed.Int64(), k.GetModuleAccountBalance(endCtx).Amount.Int64())
+
+	// just after the end the claims are rejected
+	afterCtx := sdkCtx.WithBlockTime(end.Add(time.Nanosecond))
+	_, err = k.ClaimCoinsForAction(afterCtx, addrs[0].String(), types.ACTION_VOTE)
+	require.ErrorIs(t, err, types.ErrAirdropEnded)
+	_, err = k.GetClaimableAmountForAction(afterCtx, addrs[1].String(), types.ACTION_DELEGATE, types.ARKEO)
+	require.ErrorIs(t, err, types.ErrAirdropEnded)
+
+	// the remaining portions alone are swept, what was claimed is kept
+	remaining := k.GetModuleAccountBalance(afterCtx).Amount
+	poolBefore := communityPool(afterCtx)
+	afterCtx = afterCtx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.ClawbackEndedAirdrops(afterCtx))
+	require.True(t, k.GetModuleAccountBalance(afterCtx).IsZero())
+	require.Equal(t, poolBefore.Add(remaining), communityPool(afterCtx))
+	require.Equal(t, int64(1000), keepers.BankKeeper.GetBalance(afterCtx, addrs[0], types.DefaultClaimDenom).Amount.Int64())
+	require.Equal(t, decayed, keepers.BankKeeper.GetBalance(afterCtx, addrs[1], types.DefaultClaimDenom).Amount)
+	requireClawbackEvent(t, afterCtx, "0", moduleAddr, remaining)
+
+	// swept once, nothing left for the next blocks
+	afterCtx = afterCtx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.ClawbackEndedAirdrops(afterCtx))
+	require.Empty(t, afterCtx.EventManager().Events())
+	require.Equal(t, poolBefore.Add(remaining), communityPool(afterCtx))
+}
+
+func TestClawbackClaimRound(t *testing.T) {
+	msgServer, keepers, ctx := setupMsgServer(t)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	k := keepers.ClaimKeeper
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	end := sdkCtx.BlockTime().Add(time.Hour)
+
+	addrs := []sdk.AccAddress{utils.GetRandomArkeoAddress(), utils.GetRandomArkeoAddress(), utils.GetRandomArkeoAddress()}
+	amounts := []sdkmath.Int{sdkmath.NewInt(100), sdkmath.NewInt(200), sdkmath.NewInt(300)}
+	leaves := make([][]byte, len(addrs))
+	for i, addr := range addrs {
+		leaves[i] = merkle.Leaf(addr.String(), amounts[i])
+	}
+	tree, err := merkle.NewTree(leaves)
+	require.NoError(t, err)
+	proof := func(i int) [][]byte {
+		proof, err := tree.Proof(i)
+		require.NoError(t, err)
+		return proof
+	}
+
+	// the end of a round is after its creation
+	_, err = msgServer.CreateClaimRound(sdkCtx, types.NewMsgCreateClaimRound(authority, tree.Root(), types.DefaultClaimDenom, sdkCtx.BlockTime()))
+	require.Error(t, err)
+	res, err := msgServer.CreateClaimRound(sdkCtx, types.NewMsgCreateClaimRound(authority, tree.Root(), types.DefaultClaimDenom, end))
+	require.NoError(t, err)
+	account := types.ClaimRoundAccount(res.RoundId)
+	require.NoError(t, keepers.BankKeeper.MintCoins(sdkCtx, types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(types.DefaultClaimDenom, 600))))
+	require.NoError(t, keepers.BankKeeper.SendCoinsFromModuleToAccount(sdkCtx, types.ModuleName, account, sdk.NewCoins(sdk.NewInt64Coin(types.DefaultClaimDenom, 600))))
+
+	// just before the end claims are paid, and up to the end nothing is swept
+	beforeCtx := sdkCtx.WithBlockTime(end.Add(-time.Second))
+	_, err = msgServer.ClaimMerkle(beforeCtx, types.NewMsgClaimMerkle(addrs[0], res.RoundId, amounts[0], proof(0)))
+	require.NoError(t, err)
+	endCtx := sdkCtx.WithBlockTime(end)
+	_, err = msgServer.ClaimMerkle(endCtx, types.NewMsgClaimMerkle(addrs[1], res.RoundId, amounts[1], proof(1)))
+	require.NoError(t, err)
+	require.NoError(t, k.ClawbackEndedAirdrops(endCtx))
+	require.Equal(t, int64(300), keepers.BankKeeper.GetBalance(endCtx, account, types.DefaultClaimDenom).Amount.Int64())
+
+	// just after the end claims are rejected and the unclaimed balance swept
+	afterCtx := sdkCtx.WithBlockTime(end.Add(time.Nanosecond)).WithEventManager(sdk.NewEventManager())
+	_, err = msgServer.ClaimMerkle(afterCtx, types.NewMsgClaimMerkle(addrs[2], res.RoundId, amounts[2], proof(2)))
+	require.ErrorIs(t, err, types.ErrAirdropEnded)
+	require.False(t, k.IsClaimRoundClaimed(afterCtx, res.RoundId, addrs[2].String()))
+	pool, err := keepers.DistrKeeper.FeePool.Get(afterCtx)
+	require.NoError(t, err)
+	poolBefore := pool.CommunityPool.AmountOf(types.DefaultClaimDenom).TruncateInt()
+	require.NoError(t, k.ClawbackEndedAirdrops(afterCtx))
+	require.True(t, keepers.BankKeeper.GetBalance(afterCtx, account, types.DefaultClaimDenom).IsZero())
+	pool, err = keepers.DistrKeeper.FeePool.Get(afterCtx)
+	require.NoError(t, err)
+	require.Equal(t, poolBefore.AddRaw(300), pool.CommunityPool.AmountOf(types.DefaultClaimDenom).TruncateInt())
+	requireClawbackEvent(t, afterCtx, "1", account, sdkmath.NewInt(300))
+
+	// the round is swept once, what the account receives after is left to it
+	require.Empty(t, k.GetEndedClaimRoundIds(afterCtx, afterCtx.BlockTime()))
+	require.NoError(t, keepers.BankKeeper.MintCoins(afterCtx, types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(types.DefaultClaimDenom, 5))))
+	require.NoError(t, keepers.BankKeeper.SendCoinsFromModuleToAccount(afterCtx, types.ModuleName, account, sdk.NewCoins(sdk.NewInt64Coin(types.DefaultClaimDenom, 5))))
+	afterCtx = afterCtx.WithBlockTime(end.Add(time.Hour)).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.ClawbackEndedAirdrops(afterCtx))
+	require.Empty(t, afterCtx.EventManager().Events())
+	require.Equal(t, int64(5), keepers.BankKeeper.GetBalance(afterCtx, account, types.DefaultClaimDenom).Amount.Int64())
+}
+
+func requireClawbackEvent(t *testing.T, ctx sdk.Context, round string, account sdk.AccAddress, amount sdkmath.Int) {
+	t.Helper()
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != types.EventTypeClawback {
+			continue
+		}
+		attrs := map[string]string{}
+		for _, attr := range event.Attributes {
+			attrs[attr.Key] = attr.Value
+		}
+		require.Equal(t, round, attrs[types.AttributeKeyClaimRound])
+		require.Equal(t, account.String(), attrs[types.AttributeKeyAccount])
+		require.Equal(t, sdk.NewCoin(types.DefaultClaimDenom, amount).String(), attrs[sdk.AttributeKeyAmount])
+		return
+	}
+	require.Fail(t, "no clawback event")
+}
@@ -21,6 +21,8 @@ type (
 		paramstore    paramtypes.Subspace
 		accountKeeper types.AccountKeeper
 		bankKeeper    types.BankKeeper
+		distrKeeper   types.DistributionKeeper
+		authority     string
 		logger        log.Logger
 	}
 )
@@ -30,8 +32,10 @@ func NewKeeper(
 	storeKey storetypes.StoreKey,
 	accountKeeper types.AccountKeeper,
 	bankKeeper types.BankKeeper,
+	distrKeeper types.DistributionKeeper,
 	memKey storetypes.StoreKey,
 	ps paramtypes.Subspace,
+	authority string,
 	logger log.Logger,
 ) Keeper {
 	// set KeyTable if it has not already been set
@@ -44,8 +48,10 @@ func NewKeeper(
 		storeKey:      storeKey,
 		accountKeeper: accountKeeper,
 		bankKeeper:    bankKeeper,
+		distrKeeper:   distrKeeper,
 		memKey:        memKey,
 		paramstore:    ps,
+		authority:     authority,
 		logger:        logger.With("module", fmt.Sprintf("x/%s", types.ModuleName)),
 	}
 }
@@ -54,6 +60,11 @@ func (k Keeper) Logger(ctx context.Context) log.Logger {
 	return k.logger
 }
 
+// GetAuthority returns the address allowed to create the claim rounds, the governance module
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
 func (k Keeper) AfterProposalVote(ctx context.Context, proposalID uint64, voterAddr sdk.AccAddress) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 
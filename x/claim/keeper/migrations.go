@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Migrator is a struct for handling in-place store migrations
+type Migrator struct {
+	keeper Keeper
+}
+
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 store the airdrop end time with no end, the unclaimed balance of the module is swept once governance
+// sets one rather than as the decay ends, and index the rounds by their end to sweep them once
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	m.keeper.SetParams(ctx, m.keeper.GetParams(ctx))
+
+	rounds, err := m.keeper.GetAllClaimRounds(ctx)
+	if err != nil {
+		return err
+	}
+	for _, round := range rounds {
+		if err := m.keeper.SetClaimRound(ctx, round); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	testkeeper "github.com/arkeonetwork/arkeo/testutil/keeper"
+	"github.com/arkeonetwork/arkeo/x/claim/keeper"
+	"github.com/arkeonetwork/arkeo/x/claim/types"
+)
+
+func TestMigrate1to2(t *testing.T) {
+	keepers, ctx := testkeeper.CreateTestClaimKeepers(t)
+	k := keepers.ClaimKeeper
+	params := k.GetParams(ctx)
+	decayEnd := params.AirdropStartTime.Add(params.DurationUntilDecay + params.DurationOfDecay)
+	require.NoError(t, keepers.BankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(types.DefaultClaimDenom, 1000))))
+
+	// a round stored before the rounds were indexed by their end, and one without an end
+	round := types.ClaimRound{Id: 1, MerkleRoot: make([]byte, 32), Denom: types.DefaultClaimDenom, Account: types.ClaimRoundAccount(1).String(), EndTime: decayEnd}
+	require.NoError(t, k.SetClaimRound(ctx, round))
+	k.RemoveClaimRoundEnd(ctx, round)
+	require.NoError(t, k.SetClaimRound(ctx, types.ClaimRound{Id: 2, MerkleRoot: make([]byte, 32), Denom: types.DefaultClaimDenom, Account: types.ClaimRoundAccount(2).String()}))
+	require.Empty(t, k.GetEndedClaimRoundIds(ctx, decayEnd.Add(time.Hour)))
+
+	require.NoError(t, keeper.NewMigrator(k).Migrate1to2(ctx))
+	require.Equal(t, []uint64{1}, k.GetEndedClaimRoundIds(ctx, decayEnd.Add(time.Hour)))
+	require.Empty(t, k.GetEndedClaimRoundIds(ctx, decayEnd))
+
+	// the end is stored with no end, the balance isn't swept past the decay until governance sets one
+	require.True(t, k.GetParams(ctx).AirdropEndTime.IsZero())
+	afterCtx := ctx.WithBlockTime(decayEnd.Add(time.Hour))
+	require.NoError(t, k.ClawbackEndedAirdrops(afterCtx))
+	require.Equal(t, int64(1000), k.GetModuleAccountBalance(afterCtx).Amount.Int64())
+
+	params.AirdropEndTime = decayEnd
+	k.SetParams(ctx, params)
+	require.NoError(t, keeper.NewMigrator(k).Migrate1to2(ctx))
+	require.Equal(t, decayEnd, k.GetParams(ctx).AirdropEndTime)
+	require.NoError(t, k.ClawbackEndedAirdrops(afterCtx))
+	require.True(t, k.GetModuleAccountBalance(afterCtx).IsZero())
+}
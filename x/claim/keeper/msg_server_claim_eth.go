@@ -5,9 +5,11 @@ import (
 	"context"
 	"encoding/hex"
 	"fmt"
+	"math/big"
 	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/signer/core/apitypes"
@@ -34,7 +36,7 @@ func (k msgServer) ClaimEth(goCtx context.Context, msg *types.MsgClaimEth) (*typ
 
 	// validate signature
 	isValid, err := IsValidClaimSignature(msg.EthAddress, msg.Creator,
-		totalAmountClaimable.Amount.String(), msg.Signature)
+		totalAmountClaimable.Amount.String(), msg.Signature, msg.SignMode)
 	if err != nil {
 		return nil, errors.Wrapf(types.ErrInvalidSignature, "failed to validate signature for %s", msg.EthAddress)
 	}
@@ -128,30 +130,39 @@ func GenerateClaimTypedDataBytes(ethAddress, arkeoAddress, amount string) ([]byt
 	return []byte(fmt.Sprintf("\x19\x01%s%s", string(domainSeparator), string(typedDataHash))), nil
 }
 
-func IsValidClaimSignature(ethAddress, arkeoAdddress, amount, signature string) (bool, error) {
-	rawData, err := GenerateClaimTypedDataBytes(ethAddress, arkeoAdddress, amount)
+// ClaimSignatureHash the hash the signature of the eth claim is over, the EIP712 hash of the claim signed as is or as
+// an EIP191 personal message
+func ClaimSignatureHash(ethAddress, arkeoAddress, amount string, signMode types.EthSignMode) ([]byte, error) {
+	rawData, err := GenerateClaimTypedDataBytes(ethAddress, arkeoAddress, amount)
 	if err != nil {
-		return false, errors.Wrapf(err, "failed to generate claim typed data bytes")
+		return nil, errors.Wrapf(err, "failed to generate claim typed data bytes")
 	}
-	rawDataHash := crypto.Keccak256Hash(rawData)
-	sigHex, err := hexDecode(signature)
-	if err != nil {
-		return false, errors.Wrapf(err, "failed to hex decode signature")
+	hash := crypto.Keccak256(rawData)
+	switch signMode {
+	case types.ETH_SIGN_RAW_HASH:
+		return hash, nil
+	case types.ETH_SIGN_PERSONAL:
+		return accounts.TextHash(hash), nil
+	default:
+		return nil, fmt.Errorf("unknown sign mode %d", signMode)
 	}
+}
 
-	if len(sigHex) != crypto.SignatureLength {
-		return false, fmt.Errorf("signature must be %d bytes long", crypto.SignatureLength)
+func IsValidClaimSignature(ethAddress, arkeoAdddress, amount, signature string, signMode types.EthSignMode) (bool, error) {
+	hash, err := ClaimSignatureHash(ethAddress, arkeoAdddress, amount, signMode)
+	if err != nil {
+		return false, err
 	}
-
-	if len(sigHex) != 65 {
-		return false, fmt.Errorf("invalid signature length: %d", len(sigHex))
+	sigHex, err := hexDecode(signature)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to hex decode signature")
 	}
-
-	if sigHex[crypto.RecoveryIDOffset] == 27 || sigHex[crypto.RecoveryIDOffset] == 28 {
-		sigHex[crypto.RecoveryIDOffset] -= 27 // Transform yellow paper V from 27/28 to 0/1
+	sig, err := normalizeSignature(sigHex)
+	if err != nil {
+		return false, err
 	}
 
-	pubKeyRaw, err := crypto.Ecrecover(rawDataHash.Bytes(), sigHex)
+	pubKeyRaw, err := crypto.Ecrecover(hash, sig)
 	if err != nil {
 		return false, errors.Wrapf(err, "failed to recover public key from signature")
 	}
@@ -161,6 +172,7 @@ func IsValidClaimSignature(ethAddress, arkeoAdddress, amount, signature string)
 		return false, errors.Wrapf(err, "failed to unmarshal public key from signature")
 	}
 
+	// the addresses compared as bytes, whatever the case of the hex
 	recoveredAddr := crypto.PubkeyToAddress(*pubKey)
 	if !bytes.Equal(common.HexToAddress(ethAddress).Bytes(), recoveredAddr.Bytes()) {
 		return false, errors.New("signature does not match address")
@@ -168,6 +180,39 @@ func IsValidClaimSignature(ethAddress, arkeoAdddress, amount, signature string)
 	return true, nil
 }
 
+// normalizeSignature the r || s || v signature with the recovery id of v, the v of the wallets being the recovery id
+// (0/1), the yellow paper one (27/28) or the EIP155 one holding the chain id (35 + 2 * chain id + 0/1), the latter
+// taking more than a byte for the larger chain ids. The s of the signature is in the lower half of the order of the
+// curve, as the wallets sign, so no other signature of the same claim is valid.
+func normalizeSignature(sig []byte) ([]byte, error) {
+	if len(sig) < crypto.SignatureLength || len(sig) > crypto.SignatureLength+8 {
+		return nil, fmt.Errorf("invalid signature length: %d", len(sig))
+	}
+	v := new(big.Int).SetBytes(sig[crypto.RecoveryIDOffset:])
+	var recoveryID byte
+	switch {
+	case v.Cmp(big.NewInt(1)) <= 0:
+		recoveryID = byte(v.Uint64())
+	case v.Cmp(big.NewInt(27)) == 0, v.Cmp(big.NewInt(28)) == 0:
+		recoveryID = byte(v.Uint64() - 27)
+	case v.Cmp(big.NewInt(35)) >= 0:
+		recoveryID = byte(new(big.Int).Sub(v, big.NewInt(35)).Bit(0))
+	default:
+		return nil, fmt.Errorf("invalid signature v: %s", v)
+	}
+
+	r := new(big.Int).SetBytes(sig[:32])
+	s := new(big.Int).SetBytes(sig[32:crypto.RecoveryIDOffset])
+	if !crypto.ValidateSignatureValues(recoveryID, r, s, true) {
+		return nil, errors.New("invalid signature values")
+	}
+
+	normalized := make([]byte, crypto.SignatureLength)
+	copy(normalized, sig[:crypto.RecoveryIDOffset])
+	normalized[crypto.RecoveryIDOffset] = recoveryID
+	return normalized, nil
+}
+
 // HexDecode returns the bytes represented by the hexadecimal string s.
 // s may be prefixed with "0x".
 func hexDecode(s string) ([]byte, error) {
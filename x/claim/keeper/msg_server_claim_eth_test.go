@@ -3,10 +3,12 @@ package keeper_test
 import (
 	"crypto/ecdsa"
 	"errors"
+	"math/big"
 	"strings"
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/require"
@@ -221,24 +223,78 @@ func TestIsValidClaimSignature(t *testing.T) {
 	require.NoError(t, err)
 
 	// check if signature is valid
-	valid, err := keeper.IsValidClaimSignature(strings.ToLower(addressEth), addrArkeo, "5000", sigString)
+	valid, err := keeper.IsValidClaimSignature(strings.ToLower(addressEth), addrArkeo, "5000", sigString, types.ETH_SIGN_RAW_HASH)
 	require.NoError(t, err)
 	require.True(t, valid)
 
 	// if we modify the message, signature should be invalid
-	_, err = keeper.IsValidClaimSignature(addressEth, addrArkeo, "5001", sigString)
+	_, err = keeper.IsValidClaimSignature(addressEth, addrArkeo, "5001", sigString, types.ETH_SIGN_RAW_HASH)
 	require.Error(t, err)
 
 	// if we modify the arkeo address, signature should be invalid
 	addrArkeo2 := utils.GetRandomArkeoAddress().String()
-	_, err = keeper.IsValidClaimSignature(addressEth, addrArkeo2, "5000", sigString)
+	_, err = keeper.IsValidClaimSignature(addressEth, addrArkeo2, "5000", sigString, types.ETH_SIGN_RAW_HASH)
 	require.Error(t, err)
 
 	// if we modify the eth address, signature should be invalid
-	_, err = keeper.IsValidClaimSignature("0xbd3afb0bb76683ecb4225f9dbc91f998713c3b01", addrArkeo, "5000", sigString)
+	_, err = keeper.IsValidClaimSignature("0xbd3afb0bb76683ecb4225f9dbc91f998713c3b01", addrArkeo, "5000", sigString, types.ETH_SIGN_RAW_HASH)
 	require.Error(t, err)
 }
 
+func TestIsValidClaimSignatureVectors(t *testing.T) {
+	// signed with the private key 0x4c0883a69102937d6231471b5dbb6204fe5129617082792ae468d01a3f362318, the claim of 5000
+	// to the arkeo address, in the v encodings of the signers. The recovery id of the raw hash signature is 0, the one of
+	// the personal message signature 1.
+	const (
+		addressEth = "0x2c7536E3605D9C16a7a3D7b1898e529396a65c23"
+		addrArkeo  = "arkeo1s7fyy3cg0zlmuv0nye5w46gf8r4nqtsk26al3k"
+		rawHashRS  = "0x8326f10d34a3b1a26594cd1ba1b0f3c7615453780727e717ed95acedb02682a420af912811fa9b78b1743cfe076d4982ae527966095b5f4c888122c75f869dac"
+		personalRS = "0xfd5e2bc3f5dc8489ed14c385cbfcbac609f9f7a533b1e687bc7a41118caa38ee7c5dfb343614236b2b89d8e235bc1f7f08af312a0d2f01fee7c8bcbb9e28afcb"
+	)
+	highS := func(rs string, v byte) string {
+		sig := hexutil.MustDecode(rs)
+		s := new(big.Int).Sub(crypto.S256().Params().N, new(big.Int).SetBytes(sig[32:]))
+		return hexutil.Encode(append(append(sig[:32:32], common.LeftPadBytes(s.Bytes(), 32)...), v^1))
+	}
+
+	tests := []struct {
+		name       string
+		ethAddress string
+		signature  string
+		signMode   types.EthSignMode
+		err        string
+	}{
+		{name: "raw secp256k1 signer, v 0/1", ethAddress: addressEth, signature: rawHashRS + "00", signMode: types.ETH_SIGN_RAW_HASH},
+		{name: "metamask eth_signTypedData_v4, v 27/28", ethAddress: addressEth, signature: rawHashRS + "1b", signMode: types.ETH_SIGN_RAW_HASH},
+		{name: "metamask personal_sign, v 27/28", ethAddress: addressEth, signature: personalRS + "1c", signMode: types.ETH_SIGN_PERSONAL},
+		{name: "ledger personal message, v 0/1", ethAddress: addressEth, signature: personalRS + "01", signMode: types.ETH_SIGN_PERSONAL},
+		{name: "ledger chain id 1 in v", ethAddress: addressEth, signature: rawHashRS + "25", signMode: types.ETH_SIGN_RAW_HASH},
+		{name: "ledger chain id 43114 in v", ethAddress: addressEth, signature: personalRS + "0150f8", signMode: types.ETH_SIGN_PERSONAL},
+		{name: "lower case address", ethAddress: strings.ToLower(addressEth), signature: rawHashRS + "1b", signMode: types.ETH_SIGN_RAW_HASH},
+		{name: "upper case hex, no 0x", ethAddress: strings.ToUpper(addressEth[2:]), signature: strings.ToUpper(personalRS[2:]) + "1C", signMode: types.ETH_SIGN_PERSONAL},
+		{name: "raw hash signature as personal message", ethAddress: addressEth, signature: rawHashRS + "1b", signMode: types.ETH_SIGN_PERSONAL, err: "does not match"},
+		{name: "personal message signature as raw hash", ethAddress: addressEth, signature: personalRS + "1c", signMode: types.ETH_SIGN_RAW_HASH, err: "does not match"},
+		{name: "other recovery id", ethAddress: addressEth, signature: rawHashRS + "1c", signMode: types.ETH_SIGN_RAW_HASH, err: "does not match"},
+		{name: "other chain id parity", ethAddress: addressEth, signature: rawHashRS + "26", signMode: types.ETH_SIGN_RAW_HASH, err: "does not match"},
+		{name: "high s", ethAddress: addressEth, signature: highS(rawHashRS, 0), signMode: types.ETH_SIGN_RAW_HASH, err: "invalid signature values"},
+		{name: "v between the encodings", ethAddress: addressEth, signature: rawHashRS + "1d", signMode: types.ETH_SIGN_RAW_HASH, err: "invalid signature v"},
+		{name: "v missing", ethAddress: addressEth, signature: rawHashRS, signMode: types.ETH_SIGN_RAW_HASH, err: "invalid signature length"},
+		{name: "unknown sign mode", ethAddress: addressEth, signature: personalRS + "01", signMode: types.EthSignMode(2), err: "unknown sign mode"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			valid, err := keeper.IsValidClaimSignature(tt.ethAddress, addrArkeo, "5000", tt.signature, tt.signMode)
+			if tt.err != "" {
+				require.ErrorContains(t, err, tt.err)
+				require.False(t, valid)
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, valid)
+		})
+	}
+}
+
 func generateSignedEthClaim(addrArkeo, amount string) (string, string, error) {
 	// generate a random eth address
 	privateKey, err := crypto.GenerateKey()
@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pkg/errors"
+
+	"github.com/arkeonetwork/arkeo/x/claim/merkle"
+	"github.com/arkeonetwork/arkeo/x/claim/types"
+)
+
+// ClaimMerkle pay the claim of the creator in the round from the account of the round, once, the proof leading from
+// the leaf of the claim to the merkle root of the round
+func (k msgServer) ClaimMerkle(goCtx context.Context, msg *types.MsgClaimMerkle) (*types.MsgClaimMerkleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	round, ok, err := k.GetClaimRound(ctx, msg.RoundId)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, errors.Wrapf(types.ErrClaimRoundNotFound, "claim round %d", msg.RoundId)
+	}
+	if round.HasEnded(ctx.BlockTime()) {
+		return nil, errors.Wrapf(types.ErrAirdropEnded, "claim round %d ended at %s", round.Id, round.EndTime)
+	}
+	if k.IsClaimRoundClaimed(ctx, round.Id, msg.Creator) {
+		return nil, errors.Wrapf(types.ErrClaimRoundClaimed, "%s in claim round %d", msg.Creator, round.Id)
+	}
+	if !merkle.Verify(round.MerkleRoot, merkle.Leaf(msg.Creator, msg.Amount), msg.Proof) {
+		return nil, errors.Wrapf(types.ErrInvalidMerkleProof, "claim of %s%s by %s in claim round %d", msg.Amount, round.Denom, msg.Creator, round.Id)
+	}
+
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+	amount := sdk.NewCoin(round.Denom, msg.Amount)
+	if err := k.bankKeeper.SendCoins(ctx, types.ClaimRoundAccount(round.Id), creator, sdk.NewCoins(amount)); err != nil {
+		return nil, errors.Wrapf(err, "failed to pay the claim of %s in claim round %d", msg.Creator, round.Id)
+	}
+	k.SetClaimRoundClaimed(ctx, round.Id, msg.Creator)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeClaimMerkle,
+			sdk.NewAttribute(types.AttributeKeyClaimRound, strconv.FormatUint(round.Id, 10)),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Creator),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+		),
+	})
+
+	return &types.MsgClaimMerkleResponse{}, nil
+}
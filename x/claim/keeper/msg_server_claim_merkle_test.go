@@ -0,0 +1,79 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/arkeonetwork/arkeo/testutil/utils"
+	"github.com/arkeonetwork/arkeo/x/claim/merkle"
+	"github.com/arkeonetwork/arkeo/x/claim/types"
+)
+
+func TestClaimMerkle(t *testing.T) {
+	msgServer, keepers, ctx := setupMsgServer(t)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	addrs := []sdk.AccAddress{utils.GetRandomArkeoAddress(), utils.GetRandomArkeoAddress(), utils.GetRandomArkeoAddress()}
+	amounts := []sdkmath.Int{sdkmath.NewInt(100), sdkmath.NewInt(200), sdkmath.NewInt(300)}
+	leaves := make([][]byte, len(addrs))
+	for i, addr := range addrs {
+		leaves[i] = merkle.Leaf(addr.String(), amounts[i])
+	}
+	tree, err := merkle.NewTree(leaves)
+	require.NoError(t, err)
+	proof := func(i int) [][]byte {
+		proof, err := tree.Proof(i)
+		require.NoError(t, err)
+		return proof
+	}
+
+	// the rounds are created by governance alone
+	_, err = msgServer.CreateClaimRound(ctx, types.NewMsgCreateClaimRound(addrs[0].String(), tree.Root(), types.DefaultClaimDenom, time.Time{}))
+	require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	res, err := msgServer.CreateClaimRound(ctx, types.NewMsgCreateClaimRound(authority, tree.Root(), types.DefaultClaimDenom, time.Time{}))
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), res.RoundId)
+	require.Equal(t, types.ClaimRoundAccount(1).String(), res.Account)
+
+	// nothing is paid until the account of the round is funded
+	_, err = msgServer.ClaimMerkle(ctx, types.NewMsgClaimMerkle(addrs[0], 1, amounts[0], proof(0)))
+	require.Error(t, err)
+	require.False(t, keepers.ClaimKeeper.IsClaimRoundClaimed(sdkCtx, 1, addrs[0].String()))
+	require.NoError(t, keepers.BankKeeper.MintCoins(sdkCtx, types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(types.DefaultClaimDenom, 1000))))
+	require.NoError(t, keepers.BankKeeper.SendCoinsFromModuleToAccount(sdkCtx, types.ModuleName, types.ClaimRoundAccount(1), sdk.NewCoins(sdk.NewInt64Coin(types.DefaultClaimDenom, 1000))))
+
+	// valid proofs pay their claims
+	for i, addr := range addrs {
+		_, err = msgServer.ClaimMerkle(ctx, types.NewMsgClaimMerkle(addr, 1, amounts[i], proof(i)))
+		require.NoError(t, err)
+		require.Equal(t, amounts[i], keepers.BankKeeper.GetBalance(sdkCtx, addr, types.DefaultClaimDenom).Amount)
+		require.True(t, keepers.ClaimKeeper.IsClaimRoundClaimed(sdkCtx, 1, addr.String()))
+	}
+	round, err := keepers.ClaimKeeper.ClaimRound(ctx, &types.QueryClaimRoundRequest{Id: 1})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin(types.DefaultClaimDenom, 400), round.Balance)
+
+	// replayed claims are rejected
+	_, err = msgServer.ClaimMerkle(ctx, types.NewMsgClaimMerkle(addrs[1], 1, amounts[1], proof(1)))
+	require.ErrorIs(t, err, types.ErrClaimRoundClaimed)
+
+	// forged proofs are rejected: another amount, another claimant, another round
+	outsider := utils.GetRandomArkeoAddress()
+	_, err = msgServer.ClaimMerkle(ctx, types.NewMsgClaimMerkle(outsider, 1, amounts[0], proof(0)))
+	require.ErrorIs(t, err, types.ErrInvalidMerkleProof)
+	res, err = msgServer.CreateClaimRound(ctx, types.NewMsgCreateClaimRound(authority, tree.Root(), "uother", time.Time{}))
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), res.RoundId)
+	_, err = msgServer.ClaimMerkle(ctx, types.NewMsgClaimMerkle(addrs[0], 2, amounts[0].AddRaw(1), proof(0)))
+	require.ErrorIs(t, err, types.ErrInvalidMerkleProof)
+	_, err = msgServer.ClaimMerkle(ctx, types.NewMsgClaimMerkle(addrs[0], 3, amounts[0], proof(0)))
+	require.ErrorIs(t, err, types.ErrClaimRoundNotFound)
+	require.Equal(t, sdkmath.ZeroInt(), keepers.BankKeeper.GetBalance(sdkCtx, outsider, types.DefaultClaimDenom).Amount)
+}
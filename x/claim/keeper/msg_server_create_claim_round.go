@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/pkg/errors"
+
+	"github.com/arkeonetwork/arkeo/x/claim/types"
+)
+
+// CreateClaimRound create an airdrop round of the merkle root, its account to be funded for its claims to be paid
+func (k msgServer) CreateClaimRound(goCtx context.Context, msg *types.MsgCreateClaimRound) (*types.MsgCreateClaimRoundResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.Authority != k.GetAuthority() {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "expected %s, got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	if !msg.EndTime.IsZero() && !msg.EndTime.After(ctx.BlockTime()) {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "claim round end time %s is not after the block time %s", msg.EndTime, ctx.BlockTime())
+	}
+
+	id := k.GetNextClaimRoundId(ctx)
+	round := types.ClaimRound{
+		Id:         id,
+		MerkleRoot: msg.MerkleRoot,
+		Denom:      msg.Denom,
+		Account:    types.ClaimRoundAccount(id).String(),
+		EndTime:    msg.EndTime,
+	}
+	if err := round.Validate(); err != nil {
+		return nil, err
+	}
+	if err := k.SetClaimRound(ctx, round); err != nil {
+		return nil, err
+	}
+	k.SetNextClaimRoundId(ctx, id+1)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCreateClaimRound,
+			sdk.NewAttribute(types.AttributeKeyClaimRound, strconv.FormatUint(id, 10)),
+			sdk.NewAttribute(types.AttributeKeyAccount, round.Account),
+		),
+	})
+
+	return &types.MsgCreateClaimRoundResponse{RoundId: id, Account: round.Account}, nil
+}
@@ -15,6 +15,7 @@ func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 		k.AirdropStartTime(ctx),
 		k.DurationUntilDecay(ctx),
 		k.DurationOfDecay(ctx),
+		k.AirdropEndTime(ctx),
 	)
 }
 
@@ -28,6 +29,12 @@ func (k Keeper) AirdropStartTime(ctx sdk.Context) (res time.Time) {
 	return
 }
 
+// AirdropEndTime returns the AirdropEndTime param, zero for no end when it isn't set yet
+func (k Keeper) AirdropEndTime(ctx sdk.Context) (res time.Time) {
+	k.paramstore.GetIfExists(ctx, types.KeyAirdropEndTime, &res)
+	return
+}
+
 // DurationOfDecay returns the DurationOfDecay param
 func (k Keeper) DurationOfDecay(ctx sdk.Context) (res time.Duration) {
 	k.paramstore.Get(ctx, types.KeyDurationOfDecay, &res)
@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/arkeonetwork/arkeo/x/claim/types"
+)
+
+func (k Keeper) ClaimRound(goCtx context.Context, req *types.QueryClaimRoundRequest) (*types.QueryClaimRoundResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	round, ok, err := k.GetClaimRound(ctx, req.Id)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if !ok {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryClaimRoundResponse{
+		Round:   round,
+		Balance: k.bankKeeper.GetBalance(ctx, types.ClaimRoundAccount(round.Id), round.Denom),
+	}, nil
+}
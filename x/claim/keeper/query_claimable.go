@@ -0,0 +1,80 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/arkeonetwork/arkeo/common/cosmos"
+	"github.com/arkeonetwork/arkeo/x/claim/types"
+)
+
+// ClaimableForAction returns what the address can still claim for the action, computed as the claim computes it
+func (k Keeper) ClaimableForAction(goCtx context.Context, req *types.QueryClaimableForActionRequest) (*types.QueryClaimableForActionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if _, ok := types.Action_name[int32(req.Action)]; !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid action %d", req.Action)
+	}
+	if !types.IsValidAddress(req.Address, req.Chain) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address %s for chain %s", req.Address, req.Chain.String())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	amount, err := k.claimableForAction(ctx, req.Address, req.Action, req.Chain)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryClaimableForActionResponse{Amount: amount}, nil
+}
+
+// TotalClaimable returns what the address can still claim over all the actions, along with the actions left to claim
+func (k Keeper) TotalClaimable(goCtx context.Context, req *types.QueryTotalClaimableRequest) (*types.QueryTotalClaimableResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if !types.IsValidAddress(req.Address, req.Chain) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address %s for chain %s", req.Address, req.Chain.String())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	res := &types.QueryTotalClaimableResponse{
+		Total:   sdk.NewCoin(k.GetParams(ctx).ClaimDenom, cosmos.ZeroInt()),
+		Actions: []types.ActionClaimable{},
+	}
+	for _, action := range sortedEnumValues(types.Action_name) {
+		amount, err := k.claimableForAction(ctx, req.Address, types.Action(action), req.Chain)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		if amount.IsZero() {
+			continue
+		}
+		res.Total = res.Total.Add(amount)
+		res.Actions = append(res.Actions, types.ActionClaimable{Action: types.Action(action), Amount: amount})
+	}
+
+	return res, nil
+}
+
+// claimableForAction the claimable amount of the action in the claim denom, zero where the claim would pay nothing:
+// no record, the action claimed already, before the airdrop starts and after it ends
+func (k Keeper) claimableForAction(ctx sdk.Context, addr string, action types.Action, chain types.Chain) (sdk.Coin, error) {
+	zero := sdk.NewCoin(k.GetParams(ctx).ClaimDenom, cosmos.ZeroInt())
+	amount, err := k.GetClaimableAmountForAction(ctx, addr, action, chain)
+	if errors.Is(err, types.ErrAirdropEnded) {
+		return zero, nil
+	}
+	if err != nil {
+		return zero, err
+	}
+	if amount.IsNil() || amount.IsZero() {
+		return zero, nil
+	}
+	return amount, nil
+}
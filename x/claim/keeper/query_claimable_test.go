@@ -0,0 +1,105 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/arkeonetwork/arkeo/testutil/utils"
+	"github.com/arkeonetwork/arkeo/x/claim/types"
+)
+
+func TestClaimableQueries(t *testing.T) {
+	msgServer, keepers, ctx := setupMsgServer(t)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	addrArkeo := utils.GetRandomArkeoAddress()
+	addrEth := "0xDAFEA492D9c6733ae3d56b7Ed1ADB60692c98Bc5"
+	require.NoError(t, keepers.ClaimKeeper.SetClaimRecords(sdkCtx, []types.ClaimRecord{
+		{
+			Chain:          types.ARKEO,
+			Address:        addrArkeo.String(),
+			AmountClaim:    sdk.NewInt64Coin(types.DefaultClaimDenom, 100),
+			AmountVote:     sdk.NewInt64Coin(types.DefaultClaimDenom, 200),
+			AmountDelegate: sdk.NewInt64Coin(types.DefaultClaimDenom, 300),
+		},
+		{
+			Chain:          types.ETHEREUM,
+			Address:        addrEth,
+			AmountClaim:    sdk.NewInt64Coin(types.DefaultClaimDenom, 10),
+			AmountVote:     sdk.NewInt64Coin(types.DefaultClaimDenom, 20),
+			AmountDelegate: sdk.NewInt64Coin(types.DefaultClaimDenom, 30),
+		},
+	}))
+	require.NoError(t, keepers.BankKeeper.MintCoins(sdkCtx, types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(types.DefaultClaimDenom, 10000))))
+
+	coin := func(amount int64) sdk.Coin {
+		return sdk.NewInt64Coin(types.DefaultClaimDenom, amount)
+	}
+	claimable := func(ctx sdk.Context, address string, action types.Action, chain types.Chain) sdk.Coin {
+		t.Helper()
+		res, err := keepers.ClaimKeeper.ClaimableForAction(ctx, &types.QueryClaimableForActionRequest{Address: address, Action: action, Chain: chain})
+		require.NoError(t, err)
+		return res.Amount
+	}
+	total := func(ctx sdk.Context, address string, chain types.Chain) *types.QueryTotalClaimableResponse {
+		t.Helper()
+		res, err := keepers.ClaimKeeper.TotalClaimable(ctx, &types.QueryTotalClaimableRequest{Address: address, Chain: chain})
+		require.NoError(t, err)
+		return res
+	}
+
+	require.Equal(t, coin(100), claimable(sdkCtx, addrArkeo.String(), types.ACTION_CLAIM, types.ARKEO))
+	require.Equal(t, coin(300), claimable(sdkCtx, addrArkeo.String(), types.ACTION_DELEGATE, types.ARKEO))
+	require.Equal(t, coin(20), claimable(sdkCtx, addrEth, types.ACTION_VOTE, types.ETHEREUM))
+	res := total(sdkCtx, addrEth, types.ETHEREUM)
+	require.Equal(t, coin(60), res.Total)
+	require.Len(t, res.Actions, 3)
+
+	// the claimed action is left out, the amount queried is the amount claimed
+	balanceBefore := keepers.BankKeeper.GetBalance(sdkCtx, addrArkeo, types.DefaultClaimDenom)
+	_, err := msgServer.ClaimArkeo(sdkCtx, &types.MsgClaimArkeo{Creator: addrArkeo.String()})
+	require.NoError(t, err)
+	require.Equal(t, coin(100), keepers.BankKeeper.GetBalance(sdkCtx, addrArkeo, types.DefaultClaimDenom).Sub(balanceBefore))
+	require.Equal(t, coin(0), claimable(sdkCtx, addrArkeo.String(), types.ACTION_CLAIM, types.ARKEO))
+	res = total(sdkCtx, addrArkeo.String(), types.ARKEO)
+	require.Equal(t, coin(500), res.Total)
+	require.Equal(t, []types.ActionClaimable{
+		{Action: types.ACTION_VOTE, Amount: coin(200)},
+		{Action: types.ACTION_DELEGATE, Amount: coin(300)},
+	}, res.Actions)
+
+	// halfway through the decay the amounts are halved, as the claim halves them
+	params := keepers.ClaimKeeper.GetParams(sdkCtx)
+	decayCtx := sdkCtx.WithBlockTime(params.AirdropStartTime.Add(params.DurationUntilDecay).Add(params.DurationOfDecay / 2))
+	require.Equal(t, coin(100), claimable(decayCtx, addrArkeo.String(), types.ACTION_VOTE, types.ARKEO))
+	require.Equal(t, coin(250), total(decayCtx, addrArkeo.String(), types.ARKEO).Total)
+	balanceBefore = keepers.BankKeeper.GetBalance(decayCtx, addrArkeo, types.DefaultClaimDenom)
+	keepers.ClaimKeeper.AfterProposalVote(decayCtx, 1, addrArkeo)
+	require.Equal(t, coin(100), keepers.BankKeeper.GetBalance(decayCtx, addrArkeo, types.DefaultClaimDenom).Sub(balanceBefore))
+
+	// nothing is claimable once the airdrop ended
+	endedCtx := sdkCtx.WithBlockTime(params.AirdropStartTime.Add(params.DurationUntilDecay).Add(params.DurationOfDecay * 2))
+	require.Equal(t, coin(0), claimable(endedCtx, addrArkeo.String(), types.ACTION_DELEGATE, types.ARKEO))
+	res = total(endedCtx, addrEth, types.ETHEREUM)
+	require.Equal(t, coin(0), res.Total)
+	require.Empty(t, res.Actions)
+
+	// nor for an address without a claim record
+	addrNone := utils.GetRandomArkeoAddress().String()
+	require.Equal(t, coin(0), claimable(sdkCtx, addrNone, types.ACTION_CLAIM, types.ARKEO))
+	res = total(sdkCtx, addrNone, types.ARKEO)
+	require.Equal(t, coin(0), res.Total)
+	require.Empty(t, res.Actions)
+
+	// the address must be one of the chain, the action one of the actions
+	_, err = keepers.ClaimKeeper.TotalClaimable(sdkCtx, &types.QueryTotalClaimableRequest{Address: addrEth, Chain: types.ARKEO})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = keepers.ClaimKeeper.ClaimableForAction(sdkCtx, &types.QueryClaimableForActionRequest{Address: addrNone, Action: types.Action(9)})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = keepers.ClaimKeeper.ClaimableForAction(sdkCtx, nil)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,105 @@
+// Package merkle builds the merkle trees of the claim rounds and the proofs of their claims, as the claim module
+// verifies them. The leaves and the nodes are hashed with distinct prefixes, so a node can never pass for a leaf, and
+// the pairs of nodes are hashed sorted, so the proofs need no left or right flags.
+package merkle
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+)
+
+var (
+	leafPrefix = []byte{0x00}
+	nodePrefix = []byte{0x01}
+)
+
+// Leaf the leaf of the claim of the amount by the address
+func Leaf(address string, amount sdkmath.Int) []byte {
+	h := sha256.New()
+	h.Write(leafPrefix)
+	h.Write([]byte(address))
+	h.Write([]byte{0x00})
+	h.Write([]byte(amount.String()))
+	return h.Sum(nil)
+}
+
+func hashPair(a, b []byte) []byte {
+	if bytes.Compare(a, b) > 0 {
+		a, b = b, a
+	}
+	h := sha256.New()
+	h.Write(nodePrefix)
+	h.Write(a)
+	h.Write(b)
+	return h.Sum(nil)
+}
+
+// Tree the levels of a merkle tree, from its leaves up to its root
+type Tree struct {
+	levels [][][]byte
+}
+
+// NewTree build the tree of the leaves, in their order. The last node of a level with an odd count moves up as is.
+func NewTree(leaves [][]byte) (*Tree, error) {
+	if len(leaves) == 0 {
+		return nil, errors.New("no leaves")
+	}
+	level := make([][]byte, len(leaves))
+	for i, leaf := range leaves {
+		if len(leaf) != sha256.Size {
+			return nil, fmt.Errorf("leaf %d is not a sha256 hash", i)
+		}
+		level[i] = leaf
+	}
+	tree := &Tree{levels: [][][]byte{level}}
+	for len(level) > 1 {
+		next := make([][]byte, 0, (len(level)+1)/2)
+		for i := 0; i < len(level); i += 2 {
+			if i+1 == len(level) {
+				next = append(next, level[i])
+				continue
+			}
+			next = append(next, hashPair(level[i], level[i+1]))
+		}
+		tree.levels = append(tree.levels, next)
+		level = next
+	}
+	return tree, nil
+}
+
+// Root the root of the tree
+func (t *Tree) Root() []byte {
+	return t.levels[len(t.levels)-1][0]
+}
+
+// Proof the sibling hashes from the leaf at the index up to the root
+func (t *Tree) Proof(index int) ([][]byte, error) {
+	if index < 0 || index >= len(t.levels[0]) {
+		return nil, fmt.Errorf("leaf %d out of the %d leaves", index, len(t.levels[0]))
+	}
+	var proof [][]byte
+	for _, level := range t.levels[:len(t.levels)-1] {
+		sibling := index ^ 1
+		if sibling < len(level) {
+			proof = append(proof, level[sibling])
+		}
+		index /= 2
+	}
+	return proof, nil
+}
+
+// Verify return true when the proof leads from the leaf to the root
+func Verify(root, leaf []byte, proof [][]byte) bool {
+	hash := leaf
+	for _, sibling := range proof {
+		if len(sibling) != sha256.Size {
+			return false
+		}
+		hash = hashPair(hash, sibling)
+	}
+	return bytes.Equal(hash, root)
+}
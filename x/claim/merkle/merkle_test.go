@@ -0,0 +1,63 @@
+package merkle
+
+import (
+	"fmt"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTree(t *testing.T) {
+	for _, count := range []int{1, 2, 3, 5, 8, 13} {
+		t.Run(fmt.Sprintf("%d leaves", count), func(t *testing.T) {
+			leaves := make([][]byte, count)
+			for i := range leaves {
+				leaves[i] = Leaf(fmt.Sprintf("arkeo1address%d", i), sdkmath.NewInt(int64(100+i)))
+			}
+			tree, err := NewTree(leaves)
+			require.NoError(t, err)
+
+			for i, leaf := range leaves {
+				proof, err := tree.Proof(i)
+				require.NoError(t, err)
+				require.True(t, Verify(tree.Root(), leaf, proof))
+				// the proof of a leaf holds for none of the others
+				for j, other := range leaves {
+					if j != i {
+						require.False(t, Verify(tree.Root(), other, proof))
+					}
+				}
+			}
+			_, err = tree.Proof(count)
+			require.Error(t, err)
+		})
+	}
+
+	_, err := NewTree(nil)
+	require.Error(t, err)
+	_, err = NewTree([][]byte{[]byte("short")})
+	require.Error(t, err)
+}
+
+func TestVerifyForged(t *testing.T) {
+	leaves := [][]byte{
+		Leaf("arkeo1a", sdkmath.NewInt(100)),
+		Leaf("arkeo1b", sdkmath.NewInt(200)),
+		Leaf("arkeo1c", sdkmath.NewInt(300)),
+	}
+	tree, err := NewTree(leaves)
+	require.NoError(t, err)
+	proof, err := tree.Proof(1)
+	require.NoError(t, err)
+
+	// another amount, or another address, is another leaf
+	require.False(t, Verify(tree.Root(), Leaf("arkeo1b", sdkmath.NewInt(201)), proof))
+	require.False(t, Verify(tree.Root(), Leaf("arkeo1d", sdkmath.NewInt(200)), proof))
+	// a tampered or truncated proof
+	tampered := [][]byte{append([]byte{}, proof[0]...), proof[1]}
+	tampered[0][0] ^= 0xff
+	require.False(t, Verify(tree.Root(), leaves[1], tampered))
+	require.False(t, Verify(tree.Root(), leaves[1], proof[:1]))
+	require.False(t, Verify(tree.Root(), leaves[1], [][]byte{[]byte("short")}))
+}
@@ -0,0 +1,35 @@
+package types
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// ClaimRoundAccount the account the claims of the round are paid from, derived from the id of the round. No key
+// controls it, the claims of the round alone spend from it.
+func ClaimRoundAccount(id uint64) sdk.AccAddress {
+	return authtypes.NewModuleAddress(fmt.Sprintf("%s/round/%d", ModuleName, id))
+}
+
+// Validate the round holds a merkle root and a denom, its account the one of its id
+func (round ClaimRound) Validate() error {
+	if len(round.MerkleRoot) != sha256.Size {
+		return fmt.Errorf("claim round %d merkle root is not a sha256 hash", round.Id)
+	}
+	if err := sdk.ValidateDenom(round.Denom); err != nil {
+		return fmt.Errorf("claim round %d: %w", round.Id, err)
+	}
+	if round.Account != ClaimRoundAccount(round.Id).String() {
+		return fmt.Errorf("claim round %d account %s is not the account of the round", round.Id, round.Account)
+	}
+	return nil
+}
+
+// HasEnded the round has an end time and the time is past it, its claims rejected and its balance swept
+func (round ClaimRound) HasEnded(now time.Time) bool {
+	return !round.EndTime.IsZero() && now.After(round.EndTime)
+}
@@ -0,0 +1,736 @@
+// DONTCOVER
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: arkeo/claim/claim_round.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ClaimRound an airdrop round, its claims the leaves of a merkle tree the round
+// holds the root of, see the x/claim/merkle package
+type ClaimRound struct {
+	Id         uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	MerkleRoot []byte `protobuf:"bytes,2,opt,name=merkle_root,json=merkleRoot,proto3" json:"merkle_root,omitempty"`
+	// denom the amounts of the claims are in
+	Denom string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+	// account of the round the claims are paid from, funded once the round is
+	// created
+	Account string `protobuf:"bytes,4,opt,name=account,proto3" json:"account,omitempty"`
+	// end_time the claims of the round are rejected after, the balance left in
+	// the account of the round swept to the community pool, zero for no end
+	EndTime time.Time `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+}
+
+func (m *ClaimRound) Reset()         { *m = ClaimRound{} }
+func (m *ClaimRound) String() string { return proto.CompactTextString(m) }
+func (*ClaimRound) ProtoMessage()    {}
+func (*ClaimRound) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a25fab86fd7f4f34, []int{0}
+}
+func (m *ClaimRound) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClaimRound) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClaimRound.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClaimRound) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClaimRound.Merge(m, src)
+}
+func (m *ClaimRound) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClaimRound) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClaimRound.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClaimRound proto.InternalMessageInfo
+
+func (m *ClaimRound) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *ClaimRound) GetMerkleRoot() []byte {
+	if m != nil {
+		return m.MerkleRoot
+	}
+	return nil
+}
+
+func (m *ClaimRound) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *ClaimRound) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *ClaimRound) GetEndTime() time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return time.Time{}
+}
+
+// ClaimRoundClaim the claim of the address paid by the round
+type ClaimRoundClaim struct {
+	RoundId uint64 `protobuf:"varint,1,opt,name=round_id,json=roundId,proto3" json:"round_id,omitempty"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *ClaimRoundClaim) Reset()         { *m = ClaimRoundClaim{} }
+func (m *ClaimRoundClaim) String() string { return proto.CompactTextString(m) }
+func (*ClaimRoundClaim) ProtoMessage()    {}
+func (*ClaimRoundClaim) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a25fab86fd7f4f34, []int{1}
+}
+func (m *ClaimRoundClaim) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClaimRoundClaim) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClaimRoundClaim.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClaimRoundClaim) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClaimRoundClaim.Merge(m, src)
+}
+func (m *ClaimRoundClaim) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClaimRoundClaim) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClaimRoundClaim.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClaimRoundClaim proto.InternalMessageInfo
+
+func (m *ClaimRoundClaim) GetRoundId() uint64 {
+	if m != nil {
+		return m.RoundId
+	}
+	return 0
+}
+
+func (m *ClaimRoundClaim) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*ClaimRound)(nil), "arkeo.claim.ClaimRound")
+	proto.RegisterType((*ClaimRoundClaim)(nil), "arkeo.claim.ClaimRoundClaim")
+}
+
+func init() { proto.RegisterFile("arkeo/claim/claim_round.proto", fileDescriptor_a25fab86fd7f4f34) }
+
+var fileDescriptor_a25fab86fd7f4f34 = []byte{
+	// 350 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x91, 0xb1, 0x4e, 0xeb, 0x30,
+	0x14, 0x86, 0xe3, 0xdc, 0xf6, 0xb6, 0xd7, 0xbd, 0x02, 0x29, 0xea, 0xe0, 0x56, 0x22, 0x89, 0x3a,
+	0x45, 0x42, 0x24, 0x52, 0x79, 0x00, 0x44, 0x11, 0x03, 0xab, 0x61, 0x62, 0x09, 0x69, 0x6c, 0x42,
+	0xd4, 0xda, 0xa7, 0x72, 0x1c, 0x01, 0x6f, 0xd1, 0x87, 0xe1, 0x21, 0x3a, 0x56, 0x4c, 0x4c, 0x80,
+	0xda, 0x17, 0x41, 0xb1, 0x5b, 0x18, 0x59, 0xac, 0xf3, 0xff, 0xe7, 0xc8, 0xfe, 0x7e, 0x1f, 0x7c,
+	0x94, 0xa9, 0x19, 0x87, 0x24, 0x9f, 0x67, 0xa5, 0xb0, 0x67, 0xaa, 0xa0, 0x96, 0x2c, 0x5e, 0x28,
+	0xd0, 0xe0, 0xf5, 0x4c, 0x3b, 0x36, 0x8d, 0xe1, 0x20, 0x87, 0x4a, 0x40, 0x95, 0x9a, 0x56, 0x62,
+	0x85, 0x9d, 0x1b, 0xf6, 0x0b, 0x28, 0xc0, 0xfa, 0x4d, 0xb5, 0x73, 0x83, 0x02, 0xa0, 0x98, 0xf3,
+	0xc4, 0xa8, 0x69, 0x7d, 0x9f, 0xe8, 0x52, 0xf0, 0x4a, 0x67, 0x62, 0x61, 0x07, 0x46, 0x2b, 0x84,
+	0xf1, 0x45, 0x73, 0x37, 0x6d, 0xde, 0xf4, 0x0e, 0xb0, 0x5b, 0x32, 0x82, 0x42, 0x14, 0xb5, 0xa8,
+	0x5b, 0x32, 0x2f, 0xc0, 0x3d, 0xc1, 0xd5, 0x6c, 0xce, 0x53, 0x05, 0xa0, 0x89, 0x1b, 0xa2, 0xe8,
+	0x3f, 0xc5, 0xd6, 0xa2, 0x00, 0xda, 0xeb, 0xe3, 0x36, 0xe3, 0x12, 0x04, 0xf9, 0x13, 0xa2, 0xe8,
+	0x1f, 0xb5, 0xc2, 0x1b, 0xe3, 0x4e, 0x96, 0xe7, 0x50, 0x4b, 0x4d, 0x5a, 0x8d, 0x3f, 0x21, 0xaf,
+	0x2f, 0x27, 0xfd, 0x1d, 0xef, 0x39, 0x63, 0x8a, 0x57, 0xd5, 0xb5, 0x56, 0xa5, 0x2c, 0xe8, 0x7e,
+	0xd0, 0x3b, 0xc3, 0x5d, 0x2e, 0x59, 0xda, 0x00, 0x92, 0x76, 0x88, 0xa2, 0xde, 0x78, 0x18, 0x5b,
+	0xfa, 0x78, 0x4f, 0x1f, 0xdf, 0xec, 0xe9, 0x27, 0xdd, 0xd5, 0x7b, 0xe0, 0x2c, 0x3f, 0x02, 0x44,
+	0x3b, 0x5c, 0xb2, 0xc6, 0x1f, 0xdd, 0xe1, 0xc3, 0x9f, 0x24, 0xa6, 0xf2, 0x06, 0xb8, 0x6b, 0xfe,
+	0x32, 0xfd, 0x0e, 0xd5, 0x31, 0xfa, 0x8a, 0x19, 0x44, 0x0b, 0x42, 0xdc, 0x5f, 0x11, 0xad, 0x9c,
+	0x5c, 0xae, 0x36, 0x3e, 0x5a, 0x6f, 0x7c, 0xf4, 0xb9, 0xf1, 0xd1, 0x72, 0xeb, 0x3b, 0xeb, 0xad,
+	0xef, 0xbc, 0x6d, 0x7d, 0xe7, 0xf6, 0xb8, 0x28, 0xf5, 0x43, 0x3d, 0x8d, 0x73, 0x10, 0x89, 0x59,
+	0x98, 0xe4, 0xfa, 0x11, 0xd4, 0xcc, 0x8a, 0xe4, 0x69, 0xb7, 0x5e, 0xfd, 0xbc, 0xe0, 0xd5, 0xf4,
+	0xaf, 0xc9, 0x73, 0xfa, 0x35, 0x00, 0xd4, 0x3a, 0xed, 0x80, 0xfa, 0x01, 0x00, 0x00,
+}
+
+func (m *ClaimRound) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClaimRound) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClaimRound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintClaimRound(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintClaimRound(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintClaimRound(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.MerkleRoot) > 0 {
+		i -= len(m.MerkleRoot)
+		copy(dAtA[i:], m.MerkleRoot)
+		i = encodeVarintClaimRound(dAtA, i, uint64(len(m.MerkleRoot)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintClaimRound(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClaimRoundClaim) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClaimRoundClaim) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClaimRoundClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintClaimRound(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.RoundId != 0 {
+		i = encodeVarintClaimRound(dAtA, i, uint64(m.RoundId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintClaimRound(dAtA []byte, offset int, v uint64) int {
+	offset -= sovClaimRound(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ClaimRound) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovClaimRound(uint64(m.Id))
+	}
+	l = len(m.MerkleRoot)
+	if l > 0 {
+		n += 1 + l + sovClaimRound(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovClaimRound(uint64(l))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovClaimRound(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovClaimRound(uint64(l))
+	return n
+}
+
+func (m *ClaimRoundClaim) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RoundId != 0 {
+		n += 1 + sovClaimRound(uint64(m.RoundId))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovClaimRound(uint64(l))
+	}
+	return n
+}
+
+func sovClaimRound(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozClaimRound(x uint64) (n int) {
+	return sovClaimRound(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ClaimRound) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClaimRound
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClaimRound: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClaimRound: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaimRound
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerkleRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaimRound
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthClaimRound
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaimRound
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MerkleRoot = append(m.MerkleRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.MerkleRoot == nil {
+				m.MerkleRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaimRound
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClaimRound
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaimRound
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaimRound
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClaimRound
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaimRound
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaimRound
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClaimRound
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaimRound
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClaimRound(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthClaimRound
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClaimRoundClaim) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClaimRound
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClaimRoundClaim: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClaimRoundClaim: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoundId", wireType)
+			}
+			m.RoundId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaimRound
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RoundId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaimRound
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClaimRound
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaimRound
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClaimRound(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthClaimRound
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipClaimRound(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowClaimRound
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowClaimRound
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowClaimRound
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthClaimRound
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupClaimRound
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthClaimRound
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthClaimRound        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowClaimRound          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupClaimRound = fmt.Errorf("proto: unexpected end of group")
+)
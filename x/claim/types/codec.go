@@ -13,6 +13,8 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgTransferClaim{}, "claim/TransferClaim", nil)
 	cdc.RegisterConcrete(&MsgAddClaim{}, "claim/AddClaim", nil)
 	cdc.RegisterConcrete(&MsgClaimThorchain{}, "claim/ClaimThorchain", nil)
+	cdc.RegisterConcrete(&MsgCreateClaimRound{}, "claim/CreateClaimRound", nil)
+	cdc.RegisterConcrete(&MsgClaimMerkle{}, "claim/ClaimMerkle", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -22,6 +24,8 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgClaimEth{},
 		&MsgClaimArkeo{},
 		&MsgClaimThorchain{},
+		&MsgCreateClaimRound{},
+		&MsgClaimMerkle{},
 	)
 	// this line is used by starport scaffolding # 3
 
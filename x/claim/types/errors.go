@@ -10,4 +10,7 @@ var (
 	ErrInvalidSignature            = errors.Register(ModuleName, 3, "Invalid signature")
 	ErrClaimRecordNotTransferrable = errors.Register(ModuleName, 4, "Claim record can not be transferred")
 	ErrInvalidCreator              = errors.Register(ModuleName, 5, "Invalid Creator")
+	ErrClaimRoundNotFound          = errors.Register(ModuleName, 6, "Claim round not found")
+	ErrInvalidMerkleProof          = errors.Register(ModuleName, 7, "Invalid merkle proof")
+	ErrClaimRoundClaimed           = errors.Register(ModuleName, 8, "Claimed already in the claim round")
 )
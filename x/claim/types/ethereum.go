@@ -1,6 +1,9 @@
 package types
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/math"
 	"github.com/ethereum/go-ethereum/signer/core/apitypes"
@@ -31,3 +34,19 @@ var (
 func IsValidEthAddress(address string) bool {
 	return common.IsHexAddress(address)
 }
+
+// ValidateEthAddress check the address is a 0x prefixed hex address, its EIP55 checksum valid when it is mixed case.
+// The all lower and all upper case addresses carry no checksum.
+func ValidateEthAddress(address string) error {
+	if !strings.HasPrefix(address, "0x") || !common.IsHexAddress(address) {
+		return fmt.Errorf("%s is not a 0x prefixed hex address", address)
+	}
+	hex := address[2:]
+	if hex == strings.ToLower(hex) || hex == strings.ToUpper(hex) {
+		return nil
+	}
+	if common.HexToAddress(address).Hex() != address {
+		return fmt.Errorf("%s fails its EIP55 checksum", address)
+	}
+	return nil
+}
@@ -4,4 +4,10 @@ const (
 	EventTypeClaim             = "claim"
 	EventTypeClaimFromEth      = "claim_from_eth"
 	EventTypeThorchainDelegate = "claim_thor_delegate"
+	EventTypeCreateClaimRound  = "create_claim_round"
+	EventTypeClaimMerkle       = "claim_merkle"
+	EventTypeClawback          = "clawback"
+
+	AttributeKeyClaimRound = "claim_round"
+	AttributeKeyAccount    = "account"
 )
@@ -1,5 +1,7 @@
 package types
 
+import "fmt"
+
 // this line is used by starport scaffolding # genesis/types/import
 
 // DefaultIndex is the default global index
@@ -18,5 +20,27 @@ func DefaultGenesis() *GenesisState {
 func (gs GenesisState) Validate() error {
 	// this line is used by starport scaffolding # genesis/types/validate
 
+	rounds := make(map[uint64]bool, len(gs.ClaimRounds))
+	for _, round := range gs.ClaimRounds {
+		if rounds[round.Id] {
+			return fmt.Errorf("duplicated claim round %d", round.Id)
+		}
+		if err := round.Validate(); err != nil {
+			return err
+		}
+		rounds[round.Id] = true
+	}
+	claims := make(map[string]bool, len(gs.ClaimRoundClaims))
+	for _, claim := range gs.ClaimRoundClaims {
+		if !rounds[claim.RoundId] {
+			return fmt.Errorf("claim of %s in the unknown claim round %d", claim.Address, claim.RoundId)
+		}
+		key := fmt.Sprintf("%d/%s", claim.RoundId, claim.Address)
+		if claims[key] {
+			return fmt.Errorf("duplicated claim of %s in claim round %d", claim.Address, claim.RoundId)
+		}
+		claims[key] = true
+	}
+
 	return gs.Params.Validate()
 }
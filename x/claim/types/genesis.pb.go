@@ -32,6 +32,10 @@ type GenesisState struct {
 	Params               Params     `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
 	// list of claim records, one for every airdrop recipient
 	ClaimRecords []ClaimRecord `protobuf:"bytes,3,rep,name=claim_records,json=claimRecords,proto3" json:"claim_records" yaml:"claim_records"`
+	// airdrop rounds of the claims of a merkle root
+	ClaimRounds []ClaimRound `protobuf:"bytes,4,rep,name=claim_rounds,json=claimRounds,proto3" json:"claim_rounds"`
+	// the claims the rounds paid already
+	ClaimRoundClaims []ClaimRoundClaim `protobuf:"bytes,5,rep,name=claim_round_claims,json=claimRoundClaims,proto3" json:"claim_round_claims"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -88,6 +92,20 @@ func (m *GenesisState) GetClaimRecords() []ClaimRecord {
 	return nil
 }
 
+func (m *GenesisState) GetClaimRounds() []ClaimRound {
+	if m != nil {
+		return m.ClaimRounds
+	}
+	return nil
+}
+
+func (m *GenesisState) GetClaimRoundClaims() []ClaimRoundClaim {
+	if m != nil {
+		return m.ClaimRoundClaims
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "arkeo.claim.GenesisState")
 }
@@ -95,29 +113,32 @@ func init() {
 func init() { proto.RegisterFile("arkeo/claim/genesis.proto", fileDescriptor_087fbceea1b57c13) }
 
 var fileDescriptor_087fbceea1b57c13 = []byte{
-	// 340 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0xc1, 0x4a, 0xf3, 0x40,
-	0x14, 0x85, 0x93, 0xf6, 0xa7, 0x8b, 0xb4, 0xff, 0x26, 0x16, 0x49, 0x8b, 0x4e, 0x4b, 0x40, 0x28,
-	0x08, 0x33, 0xb4, 0xee, 0xdc, 0x99, 0x22, 0x6e, 0x25, 0xee, 0x74, 0x51, 0x26, 0xd3, 0x21, 0x86,
-	0x26, 0xb9, 0x65, 0x66, 0x5a, 0xed, 0x5b, 0xb8, 0xf2, 0x99, 0xba, 0xec, 0xd2, 0x55, 0x91, 0xe6,
-	0x0d, 0x7c, 0x02, 0xc9, 0x4c, 0xc4, 0x14, 0xdc, 0x0c, 0xc9, 0xfd, 0xce, 0x39, 0xf7, 0xce, 0x1d,
-	0xa7, 0x47, 0xc5, 0x82, 0x03, 0x61, 0x29, 0x4d, 0x32, 0x12, 0xf3, 0x9c, 0xcb, 0x44, 0xe2, 0xa5,
-	0x00, 0x05, 0x6e, 0x5b, 0x23, 0xac, 0x51, 0xbf, 0x1b, 0x43, 0x0c, 0xba, 0x4e, 0xca, 0x2f, 0x23,
-	0xe9, 0x7b, 0x75, 0xf7, 0x92, 0x0a, 0x9a, 0x55, 0xe6, 0x3e, 0xaa, 0x13, 0x7d, 0xce, 0x04, 0x67,
-	0x20, 0xe6, 0x3f, 0x9c, 0x81, 0xcc, 0x40, 0x92, 0x88, 0x4a, 0x4e, 0xd6, 0xe3, 0x88, 0x2b, 0x3a,
-	0x26, 0x0c, 0x92, 0xdc, 0x70, 0xff, 0xbd, 0xe1, 0x74, 0xee, 0xcc, 0x38, 0x0f, 0x8a, 0x2a, 0xee,
-	0xae, 0x9d, 0xd3, 0x0c, 0xe6, 0xab, 0x94, 0xcf, 0x28, 0x63, 0xb0, 0xca, 0xd5, 0x2c, 0xa2, 0x29,
-	0xcd, 0x19, 0xf7, 0xec, 0xa1, 0x3d, 0x6a, 0x4f, 0x7a, 0xd8, 0x24, 0xe2, 0x32, 0x11, 0x57, 0x89,
-	0x78, 0x0a, 0x49, 0x1e, 0x5c, 0x6c, 0xf7, 0x03, 0xeb, 0x6b, 0x3f, 0x38, 0xdf, 0xd0, 0x2c, 0xbd,
-	0xf6, 0xff, 0x8e, 0xf1, 0xc3, 0xae, 0x01, 0x37, 0xa6, 0x1e, 0x98, 0xb2, 0x3b, 0x76, 0x5a, 0xe6,
-	0x62, 0x5e, 0x43, 0xf7, 0x39, 0xc1, 0xb5, 0xb5, 0xe0, 0x7b, 0x8d, 0x82, 0x7f, 0x65, 0x87, 0xb0,
-	0x12, 0xba, 0x4f, 0xce, 0xff, 0xfa, 0x8d, 0xa5, 0xd7, 0x1c, 0x36, 0x47, 0xed, 0x89, 0x77, 0xe4,
-	0x9c, 0x96, 0x67, 0xa8, 0x05, 0xc1, 0x59, 0x35, 0x60, 0xd7, 0x0c, 0x78, 0x64, 0xf6, 0xc3, 0x0e,
-	0xfb, 0x95, 0xca, 0xe0, 0x76, 0x7b, 0x40, 0xf6, 0xee, 0x80, 0xec, 0xcf, 0x03, 0xb2, 0xdf, 0x0a,
-	0x64, 0xed, 0x0a, 0x64, 0x7d, 0x14, 0xc8, 0x7a, 0xbc, 0x8c, 0x13, 0xf5, 0xbc, 0x8a, 0x30, 0x83,
-	0x8c, 0xe8, 0x4e, 0x39, 0x57, 0x2f, 0x20, 0x16, 0xe6, 0x87, 0xbc, 0x56, 0x8f, 0xa1, 0x36, 0x4b,
-	0x2e, 0xa3, 0x96, 0x5e, 0xf3, 0xd5, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0x58, 0x09, 0xe0, 0x8f,
-	0x00, 0x02, 0x00, 0x00,
+	// 390 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0x41, 0x6b, 0xe2, 0x40,
+	0x14, 0x80, 0x93, 0xd5, 0xf5, 0x90, 0xb8, 0xb0, 0x64, 0x65, 0x37, 0x8a, 0x8e, 0x12, 0x58, 0x10,
+	0x16, 0x66, 0xd0, 0xbd, 0xed, 0x69, 0x37, 0xb2, 0xf4, 0x2a, 0xe9, 0xad, 0x3d, 0x84, 0xc9, 0x38,
+	0xa4, 0xc1, 0x24, 0x23, 0x99, 0x89, 0xad, 0xff, 0xa2, 0xbf, 0xa3, 0xbf, 0xc4, 0xa3, 0xc7, 0x9e,
+	0xa4, 0xe8, 0x3f, 0xe8, 0x2f, 0x28, 0x99, 0x99, 0xb6, 0x11, 0xec, 0x65, 0x78, 0x79, 0xdf, 0x7b,
+	0xdf, 0xbc, 0xbc, 0xc4, 0xea, 0xe2, 0x62, 0x49, 0x19, 0x22, 0x29, 0x4e, 0x32, 0x14, 0xd3, 0x9c,
+	0xf2, 0x84, 0xc3, 0x55, 0xc1, 0x04, 0x73, 0x6c, 0x89, 0xa0, 0x44, 0xbd, 0x4e, 0xcc, 0x62, 0x26,
+	0xf3, 0xa8, 0x8a, 0x54, 0x49, 0xcf, 0xad, 0x77, 0xaf, 0x70, 0x81, 0x33, 0xdd, 0xdc, 0x03, 0x75,
+	0x22, 0xcf, 0xb0, 0xa0, 0x84, 0x15, 0x0b, 0xcd, 0x07, 0x67, 0x38, 0x2b, 0xf3, 0x57, 0x0c, 0x08,
+	0xe3, 0x19, 0xe3, 0x28, 0xc2, 0x9c, 0xa2, 0xf5, 0x24, 0xa2, 0x02, 0x4f, 0x10, 0x61, 0x49, 0xae,
+	0xb8, 0xf7, 0xd0, 0xb0, 0xda, 0x17, 0x6a, 0xda, 0x4b, 0x81, 0x05, 0x75, 0xd6, 0xd6, 0xf7, 0x8c,
+	0x2d, 0xca, 0x94, 0x86, 0x98, 0x10, 0x56, 0xe6, 0x22, 0x8c, 0x70, 0x8a, 0x73, 0x42, 0x5d, 0x73,
+	0x64, 0x8e, 0xed, 0x69, 0x17, 0x2a, 0x23, 0xac, 0x8c, 0x50, 0x1b, 0xe1, 0x8c, 0x25, 0xb9, 0xff,
+	0x73, 0xbb, 0x1f, 0x1a, 0xcf, 0xfb, 0xe1, 0x60, 0x83, 0xb3, 0xf4, 0x8f, 0x77, 0x5e, 0xe3, 0x05,
+	0x1d, 0x05, 0xfe, 0xa9, 0xbc, 0xaf, 0xd2, 0xce, 0xc4, 0x6a, 0xa9, 0xf7, 0x76, 0x3f, 0xc9, 0x7b,
+	0xbe, 0xc1, 0xda, 0xd6, 0xe0, 0x5c, 0x22, 0xbf, 0x59, 0xdd, 0x10, 0xe8, 0x42, 0xe7, 0xda, 0xfa,
+	0x52, 0x5f, 0x08, 0x77, 0x1b, 0xa3, 0xc6, 0xd8, 0x9e, 0xba, 0x27, 0x9d, 0xb3, 0xea, 0x0c, 0x64,
+	0x81, 0xdf, 0xd7, 0x03, 0x76, 0xd4, 0x80, 0x27, 0xcd, 0x5e, 0xd0, 0x26, 0xef, 0xa5, 0xdc, 0xf9,
+	0x6b, 0xb5, 0x6b, 0xdb, 0xe4, 0x6e, 0x53, 0xba, 0x7f, 0x9c, 0x71, 0x57, 0x5c, 0x4f, 0x66, 0x93,
+	0xb7, 0x0c, 0x77, 0xe6, 0x96, 0x53, 0x33, 0x84, 0x32, 0xe6, 0xee, 0x67, 0xe9, 0xe9, 0x7f, 0xe0,
+	0x91, 0x91, 0x96, 0x7d, 0x25, 0xa7, 0x69, 0xee, 0xff, 0xdf, 0x1e, 0x80, 0xb9, 0x3b, 0x00, 0xf3,
+	0xe9, 0x00, 0xcc, 0xfb, 0x23, 0x30, 0x76, 0x47, 0x60, 0x3c, 0x1e, 0x81, 0x71, 0xf5, 0x2b, 0x4e,
+	0xc4, 0x4d, 0x19, 0x41, 0xc2, 0x32, 0x24, 0xcd, 0x39, 0x15, 0xb7, 0xac, 0x58, 0xaa, 0x07, 0x74,
+	0xa7, 0xff, 0x0f, 0xb1, 0x59, 0x51, 0x1e, 0xb5, 0xe4, 0xa7, 0xff, 0xfd, 0x32, 0x00, 0x9b, 0x58,
+	0xdf, 0x50, 0xb3, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -140,6 +161,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ClaimRoundClaims) > 0 {
+		for iNdEx := len(m.ClaimRoundClaims) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClaimRoundClaims[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.ClaimRounds) > 0 {
+		for iNdEx := len(m.ClaimRounds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClaimRounds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.ClaimRecords) > 0 {
 		for iNdEx := len(m.ClaimRecords) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -204,6 +253,18 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.ClaimRounds) > 0 {
+		for _, e := range m.ClaimRounds {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ClaimRoundClaims) > 0 {
+		for _, e := range m.ClaimRoundClaims {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -342,6 +403,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimRounds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClaimRounds = append(m.ClaimRounds, ClaimRound{})
+			if err := m.ClaimRounds[len(m.ClaimRounds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimRoundClaims", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClaimRoundClaims = append(m.ClaimRoundClaims, ClaimRoundClaim{})
+			if err := m.ClaimRoundClaims[len(m.ClaimRoundClaims)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
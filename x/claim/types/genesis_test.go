@@ -2,6 +2,7 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -27,6 +28,54 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: true,
 		},
+		{
+			desc: "valid claim rounds",
+			genState: &types.GenesisState{
+				Params:           types.DefaultParams(),
+				ClaimRounds:      []types.ClaimRound{claimRound(1), claimRound(2)},
+				ClaimRoundClaims: []types.ClaimRoundClaim{{RoundId: 1, Address: "arkeo1a"}, {RoundId: 2, Address: "arkeo1a"}},
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicated claim round",
+			genState: &types.GenesisState{
+				Params:      types.DefaultParams(),
+				ClaimRounds: []types.ClaimRound{claimRound(1), claimRound(1)},
+			},
+		},
+		{
+			desc: "claim round of another account",
+			genState: &types.GenesisState{
+				Params:      types.DefaultParams(),
+				ClaimRounds: []types.ClaimRound{{Id: 1, MerkleRoot: make([]byte, 32), Denom: types.DefaultClaimDenom, Account: types.ClaimRoundAccount(2).String()}},
+			},
+		},
+		{
+			desc: "claim of an unknown claim round",
+			genState: &types.GenesisState{
+				Params:           types.DefaultParams(),
+				ClaimRounds:      []types.ClaimRound{claimRound(1)},
+				ClaimRoundClaims: []types.ClaimRoundClaim{{RoundId: 2, Address: "arkeo1a"}},
+			},
+		},
+		{
+			desc: "duplicated claim",
+			genState: &types.GenesisState{
+				Params:           types.DefaultParams(),
+				ClaimRounds:      []types.ClaimRound{claimRound(1)},
+				ClaimRoundClaims: []types.ClaimRoundClaim{{RoundId: 1, Address: "arkeo1a"}, {RoundId: 1, Address: "arkeo1a"}},
+			},
+		},
+		{
+			desc:     "airdrop end after its start",
+			genState: &types.GenesisState{Params: airdropParams(time.Hour)},
+			valid:    true,
+		},
+		{
+			desc:     "airdrop end before its start",
+			genState: &types.GenesisState{Params: airdropParams(-time.Hour)},
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
@@ -39,3 +88,13 @@ func TestGenesisState_Validate(t *testing.T) {
 		})
 	}
 }
+
+func claimRound(id uint64) types.ClaimRound {
+	return types.ClaimRound{Id: id, MerkleRoot: make([]byte, 32), Denom: types.DefaultClaimDenom, Account: types.ClaimRoundAccount(id).String()}
+}
+
+func airdropParams(end time.Duration) types.Params {
+	params := types.DefaultParams()
+	params.AirdropEndTime = params.AirdropStartTime.Add(end)
+	return params
+}
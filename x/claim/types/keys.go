@@ -18,6 +18,19 @@ const (
 
 	// ClaimRecordsStorePrefix defines the store prefix for the claim records (by eth address)
 	ClaimRecordsEthStorePrefix = "claimrecordsethereum"
+
+	// ClaimRoundsStorePrefix defines the store prefix for the airdrop rounds of a merkle root (by round id)
+	ClaimRoundsStorePrefix = "claimrounds"
+
+	// ClaimRoundClaimsStorePrefix defines the store prefix for the claims paid by the rounds (by round id and address)
+	ClaimRoundClaimsStorePrefix = "claimroundclaims"
+
+	// ClaimRoundEndsStorePrefix defines the store prefix for the rounds left to sweep once ended (by end time and round
+	// id)
+	ClaimRoundEndsStorePrefix = "claimroundends"
+
+	// NextClaimRoundIdKey defines the key of the id of the next round
+	NextClaimRoundIdKey = "nextclaimroundid"
 )
 
 func KeyPrefix(p string) []byte {
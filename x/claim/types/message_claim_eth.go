@@ -1,7 +1,9 @@
 package types
 
 import (
+	"cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/arkeonetwork/arkeo/common/cosmos"
 )
@@ -10,11 +12,12 @@ const TypeMsgClaimEth = "claim_eth"
 
 var _ sdk.Msg = &MsgClaimEth{}
 
-func NewMsgClaimEth(creator cosmos.AccAddress, ethAdress, signature string) *MsgClaimEth {
+func NewMsgClaimEth(creator cosmos.AccAddress, ethAdress, signature string, signMode EthSignMode) *MsgClaimEth {
 	return &MsgClaimEth{
 		Creator:    creator.String(),
 		EthAddress: ethAdress,
 		Signature:  signature,
+		SignMode:   signMode,
 	}
 }
 
@@ -36,5 +39,17 @@ func (msg *MsgClaimEth) GetSignBytes() []byte {
 }
 
 func (msg *MsgClaimEth) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if err := ValidateEthAddress(msg.EthAddress); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid eth address (%s)", err)
+	}
+	if msg.Signature == "" {
+		return errors.Wrapf(ErrInvalidSignature, "empty signature")
+	}
+	if _, ok := EthSignMode_name[int32(msg.SignMode)]; !ok {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "unknown sign mode %d", msg.SignMode)
+	}
 	return nil
 }
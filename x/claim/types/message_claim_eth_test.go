@@ -1,8 +1,73 @@
 package types
 
 import (
+	"strings"
 	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/arkeonetwork/arkeo/testutil/sample"
 )
 
 func TestMsgClaimEth_ValidateBasic(t *testing.T) {
+	const checksummed = "0x2c7536E3605D9C16a7a3D7b1898e529396a65c23"
+	tests := []struct {
+		name string
+		msg  MsgClaimEth
+		err  error
+	}{
+		{
+			name: "checksummed address",
+			msg:  MsgClaimEth{Creator: sample.AccAddress().String(), EthAddress: checksummed, Signature: "0x00"},
+		},
+		{
+			name: "lower case address",
+			msg:  MsgClaimEth{Creator: sample.AccAddress().String(), EthAddress: strings.ToLower(checksummed), Signature: "0x00", SignMode: ETH_SIGN_PERSONAL},
+		},
+		{
+			name: "upper case address",
+			msg:  MsgClaimEth{Creator: sample.AccAddress().String(), EthAddress: "0x" + strings.ToUpper(checksummed[2:]), Signature: "0x00"},
+		},
+		{
+			name: "invalid creator",
+			msg:  MsgClaimEth{Creator: "invalid address", EthAddress: checksummed, Signature: "0x00"},
+			err:  sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "bad checksum",
+			msg:  MsgClaimEth{Creator: sample.AccAddress().String(), EthAddress: "0x2c7536e3605D9C16a7a3D7b1898e529396a65c23", Signature: "0x00"},
+			err:  sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "no 0x prefix",
+			msg:  MsgClaimEth{Creator: sample.AccAddress().String(), EthAddress: checksummed[2:], Signature: "0x00"},
+			err:  sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "short address",
+			msg:  MsgClaimEth{Creator: sample.AccAddress().String(), EthAddress: checksummed[:40], Signature: "0x00"},
+			err:  sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "empty signature",
+			msg:  MsgClaimEth{Creator: sample.AccAddress().String(), EthAddress: checksummed},
+			err:  ErrInvalidSignature,
+		},
+		{
+			name: "unknown sign mode",
+			msg:  MsgClaimEth{Creator: sample.AccAddress().String(), EthAddress: checksummed, Signature: "0x00", SignMode: 2},
+			err:  sdkerrors.ErrInvalidRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
 }
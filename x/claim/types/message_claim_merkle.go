@@ -0,0 +1,62 @@
+package types
+
+import (
+	"crypto/sha256"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/arkeonetwork/arkeo/common/cosmos"
+)
+
+const TypeMsgClaimMerkle = "claim_merkle"
+
+// MaxMerkleProofLength the depth of a tree of 2^64 claims, no proof is longer
+const MaxMerkleProofLength = 64
+
+var _ sdk.Msg = &MsgClaimMerkle{}
+
+func NewMsgClaimMerkle(creator cosmos.AccAddress, roundId uint64, amount cosmos.Int, proof [][]byte) *MsgClaimMerkle {
+	return &MsgClaimMerkle{
+		Creator: creator.String(),
+		RoundId: roundId,
+		Amount:  amount,
+		Proof:   proof,
+	}
+}
+
+func (msg *MsgClaimMerkle) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgClaimMerkle) Type() string {
+	return TypeMsgClaimMerkle
+}
+
+func (msg *MsgClaimMerkle) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(msg.Creator)}
+}
+
+func (msg *MsgClaimMerkle) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgClaimMerkle) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.Amount.IsNil() || !msg.Amount.IsPositive() {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "amount should larger than 0")
+	}
+	if len(msg.Proof) > MaxMerkleProofLength {
+		return errors.Wrapf(ErrInvalidMerkleProof, "proof of %d hashes, %d at most", len(msg.Proof), MaxMerkleProofLength)
+	}
+	for _, hash := range msg.Proof {
+		if len(hash) != sha256.Size {
+			return errors.Wrapf(ErrInvalidMerkleProof, "proof hashes should be sha256 hashes")
+		}
+	}
+	return nil
+}
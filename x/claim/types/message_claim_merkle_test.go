@@ -0,0 +1,112 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/arkeonetwork/arkeo/common/cosmos"
+	"github.com/arkeonetwork/arkeo/testutil/sample"
+)
+
+func TestMsgClaimMerkle_ValidateBasic(t *testing.T) {
+	hash := make([]byte, 32)
+	tests := []struct {
+		name string
+		msg  MsgClaimMerkle
+		err  error
+	}{
+		{
+			name: "valid claim",
+			msg: MsgClaimMerkle{
+				Creator: sample.AccAddress().String(),
+				RoundId: 1,
+				Amount:  cosmos.NewInt(100),
+				Proof:   [][]byte{hash, hash},
+			},
+		},
+		{
+			name: "invalid creator",
+			msg: MsgClaimMerkle{
+				Creator: "invalid address",
+				Amount:  cosmos.NewInt(100),
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "zero amount",
+			msg: MsgClaimMerkle{
+				Creator: sample.AccAddress().String(),
+				Amount:  cosmos.ZeroInt(),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "proof hash not a sha256 hash",
+			msg: MsgClaimMerkle{
+				Creator: sample.AccAddress().String(),
+				Amount:  cosmos.NewInt(100),
+				Proof:   [][]byte{hash, hash[:31]},
+			},
+			err: ErrInvalidMerkleProof,
+		},
+		{
+			name: "proof too long",
+			msg: MsgClaimMerkle{
+				Creator: sample.AccAddress().String(),
+				Amount:  cosmos.NewInt(100),
+				Proof:   make([][]byte, MaxMerkleProofLength+1),
+			},
+			err: ErrInvalidMerkleProof,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgCreateClaimRound_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgCreateClaimRound
+		err  error
+	}{
+		{
+			name: "valid round",
+			msg:  MsgCreateClaimRound{Authority: sample.AccAddress().String(), MerkleRoot: make([]byte, 32), Denom: DefaultClaimDenom},
+		},
+		{
+			name: "invalid authority",
+			msg:  MsgCreateClaimRound{Authority: "invalid address", MerkleRoot: make([]byte, 32), Denom: DefaultClaimDenom},
+			err:  sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "merkle root not a sha256 hash",
+			msg:  MsgCreateClaimRound{Authority: sample.AccAddress().String(), MerkleRoot: make([]byte, 20), Denom: DefaultClaimDenom},
+			err:  sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "invalid denom",
+			msg:  MsgCreateClaimRound{Authority: sample.AccAddress().String(), MerkleRoot: make([]byte, 32), Denom: "1"},
+			err:  sdkerrors.ErrInvalidRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
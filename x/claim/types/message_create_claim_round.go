@@ -0,0 +1,53 @@
+package types
+
+import (
+	"crypto/sha256"
+	"time"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgCreateClaimRound = "create_claim_round"
+
+var _ sdk.Msg = &MsgCreateClaimRound{}
+
+func NewMsgCreateClaimRound(authority string, merkleRoot []byte, denom string, endTime time.Time) *MsgCreateClaimRound {
+	return &MsgCreateClaimRound{
+		Authority:  authority,
+		MerkleRoot: merkleRoot,
+		Denom:      denom,
+		EndTime:    endTime,
+	}
+}
+
+func (msg *MsgCreateClaimRound) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgCreateClaimRound) Type() string {
+	return TypeMsgCreateClaimRound
+}
+
+func (msg *MsgCreateClaimRound) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(msg.Authority)}
+}
+
+func (msg *MsgCreateClaimRound) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgCreateClaimRound) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address (%s)", err)
+	}
+	if len(msg.MerkleRoot) != sha256.Size {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "merkle root should be a sha256 hash")
+	}
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid denom (%s)", err)
+	}
+	return nil
+}
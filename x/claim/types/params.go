@@ -27,6 +27,9 @@ var (
 	DeafultAirdropStartTime time.Time = time.Now().UTC()
 )
 
+// KeyAirdropEndTime the airdrop has no end by default, it is set by governance
+var KeyAirdropEndTime = []byte("AirdropEndTime")
+
 var _ paramtypes.ParamSet = (*Params)(nil)
 
 // ParamKeyTable the param key table for launch module
@@ -35,12 +38,13 @@ func ParamKeyTable() paramtypes.KeyTable {
 }
 
 // NewParams creates a new Params instance
-func NewParams(claimDenom string, airdropStartTime time.Time, durationUntilDecay, durationOfDecay time.Duration) Params {
+func NewParams(claimDenom string, airdropStartTime time.Time, durationUntilDecay, durationOfDecay time.Duration, airdropEndTime time.Time) Params {
 	return Params{
 		ClaimDenom:         claimDenom,
 		AirdropStartTime:   airdropStartTime,
 		DurationUntilDecay: durationUntilDecay,
 		DurationOfDecay:    durationOfDecay,
+		AirdropEndTime:     airdropEndTime,
 	}
 }
 
@@ -54,6 +58,12 @@ func DefaultParams() Params {
 	}
 }
 
+// AirdropEnded the airdrop of the claim records has an end time and the time is past it, its claims rejected and the
+// unclaimed balance of the module swept to the community pool
+func (p Params) AirdropEnded(now time.Time) bool {
+	return !p.AirdropEndTime.IsZero() && now.After(p.AirdropEndTime)
+}
+
 // ParamSetPairs get the params.ParamSet
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
@@ -61,11 +71,15 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyDurationUntilDecay, &p.DurationUntilDecay, validateDurationUntilDecay),
 		paramtypes.NewParamSetPair(KeyDurationOfDecay, &p.DurationOfDecay, validateDurationOfDecay),
 		paramtypes.NewParamSetPair(KeyClaimDenom, &p.ClaimDenom, validateClaimDenom),
+		paramtypes.NewParamSetPair(KeyAirdropEndTime, &p.AirdropEndTime, validateAirdropEndTime),
 	}
 }
 
 // Validate validates the set of params
 func (p Params) Validate() error {
+	if !p.AirdropEndTime.IsZero() && !p.AirdropEndTime.After(p.AirdropStartTime) {
+		return fmt.Errorf("airdrop end time %s must be after its start time %s", p.AirdropEndTime, p.AirdropStartTime)
+	}
 	return nil
 }
 
@@ -77,6 +91,14 @@ func validateAirdropStartTime(i interface{}) error {
 	return nil
 }
 
+func validateAirdropEndTime(i interface{}) error {
+	_, ok := i.(time.Time)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
 func validateDurationOfDecay(i interface{}) error {
 	_, ok := i.(time.Duration)
 	if !ok {
@@ -39,6 +39,10 @@ type Params struct {
 	ClaimDenom string `protobuf:"bytes,4,opt,name=claim_denom,json=claimDenom,proto3" json:"claim_denom,omitempty"`
 	// uarkeo to distribute to arkeo account for gas to make claiming easier
 	InitialGasAmount *types.Coin `protobuf:"bytes,5,opt,name=initial_gas_amount,json=initialGasAmount,proto3" json:"initial_gas_amount,omitempty" yaml:"initial_gas_amount"`
+	// airdrop_end_time the claims of the claim records are rejected after, the
+	// claim denom left in the module account swept to the community pool, zero
+	// for no end
+	AirdropEndTime time.Time `protobuf:"bytes,6,opt,name=airdrop_end_time,json=airdropEndTime,proto3,stdtime" json:"airdrop_end_time" yaml:"airdrop_end_time"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -109,6 +113,13 @@ func (m *Params) GetInitialGasAmount() *types.Coin {
 	return nil
 }
 
+func (m *Params) GetAirdropEndTime() time.Time {
+	if m != nil {
+		return m.AirdropEndTime
+	}
+	return time.Time{}
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "arkeo.claim.Params")
 }
@@ -116,36 +127,37 @@ func init() {
 func init() { proto.RegisterFile("arkeo/claim/params.proto", fileDescriptor_2bdbd8eba92221b6) }
 
 var fileDescriptor_2bdbd8eba92221b6 = []byte{
-	// 451 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0x31, 0x6f, 0xd3, 0x40,
-	0x14, 0xc7, 0x73, 0x50, 0x2a, 0xe1, 0x0c, 0x14, 0xab, 0x83, 0x9b, 0x0a, 0xbb, 0xb2, 0x84, 0x54,
-	0x09, 0x74, 0xa7, 0xc2, 0xc6, 0x46, 0x1a, 0x84, 0x98, 0x40, 0x01, 0x16, 0x16, 0xeb, 0xd9, 0xb9,
-	0x98, 0x53, 0x7d, 0x7e, 0xd6, 0xdd, 0x19, 0xc8, 0x57, 0x60, 0xea, 0x84, 0xf8, 0x08, 0x7c, 0x94,
-	0x8e, 0x1d, 0x99, 0x02, 0x4a, 0x36, 0xc6, 0x7e, 0x02, 0x74, 0xe7, 0x33, 0x88, 0x26, 0x12, 0x5b,
-	0xee, 0xff, 0x7f, 0xef, 0xfd, 0x7f, 0xf1, 0x7b, 0x41, 0x04, 0xea, 0x8c, 0x23, 0x2b, 0x2a, 0x10,
-	0x92, 0x35, 0xa0, 0x40, 0x6a, 0xda, 0x28, 0x34, 0x18, 0x0e, 0x9d, 0x43, 0x9d, 0x33, 0xda, 0x2f,
-	0xb1, 0x44, 0xa7, 0x33, 0xfb, 0xab, 0x2b, 0x19, 0xc5, 0x25, 0x62, 0x59, 0x71, 0xe6, 0x5e, 0x79,
-	0x3b, 0x67, 0xb3, 0x56, 0x81, 0x11, 0x58, 0x7b, 0x3f, 0xb9, 0xee, 0x1b, 0x21, 0xb9, 0x36, 0x20,
-	0x9b, 0x7e, 0x40, 0x81, 0x5a, 0xa2, 0x66, 0x39, 0x68, 0xce, 0x3e, 0x9c, 0xe4, 0xdc, 0xc0, 0x09,
-	0x2b, 0x50, 0xf8, 0x01, 0xe9, 0xb7, 0x9d, 0x60, 0xf7, 0x95, 0x83, 0x0a, 0x31, 0x08, 0x41, 0xa8,
-	0x99, 0xc2, 0x26, 0xd3, 0x06, 0x94, 0xc9, 0xec, 0xac, 0x88, 0x1c, 0x91, 0xe3, 0xe1, 0xa3, 0x11,
-	0xed, 0x82, 0x68, 0x1f, 0x44, 0xdf, 0xf4, 0x41, 0xe3, 0xfb, 0x17, 0xcb, 0x64, 0x70, 0xb5, 0x4c,
-	0x0e, 0x16, 0x20, 0xab, 0x27, 0xe9, 0xe6, 0x8c, 0xf4, 0xfc, 0x47, 0x42, 0xa6, 0x7b, 0xde, 0x78,
-	0x6d, 0x75, 0xdb, 0x1d, 0x7e, 0x21, 0xc1, 0x7e, 0xff, 0x7f, 0xb2, 0xb6, 0x36, 0xa2, 0xca, 0x66,
-	0xbc, 0x80, 0x45, 0x74, 0xc3, 0x65, 0x1e, 0x6c, 0x64, 0x4e, 0x7c, 0xf1, 0xf8, 0x85, 0x8d, 0xfc,
-	0xb5, 0x4c, 0xe2, 0x6d, 0xed, 0x0f, 0x51, 0x0a, 0xc3, 0x65, 0x63, 0x16, 0x57, 0xcb, 0xe4, 0xb0,
-	0x83, 0xda, 0x56, 0x97, 0x7e, 0xb5, 0x58, 0x61, 0x6f, 0xbd, 0xb5, 0xce, 0xc4, 0x1a, 0xe1, 0x67,
-	0x12, 0xdc, 0xfd, 0xd3, 0x81, 0x73, 0x4f, 0x75, 0xf3, 0x7f, 0x54, 0xa7, 0x9e, 0xea, 0x70, 0xa3,
-	0xf7, 0x1f, 0xa4, 0xe8, 0x1a, 0x52, 0x5f, 0xd4, 0xf1, 0xdc, 0xe9, 0xf5, 0x97, 0xf3, 0x0e, 0x26,
-	0x09, 0x86, 0xee, 0x42, 0xb2, 0x19, 0xaf, 0x51, 0x46, 0x3b, 0x47, 0xe4, 0xf8, 0xf6, 0x34, 0x70,
-	0xd2, 0xc4, 0x2a, 0xe1, 0x3c, 0x08, 0x45, 0x2d, 0x8c, 0x80, 0x2a, 0x2b, 0x41, 0x67, 0x20, 0xb1,
-	0xad, 0x4d, 0x74, 0xcb, 0xd3, 0x76, 0xfb, 0xa7, 0x76, 0xff, 0xd4, 0xef, 0x9f, 0x9e, 0xa2, 0xa8,
-	0xc7, 0xf7, 0xfe, 0xae, 0x6c, 0xb3, 0x3d, 0x9d, 0xee, 0x79, 0xf1, 0x39, 0xe8, 0xa7, 0x4e, 0x1a,
-	0x3f, 0xbb, 0x58, 0xc5, 0xe4, 0x72, 0x15, 0x93, 0x9f, 0xab, 0x98, 0x9c, 0xaf, 0xe3, 0xc1, 0xe5,
-	0x3a, 0x1e, 0x7c, 0x5f, 0xc7, 0x83, 0x77, 0x0f, 0x4a, 0x61, 0xde, 0xb7, 0x39, 0x2d, 0x50, 0x32,
-	0x77, 0xd3, 0x35, 0x37, 0x1f, 0x51, 0x9d, 0x75, 0x0f, 0xf6, 0xc9, 0x1f, 0xbf, 0x59, 0x34, 0x5c,
-	0xe7, 0xbb, 0xee, 0xc3, 0x3d, 0xfe, 0x1d, 0x00, 0x00, 0xff, 0xff, 0xda, 0x58, 0x9f, 0x2f, 0x18,
-	0x03, 0x00, 0x00,
+	// 478 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0x41, 0x6b, 0xd4, 0x40,
+	0x14, 0xc7, 0x37, 0x6a, 0x17, 0xcc, 0x82, 0xd6, 0xa1, 0x60, 0xba, 0xc5, 0xa4, 0x44, 0x84, 0x82,
+	0x32, 0x43, 0xf5, 0xe6, 0xcd, 0xed, 0x16, 0xf1, 0xa4, 0xac, 0x7a, 0xf1, 0x12, 0x26, 0xc9, 0x24,
+	0x0e, 0xcd, 0xcc, 0x0b, 0x99, 0x89, 0xba, 0x5f, 0xc1, 0x53, 0x41, 0x10, 0x3f, 0x52, 0x8f, 0x3d,
+	0x7a, 0x5a, 0x65, 0xf7, 0xe6, 0xb1, 0x9f, 0x40, 0x66, 0x32, 0xd1, 0xb6, 0x5b, 0xe8, 0x2d, 0xf3,
+	0xff, 0xcf, 0x7b, 0xef, 0x17, 0xde, 0x7f, 0xfc, 0x80, 0x36, 0x47, 0x0c, 0x48, 0x56, 0x51, 0x2e,
+	0x48, 0x4d, 0x1b, 0x2a, 0x14, 0xae, 0x1b, 0xd0, 0x80, 0x46, 0xd6, 0xc1, 0xd6, 0x19, 0x6f, 0x95,
+	0x50, 0x82, 0xd5, 0x89, 0xf9, 0xea, 0xae, 0x8c, 0xc3, 0x12, 0xa0, 0xac, 0x18, 0xb1, 0xa7, 0xb4,
+	0x2d, 0x48, 0xde, 0x36, 0x54, 0x73, 0x90, 0xce, 0x8f, 0x2e, 0xfb, 0x9a, 0x0b, 0xa6, 0x34, 0x15,
+	0x75, 0xdf, 0x20, 0x03, 0x25, 0x40, 0x91, 0x94, 0x2a, 0x46, 0x3e, 0xed, 0xa7, 0x4c, 0xd3, 0x7d,
+	0x92, 0x01, 0x77, 0x0d, 0xe2, 0x6f, 0x1b, 0xfe, 0xf0, 0x8d, 0x85, 0x42, 0xe0, 0x23, 0xca, 0x9b,
+	0xbc, 0x81, 0x3a, 0x51, 0x9a, 0x36, 0x3a, 0x31, 0xbd, 0x02, 0x6f, 0xd7, 0xdb, 0x1b, 0x3d, 0x1d,
+	0xe3, 0x6e, 0x10, 0xee, 0x07, 0xe1, 0x77, 0xfd, 0xa0, 0xc9, 0xa3, 0x93, 0x45, 0x34, 0x38, 0x5b,
+	0x44, 0xdb, 0x73, 0x2a, 0xaa, 0xe7, 0xf1, 0x7a, 0x8f, 0xf8, 0xf8, 0x57, 0xe4, 0xcd, 0x36, 0x9d,
+	0xf1, 0xd6, 0xe8, 0xa6, 0x1a, 0x7d, 0xf7, 0xfc, 0xad, 0xfe, 0x7f, 0x92, 0x56, 0x6a, 0x5e, 0x25,
+	0x39, 0xcb, 0xe8, 0x3c, 0xb8, 0x61, 0x67, 0x6e, 0xaf, 0xcd, 0x9c, 0xba, 0xcb, 0x93, 0x57, 0x66,
+	0xe4, 0x9f, 0x45, 0x14, 0x5e, 0x55, 0xfe, 0x04, 0x04, 0xd7, 0x4c, 0xd4, 0x7a, 0x7e, 0xb6, 0x88,
+	0x76, 0x3a, 0xa8, 0xab, 0xee, 0xc5, 0x3f, 0x0c, 0x16, 0xea, 0xad, 0xf7, 0xc6, 0x99, 0x1a, 0x03,
+	0x7d, 0xf5, 0xfc, 0x7b, 0xff, 0x2a, 0xa0, 0x70, 0x54, 0x37, 0xaf, 0xa3, 0x3a, 0x70, 0x54, 0x3b,
+	0x6b, 0xb5, 0x17, 0x90, 0x82, 0x4b, 0x48, 0x50, 0x9c, 0xe7, 0xb9, 0xdb, 0xeb, 0xaf, 0x8b, 0x0e,
+	0x26, 0xf2, 0x47, 0x36, 0x21, 0x49, 0xce, 0x24, 0x88, 0xe0, 0xd6, 0xae, 0xb7, 0x77, 0x7b, 0xe6,
+	0x5b, 0x69, 0x6a, 0x14, 0x54, 0xf8, 0x88, 0x4b, 0xae, 0x39, 0xad, 0x92, 0x92, 0xaa, 0x84, 0x0a,
+	0x68, 0xa5, 0x0e, 0x36, 0x1c, 0x6d, 0xb7, 0x7f, 0x6c, 0xf6, 0x8f, 0xdd, 0xfe, 0xf1, 0x01, 0x70,
+	0x39, 0x79, 0xf0, 0x7f, 0x65, 0xeb, 0xe5, 0xf1, 0x6c, 0xd3, 0x89, 0x2f, 0xa9, 0x7a, 0x61, 0x25,
+	0xc4, 0xfd, 0x7e, 0x85, 0x09, 0x93, 0x79, 0x97, 0x8e, 0xe1, 0xb5, 0xe9, 0x78, 0xe8, 0xd2, 0x71,
+	0xff, 0x62, 0x3a, 0xfa, 0x0e, 0x5d, 0x36, 0xee, 0x38, 0xf9, 0x50, 0xe6, 0xa6, 0x72, 0x72, 0x78,
+	0xb2, 0x0c, 0xbd, 0xd3, 0x65, 0xe8, 0xfd, 0x5e, 0x86, 0xde, 0xf1, 0x2a, 0x1c, 0x9c, 0xae, 0xc2,
+	0xc1, 0xcf, 0x55, 0x38, 0xf8, 0xf0, 0xb8, 0xe4, 0xfa, 0x63, 0x9b, 0xe2, 0x0c, 0x04, 0xb1, 0xcf,
+	0x47, 0x32, 0xfd, 0x19, 0x9a, 0xa3, 0xee, 0x40, 0xbe, 0xb8, 0x77, 0xa6, 0xe7, 0x35, 0x53, 0xe9,
+	0xd0, 0xf2, 0x3c, 0xfb, 0x3b, 0x00, 0x05, 0xcc, 0x20, 0x60, 0x83, 0x03, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -168,6 +180,14 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.AirdropEndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.AirdropEndTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintParams(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x32
 	if m.InitialGasAmount != nil {
 		{
 			size, err := m.InitialGasAmount.MarshalToSizedBuffer(dAtA[:i])
@@ -187,29 +207,29 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x22
 	}
-	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.DurationOfDecay, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DurationOfDecay):])
-	if err2 != nil {
-		return 0, err2
-	}
-	i -= n2
-	i = encodeVarintParams(dAtA, i, uint64(n2))
-	i--
-	dAtA[i] = 0x1a
-	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.DurationUntilDecay, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DurationUntilDecay):])
+	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.DurationOfDecay, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DurationOfDecay):])
 	if err3 != nil {
 		return 0, err3
 	}
 	i -= n3
 	i = encodeVarintParams(dAtA, i, uint64(n3))
 	i--
-	dAtA[i] = 0x12
-	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.AirdropStartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.AirdropStartTime):])
+	dAtA[i] = 0x1a
+	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.DurationUntilDecay, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DurationUntilDecay):])
 	if err4 != nil {
 		return 0, err4
 	}
 	i -= n4
 	i = encodeVarintParams(dAtA, i, uint64(n4))
 	i--
+	dAtA[i] = 0x12
+	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.AirdropStartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.AirdropStartTime):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintParams(dAtA, i, uint64(n5))
+	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
@@ -245,6 +265,8 @@ func (m *Params) Size() (n int) {
 		l = m.InitialGasAmount.Size()
 		n += 1 + l + sovParams(uint64(l))
 	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.AirdropEndTime)
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -450,6 +472,39 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AirdropEndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.AirdropEndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -7,6 +7,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -210,44 +211,432 @@ func (m *QueryClaimRecordResponse) GetClaimRecord() *ClaimRecord {
 	return nil
 }
 
+type QueryClaimableForActionRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Action  Action `protobuf:"varint,2,opt,name=action,proto3,enum=arkeo.claim.Action" json:"action,omitempty"`
+	Chain   Chain  `protobuf:"varint,3,opt,name=chain,proto3,enum=arkeo.claim.Chain" json:"chain,omitempty"`
+}
+
+func (m *QueryClaimableForActionRequest) Reset()         { *m = QueryClaimableForActionRequest{} }
+func (m *QueryClaimableForActionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryClaimableForActionRequest) ProtoMessage()    {}
+func (*QueryClaimableForActionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9bba750e7be3a92, []int{4}
+}
+func (m *QueryClaimableForActionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClaimableForActionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClaimableForActionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryClaimableForActionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClaimableForActionRequest.Merge(m, src)
+}
+func (m *QueryClaimableForActionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClaimableForActionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClaimableForActionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryClaimableForActionRequest proto.InternalMessageInfo
+
+func (m *QueryClaimableForActionRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *QueryClaimableForActionRequest) GetAction() Action {
+	if m != nil {
+		return m.Action
+	}
+	return ACTION_CLAIM
+}
+
+func (m *QueryClaimableForActionRequest) GetChain() Chain {
+	if m != nil {
+		return m.Chain
+	}
+	return ARKEO
+}
+
+type QueryClaimableForActionResponse struct {
+	// zero once claimed, when the address has no claim record, and outside the
+	// airdrop window
+	Amount types.Coin `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *QueryClaimableForActionResponse) Reset()         { *m = QueryClaimableForActionResponse{} }
+func (m *QueryClaimableForActionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryClaimableForActionResponse) ProtoMessage()    {}
+func (*QueryClaimableForActionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9bba750e7be3a92, []int{5}
+}
+func (m *QueryClaimableForActionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClaimableForActionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClaimableForActionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryClaimableForActionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClaimableForActionResponse.Merge(m, src)
+}
+func (m *QueryClaimableForActionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClaimableForActionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClaimableForActionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryClaimableForActionResponse proto.InternalMessageInfo
+
+func (m *QueryClaimableForActionResponse) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+type QueryTotalClaimableRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Chain   Chain  `protobuf:"varint,2,opt,name=chain,proto3,enum=arkeo.claim.Chain" json:"chain,omitempty"`
+}
+
+func (m *QueryTotalClaimableRequest) Reset()         { *m = QueryTotalClaimableRequest{} }
+func (m *QueryTotalClaimableRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTotalClaimableRequest) ProtoMessage()    {}
+func (*QueryTotalClaimableRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9bba750e7be3a92, []int{6}
+}
+func (m *QueryTotalClaimableRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTotalClaimableRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTotalClaimableRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTotalClaimableRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTotalClaimableRequest.Merge(m, src)
+}
+func (m *QueryTotalClaimableRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTotalClaimableRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTotalClaimableRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTotalClaimableRequest proto.InternalMessageInfo
+
+func (m *QueryTotalClaimableRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *QueryTotalClaimableRequest) GetChain() Chain {
+	if m != nil {
+		return m.Chain
+	}
+	return ARKEO
+}
+
+// ActionClaimable amount the address can still claim for the action
+type ActionClaimable struct {
+	Action Action     `protobuf:"varint,1,opt,name=action,proto3,enum=arkeo.claim.Action" json:"action,omitempty"`
+	Amount types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *ActionClaimable) Reset()         { *m = ActionClaimable{} }
+func (m *ActionClaimable) String() string { return proto.CompactTextString(m) }
+func (*ActionClaimable) ProtoMessage()    {}
+func (*ActionClaimable) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9bba750e7be3a92, []int{7}
+}
+func (m *ActionClaimable) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ActionClaimable) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ActionClaimable.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ActionClaimable) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActionClaimable.Merge(m, src)
+}
+func (m *ActionClaimable) XXX_Size() int {
+	return m.Size()
+}
+func (m *ActionClaimable) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActionClaimable.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActionClaimable proto.InternalMessageInfo
+
+func (m *ActionClaimable) GetAction() Action {
+	if m != nil {
+		return m.Action
+	}
+	return ACTION_CLAIM
+}
+
+func (m *ActionClaimable) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+type QueryTotalClaimableResponse struct {
+	Total types.Coin `protobuf:"bytes,1,opt,name=total,proto3" json:"total"`
+	// the actions with an amount left to claim, in the order of the actions
+	Actions []ActionClaimable `protobuf:"bytes,2,rep,name=actions,proto3" json:"actions"`
+}
+
+func (m *QueryTotalClaimableResponse) Reset()         { *m = QueryTotalClaimableResponse{} }
+func (m *QueryTotalClaimableResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTotalClaimableResponse) ProtoMessage()    {}
+func (*QueryTotalClaimableResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9bba750e7be3a92, []int{8}
+}
+func (m *QueryTotalClaimableResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTotalClaimableResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTotalClaimableResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTotalClaimableResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTotalClaimableResponse.Merge(m, src)
+}
+func (m *QueryTotalClaimableResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTotalClaimableResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTotalClaimableResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTotalClaimableResponse proto.InternalMessageInfo
+
+func (m *QueryTotalClaimableResponse) GetTotal() types.Coin {
+	if m != nil {
+		return m.Total
+	}
+	return types.Coin{}
+}
+
+func (m *QueryTotalClaimableResponse) GetActions() []ActionClaimable {
+	if m != nil {
+		return m.Actions
+	}
+	return nil
+}
+
+type QueryClaimRoundRequest struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryClaimRoundRequest) Reset()         { *m = QueryClaimRoundRequest{} }
+func (m *QueryClaimRoundRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryClaimRoundRequest) ProtoMessage()    {}
+func (*QueryClaimRoundRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9bba750e7be3a92, []int{9}
+}
+func (m *QueryClaimRoundRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClaimRoundRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClaimRoundRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryClaimRoundRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClaimRoundRequest.Merge(m, src)
+}
+func (m *QueryClaimRoundRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClaimRoundRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClaimRoundRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryClaimRoundRequest proto.InternalMessageInfo
+
+func (m *QueryClaimRoundRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type QueryClaimRoundResponse struct {
+	Round ClaimRound `protobuf:"bytes,1,opt,name=round,proto3" json:"round"`
+	// left to pay the claims of the round
+	Balance types.Coin `protobuf:"bytes,2,opt,name=balance,proto3" json:"balance"`
+}
+
+func (m *QueryClaimRoundResponse) Reset()         { *m = QueryClaimRoundResponse{} }
+func (m *QueryClaimRoundResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryClaimRoundResponse) ProtoMessage()    {}
+func (*QueryClaimRoundResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9bba750e7be3a92, []int{10}
+}
+func (m *QueryClaimRoundResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClaimRoundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClaimRoundResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryClaimRoundResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClaimRoundResponse.Merge(m, src)
+}
+func (m *QueryClaimRoundResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClaimRoundResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClaimRoundResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryClaimRoundResponse proto.InternalMessageInfo
+
+func (m *QueryClaimRoundResponse) GetRound() ClaimRound {
+	if m != nil {
+		return m.Round
+	}
+	return ClaimRound{}
+}
+
+func (m *QueryClaimRoundResponse) GetBalance() types.Coin {
+	if m != nil {
+		return m.Balance
+	}
+	return types.Coin{}
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "arkeo.claim.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "arkeo.claim.QueryParamsResponse")
 	proto.RegisterType((*QueryClaimRecordRequest)(nil), "arkeo.claim.QueryClaimRecordRequest")
 	proto.RegisterType((*QueryClaimRecordResponse)(nil), "arkeo.claim.QueryClaimRecordResponse")
+	proto.RegisterType((*QueryClaimableForActionRequest)(nil), "arkeo.claim.QueryClaimableForActionRequest")
+	proto.RegisterType((*QueryClaimableForActionResponse)(nil), "arkeo.claim.QueryClaimableForActionResponse")
+	proto.RegisterType((*QueryTotalClaimableRequest)(nil), "arkeo.claim.QueryTotalClaimableRequest")
+	proto.RegisterType((*ActionClaimable)(nil), "arkeo.claim.ActionClaimable")
+	proto.RegisterType((*QueryTotalClaimableResponse)(nil), "arkeo.claim.QueryTotalClaimableResponse")
+	proto.RegisterType((*QueryClaimRoundRequest)(nil), "arkeo.claim.QueryClaimRoundRequest")
+	proto.RegisterType((*QueryClaimRoundResponse)(nil), "arkeo.claim.QueryClaimRoundResponse")
 }
 
 func init() { proto.RegisterFile("arkeo/claim/query.proto", fileDescriptor_c9bba750e7be3a92) }
 
 var fileDescriptor_c9bba750e7be3a92 = []byte{
-	// 421 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0xc1, 0xcb, 0xd3, 0x30,
-	0x18, 0xc6, 0x9b, 0xe1, 0x26, 0xa6, 0xe2, 0x21, 0x9b, 0xac, 0x54, 0xe9, 0x46, 0x99, 0x30, 0x26,
-	0x34, 0x6c, 0x1e, 0xbd, 0x6d, 0x08, 0x1e, 0xb5, 0x17, 0x41, 0x10, 0x49, 0xbb, 0xd0, 0x95, 0xad,
-	0x4d, 0x97, 0x64, 0xea, 0x10, 0x2f, 0x1e, 0x3c, 0x0b, 0xfe, 0x53, 0x3b, 0x0e, 0xbc, 0x78, 0x12,
-	0xd9, 0xfc, 0x2b, 0xbe, 0xd3, 0x47, 0x93, 0x8c, 0xaf, 0xa5, 0x7c, 0x7c, 0x97, 0xb1, 0xe4, 0x79,
-	0xde, 0xdf, 0xfb, 0xbc, 0x6f, 0x03, 0xfb, 0x84, 0xaf, 0x29, 0xc3, 0xf1, 0x86, 0xa4, 0x19, 0xde,
-	0xee, 0x28, 0xdf, 0x07, 0x05, 0x67, 0x92, 0x21, 0x5b, 0x09, 0x81, 0x12, 0xdc, 0x5e, 0xc2, 0x12,
-	0xa6, 0xee, 0x71, 0xf9, 0x4f, 0x5b, 0xdc, 0xa7, 0x09, 0x63, 0xc9, 0x86, 0x62, 0x52, 0xa4, 0x98,
-	0xe4, 0x39, 0x93, 0x44, 0xa6, 0x2c, 0x17, 0x46, 0x9d, 0xc4, 0x4c, 0x64, 0x4c, 0xe0, 0x88, 0x08,
-	0xaa, 0xc9, 0xf8, 0xd3, 0x34, 0xa2, 0x92, 0x4c, 0x71, 0x41, 0x92, 0x34, 0x57, 0x66, 0xe3, 0x75,
-	0xaa, 0x29, 0x0a, 0xc2, 0x49, 0x76, 0xa1, 0x78, 0x55, 0x45, 0xfd, 0x7e, 0xe4, 0x34, 0x66, 0x7c,
-	0xa9, 0x75, 0xbf, 0x07, 0xd1, 0xdb, 0x92, 0xfd, 0x46, 0x15, 0x85, 0x74, 0xbb, 0xa3, 0x42, 0xfa,
-	0xaf, 0x61, 0xb7, 0x76, 0x2b, 0x0a, 0x96, 0x0b, 0x8a, 0xa6, 0xb0, 0xa3, 0xe1, 0x0e, 0x18, 0x82,
-	0xb1, 0x3d, 0xeb, 0x06, 0x95, 0x21, 0x03, 0x6d, 0x9e, 0xdf, 0x3b, 0xfc, 0x1d, 0x58, 0xa1, 0x31,
-	0xfa, 0x1f, 0x60, 0x5f, 0x91, 0x16, 0xa5, 0x25, 0x54, 0x9d, 0x4d, 0x13, 0xe4, 0xc0, 0xfb, 0x64,
-	0xb9, 0xe4, 0x54, 0x68, 0xdc, 0x83, 0xf0, 0x72, 0x44, 0x63, 0xd8, 0x8e, 0x57, 0x24, 0xcd, 0x9d,
-	0xd6, 0x10, 0x8c, 0x1f, 0xcd, 0x50, 0xad, 0xcd, 0xa2, 0x54, 0x42, 0x6d, 0xf0, 0xdf, 0x41, 0xa7,
-	0x89, 0x37, 0x69, 0x5f, 0xc2, 0x87, 0xd5, 0x81, 0x4d, 0x66, 0xa7, 0x0e, 0xab, 0xd4, 0xd9, 0xf1,
-	0xcd, 0x61, 0x76, 0x05, 0x60, 0x5b, 0x91, 0xd1, 0x0a, 0x76, 0xf4, 0x64, 0x68, 0x50, 0x2b, 0x6d,
-	0xae, 0xcd, 0x1d, 0xde, 0x6e, 0xd0, 0x99, 0xfc, 0x27, 0xdf, 0x7f, 0xff, 0xff, 0xd5, 0x7a, 0x8c,
-	0xba, 0xb8, 0xf9, 0xc5, 0xd0, 0x0f, 0x00, 0xed, 0x4a, 0x20, 0x34, 0x6a, 0xe2, 0x9a, 0x6b, 0x74,
-	0x9f, 0xdd, 0xe1, 0x32, 0x9d, 0x27, 0xaa, 0xf3, 0x08, 0xf9, 0xb8, 0xf1, 0x22, 0xf4, 0x7e, 0xf0,
-	0x57, 0xb3, 0xfe, 0x6f, 0xf3, 0x57, 0x87, 0x93, 0x07, 0x8e, 0x27, 0x0f, 0xfc, 0x3b, 0x79, 0xe0,
-	0xe7, 0xd9, 0xb3, 0x8e, 0x67, 0xcf, 0xfa, 0x73, 0xf6, 0xac, 0xf7, 0xcf, 0x93, 0x54, 0xae, 0x76,
-	0x51, 0x10, 0xb3, 0x4c, 0x73, 0x72, 0x2a, 0x3f, 0x33, 0xbe, 0x36, 0xd0, 0x2f, 0x06, 0x2b, 0xf7,
-	0x05, 0x15, 0x51, 0x47, 0x3d, 0xb1, 0x17, 0xd7, 0x01, 0x00, 0x00, 0xff, 0xff, 0x20, 0x04, 0xb5,
-	0x58, 0x24, 0x03, 0x00, 0x00,
+	// 737 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0x5f, 0x6b, 0xd3, 0x5e,
+	0x18, 0xc7, 0x9b, 0x6e, 0xed, 0xf8, 0x3d, 0xfd, 0x31, 0xe1, 0x6c, 0xda, 0x98, 0xcd, 0xac, 0xc4,
+	0x89, 0x71, 0x95, 0x1c, 0xda, 0x21, 0x22, 0x7a, 0xe3, 0x86, 0xe2, 0xa5, 0x16, 0x41, 0x18, 0x88,
+	0x9e, 0xa6, 0x87, 0x2e, 0xac, 0xcd, 0xe9, 0x92, 0xd4, 0x39, 0xc6, 0x6e, 0xbc, 0x10, 0xbc, 0x10,
+	0xfc, 0xf3, 0x0e, 0xbc, 0xf4, 0x95, 0xec, 0x72, 0xe0, 0x8d, 0x57, 0x22, 0x9b, 0x2f, 0x44, 0x72,
+	0xce, 0x93, 0x35, 0x21, 0xad, 0x9d, 0xe0, 0x4d, 0x69, 0xf2, 0x7c, 0xcf, 0xf3, 0x7c, 0x9e, 0x7f,
+	0x27, 0x50, 0x65, 0xc1, 0x0e, 0x17, 0xd4, 0xed, 0x31, 0xaf, 0x4f, 0x77, 0x87, 0x3c, 0xd8, 0x77,
+	0x06, 0x81, 0x88, 0x04, 0xa9, 0x48, 0x83, 0x23, 0x0d, 0xc6, 0x62, 0x57, 0x74, 0x85, 0x7c, 0x4f,
+	0xe3, 0x7f, 0x4a, 0x62, 0x2c, 0x77, 0x85, 0xe8, 0xf6, 0x38, 0x65, 0x03, 0x8f, 0x32, 0xdf, 0x17,
+	0x11, 0x8b, 0x3c, 0xe1, 0x87, 0x68, 0x5d, 0x73, 0x45, 0xd8, 0x17, 0x21, 0x6d, 0xb3, 0x90, 0x2b,
+	0xcf, 0xf4, 0x55, 0xa3, 0xcd, 0x23, 0xd6, 0xa0, 0x03, 0xd6, 0xf5, 0x7c, 0x29, 0x46, 0xad, 0x9e,
+	0xa6, 0x18, 0xb0, 0x80, 0xf5, 0x13, 0x2f, 0x66, 0xda, 0x22, 0x7f, 0x5f, 0x04, 0xdc, 0x15, 0x41,
+	0x07, 0xed, 0x57, 0xc6, 0xd8, 0xc5, 0xd0, 0x4f, 0xcc, 0x66, 0x1a, 0x22, 0x09, 0xef, 0x0a, 0x0f,
+	0x03, 0x5b, 0x8b, 0x40, 0x9e, 0xc4, 0x68, 0x8f, 0x65, 0xcc, 0x16, 0xdf, 0x1d, 0xf2, 0x30, 0xb2,
+	0x1e, 0xc1, 0x42, 0xe6, 0x6d, 0x38, 0x10, 0x7e, 0xc8, 0x49, 0x03, 0xca, 0x8a, 0x4d, 0xd7, 0x6a,
+	0x9a, 0x5d, 0x69, 0x2e, 0x38, 0xa9, 0x1a, 0x39, 0x4a, 0xbc, 0x31, 0x7b, 0xf4, 0x63, 0xa5, 0xd0,
+	0x42, 0xa1, 0xf5, 0x1c, 0xaa, 0xd2, 0xd3, 0x66, 0x2c, 0x69, 0x49, 0x70, 0x0c, 0x42, 0x74, 0x98,
+	0x63, 0x9d, 0x4e, 0xc0, 0x43, 0xe5, 0xee, 0xbf, 0x56, 0xf2, 0x48, 0x6c, 0x28, 0xb9, 0xdb, 0xcc,
+	0xf3, 0xf5, 0x62, 0x4d, 0xb3, 0xe7, 0x9b, 0x24, 0x13, 0x66, 0x33, 0xb6, 0xb4, 0x94, 0xc0, 0x7a,
+	0x06, 0x7a, 0xde, 0x3d, 0xd2, 0xde, 0x85, 0xff, 0xd3, 0xf5, 0x42, 0x66, 0x3d, 0xeb, 0x2c, 0x75,
+	0xae, 0xe2, 0x8e, 0x1e, 0xac, 0x8f, 0x1a, 0x98, 0x23, 0xcf, 0xac, 0xdd, 0xe3, 0x0f, 0x45, 0x70,
+	0xdf, 0x8d, 0x5b, 0x36, 0x9d, 0xbf, 0x0e, 0x65, 0x26, 0xa5, 0x98, 0x40, 0xb6, 0x4e, 0xe8, 0x05,
+	0x25, 0xa3, 0x64, 0x67, 0xa6, 0x25, 0xbb, 0x05, 0x2b, 0x13, 0x91, 0x30, 0xe7, 0xdb, 0x50, 0x66,
+	0x7d, 0x31, 0xf4, 0x23, 0xcc, 0xf6, 0xb2, 0xa3, 0xfa, 0xef, 0xc4, 0xfd, 0x77, 0xb0, 0xff, 0xce,
+	0xa6, 0xf0, 0xfc, 0xa4, 0x4f, 0x4a, 0x6e, 0xbd, 0x04, 0x43, 0xfa, 0x7e, 0x2a, 0x22, 0xd6, 0x3b,
+	0x0b, 0xf0, 0x2f, 0x5b, 0xb5, 0x07, 0x17, 0x14, 0xec, 0x99, 0xf7, 0x54, 0x9d, 0xb4, 0xe9, 0x75,
+	0x1a, 0xa5, 0x56, 0xfc, 0xbb, 0xd4, 0x3e, 0x69, 0xb0, 0x34, 0x36, 0x37, 0xac, 0xd9, 0x2d, 0x28,
+	0x45, 0xb1, 0xe5, 0xbc, 0x25, 0x53, 0x6a, 0x72, 0x0f, 0xe6, 0x14, 0x59, 0xa8, 0x17, 0x6b, 0x33,
+	0x76, 0xa5, 0xb9, 0x3c, 0x86, 0xfe, 0x2c, 0x1a, 0x9e, 0x4d, 0x8e, 0x58, 0x36, 0x5c, 0x4a, 0x0d,
+	0x6e, 0xbc, 0xb0, 0x49, 0xad, 0xe7, 0xa1, 0xe8, 0xa9, 0x61, 0x9d, 0x6d, 0x15, 0xbd, 0x8e, 0xf5,
+	0x4e, 0x83, 0x6a, 0x4e, 0x8a, 0xe8, 0xeb, 0x50, 0x92, 0xcb, 0x8e, 0xe8, 0xd5, 0x31, 0xb3, 0x1d,
+	0x9b, 0x13, 0x70, 0xa9, 0x25, 0x77, 0x60, 0xae, 0xcd, 0x7a, 0xcc, 0x77, 0xf9, 0x79, 0x2b, 0x99,
+	0xe8, 0x9b, 0x5f, 0x4b, 0x50, 0x92, 0x2c, 0x64, 0x1b, 0xca, 0x6a, 0xdf, 0xc9, 0x4a, 0x26, 0x68,
+	0xfe, 0x32, 0x31, 0x6a, 0x93, 0x05, 0x2a, 0x0d, 0x6b, 0xe9, 0xcd, 0xb7, 0x5f, 0x9f, 0x8b, 0x17,
+	0xc9, 0x02, 0xcd, 0x5f, 0x83, 0xe4, 0xad, 0x06, 0x95, 0xd4, 0x9a, 0x92, 0xd5, 0xbc, 0xbb, 0xfc,
+	0xe5, 0x62, 0x5c, 0x9b, 0xa2, 0xc2, 0xc8, 0x6b, 0x32, 0xf2, 0x2a, 0xb1, 0x68, 0xee, 0x1a, 0x55,
+	0xb7, 0x06, 0x3d, 0xc0, 0x49, 0x3f, 0x24, 0x5f, 0x34, 0x20, 0xf9, 0xd5, 0x23, 0xf5, 0x09, 0x91,
+	0xc6, 0xdd, 0x19, 0xc6, 0xcd, 0xf3, 0x89, 0x91, 0xae, 0x21, 0xe9, 0xea, 0xe4, 0x46, 0x9e, 0x2e,
+	0x3e, 0x30, 0x62, 0xa3, 0x07, 0x6a, 0xae, 0x0e, 0xc9, 0x7b, 0x0d, 0xe6, 0xb3, 0x73, 0x4e, 0xae,
+	0xe7, 0x63, 0x8e, 0xdd, 0x72, 0xc3, 0x9e, 0x2e, 0x44, 0x30, 0x5b, 0x82, 0x59, 0xa4, 0x36, 0x0d,
+	0x8c, 0xec, 0x03, 0x8c, 0xe6, 0x90, 0x5c, 0x9d, 0xd4, 0x95, 0xd4, 0x02, 0x18, 0xab, 0x7f, 0x16,
+	0x21, 0x42, 0x4d, 0x22, 0x18, 0x44, 0xcf, 0x20, 0xc8, 0x09, 0x0f, 0xe9, 0x81, 0xd7, 0x39, 0xdc,
+	0x78, 0x70, 0x74, 0x62, 0x6a, 0xc7, 0x27, 0xa6, 0xf6, 0xf3, 0xc4, 0xd4, 0x3e, 0x9c, 0x9a, 0x85,
+	0xe3, 0x53, 0xb3, 0xf0, 0xfd, 0xd4, 0x2c, 0x6c, 0xd5, 0xbb, 0x5e, 0xb4, 0x3d, 0x6c, 0x3b, 0xae,
+	0xe8, 0xab, 0xd3, 0x3e, 0x8f, 0xf6, 0x44, 0xb0, 0x83, 0xae, 0x5e, 0xa3, 0xb3, 0x68, 0x7f, 0xc0,
+	0xc3, 0x76, 0x59, 0x7e, 0x28, 0xd7, 0x7f, 0x0f, 0x00, 0xf5, 0x11, 0x7b, 0xd7, 0x29, 0x08, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -266,6 +655,14 @@ type QueryClient interface {
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	// Queries a list of ClaimRecord items.
 	ClaimRecord(ctx context.Context, in *QueryClaimRecordRequest, opts ...grpc.CallOption) (*QueryClaimRecordResponse, error)
+	// ClaimableForAction queries what the address can still claim for the
+	// action, decayed as the claim would be
+	ClaimableForAction(ctx context.Context, in *QueryClaimableForActionRequest, opts ...grpc.CallOption) (*QueryClaimableForActionResponse, error)
+	// TotalClaimable queries what the address can still claim over all the
+	// actions, and for which of them
+	TotalClaimable(ctx context.Context, in *QueryTotalClaimableRequest, opts ...grpc.CallOption) (*QueryTotalClaimableResponse, error)
+	// ClaimRound queries the airdrop round and the balance of its account
+	ClaimRound(ctx context.Context, in *QueryClaimRoundRequest, opts ...grpc.CallOption) (*QueryClaimRoundResponse, error)
 }
 
 type queryClient struct {
@@ -294,12 +691,47 @@ func (c *queryClient) ClaimRecord(ctx context.Context, in *QueryClaimRecordReque
 	return out, nil
 }
 
+func (c *queryClient) ClaimableForAction(ctx context.Context, in *QueryClaimableForActionRequest, opts ...grpc.CallOption) (*QueryClaimableForActionResponse, error) {
+	out := new(QueryClaimableForActionResponse)
+	err := c.cc.Invoke(ctx, "/arkeo.claim.Query/ClaimableForAction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TotalClaimable(ctx context.Context, in *QueryTotalClaimableRequest, opts ...grpc.CallOption) (*QueryTotalClaimableResponse, error) {
+	out := new(QueryTotalClaimableResponse)
+	err := c.cc.Invoke(ctx, "/arkeo.claim.Query/TotalClaimable", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ClaimRound(ctx context.Context, in *QueryClaimRoundRequest, opts ...grpc.CallOption) (*QueryClaimRoundResponse, error) {
+	out := new(QueryClaimRoundResponse)
+	err := c.cc.Invoke(ctx, "/arkeo.claim.Query/ClaimRound", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	// Queries a list of ClaimRecord items.
 	ClaimRecord(context.Context, *QueryClaimRecordRequest) (*QueryClaimRecordResponse, error)
+	// ClaimableForAction queries what the address can still claim for the
+	// action, decayed as the claim would be
+	ClaimableForAction(context.Context, *QueryClaimableForActionRequest) (*QueryClaimableForActionResponse, error)
+	// TotalClaimable queries what the address can still claim over all the
+	// actions, and for which of them
+	TotalClaimable(context.Context, *QueryTotalClaimableRequest) (*QueryTotalClaimableResponse, error)
+	// ClaimRound queries the airdrop round and the balance of its account
+	ClaimRound(context.Context, *QueryClaimRoundRequest) (*QueryClaimRoundResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -312,6 +744,15 @@ func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsReq
 func (*UnimplementedQueryServer) ClaimRecord(ctx context.Context, req *QueryClaimRecordRequest) (*QueryClaimRecordResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ClaimRecord not implemented")
 }
+func (*UnimplementedQueryServer) ClaimableForAction(ctx context.Context, req *QueryClaimableForActionRequest) (*QueryClaimableForActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimableForAction not implemented")
+}
+func (*UnimplementedQueryServer) TotalClaimable(ctx context.Context, req *QueryTotalClaimableRequest) (*QueryTotalClaimableResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalClaimable not implemented")
+}
+func (*UnimplementedQueryServer) ClaimRound(ctx context.Context, req *QueryClaimRoundRequest) (*QueryClaimRoundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimRound not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -353,32 +794,97 @@ func _Query_ClaimRecord_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
-var Query_serviceDesc = _Query_serviceDesc
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "arkeo.claim.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "ClaimRecord",
-			Handler:    _Query_ClaimRecord_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "arkeo/claim/query.proto",
-}
-
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_ClaimableForAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClaimableForActionRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).ClaimableForAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/arkeo.claim.Query/ClaimableForAction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClaimableForAction(ctx, req.(*QueryClaimableForActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TotalClaimable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTotalClaimableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TotalClaimable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/arkeo.claim.Query/TotalClaimable",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TotalClaimable(ctx, req.(*QueryTotalClaimableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ClaimRound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClaimRoundRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ClaimRound(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/arkeo.claim.Query/ClaimRound",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClaimRound(ctx, req.(*QueryClaimRoundRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "arkeo.claim.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "ClaimRecord",
+			Handler:    _Query_ClaimRecord_Handler,
+		},
+		{
+			MethodName: "ClaimableForAction",
+			Handler:    _Query_ClaimableForAction_Handler,
+		},
+		{
+			MethodName: "TotalClaimable",
+			Handler:    _Query_TotalClaimable_Handler,
+		},
+		{
+			MethodName: "ClaimRound",
+			Handler:    _Query_ClaimRound_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "arkeo/claim/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
 
 func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
@@ -497,73 +1003,962 @@ func (m *QueryClaimRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryClaimableForActionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *QueryClaimableForActionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClaimableForActionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Chain != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Chain))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Action != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Action))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClaimableForActionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClaimableForActionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClaimableForActionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryTotalClaimableRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalClaimableRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalClaimableRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if m.Chain != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Chain))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryClaimRecordRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ActionClaimable) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ActionClaimable) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ActionClaimable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	if m.Chain != 0 {
-		n += 1 + sovQuery(uint64(m.Chain))
+	i--
+	dAtA[i] = 0x12
+	if m.Action != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Action))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalClaimableResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalClaimableResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalClaimableResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Actions) > 0 {
+		for iNdEx := len(m.Actions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Actions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Total.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClaimRoundRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClaimRoundRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClaimRoundRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClaimRoundResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClaimRoundResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClaimRoundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Balance.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Round.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryClaimRecordRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Chain != 0 {
+		n += 1 + sovQuery(uint64(m.Chain))
+	}
+	return n
+}
+
+func (m *QueryClaimRecordResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ClaimRecord != nil {
+		l = m.ClaimRecord.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryClaimableForActionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Action != 0 {
+		n += 1 + sovQuery(uint64(m.Action))
+	}
+	if m.Chain != 0 {
+		n += 1 + sovQuery(uint64(m.Chain))
+	}
+	return n
+}
+
+func (m *QueryClaimableForActionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTotalClaimableRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Chain != 0 {
+		n += 1 + sovQuery(uint64(m.Chain))
+	}
+	return n
+}
+
+func (m *ActionClaimable) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Action != 0 {
+		n += 1 + sovQuery(uint64(m.Action))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTotalClaimableResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Total.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Actions) > 0 {
+		for _, e := range m.Actions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryClaimRoundRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryClaimRoundResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Round.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Balance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClaimRecordRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClaimRecordRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClaimRecordRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+			}
+			m.Chain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Chain |= Chain(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClaimRecordResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClaimRecordResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClaimRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimRecord", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ClaimRecord == nil {
+				m.ClaimRecord = &ClaimRecord{}
+			}
+			if err := m.ClaimRecord.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClaimableForActionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClaimableForActionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClaimableForActionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			m.Action = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Action |= Action(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+			}
+			m.Chain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Chain |= Chain(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClaimableForActionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClaimableForActionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClaimableForActionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *QueryClaimRecordResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ClaimRecord != nil {
-		l = m.ClaimRecord.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTotalClaimableRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -586,12 +1981,63 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTotalClaimableRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTotalClaimableRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+			}
+			m.Chain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Chain |= Chain(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -613,7 +2059,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *ActionClaimable) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -636,15 +2082,34 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ActionClaimable: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ActionClaimable: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			m.Action = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Action |= Action(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -671,7 +2136,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -696,7 +2161,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryClaimRecordRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTotalClaimableResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -719,17 +2184,17 @@ func (m *QueryClaimRecordRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryClaimRecordRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTotalClaimableResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryClaimRecordRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTotalClaimableResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -739,29 +2204,114 @@ func (m *QueryClaimRecordRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			if err := m.Total.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Actions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Actions = append(m.Actions, ActionClaimable{})
+			if err := m.Actions[len(m.Actions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClaimRoundRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClaimRoundRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClaimRoundRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			m.Chain = 0
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -771,7 +2321,7 @@ func (m *QueryClaimRecordRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Chain |= Chain(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -797,7 +2347,7 @@ func (m *QueryClaimRecordRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryClaimRecordResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryClaimRoundResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -820,15 +2370,15 @@ func (m *QueryClaimRecordResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryClaimRecordResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryClaimRoundResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryClaimRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryClaimRoundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClaimRecord", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -855,10 +2405,40 @@ func (m *QueryClaimRecordResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ClaimRecord == nil {
-				m.ClaimRecord = &ClaimRecord{}
+			if err := m.Round.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.ClaimRecord.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
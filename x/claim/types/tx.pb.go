@@ -6,6 +6,7 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
@@ -13,18 +14,22 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -32,10 +37,40 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// EthSignMode how the EIP712 hash of an eth claim was signed
+type EthSignMode int32
+
+const (
+	// the hash signed as is, by eth_signTypedData or a raw secp256k1 signer
+	ETH_SIGN_RAW_HASH EthSignMode = 0
+	// the hash signed as an EIP191 personal message, by personal_sign
+	ETH_SIGN_PERSONAL EthSignMode = 1
+)
+
+var EthSignMode_name = map[int32]string{
+	0: "ETH_SIGN_RAW_HASH",
+	1: "ETH_SIGN_PERSONAL",
+}
+
+var EthSignMode_value = map[string]int32{
+	"ETH_SIGN_RAW_HASH": 0,
+	"ETH_SIGN_PERSONAL": 1,
+}
+
+func (x EthSignMode) String() string {
+	return proto.EnumName(EthSignMode_name, int32(x))
+}
+
+func (EthSignMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_6a4ddac60cb43154, []int{0}
+}
+
 type MsgClaimEth struct {
 	Creator    string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
 	EthAddress string `protobuf:"bytes,2,opt,name=eth_address,json=ethAddress,proto3" json:"eth_address,omitempty"`
 	Signature  string `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+	// how the EIP712 hash of the claim was signed
+	SignMode EthSignMode `protobuf:"varint,4,opt,name=sign_mode,json=signMode,proto3,enum=arkeo.claim.EthSignMode" json:"sign_mode,omitempty"`
 }
 
 func (m *MsgClaimEth) Reset()         { *m = MsgClaimEth{} }
@@ -92,6 +127,13 @@ func (m *MsgClaimEth) GetSignature() string {
 	return ""
 }
 
+func (m *MsgClaimEth) GetSignMode() EthSignMode {
+	if m != nil {
+		return m.SignMode
+	}
+	return ETH_SIGN_RAW_HASH
+}
+
 type MsgClaimEthResponse struct {
 	EthAddress       string `protobuf:"bytes,1,opt,name=ethAddress,proto3" json:"ethAddress,omitempty"`
 	ArkeoAddress     string `protobuf:"bytes,2,opt,name=arkeoAddress,proto3" json:"arkeoAddress,omitempty"`
@@ -560,7 +602,231 @@ func (m *MsgClaimThorchainResponse) GetToAddress() string {
 	return ""
 }
 
+// MsgCreateClaimRound create an airdrop round of the claims of the merkle root,
+// through a governance proposal
+type MsgCreateClaimRound struct {
+	Authority  string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	MerkleRoot []byte `protobuf:"bytes,2,opt,name=merkle_root,json=merkleRoot,proto3" json:"merkle_root,omitempty"`
+	Denom      string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+	// end_time of the round, zero for no end
+	EndTime time.Time `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+}
+
+func (m *MsgCreateClaimRound) Reset()         { *m = MsgCreateClaimRound{} }
+func (m *MsgCreateClaimRound) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateClaimRound) ProtoMessage()    {}
+func (*MsgCreateClaimRound) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6a4ddac60cb43154, []int{10}
+}
+func (m *MsgCreateClaimRound) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateClaimRound) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateClaimRound.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateClaimRound) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateClaimRound.Merge(m, src)
+}
+func (m *MsgCreateClaimRound) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateClaimRound) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateClaimRound.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateClaimRound proto.InternalMessageInfo
+
+func (m *MsgCreateClaimRound) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgCreateClaimRound) GetMerkleRoot() []byte {
+	if m != nil {
+		return m.MerkleRoot
+	}
+	return nil
+}
+
+func (m *MsgCreateClaimRound) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *MsgCreateClaimRound) GetEndTime() time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return time.Time{}
+}
+
+type MsgCreateClaimRoundResponse struct {
+	RoundId uint64 `protobuf:"varint,1,opt,name=round_id,json=roundId,proto3" json:"round_id,omitempty"`
+	// account to fund for the claims to be paid
+	Account string `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+}
+
+func (m *MsgCreateClaimRoundResponse) Reset()         { *m = MsgCreateClaimRoundResponse{} }
+func (m *MsgCreateClaimRoundResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateClaimRoundResponse) ProtoMessage()    {}
+func (*MsgCreateClaimRoundResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6a4ddac60cb43154, []int{11}
+}
+func (m *MsgCreateClaimRoundResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateClaimRoundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateClaimRoundResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateClaimRoundResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateClaimRoundResponse.Merge(m, src)
+}
+func (m *MsgCreateClaimRoundResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateClaimRoundResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateClaimRoundResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateClaimRoundResponse proto.InternalMessageInfo
+
+func (m *MsgCreateClaimRoundResponse) GetRoundId() uint64 {
+	if m != nil {
+		return m.RoundId
+	}
+	return 0
+}
+
+func (m *MsgCreateClaimRoundResponse) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+// MsgClaimMerkle claim the amount of the creator in the round, the proof
+// leading from the leaf of the claim to the merkle root of the round
+type MsgClaimMerkle struct {
+	Creator string                `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	RoundId uint64                `protobuf:"varint,2,opt,name=round_id,json=roundId,proto3" json:"round_id,omitempty"`
+	Amount  cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	Proof   [][]byte              `protobuf:"bytes,4,rep,name=proof,proto3" json:"proof,omitempty"`
+}
+
+func (m *MsgClaimMerkle) Reset()         { *m = MsgClaimMerkle{} }
+func (m *MsgClaimMerkle) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimMerkle) ProtoMessage()    {}
+func (*MsgClaimMerkle) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6a4ddac60cb43154, []int{12}
+}
+func (m *MsgClaimMerkle) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimMerkle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimMerkle.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimMerkle) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimMerkle.Merge(m, src)
+}
+func (m *MsgClaimMerkle) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimMerkle) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimMerkle.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimMerkle proto.InternalMessageInfo
+
+func (m *MsgClaimMerkle) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgClaimMerkle) GetRoundId() uint64 {
+	if m != nil {
+		return m.RoundId
+	}
+	return 0
+}
+
+func (m *MsgClaimMerkle) GetProof() [][]byte {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+type MsgClaimMerkleResponse struct {
+}
+
+func (m *MsgClaimMerkleResponse) Reset()         { *m = MsgClaimMerkleResponse{} }
+func (m *MsgClaimMerkleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimMerkleResponse) ProtoMessage()    {}
+func (*MsgClaimMerkleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6a4ddac60cb43154, []int{13}
+}
+func (m *MsgClaimMerkleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimMerkleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimMerkleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimMerkleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimMerkleResponse.Merge(m, src)
+}
+func (m *MsgClaimMerkleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimMerkleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimMerkleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimMerkleResponse proto.InternalMessageInfo
+
 func init() {
+	proto.RegisterEnum("arkeo.claim.EthSignMode", EthSignMode_name, EthSignMode_value)
 	proto.RegisterType((*MsgClaimEth)(nil), "arkeo.claim.MsgClaimEth")
 	proto.RegisterType((*MsgClaimEthResponse)(nil), "arkeo.claim.MsgClaimEthResponse")
 	proto.RegisterType((*MsgClaimArkeo)(nil), "arkeo.claim.MsgClaimArkeo")
@@ -571,56 +837,82 @@ func init() {
 	proto.RegisterType((*MsgAddClaimResponse)(nil), "arkeo.claim.MsgAddClaimResponse")
 	proto.RegisterType((*MsgClaimThorchain)(nil), "arkeo.claim.MsgClaimThorchain")
 	proto.RegisterType((*MsgClaimThorchainResponse)(nil), "arkeo.claim.MsgClaimThorchainResponse")
+	proto.RegisterType((*MsgCreateClaimRound)(nil), "arkeo.claim.MsgCreateClaimRound")
+	proto.RegisterType((*MsgCreateClaimRoundResponse)(nil), "arkeo.claim.MsgCreateClaimRoundResponse")
+	proto.RegisterType((*MsgClaimMerkle)(nil), "arkeo.claim.MsgClaimMerkle")
+	proto.RegisterType((*MsgClaimMerkleResponse)(nil), "arkeo.claim.MsgClaimMerkleResponse")
 }
 
 func init() { proto.RegisterFile("arkeo/claim/tx.proto", fileDescriptor_6a4ddac60cb43154) }
 
 var fileDescriptor_6a4ddac60cb43154 = []byte{
-	// 701 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0x4f, 0x4f, 0x13, 0x4f,
-	0x18, 0x66, 0xe9, 0x8f, 0x7f, 0x6f, 0xa1, 0x81, 0xfd, 0x81, 0x2e, 0xab, 0x2c, 0xb8, 0x89, 0x84,
-	0x54, 0xe9, 0x6a, 0x4d, 0xd0, 0x54, 0x2f, 0x85, 0x40, 0x62, 0x22, 0x97, 0x8a, 0x89, 0xf1, 0xd2,
-	0x2c, 0xed, 0xb0, 0xdb, 0xe0, 0xee, 0xe0, 0xcc, 0xa0, 0x78, 0x53, 0x8f, 0x9e, 0xf4, 0xec, 0x57,
-	0x30, 0x91, 0x03, 0x37, 0xbf, 0x80, 0xde, 0x08, 0x27, 0x8f, 0x06, 0x0e, 0x7c, 0x0d, 0xb3, 0x33,
-	0x3b, 0xc3, 0xee, 0xb6, 0x94, 0xa6, 0x97, 0x85, 0x79, 0x9f, 0x77, 0xde, 0x79, 0x9e, 0x67, 0xde,
-	0x77, 0x0a, 0xd3, 0x2e, 0xd9, 0x45, 0xd8, 0x69, 0xbc, 0x76, 0x5b, 0x81, 0xc3, 0x0e, 0x4a, 0x7b,
-	0x04, 0x33, 0xac, 0xe7, 0x79, 0xb4, 0xc4, 0xa3, 0xe6, 0xb4, 0x87, 0x3d, 0xcc, 0xe3, 0x4e, 0xf4,
-	0x9f, 0x48, 0x31, 0x67, 0x1b, 0x98, 0x06, 0x98, 0xd6, 0x05, 0x20, 0x16, 0x31, 0x64, 0x25, 0x6b,
-	0xf2, 0x6f, 0x9d, 0xa0, 0x06, 0x26, 0xcd, 0x18, 0xbf, 0x2e, 0xb2, 0x9d, 0x80, 0x7a, 0xce, 0xdb,
-	0xfb, 0xd1, 0x9f, 0x18, 0x98, 0x72, 0x83, 0x56, 0x88, 0x1d, 0xfe, 0x15, 0x21, 0xfb, 0xbb, 0x06,
-	0xf9, 0x4d, 0xea, 0xad, 0x45, 0x55, 0xd6, 0x99, 0xaf, 0x97, 0x61, 0xa4, 0x41, 0x90, 0xcb, 0x30,
-	0x31, 0xb4, 0x05, 0x6d, 0x69, 0x6c, 0xd5, 0x38, 0x39, 0x5a, 0x9e, 0x8e, 0x8f, 0xaf, 0x36, 0x9b,
-	0x04, 0x51, 0xfa, 0x9c, 0x91, 0x56, 0xe8, 0xd5, 0x64, 0xa2, 0x3e, 0x0f, 0x79, 0xc4, 0xfc, 0xba,
-	0x2b, 0x50, 0x63, 0x30, 0xda, 0x57, 0x03, 0xc4, 0xfc, 0x38, 0x5f, 0xbf, 0x09, 0x63, 0xb4, 0xe5,
-	0x85, 0x2e, 0xdb, 0x27, 0xc8, 0xc8, 0x71, 0xf8, 0x22, 0x50, 0x29, 0x7e, 0x3a, 0x3f, 0x2c, 0xca,
-	0x62, 0x9f, 0xcf, 0x0f, 0x8b, 0xb3, 0x42, 0xdf, 0x41, 0xac, 0x30, 0x41, 0xcf, 0xfe, 0x38, 0x08,
-	0xff, 0x27, 0xd6, 0x35, 0x44, 0xf7, 0x70, 0x48, 0x91, 0xfe, 0x08, 0x12, 0xe7, 0x5d, 0xc9, 0x3c,
-	0xc9, 0xed, 0x09, 0x8c, 0xf3, 0xe3, 0xaa, 0x49, 0xf6, 0x5d, 0xf6, 0xa6, 0xb2, 0xf5, 0x15, 0x28,
-	0x20, 0xe6, 0x73, 0x3a, 0xd5, 0x00, 0xef, 0x87, 0x8c, 0xcb, 0xcb, 0xad, 0x16, 0x4e, 0x8e, 0x96,
-	0x21, 0xde, 0xff, 0x34, 0x64, 0xb5, 0x4c, 0x96, 0x5e, 0x81, 0x49, 0x5e, 0x27, 0xb9, 0xf3, 0xbf,
-	0x8e, 0x3b, 0xdb, 0xf2, 0xec, 0x37, 0x30, 0x21, 0x2d, 0xa8, 0x46, 0x58, 0x3f, 0x77, 0x56, 0xb9,
-	0x9b, 0x35, 0xfd, 0x46, 0x67, 0xd3, 0xf9, 0x09, 0x36, 0x85, 0x99, 0x54, 0x40, 0xf9, 0x5e, 0x86,
-	0x11, 0xb7, 0x47, 0xd3, 0x65, 0xa2, 0xbe, 0x08, 0xc3, 0xae, 0x50, 0x3c, 0xd8, 0x51, 0x71, 0x8c,
-	0xda, 0x3f, 0x34, 0x98, 0xdc, 0xa4, 0xde, 0x16, 0x71, 0x43, 0xba, 0x83, 0x08, 0x3f, 0xbd, 0xaf,
-	0xfe, 0x5c, 0x81, 0x31, 0xd6, 0xf3, 0xfd, 0x5e, 0xa4, 0x56, 0x9c, 0xac, 0x47, 0x56, 0x9b, 0x47,
-	0x29, 0x72, 0xb6, 0x09, 0x46, 0x36, 0x26, 0x9d, 0xb2, 0x7f, 0x8b, 0x41, 0xab, 0x36, 0x9b, 0xfd,
-	0x0b, 0x59, 0x82, 0xa1, 0x86, 0xef, 0xb6, 0x42, 0x2e, 0xa2, 0x50, 0xd6, 0x4b, 0x89, 0x67, 0xa4,
-	0xb4, 0x16, 0x21, 0x35, 0x91, 0xa0, 0x1b, 0x17, 0xf7, 0x22, 0xe6, 0x4d, 0xb9, 0x7f, 0x4d, 0xb9,
-	0xcf, 0xfb, 0x4d, 0xba, 0xdd, 0xcb, 0x14, 0x4a, 0xee, 0xf6, 0x0c, 0x1f, 0x42, 0xb9, 0x54, 0x12,
-	0x7f, 0x6a, 0x30, 0x25, 0xdb, 0x64, 0xcb, 0xc7, 0x44, 0x50, 0xe9, 0x47, 0xe8, 0x2d, 0x18, 0xdf,
-	0x21, 0x38, 0xc8, 0x3c, 0x29, 0xf9, 0x28, 0x26, 0x27, 0x6f, 0x0e, 0x80, 0xe1, 0x7a, 0x5a, 0x64,
-	0xe2, 0xee, 0xee, 0x65, 0xe5, 0xcc, 0x77, 0xee, 0x6f, 0xc5, 0xd3, 0xfe, 0xaa, 0xc1, 0x6c, 0x5b,
-	0x54, 0x35, 0xfa, 0xe3, 0x0c, 0xa3, 0xab, 0xa4, 0xa4, 0xb8, 0x3e, 0x4c, 0x71, 0xed, 0xbd, 0x03,
-	0xcb, 0xdf, 0x72, 0x90, 0xdb, 0xa4, 0x9e, 0xbe, 0x01, 0xa3, 0xea, 0x85, 0x36, 0x52, 0xb7, 0x9e,
-	0x78, 0x0c, 0xcd, 0x85, 0xcb, 0x10, 0xa5, 0xe2, 0x19, 0x40, 0xe2, 0xdd, 0x30, 0x3b, 0xe6, 0x73,
-	0xcc, 0xb4, 0x2f, 0xc7, 0x54, 0xb5, 0x17, 0x30, 0x91, 0x1e, 0xce, 0xb9, 0xec, 0xa6, 0x14, 0x6c,
-	0xde, 0xee, 0x0a, 0xab, 0xb2, 0x1b, 0x30, 0xaa, 0xa6, 0xa4, 0x4d, 0xac, 0x44, 0xda, 0xc5, 0x66,
-	0xdb, 0x51, 0x7f, 0x09, 0x85, 0x4c, 0x2b, 0x5a, 0x1d, 0x45, 0x29, 0xdc, 0x5c, 0xec, 0x8e, 0xcb,
-	0xca, 0xe6, 0xd0, 0x87, 0xf3, 0xc3, 0xa2, 0xb6, 0xba, 0xfe, 0xeb, 0xd4, 0xd2, 0x8e, 0x4f, 0x2d,
-	0xed, 0xef, 0xa9, 0xa5, 0x7d, 0x39, 0xb3, 0x06, 0x8e, 0xcf, 0xac, 0x81, 0x3f, 0x67, 0xd6, 0xc0,
-	0xab, 0x3b, 0x5e, 0x8b, 0xf9, 0xfb, 0xdb, 0xa5, 0x06, 0x0e, 0x1c, 0x5e, 0x32, 0x44, 0xec, 0x1d,
-	0x26, 0xbb, 0x4e, 0xba, 0x09, 0xd9, 0xfb, 0x3d, 0x44, 0xb7, 0x87, 0xf9, 0x2f, 0xf1, 0x83, 0x7f,
-	0x01, 0x00, 0x00, 0xff, 0xff, 0x09, 0xc9, 0xe8, 0xdc, 0x2b, 0x08, 0x00, 0x00,
+	// 1056 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0x51, 0x6f, 0xdb, 0x54,
+	0x14, 0x8e, 0xdb, 0x6c, 0x4d, 0x4f, 0xba, 0xa8, 0xf5, 0xda, 0xe1, 0xba, 0x9b, 0xd3, 0x19, 0x31,
+	0x45, 0x19, 0x8d, 0x21, 0xc0, 0x40, 0x01, 0x09, 0x25, 0x55, 0x46, 0x2b, 0x2d, 0x1b, 0x72, 0x8a,
+	0x40, 0x3c, 0x60, 0xb9, 0xf1, 0xad, 0x6d, 0xb5, 0xf6, 0x0d, 0xf6, 0x0d, 0x6c, 0x6f, 0xc0, 0x13,
+	0xe2, 0x69, 0xfc, 0x06, 0x7e, 0x00, 0x7d, 0xd8, 0x1b, 0x7f, 0x60, 0x48, 0x3c, 0x4c, 0x7b, 0x42,
+	0x3c, 0x0c, 0xd4, 0x3e, 0x54, 0xfc, 0x06, 0x5e, 0x90, 0xef, 0xb5, 0x1d, 0xdb, 0x71, 0xd3, 0x2a,
+	0x2f, 0x49, 0xee, 0xf9, 0xce, 0x3d, 0xf7, 0x7c, 0xdf, 0x39, 0xf7, 0xdc, 0xc0, 0xaa, 0xee, 0x1d,
+	0x22, 0xac, 0x0c, 0x8e, 0x74, 0xdb, 0x51, 0xc8, 0xe3, 0xc6, 0xd0, 0xc3, 0x04, 0xf3, 0x65, 0x6a,
+	0x6d, 0x50, 0xab, 0xb8, 0x3e, 0xc0, 0xbe, 0x83, 0x7d, 0x8d, 0x42, 0x0a, 0x5b, 0x30, 0x3f, 0x51,
+	0x4a, 0xee, 0xa6, 0x9f, 0x9a, 0x87, 0x06, 0xd8, 0x33, 0x42, 0x7c, 0xd5, 0xc4, 0x26, 0x66, 0xfb,
+	0x82, 0x5f, 0xa1, 0xf5, 0x35, 0x16, 0x43, 0x71, 0x7c, 0x53, 0xf9, 0xe6, 0xed, 0xe0, 0x2b, 0x04,
+	0x56, 0x74, 0xc7, 0x76, 0xb1, 0x42, 0x3f, 0x43, 0x53, 0xd5, 0xc4, 0xd8, 0x3c, 0x42, 0x0a, 0x5d,
+	0xed, 0x8f, 0x0e, 0x14, 0x62, 0x3b, 0xc8, 0x27, 0xba, 0x33, 0x64, 0x0e, 0xf2, 0x29, 0x07, 0xe5,
+	0x9e, 0x6f, 0x6e, 0x07, 0x87, 0x77, 0x89, 0xc5, 0x37, 0x61, 0x61, 0xe0, 0x21, 0x9d, 0x60, 0x4f,
+	0xe0, 0x36, 0xb9, 0xda, 0x62, 0x47, 0x78, 0xf9, 0x6c, 0x6b, 0x35, 0xcc, 0xba, 0x6d, 0x18, 0x1e,
+	0xf2, 0xfd, 0x3e, 0xf1, 0x6c, 0xd7, 0x54, 0x23, 0x47, 0xbe, 0x0a, 0x65, 0x44, 0x2c, 0x4d, 0x67,
+	0xa8, 0x30, 0x17, 0xec, 0x53, 0x01, 0x11, 0x2b, 0xf4, 0xe7, 0x6f, 0xc2, 0xa2, 0x6f, 0x9b, 0xae,
+	0x4e, 0x46, 0x1e, 0x12, 0xe6, 0x29, 0x3c, 0x36, 0xf0, 0xef, 0x31, 0x54, 0x73, 0xb0, 0x81, 0x84,
+	0xe2, 0x26, 0x57, 0xab, 0x34, 0x85, 0x46, 0x42, 0xc1, 0x46, 0x97, 0x58, 0x7d, 0xdb, 0x74, 0x7b,
+	0xd8, 0x40, 0x6a, 0xc9, 0x0f, 0x7f, 0xb5, 0xea, 0x3f, 0x9c, 0x1d, 0xd7, 0xa3, 0x1c, 0x7e, 0x3a,
+	0x3b, 0xae, 0xaf, 0x33, 0x35, 0x1f, 0x87, 0x7a, 0x26, 0x58, 0xc9, 0xdf, 0xcf, 0xc1, 0xf5, 0xc4,
+	0x5a, 0x45, 0xfe, 0x10, 0xbb, 0x3e, 0xe2, 0x3f, 0x80, 0x44, 0x9a, 0x17, 0x12, 0x4e, 0x52, 0xfa,
+	0x08, 0x96, 0xe8, 0x71, 0xed, 0x24, 0xe9, 0x29, 0x7b, 0x53, 0xde, 0xfc, 0x3d, 0xa8, 0x20, 0x62,
+	0xd1, 0x74, 0xda, 0x0e, 0x1e, 0xb9, 0x84, 0xaa, 0x32, 0xdf, 0xa9, 0xbc, 0x7c, 0xb6, 0x05, 0xe1,
+	0xfe, 0x5d, 0x97, 0xa8, 0x19, 0x2f, 0xbe, 0x05, 0xcb, 0x34, 0x4e, 0x72, 0x67, 0x31, 0x77, 0xe7,
+	0x84, 0x9f, 0xfc, 0x35, 0x5c, 0x8b, 0x24, 0x68, 0x07, 0xd8, 0x2c, 0xa5, 0x6e, 0xbd, 0x99, 0x15,
+	0x7d, 0x23, 0x5f, 0x74, 0x7a, 0x82, 0xec, 0xc3, 0x5a, 0xca, 0x10, 0xeb, 0xde, 0x84, 0x05, 0xfd,
+	0x92, 0xa2, 0x47, 0x8e, 0xfc, 0x1d, 0xb8, 0xaa, 0x33, 0xc6, 0x73, 0xb9, 0x8c, 0x43, 0x54, 0xfe,
+	0x95, 0x83, 0xe5, 0x9e, 0x6f, 0xee, 0x79, 0xba, 0xeb, 0x1f, 0x20, 0x8f, 0x9e, 0x3e, 0x53, 0x5b,
+	0xdf, 0x83, 0x45, 0x72, 0xe9, 0xfa, 0x8e, 0x5d, 0x5b, 0x4a, 0x56, 0x23, 0x69, 0x42, 0xa3, 0x54,
+	0x72, 0xb2, 0x08, 0x42, 0xd6, 0x16, 0x29, 0x25, 0xff, 0xce, 0xee, 0x67, 0xdb, 0x30, 0x66, 0x27,
+	0x52, 0x83, 0x2b, 0x03, 0x4b, 0xb7, 0x5d, 0x4a, 0xa2, 0xd2, 0xe4, 0x53, 0x97, 0x6b, 0x3b, 0x40,
+	0x54, 0xe6, 0xc0, 0x0b, 0xe3, 0xba, 0xb0, 0x6b, 0x1a, 0xab, 0x7f, 0x23, 0x56, 0x9f, 0xf6, 0x5b,
+	0xa4, 0xf6, 0x65, 0x6e, 0x61, 0x94, 0xbb, 0xbc, 0x06, 0xd7, 0x13, 0xcb, 0x98, 0xe2, 0x6f, 0x1c,
+	0xac, 0x44, 0x6d, 0xb2, 0x67, 0x61, 0x8f, 0xa5, 0x32, 0x0b, 0xd1, 0xdb, 0xb0, 0x74, 0xe0, 0x61,
+	0x27, 0x33, 0x89, 0xca, 0x81, 0x2d, 0xba, 0x79, 0xb7, 0x00, 0x08, 0xd6, 0xd2, 0x24, 0x13, 0xb5,
+	0x7b, 0x2b, 0x4b, 0xa7, 0x9a, 0xdf, 0xdf, 0x71, 0x9e, 0xf2, 0xcf, 0x1c, 0xac, 0x4f, 0x58, 0xe3,
+	0x46, 0xff, 0x30, 0x93, 0xd1, 0x45, 0x54, 0x52, 0xb9, 0xbe, 0x9f, 0xca, 0xf5, 0xf2, 0x1d, 0x28,
+	0xff, 0xc7, 0xb1, 0x71, 0x17, 0xd0, 0x40, 0x4c, 0x6c, 0x3c, 0x72, 0x8d, 0xa0, 0xa3, 0xf5, 0x11,
+	0xb1, 0xb0, 0x67, 0x93, 0x27, 0x17, 0xa6, 0x32, 0x76, 0x0d, 0x06, 0xbc, 0x83, 0xbc, 0xc3, 0x23,
+	0xa4, 0x79, 0x18, 0xb3, 0xfb, 0xb7, 0xa4, 0x02, 0x33, 0xa9, 0x18, 0x13, 0x7e, 0x15, 0xae, 0x18,
+	0xc8, 0xc5, 0x4e, 0x28, 0x28, 0x5b, 0xf0, 0x1f, 0x43, 0x09, 0xb9, 0x86, 0x16, 0x3c, 0x39, 0xb4,
+	0x6b, 0xca, 0x4d, 0xb1, 0xc1, 0xde, 0xa3, 0x46, 0xf4, 0x1e, 0x35, 0xf6, 0xa2, 0xf7, 0xa8, 0x53,
+	0x7a, 0xfe, 0xaa, 0x5a, 0x78, 0xfa, 0x77, 0x95, 0x53, 0x17, 0x90, 0x6b, 0x04, 0xf6, 0xd6, 0xbb,
+	0x41, 0x35, 0xc6, 0x79, 0x04, 0xf5, 0xb8, 0x3d, 0x59, 0x8f, 0x0c, 0x4b, 0xf9, 0x08, 0x36, 0x72,
+	0xcc, 0x71, 0x49, 0xd6, 0xa1, 0xe4, 0x05, 0x06, 0xcd, 0x36, 0xa8, 0x06, 0x45, 0x75, 0x81, 0xae,
+	0x77, 0x0d, 0x3a, 0x96, 0x06, 0x83, 0x78, 0xc6, 0x4c, 0x1f, 0x4b, 0xcc, 0x51, 0xfe, 0x97, 0x83,
+	0x4a, 0x54, 0xff, 0x1e, 0x55, 0x64, 0xa6, 0xd6, 0x4d, 0x66, 0x35, 0x97, 0xce, 0x6a, 0x3b, 0xbe,
+	0x7a, 0x54, 0xdd, 0xce, 0xdd, 0x40, 0xa8, 0xbf, 0x5e, 0x55, 0xd7, 0x58, 0x44, 0xdf, 0x38, 0x6c,
+	0xd8, 0x58, 0x71, 0x74, 0x62, 0x05, 0x73, 0x30, 0x7f, 0x2a, 0x06, 0x15, 0x1a, 0x7a, 0x18, 0x1f,
+	0x08, 0xc5, 0xcd, 0xf9, 0xda, 0x92, 0xca, 0x16, 0xad, 0xad, 0x6c, 0xbb, 0xdf, 0xcc, 0x6f, 0x77,
+	0x46, 0x4c, 0x16, 0xe0, 0x46, 0xda, 0x12, 0x89, 0x5a, 0x6f, 0x43, 0x39, 0xf1, 0x4a, 0xf3, 0x6b,
+	0xb0, 0xd2, 0xdd, 0xdb, 0xd1, 0xfa, 0xbb, 0x9f, 0x3c, 0xd4, 0xd4, 0xf6, 0xe7, 0xda, 0x4e, 0xbb,
+	0xbf, 0xb3, 0x5c, 0x48, 0x99, 0x3f, 0xed, 0xaa, 0xfd, 0x47, 0x0f, 0xdb, 0x0f, 0x96, 0x39, 0xb1,
+	0xf8, 0xe3, 0x2f, 0x52, 0xa1, 0xf9, 0x47, 0x11, 0xe6, 0x7b, 0xbe, 0xc9, 0xdf, 0x87, 0x52, 0xfc,
+	0x6f, 0x24, 0xfd, 0x3f, 0x20, 0xf1, 0x82, 0x8b, 0x9b, 0xe7, 0x21, 0x71, 0x9d, 0x1f, 0x00, 0x24,
+	0x1e, 0x3b, 0x31, 0xd7, 0x9f, 0x62, 0xa2, 0x7c, 0x3e, 0x16, 0x47, 0xfb, 0x0c, 0xae, 0xa5, 0x5f,
+	0x94, 0x5b, 0xd9, 0x4d, 0x29, 0x58, 0x7c, 0x63, 0x2a, 0x1c, 0x87, 0xbd, 0x0f, 0xa5, 0x78, 0xb4,
+	0x4f, 0x90, 0x8d, 0x10, 0x71, 0xf3, 0x3c, 0x24, 0x8e, 0xf3, 0x05, 0x54, 0x32, 0xf3, 0x53, 0xca,
+	0x25, 0x15, 0xe3, 0xe2, 0x9d, 0xe9, 0x78, 0x1c, 0xf9, 0x2b, 0x58, 0x9e, 0x98, 0x23, 0x93, 0xe2,
+	0x67, 0x3c, 0xc4, 0xda, 0x45, 0x1e, 0x71, 0xfc, 0x47, 0x50, 0x4e, 0xde, 0x9d, 0x8d, 0xdc, 0xb4,
+	0x18, 0x28, 0xbe, 0x3e, 0x05, 0x8c, 0x02, 0x8a, 0x57, 0xbe, 0x3b, 0x3b, 0xae, 0x73, 0x9d, 0xee,
+	0xf3, 0x13, 0x89, 0x7b, 0x71, 0x22, 0x71, 0xff, 0x9c, 0x48, 0xdc, 0xd3, 0x53, 0xa9, 0xf0, 0xe2,
+	0x54, 0x2a, 0xfc, 0x79, 0x2a, 0x15, 0xbe, 0xbc, 0x6b, 0xda, 0xc4, 0x1a, 0xed, 0x37, 0x06, 0xd8,
+	0x51, 0x68, 0x3c, 0x17, 0x91, 0x6f, 0xb1, 0x77, 0xa8, 0xa4, 0x7b, 0x9f, 0x3c, 0x19, 0x22, 0x7f,
+	0xff, 0x2a, 0x9d, 0x54, 0xef, 0xfc, 0x3f, 0x00, 0x72, 0xd7, 0x48, 0xea, 0xe9, 0x0b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -640,6 +932,8 @@ type MsgClient interface {
 	TransferClaim(ctx context.Context, in *MsgTransferClaim, opts ...grpc.CallOption) (*MsgTransferClaimResponse, error)
 	AddClaim(ctx context.Context, in *MsgAddClaim, opts ...grpc.CallOption) (*MsgAddClaimResponse, error)
 	ClaimThorchain(ctx context.Context, in *MsgClaimThorchain, opts ...grpc.CallOption) (*MsgClaimThorchainResponse, error)
+	CreateClaimRound(ctx context.Context, in *MsgCreateClaimRound, opts ...grpc.CallOption) (*MsgCreateClaimRoundResponse, error)
+	ClaimMerkle(ctx context.Context, in *MsgClaimMerkle, opts ...grpc.CallOption) (*MsgClaimMerkleResponse, error)
 }
 
 type msgClient struct {
@@ -695,6 +989,24 @@ func (c *msgClient) ClaimThorchain(ctx context.Context, in *MsgClaimThorchain, o
 	return out, nil
 }
 
+func (c *msgClient) CreateClaimRound(ctx context.Context, in *MsgCreateClaimRound, opts ...grpc.CallOption) (*MsgCreateClaimRoundResponse, error) {
+	out := new(MsgCreateClaimRoundResponse)
+	err := c.cc.Invoke(ctx, "/arkeo.claim.Msg/CreateClaimRound", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClaimMerkle(ctx context.Context, in *MsgClaimMerkle, opts ...grpc.CallOption) (*MsgClaimMerkleResponse, error) {
+	out := new(MsgClaimMerkleResponse)
+	err := c.cc.Invoke(ctx, "/arkeo.claim.Msg/ClaimMerkle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	ClaimEth(context.Context, *MsgClaimEth) (*MsgClaimEthResponse, error)
@@ -702,6 +1014,8 @@ type MsgServer interface {
 	TransferClaim(context.Context, *MsgTransferClaim) (*MsgTransferClaimResponse, error)
 	AddClaim(context.Context, *MsgAddClaim) (*MsgAddClaimResponse, error)
 	ClaimThorchain(context.Context, *MsgClaimThorchain) (*MsgClaimThorchainResponse, error)
+	CreateClaimRound(context.Context, *MsgCreateClaimRound) (*MsgCreateClaimRoundResponse, error)
+	ClaimMerkle(context.Context, *MsgClaimMerkle) (*MsgClaimMerkleResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -723,6 +1037,12 @@ func (*UnimplementedMsgServer) AddClaim(ctx context.Context, req *MsgAddClaim) (
 func (*UnimplementedMsgServer) ClaimThorchain(ctx context.Context, req *MsgClaimThorchain) (*MsgClaimThorchainResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ClaimThorchain not implemented")
 }
+func (*UnimplementedMsgServer) CreateClaimRound(ctx context.Context, req *MsgCreateClaimRound) (*MsgCreateClaimRoundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateClaimRound not implemented")
+}
+func (*UnimplementedMsgServer) ClaimMerkle(ctx context.Context, req *MsgClaimMerkle) (*MsgClaimMerkleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimMerkle not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -818,7 +1138,42 @@ func _Msg_ClaimThorchain_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
-var Msg_serviceDesc = _Msg_serviceDesc
+func _Msg_CreateClaimRound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateClaimRound)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateClaimRound(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/arkeo.claim.Msg/CreateClaimRound",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateClaimRound(ctx, req.(*MsgCreateClaimRound))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimMerkle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimMerkle)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimMerkle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/arkeo.claim.Msg/ClaimMerkle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimMerkle(ctx, req.(*MsgClaimMerkle))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "arkeo.claim.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -843,6 +1198,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ClaimThorchain",
 			Handler:    _Msg_ClaimThorchain_Handler,
 		},
+		{
+			MethodName: "CreateClaimRound",
+			Handler:    _Msg_CreateClaimRound_Handler,
+		},
+		{
+			MethodName: "ClaimMerkle",
+			Handler:    _Msg_ClaimMerkle_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "arkeo/claim/tx.proto",
@@ -868,6 +1231,11 @@ func (m *MsgClaimEth) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SignMode != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SignMode))
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.Signature) > 0 {
 		i -= len(m.Signature)
 		copy(dAtA[i:], m.Signature)
@@ -1215,48 +1583,215 @@ func (m *MsgClaimThorchainResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgCreateClaimRound) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgClaimEth) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgCreateClaimRound) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateClaimRound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintTx(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.EthAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.MerkleRoot) > 0 {
+		i -= len(m.MerkleRoot)
+		copy(dAtA[i:], m.MerkleRoot)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MerkleRoot)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Signature)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgClaimEthResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCreateClaimRoundResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateClaimRoundResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateClaimRoundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.EthAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.RoundId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RoundId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimMerkle) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimMerkle) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimMerkle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Proof) > 0 {
+		for iNdEx := len(m.Proof) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Proof[iNdEx])
+			copy(dAtA[i:], m.Proof[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Proof[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.RoundId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RoundId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimMerkleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimMerkleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimMerkleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgClaimEth) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.EthAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SignMode != 0 {
+		n += 1 + sovTx(uint64(m.SignMode))
+	}
+	return n
+}
+
+func (m *MsgClaimEthResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EthAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	l = len(m.ArkeoAddress)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
@@ -1395,6 +1930,78 @@ func (m *MsgClaimThorchainResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgCreateClaimRound) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MerkleRoot)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCreateClaimRoundResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RoundId != 0 {
+		n += 1 + sovTx(uint64(m.RoundId))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgClaimMerkle) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.RoundId != 0 {
+		n += 1 + sovTx(uint64(m.RoundId))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Proof) > 0 {
+		for _, b := range m.Proof {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgClaimMerkleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1526,6 +2133,25 @@ func (m *MsgClaimEth) Unmarshal(dAtA []byte) error {
 			}
 			m.Signature = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignMode", wireType)
+			}
+			m.SignMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignMode |= EthSignMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2508,6 +3134,505 @@ func (m *MsgClaimThorchainResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgCreateClaimRound) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateClaimRound: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateClaimRound: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerkleRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MerkleRoot = append(m.MerkleRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.MerkleRoot == nil {
+				m.MerkleRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateClaimRoundResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateClaimRoundResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateClaimRoundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoundId", wireType)
+			}
+			m.RoundId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RoundId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimMerkle) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimMerkle: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimMerkle: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoundId", wireType)
+			}
+			m.RoundId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RoundId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proof = append(m.Proof, make([]byte, postIndex-iNdEx))
+			copy(m.Proof[len(m.Proof)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimMerkleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimMerkleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimMerkleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0